@@ -0,0 +1,82 @@
+package hl7
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestBuilder(t *testing.T) {
+	b := v251.NewORU_R01Builder().MSH(v251.MSH{
+		FieldSeparator:     "|",
+		EncodingCharacters: `^~\&`,
+		DateTimeOfMessage:  time.Date(2025, 6, 9, 7, 16, 16, 0, time.UTC),
+		MessageType:        v251.MSG{MessageCode: "ORU", TriggerEvent: "R01", MessageStructure: "ORU_R01"},
+		MessageControlID:   "1",
+		ProcessingID:       v251.PT{ProcessingID: "P"},
+		VersionID:          v251.VID{VersionID: "2.5.1"},
+	})
+	pr := b.PatientResult()
+	pr.Patient().PID(v251.PID{PatientName: []v251.XPN{{FamilyName: "Smith", GivenName: "John"}}})
+	order := pr.OrderObservation().OBR(v251.OBR{UniversalServiceIdentifier: v251.CE{Identifier: "ABC"}})
+	order.Observation().OBX(v251.OBX{ValueType: "ST", ObservationIdentifier: v251.CE{Identifier: "GLU"}, ObservationValue: []v251.VARIES{"Low"}})
+	order.Observation().
+		OBX(v251.OBX{ValueType: "ST", ObservationIdentifier: v251.CE{Identifier: "NA"}, ObservationValue: []v251.VARIES{"High"}}).
+		NTE(v251.NTE{Comment: []v251.FT{"First"}}).
+		NTE(v251.NTE{SetID: "7", Comment: []v251.FT{"Second"}})
+
+	msg, err := b.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	obs := msg.PatientResult[0].OrderObservation[0].Observation
+	for i, w := range []string{"1", "2"} {
+		if g := obs[i].OBX.SetID; g != w {
+			t.Errorf("OBX %d set ID %q, want %q", i, g, w)
+		}
+	}
+	if g := obs[1].NTE[1].SetID; g != "7" {
+		t.Errorf("set ID was replaced with %q", g)
+	}
+
+	got, err := NewEncoder(&EncodeOption{TrimTrailingSeparator: true}).Encode(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Join([]string{
+		`MSH|^~\&|||||20250609071616||ORU^R01^ORU_R01|1|P|2.5.1`,
+		`PID|1||||Smith^John`,
+		`OBR|1|||ABC`,
+		`OBX|1|ST|GLU||Low`,
+		`OBX|2|ST|NA||High`,
+		`NTE|1||First`,
+		`NTE|7||Second`,
+	}, "\r")
+	if string(got) != want {
+		t.Fatalf("got:\n%q\nwant:\n%q", got, want)
+	}
+}
+
+func TestBuilderErrors(t *testing.T) {
+	b := v251.NewORU_R01Builder()
+	pr := b.PatientResult()
+	pr.Patient().PID(v251.PID{}).PID(v251.PID{})
+	pr.OrderObservation().Observation()
+
+	_, err := b.Build()
+	if err == nil {
+		t.Fatal("expected validation errors")
+	}
+	for _, w := range []string{
+		"ORU_R01: missing required MSH",
+		"ORU_R01.PatientResult[0].Patient: PID set more than once",
+		"ORU_R01.PatientResult[0].OrderObservation[0]: missing required OBR",
+		"ORU_R01.PatientResult[0].OrderObservation[0].Observation[0]: missing required OBX",
+	} {
+		if !strings.Contains(err.Error(), w) {
+			t.Errorf("missing error %q in:\n%v", w, err)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package hl7
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// ErrCheckDigit is returned when the check digit of an identifier does not match its scheme.
+var ErrCheckDigit = errors.New("check digit mismatch")
+
+// CheckDigit computes the check digit of an identifier with a scheme from table 0061.
+//
+//	M10  Mod 10 (Luhn) algorithm.
+//	M11  Mod 11 algorithm, weights 2 to 7 from the right. A check digit of 10 is "X" and 11 is "0".
+//	ISO  ISO 7064 MOD 11-2. A check digit of 10 is "X".
+//	NPI  Mod 10 algorithm with the "80840" prefix of the US National Provider Identifier.
+//
+// Check digits are only defined for numeric identifiers.
+func CheckDigit(id, scheme string) (string, error) {
+	if len(id) == 0 {
+		return "", fmt.Errorf("check digit: empty identifier")
+	}
+	for i := 0; i < len(id); i++ {
+		if c := id[i]; c < '0' || c > '9' {
+			return "", fmt.Errorf("check digit: identifier %q is not numeric", id)
+		}
+	}
+	switch scheme {
+	default:
+		return "", fmt.Errorf("check digit: unknown scheme %q", scheme)
+	case "M10":
+		return strconv.Itoa(mod10(id)), nil
+	case "NPI":
+		return strconv.Itoa(mod10("80840" + id)), nil
+	case "M11":
+		sum := 0
+		for i := 0; i < len(id); i++ {
+			weight := 2 + i%6
+			sum += int(id[len(id)-1-i]-'0') * weight
+		}
+		switch c := 11 - sum%11; c {
+		case 10:
+			return "X", nil
+		case 11:
+			return "0", nil
+		default:
+			return strconv.Itoa(c), nil
+		}
+	case "ISO":
+		p := 0
+		for i := 0; i < len(id); i++ {
+			p = (p + int(id[i]-'0')) * 2 % 11
+		}
+		if c := (12 - p) % 11; c != 10 {
+			return strconv.Itoa(c), nil
+		}
+		return "X", nil
+	}
+}
+
+// mod10 returns the Luhn check digit of a numeric identifier.
+func mod10(id string) int {
+	sum := 0
+	for i := 0; i < len(id); i++ {
+		d := int(id[len(id)-1-i] - '0')
+		if i%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return (10 - sum%10) % 10
+}
+
+// VerifyCheckDigit returns an error wrapping ErrCheckDigit if the check digit does not match
+// the identifier with the scheme. It may be used as DecodeOption.CheckDigit.
+// Without a check digit or without a scheme there is nothing to verify and nil is returned.
+func VerifyCheckDigit(id, checkDigit, scheme string) error {
+	if len(checkDigit) == 0 || len(scheme) == 0 {
+		return nil
+	}
+	want, err := CheckDigit(id, scheme)
+	if err != nil {
+		return err
+	}
+	if want != checkDigit {
+		return fmt.Errorf("%w: identifier %q with scheme %s has check digit %q, want %q", ErrCheckDigit, id, scheme, checkDigit, want)
+	}
+	return nil
+}
+
+// cxParts returns the identifier, check digit and scheme components of a CX value, if rv is a CX.
+func cxParts(rv reflect.Value) (id, digit, scheme reflect.Value, ok bool) {
+	meta, err := typeMeta(rv.Type())
+	if err != nil || !meta.Present || meta.Type != structDataType || meta.Name != "CX" {
+		return
+	}
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		ft := rt.Field(i)
+		t, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+		if err != nil || !t.Present || t.Meta || ft.Type.Kind() != reflect.String {
+			continue
+		}
+		switch t.Order {
+		case 1:
+			id = rv.Field(i)
+		case 2:
+			digit = rv.Field(i)
+		case 3:
+			scheme = rv.Field(i)
+		}
+	}
+	ok = id.IsValid() && digit.IsValid() && scheme.IsValid()
+	return
+}
+
+// walkCX calls fn on each CX value within a decoded field.
+func walkCX(rv reflect.Value, fn func(id, digit, scheme string) error) []error {
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return nil
+		}
+		return walkCX(rv.Elem(), fn)
+	case reflect.Slice:
+		var list []error
+		for i := 0; i < rv.Len(); i++ {
+			list = append(list, walkCX(rv.Index(i), fn)...)
+		}
+		return list
+	case reflect.Struct:
+		if id, digit, scheme, ok := cxParts(rv); ok {
+			if len(id.String()) == 0 {
+				return nil
+			}
+			if err := fn(id.String(), digit.String(), scheme.String()); err != nil {
+				return []error{err}
+			}
+			return nil
+		}
+		meta, err := typeMeta(rv.Type())
+		if err != nil || !meta.Present || meta.Type != structDataType {
+			return nil
+		}
+		var list []error
+		for i := 0; i < rv.NumField(); i++ {
+			if rv.Type().Field(i).IsExported() {
+				list = append(list, walkCX(rv.Field(i), fn)...)
+			}
+		}
+		return list
+	}
+	return nil
+}
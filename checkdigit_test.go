@@ -0,0 +1,87 @@
+package hl7
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestCheckDigit(t *testing.T) {
+	list := []struct {
+		id, scheme, want string
+	}{
+		{"12345", "M10", "5"},
+		{"7992739871", "M10", "3"},
+		{"12345", "M11", "5"},
+		{"6", "M11", "X"},
+		{"000000021825009", "ISO", "7"},
+		{"000000021694233", "ISO", "X"},
+		{"123456789", "NPI", "3"},
+	}
+	for _, item := range list {
+		got, err := CheckDigit(item.id, item.scheme)
+		if err != nil {
+			t.Errorf("%s %s: %v", item.scheme, item.id, err)
+			continue
+		}
+		if got != item.want {
+			t.Errorf("%s %s: got %q, want %q", item.scheme, item.id, got, item.want)
+		}
+		if err := VerifyCheckDigit(item.id, item.want, item.scheme); err != nil {
+			t.Errorf("verify %s %s: %v", item.scheme, item.id, err)
+		}
+	}
+	if _, err := CheckDigit("A123", "M10"); err == nil {
+		t.Error("alphanumeric identifier has a check digit")
+	}
+	if _, err := CheckDigit("123", "BAD"); err == nil {
+		t.Error("unknown scheme has a check digit")
+	}
+	if err := VerifyCheckDigit("12345", "4", "M10"); !errors.Is(err, ErrCheckDigit) {
+		t.Errorf("got %v, want ErrCheckDigit", err)
+	}
+}
+
+func TestCheckDigitDecode(t *testing.T) {
+	raw := []byte(`MSH|^~\&|A|B|C|D|20070305170957||ADT^A01^ADT_A01|1|P|2.5.1
+PID|1||12345^4^M10~99^^^HOSP~12345^5^M10||Smith^John`)
+
+	d := NewDecoder(v251.Registry, &DecodeOption{CheckDigit: VerifyCheckDigit})
+	list, err := d.DecodeList(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	se, ok := list[1].(SegmentError)
+	if !ok {
+		t.Fatalf("got %T, want SegmentError", list[1])
+	}
+	if len(se.ErrorList) != 1 || !errors.Is(se.ErrorList[0], ErrCheckDigit) {
+		t.Fatalf("got %v, want one check digit error", se.ErrorList)
+	}
+	const want = `line 2, PID.PatientIdentifierList[3]: check digit mismatch: identifier "12345" with scheme M10 has check digit "4", want "5"`
+	if g := se.ErrorList[0].Error(); g != want {
+		t.Errorf("got  %s\nwant %s", g, want)
+	}
+}
+
+func TestCheckDigitEncode(t *testing.T) {
+	pid := &v251.PID{
+		PatientIdentifierList: []v251.CX{
+			{IDNumber: "12345"},
+			{IDNumber: "12345", CheckDigitScheme: "M11"},
+			{IDNumber: "12345", CheckDigit: "9", CheckDigitScheme: "M10"},
+			{IDNumber: "A1"},
+		},
+	}
+	e := NewEncoder(&EncodeOption{TrimTrailingSeparator: true, CheckDigitScheme: "M10"})
+	got, err := e.Encode(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const want = `PID|1||12345^5^M10~12345^5^M11~12345^9^M10~A1`
+	if g := strings.TrimSpace(string(got)); g != want {
+		t.Errorf("got  %s\nwant %s", g, want)
+	}
+}
@@ -0,0 +1,394 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/kardianos/hl7"
+	"github.com/kardianos/hl7/h210"
+	"github.com/kardianos/hl7/h220"
+	"github.com/kardianos/hl7/h231"
+	"github.com/kardianos/hl7/h240"
+	"github.com/kardianos/hl7/h250"
+	"github.com/kardianos/hl7/h251"
+	"github.com/kardianos/hl7/h270"
+	"github.com/kardianos/hl7/h271"
+	"github.com/kardianos/hl7/h280"
+)
+
+// Message formats.
+const (
+	formatER7  = "er7"
+	formatJSON = "json"
+	formatXML  = "xml"
+)
+
+// version is a generated HL7 package.
+type version struct {
+	registry hl7.Registry
+	tables   map[string]map[string]bool
+}
+
+// The h230 and h260 packages are not current with the hl7.Registry interface.
+var versions = []version{
+	{h210.Registry, h210.TableValueLookup},
+	{h220.Registry, h220.TableValueLookup},
+	{h231.Registry, h231.TableValueLookup},
+	{h240.Registry, h240.TableValueLookup},
+	{h250.Registry, h250.TableValueLookup},
+	{h251.Registry, h251.TableValueLookup},
+	{h270.Registry, h270.TableValueLookup},
+	{h271.Registry, h271.TableValueLookup},
+	{h280.Registry, h280.TableValueLookup},
+}
+
+// lookupVersion returns the package of a version, such as "2.5.1".
+// A version without a package uses the package of a revision of the version,
+// such as "2.3.1" for "2.3", or else of the shorter version, such as "2.5.1" for "2.5.1.1",
+// or else the nearest earlier package of the same major version, such as "2.5.1" for "2.6".
+// A version is never matched by major version alone, so "2.9" does not decode as "2.1".
+func lookupVersion(v string) (version, bool) {
+	parts := strings.Split(strings.TrimSpace(v), ".")
+	if len(parts) < 2 {
+		return version{}, false
+	}
+	for n := len(parts); n >= 2; n-- {
+		prefix := strings.Join(parts[:n], ".")
+		for _, item := range versions {
+			if item.registry.Version() == prefix {
+				return item, true
+			}
+		}
+		for _, item := range versions {
+			if strings.HasPrefix(item.registry.Version(), prefix+".") {
+				return item, true
+			}
+		}
+	}
+	var found version
+	ok := false
+	for _, item := range versions {
+		pv := strings.Split(item.registry.Version(), ".")
+		if pv[0] == parts[0] && versionLess(pv, parts) {
+			found, ok = item, true
+		}
+	}
+	return found, ok
+}
+
+// versionLess reports if version a is before version b, comparing each number in turn.
+// Versions with parts that are not numbers are never before another.
+func versionLess(a, b []string) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		x, err := strconv.Atoi(a[i])
+		if err != nil {
+			return false
+		}
+		y, err := strconv.Atoi(b[i])
+		if err != nil {
+			return false
+		}
+		if x != y {
+			return x < y
+		}
+	}
+	return len(a) < len(b)
+}
+
+// message is a single message read from an input.
+type message struct {
+	file   string // Name of the input file, or "-" for stdin.
+	index  int    // Index of the message in the input, starting at one.
+	format string
+	data   []byte
+}
+
+// header returns the version and, for ER7, the message type and control ID of the message.
+func (m message) header() (ver, messageType, controlID string) {
+	switch m.format {
+	case formatJSON:
+		return jsonVersion(m.data), "", ""
+	case formatXML:
+		return xmlVersion(m.data), "", ""
+	}
+	fields, comp := mshFields(m.data)
+	field := func(n int) string {
+		if n >= len(fields) {
+			return ""
+		}
+		return fields[n]
+	}
+	ver, _, _ = strings.Cut(field(12), comp)
+	return ver, field(9), field(10)
+}
+
+// decode returns the trigger of the message. If as is set, it is the version
+// to decode as, otherwise the version is read from MSH-12.
+// As with hl7.Decoder.Decode, a trigger and an error may be returned together.
+func (m message) decode(as string, key hl7.JSONKey) (any, version, error) {
+	v := as
+	if len(v) == 0 {
+		v, _, _ = m.header()
+	}
+	ver, ok := lookupVersion(v)
+	if !ok {
+		if len(v) == 0 {
+			return nil, ver, fmt.Errorf("missing version in MSH-12, set the -as flag")
+		}
+		return nil, ver, fmt.Errorf("unknown version %q, set the -as flag", v)
+	}
+	var trigger any
+	var err error
+	switch m.format {
+	case formatJSON:
+		trigger, err = hl7.NewJSONDecoder(ver.registry, &hl7.JSONOption{Key: key}).Decode(m.data)
+	case formatXML:
+		trigger, err = hl7.NewXMLDecoder(ver.registry).Decode(m.data)
+	default:
+		trigger, err = hl7.NewDecoder(ver.registry, nil).Decode(m.data)
+	}
+	return trigger, ver, err
+}
+
+// readMessages reads the messages of each file, or of stdin if there are no files or the file is "-".
+func readMessages(stdin io.Reader, files []string) ([]message, error) {
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+	var list []message
+	for _, fn := range files {
+		var data []byte
+		var err error
+		if fn == "-" {
+			data, err = io.ReadAll(stdin)
+		} else {
+			data, err = os.ReadFile(fn)
+		}
+		if err != nil {
+			return nil, err
+		}
+		format := detectFormat(data)
+		var parts [][]byte
+		switch format {
+		case formatJSON:
+			parts, err = splitJSON(data)
+		case formatXML:
+			parts, err = splitXML(data)
+		default:
+			parts = splitER7(data)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", fn, err)
+		}
+		for i, p := range parts {
+			list = append(list, message{file: fn, index: i + 1, format: format, data: p})
+		}
+	}
+	return list, nil
+}
+
+// detectFormat returns the format of the data from the first character.
+func detectFormat(data []byte) string {
+	data = bytes.TrimLeft(data, " \t\r\n\v\x1c\ufeff")
+	switch {
+	case bytes.HasPrefix(data, []byte("{")):
+		return formatJSON
+	case bytes.HasPrefix(data, []byte("<")):
+		return formatXML
+	}
+	return formatER7
+}
+
+// batchSegments are the segments of a file or batch envelope.
+var batchSegments = map[string]bool{"FHS": true, "BHS": true, "BTS": true, "FTS": true}
+
+// splitER7 returns each message of the data, from each MSH segment to the next.
+// Segments of a batch envelope, segments before the first MSH, and MLLP framing are dropped.
+// Segments of each message are joined with a carriage return.
+func splitER7(data []byte) [][]byte {
+	var list [][]byte
+	var current [][]byte
+	flush := func() {
+		if len(current) > 0 {
+			list = append(list, bytes.Join(current, []byte{'\r'}))
+			current = nil
+		}
+	}
+	lines := bytes.FieldsFunc(data, func(r rune) bool {
+		return r == '\r' || r == '\n' || r == '\x0b' || r == '\x1c'
+	})
+	for _, line := range lines {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		name := string(line[:min(3, len(line))])
+		switch {
+		case name == "MSH":
+			flush()
+			current = append(current, line)
+		case batchSegments[name]:
+			flush()
+		case len(current) > 0:
+			current = append(current, line)
+		}
+	}
+	flush()
+	return list
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// splitJSON returns each JSON value of the data, such as the lines of JSON Lines.
+func splitJSON(data []byte) ([][]byte, error) {
+	var list [][]byte
+	d := json.NewDecoder(bytes.NewReader(data))
+	for {
+		var raw json.RawMessage
+		err := d.Decode(&raw)
+		if errors.Is(err, io.EOF) {
+			return list, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, raw)
+	}
+}
+
+// splitXML returns each top level element of the data.
+func splitXML(data []byte) ([][]byte, error) {
+	var list [][]byte
+	d := xml.NewDecoder(bytes.NewReader(data))
+	depth := 0
+	var start int64
+	for {
+		offset := d.InputOffset()
+		tok, err := d.RawToken()
+		if errors.Is(err, io.EOF) {
+			return list, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch tok.(type) {
+		case xml.StartElement:
+			if depth == 0 {
+				start = offset
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+			if depth == 0 {
+				list = append(list, data[start:d.InputOffset()])
+			}
+		}
+	}
+}
+
+// mshFields returns the fields of the MSH segment of an ER7 message, numbered
+// as the MSH fields with the field separator, such as fields[9] for MSH-9, and the
+// component separator.
+func mshFields(data []byte) ([]string, string) {
+	i := bytes.Index(data, []byte("MSH"))
+	if i < 0 || len(data) < i+5 {
+		return nil, ""
+	}
+	line := data[i:]
+	if end := bytes.IndexAny(line, "\r\n"); end >= 0 {
+		line = line[:end]
+	}
+	sep := string(line[3])
+	fields := strings.Split(string(line), sep)
+	// MSH-1 is the field separator itself.
+	fields = append([]string{fields[0], sep}, fields[1:]...)
+	return fields, string(line[4])
+}
+
+// jsonVersion returns the first value of MSH-12 of a JSON message, by position or name.
+func jsonVersion(data []byte) string {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return ""
+	}
+	var msh map[string]json.RawMessage
+	if err := json.Unmarshal(m["MSH"], &msh); err != nil {
+		return ""
+	}
+	for _, key := range []string{"MSH.12", "VersionID"} {
+		if v, ok := msh[key]; ok {
+			return firstJSONString(v)
+		}
+	}
+	return ""
+}
+
+func firstJSONString(data json.RawMessage) string {
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return ""
+	}
+	for {
+		switch x := v.(type) {
+		case string:
+			return x
+		case []any:
+			if len(x) == 0 {
+				return ""
+			}
+			v = x[0]
+		case map[string]any:
+			// The first component, by position or name.
+			next, ok := any(nil), false
+			for key, c := range x {
+				if strings.HasSuffix(key, ".1") || key == "VersionID" {
+					next, ok = c, true
+					break
+				}
+			}
+			if !ok {
+				return ""
+			}
+			v = next
+		default:
+			return ""
+		}
+	}
+}
+
+// xmlVersion returns the first text of the MSH.12 element of an XML message.
+func xmlVersion(data []byte) string {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	in := false
+	for {
+		tok, err := d.RawToken()
+		if err != nil {
+			return ""
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if t.Name.Local == "MSH.12" {
+				in = true
+			}
+		case xml.EndElement:
+			if t.Name.Local == "MSH.12" {
+				return ""
+			}
+		case xml.CharData:
+			if s := strings.TrimSpace(string(t)); in && len(s) > 0 {
+				return s
+			}
+		}
+	}
+}
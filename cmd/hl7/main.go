@@ -0,0 +1,479 @@
+// Command hl7 inspects, validates and converts HL7 v2 messages.
+//
+//	hl7 [-as version] command [flags] [file ...]
+//
+// Messages are read from each file, or from stdin if there are no files or the
+// file is "-". Pass "--" before the files if the first file is "-". A file may hold several messages, such as a batch file. Each
+// message is read as ER7, JSON or XML, from the first character of the file,
+// and decoded with the package of the version in MSH-12, or of the -as flag. A version
+// without a package, such as "2.6", uses the nearest earlier package of the same major version.
+//
+// Commands:
+//
+//	parse     print the grouped trigger of each message as a tree
+//	validate  check required fields, lengths and table values, one JSON line per problem
+//	convert   convert messages between ER7, JSON and XML
+//	split     split batch files into messages, one JSON line per message
+//	diff      compare the values of the messages of two files, one JSON line per difference
+//	query     select values by path, such as 'PID-3[*].1, OBX[OBX-3.1=2345-7]-5', as CSV or JSON lines
+//	detect    print the version of each message from MSH-12, one JSON line per message
+//	version   same as detect
+//
+// The query command takes the query before the files, and with -raw reads the ER7
+// fields of messages of any version without decoding them:
+//
+//	hl7 query -format json 'PID-3[*].1, OBX[*]-3.1, OBX[*]-5' batch.hl7
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kardianos/hl7"
+	"github.com/kardianos/task"
+)
+
+func main() {
+	err := task.Start(context.Background(), time.Second*2, func(ctx context.Context) error {
+		st := task.DefaultState()
+		return task.Run(ctx, st, command(os.Stdin).Exec(os.Args[1:]))
+	})
+	if err != nil {
+		fmt.Fprint(os.Stderr, err, "\n")
+		var usage task.ErrUsage
+		if errors.As(err, &usage) {
+			os.Exit(2)
+		}
+		os.Exit(1)
+	}
+}
+
+// command returns the hl7 command, which reads messages from stdin when no file is given.
+func command(stdin io.Reader) *task.Command {
+	detect := &task.Command{
+		Name:   "detect",
+		Usage:  "print the version of each message from MSH-12, one JSON line per message",
+		Action: run(stdin, detectAction),
+	}
+	return &task.Command{
+		Name:  "hl7",
+		Usage: "inspect, validate and convert HL7 v2 messages",
+		Flags: []*task.Flag{
+			{Name: "as", Usage: "decode as this version, such as 2.5.1, rather than the version in MSH-12", Default: ""},
+		},
+		Commands: []*task.Command{
+			{
+				Name:   "parse",
+				Usage:  "print the grouped trigger of each message as a tree",
+				Action: run(stdin, parseAction),
+			},
+			{
+				Name:  "validate",
+				Usage: "check required fields, lengths and table values, one JSON line per problem",
+				Flags: []*task.Flag{
+					{Name: "tables", Usage: "check values of HL7 tables", Default: true},
+				},
+				Action: run(stdin, validateAction),
+			},
+			{
+				Name:  "convert",
+				Usage: "convert messages between ER7, JSON and XML",
+				Flags: []*task.Flag{
+					{Name: "to", Usage: "output format: er7, json or xml", Default: formatJSON},
+					{Name: "key", Usage: "JSON keys: position or name", Default: "position"},
+					{Name: "indent", Usage: "indent JSON and XML", Default: false},
+					{Name: "lf", Usage: "end ER7 segments with a line feed rather than a carriage return", Default: false},
+				},
+				Action: run(stdin, convertAction),
+			},
+			{
+				Name:  "split",
+				Usage: "split batch files into messages, one JSON line per message",
+				Flags: []*task.Flag{
+					{Name: "dir", Usage: "write each message to a file in this directory rather than to the JSON line", Default: ""},
+				},
+				Action: run(stdin, splitAction),
+			},
+			{
+				Name:  "diff",
+				Usage: "compare the values of the messages of two files, one JSON line per difference",
+				Flags: []*task.Flag{
+					{Name: "ignore", Usage: "comma separated paths to ignore, such as MSH-7,OBX[*]-14", Default: "MSH-7,MSH-10"},
+				},
+				Action: task.ActionFunc(func(ctx context.Context, st *task.State, sc task.Script) error {
+					return diffAction(st, stdin)
+				}),
+			},
+			{
+				Name:  "query",
+				Usage: "select values by path, such as 'PID-3[*].1, OBX[OBX-3.1=2345-7]-5', as CSV or JSON lines",
+				Flags: []*task.Flag{
+					{Name: "format", Usage: "output format: csv or json", Default: "csv"},
+					{Name: "raw", Usage: "query the ER7 fields without decoding, for messages of any version", Default: false},
+				},
+				Action: task.ActionFunc(func(ctx context.Context, st *task.State, sc task.Script) error {
+					return queryAction(st, stdin)
+				}),
+			},
+			detect,
+			{
+				Name:   "version",
+				Usage:  "same as detect",
+				Action: detect.Action,
+			},
+		},
+	}
+}
+
+// action runs a command on the messages of the inputs.
+type action func(st *task.State, list []message) error
+
+func run(stdin io.Reader, a action) task.Action {
+	return task.ActionFunc(func(ctx context.Context, st *task.State, sc task.Script) error {
+		files, _ := st.Get("args").([]string)
+		list, err := readMessages(stdin, files)
+		if err != nil {
+			return err
+		}
+		return a(st, list)
+	})
+}
+
+// jsonLines returns an encoder of one JSON value per line.
+func jsonLines(w io.Writer) *json.Encoder {
+	e := json.NewEncoder(w)
+	e.SetEscapeHTML(false)
+	return e
+}
+
+func str(st *task.State, name string) string {
+	v, _ := st.Get(name).(string)
+	return v
+}
+
+func flag(st *task.State, name string) bool {
+	v, _ := st.Get(name).(bool)
+	return v
+}
+
+func jsonKey(st *task.State) (hl7.JSONKey, error) {
+	switch k := str(st, "key"); k {
+	case "", "position":
+		return hl7.JSONKeyPosition, nil
+	case "name":
+		return hl7.JSONKeyName, nil
+	default:
+		return 0, fmt.Errorf("unknown JSON key %q, must be position or name", k)
+	}
+}
+
+// source is the location of a message in the JSON lines.
+type source struct {
+	File  string `json:"file"`
+	Index int    `json:"index"`
+}
+
+func (m message) source() source {
+	return source{File: m.file, Index: m.index}
+}
+
+func parseAction(st *task.State, list []message) error {
+	for i, m := range list {
+		trigger, _, err := m.decode(str(st, "as"), hl7.JSONKeyPosition)
+		if err != nil {
+			return fmt.Errorf("%s message %d: %w", m.file, m.index, err)
+		}
+		if i > 0 {
+			fmt.Fprintln(st.Stdout)
+		}
+		if err := printTree(st.Stdout, trigger); err != nil {
+			return fmt.Errorf("%s message %d: %w", m.file, m.index, err)
+		}
+	}
+	return nil
+}
+
+type problem struct {
+	source
+	Path   string `json:"path,omitempty"`
+	Rule   string `json:"rule"`
+	Detail string `json:"detail"`
+}
+
+func validateAction(st *task.State, list []message) error {
+	out := jsonLines(st.Stdout)
+	count := 0
+	for _, m := range list {
+		trigger, ver, err := m.decode(str(st, "as"), hl7.JSONKeyPosition)
+		if err != nil {
+			count++
+			if err := out.Encode(problem{source: m.source(), Rule: "decode", Detail: err.Error()}); err != nil {
+				return err
+			}
+		}
+		if trigger == nil {
+			continue
+		}
+		opt := &hl7.ValidateOption{}
+		if flag(st, "tables") {
+			opt.Tables = ver.tables
+		}
+		for _, ve := range hl7.Validate(trigger, opt) {
+			count++
+			if err := out.Encode(problem{source: m.source(), Path: ve.Path, Rule: ve.Rule, Detail: ve.Detail}); err != nil {
+				return err
+			}
+		}
+	}
+	if count > 0 {
+		return fmt.Errorf("%d problems in %d messages", count, len(list))
+	}
+	return nil
+}
+
+func convertAction(st *task.State, list []message) error {
+	key, err := jsonKey(st)
+	if err != nil {
+		return err
+	}
+	indent := ""
+	if flag(st, "indent") {
+		indent = "  "
+	}
+	to := strings.ToLower(str(st, "to"))
+	for _, m := range list {
+		trigger, _, err := m.decode(str(st, "as"), key)
+		if err != nil {
+			return fmt.Errorf("%s message %d: %w", m.file, m.index, err)
+		}
+		var data []byte
+		switch to {
+		default:
+			return fmt.Errorf("unknown format %q, must be er7, json or xml", to)
+		case formatER7:
+			data, err = hl7.NewEncoder(&hl7.EncodeOption{TrimTrailingSeparator: true}).Encode(trigger)
+			data = append(data, '\r')
+			if flag(st, "lf") {
+				data = bytes.ReplaceAll(data, []byte{'\r'}, []byte{'\n'})
+			}
+		case formatJSON:
+			data, err = hl7.NewJSONEncoder(&hl7.JSONOption{Key: key, Indent: indent}).Encode(trigger)
+			data = append(data, '\n')
+		case formatXML:
+			data, err = hl7.NewXMLEncoder(&hl7.XMLEncodeOption{Indent: indent}).Encode(trigger)
+			data = append(data, '\n')
+		}
+		if err != nil {
+			return fmt.Errorf("%s message %d: %w", m.file, m.index, err)
+		}
+		if _, err := st.Stdout.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type splitMessage struct {
+	source
+	Type      string `json:"type,omitempty"`
+	ControlID string `json:"control_id,omitempty"`
+	Path      string `json:"path,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
+
+func splitAction(st *task.State, list []message) error {
+	out := jsonLines(st.Stdout)
+	dir := str(st, "dir")
+	if len(dir) > 0 {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+	}
+	for _, m := range list {
+		_, messageType, controlID := m.header()
+		sm := splitMessage{source: m.source(), Type: messageType, ControlID: controlID}
+		if len(dir) == 0 {
+			sm.Message = string(m.data)
+		} else {
+			base := "stdin"
+			if m.file != "-" {
+				base = strings.TrimSuffix(filepath.Base(m.file), filepath.Ext(m.file))
+			}
+			ext := m.format
+			if ext == formatER7 {
+				ext = "hl7"
+			}
+			sm.Path = filepath.Join(dir, fmt.Sprintf("%s-%04d.%s", base, m.index, ext))
+			end := byte('\n')
+			if m.format == formatER7 {
+				end = '\r'
+			}
+			data := append(append([]byte{}, m.data...), end)
+			if err := os.WriteFile(sm.Path, data, 0o644); err != nil {
+				return err
+			}
+		}
+		if err := out.Encode(sm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type difference struct {
+	Index int    `json:"index"`
+	Path  string `json:"path"`
+	A     string `json:"a"`
+	B     string `json:"b"`
+}
+
+func diffAction(st *task.State, stdin io.Reader) error {
+	files, _ := st.Get("args").([]string)
+	if len(files) != 2 {
+		return fmt.Errorf("diff needs two files, got %d", len(files))
+	}
+	var ignore []string
+	for _, p := range strings.Split(str(st, "ignore"), ",") {
+		if p = strings.TrimSpace(p); len(p) > 0 {
+			ignore = append(ignore, p)
+		}
+	}
+	a, err := readMessages(stdin, files[:1])
+	if err != nil {
+		return err
+	}
+	b, err := readMessages(stdin, files[1:])
+	if err != nil {
+		return err
+	}
+	out := jsonLines(st.Stdout)
+	count := 0
+	for i := 0; i < len(a) && i < len(b); i++ {
+		ta, _, err := a[i].decode(str(st, "as"), hl7.JSONKeyPosition)
+		if err != nil {
+			return fmt.Errorf("%s message %d: %w", a[i].file, a[i].index, err)
+		}
+		tb, _, err := b[i].decode(str(st, "as"), hl7.JSONKeyPosition)
+		if err != nil {
+			return fmt.Errorf("%s message %d: %w", b[i].file, b[i].index, err)
+		}
+		list, err := hl7.Diff(ta, tb, &hl7.DiffOption{Ignore: ignore})
+		if err != nil {
+			return err
+		}
+		for _, d := range list {
+			count++
+			if err := out.Encode(difference{Index: i + 1, Path: d.Path, A: d.A, B: d.B}); err != nil {
+				return err
+			}
+		}
+	}
+	if len(a) != len(b) {
+		return fmt.Errorf("%s has %d messages, %s has %d", files[0], len(a), files[1], len(b))
+	}
+	if count > 0 {
+		return fmt.Errorf("%d differences in %d messages", count, len(a))
+	}
+	return nil
+}
+
+type queryRow struct {
+	source
+	Values map[string]string `json:"values"`
+}
+
+// queryAction runs the query of the first argument on the messages of the other arguments.
+func queryAction(st *task.State, stdin io.Reader) error {
+	args, _ := st.Get("args").([]string)
+	if len(args) == 0 {
+		return fmt.Errorf("query needs an expression, such as 'OBX[*]-5'")
+	}
+	q, err := hl7.ParseQuery(args[0])
+	if err != nil {
+		return err
+	}
+	format := strings.ToLower(str(st, "format"))
+	if format != "csv" && format != formatJSON {
+		return fmt.Errorf("unknown format %q, must be csv or json", format)
+	}
+	list, err := readMessages(stdin, args[1:])
+	if err != nil {
+		return err
+	}
+	var write func(m message, row []string) error
+	if format == formatJSON {
+		out := jsonLines(st.Stdout)
+		write = func(m message, row []string) error {
+			values := make(map[string]string, len(row))
+			for i, v := range row {
+				values[q.Columns[i]] = v
+			}
+			return out.Encode(queryRow{source: m.source(), Values: values})
+		}
+	} else {
+		out := csv.NewWriter(st.Stdout)
+		defer out.Flush()
+		if err := out.Write(append([]string{"file", "index"}, q.Columns...)); err != nil {
+			return err
+		}
+		write = func(m message, row []string) error {
+			return out.Write(append([]string{m.file, strconv.Itoa(m.index)}, row...))
+		}
+	}
+	for _, m := range list {
+		var values []hl7.Value
+		if flag(st, "raw") {
+			if m.format != formatER7 {
+				return fmt.Errorf("%s message %d: -raw needs ER7 messages, got %s", m.file, m.index, m.format)
+			}
+			values, err = hl7.ParseValues(m.data)
+		} else {
+			var trigger any
+			trigger, _, err = m.decode(str(st, "as"), hl7.JSONKeyPosition)
+			values = hl7.Values(trigger)
+		}
+		if err != nil {
+			return fmt.Errorf("%s message %d: %w", m.file, m.index, err)
+		}
+		for _, row := range q.Rows(values) {
+			if err := write(m, row); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type detected struct {
+	source
+	Format    string `json:"format"`
+	Version   string `json:"version"`
+	Package   string `json:"package,omitempty"` // Version of the package used to decode, such as "2.5.1" for "2.5.1.1".
+	Type      string `json:"type,omitempty"`
+	ControlID string `json:"control_id,omitempty"`
+}
+
+func detectAction(st *task.State, list []message) error {
+	out := jsonLines(st.Stdout)
+	for _, m := range list {
+		v, messageType, controlID := m.header()
+		d := detected{source: m.source(), Format: m.format, Version: v, Type: messageType, ControlID: controlID}
+		if ver, ok := lookupVersion(v); ok {
+			d.Package = ver.registry.Version()
+		}
+		if err := out.Encode(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kardianos/task"
+)
+
+const batch = "FHS|^~\\&|LAB\r" +
+	"BHS|^~\\&|LAB\r" +
+	"MSH|^~\\&|LAB|ORG|SYS||20250609071616||ORU^R01^ORU_R01|1|P|2.5.1\r" +
+	"PID|1||123||Smith^John||19700101|Q\r" +
+	"OBR|1|ABC||GLU\r" +
+	"OBX|1|NM|GLU||182||||||F\n" +
+	"MSH|^~\\&|LAB|ORG|SYS||20250609071616||ADT^A01|2|P|2.3\r\n" +
+	"EVN|A01|20250609071616\r\n" +
+	"PID|1||456||Doe^Jane\r\n" +
+	"PV1|1|I\r\n" +
+	"BTS|2\r" +
+	"FTS|1\r"
+
+func runCommand(t *testing.T, input string, args ...string) (string, error) {
+	t.Helper()
+	out := &bytes.Buffer{}
+	st := task.DefaultState()
+	st.Stdout = out
+	err := task.Run(context.Background(), st, command(strings.NewReader(input)).Exec(args))
+	return out.String(), err
+}
+
+func TestDetect(t *testing.T) {
+	got, err := runCommand(t, batch, "detect")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"file":"-","index":1,"format":"er7","version":"2.5.1","package":"2.5.1","type":"ORU^R01^ORU_R01","control_id":"1"}
+{"file":"-","index":2,"format":"er7","version":"2.3","package":"2.3.1","type":"ADT^A01","control_id":"2"}
+`
+	if got != want {
+		t.Fatalf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestDetectVersion(t *testing.T) {
+	input := "MSH|^~\\&|LAB|ORG|SYS||20250609071616||ADT^A01|1|P|2.6\r" +
+		"MSH|^~\\&|LAB|ORG|SYS||20250609071616||ADT^A01|2|P|2.9\r" +
+		"MSH|^~\\&|LAB|ORG|SYS||20250609071616||ADT^A01|3|P|2\r"
+	got, err := runCommand(t, input, "detect")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"file":"-","index":1,"format":"er7","version":"2.6","package":"2.5.1","type":"ADT^A01","control_id":"1"}
+{"file":"-","index":2,"format":"er7","version":"2.9","package":"2.8","type":"ADT^A01","control_id":"2"}
+{"file":"-","index":3,"format":"er7","version":"2","type":"ADT^A01","control_id":"3"}
+`
+	if got != want {
+		t.Fatalf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	got, err := runCommand(t, batch, "split")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(got), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d messages:\n%s", len(lines), got)
+	}
+	if !strings.Contains(lines[1], `"message":"MSH|^~\\&|LAB|ORG|SYS||20250609071616||ADT^A01|2|P|2.3\rEVN|`) {
+		t.Errorf("message 2 got %s", lines[1])
+	}
+}
+
+func TestConvert(t *testing.T) {
+	er7, err := runCommand(t, batch, "convert", "-to", "er7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, to := range []string{"json", "xml"} {
+		t.Run(to, func(t *testing.T) {
+			data, err := runCommand(t, batch, "convert", "-to", to)
+			if err != nil {
+				t.Fatal(err)
+			}
+			back, err := runCommand(t, data, "convert", "-to", "er7")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if back != er7 {
+				t.Fatalf("got:\n%q\nwant:\n%q", back, er7)
+			}
+		})
+	}
+}
+
+func TestValidateCommand(t *testing.T) {
+	got, err := runCommand(t, batch, "validate")
+	if err == nil || err.Error() != "1 problems in 2 messages" {
+		t.Fatalf("got error %v", err)
+	}
+	want := `{"file":"-","index":1,"path":"PID-8","rule":"table","detail":"value \"Q\" is not in table 0001"}` + "\n"
+	if got != want {
+		t.Fatalf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestParse(t *testing.T) {
+	got, err := runCommand(t, batch, "-as", "2.5.1", "parse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		"ORU_R01\n  MSH\n",
+		"\n  PatientResult[0]\n    Patient\n      PID\n        PID-1 Set ID - PID: 1\n        PID-3 Patient Identifier List: 123\n",
+		"\nADT_A01\n",
+	} {
+		if !strings.Contains(got, line) {
+			t.Errorf("missing %q in:\n%s", line, got)
+		}
+	}
+}
+
+func TestDiffCommand(t *testing.T) {
+	other := strings.NewReplacer("Smith^John", "Smith^Jon", "|1|P|2.5.1", "|9|P|2.5.1|||").Replace(batch)
+	fn := filepath.Join(t.TempDir(), "other.hl7")
+	if err := os.WriteFile(fn, []byte(other), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := runCommand(t, batch, "diff", fn, "-")
+	if err == nil || err.Error() != "1 differences in 2 messages" {
+		t.Fatalf("got error %v", err)
+	}
+	want := `{"index":1,"path":"PID-5[1].2","a":"Jon","b":"John"}` + "\n"
+	if got != want {
+		t.Fatalf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestQueryCommand(t *testing.T) {
+	for _, raw := range []bool{false, true} {
+		args := []string{"query", "PID-3.1, OBX[OBX-3.1=GLU]-5"}
+		if raw {
+			args = []string{"query", "-raw", "PID-3.1, OBX[OBX-3.1=GLU]-5"}
+		}
+		got, err := runCommand(t, batch, args...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := "file,index,PID-3.1,OBX[OBX-3.1=GLU]-5\n-,1,123,182\n-,2,456,\n"
+		if got != want {
+			t.Errorf("raw %t got:\n%s\nwant:\n%s", raw, got, want)
+		}
+	}
+	got, err := runCommand(t, batch, "query", "-format", "json", "PID-5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"file":"-","index":1,"values":{"PID-5":"Smith^John"}}
+{"file":"-","index":2,"values":{"PID-5":"Doe^Jane"}}
+`
+	if got != want {
+		t.Fatalf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
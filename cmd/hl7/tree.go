@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/kardianos/hl7"
+)
+
+// printTree prints the groups and segments of a trigger, indented by group,
+// with each field that has a value on a line of its own:
+//
+//	ORU_R01
+//	  MSH
+//	    MSH-3 Sending Application: LAB
+//	  PatientResult[0]
+//	    Patient
+//	      PID
+//	        PID-5 Patient Name: Smith^John
+func printTree(w io.Writer, trigger any) error {
+	var prev []string
+	e := hl7.NewEncoder(&hl7.EncodeOption{TrimTrailingSeparator: true})
+	for _, f := range hl7.FlattenSegments(trigger) {
+		path := strings.Split(f.Path, ".")
+		same := 0
+		for same < len(prev) && same < len(path) && prev[same] == path[same] {
+			same++
+		}
+		for i := same; i < len(path); i++ {
+			fmt.Fprintf(w, "%s%s\n", strings.Repeat("  ", i), path[i])
+		}
+		prev = path
+
+		data, err := e.Encode(f.Segment)
+		if err != nil {
+			return fmt.Errorf("%s: %w", f.Path, err)
+		}
+		rv := reflect.Indirect(reflect.ValueOf(f.Segment))
+		fields := strings.Split(string(data), string(data[3]))
+		name := fields[0]
+		if name == "MSH" {
+			// MSH-1 is the field separator itself.
+			fields = append([]string{name, string(data[3])}, fields[1:]...)
+		}
+		indent := strings.Repeat("  ", len(path))
+		for i := 1; i < len(fields); i++ {
+			if len(fields[i]) == 0 {
+				continue
+			}
+			fmt.Fprintf(w, "%s%s-%d", indent, name, i)
+			if display := fieldDisplay(rv.Type(), i); len(display) > 0 {
+				fmt.Fprintf(w, " %s", display)
+			}
+			fmt.Fprintf(w, ": %s\n", fields[i])
+		}
+	}
+	return nil
+}
+
+// fieldDisplay returns the display name of a segment field by position, such as "Patient Name" for PID-5.
+func fieldDisplay(rt reflect.Type, order int) string {
+	prefix := strconv.Itoa(order) + ","
+	for i := 0; i < rt.NumField(); i++ {
+		tag := rt.Field(i).Tag.Get("hl7")
+		if !strings.HasPrefix(tag, prefix) {
+			continue
+		}
+		if _, display, ok := strings.Cut(tag, "display="); ok {
+			return display
+		}
+		return ""
+	}
+	return ""
+}
@@ -0,0 +1,67 @@
+package hl7
+
+import (
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+	v280 "github.com/kardianos/hl7/h280"
+)
+
+func TestCoded(t *testing.T) {
+	glucose := v251.CWE{
+		Identifier:                  "GLU",
+		Text:                        "Glucose",
+		NameOfCodingSystem:          "L",
+		AlternateIdentifier:         "2345-7",
+		AlternateText:               "Glucose [Mass/volume] in Serum or Plasma",
+		NameOfAlternateCodingSystem: "LOINC",
+	}
+	if !glucose.Is("LN", "2345-7") {
+		t.Error("alternate LOINC code not matched by alias")
+	}
+	if glucose.Is("LN", "GLU") {
+		t.Error("local code matched as LOINC")
+	}
+	if c, ok := glucose.Code("2.16.840.1.113883.6.1"); !ok || c.Identifier != "2345-7" {
+		t.Errorf("code by OID got %v %t", c, ok)
+	}
+	ce := v251.CE{Identifier: "2345-7", NameOfCodingSystem: "LN"}
+	if !glucose.Equal(ce) || !ce.Equal(glucose) {
+		t.Error("CWE and CE with the same LOINC code are not equal")
+	}
+	if ce.Equal(v251.CE{Identifier: "2345-7", NameOfCodingSystem: "L"}) {
+		t.Error("codes in different systems are equal")
+	}
+
+	finding := v280.CWE{Identifier: "22298006", CodingSystemOid: "2.16.840.1.113883.6.96"}
+	if !finding.Is("SNM", "22298006") {
+		t.Error("SNOMED code by OID not matched by alias")
+	}
+	if g, w := finding.Display(), "22298006"; g != w {
+		t.Errorf("display got %q, want %q", g, w)
+	}
+
+	sex := v280.CWE{Identifier: "F", CodingSystemOid: "2.16.840.1.113883.12.1"}
+	if g, w := sex.Display(), "Female"; g != w {
+		t.Errorf("table display got %q, want %q", g, w)
+	}
+	if g, w := (v251.CE{Identifier: "M", NameOfCodingSystem: "HL70001"}).Display(), "Male"; g != w {
+		t.Errorf("table display got %q, want %q", g, w)
+	}
+	if g, w := (v251.CWE{OriginalText: "sugar"}).Display(), "sugar"; g != w {
+		t.Errorf("original text display got %q, want %q", g, w)
+	}
+
+	for system, want := range map[string]string{
+		"loinc":                    "LN",
+		"SCT":                      "SCT",
+		"SNM":                      "SCT",
+		"2.16.840.1.113883.12.396": "HL70396",
+		"hl70001":                  "HL70001",
+		"99ZZZ":                    "99ZZZ",
+	} {
+		if g := v251.CodingSystem(system); g != want {
+			t.Errorf("CodingSystem(%q) got %q, want %q", system, g, want)
+		}
+	}
+}
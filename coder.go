@@ -2,6 +2,7 @@ package hl7
 
 import (
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
 )
@@ -16,6 +17,12 @@ type Registry interface {
 	DataType(string) (any, bool)
 }
 
+// EventRegistry may be implemented by a Registry to map a message code and
+// trigger event, such as "ADT_A04", to a message structure, such as "ADT_A01".
+type EventRegistry interface {
+	EventStructure(string) (string, bool)
+}
+
 const tagName = "hl7"
 
 type structType byte
@@ -31,10 +38,14 @@ const (
 type tag struct {
 	Order      int32
 	Name       string
+	Display    string
 	Format     string
+	Table      string // Table of valid values, such as "0001".
+	Len        int32  // Maximum length, or zero.
 	Type       structType
 	Meta       bool
 	Omit       bool
+	Required   bool
 	NoEscape   bool
 	Sequence   bool
 	FieldSep   bool
@@ -94,17 +105,21 @@ func parseTag(fieldName, v string) (tag, error) {
 		case "seq":
 			t.Sequence = true
 		case "required":
-			// TODO.
+			t.Required = true
 		case "conditional":
 			// TODO.
 		case "len":
-			// TODO.
+			n, err := strconv.ParseInt(v, 10, 32)
+			if err != nil {
+				return t, fmt.Errorf("field %q: unable to parse tag length: %w", fieldName, err)
+			}
+			t.Len = int32(n)
 		case "max":
 			// TODO.
 		case "display":
-			// TODO.
+			t.Display = v
 		case "table":
-			// TODO.
+			t.Table = v
 		case "fieldsep":
 			t.FieldSep = true
 		case "fieldchars":
@@ -113,3 +128,21 @@ func parseTag(fieldName, v string) (tag, error) {
 	}
 	return t, nil
 }
+
+// typeMeta returns the tag of the HL7 meta field of a trigger, group, segment, or data type.
+func typeMeta(wt reflect.Type) (tag, error) {
+	switch wt.Kind() {
+	default:
+		return tag{}, nil
+	case reflect.Pointer:
+		return typeMeta(wt.Elem())
+	case reflect.Slice:
+		return typeMeta(wt.Elem())
+	case reflect.Struct:
+		sf, ok := wt.FieldByName(hl7MetaName)
+		if !ok {
+			return tag{}, nil
+		}
+		return parseTag(sf.Name, sf.Tag.Get(tagName))
+	}
+}
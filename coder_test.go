@@ -176,7 +176,7 @@ MSA|AA|1749478576661393532|HL7
 		t.Fatal(err)
 	}
 
-	_, err = group(v, v25.Registry)
+	_, err = group(v, v25.Registry, nil)
 	if err == nil {
 		t.Fatal("expected err, got nil")
 	}
@@ -210,7 +210,7 @@ MSA|AA|161||||
 	if err != nil {
 		t.Fatal(err)
 	}
-	root, err := group(v, v25.Registry)
+	root, err := group(v, v25.Registry, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -289,6 +289,176 @@ AIP|1|A|1^White^Charles^A^MD^^^^|D^White, Douglas||20110614084500|||45|m^Minutes
 
 }
 
+func TestEventStructure(t *testing.T) {
+	const raw = `MSH|^~\&|ADT|HOSP|SYS||20250609071616||%s|1|P|2.5.1
+EVN|A04|20250609071616
+PID|1||123||Smith^John
+PV1|1|O
+`
+	list := []struct {
+		Name    string
+		Type    string
+		Options *DecodeOption
+		Want    any
+	}{
+		{
+			Name: "table",
+			Type: "ADT^A04",
+			Want: v251.ADT_A01{},
+		},
+		{
+			Name: "structure",
+			Type: "ADT^A04^ADT_A01",
+			Want: v251.ADT_A01{},
+		},
+		{
+			Name: "override",
+			Type: "ADT^Z04",
+			Options: &DecodeOption{
+				EventStructure: map[string]string{"ADT_Z04": "ADT_A01"},
+			},
+			Want: v251.ADT_A01{},
+		},
+		{
+			Name: "override-table",
+			Type: "ADT^A04",
+			Options: &DecodeOption{
+				EventStructure: map[string]string{"ADT_A04": "ADT_A04"},
+			},
+			Want: v251.ADT_A04{},
+		},
+		{
+			Name: "override-structure",
+			Type: "ADT^A04^ADT_A01",
+			Options: &DecodeOption{
+				EventStructure: map[string]string{"ADT_A04": "ADT_A04"},
+			},
+			Want: v251.ADT_A04{},
+		},
+	}
+	for _, item := range list {
+		t.Run(item.Name, func(t *testing.T) {
+			d := NewDecoder(v251.Registry, item.Options)
+			g, err := d.Decode([]byte(fmt.Sprintf(raw, item.Type)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if g, w := fmt.Sprintf("%T", g), fmt.Sprintf("%T", item.Want); g != w {
+				t.Fatalf("got %s, want %s", g, w)
+			}
+		})
+	}
+}
+
+func TestGroupTrace(t *testing.T) {
+	var raw = []byte(`MSH|^~\&|LAB|ORG|SYS||20250609071616||ORU^R01|1749478576661393532|P|2.5||||||UTF-8
+PID|1||123||Smith^John
+OBR|1|ABC
+OBX|1|ST|GLU||Low
+MSA|AA|1749478576661393532|HL7
+`)
+	trace := &GroupTrace{}
+	d := NewDecoder(v25.Registry, &DecodeOption{Trace: trace})
+	_, err := d.Decode(raw)
+	var segmentError ErrUnexpectedSegment
+	if !errors.As(err, &segmentError) {
+		t.Fatalf("expected ErrUnexpectedSegment, got %v", err)
+	}
+	if g, w := len(trace.Steps), 5; g != w {
+		t.Fatalf("got %d steps, want %d", g, w)
+	}
+	obx := trace.Steps[3]
+	if g, w := obx.Chosen, "ORU_R01.PatientResult.OrderObservation.Observation.OBX"; g != w {
+		t.Fatalf("got OBX path %q, want %q", g, w)
+	}
+	msa := trace.Steps[4]
+	if g, w := msa.Reason, "no position in trigger"; g != w {
+		t.Fatalf("got MSA reason %q, want %q", g, w)
+	}
+	t.Log(trace)
+}
+
+func TestLenientGroup(t *testing.T) {
+	var raw = []byte(`MSH|^~\&|LAB|ORG|SYS||20250609071616||ORU^R01|1749478576661393532|P|2.5||||||UTF-8
+PID|1||123||Smith^John
+OBR|1|ABC
+OBX|1|ST|GLU||Low
+MSA|AA|1749478576661393532|HL7
+OBX|2|ST|NA||High
+`)
+	d := NewDecoder(v25.Registry, &DecodeOption{LenientGroup: true})
+	g, err := d.Decode(raw)
+	var unplaced ErrUnplaced
+	if !errors.As(err, &unplaced) {
+		t.Fatalf("expected ErrUnplaced, got %v", err)
+	}
+	const want = `trigger group: 1 segment(s) not placed in trigger "ORU_R01":
+	line 5 (*h250.MSA) near ORU_R01.PatientResult.OrderObservation.Observation`
+	if g, w := err.Error(), want; g != w {
+		t.Fatalf("got: %q, want: %q", g, w)
+	}
+	if _, ok := unplaced.Unplaced[0].Segment.(*v25.MSA); !ok {
+		t.Fatalf("expected *h250.MSA, got %T", unplaced.Unplaced[0].Segment)
+	}
+	m, ok := g.(v25.ORU_R01)
+	if !ok {
+		t.Fatalf("expected ORU_R01, got %T", g)
+	}
+	obs := m.PatientResult[0].OrderObservation[0].Observation
+	if g, w := len(obs), 2; g != w {
+		t.Fatalf("got %d observations, want %d", g, w)
+	}
+}
+
+func TestDecodeUnplacedResult(t *testing.T) {
+	var raw = []byte(`MSH|^~\&|LAB|ORG|SYS||20250609071616||ORU^R01|1|P|2.5
+PID|1||123||Smith^John
+MSA|AA|1
+`)
+	g, err := NewDecoder(v25.Registry, nil).Decode(raw)
+	var unexpected ErrUnexpectedSegment
+	if !errors.As(err, &unexpected) || g != nil {
+		t.Fatalf("strict: got %T and %v, want no trigger and ErrUnexpectedSegment", g, err)
+	}
+	g, err = NewDecoder(v25.Registry, &DecodeOption{LenientGroup: true}).Decode(raw)
+	var unplaced ErrUnplaced
+	if !errors.As(err, &unplaced) {
+		t.Fatalf("lenient: expected ErrUnplaced, got %v", err)
+	}
+	m, ok := g.(v25.ORU_R01)
+	if !ok {
+		t.Fatalf("lenient: expected ORU_R01 with the error, got %T", g)
+	}
+	if g, w := m.PatientResult[0].Patient.PID.PatientName[0].GivenName, "John"; g != w {
+		t.Fatalf("got given name %q, want %q", g, w)
+	}
+	if g, w := len(unplaced.Unplaced), 1; g != w {
+		t.Fatalf("got %d unplaced, want %d", g, w)
+	}
+}
+
+func TestDecodeUnplacedRepeat(t *testing.T) {
+	raw, err := os.ReadFile(filepath.Join("testdata", "error", "adt.hl7"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	g, err := NewDecoder(v251.Registry, &DecodeOption{LenientGroup: true}).Decode(raw)
+	var unplaced ErrUnplaced
+	if !errors.As(err, &unplaced) {
+		t.Fatalf("expected ErrUnplaced, got %v", err)
+	}
+	m, ok := g.(v251.ADT_A03)
+	if !ok || m.DRG == nil {
+		t.Fatalf("expected ADT_A03 with the first DRG, got %T", g)
+	}
+	if g, w := len(unplaced.Unplaced), 1; g != w {
+		t.Fatalf("got %d unplaced, want %d", g, w)
+	}
+	if u := unplaced.Unplaced[0]; u.LineNumber != 3 {
+		t.Fatalf("got unplaced line %d (%T), want line 3", u.LineNumber, u.Segment)
+	}
+}
+
 func TestVaries(t *testing.T) {
 	raw, err := os.ReadFile(filepath.Join("testdata", "roundtrip", "vaers_long.hl7"))
 	if err != nil {
@@ -385,7 +555,6 @@ func TestRoundTrip(t *testing.T) {
 	}
 	_ = c
 
-	d := NewDecoder(v251.Registry, nil)
 	e := NewEncoder(&EncodeOption{
 		TrimTrailingSeparator: false,
 	})
@@ -395,43 +564,47 @@ func TestRoundTrip(t *testing.T) {
 			continue
 		}
 		name := f.Name()
-		t.Run(name, func(t *testing.T) {
-			fn := filepath.Join(fsDir, name)
-			bb, err := os.ReadFile(fn)
-			if err != nil {
-				t.Fatal(err)
-			}
-			v, err := d.DecodeList(bb)
-			if err != nil {
-				t.Fatal("unmarshal", err)
-			}
-			if *dumpSegmentList {
-				c.Dump(v)
-			}
+		// The golden file is written by the default engine only, the other engines must match it.
+		for _, engine := range []GroupEngine{GroupGreedy, GroupParse} {
+			d := NewDecoder(v251.Registry, &DecodeOption{GroupEngine: engine})
+			t.Run(name+"-"+engine.String(), func(t *testing.T) {
+				fn := filepath.Join(fsDir, name)
+				bb, err := os.ReadFile(fn)
+				if err != nil {
+					t.Fatal(err)
+				}
+				v, err := d.DecodeList(bb)
+				if err != nil {
+					t.Fatal("unmarshal", err)
+				}
+				if *dumpSegmentList {
+					c.Dump(v)
+				}
 
-			root, err := d.DecodeGroup(v)
-			if err != nil {
-				t.Fatal("group", err)
-			}
-			if *dumpSegmentGroup {
-				c.Dump(root)
-			}
+				root, err := d.DecodeGroup(v)
+				if err != nil {
+					t.Fatal("group", err)
+				}
+				if *dumpSegmentGroup {
+					c.Dump(root)
+				}
 
-			rt, err := e.Encode(root)
-			if err != nil {
-				t.Fatal("marshal", err)
-			}
-			rt = bytes.ReplaceAll(rt, []byte{'\r'}, []byte{'\n'})
-			if *overwrite {
-				if err := os.WriteFile(fn, rt, 0600); err != nil {
-					t.Fatal("overwrite", err)
+				rt, err := e.Encode(root)
+				if err != nil {
+					t.Fatal("marshal", err)
 				}
-			}
-			d := lineDiff(bb, rt)
-			if len(d) > 0 {
-				t.Fatalf("mismatch\n%s", d)
-			}
-		})
+				rt = bytes.ReplaceAll(rt, []byte{'\r'}, []byte{'\n'})
+				if *overwrite && engine == GroupGreedy {
+					if err := os.WriteFile(fn, rt, 0600); err != nil {
+						t.Fatal("overwrite", err)
+					}
+				}
+				d := lineDiff(bb, rt)
+				if len(d) > 0 {
+					t.Fatalf("mismatch\n%s", d)
+				}
+			})
+		}
 	}
 }
 
@@ -35,6 +35,36 @@ type DecodeOption struct {
 	HeaderOnly       bool // Only decode first segment, usually the header.
 	IgnoreFieldSep   bool // Ignore field separator values in text fields.
 	IgnoreRepetition bool // Ignore repetitions in fields that are not repeatable.
+
+	// Map a message code and trigger event to a message structure, such as "ADT_A04" to "ADT_A01".
+	// The code and event of MSH-9.1 and MSH-9.2 are looked up first, so a site mapping
+	// overrides the message structure of MSH-9.3, then the registry event table is used.
+	EventStructure map[string]string
+
+	// Algorithm used to group segments into a trigger.
+	GroupEngine GroupEngine
+
+	// Collect segments that cannot be placed in the trigger into ErrUnplaced,
+	// rather than stopping with ErrUnexpectedSegment. Decode then returns the
+	// trigger of the placed segments together with the ErrUnplaced error:
+	// check for ErrUnplaced before discarding the trigger on error.
+	LenientGroup bool
+
+	// When set, each grouping decision is recorded into the trace.
+	// The trace is reset for each call to DecodeGroup.
+	Trace *GroupTrace
+
+	// When set, called with the identifier, check digit and scheme of each CX
+	// in a decoded field, such as PID-3. Errors are added to the SegmentError
+	// of the segment. Set to VerifyCheckDigit to verify check digits.
+	CheckDigit func(id, checkDigit, scheme string) error
+
+	// Check that each NM value declared as a string, with format=NM in the tag as in
+	// the generated packages, has the grammar of ParseNumber, such as "-1.20" but not
+	// "1.2E2", and that each SI value, with format=SI, is a non-negative integer.
+	// Errors are added to the SegmentError of the segment. Fields declared as
+	// Number are always checked.
+	ValidateNumber bool
 }
 
 // Create a new Decoder. A registry must be provided. Option is optional.
@@ -50,6 +80,13 @@ func NewDecoder(registry Registry, opt *DecodeOption) *Decoder {
 }
 
 // Decode takes an hl7 message and returns a final trigger with all segments grouped.
+// As with DecodeGroup, a value and error may be present at the same time:
+// with DecodeOption.LenientGroup, segments that cannot be placed are returned
+// in an ErrUnplaced error along with the trigger of the other segments.
+//
+//	g, err := d.Decode(data)
+//	var unplaced hl7.ErrUnplaced
+//	if errors.As(err, &unplaced) { /* Use g and unplaced.Unplaced. */ } else if err != nil { /* Fail. */ }
 func (d *Decoder) Decode(data []byte) (any, error) {
 	list, err := d.DecodeList(data)
 	if err != nil {
@@ -57,15 +94,15 @@ func (d *Decoder) Decode(data []byte) (any, error) {
 	}
 	g, err := d.DecodeGroup(list)
 	if err != nil {
-		return nil, fmt.Errorf("trigger group: %w", err)
+		return g, fmt.Errorf("trigger group: %w", err)
 	}
 	return g, nil
 }
 
 // Group a list of elements into trigger groupings.
-// A value and error may be present at the same time.
+// A value and error may be present at the same time, such as the trigger with an ErrUnplaced error.
 func (d *Decoder) DecodeGroup(list []any) (any, error) {
-	return group(list, d.registry)
+	return group(list, d.registry, &d.opt)
 }
 
 // Varies should be implemented on a segment that knows how to
@@ -74,9 +111,20 @@ type Varies interface {
 	ChildVaries(reg func(string) (any, bool)) (reflect.Value, error)
 }
 
-type variesFunc func() (reflect.Value, error)
+// FieldVaries may be implemented on a segment with more than one VARIES field,
+// or with a VARIES field that repeats with a data type for each repetition,
+// such as MFE-4 with the data types in MFE-5. It is used before Varies.
+type FieldVaries interface {
+	FieldVaries(field int32, rep int, reg func(string) (any, bool)) (reflect.Value, error)
+}
+
+// variesFunc returns the value to decode a repetition of a VARIES field into.
+type variesFunc func(field int32, rep int) (reflect.Value, error)
 
-var variesType = reflect.TypeFor[Varies]()
+var (
+	variesType      = reflect.TypeFor[Varies]()
+	fieldVariesType = reflect.TypeFor[FieldVaries]()
+)
 
 // SegmentError may be returned as part of the DecodeList result.
 // This allows a single segment to be decoded poorly with error, while
@@ -240,40 +288,67 @@ func (d *Decoder) DecodeList(data []byte) ([]any, error) {
 			ff[index] = f
 		}
 
-		var vfc variesFunc
-		if rvv.Type().Implements(variesType) {
-			vfc = func() (reflect.Value, error) {
-				return rvv.Interface().(Varies).ChildVaries(d.registry.DataType)
-			}
-		}
+		vfc := segmentVaries(rvv, d.registry)
 
 		var segmentErrorList []error
-		for i, f := range ff {
-			if i >= len(parts) {
-				break
-			}
-			p := parts[i]
-			if !f.tag.Present {
-				continue
-			}
-			if f.tag.Omit {
-				continue
-			}
-			err := ld.decodeSegmentList(p, f.tag, f.field, vfc)
-			if err != nil {
-				if v, ok := err.(*DecodeSegmentError); ok && v.Line == 0 && len(v.SegmentName) == 0 && len(v.FieldName) == 0 {
-					v.Line = lineNumber
-					v.SegmentName = SegmentName
-					v.FieldName = f.name
-				} else {
-					err = &DecodeSegmentError{
-						Line:        lineNumber,
-						SegmentName: SegmentName,
-						FieldName:   f.name,
-						Inner:       err,
+		// Decode varies fields last, after the field that selects their type.
+		for _, varies := range []bool{false, true} {
+			for i, f := range ff {
+				if i >= len(parts) {
+					break
+				}
+				p := parts[i]
+				if !f.tag.Present {
+					continue
+				}
+				if f.tag.Omit {
+					continue
+				}
+				if hasVaries(f.field.Type()) != varies {
+					continue
+				}
+				err := ld.decodeSegmentList(p, f.tag, f.field, vfc)
+				if err != nil {
+					if v, ok := err.(*DecodeSegmentError); ok && v.Line == 0 && len(v.SegmentName) == 0 && len(v.FieldName) == 0 {
+						v.Line = lineNumber
+						v.SegmentName = SegmentName
+						v.FieldName = f.name
+					} else {
+						err = &DecodeSegmentError{
+							Line:        lineNumber,
+							SegmentName: SegmentName,
+							FieldName:   f.name,
+							Inner:       err,
+						}
+					}
+					segmentErrorList = append(segmentErrorList, err)
+				}
+				if d.opt.ValidateNumber {
+					errs := numberErrors(f.tag, f.field)
+					if varies {
+						errs = variesNumberErrors(rvv, SegmentName, f.tag, f.field)
+					}
+					for _, err := range errs {
+						segmentErrorList = append(segmentErrorList, &DecodeSegmentError{
+							Line:        lineNumber,
+							SegmentName: SegmentName,
+							FieldName:   f.name,
+							Ordinal:     f.tag.Order,
+							Inner:       err,
+						})
+					}
+				}
+				if d.opt.CheckDigit != nil {
+					for _, err := range walkCX(f.field, d.opt.CheckDigit) {
+						segmentErrorList = append(segmentErrorList, &DecodeSegmentError{
+							Line:        lineNumber,
+							SegmentName: SegmentName,
+							FieldName:   f.name,
+							Ordinal:     f.tag.Order,
+							Inner:       err,
+						})
 					}
 				}
-				segmentErrorList = append(segmentErrorList, err)
 			}
 		}
 		if segmentErrorList != nil {
@@ -419,7 +494,7 @@ func (d *lineDecoder) decodeSegment(data []byte, t tag, rv reflect.Value, level
 		if vfc == nil {
 			return fmt.Errorf("unsupported interface field kind %#v data=%q", t, data)
 		}
-		nextRV, err := vfc()
+		nextRV, err := vfc(t.Order, 0)
 		if err != nil {
 			return err
 		}
@@ -441,7 +516,7 @@ func (d *lineDecoder) decodeSegment(data []byte, t tag, rv reflect.Value, level
 		}
 		itemValue := reflect.New(itemType)
 		ivv := itemValue.Elem()
-		err := d.decodeSegment(data, t, ivv, level, false, vfc)
+		err := d.decodeSegment(data, t, ivv, level, false, vfc.at(rv.Len()))
 		if err != nil {
 			return fmt.Errorf("slice: %w", err)
 		}
@@ -536,6 +611,13 @@ func (d *lineDecoder) decodeSegment(data []byte, t tag, rv reflect.Value, level
 			}
 			rv.Set(reflect.ValueOf(t))
 			return nil
+		case numberType:
+			n, err := ParseNumber(d.decodeByte(data, t))
+			if err != nil {
+				return err
+			}
+			rv.Set(reflect.ValueOf(n))
+			return nil
 		}
 	case reflect.String:
 		c1, c2, c3 := d.dividers[0], d.dividers[1], d.dividers[2]
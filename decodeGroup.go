@@ -4,21 +4,23 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 )
 
 type messageStructure interface {
 	MessageStructureID() []string
 }
 
-func newWalker(list []any, registry Registry) (*walker, error) {
+// triggerType returns the trigger code and type for the first segment in the list.
+func triggerType(list []any, registry Registry, opt *DecodeOption) (string, reflect.Type, error) {
 	if len(list) == 0 {
-		return nil, fmt.Errorf("list is empty")
+		return "", nil, fmt.Errorf("list is empty")
 	}
 
 	root := list[0]
 	ms, ok := root.(messageStructure)
 	if !ok {
-		return nil, ErrUnexpectedSegment{
+		return "", nil, ErrUnexpectedSegment{
 			Trigger:    fmt.Sprintf("first message must implment MessageStructure, %T does not", root),
 			LineNumber: 1,
 			Segment:    root,
@@ -26,14 +28,23 @@ func newWalker(list []any, registry Registry) (*walker, error) {
 	}
 	codeList := ms.MessageStructureID()
 	if len(codeList) == 0 {
-		return nil, fmt.Errorf("message structure code missing, malformed message: %#v", root)
+		return "", nil, fmt.Errorf("message structure code missing, malformed message: %#v", root)
+	}
+	// A site override of the message code and trigger event is used before MSH-9.3.
+	if event := messageEvent(root); len(event) > 0 && opt != nil {
+		if s, ok := opt.EventStructure[event]; ok {
+			if vex, ok := registry.Trigger(s); ok {
+				return s, reflect.TypeOf(vex), nil
+			}
+		}
 	}
 	var vex any
 	var code string
 	for _, c := range codeList {
 		if len(c) == 0 {
-			return nil, fmt.Errorf("message structure code empty, malformed message: %#v", root)
+			return "", nil, fmt.Errorf("message structure code empty, malformed message: %#v", root)
 		}
+		c = eventStructure(c, registry, opt)
 		vex, ok = registry.Trigger(c)
 		if ok {
 			code = c
@@ -41,9 +52,16 @@ func newWalker(list []any, registry Registry) (*walker, error) {
 		}
 	}
 	if vex == nil {
-		return nil, fmt.Errorf("message structure code not found %q", codeList)
+		return "", nil, fmt.Errorf("message structure code not found %q", codeList)
+	}
+	return code, reflect.TypeOf(vex), nil
+}
+
+func newWalker(list []any, registry Registry, opt *DecodeOption) (*walker, error) {
+	code, tp, err := triggerType(list, registry, opt)
+	if err != nil {
+		return nil, err
 	}
-	tp := reflect.TypeOf(vex)
 
 	// Map a linear structure onto a hierarchical structure.
 	//
@@ -62,17 +80,88 @@ func newWalker(list []any, registry Registry) (*walker, error) {
 		triggerCode: code,
 		registry:    registry,
 	}
-	err := w.eat(nil, 0, tp, false)
+	if opt != nil {
+		w.lenient = opt.LenientGroup
+	}
+	if opt != nil && opt.Trace != nil {
+		w.trace = opt.Trace
+		w.trace.reset(code)
+	}
+	err = w.eat(nil, 0, tp, false)
 	if err != nil {
 		return nil, err
 	}
 	return w, nil
 }
 
+// messageEvent returns the message code and trigger event of a header segment
+// from MSH-9.1 and MSH-9.2, such as "ADT_A04", or empty if either is missing.
+func messageEvent(header any) string {
+	rv := reflect.Indirect(reflect.ValueOf(header))
+	if rv.Kind() != reflect.Struct {
+		return ""
+	}
+	code, ok := pathValue(rv, false, 9, 1)
+	if !ok {
+		return ""
+	}
+	event, ok := pathValue(rv, false, 9, 2)
+	if !ok {
+		return ""
+	}
+	c, e := firstString(code), firstString(event)
+	if len(c) == 0 || len(e) == 0 {
+		return ""
+	}
+	return c + "_" + e
+}
+
+// eventStructure returns the message structure for a message code and trigger event.
+// Site overrides are checked first, then the registry event table, if present.
+// If neither is found, the code is returned as is.
+func eventStructure(code string, registry Registry, opt *DecodeOption) string {
+	if opt != nil {
+		if s, ok := opt.EventStructure[code]; ok {
+			return s
+		}
+	}
+	er, ok := registry.(EventRegistry)
+	if !ok {
+		return code
+	}
+	s, ok := er.EventStructure(code)
+	if !ok {
+		return code
+	}
+	if _, ok := registry.Trigger(s); !ok {
+		return code
+	}
+	return s
+}
+
 func (w *walker) process(list []any) (any, error) {
+	var unplaced []UnplacedSegment
 	for i, item := range list {
 		err := w.digest(i+1, item)
 		if err != nil {
+			var unexpected ErrUnexpectedSegment
+			if w.lenient && errors.As(err, &unexpected) {
+				unplaced = append(unplaced, UnplacedSegment{
+					LineNumber: unexpected.LineNumber,
+					Segment:    unexpected.Segment,
+					Path:       w.nearestGroup(),
+				})
+				continue
+			}
+			var full errFull
+			if w.lenient && errors.As(err, &full) {
+				unplaced = append(unplaced, UnplacedSegment{
+					LineNumber: i + 1,
+					Segment:    item,
+					Path:       w.nearestGroup(),
+				})
+				continue
+			}
 			return nil, err
 		}
 	}
@@ -86,11 +175,29 @@ func (w *walker) process(list []any) (any, error) {
 	}
 	rootI := rootSI.ActiveValue.Interface()
 
+	if len(unplaced) > 0 {
+		return rootI, ErrUnplaced{
+			Trigger:  w.triggerCode,
+			Unplaced: unplaced,
+		}
+	}
 	return rootI, nil
 }
 
+// nearestGroup returns the path of the group containing the last placed segment.
+func (w *walker) nearestGroup() string {
+	if w.last < 0 || w.last >= len(w.list) {
+		return w.triggerCode
+	}
+	si := w.list[w.last]
+	if si.Parent != nil {
+		si = si.Parent
+	}
+	return si.path()
+}
+
 // group a list of segments into hierarchical groups with a single root element.
-func group(list []any, registry Registry) (any, error) {
+func group(list []any, registry Registry, opt *DecodeOption) (any, error) {
 	var segErrs []error
 	for i, item := range list {
 		if se, ok := item.(SegmentError); ok {
@@ -98,11 +205,24 @@ func group(list []any, registry Registry) (any, error) {
 			list[i] = se.Segment
 		}
 	}
-	w, err := newWalker(list, registry)
-	if err != nil {
-		return nil, err
+	var gr any
+	var err error
+	switch {
+	default:
+		var w *walker
+		w, err = newWalker(list, registry, opt)
+		if err != nil {
+			return nil, err
+		}
+		gr, err = w.process(list)
+	case opt != nil && opt.GroupEngine == GroupParse:
+		var p *parser
+		p, err = newParser(list, registry, opt)
+		if err != nil {
+			return nil, err
+		}
+		gr, err = p.process(list)
 	}
-	gr, err := w.process(list)
 	if err != nil {
 		segErrs = append(segErrs, err)
 	}
@@ -205,6 +325,16 @@ func (si *structItem) presentInContext() bool {
 	return present(si.currentValue())
 }
 
+// path returns the field path from the trigger to this item, such as
+// "ORU_R01.PatientResult.OrderObservation.OBR".
+func (si *structItem) path() string {
+	if si.Parent == nil {
+		return si.Type.Name()
+	}
+	pt := si.Parent.Type
+	return si.Parent.path() + "." + pt.Field(si.Index).Name
+}
+
 func (si *structItem) set(rv reflect.Value) {
 	si.ActiveValue = rv
 }
@@ -212,6 +342,8 @@ func (si *structItem) set(rv reflect.Value) {
 type walker struct {
 	triggerCode string // For error reporting.
 	registry    Registry
+	trace       *GroupTrace
+	lenient     bool
 
 	last int
 	list []*structItem
@@ -227,6 +359,8 @@ func (w *walker) digest(line int, v any) error {
 	// Find all valid candidates (both forward and backward).
 	var candidates []*candidateMatch
 
+	step := w.traceStep(line, rt)
+
 	// First look forward.
 	for i := w.last; i < len(w.list); i++ {
 		si := w.list[i]
@@ -234,6 +368,7 @@ func (w *walker) digest(line int, v any) error {
 			continue
 		}
 		if w.fullInArray(si) {
+			step.reject(si, true)
 			continue
 		}
 		candidates = append(candidates, &candidateMatch{index: i, si: si, forward: true})
@@ -245,6 +380,7 @@ func (w *walker) digest(line int, v any) error {
 			continue
 		}
 		if w.fullInArray(si) {
+			step.reject(si, false)
 			continue
 		}
 		candidates = append(candidates, &candidateMatch{index: i, si: si, forward: false})
@@ -307,23 +443,32 @@ func (w *walker) digest(line int, v any) error {
 			shallowestBackward.si.InArray &&
 			currentDepth-shallowestBackward.si.Depth >= 2
 
+		var reason string
 		switch {
 		case backwardStartsNewGroup && (shallowestForward == nil || shallowestBackward.si.Depth < shallowestForward.si.Depth):
 			best = shallowestBackward
+			reason = "backward starts new group"
 		case shallowestForward != nil:
 			best = shallowestForward
+			reason = "shallowest forward"
 		default:
 			best = shallowestBackward
+			reason = "shallowest backward"
 		}
+		step.choose(candidates, best, reason)
 
-		return w.found(best.index, best.si, rv)
+		err := w.found(best.index, best.si, rv)
+		step.fail(err)
+		return err
 	}
 
 	// Control segments are handled separately.
 	if _, isControl := w.registry.ControlSegment(rt.Name()); isControl {
 		// TODO: handle batch and control segments.
+		step.skip("control segment")
 		return nil
 	}
+	step.skip("no position in trigger")
 	return ErrUnexpectedSegment{
 		Trigger:    w.triggerCode,
 		LineNumber: line,
@@ -352,8 +497,46 @@ func (err ErrUnexpectedSegment) Error() string {
 	return fmt.Sprintf("line %d (%T) not found in trigger %q", err.LineNumber, err.Segment, err.Trigger)
 }
 
+// UnplacedSegment is a segment that could not be placed in the trigger.
+type UnplacedSegment struct {
+	LineNumber int    // Line number of the message this segment is found on.
+	Segment    any    // Segment value, such as *h250.MSA.
+	Path       string // Path of the nearest group, such as "ORU_R01.PatientResult.OrderObservation".
+}
+
+// The error ErrUnplaced will be returned along with the grouped trigger
+// when DecodeOption.LenientGroup is set and one or more segments could not
+// be placed in the trigger. The grouped trigger is still valid.
+//
+//	var unplaced hl7.ErrUnplaced
+//	if errors.As(err, &unplaced) { /* Use unplaced.Unplaced. */ }
+type ErrUnplaced struct {
+	Trigger  string // Name of the trigger.
+	Unplaced []UnplacedSegment
+}
+
+func (err ErrUnplaced) Error() string {
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "%d segment(s) not placed in trigger %q:", len(err.Unplaced), err.Trigger)
+	for _, u := range err.Unplaced {
+		fmt.Fprintf(sb, "\n\tline %d (%T) near %s", u.LineNumber, u.Segment, u.Path)
+	}
+	return sb.String()
+}
+
+// errFull is returned by found when the segment only fits in a single segment
+// or group which is already present, such as a second DRG in ADT_A03.
+type errFull struct {
+	msg string
+}
+
+func (err errFull) Error() string {
+	return err.msg
+}
+
 // found creates the parent tree and sets it up.
 func (w *walker) found(index int, si *structItem, rv reflect.Value) error {
+	last := w.last
 	w.last = index
 
 	currentList := []*structItem{}
@@ -400,7 +583,8 @@ func (w *walker) found(index int, si *structItem, rv reflect.Value) error {
 			if c.present() {
 				parent := c.ActiveValue.Type().String()
 				child := rv.Type().String()
-				return fmt.Errorf("cannot overwrite %[2]s in %[1]s when %[2]s is already present", parent, child)
+				w.last = last
+				return errFull{fmt.Sprintf("cannot overwrite %[2]s in %[1]s when %[2]s is already present", parent, child)}
 			}
 			c.ActiveValue = reflect.New(c.Type).Elem()
 			continue
@@ -422,7 +606,8 @@ func (w *walker) found(index int, si *structItem, rv reflect.Value) error {
 				set = set.Elem()
 			}
 			if present(pv) {
-				return fmt.Errorf("expected empty value %s, value present", pv.Type())
+				w.last = last
+				return errFull{fmt.Sprintf("expected empty value %s, value present", pv.Type())}
 			}
 			pv.Set(set)
 			c.set(pv)
@@ -432,7 +617,8 @@ func (w *walker) found(index int, si *structItem, rv reflect.Value) error {
 				set = set.Addr()
 			}
 			if present(pv) {
-				return fmt.Errorf("expected empty pointer %s, pointer is present", pv.Type())
+				w.last = last
+				return errFull{fmt.Sprintf("expected empty pointer %s, pointer is present", pv.Type())}
 			}
 			pv.Set(set)
 			c.set(pv.Elem())
@@ -2,11 +2,16 @@ package hl7
 
 import (
 	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	v25 "github.com/kardianos/hl7/h250"
+	v251 "github.com/kardianos/hl7/h251"
 )
 
 // TestComplexTriggerRoundTrip tests encoding and decoding of complex triggers
@@ -459,7 +464,18 @@ func verifyRoundTrip(t *testing.T, original any, registry Registry) {
 	// Log the encoded message for debugging
 	t.Logf("Encoded message:\n%s", bytes.ReplaceAll(encoded, []byte{'\r'}, []byte{'\n'}))
 
-	dec := NewDecoder(registry, nil)
+	for _, engine := range []GroupEngine{GroupGreedy, GroupParse} {
+		t.Run(engine.String(), func(t *testing.T) {
+			verifyDecode(t, enc, original, registry, encoded, engine)
+		})
+	}
+}
+
+// verifyDecode decodes the encoded message with the given engine and verifies it matches.
+func verifyDecode(t *testing.T, enc *Encoder, original any, registry Registry, encoded []byte, engine GroupEngine) {
+	t.Helper()
+
+	dec := NewDecoder(registry, &DecodeOption{GroupEngine: engine})
 	list, err := dec.DecodeList(encoded)
 	if err != nil {
 		t.Fatalf("DecodeList failed: %v", err)
@@ -709,3 +725,81 @@ func testBackwardsSegmentMatch(t *testing.T) {
 
 	verifyRoundTrip(t, msg, v25.Registry)
 }
+
+// TestGroupParse tests triggers where the same segment appears at several levels.
+func TestGroupParse(t *testing.T) {
+	t.Run("OML_O21_RepeatOrder", testOML_O21_RepeatOrder)
+	t.Run("ORU_R01_PatientAfterOrder", testORU_R01_PatientAfterOrder)
+	t.Run("ADT_A03_RepeatDRG", testADT_A03_RepeatDRG)
+}
+
+func decodeParse(t *testing.T, raw string) (any, error) {
+	t.Helper()
+	d := NewDecoder(v251.Registry, &DecodeOption{GroupEngine: GroupParse})
+	return d.Decode([]byte(strings.ReplaceAll(raw, "\n", "\r")))
+}
+
+func testOML_O21_RepeatOrder(t *testing.T) {
+	// The second ORC starts a new order, it is not a prior result.
+	g, err := decodeParse(t, `MSH|^~\&|A|B|C|D|20200101||OML^O21^OML_O21|1|P|2.5.1
+PID|1
+ORC|NW|1
+OBR|1
+ORC|NW|2
+OBR|2
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := g.(v251.OML_O21)
+	if g, w := len(m.Order), 2; g != w {
+		t.Fatalf("got %d orders, want %d", g, w)
+	}
+	for i, o := range m.Order {
+		if o.ORC == nil || o.ObservationRequest == nil || o.ObservationRequest.OBR == nil {
+			t.Fatalf("order %d missing ORC or OBR", i)
+		}
+		if len(o.ObservationRequest.PriorResult) > 0 {
+			t.Fatalf("order %d has unexpected prior result", i)
+		}
+	}
+}
+
+func testORU_R01_PatientAfterOrder(t *testing.T) {
+	// A PID after the first order starts a new patient result, keeping segment order.
+	g, err := decodeParse(t, `MSH|^~\&|A|B|C|D|20200101||ORU^R01^ORU_R01|1|P|2.5.1
+OBR|1
+OBX|1|ST|A||x
+PID|1
+OBR|2
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := g.(v251.ORU_R01)
+	if g, w := len(m.PatientResult), 2; g != w {
+		t.Fatalf("got %d patient results, want %d", g, w)
+	}
+	if m.PatientResult[0].Patient != nil {
+		t.Fatal("expected first patient result without a patient")
+	}
+	if m.PatientResult[1].Patient == nil {
+		t.Fatal("expected second patient result with a patient")
+	}
+}
+
+func testADT_A03_RepeatDRG(t *testing.T) {
+	raw, err := os.ReadFile(filepath.Join("testdata", "error", "adt.hl7"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = decodeParse(t, string(raw))
+	var segmentError ErrUnexpectedSegment
+	if !errors.As(err, &segmentError) {
+		t.Fatalf("expected ErrUnexpectedSegment, got %v", err)
+	}
+	const want = `trigger group: line 3 (*h251.DRG) not found in trigger "ADT_A03"`
+	if g := err.Error(); g != want {
+		t.Fatalf("got: %q, want: %q", g, want)
+	}
+}
@@ -0,0 +1,269 @@
+// Package deid removes protected health information from decoded messages.
+//
+// Any trigger or segment from the generated version packages may be
+// de-identified. By default fields are handled by data type:
+//
+//	XPN, PN, XCN, CN   names and identifiers are replaced with pseudonyms
+//	XAD, AD            street, city, postal code and county are replaced with pseudonyms
+//	XTN                numbers and email addresses are replaced with pseudonyms
+//	CX, DLN            identifiers are replaced with pseudonyms
+//	SSN fields         replaced with pseudonyms
+//	NTE-3, OBX-5       free text (OBX-2 is TX or FT) is redacted
+//	dates and times    shifted by a per-patient number of days
+//
+// Pseudonyms are derived from a keyed hash, so the same value with the same
+// key is always replaced with the same pseudonym, across messages. Letters
+// and digits of identifiers are replaced in place, so the length and format
+// of the value is kept.
+//
+// The date shift of a patient is derived from the first identifier in PID-3.
+// Segments before the first PID are shifted with the first patient's offset.
+package deid
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kardianos/hl7"
+)
+
+// Action to take on a field.
+type Action int
+
+const (
+	Default   Action = iota // Action chosen by the data type and field.
+	Keep                    // Keep the field unchanged, including dates.
+	Shift                   // Shift dates and times, keep other values.
+	Pseudonym               // Replace values with pseudonyms and shift dates and times.
+	Redact                  // Replace text with the redaction text and shift dates and times.
+	Clear                   // Remove the field.
+)
+
+func (a Action) String() string {
+	switch a {
+	default:
+		return "Action(" + strconv.Itoa(int(a)) + ")"
+	case Default:
+		return "Default"
+	case Keep:
+		return "Keep"
+	case Shift:
+		return "Shift"
+	case Pseudonym:
+		return "Pseudonym"
+	case Redact:
+		return "Redact"
+	case Clear:
+		return "Clear"
+	}
+}
+
+// Policy configures de-identification.
+type Policy struct {
+	// Key of the keyed hash for pseudonyms and date shifts. Required.
+	Key []byte
+
+	// Fields overrides the action of a field, keyed by the segment and field
+	// number, such as "PID-7", or of every field in a segment, such as "ZPI".
+	Fields map[string]Action
+
+	// MaxShiftDays is the largest number of days a date is shifted, earlier or later.
+	// Defaults to 365.
+	MaxShiftDays int
+
+	// RedactText replaces redacted text. Defaults to "REDACTED".
+	RedactText string
+}
+
+// Deidentifier removes protected health information according to a policy.
+type Deidentifier struct {
+	policy Policy
+	hash   hasher
+}
+
+// New returns a Deidentifier for a policy.
+func New(policy Policy) (*Deidentifier, error) {
+	if len(policy.Key) == 0 {
+		return nil, fmt.Errorf("deid: missing key")
+	}
+	if policy.MaxShiftDays <= 0 {
+		policy.MaxShiftDays = 365
+	}
+	if len(policy.RedactText) == 0 {
+		policy.RedactText = "REDACTED"
+	}
+	return &Deidentifier{
+		policy: policy,
+		hash:   hasher{key: policy.Key},
+	}, nil
+}
+
+// Trigger de-identifies each segment of a trigger in place. The trigger must be a pointer, such as *h251.ORU_R01.
+func (d *Deidentifier) Trigger(trigger any) error {
+	if rv := reflect.ValueOf(trigger); rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("deid: trigger must be a non-nil pointer, got %T", trigger)
+	}
+	list := hl7.Flatten(trigger)
+
+	// Shift the segments before the first patient with that patient's offset.
+	var shift time.Duration
+	for _, seg := range list {
+		if name, _ := segmentName(seg); name == "PID" {
+			shift = d.shift(seg)
+			break
+		}
+	}
+	for _, seg := range list {
+		if name, _ := segmentName(seg); name == "PID" {
+			shift = d.shift(seg)
+		}
+		if err := d.segment(seg, shift); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Segment de-identifies a single segment in place, such as *h251.PID.
+// Dates are shifted by the offset of the patient, if the segment is a PID, or not at all.
+func (d *Deidentifier) Segment(segment any) error {
+	var shift time.Duration
+	if name, _ := segmentName(segment); name == "PID" {
+		shift = d.shift(segment)
+	}
+	return d.segment(segment, shift)
+}
+
+func (d *Deidentifier) segment(segment any, shift time.Duration) error {
+	rv := reflect.ValueOf(segment)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("deid: segment must be a non-nil struct pointer, got %T", segment)
+	}
+	name, ok := segmentName(segment)
+	if !ok {
+		return fmt.Errorf("deid: %T is not a segment", segment)
+	}
+	rv = rv.Elem()
+	rt := rv.Type()
+	w := &walker{d: d, shift: shift}
+	for i := 0; i < rt.NumField(); i++ {
+		ft := rt.Field(i)
+		order := fieldOrder(ft)
+		if order <= 0 || !ft.IsExported() {
+			continue
+		}
+		action := d.action(name, order, ft, rv)
+		w.field(rv.Field(i), action)
+	}
+	return nil
+}
+
+// action returns the action of a field from the policy, or from the data type and field.
+func (d *Deidentifier) action(segment string, order int, ft reflect.StructField, rv reflect.Value) Action {
+	if a := d.policy.Fields[segment+"-"+strconv.Itoa(order)]; a != Default {
+		return a
+	}
+	if a := d.policy.Fields[segment]; a != Default {
+		return a
+	}
+	switch {
+	case segment == "NTE" && order == 3:
+		return Redact
+	case segment == "OBX" && order == 5:
+		if vt, ok := fieldValue(rv, 2).(string); ok && (vt == "TX" || vt == "FT") {
+			return Redact
+		}
+	case strings.Contains(ft.Name, "SSN"):
+		return Pseudonym
+	}
+	if _, ok := components[typeName(ft.Type)]; ok {
+		return Pseudonym
+	}
+	return Shift
+}
+
+// shift returns the date offset of the patient in a PID.
+func (d *Deidentifier) shift(pid any) time.Duration {
+	id := firstString(reflect.ValueOf(fieldValue(reflect.ValueOf(pid).Elem(), 3)))
+	n := d.policy.MaxShiftDays
+	days := int(d.hash.sum("shift", id)%uint64(2*n+1)) - n
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// segmentName returns the name of a segment from its meta field, such as "PID".
+func segmentName(segment any) (string, bool) {
+	rt := reflect.TypeOf(segment)
+	if rt == nil {
+		return "", false
+	}
+	if rt.Kind() == reflect.Pointer {
+		rt = rt.Elem()
+	}
+	if rt.Kind() != reflect.Struct || rt.NumField() == 0 {
+		return "", false
+	}
+	tag := rt.Field(0).Tag.Get("hl7")
+	if !strings.Contains(tag, "type=s") {
+		return "", false
+	}
+	for _, part := range strings.Split(tag, ",") {
+		if name, ok := strings.CutPrefix(part, "name="); ok {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// fieldOrder returns the field or component number of a struct field, or zero for the meta field.
+func fieldOrder(ft reflect.StructField) int {
+	order, _, _ := strings.Cut(ft.Tag.Get("hl7"), ",")
+	n, _ := strconv.Atoi(order)
+	return n
+}
+
+// fieldValue returns the value of a field by number, or nil.
+func fieldValue(rv reflect.Value, order int) any {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		if fieldOrder(rt.Field(i)) == order {
+			return rv.Field(i).Interface()
+		}
+	}
+	return nil
+}
+
+// firstString returns the first non-empty string in a value, depth first.
+func firstString(rv reflect.Value) string {
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String()
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return ""
+		}
+		return firstString(rv.Elem())
+	case reflect.Slice:
+		for i := 0; i < rv.Len(); i++ {
+			if s := firstString(rv.Index(i)); len(s) > 0 {
+				return s
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < rv.NumField(); i++ {
+			if s := firstString(rv.Field(i)); len(s) > 0 {
+				return s
+			}
+		}
+	}
+	return ""
+}
+
+func typeName(rt reflect.Type) string {
+	for rt.Kind() == reflect.Pointer || rt.Kind() == reflect.Slice {
+		rt = rt.Elem()
+	}
+	return rt.Name()
+}
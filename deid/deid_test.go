@@ -0,0 +1,140 @@
+package deid
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/kardianos/hl7"
+	"github.com/kardianos/hl7/h251"
+)
+
+const message = "MSH|^~\\&|LAB|GHH|EHR|GHH|20250609081500||ORU^R01^ORU_R01|MSG00002|P|2.5.1\r" +
+	"PID|1||555-44-4444^4^M10^GHH^MR||EVERYWOMAN^EVE^E^^^^L||19620320|F|||153 FERNWOOD DR.^APT 2^STATESVILLE^OH^35292^USA^H||(206)3345232^PRN^PH~^NET^Internet^eve@example.org||||||123-45-6789\r" +
+	"PV1|1|O|CLINIC^^^GHH||||004777^ATTEND^AARON^A^^^MD\r" +
+	"OBR|1|845439^GHH OE|1045813^GHH LAB|24331-1^Lipid panel^LN|||20250609073000\r" +
+	"OBX|1|NM|2093-3^Cholesterol^LN||196|mg/dL^mg/dL^UCUM|<200|N|||F|||20250609073000\r" +
+	"NTE|1||Patient EVE called back\r" +
+	"OBX|2|TX|18748-4^Comment^LN||Eve was fasting~since 8 PM||||||F\r"
+
+func decode(t *testing.T) *h251.ORU_R01 {
+	t.Helper()
+	msg, err := hl7.NewDecoder(h251.Registry, nil).Decode([]byte(message))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := msg.(h251.ORU_R01)
+	return &v
+}
+
+func deidentify(t *testing.T, policy Policy) (*h251.ORU_R01, []byte) {
+	t.Helper()
+	msg := decode(t)
+	d, err := New(policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Trigger(msg); err != nil {
+		t.Fatal(err)
+	}
+	out, err := hl7.NewEncoder(&hl7.EncodeOption{TrimTrailingSeparator: true}).Encode(msg)
+	if err != nil {
+		t.Fatal("encode", err)
+	}
+	if _, err := hl7.NewDecoder(h251.Registry, nil).Decode(out); err != nil {
+		t.Fatal("decode", err)
+	}
+	return msg, out
+}
+
+func TestTrigger(t *testing.T) {
+	orig := decode(t)
+	msg, out := deidentify(t, Policy{Key: []byte("key")})
+	t.Logf("%s", bytes.ReplaceAll(out, []byte{'\r'}, []byte{'\n'}))
+
+	for _, phi := range []string{"EVERYWOMAN", "EVE", "FERNWOOD", "STATESVILLE", "APT 2", "3345232", "555-44-4444", "123-45-6789", "eve@", "ATTEND", "fasting"} {
+		if bytes.Contains(out, []byte(phi)) {
+			t.Errorf("output contains %q", phi)
+		}
+	}
+	for _, keep := range []string{"GHH^MR", "^OH^", "2093-3^Cholesterol^LN||196|mg/dL", "NTE|1||REDACTED", "||REDACTED~REDACTED|"} {
+		if !bytes.Contains(out, []byte(keep)) {
+			t.Errorf("output missing %q", keep)
+		}
+	}
+
+	pid := msg.PatientResult[0].Patient.PID
+	if g, w := pid.PatientIdentifierList[0].IDNumber, "555-44-4444"; len(g) != len(w) || g[3] != '-' {
+		t.Errorf("identifier %q does not keep the format of %q", g, w)
+	}
+	if pid.PatientIdentifierList[0].CheckDigit != "" {
+		t.Error("check digit not removed")
+	}
+
+	shift := pid.DateTimeOfBirth.Sub(orig.PatientResult[0].Patient.PID.DateTimeOfBirth)
+	if shift == 0 {
+		t.Fatal("birth date not shifted")
+	}
+	for name, pair := range map[string][2]any{
+		"MSH-7":  {msg.MSH.DateTimeOfMessage, orig.MSH.DateTimeOfMessage},
+		"OBR-7":  {msg.PatientResult[0].OrderObservation[0].OBR.ObservationDateTime, orig.PatientResult[0].OrderObservation[0].OBR.ObservationDateTime},
+		"OBX-14": {msg.PatientResult[0].OrderObservation[0].Observation[0].OBX.DateTimeOfTheObservation, orig.PatientResult[0].OrderObservation[0].Observation[0].OBX.DateTimeOfTheObservation},
+	} {
+		g, o := pair[0].(h251.TS), pair[1].(h251.TS)
+		if g.Sub(o) != shift {
+			t.Errorf("%s shifted by %v, want %v", name, g.Sub(o), shift)
+		}
+	}
+
+	_, again := deidentify(t, Policy{Key: []byte("key")})
+	if !bytes.Equal(out, again) {
+		t.Error("pseudonyms are not consistent for the same key")
+	}
+	_, other := deidentify(t, Policy{Key: []byte("other key")})
+	if bytes.Equal(out, other) {
+		t.Error("pseudonyms are the same for another key")
+	}
+}
+
+func TestPolicy(t *testing.T) {
+	msg, out := deidentify(t, Policy{
+		Key:        []byte("key"),
+		RedactText: "X",
+		Fields: map[string]Action{
+			"PID-5": Keep,
+			"PID-7": Clear,
+			"PID-8": Redact,
+			"NTE":   Keep,
+			"OBR-4": Pseudonym,
+		},
+	})
+	pid := msg.PatientResult[0].Patient.PID
+	if pid.PatientName[0].FamilyName != "EVERYWOMAN" {
+		t.Errorf("PID-5 not kept: %+v", pid.PatientName[0])
+	}
+	if !pid.DateTimeOfBirth.IsZero() {
+		t.Error("PID-7 not cleared")
+	}
+	if pid.AdministrativeSex != "X" {
+		t.Errorf("PID-8 not redacted: %q", pid.AdministrativeSex)
+	}
+	if !bytes.Contains(out, []byte("NTE|1||Patient EVE called back")) {
+		t.Error("NTE not kept")
+	}
+	if code := msg.PatientResult[0].OrderObservation[0].OBR.UniversalServiceIdentifier; code.Identifier == "24331-1" || !strings.Contains(code.Identifier, "-") {
+		t.Errorf("OBR-4 not replaced in place: %+v", code)
+	}
+}
+
+func TestNew(t *testing.T) {
+	if _, err := New(Policy{}); err == nil {
+		t.Fatal("expected error without a key")
+	}
+	d, err := New(Policy{Key: []byte("key")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Trigger(h251.ORU_R01{}); err == nil {
+		t.Fatal("expected error for a trigger value")
+	}
+}
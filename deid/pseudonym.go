@@ -0,0 +1,105 @@
+package deid
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	familyNames = []string{
+		"ADAMS", "BAKER", "CARTER", "DIAZ", "EVANS", "FISHER", "GARCIA", "HAYES",
+		"IRWIN", "JONES", "KELLY", "LOPEZ", "MILLER", "NGUYEN", "OWENS", "PARKER",
+		"QUINN", "REED", "SMITH", "TURNER", "UNDERWOOD", "VEGA", "WALKER", "YOUNG",
+	}
+	givenNames = []string{
+		"ALEX", "BLAKE", "CASEY", "DANA", "ELLIS", "FRANCIS", "GLENN", "HARPER",
+		"JAMIE", "JORDAN", "KENDALL", "LEE", "MORGAN", "NOEL", "PAT", "QUINN",
+		"RILEY", "SAGE", "TAYLOR", "VAL",
+	}
+	streetNames = []string{
+		"ASH", "BIRCH", "CEDAR", "ELM", "HICKORY", "LAUREL", "MAPLE", "OAK",
+		"PINE", "SPRUCE", "WALNUT", "WILLOW",
+	}
+	cityNames = []string{
+		"ASHFORD", "BROOKVILLE", "CLEARWATER", "FAIRVIEW", "GREENFIELD", "HILLSDALE",
+		"LAKEWOOD", "MILLTOWN", "RIVERSIDE", "SPRINGDALE",
+	}
+)
+
+// hasher derives pseudonyms from a keyed hash.
+type hasher struct {
+	key []byte
+}
+
+// digest returns the HMAC-SHA256 of a domain and value.
+func (h hasher) digest(domain, value string) []byte {
+	m := hmac.New(sha256.New, h.key)
+	m.Write([]byte(domain))
+	m.Write([]byte{0})
+	m.Write([]byte(value))
+	return m.Sum(nil)
+}
+
+func (h hasher) sum(domain, value string) uint64 {
+	return binary.BigEndian.Uint64(h.digest(domain, value))
+}
+
+// pick returns a list item for a value.
+func (h hasher) pick(domain, value string, list []string) string {
+	return list[h.sum(domain, value)%uint64(len(list))]
+}
+
+// shape replaces each letter with a letter and each digit with a digit, keeping case and other characters.
+func (h hasher) shape(domain, value string) string {
+	var stream []byte
+	for i := 0; len(stream) < len(value); i++ {
+		stream = append(stream, h.digest(domain, value+string(rune('0'+i)))...)
+	}
+	b := []rune(value)
+	for i, r := range b {
+		n := stream[i%len(stream)]
+		switch {
+		case r >= '0' && r <= '9':
+			b[i] = rune('0' + n%10)
+		case unicode.IsUpper(r):
+			b[i] = rune('A' + n%26)
+		case unicode.IsLower(r):
+			b[i] = rune('a' + n%26)
+		}
+	}
+	return string(b)
+}
+
+// replace returns the pseudonym of a value.
+// Names keep the letter case of the value.
+func (d *Deidentifier) replace(k kind, value string) string {
+	h := d.hash
+	name := func(s string) string {
+		if strings.ToUpper(value) == value {
+			return s
+		}
+		return s[:1] + strings.ToLower(s[1:])
+	}
+	switch k {
+	default:
+		return h.shape("id", value)
+	case kindClear:
+		return ""
+	case kindFamily:
+		return name(h.pick("family", strings.ToUpper(value), familyNames))
+	case kindGiven:
+		return name(h.pick("given", strings.ToUpper(value), givenNames))
+	case kindCity:
+		return name(h.pick("city", strings.ToUpper(value), cityNames))
+	case kindStreet:
+		number := h.sum("street-number", value)%9000 + 100
+		return name(strings.TrimSpace(strconv.FormatUint(number, 10) + " " + h.pick("street", strings.ToUpper(value), streetNames) + " ST"))
+	case kindEmail:
+		local, _, _ := strings.Cut(value, "@")
+		return h.shape("email", local) + "@example.com"
+	}
+}
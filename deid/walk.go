@@ -0,0 +1,166 @@
+package deid
+
+import (
+	"reflect"
+	"time"
+)
+
+// kind of pseudonym for a component.
+type kind int
+
+const (
+	kindNone   kind = iota // Keep the component, shift dates and times.
+	kindShape              // Replace letters and digits in place.
+	kindFamily             // Replace with a family name.
+	kindGiven              // Replace with a given name.
+	kindStreet             // Replace with a street address.
+	kindCity               // Replace with a city.
+	kindEmail              // Replace with an email address.
+	kindClear              // Remove the component.
+)
+
+// components lists the protected components of each data type by component number.
+// Component numbers are the same in each version that defines the data type.
+var components = map[string]map[int]kind{
+	"XPN": {1: kindFamily, 2: kindGiven, 3: kindGiven},
+	"PN":  {1: kindFamily, 2: kindGiven, 3: kindGiven},
+	"XCN": {1: kindShape, 2: kindFamily, 3: kindGiven, 4: kindGiven, 11: kindClear},
+	"CN":  {1: kindShape, 2: kindFamily, 3: kindGiven, 4: kindGiven},
+	"XAD": {1: kindStreet, 2: kindClear, 3: kindCity, 5: kindShape, 8: kindClear, 9: kindClear, 10: kindClear},
+	"AD":  {1: kindStreet, 2: kindClear, 3: kindCity, 5: kindShape, 8: kindClear},
+	"XTN": {1: kindShape, 4: kindEmail, 6: kindShape, 7: kindShape, 8: kindShape, 9: kindClear, 12: kindShape},
+	"CX":  {1: kindShape, 2: kindClear},
+	"DLN": {1: kindShape},
+	"SAD": {1: kindStreet, 2: kindStreet, 3: kindShape},
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+type walker struct {
+	d     *Deidentifier
+	shift time.Duration
+}
+
+func (w *walker) field(v reflect.Value, action Action) {
+	switch action {
+	case Keep:
+	case Clear:
+		v.Set(reflect.Zero(v.Type()))
+	case Redact:
+		w.redact(v)
+	case Pseudonym:
+		w.pseudonym(v, kindNone)
+	case Shift, Default:
+		w.shiftAll(v)
+	}
+}
+
+// each calls fn on each value within pointers, slices and interfaces.
+// Values in an interface are copied, passed to fn, and set back.
+func each(v reflect.Value, fn func(v reflect.Value)) {
+	switch v.Kind() {
+	default:
+		fn(v)
+	case reflect.Pointer:
+		if !v.IsNil() {
+			each(v.Elem(), fn)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			each(v.Index(i), fn)
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		c := reflect.New(v.Elem().Type()).Elem()
+		c.Set(v.Elem())
+		each(c, fn)
+		v.Set(c)
+	}
+}
+
+// shiftTime shifts a time value, reporting false if the value is not a time.
+func (w *walker) shiftTime(v reflect.Value) bool {
+	if v.Type() != timeType {
+		return false
+	}
+	if t := v.Interface().(time.Time); !t.IsZero() {
+		v.Set(reflect.ValueOf(t.Add(w.shift)))
+	}
+	return true
+}
+
+// redact replaces each string with the redaction text and shifts each time.
+func (w *walker) redact(v reflect.Value) {
+	each(v, func(v reflect.Value) {
+		switch {
+		case w.shiftTime(v):
+		case v.Kind() == reflect.String:
+			if len(v.String()) > 0 {
+				v.SetString(w.d.policy.RedactText)
+			}
+		case v.Kind() == reflect.Struct:
+			for i := 0; i < v.NumField(); i++ {
+				if v.Type().Field(i).IsExported() {
+					w.redact(v.Field(i))
+				}
+			}
+		}
+	})
+}
+
+// pseudonym replaces the value with a pseudonym of a kind.
+// The protected components of a listed data type are replaced and other
+// components are kept. The strings of other data types are replaced with the
+// kind, or in place without a kind. Dates and times are shifted.
+func (w *walker) pseudonym(v reflect.Value, k kind) {
+	each(v, func(v reflect.Value) {
+		switch {
+		case w.shiftTime(v):
+		case v.Kind() == reflect.String:
+			sk := k
+			if sk == kindNone {
+				sk = kindShape
+			}
+			if s := v.String(); len(s) > 0 {
+				v.SetString(w.d.replace(sk, s))
+			}
+		case v.Kind() == reflect.Struct:
+			comps, known := components[v.Type().Name()]
+			for i := 0; i < v.NumField(); i++ {
+				ft := v.Type().Field(i)
+				if !ft.IsExported() {
+					continue
+				}
+				f := v.Field(i)
+				if !known {
+					w.pseudonym(f, k)
+					continue
+				}
+				switch ck := comps[fieldOrder(ft)]; ck {
+				case kindNone:
+					w.shiftAll(f)
+				case kindClear:
+					f.Set(reflect.Zero(f.Type()))
+				default:
+					w.pseudonym(f, ck)
+				}
+			}
+		}
+	})
+}
+
+// shiftAll shifts each time and keeps all other values.
+func (w *walker) shiftAll(v reflect.Value) {
+	each(v, func(v reflect.Value) {
+		if w.shiftTime(v) || v.Kind() != reflect.Struct {
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				w.shiftAll(v.Field(i))
+			}
+		}
+	})
+}
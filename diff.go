@@ -0,0 +1,72 @@
+package hl7
+
+import "fmt"
+
+// DiffOption are the options of Diff.
+type DiffOption struct {
+	// Paths of values to ignore, with the values within them, such as "MSH-7", "MSH-10" or "OBX[*]-14".
+	// A path without a segment occurrence is the first segment, and "*" matches any index.
+	Ignore []string
+}
+
+// Difference is a value that differs between two messages.
+type Difference struct {
+	Path string // Path of the value, as in Values, such as "PID-5[1].2".
+	A    string // Value of the first message, or empty if absent.
+	B    string // Value of the second message, or empty if absent.
+}
+
+// Diff compares the values of two triggers, or two segments, by path, and returns the
+// values that differ, in the order of the first message and then of the second.
+// Empty and absent values are equal, so differences in trailing separators or
+// empty components are not reported. Options may be nil.
+func Diff(a, b any, opt *DiffOption) ([]Difference, error) {
+	var ignore []pathPattern
+	if opt != nil {
+		for _, s := range opt.Ignore {
+			p, err := parsePath(s)
+			if err != nil {
+				return nil, err
+			}
+			if p.hasFilter() {
+				return nil, fmt.Errorf("path %q: filters are not supported in ignored paths", s)
+			}
+			ignore = append(ignore, p)
+		}
+	}
+	ignored := func(path string) bool {
+		if len(ignore) == 0 {
+			return false
+		}
+		p, err := parsePath(path)
+		if err != nil {
+			return false
+		}
+		for _, ig := range ignore {
+			if ig.match(p) {
+				return true
+			}
+		}
+		return false
+	}
+
+	av, bv := Values(a), Values(b)
+	bmap := make(map[string]string, len(bv))
+	for _, v := range bv {
+		bmap[v.Path] = v.Value
+	}
+	seen := make(map[string]bool, len(av))
+	var list []Difference
+	for _, v := range av {
+		seen[v.Path] = true
+		if w := bmap[v.Path]; w != v.Value && !ignored(v.Path) {
+			list = append(list, Difference{Path: v.Path, A: v.Value, B: w})
+		}
+	}
+	for _, v := range bv {
+		if !seen[v.Path] && !ignored(v.Path) {
+			list = append(list, Difference{Path: v.Path, B: v.Value})
+		}
+	}
+	return list, nil
+}
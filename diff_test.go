@@ -2,10 +2,62 @@ package hl7
 
 import (
 	"bytes"
+	"testing"
 
+	v251 "github.com/kardianos/hl7/h251"
 	"github.com/mb0/diff"
 )
 
+func TestDiff(t *testing.T) {
+	a := "MSH|^~\\&|LAB|ORG|SYS||20250609071616||ORU^R01^ORU_R01|1|P|2.5.1\r" +
+		"PID|1||123||Smith^John~Smith^Johnny||19700101|M\r" +
+		"OBR|1|ABC||GLU\r" +
+		"OBX|1|NM|GLU||182|mg/dL|||||F|||20250609\r" +
+		"OBX|2|NM|NA||140|mmol/L|||||F|||20250609\r"
+	b := "MSH|^~\\&|LAB|ORG|SYS||20250610080000||ORU^R01^ORU_R01|2|P|2.5.1|||\r" +
+		"PID|1||123||Smith^Jon~Smith^Johnny^^^^||19700101|M|||\r" +
+		"OBR|1|ABC||GLU^^\r" +
+		"OBX|1|NM|GLU||182|mg/dL|||||F|||20250610\r" +
+		"OBX|2|NM|NA||141||||||F|||20250610\r"
+	d := NewDecoder(v251.Registry, nil)
+	ga, err := d.Decode([]byte(a))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gb, err := d.Decode([]byte(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	list, err := Diff(ga, gb, &DiffOption{Ignore: []string{"MSH-7", "MSH-10", "OBX[*]-14"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Difference{
+		{Path: "PID-5[1].2", A: "John", B: "Jon"},
+		{Path: "OBX[2]-5[1]", A: "140", B: "141"},
+		{Path: "OBX[2]-6.1", A: "mmol/L"},
+	}
+	if len(list) != len(want) {
+		t.Fatalf("got %d differences, want %d: %+v", len(list), len(want), list)
+	}
+	for i, w := range want {
+		if list[i] != w {
+			t.Errorf("%d: got %+v, want %+v", i, list[i], w)
+		}
+	}
+
+	list, err = Diff(ga, ga, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 0 {
+		t.Errorf("same message got %+v", list)
+	}
+	if _, err := Diff(ga, gb, &DiffOption{Ignore: []string{"MSH-x"}}); err == nil {
+		t.Error("expected an error for an invalid path")
+	}
+}
+
 func splitLine(b []byte) [][]byte {
 	ff := bytes.FieldsFunc(b, func(r rune) bool {
 		return r == '\n' || r == '\r'
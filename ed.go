@@ -0,0 +1,312 @@
+package hl7
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Payload is the content of an ED, or the location of content referenced by a RP.
+type Payload struct {
+	MIME        string // MIME type from the type of data and subtype, such as "application/pdf".
+	TypeOfData  string // Type of data from table 0191, such as "AP".
+	Subtype     string // Subtype from table 0291, such as "PDF".
+	Encoding    string // Encoding from table 0299: "A", "Hex" or "Base64". Empty for a RP.
+	Application string // Namespace ID of the source application of an ED or the application of a RP.
+	Pointer     string // Pointer of a RP, such as a URL. Empty for an ED.
+
+	data []string // Encoded data of each ED, in order.
+}
+
+// PayloadOf returns the payload of an ED or RP value from any version, such as an OBX-5 value.
+func PayloadOf(v any) (*Payload, error) {
+	rv := reflect.ValueOf(v)
+	p := &Payload{}
+	switch name := dataTypeName(reflect.TypeOf(v)); name {
+	default:
+		return nil, fmt.Errorf("%T is not an ED or RP", v)
+	case "ED":
+		p.Application = componentString(rv, 1)
+		p.TypeOfData = componentString(rv, 2)
+		p.Subtype = componentString(rv, 3)
+		p.Encoding = componentString(rv, 4)
+		p.data = append(p.data, componentString(rv, 5))
+	case "RP":
+		p.Pointer = componentString(rv, 1)
+		p.Application = componentString(rv, 2)
+		p.TypeOfData = componentString(rv, 3)
+		p.Subtype = componentString(rv, 4)
+	}
+	p.MIME = edMIME(p.TypeOfData, p.Subtype)
+	return p, nil
+}
+
+// ObservationPayload returns the payload in OBX-5 of one or more OBX segments, such as *h251.OBX.
+// Data split across the repetitions of OBX-5, or across the OBX segments, is joined in order.
+// The type, subtype and encoding are from the first ED. Values that are not ED or RP are skipped.
+func ObservationPayload(obx ...any) (*Payload, error) {
+	var p *Payload
+	for _, seg := range obx {
+		rv := reflect.ValueOf(seg)
+		f, ok := component(rv, 5)
+		if !ok || f.Kind() != reflect.Slice {
+			return nil, fmt.Errorf("%T is not an OBX", seg)
+		}
+		for i := 0; i < f.Len(); i++ {
+			item := f.Index(i)
+			if item.Kind() == reflect.Interface {
+				if item.IsNil() {
+					continue
+				}
+				item = item.Elem()
+			}
+			next, err := PayloadOf(item.Interface())
+			if err != nil {
+				continue
+			}
+			switch {
+			case p == nil:
+				p = next
+			case len(next.Pointer) == 0:
+				p.data = append(p.data, next.data...)
+			}
+		}
+	}
+	if p == nil {
+		return nil, fmt.Errorf("no ED or RP in OBX-5")
+	}
+	return p, nil
+}
+
+// Reader returns a reader of the decoded data. Data is decoded as it is read.
+// Escape sequences left in the data, such as "\X0D0A\" and "\.br\", are replaced,
+// and line breaks within Base64 and Hex data are skipped.
+func (p *Payload) Reader() (io.Reader, error) {
+	if len(p.Pointer) > 0 {
+		return nil, fmt.Errorf("RP payload has no data, fetch %q", p.Pointer)
+	}
+	parts := make([]io.Reader, len(p.data))
+	for i, d := range p.data {
+		parts[i] = strings.NewReader(edUnescape(d))
+	}
+	r := io.MultiReader(parts...)
+	switch strings.ToUpper(p.Encoding) {
+	default:
+		return nil, fmt.Errorf("unknown ED encoding %q", p.Encoding)
+	case "A":
+		return r, nil
+	case "HEX":
+		return hex.NewDecoder(&spaceSkipper{r: r}), nil
+	case "BASE64":
+		return base64.NewDecoder(base64.StdEncoding, &spaceSkipper{r: r}), nil
+	}
+}
+
+// Bytes returns the decoded data.
+func (p *Payload) Bytes() ([]byte, error) {
+	r, err := p.Reader()
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+// NewED returns an ED of any version, such as h251.ED, with the data and the type and subtype of the MIME type.
+// Printable ASCII data is sent with the "A" encoding, as the Encoder escapes the separators.
+// Other data, including line breaks and text that reads as an escape sequence, such as `\X41\`,
+// is sent with the "Base64" encoding.
+func NewED[T any](data []byte, mimeType string) (T, error) {
+	var v T
+	rv := reflect.ValueOf(&v).Elem()
+	if dataTypeName(rv.Type()) != "ED" {
+		return v, fmt.Errorf("%T is not an ED", v)
+	}
+	typeOfData, subtype := edType(mimeType)
+	encoding, text := "A", string(data)
+	for _, b := range data {
+		if b < ' ' || b > '~' {
+			encoding, text = "Base64", base64.StdEncoding.EncodeToString(data)
+			break
+		}
+	}
+	if encoding == "A" && edUnescape(text) != text {
+		encoding, text = "Base64", base64.StdEncoding.EncodeToString(data)
+	}
+	setComponent(rv, 2, typeOfData)
+	setComponent(rv, 3, subtype)
+	setComponent(rv, 4, encoding)
+	setComponent(rv, 5, text)
+	return v, nil
+}
+
+// MIME types of the subtypes of table 0291 and common subtypes.
+var edSubtypeMIME = map[string]string{
+	"PDF":                 "application/pdf",
+	"RTF":                 "application/rtf",
+	"JPEG":                "image/jpeg",
+	"JPG":                 "image/jpeg",
+	"PNG":                 "image/png",
+	"GIF":                 "image/gif",
+	"TIFF":                "image/tiff",
+	"BMP":                 "image/bmp",
+	"HTML":                "text/html",
+	"XML":                 "application/xml",
+	"PLAIN":               "text/plain",
+	"DICOM":               "application/dicom",
+	"OCTET-STREAM":        "application/octet-stream",
+	"X-HL7-CDA-LEVEL-ONE": "application/x-hl7-cda-level-one+xml",
+}
+
+// MIME major types of the types of data of table 0191.
+var edTypeMIME = map[string]string{
+	"AP":    "application",
+	"AU":    "audio",
+	"IM":    "image",
+	"NS":    "image",
+	"SI":    "image",
+	"SD":    "application",
+	"TEXT":  "text",
+	"FT":    "text",
+	"TX":    "text",
+	"VIDEO": "video",
+}
+
+// edMIME returns the MIME type of a type of data and subtype.
+func edMIME(typeOfData, subtype string) string {
+	if strings.Contains(subtype, "/") {
+		return strings.ToLower(subtype)
+	}
+	if m, ok := edSubtypeMIME[strings.ToUpper(subtype)]; ok {
+		return m
+	}
+	major, ok := edTypeMIME[strings.ToUpper(typeOfData)]
+	switch {
+	case !ok:
+		return "application/octet-stream"
+	case len(subtype) == 0 && major == "text":
+		return "text/plain"
+	case len(subtype) == 0:
+		return "application/octet-stream"
+	}
+	return major + "/" + strings.ToLower(subtype)
+}
+
+// edType returns the type of data and subtype of a MIME type.
+func edType(mimeType string) (typeOfData, subtype string) {
+	major, minor, _ := strings.Cut(strings.ToLower(mimeType), "/")
+	minor, _, _ = strings.Cut(minor, ";")
+	minor = strings.TrimSpace(minor)
+	switch major {
+	default:
+		typeOfData = "AP"
+	case "image":
+		typeOfData = "IM"
+	case "audio":
+		typeOfData = "AU"
+	case "text":
+		typeOfData = "TEXT"
+	}
+	for s, m := range edSubtypeMIME {
+		if m == major+"/"+minor && s != "JPG" {
+			return typeOfData, s
+		}
+	}
+	return typeOfData, strings.ToUpper(minor)
+}
+
+// edUnescape replaces the escape sequences the decoder leaves in data:
+// hexadecimal data, "\Xhh..\", and line breaks, "\.br\". Highlighting and other formatting
+// sequences, such as "\H\" and "\.sp2\", are removed. Any other backslash is kept, as the decoder
+// has already replaced "\E\" with a backslash, so text such as "C:\temp\file.txt" is unchanged.
+func edUnescape(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	buf := &bytes.Buffer{}
+	for {
+		start := strings.IndexByte(s, '\\')
+		if start < 0 {
+			buf.WriteString(s)
+			break
+		}
+		buf.WriteString(s[:start])
+		s = s[start:]
+		end := strings.IndexByte(s[1:], '\\')
+		if end < 0 {
+			buf.WriteString(s)
+			break
+		}
+		seq := s[1 : 1+end]
+		switch {
+		default:
+			buf.WriteByte('\\')
+			s = s[1:]
+			continue
+		case seq == ".br":
+			buf.WriteString("\r\n")
+		case edFormat(seq):
+		case len(seq) > 1 && seq[0] == 'X' && len(seq)%2 == 1 && edHex(seq[1:]):
+			b, _ := hex.DecodeString(seq[1:])
+			buf.Write(b)
+		}
+		s = s[end+2:]
+	}
+	return buf.String()
+}
+
+// edFormat reports if seq is a highlighting or formatted text sequence, without the escape characters.
+func edFormat(seq string) bool {
+	switch seq {
+	case "H", "N", ".fi", ".nf", ".ce":
+		return true
+	}
+	if len(seq) < 3 || seq[0] != '.' {
+		return false
+	}
+	switch seq[1:3] {
+	case "sp", "in", "ti", "sk":
+		_, err := strconv.Atoi(strings.TrimPrefix(seq[3:], "+"))
+		return len(seq) == 3 || err == nil
+	}
+	return false
+}
+
+// edHex reports if s is only hexadecimal digits.
+func edHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c >= '0' && c <= '9', c >= 'a' && c <= 'f', c >= 'A' && c <= 'F':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// spaceSkipper skips ASCII white space, such as line breaks in Base64 data.
+type spaceSkipper struct {
+	r io.Reader
+}
+
+func (s *spaceSkipper) Read(p []byte) (int, error) {
+	for {
+		n, err := s.r.Read(p)
+		w := 0
+		for _, b := range p[:n] {
+			switch b {
+			case ' ', '\t', '\r', '\n':
+			default:
+				p[w] = b
+				w++
+			}
+		}
+		if w > 0 || err != nil {
+			return w, err
+		}
+	}
+}
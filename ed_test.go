@@ -0,0 +1,124 @@
+package hl7
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestObservationPayload(t *testing.T) {
+	pdf := []byte("%PDF-1.4 binary \x00\x01\x02 content %%EOF")
+	enc := base64.StdEncoding.EncodeToString(pdf)
+	a, b, c := enc[:8], enc[8:20], enc[20:]
+	raw := "MSH|^~\\&|A|B|C|D|20070305170957||ORU^R01^ORU_R01|1|P|2.5.1\r" +
+		"OBX|1|ED|PDF^Report||^AP^PDF^Base64^" + a + "\\X0D0A\\~^AP^PDF^Base64^" + b + "\r" +
+		"OBX|2|ED|PDF^Report||^AP^PDF^Base64^" + c + "\r"
+	d := NewDecoder(v251.Registry, nil)
+	list, err := d.DecodeList([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := ObservationPayload(list[1], list[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.MIME != "application/pdf" {
+		t.Errorf("MIME got %q", p.MIME)
+	}
+	got, err := p.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, pdf) {
+		t.Errorf("got %q, want %q", got, pdf)
+	}
+	if _, err := ObservationPayload(&v251.OBX{}); err == nil {
+		t.Error("payload from an empty OBX")
+	}
+}
+
+func TestNewED(t *testing.T) {
+	text := []byte("a|b^c~d&e\\f")
+	ed, err := NewED[v251.ED](text, "text/plain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ed.TypeOfData != "TEXT" || ed.DataSubtype != "PLAIN" || ed.Encoding != "A" {
+		t.Errorf("got %+v", ed)
+	}
+	obx := &v251.OBX{ValueType: "ED", ObservationValue: []v251.VARIES{ed}}
+	e := NewEncoder(&EncodeOption{TrimTrailingSeparator: true})
+	got, err := e.Encode(obx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const want = `OBX|1|ED|||^TEXT^PLAIN^A^a\F\b\S\c\R\d\T\e\E\f`
+	if g := strings.TrimSpace(string(got)); g != want {
+		t.Errorf("got  %s\nwant %s", g, want)
+	}
+	d := NewDecoder(v251.Registry, nil)
+	list, err := d.DecodeList(append([]byte("MSH|^~\\&|A|B|C|D|20070305170957||ORU^R01^ORU_R01|1|P|2.5.1\r"), got...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := ObservationPayload(list[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := p.Bytes(); !bytes.Equal(data, text) {
+		t.Errorf("round trip got %q, want %q", data, text)
+	}
+
+	for _, item := range []struct {
+		text     string
+		encoding string
+	}{
+		{`C:\temp\file.txt`, "A"},
+		{`\X41\ and \.br\`, "Base64"},
+	} {
+		ed, err := NewED[v251.ED]([]byte(item.text), "text/plain")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ed.Encoding != item.encoding {
+			t.Errorf("%s: got encoding %s, want %s", item.text, ed.Encoding, item.encoding)
+		}
+		got, err := e.Encode(&v251.OBX{ValueType: "ED", ObservationValue: []v251.VARIES{ed}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		list, err := d.DecodeList(append([]byte("MSH|^~\\&|A|B|C|D|20070305170957||ORU^R01^ORU_R01|1|P|2.5.1\r"), got...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := ObservationPayload(list[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if data, _ := p.Bytes(); string(data) != item.text {
+			t.Errorf("round trip got %q, want %q", data, item.text)
+		}
+	}
+
+	image := []byte{0x89, 'P', 'N', 'G', '\r', '\n'}
+	ed, err = NewED[v251.ED](image, "image/png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ed.TypeOfData != "IM" || ed.DataSubtype != "PNG" || ed.Encoding != "Base64" || ed.Data != base64.StdEncoding.EncodeToString(image) {
+		t.Errorf("got %+v", ed)
+	}
+	p, err = PayloadOf(ed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := p.Bytes(); p.MIME != "image/png" || !bytes.Equal(data, image) {
+		t.Errorf("payload got %s %q", p.MIME, data)
+	}
+	if _, err := NewED[v251.CE](image, "image/png"); err == nil {
+		t.Error("built a CE as an ED")
+	}
+}
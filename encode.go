@@ -25,6 +25,17 @@ func NewEncoder(opt *EncodeOption) *Encoder {
 // Encoding options.
 type EncodeOption struct {
 	TrimTrailingSeparator bool
+
+	// When set, such as "M10", each CX with a numeric identifier and no check digit
+	// has the check digit computed and, if empty, the check digit scheme set to this scheme.
+	// A scheme already in the CX is used to compute the check digit.
+	CheckDigitScheme string
+
+	// Registry of the data types of VARIES values, such as h251.Registry. When set, values are
+	// checked against the data types of the registry, and a time value without a TS data type is a DTM.
+	// An empty data type field of a VARIES field, such as OBX-2 of OBX-5, is set from the data type
+	// of the values with or without a registry.
+	Registry Registry
 }
 
 type Encoder struct {
@@ -104,7 +115,7 @@ func (e *Encoder) walk(seq int, wv reflect.Value) error {
 	if !wv.IsValid() {
 		return nil
 	}
-	metaTag, err := e.meta(wv.Type())
+	metaTag, err := typeMeta(wv.Type())
 	if err != nil {
 		return err
 	}
@@ -162,22 +173,6 @@ func (e *Encoder) walk(seq int, wv reflect.Value) error {
 		}
 	}
 }
-func (e *Encoder) meta(wt reflect.Type) (tag, error) {
-	switch wt.Kind() {
-	default:
-		return tag{}, nil
-	case reflect.Pointer:
-		return e.meta(wt.Elem())
-	case reflect.Slice:
-		return e.meta(wt.Elem())
-	case reflect.Struct:
-		sf, ok := wt.FieldByName(hl7MetaName)
-		if !ok {
-			return tag{}, nil
-		}
-		return parseTag(sf.Name, sf.Tag.Get(tagName))
-	}
-}
 
 // encodeSegment the given message into buffer.
 func (e *Encoder) encodeSegment(seq int, st reflect.Value) error {
@@ -190,6 +185,7 @@ func (e *Encoder) encodeSegment(seq int, st reflect.Value) error {
 	type field struct {
 		name    string
 		present bool
+		varies  bool
 		tag     tag
 		value   any
 	}
@@ -232,6 +228,7 @@ func (e *Encoder) encodeSegment(seq int, st reflect.Value) error {
 		fieldList = append(fieldList, field{
 			name:    fld.Name,
 			present: !f.IsZero(),
+			varies:  hasVaries(fld.Type),
 			tag:     tag,
 			value:   f.Interface(),
 		})
@@ -248,6 +245,17 @@ func (e *Encoder) encodeSegment(seq int, st reflect.Value) error {
 		}
 		ff[index] = f
 	}
+	for vo, so := range variesSelectors[SegmentName] {
+		// Some versions declare the field with a fixed data type, such as MFA-5 of 2.3.1.
+		if vo > SegmentSize || so > SegmentSize || !ff[vo-1].varies || !ff[so-1].tag.Present {
+			continue
+		}
+		sel, err := e.variesSelector(ff[so-1].value, ff[vo-1].value)
+		if err != nil {
+			return fmt.Errorf("%s-%d: %w", SegmentName, vo, err)
+		}
+		ff[so-1].value = sel
+	}
 
 	e.write(SegmentName, 0, true)
 	for _, f := range ff {
@@ -367,6 +375,13 @@ func (e *Encoder) encodeDataType(t tag, o interface{}, level int) error {
 			return fmt.Errorf("unknown value kind: %v", rv.Kind())
 		case reflect.Pointer:
 			rv = rv.Elem()
+			// An optional VARIES field, such as QPD-3.
+			if rv.Kind() == reflect.Interface {
+				if rv.IsNil() {
+					return nil
+				}
+				return e.encodeDataType(t, rv.Elem().Interface(), level)
+			}
 			fallthrough
 		case reflect.Struct:
 			var SegmentName string
@@ -423,6 +438,9 @@ func (e *Encoder) encodeDataType(t tag, o interface{}, level int) error {
 				}
 				ff[index] = f
 			}
+			if SegmentName == "CX" && len(e.opt.CheckDigitScheme) > 0 && len(ff) >= 3 {
+				e.fillCheckDigit(ff[0].value, &ff[1].value, &ff[2].value)
+			}
 
 			for i, f := range ff {
 				if i != 0 {
@@ -453,6 +471,12 @@ func (e *Encoder) encodeDataType(t tag, o interface{}, level int) error {
 			}
 			return nil
 		}
+	case Number:
+		e.write(v.String(), level, true)
+	case *Number:
+		if v != nil {
+			e.write(v.String(), level, true)
+		}
 	case []byte:
 		e.writeByte(v, level, true)
 	case string:
@@ -461,20 +485,42 @@ func (e *Encoder) encodeDataType(t tag, o interface{}, level int) error {
 		if v.IsZero() {
 			return nil
 		}
-		var sv string
-		switch t.Format {
-		default:
-			sv = v.Format("20060102150405")
-		case "YMDHMS":
-			sv = v.Format("20060102150405")
-		case "YMDHM":
-			sv = v.Format("200601021504")
-		case "YMD":
-			sv = v.Format("20060102")
-		case "HM":
-			sv = v.Format("1504")
-		}
-		e.write(sv, level, t.NoEscape)
+		e.write(formatTime(t, v), level, t.NoEscape)
 	}
 	return nil
 }
+
+// fillCheckDigit sets an empty check digit, and an empty scheme, of a CX from the identifier.
+// Identifiers without a check digit in the scheme, such as alphanumeric identifiers, are left unchanged.
+func (e *Encoder) fillCheckDigit(id any, digit, scheme *any) {
+	idValue, _ := id.(string)
+	digitValue, _ := (*digit).(string)
+	schemeValue, ok := (*scheme).(string)
+	if !ok || len(idValue) == 0 || len(digitValue) > 0 {
+		return
+	}
+	if len(schemeValue) == 0 {
+		schemeValue = e.opt.CheckDigitScheme
+	}
+	c, err := CheckDigit(idValue, schemeValue)
+	if err != nil {
+		return
+	}
+	*digit, *scheme = c, schemeValue
+}
+
+// formatTime formats a time using the format of the field tag.
+func formatTime(t tag, v time.Time) string {
+	switch t.Format {
+	default:
+		return v.Format("20060102150405")
+	case "YMDHMS":
+		return v.Format("20060102150405")
+	case "YMDHM":
+		return v.Format("200601021504")
+	case "YMD":
+		return v.Format("20060102")
+	case "HM":
+		return v.Format("1504")
+	}
+}
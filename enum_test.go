@@ -0,0 +1,28 @@
+package hl7
+
+import (
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestTableEnum(t *testing.T) {
+	pid := v251.PID{AdministrativeSex: string(v251.Table0001Female)}
+	if pid.AdministrativeSex != "F" {
+		t.Fatalf("got %q, want F", pid.AdministrativeSex)
+	}
+	sex := v251.Table0001(pid.AdministrativeSex)
+	if g, w := sex.Description(), "Female"; g != w {
+		t.Errorf("description got %q, want %q", g, w)
+	}
+	if !sex.Valid() {
+		t.Error("F not valid")
+	}
+	if g, w := sex.Table().Name, "Administrative Sex"; g != w {
+		t.Errorf("table name got %q, want %q", g, w)
+	}
+	bad := v251.Table0001("Z")
+	if bad.Valid() || len(bad.Description()) > 0 {
+		t.Error("Z is valid")
+	}
+}
@@ -0,0 +1,674 @@
+package fhir
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	systemV2Table    = "http://terminology.hl7.org/CodeSystem/v2-"
+	systemActCode    = "http://terminology.hl7.org/CodeSystem/v3-ActCode"
+	systemParticipat = "http://terminology.hl7.org/CodeSystem/v3-ParticipationType"
+	systemAllergy    = "http://terminology.hl7.org/CodeSystem/allergyintolerance-clinical"
+	systemUCUM       = "http://unitsofmeasure.org"
+)
+
+// codingSystems maps HL7 table 0396 coding system names to FHIR system URIs.
+var codingSystems = map[string]string{
+	"LN":     "http://loinc.org",
+	"SCT":    "http://snomed.info/sct",
+	"SNM":    "http://snomed.info/sct",
+	"UCUM":   systemUCUM,
+	"I9":     "http://hl7.org/fhir/sid/icd-9-cm",
+	"I9C":    "http://hl7.org/fhir/sid/icd-9-cm",
+	"I10":    "http://hl7.org/fhir/sid/icd-10",
+	"I10C":   "http://hl7.org/fhir/sid/icd-10-cm",
+	"RXNORM": "http://www.nlm.nih.gov/research/umls/rxnorm",
+	"CPT":    "http://www.ama-assn.org/go/cpt",
+	"C4":     "http://www.ama-assn.org/go/cpt",
+	"NDC":    "http://hl7.org/fhir/sid/ndc",
+	"CVX":    "http://hl7.org/fhir/sid/cvx",
+}
+
+// codingSystem returns the FHIR system URI of a v2 coding system name, such as "LN".
+// HL7 tables, such as "HL70078", map to the v2 table code systems.
+// Unknown coding systems return an empty system.
+func codingSystem(name string) string {
+	if s, ok := codingSystems[strings.ToUpper(name)]; ok {
+		return s
+	}
+	if table, ok := strings.CutPrefix(name, "HL7"); ok && len(table) == 4 {
+		return systemV2Table + table
+	}
+	return ""
+}
+
+// coded returns a codeable concept from the triplets of a CE or CWE, or nil if empty.
+func coded(originalText string, triplet ...string) *CodeableConcept {
+	cc := &CodeableConcept{Text: originalText}
+	for i := 0; i+2 < len(triplet); i += 3 {
+		code, text, system := triplet[i], triplet[i+1], triplet[i+2]
+		if len(code) == 0 && len(text) == 0 {
+			continue
+		}
+		if len(code) == 0 {
+			if len(cc.Text) == 0 {
+				cc.Text = text
+			}
+			continue
+		}
+		cc.Coding = append(cc.Coding, Coding{
+			System:  codingSystem(system),
+			Code:    code,
+			Display: text,
+		})
+	}
+	if len(cc.Coding) == 0 && len(cc.Text) == 0 {
+		return nil
+	}
+	return cc
+}
+
+// tableCode returns a codeable concept for a code in an HL7 table, or nil if the code is empty.
+func tableCode(table, code string) *CodeableConcept {
+	if len(code) == 0 {
+		return nil
+	}
+	return &CodeableConcept{Coding: []Coding{{System: systemV2Table + table, Code: code}}}
+}
+
+// assigner returns the identifier system of an HD, or the namespace when the HD has no universal ID.
+func assigner(namespace, universalID, universalIDType string) (system string, display string) {
+	switch {
+	case len(universalID) == 0:
+		return "", namespace
+	case strings.EqualFold(universalIDType, "ISO"):
+		return "urn:oid:" + universalID, namespace
+	case strings.EqualFold(universalIDType, "UUID"):
+		return "urn:uuid:" + universalID, namespace
+	default:
+		return universalID, namespace
+	}
+}
+
+func nonEmpty(list ...string) []string {
+	var ret []string
+	for _, s := range list {
+		if len(s) > 0 {
+			ret = append(ret, s)
+		}
+	}
+	return ret
+}
+
+// dateTime formats a time as a FHIR dateTime.
+// A time without a clock is formatted as a date.
+func dateTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	if h, m, s := t.Clock(); h == 0 && m == 0 && s == 0 && t.Location() == time.UTC {
+		return t.Format(time.DateOnly)
+	}
+	return t.Format(time.RFC3339)
+}
+
+func date(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.DateOnly)
+}
+
+// dateText formats an HL7 date string, such as "20250609", as a FHIR date.
+func dateText(s string) string {
+	s = strings.TrimSpace(s)
+	switch {
+	case len(s) >= 8:
+		return s[:4] + "-" + s[4:6] + "-" + s[6:8]
+	case len(s) >= 6:
+		return s[:4] + "-" + s[4:6]
+	case len(s) >= 4:
+		return s[:4]
+	}
+	return ""
+}
+
+// quantity returns the quantity of a numeric string, or nil if it is not a number.
+func quantity(value string) *Quantity {
+	value = strings.TrimSpace(value)
+	if _, err := strconv.ParseFloat(value, 64); err != nil || !json.Valid([]byte(value)) {
+		return nil
+	}
+	return &Quantity{Value: json.Number(value)}
+}
+
+// units sets the units of a quantity from the OBX-6 triplet.
+func (q *Quantity) units(code, text, system string) {
+	q.Unit = text
+	if len(q.Unit) == 0 {
+		q.Unit = code
+	}
+	if strings.EqualFold(system, "UCUM") {
+		q.System = systemUCUM
+		q.Code = code
+	}
+}
+
+// gender maps HL7 table 0001 to the FHIR administrative gender.
+func gender(code string) string {
+	switch code {
+	case "M":
+		return "male"
+	case "F":
+		return "female"
+	case "O", "A":
+		return "other"
+	case "U", "N":
+		return "unknown"
+	}
+	return ""
+}
+
+// nameUse maps HL7 table 0200 to the FHIR name use.
+func nameUse(code string) string {
+	switch code {
+	case "L":
+		return "official"
+	case "D", "A":
+		return "usual"
+	case "M":
+		return "maiden"
+	case "N":
+		return "nickname"
+	case "S":
+		return "anonymous"
+	case "B", "BAD":
+		return "old"
+	case "T", "TEMP":
+		return "temp"
+	}
+	return ""
+}
+
+// addressUse maps HL7 table 0190 to the FHIR address use and type.
+func addressUse(code string) (use, typ string) {
+	switch code {
+	case "H":
+		return "home", ""
+	case "B", "O":
+		return "work", ""
+	case "C":
+		return "temp", ""
+	case "BA":
+		return "old", ""
+	case "M":
+		return "", "postal"
+	}
+	return "", ""
+}
+
+// contactPoint maps HL7 tables 0201 and 0202 to a FHIR contact point.
+// The default use is applied when the XTN has no use code, such as "work" for PID-14.
+func contactPoint(number, useCode, equipment, email string, defaultUse string) ContactPoint {
+	cp := ContactPoint{Value: number}
+	switch equipment {
+	case "Internet", "X.400":
+		cp.System = "email"
+	case "FX":
+		cp.System = "fax"
+	case "BP":
+		cp.System = "pager"
+	case "PH", "CP", "MD", "TDD", "TTY", "SAT":
+		cp.System = "phone"
+	}
+	switch useCode {
+	case "PRN", "ORN", "VHN":
+		cp.Use = "home"
+	case "WPN":
+		cp.Use = "work"
+	case "NET":
+		cp.System = "email"
+	case "BPN":
+		cp.System = "pager"
+	}
+	if equipment == "CP" {
+		cp.Use = "mobile"
+	}
+	if len(email) > 0 && (cp.System == "email" || len(number) == 0) {
+		cp.System = "email"
+		cp.Value = email
+	}
+	if len(cp.System) == 0 && len(cp.Value) > 0 {
+		cp.System = "phone"
+	}
+	if len(cp.Use) == 0 {
+		cp.Use = defaultUse
+	}
+	return cp
+}
+
+// phoneNumber formats the parts of an XTN when the number is not present.
+func phoneNumber(country, area, local, extension string) string {
+	if len(local) == 0 {
+		return ""
+	}
+	sb := &strings.Builder{}
+	if len(country) > 0 {
+		sb.WriteString("+" + country + " ")
+	}
+	if len(area) > 0 {
+		sb.WriteString("(" + area + ") ")
+	}
+	sb.WriteString(local)
+	if len(extension) > 0 {
+		sb.WriteString(" x" + extension)
+	}
+	return sb.String()
+}
+
+// encounterClass maps HL7 table 0004 to the FHIR encounter class.
+func encounterClass(code string) Coding {
+	switch code {
+	case "I", "B":
+		return Coding{System: systemActCode, Code: "IMP", Display: "inpatient encounter"}
+	case "O", "R":
+		return Coding{System: systemActCode, Code: "AMB", Display: "ambulatory"}
+	case "E":
+		return Coding{System: systemActCode, Code: "EMER", Display: "emergency"}
+	case "P":
+		return Coding{System: systemActCode, Code: "PRENC", Display: "pre-admission"}
+	}
+	return Coding{System: systemV2Table + "0004", Code: code}
+}
+
+// encounterStatus is "finished" once the patient is discharged.
+func encounterStatus(discharged bool) string {
+	if discharged {
+		return "finished"
+	}
+	return "in-progress"
+}
+
+func participant(code, display string, individual *Reference) EncounterParticipant {
+	return EncounterParticipant{
+		Type:       []CodeableConcept{{Coding: []Coding{{System: systemParticipat, Code: code, Display: display}}}},
+		Individual: individual,
+	}
+}
+
+// reportStatus maps HL7 table 0123 to the FHIR diagnostic report status.
+func reportStatus(code string) string {
+	switch code {
+	case "O", "I":
+		return "registered"
+	case "S", "A", "R":
+		return "partial"
+	case "P":
+		return "preliminary"
+	case "C":
+		return "corrected"
+	case "F":
+		return "final"
+	case "X":
+		return "cancelled"
+	}
+	return "unknown"
+}
+
+// observationStatus maps HL7 table 0085 to the FHIR observation status.
+func observationStatus(code string) string {
+	switch code {
+	case "I":
+		return "registered"
+	case "P", "R", "S":
+		return "preliminary"
+	case "F", "U":
+		return "final"
+	case "C":
+		return "corrected"
+	case "D", "W":
+		return "entered-in-error"
+	case "X":
+		return "cancelled"
+	}
+	return "unknown"
+}
+
+// allergyCategory maps HL7 table 0127 to the FHIR allergy category.
+func allergyCategory(code string) []string {
+	switch code {
+	case "DA":
+		return []string{"medication"}
+	case "FA":
+		return []string{"food"}
+	case "EA", "AA", "PA", "LA":
+		return []string{"environment"}
+	}
+	return nil
+}
+
+// allergyCriticality maps HL7 table 0128 to the FHIR allergy criticality.
+func allergyCriticality(code string) string {
+	switch code {
+	case "SV":
+		return "high"
+	case "MO", "MI":
+		return "low"
+	case "U":
+		return "unable-to-assess"
+	}
+	return ""
+}
+
+func allergyActive() *CodeableConcept {
+	return &CodeableConcept{Coding: []Coding{{System: systemAllergy, Code: "active"}}}
+}
+
+// reactions maps AL1-5 to reaction manifestations.
+func reactions(list []string) []AllergyReaction {
+	var ret []AllergyReaction
+	for _, s := range list {
+		if len(s) == 0 {
+			continue
+		}
+		ret = append(ret, AllergyReaction{Manifestation: []CodeableConcept{{Text: s}}})
+	}
+	return ret
+}
+
+// yesNo maps HL7 table 0136 to a boolean, or nil if empty.
+func yesNo(code string) *bool {
+	var b bool
+	switch code {
+	default:
+		return nil
+	case "Y":
+		b = true
+	case "N":
+		b = false
+	}
+	return &b
+}
+
+func joinNonEmpty(sep string, list ...string) string {
+	return strings.Join(nonEmpty(list...), sep)
+}
+
+// sn returns the quantity of a structured numeric with a single number, such as ">^100",
+// or nil if it is a ratio or range.
+func sn(comparator, num1, separator, num2 string) *Quantity {
+	if len(separator) > 0 || len(num2) > 0 {
+		return nil
+	}
+	q := quantity(num1)
+	if q == nil {
+		return nil
+	}
+	switch comparator {
+	default:
+		return nil
+	case "", "=":
+	case "<", "<=", ">=", ">":
+		q.Comparator = comparator
+	}
+	return q
+}
+
+// withTable sets the system of codings without a coding system to an HL7 table.
+func withTable(cc *CodeableConcept, table string) *CodeableConcept {
+	if cc == nil {
+		return nil
+	}
+	for i := range cc.Coding {
+		if len(cc.Coding[i].System) == 0 {
+			cc.Coding[i].System = systemV2Table + table
+		}
+	}
+	return cc
+}
+
+// codingSystemNames maps FHIR system URIs to HL7 table 0396 coding system names.
+var codingSystemNames = map[string]string{
+	"http://loinc.org":                            "LN",
+	"http://snomed.info/sct":                      "SCT",
+	systemUCUM:                                    "UCUM",
+	"http://hl7.org/fhir/sid/icd-9-cm":            "I9C",
+	"http://hl7.org/fhir/sid/icd-10":              "I10",
+	"http://hl7.org/fhir/sid/icd-10-cm":           "I10C",
+	"http://www.nlm.nih.gov/research/umls/rxnorm": "RXNORM",
+	"http://www.ama-assn.org/go/cpt":              "CPT",
+	"http://hl7.org/fhir/sid/ndc":                 "NDC",
+	"http://hl7.org/fhir/sid/cvx":                 "CVX",
+}
+
+// codingSystemName returns the v2 coding system name of a FHIR system URI, the reverse of codingSystem.
+// Unknown systems return an empty name.
+func codingSystemName(system string) string {
+	if name, ok := codingSystemNames[system]; ok {
+		return name
+	}
+	if table, ok := strings.CutPrefix(system, systemV2Table); ok && len(table) == 4 {
+		return "HL7" + table
+	}
+	return ""
+}
+
+// tableValue returns the code of a codeable concept in an HL7 table.
+// A coding from the table is preferred, otherwise the first code is used.
+func tableValue(cc *CodeableConcept, table string) string {
+	if cc == nil {
+		return ""
+	}
+	for _, c := range cc.Coding {
+		if c.System == systemV2Table+table {
+			return c.Code
+		}
+	}
+	for _, c := range cc.Coding {
+		if len(c.Code) > 0 {
+			return c.Code
+		}
+	}
+	return ""
+}
+
+// universalID returns the HD parts of an identifier system, the reverse of assigner.
+func universalID(system string) (id, idType string) {
+	switch {
+	case len(system) == 0:
+		return "", ""
+	case strings.HasPrefix(system, "urn:oid:"):
+		return strings.TrimPrefix(system, "urn:oid:"), "ISO"
+	case strings.HasPrefix(system, "urn:uuid:"):
+		return strings.TrimPrefix(system, "urn:uuid:"), "UUID"
+	default:
+		return system, "URI"
+	}
+}
+
+// parseDateTime parses a FHIR date, dateTime or instant.
+// A value without a time zone is in UTC. An invalid value returns the zero time.
+func parseDateTime(s string) time.Time {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05", time.DateOnly, "2006-01", "2006"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// genderCode maps the FHIR administrative gender to HL7 table 0001.
+func genderCode(g string) string {
+	switch g {
+	case "male":
+		return "M"
+	case "female":
+		return "F"
+	case "other":
+		return "O"
+	case "unknown":
+		return "U"
+	}
+	return ""
+}
+
+// nameUseCode maps the FHIR name use to HL7 table 0200.
+func nameUseCode(use string) string {
+	switch use {
+	case "official":
+		return "L"
+	case "usual":
+		return "D"
+	case "maiden":
+		return "M"
+	case "nickname":
+		return "N"
+	case "anonymous":
+		return "S"
+	case "old":
+		return "BAD"
+	case "temp":
+		return "T"
+	}
+	return ""
+}
+
+// addressUseCode maps the FHIR address use and type to HL7 table 0190.
+func addressUseCode(use, typ string) string {
+	switch use {
+	case "home":
+		return "H"
+	case "work":
+		return "B"
+	case "temp":
+		return "C"
+	case "old":
+		return "BA"
+	}
+	if typ == "postal" {
+		return "M"
+	}
+	return ""
+}
+
+// contactPointCode maps a FHIR contact point to the XTN use code (table 0201) and equipment type (table 0202).
+func contactPointCode(cp ContactPoint) (useCode, equipment string) {
+	switch cp.System {
+	case "email":
+		return "NET", "Internet"
+	case "fax":
+		equipment = "FX"
+	case "pager":
+		return "BPN", "BP"
+	default:
+		equipment = "PH"
+	}
+	switch cp.Use {
+	case "home":
+		useCode = "PRN"
+	case "work":
+		useCode = "WPN"
+	case "mobile":
+		useCode, equipment = "PRN", "CP"
+	}
+	return useCode, equipment
+}
+
+// encounterClassCode maps the FHIR encounter class to HL7 table 0004.
+func encounterClassCode(c Coding) string {
+	switch c.Code {
+	case "IMP":
+		return "I"
+	case "AMB":
+		return "O"
+	case "EMER":
+		return "E"
+	case "PRENC":
+		return "P"
+	}
+	if c.System == systemV2Table+"0004" {
+		return c.Code
+	}
+	return ""
+}
+
+// reportStatusCode maps the FHIR diagnostic report status to HL7 table 0123.
+func reportStatusCode(status string) string {
+	switch status {
+	case "registered":
+		return "I"
+	case "partial":
+		return "A"
+	case "preliminary":
+		return "P"
+	case "corrected", "amended":
+		return "C"
+	case "final":
+		return "F"
+	case "cancelled":
+		return "X"
+	}
+	return ""
+}
+
+// observationStatusCode maps the FHIR observation status to HL7 table 0085.
+func observationStatusCode(status string) string {
+	switch status {
+	case "registered":
+		return "I"
+	case "preliminary":
+		return "P"
+	case "final":
+		return "F"
+	case "corrected", "amended":
+		return "C"
+	case "entered-in-error":
+		return "W"
+	case "cancelled":
+		return "X"
+	}
+	return ""
+}
+
+// allergyCategoryCode maps the FHIR allergy category to HL7 table 0127.
+func allergyCategoryCode(list []string) string {
+	if len(list) == 0 {
+		return ""
+	}
+	switch list[0] {
+	case "medication":
+		return "DA"
+	case "food":
+		return "FA"
+	case "environment":
+		return "EA"
+	}
+	return ""
+}
+
+// allergyCriticalityCode maps the FHIR allergy criticality to HL7 table 0128.
+func allergyCriticalityCode(c string) string {
+	switch c {
+	case "high":
+		return "SV"
+	case "low":
+		return "MO"
+	case "unable-to-assess":
+		return "U"
+	}
+	return ""
+}
+
+// yesNoCode maps a boolean to HL7 table 0136.
+func yesNoCode(b *bool) string {
+	switch {
+	case b == nil:
+		return ""
+	case *b:
+		return "Y"
+	}
+	return "N"
+}
@@ -0,0 +1,197 @@
+// Package fhir maps HL7 v2 messages to FHIR R4 resources, and FHIR R4 resources to HL7 v2 messages.
+//
+// The mapping follows the HL7 v2-to-FHIR concept maps for the core segments:
+//
+//	PID     Patient
+//	PV1     Encounter
+//	NK1     RelatedPerson
+//	AL1     AllergyIntolerance
+//	OBR     DiagnosticReport
+//	OBX     Observation
+//	NTE     Observation.note, when following an OBX
+//
+// Segments from the h251 and h280 packages are supported. Other segments are ignored.
+//
+// ToADT_A01 and ToORU_R01 map resources back to h251 messages, and report
+// each FHIR element without a v2 destination.
+package fhir
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kardianos/hl7"
+	"github.com/kardianos/hl7/h251"
+	"github.com/kardianos/hl7/h280"
+)
+
+// Convert a trigger, such as h251.ORU_R01, into a bundle of type "collection".
+// Resources reference each other by relative reference, such as "Patient/patient-1".
+func Convert(trigger any) (*Bundle, error) {
+	list := hl7.Flatten(trigger)
+	if len(list) == 0 {
+		return nil, fmt.Errorf("fhir: no segments in %T", trigger)
+	}
+	c := &converter{
+		bundle: &Bundle{
+			ResourceType: "Bundle",
+			Type:         "collection",
+		},
+		count: map[string]int{},
+	}
+	for _, seg := range list {
+		switch v := seg.(type) {
+		case *h251.MSH:
+			c.header(v.MessageControlID, dateTime(v.DateTimeOfMessage))
+		case *h251.PID:
+			c.addPatient(patient251(v))
+		case *h251.PV1:
+			c.addEncounter(encounter251(v))
+		case *h251.NK1:
+			c.addRelatedPerson(relatedPerson251(v))
+		case *h251.AL1:
+			c.addAllergy(allergy251(v))
+		case *h251.OBR:
+			c.addReport(report251(v))
+		case *h251.OBX:
+			c.addObservation(observation251(v))
+		case *h251.NTE:
+			c.note(v.Comment)
+
+		case *h280.MSH:
+			c.header(v.MessageControlID, dateTime(v.DateTimeOfMessage))
+		case *h280.PID:
+			c.addPatient(patient280(v))
+		case *h280.PV1:
+			c.addEncounter(encounter280(v))
+		case *h280.NK1:
+			c.addRelatedPerson(relatedPerson280(v))
+		case *h280.AL1:
+			c.addAllergy(allergy280(v))
+		case *h280.OBR:
+			c.addReport(report280(v))
+		case *h280.OBX:
+			c.addObservation(observation280(v))
+		case *h280.NTE:
+			c.note(v.Comment)
+		}
+	}
+	return c.bundle, nil
+}
+
+// converter links resources in segment order. Each resource refers to the
+// most recent patient and encounter. Observations following an OBR are
+// results of that report.
+type converter struct {
+	bundle *Bundle
+	count  map[string]int
+
+	patient     *Patient
+	encounter   *Encounter
+	report      *DiagnosticReport
+	observation *Observation
+}
+
+// id returns the next resource ID for the resource type, such as "observation-2".
+func (c *converter) id(resourceType string) string {
+	c.count[resourceType]++
+	return strings.ToLower(resourceType) + "-" + strconv.Itoa(c.count[resourceType])
+}
+
+func (c *converter) add(r Resource) {
+	c.bundle.Entry = append(c.bundle.Entry, BundleEntry{Resource: r})
+}
+
+func (c *converter) subject() *Reference {
+	if c.patient == nil {
+		return nil
+	}
+	return c.patient.Reference()
+}
+
+func (c *converter) context() *Reference {
+	if c.encounter == nil {
+		return nil
+	}
+	return c.encounter.Reference()
+}
+
+func (c *converter) header(controlID, timestamp string) {
+	if len(controlID) > 0 {
+		c.bundle.Identifier = &Identifier{Value: controlID}
+	}
+	c.bundle.Timestamp = timestamp
+}
+
+func (c *converter) addPatient(r *Patient) {
+	r.ResourceType = "Patient"
+	r.ID = c.id(r.ResourceType)
+	c.patient = r
+	c.encounter = nil
+	c.report = nil
+	c.observation = nil
+	c.add(r)
+}
+
+func (c *converter) addEncounter(r *Encounter) {
+	r.ResourceType = "Encounter"
+	r.ID = c.id(r.ResourceType)
+	r.Subject = c.subject()
+	c.encounter = r
+	c.report = nil
+	c.observation = nil
+	c.add(r)
+}
+
+func (c *converter) addRelatedPerson(r *RelatedPerson) {
+	r.ResourceType = "RelatedPerson"
+	r.ID = c.id(r.ResourceType)
+	if s := c.subject(); s != nil {
+		r.Patient = *s
+	}
+	c.add(r)
+}
+
+func (c *converter) addAllergy(r *AllergyIntolerance) {
+	r.ResourceType = "AllergyIntolerance"
+	r.ID = c.id(r.ResourceType)
+	if s := c.subject(); s != nil {
+		r.Patient = *s
+	}
+	c.add(r)
+}
+
+func (c *converter) addReport(r *DiagnosticReport) {
+	r.ResourceType = "DiagnosticReport"
+	r.ID = c.id(r.ResourceType)
+	r.Subject = c.subject()
+	r.Encounter = c.context()
+	c.report = r
+	c.observation = nil
+	c.add(r)
+}
+
+func (c *converter) addObservation(r *Observation) {
+	r.ResourceType = "Observation"
+	r.ID = c.id(r.ResourceType)
+	r.Subject = c.subject()
+	r.Encounter = c.context()
+	if c.report != nil {
+		c.report.Result = append(c.report.Result, *r.Reference())
+	}
+	c.observation = r
+	c.add(r)
+}
+
+// note adds NTE comments to the previous observation.
+func (c *converter) note(comment []string) {
+	if c.observation == nil {
+		return
+	}
+	text := strings.Join(comment, "\n")
+	if len(text) == 0 {
+		return
+	}
+	c.observation.Note = append(c.observation.Note, Annotation{Text: text})
+}
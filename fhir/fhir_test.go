@@ -0,0 +1,173 @@
+package fhir
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kardianos/hl7"
+	"github.com/kardianos/hl7/h251"
+	"github.com/kardianos/hl7/h280"
+)
+
+var overwrite = flag.Bool("overwrite", false, "overwrite golden files with got values")
+
+// TestConvert maps each message in testdata to a bundle and compares it to the golden JSON file.
+// The registry is chosen from the file name suffix, such as "_h251.hl7".
+func TestConvert(t *testing.T) {
+	registryList := map[string]hl7.Registry{
+		"h251": h251.Registry,
+		"h280": h280.Registry,
+	}
+	fnList, err := filepath.Glob(filepath.Join("testdata", "*.hl7"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fnList) == 0 {
+		t.Fatal("no test messages")
+	}
+	for _, fn := range fnList {
+		name := strings.TrimSuffix(filepath.Base(fn), ".hl7")
+		t.Run(name, func(t *testing.T) {
+			_, version, _ := strings.Cut(name[strings.LastIndex(name, "_"):], "_")
+			registry, ok := registryList[version]
+			if !ok {
+				t.Fatalf("unknown version %q", version)
+			}
+			raw, err := os.ReadFile(fn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			msg, err := hl7.NewDecoder(registry, nil).Decode(raw)
+			if err != nil {
+				t.Fatal("decode", err)
+			}
+			b, err := Convert(msg)
+			if err != nil {
+				t.Fatal("convert", err)
+			}
+			got, err := json.MarshalIndent(b, "", "\t")
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, '\n')
+
+			goldenFn := strings.TrimSuffix(fn, ".hl7") + ".json"
+			if *overwrite {
+				if err := os.WriteFile(goldenFn, got, 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(goldenFn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Fatalf("mismatch with %s, got:\n%s", goldenFn, got)
+			}
+		})
+	}
+}
+
+func TestResources(t *testing.T) {
+	raw, err := os.ReadFile(filepath.Join("testdata", "oru_r01_h251.hl7"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := hl7.NewDecoder(h251.Registry, nil).Decode(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := Convert(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	list := b.Resources("DiagnosticReport")
+	if len(list) != 1 {
+		t.Fatalf("got %d reports, want 1", len(list))
+	}
+	report := list[0].(*DiagnosticReport)
+	if g, w := len(report.Result), len(b.Resources("Observation")); g != w {
+		t.Fatalf("got %d results, want %d", g, w)
+	}
+	obs := b.Resources("Observation")[1].(*Observation)
+	if q := obs.ValueQuantity; q == nil || q.Comparator != "<" || q.Value != "10" {
+		t.Fatalf("unexpected SN quantity: %+v", q)
+	}
+}
+
+// TestToV2 maps each bundle in testdata/v2 to a message and compares the
+// encoded message and the unmapped elements to the golden files.
+func TestToV2(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		to   func(data []byte) (any, []Unmapped, error)
+	}{
+		{"adt_a01", func(data []byte) (any, []Unmapped, error) { return ToADT_A01(data) }},
+		{"oru_r01", func(data []byte) (any, []Unmapped, error) { return ToORU_R01(data) }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fn := filepath.Join("testdata", "v2", tc.name)
+			raw, err := os.ReadFile(fn + ".json")
+			if err != nil {
+				t.Fatal(err)
+			}
+			msg, unmapped, err := tc.to(raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := hl7.NewEncoder(&hl7.EncodeOption{TrimTrailingSeparator: true}).Encode(msg)
+			if err != nil {
+				t.Fatal("encode", err)
+			}
+			if _, err := hl7.NewDecoder(h251.Registry, nil).Decode(got); err != nil {
+				t.Fatal("decode", err)
+			}
+			var report bytes.Buffer
+			for _, u := range unmapped {
+				report.WriteString(u.String() + "\n")
+			}
+			for _, golden := range []struct {
+				ext string
+				got []byte
+			}{
+				{".hl7", got},
+				{".txt", report.Bytes()},
+			} {
+				if *overwrite {
+					if err := os.WriteFile(fn+golden.ext, golden.got, 0600); err != nil {
+						t.Fatal(err)
+					}
+				}
+				want, err := os.ReadFile(fn + golden.ext)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(golden.got, want) {
+					t.Fatalf("mismatch with %s%s, got:\n%s", fn, golden.ext, golden.got)
+				}
+			}
+		})
+	}
+}
+
+func TestToV2Resource(t *testing.T) {
+	msg, unmapped, err := ToORU_R01([]byte(`{"resourceType":"Observation","status":"final","code":{"text":"Note"},"valueString":"a\nb"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unmapped) != 0 {
+		t.Fatalf("unexpected unmapped: %v", unmapped)
+	}
+	obx := msg.PatientResult[0].OrderObservation[0].Observation[0].OBX
+	if obx.ValueType != "ST" || len(obx.ObservationValue) != 2 {
+		t.Fatalf("unexpected OBX: %+v", obx)
+	}
+	if _, _, err := ToADT_A01([]byte(`{"resourceType":"Observation"}`)); err == nil {
+		t.Fatal("expected error without a patient")
+	}
+}
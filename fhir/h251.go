@@ -0,0 +1,207 @@
+package fhir
+
+import (
+	"time"
+
+	"github.com/kardianos/hl7/h251"
+)
+
+func ce251(v *h251.CE) *CodeableConcept {
+	if v == nil {
+		return nil
+	}
+	return coded("", v.Identifier, v.Text, v.NameOfCodingSystem, v.AlternateIdentifier, v.AlternateText, v.NameOfAlternateCodingSystem)
+}
+
+func cwe251(v *h251.CWE) *CodeableConcept {
+	if v == nil {
+		return nil
+	}
+	return coded(v.OriginalText, v.Identifier, v.Text, v.NameOfCodingSystem, v.AlternateIdentifier, v.AlternateText, v.NameOfAlternateCodingSystem)
+}
+
+func authority251(v *h251.HD) *authority {
+	if v == nil {
+		return nil
+	}
+	return &authority{v.NamespaceID, v.UniversalID, v.UniversalIDType}
+}
+
+func identifier251(v h251.CX) (Identifier, bool) {
+	return identifier(v.IdentifierTypeCode, v.IDNumber, authority251(v.AssigningAuthority))
+}
+
+func name251(v h251.XPN) (HumanName, bool) {
+	return humanName(v.NameTypeCode, v.FamilyName,
+		[]string{v.GivenName, v.SecondAndFurtherGivenNamesOrInitialsThereof},
+		[]string{v.Prefix},
+		[]string{v.Suffix, v.Degree, v.ProfessionalSuffix},
+	)
+}
+
+func address251(v h251.XAD) (Address, bool) {
+	var line []string
+	if sa := v.StreetAddress; sa != nil {
+		line = append(line, sa.StreetOrMailingAddress)
+	}
+	line = append(line, v.OtherDesignation)
+	return address(v.AddressType, line, v.City, v.StateOrProvince, v.ZipOrPostalCode, v.Country)
+}
+
+func telecom251(list []h251.XTN, defaultUse string) []ContactPoint {
+	return each(list, func(v h251.XTN) (ContactPoint, bool) {
+		parts := [4]string{v.CountryCode, v.AreaCityCode, v.LocalNumber, v.Extension}
+		return telecom(v.TelephoneNumber, parts, v.TelecommunicationUseCode, v.TelecommunicationEquipmentType, v.EmailAddress, defaultUse)
+	})
+}
+
+func practitioner251(v h251.XCN) (*Reference, bool) {
+	display := joinNonEmpty(" ", v.Prefix, v.GivenName, v.SecondAndFurtherGivenNamesOrInitialsThereof, v.FamilyName, v.Suffix, v.Degree)
+	return practitioner(display, v.IDNumber, authority251(v.AssigningAuthority))
+}
+
+func location251(v *h251.PL) *Reference {
+	if v == nil {
+		return nil
+	}
+	var facility string
+	if v.Facility != nil {
+		facility = v.Facility.NamespaceID
+	}
+	return location(facility, v.Building, v.Floor, v.PointOfCare, v.Room, v.Bed, v.LocationDescription)
+}
+
+func patient251(v *h251.PID) *Patient {
+	r := &Patient{
+		Identifier:    each(v.PatientIdentifierList, identifier251),
+		Name:          each(v.PatientName, name251),
+		Gender:        gender(v.AdministrativeSex),
+		BirthDate:     date(v.DateTimeOfBirth),
+		Address:       each(v.PatientAddress, address251),
+		MaritalStatus: withTable(ce251(v.MaritalStatus), "0002"),
+	}
+	r.Telecom = append(telecom251(v.PhoneNumberHome, "home"), telecom251(v.PhoneNumberBusiness, "work")...)
+	r.deceased(v.PatientDeathIndicator, v.PatientDeathDateAndTime)
+	r.multipleBirth(v.MultipleBirthIndicator, v.BirthOrder)
+	return r
+}
+
+func encounter251(v *h251.PV1) *Encounter {
+	r := &Encounter{
+		Class: encounterClass(v.PatientClass),
+	}
+	var discharge time.Time
+	if len(v.DischargeDateTime) > 0 {
+		discharge = v.DischargeDateTime[0]
+	}
+	r.period(v.AdmitDateTime, discharge)
+	if v.VisitNumber != nil {
+		r.Identifier = each([]h251.CX{*v.VisitNumber}, identifier251)
+	}
+	for _, p := range []struct {
+		code, display string
+		list          []h251.XCN
+	}{
+		{"ATND", "attender", v.AttendingDoctor},
+		{"REF", "referrer", v.ReferringDoctor},
+		{"CON", "consultant", v.ConsultingDoctor},
+		{"ADM", "admitter", v.AdmittingDoctor},
+	} {
+		for _, ref := range each(p.list, practitioner251) {
+			r.Participant = append(r.Participant, participant(p.code, p.display, ref))
+		}
+	}
+	r.hospitalization(tableCode("0023", v.AdmitSource), tableCode("0112", v.DischargeDisposition))
+	if loc := location251(v.AssignedPatientLocation); loc != nil {
+		r.Location = append(r.Location, EncounterLocation{Location: *loc})
+	}
+	return r
+}
+
+func relatedPerson251(v *h251.NK1) *RelatedPerson {
+	r := &RelatedPerson{
+		Identifier: each(v.NextOfKinAssociatedPartysIdentifiers, identifier251),
+		Name:       each(v.NKName, name251),
+		Gender:     gender(v.AdministrativeSex),
+		BirthDate:  date(v.DateTimeOfBirth),
+		Address:    each(v.Address, address251),
+	}
+	if rel := withTable(ce251(v.Relationship), "0063"); rel != nil {
+		r.Relationship = append(r.Relationship, *rel)
+	}
+	r.Telecom = append(telecom251(v.PhoneNumber, "home"), telecom251(v.BusinessPhoneNumber, "work")...)
+	return r
+}
+
+func allergy251(v *h251.AL1) *AllergyIntolerance {
+	r := &AllergyIntolerance{
+		ClinicalStatus: allergyActive(),
+		Code:           ce251(&v.AllergenCodeMnemonicDescription),
+		OnsetDateTime:  date(v.IdentificationDate),
+		Reaction:       reactions(v.AllergyReactionCode),
+	}
+	if v.AllergenTypeCode != nil {
+		r.Category = allergyCategory(v.AllergenTypeCode.Identifier)
+	}
+	if v.AllergySeverityCode != nil {
+		r.Criticality = allergyCriticality(v.AllergySeverityCode.Identifier)
+	}
+	return r
+}
+
+func report251(v *h251.OBR) *DiagnosticReport {
+	r := &DiagnosticReport{
+		Status:            reportStatus(v.ResultStatus),
+		EffectiveDateTime: dateTime(v.ObservationDateTime),
+	}
+	if code := ce251(&v.UniversalServiceIdentifier); code != nil {
+		r.Code = *code
+	}
+	if !v.ResultsRptStatusChngDateTime.IsZero() {
+		r.Issued = v.ResultsRptStatusChngDateTime.Format(time.RFC3339)
+	}
+	if ei := v.PlacerOrderNumber; ei != nil {
+		r.orderIdentifier("PLAC", ei.EntityIdentifier, authority{ei.NamespaceID, ei.UniversalID, ei.UniversalIDType})
+	}
+	if ei := v.FillerOrderNumber; ei != nil {
+		r.orderIdentifier("FILL", ei.EntityIdentifier, authority{ei.NamespaceID, ei.UniversalID, ei.UniversalIDType})
+	}
+	return r
+}
+
+func observation251(v *h251.OBX) *Observation {
+	r := &Observation{
+		Status:            observationStatus(v.ObservationResultStatus),
+		EffectiveDateTime: dateTime(v.DateTimeOfTheObservation),
+	}
+	if code := ce251(&v.ObservationIdentifier); code != nil {
+		r.Code = *code
+	}
+	if len(v.ReferencesRange) > 0 {
+		r.ReferenceRange = []ObservationReferenceRange{{Text: v.ReferencesRange}}
+	}
+	for _, flag := range v.AbnormalFlags {
+		if cc := tableCode("0078", flag); cc != nil {
+			r.Interpretation = append(r.Interpretation, *cc)
+		}
+	}
+	values := make([]any, len(v.ObservationValue))
+	for i, value := range v.ObservationValue {
+		switch value := value.(type) {
+		default:
+			values[i] = value
+		case h251.CE:
+			values[i] = ce251(&value)
+		case h251.CWE:
+			values[i] = cwe251(&value)
+		case h251.SN:
+			values[i] = structuredNumeric{value.Comparator, value.Num1, value.SeparatorSuffix, value.Num2}
+		}
+	}
+	var u *units
+	if v.Units != nil {
+		u = &units{v.Units.Identifier, v.Units.Text, v.Units.NameOfCodingSystem}
+	}
+	r.value(v.ValueType, values, u)
+	return r
+}
@@ -0,0 +1,222 @@
+package fhir
+
+import (
+	"time"
+
+	"github.com/kardianos/hl7/h280"
+)
+
+func cwe280(v *h280.CWE) *CodeableConcept {
+	if v == nil {
+		return nil
+	}
+	return coded(v.OriginalText, v.Identifier, v.Text, v.NameOfCodingSystem, v.AlternateIdentifier, v.AlternateText, v.NameOfAlternateCodingSystem)
+}
+
+func cne280(v *h280.CNE) *CodeableConcept {
+	if v == nil {
+		return nil
+	}
+	return coded(v.OriginalText, v.Identifier, v.Text, v.NameOfCodingSystem, v.AlternateIdentifier, v.AlternateText, v.NameOfAlternateCodingSystem)
+}
+
+// code280 returns the identifier of a coded value, or an empty string.
+func code280(v *h280.CWE) string {
+	if v == nil {
+		return ""
+	}
+	return v.Identifier
+}
+
+func namespace280(v *h280.HD) string {
+	if v == nil {
+		return ""
+	}
+	return v.NamespaceID
+}
+
+func authority280(v *h280.HD) *authority {
+	if v == nil {
+		return nil
+	}
+	return &authority{v.NamespaceID, v.UniversalID, v.UniversalIDType}
+}
+
+func identifier280(v h280.CX) (Identifier, bool) {
+	return identifier(v.IdentifierTypeCode, v.IDNumber, authority280(v.AssigningAuthority))
+}
+
+func name280(v h280.XPN) (HumanName, bool) {
+	return humanName(v.NameTypeCode, v.FamilyName,
+		[]string{v.GivenName, v.SecondAndFurtherGivenNamesOrInitialsThereof},
+		[]string{v.Prefix},
+		[]string{v.Suffix, v.Degree, v.ProfessionalSuffix},
+	)
+}
+
+func address280(v h280.XAD) (Address, bool) {
+	var line []string
+	if sa := v.StreetAddress; sa != nil {
+		line = append(line, sa.StreetOrMailingAddress)
+	}
+	line = append(line, v.OtherDesignation)
+	return address(v.AddressType, line, v.City, v.StateOrProvince, v.ZipOrPostalCode, v.Country)
+}
+
+func telecom280(list []h280.XTN, defaultUse string) []ContactPoint {
+	return each(list, func(v h280.XTN) (ContactPoint, bool) {
+		parts := [4]string{v.CountryCode, v.AreaCityCode, v.LocalNumber, v.Extension}
+		return telecom(v.TelephoneNumber, parts, v.TelecommunicationUseCode, v.TelecommunicationEquipmentType, v.CommunicationAddress, defaultUse)
+	})
+}
+
+func practitioner280(v h280.XCN) (*Reference, bool) {
+	display := joinNonEmpty(" ", v.Prefix, v.GivenName, v.SecondAndFurtherGivenNamesOrInitialsThereof, v.FamilyName, v.Suffix, v.Degree)
+	return practitioner(display, v.PersonIdentifier, authority280(v.AssigningAuthority))
+}
+
+func location280(v *h280.PL) *Reference {
+	if v == nil {
+		return nil
+	}
+	return location(
+		namespace280(v.Facility),
+		namespace280(v.Building),
+		namespace280(v.Floor),
+		namespace280(v.PointOfCare),
+		namespace280(v.Room),
+		namespace280(v.Bed),
+		v.LocationDescription,
+	)
+}
+
+func patient280(v *h280.PID) *Patient {
+	r := &Patient{
+		Identifier:    each(v.PatientIdentifierList, identifier280),
+		Name:          each(v.PatientName, name280),
+		Gender:        gender(code280(v.AdministrativeSex)),
+		BirthDate:     date(v.DateTimeOfBirth),
+		Address:       each(v.PatientAddress, address280),
+		MaritalStatus: withTable(cwe280(v.MaritalStatus), "0002"),
+	}
+	r.Telecom = append(telecom280(v.PhoneNumberHome, "home"), telecom280(v.PhoneNumberBusiness, "work")...)
+	r.deceased(v.PatientDeathIndicator, v.PatientDeathDateAndTime)
+	r.multipleBirth(v.MultipleBirthIndicator, v.BirthOrder)
+	return r
+}
+
+func encounter280(v *h280.PV1) *Encounter {
+	r := &Encounter{
+		Class: encounterClass(v.PatientClass.Identifier),
+	}
+	r.period(v.AdmitDateTime, v.DischargeDateTime)
+	if v.VisitNumber != nil {
+		r.Identifier = each([]h280.CX{*v.VisitNumber}, identifier280)
+	}
+	for _, p := range []struct {
+		code, display string
+		list          []h280.XCN
+	}{
+		{"ATND", "attender", v.AttendingDoctor},
+		{"REF", "referrer", v.ReferringDoctor},
+		{"CON", "consultant", v.ConsultingDoctor},
+		{"ADM", "admitter", v.AdmittingDoctor},
+	} {
+		for _, ref := range each(p.list, practitioner280) {
+			r.Participant = append(r.Participant, participant(p.code, p.display, ref))
+		}
+	}
+	r.hospitalization(withTable(cwe280(v.AdmitSource), "0023"), withTable(cwe280(v.DischargeDisposition), "0112"))
+	if loc := location280(v.AssignedPatientLocation); loc != nil {
+		r.Location = append(r.Location, EncounterLocation{Location: *loc})
+	}
+	return r
+}
+
+func relatedPerson280(v *h280.NK1) *RelatedPerson {
+	r := &RelatedPerson{
+		Identifier: each(v.NextOfKinAssociatedPartysIdentifiers, identifier280),
+		Name:       each(v.Name, name280),
+		Gender:     gender(code280(v.AdministrativeSex)),
+		BirthDate:  date(v.DateTimeOfBirth),
+		Address:    each(v.Address, address280),
+	}
+	if rel := withTable(cwe280(v.Relationship), "0063"); rel != nil {
+		r.Relationship = append(r.Relationship, *rel)
+	}
+	r.Telecom = append(telecom280(v.PhoneNumber, "home"), telecom280(v.BusinessPhoneNumber, "work")...)
+	return r
+}
+
+func allergy280(v *h280.AL1) *AllergyIntolerance {
+	r := &AllergyIntolerance{
+		ClinicalStatus: allergyActive(),
+		Code:           cwe280(&v.AllergenCodeMnemonicDescription),
+		OnsetDateTime:  dateText(v.IdentificationDate),
+		Reaction:       reactions(v.AllergyReactionCode),
+	}
+	if v.AllergenTypeCode != nil {
+		r.Category = allergyCategory(v.AllergenTypeCode.Identifier)
+	}
+	if v.AllergySeverityCode != nil {
+		r.Criticality = allergyCriticality(v.AllergySeverityCode.Identifier)
+	}
+	return r
+}
+
+func report280(v *h280.OBR) *DiagnosticReport {
+	r := &DiagnosticReport{
+		Status:            reportStatus(v.ResultStatus),
+		EffectiveDateTime: dateTime(v.ObservationDateTime),
+	}
+	if code := cwe280(&v.UniversalServiceIdentifier); code != nil {
+		r.Code = *code
+	}
+	if !v.ResultsRptStatusChngDateTime.IsZero() {
+		r.Issued = v.ResultsRptStatusChngDateTime.Format(time.RFC3339)
+	}
+	if ei := v.PlacerOrderNumber; ei != nil {
+		r.orderIdentifier("PLAC", ei.EntityIdentifier, authority{ei.NamespaceID, ei.UniversalID, ei.UniversalIDType})
+	}
+	if ei := v.FillerOrderNumber; ei != nil {
+		r.orderIdentifier("FILL", ei.EntityIdentifier, authority{ei.NamespaceID, ei.UniversalID, ei.UniversalIDType})
+	}
+	return r
+}
+
+func observation280(v *h280.OBX) *Observation {
+	r := &Observation{
+		Status:            observationStatus(v.ObservationResultStatus),
+		EffectiveDateTime: dateTime(v.DateTimeOfTheObservation),
+	}
+	if code := cwe280(&v.ObservationIdentifier); code != nil {
+		r.Code = *code
+	}
+	if len(v.ReferencesRange) > 0 {
+		r.ReferenceRange = []ObservationReferenceRange{{Text: v.ReferencesRange}}
+	}
+	for _, flag := range v.InterpretationCodes {
+		if cc := withTable(cwe280(&flag), "0078"); cc != nil {
+			r.Interpretation = append(r.Interpretation, *cc)
+		}
+	}
+	values := make([]any, len(v.ObservationValue))
+	for i, value := range v.ObservationValue {
+		switch value := value.(type) {
+		default:
+			values[i] = value
+		case h280.CNE:
+			values[i] = cne280(&value)
+		case h280.CWE:
+			values[i] = cwe280(&value)
+		case h280.SN:
+			values[i] = structuredNumeric{value.Comparator, value.Num1, value.SeparatorSuffix, value.Num2}
+		}
+	}
+	var u *units
+	if v.Units != nil {
+		u = &units{v.Units.Identifier, v.Units.Text, v.Units.NameOfCodingSystem}
+	}
+	r.value(v.ValueType, values, u)
+	return r
+}
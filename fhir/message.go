@@ -0,0 +1,283 @@
+package fhir
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kardianos/hl7/h251"
+)
+
+// Unmapped is a FHIR element with no v2 destination.
+type Unmapped struct {
+	// Resource is the reference of the resource, such as "Patient/patient-1".
+	Resource string
+	// Path of the element, such as "Patient.communication[0]".
+	// A resource without a v2 destination is reported by its resource type.
+	Path string
+}
+
+func (u Unmapped) String() string {
+	return u.Resource + " " + u.Path
+}
+
+// ToADT_A01 maps a bundle, or a single Patient resource, to an ADT_A01 message ready for hl7.Encoder.
+//
+// The first Patient maps to PID and the first Encounter of the patient to PV1.
+// RelatedPerson, AllergyIntolerance and Observation resources of the patient map to NK1, AL1 and OBX.
+// Elements and resources without a v2 destination are returned as unmapped.
+func ToADT_A01(data []byte) (h251.ADT_A01, []Unmapped, error) {
+	msg := h251.ADT_A01{}
+	m, err := parseMessage(data, mappedADT)
+	if err != nil {
+		return msg, nil, err
+	}
+	patients := m.resources("Patient")
+	if len(patients) == 0 {
+		return msg, nil, fmt.Errorf("fhir: no Patient resource")
+	}
+	patient := patients[0]
+	msg.MSH = m.header("ADT", "A01", "ADT_A01")
+	msg.EVN = &h251.EVN{EventTypeCode: "A01", RecordedDateTime: msg.MSH.DateTimeOfMessage}
+	msg.PID = toPID(m.use(patient).(*Patient))
+	msg.PV1 = &h251.PV1{PatientClass: "U"}
+	for _, i := range m.resources("Encounter") {
+		if r := m.entry[i].(*Encounter); m.belongs(r.Subject, patient) {
+			msg.PV1 = toPV1(m.use(i).(*Encounter))
+			break
+		}
+	}
+	for _, i := range m.resources("RelatedPerson") {
+		if r := m.entry[i].(*RelatedPerson); m.belongs(&r.Patient, patient) {
+			msg.NK1 = append(msg.NK1, toNK1(m.use(i).(*RelatedPerson)))
+		}
+	}
+	for _, i := range m.resources("Observation") {
+		if r := m.entry[i].(*Observation); m.belongs(r.Subject, patient) {
+			msg.OBX = append(msg.OBX, *toOBX(m.use(i).(*Observation)))
+		}
+	}
+	for _, i := range m.resources("AllergyIntolerance") {
+		if r := m.entry[i].(*AllergyIntolerance); m.belongs(&r.Patient, patient) {
+			msg.AL1 = append(msg.AL1, toAL1(m.use(i).(*AllergyIntolerance)))
+		}
+	}
+	return msg, m.unmapped(), nil
+}
+
+// ToORU_R01 maps a bundle, or a single Observation resource, to an ORU_R01 message ready for hl7.Encoder.
+//
+// Each Patient maps to a patient result with PID and the PV1 of the first Encounter of the patient.
+// Each DiagnosticReport maps to an OBR followed by the OBX and NTE of its result observations.
+// Observations not in the result of any report follow an OBR coded as the first of these observations.
+// Elements and resources without a v2 destination are returned as unmapped.
+func ToORU_R01(data []byte) (h251.ORU_R01, []Unmapped, error) {
+	msg := h251.ORU_R01{}
+	m, err := parseMessage(data, mappedORU)
+	if err != nil {
+		return msg, nil, err
+	}
+	patients := m.resources("Patient")
+	if len(patients) == 0 {
+		// Observations without a patient.
+		patients = []int{-1}
+	}
+	for _, patient := range patients {
+		result := h251.ORU_R01_PatientResult{}
+		for _, i := range m.resources("DiagnosticReport") {
+			r := m.entry[i].(*DiagnosticReport)
+			if !m.belongs(r.Subject, patient) {
+				continue
+			}
+			order := h251.ORU_R01_OrderObservation{OBR: toOBR(m.use(i).(*DiagnosticReport))}
+			for _, ref := range r.Result {
+				if j, ok := m.lookup(&ref); ok && !m.used[j] {
+					if _, ok := m.entry[j].(*Observation); ok {
+						order.Observation = append(order.Observation, observationGroup(m.use(j).(*Observation)))
+					}
+				}
+			}
+			result.OrderObservation = append(result.OrderObservation, order)
+		}
+		var order *h251.ORU_R01_OrderObservation
+		for _, i := range m.resources("Observation") {
+			r := m.entry[i].(*Observation)
+			if m.used[i] || !m.belongs(r.Subject, patient) {
+				continue
+			}
+			if order == nil {
+				order = &h251.ORU_R01_OrderObservation{OBR: &h251.OBR{UniversalServiceIdentifier: toCEValue(&r.Code)}}
+			}
+			order.Observation = append(order.Observation, observationGroup(m.use(i).(*Observation)))
+		}
+		if order != nil {
+			result.OrderObservation = append(result.OrderObservation, *order)
+		}
+		if len(result.OrderObservation) == 0 {
+			continue
+		}
+		if patient >= 0 {
+			result.Patient = &h251.ORU_R01_Patient{PID: toPID(m.use(patient).(*Patient))}
+			for _, i := range m.resources("Encounter") {
+				if r := m.entry[i].(*Encounter); m.belongs(r.Subject, patient) {
+					result.Patient.Visit = &h251.ORU_R01_Visit{PV1: toPV1(m.use(i).(*Encounter))}
+					break
+				}
+			}
+		}
+		msg.PatientResult = append(msg.PatientResult, result)
+	}
+	if len(msg.PatientResult) == 0 {
+		return msg, nil, fmt.Errorf("fhir: no DiagnosticReport or Observation resource")
+	}
+	msg.MSH = m.header("ORU", "R01", "ORU_R01")
+	return msg, m.unmapped(), nil
+}
+
+func observationGroup(r *Observation) h251.ORU_R01_Observation {
+	return h251.ORU_R01_Observation{OBX: toOBX(r), NTE: toNTE(r.Note)}
+}
+
+// message is a bundle being mapped to a v2 message.
+type message struct {
+	bundle  *Bundle
+	raw     json.RawMessage   // Bundle JSON, or nil for a single resource.
+	entry   []Resource        // Decoded resource of each entry.
+	json    []json.RawMessage // Resource JSON of each entry.
+	used    []bool            // Entry is mapped to a segment.
+	keys    map[string]int    // Entry index by reference, such as "Patient/patient-1" or a full URL.
+	mapping map[string]elementSet
+}
+
+// parseMessage decodes a bundle or a single resource.
+func parseMessage(data []byte, mapping map[string]elementSet) (*message, error) {
+	var head struct {
+		ResourceType string `json:"resourceType"`
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return nil, fmt.Errorf("fhir: %w", err)
+	}
+	m := &message{
+		bundle:  &Bundle{},
+		keys:    map[string]int{},
+		mapping: mapping,
+	}
+	type entry struct {
+		FullURL  string          `json:"fullUrl"`
+		Resource json.RawMessage `json:"resource"`
+	}
+	var list []entry
+	if head.ResourceType == "Bundle" {
+		var b struct {
+			Identifier *Identifier `json:"identifier"`
+			Timestamp  string      `json:"timestamp"`
+			Entry      []entry     `json:"entry"`
+		}
+		if err := json.Unmarshal(data, &b); err != nil {
+			return nil, fmt.Errorf("fhir: Bundle: %w", err)
+		}
+		m.bundle.Identifier, m.bundle.Timestamp = b.Identifier, b.Timestamp
+		m.raw = data
+		list = b.Entry
+	} else {
+		list = []entry{{Resource: data}}
+	}
+	for i, e := range list {
+		r, err := decodeResource(e.Resource)
+		if err != nil {
+			return nil, err
+		}
+		m.bundle.Entry = append(m.bundle.Entry, BundleEntry{FullURL: e.FullURL, Resource: r})
+		m.entry = append(m.entry, r)
+		m.json = append(m.json, e.Resource)
+		m.used = append(m.used, false)
+		if len(e.FullURL) > 0 {
+			m.keys[e.FullURL] = i
+		}
+		m.keys[r.Reference().Reference] = i
+	}
+	return m, nil
+}
+
+// resources returns the entry index of each resource of a type.
+func (m *message) resources(resourceType string) []int {
+	var list []int
+	for i, r := range m.entry {
+		if resourceTypeOf(r) == resourceType {
+			list = append(list, i)
+		}
+	}
+	return list
+}
+
+// use marks an entry as mapped and returns its resource.
+func (m *message) use(i int) Resource {
+	m.used[i] = true
+	return m.entry[i]
+}
+
+func (m *message) lookup(r *Reference) (int, bool) {
+	if r == nil || len(r.Reference) == 0 {
+		return 0, false
+	}
+	i, ok := m.keys[r.Reference]
+	return i, ok
+}
+
+// belongs reports if a subject refers to the patient entry.
+// A resource without a subject belongs to every patient.
+func (m *message) belongs(subject *Reference, patient int) bool {
+	if patient < 0 || subject == nil || len(subject.Reference) == 0 {
+		return true
+	}
+	i, ok := m.lookup(subject)
+	return ok && i == patient
+}
+
+// header returns the MSH with the bundle identifier as the control ID and the bundle timestamp as the message time.
+func (m *message) header(code, event, structure string) *h251.MSH {
+	t := parseDateTime(m.bundle.Timestamp)
+	if t.IsZero() {
+		t = time.Now()
+	}
+	v := &h251.MSH{
+		FieldSeparator:     "|",
+		EncodingCharacters: `^~\&`,
+		DateTimeOfMessage:  t,
+		MessageType:        h251.MSG{MessageCode: code, TriggerEvent: event, MessageStructure: structure},
+		ProcessingID:       h251.PT{ProcessingID: "P"},
+		VersionID:          h251.VID{VersionID: "2.5.1"},
+	}
+	if id := m.bundle.Identifier; id != nil {
+		v.MessageControlID = id.Value
+	}
+	if len(v.MessageControlID) == 0 {
+		v.MessageControlID = t.Format("20060102150405")
+	}
+	return v
+}
+
+// unmapped returns the elements of mapped resources without a v2 destination,
+// and each resource not mapped to a segment.
+func (m *message) unmapped() []Unmapped {
+	var list []Unmapped
+	if m.raw != nil {
+		list = walkUnmapped(list, "Bundle", m.raw, mappedBundle)
+	}
+	for i, r := range m.entry {
+		rt := resourceTypeOf(r)
+		if !m.used[i] {
+			list = append(list, Unmapped{Resource: r.Reference().Reference, Path: rt})
+			continue
+		}
+		list = walkUnmapped(list, r.Reference().Reference, m.json[i], m.mapping[rt])
+	}
+	return list
+}
+
+// resourceTypeOf returns the type of a resource from its reference.
+func resourceTypeOf(r Resource) string {
+	rt, _, _ := strings.Cut(r.Reference().Reference, "/")
+	return rt
+}
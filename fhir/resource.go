@@ -0,0 +1,291 @@
+package fhir
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Resource is a FHIR resource, such as *Patient.
+type Resource interface {
+	// Reference to the resource from another resource in the same bundle.
+	Reference() *Reference
+}
+
+// Bundle of resources mapped from a single message.
+type Bundle struct {
+	ResourceType string        `json:"resourceType"`
+	Identifier   *Identifier   `json:"identifier,omitempty"`
+	Type         string        `json:"type"`
+	Timestamp    string        `json:"timestamp,omitempty"`
+	Entry        []BundleEntry `json:"entry,omitempty"`
+}
+
+// BundleEntry contains a single resource.
+type BundleEntry struct {
+	FullURL  string   `json:"fullUrl,omitempty"`
+	Resource Resource `json:"resource"`
+}
+
+// UnmarshalJSON decodes the resource by its resource type.
+func (e *BundleEntry) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		FullURL  string          `json:"fullUrl"`
+		Resource json.RawMessage `json:"resource"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r, err := decodeResource(raw.Resource)
+	if err != nil {
+		return err
+	}
+	e.FullURL = raw.FullURL
+	e.Resource = r
+	return nil
+}
+
+// OtherResource is a resource type this package does not map, kept as JSON.
+type OtherResource struct {
+	ResourceType string
+	ID           string
+	JSON         json.RawMessage
+}
+
+func (r *OtherResource) Reference() *Reference { return ref(r.ResourceType, r.ID) }
+
+func (r *OtherResource) MarshalJSON() ([]byte, error) { return r.JSON, nil }
+
+// decodeResource decodes a resource into the type named by its resource type.
+func decodeResource(data []byte) (Resource, error) {
+	var head struct {
+		ResourceType string `json:"resourceType"`
+		ID           string `json:"id"`
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return nil, err
+	}
+	var r Resource
+	switch head.ResourceType {
+	default:
+		return &OtherResource{ResourceType: head.ResourceType, ID: head.ID, JSON: data}, nil
+	case "":
+		return nil, fmt.Errorf("fhir: missing resourceType")
+	case "Patient":
+		r = &Patient{}
+	case "Encounter":
+		r = &Encounter{}
+	case "DiagnosticReport":
+		r = &DiagnosticReport{}
+	case "Observation":
+		r = &Observation{}
+	case "AllergyIntolerance":
+		r = &AllergyIntolerance{}
+	case "RelatedPerson":
+		r = &RelatedPerson{}
+	}
+	if err := json.Unmarshal(data, r); err != nil {
+		return nil, fmt.Errorf("fhir: %s: %w", head.ResourceType, err)
+	}
+	return r, nil
+}
+
+// Resources returns each resource of a given type, such as "Observation".
+func (b *Bundle) Resources(resourceType string) []Resource {
+	var list []Resource
+	for _, e := range b.Entry {
+		ref := e.Resource.Reference()
+		if rt, _, _ := strings.Cut(ref.Reference, "/"); rt == resourceType {
+			list = append(list, e.Resource)
+		}
+	}
+	return list
+}
+
+type Coding struct {
+	System  string `json:"system,omitempty"`
+	Code    string `json:"code,omitempty"`
+	Display string `json:"display,omitempty"`
+}
+
+type CodeableConcept struct {
+	Coding []Coding `json:"coding,omitempty"`
+	Text   string   `json:"text,omitempty"`
+}
+
+type Identifier struct {
+	Use      string           `json:"use,omitempty"`
+	Type     *CodeableConcept `json:"type,omitempty"`
+	System   string           `json:"system,omitempty"`
+	Value    string           `json:"value,omitempty"`
+	Assigner *Reference       `json:"assigner,omitempty"`
+}
+
+type Reference struct {
+	Reference  string      `json:"reference,omitempty"`
+	Identifier *Identifier `json:"identifier,omitempty"`
+	Display    string      `json:"display,omitempty"`
+}
+
+type HumanName struct {
+	Use    string   `json:"use,omitempty"`
+	Family string   `json:"family,omitempty"`
+	Given  []string `json:"given,omitempty"`
+	Prefix []string `json:"prefix,omitempty"`
+	Suffix []string `json:"suffix,omitempty"`
+}
+
+type Address struct {
+	Use        string   `json:"use,omitempty"`
+	Type       string   `json:"type,omitempty"`
+	Line       []string `json:"line,omitempty"`
+	City       string   `json:"city,omitempty"`
+	State      string   `json:"state,omitempty"`
+	PostalCode string   `json:"postalCode,omitempty"`
+	Country    string   `json:"country,omitempty"`
+}
+
+type ContactPoint struct {
+	System string `json:"system,omitempty"`
+	Value  string `json:"value,omitempty"`
+	Use    string `json:"use,omitempty"`
+}
+
+type Period struct {
+	Start string `json:"start,omitempty"`
+	End   string `json:"end,omitempty"`
+}
+
+type Quantity struct {
+	Value      json.Number `json:"value,omitempty"`
+	Comparator string      `json:"comparator,omitempty"`
+	Unit       string      `json:"unit,omitempty"`
+	System     string      `json:"system,omitempty"`
+	Code       string      `json:"code,omitempty"`
+}
+
+type Annotation struct {
+	Text string `json:"text"`
+}
+
+type Patient struct {
+	ResourceType         string           `json:"resourceType"`
+	ID                   string           `json:"id,omitempty"`
+	Identifier           []Identifier     `json:"identifier,omitempty"`
+	Name                 []HumanName      `json:"name,omitempty"`
+	Telecom              []ContactPoint   `json:"telecom,omitempty"`
+	Gender               string           `json:"gender,omitempty"`
+	BirthDate            string           `json:"birthDate,omitempty"`
+	DeceasedBoolean      *bool            `json:"deceasedBoolean,omitempty"`
+	DeceasedDateTime     string           `json:"deceasedDateTime,omitempty"`
+	Address              []Address        `json:"address,omitempty"`
+	MaritalStatus        *CodeableConcept `json:"maritalStatus,omitempty"`
+	MultipleBirthBoolean *bool            `json:"multipleBirthBoolean,omitempty"`
+	MultipleBirthInteger *int             `json:"multipleBirthInteger,omitempty"`
+}
+
+func (r *Patient) Reference() *Reference { return ref(r.ResourceType, r.ID) }
+
+type Encounter struct {
+	ResourceType    string                    `json:"resourceType"`
+	ID              string                    `json:"id,omitempty"`
+	Identifier      []Identifier              `json:"identifier,omitempty"`
+	Status          string                    `json:"status"`
+	Class           Coding                    `json:"class"`
+	Subject         *Reference                `json:"subject,omitempty"`
+	Participant     []EncounterParticipant    `json:"participant,omitempty"`
+	Period          *Period                   `json:"period,omitempty"`
+	Hospitalization *EncounterHospitalization `json:"hospitalization,omitempty"`
+	Location        []EncounterLocation       `json:"location,omitempty"`
+}
+
+func (r *Encounter) Reference() *Reference { return ref(r.ResourceType, r.ID) }
+
+type EncounterParticipant struct {
+	Type       []CodeableConcept `json:"type,omitempty"`
+	Individual *Reference        `json:"individual,omitempty"`
+}
+
+type EncounterHospitalization struct {
+	AdmitSource          *CodeableConcept `json:"admitSource,omitempty"`
+	DischargeDisposition *CodeableConcept `json:"dischargeDisposition,omitempty"`
+}
+
+type EncounterLocation struct {
+	Location Reference `json:"location"`
+}
+
+type DiagnosticReport struct {
+	ResourceType      string          `json:"resourceType"`
+	ID                string          `json:"id,omitempty"`
+	Identifier        []Identifier    `json:"identifier,omitempty"`
+	Status            string          `json:"status"`
+	Code              CodeableConcept `json:"code"`
+	Subject           *Reference      `json:"subject,omitempty"`
+	Encounter         *Reference      `json:"encounter,omitempty"`
+	EffectiveDateTime string          `json:"effectiveDateTime,omitempty"`
+	Issued            string          `json:"issued,omitempty"`
+	Result            []Reference     `json:"result,omitempty"`
+}
+
+func (r *DiagnosticReport) Reference() *Reference { return ref(r.ResourceType, r.ID) }
+
+type Observation struct {
+	ResourceType         string                      `json:"resourceType"`
+	ID                   string                      `json:"id,omitempty"`
+	Status               string                      `json:"status"`
+	Code                 CodeableConcept             `json:"code"`
+	Subject              *Reference                  `json:"subject,omitempty"`
+	Encounter            *Reference                  `json:"encounter,omitempty"`
+	EffectiveDateTime    string                      `json:"effectiveDateTime,omitempty"`
+	ValueQuantity        *Quantity                   `json:"valueQuantity,omitempty"`
+	ValueCodeableConcept *CodeableConcept            `json:"valueCodeableConcept,omitempty"`
+	ValueString          string                      `json:"valueString,omitempty"`
+	ValueDateTime        string                      `json:"valueDateTime,omitempty"`
+	Interpretation       []CodeableConcept           `json:"interpretation,omitempty"`
+	Note                 []Annotation                `json:"note,omitempty"`
+	ReferenceRange       []ObservationReferenceRange `json:"referenceRange,omitempty"`
+}
+
+func (r *Observation) Reference() *Reference { return ref(r.ResourceType, r.ID) }
+
+type ObservationReferenceRange struct {
+	Text string `json:"text,omitempty"`
+}
+
+type AllergyIntolerance struct {
+	ResourceType   string            `json:"resourceType"`
+	ID             string            `json:"id,omitempty"`
+	ClinicalStatus *CodeableConcept  `json:"clinicalStatus,omitempty"`
+	Category       []string          `json:"category,omitempty"`
+	Criticality    string            `json:"criticality,omitempty"`
+	Code           *CodeableConcept  `json:"code,omitempty"`
+	Patient        Reference         `json:"patient"`
+	OnsetDateTime  string            `json:"onsetDateTime,omitempty"`
+	Reaction       []AllergyReaction `json:"reaction,omitempty"`
+}
+
+func (r *AllergyIntolerance) Reference() *Reference { return ref(r.ResourceType, r.ID) }
+
+type AllergyReaction struct {
+	Manifestation []CodeableConcept `json:"manifestation"`
+}
+
+type RelatedPerson struct {
+	ResourceType string            `json:"resourceType"`
+	ID           string            `json:"id,omitempty"`
+	Identifier   []Identifier      `json:"identifier,omitempty"`
+	Patient      Reference         `json:"patient"`
+	Relationship []CodeableConcept `json:"relationship,omitempty"`
+	Name         []HumanName       `json:"name,omitempty"`
+	Telecom      []ContactPoint    `json:"telecom,omitempty"`
+	Gender       string            `json:"gender,omitempty"`
+	BirthDate    string            `json:"birthDate,omitempty"`
+	Address      []Address         `json:"address,omitempty"`
+}
+
+func (r *RelatedPerson) Reference() *Reference { return ref(r.ResourceType, r.ID) }
+
+func ref(resourceType, id string) *Reference {
+	return &Reference{Reference: resourceType + "/" + id}
+}
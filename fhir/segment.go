@@ -0,0 +1,198 @@
+package fhir
+
+import (
+	"time"
+)
+
+// The functions in this file map the parts of segments the same way for every version.
+// The functions in h251.go and h280.go read the fields of their version, which differ
+// in name and data type, and pass them here.
+
+// each returns the result of fn for each item of a list, skipping the items fn rejects.
+func each[T, R any](list []T, fn func(T) (R, bool)) []R {
+	var ret []R
+	for _, v := range list {
+		if r, ok := fn(v); ok {
+			ret = append(ret, r)
+		}
+	}
+	return ret
+}
+
+// authority is the assigning authority of an identifier, from a HD.
+type authority struct {
+	namespace, universalID, universalIDType string
+}
+
+// identifier returns the identifier of a CX, or false if the ID number is empty.
+func identifier(typeCode, value string, a *authority) (Identifier, bool) {
+	if len(value) == 0 {
+		return Identifier{}, false
+	}
+	id := Identifier{
+		Type:  tableCode("0203", typeCode),
+		Value: value,
+	}
+	if a != nil {
+		var display string
+		id.System, display = assigner(a.namespace, a.universalID, a.universalIDType)
+		if len(display) > 0 {
+			id.Assigner = &Reference{Display: display}
+		}
+	}
+	return id, true
+}
+
+// humanName returns the name of a XPN, or false if there is no family or given name.
+func humanName(typeCode, family string, given, prefix, suffix []string) (HumanName, bool) {
+	n := HumanName{
+		Use:    nameUse(typeCode),
+		Family: family,
+		Given:  nonEmpty(given...),
+		Prefix: nonEmpty(prefix...),
+		Suffix: nonEmpty(suffix...),
+	}
+	return n, len(n.Family) > 0 || len(n.Given) > 0
+}
+
+// address returns the address of a XAD, or false if it is empty.
+func address(typeCode string, line []string, city, state, postalCode, country string) (Address, bool) {
+	a := Address{
+		Line:       nonEmpty(line...),
+		City:       city,
+		State:      state,
+		PostalCode: postalCode,
+		Country:    country,
+	}
+	a.Use, a.Type = addressUse(typeCode)
+	return a, len(a.Line) > 0 || len(a.City) > 0 || len(a.State) > 0 || len(a.PostalCode) > 0 || len(a.Country) > 0
+}
+
+// telecom returns the contact point of a XTN, or false if there is no number or address.
+// The number is built from its parts if the unformatted number is empty.
+func telecom(number string, parts [4]string, useCode, equipment, email, defaultUse string) (ContactPoint, bool) {
+	if len(number) == 0 {
+		number = phoneNumber(parts[0], parts[1], parts[2], parts[3])
+	}
+	if len(number) == 0 && len(email) == 0 {
+		return ContactPoint{}, false
+	}
+	return contactPoint(number, useCode, equipment, email, defaultUse), true
+}
+
+// practitioner references a practitioner by identifier and name, without a Practitioner resource.
+func practitioner(display, id string, a *authority) (*Reference, bool) {
+	r := &Reference{Display: display}
+	if len(id) > 0 {
+		r.Identifier = &Identifier{Value: id}
+		if a != nil {
+			r.Identifier.System, _ = assigner(a.namespace, a.universalID, a.universalIDType)
+		}
+	}
+	return r, len(r.Display) > 0 || r.Identifier != nil
+}
+
+// location references a location by the display name of a PL, or nil if it is empty.
+func location(part ...string) *Reference {
+	display := joinNonEmpty(" ", part...)
+	if len(display) == 0 {
+		return nil
+	}
+	return &Reference{Display: display}
+}
+
+// deceased sets the deceased indicator of a patient, or the date and time if present.
+func (r *Patient) deceased(indicator string, at time.Time) {
+	r.DeceasedBoolean = yesNo(indicator)
+	r.DeceasedDateTime = dateTime(at)
+	if len(r.DeceasedDateTime) > 0 {
+		r.DeceasedBoolean = nil
+	}
+}
+
+// multipleBirth sets the multiple birth indicator of a patient, or the birth order if it is a number.
+func (r *Patient) multipleBirth(indicator, order string) {
+	r.MultipleBirthBoolean = yesNo(indicator)
+	if q := quantity(order); q != nil {
+		if n, err := q.Value.Int64(); err == nil {
+			i := int(n)
+			r.MultipleBirthInteger = &i
+			r.MultipleBirthBoolean = nil
+		}
+	}
+}
+
+// hospitalization sets the admit source and discharge disposition of an encounter, if either is present.
+func (r *Encounter) hospitalization(admit, disposition *CodeableConcept) {
+	if admit != nil || disposition != nil {
+		r.Hospitalization = &EncounterHospitalization{AdmitSource: admit, DischargeDisposition: disposition}
+	}
+}
+
+// period sets the status and period of an encounter from the admit and discharge times.
+func (r *Encounter) period(admit, discharge time.Time) {
+	r.Status = encounterStatus(!discharge.IsZero())
+	if !admit.IsZero() || !discharge.IsZero() {
+		r.Period = &Period{Start: dateTime(admit), End: dateTime(discharge)}
+	}
+}
+
+// orderIdentifier adds the identifier of a placer or filler order number, an EI, if present.
+func (r *DiagnosticReport) orderIdentifier(typeCode, value string, a authority) {
+	if len(value) == 0 {
+		return
+	}
+	id := Identifier{Type: tableCode("0203", typeCode), Value: value}
+	id.System, _ = assigner(a.namespace, a.universalID, a.universalIDType)
+	r.Identifier = append(r.Identifier, id)
+}
+
+// units is the OBX-6 triplet of an observation.
+type units struct {
+	code, text, system string
+}
+
+// structuredNumeric is the SN of an observation value.
+type structuredNumeric struct {
+	comparator, num1, separator, num2 string
+}
+
+// value sets the value of an observation from OBX-5, with each repetition as a string,
+// time, *CodeableConcept or structuredNumeric. Other values are skipped.
+func (r *Observation) value(valueType string, values []any, u *units) {
+	if len(values) == 0 {
+		return
+	}
+	withUnits := func(q *Quantity) *Quantity {
+		if u != nil {
+			q.units(u.code, u.text, u.system)
+		}
+		return q
+	}
+	switch value := values[0].(type) {
+	case string:
+		if valueType == "NM" {
+			if q := quantity(value); q != nil {
+				r.ValueQuantity = withUnits(q)
+				return
+			}
+		}
+		r.ValueString = value
+		for _, more := range values[1:] {
+			if s, ok := more.(string); ok {
+				r.ValueString += "\n" + s
+			}
+		}
+	case time.Time:
+		r.ValueDateTime = dateTime(value)
+	case *CodeableConcept:
+		r.ValueCodeableConcept = value
+	case structuredNumeric:
+		q := sn(value.comparator, value.num1, value.separator, value.num2)
+		if q == nil {
+			r.ValueString = joinNonEmpty("", value.comparator, value.num1, value.separator, value.num2)
+			return
+		}
+		r.ValueQuantity = withUnits(q)
+	}
+}
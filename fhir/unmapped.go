@@ -0,0 +1,187 @@
+package fhir
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// element of a resource mapped to v2.
+type element struct {
+	leaf bool // The element and all of its children are mapped.
+	max  int  // Maximum number of mapped repetitions, or zero if all are mapped.
+}
+
+// elementSet is keyed by element path without indices, such as "Patient.name.given".
+type elementSet map[string]element
+
+// newElementSet returns the set of mapped element paths. Each path may limit
+// the number of mapped repetitions of a parent, such as "Encounter.location[1].location.display".
+func newElementSet(list ...[]string) elementSet {
+	set := elementSet{}
+	for _, paths := range list {
+		for _, p := range paths {
+			var key string
+			parts := strings.Split(p, ".")
+			for i, part := range parts {
+				name, limit, _ := strings.Cut(part, "[")
+				if i > 0 {
+					key += "."
+				}
+				key += name
+				e := set[key]
+				if n, err := strconv.Atoi(strings.TrimSuffix(limit, "]")); err == nil {
+					e.max = n
+				}
+				if i == len(parts)-1 {
+					e.leaf = true
+				}
+				set[key] = e
+			}
+		}
+	}
+	return set
+}
+
+func resourceElements(resourceType string, paths ...string) []string {
+	ret := []string{resourceType + ".resourceType", resourceType + ".id"}
+	for _, p := range paths {
+		ret = append(ret, resourceType+"."+p)
+	}
+	return ret
+}
+
+// codeElements are the elements of a codeable concept mapped to a CE.
+func codeElements(path string) []string {
+	return []string{path + ".coding[2].system", path + ".coding[2].code", path + ".coding[2].display", path + ".text"}
+}
+
+func identifierElements(path string) []string {
+	return []string{path + ".type", path + ".system", path + ".value", path + ".assigner.display"}
+}
+
+func nameElements(path string) []string {
+	return []string{path + ".use", path + ".family", path + ".given", path + ".prefix", path + ".suffix"}
+}
+
+func addressElements(path string) []string {
+	return []string{path + ".use", path + ".type", path + ".line", path + ".city", path + ".state", path + ".postalCode", path + ".country"}
+}
+
+func telecomElements(path string) []string {
+	return []string{path + ".system", path + ".value", path + ".use"}
+}
+
+var (
+	mappedBundle = newElementSet(
+		resourceElements("Bundle", "type", "timestamp", "identifier.value", "entry.fullUrl", "entry.resource"),
+	)
+	patientElements = [][]string{
+		resourceElements("Patient", "gender", "birthDate", "deceasedBoolean", "deceasedDateTime", "multipleBirthBoolean", "multipleBirthInteger"),
+		identifierElements("Patient.identifier"),
+		nameElements("Patient.name"),
+		telecomElements("Patient.telecom"),
+		addressElements("Patient.address"),
+		codeElements("Patient.maritalStatus"),
+	}
+	encounterElements = [][]string{
+		resourceElements("Encounter", "status", "class", "subject", "period.start", "period.end",
+			"participant.type", "participant.individual.identifier.system", "participant.individual.identifier.value", "participant.individual.display",
+			"hospitalization.admitSource", "hospitalization.dischargeDisposition", "location[1].location.display"),
+		identifierElements("Encounter.identifier[1]"),
+	}
+	relatedPersonElements = [][]string{
+		resourceElements("RelatedPerson", "patient", "gender", "birthDate"),
+		identifierElements("RelatedPerson.identifier"),
+		codeElements("RelatedPerson.relationship[1]"),
+		nameElements("RelatedPerson.name"),
+		telecomElements("RelatedPerson.telecom"),
+		addressElements("RelatedPerson.address"),
+	}
+	allergyElements = [][]string{
+		resourceElements("AllergyIntolerance", "clinicalStatus", "category[1]", "criticality", "patient", "onsetDateTime",
+			"reaction.manifestation.text", "reaction.manifestation.coding[1].code"),
+		codeElements("AllergyIntolerance.code"),
+	}
+	reportElements = [][]string{
+		resourceElements("DiagnosticReport", "status", "subject", "encounter", "effectiveDateTime", "issued", "result.reference"),
+		identifierElements("DiagnosticReport.identifier"),
+		codeElements("DiagnosticReport.code"),
+	}
+	observationElements = [][]string{
+		resourceElements("Observation", "status", "subject", "encounter", "effectiveDateTime", "valueString", "valueDateTime",
+			"valueQuantity.value", "valueQuantity.comparator", "valueQuantity.unit", "valueQuantity.system", "valueQuantity.code",
+			"interpretation.coding[1].system", "interpretation.coding[1].code", "referenceRange[1].text"),
+		codeElements("Observation.code"),
+		codeElements("Observation.valueCodeableConcept"),
+	}
+
+	mappedADT = map[string]elementSet{
+		"Patient":            newElementSet(patientElements...),
+		"Encounter":          newElementSet(encounterElements...),
+		"RelatedPerson":      newElementSet(relatedPersonElements...),
+		"AllergyIntolerance": newElementSet(allergyElements...),
+		"Observation":        newElementSet(observationElements...),
+	}
+	mappedORU = map[string]elementSet{
+		"Patient":          newElementSet(patientElements...),
+		"Encounter":        newElementSet(encounterElements...),
+		"DiagnosticReport": newElementSet(reportElements...),
+		"Observation":      newElementSet(append(observationElements, []string{"Observation.note.text"})...),
+	}
+)
+
+// walkUnmapped appends the shallowest elements of a resource that are not in the set.
+func walkUnmapped(list []Unmapped, resource string, data json.RawMessage, set elementSet) []Unmapped {
+	var obj map[string]any
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return list
+	}
+	rt, _ := obj["resourceType"].(string)
+	w := &unmappedWalker{list: list, resource: resource, set: set}
+	w.object(obj, rt, rt)
+	return w.list
+}
+
+type unmappedWalker struct {
+	list     []Unmapped
+	resource string
+	set      elementSet
+}
+
+// object walks the members of a JSON object. The path has indices, the key does not.
+func (w *unmappedWalker) object(obj map[string]any, path, key string) {
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		p, k := path+"."+name, key+"."+name
+		e, ok := w.set[k]
+		if !ok {
+			w.list = append(w.list, Unmapped{Resource: w.resource, Path: p})
+			continue
+		}
+		w.value(obj[name], p, k, e)
+	}
+}
+
+func (w *unmappedWalker) value(v any, path, key string, e element) {
+	switch v := v.(type) {
+	case map[string]any:
+		if !e.leaf {
+			w.object(v, path, key)
+		}
+	case []any:
+		for i, item := range v {
+			p := path + "[" + strconv.Itoa(i) + "]"
+			if e.max > 0 && i >= e.max {
+				w.list = append(w.list, Unmapped{Resource: w.resource, Path: p})
+				continue
+			}
+			w.value(item, p, key, e)
+		}
+	}
+}
@@ -0,0 +1,332 @@
+package fhir
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kardianos/hl7/h251"
+)
+
+// The functions in this file build h251 segments from resources, the reverse
+// of the functions in h251.go. Set IDs are left empty for the encoder to fill.
+
+func toCE(cc *CodeableConcept) *h251.CE {
+	if cc == nil {
+		return nil
+	}
+	v := &h251.CE{Text: cc.Text}
+	for i, c := range cc.Coding {
+		switch i {
+		case 0:
+			v.Identifier, v.NameOfCodingSystem = c.Code, codingSystemName(c.System)
+			if len(c.Display) > 0 {
+				v.Text = c.Display
+			}
+		case 1:
+			v.AlternateIdentifier, v.AlternateText, v.NameOfAlternateCodingSystem = c.Code, c.Display, codingSystemName(c.System)
+		}
+	}
+	if len(v.Identifier) == 0 && len(v.Text) == 0 {
+		return nil
+	}
+	return v
+}
+
+// toCEValue returns the CE of a codeable concept, or an empty CE.
+func toCEValue(cc *CodeableConcept) h251.CE {
+	if v := toCE(cc); v != nil {
+		return *v
+	}
+	return h251.CE{}
+}
+
+// toHD returns the assigning authority of an identifier system and assigner, or nil if both are empty.
+func toHD(system string, assigner *Reference) *h251.HD {
+	hd := &h251.HD{}
+	hd.UniversalID, hd.UniversalIDType = universalID(system)
+	if assigner != nil {
+		hd.NamespaceID = assigner.Display
+	}
+	if len(hd.NamespaceID) == 0 && len(hd.UniversalID) == 0 {
+		return nil
+	}
+	return hd
+}
+
+func toCX(id Identifier) h251.CX {
+	return h251.CX{
+		IDNumber:           id.Value,
+		AssigningAuthority: toHD(id.System, id.Assigner),
+		IdentifierTypeCode: tableValue(id.Type, "0203"),
+	}
+}
+
+func toCXList(list []Identifier) []h251.CX {
+	var ret []h251.CX
+	for _, id := range list {
+		if len(id.Value) == 0 {
+			continue
+		}
+		ret = append(ret, toCX(id))
+	}
+	return ret
+}
+
+func toXPN(list []HumanName) []h251.XPN {
+	var ret []h251.XPN
+	for _, n := range list {
+		v := h251.XPN{
+			FamilyName:   n.Family,
+			Prefix:       strings.Join(n.Prefix, " "),
+			Suffix:       strings.Join(n.Suffix, " "),
+			NameTypeCode: nameUseCode(n.Use),
+		}
+		if len(n.Given) > 0 {
+			v.GivenName = n.Given[0]
+			v.SecondAndFurtherGivenNamesOrInitialsThereof = strings.Join(n.Given[1:], " ")
+		}
+		ret = append(ret, v)
+	}
+	return ret
+}
+
+func toXAD(list []Address) []h251.XAD {
+	var ret []h251.XAD
+	for _, a := range list {
+		v := h251.XAD{
+			City:            a.City,
+			StateOrProvince: a.State,
+			ZipOrPostalCode: a.PostalCode,
+			Country:         a.Country,
+			AddressType:     addressUseCode(a.Use, a.Type),
+		}
+		if len(a.Line) > 0 {
+			v.StreetAddress = &h251.SAD{StreetOrMailingAddress: a.Line[0]}
+			v.OtherDesignation = strings.Join(a.Line[1:], ", ")
+		}
+		ret = append(ret, v)
+	}
+	return ret
+}
+
+// toXTN splits contact points into home and work lists, such as PID-13 and PID-14.
+func toXTN(list []ContactPoint) (home, work []h251.XTN) {
+	for _, cp := range list {
+		if len(cp.Value) == 0 {
+			continue
+		}
+		v := h251.XTN{}
+		v.TelecommunicationUseCode, v.TelecommunicationEquipmentType = contactPointCode(cp)
+		if cp.System == "email" {
+			v.EmailAddress = cp.Value
+		} else {
+			v.TelephoneNumber = cp.Value
+		}
+		if cp.Use == "work" {
+			work = append(work, v)
+			continue
+		}
+		home = append(home, v)
+	}
+	return home, work
+}
+
+// toXCN references a practitioner by identifier and display name.
+// The display is not split into name parts and is kept as the family name.
+func toXCN(r *Reference) h251.XCN {
+	v := h251.XCN{}
+	if r == nil {
+		return v
+	}
+	if id := r.Identifier; id != nil {
+		v.IDNumber = id.Value
+		v.AssigningAuthority = toHD(id.System, id.Assigner)
+	}
+	v.FamilyName = r.Display
+	return v
+}
+
+func toPID(r *Patient) *h251.PID {
+	v := &h251.PID{
+		PatientIdentifierList:   toCXList(r.Identifier),
+		PatientName:             toXPN(r.Name),
+		DateTimeOfBirth:         parseDateTime(r.BirthDate),
+		AdministrativeSex:       genderCode(r.Gender),
+		PatientAddress:          toXAD(r.Address),
+		MaritalStatus:           toCE(r.MaritalStatus),
+		PatientDeathDateAndTime: parseDateTime(r.DeceasedDateTime),
+		PatientDeathIndicator:   yesNoCode(r.DeceasedBoolean),
+		MultipleBirthIndicator:  yesNoCode(r.MultipleBirthBoolean),
+	}
+	v.PhoneNumberHome, v.PhoneNumberBusiness = toXTN(r.Telecom)
+	if len(r.DeceasedDateTime) > 0 {
+		v.PatientDeathIndicator = "Y"
+	}
+	if r.MultipleBirthInteger != nil {
+		v.MultipleBirthIndicator = "Y"
+		v.BirthOrder = strconv.Itoa(*r.MultipleBirthInteger)
+	}
+	return v
+}
+
+func toPV1(r *Encounter) *h251.PV1 {
+	v := &h251.PV1{
+		PatientClass: encounterClassCode(r.Class),
+	}
+	if len(r.Identifier) > 0 {
+		cx := toCX(r.Identifier[0])
+		v.VisitNumber = &cx
+	}
+	if p := r.Period; p != nil {
+		v.AdmitDateTime = parseDateTime(p.Start)
+		if end := parseDateTime(p.End); !end.IsZero() {
+			v.DischargeDateTime = []time.Time{end}
+		}
+	}
+	for _, p := range r.Participant {
+		var code string
+		if len(p.Type) > 0 {
+			code = tableValue(&p.Type[0], "")
+		}
+		xcn := toXCN(p.Individual)
+		switch code {
+		case "ATND":
+			v.AttendingDoctor = append(v.AttendingDoctor, xcn)
+		case "REF":
+			v.ReferringDoctor = append(v.ReferringDoctor, xcn)
+		case "CON":
+			v.ConsultingDoctor = append(v.ConsultingDoctor, xcn)
+		case "ADM":
+			v.AdmittingDoctor = append(v.AdmittingDoctor, xcn)
+		}
+	}
+	if h := r.Hospitalization; h != nil {
+		v.AdmitSource = tableValue(h.AdmitSource, "0023")
+		v.DischargeDisposition = tableValue(h.DischargeDisposition, "0112")
+	}
+	if len(r.Location) > 0 && len(r.Location[0].Location.Display) > 0 {
+		v.AssignedPatientLocation = &h251.PL{LocationDescription: r.Location[0].Location.Display}
+	}
+	return v
+}
+
+func toNK1(r *RelatedPerson) h251.NK1 {
+	v := h251.NK1{
+		NextOfKinAssociatedPartysIdentifiers: toCXList(r.Identifier),
+		NKName:                               toXPN(r.Name),
+		AdministrativeSex:                    genderCode(r.Gender),
+		DateTimeOfBirth:                      parseDateTime(r.BirthDate),
+		Address:                              toXAD(r.Address),
+	}
+	if len(r.Relationship) > 0 {
+		v.Relationship = toCE(&r.Relationship[0])
+	}
+	v.PhoneNumber, v.BusinessPhoneNumber = toXTN(r.Telecom)
+	return v
+}
+
+func toAL1(r *AllergyIntolerance) h251.AL1 {
+	v := h251.AL1{
+		AllergenCodeMnemonicDescription: toCEValue(r.Code),
+		IdentificationDate:              parseDateTime(r.OnsetDateTime),
+	}
+	if code := allergyCategoryCode(r.Category); len(code) > 0 {
+		v.AllergenTypeCode = &h251.CE{Identifier: code}
+	}
+	if code := allergyCriticalityCode(r.Criticality); len(code) > 0 {
+		v.AllergySeverityCode = &h251.CE{Identifier: code}
+	}
+	for _, reaction := range r.Reaction {
+		for _, m := range reaction.Manifestation {
+			if text := m.Text; len(text) > 0 {
+				v.AllergyReactionCode = append(v.AllergyReactionCode, text)
+			} else if len(m.Coding) > 0 {
+				v.AllergyReactionCode = append(v.AllergyReactionCode, m.Coding[0].Code)
+			}
+		}
+	}
+	return v
+}
+
+func toOBR(r *DiagnosticReport) *h251.OBR {
+	v := &h251.OBR{
+		UniversalServiceIdentifier:   toCEValue(&r.Code),
+		ObservationDateTime:          parseDateTime(r.EffectiveDateTime),
+		ResultsRptStatusChngDateTime: parseDateTime(r.Issued),
+		ResultStatus:                 reportStatusCode(r.Status),
+	}
+	for _, id := range r.Identifier {
+		ei := &h251.EI{EntityIdentifier: id.Value}
+		if hd := toHD(id.System, id.Assigner); hd != nil {
+			ei.NamespaceID, ei.UniversalID, ei.UniversalIDType = hd.NamespaceID, hd.UniversalID, hd.UniversalIDType
+		}
+		switch tableValue(id.Type, "0203") {
+		case "PLAC":
+			v.PlacerOrderNumber = ei
+		case "FILL":
+			v.FillerOrderNumber = ei
+		}
+	}
+	return v
+}
+
+func toOBX(r *Observation) *h251.OBX {
+	v := &h251.OBX{
+		ObservationIdentifier:    toCEValue(&r.Code),
+		ObservationResultStatus:  observationStatusCode(r.Status),
+		DateTimeOfTheObservation: parseDateTime(r.EffectiveDateTime),
+	}
+	if len(r.ReferenceRange) > 0 {
+		v.ReferencesRange = r.ReferenceRange[0].Text
+	}
+	for i := range r.Interpretation {
+		if code := tableValue(&r.Interpretation[i], "0078"); len(code) > 0 {
+			v.AbnormalFlags = append(v.AbnormalFlags, code)
+		}
+	}
+	switch {
+	case r.ValueQuantity != nil:
+		q := r.ValueQuantity
+		if len(q.Comparator) > 0 {
+			v.ValueType = "SN"
+			v.ObservationValue = []h251.VARIES{h251.SN{Comparator: q.Comparator, Num1: q.Value.String()}}
+		} else {
+			v.ValueType = "NM"
+			v.ObservationValue = []h251.VARIES{q.Value.String()}
+		}
+		v.Units = toUnits(q)
+	case r.ValueCodeableConcept != nil:
+		v.ValueType = "CE"
+		v.ObservationValue = []h251.VARIES{toCEValue(r.ValueCodeableConcept)}
+	case len(r.ValueDateTime) > 0:
+		v.ValueType = "TS"
+		v.ObservationValue = []h251.VARIES{parseDateTime(r.ValueDateTime)}
+	case len(r.ValueString) > 0:
+		v.ValueType = "ST"
+		for _, line := range strings.Split(r.ValueString, "\n") {
+			v.ObservationValue = append(v.ObservationValue, line)
+		}
+	}
+	return v
+}
+
+// toUnits returns the OBX-6 units of a quantity, the reverse of Quantity.units.
+func toUnits(q *Quantity) *h251.CE {
+	switch {
+	case q.System == systemUCUM && len(q.Code) > 0:
+		return &h251.CE{Identifier: q.Code, Text: q.Unit, NameOfCodingSystem: "UCUM"}
+	case len(q.Unit) > 0:
+		return &h251.CE{Identifier: q.Unit}
+	}
+	return nil
+}
+
+// toNTE returns a NTE for each note.
+func toNTE(list []Annotation) []h251.NTE {
+	var ret []h251.NTE
+	for _, n := range list {
+		ret = append(ret, h251.NTE{Comment: strings.Split(n.Text, "\n")})
+	}
+	return ret
+}
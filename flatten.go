@@ -0,0 +1,113 @@
+package hl7
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// FlatSegment is a single segment from a flattened trigger.
+type FlatSegment struct {
+	Segment any    // Segment pointer, such as *h251.OBX.
+	Path    string // Group path of the segment, such as "ORU_R01.PatientResult[0].OrderObservation[1].OBX".
+	SetID   int    // Sequence number the encoder uses for an empty set ID.
+}
+
+// Flatten returns the segments of a trigger in wire order.
+// Each segment is a pointer, as returned from Decoder.DecodeList, so the list
+// may be filtered or changed and passed to Decoder.DecodeGroup.
+// Segments in an addressable trigger, such as a pointer to a trigger, are not copied.
+func Flatten(trigger any) []any {
+	fl := FlattenSegments(trigger)
+	list := make([]any, len(fl))
+	for i, f := range fl {
+		list[i] = f.Segment
+	}
+	return list
+}
+
+// FlattenSegments returns the segments of a trigger in wire order, along with
+// the group path and set ID of each segment.
+// The trigger is walked the same way the Encoder walks it.
+func FlattenSegments(trigger any) []FlatSegment {
+	f := &flattener{}
+	rv := reflect.ValueOf(trigger)
+	f.walk(1, "", rv)
+	return f.list
+}
+
+type flattener struct {
+	list []FlatSegment
+}
+
+func (f *flattener) walk(seq int, path string, wv reflect.Value) {
+	if !wv.IsValid() {
+		return
+	}
+	metaTag, err := typeMeta(wv.Type())
+	if err != nil {
+		return
+	}
+	if !metaTag.Present {
+		return
+	}
+	switch metaTag.Type {
+	default:
+		return
+	case structTrigger, structTriggerGroup:
+		switch wv.Kind() {
+		default:
+			return
+		case reflect.Pointer:
+			f.walk(seq, path, wv.Elem())
+		case reflect.Slice:
+			l := wv.Len()
+			for i := 0; i < l; i++ {
+				f.walk(i+1, path+"["+strconv.Itoa(i)+"]", wv.Index(i))
+			}
+		case reflect.Struct:
+			if len(path) == 0 {
+				path = metaTag.Name
+			}
+			wt := wv.Type()
+			ct := wv.NumField()
+			for i := 0; i < ct; i++ {
+				f.walk(seq, path+"."+wt.Field(i).Name, wv.Field(i))
+			}
+		}
+	case structSegment:
+		switch wv.Kind() {
+		default:
+			return
+		case reflect.Pointer:
+			if wv.IsNil() {
+				return
+			}
+			f.add(seq, path, wv)
+		case reflect.Slice:
+			l := wv.Len()
+			for i := 0; i < l; i++ {
+				f.add(i+1, path+"["+strconv.Itoa(i)+"]", wv.Index(i))
+			}
+		case reflect.Struct:
+			f.add(seq, path, wv)
+		}
+	}
+}
+
+func (f *flattener) add(seq int, path string, wv reflect.Value) {
+	var ptr reflect.Value
+	switch {
+	case wv.Kind() == reflect.Pointer:
+		ptr = wv
+	case wv.CanAddr():
+		ptr = wv.Addr()
+	default:
+		ptr = reflect.New(wv.Type())
+		ptr.Elem().Set(wv)
+	}
+	f.list = append(f.list, FlatSegment{
+		Segment: ptr.Interface(),
+		Path:    path,
+		SetID:   seq,
+	})
+}
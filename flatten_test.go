@@ -0,0 +1,94 @@
+package hl7
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestFlatten(t *testing.T) {
+	var raw = []byte(`MSH|^~\&|LAB|ORG|SYS||20250609071616||ORU^R01^ORU_R01|1|P|2.5.1
+PID|1||123||Smith^John
+OBR|1|ABC
+OBX||ST|GLU||Low
+OBX||ST|NA||High
+NTE|1||Comment
+`)
+	d := NewDecoder(v251.Registry, nil)
+	g, err := d.Decode(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	list := FlattenSegments(g)
+	want := []struct {
+		Path  string
+		SetID int
+	}{
+		{"ORU_R01.MSH", 1},
+		{"ORU_R01.PatientResult[0].Patient.PID", 1},
+		{"ORU_R01.PatientResult[0].OrderObservation[0].OBR", 1},
+		{"ORU_R01.PatientResult[0].OrderObservation[0].Observation[0].OBX", 1},
+		{"ORU_R01.PatientResult[0].OrderObservation[0].Observation[1].OBX", 2},
+		{"ORU_R01.PatientResult[0].OrderObservation[0].Observation[1].NTE[0]", 1},
+	}
+	if g, w := len(list), len(want); g != w {
+		t.Fatalf("got %d segments, want %d", g, w)
+	}
+	for i, w := range want {
+		f := list[i]
+		if f.Path != w.Path || f.SetID != w.SetID {
+			t.Errorf("segment %d: got %s set ID %d, want %s set ID %d", i, f.Path, f.SetID, w.Path, w.SetID)
+		}
+	}
+	if _, ok := list[4].Segment.(*v251.OBX); !ok {
+		t.Fatalf("expected *h251.OBX, got %T", list[4].Segment)
+	}
+}
+
+// TestFlattenRoundTrip groups, flattens, and groups again each message, which should encode the same.
+func TestFlattenRoundTrip(t *testing.T) {
+	fsDir := filepath.Join("testdata", "roundtrip")
+	dirList, err := os.ReadDir(fsDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewDecoder(v251.Registry, nil)
+	e := NewEncoder(nil)
+
+	for _, f := range dirList {
+		if f.IsDir() {
+			continue
+		}
+		name := f.Name()
+		t.Run(name, func(t *testing.T) {
+			bb, err := os.ReadFile(filepath.Join(fsDir, name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			root, err := d.Decode(bb)
+			if err != nil {
+				t.Fatal("decode", err)
+			}
+			want, err := e.Encode(root)
+			if err != nil {
+				t.Fatal("encode", err)
+			}
+			want = bytes.Clone(want)
+
+			root2, err := d.DecodeGroup(Flatten(root))
+			if err != nil {
+				t.Fatal("group", err)
+			}
+			got, err := e.Encode(root2)
+			if err != nil {
+				t.Fatal("encode flat", err)
+			}
+			if d := lineDiff(want, got); len(d) > 0 {
+				t.Fatalf("mismatch\n%s", d)
+			}
+		})
+	}
+}
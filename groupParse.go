@@ -0,0 +1,524 @@
+package hl7
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// GroupEngine selects the algorithm used to group segments into a trigger.
+type GroupEngine byte
+
+const (
+	// GroupGreedy places each segment in the nearest matching position,
+	// preferring forward positions. This is the default.
+	GroupGreedy GroupEngine = iota
+
+	// GroupParse treats the trigger as a grammar and finds the parse of the
+	// whole message that satisfies the most required segments and groups.
+	// Use when the same segment appears at several levels of a trigger.
+	GroupParse
+)
+
+func (ge GroupEngine) String() string {
+	switch ge {
+	default:
+		return ""
+	case GroupGreedy:
+		return "greedy"
+	case GroupParse:
+		return "parse"
+	}
+}
+
+// pcost is the cost of a parse, compared in field order.
+// Any parse that satisfies all required items is preferred over one that does not.
+// Then a parse that closes fewer groups, staying in the current group, is preferred.
+// Then a parse that places segments in shallower positions is preferred.
+type pcost struct {
+	skipped int // Required segments and groups skipped.
+	pops    int // Groups closed.
+	depth   int // Depth of each placed segment.
+}
+
+func (c pcost) add(o pcost) pcost {
+	return pcost{
+		skipped: c.skipped + o.skipped,
+		pops:    c.pops + o.pops,
+		depth:   c.depth + o.depth,
+	}
+}
+
+func (c pcost) less(o pcost) bool {
+	switch {
+	case c.skipped != o.skipped:
+		return c.skipped < o.skipped
+	case c.pops != o.pops:
+		return c.pops < o.pops
+	default:
+		return c.depth < o.depth
+	}
+}
+
+// gnode is a segment or group position in a trigger grammar.
+type gnode struct {
+	Index    int // Field index in the parent struct.
+	Pos      int // Position in the parent children.
+	Order    int // Position of a segment in the trigger, depth first.
+	Parent   *gnode
+	Children []*gnode
+	LinkType linkType
+	Type     reflect.Type
+	Required bool
+	Group    bool
+	Depth    int // Depth in the tree (0 = root, higher = deeper).
+}
+
+// path returns the field path from the trigger to this node.
+func (n *gnode) path() string {
+	if n.Parent == nil {
+		return n.Type.Name()
+	}
+	return n.Parent.path() + "." + n.Parent.Type.Field(n.Index).Name
+}
+
+// transition moves from one segment position to the next.
+type transition struct {
+	to   *gnode
+	keep *gnode // Deepest group to keep the current instance of. Groups below it on the path to "to" get a new instance.
+	cost pcost
+}
+
+// pstate is a possible position of a segment in the trigger, along with the
+// cheapest parse leading up to it.
+type pstate struct {
+	node *gnode
+	keep *gnode
+	cost pcost
+	line int
+	prev *pstate
+}
+
+type parser struct {
+	triggerCode string // For error reporting.
+	registry    Registry
+	trace       *GroupTrace
+	lenient     bool
+
+	root  *gnode
+	order int
+	trans map[*gnode][]transition
+}
+
+func newParser(list []any, registry Registry, opt *DecodeOption) (*parser, error) {
+	code, tp, err := triggerType(list, registry, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse a linear structure into a hierarchical structure.
+	//
+	// 1. Build a grammar from the trigger struct: each group is a sequence of
+	//    segments and groups, which may be optional, required, or repeat.
+	// 2. For each segment, find every position it may be placed in, given
+	//    every position the previous segment may have been placed in.
+	// 3. Keep the cheapest way to reach each position. Skipping a required
+	//    item is expensive, closing a group is cheap.
+	// 4. Choose the cheapest final position and walk back to build the trigger.
+
+	p := &parser{
+		triggerCode: code,
+		registry:    registry,
+		trans:       map[*gnode][]transition{},
+	}
+	if opt != nil {
+		p.lenient = opt.LenientGroup
+	}
+	if opt != nil && opt.Trace != nil {
+		p.trace = opt.Trace
+		p.trace.reset(code)
+	}
+	p.root, err = p.build(nil, 0, tp, true)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *parser) build(parent *gnode, index int, rt reflect.Type, required bool) (*gnode, error) {
+	n := &gnode{
+		Index:    index,
+		Parent:   parent,
+		Required: required,
+	}
+	switch rt.Kind() {
+	default:
+		return nil, fmt.Errorf("unknown kind: %v", rt.Kind())
+	case reflect.Struct:
+		n.LinkType = linkValue
+	case reflect.Slice:
+		n.LinkType = linkList
+		rt = rt.Elem()
+	case reflect.Pointer:
+		n.LinkType = linkOpt
+		rt = rt.Elem()
+	}
+	n.Type = rt
+	if parent != nil {
+		n.Depth = parent.Depth + 1
+	}
+
+	var currentTag tag
+	if metaField, ok := rt.FieldByName(hl7MetaName); ok {
+		var err error
+		currentTag, err = parseTag(metaField.Name, metaField.Tag.Get(tagName))
+		if err != nil {
+			return nil, err
+		}
+	}
+	n.Group = parent == nil || currentTag.Type == structTriggerGroup
+	if !n.Group {
+		n.Order = p.order
+		p.order++
+		return n, nil
+	}
+
+	ct := rt.NumField()
+	for i := 0; i < ct; i++ {
+		ft := rt.Field(i)
+		tag, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+		if err != nil {
+			return nil, err
+		}
+		if !tag.Present {
+			continue
+		}
+		if tag.Meta {
+			continue
+		}
+		child, err := p.build(n, i, ft.Type, tag.Required)
+		if err != nil {
+			return nil, err
+		}
+		child.Pos = len(n.Children)
+		n.Children = append(n.Children, child)
+	}
+	return n, nil
+}
+
+// first calls add with each segment that may start n, along with the
+// number of required items skipped to reach it.
+func first(n *gnode, skipped int, add func(to *gnode, skipped int)) {
+	if !n.Group {
+		add(n, skipped)
+		return
+	}
+	for _, c := range n.Children {
+		first(c, skipped, add)
+		if c.Required {
+			skipped++
+		}
+	}
+}
+
+// transitions returns every position the segment after from may be placed in.
+// A nil from is the start of the message.
+func (p *parser) transitions(from *gnode) []transition {
+	if list, ok := p.trans[from]; ok {
+		return list
+	}
+	var list []transition
+	add := func(keep *gnode, pop int) func(to *gnode, skipped int) {
+		return func(to *gnode, skipped int) {
+			list = append(list, transition{
+				to:   to,
+				keep: keep,
+				cost: pcost{skipped: skipped, pops: pop, depth: to.Depth},
+			})
+		}
+	}
+	if from == nil {
+		first(p.root, 0, add(p.root, 0))
+		p.trans[from] = list
+		return list
+	}
+
+	// Repeat the same segment.
+	if from.LinkType == linkList {
+		list = append(list, transition{
+			to:   from,
+			keep: from.Parent,
+			cost: pcost{depth: from.Depth},
+		})
+	}
+	skipped := 0
+	pop := 0
+	c := from
+	for g := from.Parent; g != nil; g = g.Parent {
+		// Advance within the current instance of g.
+		for _, d := range g.Children[c.Pos+1:] {
+			first(d, skipped, add(g, pop))
+			if d.Required {
+				skipped++
+			}
+		}
+		if g.Parent == nil {
+			break
+		}
+		// Close the current instance of g, then start a new instance of g.
+		pop++
+		if g.LinkType == linkList {
+			first(g, skipped, add(g.Parent, pop))
+		}
+		c = g
+	}
+	p.trans[from] = list
+	return list
+}
+
+// closeCost returns the cost of ending the message after from.
+func closeCost(from *gnode) pcost {
+	if from == nil {
+		return pcost{}
+	}
+	skipped := 0
+	c := from
+	for g := from.Parent; g != nil; g = g.Parent {
+		for _, d := range g.Children[c.Pos+1:] {
+			if d.Required {
+				skipped++
+			}
+		}
+		c = g
+	}
+	return pcost{skipped: skipped}
+}
+
+func (p *parser) process(list []any) (any, error) {
+	states := []*pstate{{}}
+	var stepStates [][]*pstate
+	var unplaced []UnplacedSegment
+
+	for i, item := range list {
+		line := i + 1
+		rt := reflect.TypeOf(item)
+		if rt.Kind() == reflect.Pointer {
+			rt = rt.Elem()
+		}
+
+		var next []*pstate
+		at := map[*gnode]*pstate{}
+		for _, st := range states {
+			for _, t := range p.transitions(st.node) {
+				if t.to.Type != rt {
+					continue
+				}
+				cost := st.cost.add(t.cost)
+				if cur, ok := at[t.to]; ok {
+					if !cost.less(cur.cost) {
+						continue
+					}
+					cur.keep, cur.cost, cur.prev = t.keep, cost, st
+					continue
+				}
+				ns := &pstate{
+					node: t.to,
+					keep: t.keep,
+					cost: cost,
+					line: line,
+					prev: st,
+				}
+				at[t.to] = ns
+				next = append(next, ns)
+			}
+		}
+		if len(next) > 0 {
+			states = next
+			if p.trace != nil {
+				stepStates = append(stepStates, next)
+			}
+			continue
+		}
+
+		// Control segments, such as the batch and file header and trailer segments,
+		// are not part of the trigger grammar and are skipped by design.
+		if _, isControl := p.registry.ControlSegment(rt.Name()); isControl {
+			p.traceSkip(line, rt, "control segment")
+			continue
+		}
+		p.traceSkip(line, rt, "no position in trigger")
+		if !p.lenient {
+			p.traceChain(chainOf(cheapest(states, false)), stepStates)
+			return nil, ErrUnexpectedSegment{
+				Trigger:    p.triggerCode,
+				LineNumber: line,
+				Segment:    item,
+			}
+		}
+		path := p.root.path()
+		if best := cheapest(states, false); best.node != nil {
+			path = best.node.Parent.path()
+		}
+		unplaced = append(unplaced, UnplacedSegment{
+			LineNumber: line,
+			Segment:    item,
+			Path:       path,
+		})
+	}
+
+	chain := chainOf(cheapest(states, true))
+	if len(chain) == 0 {
+		return nil, fmt.Errorf("missing list item, this should not happen")
+	}
+	p.traceChain(chain, stepStates)
+
+	root := reflect.New(p.root.Type).Elem()
+	frames := map[*gnode]reflect.Value{
+		p.root: root,
+	}
+	var groups []*gnode
+	for i := len(chain) - 1; i >= 0; i-- {
+		st := chain[i]
+
+		// Start new group instances from the kept group down to the segment.
+		groups = groups[:0]
+		for g := st.node.Parent; g != st.keep; g = g.Parent {
+			groups = append(groups, g)
+		}
+		for j := len(groups) - 1; j >= 0; j-- {
+			g := groups[j]
+			frames[g] = instance(frames[g.Parent], g)
+		}
+		place(frames[st.node.Parent], st.node, list[st.line-1])
+	}
+
+	if len(unplaced) > 0 {
+		return root.Interface(), ErrUnplaced{
+			Trigger:  p.triggerCode,
+			Unplaced: unplaced,
+		}
+	}
+	return root.Interface(), nil
+}
+
+// cheapest returns the state with the lowest cost, optionally including the
+// cost of ending the message at that state.
+func cheapest(states []*pstate, end bool) *pstate {
+	var best *pstate
+	var bestCost pcost
+	for _, st := range states {
+		cost := st.cost
+		if end {
+			cost = cost.add(closeCost(st.node))
+		}
+		if best == nil || cost.less(bestCost) {
+			best = st
+			bestCost = cost
+		}
+	}
+	return best
+}
+
+// chainOf returns the states leading to st, last state first.
+func chainOf(st *pstate) []*pstate {
+	var chain []*pstate
+	for ; st.node != nil; st = st.prev {
+		chain = append(chain, st)
+	}
+	return chain
+}
+
+// instance creates a new instance of group n in the parent value.
+func instance(pv reflect.Value, n *gnode) reflect.Value {
+	f := pv.Field(n.Index)
+	switch n.LinkType {
+	default:
+		return f
+	case linkOpt:
+		v := reflect.New(n.Type)
+		f.Set(v)
+		return v.Elem()
+	case linkList:
+		f.Set(reflect.Append(f, reflect.New(n.Type).Elem()))
+		return f.Index(f.Len() - 1)
+	}
+}
+
+// place sets segment n in the parent value.
+func place(pv reflect.Value, n *gnode, segment any) {
+	rv := reflect.ValueOf(segment)
+	if rv.Kind() != reflect.Pointer {
+		ptr := reflect.New(rv.Type())
+		ptr.Elem().Set(rv)
+		rv = ptr
+	}
+	f := pv.Field(n.Index)
+	switch n.LinkType {
+	case linkValue:
+		f.Set(rv.Elem())
+	case linkOpt:
+		f.Set(rv)
+	case linkList:
+		f.Set(reflect.Append(f, rv.Elem()))
+	}
+}
+
+func (p *parser) traceSkip(line int, rt reflect.Type, reason string) {
+	if p.trace == nil {
+		return
+	}
+	p.trace.Steps = append(p.trace.Steps, &GroupStep{
+		Line:    line,
+		Segment: rt.Name(),
+		Reason:  reason,
+	})
+}
+
+// traceChain records the chosen parse, with the other possible positions of
+// each segment as candidates.
+func (p *parser) traceChain(chain []*pstate, stepStates [][]*pstate) {
+	if p.trace == nil {
+		return
+	}
+	skipped := p.trace.Steps
+	p.trace.Steps = nil
+
+	var prev *gnode
+	for i := len(chain) - 1; i >= 0; i-- {
+		st := chain[i]
+		for len(skipped) > 0 && skipped[0].Line < st.line {
+			p.trace.Steps = append(p.trace.Steps, skipped[0])
+			skipped = skipped[1:]
+		}
+		step := &GroupStep{
+			Line:    st.line,
+			Segment: st.node.Type.Name(),
+			Chosen:  st.node.path(),
+			Reason:  "grammar parse",
+		}
+		if prev != nil {
+			step.Depth = prev.Depth
+		}
+		for _, cs := range stepStates[len(chain)-1-i] {
+			step.Candidates = append(step.Candidates, GroupCandidate{
+				Path:    cs.node.path(),
+				Depth:   cs.node.Depth,
+				Forward: prev == nil || cs.node.Order >= prev.Order,
+				InArray: inArray(cs.node),
+				Chosen:  cs.node == st.node,
+			})
+		}
+		p.trace.Steps = append(p.trace.Steps, step)
+		prev = st.node
+	}
+	p.trace.Steps = append(p.trace.Steps, skipped...)
+}
+
+// inArray reports if n is a list or within a list.
+func inArray(n *gnode) bool {
+	for ; n != nil; n = n.Parent {
+		if n.LinkType == linkList {
+			return true
+		}
+	}
+	return false
+}
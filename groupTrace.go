@@ -0,0 +1,152 @@
+package hl7
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// GroupTrace records each grouping decision made while placing segments into
+// a trigger. Set DecodeOption.Trace to record a trace.
+//
+//	trace := &hl7.GroupTrace{}
+//	d := hl7.NewDecoder(h251.Registry, &hl7.DecodeOption{Trace: trace})
+//	_, err := d.Decode(data)
+//	fmt.Println(trace)
+type GroupTrace struct {
+	Trigger string       // Name of the trigger.
+	Steps   []*GroupStep // One step per segment, in message order.
+}
+
+// GroupStep is the grouping decision for a single segment.
+type GroupStep struct {
+	Line       int              // Line number of the segment in the message.
+	Segment    string           // Segment type name, such as "OBX".
+	Depth      int              // Depth of the previous position in the trigger.
+	Candidates []GroupCandidate // All positions with a matching segment type.
+	Chosen     string           // Path of the chosen position, empty if none was chosen.
+	Reason     string           // Why the position was chosen, or why none was.
+	Err        error            // Error when placing the segment, if any.
+}
+
+// GroupCandidate is a single trigger position a segment could be placed in.
+type GroupCandidate struct {
+	Path     string // Path of the position, such as "ORU_R01.PatientResult.OrderObservation.OBR".
+	Depth    int    // Depth in the trigger (0 = root).
+	Forward  bool   // Position is at or after the previous position.
+	InArray  bool   // Position is within a repeating group.
+	Rejected bool   // Position is full and cannot accept another segment.
+	Chosen   bool   // Position was chosen.
+}
+
+func (t *GroupTrace) reset(trigger string) {
+	t.Trigger = trigger
+	t.Steps = t.Steps[:0]
+}
+
+// String renders the trace as a tree, indented by the depth of each chosen position.
+func (t *GroupTrace) String() string {
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "trigger %s\n", t.Trigger)
+	for _, s := range t.Steps {
+		indent := 0
+		for _, c := range s.Candidates {
+			if c.Chosen && c.Depth > 0 {
+				indent = c.Depth - 1
+			}
+		}
+		pad := strings.Repeat("  ", indent)
+		fmt.Fprintf(sb, "%sline %d %s", pad, s.Line, s.Segment)
+		if len(s.Chosen) > 0 {
+			fmt.Fprintf(sb, " => %s", s.Chosen)
+		}
+		fmt.Fprintf(sb, " (%s, from depth %d)\n", s.Reason, s.Depth)
+		for _, c := range s.Candidates {
+			var mark string
+			switch {
+			default:
+				mark = "-"
+			case c.Chosen:
+				mark = "*"
+			case c.Rejected:
+				mark = "x"
+			}
+			dir := "backward"
+			if c.Forward {
+				dir = "forward"
+			}
+			fmt.Fprintf(sb, "%s  %s %s depth=%d %s", pad, mark, dir, c.Depth, c.Path)
+			if c.InArray {
+				sb.WriteString(" in-array")
+			}
+			if c.Rejected {
+				sb.WriteString(" full")
+			}
+			sb.WriteRune('\n')
+		}
+		if s.Err != nil {
+			fmt.Fprintf(sb, "%s  error: %v\n", pad, s.Err)
+		}
+	}
+	return sb.String()
+}
+
+// traceStep starts a new step if tracing is enabled, otherwise it returns nil.
+func (w *walker) traceStep(line int, rt reflect.Type) *GroupStep {
+	if w.trace == nil {
+		return nil
+	}
+	step := &GroupStep{
+		Line:    line,
+		Segment: rt.Name(),
+	}
+	if w.last >= 0 && w.last < len(w.list) {
+		step.Depth = w.list[w.last].Depth
+	}
+	w.trace.Steps = append(w.trace.Steps, step)
+	return step
+}
+
+func (s *GroupStep) reject(si *structItem, forward bool) {
+	if s == nil {
+		return
+	}
+	s.Candidates = append(s.Candidates, GroupCandidate{
+		Path:     si.path(),
+		Depth:    si.Depth,
+		Forward:  forward,
+		InArray:  si.InArray,
+		Rejected: true,
+	})
+}
+
+func (s *GroupStep) choose(list []*candidateMatch, best *candidateMatch, reason string) {
+	if s == nil {
+		return
+	}
+	s.Reason = reason
+	s.Chosen = best.si.path()
+	for _, c := range list {
+		s.Candidates = append(s.Candidates, GroupCandidate{
+			Path:    c.si.path(),
+			Depth:   c.si.Depth,
+			Forward: c.forward,
+			InArray: c.si.InArray,
+			Chosen:  c == best,
+		})
+	}
+}
+
+func (s *GroupStep) skip(reason string) {
+	if s == nil {
+		return
+	}
+	s.Reason = reason
+}
+
+func (s *GroupStep) fail(err error) {
+	if s == nil {
+		return
+	}
+	s.Err = err
+}
@@ -0,0 +1,2739 @@
+// Code generated by "hl7fetch -pkgdir h210 -root ./genjson -version 2.1 -enum"; DO NOT EDIT.
+
+package h210
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// builder holds the state of a trigger or group builder.
+type builder struct {
+	path string // Path of the trigger or group, such as "ORU_R01.PatientResult[0]".
+	seq  int    // Set ID of single segments in the trigger or group.
+	errs []error
+}
+
+func (b *builder) errorf(format string, args ...any) {
+	b.errs = append(b.errs, fmt.Errorf("%s: %s", b.path, fmt.Sprintf(format, args...)))
+}
+
+func (b *builder) child(name string, index int) builder {
+	if index < 0 {
+		return builder{path: b.path + "." + name, seq: b.seq}
+	}
+	return builder{path: b.path + "." + name + "[" + strconv.Itoa(index) + "]", seq: index + 1}
+}
+
+// check adds the validation errors of a trigger or group: missing required items and too many repetitions.
+func (b *builder) check(name string, count, max int, required bool) {
+	switch {
+	case required && count == 0:
+		b.errorf("missing required %s", name)
+	case max > 0 && count > max:
+		b.errorf("%s repeats %d times, more than %d", name, count, max)
+	}
+}
+
+// setOnce sets a single segment, adding an error if the segment is already set.
+func setOnce[T any](b *builder, name string, field **T, v T) {
+	if *field != nil {
+		b.errorf("%s set more than once", name)
+	}
+	*field = &v
+}
+
+func builderCount(present bool) int {
+	if present {
+		return 1
+	}
+	return 0
+}
+
+// ADT_A01Builder adds the groups and segments of a ADT_A01.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A01Builder struct {
+	b builder
+	v ADT_A01
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A01Builder) MSH(v MSH) *ADT_A01Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A01Builder) EVN(v EVN) *ADT_A01Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A01Builder) PID(v PID) *ADT_A01Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NK1 sets the NK1 segment.
+func (b *ADT_A01Builder) NK1(v NK1) *ADT_A01Builder {
+	setOnce(&b.b, "NK1", &b.v.NK1, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A01Builder) PV1(v PV1) *ADT_A01Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// DG1 sets the DG1 segment.
+func (b *ADT_A01Builder) DG1(v DG1) *ADT_A01Builder {
+	setOnce(&b.b, "DG1", &b.v.DG1, v)
+	return b
+}
+
+func (b *ADT_A01Builder) build() (ADT_A01, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NK1", builderCount(v.NK1 != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("DG1", builderCount(v.DG1 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A01Builder returns a builder for ADT_A01.
+func NewADT_A01Builder() *ADT_A01Builder {
+	return &ADT_A01Builder{b: builder{path: "ADT_A01", seq: 1}}
+}
+
+// Build returns the ADT_A01 and the validation errors, if any.
+func (b *ADT_A01Builder) Build() (ADT_A01, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A02Builder adds the groups and segments of a ADT_A02.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A02Builder struct {
+	b builder
+	v ADT_A02
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A02Builder) MSH(v MSH) *ADT_A02Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A02Builder) EVN(v EVN) *ADT_A02Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A02Builder) PID(v PID) *ADT_A02Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A02Builder) PV1(v PV1) *ADT_A02Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+func (b *ADT_A02Builder) build() (ADT_A02, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	return v, bb.errs
+}
+
+// NewADT_A02Builder returns a builder for ADT_A02.
+func NewADT_A02Builder() *ADT_A02Builder {
+	return &ADT_A02Builder{b: builder{path: "ADT_A02", seq: 1}}
+}
+
+// Build returns the ADT_A02 and the validation errors, if any.
+func (b *ADT_A02Builder) Build() (ADT_A02, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A03Builder adds the groups and segments of a ADT_A03.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A03Builder struct {
+	b builder
+	v ADT_A03
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A03Builder) MSH(v MSH) *ADT_A03Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A03Builder) EVN(v EVN) *ADT_A03Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A03Builder) PID(v PID) *ADT_A03Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A03Builder) PV1(v PV1) *ADT_A03Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+func (b *ADT_A03Builder) build() (ADT_A03, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	return v, bb.errs
+}
+
+// NewADT_A03Builder returns a builder for ADT_A03.
+func NewADT_A03Builder() *ADT_A03Builder {
+	return &ADT_A03Builder{b: builder{path: "ADT_A03", seq: 1}}
+}
+
+// Build returns the ADT_A03 and the validation errors, if any.
+func (b *ADT_A03Builder) Build() (ADT_A03, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A04Builder adds the groups and segments of a ADT_A04.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A04Builder struct {
+	b builder
+	v ADT_A04
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A04Builder) MSH(v MSH) *ADT_A04Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A04Builder) EVN(v EVN) *ADT_A04Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A04Builder) PID(v PID) *ADT_A04Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NK1 sets the NK1 segment.
+func (b *ADT_A04Builder) NK1(v NK1) *ADT_A04Builder {
+	setOnce(&b.b, "NK1", &b.v.NK1, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A04Builder) PV1(v PV1) *ADT_A04Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// DG1 sets the DG1 segment.
+func (b *ADT_A04Builder) DG1(v DG1) *ADT_A04Builder {
+	setOnce(&b.b, "DG1", &b.v.DG1, v)
+	return b
+}
+
+func (b *ADT_A04Builder) build() (ADT_A04, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NK1", builderCount(v.NK1 != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("DG1", builderCount(v.DG1 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A04Builder returns a builder for ADT_A04.
+func NewADT_A04Builder() *ADT_A04Builder {
+	return &ADT_A04Builder{b: builder{path: "ADT_A04", seq: 1}}
+}
+
+// Build returns the ADT_A04 and the validation errors, if any.
+func (b *ADT_A04Builder) Build() (ADT_A04, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A05Builder adds the groups and segments of a ADT_A05.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A05Builder struct {
+	b builder
+	v ADT_A05
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A05Builder) MSH(v MSH) *ADT_A05Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A05Builder) EVN(v EVN) *ADT_A05Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A05Builder) PID(v PID) *ADT_A05Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NK1 sets the NK1 segment.
+func (b *ADT_A05Builder) NK1(v NK1) *ADT_A05Builder {
+	setOnce(&b.b, "NK1", &b.v.NK1, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A05Builder) PV1(v PV1) *ADT_A05Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// DG1 sets the DG1 segment.
+func (b *ADT_A05Builder) DG1(v DG1) *ADT_A05Builder {
+	setOnce(&b.b, "DG1", &b.v.DG1, v)
+	return b
+}
+
+func (b *ADT_A05Builder) build() (ADT_A05, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NK1", builderCount(v.NK1 != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("DG1", builderCount(v.DG1 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A05Builder returns a builder for ADT_A05.
+func NewADT_A05Builder() *ADT_A05Builder {
+	return &ADT_A05Builder{b: builder{path: "ADT_A05", seq: 1}}
+}
+
+// Build returns the ADT_A05 and the validation errors, if any.
+func (b *ADT_A05Builder) Build() (ADT_A05, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A06Builder adds the groups and segments of a ADT_A06.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A06Builder struct {
+	b builder
+	v ADT_A06
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A06Builder) MSH(v MSH) *ADT_A06Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A06Builder) EVN(v EVN) *ADT_A06Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A06Builder) PID(v PID) *ADT_A06Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A06Builder) PV1(v PV1) *ADT_A06Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+func (b *ADT_A06Builder) build() (ADT_A06, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	return v, bb.errs
+}
+
+// NewADT_A06Builder returns a builder for ADT_A06.
+func NewADT_A06Builder() *ADT_A06Builder {
+	return &ADT_A06Builder{b: builder{path: "ADT_A06", seq: 1}}
+}
+
+// Build returns the ADT_A06 and the validation errors, if any.
+func (b *ADT_A06Builder) Build() (ADT_A06, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A07Builder adds the groups and segments of a ADT_A07.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A07Builder struct {
+	b builder
+	v ADT_A07
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A07Builder) MSH(v MSH) *ADT_A07Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A07Builder) EVN(v EVN) *ADT_A07Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A07Builder) PID(v PID) *ADT_A07Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A07Builder) PV1(v PV1) *ADT_A07Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+func (b *ADT_A07Builder) build() (ADT_A07, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	return v, bb.errs
+}
+
+// NewADT_A07Builder returns a builder for ADT_A07.
+func NewADT_A07Builder() *ADT_A07Builder {
+	return &ADT_A07Builder{b: builder{path: "ADT_A07", seq: 1}}
+}
+
+// Build returns the ADT_A07 and the validation errors, if any.
+func (b *ADT_A07Builder) Build() (ADT_A07, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A08Builder adds the groups and segments of a ADT_A08.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A08Builder struct {
+	b builder
+	v ADT_A08
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A08Builder) MSH(v MSH) *ADT_A08Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A08Builder) EVN(v EVN) *ADT_A08Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A08Builder) PID(v PID) *ADT_A08Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NK1 sets the NK1 segment.
+func (b *ADT_A08Builder) NK1(v NK1) *ADT_A08Builder {
+	setOnce(&b.b, "NK1", &b.v.NK1, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A08Builder) PV1(v PV1) *ADT_A08Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// DG1 sets the DG1 segment.
+func (b *ADT_A08Builder) DG1(v DG1) *ADT_A08Builder {
+	setOnce(&b.b, "DG1", &b.v.DG1, v)
+	return b
+}
+
+func (b *ADT_A08Builder) build() (ADT_A08, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NK1", builderCount(v.NK1 != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("DG1", builderCount(v.DG1 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A08Builder returns a builder for ADT_A08.
+func NewADT_A08Builder() *ADT_A08Builder {
+	return &ADT_A08Builder{b: builder{path: "ADT_A08", seq: 1}}
+}
+
+// Build returns the ADT_A08 and the validation errors, if any.
+func (b *ADT_A08Builder) Build() (ADT_A08, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A09Builder adds the groups and segments of a ADT_A09.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A09Builder struct {
+	b builder
+	v ADT_A09
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A09Builder) MSH(v MSH) *ADT_A09Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A09Builder) EVN(v EVN) *ADT_A09Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A09Builder) PID(v PID) *ADT_A09Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A09Builder) PV1(v PV1) *ADT_A09Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// DG1 sets the DG1 segment.
+func (b *ADT_A09Builder) DG1(v DG1) *ADT_A09Builder {
+	setOnce(&b.b, "DG1", &b.v.DG1, v)
+	return b
+}
+
+func (b *ADT_A09Builder) build() (ADT_A09, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("DG1", builderCount(v.DG1 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A09Builder returns a builder for ADT_A09.
+func NewADT_A09Builder() *ADT_A09Builder {
+	return &ADT_A09Builder{b: builder{path: "ADT_A09", seq: 1}}
+}
+
+// Build returns the ADT_A09 and the validation errors, if any.
+func (b *ADT_A09Builder) Build() (ADT_A09, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A10Builder adds the groups and segments of a ADT_A10.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A10Builder struct {
+	b builder
+	v ADT_A10
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A10Builder) MSH(v MSH) *ADT_A10Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A10Builder) EVN(v EVN) *ADT_A10Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A10Builder) PID(v PID) *ADT_A10Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A10Builder) PV1(v PV1) *ADT_A10Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// DG1 sets the DG1 segment.
+func (b *ADT_A10Builder) DG1(v DG1) *ADT_A10Builder {
+	setOnce(&b.b, "DG1", &b.v.DG1, v)
+	return b
+}
+
+func (b *ADT_A10Builder) build() (ADT_A10, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("DG1", builderCount(v.DG1 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A10Builder returns a builder for ADT_A10.
+func NewADT_A10Builder() *ADT_A10Builder {
+	return &ADT_A10Builder{b: builder{path: "ADT_A10", seq: 1}}
+}
+
+// Build returns the ADT_A10 and the validation errors, if any.
+func (b *ADT_A10Builder) Build() (ADT_A10, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A11Builder adds the groups and segments of a ADT_A11.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A11Builder struct {
+	b builder
+	v ADT_A11
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A11Builder) MSH(v MSH) *ADT_A11Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A11Builder) EVN(v EVN) *ADT_A11Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A11Builder) PID(v PID) *ADT_A11Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A11Builder) PV1(v PV1) *ADT_A11Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// DG1 sets the DG1 segment.
+func (b *ADT_A11Builder) DG1(v DG1) *ADT_A11Builder {
+	setOnce(&b.b, "DG1", &b.v.DG1, v)
+	return b
+}
+
+func (b *ADT_A11Builder) build() (ADT_A11, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("DG1", builderCount(v.DG1 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A11Builder returns a builder for ADT_A11.
+func NewADT_A11Builder() *ADT_A11Builder {
+	return &ADT_A11Builder{b: builder{path: "ADT_A11", seq: 1}}
+}
+
+// Build returns the ADT_A11 and the validation errors, if any.
+func (b *ADT_A11Builder) Build() (ADT_A11, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A12Builder adds the groups and segments of a ADT_A12.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A12Builder struct {
+	b builder
+	v ADT_A12
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A12Builder) MSH(v MSH) *ADT_A12Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A12Builder) EVN(v EVN) *ADT_A12Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A12Builder) PID(v PID) *ADT_A12Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A12Builder) PV1(v PV1) *ADT_A12Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// DG1 sets the DG1 segment.
+func (b *ADT_A12Builder) DG1(v DG1) *ADT_A12Builder {
+	setOnce(&b.b, "DG1", &b.v.DG1, v)
+	return b
+}
+
+func (b *ADT_A12Builder) build() (ADT_A12, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("DG1", builderCount(v.DG1 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A12Builder returns a builder for ADT_A12.
+func NewADT_A12Builder() *ADT_A12Builder {
+	return &ADT_A12Builder{b: builder{path: "ADT_A12", seq: 1}}
+}
+
+// Build returns the ADT_A12 and the validation errors, if any.
+func (b *ADT_A12Builder) Build() (ADT_A12, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A13Builder adds the groups and segments of a ADT_A13.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A13Builder struct {
+	b builder
+	v ADT_A13
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A13Builder) MSH(v MSH) *ADT_A13Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A13Builder) EVN(v EVN) *ADT_A13Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A13Builder) PID(v PID) *ADT_A13Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A13Builder) PV1(v PV1) *ADT_A13Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// DG1 sets the DG1 segment.
+func (b *ADT_A13Builder) DG1(v DG1) *ADT_A13Builder {
+	setOnce(&b.b, "DG1", &b.v.DG1, v)
+	return b
+}
+
+func (b *ADT_A13Builder) build() (ADT_A13, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("DG1", builderCount(v.DG1 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A13Builder returns a builder for ADT_A13.
+func NewADT_A13Builder() *ADT_A13Builder {
+	return &ADT_A13Builder{b: builder{path: "ADT_A13", seq: 1}}
+}
+
+// Build returns the ADT_A13 and the validation errors, if any.
+func (b *ADT_A13Builder) Build() (ADT_A13, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A14Builder adds the groups and segments of a ADT_A14.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A14Builder struct {
+	b builder
+	v ADT_A14
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A14Builder) MSH(v MSH) *ADT_A14Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A14Builder) EVN(v EVN) *ADT_A14Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A14Builder) PID(v PID) *ADT_A14Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PD1 sets the PD1 segment.
+func (b *ADT_A14Builder) PD1(v PD1) *ADT_A14Builder {
+	setOnce(&b.b, "PD1", &b.v.PD1, v)
+	return b
+}
+
+// NK1 sets the NK1 segment.
+func (b *ADT_A14Builder) NK1(v NK1) *ADT_A14Builder {
+	setOnce(&b.b, "NK1", &b.v.NK1, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A14Builder) PV1(v PV1) *ADT_A14Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// DG1 sets the DG1 segment.
+func (b *ADT_A14Builder) DG1(v DG1) *ADT_A14Builder {
+	setOnce(&b.b, "DG1", &b.v.DG1, v)
+	return b
+}
+
+func (b *ADT_A14Builder) build() (ADT_A14, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PD1", builderCount(v.PD1 != nil), 0, true)
+	bb.check("NK1", builderCount(v.NK1 != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("DG1", builderCount(v.DG1 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A14Builder returns a builder for ADT_A14.
+func NewADT_A14Builder() *ADT_A14Builder {
+	return &ADT_A14Builder{b: builder{path: "ADT_A14", seq: 1}}
+}
+
+// Build returns the ADT_A14 and the validation errors, if any.
+func (b *ADT_A14Builder) Build() (ADT_A14, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A15Builder adds the groups and segments of a ADT_A15.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A15Builder struct {
+	b builder
+	v ADT_A15
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A15Builder) MSH(v MSH) *ADT_A15Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A15Builder) EVN(v EVN) *ADT_A15Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A15Builder) PID(v PID) *ADT_A15Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A15Builder) PV1(v PV1) *ADT_A15Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// DG1 sets the DG1 segment.
+func (b *ADT_A15Builder) DG1(v DG1) *ADT_A15Builder {
+	setOnce(&b.b, "DG1", &b.v.DG1, v)
+	return b
+}
+
+func (b *ADT_A15Builder) build() (ADT_A15, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("DG1", builderCount(v.DG1 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A15Builder returns a builder for ADT_A15.
+func NewADT_A15Builder() *ADT_A15Builder {
+	return &ADT_A15Builder{b: builder{path: "ADT_A15", seq: 1}}
+}
+
+// Build returns the ADT_A15 and the validation errors, if any.
+func (b *ADT_A15Builder) Build() (ADT_A15, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A16Builder adds the groups and segments of a ADT_A16.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A16Builder struct {
+	b builder
+	v ADT_A16
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A16Builder) MSH(v MSH) *ADT_A16Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A16Builder) EVN(v EVN) *ADT_A16Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A16Builder) PID(v PID) *ADT_A16Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A16Builder) PV1(v PV1) *ADT_A16Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// DG1 sets the DG1 segment.
+func (b *ADT_A16Builder) DG1(v DG1) *ADT_A16Builder {
+	setOnce(&b.b, "DG1", &b.v.DG1, v)
+	return b
+}
+
+func (b *ADT_A16Builder) build() (ADT_A16, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("DG1", builderCount(v.DG1 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A16Builder returns a builder for ADT_A16.
+func NewADT_A16Builder() *ADT_A16Builder {
+	return &ADT_A16Builder{b: builder{path: "ADT_A16", seq: 1}}
+}
+
+// Build returns the ADT_A16 and the validation errors, if any.
+func (b *ADT_A16Builder) Build() (ADT_A16, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A17_PatientBuilder adds the groups and segments of a ADT_A17_Patient.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A17_PatientBuilder struct {
+	b builder
+	v ADT_A17_Patient
+}
+
+// PID sets the PID segment.
+func (b *ADT_A17_PatientBuilder) PID(v PID) *ADT_A17_PatientBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A17_PatientBuilder) PV1(v PV1) *ADT_A17_PatientBuilder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+func (b *ADT_A17_PatientBuilder) build() (ADT_A17_Patient, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	return v, bb.errs
+}
+
+// ADT_A17Builder adds the groups and segments of a ADT_A17.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A17Builder struct {
+	b        builder
+	v        ADT_A17
+	gPatient []*ADT_A17_PatientBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A17Builder) MSH(v MSH) *ADT_A17Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A17Builder) EVN(v EVN) *ADT_A17Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// Patient adds a Patient group and returns the group builder.
+func (b *ADT_A17Builder) Patient() *ADT_A17_PatientBuilder {
+	g := &ADT_A17_PatientBuilder{b: b.b.child("Patient", len(b.gPatient))}
+	b.gPatient = append(b.gPatient, g)
+	return g
+}
+
+func (b *ADT_A17Builder) build() (ADT_A17, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gPatient {
+		gv, errs := g.build()
+		v.Patient = append(v.Patient, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("Patient", len(v.Patient), 0, true)
+	return v, bb.errs
+}
+
+// NewADT_A17Builder returns a builder for ADT_A17.
+func NewADT_A17Builder() *ADT_A17Builder {
+	return &ADT_A17Builder{b: builder{path: "ADT_A17", seq: 1}}
+}
+
+// Build returns the ADT_A17 and the validation errors, if any.
+func (b *ADT_A17Builder) Build() (ADT_A17, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A18Builder adds the groups and segments of a ADT_A18.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A18Builder struct {
+	b builder
+	v ADT_A18
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A18Builder) MSH(v MSH) *ADT_A18Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A18Builder) EVN(v EVN) *ADT_A18Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A18Builder) PID(v PID) *ADT_A18Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// MRG sets the MRG segment.
+func (b *ADT_A18Builder) MRG(v MRG) *ADT_A18Builder {
+	setOnce(&b.b, "MRG", &b.v.MRG, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A18Builder) PV1(v PV1) *ADT_A18Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+func (b *ADT_A18Builder) build() (ADT_A18, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("MRG", builderCount(v.MRG != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A18Builder returns a builder for ADT_A18.
+func NewADT_A18Builder() *ADT_A18Builder {
+	return &ADT_A18Builder{b: builder{path: "ADT_A18", seq: 1}}
+}
+
+// Build returns the ADT_A18 and the validation errors, if any.
+func (b *ADT_A18Builder) Build() (ADT_A18, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A20Builder adds the groups and segments of a ADT_A20.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A20Builder struct {
+	b builder
+	v ADT_A20
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A20Builder) MSH(v MSH) *ADT_A20Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A20Builder) EVN(v EVN) *ADT_A20Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// NPU sets the NPU segment.
+func (b *ADT_A20Builder) NPU(v NPU) *ADT_A20Builder {
+	setOnce(&b.b, "NPU", &b.v.NPU, v)
+	return b
+}
+
+func (b *ADT_A20Builder) build() (ADT_A20, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("NPU", builderCount(v.NPU != nil), 0, true)
+	return v, bb.errs
+}
+
+// NewADT_A20Builder returns a builder for ADT_A20.
+func NewADT_A20Builder() *ADT_A20Builder {
+	return &ADT_A20Builder{b: builder{path: "ADT_A20", seq: 1}}
+}
+
+// Build returns the ADT_A20 and the validation errors, if any.
+func (b *ADT_A20Builder) Build() (ADT_A20, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A21Builder adds the groups and segments of a ADT_A21.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A21Builder struct {
+	b builder
+	v ADT_A21
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A21Builder) MSH(v MSH) *ADT_A21Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A21Builder) EVN(v EVN) *ADT_A21Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A21Builder) PID(v PID) *ADT_A21Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A21Builder) PV1(v PV1) *ADT_A21Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+func (b *ADT_A21Builder) build() (ADT_A21, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	return v, bb.errs
+}
+
+// NewADT_A21Builder returns a builder for ADT_A21.
+func NewADT_A21Builder() *ADT_A21Builder {
+	return &ADT_A21Builder{b: builder{path: "ADT_A21", seq: 1}}
+}
+
+// Build returns the ADT_A21 and the validation errors, if any.
+func (b *ADT_A21Builder) Build() (ADT_A21, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A22Builder adds the groups and segments of a ADT_A22.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A22Builder struct {
+	b builder
+	v ADT_A22
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A22Builder) MSH(v MSH) *ADT_A22Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A22Builder) EVN(v EVN) *ADT_A22Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A22Builder) PID(v PID) *ADT_A22Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A22Builder) PV1(v PV1) *ADT_A22Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+func (b *ADT_A22Builder) build() (ADT_A22, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	return v, bb.errs
+}
+
+// NewADT_A22Builder returns a builder for ADT_A22.
+func NewADT_A22Builder() *ADT_A22Builder {
+	return &ADT_A22Builder{b: builder{path: "ADT_A22", seq: 1}}
+}
+
+// Build returns the ADT_A22 and the validation errors, if any.
+func (b *ADT_A22Builder) Build() (ADT_A22, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A23Builder adds the groups and segments of a ADT_A23.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A23Builder struct {
+	b builder
+	v ADT_A23
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A23Builder) MSH(v MSH) *ADT_A23Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A23Builder) EVN(v EVN) *ADT_A23Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A23Builder) PID(v PID) *ADT_A23Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A23Builder) PV1(v PV1) *ADT_A23Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+func (b *ADT_A23Builder) build() (ADT_A23, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	return v, bb.errs
+}
+
+// NewADT_A23Builder returns a builder for ADT_A23.
+func NewADT_A23Builder() *ADT_A23Builder {
+	return &ADT_A23Builder{b: builder{path: "ADT_A23", seq: 1}}
+}
+
+// Build returns the ADT_A23 and the validation errors, if any.
+func (b *ADT_A23Builder) Build() (ADT_A23, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A24Builder adds the groups and segments of a ADT_A24.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A24Builder struct {
+	b builder
+	v ADT_A24
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A24Builder) MSH(v MSH) *ADT_A24Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A24Builder) EVN(v EVN) *ADT_A24Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A24Builder) PID(v PID) *ADT_A24Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A24Builder) PV1(v PV1) *ADT_A24Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PID2 sets the PID segment.
+func (b *ADT_A24Builder) PID2(v PID) *ADT_A24Builder {
+	setOnce(&b.b, "PID2", &b.v.PID2, v)
+	return b
+}
+
+func (b *ADT_A24Builder) build() (ADT_A24, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PID2", builderCount(v.PID2 != nil), 0, true)
+	return v, bb.errs
+}
+
+// NewADT_A24Builder returns a builder for ADT_A24.
+func NewADT_A24Builder() *ADT_A24Builder {
+	return &ADT_A24Builder{b: builder{path: "ADT_A24", seq: 1}}
+}
+
+// Build returns the ADT_A24 and the validation errors, if any.
+func (b *ADT_A24Builder) Build() (ADT_A24, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// BAR_P01_VisitBuilder adds the groups and segments of a BAR_P01_Visit.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type BAR_P01_VisitBuilder struct {
+	b builder
+	v BAR_P01_Visit
+}
+
+// PV1 sets the PV1 segment.
+func (b *BAR_P01_VisitBuilder) PV1(v PV1) *BAR_P01_VisitBuilder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// DG1 adds a DG1 segment.
+func (b *BAR_P01_VisitBuilder) DG1(v DG1) *BAR_P01_VisitBuilder {
+	b.v.DG1 = append(b.v.DG1, v)
+	return b
+}
+
+// PR1 adds a PR1 segment.
+func (b *BAR_P01_VisitBuilder) PR1(v PR1) *BAR_P01_VisitBuilder {
+	b.v.PR1 = append(b.v.PR1, v)
+	return b
+}
+
+// GT1 adds a GT1 segment.
+func (b *BAR_P01_VisitBuilder) GT1(v GT1) *BAR_P01_VisitBuilder {
+	b.v.GT1 = append(b.v.GT1, v)
+	return b
+}
+
+// NK1 adds a NK1 segment.
+func (b *BAR_P01_VisitBuilder) NK1(v NK1) *BAR_P01_VisitBuilder {
+	b.v.NK1 = append(b.v.NK1, v)
+	return b
+}
+
+// IN1 adds a IN1 segment.
+func (b *BAR_P01_VisitBuilder) IN1(v IN1) *BAR_P01_VisitBuilder {
+	b.v.IN1 = append(b.v.IN1, v)
+	return b
+}
+
+// ACC sets the ACC segment.
+func (b *BAR_P01_VisitBuilder) ACC(v ACC) *BAR_P01_VisitBuilder {
+	setOnce(&b.b, "ACC", &b.v.ACC, v)
+	return b
+}
+
+// UB1 sets the UB1 segment.
+func (b *BAR_P01_VisitBuilder) UB1(v UB1) *BAR_P01_VisitBuilder {
+	setOnce(&b.b, "UB1", &b.v.UB1, v)
+	return b
+}
+
+func (b *BAR_P01_VisitBuilder) build() (BAR_P01_Visit, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, false)
+	bb.check("DG1", len(v.DG1), 0, false)
+	bb.check("PR1", len(v.PR1), 0, false)
+	bb.check("GT1", len(v.GT1), 0, false)
+	bb.check("NK1", len(v.NK1), 0, false)
+	bb.check("IN1", len(v.IN1), 0, false)
+	bb.check("ACC", builderCount(v.ACC != nil), 0, false)
+	bb.check("UB1", builderCount(v.UB1 != nil), 0, false)
+	return v, bb.errs
+}
+
+// BAR_P01Builder adds the groups and segments of a BAR_P01.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type BAR_P01Builder struct {
+	b      builder
+	v      BAR_P01
+	gVisit []*BAR_P01_VisitBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *BAR_P01Builder) MSH(v MSH) *BAR_P01Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *BAR_P01Builder) EVN(v EVN) *BAR_P01Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *BAR_P01Builder) PID(v PID) *BAR_P01Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// Visit adds a Visit group and returns the group builder.
+func (b *BAR_P01Builder) Visit() *BAR_P01_VisitBuilder {
+	g := &BAR_P01_VisitBuilder{b: b.b.child("Visit", len(b.gVisit))}
+	b.gVisit = append(b.gVisit, g)
+	return g
+}
+
+func (b *BAR_P01Builder) build() (BAR_P01, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gVisit {
+		gv, errs := g.build()
+		v.Visit = append(v.Visit, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("Visit", len(v.Visit), 0, true)
+	return v, bb.errs
+}
+
+// NewBAR_P01Builder returns a builder for BAR_P01.
+func NewBAR_P01Builder() *BAR_P01Builder {
+	return &BAR_P01Builder{b: builder{path: "BAR_P01", seq: 1}}
+}
+
+// Build returns the BAR_P01 and the validation errors, if any.
+func (b *BAR_P01Builder) Build() (BAR_P01, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// BAR_P02_PatientBuilder adds the groups and segments of a BAR_P02_Patient.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type BAR_P02_PatientBuilder struct {
+	b builder
+	v BAR_P02_Patient
+}
+
+// PID sets the PID segment.
+func (b *BAR_P02_PatientBuilder) PID(v PID) *BAR_P02_PatientBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *BAR_P02_PatientBuilder) PV1(v PV1) *BAR_P02_PatientBuilder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+func (b *BAR_P02_PatientBuilder) build() (BAR_P02_Patient, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, false)
+	return v, bb.errs
+}
+
+// BAR_P02Builder adds the groups and segments of a BAR_P02.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type BAR_P02Builder struct {
+	b        builder
+	v        BAR_P02
+	gPatient []*BAR_P02_PatientBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *BAR_P02Builder) MSH(v MSH) *BAR_P02Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *BAR_P02Builder) EVN(v EVN) *BAR_P02Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// Patient adds a Patient group and returns the group builder.
+func (b *BAR_P02Builder) Patient() *BAR_P02_PatientBuilder {
+	g := &BAR_P02_PatientBuilder{b: b.b.child("Patient", len(b.gPatient))}
+	b.gPatient = append(b.gPatient, g)
+	return g
+}
+
+func (b *BAR_P02Builder) build() (BAR_P02, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gPatient {
+		gv, errs := g.build()
+		v.Patient = append(v.Patient, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("Patient", len(v.Patient), 0, true)
+	return v, bb.errs
+}
+
+// NewBAR_P02Builder returns a builder for BAR_P02.
+func NewBAR_P02Builder() *BAR_P02Builder {
+	return &BAR_P02Builder{b: builder{path: "BAR_P02", seq: 1}}
+}
+
+// Build returns the BAR_P02 and the validation errors, if any.
+func (b *BAR_P02Builder) Build() (BAR_P02, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// DFT_P03Builder adds the groups and segments of a DFT_P03.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type DFT_P03Builder struct {
+	b builder
+	v DFT_P03
+}
+
+// MSH sets the MSH segment.
+func (b *DFT_P03Builder) MSH(v MSH) *DFT_P03Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *DFT_P03Builder) EVN(v EVN) *DFT_P03Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *DFT_P03Builder) PID(v PID) *DFT_P03Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *DFT_P03Builder) PV1(v PV1) *DFT_P03Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// FT1 adds a FT1 segment.
+func (b *DFT_P03Builder) FT1(v FT1) *DFT_P03Builder {
+	b.v.FT1 = append(b.v.FT1, v)
+	return b
+}
+
+func (b *DFT_P03Builder) build() (DFT_P03, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, false)
+	bb.check("FT1", len(v.FT1), 0, false)
+	return v, bb.errs
+}
+
+// NewDFT_P03Builder returns a builder for DFT_P03.
+func NewDFT_P03Builder() *DFT_P03Builder {
+	return &DFT_P03Builder{b: builder{path: "DFT_P03", seq: 1}}
+}
+
+// Build returns the DFT_P03 and the validation errors, if any.
+func (b *DFT_P03Builder) Build() (DFT_P03, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// DSR_Q03Builder adds the groups and segments of a DSR_Q03.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type DSR_Q03Builder struct {
+	b builder
+	v DSR_Q03
+}
+
+// MSH sets the MSH segment.
+func (b *DSR_Q03Builder) MSH(v MSH) *DSR_Q03Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// QRD sets the QRD segment.
+func (b *DSR_Q03Builder) QRD(v QRD) *DSR_Q03Builder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *DSR_Q03Builder) QRF(v QRF) *DSR_Q03Builder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// DSP adds a DSP segment.
+func (b *DSR_Q03Builder) DSP(v DSP) *DSR_Q03Builder {
+	b.v.DSP = append(b.v.DSP, v)
+	return b
+}
+
+// DSC sets the DSC segment.
+func (b *DSR_Q03Builder) DSC(v DSC) *DSR_Q03Builder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *DSR_Q03Builder) build() (DSR_Q03, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("DSP", len(v.DSP), 0, true)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, true)
+	return v, bb.errs
+}
+
+// NewDSR_Q03Builder returns a builder for DSR_Q03.
+func NewDSR_Q03Builder() *DSR_Q03Builder {
+	return &DSR_Q03Builder{b: builder{path: "DSR_Q03", seq: 1}}
+}
+
+// Build returns the DSR_Q03 and the validation errors, if any.
+func (b *DSR_Q03Builder) Build() (DSR_Q03, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ORM_O01_PatientBuilder adds the groups and segments of a ORM_O01_Patient.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORM_O01_PatientBuilder struct {
+	b      builder
+	v      ORM_O01_Patient
+	gOrder []*ORM_O01_OrderBuilder
+}
+
+// PID sets the PID segment.
+func (b *ORM_O01_PatientBuilder) PID(v PID) *ORM_O01_PatientBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ORM_O01_PatientBuilder) NTE(v NTE) *ORM_O01_PatientBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ORM_O01_PatientBuilder) PV1(v PV1) *ORM_O01_PatientBuilder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// Order adds a Order group and returns the group builder.
+func (b *ORM_O01_PatientBuilder) Order() *ORM_O01_OrderBuilder {
+	g := &ORM_O01_OrderBuilder{b: b.b.child("Order", len(b.gOrder))}
+	b.gOrder = append(b.gOrder, g)
+	return g
+}
+
+func (b *ORM_O01_PatientBuilder) build() (ORM_O01_Patient, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gOrder {
+		gv, errs := g.build()
+		v.Order = append(v.Order, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, false)
+	bb.check("Order", len(v.Order), 0, true)
+	return v, bb.errs
+}
+
+// ORM_O01_OrderBuilder adds the groups and segments of a ORM_O01_Order.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORM_O01_OrderBuilder struct {
+	b            builder
+	v            ORM_O01_Order
+	gOrderdetail *ORM_O01_OrderdetailBuilder
+}
+
+// ORC sets the ORC segment.
+func (b *ORM_O01_OrderBuilder) ORC(v ORC) *ORM_O01_OrderBuilder {
+	setOnce(&b.b, "ORC", &b.v.ORC, v)
+	return b
+}
+
+// Orderdetail returns the Orderdetail group builder, adding the group the first time.
+func (b *ORM_O01_OrderBuilder) Orderdetail() *ORM_O01_OrderdetailBuilder {
+	if b.gOrderdetail == nil {
+		b.gOrderdetail = &ORM_O01_OrderdetailBuilder{b: b.b.child("Orderdetail", -1)}
+	}
+	return b.gOrderdetail
+}
+
+func (b *ORM_O01_OrderBuilder) build() (ORM_O01_Order, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gOrderdetail; g != nil {
+		gv, errs := g.build()
+		v.Orderdetail = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("ORC", builderCount(v.ORC != nil), 0, true)
+	bb.check("Orderdetail", builderCount(v.Orderdetail != nil), 0, false)
+	return v, bb.errs
+}
+
+// ORM_O01_OrderdetailBuilder adds the groups and segments of a ORM_O01_Orderdetail.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORM_O01_OrderdetailBuilder struct {
+	b builder
+	v ORM_O01_Orderdetail
+}
+
+// OBR sets the OBR segment.
+func (b *ORM_O01_OrderdetailBuilder) OBR(v OBR) *ORM_O01_OrderdetailBuilder {
+	setOnce(&b.b, "OBR", &b.v.OBR, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ORM_O01_OrderdetailBuilder) NTE(v NTE) *ORM_O01_OrderdetailBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ORM_O01_OrderdetailBuilder) OBX(v OBX) *ORM_O01_OrderdetailBuilder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+// NTE2 adds a NTE segment.
+func (b *ORM_O01_OrderdetailBuilder) NTE2(v NTE) *ORM_O01_OrderdetailBuilder {
+	b.v.NTE2 = append(b.v.NTE2, v)
+	return b
+}
+
+// BLG sets the BLG segment.
+func (b *ORM_O01_OrderdetailBuilder) BLG(v BLG) *ORM_O01_OrderdetailBuilder {
+	setOnce(&b.b, "BLG", &b.v.BLG, v)
+	return b
+}
+
+func (b *ORM_O01_OrderdetailBuilder) build() (ORM_O01_Orderdetail, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("OBR", builderCount(v.OBR != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	bb.check("NTE2", len(v.NTE2), 0, false)
+	bb.check("BLG", builderCount(v.BLG != nil), 0, false)
+	return v, bb.errs
+}
+
+// ORM_O01Builder adds the groups and segments of a ORM_O01.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORM_O01Builder struct {
+	b        builder
+	v        ORM_O01
+	gPatient *ORM_O01_PatientBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *ORM_O01Builder) MSH(v MSH) *ORM_O01Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ORM_O01Builder) NTE(v NTE) *ORM_O01Builder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Patient returns the Patient group builder, adding the group the first time.
+func (b *ORM_O01Builder) Patient() *ORM_O01_PatientBuilder {
+	if b.gPatient == nil {
+		b.gPatient = &ORM_O01_PatientBuilder{b: b.b.child("Patient", -1)}
+	}
+	return b.gPatient
+}
+
+func (b *ORM_O01Builder) build() (ORM_O01, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gPatient; g != nil {
+		gv, errs := g.build()
+		v.Patient = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Patient", builderCount(v.Patient != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewORM_O01Builder returns a builder for ORM_O01.
+func NewORM_O01Builder() *ORM_O01Builder {
+	return &ORM_O01Builder{b: builder{path: "ORM_O01", seq: 1}}
+}
+
+// Build returns the ORM_O01 and the validation errors, if any.
+func (b *ORM_O01Builder) Build() (ORM_O01, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ORR_O02_PatientBuilder adds the groups and segments of a ORR_O02_Patient.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORR_O02_PatientBuilder struct {
+	b      builder
+	v      ORR_O02_Patient
+	gOrder []*ORR_O02_OrderBuilder
+}
+
+// PID sets the PID segment.
+func (b *ORR_O02_PatientBuilder) PID(v PID) *ORR_O02_PatientBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ORR_O02_PatientBuilder) NTE(v NTE) *ORR_O02_PatientBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Order adds a Order group and returns the group builder.
+func (b *ORR_O02_PatientBuilder) Order() *ORR_O02_OrderBuilder {
+	g := &ORR_O02_OrderBuilder{b: b.b.child("Order", len(b.gOrder))}
+	b.gOrder = append(b.gOrder, g)
+	return g
+}
+
+func (b *ORR_O02_PatientBuilder) build() (ORR_O02_Patient, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gOrder {
+		gv, errs := g.build()
+		v.Order = append(v.Order, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("PID", builderCount(v.PID != nil), 0, false)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Order", len(v.Order), 0, true)
+	return v, bb.errs
+}
+
+// ORR_O02_OrderBuilder adds the groups and segments of a ORR_O02_Order.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORR_O02_OrderBuilder struct {
+	b            builder
+	v            ORR_O02_Order
+	gOrderdetail *ORR_O02_OrderdetailBuilder
+}
+
+// ORC sets the ORC segment.
+func (b *ORR_O02_OrderBuilder) ORC(v ORC) *ORR_O02_OrderBuilder {
+	setOnce(&b.b, "ORC", &b.v.ORC, v)
+	return b
+}
+
+// Orderdetail returns the Orderdetail group builder, adding the group the first time.
+func (b *ORR_O02_OrderBuilder) Orderdetail() *ORR_O02_OrderdetailBuilder {
+	if b.gOrderdetail == nil {
+		b.gOrderdetail = &ORR_O02_OrderdetailBuilder{b: b.b.child("Orderdetail", -1)}
+	}
+	return b.gOrderdetail
+}
+
+func (b *ORR_O02_OrderBuilder) build() (ORR_O02_Order, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gOrderdetail; g != nil {
+		gv, errs := g.build()
+		v.Orderdetail = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("ORC", builderCount(v.ORC != nil), 0, true)
+	bb.check("Orderdetail", builderCount(v.Orderdetail != nil), 0, false)
+	return v, bb.errs
+}
+
+// ORR_O02_OrderdetailBuilder adds the groups and segments of a ORR_O02_Orderdetail.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORR_O02_OrderdetailBuilder struct {
+	b builder
+	v ORR_O02_Orderdetail
+}
+
+// OBR sets the OBR segment.
+func (b *ORR_O02_OrderdetailBuilder) OBR(v OBR) *ORR_O02_OrderdetailBuilder {
+	setOnce(&b.b, "OBR", &b.v.OBR, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ORR_O02_OrderdetailBuilder) NTE(v NTE) *ORR_O02_OrderdetailBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+func (b *ORR_O02_OrderdetailBuilder) build() (ORR_O02_Orderdetail, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("OBR", builderCount(v.OBR != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	return v, bb.errs
+}
+
+// ORR_O02Builder adds the groups and segments of a ORR_O02.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORR_O02Builder struct {
+	b        builder
+	v        ORR_O02
+	gPatient *ORR_O02_PatientBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *ORR_O02Builder) MSH(v MSH) *ORR_O02Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MSA sets the MSA segment.
+func (b *ORR_O02Builder) MSA(v MSA) *ORR_O02Builder {
+	setOnce(&b.b, "MSA", &b.v.MSA, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ORR_O02Builder) NTE(v NTE) *ORR_O02Builder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Patient returns the Patient group builder, adding the group the first time.
+func (b *ORR_O02Builder) Patient() *ORR_O02_PatientBuilder {
+	if b.gPatient == nil {
+		b.gPatient = &ORR_O02_PatientBuilder{b: b.b.child("Patient", -1)}
+	}
+	return b.gPatient
+}
+
+func (b *ORR_O02Builder) build() (ORR_O02, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gPatient; g != nil {
+		gv, errs := g.build()
+		v.Patient = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MSA", builderCount(v.MSA != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Patient", builderCount(v.Patient != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewORR_O02Builder returns a builder for ORR_O02.
+func NewORR_O02Builder() *ORR_O02Builder {
+	return &ORR_O02Builder{b: builder{path: "ORR_O02", seq: 1}}
+}
+
+// Build returns the ORR_O02 and the validation errors, if any.
+func (b *ORR_O02Builder) Build() (ORR_O02, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ORU_R01_PatientresultBuilder adds the groups and segments of a ORU_R01_Patientresult.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORU_R01_PatientresultBuilder struct {
+	b        builder
+	v        ORU_R01_Patientresult
+	gPatient *ORU_R01_PatientBuilder
+}
+
+// Patient returns the Patient group builder, adding the group the first time.
+func (b *ORU_R01_PatientresultBuilder) Patient() *ORU_R01_PatientBuilder {
+	if b.gPatient == nil {
+		b.gPatient = &ORU_R01_PatientBuilder{b: b.b.child("Patient", -1)}
+	}
+	return b.gPatient
+}
+
+func (b *ORU_R01_PatientresultBuilder) build() (ORU_R01_Patientresult, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gPatient; g != nil {
+		gv, errs := g.build()
+		v.Patient = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("Patient", builderCount(v.Patient != nil), 0, false)
+	return v, bb.errs
+}
+
+// ORU_R01_PatientBuilder adds the groups and segments of a ORU_R01_Patient.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORU_R01_PatientBuilder struct {
+	b                 builder
+	v                 ORU_R01_Patient
+	gOrderobservation []*ORU_R01_OrderobservationBuilder
+}
+
+// PID sets the PID segment.
+func (b *ORU_R01_PatientBuilder) PID(v PID) *ORU_R01_PatientBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ORU_R01_PatientBuilder) NTE(v NTE) *ORU_R01_PatientBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ORU_R01_PatientBuilder) PV1(v PV1) *ORU_R01_PatientBuilder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// Orderobservation adds a Orderobservation group and returns the group builder.
+func (b *ORU_R01_PatientBuilder) Orderobservation() *ORU_R01_OrderobservationBuilder {
+	g := &ORU_R01_OrderobservationBuilder{b: b.b.child("Orderobservation", len(b.gOrderobservation))}
+	b.gOrderobservation = append(b.gOrderobservation, g)
+	return g
+}
+
+func (b *ORU_R01_PatientBuilder) build() (ORU_R01_Patient, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gOrderobservation {
+		gv, errs := g.build()
+		v.Orderobservation = append(v.Orderobservation, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, false)
+	bb.check("Orderobservation", len(v.Orderobservation), 0, true)
+	return v, bb.errs
+}
+
+// ORU_R01_OrderobservationBuilder adds the groups and segments of a ORU_R01_Orderobservation.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORU_R01_OrderobservationBuilder struct {
+	b            builder
+	v            ORU_R01_Orderobservation
+	gObservation []*ORU_R01_ObservationBuilder
+}
+
+// ORC sets the ORC segment.
+func (b *ORU_R01_OrderobservationBuilder) ORC(v ORC) *ORU_R01_OrderobservationBuilder {
+	setOnce(&b.b, "ORC", &b.v.ORC, v)
+	return b
+}
+
+// OBR sets the OBR segment.
+func (b *ORU_R01_OrderobservationBuilder) OBR(v OBR) *ORU_R01_OrderobservationBuilder {
+	setOnce(&b.b, "OBR", &b.v.OBR, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ORU_R01_OrderobservationBuilder) NTE(v NTE) *ORU_R01_OrderobservationBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Observation adds a Observation group and returns the group builder.
+func (b *ORU_R01_OrderobservationBuilder) Observation() *ORU_R01_ObservationBuilder {
+	g := &ORU_R01_ObservationBuilder{b: b.b.child("Observation", len(b.gObservation))}
+	b.gObservation = append(b.gObservation, g)
+	return g
+}
+
+func (b *ORU_R01_OrderobservationBuilder) build() (ORU_R01_Orderobservation, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gObservation {
+		gv, errs := g.build()
+		v.Observation = append(v.Observation, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("ORC", builderCount(v.ORC != nil), 0, false)
+	bb.check("OBR", builderCount(v.OBR != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Observation", len(v.Observation), 0, true)
+	return v, bb.errs
+}
+
+// ORU_R01_ObservationBuilder adds the groups and segments of a ORU_R01_Observation.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORU_R01_ObservationBuilder struct {
+	b builder
+	v ORU_R01_Observation
+}
+
+// OBX sets the OBX segment.
+func (b *ORU_R01_ObservationBuilder) OBX(v OBX) *ORU_R01_ObservationBuilder {
+	setOnce(&b.b, "OBX", &b.v.OBX, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ORU_R01_ObservationBuilder) NTE(v NTE) *ORU_R01_ObservationBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// DSC sets the DSC segment.
+func (b *ORU_R01_ObservationBuilder) DSC(v DSC) *ORU_R01_ObservationBuilder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *ORU_R01_ObservationBuilder) build() (ORU_R01_Observation, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("OBX", builderCount(v.OBX != nil), 0, false)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// ORU_R01Builder adds the groups and segments of a ORU_R01.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORU_R01Builder struct {
+	b              builder
+	v              ORU_R01
+	gPatientresult []*ORU_R01_PatientresultBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *ORU_R01Builder) MSH(v MSH) *ORU_R01Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// Patientresult adds a Patientresult group and returns the group builder.
+func (b *ORU_R01Builder) Patientresult() *ORU_R01_PatientresultBuilder {
+	g := &ORU_R01_PatientresultBuilder{b: b.b.child("Patientresult", len(b.gPatientresult))}
+	b.gPatientresult = append(b.gPatientresult, g)
+	return g
+}
+
+func (b *ORU_R01Builder) build() (ORU_R01, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gPatientresult {
+		gv, errs := g.build()
+		v.Patientresult = append(v.Patientresult, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("Patientresult", len(v.Patientresult), 0, true)
+	return v, bb.errs
+}
+
+// NewORU_R01Builder returns a builder for ORU_R01.
+func NewORU_R01Builder() *ORU_R01Builder {
+	return &ORU_R01Builder{b: builder{path: "ORU_R01", seq: 1}}
+}
+
+// Build returns the ORU_R01 and the validation errors, if any.
+func (b *ORU_R01Builder) Build() (ORU_R01, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ORU_R03_PatientresultBuilder adds the groups and segments of a ORU_R03_Patientresult.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORU_R03_PatientresultBuilder struct {
+	b        builder
+	v        ORU_R03_Patientresult
+	gPatient *ORU_R03_PatientBuilder
+}
+
+// Patient returns the Patient group builder, adding the group the first time.
+func (b *ORU_R03_PatientresultBuilder) Patient() *ORU_R03_PatientBuilder {
+	if b.gPatient == nil {
+		b.gPatient = &ORU_R03_PatientBuilder{b: b.b.child("Patient", -1)}
+	}
+	return b.gPatient
+}
+
+func (b *ORU_R03_PatientresultBuilder) build() (ORU_R03_Patientresult, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gPatient; g != nil {
+		gv, errs := g.build()
+		v.Patient = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("Patient", builderCount(v.Patient != nil), 0, false)
+	return v, bb.errs
+}
+
+// ORU_R03_PatientBuilder adds the groups and segments of a ORU_R03_Patient.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORU_R03_PatientBuilder struct {
+	b                 builder
+	v                 ORU_R03_Patient
+	gOrderobservation []*ORU_R03_OrderobservationBuilder
+}
+
+// PID sets the PID segment.
+func (b *ORU_R03_PatientBuilder) PID(v PID) *ORU_R03_PatientBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ORU_R03_PatientBuilder) NTE(v NTE) *ORU_R03_PatientBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ORU_R03_PatientBuilder) PV1(v PV1) *ORU_R03_PatientBuilder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// Orderobservation adds a Orderobservation group and returns the group builder.
+func (b *ORU_R03_PatientBuilder) Orderobservation() *ORU_R03_OrderobservationBuilder {
+	g := &ORU_R03_OrderobservationBuilder{b: b.b.child("Orderobservation", len(b.gOrderobservation))}
+	b.gOrderobservation = append(b.gOrderobservation, g)
+	return g
+}
+
+func (b *ORU_R03_PatientBuilder) build() (ORU_R03_Patient, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gOrderobservation {
+		gv, errs := g.build()
+		v.Orderobservation = append(v.Orderobservation, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, false)
+	bb.check("Orderobservation", len(v.Orderobservation), 0, true)
+	return v, bb.errs
+}
+
+// ORU_R03_OrderobservationBuilder adds the groups and segments of a ORU_R03_Orderobservation.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORU_R03_OrderobservationBuilder struct {
+	b            builder
+	v            ORU_R03_Orderobservation
+	gObservation []*ORU_R03_ObservationBuilder
+}
+
+// ORC sets the ORC segment.
+func (b *ORU_R03_OrderobservationBuilder) ORC(v ORC) *ORU_R03_OrderobservationBuilder {
+	setOnce(&b.b, "ORC", &b.v.ORC, v)
+	return b
+}
+
+// OBR sets the OBR segment.
+func (b *ORU_R03_OrderobservationBuilder) OBR(v OBR) *ORU_R03_OrderobservationBuilder {
+	setOnce(&b.b, "OBR", &b.v.OBR, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ORU_R03_OrderobservationBuilder) NTE(v NTE) *ORU_R03_OrderobservationBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Observation adds a Observation group and returns the group builder.
+func (b *ORU_R03_OrderobservationBuilder) Observation() *ORU_R03_ObservationBuilder {
+	g := &ORU_R03_ObservationBuilder{b: b.b.child("Observation", len(b.gObservation))}
+	b.gObservation = append(b.gObservation, g)
+	return g
+}
+
+func (b *ORU_R03_OrderobservationBuilder) build() (ORU_R03_Orderobservation, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gObservation {
+		gv, errs := g.build()
+		v.Observation = append(v.Observation, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("ORC", builderCount(v.ORC != nil), 0, false)
+	bb.check("OBR", builderCount(v.OBR != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Observation", len(v.Observation), 0, true)
+	return v, bb.errs
+}
+
+// ORU_R03_ObservationBuilder adds the groups and segments of a ORU_R03_Observation.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORU_R03_ObservationBuilder struct {
+	b builder
+	v ORU_R03_Observation
+}
+
+// OBX sets the OBX segment.
+func (b *ORU_R03_ObservationBuilder) OBX(v OBX) *ORU_R03_ObservationBuilder {
+	setOnce(&b.b, "OBX", &b.v.OBX, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ORU_R03_ObservationBuilder) NTE(v NTE) *ORU_R03_ObservationBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// DSC sets the DSC segment.
+func (b *ORU_R03_ObservationBuilder) DSC(v DSC) *ORU_R03_ObservationBuilder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *ORU_R03_ObservationBuilder) build() (ORU_R03_Observation, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("OBX", builderCount(v.OBX != nil), 0, false)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// ORU_R03Builder adds the groups and segments of a ORU_R03.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORU_R03Builder struct {
+	b              builder
+	v              ORU_R03
+	gPatientresult []*ORU_R03_PatientresultBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *ORU_R03Builder) MSH(v MSH) *ORU_R03Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// Patientresult adds a Patientresult group and returns the group builder.
+func (b *ORU_R03Builder) Patientresult() *ORU_R03_PatientresultBuilder {
+	g := &ORU_R03_PatientresultBuilder{b: b.b.child("Patientresult", len(b.gPatientresult))}
+	b.gPatientresult = append(b.gPatientresult, g)
+	return g
+}
+
+func (b *ORU_R03Builder) build() (ORU_R03, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gPatientresult {
+		gv, errs := g.build()
+		v.Patientresult = append(v.Patientresult, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("Patientresult", len(v.Patientresult), 0, true)
+	return v, bb.errs
+}
+
+// NewORU_R03Builder returns a builder for ORU_R03.
+func NewORU_R03Builder() *ORU_R03Builder {
+	return &ORU_R03Builder{b: builder{path: "ORU_R03", seq: 1}}
+}
+
+// Build returns the ORU_R03 and the validation errors, if any.
+func (b *ORU_R03Builder) Build() (ORU_R03, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// QRY_A19Builder adds the groups and segments of a QRY_A19.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type QRY_A19Builder struct {
+	b builder
+	v QRY_A19
+}
+
+// MSH sets the MSH segment.
+func (b *QRY_A19Builder) MSH(v MSH) *QRY_A19Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// QRD sets the QRD segment.
+func (b *QRY_A19Builder) QRD(v QRD) *QRY_A19Builder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+func (b *QRY_A19Builder) build() (QRY_A19, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	return v, bb.errs
+}
+
+// NewQRY_A19Builder returns a builder for QRY_A19.
+func NewQRY_A19Builder() *QRY_A19Builder {
+	return &QRY_A19Builder{b: builder{path: "QRY_A19", seq: 1}}
+}
+
+// Build returns the QRY_A19 and the validation errors, if any.
+func (b *QRY_A19Builder) Build() (QRY_A19, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// QRY_Q01Builder adds the groups and segments of a QRY_Q01.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type QRY_Q01Builder struct {
+	b builder
+	v QRY_Q01
+}
+
+// MSH sets the MSH segment.
+func (b *QRY_Q01Builder) MSH(v MSH) *QRY_Q01Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// QRD sets the QRD segment.
+func (b *QRY_Q01Builder) QRD(v QRD) *QRY_Q01Builder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *QRY_Q01Builder) QRF(v QRF) *QRY_Q01Builder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// DSC sets the DSC segment.
+func (b *QRY_Q01Builder) DSC(v DSC) *QRY_Q01Builder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *QRY_Q01Builder) build() (QRY_Q01, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, true)
+	return v, bb.errs
+}
+
+// NewQRY_Q01Builder returns a builder for QRY_Q01.
+func NewQRY_Q01Builder() *QRY_Q01Builder {
+	return &QRY_Q01Builder{b: builder{path: "QRY_Q01", seq: 1}}
+}
+
+// Build returns the QRY_Q01 and the validation errors, if any.
+func (b *QRY_Q01Builder) Build() (QRY_Q01, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// QRY_Q02Builder adds the groups and segments of a QRY_Q02.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type QRY_Q02Builder struct {
+	b builder
+	v QRY_Q02
+}
+
+// MSH sets the MSH segment.
+func (b *QRY_Q02Builder) MSH(v MSH) *QRY_Q02Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// QRD sets the QRD segment.
+func (b *QRY_Q02Builder) QRD(v QRD) *QRY_Q02Builder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *QRY_Q02Builder) QRF(v QRF) *QRY_Q02Builder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// DSC sets the DSC segment.
+func (b *QRY_Q02Builder) DSC(v DSC) *QRY_Q02Builder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *QRY_Q02Builder) build() (QRY_Q02, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, true)
+	return v, bb.errs
+}
+
+// NewQRY_Q02Builder returns a builder for QRY_Q02.
+func NewQRY_Q02Builder() *QRY_Q02Builder {
+	return &QRY_Q02Builder{b: builder{path: "QRY_Q02", seq: 1}}
+}
+
+// Build returns the QRY_Q02 and the validation errors, if any.
+func (b *QRY_Q02Builder) Build() (QRY_Q02, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// UDM_Q05Builder adds the groups and segments of a UDM_Q05.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type UDM_Q05Builder struct {
+	b builder
+	v UDM_Q05
+}
+
+// MSH sets the MSH segment.
+func (b *UDM_Q05Builder) MSH(v MSH) *UDM_Q05Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// URD sets the URD segment.
+func (b *UDM_Q05Builder) URD(v URD) *UDM_Q05Builder {
+	setOnce(&b.b, "URD", &b.v.URD, v)
+	return b
+}
+
+// URS sets the URS segment.
+func (b *UDM_Q05Builder) URS(v URS) *UDM_Q05Builder {
+	setOnce(&b.b, "URS", &b.v.URS, v)
+	return b
+}
+
+// DSP adds a DSP segment.
+func (b *UDM_Q05Builder) DSP(v DSP) *UDM_Q05Builder {
+	b.v.DSP = append(b.v.DSP, v)
+	return b
+}
+
+// DSC sets the DSC segment.
+func (b *UDM_Q05Builder) DSC(v DSC) *UDM_Q05Builder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *UDM_Q05Builder) build() (UDM_Q05, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("URD", builderCount(v.URD != nil), 0, true)
+	bb.check("URS", builderCount(v.URS != nil), 0, false)
+	bb.check("DSP", len(v.DSP), 0, true)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, true)
+	return v, bb.errs
+}
+
+// NewUDM_Q05Builder returns a builder for UDM_Q05.
+func NewUDM_Q05Builder() *UDM_Q05Builder {
+	return &UDM_Q05Builder{b: builder{path: "UDM_Q05", seq: 1}}
+}
+
+// Build returns the UDM_Q05 and the validation errors, if any.
+func (b *UDM_Q05Builder) Build() (UDM_Q05, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
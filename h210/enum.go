@@ -0,0 +1,1156 @@
+// Code generated by "hl7fetch -pkgdir h210 -root ./genjson -version 2.1 -enum"; DO NOT EDIT.
+
+package h210
+
+// Table0001 is a value of table 0001, SEX.
+// Fields in this table are strings; convert a field, such as Table0001(v), to use the helpers.
+type Table0001 string
+
+const (
+	Table0001Female  Table0001 = `F` // Female
+	Table0001Male    Table0001 = `M` // Male
+	Table0001Other   Table0001 = `O` // Other
+	Table0001Unknown Table0001 = `U` // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0001.
+func (v Table0001) Description() string {
+	return tableDescription(`0001`, string(v))
+}
+
+// Valid reports if the value is in table 0001.
+func (v Table0001) Valid() bool {
+	return TableValueLookup[`0001`][string(v)]
+}
+
+// Table 0001.
+func (v Table0001) Table() Table {
+	return TableLookup[`0001`]
+}
+
+// Table0002 is a value of table 0002, MARITAL STATUS.
+// Fields in this table are strings; convert a field, such as Table0002(v), to use the helpers.
+type Table0002 string
+
+const (
+	Table0002Separated Table0002 = `A` // Separated
+	Table0002Divorced  Table0002 = `D` // Divorced
+	Table0002Married   Table0002 = `M` // Married
+	Table0002Single    Table0002 = `S` // Single
+	Table0002Widowed   Table0002 = `W` // Widowed
+)
+
+// Description of the value, or empty if the value is not in table 0002.
+func (v Table0002) Description() string {
+	return tableDescription(`0002`, string(v))
+}
+
+// Valid reports if the value is in table 0002.
+func (v Table0002) Valid() bool {
+	return TableValueLookup[`0002`][string(v)]
+}
+
+// Table 0002.
+func (v Table0002) Table() Table {
+	return TableLookup[`0002`]
+}
+
+// Table0003 is a value of table 0003, EVENT TYPE CODE.
+// Fields in this table are strings; convert a field, such as Table0003(v), to use the helpers.
+type Table0003 string
+
+const (
+	Table0003AdmitAPatient                            Table0003 = `A01` // Admit a patient
+	Table0003TransferAPatient                         Table0003 = `A02` // Transfer a Patient
+	Table0003DischargeAPatient                        Table0003 = `A03` // Discharge a Patient
+	Table0003RegisterAPatient                         Table0003 = `A04` // Register a Patient
+	Table0003PreAdmitAPatient                         Table0003 = `A05` // Pre-admit a Patient
+	Table0003TransferAnOutpatientToInpatient          Table0003 = `A06` // Transfer an outpatient to inpatient
+	Table0003TransferAnInpatientToOutpatient          Table0003 = `A07` // Transfer an Inpatient to Outpatient
+	Table0003UpdatePatientInformation                 Table0003 = `A08` // Update patient information
+	Table0003PatientDeparting                         Table0003 = `A09` // Patient departing
+	Table0003PatientArriving                          Table0003 = `A10` // Patient arriving
+	Table0003CancelAdmit                              Table0003 = `A11` // Cancel admit
+	Table0003CancelTransfer                           Table0003 = `A12` // Cancel transfer
+	Table0003CancelDischarge                          Table0003 = `A13` // Cancel discharge
+	Table0003PendingAdmit                             Table0003 = `A14` // Pending admit
+	Table0003PendingTransfer                          Table0003 = `A15` // Pending transfer
+	Table0003PendingDischarge                         Table0003 = `A16` // Pending discharge
+	Table0003SwapPatients                             Table0003 = `A17` // Swap Patients
+	Table0003MergePatientInformation                  Table0003 = `A18` // Merge patient information
+	Table0003PatientQuery                             Table0003 = `A19` // Patient query
+	Table0003BedStatusUpdates                         Table0003 = `A20` // Bed status updates
+	Table0003LeaveOfAbsenceOutLeaving                 Table0003 = `A21` // Leave of Absence - Out (leaving)
+	Table0003LeaveOfAbsenceInReturning                Table0003 = `A22` // Leave of Absence - In (returning)
+	Table0003DeleteAPatientRecord                     Table0003 = `A23` // Delete a Patient Record
+	Table0003LinkPatientRecords                       Table0003 = `A24` // Link Patient Records
+	Table0003OrderMessage                             Table0003 = `O01` // Order message
+	Table0003OrderResponse                            Table0003 = `O02` // Order response
+	Table0003AddAndUpdatePatientAccount               Table0003 = `P01` // Add and update patient account
+	Table0003PurgePatientAccounts                     Table0003 = `P02` // Purge Patient Accounts
+	Table0003PostDetailFinancialTransaction           Table0003 = `P03` // Post detail financial transaction
+	Table0003GenerateBillsAndARStatements             Table0003 = `P04` // Generate bills and A/R statements
+	Table0003ImmediateAccess                          Table0003 = `Q01` // Immediate access
+	Table0003DeferredAccess                           Table0003 = `Q02` // Deferred Access
+	Table0003UnsolicitedTransmissionOfRequestedObserv Table0003 = `R01` // Unsolicited transmission of requested Observ.
+	Table0003DisplayOrientedResultsQueryUnsolUpdate   Table0003 = `R03` // Display oriented results, query/unsol. update
+)
+
+// Description of the value, or empty if the value is not in table 0003.
+func (v Table0003) Description() string {
+	return tableDescription(`0003`, string(v))
+}
+
+// Valid reports if the value is in table 0003.
+func (v Table0003) Valid() bool {
+	return TableValueLookup[`0003`][string(v)]
+}
+
+// Table 0003.
+func (v Table0003) Table() Table {
+	return TableLookup[`0003`]
+}
+
+// Table0004 is a value of table 0004, PATIENT CLASS.
+// Fields in this table are strings; convert a field, such as Table0004(v), to use the helpers.
+type Table0004 string
+
+const (
+	Table0004Emergency  Table0004 = `E` // Emergency
+	Table0004Inpatient  Table0004 = `I` // Inpatient
+	Table0004Outpatient Table0004 = `O` // Outpatient
+	Table0004Preadmit   Table0004 = `P` // Preadmit
+)
+
+// Description of the value, or empty if the value is not in table 0004.
+func (v Table0004) Description() string {
+	return tableDescription(`0004`, string(v))
+}
+
+// Valid reports if the value is in table 0004.
+func (v Table0004) Valid() bool {
+	return TableValueLookup[`0004`][string(v)]
+}
+
+// Table 0004.
+func (v Table0004) Table() Table {
+	return TableLookup[`0004`]
+}
+
+// Table0005 is a value of table 0005, ETHNIC GROUP.
+// Fields in this table are strings; convert a field, such as Table0005(v), to use the helpers.
+type Table0005 string
+
+const (
+	Table0005Black     Table0005 = `B` // Black
+	Table0005Caucasian Table0005 = `C` // Caucasian
+	Table0005Hispanic  Table0005 = `H` // Hispanic
+	Table0005Oriental  Table0005 = `R` // Oriental
+)
+
+// Description of the value, or empty if the value is not in table 0005.
+func (v Table0005) Description() string {
+	return tableDescription(`0005`, string(v))
+}
+
+// Valid reports if the value is in table 0005.
+func (v Table0005) Valid() bool {
+	return TableValueLookup[`0005`][string(v)]
+}
+
+// Table 0005.
+func (v Table0005) Table() Table {
+	return TableLookup[`0005`]
+}
+
+// Table0006 is a value of table 0006, RELIGION.
+// Fields in this table are strings; convert a field, such as Table0006(v), to use the helpers.
+type Table0006 string
+
+const (
+	Table0006Atheist                       Table0006 = `A` // Atheist
+	Table0006Baptist                       Table0006 = `B` // Baptist
+	Table0006Catholic                      Table0006 = `C` // Catholic
+	Table0006Episcopalian                  Table0006 = `E` // Episcopalian
+	Table0006Judaism                       Table0006 = `J` // Judaism
+	Table0006Lutheran                      Table0006 = `L` // Lutheran
+	Table0006ChurchOfLatterDaySaintsMormon Table0006 = `M` // Church of Latter Day Saints (Mormon)
+	Table0006Hindu                         Table0006 = `N` // Hindu
+	Table0006Protestant                    Table0006 = `P` // Protestant
+)
+
+// Description of the value, or empty if the value is not in table 0006.
+func (v Table0006) Description() string {
+	return tableDescription(`0006`, string(v))
+}
+
+// Valid reports if the value is in table 0006.
+func (v Table0006) Valid() bool {
+	return TableValueLookup[`0006`][string(v)]
+}
+
+// Table 0006.
+func (v Table0006) Table() Table {
+	return TableLookup[`0006`]
+}
+
+// Table0007 is a value of table 0007, ADMISSION TYPE.
+// Fields in this table are strings; convert a field, such as Table0007(v), to use the helpers.
+type Table0007 string
+
+const (
+	Table0007Accident         Table0007 = `A` // Accident
+	Table0007Emergency        Table0007 = `E` // Emergency
+	Table0007LaborAndDelivery Table0007 = `L` // Labor and Delivery
+	Table0007Routine          Table0007 = `R` // Routine
+)
+
+// Description of the value, or empty if the value is not in table 0007.
+func (v Table0007) Description() string {
+	return tableDescription(`0007`, string(v))
+}
+
+// Valid reports if the value is in table 0007.
+func (v Table0007) Valid() bool {
+	return TableValueLookup[`0007`][string(v)]
+}
+
+// Table 0007.
+func (v Table0007) Table() Table {
+	return TableLookup[`0007`]
+}
+
+// Table0008 is a value of table 0008, ACKNOWLEDGMENT CODE.
+// Fields in this table are strings; convert a field, such as Table0008(v), to use the helpers.
+type Table0008 string
+
+const (
+	Table0008ApplicationAccept Table0008 = `AA` // Application Accept
+	Table0008ApplicationError  Table0008 = `AE` // Application Error
+	Table0008ApplicationReject Table0008 = `AR` // Application Reject
+)
+
+// Description of the value, or empty if the value is not in table 0008.
+func (v Table0008) Description() string {
+	return tableDescription(`0008`, string(v))
+}
+
+// Valid reports if the value is in table 0008.
+func (v Table0008) Valid() bool {
+	return TableValueLookup[`0008`][string(v)]
+}
+
+// Table 0008.
+func (v Table0008) Table() Table {
+	return TableLookup[`0008`]
+}
+
+// Table0009 is a value of table 0009, AMBULATORY STATUS.
+// Fields in this table are strings; convert a field, such as Table0009(v), to use the helpers.
+type Table0009 string
+
+const (
+	Table0009NoFunctionalLimitations           Table0009 = `A0` // No functional limitations
+	Table0009AmbulatesWithAssistiveDevice      Table0009 = `A1` // Ambulates with assistive device
+	Table0009WheelchairStretcherBound          Table0009 = `A2` // Wheelchair/stretcher bound
+	Table0009ComatoseNonResponsive             Table0009 = `A3` // Comatose; non-responsive
+	Table0009Disoriented                       Table0009 = `A4` // Disoriented
+	Table0009VisionImpaired                    Table0009 = `A5` // Vision impaired
+	Table0009SpeechImpaired                    Table0009 = `A7` // Speech impaired
+	Table0009NonEnglishSpeaking                Table0009 = `A8` // Non-English Speaking
+	Table0009FunctionalLevelUnknown            Table0009 = `A9` // Functional level unknown
+	Table0009OxygenTherapy                     Table0009 = `B1` // Oxygen Therapy
+	Table0009SpecialEquipmentTunesIVSCatheters Table0009 = `B2` // Special Equipment (tunes, IV's, Catheters)
+	Table0009Amputee                           Table0009 = `B3` // Amputee
+	Table0009Mastectomy                        Table0009 = `B4` // Mastectomy
+	Table0009Paraplegic                        Table0009 = `B5` // Paraplegic
+	Table0009Pregnant                          Table0009 = `B6` // Pregnant
+)
+
+// Description of the value, or empty if the value is not in table 0009.
+func (v Table0009) Description() string {
+	return tableDescription(`0009`, string(v))
+}
+
+// Valid reports if the value is in table 0009.
+func (v Table0009) Valid() bool {
+	return TableValueLookup[`0009`][string(v)]
+}
+
+// Table 0009.
+func (v Table0009) Table() Table {
+	return TableLookup[`0009`]
+}
+
+// Table0036 is a value of table 0036, UNITS OF MEASURE - ISO528,1977.
+// Fields in this table are strings; convert a field, such as Table0036(v), to use the helpers.
+type Table0036 string
+
+const (
+	Table0036Bottle            Table0036 = `BT`  // Bottle
+	Table0036Each              Table0036 = `EA`  // Each
+	Table0036Grams             Table0036 = `GM`  // Grams
+	Table0036Kilograms         Table0036 = `KG`  // Kilograms
+	Table0036Milliequivalent   Table0036 = `MEQ` // Milliequivalent
+	Table0036Milligrams        Table0036 = `MG`  // Milligrams
+	Table0036Ounces            Table0036 = `OZ`  // Ounces
+	Table0036SquareCentimeters Table0036 = `SC`  // Square centimeters
+	Table0036Tablet            Table0036 = `TB`  // Tablet
+	Table0036Vial              Table0036 = `VL`  // Vial
+)
+
+// Description of the value, or empty if the value is not in table 0036.
+func (v Table0036) Description() string {
+	return tableDescription(`0036`, string(v))
+}
+
+// Valid reports if the value is in table 0036.
+func (v Table0036) Valid() bool {
+	return TableValueLookup[`0036`][string(v)]
+}
+
+// Table 0036.
+func (v Table0036) Table() Table {
+	return TableLookup[`0036`]
+}
+
+// Table0038 is a value of table 0038, ORDER STATUS.
+// Fields in this table are strings; convert a field, such as Table0038(v), to use the helpers.
+type Table0038 string
+
+const (
+	Table0038OrderWasCanceled     Table0038 = `CA` // Order was canceled
+	Table0038OrderIsCompleted     Table0038 = `CM` // Order is completed
+	Table0038OrderWasDiscontinued Table0038 = `DC` // Order was discontinued
+	Table0038ErrorOrderNotFound   Table0038 = `ER` // Error, order not found
+	Table0038OrderIsOnHold        Table0038 = `HD` // Order is on hold
+	Table0038InProcessUnspecified Table0038 = `IP` // In process, unspecified
+	Table0038InProcessScheduled   Table0038 = `SC` // In process, scheduled
+)
+
+// Description of the value, or empty if the value is not in table 0038.
+func (v Table0038) Description() string {
+	return tableDescription(`0038`, string(v))
+}
+
+// Valid reports if the value is in table 0038.
+func (v Table0038) Valid() bool {
+	return TableValueLookup[`0038`][string(v)]
+}
+
+// Table 0038.
+func (v Table0038) Table() Table {
+	return TableLookup[`0038`]
+}
+
+// Table0048 is a value of table 0048, WHAT SUBJECT FILTER.
+// Fields in this table are strings; convert a field, such as Table0048(v), to use the helpers.
+type Table0048 string
+
+const (
+	Table0048AdviceDiagnosis          Table0048 = `ADV` // Advice/Diagnosis
+	Table0048NursingUnitLookUp        Table0048 = `ANU` // Nursing Unit Look up
+	Table0048PatientNameLookUp        Table0048 = `APN` // Patient name look up
+	Table0048CancelUsedToCancelAQuery Table0048 = `CAN` // Cancel. Used to cancel a query
+	Table0048Demographics             Table0048 = `DEM` // Demographics
+	Table0048MostRecentInpatient      Table0048 = `MRI` // Most recent inpatient
+	Table0048MostRecentOutpatient     Table0048 = `MRO` // Most recent outpatient
+	Table0048Other                    Table0048 = `OTH` // Other
+	Table0048Procedure                Table0048 = `PRO` // Procedure
+	Table0048Result                   Table0048 = `RES` // Result
+	Table0048Status                   Table0048 = `STA` // Status
+)
+
+// Description of the value, or empty if the value is not in table 0048.
+func (v Table0048) Description() string {
+	return tableDescription(`0048`, string(v))
+}
+
+// Valid reports if the value is in table 0048.
+func (v Table0048) Valid() bool {
+	return TableValueLookup[`0048`][string(v)]
+}
+
+// Table 0048.
+func (v Table0048) Table() Table {
+	return TableLookup[`0048`]
+}
+
+// Table0053 is a value of table 0053, DIAGNOSIS CODING METHOD.
+// Fields in this table are strings; convert a field, such as Table0053(v), to use the helpers.
+type Table0053 string
+
+const (
+	Table0053ICD9 Table0053 = `I9` // ICD9
+)
+
+// Description of the value, or empty if the value is not in table 0053.
+func (v Table0053) Description() string {
+	return tableDescription(`0053`, string(v))
+}
+
+// Valid reports if the value is in table 0053.
+func (v Table0053) Valid() bool {
+	return TableValueLookup[`0053`][string(v)]
+}
+
+// Table 0053.
+func (v Table0053) Table() Table {
+	return TableLookup[`0053`]
+}
+
+// Table0062 is a value of table 0062, EVENT REASON.
+// Fields in this table are strings; convert a field, such as Table0062(v), to use the helpers.
+type Table0062 string
+
+const (
+	Table0062PatientRequest Table0062 = `01` // Patient Request
+	Table0062PhysicianOrder Table0062 = `02` // Physician Order
+)
+
+// Description of the value, or empty if the value is not in table 0062.
+func (v Table0062) Description() string {
+	return tableDescription(`0062`, string(v))
+}
+
+// Valid reports if the value is in table 0062.
+func (v Table0062) Valid() bool {
+	return TableValueLookup[`0062`][string(v)]
+}
+
+// Table 0062.
+func (v Table0062) Table() Table {
+	return TableLookup[`0062`]
+}
+
+// Table0065 is a value of table 0065, ACTION CODE.
+// Fields in this table are strings; convert a field, such as Table0065(v), to use the helpers.
+type Table0065 string
+
+const (
+	Table0065AddOrderedTestsToTheExisting       Table0065 = `A` // Add ordered tests to the existing specimen
+	Table0065CancelOrderForBatteryOrTests       Table0065 = `C` // Cancel order for battery or tests named
+	Table0065GeneratedOrder                     Table0065 = `G` // Generated order
+	Table0065LabToObtainSpecimenFromPatient     Table0065 = `L` // Lab to obtain specimen from patient.
+	Table0065SpecimenObtainedByServiceOtherThan Table0065 = `O` // Specimen obtained by service other than Lab
+	Table0065PendingSpecimenOrderSentPriorTo    Table0065 = `P` // Pending specimen-Order sent prior to delivery
+	Table0065ScheduleTheTestsSpecifiedBelow     Table0065 = `S` // Schedule the tests specified below
+)
+
+// Description of the value, or empty if the value is not in table 0065.
+func (v Table0065) Description() string {
+	return tableDescription(`0065`, string(v))
+}
+
+// Valid reports if the value is in table 0065.
+func (v Table0065) Valid() bool {
+	return TableValueLookup[`0065`][string(v)]
+}
+
+// Table 0065.
+func (v Table0065) Table() Table {
+	return TableLookup[`0065`]
+}
+
+// Table0070 is a value of table 0070, SOURCE OF SPECIMEN.
+// Fields in this table are strings; convert a field, such as Table0070(v), to use the helpers.
+type Table0070 string
+
+const (
+	Table0070Blood               Table0070 = `BLD`  // Blood
+	Table0070Bone                Table0070 = `BON`  // Bone
+	Table0070Burn                Table0070 = `BRN`  // Burn
+	Table0070Conjunctiva         Table0070 = `CNJT` // Conjunctiva
+	Table0070CerebralSpinalFluid Table0070 = `CSF`  // Cerebral spinal fluid
+	Table0070Cervix              Table0070 = `CVX`  // Cervix
+	Table0070Ear                 Table0070 = `EAR`  // Ear
+	Table0070Fibroblood          Table0070 = `FIB`  // Fibroblood
+	Table0070Hair                Table0070 = `HAR`  // Hair
+	Table0070AmnioticFluid       Table0070 = `MN`   // Amniotic Fluid
+	Table0070Nose                Table0070 = `NOS`  // Nose
+	Table0070Other               Table0070 = `OTH`  // Other
+	Table0070Plasma              Table0070 = `PLAS` // Plasma
+	Table0070PeritonealFluid     Table0070 = `PRT`  // Peritoneal Fluid
+	Table0070Erythrocytes        Table0070 = `RBC`  // Erythrocytes
+	Table0070Saliva              Table0070 = `SAL`  // Saliva
+	Table0070SeminalFluid        Table0070 = `SEM`  // Seminal Fluid
+	Table0070Serum               Table0070 = `SER`  // Serum
+	Table0070Skin                Table0070 = `SKN`  // Skin
+	Table0070SynovialFluid       Table0070 = `SNV`  // Synovial Fluid
+	Table0070Stool               Table0070 = `STL`  // Stool
+	Table0070Sweat               Table0070 = `SWT`  // Sweat
+	Table0070Throat              Table0070 = `THRT` // Throat
+	Table0070Tissue              Table0070 = `TIS`  // Tissue
+	Table0070UmbilicalBlood      Table0070 = `UMB`  // Umbilical Blood
+	Table0070Urine               Table0070 = `UR`   // Urine
+	Table0070Urethra             Table0070 = `URTH` // Urethra
+	Table0070Leukocytes          Table0070 = `WBC`  // Leukocytes
+	Table0070Wound               Table0070 = `WND`  // Wound
+)
+
+// Description of the value, or empty if the value is not in table 0070.
+func (v Table0070) Description() string {
+	return tableDescription(`0070`, string(v))
+}
+
+// Valid reports if the value is in table 0070.
+func (v Table0070) Valid() bool {
+	return TableValueLookup[`0070`][string(v)]
+}
+
+// Table 0070.
+func (v Table0070) Table() Table {
+	return TableLookup[`0070`]
+}
+
+// Table0074 is a value of table 0074, DIAGNOSTIC SERVICE SECTION ID.
+// Fields in this table are strings; convert a field, such as Table0074(v), to use the helpers.
+type Table0074 string
+
+const (
+	Table0074BloodGases                   Table0074 = `BG`  // Blood gases
+	Table0074Chemistry                    Table0074 = `CH`  // Chemistry
+	Table0074Cytopathology                Table0074 = `CP`  // Cytopathology
+	Table0074CATScan                      Table0074 = `CT`  // CAT scan
+	Table0074CardiacUltrasound            Table0074 = `CUS` // Cardiac Ultrasound
+	Table0074ElectrocardiacEGEKGEECHolter Table0074 = `EC`  // Electrocardiac (e.g., EKG, EEC, Holter)
+	Table0074Hematology                   Table0074 = `HM`  // Hematology
+	Table0074Immunology                   Table0074 = `IMM` // Immunology
+	Table0074Microbiology                 Table0074 = `MB`  // Microbiology
+	Table0074Mycobacteriology             Table0074 = `MCB` // Mycobacteriology
+	Table0074Mycology                     Table0074 = `MYC` // Mycology
+	Table0074NuclearMagneticResonance     Table0074 = `NMR` // Nuclear magnetic resonance
+	Table0074NuclearMedicineScan          Table0074 = `NMS` // Nuclear medicine scan
+	Table0074NursingServiceMeasures       Table0074 = `NRS` // Nursing service measures
+	Table0074OccupationalTherapy          Table0074 = `OT`  // Occupational Therapy
+	Table0074Other                        Table0074 = `OTH` // Other
+	Table0074OBUltrasound                 Table0074 = `OUS` // OB Ultrasound
+	Table0074Pharmacy                     Table0074 = `PHR` // Pharmacy
+	Table0074PhysicalTherapy              Table0074 = `PT`  // Physical Therapy
+	Table0074RespiratoryCare              Table0074 = `RC`  // Respiratory Care
+	Table0074RadiationTherapy             Table0074 = `RT`  // Radiation Therapy
+	Table0074RadiologyUltrasound          Table0074 = `RUS` // Radiology ultrasound
+	Table0074SurgicalPathology            Table0074 = `SP`  // Surgical Pathology
+	Table0074Serology                     Table0074 = `SR`  // Serology
+	Table0074Toxicology                   Table0074 = `TX`  // Toxicology
+	Table0074VascularUltrasound           Table0074 = `VUS` // Vascular Ultrasound
+	Table0074Cineradiography              Table0074 = `XRC` // Cineradiography
+)
+
+// Description of the value, or empty if the value is not in table 0074.
+func (v Table0074) Description() string {
+	return tableDescription(`0074`, string(v))
+}
+
+// Valid reports if the value is in table 0074.
+func (v Table0074) Valid() bool {
+	return TableValueLookup[`0074`][string(v)]
+}
+
+// Table 0074.
+func (v Table0074) Table() Table {
+	return TableLookup[`0074`]
+}
+
+// Table0076 is a value of table 0076, MESSAGE TYPE.
+// Fields in this table are strings; convert a field, such as Table0076(v), to use the helpers.
+type Table0076 string
+
+const (
+	Table0076GeneralAcknowledgmentCNTII    Table0076 = `ACK` // General Acknowledgment       CNT       II
+	Table0076AncillaryRPTDisplayANRVII     Table0076 = `ARD` // Ancillary RPT (display)      ANR       VII
+	Table0076AddChangeBillingAccountBLNVI  Table0076 = `BAR` // Add/change billing account   BLN       VI
+	Table0076DisplayResponseQRYV           Table0076 = `DSR` // Display response             QRY       V
+	Table0076DelayedAcknowledgmentCNTII    Table0076 = `MCF` // Delayed acknowledgment       CNT       II
+	Table0076ObservResultRecordRespANRVII  Table0076 = `ORF` // Observ. Result/record resp.  ANR       VII
+	Table0076OrderORDIV                    Table0076 = `ORM` // Order                        ORD       IV
+	Table0076OrderResponseMessageORDIV     Table0076 = `ORR` // Order response message       ORD       IV
+	Table0076ObservResultUnsolicitedANRVII Table0076 = `ORU` // Observ. result/unsolicited   ANR       VII
+	Table0076OrderStatusQueryORDIV         Table0076 = `OSQ` // Order status query           ORD       IV
+	Table0076UnsolicitedDisplayQRYV        Table0076 = `UDM` // Unsolicited display          QRY       V
+)
+
+// Description of the value, or empty if the value is not in table 0076.
+func (v Table0076) Description() string {
+	return tableDescription(`0076`, string(v))
+}
+
+// Valid reports if the value is in table 0076.
+func (v Table0076) Valid() bool {
+	return TableValueLookup[`0076`][string(v)]
+}
+
+// Table 0076.
+func (v Table0076) Table() Table {
+	return TableLookup[`0076`]
+}
+
+// Table0078 is a value of table 0078, ABNORMAL FLAGS.
+// Fields in this table are strings; convert a field, such as Table0078(v), to use the helpers.
+type Table0078 string
+
+const (
+	Table0078BelowAbsoluteLowOffInstrumentScale Table0078 = `<`  // Below absolute low-off instrument scale
+	Table0078AbnormalAppliesToNonNumericResults Table0078 = `A`  // Abnormal (applies to non-numeric results)
+	Table0078VeryAbnormal                       Table0078 = `AA` // Very abnormal
+	Table0078SignificantChangeDown              Table0078 = `D`  // Significant change down
+	Table0078AboveHighNormal                    Table0078 = `H`  // Above high normal
+	Table0078AboveUpperPanicLimits              Table0078 = `HH` // Above upper panic limits
+	Table0078Interval                           Table0078 = `I`  // Interval
+	Table0078BelowLowerPanicLimits              Table0078 = `LL` // Below lower panic limits
+	Table0078ModeratelySensitive                Table0078 = `MS` // Moderately sensitive
+	Table0078Resists                            Table0078 = `R`  // Resists
+	Table0078Sensitive                          Table0078 = `S`  // Sensitive
+	Table0078SignificantChangeUp                Table0078 = `U`  // Significant change up
+	Table0078VerySensitive                      Table0078 = `VS` // Very sensitive
+)
+
+// Description of the value, or empty if the value is not in table 0078.
+func (v Table0078) Description() string {
+	return tableDescription(`0078`, string(v))
+}
+
+// Valid reports if the value is in table 0078.
+func (v Table0078) Valid() bool {
+	return TableValueLookup[`0078`][string(v)]
+}
+
+// Table 0078.
+func (v Table0078) Table() Table {
+	return TableLookup[`0078`]
+}
+
+// Table0080 is a value of table 0080, NATURE OF ABNORMAL TESTING.
+// Fields in this table are strings; convert a field, such as Table0080(v), to use the helpers.
+type Table0080 string
+
+const (
+	Table0080AnAgedBasedPopulation  Table0080 = `A` // An aged based population
+	Table0080NoneGenericNormalRange Table0080 = `N` // None - generic normal range
+	Table0080ARaceBasedPopulation   Table0080 = `R` // A race based population
+	Table0080ASexedBasedPopulation  Table0080 = `S` // A sexed based population
+)
+
+// Description of the value, or empty if the value is not in table 0080.
+func (v Table0080) Description() string {
+	return tableDescription(`0080`, string(v))
+}
+
+// Valid reports if the value is in table 0080.
+func (v Table0080) Valid() bool {
+	return TableValueLookup[`0080`][string(v)]
+}
+
+// Table 0080.
+func (v Table0080) Table() Table {
+	return TableLookup[`0080`]
+}
+
+// Table0085 is a value of table 0085, OBSERVATION RESULT STATUS.
+// Fields in this table are strings; convert a field, such as Table0085(v), to use the helpers.
+type Table0085 string
+
+const (
+	Table0085DeletePreviouslyTransmittedObservation Table0085 = `D` // Delete previously transmitted observation
+	Table0085CompleteFinalResultsEnteredAndVerified Table0085 = `F` // Complete/final results (entered and verified)
+	Table0085SpecimenInLabResultsPending            Table0085 = `I` // Specimen in lab--results pending
+	Table0085ResultsEnteredNotVerified              Table0085 = `R` // Results entered - not verified
+	Table0085PartialResults                         Table0085 = `S` // Partial results
+)
+
+// Description of the value, or empty if the value is not in table 0085.
+func (v Table0085) Description() string {
+	return tableDescription(`0085`, string(v))
+}
+
+// Valid reports if the value is in table 0085.
+func (v Table0085) Valid() bool {
+	return TableValueLookup[`0085`][string(v)]
+}
+
+// Table 0085.
+func (v Table0085) Table() Table {
+	return TableLookup[`0085`]
+}
+
+// Table0091 is a value of table 0091, QUERY PRIORITY.
+// Fields in this table are strings; convert a field, such as Table0091(v), to use the helpers.
+type Table0091 string
+
+const (
+	Table0091Deferred  Table0091 = `D` // Deferred
+	Table0091Immediate Table0091 = `I` // Immediate
+)
+
+// Description of the value, or empty if the value is not in table 0091.
+func (v Table0091) Description() string {
+	return tableDescription(`0091`, string(v))
+}
+
+// Valid reports if the value is in table 0091.
+func (v Table0091) Valid() bool {
+	return TableValueLookup[`0091`][string(v)]
+}
+
+// Table 0091.
+func (v Table0091) Table() Table {
+	return TableLookup[`0091`]
+}
+
+// Table0100 is a value of table 0100, WHEN TO CHARGE.
+// Fields in this table are strings; convert a field, such as Table0100(v), to use the helpers.
+type Table0100 string
+
+const (
+	Table0100OnDischarge              Table0100 = `D` // On discharge
+	Table0100OnReceiptOfOrder         Table0100 = `O` // On receipt of order
+	Table0100AtTimeServiceIsCompleted Table0100 = `R` // At time service is completed
+	Table0100AtTimeServiceIsStarted   Table0100 = `S` // At time service is started
+)
+
+// Description of the value, or empty if the value is not in table 0100.
+func (v Table0100) Description() string {
+	return tableDescription(`0100`, string(v))
+}
+
+// Valid reports if the value is in table 0100.
+func (v Table0100) Valid() bool {
+	return TableValueLookup[`0100`][string(v)]
+}
+
+// Table 0100.
+func (v Table0100) Table() Table {
+	return TableLookup[`0100`]
+}
+
+// Table0102 is a value of table 0102, DELAYED ACKNOWLEDGMENT TYPE.
+// Fields in this table are strings; convert a field, such as Table0102(v), to use the helpers.
+type Table0102 string
+
+const (
+	Table0102MessageReceivedStoredForLaterProcessing Table0102 = `D` // Message Received, stored for later processing
+)
+
+// Description of the value, or empty if the value is not in table 0102.
+func (v Table0102) Description() string {
+	return tableDescription(`0102`, string(v))
+}
+
+// Valid reports if the value is in table 0102.
+func (v Table0102) Valid() bool {
+	return TableValueLookup[`0102`][string(v)]
+}
+
+// Table 0102.
+func (v Table0102) Table() Table {
+	return TableLookup[`0102`]
+}
+
+// Table0103 is a value of table 0103, PROCESSING ID.
+// Fields in this table are strings; convert a field, such as Table0103(v), to use the helpers.
+type Table0103 string
+
+const (
+	Table0103Debugging  Table0103 = `D` // Debugging
+	Table0103Production Table0103 = `P` // Production
+	Table0103Training   Table0103 = `T` // Training
+)
+
+// Description of the value, or empty if the value is not in table 0103.
+func (v Table0103) Description() string {
+	return tableDescription(`0103`, string(v))
+}
+
+// Valid reports if the value is in table 0103.
+func (v Table0103) Valid() bool {
+	return TableValueLookup[`0103`][string(v)]
+}
+
+// Table 0103.
+func (v Table0103) Table() Table {
+	return TableLookup[`0103`]
+}
+
+// Table0104 is a value of table 0104, VERSION CONTROL TABLE.
+// Fields in this table are strings; convert a field, such as Table0104(v), to use the helpers.
+type Table0104 string
+
+const (
+	Table0104Release20September1988 Table0104 = `2.0`  // Release 2.0  September 1988
+	Table0104Demo20October1988      Table0104 = `2.0D` // Demo    2.0  October 1988
+	Table0104Release21March1990     Table0104 = `2.1`  // Release 2.1  March 1990
+)
+
+// Description of the value, or empty if the value is not in table 0104.
+func (v Table0104) Description() string {
+	return tableDescription(`0104`, string(v))
+}
+
+// Valid reports if the value is in table 0104.
+func (v Table0104) Valid() bool {
+	return TableValueLookup[`0104`][string(v)]
+}
+
+// Table 0104.
+func (v Table0104) Table() Table {
+	return TableLookup[`0104`]
+}
+
+// Table0105 is a value of table 0105, SOURCE OF COMMENT.
+// Fields in this table are strings; convert a field, such as Table0105(v), to use the helpers.
+type Table0105 string
+
+const (
+	Table0105AncillaryDepartmentIsSourceOfComment Table0105 = `L` // Ancillary department is source of comment
+	Table0105OrdererIsSourceOfComment             Table0105 = `P` // Orderer is source of comment
+)
+
+// Description of the value, or empty if the value is not in table 0105.
+func (v Table0105) Description() string {
+	return tableDescription(`0105`, string(v))
+}
+
+// Valid reports if the value is in table 0105.
+func (v Table0105) Valid() bool {
+	return TableValueLookup[`0105`][string(v)]
+}
+
+// Table 0105.
+func (v Table0105) Table() Table {
+	return TableLookup[`0105`]
+}
+
+// Table0106 is a value of table 0106, QUERY FORMAT CODE.
+// Fields in this table are strings; convert a field, such as Table0106(v), to use the helpers.
+type Table0106 string
+
+const (
+	Table0106ResponseInRecordOrientedFormat Table0106 = `R` // Response in Record-oriented format
+)
+
+// Description of the value, or empty if the value is not in table 0106.
+func (v Table0106) Description() string {
+	return tableDescription(`0106`, string(v))
+}
+
+// Valid reports if the value is in table 0106.
+func (v Table0106) Valid() bool {
+	return TableValueLookup[`0106`][string(v)]
+}
+
+// Table 0106.
+func (v Table0106) Table() Table {
+	return TableLookup[`0106`]
+}
+
+// Table0107 is a value of table 0107, DEFERRED RESPONSE TYPE.
+// Fields in this table are strings; convert a field, such as Table0107(v), to use the helpers.
+type Table0107 string
+
+const (
+	Table0107LaterThanTheDATETIMESpecified Table0107 = `L` // Later than the DATE/TIME specified
+)
+
+// Description of the value, or empty if the value is not in table 0107.
+func (v Table0107) Description() string {
+	return tableDescription(`0107`, string(v))
+}
+
+// Valid reports if the value is in table 0107.
+func (v Table0107) Valid() bool {
+	return TableValueLookup[`0107`][string(v)]
+}
+
+// Table 0107.
+func (v Table0107) Table() Table {
+	return TableLookup[`0107`]
+}
+
+// Table0108 is a value of table 0108, QUERY RESULTS LEVEL.
+// Fields in this table are strings; convert a field, such as Table0108(v), to use the helpers.
+type Table0108 string
+
+const (
+	Table0108OrderPlusOrderStatus Table0108 = `O` // Order plus order status
+	Table0108StatusOnly           Table0108 = `S` // Status only
+	Table0108FullResults          Table0108 = `T` // Full Results
+)
+
+// Description of the value, or empty if the value is not in table 0108.
+func (v Table0108) Description() string {
+	return tableDescription(`0108`, string(v))
+}
+
+// Valid reports if the value is in table 0108.
+func (v Table0108) Valid() bool {
+	return TableValueLookup[`0108`][string(v)]
+}
+
+// Table 0108.
+func (v Table0108) Table() Table {
+	return TableLookup[`0108`]
+}
+
+// Table0109 is a value of table 0109, REPORT PRIORITY.
+// Fields in this table are strings; convert a field, such as Table0109(v), to use the helpers.
+type Table0109 string
+
+const (
+	Table0109Routine Table0109 = `R` // Routine
+	Table0109Stat    Table0109 = `S` // Stat
+)
+
+// Description of the value, or empty if the value is not in table 0109.
+func (v Table0109) Description() string {
+	return tableDescription(`0109`, string(v))
+}
+
+// Valid reports if the value is in table 0109.
+func (v Table0109) Valid() bool {
+	return TableValueLookup[`0109`][string(v)]
+}
+
+// Table 0109.
+func (v Table0109) Table() Table {
+	return TableLookup[`0109`]
+}
+
+// Table0116 is a value of table 0116, BED STATUS.
+// Fields in this table are strings; convert a field, such as Table0116(v), to use the helpers.
+type Table0116 string
+
+const (
+	Table0116Closed       Table0116 = `C` // Closed
+	Table0116Housekeeping Table0116 = `H` // Housekeeping
+	Table0116Occupied     Table0116 = `O` // Occupied
+)
+
+// Description of the value, or empty if the value is not in table 0116.
+func (v Table0116) Description() string {
+	return tableDescription(`0116`, string(v))
+}
+
+// Valid reports if the value is in table 0116.
+func (v Table0116) Valid() bool {
+	return TableValueLookup[`0116`][string(v)]
+}
+
+// Table 0116.
+func (v Table0116) Table() Table {
+	return TableLookup[`0116`]
+}
+
+// Table0119 is a value of table 0119, ORDER CONTROL.
+// Fields in this table are strings; convert a field, such as Table0119(v), to use the helpers.
+type Table0119 string
+
+const (
+	Table0119CancelOrderRequest      Table0119 = `CA` // Cancel order request
+	Table0119ChildOrder              Table0119 = `CH` // Child order
+	Table0119CombinedResult          Table0119 = `CN` // Combined result
+	Table0119DiscontinueOrderRequest Table0119 = `DC` // Discontinue order request
+	Table0119DataErrors              Table0119 = `DE` // Data Errors
+	Table0119DiscontinuedAsRequested Table0119 = `DR` // Discontinued as requested
+	Table0119HoldOrderRequest        Table0119 = `HD` // Hold order request
+	Table0119OnHoldAsRequested       Table0119 = `HR` // On hold as requested
+	Table0119NumberAssignedT         Table0119 = `NA` // Number assigned            T
+	Table0119NewOrderT               Table0119 = `NW` // New order                  T
+	Table0119OrderDiscontinued       Table0119 = `OD` // Order discontinued
+	Table0119OrderAcceptedAndOK      Table0119 = `OK` // Order accepted and OK
+	Table0119ReleasedAsRequested     Table0119 = `OR` // Released as requested
+	Table0119ParentOrder             Table0119 = `PA` // Parent order
+	Table0119ObservationsToFollow    Table0119 = `RE` // Observations to follow
+	Table0119ReplacementOrder        Table0119 = `RO` // Replacement order
+	Table0119OrderReplaceRequest     Table0119 = `RP` // Order replace request
+	Table0119RequestReceived         Table0119 = `RR` // Request received
+	Table0119ReplacedUnsolicited     Table0119 = `RU` // Replaced unsolicited
+	Table0119SendFillerNumberFI      Table0119 = `SN` // Send filler number            F         I
+	Table0119SendOrderStatusRequest  Table0119 = `SS` // Send order status request
+	Table0119UnableToDiscontinue     Table0119 = `UD` // Unable to discontinue
+	Table0119UnableToPutOnHold       Table0119 = `UH` // Unable to put on hold
+	Table0119UnableToRelease         Table0119 = `UR` // Unable to release
+	Table0119UnableToChange          Table0119 = `UX` // Unable to change
+	Table0119ChangedAsRequested      Table0119 = `XR` // Changed as requested
+	Table0119OrderChangedUnsolicited Table0119 = `XX` // Order changed, unsolicited
+)
+
+// Description of the value, or empty if the value is not in table 0119.
+func (v Table0119) Description() string {
+	return tableDescription(`0119`, string(v))
+}
+
+// Valid reports if the value is in table 0119.
+func (v Table0119) Valid() bool {
+	return TableValueLookup[`0119`][string(v)]
+}
+
+// Table 0119.
+func (v Table0119) Table() Table {
+	return TableLookup[`0119`]
+}
+
+// Table0121 is a value of table 0121, RESPONSE FLAG.
+// Fields in this table are strings; convert a field, such as Table0121(v), to use the helpers.
+type Table0121 string
+
+const (
+	Table0121ReportExceptionsOnly               Table0121 = `E` // Report exceptions only.
+	Table0121SameAsDPlusConfirmationsExplicitly Table0121 = `F` // Same as D, plus confirmations explicitly.
+	Table0121OnlyTheMSASegmentIsReturned        Table0121 = `N` // Only the MSA segment is returned.
+)
+
+// Description of the value, or empty if the value is not in table 0121.
+func (v Table0121) Description() string {
+	return tableDescription(`0121`, string(v))
+}
+
+// Valid reports if the value is in table 0121.
+func (v Table0121) Valid() bool {
+	return TableValueLookup[`0121`][string(v)]
+}
+
+// Table 0121.
+func (v Table0121) Table() Table {
+	return TableLookup[`0121`]
+}
+
+// Table0122 is a value of table 0122, CHARGE TYPE.
+// Fields in this table are strings; convert a field, such as Table0122(v), to use the helpers.
+type Table0122 string
+
+const (
+	Table0122Charge       Table0122 = `CH` // Charge
+	Table0122Contract     Table0122 = `CO` // Contract
+	Table0122Credit       Table0122 = `CR` // Credit
+	Table0122Department   Table0122 = `DP` // Department
+	Table0122Grant        Table0122 = `GR` // Grant
+	Table0122NoCharge     Table0122 = `NC` // No Charge
+	Table0122Professional Table0122 = `PC` // Professional
+	Table0122Research     Table0122 = `RS` // Research
+)
+
+// Description of the value, or empty if the value is not in table 0122.
+func (v Table0122) Description() string {
+	return tableDescription(`0122`, string(v))
+}
+
+// Valid reports if the value is in table 0122.
+func (v Table0122) Valid() bool {
+	return TableValueLookup[`0122`][string(v)]
+}
+
+// Table 0122.
+func (v Table0122) Table() Table {
+	return TableLookup[`0122`]
+}
+
+// Table0123 is a value of table 0123, RESULT STATUS - OBR.
+// Fields in this table are strings; convert a field, such as Table0123(v), to use the helpers.
+type Table0123 string
+
+const (
+	Table0123CorrectionOfPreviouslyTransmittedResults Table0123 = `C` // Correction of previously transmitted results
+	Table0123FinalResultsResultsStoredVerified        Table0123 = `F` // Final results - results stored & verified
+	Table0123SpecimenInLabNotYetProcessed             Table0123 = `I` // Specimen in lab, not yet processed.
+	Table0123PreliminaryResults                       Table0123 = `P` // Preliminary results
+	Table0123ResultsStoredNotYetVerified              Table0123 = `R` // Results stored - not yet verified
+	Table0123ProcedureScheduledNotDone                Table0123 = `S` // Procedure scheduled, not done
+	Table0123NoOrderOnRecordForThis                   Table0123 = `Y` // No order on record for this test
+	Table0123NoRecordOfThisPatient                    Table0123 = `Z` // No record of this patient
+)
+
+// Description of the value, or empty if the value is not in table 0123.
+func (v Table0123) Description() string {
+	return tableDescription(`0123`, string(v))
+}
+
+// Valid reports if the value is in table 0123.
+func (v Table0123) Valid() bool {
+	return TableValueLookup[`0123`][string(v)]
+}
+
+// Table 0123.
+func (v Table0123) Table() Table {
+	return TableLookup[`0123`]
+}
+
+// Table0124 is a value of table 0124, TRANSPORTATION MODE.
+// Fields in this table are strings; convert a field, such as Table0124(v), to use the helpers.
+type Table0124 string
+
+const (
+	Table0124TheExaminingDeviceGoesToPatient Table0124 = `PORT` // The examining device goes to Patient's Loc.
+	Table0124PatientWalksToDiagnosticService Table0124 = `WALK` // Patient walks to diagnostic service
+	Table0124Wheelchair                      Table0124 = `WHLC` // Wheelchair
+)
+
+// Description of the value, or empty if the value is not in table 0124.
+func (v Table0124) Description() string {
+	return tableDescription(`0124`, string(v))
+}
+
+// Valid reports if the value is in table 0124.
+func (v Table0124) Valid() bool {
+	return TableValueLookup[`0124`][string(v)]
+}
+
+// Table 0124.
+func (v Table0124) Table() Table {
+	return TableLookup[`0124`]
+}
+
+// Table0125 is a value of table 0125, VALUE TYPE.
+// Fields in this table are strings; convert a field, such as Table0125(v), to use the helpers.
+type Table0125 string
+
+const (
+	Table0125Address                          Table0125 = `AD` // Address
+	Table0125CompositeIDWithCheckDigit        Table0125 = `CK` // Composite ID with check digit
+	Table0125FormattedText                    Table0125 = `FT` // Formatted Text
+	Table0125PersonName                       Table0125 = `PN` // Person name
+	Table0125StringDataUsedToTransmitNumerics Table0125 = `ST` // String data. Used to transmit numerics.
+	Table0125Time                             Table0125 = `TM` // Time
+	Table0125TimeStamp                        Table0125 = `TS` // Time stamp
+	Table0125Text                             Table0125 = `TX` // Text
+)
+
+// Description of the value, or empty if the value is not in table 0125.
+func (v Table0125) Description() string {
+	return tableDescription(`0125`, string(v))
+}
+
+// Valid reports if the value is in table 0125.
+func (v Table0125) Valid() bool {
+	return TableValueLookup[`0125`][string(v)]
+}
+
+// Table 0125.
+func (v Table0125) Table() Table {
+	return TableLookup[`0125`]
+}
+
+// Table0126 is a value of table 0126, QUANTITY LIMITED REQUEST.
+// Fields in this table are strings; convert a field, such as Table0126(v), to use the helpers.
+type Table0126 string
+
+const (
+	Table0126Characters     Table0126 = `CH` // Characters
+	Table0126Lines          Table0126 = `LI` // Lines
+	Table0126Pages          Table0126 = `PG` // Pages
+	Table0126LocallyDefined Table0126 = `ZO` // Locally defined
+)
+
+// Description of the value, or empty if the value is not in table 0126.
+func (v Table0126) Description() string {
+	return tableDescription(`0126`, string(v))
+}
+
+// Valid reports if the value is in table 0126.
+func (v Table0126) Valid() bool {
+	return TableValueLookup[`0126`][string(v)]
+}
+
+// Table 0126.
+func (v Table0126) Table() Table {
+	return TableLookup[`0126`]
+}
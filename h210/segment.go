@@ -1,4 +1,4 @@
-// Code generated by "hl7fetch -pkgdir h210 -root ./genjson -version 2.1"; DO NOT EDIT.
+// Code generated by "hl7fetch -pkgdir h210 -root ./genjson -version 2.1 -enum"; DO NOT EDIT.
 
 package h210
 
@@ -57,7 +57,7 @@ type BTS struct {
 // Diagnosis
 type DG1 struct {
 	HL7                     HL7Name `hl7:",name=DG1,type=s"`
-	SetIDDiagnosis          SI      `hl7:"1,required,len=4,display=Set Id - Diagnosis"`
+	SetIDDiagnosis          SI      `hl7:"1,required,len=4,format=SI,display=Set Id - Diagnosis"`
 	DiagnosisCodingMethod   ID      `hl7:"2,required,len=2,table=0053,display=Diagnosis Coding Method"`
 	DiagnosisCode           ID      `hl7:"3,len=8,table=0051,display=Diagnosis Code"`
 	DiagnosisDescription    ST      `hl7:"4,len=40,display=Diagnosis Description"`
@@ -68,8 +68,8 @@ type DG1 struct {
 	DrgApprovalIndicator    ID      `hl7:"9,len=2,display=Drg Approval Indicator"`
 	DrgGrouperReviewCode    ID      `hl7:"10,len=2,table=0056,display=Drg Grouper Review Code"`
 	OutlierType             ID      `hl7:"11,len=2,table=0083,display=Outlier Type"`
-	OutlierDays             NM      `hl7:"12,len=3,display=Outlier Days"`
-	OutlierCost             NM      `hl7:"13,len=12,display=Outlier Cost"`
+	OutlierDays             NM      `hl7:"12,len=3,format=NM,display=Outlier Days"`
+	OutlierCost             NM      `hl7:"13,len=12,format=NM,display=Outlier Cost"`
 	GrouperVersionAndType   ST      `hl7:"14,len=4,display=Grouper Version And Type"`
 }
 
@@ -82,8 +82,8 @@ type DSC struct {
 // Display Data
 type DSP struct {
 	HL7               HL7Name `hl7:",name=DSP,type=s"`
-	SetIDDisplayData  SI      `hl7:"1,len=4,display=Set Id - Display Data"`
-	DisplayLevel      SI      `hl7:"2,len=4,display=Display Level"`
+	SetIDDisplayData  SI      `hl7:"1,len=4,format=SI,display=Set Id - Display Data"`
+	DisplayLevel      SI      `hl7:"2,len=4,format=SI,display=Display Level"`
 	DataLine          TX      `hl7:"3,required,len=300,display=Data Line"`
 	LogicalBreakPoint ST      `hl7:"4,len=2,display=Logical Break Point"`
 	ResultID          TX      `hl7:"5,len=20,display=Result Id"`
@@ -118,7 +118,7 @@ type FHS struct {
 // Financial Transaction
 type FT1 struct {
 	HL7                       HL7Name `hl7:",name=FT1,type=s"`
-	SetIDFinancialTransaction SI      `hl7:"1,len=4,display=Set Id - Financial Transaction"`
+	SetIDFinancialTransaction SI      `hl7:"1,len=4,format=SI,display=Set Id - Financial Transaction"`
 	TransactionID             ST      `hl7:"2,len=12,display=Transaction Id"`
 	TransactionBatchID        ST      `hl7:"3,len=5,display=Transaction Batch Id"`
 	TransactionDate           DT      `hl7:"4,required,len=8,format=YMD,display=Transaction Date"`
@@ -127,19 +127,19 @@ type FT1 struct {
 	TransactionCode           ID      `hl7:"7,required,len=20,table=0096,display=Transaction Code"`
 	TransactionDescription    ST      `hl7:"8,len=40,display=Transaction Description"`
 	TransactionDescriptionAlt ST      `hl7:"9,len=40,display=Transaction Description - Alt"`
-	TransactionAmountExtended NM      `hl7:"10,len=12,display=Transaction Amount - Extended"`
-	TransactionQuantity       NM      `hl7:"11,len=4,display=Transaction Quantity"`
-	TransactionAmountUnit     NM      `hl7:"12,len=12,display=Transaction Amount - Unit"`
+	TransactionAmountExtended NM      `hl7:"10,len=12,format=NM,display=Transaction Amount - Extended"`
+	TransactionQuantity       NM      `hl7:"11,len=4,format=NM,display=Transaction Quantity"`
+	TransactionAmountUnit     NM      `hl7:"12,len=12,format=NM,display=Transaction Amount - Unit"`
 	DepartmentCode            ST      `hl7:"13,len=16,table=0049,display=Department Code"`
 	InsurancePlanID           ID      `hl7:"14,len=8,table=0072,display=Insurance Plan Id"`
-	InsuranceAmount           NM      `hl7:"15,len=12,display=Insurance Amount"`
+	InsuranceAmount           NM      `hl7:"15,len=12,format=NM,display=Insurance Amount"`
 	PatientLocation           ST      `hl7:"16,len=12,table=0079,display=Patient Location"`
 	FeeSchedule               ID      `hl7:"17,len=1,table=0024,display=Fee Schedule"`
 	PatientType               ID      `hl7:"18,len=2,table=0018,display=Patient Type"`
 	DiagnosisCode             ID      `hl7:"19,len=8,table=0051,display=Diagnosis Code"`
 	PerformedByCode           CN      `hl7:"20,len=60,table=0084,display=Performed By Code"`
 	OrderedByCode             CN      `hl7:"21,len=60,display=Ordered By Code"`
-	UnitCost                  NM      `hl7:"22,len=12,display=Unit Cost"`
+	UnitCost                  NM      `hl7:"22,len=12,format=NM,display=Unit Cost"`
 }
 
 // File Trailer
@@ -152,7 +152,7 @@ type FTS struct {
 // Guarantor
 type GT1 struct {
 	HL7                       HL7Name `hl7:",name=GT1,type=s"`
-	SetIDGuarantor            SI      `hl7:"1,required,len=4,display=Set Id - Guarantor"`
+	SetIDGuarantor            SI      `hl7:"1,required,len=4,format=SI,display=Set Id - Guarantor"`
 	GuarantorNumber           ID      `hl7:"2,len=20,display=Guarantor Number"`
 	GuarantorName             PN      `hl7:"3,required,len=48,display=Guarantor Name"`
 	GuarantorSpouseName       PN      `hl7:"4,len=48,display=Guarantor Spouse Name"`
@@ -166,7 +166,7 @@ type GT1 struct {
 	GuarantorSSN              ST      `hl7:"12,len=11,display=Guarantor Ssn"`
 	GuarantorDateBegin        DT      `hl7:"13,len=8,format=YMD,display=Guarantor Date - Begin"`
 	GuarantorDateEnd          DT      `hl7:"14,len=8,format=YMD,display=Guarantor Date - End"`
-	GuarantorPriority         NM      `hl7:"15,len=2,display=Guarantor Priority"`
+	GuarantorPriority         NM      `hl7:"15,len=2,format=NM,display=Guarantor Priority"`
 	GuarantorEmployerName     ST      `hl7:"16,len=45,display=Guarantor Employer Name"`
 	GuarantorEmployerAddress  AD      `hl7:"17,len=106,display=Guarantor Employer Address"`
 	GuarantorEmployPhone      TN      `hl7:"18,len=40,display=Guarantor Employ Phone #"`
@@ -177,7 +177,7 @@ type GT1 struct {
 // Insurance
 type IN1 struct {
 	HL7                           HL7Name `hl7:",name=IN1,type=s"`
-	SetIDInsurance                SI      `hl7:"1,required,len=4,display=Set Id - Insurance"`
+	SetIDInsurance                SI      `hl7:"1,required,len=4,format=SI,display=Set Id - Insurance"`
 	InsurancePlanID               ID      `hl7:"2,required,len=8,table=0072,display=Insurance Plan Id"`
 	InsuranceCompanyID            ST      `hl7:"3,required,len=6,display=Insurance Company Id"`
 	InsuranceCompanyName          ST      `hl7:"4,len=45,display=Insurance Company Name"`
@@ -209,15 +209,15 @@ type IN1 struct {
 	VerificationBy                CM      `hl7:"30,len=60,display=Verification By"`
 	TypeOfAgreementCode           ID      `hl7:"31,len=2,table=0098,display=Type Of Agreement Code"`
 	BillingStatus                 ID      `hl7:"32,len=2,table=0022,display=Billing Status"`
-	LifetimeReserveDays           NM      `hl7:"33,len=4,display=Lifetime Reserve Days"`
-	DelayBeforeLRDay              NM      `hl7:"34,len=4,display=Delay Before L. R. Day"`
+	LifetimeReserveDays           NM      `hl7:"33,len=4,format=NM,display=Lifetime Reserve Days"`
+	DelayBeforeLRDay              NM      `hl7:"34,len=4,format=NM,display=Delay Before L. R. Day"`
 	CompanyPlanCode               ST      `hl7:"35,len=8,table=0042,display=Company Plan Code"`
 	PolicyNumber                  ST      `hl7:"36,len=15,display=Policy Number"`
-	PolicyDeductible              NM      `hl7:"37,len=12,display=Policy Deductible"`
-	PolicyLimitAmount             NM      `hl7:"38,len=12,display=Policy Limit - Amount"`
-	PolicyLimitDays               NM      `hl7:"39,len=4,display=Policy Limit - Days"`
-	RoomRateSemiPrivate           NM      `hl7:"40,len=12,display=Room Rate - Semi-private"`
-	RoomRatePrivate               NM      `hl7:"41,len=12,display=Room Rate - Private"`
+	PolicyDeductible              NM      `hl7:"37,len=12,format=NM,display=Policy Deductible"`
+	PolicyLimitAmount             NM      `hl7:"38,len=12,format=NM,display=Policy Limit - Amount"`
+	PolicyLimitDays               NM      `hl7:"39,len=4,format=NM,display=Policy Limit - Days"`
+	RoomRateSemiPrivate           NM      `hl7:"40,len=12,format=NM,display=Room Rate - Semi-private"`
+	RoomRatePrivate               NM      `hl7:"41,len=12,format=NM,display=Room Rate - Private"`
 	InsuredsEmploymentStatus      ID      `hl7:"42,len=1,table=0066,display=Insured's Employment Status"`
 	InsuredsSex                   ID      `hl7:"43,len=1,table=0001,display=Insured's Sex"`
 	InsuredsEmployerAddress       AD      `hl7:"44,len=106,display=Insured's Employer Address"`
@@ -237,7 +237,7 @@ type MSA struct {
 	AcknowledgmentCode        ID      `hl7:"1,required,len=2,table=0008,display=Acknowledgment Code"`
 	MessageControlID          ST      `hl7:"2,required,len=20,display=Message Control Id"`
 	TextMessage               ST      `hl7:"3,len=80,display=Text Message"`
-	ExpectedSequenceNumber    NM      `hl7:"4,len=15,display=Expected Sequence Number"`
+	ExpectedSequenceNumber    NM      `hl7:"4,len=15,format=NM,display=Expected Sequence Number"`
 	DelayedAcknowledgmentType ID      `hl7:"5,len=1,table=0102,display=Delayed Acknowledgment Type"`
 }
 
@@ -255,15 +255,15 @@ type MSH struct {
 	MessageType          ID      `hl7:"9,required,len=7,table=0076,display=Message Type"`
 	MessageControlID     ST      `hl7:"10,required,len=20,display=Message Control Id"`
 	ProcessingID         ID      `hl7:"11,required,len=1,table=0103,display=Processing Id"`
-	VersionID            NM      `hl7:"12,required,len=8,table=0104,display=Version Id"`
-	SequenceNumber       NM      `hl7:"13,len=15,display=Sequence Number"`
+	VersionID            NM      `hl7:"12,required,len=8,table=0104,format=NM,display=Version Id"`
+	SequenceNumber       NM      `hl7:"13,len=15,format=NM,display=Sequence Number"`
 	ContinuationPointer  ST      `hl7:"14,len=180,display=Continuation Pointer"`
 }
 
 // Next Of Kin
 type NK1 struct {
 	HL7                   HL7Name `hl7:",name=NK1,type=s"`
-	SetIDNextOfKin        SI      `hl7:"1,required,len=4,display=Set Id - Next Of Kin"`
+	SetIDNextOfKin        SI      `hl7:"1,required,len=4,format=SI,display=Set Id - Next Of Kin"`
 	NextOfKinName         PN      `hl7:"2,len=48,display=Next Of Kin Name"`
 	NextOfKinRelationship ST      `hl7:"3,len=15,table=0063,display=Next Of Kin Relationship"`
 	NextOfKinAddress      AD      `hl7:"4,len=106,display=Next Of Kin - Address"`
@@ -280,7 +280,7 @@ type NPU struct {
 // Notes And Comments
 type NTE struct {
 	HL7                   HL7Name `hl7:",name=NTE,type=s"`
-	SetIDNotesAndComments SI      `hl7:"1,len=4,display=Set Id - Notes And Comments"`
+	SetIDNotesAndComments SI      `hl7:"1,len=4,format=SI,display=Set Id - Notes And Comments"`
 	SourceOfComment       ID      `hl7:"2,len=8,table=0105,display=Source Of Comment"`
 	Comment               []TX    `hl7:"3,required,len=120,display=Comment"`
 }
@@ -288,7 +288,7 @@ type NTE struct {
 // Observation Request
 type OBR struct {
 	HL7                        HL7Name `hl7:",name=OBR,type=s"`
-	SetIDObservationRequest    SI      `hl7:"1,len=4,display=Set Id - Observation Request"`
+	SetIDObservationRequest    SI      `hl7:"1,len=4,format=SI,display=Set Id - Observation Request"`
 	PlacerOrder                CM      `hl7:"2,len=75,display=Placer Order #"`
 	FillerOrder                CM      `hl7:"3,len=75,display=Filler Order #"`
 	UniversalServiceIdent      CE      `hl7:"4,required,len=200,display=Universal Service Ident."`
@@ -329,15 +329,15 @@ type OBR struct {
 // Result
 type OBX struct {
 	HL7                     HL7Name `hl7:",name=OBX,type=s"`
-	SetIDObservationSimple  SI      `hl7:"1,len=4,display=Set Id - Observation Simple"`
+	SetIDObservationSimple  SI      `hl7:"1,len=4,format=SI,display=Set Id - Observation Simple"`
 	ValueType               ID      `hl7:"2,len=2,table=0125,display=Value Type"`
 	ObservationIdentifier   CE      `hl7:"3,required,len=80,display=Observation Identifier"`
-	ObservationSubID        NM      `hl7:"4,len=20,display=Observation Sub-id"`
+	ObservationSubID        NM      `hl7:"4,len=20,format=NM,display=Observation Sub-id"`
 	ObservationResults      ST      `hl7:"5,required,len=65,display=Observation Results"`
 	Units                   ID      `hl7:"6,len=20,display=Units"`
 	ReferencesRange         ST      `hl7:"7,len=60,display=References Range"`
 	AbnormalFlags           []ST    `hl7:"8,max=5,len=10,table=0078,display=Abnormal Flags"`
-	Probability             NM      `hl7:"9,len=5,display=Probability"`
+	Probability             NM      `hl7:"9,len=5,format=NM,display=Probability"`
 	NatureOfAbnormalTest    ID      `hl7:"10,len=5,table=0080,display=Nature Of Abnormal Test"`
 	ObservResultStatus      ID      `hl7:"11,len=2,table=0085,display=Observ Result Status"`
 	DateLastObsNormalValues TS      `hl7:"12,len=19,format=YMDHMS,display=Date Last Obs Normal Values"`
@@ -382,7 +382,7 @@ type PD1 struct {
 // Patient Identification
 type PID struct {
 	HL7                         HL7Name `hl7:",name=PID,type=s"`
-	SetIDPatientID              SI      `hl7:"1,len=4,display=Set Id - Patient Id"`
+	SetIDPatientID              SI      `hl7:"1,len=4,format=SI,display=Set Id - Patient Id"`
 	PatientIDExternalExternalID CK      `hl7:"2,len=16,display=Patient Id External (external Id)"`
 	PatientIDInternalInternalID CK      `hl7:"3,required,len=16,display=Patient Id Internal (internal Id)"`
 	AlternatePatientID          ST      `hl7:"4,len=12,display=Alternate Patient Id"`
@@ -407,16 +407,16 @@ type PID struct {
 // Procedures
 type PR1 struct {
 	HL7                   HL7Name `hl7:",name=PR1,type=s"`
-	SetIDProcedure        []SI    `hl7:"1,required,len=4,display=Set Id - Procedure"`
+	SetIDProcedure        []SI    `hl7:"1,required,len=4,format=SI,display=Set Id - Procedure"`
 	ProcedureCodingMethod ID      `hl7:"2,required,len=2,table=0089,display=Procedure Coding Method."`
 	ProcedureCode         ID      `hl7:"3,required,len=10,table=0088,display=Procedure Code"`
 	ProcedureDescription  ST      `hl7:"4,len=40,display=Procedure Description"`
 	ProcedureDateTime     TS      `hl7:"5,required,len=19,format=YMDHMS,display=Procedure Date/Time"`
 	ProcedureType         ID      `hl7:"6,required,len=2,table=0090,display=Procedure Type"`
-	ProcedureMinutes      NM      `hl7:"7,len=4,display=Procedure Minutes"`
+	ProcedureMinutes      NM      `hl7:"7,len=4,format=NM,display=Procedure Minutes"`
 	Anesthesiologist      CN      `hl7:"8,len=60,table=0010,display=Anesthesiologist"`
 	AnesthesiaCode        ID      `hl7:"9,len=2,table=0019,display=Anesthesia Code"`
-	AnesthesiaMinutes     NM      `hl7:"10,len=4,display=Anesthesia Minutes"`
+	AnesthesiaMinutes     NM      `hl7:"10,len=4,format=NM,display=Anesthesia Minutes"`
 	Surgeon               CN      `hl7:"11,len=60,table=0010,display=Surgeon"`
 	ResidentCode          CN      `hl7:"12,len=60,table=0010,display=Resident Code"`
 	ConsentCode           ID      `hl7:"13,len=2,table=0059,display=Consent Code"`
@@ -425,7 +425,7 @@ type PR1 struct {
 // Patient Visit
 type PV1 struct {
 	HL7                     HL7Name `hl7:",name=PV1,type=s"`
-	SetIDPatientVisit       SI      `hl7:"1,len=4,display=Set Id - Patient Visit"`
+	SetIDPatientVisit       SI      `hl7:"1,len=4,format=SI,display=Set Id - Patient Visit"`
 	PatientClass            ID      `hl7:"2,required,len=1,table=0004,display=Patient Class"`
 	AssignedPatientLocation ID      `hl7:"3,required,len=12,table=0079,display=Assigned Patient Location"`
 	AdmissionType           ID      `hl7:"4,len=2,table=0007,display=Admission Type"`
@@ -443,21 +443,21 @@ type PV1 struct {
 	VipIndicator            ID      `hl7:"16,len=2,table=0099,display=Vip Indicator"`
 	AdmittingDoctor         CN      `hl7:"17,len=60,table=0010,display=Admitting Doctor"`
 	PatientType             ID      `hl7:"18,len=2,table=0018,display=Patient Type"`
-	VisitNumber             NM      `hl7:"19,len=4,display=Visit Number"`
+	VisitNumber             NM      `hl7:"19,len=4,format=NM,display=Visit Number"`
 	FinancialClass          []ID    `hl7:"20,max=4,len=11,table=0064,display=Financial Class"`
 	ChargePriceIndicator    ID      `hl7:"21,len=2,table=0032,display=Charge Price Indicator"`
 	CourtesyCode            ID      `hl7:"22,len=2,table=0045,display=Courtesy Code"`
 	CreditRating            ID      `hl7:"23,len=2,table=0046,display=Credit Rating"`
 	ContractCode            []ID    `hl7:"24,len=2,table=0044,display=Contract Code"`
 	ContractEffectiveDate   []DT    `hl7:"25,len=8,format=YMD,display=Contract Effective Date"`
-	ContractAmount          []NM    `hl7:"26,len=12,display=Contract Amount"`
-	ContractPeriod          []NM    `hl7:"27,len=3,display=Contract Period"`
+	ContractAmount          []NM    `hl7:"26,len=12,format=NM,display=Contract Amount"`
+	ContractPeriod          []NM    `hl7:"27,len=3,format=NM,display=Contract Period"`
 	InterestCode            ID      `hl7:"28,len=2,table=0073,display=Interest Code"`
 	TransferToBadDebtCode   ID      `hl7:"29,len=1,table=0110,display=Transfer To Bad Debt Code"`
 	TransferToBadDebtDate   DT      `hl7:"30,len=8,format=YMD,display=Transfer To Bad Debt Date"`
 	BadDebtAgencyCode       ST      `hl7:"31,len=10,table=0021,display=Bad Debt Agency Code"`
-	BadDebtTransferAmount   NM      `hl7:"32,len=12,display=Bad Debt Transfer Amount"`
-	BadDebtRecoveryAmount   NM      `hl7:"33,len=12,display=Bad Debt Recovery Amount"`
+	BadDebtTransferAmount   NM      `hl7:"32,len=12,format=NM,display=Bad Debt Transfer Amount"`
+	BadDebtRecoveryAmount   NM      `hl7:"33,len=12,format=NM,display=Bad Debt Recovery Amount"`
 	DeleteAccountIndicator  ID      `hl7:"34,len=1,table=0111,display=Delete Account Indicator"`
 	DeleteAccountDate       DT      `hl7:"35,len=8,format=YMD,display=Delete Account Date"`
 	DischargeDisposition    ID      `hl7:"36,len=2,table=0112,display=Discharge Disposition"`
@@ -470,10 +470,10 @@ type PV1 struct {
 	PriorTemporaryLocation  ID      `hl7:"43,len=12,table=0079,display=Prior Temporary Location"`
 	AdmitDateTime           TS      `hl7:"44,len=19,format=YMDHMS,display=Admit Date/Time"`
 	DischargeDateTime       TS      `hl7:"45,len=19,format=YMDHMS,display=Discharge Date/Time"`
-	CurrentPatientBalance   NM      `hl7:"46,len=12,display=Current Patient Balance"`
-	TotalCharges            NM      `hl7:"47,len=12,display=Total Charges"`
-	TotalAdjustments        NM      `hl7:"48,len=12,display=Total Adjustments"`
-	TotalPayments           NM      `hl7:"49,len=12,display=Total Payments"`
+	CurrentPatientBalance   NM      `hl7:"46,len=12,format=NM,display=Current Patient Balance"`
+	TotalCharges            NM      `hl7:"47,len=12,format=NM,display=Total Charges"`
+	TotalAdjustments        NM      `hl7:"48,len=12,format=NM,display=Total Adjustments"`
+	TotalPayments           NM      `hl7:"49,len=12,format=NM,display=Total Payments"`
 }
 
 // Query Definition
@@ -506,7 +506,7 @@ type QRF struct {
 // Ub82 Data
 type UB1 struct {
 	HL7                       HL7Name `hl7:",name=UB1,type=s"`
-	SetIDUb82                 SI      `hl7:"1,len=4,display=Set Id - Ub82"`
+	SetIDUb82                 SI      `hl7:"1,len=4,format=SI,display=Set Id - Ub82"`
 	BloodDeductible           ST      `hl7:"2,len=1,display=Blood Deductible"`
 	BloodFurnPintsOf40        ST      `hl7:"3,len=2,display=Blood Furn.-pints Of (40)"`
 	BloodReplacedPints41      ST      `hl7:"4,len=2,display=Blood Replaced-pints (41)"`
@@ -0,0 +1,10155 @@
+// Code generated by "hl7fetch -pkgdir h220 -root ./genjson -version 2.2 -enum"; DO NOT EDIT.
+
+package h220
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// builder holds the state of a trigger or group builder.
+type builder struct {
+	path string // Path of the trigger or group, such as "ORU_R01.PatientResult[0]".
+	seq  int    // Set ID of single segments in the trigger or group.
+	errs []error
+}
+
+func (b *builder) errorf(format string, args ...any) {
+	b.errs = append(b.errs, fmt.Errorf("%s: %s", b.path, fmt.Sprintf(format, args...)))
+}
+
+func (b *builder) child(name string, index int) builder {
+	if index < 0 {
+		return builder{path: b.path + "." + name, seq: b.seq}
+	}
+	return builder{path: b.path + "." + name + "[" + strconv.Itoa(index) + "]", seq: index + 1}
+}
+
+// check adds the validation errors of a trigger or group: missing required items and too many repetitions.
+func (b *builder) check(name string, count, max int, required bool) {
+	switch {
+	case required && count == 0:
+		b.errorf("missing required %s", name)
+	case max > 0 && count > max:
+		b.errorf("%s repeats %d times, more than %d", name, count, max)
+	}
+}
+
+// setOnce sets a single segment, adding an error if the segment is already set.
+func setOnce[T any](b *builder, name string, field **T, v T) {
+	if *field != nil {
+		b.errorf("%s set more than once", name)
+	}
+	*field = &v
+}
+
+func builderCount(present bool) int {
+	if present {
+		return 1
+	}
+	return 0
+}
+
+// ACKBuilder adds the groups and segments of a ACK.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ACKBuilder struct {
+	b builder
+	v ACK
+}
+
+// MSH sets the MSH segment.
+func (b *ACKBuilder) MSH(v MSH) *ACKBuilder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MSA sets the MSA segment.
+func (b *ACKBuilder) MSA(v MSA) *ACKBuilder {
+	setOnce(&b.b, "MSA", &b.v.MSA, v)
+	return b
+}
+
+// ERR sets the ERR segment.
+func (b *ACKBuilder) ERR(v ERR) *ACKBuilder {
+	setOnce(&b.b, "ERR", &b.v.ERR, v)
+	return b
+}
+
+func (b *ACKBuilder) build() (ACK, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MSA", builderCount(v.MSA != nil), 0, true)
+	bb.check("ERR", builderCount(v.ERR != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewACKBuilder returns a builder for ACK.
+func NewACKBuilder() *ACKBuilder {
+	return &ACKBuilder{b: builder{path: "ACK", seq: 1}}
+}
+
+// Build returns the ACK and the validation errors, if any.
+func (b *ACKBuilder) Build() (ACK, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADR_A19_QueryResponseBuilder adds the groups and segments of a ADR_A19_QueryResponse.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADR_A19_QueryResponseBuilder struct {
+	b          builder
+	v          ADR_A19_QueryResponse
+	gInsurance []*ADR_A19_InsuranceBuilder
+}
+
+// EVN sets the EVN segment.
+func (b *ADR_A19_QueryResponseBuilder) EVN(v EVN) *ADR_A19_QueryResponseBuilder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADR_A19_QueryResponseBuilder) PID(v PID) *ADR_A19_QueryResponseBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NK1 adds a NK1 segment.
+func (b *ADR_A19_QueryResponseBuilder) NK1(v NK1) *ADR_A19_QueryResponseBuilder {
+	b.v.NK1 = append(b.v.NK1, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADR_A19_QueryResponseBuilder) PV1(v PV1) *ADR_A19_QueryResponseBuilder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADR_A19_QueryResponseBuilder) PV2(v PV2) *ADR_A19_QueryResponseBuilder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADR_A19_QueryResponseBuilder) OBX(v OBX) *ADR_A19_QueryResponseBuilder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+// AL1 adds a AL1 segment.
+func (b *ADR_A19_QueryResponseBuilder) AL1(v AL1) *ADR_A19_QueryResponseBuilder {
+	b.v.AL1 = append(b.v.AL1, v)
+	return b
+}
+
+// DG1 adds a DG1 segment.
+func (b *ADR_A19_QueryResponseBuilder) DG1(v DG1) *ADR_A19_QueryResponseBuilder {
+	b.v.DG1 = append(b.v.DG1, v)
+	return b
+}
+
+// PR1 adds a PR1 segment.
+func (b *ADR_A19_QueryResponseBuilder) PR1(v PR1) *ADR_A19_QueryResponseBuilder {
+	b.v.PR1 = append(b.v.PR1, v)
+	return b
+}
+
+// GT1 adds a GT1 segment.
+func (b *ADR_A19_QueryResponseBuilder) GT1(v GT1) *ADR_A19_QueryResponseBuilder {
+	b.v.GT1 = append(b.v.GT1, v)
+	return b
+}
+
+// Insurance adds a Insurance group and returns the group builder.
+func (b *ADR_A19_QueryResponseBuilder) Insurance() *ADR_A19_InsuranceBuilder {
+	g := &ADR_A19_InsuranceBuilder{b: b.b.child("Insurance", len(b.gInsurance))}
+	b.gInsurance = append(b.gInsurance, g)
+	return g
+}
+
+// ACC sets the ACC segment.
+func (b *ADR_A19_QueryResponseBuilder) ACC(v ACC) *ADR_A19_QueryResponseBuilder {
+	setOnce(&b.b, "ACC", &b.v.ACC, v)
+	return b
+}
+
+// UB1 sets the UB1 segment.
+func (b *ADR_A19_QueryResponseBuilder) UB1(v UB1) *ADR_A19_QueryResponseBuilder {
+	setOnce(&b.b, "UB1", &b.v.UB1, v)
+	return b
+}
+
+// UB2 sets the UB2 segment.
+func (b *ADR_A19_QueryResponseBuilder) UB2(v UB2) *ADR_A19_QueryResponseBuilder {
+	setOnce(&b.b, "UB2", &b.v.UB2, v)
+	return b
+}
+
+func (b *ADR_A19_QueryResponseBuilder) build() (ADR_A19_QueryResponse, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gInsurance {
+		gv, errs := g.build()
+		v.Insurance = append(v.Insurance, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("EVN", builderCount(v.EVN != nil), 0, false)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NK1", len(v.NK1), 0, false)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	bb.check("AL1", len(v.AL1), 0, false)
+	bb.check("DG1", len(v.DG1), 0, false)
+	bb.check("PR1", len(v.PR1), 0, false)
+	bb.check("GT1", len(v.GT1), 0, false)
+	bb.check("Insurance", len(v.Insurance), 0, false)
+	bb.check("ACC", builderCount(v.ACC != nil), 0, false)
+	bb.check("UB1", builderCount(v.UB1 != nil), 0, false)
+	bb.check("UB2", builderCount(v.UB2 != nil), 0, false)
+	return v, bb.errs
+}
+
+// ADR_A19_InsuranceBuilder adds the groups and segments of a ADR_A19_Insurance.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADR_A19_InsuranceBuilder struct {
+	b builder
+	v ADR_A19_Insurance
+}
+
+// IN1 sets the IN1 segment.
+func (b *ADR_A19_InsuranceBuilder) IN1(v IN1) *ADR_A19_InsuranceBuilder {
+	setOnce(&b.b, "IN1", &b.v.IN1, v)
+	return b
+}
+
+// IN2 sets the IN2 segment.
+func (b *ADR_A19_InsuranceBuilder) IN2(v IN2) *ADR_A19_InsuranceBuilder {
+	setOnce(&b.b, "IN2", &b.v.IN2, v)
+	return b
+}
+
+// IN3 sets the IN3 segment.
+func (b *ADR_A19_InsuranceBuilder) IN3(v IN3) *ADR_A19_InsuranceBuilder {
+	setOnce(&b.b, "IN3", &b.v.IN3, v)
+	return b
+}
+
+func (b *ADR_A19_InsuranceBuilder) build() (ADR_A19_Insurance, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("IN1", builderCount(v.IN1 != nil), 0, true)
+	bb.check("IN2", builderCount(v.IN2 != nil), 0, false)
+	bb.check("IN3", builderCount(v.IN3 != nil), 0, false)
+	return v, bb.errs
+}
+
+// ADR_A19Builder adds the groups and segments of a ADR_A19.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADR_A19Builder struct {
+	b              builder
+	v              ADR_A19
+	gQueryResponse []*ADR_A19_QueryResponseBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *ADR_A19Builder) MSH(v MSH) *ADR_A19Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MSA sets the MSA segment.
+func (b *ADR_A19Builder) MSA(v MSA) *ADR_A19Builder {
+	setOnce(&b.b, "MSA", &b.v.MSA, v)
+	return b
+}
+
+// ERR sets the ERR segment.
+func (b *ADR_A19Builder) ERR(v ERR) *ADR_A19Builder {
+	setOnce(&b.b, "ERR", &b.v.ERR, v)
+	return b
+}
+
+// QRD sets the QRD segment.
+func (b *ADR_A19Builder) QRD(v QRD) *ADR_A19Builder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QueryResponse adds a QueryResponse group and returns the group builder.
+func (b *ADR_A19Builder) QueryResponse() *ADR_A19_QueryResponseBuilder {
+	g := &ADR_A19_QueryResponseBuilder{b: b.b.child("QueryResponse", len(b.gQueryResponse))}
+	b.gQueryResponse = append(b.gQueryResponse, g)
+	return g
+}
+
+// DSC sets the DSC segment.
+func (b *ADR_A19Builder) DSC(v DSC) *ADR_A19Builder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *ADR_A19Builder) build() (ADR_A19, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gQueryResponse {
+		gv, errs := g.build()
+		v.QueryResponse = append(v.QueryResponse, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MSA", builderCount(v.MSA != nil), 0, true)
+	bb.check("ERR", builderCount(v.ERR != nil), 0, false)
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QueryResponse", len(v.QueryResponse), 0, true)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADR_A19Builder returns a builder for ADR_A19.
+func NewADR_A19Builder() *ADR_A19Builder {
+	return &ADR_A19Builder{b: builder{path: "ADR_A19", seq: 1}}
+}
+
+// Build returns the ADR_A19 and the validation errors, if any.
+func (b *ADR_A19Builder) Build() (ADR_A19, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A01_InsuranceBuilder adds the groups and segments of a ADT_A01_Insurance.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A01_InsuranceBuilder struct {
+	b builder
+	v ADT_A01_Insurance
+}
+
+// IN1 sets the IN1 segment.
+func (b *ADT_A01_InsuranceBuilder) IN1(v IN1) *ADT_A01_InsuranceBuilder {
+	setOnce(&b.b, "IN1", &b.v.IN1, v)
+	return b
+}
+
+// IN2 sets the IN2 segment.
+func (b *ADT_A01_InsuranceBuilder) IN2(v IN2) *ADT_A01_InsuranceBuilder {
+	setOnce(&b.b, "IN2", &b.v.IN2, v)
+	return b
+}
+
+// IN3 sets the IN3 segment.
+func (b *ADT_A01_InsuranceBuilder) IN3(v IN3) *ADT_A01_InsuranceBuilder {
+	setOnce(&b.b, "IN3", &b.v.IN3, v)
+	return b
+}
+
+func (b *ADT_A01_InsuranceBuilder) build() (ADT_A01_Insurance, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("IN1", builderCount(v.IN1 != nil), 0, true)
+	bb.check("IN2", builderCount(v.IN2 != nil), 0, false)
+	bb.check("IN3", builderCount(v.IN3 != nil), 0, false)
+	return v, bb.errs
+}
+
+// ADT_A01Builder adds the groups and segments of a ADT_A01.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A01Builder struct {
+	b          builder
+	v          ADT_A01
+	gInsurance []*ADT_A01_InsuranceBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A01Builder) MSH(v MSH) *ADT_A01Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A01Builder) EVN(v EVN) *ADT_A01Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A01Builder) PID(v PID) *ADT_A01Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NK1 adds a NK1 segment.
+func (b *ADT_A01Builder) NK1(v NK1) *ADT_A01Builder {
+	b.v.NK1 = append(b.v.NK1, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A01Builder) PV1(v PV1) *ADT_A01Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A01Builder) PV2(v PV2) *ADT_A01Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A01Builder) OBX(v OBX) *ADT_A01Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+// AL1 adds a AL1 segment.
+func (b *ADT_A01Builder) AL1(v AL1) *ADT_A01Builder {
+	b.v.AL1 = append(b.v.AL1, v)
+	return b
+}
+
+// DG1 adds a DG1 segment.
+func (b *ADT_A01Builder) DG1(v DG1) *ADT_A01Builder {
+	b.v.DG1 = append(b.v.DG1, v)
+	return b
+}
+
+// PR1 adds a PR1 segment.
+func (b *ADT_A01Builder) PR1(v PR1) *ADT_A01Builder {
+	b.v.PR1 = append(b.v.PR1, v)
+	return b
+}
+
+// GT1 adds a GT1 segment.
+func (b *ADT_A01Builder) GT1(v GT1) *ADT_A01Builder {
+	b.v.GT1 = append(b.v.GT1, v)
+	return b
+}
+
+// Insurance adds a Insurance group and returns the group builder.
+func (b *ADT_A01Builder) Insurance() *ADT_A01_InsuranceBuilder {
+	g := &ADT_A01_InsuranceBuilder{b: b.b.child("Insurance", len(b.gInsurance))}
+	b.gInsurance = append(b.gInsurance, g)
+	return g
+}
+
+// ACC sets the ACC segment.
+func (b *ADT_A01Builder) ACC(v ACC) *ADT_A01Builder {
+	setOnce(&b.b, "ACC", &b.v.ACC, v)
+	return b
+}
+
+// UB1 sets the UB1 segment.
+func (b *ADT_A01Builder) UB1(v UB1) *ADT_A01Builder {
+	setOnce(&b.b, "UB1", &b.v.UB1, v)
+	return b
+}
+
+// UB2 sets the UB2 segment.
+func (b *ADT_A01Builder) UB2(v UB2) *ADT_A01Builder {
+	setOnce(&b.b, "UB2", &b.v.UB2, v)
+	return b
+}
+
+func (b *ADT_A01Builder) build() (ADT_A01, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gInsurance {
+		gv, errs := g.build()
+		v.Insurance = append(v.Insurance, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NK1", len(v.NK1), 0, false)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	bb.check("AL1", len(v.AL1), 0, false)
+	bb.check("DG1", len(v.DG1), 0, false)
+	bb.check("PR1", len(v.PR1), 0, false)
+	bb.check("GT1", len(v.GT1), 0, false)
+	bb.check("Insurance", len(v.Insurance), 0, false)
+	bb.check("ACC", builderCount(v.ACC != nil), 0, false)
+	bb.check("UB1", builderCount(v.UB1 != nil), 0, false)
+	bb.check("UB2", builderCount(v.UB2 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A01Builder returns a builder for ADT_A01.
+func NewADT_A01Builder() *ADT_A01Builder {
+	return &ADT_A01Builder{b: builder{path: "ADT_A01", seq: 1}}
+}
+
+// Build returns the ADT_A01 and the validation errors, if any.
+func (b *ADT_A01Builder) Build() (ADT_A01, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A02Builder adds the groups and segments of a ADT_A02.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A02Builder struct {
+	b builder
+	v ADT_A02
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A02Builder) MSH(v MSH) *ADT_A02Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A02Builder) EVN(v EVN) *ADT_A02Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A02Builder) PID(v PID) *ADT_A02Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A02Builder) PV1(v PV1) *ADT_A02Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A02Builder) PV2(v PV2) *ADT_A02Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A02Builder) OBX(v OBX) *ADT_A02Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+func (b *ADT_A02Builder) build() (ADT_A02, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A02Builder returns a builder for ADT_A02.
+func NewADT_A02Builder() *ADT_A02Builder {
+	return &ADT_A02Builder{b: builder{path: "ADT_A02", seq: 1}}
+}
+
+// Build returns the ADT_A02 and the validation errors, if any.
+func (b *ADT_A02Builder) Build() (ADT_A02, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A03Builder adds the groups and segments of a ADT_A03.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A03Builder struct {
+	b builder
+	v ADT_A03
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A03Builder) MSH(v MSH) *ADT_A03Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A03Builder) EVN(v EVN) *ADT_A03Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A03Builder) PID(v PID) *ADT_A03Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A03Builder) PV1(v PV1) *ADT_A03Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A03Builder) PV2(v PV2) *ADT_A03Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A03Builder) OBX(v OBX) *ADT_A03Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+func (b *ADT_A03Builder) build() (ADT_A03, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A03Builder returns a builder for ADT_A03.
+func NewADT_A03Builder() *ADT_A03Builder {
+	return &ADT_A03Builder{b: builder{path: "ADT_A03", seq: 1}}
+}
+
+// Build returns the ADT_A03 and the validation errors, if any.
+func (b *ADT_A03Builder) Build() (ADT_A03, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A04_InsuranceBuilder adds the groups and segments of a ADT_A04_Insurance.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A04_InsuranceBuilder struct {
+	b builder
+	v ADT_A04_Insurance
+}
+
+// IN1 sets the IN1 segment.
+func (b *ADT_A04_InsuranceBuilder) IN1(v IN1) *ADT_A04_InsuranceBuilder {
+	setOnce(&b.b, "IN1", &b.v.IN1, v)
+	return b
+}
+
+// IN2 sets the IN2 segment.
+func (b *ADT_A04_InsuranceBuilder) IN2(v IN2) *ADT_A04_InsuranceBuilder {
+	setOnce(&b.b, "IN2", &b.v.IN2, v)
+	return b
+}
+
+// IN3 sets the IN3 segment.
+func (b *ADT_A04_InsuranceBuilder) IN3(v IN3) *ADT_A04_InsuranceBuilder {
+	setOnce(&b.b, "IN3", &b.v.IN3, v)
+	return b
+}
+
+func (b *ADT_A04_InsuranceBuilder) build() (ADT_A04_Insurance, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("IN1", builderCount(v.IN1 != nil), 0, true)
+	bb.check("IN2", builderCount(v.IN2 != nil), 0, false)
+	bb.check("IN3", builderCount(v.IN3 != nil), 0, false)
+	return v, bb.errs
+}
+
+// ADT_A04Builder adds the groups and segments of a ADT_A04.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A04Builder struct {
+	b          builder
+	v          ADT_A04
+	gInsurance []*ADT_A04_InsuranceBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A04Builder) MSH(v MSH) *ADT_A04Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A04Builder) EVN(v EVN) *ADT_A04Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A04Builder) PID(v PID) *ADT_A04Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NK1 adds a NK1 segment.
+func (b *ADT_A04Builder) NK1(v NK1) *ADT_A04Builder {
+	b.v.NK1 = append(b.v.NK1, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A04Builder) PV1(v PV1) *ADT_A04Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A04Builder) PV2(v PV2) *ADT_A04Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A04Builder) OBX(v OBX) *ADT_A04Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+// AL1 adds a AL1 segment.
+func (b *ADT_A04Builder) AL1(v AL1) *ADT_A04Builder {
+	b.v.AL1 = append(b.v.AL1, v)
+	return b
+}
+
+// DG1 adds a DG1 segment.
+func (b *ADT_A04Builder) DG1(v DG1) *ADT_A04Builder {
+	b.v.DG1 = append(b.v.DG1, v)
+	return b
+}
+
+// PR1 adds a PR1 segment.
+func (b *ADT_A04Builder) PR1(v PR1) *ADT_A04Builder {
+	b.v.PR1 = append(b.v.PR1, v)
+	return b
+}
+
+// GT1 adds a GT1 segment.
+func (b *ADT_A04Builder) GT1(v GT1) *ADT_A04Builder {
+	b.v.GT1 = append(b.v.GT1, v)
+	return b
+}
+
+// Insurance adds a Insurance group and returns the group builder.
+func (b *ADT_A04Builder) Insurance() *ADT_A04_InsuranceBuilder {
+	g := &ADT_A04_InsuranceBuilder{b: b.b.child("Insurance", len(b.gInsurance))}
+	b.gInsurance = append(b.gInsurance, g)
+	return g
+}
+
+// ACC sets the ACC segment.
+func (b *ADT_A04Builder) ACC(v ACC) *ADT_A04Builder {
+	setOnce(&b.b, "ACC", &b.v.ACC, v)
+	return b
+}
+
+// UB1 sets the UB1 segment.
+func (b *ADT_A04Builder) UB1(v UB1) *ADT_A04Builder {
+	setOnce(&b.b, "UB1", &b.v.UB1, v)
+	return b
+}
+
+// UB2 sets the UB2 segment.
+func (b *ADT_A04Builder) UB2(v UB2) *ADT_A04Builder {
+	setOnce(&b.b, "UB2", &b.v.UB2, v)
+	return b
+}
+
+func (b *ADT_A04Builder) build() (ADT_A04, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gInsurance {
+		gv, errs := g.build()
+		v.Insurance = append(v.Insurance, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NK1", len(v.NK1), 0, false)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	bb.check("AL1", len(v.AL1), 0, false)
+	bb.check("DG1", len(v.DG1), 0, false)
+	bb.check("PR1", len(v.PR1), 0, false)
+	bb.check("GT1", len(v.GT1), 0, false)
+	bb.check("Insurance", len(v.Insurance), 0, false)
+	bb.check("ACC", builderCount(v.ACC != nil), 0, false)
+	bb.check("UB1", builderCount(v.UB1 != nil), 0, false)
+	bb.check("UB2", builderCount(v.UB2 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A04Builder returns a builder for ADT_A04.
+func NewADT_A04Builder() *ADT_A04Builder {
+	return &ADT_A04Builder{b: builder{path: "ADT_A04", seq: 1}}
+}
+
+// Build returns the ADT_A04 and the validation errors, if any.
+func (b *ADT_A04Builder) Build() (ADT_A04, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A05_InsuranceBuilder adds the groups and segments of a ADT_A05_Insurance.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A05_InsuranceBuilder struct {
+	b builder
+	v ADT_A05_Insurance
+}
+
+// IN1 sets the IN1 segment.
+func (b *ADT_A05_InsuranceBuilder) IN1(v IN1) *ADT_A05_InsuranceBuilder {
+	setOnce(&b.b, "IN1", &b.v.IN1, v)
+	return b
+}
+
+// IN2 sets the IN2 segment.
+func (b *ADT_A05_InsuranceBuilder) IN2(v IN2) *ADT_A05_InsuranceBuilder {
+	setOnce(&b.b, "IN2", &b.v.IN2, v)
+	return b
+}
+
+// IN3 sets the IN3 segment.
+func (b *ADT_A05_InsuranceBuilder) IN3(v IN3) *ADT_A05_InsuranceBuilder {
+	setOnce(&b.b, "IN3", &b.v.IN3, v)
+	return b
+}
+
+func (b *ADT_A05_InsuranceBuilder) build() (ADT_A05_Insurance, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("IN1", builderCount(v.IN1 != nil), 0, true)
+	bb.check("IN2", builderCount(v.IN2 != nil), 0, false)
+	bb.check("IN3", builderCount(v.IN3 != nil), 0, false)
+	return v, bb.errs
+}
+
+// ADT_A05Builder adds the groups and segments of a ADT_A05.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A05Builder struct {
+	b          builder
+	v          ADT_A05
+	gInsurance []*ADT_A05_InsuranceBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A05Builder) MSH(v MSH) *ADT_A05Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A05Builder) EVN(v EVN) *ADT_A05Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A05Builder) PID(v PID) *ADT_A05Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NK1 adds a NK1 segment.
+func (b *ADT_A05Builder) NK1(v NK1) *ADT_A05Builder {
+	b.v.NK1 = append(b.v.NK1, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A05Builder) PV1(v PV1) *ADT_A05Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A05Builder) PV2(v PV2) *ADT_A05Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A05Builder) OBX(v OBX) *ADT_A05Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+// AL1 adds a AL1 segment.
+func (b *ADT_A05Builder) AL1(v AL1) *ADT_A05Builder {
+	b.v.AL1 = append(b.v.AL1, v)
+	return b
+}
+
+// DG1 adds a DG1 segment.
+func (b *ADT_A05Builder) DG1(v DG1) *ADT_A05Builder {
+	b.v.DG1 = append(b.v.DG1, v)
+	return b
+}
+
+// PR1 adds a PR1 segment.
+func (b *ADT_A05Builder) PR1(v PR1) *ADT_A05Builder {
+	b.v.PR1 = append(b.v.PR1, v)
+	return b
+}
+
+// GT1 adds a GT1 segment.
+func (b *ADT_A05Builder) GT1(v GT1) *ADT_A05Builder {
+	b.v.GT1 = append(b.v.GT1, v)
+	return b
+}
+
+// Insurance adds a Insurance group and returns the group builder.
+func (b *ADT_A05Builder) Insurance() *ADT_A05_InsuranceBuilder {
+	g := &ADT_A05_InsuranceBuilder{b: b.b.child("Insurance", len(b.gInsurance))}
+	b.gInsurance = append(b.gInsurance, g)
+	return g
+}
+
+// ACC sets the ACC segment.
+func (b *ADT_A05Builder) ACC(v ACC) *ADT_A05Builder {
+	setOnce(&b.b, "ACC", &b.v.ACC, v)
+	return b
+}
+
+// UB1 sets the UB1 segment.
+func (b *ADT_A05Builder) UB1(v UB1) *ADT_A05Builder {
+	setOnce(&b.b, "UB1", &b.v.UB1, v)
+	return b
+}
+
+// UB2 sets the UB2 segment.
+func (b *ADT_A05Builder) UB2(v UB2) *ADT_A05Builder {
+	setOnce(&b.b, "UB2", &b.v.UB2, v)
+	return b
+}
+
+func (b *ADT_A05Builder) build() (ADT_A05, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gInsurance {
+		gv, errs := g.build()
+		v.Insurance = append(v.Insurance, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NK1", len(v.NK1), 0, false)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	bb.check("AL1", len(v.AL1), 0, false)
+	bb.check("DG1", len(v.DG1), 0, false)
+	bb.check("PR1", len(v.PR1), 0, false)
+	bb.check("GT1", len(v.GT1), 0, false)
+	bb.check("Insurance", len(v.Insurance), 0, false)
+	bb.check("ACC", builderCount(v.ACC != nil), 0, false)
+	bb.check("UB1", builderCount(v.UB1 != nil), 0, false)
+	bb.check("UB2", builderCount(v.UB2 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A05Builder returns a builder for ADT_A05.
+func NewADT_A05Builder() *ADT_A05Builder {
+	return &ADT_A05Builder{b: builder{path: "ADT_A05", seq: 1}}
+}
+
+// Build returns the ADT_A05 and the validation errors, if any.
+func (b *ADT_A05Builder) Build() (ADT_A05, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A06_InsuranceBuilder adds the groups and segments of a ADT_A06_Insurance.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A06_InsuranceBuilder struct {
+	b builder
+	v ADT_A06_Insurance
+}
+
+// IN1 sets the IN1 segment.
+func (b *ADT_A06_InsuranceBuilder) IN1(v IN1) *ADT_A06_InsuranceBuilder {
+	setOnce(&b.b, "IN1", &b.v.IN1, v)
+	return b
+}
+
+// IN2 sets the IN2 segment.
+func (b *ADT_A06_InsuranceBuilder) IN2(v IN2) *ADT_A06_InsuranceBuilder {
+	setOnce(&b.b, "IN2", &b.v.IN2, v)
+	return b
+}
+
+// IN3 sets the IN3 segment.
+func (b *ADT_A06_InsuranceBuilder) IN3(v IN3) *ADT_A06_InsuranceBuilder {
+	setOnce(&b.b, "IN3", &b.v.IN3, v)
+	return b
+}
+
+func (b *ADT_A06_InsuranceBuilder) build() (ADT_A06_Insurance, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("IN1", builderCount(v.IN1 != nil), 0, true)
+	bb.check("IN2", builderCount(v.IN2 != nil), 0, false)
+	bb.check("IN3", builderCount(v.IN3 != nil), 0, false)
+	return v, bb.errs
+}
+
+// ADT_A06Builder adds the groups and segments of a ADT_A06.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A06Builder struct {
+	b          builder
+	v          ADT_A06
+	gInsurance []*ADT_A06_InsuranceBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A06Builder) MSH(v MSH) *ADT_A06Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A06Builder) EVN(v EVN) *ADT_A06Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A06Builder) PID(v PID) *ADT_A06Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// MRG sets the MRG segment.
+func (b *ADT_A06Builder) MRG(v MRG) *ADT_A06Builder {
+	setOnce(&b.b, "MRG", &b.v.MRG, v)
+	return b
+}
+
+// NK1 adds a NK1 segment.
+func (b *ADT_A06Builder) NK1(v NK1) *ADT_A06Builder {
+	b.v.NK1 = append(b.v.NK1, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A06Builder) PV1(v PV1) *ADT_A06Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A06Builder) PV2(v PV2) *ADT_A06Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A06Builder) OBX(v OBX) *ADT_A06Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+// AL1 adds a AL1 segment.
+func (b *ADT_A06Builder) AL1(v AL1) *ADT_A06Builder {
+	b.v.AL1 = append(b.v.AL1, v)
+	return b
+}
+
+// DG1 adds a DG1 segment.
+func (b *ADT_A06Builder) DG1(v DG1) *ADT_A06Builder {
+	b.v.DG1 = append(b.v.DG1, v)
+	return b
+}
+
+// PR1 adds a PR1 segment.
+func (b *ADT_A06Builder) PR1(v PR1) *ADT_A06Builder {
+	b.v.PR1 = append(b.v.PR1, v)
+	return b
+}
+
+// GT1 adds a GT1 segment.
+func (b *ADT_A06Builder) GT1(v GT1) *ADT_A06Builder {
+	b.v.GT1 = append(b.v.GT1, v)
+	return b
+}
+
+// Insurance adds a Insurance group and returns the group builder.
+func (b *ADT_A06Builder) Insurance() *ADT_A06_InsuranceBuilder {
+	g := &ADT_A06_InsuranceBuilder{b: b.b.child("Insurance", len(b.gInsurance))}
+	b.gInsurance = append(b.gInsurance, g)
+	return g
+}
+
+// ACC sets the ACC segment.
+func (b *ADT_A06Builder) ACC(v ACC) *ADT_A06Builder {
+	setOnce(&b.b, "ACC", &b.v.ACC, v)
+	return b
+}
+
+// UB1 sets the UB1 segment.
+func (b *ADT_A06Builder) UB1(v UB1) *ADT_A06Builder {
+	setOnce(&b.b, "UB1", &b.v.UB1, v)
+	return b
+}
+
+// UB2 sets the UB2 segment.
+func (b *ADT_A06Builder) UB2(v UB2) *ADT_A06Builder {
+	setOnce(&b.b, "UB2", &b.v.UB2, v)
+	return b
+}
+
+func (b *ADT_A06Builder) build() (ADT_A06, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gInsurance {
+		gv, errs := g.build()
+		v.Insurance = append(v.Insurance, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("MRG", builderCount(v.MRG != nil), 0, false)
+	bb.check("NK1", len(v.NK1), 0, false)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	bb.check("AL1", len(v.AL1), 0, false)
+	bb.check("DG1", len(v.DG1), 0, false)
+	bb.check("PR1", len(v.PR1), 0, false)
+	bb.check("GT1", len(v.GT1), 0, false)
+	bb.check("Insurance", len(v.Insurance), 0, false)
+	bb.check("ACC", builderCount(v.ACC != nil), 0, false)
+	bb.check("UB1", builderCount(v.UB1 != nil), 0, false)
+	bb.check("UB2", builderCount(v.UB2 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A06Builder returns a builder for ADT_A06.
+func NewADT_A06Builder() *ADT_A06Builder {
+	return &ADT_A06Builder{b: builder{path: "ADT_A06", seq: 1}}
+}
+
+// Build returns the ADT_A06 and the validation errors, if any.
+func (b *ADT_A06Builder) Build() (ADT_A06, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A07_InsuranceBuilder adds the groups and segments of a ADT_A07_Insurance.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A07_InsuranceBuilder struct {
+	b builder
+	v ADT_A07_Insurance
+}
+
+// IN1 sets the IN1 segment.
+func (b *ADT_A07_InsuranceBuilder) IN1(v IN1) *ADT_A07_InsuranceBuilder {
+	setOnce(&b.b, "IN1", &b.v.IN1, v)
+	return b
+}
+
+// IN2 sets the IN2 segment.
+func (b *ADT_A07_InsuranceBuilder) IN2(v IN2) *ADT_A07_InsuranceBuilder {
+	setOnce(&b.b, "IN2", &b.v.IN2, v)
+	return b
+}
+
+// IN3 sets the IN3 segment.
+func (b *ADT_A07_InsuranceBuilder) IN3(v IN3) *ADT_A07_InsuranceBuilder {
+	setOnce(&b.b, "IN3", &b.v.IN3, v)
+	return b
+}
+
+func (b *ADT_A07_InsuranceBuilder) build() (ADT_A07_Insurance, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("IN1", builderCount(v.IN1 != nil), 0, true)
+	bb.check("IN2", builderCount(v.IN2 != nil), 0, false)
+	bb.check("IN3", builderCount(v.IN3 != nil), 0, false)
+	return v, bb.errs
+}
+
+// ADT_A07Builder adds the groups and segments of a ADT_A07.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A07Builder struct {
+	b          builder
+	v          ADT_A07
+	gInsurance []*ADT_A07_InsuranceBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A07Builder) MSH(v MSH) *ADT_A07Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A07Builder) EVN(v EVN) *ADT_A07Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A07Builder) PID(v PID) *ADT_A07Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// MRG sets the MRG segment.
+func (b *ADT_A07Builder) MRG(v MRG) *ADT_A07Builder {
+	setOnce(&b.b, "MRG", &b.v.MRG, v)
+	return b
+}
+
+// NK1 adds a NK1 segment.
+func (b *ADT_A07Builder) NK1(v NK1) *ADT_A07Builder {
+	b.v.NK1 = append(b.v.NK1, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A07Builder) PV1(v PV1) *ADT_A07Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A07Builder) PV2(v PV2) *ADT_A07Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A07Builder) OBX(v OBX) *ADT_A07Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+// AL1 adds a AL1 segment.
+func (b *ADT_A07Builder) AL1(v AL1) *ADT_A07Builder {
+	b.v.AL1 = append(b.v.AL1, v)
+	return b
+}
+
+// DG1 adds a DG1 segment.
+func (b *ADT_A07Builder) DG1(v DG1) *ADT_A07Builder {
+	b.v.DG1 = append(b.v.DG1, v)
+	return b
+}
+
+// PR1 adds a PR1 segment.
+func (b *ADT_A07Builder) PR1(v PR1) *ADT_A07Builder {
+	b.v.PR1 = append(b.v.PR1, v)
+	return b
+}
+
+// GT1 adds a GT1 segment.
+func (b *ADT_A07Builder) GT1(v GT1) *ADT_A07Builder {
+	b.v.GT1 = append(b.v.GT1, v)
+	return b
+}
+
+// Insurance adds a Insurance group and returns the group builder.
+func (b *ADT_A07Builder) Insurance() *ADT_A07_InsuranceBuilder {
+	g := &ADT_A07_InsuranceBuilder{b: b.b.child("Insurance", len(b.gInsurance))}
+	b.gInsurance = append(b.gInsurance, g)
+	return g
+}
+
+// ACC sets the ACC segment.
+func (b *ADT_A07Builder) ACC(v ACC) *ADT_A07Builder {
+	setOnce(&b.b, "ACC", &b.v.ACC, v)
+	return b
+}
+
+// UB1 sets the UB1 segment.
+func (b *ADT_A07Builder) UB1(v UB1) *ADT_A07Builder {
+	setOnce(&b.b, "UB1", &b.v.UB1, v)
+	return b
+}
+
+// UB2 sets the UB2 segment.
+func (b *ADT_A07Builder) UB2(v UB2) *ADT_A07Builder {
+	setOnce(&b.b, "UB2", &b.v.UB2, v)
+	return b
+}
+
+func (b *ADT_A07Builder) build() (ADT_A07, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gInsurance {
+		gv, errs := g.build()
+		v.Insurance = append(v.Insurance, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("MRG", builderCount(v.MRG != nil), 0, false)
+	bb.check("NK1", len(v.NK1), 0, false)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	bb.check("AL1", len(v.AL1), 0, false)
+	bb.check("DG1", len(v.DG1), 0, false)
+	bb.check("PR1", len(v.PR1), 0, false)
+	bb.check("GT1", len(v.GT1), 0, false)
+	bb.check("Insurance", len(v.Insurance), 0, false)
+	bb.check("ACC", builderCount(v.ACC != nil), 0, false)
+	bb.check("UB1", builderCount(v.UB1 != nil), 0, false)
+	bb.check("UB2", builderCount(v.UB2 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A07Builder returns a builder for ADT_A07.
+func NewADT_A07Builder() *ADT_A07Builder {
+	return &ADT_A07Builder{b: builder{path: "ADT_A07", seq: 1}}
+}
+
+// Build returns the ADT_A07 and the validation errors, if any.
+func (b *ADT_A07Builder) Build() (ADT_A07, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A08_InsuranceBuilder adds the groups and segments of a ADT_A08_Insurance.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A08_InsuranceBuilder struct {
+	b builder
+	v ADT_A08_Insurance
+}
+
+// IN1 sets the IN1 segment.
+func (b *ADT_A08_InsuranceBuilder) IN1(v IN1) *ADT_A08_InsuranceBuilder {
+	setOnce(&b.b, "IN1", &b.v.IN1, v)
+	return b
+}
+
+// IN2 sets the IN2 segment.
+func (b *ADT_A08_InsuranceBuilder) IN2(v IN2) *ADT_A08_InsuranceBuilder {
+	setOnce(&b.b, "IN2", &b.v.IN2, v)
+	return b
+}
+
+// IN3 sets the IN3 segment.
+func (b *ADT_A08_InsuranceBuilder) IN3(v IN3) *ADT_A08_InsuranceBuilder {
+	setOnce(&b.b, "IN3", &b.v.IN3, v)
+	return b
+}
+
+func (b *ADT_A08_InsuranceBuilder) build() (ADT_A08_Insurance, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("IN1", builderCount(v.IN1 != nil), 0, true)
+	bb.check("IN2", builderCount(v.IN2 != nil), 0, false)
+	bb.check("IN3", builderCount(v.IN3 != nil), 0, false)
+	return v, bb.errs
+}
+
+// ADT_A08Builder adds the groups and segments of a ADT_A08.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A08Builder struct {
+	b          builder
+	v          ADT_A08
+	gInsurance []*ADT_A08_InsuranceBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A08Builder) MSH(v MSH) *ADT_A08Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A08Builder) EVN(v EVN) *ADT_A08Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A08Builder) PID(v PID) *ADT_A08Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NK1 adds a NK1 segment.
+func (b *ADT_A08Builder) NK1(v NK1) *ADT_A08Builder {
+	b.v.NK1 = append(b.v.NK1, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A08Builder) PV1(v PV1) *ADT_A08Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A08Builder) PV2(v PV2) *ADT_A08Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A08Builder) OBX(v OBX) *ADT_A08Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+// AL1 adds a AL1 segment.
+func (b *ADT_A08Builder) AL1(v AL1) *ADT_A08Builder {
+	b.v.AL1 = append(b.v.AL1, v)
+	return b
+}
+
+// DG1 adds a DG1 segment.
+func (b *ADT_A08Builder) DG1(v DG1) *ADT_A08Builder {
+	b.v.DG1 = append(b.v.DG1, v)
+	return b
+}
+
+// PR1 adds a PR1 segment.
+func (b *ADT_A08Builder) PR1(v PR1) *ADT_A08Builder {
+	b.v.PR1 = append(b.v.PR1, v)
+	return b
+}
+
+// GT1 adds a GT1 segment.
+func (b *ADT_A08Builder) GT1(v GT1) *ADT_A08Builder {
+	b.v.GT1 = append(b.v.GT1, v)
+	return b
+}
+
+// Insurance adds a Insurance group and returns the group builder.
+func (b *ADT_A08Builder) Insurance() *ADT_A08_InsuranceBuilder {
+	g := &ADT_A08_InsuranceBuilder{b: b.b.child("Insurance", len(b.gInsurance))}
+	b.gInsurance = append(b.gInsurance, g)
+	return g
+}
+
+// ACC sets the ACC segment.
+func (b *ADT_A08Builder) ACC(v ACC) *ADT_A08Builder {
+	setOnce(&b.b, "ACC", &b.v.ACC, v)
+	return b
+}
+
+// UB1 sets the UB1 segment.
+func (b *ADT_A08Builder) UB1(v UB1) *ADT_A08Builder {
+	setOnce(&b.b, "UB1", &b.v.UB1, v)
+	return b
+}
+
+// UB2 sets the UB2 segment.
+func (b *ADT_A08Builder) UB2(v UB2) *ADT_A08Builder {
+	setOnce(&b.b, "UB2", &b.v.UB2, v)
+	return b
+}
+
+func (b *ADT_A08Builder) build() (ADT_A08, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gInsurance {
+		gv, errs := g.build()
+		v.Insurance = append(v.Insurance, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NK1", len(v.NK1), 0, false)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	bb.check("AL1", len(v.AL1), 0, false)
+	bb.check("DG1", len(v.DG1), 0, false)
+	bb.check("PR1", len(v.PR1), 0, false)
+	bb.check("GT1", len(v.GT1), 0, false)
+	bb.check("Insurance", len(v.Insurance), 0, false)
+	bb.check("ACC", builderCount(v.ACC != nil), 0, false)
+	bb.check("UB1", builderCount(v.UB1 != nil), 0, false)
+	bb.check("UB2", builderCount(v.UB2 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A08Builder returns a builder for ADT_A08.
+func NewADT_A08Builder() *ADT_A08Builder {
+	return &ADT_A08Builder{b: builder{path: "ADT_A08", seq: 1}}
+}
+
+// Build returns the ADT_A08 and the validation errors, if any.
+func (b *ADT_A08Builder) Build() (ADT_A08, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A09Builder adds the groups and segments of a ADT_A09.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A09Builder struct {
+	b builder
+	v ADT_A09
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A09Builder) MSH(v MSH) *ADT_A09Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A09Builder) EVN(v EVN) *ADT_A09Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A09Builder) PID(v PID) *ADT_A09Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A09Builder) PV1(v PV1) *ADT_A09Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A09Builder) PV2(v PV2) *ADT_A09Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A09Builder) OBX(v OBX) *ADT_A09Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+// DG1 adds a DG1 segment.
+func (b *ADT_A09Builder) DG1(v DG1) *ADT_A09Builder {
+	b.v.DG1 = append(b.v.DG1, v)
+	return b
+}
+
+func (b *ADT_A09Builder) build() (ADT_A09, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	bb.check("DG1", len(v.DG1), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A09Builder returns a builder for ADT_A09.
+func NewADT_A09Builder() *ADT_A09Builder {
+	return &ADT_A09Builder{b: builder{path: "ADT_A09", seq: 1}}
+}
+
+// Build returns the ADT_A09 and the validation errors, if any.
+func (b *ADT_A09Builder) Build() (ADT_A09, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A10Builder adds the groups and segments of a ADT_A10.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A10Builder struct {
+	b builder
+	v ADT_A10
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A10Builder) MSH(v MSH) *ADT_A10Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A10Builder) EVN(v EVN) *ADT_A10Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A10Builder) PID(v PID) *ADT_A10Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A10Builder) PV1(v PV1) *ADT_A10Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A10Builder) PV2(v PV2) *ADT_A10Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A10Builder) OBX(v OBX) *ADT_A10Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+// DG1 adds a DG1 segment.
+func (b *ADT_A10Builder) DG1(v DG1) *ADT_A10Builder {
+	b.v.DG1 = append(b.v.DG1, v)
+	return b
+}
+
+func (b *ADT_A10Builder) build() (ADT_A10, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	bb.check("DG1", len(v.DG1), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A10Builder returns a builder for ADT_A10.
+func NewADT_A10Builder() *ADT_A10Builder {
+	return &ADT_A10Builder{b: builder{path: "ADT_A10", seq: 1}}
+}
+
+// Build returns the ADT_A10 and the validation errors, if any.
+func (b *ADT_A10Builder) Build() (ADT_A10, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A11Builder adds the groups and segments of a ADT_A11.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A11Builder struct {
+	b builder
+	v ADT_A11
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A11Builder) MSH(v MSH) *ADT_A11Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A11Builder) EVN(v EVN) *ADT_A11Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A11Builder) PID(v PID) *ADT_A11Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A11Builder) PV1(v PV1) *ADT_A11Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A11Builder) PV2(v PV2) *ADT_A11Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A11Builder) OBX(v OBX) *ADT_A11Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+// DG1 adds a DG1 segment.
+func (b *ADT_A11Builder) DG1(v DG1) *ADT_A11Builder {
+	b.v.DG1 = append(b.v.DG1, v)
+	return b
+}
+
+func (b *ADT_A11Builder) build() (ADT_A11, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	bb.check("DG1", len(v.DG1), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A11Builder returns a builder for ADT_A11.
+func NewADT_A11Builder() *ADT_A11Builder {
+	return &ADT_A11Builder{b: builder{path: "ADT_A11", seq: 1}}
+}
+
+// Build returns the ADT_A11 and the validation errors, if any.
+func (b *ADT_A11Builder) Build() (ADT_A11, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A12Builder adds the groups and segments of a ADT_A12.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A12Builder struct {
+	b builder
+	v ADT_A12
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A12Builder) MSH(v MSH) *ADT_A12Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A12Builder) EVN(v EVN) *ADT_A12Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A12Builder) PID(v PID) *ADT_A12Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A12Builder) PV1(v PV1) *ADT_A12Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A12Builder) PV2(v PV2) *ADT_A12Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A12Builder) OBX(v OBX) *ADT_A12Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+// DG1 adds a DG1 segment.
+func (b *ADT_A12Builder) DG1(v DG1) *ADT_A12Builder {
+	b.v.DG1 = append(b.v.DG1, v)
+	return b
+}
+
+func (b *ADT_A12Builder) build() (ADT_A12, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	bb.check("DG1", len(v.DG1), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A12Builder returns a builder for ADT_A12.
+func NewADT_A12Builder() *ADT_A12Builder {
+	return &ADT_A12Builder{b: builder{path: "ADT_A12", seq: 1}}
+}
+
+// Build returns the ADT_A12 and the validation errors, if any.
+func (b *ADT_A12Builder) Build() (ADT_A12, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A13_InsuranceBuilder adds the groups and segments of a ADT_A13_Insurance.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A13_InsuranceBuilder struct {
+	b builder
+	v ADT_A13_Insurance
+}
+
+// IN1 sets the IN1 segment.
+func (b *ADT_A13_InsuranceBuilder) IN1(v IN1) *ADT_A13_InsuranceBuilder {
+	setOnce(&b.b, "IN1", &b.v.IN1, v)
+	return b
+}
+
+// IN2 sets the IN2 segment.
+func (b *ADT_A13_InsuranceBuilder) IN2(v IN2) *ADT_A13_InsuranceBuilder {
+	setOnce(&b.b, "IN2", &b.v.IN2, v)
+	return b
+}
+
+// IN3 sets the IN3 segment.
+func (b *ADT_A13_InsuranceBuilder) IN3(v IN3) *ADT_A13_InsuranceBuilder {
+	setOnce(&b.b, "IN3", &b.v.IN3, v)
+	return b
+}
+
+func (b *ADT_A13_InsuranceBuilder) build() (ADT_A13_Insurance, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("IN1", builderCount(v.IN1 != nil), 0, true)
+	bb.check("IN2", builderCount(v.IN2 != nil), 0, false)
+	bb.check("IN3", builderCount(v.IN3 != nil), 0, false)
+	return v, bb.errs
+}
+
+// ADT_A13Builder adds the groups and segments of a ADT_A13.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A13Builder struct {
+	b          builder
+	v          ADT_A13
+	gInsurance []*ADT_A13_InsuranceBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A13Builder) MSH(v MSH) *ADT_A13Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A13Builder) EVN(v EVN) *ADT_A13Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A13Builder) PID(v PID) *ADT_A13Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NK1 adds a NK1 segment.
+func (b *ADT_A13Builder) NK1(v NK1) *ADT_A13Builder {
+	b.v.NK1 = append(b.v.NK1, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A13Builder) PV1(v PV1) *ADT_A13Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A13Builder) PV2(v PV2) *ADT_A13Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A13Builder) OBX(v OBX) *ADT_A13Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+// AL1 adds a AL1 segment.
+func (b *ADT_A13Builder) AL1(v AL1) *ADT_A13Builder {
+	b.v.AL1 = append(b.v.AL1, v)
+	return b
+}
+
+// DG1 adds a DG1 segment.
+func (b *ADT_A13Builder) DG1(v DG1) *ADT_A13Builder {
+	b.v.DG1 = append(b.v.DG1, v)
+	return b
+}
+
+// PR1 adds a PR1 segment.
+func (b *ADT_A13Builder) PR1(v PR1) *ADT_A13Builder {
+	b.v.PR1 = append(b.v.PR1, v)
+	return b
+}
+
+// GT1 adds a GT1 segment.
+func (b *ADT_A13Builder) GT1(v GT1) *ADT_A13Builder {
+	b.v.GT1 = append(b.v.GT1, v)
+	return b
+}
+
+// Insurance adds a Insurance group and returns the group builder.
+func (b *ADT_A13Builder) Insurance() *ADT_A13_InsuranceBuilder {
+	g := &ADT_A13_InsuranceBuilder{b: b.b.child("Insurance", len(b.gInsurance))}
+	b.gInsurance = append(b.gInsurance, g)
+	return g
+}
+
+// ACC sets the ACC segment.
+func (b *ADT_A13Builder) ACC(v ACC) *ADT_A13Builder {
+	setOnce(&b.b, "ACC", &b.v.ACC, v)
+	return b
+}
+
+// UB1 sets the UB1 segment.
+func (b *ADT_A13Builder) UB1(v UB1) *ADT_A13Builder {
+	setOnce(&b.b, "UB1", &b.v.UB1, v)
+	return b
+}
+
+// UB2 sets the UB2 segment.
+func (b *ADT_A13Builder) UB2(v UB2) *ADT_A13Builder {
+	setOnce(&b.b, "UB2", &b.v.UB2, v)
+	return b
+}
+
+func (b *ADT_A13Builder) build() (ADT_A13, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gInsurance {
+		gv, errs := g.build()
+		v.Insurance = append(v.Insurance, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NK1", len(v.NK1), 0, false)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	bb.check("AL1", len(v.AL1), 0, false)
+	bb.check("DG1", len(v.DG1), 0, false)
+	bb.check("PR1", len(v.PR1), 0, false)
+	bb.check("GT1", len(v.GT1), 0, false)
+	bb.check("Insurance", len(v.Insurance), 0, false)
+	bb.check("ACC", builderCount(v.ACC != nil), 0, false)
+	bb.check("UB1", builderCount(v.UB1 != nil), 0, false)
+	bb.check("UB2", builderCount(v.UB2 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A13Builder returns a builder for ADT_A13.
+func NewADT_A13Builder() *ADT_A13Builder {
+	return &ADT_A13Builder{b: builder{path: "ADT_A13", seq: 1}}
+}
+
+// Build returns the ADT_A13 and the validation errors, if any.
+func (b *ADT_A13Builder) Build() (ADT_A13, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A14_InsuranceBuilder adds the groups and segments of a ADT_A14_Insurance.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A14_InsuranceBuilder struct {
+	b builder
+	v ADT_A14_Insurance
+}
+
+// IN1 sets the IN1 segment.
+func (b *ADT_A14_InsuranceBuilder) IN1(v IN1) *ADT_A14_InsuranceBuilder {
+	setOnce(&b.b, "IN1", &b.v.IN1, v)
+	return b
+}
+
+// IN2 sets the IN2 segment.
+func (b *ADT_A14_InsuranceBuilder) IN2(v IN2) *ADT_A14_InsuranceBuilder {
+	setOnce(&b.b, "IN2", &b.v.IN2, v)
+	return b
+}
+
+// IN3 sets the IN3 segment.
+func (b *ADT_A14_InsuranceBuilder) IN3(v IN3) *ADT_A14_InsuranceBuilder {
+	setOnce(&b.b, "IN3", &b.v.IN3, v)
+	return b
+}
+
+func (b *ADT_A14_InsuranceBuilder) build() (ADT_A14_Insurance, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("IN1", builderCount(v.IN1 != nil), 0, true)
+	bb.check("IN2", builderCount(v.IN2 != nil), 0, false)
+	bb.check("IN3", builderCount(v.IN3 != nil), 0, false)
+	return v, bb.errs
+}
+
+// ADT_A14Builder adds the groups and segments of a ADT_A14.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A14Builder struct {
+	b          builder
+	v          ADT_A14
+	gInsurance []*ADT_A14_InsuranceBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A14Builder) MSH(v MSH) *ADT_A14Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A14Builder) EVN(v EVN) *ADT_A14Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A14Builder) PID(v PID) *ADT_A14Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NK1 adds a NK1 segment.
+func (b *ADT_A14Builder) NK1(v NK1) *ADT_A14Builder {
+	b.v.NK1 = append(b.v.NK1, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A14Builder) PV1(v PV1) *ADT_A14Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A14Builder) PV2(v PV2) *ADT_A14Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A14Builder) OBX(v OBX) *ADT_A14Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+// AL1 adds a AL1 segment.
+func (b *ADT_A14Builder) AL1(v AL1) *ADT_A14Builder {
+	b.v.AL1 = append(b.v.AL1, v)
+	return b
+}
+
+// DG1 adds a DG1 segment.
+func (b *ADT_A14Builder) DG1(v DG1) *ADT_A14Builder {
+	b.v.DG1 = append(b.v.DG1, v)
+	return b
+}
+
+// PR1 adds a PR1 segment.
+func (b *ADT_A14Builder) PR1(v PR1) *ADT_A14Builder {
+	b.v.PR1 = append(b.v.PR1, v)
+	return b
+}
+
+// GT1 adds a GT1 segment.
+func (b *ADT_A14Builder) GT1(v GT1) *ADT_A14Builder {
+	b.v.GT1 = append(b.v.GT1, v)
+	return b
+}
+
+// Insurance adds a Insurance group and returns the group builder.
+func (b *ADT_A14Builder) Insurance() *ADT_A14_InsuranceBuilder {
+	g := &ADT_A14_InsuranceBuilder{b: b.b.child("Insurance", len(b.gInsurance))}
+	b.gInsurance = append(b.gInsurance, g)
+	return g
+}
+
+// ACC sets the ACC segment.
+func (b *ADT_A14Builder) ACC(v ACC) *ADT_A14Builder {
+	setOnce(&b.b, "ACC", &b.v.ACC, v)
+	return b
+}
+
+// UB1 sets the UB1 segment.
+func (b *ADT_A14Builder) UB1(v UB1) *ADT_A14Builder {
+	setOnce(&b.b, "UB1", &b.v.UB1, v)
+	return b
+}
+
+// UB2 sets the UB2 segment.
+func (b *ADT_A14Builder) UB2(v UB2) *ADT_A14Builder {
+	setOnce(&b.b, "UB2", &b.v.UB2, v)
+	return b
+}
+
+func (b *ADT_A14Builder) build() (ADT_A14, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gInsurance {
+		gv, errs := g.build()
+		v.Insurance = append(v.Insurance, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NK1", len(v.NK1), 0, false)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	bb.check("AL1", len(v.AL1), 0, false)
+	bb.check("DG1", len(v.DG1), 0, false)
+	bb.check("PR1", len(v.PR1), 0, false)
+	bb.check("GT1", len(v.GT1), 0, false)
+	bb.check("Insurance", len(v.Insurance), 0, false)
+	bb.check("ACC", builderCount(v.ACC != nil), 0, false)
+	bb.check("UB1", builderCount(v.UB1 != nil), 0, false)
+	bb.check("UB2", builderCount(v.UB2 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A14Builder returns a builder for ADT_A14.
+func NewADT_A14Builder() *ADT_A14Builder {
+	return &ADT_A14Builder{b: builder{path: "ADT_A14", seq: 1}}
+}
+
+// Build returns the ADT_A14 and the validation errors, if any.
+func (b *ADT_A14Builder) Build() (ADT_A14, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A15Builder adds the groups and segments of a ADT_A15.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A15Builder struct {
+	b builder
+	v ADT_A15
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A15Builder) MSH(v MSH) *ADT_A15Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A15Builder) EVN(v EVN) *ADT_A15Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A15Builder) PID(v PID) *ADT_A15Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A15Builder) PV1(v PV1) *ADT_A15Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A15Builder) PV2(v PV2) *ADT_A15Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A15Builder) OBX(v OBX) *ADT_A15Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+// DG1 adds a DG1 segment.
+func (b *ADT_A15Builder) DG1(v DG1) *ADT_A15Builder {
+	b.v.DG1 = append(b.v.DG1, v)
+	return b
+}
+
+func (b *ADT_A15Builder) build() (ADT_A15, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	bb.check("DG1", len(v.DG1), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A15Builder returns a builder for ADT_A15.
+func NewADT_A15Builder() *ADT_A15Builder {
+	return &ADT_A15Builder{b: builder{path: "ADT_A15", seq: 1}}
+}
+
+// Build returns the ADT_A15 and the validation errors, if any.
+func (b *ADT_A15Builder) Build() (ADT_A15, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A16Builder adds the groups and segments of a ADT_A16.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A16Builder struct {
+	b builder
+	v ADT_A16
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A16Builder) MSH(v MSH) *ADT_A16Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A16Builder) EVN(v EVN) *ADT_A16Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A16Builder) PID(v PID) *ADT_A16Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A16Builder) PV1(v PV1) *ADT_A16Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A16Builder) PV2(v PV2) *ADT_A16Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A16Builder) OBX(v OBX) *ADT_A16Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+// DG1 adds a DG1 segment.
+func (b *ADT_A16Builder) DG1(v DG1) *ADT_A16Builder {
+	b.v.DG1 = append(b.v.DG1, v)
+	return b
+}
+
+func (b *ADT_A16Builder) build() (ADT_A16, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	bb.check("DG1", len(v.DG1), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A16Builder returns a builder for ADT_A16.
+func NewADT_A16Builder() *ADT_A16Builder {
+	return &ADT_A16Builder{b: builder{path: "ADT_A16", seq: 1}}
+}
+
+// Build returns the ADT_A16 and the validation errors, if any.
+func (b *ADT_A16Builder) Build() (ADT_A16, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A17Builder adds the groups and segments of a ADT_A17.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A17Builder struct {
+	b builder
+	v ADT_A17
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A17Builder) MSH(v MSH) *ADT_A17Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A17Builder) EVN(v EVN) *ADT_A17Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A17Builder) PID(v PID) *ADT_A17Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A17Builder) PV1(v PV1) *ADT_A17Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A17Builder) PV2(v PV2) *ADT_A17Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A17Builder) OBX(v OBX) *ADT_A17Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+// PID2 sets the PID segment.
+func (b *ADT_A17Builder) PID2(v PID) *ADT_A17Builder {
+	setOnce(&b.b, "PID2", &b.v.PID2, v)
+	return b
+}
+
+// PV12 sets the PV1 segment.
+func (b *ADT_A17Builder) PV12(v PV1) *ADT_A17Builder {
+	setOnce(&b.b, "PV12", &b.v.PV12, v)
+	return b
+}
+
+// PV22 sets the PV2 segment.
+func (b *ADT_A17Builder) PV22(v PV2) *ADT_A17Builder {
+	setOnce(&b.b, "PV22", &b.v.PV22, v)
+	return b
+}
+
+// OBX2 adds a OBX segment.
+func (b *ADT_A17Builder) OBX2(v OBX) *ADT_A17Builder {
+	b.v.OBX2 = append(b.v.OBX2, v)
+	return b
+}
+
+func (b *ADT_A17Builder) build() (ADT_A17, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	bb.check("PID2", builderCount(v.PID2 != nil), 0, true)
+	bb.check("PV12", builderCount(v.PV12 != nil), 0, true)
+	bb.check("PV22", builderCount(v.PV22 != nil), 0, false)
+	bb.check("OBX2", len(v.OBX2), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A17Builder returns a builder for ADT_A17.
+func NewADT_A17Builder() *ADT_A17Builder {
+	return &ADT_A17Builder{b: builder{path: "ADT_A17", seq: 1}}
+}
+
+// Build returns the ADT_A17 and the validation errors, if any.
+func (b *ADT_A17Builder) Build() (ADT_A17, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A18Builder adds the groups and segments of a ADT_A18.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A18Builder struct {
+	b builder
+	v ADT_A18
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A18Builder) MSH(v MSH) *ADT_A18Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A18Builder) EVN(v EVN) *ADT_A18Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A18Builder) PID(v PID) *ADT_A18Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// MRG sets the MRG segment.
+func (b *ADT_A18Builder) MRG(v MRG) *ADT_A18Builder {
+	setOnce(&b.b, "MRG", &b.v.MRG, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A18Builder) PV1(v PV1) *ADT_A18Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+func (b *ADT_A18Builder) build() (ADT_A18, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("MRG", builderCount(v.MRG != nil), 0, false)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	return v, bb.errs
+}
+
+// NewADT_A18Builder returns a builder for ADT_A18.
+func NewADT_A18Builder() *ADT_A18Builder {
+	return &ADT_A18Builder{b: builder{path: "ADT_A18", seq: 1}}
+}
+
+// Build returns the ADT_A18 and the validation errors, if any.
+func (b *ADT_A18Builder) Build() (ADT_A18, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A20Builder adds the groups and segments of a ADT_A20.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A20Builder struct {
+	b builder
+	v ADT_A20
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A20Builder) MSH(v MSH) *ADT_A20Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A20Builder) EVN(v EVN) *ADT_A20Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// NPU sets the NPU segment.
+func (b *ADT_A20Builder) NPU(v NPU) *ADT_A20Builder {
+	setOnce(&b.b, "NPU", &b.v.NPU, v)
+	return b
+}
+
+func (b *ADT_A20Builder) build() (ADT_A20, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("NPU", builderCount(v.NPU != nil), 0, true)
+	return v, bb.errs
+}
+
+// NewADT_A20Builder returns a builder for ADT_A20.
+func NewADT_A20Builder() *ADT_A20Builder {
+	return &ADT_A20Builder{b: builder{path: "ADT_A20", seq: 1}}
+}
+
+// Build returns the ADT_A20 and the validation errors, if any.
+func (b *ADT_A20Builder) Build() (ADT_A20, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A21Builder adds the groups and segments of a ADT_A21.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A21Builder struct {
+	b builder
+	v ADT_A21
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A21Builder) MSH(v MSH) *ADT_A21Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A21Builder) EVN(v EVN) *ADT_A21Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A21Builder) PID(v PID) *ADT_A21Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A21Builder) PV1(v PV1) *ADT_A21Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A21Builder) PV2(v PV2) *ADT_A21Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A21Builder) OBX(v OBX) *ADT_A21Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+func (b *ADT_A21Builder) build() (ADT_A21, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A21Builder returns a builder for ADT_A21.
+func NewADT_A21Builder() *ADT_A21Builder {
+	return &ADT_A21Builder{b: builder{path: "ADT_A21", seq: 1}}
+}
+
+// Build returns the ADT_A21 and the validation errors, if any.
+func (b *ADT_A21Builder) Build() (ADT_A21, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A22Builder adds the groups and segments of a ADT_A22.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A22Builder struct {
+	b builder
+	v ADT_A22
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A22Builder) MSH(v MSH) *ADT_A22Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A22Builder) EVN(v EVN) *ADT_A22Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A22Builder) PID(v PID) *ADT_A22Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A22Builder) PV1(v PV1) *ADT_A22Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A22Builder) PV2(v PV2) *ADT_A22Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A22Builder) OBX(v OBX) *ADT_A22Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+func (b *ADT_A22Builder) build() (ADT_A22, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A22Builder returns a builder for ADT_A22.
+func NewADT_A22Builder() *ADT_A22Builder {
+	return &ADT_A22Builder{b: builder{path: "ADT_A22", seq: 1}}
+}
+
+// Build returns the ADT_A22 and the validation errors, if any.
+func (b *ADT_A22Builder) Build() (ADT_A22, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A23Builder adds the groups and segments of a ADT_A23.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A23Builder struct {
+	b builder
+	v ADT_A23
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A23Builder) MSH(v MSH) *ADT_A23Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A23Builder) EVN(v EVN) *ADT_A23Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A23Builder) PID(v PID) *ADT_A23Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A23Builder) PV1(v PV1) *ADT_A23Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A23Builder) PV2(v PV2) *ADT_A23Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A23Builder) OBX(v OBX) *ADT_A23Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+func (b *ADT_A23Builder) build() (ADT_A23, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A23Builder returns a builder for ADT_A23.
+func NewADT_A23Builder() *ADT_A23Builder {
+	return &ADT_A23Builder{b: builder{path: "ADT_A23", seq: 1}}
+}
+
+// Build returns the ADT_A23 and the validation errors, if any.
+func (b *ADT_A23Builder) Build() (ADT_A23, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A24Builder adds the groups and segments of a ADT_A24.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A24Builder struct {
+	b builder
+	v ADT_A24
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A24Builder) MSH(v MSH) *ADT_A24Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A24Builder) EVN(v EVN) *ADT_A24Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A24Builder) PID(v PID) *ADT_A24Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A24Builder) PV1(v PV1) *ADT_A24Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PID2 sets the PID segment.
+func (b *ADT_A24Builder) PID2(v PID) *ADT_A24Builder {
+	setOnce(&b.b, "PID2", &b.v.PID2, v)
+	return b
+}
+
+// PV12 sets the PV1 segment.
+func (b *ADT_A24Builder) PV12(v PV1) *ADT_A24Builder {
+	setOnce(&b.b, "PV12", &b.v.PV12, v)
+	return b
+}
+
+func (b *ADT_A24Builder) build() (ADT_A24, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, false)
+	bb.check("PID2", builderCount(v.PID2 != nil), 0, true)
+	bb.check("PV12", builderCount(v.PV12 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A24Builder returns a builder for ADT_A24.
+func NewADT_A24Builder() *ADT_A24Builder {
+	return &ADT_A24Builder{b: builder{path: "ADT_A24", seq: 1}}
+}
+
+// Build returns the ADT_A24 and the validation errors, if any.
+func (b *ADT_A24Builder) Build() (ADT_A24, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A25Builder adds the groups and segments of a ADT_A25.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A25Builder struct {
+	b builder
+	v ADT_A25
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A25Builder) MSH(v MSH) *ADT_A25Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A25Builder) EVN(v EVN) *ADT_A25Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A25Builder) PID(v PID) *ADT_A25Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A25Builder) PV1(v PV1) *ADT_A25Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A25Builder) PV2(v PV2) *ADT_A25Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A25Builder) OBX(v OBX) *ADT_A25Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+func (b *ADT_A25Builder) build() (ADT_A25, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A25Builder returns a builder for ADT_A25.
+func NewADT_A25Builder() *ADT_A25Builder {
+	return &ADT_A25Builder{b: builder{path: "ADT_A25", seq: 1}}
+}
+
+// Build returns the ADT_A25 and the validation errors, if any.
+func (b *ADT_A25Builder) Build() (ADT_A25, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A26Builder adds the groups and segments of a ADT_A26.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A26Builder struct {
+	b builder
+	v ADT_A26
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A26Builder) MSH(v MSH) *ADT_A26Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A26Builder) EVN(v EVN) *ADT_A26Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A26Builder) PID(v PID) *ADT_A26Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A26Builder) PV1(v PV1) *ADT_A26Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A26Builder) PV2(v PV2) *ADT_A26Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A26Builder) OBX(v OBX) *ADT_A26Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+func (b *ADT_A26Builder) build() (ADT_A26, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A26Builder returns a builder for ADT_A26.
+func NewADT_A26Builder() *ADT_A26Builder {
+	return &ADT_A26Builder{b: builder{path: "ADT_A26", seq: 1}}
+}
+
+// Build returns the ADT_A26 and the validation errors, if any.
+func (b *ADT_A26Builder) Build() (ADT_A26, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A27Builder adds the groups and segments of a ADT_A27.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A27Builder struct {
+	b builder
+	v ADT_A27
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A27Builder) MSH(v MSH) *ADT_A27Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A27Builder) EVN(v EVN) *ADT_A27Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A27Builder) PID(v PID) *ADT_A27Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A27Builder) PV1(v PV1) *ADT_A27Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A27Builder) PV2(v PV2) *ADT_A27Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A27Builder) OBX(v OBX) *ADT_A27Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+func (b *ADT_A27Builder) build() (ADT_A27, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A27Builder returns a builder for ADT_A27.
+func NewADT_A27Builder() *ADT_A27Builder {
+	return &ADT_A27Builder{b: builder{path: "ADT_A27", seq: 1}}
+}
+
+// Build returns the ADT_A27 and the validation errors, if any.
+func (b *ADT_A27Builder) Build() (ADT_A27, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A28_InsuranceBuilder adds the groups and segments of a ADT_A28_Insurance.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A28_InsuranceBuilder struct {
+	b builder
+	v ADT_A28_Insurance
+}
+
+// IN1 sets the IN1 segment.
+func (b *ADT_A28_InsuranceBuilder) IN1(v IN1) *ADT_A28_InsuranceBuilder {
+	setOnce(&b.b, "IN1", &b.v.IN1, v)
+	return b
+}
+
+// IN2 sets the IN2 segment.
+func (b *ADT_A28_InsuranceBuilder) IN2(v IN2) *ADT_A28_InsuranceBuilder {
+	setOnce(&b.b, "IN2", &b.v.IN2, v)
+	return b
+}
+
+// IN3 sets the IN3 segment.
+func (b *ADT_A28_InsuranceBuilder) IN3(v IN3) *ADT_A28_InsuranceBuilder {
+	setOnce(&b.b, "IN3", &b.v.IN3, v)
+	return b
+}
+
+func (b *ADT_A28_InsuranceBuilder) build() (ADT_A28_Insurance, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("IN1", builderCount(v.IN1 != nil), 0, true)
+	bb.check("IN2", builderCount(v.IN2 != nil), 0, false)
+	bb.check("IN3", builderCount(v.IN3 != nil), 0, false)
+	return v, bb.errs
+}
+
+// ADT_A28Builder adds the groups and segments of a ADT_A28.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A28Builder struct {
+	b          builder
+	v          ADT_A28
+	gInsurance []*ADT_A28_InsuranceBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A28Builder) MSH(v MSH) *ADT_A28Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A28Builder) EVN(v EVN) *ADT_A28Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A28Builder) PID(v PID) *ADT_A28Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NK1 adds a NK1 segment.
+func (b *ADT_A28Builder) NK1(v NK1) *ADT_A28Builder {
+	b.v.NK1 = append(b.v.NK1, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A28Builder) PV1(v PV1) *ADT_A28Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A28Builder) PV2(v PV2) *ADT_A28Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A28Builder) OBX(v OBX) *ADT_A28Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+// AL1 adds a AL1 segment.
+func (b *ADT_A28Builder) AL1(v AL1) *ADT_A28Builder {
+	b.v.AL1 = append(b.v.AL1, v)
+	return b
+}
+
+// DG1 adds a DG1 segment.
+func (b *ADT_A28Builder) DG1(v DG1) *ADT_A28Builder {
+	b.v.DG1 = append(b.v.DG1, v)
+	return b
+}
+
+// PR1 adds a PR1 segment.
+func (b *ADT_A28Builder) PR1(v PR1) *ADT_A28Builder {
+	b.v.PR1 = append(b.v.PR1, v)
+	return b
+}
+
+// GT1 adds a GT1 segment.
+func (b *ADT_A28Builder) GT1(v GT1) *ADT_A28Builder {
+	b.v.GT1 = append(b.v.GT1, v)
+	return b
+}
+
+// Insurance adds a Insurance group and returns the group builder.
+func (b *ADT_A28Builder) Insurance() *ADT_A28_InsuranceBuilder {
+	g := &ADT_A28_InsuranceBuilder{b: b.b.child("Insurance", len(b.gInsurance))}
+	b.gInsurance = append(b.gInsurance, g)
+	return g
+}
+
+// ACC sets the ACC segment.
+func (b *ADT_A28Builder) ACC(v ACC) *ADT_A28Builder {
+	setOnce(&b.b, "ACC", &b.v.ACC, v)
+	return b
+}
+
+// UB1 sets the UB1 segment.
+func (b *ADT_A28Builder) UB1(v UB1) *ADT_A28Builder {
+	setOnce(&b.b, "UB1", &b.v.UB1, v)
+	return b
+}
+
+// UB2 sets the UB2 segment.
+func (b *ADT_A28Builder) UB2(v UB2) *ADT_A28Builder {
+	setOnce(&b.b, "UB2", &b.v.UB2, v)
+	return b
+}
+
+func (b *ADT_A28Builder) build() (ADT_A28, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gInsurance {
+		gv, errs := g.build()
+		v.Insurance = append(v.Insurance, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NK1", len(v.NK1), 0, false)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	bb.check("AL1", len(v.AL1), 0, false)
+	bb.check("DG1", len(v.DG1), 0, false)
+	bb.check("PR1", len(v.PR1), 0, false)
+	bb.check("GT1", len(v.GT1), 0, false)
+	bb.check("Insurance", len(v.Insurance), 0, false)
+	bb.check("ACC", builderCount(v.ACC != nil), 0, false)
+	bb.check("UB1", builderCount(v.UB1 != nil), 0, false)
+	bb.check("UB2", builderCount(v.UB2 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A28Builder returns a builder for ADT_A28.
+func NewADT_A28Builder() *ADT_A28Builder {
+	return &ADT_A28Builder{b: builder{path: "ADT_A28", seq: 1}}
+}
+
+// Build returns the ADT_A28 and the validation errors, if any.
+func (b *ADT_A28Builder) Build() (ADT_A28, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A29Builder adds the groups and segments of a ADT_A29.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A29Builder struct {
+	b builder
+	v ADT_A29
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A29Builder) MSH(v MSH) *ADT_A29Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A29Builder) EVN(v EVN) *ADT_A29Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A29Builder) PID(v PID) *ADT_A29Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A29Builder) PV1(v PV1) *ADT_A29Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A29Builder) PV2(v PV2) *ADT_A29Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A29Builder) OBX(v OBX) *ADT_A29Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+func (b *ADT_A29Builder) build() (ADT_A29, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A29Builder returns a builder for ADT_A29.
+func NewADT_A29Builder() *ADT_A29Builder {
+	return &ADT_A29Builder{b: builder{path: "ADT_A29", seq: 1}}
+}
+
+// Build returns the ADT_A29 and the validation errors, if any.
+func (b *ADT_A29Builder) Build() (ADT_A29, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A30Builder adds the groups and segments of a ADT_A30.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A30Builder struct {
+	b builder
+	v ADT_A30
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A30Builder) MSH(v MSH) *ADT_A30Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A30Builder) EVN(v EVN) *ADT_A30Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A30Builder) PID(v PID) *ADT_A30Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// MRG sets the MRG segment.
+func (b *ADT_A30Builder) MRG(v MRG) *ADT_A30Builder {
+	setOnce(&b.b, "MRG", &b.v.MRG, v)
+	return b
+}
+
+func (b *ADT_A30Builder) build() (ADT_A30, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("MRG", builderCount(v.MRG != nil), 0, true)
+	return v, bb.errs
+}
+
+// NewADT_A30Builder returns a builder for ADT_A30.
+func NewADT_A30Builder() *ADT_A30Builder {
+	return &ADT_A30Builder{b: builder{path: "ADT_A30", seq: 1}}
+}
+
+// Build returns the ADT_A30 and the validation errors, if any.
+func (b *ADT_A30Builder) Build() (ADT_A30, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A31_InsuranceBuilder adds the groups and segments of a ADT_A31_Insurance.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A31_InsuranceBuilder struct {
+	b builder
+	v ADT_A31_Insurance
+}
+
+// IN1 sets the IN1 segment.
+func (b *ADT_A31_InsuranceBuilder) IN1(v IN1) *ADT_A31_InsuranceBuilder {
+	setOnce(&b.b, "IN1", &b.v.IN1, v)
+	return b
+}
+
+// IN2 sets the IN2 segment.
+func (b *ADT_A31_InsuranceBuilder) IN2(v IN2) *ADT_A31_InsuranceBuilder {
+	setOnce(&b.b, "IN2", &b.v.IN2, v)
+	return b
+}
+
+// IN3 sets the IN3 segment.
+func (b *ADT_A31_InsuranceBuilder) IN3(v IN3) *ADT_A31_InsuranceBuilder {
+	setOnce(&b.b, "IN3", &b.v.IN3, v)
+	return b
+}
+
+func (b *ADT_A31_InsuranceBuilder) build() (ADT_A31_Insurance, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("IN1", builderCount(v.IN1 != nil), 0, true)
+	bb.check("IN2", builderCount(v.IN2 != nil), 0, false)
+	bb.check("IN3", builderCount(v.IN3 != nil), 0, false)
+	return v, bb.errs
+}
+
+// ADT_A31Builder adds the groups and segments of a ADT_A31.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A31Builder struct {
+	b          builder
+	v          ADT_A31
+	gInsurance []*ADT_A31_InsuranceBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A31Builder) MSH(v MSH) *ADT_A31Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A31Builder) EVN(v EVN) *ADT_A31Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A31Builder) PID(v PID) *ADT_A31Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NK1 adds a NK1 segment.
+func (b *ADT_A31Builder) NK1(v NK1) *ADT_A31Builder {
+	b.v.NK1 = append(b.v.NK1, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A31Builder) PV1(v PV1) *ADT_A31Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A31Builder) PV2(v PV2) *ADT_A31Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A31Builder) OBX(v OBX) *ADT_A31Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+// AL1 adds a AL1 segment.
+func (b *ADT_A31Builder) AL1(v AL1) *ADT_A31Builder {
+	b.v.AL1 = append(b.v.AL1, v)
+	return b
+}
+
+// DG1 adds a DG1 segment.
+func (b *ADT_A31Builder) DG1(v DG1) *ADT_A31Builder {
+	b.v.DG1 = append(b.v.DG1, v)
+	return b
+}
+
+// PR1 adds a PR1 segment.
+func (b *ADT_A31Builder) PR1(v PR1) *ADT_A31Builder {
+	b.v.PR1 = append(b.v.PR1, v)
+	return b
+}
+
+// GT1 adds a GT1 segment.
+func (b *ADT_A31Builder) GT1(v GT1) *ADT_A31Builder {
+	b.v.GT1 = append(b.v.GT1, v)
+	return b
+}
+
+// Insurance adds a Insurance group and returns the group builder.
+func (b *ADT_A31Builder) Insurance() *ADT_A31_InsuranceBuilder {
+	g := &ADT_A31_InsuranceBuilder{b: b.b.child("Insurance", len(b.gInsurance))}
+	b.gInsurance = append(b.gInsurance, g)
+	return g
+}
+
+// ACC sets the ACC segment.
+func (b *ADT_A31Builder) ACC(v ACC) *ADT_A31Builder {
+	setOnce(&b.b, "ACC", &b.v.ACC, v)
+	return b
+}
+
+// UB1 sets the UB1 segment.
+func (b *ADT_A31Builder) UB1(v UB1) *ADT_A31Builder {
+	setOnce(&b.b, "UB1", &b.v.UB1, v)
+	return b
+}
+
+// UB2 sets the UB2 segment.
+func (b *ADT_A31Builder) UB2(v UB2) *ADT_A31Builder {
+	setOnce(&b.b, "UB2", &b.v.UB2, v)
+	return b
+}
+
+func (b *ADT_A31Builder) build() (ADT_A31, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gInsurance {
+		gv, errs := g.build()
+		v.Insurance = append(v.Insurance, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NK1", len(v.NK1), 0, false)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	bb.check("AL1", len(v.AL1), 0, false)
+	bb.check("DG1", len(v.DG1), 0, false)
+	bb.check("PR1", len(v.PR1), 0, false)
+	bb.check("GT1", len(v.GT1), 0, false)
+	bb.check("Insurance", len(v.Insurance), 0, false)
+	bb.check("ACC", builderCount(v.ACC != nil), 0, false)
+	bb.check("UB1", builderCount(v.UB1 != nil), 0, false)
+	bb.check("UB2", builderCount(v.UB2 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A31Builder returns a builder for ADT_A31.
+func NewADT_A31Builder() *ADT_A31Builder {
+	return &ADT_A31Builder{b: builder{path: "ADT_A31", seq: 1}}
+}
+
+// Build returns the ADT_A31 and the validation errors, if any.
+func (b *ADT_A31Builder) Build() (ADT_A31, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A32Builder adds the groups and segments of a ADT_A32.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A32Builder struct {
+	b builder
+	v ADT_A32
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A32Builder) MSH(v MSH) *ADT_A32Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A32Builder) EVN(v EVN) *ADT_A32Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A32Builder) PID(v PID) *ADT_A32Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A32Builder) PV1(v PV1) *ADT_A32Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A32Builder) PV2(v PV2) *ADT_A32Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A32Builder) OBX(v OBX) *ADT_A32Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+func (b *ADT_A32Builder) build() (ADT_A32, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A32Builder returns a builder for ADT_A32.
+func NewADT_A32Builder() *ADT_A32Builder {
+	return &ADT_A32Builder{b: builder{path: "ADT_A32", seq: 1}}
+}
+
+// Build returns the ADT_A32 and the validation errors, if any.
+func (b *ADT_A32Builder) Build() (ADT_A32, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A33Builder adds the groups and segments of a ADT_A33.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A33Builder struct {
+	b builder
+	v ADT_A33
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A33Builder) MSH(v MSH) *ADT_A33Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A33Builder) EVN(v EVN) *ADT_A33Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A33Builder) PID(v PID) *ADT_A33Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A33Builder) PV1(v PV1) *ADT_A33Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *ADT_A33Builder) PV2(v PV2) *ADT_A33Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *ADT_A33Builder) OBX(v OBX) *ADT_A33Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+func (b *ADT_A33Builder) build() (ADT_A33, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, true)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A33Builder returns a builder for ADT_A33.
+func NewADT_A33Builder() *ADT_A33Builder {
+	return &ADT_A33Builder{b: builder{path: "ADT_A33", seq: 1}}
+}
+
+// Build returns the ADT_A33 and the validation errors, if any.
+func (b *ADT_A33Builder) Build() (ADT_A33, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A34Builder adds the groups and segments of a ADT_A34.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A34Builder struct {
+	b builder
+	v ADT_A34
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A34Builder) MSH(v MSH) *ADT_A34Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A34Builder) EVN(v EVN) *ADT_A34Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A34Builder) PID(v PID) *ADT_A34Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// MRG sets the MRG segment.
+func (b *ADT_A34Builder) MRG(v MRG) *ADT_A34Builder {
+	setOnce(&b.b, "MRG", &b.v.MRG, v)
+	return b
+}
+
+func (b *ADT_A34Builder) build() (ADT_A34, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("MRG", builderCount(v.MRG != nil), 0, true)
+	return v, bb.errs
+}
+
+// NewADT_A34Builder returns a builder for ADT_A34.
+func NewADT_A34Builder() *ADT_A34Builder {
+	return &ADT_A34Builder{b: builder{path: "ADT_A34", seq: 1}}
+}
+
+// Build returns the ADT_A34 and the validation errors, if any.
+func (b *ADT_A34Builder) Build() (ADT_A34, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A35Builder adds the groups and segments of a ADT_A35.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A35Builder struct {
+	b builder
+	v ADT_A35
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A35Builder) MSH(v MSH) *ADT_A35Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A35Builder) EVN(v EVN) *ADT_A35Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A35Builder) PID(v PID) *ADT_A35Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// MRG sets the MRG segment.
+func (b *ADT_A35Builder) MRG(v MRG) *ADT_A35Builder {
+	setOnce(&b.b, "MRG", &b.v.MRG, v)
+	return b
+}
+
+func (b *ADT_A35Builder) build() (ADT_A35, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("MRG", builderCount(v.MRG != nil), 0, true)
+	return v, bb.errs
+}
+
+// NewADT_A35Builder returns a builder for ADT_A35.
+func NewADT_A35Builder() *ADT_A35Builder {
+	return &ADT_A35Builder{b: builder{path: "ADT_A35", seq: 1}}
+}
+
+// Build returns the ADT_A35 and the validation errors, if any.
+func (b *ADT_A35Builder) Build() (ADT_A35, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A36Builder adds the groups and segments of a ADT_A36.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A36Builder struct {
+	b builder
+	v ADT_A36
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A36Builder) MSH(v MSH) *ADT_A36Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A36Builder) EVN(v EVN) *ADT_A36Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A36Builder) PID(v PID) *ADT_A36Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// MRG sets the MRG segment.
+func (b *ADT_A36Builder) MRG(v MRG) *ADT_A36Builder {
+	setOnce(&b.b, "MRG", &b.v.MRG, v)
+	return b
+}
+
+func (b *ADT_A36Builder) build() (ADT_A36, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("MRG", builderCount(v.MRG != nil), 0, true)
+	return v, bb.errs
+}
+
+// NewADT_A36Builder returns a builder for ADT_A36.
+func NewADT_A36Builder() *ADT_A36Builder {
+	return &ADT_A36Builder{b: builder{path: "ADT_A36", seq: 1}}
+}
+
+// Build returns the ADT_A36 and the validation errors, if any.
+func (b *ADT_A36Builder) Build() (ADT_A36, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ADT_A37Builder adds the groups and segments of a ADT_A37.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ADT_A37Builder struct {
+	b builder
+	v ADT_A37
+}
+
+// MSH sets the MSH segment.
+func (b *ADT_A37Builder) MSH(v MSH) *ADT_A37Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *ADT_A37Builder) EVN(v EVN) *ADT_A37Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ADT_A37Builder) PID(v PID) *ADT_A37Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ADT_A37Builder) PV1(v PV1) *ADT_A37Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PID2 sets the PID segment.
+func (b *ADT_A37Builder) PID2(v PID) *ADT_A37Builder {
+	setOnce(&b.b, "PID2", &b.v.PID2, v)
+	return b
+}
+
+// PV12 sets the PV1 segment.
+func (b *ADT_A37Builder) PV12(v PV1) *ADT_A37Builder {
+	setOnce(&b.b, "PV12", &b.v.PV12, v)
+	return b
+}
+
+func (b *ADT_A37Builder) build() (ADT_A37, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, false)
+	bb.check("PID2", builderCount(v.PID2 != nil), 0, true)
+	bb.check("PV12", builderCount(v.PV12 != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewADT_A37Builder returns a builder for ADT_A37.
+func NewADT_A37Builder() *ADT_A37Builder {
+	return &ADT_A37Builder{b: builder{path: "ADT_A37", seq: 1}}
+}
+
+// Build returns the ADT_A37 and the validation errors, if any.
+func (b *ADT_A37Builder) Build() (ADT_A37, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// BAR_P01_VisitBuilder adds the groups and segments of a BAR_P01_Visit.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type BAR_P01_VisitBuilder struct {
+	b          builder
+	v          BAR_P01_Visit
+	gInsurance []*BAR_P01_InsuranceBuilder
+}
+
+// PV1 sets the PV1 segment.
+func (b *BAR_P01_VisitBuilder) PV1(v PV1) *BAR_P01_VisitBuilder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *BAR_P01_VisitBuilder) PV2(v PV2) *BAR_P01_VisitBuilder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *BAR_P01_VisitBuilder) OBX(v OBX) *BAR_P01_VisitBuilder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+// AL1 adds a AL1 segment.
+func (b *BAR_P01_VisitBuilder) AL1(v AL1) *BAR_P01_VisitBuilder {
+	b.v.AL1 = append(b.v.AL1, v)
+	return b
+}
+
+// DG1 adds a DG1 segment.
+func (b *BAR_P01_VisitBuilder) DG1(v DG1) *BAR_P01_VisitBuilder {
+	b.v.DG1 = append(b.v.DG1, v)
+	return b
+}
+
+// PR1 adds a PR1 segment.
+func (b *BAR_P01_VisitBuilder) PR1(v PR1) *BAR_P01_VisitBuilder {
+	b.v.PR1 = append(b.v.PR1, v)
+	return b
+}
+
+// GT1 adds a GT1 segment.
+func (b *BAR_P01_VisitBuilder) GT1(v GT1) *BAR_P01_VisitBuilder {
+	b.v.GT1 = append(b.v.GT1, v)
+	return b
+}
+
+// NK1 adds a NK1 segment.
+func (b *BAR_P01_VisitBuilder) NK1(v NK1) *BAR_P01_VisitBuilder {
+	b.v.NK1 = append(b.v.NK1, v)
+	return b
+}
+
+// Insurance adds a Insurance group and returns the group builder.
+func (b *BAR_P01_VisitBuilder) Insurance() *BAR_P01_InsuranceBuilder {
+	g := &BAR_P01_InsuranceBuilder{b: b.b.child("Insurance", len(b.gInsurance))}
+	b.gInsurance = append(b.gInsurance, g)
+	return g
+}
+
+// ACC sets the ACC segment.
+func (b *BAR_P01_VisitBuilder) ACC(v ACC) *BAR_P01_VisitBuilder {
+	setOnce(&b.b, "ACC", &b.v.ACC, v)
+	return b
+}
+
+// UB1 sets the UB1 segment.
+func (b *BAR_P01_VisitBuilder) UB1(v UB1) *BAR_P01_VisitBuilder {
+	setOnce(&b.b, "UB1", &b.v.UB1, v)
+	return b
+}
+
+// UB2 sets the UB2 segment.
+func (b *BAR_P01_VisitBuilder) UB2(v UB2) *BAR_P01_VisitBuilder {
+	setOnce(&b.b, "UB2", &b.v.UB2, v)
+	return b
+}
+
+func (b *BAR_P01_VisitBuilder) build() (BAR_P01_Visit, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gInsurance {
+		gv, errs := g.build()
+		v.Insurance = append(v.Insurance, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, false)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	bb.check("AL1", len(v.AL1), 0, false)
+	bb.check("DG1", len(v.DG1), 0, false)
+	bb.check("PR1", len(v.PR1), 0, false)
+	bb.check("GT1", len(v.GT1), 0, false)
+	bb.check("NK1", len(v.NK1), 0, false)
+	bb.check("Insurance", len(v.Insurance), 0, false)
+	bb.check("ACC", builderCount(v.ACC != nil), 0, false)
+	bb.check("UB1", builderCount(v.UB1 != nil), 0, false)
+	bb.check("UB2", builderCount(v.UB2 != nil), 0, false)
+	return v, bb.errs
+}
+
+// BAR_P01_InsuranceBuilder adds the groups and segments of a BAR_P01_Insurance.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type BAR_P01_InsuranceBuilder struct {
+	b builder
+	v BAR_P01_Insurance
+}
+
+// IN1 sets the IN1 segment.
+func (b *BAR_P01_InsuranceBuilder) IN1(v IN1) *BAR_P01_InsuranceBuilder {
+	setOnce(&b.b, "IN1", &b.v.IN1, v)
+	return b
+}
+
+// IN2 sets the IN2 segment.
+func (b *BAR_P01_InsuranceBuilder) IN2(v IN2) *BAR_P01_InsuranceBuilder {
+	setOnce(&b.b, "IN2", &b.v.IN2, v)
+	return b
+}
+
+// IN3 sets the IN3 segment.
+func (b *BAR_P01_InsuranceBuilder) IN3(v IN3) *BAR_P01_InsuranceBuilder {
+	setOnce(&b.b, "IN3", &b.v.IN3, v)
+	return b
+}
+
+func (b *BAR_P01_InsuranceBuilder) build() (BAR_P01_Insurance, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("IN1", builderCount(v.IN1 != nil), 0, true)
+	bb.check("IN2", builderCount(v.IN2 != nil), 0, false)
+	bb.check("IN3", builderCount(v.IN3 != nil), 0, false)
+	return v, bb.errs
+}
+
+// BAR_P01Builder adds the groups and segments of a BAR_P01.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type BAR_P01Builder struct {
+	b      builder
+	v      BAR_P01
+	gVisit []*BAR_P01_VisitBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *BAR_P01Builder) MSH(v MSH) *BAR_P01Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *BAR_P01Builder) EVN(v EVN) *BAR_P01Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *BAR_P01Builder) PID(v PID) *BAR_P01Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// Visit adds a Visit group and returns the group builder.
+func (b *BAR_P01Builder) Visit() *BAR_P01_VisitBuilder {
+	g := &BAR_P01_VisitBuilder{b: b.b.child("Visit", len(b.gVisit))}
+	b.gVisit = append(b.gVisit, g)
+	return g
+}
+
+func (b *BAR_P01Builder) build() (BAR_P01, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gVisit {
+		gv, errs := g.build()
+		v.Visit = append(v.Visit, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("Visit", len(v.Visit), 0, true)
+	return v, bb.errs
+}
+
+// NewBAR_P01Builder returns a builder for BAR_P01.
+func NewBAR_P01Builder() *BAR_P01Builder {
+	return &BAR_P01Builder{b: builder{path: "BAR_P01", seq: 1}}
+}
+
+// Build returns the BAR_P01 and the validation errors, if any.
+func (b *BAR_P01Builder) Build() (BAR_P01, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// BAR_P02_PatientBuilder adds the groups and segments of a BAR_P02_Patient.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type BAR_P02_PatientBuilder struct {
+	b builder
+	v BAR_P02_Patient
+}
+
+// PID sets the PID segment.
+func (b *BAR_P02_PatientBuilder) PID(v PID) *BAR_P02_PatientBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *BAR_P02_PatientBuilder) PV1(v PV1) *BAR_P02_PatientBuilder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+func (b *BAR_P02_PatientBuilder) build() (BAR_P02_Patient, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, false)
+	return v, bb.errs
+}
+
+// BAR_P02Builder adds the groups and segments of a BAR_P02.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type BAR_P02Builder struct {
+	b        builder
+	v        BAR_P02
+	gPatient []*BAR_P02_PatientBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *BAR_P02Builder) MSH(v MSH) *BAR_P02Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *BAR_P02Builder) EVN(v EVN) *BAR_P02Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// Patient adds a Patient group and returns the group builder.
+func (b *BAR_P02Builder) Patient() *BAR_P02_PatientBuilder {
+	g := &BAR_P02_PatientBuilder{b: b.b.child("Patient", len(b.gPatient))}
+	b.gPatient = append(b.gPatient, g)
+	return g
+}
+
+func (b *BAR_P02Builder) build() (BAR_P02, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gPatient {
+		gv, errs := g.build()
+		v.Patient = append(v.Patient, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("Patient", len(v.Patient), 0, true)
+	return v, bb.errs
+}
+
+// NewBAR_P02Builder returns a builder for BAR_P02.
+func NewBAR_P02Builder() *BAR_P02Builder {
+	return &BAR_P02Builder{b: builder{path: "BAR_P02", seq: 1}}
+}
+
+// Build returns the BAR_P02 and the validation errors, if any.
+func (b *BAR_P02Builder) Build() (BAR_P02, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// DFT_P03Builder adds the groups and segments of a DFT_P03.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type DFT_P03Builder struct {
+	b builder
+	v DFT_P03
+}
+
+// MSH sets the MSH segment.
+func (b *DFT_P03Builder) MSH(v MSH) *DFT_P03Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// EVN sets the EVN segment.
+func (b *DFT_P03Builder) EVN(v EVN) *DFT_P03Builder {
+	setOnce(&b.b, "EVN", &b.v.EVN, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *DFT_P03Builder) PID(v PID) *DFT_P03Builder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *DFT_P03Builder) PV1(v PV1) *DFT_P03Builder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+// PV2 sets the PV2 segment.
+func (b *DFT_P03Builder) PV2(v PV2) *DFT_P03Builder {
+	setOnce(&b.b, "PV2", &b.v.PV2, v)
+	return b
+}
+
+// OBX adds a OBX segment.
+func (b *DFT_P03Builder) OBX(v OBX) *DFT_P03Builder {
+	b.v.OBX = append(b.v.OBX, v)
+	return b
+}
+
+// FT1 adds a FT1 segment.
+func (b *DFT_P03Builder) FT1(v FT1) *DFT_P03Builder {
+	b.v.FT1 = append(b.v.FT1, v)
+	return b
+}
+
+func (b *DFT_P03Builder) build() (DFT_P03, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("EVN", builderCount(v.EVN != nil), 0, true)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, false)
+	bb.check("PV2", builderCount(v.PV2 != nil), 0, false)
+	bb.check("OBX", len(v.OBX), 0, false)
+	bb.check("FT1", len(v.FT1), 0, true)
+	return v, bb.errs
+}
+
+// NewDFT_P03Builder returns a builder for DFT_P03.
+func NewDFT_P03Builder() *DFT_P03Builder {
+	return &DFT_P03Builder{b: builder{path: "DFT_P03", seq: 1}}
+}
+
+// Build returns the DFT_P03 and the validation errors, if any.
+func (b *DFT_P03Builder) Build() (DFT_P03, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// DSR_Q01Builder adds the groups and segments of a DSR_Q01.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type DSR_Q01Builder struct {
+	b builder
+	v DSR_Q01
+}
+
+// MSH sets the MSH segment.
+func (b *DSR_Q01Builder) MSH(v MSH) *DSR_Q01Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MFA sets the MFA segment.
+func (b *DSR_Q01Builder) MFA(v MFA) *DSR_Q01Builder {
+	setOnce(&b.b, "MFA", &b.v.MFA, v)
+	return b
+}
+
+// ERR sets the ERR segment.
+func (b *DSR_Q01Builder) ERR(v ERR) *DSR_Q01Builder {
+	setOnce(&b.b, "ERR", &b.v.ERR, v)
+	return b
+}
+
+// QRD sets the QRD segment.
+func (b *DSR_Q01Builder) QRD(v QRD) *DSR_Q01Builder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *DSR_Q01Builder) QRF(v QRF) *DSR_Q01Builder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// DSP adds a DSP segment.
+func (b *DSR_Q01Builder) DSP(v DSP) *DSR_Q01Builder {
+	b.v.DSP = append(b.v.DSP, v)
+	return b
+}
+
+// DSC sets the DSC segment.
+func (b *DSR_Q01Builder) DSC(v DSC) *DSR_Q01Builder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *DSR_Q01Builder) build() (DSR_Q01, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MFA", builderCount(v.MFA != nil), 0, true)
+	bb.check("ERR", builderCount(v.ERR != nil), 0, false)
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("DSP", len(v.DSP), 0, true)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewDSR_Q01Builder returns a builder for DSR_Q01.
+func NewDSR_Q01Builder() *DSR_Q01Builder {
+	return &DSR_Q01Builder{b: builder{path: "DSR_Q01", seq: 1}}
+}
+
+// Build returns the DSR_Q01 and the validation errors, if any.
+func (b *DSR_Q01Builder) Build() (DSR_Q01, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// DSR_Q03Builder adds the groups and segments of a DSR_Q03.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type DSR_Q03Builder struct {
+	b builder
+	v DSR_Q03
+}
+
+// MSH sets the MSH segment.
+func (b *DSR_Q03Builder) MSH(v MSH) *DSR_Q03Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MSA sets the MSA segment.
+func (b *DSR_Q03Builder) MSA(v MSA) *DSR_Q03Builder {
+	setOnce(&b.b, "MSA", &b.v.MSA, v)
+	return b
+}
+
+// QRD sets the QRD segment.
+func (b *DSR_Q03Builder) QRD(v QRD) *DSR_Q03Builder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *DSR_Q03Builder) QRF(v QRF) *DSR_Q03Builder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// DSP adds a DSP segment.
+func (b *DSR_Q03Builder) DSP(v DSP) *DSR_Q03Builder {
+	b.v.DSP = append(b.v.DSP, v)
+	return b
+}
+
+// DSC sets the DSC segment.
+func (b *DSR_Q03Builder) DSC(v DSC) *DSR_Q03Builder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *DSR_Q03Builder) build() (DSR_Q03, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MSA", builderCount(v.MSA != nil), 0, false)
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("DSP", len(v.DSP), 0, true)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewDSR_Q03Builder returns a builder for DSR_Q03.
+func NewDSR_Q03Builder() *DSR_Q03Builder {
+	return &DSR_Q03Builder{b: builder{path: "DSR_Q03", seq: 1}}
+}
+
+// Build returns the DSR_Q03 and the validation errors, if any.
+func (b *DSR_Q03Builder) Build() (DSR_Q03, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// DSR_R03Builder adds the groups and segments of a DSR_R03.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type DSR_R03Builder struct {
+	b builder
+	v DSR_R03
+}
+
+// MSH sets the MSH segment.
+func (b *DSR_R03Builder) MSH(v MSH) *DSR_R03Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MSA sets the MSA segment.
+func (b *DSR_R03Builder) MSA(v MSA) *DSR_R03Builder {
+	setOnce(&b.b, "MSA", &b.v.MSA, v)
+	return b
+}
+
+// QRD sets the QRD segment.
+func (b *DSR_R03Builder) QRD(v QRD) *DSR_R03Builder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *DSR_R03Builder) QRF(v QRF) *DSR_R03Builder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// DSP adds a DSP segment.
+func (b *DSR_R03Builder) DSP(v DSP) *DSR_R03Builder {
+	b.v.DSP = append(b.v.DSP, v)
+	return b
+}
+
+// DSC sets the DSC segment.
+func (b *DSR_R03Builder) DSC(v DSC) *DSR_R03Builder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *DSR_R03Builder) build() (DSR_R03, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MSA", builderCount(v.MSA != nil), 0, false)
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("DSP", len(v.DSP), 0, true)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewDSR_R03Builder returns a builder for DSR_R03.
+func NewDSR_R03Builder() *DSR_R03Builder {
+	return &DSR_R03Builder{b: builder{path: "DSR_R03", seq: 1}}
+}
+
+// Build returns the DSR_R03 and the validation errors, if any.
+func (b *DSR_R03Builder) Build() (DSR_R03, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// MCFBuilder adds the groups and segments of a MCF.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type MCFBuilder struct {
+	b builder
+	v MCF
+}
+
+// MSH sets the MSH segment.
+func (b *MCFBuilder) MSH(v MSH) *MCFBuilder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MFA sets the MFA segment.
+func (b *MCFBuilder) MFA(v MFA) *MCFBuilder {
+	setOnce(&b.b, "MFA", &b.v.MFA, v)
+	return b
+}
+
+// ERR sets the ERR segment.
+func (b *MCFBuilder) ERR(v ERR) *MCFBuilder {
+	setOnce(&b.b, "ERR", &b.v.ERR, v)
+	return b
+}
+
+func (b *MCFBuilder) build() (MCF, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MFA", builderCount(v.MFA != nil), 0, true)
+	bb.check("ERR", builderCount(v.ERR != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewMCFBuilder returns a builder for MCF.
+func NewMCFBuilder() *MCFBuilder {
+	return &MCFBuilder{b: builder{path: "MCF", seq: 1}}
+}
+
+// Build returns the MCF and the validation errors, if any.
+func (b *MCFBuilder) Build() (MCF, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// MFD_M01Builder adds the groups and segments of a MFD_M01.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type MFD_M01Builder struct {
+	b builder
+	v MFD_M01
+}
+
+// MSH sets the MSH segment.
+func (b *MFD_M01Builder) MSH(v MSH) *MFD_M01Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MFI sets the MFI segment.
+func (b *MFD_M01Builder) MFI(v MFI) *MFD_M01Builder {
+	setOnce(&b.b, "MFI", &b.v.MFI, v)
+	return b
+}
+
+// MFA adds a MFA segment.
+func (b *MFD_M01Builder) MFA(v MFA) *MFD_M01Builder {
+	b.v.MFA = append(b.v.MFA, v)
+	return b
+}
+
+func (b *MFD_M01Builder) build() (MFD_M01, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MFI", builderCount(v.MFI != nil), 0, true)
+	bb.check("MFA", len(v.MFA), 0, false)
+	return v, bb.errs
+}
+
+// NewMFD_M01Builder returns a builder for MFD_M01.
+func NewMFD_M01Builder() *MFD_M01Builder {
+	return &MFD_M01Builder{b: builder{path: "MFD_M01", seq: 1}}
+}
+
+// Build returns the MFD_M01 and the validation errors, if any.
+func (b *MFD_M01Builder) Build() (MFD_M01, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// MFD_M02Builder adds the groups and segments of a MFD_M02.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type MFD_M02Builder struct {
+	b builder
+	v MFD_M02
+}
+
+// MSH sets the MSH segment.
+func (b *MFD_M02Builder) MSH(v MSH) *MFD_M02Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MFI sets the MFI segment.
+func (b *MFD_M02Builder) MFI(v MFI) *MFD_M02Builder {
+	setOnce(&b.b, "MFI", &b.v.MFI, v)
+	return b
+}
+
+// MFA adds a MFA segment.
+func (b *MFD_M02Builder) MFA(v MFA) *MFD_M02Builder {
+	b.v.MFA = append(b.v.MFA, v)
+	return b
+}
+
+func (b *MFD_M02Builder) build() (MFD_M02, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MFI", builderCount(v.MFI != nil), 0, true)
+	bb.check("MFA", len(v.MFA), 0, false)
+	return v, bb.errs
+}
+
+// NewMFD_M02Builder returns a builder for MFD_M02.
+func NewMFD_M02Builder() *MFD_M02Builder {
+	return &MFD_M02Builder{b: builder{path: "MFD_M02", seq: 1}}
+}
+
+// Build returns the MFD_M02 and the validation errors, if any.
+func (b *MFD_M02Builder) Build() (MFD_M02, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// MFD_M03Builder adds the groups and segments of a MFD_M03.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type MFD_M03Builder struct {
+	b builder
+	v MFD_M03
+}
+
+// MSH sets the MSH segment.
+func (b *MFD_M03Builder) MSH(v MSH) *MFD_M03Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MFI sets the MFI segment.
+func (b *MFD_M03Builder) MFI(v MFI) *MFD_M03Builder {
+	setOnce(&b.b, "MFI", &b.v.MFI, v)
+	return b
+}
+
+// MFA adds a MFA segment.
+func (b *MFD_M03Builder) MFA(v MFA) *MFD_M03Builder {
+	b.v.MFA = append(b.v.MFA, v)
+	return b
+}
+
+func (b *MFD_M03Builder) build() (MFD_M03, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MFI", builderCount(v.MFI != nil), 0, true)
+	bb.check("MFA", len(v.MFA), 0, false)
+	return v, bb.errs
+}
+
+// NewMFD_M03Builder returns a builder for MFD_M03.
+func NewMFD_M03Builder() *MFD_M03Builder {
+	return &MFD_M03Builder{b: builder{path: "MFD_M03", seq: 1}}
+}
+
+// Build returns the MFD_M03 and the validation errors, if any.
+func (b *MFD_M03Builder) Build() (MFD_M03, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// MFN_M01_MfBuilder adds the groups and segments of a MFN_M01_Mf.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type MFN_M01_MfBuilder struct {
+	b builder
+	v MFN_M01_Mf
+}
+
+// MFE sets the MFE segment.
+func (b *MFN_M01_MfBuilder) MFE(v MFE) *MFN_M01_MfBuilder {
+	setOnce(&b.b, "MFE", &b.v.MFE, v)
+	return b
+}
+
+// Zxx sets the Zxx segment.
+func (b *MFN_M01_MfBuilder) Zxx(v Zxx) *MFN_M01_MfBuilder {
+	setOnce(&b.b, "Zxx", &b.v.Zxx, v)
+	return b
+}
+
+func (b *MFN_M01_MfBuilder) build() (MFN_M01_Mf, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MFE", builderCount(v.MFE != nil), 0, true)
+	bb.check("Zxx", builderCount(v.Zxx != nil), 0, false)
+	return v, bb.errs
+}
+
+// MFN_M01Builder adds the groups and segments of a MFN_M01.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type MFN_M01Builder struct {
+	b   builder
+	v   MFN_M01
+	gMf []*MFN_M01_MfBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *MFN_M01Builder) MSH(v MSH) *MFN_M01Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MFI sets the MFI segment.
+func (b *MFN_M01Builder) MFI(v MFI) *MFN_M01Builder {
+	setOnce(&b.b, "MFI", &b.v.MFI, v)
+	return b
+}
+
+// Mf adds a Mf group and returns the group builder.
+func (b *MFN_M01Builder) Mf() *MFN_M01_MfBuilder {
+	g := &MFN_M01_MfBuilder{b: b.b.child("Mf", len(b.gMf))}
+	b.gMf = append(b.gMf, g)
+	return g
+}
+
+func (b *MFN_M01Builder) build() (MFN_M01, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gMf {
+		gv, errs := g.build()
+		v.Mf = append(v.Mf, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MFI", builderCount(v.MFI != nil), 0, true)
+	bb.check("Mf", len(v.Mf), 0, true)
+	return v, bb.errs
+}
+
+// NewMFN_M01Builder returns a builder for MFN_M01.
+func NewMFN_M01Builder() *MFN_M01Builder {
+	return &MFN_M01Builder{b: builder{path: "MFN_M01", seq: 1}}
+}
+
+// Build returns the MFN_M01 and the validation errors, if any.
+func (b *MFN_M01Builder) Build() (MFN_M01, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// MFN_M02_MfstaffBuilder adds the groups and segments of a MFN_M02_Mfstaff.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type MFN_M02_MfstaffBuilder struct {
+	b builder
+	v MFN_M02_Mfstaff
+}
+
+// MFE sets the MFE segment.
+func (b *MFN_M02_MfstaffBuilder) MFE(v MFE) *MFN_M02_MfstaffBuilder {
+	setOnce(&b.b, "MFE", &b.v.MFE, v)
+	return b
+}
+
+// Zxx sets the Zxx segment.
+func (b *MFN_M02_MfstaffBuilder) Zxx(v Zxx) *MFN_M02_MfstaffBuilder {
+	setOnce(&b.b, "Zxx", &b.v.Zxx, v)
+	return b
+}
+
+func (b *MFN_M02_MfstaffBuilder) build() (MFN_M02_Mfstaff, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MFE", builderCount(v.MFE != nil), 0, true)
+	bb.check("Zxx", builderCount(v.Zxx != nil), 0, false)
+	return v, bb.errs
+}
+
+// MFN_M02Builder adds the groups and segments of a MFN_M02.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type MFN_M02Builder struct {
+	b        builder
+	v        MFN_M02
+	gMfstaff []*MFN_M02_MfstaffBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *MFN_M02Builder) MSH(v MSH) *MFN_M02Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MFI sets the MFI segment.
+func (b *MFN_M02Builder) MFI(v MFI) *MFN_M02Builder {
+	setOnce(&b.b, "MFI", &b.v.MFI, v)
+	return b
+}
+
+// Mfstaff adds a Mfstaff group and returns the group builder.
+func (b *MFN_M02Builder) Mfstaff() *MFN_M02_MfstaffBuilder {
+	g := &MFN_M02_MfstaffBuilder{b: b.b.child("Mfstaff", len(b.gMfstaff))}
+	b.gMfstaff = append(b.gMfstaff, g)
+	return g
+}
+
+func (b *MFN_M02Builder) build() (MFN_M02, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gMfstaff {
+		gv, errs := g.build()
+		v.Mfstaff = append(v.Mfstaff, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MFI", builderCount(v.MFI != nil), 0, true)
+	bb.check("Mfstaff", len(v.Mfstaff), 0, true)
+	return v, bb.errs
+}
+
+// NewMFN_M02Builder returns a builder for MFN_M02.
+func NewMFN_M02Builder() *MFN_M02Builder {
+	return &MFN_M02Builder{b: builder{path: "MFN_M02", seq: 1}}
+}
+
+// Build returns the MFN_M02 and the validation errors, if any.
+func (b *MFN_M02Builder) Build() (MFN_M02, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// MFN_M03_MftestBuilder adds the groups and segments of a MFN_M03_Mftest.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type MFN_M03_MftestBuilder struct {
+	b builder
+	v MFN_M03_Mftest
+}
+
+// MFE sets the MFE segment.
+func (b *MFN_M03_MftestBuilder) MFE(v MFE) *MFN_M03_MftestBuilder {
+	setOnce(&b.b, "MFE", &b.v.MFE, v)
+	return b
+}
+
+// Zxx sets the Zxx segment.
+func (b *MFN_M03_MftestBuilder) Zxx(v Zxx) *MFN_M03_MftestBuilder {
+	setOnce(&b.b, "Zxx", &b.v.Zxx, v)
+	return b
+}
+
+func (b *MFN_M03_MftestBuilder) build() (MFN_M03_Mftest, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MFE", builderCount(v.MFE != nil), 0, true)
+	bb.check("Zxx", builderCount(v.Zxx != nil), 0, false)
+	return v, bb.errs
+}
+
+// MFN_M03Builder adds the groups and segments of a MFN_M03.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type MFN_M03Builder struct {
+	b       builder
+	v       MFN_M03
+	gMftest []*MFN_M03_MftestBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *MFN_M03Builder) MSH(v MSH) *MFN_M03Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MFI sets the MFI segment.
+func (b *MFN_M03Builder) MFI(v MFI) *MFN_M03Builder {
+	setOnce(&b.b, "MFI", &b.v.MFI, v)
+	return b
+}
+
+// Mftest adds a Mftest group and returns the group builder.
+func (b *MFN_M03Builder) Mftest() *MFN_M03_MftestBuilder {
+	g := &MFN_M03_MftestBuilder{b: b.b.child("Mftest", len(b.gMftest))}
+	b.gMftest = append(b.gMftest, g)
+	return g
+}
+
+func (b *MFN_M03Builder) build() (MFN_M03, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gMftest {
+		gv, errs := g.build()
+		v.Mftest = append(v.Mftest, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MFI", builderCount(v.MFI != nil), 0, true)
+	bb.check("Mftest", len(v.Mftest), 0, true)
+	return v, bb.errs
+}
+
+// NewMFN_M03Builder returns a builder for MFN_M03.
+func NewMFN_M03Builder() *MFN_M03Builder {
+	return &MFN_M03Builder{b: builder{path: "MFN_M03", seq: 1}}
+}
+
+// Build returns the MFN_M03 and the validation errors, if any.
+func (b *MFN_M03Builder) Build() (MFN_M03, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// MFQ_M01Builder adds the groups and segments of a MFQ_M01.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type MFQ_M01Builder struct {
+	b builder
+	v MFQ_M01
+}
+
+// MSH sets the MSH segment.
+func (b *MFQ_M01Builder) MSH(v MSH) *MFQ_M01Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// QRD sets the QRD segment.
+func (b *MFQ_M01Builder) QRD(v QRD) *MFQ_M01Builder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *MFQ_M01Builder) QRF(v QRF) *MFQ_M01Builder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// DSC sets the DSC segment.
+func (b *MFQ_M01Builder) DSC(v DSC) *MFQ_M01Builder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *MFQ_M01Builder) build() (MFQ_M01, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewMFQ_M01Builder returns a builder for MFQ_M01.
+func NewMFQ_M01Builder() *MFQ_M01Builder {
+	return &MFQ_M01Builder{b: builder{path: "MFQ_M01", seq: 1}}
+}
+
+// Build returns the MFQ_M01 and the validation errors, if any.
+func (b *MFQ_M01Builder) Build() (MFQ_M01, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// MFQ_M02Builder adds the groups and segments of a MFQ_M02.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type MFQ_M02Builder struct {
+	b builder
+	v MFQ_M02
+}
+
+// MSH sets the MSH segment.
+func (b *MFQ_M02Builder) MSH(v MSH) *MFQ_M02Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// QRD sets the QRD segment.
+func (b *MFQ_M02Builder) QRD(v QRD) *MFQ_M02Builder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *MFQ_M02Builder) QRF(v QRF) *MFQ_M02Builder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// DSC sets the DSC segment.
+func (b *MFQ_M02Builder) DSC(v DSC) *MFQ_M02Builder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *MFQ_M02Builder) build() (MFQ_M02, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewMFQ_M02Builder returns a builder for MFQ_M02.
+func NewMFQ_M02Builder() *MFQ_M02Builder {
+	return &MFQ_M02Builder{b: builder{path: "MFQ_M02", seq: 1}}
+}
+
+// Build returns the MFQ_M02 and the validation errors, if any.
+func (b *MFQ_M02Builder) Build() (MFQ_M02, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// MFQ_M03Builder adds the groups and segments of a MFQ_M03.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type MFQ_M03Builder struct {
+	b builder
+	v MFQ_M03
+}
+
+// MSH sets the MSH segment.
+func (b *MFQ_M03Builder) MSH(v MSH) *MFQ_M03Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// QRD sets the QRD segment.
+func (b *MFQ_M03Builder) QRD(v QRD) *MFQ_M03Builder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *MFQ_M03Builder) QRF(v QRF) *MFQ_M03Builder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// DSC sets the DSC segment.
+func (b *MFQ_M03Builder) DSC(v DSC) *MFQ_M03Builder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *MFQ_M03Builder) build() (MFQ_M03, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewMFQ_M03Builder returns a builder for MFQ_M03.
+func NewMFQ_M03Builder() *MFQ_M03Builder {
+	return &MFQ_M03Builder{b: builder{path: "MFQ_M03", seq: 1}}
+}
+
+// Build returns the MFQ_M03 and the validation errors, if any.
+func (b *MFQ_M03Builder) Build() (MFQ_M03, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// NMD_N01_ClockandstatswithnotesBuilder adds the groups and segments of a NMD_N01_Clockandstatswithnotes.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type NMD_N01_ClockandstatswithnotesBuilder struct {
+	b      builder
+	v      NMD_N01_Clockandstatswithnotes
+	gClock *NMD_N01_ClockBuilder
+}
+
+// Clock returns the Clock group builder, adding the group the first time.
+func (b *NMD_N01_ClockandstatswithnotesBuilder) Clock() *NMD_N01_ClockBuilder {
+	if b.gClock == nil {
+		b.gClock = &NMD_N01_ClockBuilder{b: b.b.child("Clock", -1)}
+	}
+	return b.gClock
+}
+
+func (b *NMD_N01_ClockandstatswithnotesBuilder) build() (NMD_N01_Clockandstatswithnotes, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gClock; g != nil {
+		gv, errs := g.build()
+		v.Clock = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("Clock", builderCount(v.Clock != nil), 0, false)
+	return v, bb.errs
+}
+
+// NMD_N01_ClockBuilder adds the groups and segments of a NMD_N01_Clock.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type NMD_N01_ClockBuilder struct {
+	b         builder
+	v         NMD_N01_Clock
+	gAppstats *NMD_N01_AppstatsBuilder
+}
+
+// NCK sets the NCK segment.
+func (b *NMD_N01_ClockBuilder) NCK(v NCK) *NMD_N01_ClockBuilder {
+	setOnce(&b.b, "NCK", &b.v.NCK, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *NMD_N01_ClockBuilder) NTE(v NTE) *NMD_N01_ClockBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Appstats returns the Appstats group builder, adding the group the first time.
+func (b *NMD_N01_ClockBuilder) Appstats() *NMD_N01_AppstatsBuilder {
+	if b.gAppstats == nil {
+		b.gAppstats = &NMD_N01_AppstatsBuilder{b: b.b.child("Appstats", -1)}
+	}
+	return b.gAppstats
+}
+
+func (b *NMD_N01_ClockBuilder) build() (NMD_N01_Clock, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gAppstats; g != nil {
+		gv, errs := g.build()
+		v.Appstats = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("NCK", builderCount(v.NCK != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Appstats", builderCount(v.Appstats != nil), 0, false)
+	return v, bb.errs
+}
+
+// NMD_N01_AppstatsBuilder adds the groups and segments of a NMD_N01_Appstats.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type NMD_N01_AppstatsBuilder struct {
+	b          builder
+	v          NMD_N01_Appstats
+	gAppstatus *NMD_N01_AppstatusBuilder
+}
+
+// NST sets the NST segment.
+func (b *NMD_N01_AppstatsBuilder) NST(v NST) *NMD_N01_AppstatsBuilder {
+	setOnce(&b.b, "NST", &b.v.NST, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *NMD_N01_AppstatsBuilder) NTE(v NTE) *NMD_N01_AppstatsBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Appstatus returns the Appstatus group builder, adding the group the first time.
+func (b *NMD_N01_AppstatsBuilder) Appstatus() *NMD_N01_AppstatusBuilder {
+	if b.gAppstatus == nil {
+		b.gAppstatus = &NMD_N01_AppstatusBuilder{b: b.b.child("Appstatus", -1)}
+	}
+	return b.gAppstatus
+}
+
+func (b *NMD_N01_AppstatsBuilder) build() (NMD_N01_Appstats, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gAppstatus; g != nil {
+		gv, errs := g.build()
+		v.Appstatus = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("NST", builderCount(v.NST != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Appstatus", builderCount(v.Appstatus != nil), 0, false)
+	return v, bb.errs
+}
+
+// NMD_N01_AppstatusBuilder adds the groups and segments of a NMD_N01_Appstatus.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type NMD_N01_AppstatusBuilder struct {
+	b builder
+	v NMD_N01_Appstatus
+}
+
+// NSC sets the NSC segment.
+func (b *NMD_N01_AppstatusBuilder) NSC(v NSC) *NMD_N01_AppstatusBuilder {
+	setOnce(&b.b, "NSC", &b.v.NSC, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *NMD_N01_AppstatusBuilder) NTE(v NTE) *NMD_N01_AppstatusBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+func (b *NMD_N01_AppstatusBuilder) build() (NMD_N01_Appstatus, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("NSC", builderCount(v.NSC != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	return v, bb.errs
+}
+
+// NMD_N01Builder adds the groups and segments of a NMD_N01.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type NMD_N01Builder struct {
+	b                       builder
+	v                       NMD_N01
+	gClockandstatswithnotes []*NMD_N01_ClockandstatswithnotesBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *NMD_N01Builder) MSH(v MSH) *NMD_N01Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// Clockandstatswithnotes adds a Clockandstatswithnotes group and returns the group builder.
+func (b *NMD_N01Builder) Clockandstatswithnotes() *NMD_N01_ClockandstatswithnotesBuilder {
+	g := &NMD_N01_ClockandstatswithnotesBuilder{b: b.b.child("Clockandstatswithnotes", len(b.gClockandstatswithnotes))}
+	b.gClockandstatswithnotes = append(b.gClockandstatswithnotes, g)
+	return g
+}
+
+func (b *NMD_N01Builder) build() (NMD_N01, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gClockandstatswithnotes {
+		gv, errs := g.build()
+		v.Clockandstatswithnotes = append(v.Clockandstatswithnotes, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("Clockandstatswithnotes", len(v.Clockandstatswithnotes), 0, true)
+	return v, bb.errs
+}
+
+// NewNMD_N01Builder returns a builder for NMD_N01.
+func NewNMD_N01Builder() *NMD_N01Builder {
+	return &NMD_N01Builder{b: builder{path: "NMD_N01", seq: 1}}
+}
+
+// Build returns the NMD_N01 and the validation errors, if any.
+func (b *NMD_N01Builder) Build() (NMD_N01, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// NMQ_N02_QrywithdetailBuilder adds the groups and segments of a NMQ_N02_Qrywithdetail.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type NMQ_N02_QrywithdetailBuilder struct {
+	b                   builder
+	v                   NMQ_N02_Qrywithdetail
+	gClockandstatistics []*NMQ_N02_ClockandstatisticsBuilder
+}
+
+// QRD sets the QRD segment.
+func (b *NMQ_N02_QrywithdetailBuilder) QRD(v QRD) *NMQ_N02_QrywithdetailBuilder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *NMQ_N02_QrywithdetailBuilder) QRF(v QRF) *NMQ_N02_QrywithdetailBuilder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// Clockandstatistics adds a Clockandstatistics group and returns the group builder.
+func (b *NMQ_N02_QrywithdetailBuilder) Clockandstatistics() *NMQ_N02_ClockandstatisticsBuilder {
+	g := &NMQ_N02_ClockandstatisticsBuilder{b: b.b.child("Clockandstatistics", len(b.gClockandstatistics))}
+	b.gClockandstatistics = append(b.gClockandstatistics, g)
+	return g
+}
+
+func (b *NMQ_N02_QrywithdetailBuilder) build() (NMQ_N02_Qrywithdetail, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gClockandstatistics {
+		gv, errs := g.build()
+		v.Clockandstatistics = append(v.Clockandstatistics, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("Clockandstatistics", len(v.Clockandstatistics), 0, true)
+	return v, bb.errs
+}
+
+// NMQ_N02_ClockandstatisticsBuilder adds the groups and segments of a NMQ_N02_Clockandstatistics.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type NMQ_N02_ClockandstatisticsBuilder struct {
+	b builder
+	v NMQ_N02_Clockandstatistics
+}
+
+// NCK sets the NCK segment.
+func (b *NMQ_N02_ClockandstatisticsBuilder) NCK(v NCK) *NMQ_N02_ClockandstatisticsBuilder {
+	setOnce(&b.b, "NCK", &b.v.NCK, v)
+	return b
+}
+
+// NST sets the NST segment.
+func (b *NMQ_N02_ClockandstatisticsBuilder) NST(v NST) *NMQ_N02_ClockandstatisticsBuilder {
+	setOnce(&b.b, "NST", &b.v.NST, v)
+	return b
+}
+
+// NSC sets the NSC segment.
+func (b *NMQ_N02_ClockandstatisticsBuilder) NSC(v NSC) *NMQ_N02_ClockandstatisticsBuilder {
+	setOnce(&b.b, "NSC", &b.v.NSC, v)
+	return b
+}
+
+func (b *NMQ_N02_ClockandstatisticsBuilder) build() (NMQ_N02_Clockandstatistics, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("NCK", builderCount(v.NCK != nil), 0, false)
+	bb.check("NST", builderCount(v.NST != nil), 0, false)
+	bb.check("NSC", builderCount(v.NSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NMQ_N02Builder adds the groups and segments of a NMQ_N02.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type NMQ_N02Builder struct {
+	b              builder
+	v              NMQ_N02
+	gQrywithdetail *NMQ_N02_QrywithdetailBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *NMQ_N02Builder) MSH(v MSH) *NMQ_N02Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// Qrywithdetail returns the Qrywithdetail group builder, adding the group the first time.
+func (b *NMQ_N02Builder) Qrywithdetail() *NMQ_N02_QrywithdetailBuilder {
+	if b.gQrywithdetail == nil {
+		b.gQrywithdetail = &NMQ_N02_QrywithdetailBuilder{b: b.b.child("Qrywithdetail", -1)}
+	}
+	return b.gQrywithdetail
+}
+
+func (b *NMQ_N02Builder) build() (NMQ_N02, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gQrywithdetail; g != nil {
+		gv, errs := g.build()
+		v.Qrywithdetail = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("Qrywithdetail", builderCount(v.Qrywithdetail != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewNMQ_N02Builder returns a builder for NMQ_N02.
+func NewNMQ_N02Builder() *NMQ_N02Builder {
+	return &NMQ_N02Builder{b: builder{path: "NMQ_N02", seq: 1}}
+}
+
+// Build returns the NMQ_N02 and the validation errors, if any.
+func (b *NMQ_N02Builder) Build() (NMQ_N02, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// NMR_N02_ResponseBuilder adds the groups and segments of a NMR_N02_Response.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type NMR_N02_ResponseBuilder struct {
+	b builder
+	v NMR_N02_Response
+}
+
+// NCK sets the NCK segment.
+func (b *NMR_N02_ResponseBuilder) NCK(v NCK) *NMR_N02_ResponseBuilder {
+	setOnce(&b.b, "NCK", &b.v.NCK, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *NMR_N02_ResponseBuilder) NTE(v NTE) *NMR_N02_ResponseBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// NST sets the NST segment.
+func (b *NMR_N02_ResponseBuilder) NST(v NST) *NMR_N02_ResponseBuilder {
+	setOnce(&b.b, "NST", &b.v.NST, v)
+	return b
+}
+
+// NTE2 adds a NTE segment.
+func (b *NMR_N02_ResponseBuilder) NTE2(v NTE) *NMR_N02_ResponseBuilder {
+	b.v.NTE2 = append(b.v.NTE2, v)
+	return b
+}
+
+// NSC sets the NSC segment.
+func (b *NMR_N02_ResponseBuilder) NSC(v NSC) *NMR_N02_ResponseBuilder {
+	setOnce(&b.b, "NSC", &b.v.NSC, v)
+	return b
+}
+
+// NTE3 adds a NTE segment.
+func (b *NMR_N02_ResponseBuilder) NTE3(v NTE) *NMR_N02_ResponseBuilder {
+	b.v.NTE3 = append(b.v.NTE3, v)
+	return b
+}
+
+func (b *NMR_N02_ResponseBuilder) build() (NMR_N02_Response, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("NCK", builderCount(v.NCK != nil), 0, false)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("NST", builderCount(v.NST != nil), 0, false)
+	bb.check("NTE2", len(v.NTE2), 0, false)
+	bb.check("NSC", builderCount(v.NSC != nil), 0, false)
+	bb.check("NTE3", len(v.NTE3), 0, false)
+	return v, bb.errs
+}
+
+// NMR_N02Builder adds the groups and segments of a NMR_N02.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type NMR_N02Builder struct {
+	b         builder
+	v         NMR_N02
+	gResponse []*NMR_N02_ResponseBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *NMR_N02Builder) MSH(v MSH) *NMR_N02Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MSA sets the MSA segment.
+func (b *NMR_N02Builder) MSA(v MSA) *NMR_N02Builder {
+	setOnce(&b.b, "MSA", &b.v.MSA, v)
+	return b
+}
+
+// ERR sets the ERR segment.
+func (b *NMR_N02Builder) ERR(v ERR) *NMR_N02Builder {
+	setOnce(&b.b, "ERR", &b.v.ERR, v)
+	return b
+}
+
+// QRD sets the QRD segment.
+func (b *NMR_N02Builder) QRD(v QRD) *NMR_N02Builder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// Response adds a Response group and returns the group builder.
+func (b *NMR_N02Builder) Response() *NMR_N02_ResponseBuilder {
+	g := &NMR_N02_ResponseBuilder{b: b.b.child("Response", len(b.gResponse))}
+	b.gResponse = append(b.gResponse, g)
+	return g
+}
+
+func (b *NMR_N02Builder) build() (NMR_N02, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gResponse {
+		gv, errs := g.build()
+		v.Response = append(v.Response, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MSA", builderCount(v.MSA != nil), 0, true)
+	bb.check("ERR", builderCount(v.ERR != nil), 0, false)
+	bb.check("QRD", builderCount(v.QRD != nil), 0, false)
+	bb.check("Response", len(v.Response), 0, true)
+	return v, bb.errs
+}
+
+// NewNMR_N02Builder returns a builder for NMR_N02.
+func NewNMR_N02Builder() *NMR_N02Builder {
+	return &NMR_N02Builder{b: builder{path: "NMR_N02", seq: 1}}
+}
+
+// Build returns the NMR_N02 and the validation errors, if any.
+func (b *NMR_N02Builder) Build() (NMR_N02, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ORF_R04_QueryresponseBuilder adds the groups and segments of a ORF_R04_Queryresponse.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORF_R04_QueryresponseBuilder struct {
+	b      builder
+	v      ORF_R04_Queryresponse
+	gOrder []*ORF_R04_OrderBuilder
+}
+
+// QRD sets the QRD segment.
+func (b *ORF_R04_QueryresponseBuilder) QRD(v QRD) *ORF_R04_QueryresponseBuilder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *ORF_R04_QueryresponseBuilder) QRF(v QRF) *ORF_R04_QueryresponseBuilder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// PID sets the PID segment.
+func (b *ORF_R04_QueryresponseBuilder) PID(v PID) *ORF_R04_QueryresponseBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ORF_R04_QueryresponseBuilder) NTE(v NTE) *ORF_R04_QueryresponseBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Order adds a Order group and returns the group builder.
+func (b *ORF_R04_QueryresponseBuilder) Order() *ORF_R04_OrderBuilder {
+	g := &ORF_R04_OrderBuilder{b: b.b.child("Order", len(b.gOrder))}
+	b.gOrder = append(b.gOrder, g)
+	return g
+}
+
+func (b *ORF_R04_QueryresponseBuilder) build() (ORF_R04_Queryresponse, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gOrder {
+		gv, errs := g.build()
+		v.Order = append(v.Order, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("PID", builderCount(v.PID != nil), 0, false)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Order", len(v.Order), 0, true)
+	return v, bb.errs
+}
+
+// ORF_R04_OrderBuilder adds the groups and segments of a ORF_R04_Order.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORF_R04_OrderBuilder struct {
+	b            builder
+	v            ORF_R04_Order
+	gObservation []*ORF_R04_ObservationBuilder
+}
+
+// ORC sets the ORC segment.
+func (b *ORF_R04_OrderBuilder) ORC(v ORC) *ORF_R04_OrderBuilder {
+	setOnce(&b.b, "ORC", &b.v.ORC, v)
+	return b
+}
+
+// OBR sets the OBR segment.
+func (b *ORF_R04_OrderBuilder) OBR(v OBR) *ORF_R04_OrderBuilder {
+	setOnce(&b.b, "OBR", &b.v.OBR, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ORF_R04_OrderBuilder) NTE(v NTE) *ORF_R04_OrderBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Observation adds a Observation group and returns the group builder.
+func (b *ORF_R04_OrderBuilder) Observation() *ORF_R04_ObservationBuilder {
+	g := &ORF_R04_ObservationBuilder{b: b.b.child("Observation", len(b.gObservation))}
+	b.gObservation = append(b.gObservation, g)
+	return g
+}
+
+func (b *ORF_R04_OrderBuilder) build() (ORF_R04_Order, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gObservation {
+		gv, errs := g.build()
+		v.Observation = append(v.Observation, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("ORC", builderCount(v.ORC != nil), 0, false)
+	bb.check("OBR", builderCount(v.OBR != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Observation", len(v.Observation), 0, true)
+	return v, bb.errs
+}
+
+// ORF_R04_ObservationBuilder adds the groups and segments of a ORF_R04_Observation.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORF_R04_ObservationBuilder struct {
+	b builder
+	v ORF_R04_Observation
+}
+
+// OBX sets the OBX segment.
+func (b *ORF_R04_ObservationBuilder) OBX(v OBX) *ORF_R04_ObservationBuilder {
+	setOnce(&b.b, "OBX", &b.v.OBX, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ORF_R04_ObservationBuilder) NTE(v NTE) *ORF_R04_ObservationBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+func (b *ORF_R04_ObservationBuilder) build() (ORF_R04_Observation, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("OBX", builderCount(v.OBX != nil), 0, false)
+	bb.check("NTE", len(v.NTE), 0, false)
+	return v, bb.errs
+}
+
+// ORF_R04Builder adds the groups and segments of a ORF_R04.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORF_R04Builder struct {
+	b              builder
+	v              ORF_R04
+	gQueryresponse []*ORF_R04_QueryresponseBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *ORF_R04Builder) MSH(v MSH) *ORF_R04Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MSA sets the MSA segment.
+func (b *ORF_R04Builder) MSA(v MSA) *ORF_R04Builder {
+	setOnce(&b.b, "MSA", &b.v.MSA, v)
+	return b
+}
+
+// Queryresponse adds a Queryresponse group and returns the group builder.
+func (b *ORF_R04Builder) Queryresponse() *ORF_R04_QueryresponseBuilder {
+	g := &ORF_R04_QueryresponseBuilder{b: b.b.child("Queryresponse", len(b.gQueryresponse))}
+	b.gQueryresponse = append(b.gQueryresponse, g)
+	return g
+}
+
+// DSC sets the DSC segment.
+func (b *ORF_R04Builder) DSC(v DSC) *ORF_R04Builder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *ORF_R04Builder) build() (ORF_R04, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gQueryresponse {
+		gv, errs := g.build()
+		v.Queryresponse = append(v.Queryresponse, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MSA", builderCount(v.MSA != nil), 0, true)
+	bb.check("Queryresponse", len(v.Queryresponse), 0, true)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewORF_R04Builder returns a builder for ORF_R04.
+func NewORF_R04Builder() *ORF_R04Builder {
+	return &ORF_R04Builder{b: builder{path: "ORF_R04", seq: 1}}
+}
+
+// Build returns the ORF_R04 and the validation errors, if any.
+func (b *ORF_R04Builder) Build() (ORF_R04, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ORM_O01_PatientBuilder adds the groups and segments of a ORM_O01_Patient.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORM_O01_PatientBuilder struct {
+	b builder
+	v ORM_O01_Patient
+}
+
+// PID sets the PID segment.
+func (b *ORM_O01_PatientBuilder) PID(v PID) *ORM_O01_PatientBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ORM_O01_PatientBuilder) NTE(v NTE) *ORM_O01_PatientBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// AL1 adds a AL1 segment.
+func (b *ORM_O01_PatientBuilder) AL1(v AL1) *ORM_O01_PatientBuilder {
+	b.v.AL1 = append(b.v.AL1, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ORM_O01_PatientBuilder) PV1(v PV1) *ORM_O01_PatientBuilder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+func (b *ORM_O01_PatientBuilder) build() (ORM_O01_Patient, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("AL1", len(v.AL1), 0, false)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, false)
+	return v, bb.errs
+}
+
+// ORM_O01_OrderBuilder adds the groups and segments of a ORM_O01_Order.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORM_O01_OrderBuilder struct {
+	b            builder
+	v            ORM_O01_Order
+	gOrderdetail *ORM_O01_OrderdetailBuilder
+}
+
+// ORC sets the ORC segment.
+func (b *ORM_O01_OrderBuilder) ORC(v ORC) *ORM_O01_OrderBuilder {
+	setOnce(&b.b, "ORC", &b.v.ORC, v)
+	return b
+}
+
+// Orderdetail returns the Orderdetail group builder, adding the group the first time.
+func (b *ORM_O01_OrderBuilder) Orderdetail() *ORM_O01_OrderdetailBuilder {
+	if b.gOrderdetail == nil {
+		b.gOrderdetail = &ORM_O01_OrderdetailBuilder{b: b.b.child("Orderdetail", -1)}
+	}
+	return b.gOrderdetail
+}
+
+// BLG sets the BLG segment.
+func (b *ORM_O01_OrderBuilder) BLG(v BLG) *ORM_O01_OrderBuilder {
+	setOnce(&b.b, "BLG", &b.v.BLG, v)
+	return b
+}
+
+func (b *ORM_O01_OrderBuilder) build() (ORM_O01_Order, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gOrderdetail; g != nil {
+		gv, errs := g.build()
+		v.Orderdetail = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("ORC", builderCount(v.ORC != nil), 0, true)
+	bb.check("Orderdetail", builderCount(v.Orderdetail != nil), 0, false)
+	bb.check("BLG", builderCount(v.BLG != nil), 0, false)
+	return v, bb.errs
+}
+
+// ORM_O01_OrderdetailBuilder adds the groups and segments of a ORM_O01_Orderdetail.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORM_O01_OrderdetailBuilder struct {
+	b                   builder
+	v                   ORM_O01_Orderdetail
+	gOrderDetailSegment *ORM_O01_OrderDetailSegmentBuilder
+	gObservation        []*ORM_O01_ObservationBuilder
+}
+
+// OrderDetailSegment returns the OrderDetailSegment group builder, adding the group the first time.
+func (b *ORM_O01_OrderdetailBuilder) OrderDetailSegment() *ORM_O01_OrderDetailSegmentBuilder {
+	if b.gOrderDetailSegment == nil {
+		b.gOrderDetailSegment = &ORM_O01_OrderDetailSegmentBuilder{b: b.b.child("OrderDetailSegment", -1)}
+	}
+	return b.gOrderDetailSegment
+}
+
+// NTE adds a NTE segment.
+func (b *ORM_O01_OrderdetailBuilder) NTE(v NTE) *ORM_O01_OrderdetailBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Observation adds a Observation group and returns the group builder.
+func (b *ORM_O01_OrderdetailBuilder) Observation() *ORM_O01_ObservationBuilder {
+	g := &ORM_O01_ObservationBuilder{b: b.b.child("Observation", len(b.gObservation))}
+	b.gObservation = append(b.gObservation, g)
+	return g
+}
+
+func (b *ORM_O01_OrderdetailBuilder) build() (ORM_O01_Orderdetail, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gOrderDetailSegment; g != nil {
+		gv, errs := g.build()
+		v.OrderDetailSegment = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	for _, g := range b.gObservation {
+		gv, errs := g.build()
+		v.Observation = append(v.Observation, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("OrderDetailSegment", builderCount(v.OrderDetailSegment != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Observation", len(v.Observation), 0, false)
+	return v, bb.errs
+}
+
+// ORM_O01_OrderDetailSegmentBuilder adds the groups and segments of a ORM_O01_OrderDetailSegment.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORM_O01_OrderDetailSegmentBuilder struct {
+	b builder
+	v ORM_O01_OrderDetailSegment
+}
+
+// OBR sets the OBR segment.
+func (b *ORM_O01_OrderDetailSegmentBuilder) OBR(v OBR) *ORM_O01_OrderDetailSegmentBuilder {
+	setOnce(&b.b, "OBR", &b.v.OBR, v)
+	return b
+}
+
+// RQD sets the RQD segment.
+func (b *ORM_O01_OrderDetailSegmentBuilder) RQD(v RQD) *ORM_O01_OrderDetailSegmentBuilder {
+	setOnce(&b.b, "RQD", &b.v.RQD, v)
+	return b
+}
+
+// RQ1 sets the RQ1 segment.
+func (b *ORM_O01_OrderDetailSegmentBuilder) RQ1(v RQ1) *ORM_O01_OrderDetailSegmentBuilder {
+	setOnce(&b.b, "RQ1", &b.v.RQ1, v)
+	return b
+}
+
+// RXO sets the RXO segment.
+func (b *ORM_O01_OrderDetailSegmentBuilder) RXO(v RXO) *ORM_O01_OrderDetailSegmentBuilder {
+	setOnce(&b.b, "RXO", &b.v.RXO, v)
+	return b
+}
+
+// ODS sets the ODS segment.
+func (b *ORM_O01_OrderDetailSegmentBuilder) ODS(v ODS) *ORM_O01_OrderDetailSegmentBuilder {
+	setOnce(&b.b, "ODS", &b.v.ODS, v)
+	return b
+}
+
+// ODT sets the ODT segment.
+func (b *ORM_O01_OrderDetailSegmentBuilder) ODT(v ODT) *ORM_O01_OrderDetailSegmentBuilder {
+	setOnce(&b.b, "ODT", &b.v.ODT, v)
+	return b
+}
+
+func (b *ORM_O01_OrderDetailSegmentBuilder) build() (ORM_O01_OrderDetailSegment, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("OBR", builderCount(v.OBR != nil), 0, false)
+	bb.check("RQD", builderCount(v.RQD != nil), 0, false)
+	bb.check("RQ1", builderCount(v.RQ1 != nil), 0, false)
+	bb.check("RXO", builderCount(v.RXO != nil), 0, false)
+	bb.check("ODS", builderCount(v.ODS != nil), 0, false)
+	bb.check("ODT", builderCount(v.ODT != nil), 0, false)
+	return v, bb.errs
+}
+
+// ORM_O01_ObservationBuilder adds the groups and segments of a ORM_O01_Observation.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORM_O01_ObservationBuilder struct {
+	b builder
+	v ORM_O01_Observation
+}
+
+// OBX sets the OBX segment.
+func (b *ORM_O01_ObservationBuilder) OBX(v OBX) *ORM_O01_ObservationBuilder {
+	setOnce(&b.b, "OBX", &b.v.OBX, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ORM_O01_ObservationBuilder) NTE(v NTE) *ORM_O01_ObservationBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+func (b *ORM_O01_ObservationBuilder) build() (ORM_O01_Observation, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("OBX", builderCount(v.OBX != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	return v, bb.errs
+}
+
+// ORM_O01Builder adds the groups and segments of a ORM_O01.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORM_O01Builder struct {
+	b        builder
+	v        ORM_O01
+	gPatient *ORM_O01_PatientBuilder
+	gOrder   []*ORM_O01_OrderBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *ORM_O01Builder) MSH(v MSH) *ORM_O01Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ORM_O01Builder) NTE(v NTE) *ORM_O01Builder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Patient returns the Patient group builder, adding the group the first time.
+func (b *ORM_O01Builder) Patient() *ORM_O01_PatientBuilder {
+	if b.gPatient == nil {
+		b.gPatient = &ORM_O01_PatientBuilder{b: b.b.child("Patient", -1)}
+	}
+	return b.gPatient
+}
+
+// Order adds a Order group and returns the group builder.
+func (b *ORM_O01Builder) Order() *ORM_O01_OrderBuilder {
+	g := &ORM_O01_OrderBuilder{b: b.b.child("Order", len(b.gOrder))}
+	b.gOrder = append(b.gOrder, g)
+	return g
+}
+
+func (b *ORM_O01Builder) build() (ORM_O01, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gPatient; g != nil {
+		gv, errs := g.build()
+		v.Patient = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	for _, g := range b.gOrder {
+		gv, errs := g.build()
+		v.Order = append(v.Order, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Patient", builderCount(v.Patient != nil), 0, false)
+	bb.check("Order", len(v.Order), 0, true)
+	return v, bb.errs
+}
+
+// NewORM_O01Builder returns a builder for ORM_O01.
+func NewORM_O01Builder() *ORM_O01Builder {
+	return &ORM_O01Builder{b: builder{path: "ORM_O01", seq: 1}}
+}
+
+// Build returns the ORM_O01 and the validation errors, if any.
+func (b *ORM_O01Builder) Build() (ORM_O01, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ORR_O02_PatientBuilder adds the groups and segments of a ORR_O02_Patient.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORR_O02_PatientBuilder struct {
+	b      builder
+	v      ORR_O02_Patient
+	gOrder []*ORR_O02_OrderBuilder
+}
+
+// PID sets the PID segment.
+func (b *ORR_O02_PatientBuilder) PID(v PID) *ORR_O02_PatientBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ORR_O02_PatientBuilder) NTE(v NTE) *ORR_O02_PatientBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Order adds a Order group and returns the group builder.
+func (b *ORR_O02_PatientBuilder) Order() *ORR_O02_OrderBuilder {
+	g := &ORR_O02_OrderBuilder{b: b.b.child("Order", len(b.gOrder))}
+	b.gOrder = append(b.gOrder, g)
+	return g
+}
+
+func (b *ORR_O02_PatientBuilder) build() (ORR_O02_Patient, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gOrder {
+		gv, errs := g.build()
+		v.Order = append(v.Order, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("PID", builderCount(v.PID != nil), 0, false)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Order", len(v.Order), 0, true)
+	return v, bb.errs
+}
+
+// ORR_O02_OrderBuilder adds the groups and segments of a ORR_O02_Order.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORR_O02_OrderBuilder struct {
+	b            builder
+	v            ORR_O02_Order
+	gOrderdetail *ORR_O02_OrderdetailBuilder
+}
+
+// ORC sets the ORC segment.
+func (b *ORR_O02_OrderBuilder) ORC(v ORC) *ORR_O02_OrderBuilder {
+	setOnce(&b.b, "ORC", &b.v.ORC, v)
+	return b
+}
+
+// Orderdetail returns the Orderdetail group builder, adding the group the first time.
+func (b *ORR_O02_OrderBuilder) Orderdetail() *ORR_O02_OrderdetailBuilder {
+	if b.gOrderdetail == nil {
+		b.gOrderdetail = &ORR_O02_OrderdetailBuilder{b: b.b.child("Orderdetail", -1)}
+	}
+	return b.gOrderdetail
+}
+
+func (b *ORR_O02_OrderBuilder) build() (ORR_O02_Order, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gOrderdetail; g != nil {
+		gv, errs := g.build()
+		v.Orderdetail = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("ORC", builderCount(v.ORC != nil), 0, true)
+	bb.check("Orderdetail", builderCount(v.Orderdetail != nil), 0, false)
+	return v, bb.errs
+}
+
+// ORR_O02_OrderdetailBuilder adds the groups and segments of a ORR_O02_Orderdetail.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORR_O02_OrderdetailBuilder struct {
+	b                   builder
+	v                   ORR_O02_Orderdetail
+	gOrderDetailSegment *ORR_O02_OrderDetailSegmentBuilder
+}
+
+// OrderDetailSegment returns the OrderDetailSegment group builder, adding the group the first time.
+func (b *ORR_O02_OrderdetailBuilder) OrderDetailSegment() *ORR_O02_OrderDetailSegmentBuilder {
+	if b.gOrderDetailSegment == nil {
+		b.gOrderDetailSegment = &ORR_O02_OrderDetailSegmentBuilder{b: b.b.child("OrderDetailSegment", -1)}
+	}
+	return b.gOrderDetailSegment
+}
+
+// NTE adds a NTE segment.
+func (b *ORR_O02_OrderdetailBuilder) NTE(v NTE) *ORR_O02_OrderdetailBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+func (b *ORR_O02_OrderdetailBuilder) build() (ORR_O02_Orderdetail, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gOrderDetailSegment; g != nil {
+		gv, errs := g.build()
+		v.OrderDetailSegment = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("OrderDetailSegment", builderCount(v.OrderDetailSegment != nil), 0, false)
+	bb.check("NTE", len(v.NTE), 0, false)
+	return v, bb.errs
+}
+
+// ORR_O02_OrderDetailSegmentBuilder adds the groups and segments of a ORR_O02_OrderDetailSegment.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORR_O02_OrderDetailSegmentBuilder struct {
+	b builder
+	v ORR_O02_OrderDetailSegment
+}
+
+// OBR sets the OBR segment.
+func (b *ORR_O02_OrderDetailSegmentBuilder) OBR(v OBR) *ORR_O02_OrderDetailSegmentBuilder {
+	setOnce(&b.b, "OBR", &b.v.OBR, v)
+	return b
+}
+
+// RQD sets the RQD segment.
+func (b *ORR_O02_OrderDetailSegmentBuilder) RQD(v RQD) *ORR_O02_OrderDetailSegmentBuilder {
+	setOnce(&b.b, "RQD", &b.v.RQD, v)
+	return b
+}
+
+// RQ1 sets the RQ1 segment.
+func (b *ORR_O02_OrderDetailSegmentBuilder) RQ1(v RQ1) *ORR_O02_OrderDetailSegmentBuilder {
+	setOnce(&b.b, "RQ1", &b.v.RQ1, v)
+	return b
+}
+
+// RXO sets the RXO segment.
+func (b *ORR_O02_OrderDetailSegmentBuilder) RXO(v RXO) *ORR_O02_OrderDetailSegmentBuilder {
+	setOnce(&b.b, "RXO", &b.v.RXO, v)
+	return b
+}
+
+// ODS sets the ODS segment.
+func (b *ORR_O02_OrderDetailSegmentBuilder) ODS(v ODS) *ORR_O02_OrderDetailSegmentBuilder {
+	setOnce(&b.b, "ODS", &b.v.ODS, v)
+	return b
+}
+
+// ODT sets the ODT segment.
+func (b *ORR_O02_OrderDetailSegmentBuilder) ODT(v ODT) *ORR_O02_OrderDetailSegmentBuilder {
+	setOnce(&b.b, "ODT", &b.v.ODT, v)
+	return b
+}
+
+func (b *ORR_O02_OrderDetailSegmentBuilder) build() (ORR_O02_OrderDetailSegment, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("OBR", builderCount(v.OBR != nil), 0, false)
+	bb.check("RQD", builderCount(v.RQD != nil), 0, false)
+	bb.check("RQ1", builderCount(v.RQ1 != nil), 0, false)
+	bb.check("RXO", builderCount(v.RXO != nil), 0, false)
+	bb.check("ODS", builderCount(v.ODS != nil), 0, false)
+	bb.check("ODT", builderCount(v.ODT != nil), 0, false)
+	return v, bb.errs
+}
+
+// ORR_O02Builder adds the groups and segments of a ORR_O02.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORR_O02Builder struct {
+	b        builder
+	v        ORR_O02
+	gPatient *ORR_O02_PatientBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *ORR_O02Builder) MSH(v MSH) *ORR_O02Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MSA sets the MSA segment.
+func (b *ORR_O02Builder) MSA(v MSA) *ORR_O02Builder {
+	setOnce(&b.b, "MSA", &b.v.MSA, v)
+	return b
+}
+
+// ERR sets the ERR segment.
+func (b *ORR_O02Builder) ERR(v ERR) *ORR_O02Builder {
+	setOnce(&b.b, "ERR", &b.v.ERR, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ORR_O02Builder) NTE(v NTE) *ORR_O02Builder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Patient returns the Patient group builder, adding the group the first time.
+func (b *ORR_O02Builder) Patient() *ORR_O02_PatientBuilder {
+	if b.gPatient == nil {
+		b.gPatient = &ORR_O02_PatientBuilder{b: b.b.child("Patient", -1)}
+	}
+	return b.gPatient
+}
+
+func (b *ORR_O02Builder) build() (ORR_O02, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gPatient; g != nil {
+		gv, errs := g.build()
+		v.Patient = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MSA", builderCount(v.MSA != nil), 0, true)
+	bb.check("ERR", builderCount(v.ERR != nil), 0, false)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Patient", builderCount(v.Patient != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewORR_O02Builder returns a builder for ORR_O02.
+func NewORR_O02Builder() *ORR_O02Builder {
+	return &ORR_O02Builder{b: builder{path: "ORR_O02", seq: 1}}
+}
+
+// Build returns the ORR_O02 and the validation errors, if any.
+func (b *ORR_O02Builder) Build() (ORR_O02, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ORU_R01_PatientresultBuilder adds the groups and segments of a ORU_R01_Patientresult.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORU_R01_PatientresultBuilder struct {
+	b                 builder
+	v                 ORU_R01_Patientresult
+	gPatient          *ORU_R01_PatientBuilder
+	gOrderobservation []*ORU_R01_OrderobservationBuilder
+}
+
+// Patient returns the Patient group builder, adding the group the first time.
+func (b *ORU_R01_PatientresultBuilder) Patient() *ORU_R01_PatientBuilder {
+	if b.gPatient == nil {
+		b.gPatient = &ORU_R01_PatientBuilder{b: b.b.child("Patient", -1)}
+	}
+	return b.gPatient
+}
+
+// Orderobservation adds a Orderobservation group and returns the group builder.
+func (b *ORU_R01_PatientresultBuilder) Orderobservation() *ORU_R01_OrderobservationBuilder {
+	g := &ORU_R01_OrderobservationBuilder{b: b.b.child("Orderobservation", len(b.gOrderobservation))}
+	b.gOrderobservation = append(b.gOrderobservation, g)
+	return g
+}
+
+func (b *ORU_R01_PatientresultBuilder) build() (ORU_R01_Patientresult, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gPatient; g != nil {
+		gv, errs := g.build()
+		v.Patient = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	for _, g := range b.gOrderobservation {
+		gv, errs := g.build()
+		v.Orderobservation = append(v.Orderobservation, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("Patient", builderCount(v.Patient != nil), 0, false)
+	bb.check("Orderobservation", len(v.Orderobservation), 0, true)
+	return v, bb.errs
+}
+
+// ORU_R01_PatientBuilder adds the groups and segments of a ORU_R01_Patient.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORU_R01_PatientBuilder struct {
+	b builder
+	v ORU_R01_Patient
+}
+
+// PID sets the PID segment.
+func (b *ORU_R01_PatientBuilder) PID(v PID) *ORU_R01_PatientBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ORU_R01_PatientBuilder) NTE(v NTE) *ORU_R01_PatientBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *ORU_R01_PatientBuilder) PV1(v PV1) *ORU_R01_PatientBuilder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+func (b *ORU_R01_PatientBuilder) build() (ORU_R01_Patient, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, false)
+	return v, bb.errs
+}
+
+// ORU_R01_OrderobservationBuilder adds the groups and segments of a ORU_R01_Orderobservation.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORU_R01_OrderobservationBuilder struct {
+	b            builder
+	v            ORU_R01_Orderobservation
+	gObservation []*ORU_R01_ObservationBuilder
+}
+
+// ORC sets the ORC segment.
+func (b *ORU_R01_OrderobservationBuilder) ORC(v ORC) *ORU_R01_OrderobservationBuilder {
+	setOnce(&b.b, "ORC", &b.v.ORC, v)
+	return b
+}
+
+// OBR sets the OBR segment.
+func (b *ORU_R01_OrderobservationBuilder) OBR(v OBR) *ORU_R01_OrderobservationBuilder {
+	setOnce(&b.b, "OBR", &b.v.OBR, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ORU_R01_OrderobservationBuilder) NTE(v NTE) *ORU_R01_OrderobservationBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Observation adds a Observation group and returns the group builder.
+func (b *ORU_R01_OrderobservationBuilder) Observation() *ORU_R01_ObservationBuilder {
+	g := &ORU_R01_ObservationBuilder{b: b.b.child("Observation", len(b.gObservation))}
+	b.gObservation = append(b.gObservation, g)
+	return g
+}
+
+func (b *ORU_R01_OrderobservationBuilder) build() (ORU_R01_Orderobservation, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gObservation {
+		gv, errs := g.build()
+		v.Observation = append(v.Observation, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("ORC", builderCount(v.ORC != nil), 0, false)
+	bb.check("OBR", builderCount(v.OBR != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Observation", len(v.Observation), 0, true)
+	return v, bb.errs
+}
+
+// ORU_R01_ObservationBuilder adds the groups and segments of a ORU_R01_Observation.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORU_R01_ObservationBuilder struct {
+	b builder
+	v ORU_R01_Observation
+}
+
+// OBX sets the OBX segment.
+func (b *ORU_R01_ObservationBuilder) OBX(v OBX) *ORU_R01_ObservationBuilder {
+	setOnce(&b.b, "OBX", &b.v.OBX, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ORU_R01_ObservationBuilder) NTE(v NTE) *ORU_R01_ObservationBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+func (b *ORU_R01_ObservationBuilder) build() (ORU_R01_Observation, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("OBX", builderCount(v.OBX != nil), 0, false)
+	bb.check("NTE", len(v.NTE), 0, false)
+	return v, bb.errs
+}
+
+// ORU_R01Builder adds the groups and segments of a ORU_R01.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ORU_R01Builder struct {
+	b              builder
+	v              ORU_R01
+	gPatientresult []*ORU_R01_PatientresultBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *ORU_R01Builder) MSH(v MSH) *ORU_R01Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// Patientresult adds a Patientresult group and returns the group builder.
+func (b *ORU_R01Builder) Patientresult() *ORU_R01_PatientresultBuilder {
+	g := &ORU_R01_PatientresultBuilder{b: b.b.child("Patientresult", len(b.gPatientresult))}
+	b.gPatientresult = append(b.gPatientresult, g)
+	return g
+}
+
+// DSC sets the DSC segment.
+func (b *ORU_R01Builder) DSC(v DSC) *ORU_R01Builder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *ORU_R01Builder) build() (ORU_R01, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gPatientresult {
+		gv, errs := g.build()
+		v.Patientresult = append(v.Patientresult, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("Patientresult", len(v.Patientresult), 0, true)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewORU_R01Builder returns a builder for ORU_R01.
+func NewORU_R01Builder() *ORU_R01Builder {
+	return &ORU_R01Builder{b: builder{path: "ORU_R01", seq: 1}}
+}
+
+// Build returns the ORU_R01 and the validation errors, if any.
+func (b *ORU_R01Builder) Build() (ORU_R01, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// QRY_A19Builder adds the groups and segments of a QRY_A19.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type QRY_A19Builder struct {
+	b builder
+	v QRY_A19
+}
+
+// MSH sets the MSH segment.
+func (b *QRY_A19Builder) MSH(v MSH) *QRY_A19Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// QRD sets the QRD segment.
+func (b *QRY_A19Builder) QRD(v QRD) *QRY_A19Builder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *QRY_A19Builder) QRF(v QRF) *QRY_A19Builder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+func (b *QRY_A19Builder) build() (QRY_A19, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewQRY_A19Builder returns a builder for QRY_A19.
+func NewQRY_A19Builder() *QRY_A19Builder {
+	return &QRY_A19Builder{b: builder{path: "QRY_A19", seq: 1}}
+}
+
+// Build returns the QRY_A19 and the validation errors, if any.
+func (b *QRY_A19Builder) Build() (QRY_A19, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// QRY_P04Builder adds the groups and segments of a QRY_P04.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type QRY_P04Builder struct {
+	b builder
+	v QRY_P04
+}
+
+// MSH sets the MSH segment.
+func (b *QRY_P04Builder) MSH(v MSH) *QRY_P04Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// QRD sets the QRD segment.
+func (b *QRY_P04Builder) QRD(v QRD) *QRY_P04Builder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *QRY_P04Builder) QRF(v QRF) *QRY_P04Builder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// DSC sets the DSC segment.
+func (b *QRY_P04Builder) DSC(v DSC) *QRY_P04Builder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *QRY_P04Builder) build() (QRY_P04, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewQRY_P04Builder returns a builder for QRY_P04.
+func NewQRY_P04Builder() *QRY_P04Builder {
+	return &QRY_P04Builder{b: builder{path: "QRY_P04", seq: 1}}
+}
+
+// Build returns the QRY_P04 and the validation errors, if any.
+func (b *QRY_P04Builder) Build() (QRY_P04, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// QRY_Q01Builder adds the groups and segments of a QRY_Q01.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type QRY_Q01Builder struct {
+	b builder
+	v QRY_Q01
+}
+
+// MSH sets the MSH segment.
+func (b *QRY_Q01Builder) MSH(v MSH) *QRY_Q01Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// QRD sets the QRD segment.
+func (b *QRY_Q01Builder) QRD(v QRD) *QRY_Q01Builder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *QRY_Q01Builder) QRF(v QRF) *QRY_Q01Builder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// DSC sets the DSC segment.
+func (b *QRY_Q01Builder) DSC(v DSC) *QRY_Q01Builder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *QRY_Q01Builder) build() (QRY_Q01, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewQRY_Q01Builder returns a builder for QRY_Q01.
+func NewQRY_Q01Builder() *QRY_Q01Builder {
+	return &QRY_Q01Builder{b: builder{path: "QRY_Q01", seq: 1}}
+}
+
+// Build returns the QRY_Q01 and the validation errors, if any.
+func (b *QRY_Q01Builder) Build() (QRY_Q01, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// QRY_Q02Builder adds the groups and segments of a QRY_Q02.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type QRY_Q02Builder struct {
+	b builder
+	v QRY_Q02
+}
+
+// MSH sets the MSH segment.
+func (b *QRY_Q02Builder) MSH(v MSH) *QRY_Q02Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// QRD sets the QRD segment.
+func (b *QRY_Q02Builder) QRD(v QRD) *QRY_Q02Builder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *QRY_Q02Builder) QRF(v QRF) *QRY_Q02Builder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// DSC sets the DSC segment.
+func (b *QRY_Q02Builder) DSC(v DSC) *QRY_Q02Builder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *QRY_Q02Builder) build() (QRY_Q02, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewQRY_Q02Builder returns a builder for QRY_Q02.
+func NewQRY_Q02Builder() *QRY_Q02Builder {
+	return &QRY_Q02Builder{b: builder{path: "QRY_Q02", seq: 1}}
+}
+
+// Build returns the QRY_Q02 and the validation errors, if any.
+func (b *QRY_Q02Builder) Build() (QRY_Q02, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// QRY_Q26Builder adds the groups and segments of a QRY_Q26.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type QRY_Q26Builder struct {
+	b builder
+	v QRY_Q26
+}
+
+// MSH sets the MSH segment.
+func (b *QRY_Q26Builder) MSH(v MSH) *QRY_Q26Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// QRD sets the QRD segment.
+func (b *QRY_Q26Builder) QRD(v QRD) *QRY_Q26Builder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *QRY_Q26Builder) QRF(v QRF) *QRY_Q26Builder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// DSC sets the DSC segment.
+func (b *QRY_Q26Builder) DSC(v DSC) *QRY_Q26Builder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *QRY_Q26Builder) build() (QRY_Q26, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewQRY_Q26Builder returns a builder for QRY_Q26.
+func NewQRY_Q26Builder() *QRY_Q26Builder {
+	return &QRY_Q26Builder{b: builder{path: "QRY_Q26", seq: 1}}
+}
+
+// Build returns the QRY_Q26 and the validation errors, if any.
+func (b *QRY_Q26Builder) Build() (QRY_Q26, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// QRY_Q27Builder adds the groups and segments of a QRY_Q27.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type QRY_Q27Builder struct {
+	b builder
+	v QRY_Q27
+}
+
+// MSH sets the MSH segment.
+func (b *QRY_Q27Builder) MSH(v MSH) *QRY_Q27Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// QRD sets the QRD segment.
+func (b *QRY_Q27Builder) QRD(v QRD) *QRY_Q27Builder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *QRY_Q27Builder) QRF(v QRF) *QRY_Q27Builder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// DSC sets the DSC segment.
+func (b *QRY_Q27Builder) DSC(v DSC) *QRY_Q27Builder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *QRY_Q27Builder) build() (QRY_Q27, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewQRY_Q27Builder returns a builder for QRY_Q27.
+func NewQRY_Q27Builder() *QRY_Q27Builder {
+	return &QRY_Q27Builder{b: builder{path: "QRY_Q27", seq: 1}}
+}
+
+// Build returns the QRY_Q27 and the validation errors, if any.
+func (b *QRY_Q27Builder) Build() (QRY_Q27, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// QRY_Q28Builder adds the groups and segments of a QRY_Q28.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type QRY_Q28Builder struct {
+	b builder
+	v QRY_Q28
+}
+
+// MSH sets the MSH segment.
+func (b *QRY_Q28Builder) MSH(v MSH) *QRY_Q28Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// QRD sets the QRD segment.
+func (b *QRY_Q28Builder) QRD(v QRD) *QRY_Q28Builder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *QRY_Q28Builder) QRF(v QRF) *QRY_Q28Builder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// DSC sets the DSC segment.
+func (b *QRY_Q28Builder) DSC(v DSC) *QRY_Q28Builder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *QRY_Q28Builder) build() (QRY_Q28, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewQRY_Q28Builder returns a builder for QRY_Q28.
+func NewQRY_Q28Builder() *QRY_Q28Builder {
+	return &QRY_Q28Builder{b: builder{path: "QRY_Q28", seq: 1}}
+}
+
+// Build returns the QRY_Q28 and the validation errors, if any.
+func (b *QRY_Q28Builder) Build() (QRY_Q28, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// QRY_Q29Builder adds the groups and segments of a QRY_Q29.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type QRY_Q29Builder struct {
+	b builder
+	v QRY_Q29
+}
+
+// MSH sets the MSH segment.
+func (b *QRY_Q29Builder) MSH(v MSH) *QRY_Q29Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// QRD sets the QRD segment.
+func (b *QRY_Q29Builder) QRD(v QRD) *QRY_Q29Builder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *QRY_Q29Builder) QRF(v QRF) *QRY_Q29Builder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// DSC sets the DSC segment.
+func (b *QRY_Q29Builder) DSC(v DSC) *QRY_Q29Builder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *QRY_Q29Builder) build() (QRY_Q29, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewQRY_Q29Builder returns a builder for QRY_Q29.
+func NewQRY_Q29Builder() *QRY_Q29Builder {
+	return &QRY_Q29Builder{b: builder{path: "QRY_Q29", seq: 1}}
+}
+
+// Build returns the QRY_Q29 and the validation errors, if any.
+func (b *QRY_Q29Builder) Build() (QRY_Q29, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// QRY_Q30Builder adds the groups and segments of a QRY_Q30.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type QRY_Q30Builder struct {
+	b builder
+	v QRY_Q30
+}
+
+// MSH sets the MSH segment.
+func (b *QRY_Q30Builder) MSH(v MSH) *QRY_Q30Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// QRD sets the QRD segment.
+func (b *QRY_Q30Builder) QRD(v QRD) *QRY_Q30Builder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *QRY_Q30Builder) QRF(v QRF) *QRY_Q30Builder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// DSC sets the DSC segment.
+func (b *QRY_Q30Builder) DSC(v DSC) *QRY_Q30Builder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *QRY_Q30Builder) build() (QRY_Q30, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewQRY_Q30Builder returns a builder for QRY_Q30.
+func NewQRY_Q30Builder() *QRY_Q30Builder {
+	return &QRY_Q30Builder{b: builder{path: "QRY_Q30", seq: 1}}
+}
+
+// Build returns the QRY_Q30 and the validation errors, if any.
+func (b *QRY_Q30Builder) Build() (QRY_Q30, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// QRY_R02Builder adds the groups and segments of a QRY_R02.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type QRY_R02Builder struct {
+	b builder
+	v QRY_R02
+}
+
+// MSH sets the MSH segment.
+func (b *QRY_R02Builder) MSH(v MSH) *QRY_R02Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// QRD sets the QRD segment.
+func (b *QRY_R02Builder) QRD(v QRD) *QRY_R02Builder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *QRY_R02Builder) QRF(v QRF) *QRY_R02Builder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// DSC sets the DSC segment.
+func (b *QRY_R02Builder) DSC(v DSC) *QRY_R02Builder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *QRY_R02Builder) build() (QRY_R02, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, true)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewQRY_R02Builder returns a builder for QRY_R02.
+func NewQRY_R02Builder() *QRY_R02Builder {
+	return &QRY_R02Builder{b: builder{path: "QRY_R02", seq: 1}}
+}
+
+// Build returns the QRY_R02 and the validation errors, if any.
+func (b *QRY_R02Builder) Build() (QRY_R02, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// RAR_RAR_DefinitionBuilder adds the groups and segments of a RAR_RAR_Definition.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RAR_RAR_DefinitionBuilder struct {
+	b        builder
+	v        RAR_RAR_Definition
+	gPatient *RAR_RAR_PatientBuilder
+	gOrder   []*RAR_RAR_OrderBuilder
+}
+
+// QRD sets the QRD segment.
+func (b *RAR_RAR_DefinitionBuilder) QRD(v QRD) *RAR_RAR_DefinitionBuilder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *RAR_RAR_DefinitionBuilder) QRF(v QRF) *RAR_RAR_DefinitionBuilder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// Patient returns the Patient group builder, adding the group the first time.
+func (b *RAR_RAR_DefinitionBuilder) Patient() *RAR_RAR_PatientBuilder {
+	if b.gPatient == nil {
+		b.gPatient = &RAR_RAR_PatientBuilder{b: b.b.child("Patient", -1)}
+	}
+	return b.gPatient
+}
+
+// Order adds a Order group and returns the group builder.
+func (b *RAR_RAR_DefinitionBuilder) Order() *RAR_RAR_OrderBuilder {
+	g := &RAR_RAR_OrderBuilder{b: b.b.child("Order", len(b.gOrder))}
+	b.gOrder = append(b.gOrder, g)
+	return g
+}
+
+func (b *RAR_RAR_DefinitionBuilder) build() (RAR_RAR_Definition, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gPatient; g != nil {
+		gv, errs := g.build()
+		v.Patient = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	for _, g := range b.gOrder {
+		gv, errs := g.build()
+		v.Order = append(v.Order, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("Patient", builderCount(v.Patient != nil), 0, false)
+	bb.check("Order", len(v.Order), 0, true)
+	return v, bb.errs
+}
+
+// RAR_RAR_PatientBuilder adds the groups and segments of a RAR_RAR_Patient.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RAR_RAR_PatientBuilder struct {
+	b builder
+	v RAR_RAR_Patient
+}
+
+// PID sets the PID segment.
+func (b *RAR_RAR_PatientBuilder) PID(v PID) *RAR_RAR_PatientBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RAR_RAR_PatientBuilder) NTE(v NTE) *RAR_RAR_PatientBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+func (b *RAR_RAR_PatientBuilder) build() (RAR_RAR_Patient, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	return v, bb.errs
+}
+
+// RAR_RAR_OrderBuilder adds the groups and segments of a RAR_RAR_Order.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RAR_RAR_OrderBuilder struct {
+	b         builder
+	v         RAR_RAR_Order
+	gEncoding *RAR_RAR_EncodingBuilder
+}
+
+// ORC sets the ORC segment.
+func (b *RAR_RAR_OrderBuilder) ORC(v ORC) *RAR_RAR_OrderBuilder {
+	setOnce(&b.b, "ORC", &b.v.ORC, v)
+	return b
+}
+
+// Encoding returns the Encoding group builder, adding the group the first time.
+func (b *RAR_RAR_OrderBuilder) Encoding() *RAR_RAR_EncodingBuilder {
+	if b.gEncoding == nil {
+		b.gEncoding = &RAR_RAR_EncodingBuilder{b: b.b.child("Encoding", -1)}
+	}
+	return b.gEncoding
+}
+
+// RXA adds a RXA segment.
+func (b *RAR_RAR_OrderBuilder) RXA(v RXA) *RAR_RAR_OrderBuilder {
+	b.v.RXA = append(b.v.RXA, v)
+	return b
+}
+
+func (b *RAR_RAR_OrderBuilder) build() (RAR_RAR_Order, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gEncoding; g != nil {
+		gv, errs := g.build()
+		v.Encoding = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("ORC", builderCount(v.ORC != nil), 0, true)
+	bb.check("Encoding", builderCount(v.Encoding != nil), 0, false)
+	bb.check("RXA", len(v.RXA), 0, true)
+	return v, bb.errs
+}
+
+// RAR_RAR_EncodingBuilder adds the groups and segments of a RAR_RAR_Encoding.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RAR_RAR_EncodingBuilder struct {
+	b builder
+	v RAR_RAR_Encoding
+}
+
+// RXE sets the RXE segment.
+func (b *RAR_RAR_EncodingBuilder) RXE(v RXE) *RAR_RAR_EncodingBuilder {
+	setOnce(&b.b, "RXE", &b.v.RXE, v)
+	return b
+}
+
+// RXR adds a RXR segment.
+func (b *RAR_RAR_EncodingBuilder) RXR(v RXR) *RAR_RAR_EncodingBuilder {
+	b.v.RXR = append(b.v.RXR, v)
+	return b
+}
+
+// RXC adds a RXC segment.
+func (b *RAR_RAR_EncodingBuilder) RXC(v RXC) *RAR_RAR_EncodingBuilder {
+	b.v.RXC = append(b.v.RXC, v)
+	return b
+}
+
+func (b *RAR_RAR_EncodingBuilder) build() (RAR_RAR_Encoding, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("RXE", builderCount(v.RXE != nil), 0, true)
+	bb.check("RXR", len(v.RXR), 0, true)
+	bb.check("RXC", len(v.RXC), 0, false)
+	return v, bb.errs
+}
+
+// RAR_RARBuilder adds the groups and segments of a RAR_RAR.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RAR_RARBuilder struct {
+	b           builder
+	v           RAR_RAR
+	gDefinition []*RAR_RAR_DefinitionBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *RAR_RARBuilder) MSH(v MSH) *RAR_RARBuilder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MSA sets the MSA segment.
+func (b *RAR_RARBuilder) MSA(v MSA) *RAR_RARBuilder {
+	setOnce(&b.b, "MSA", &b.v.MSA, v)
+	return b
+}
+
+// ERR sets the ERR segment.
+func (b *RAR_RARBuilder) ERR(v ERR) *RAR_RARBuilder {
+	setOnce(&b.b, "ERR", &b.v.ERR, v)
+	return b
+}
+
+// Definition adds a Definition group and returns the group builder.
+func (b *RAR_RARBuilder) Definition() *RAR_RAR_DefinitionBuilder {
+	g := &RAR_RAR_DefinitionBuilder{b: b.b.child("Definition", len(b.gDefinition))}
+	b.gDefinition = append(b.gDefinition, g)
+	return g
+}
+
+// DSC sets the DSC segment.
+func (b *RAR_RARBuilder) DSC(v DSC) *RAR_RARBuilder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *RAR_RARBuilder) build() (RAR_RAR, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gDefinition {
+		gv, errs := g.build()
+		v.Definition = append(v.Definition, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MSA", builderCount(v.MSA != nil), 0, true)
+	bb.check("ERR", builderCount(v.ERR != nil), 0, false)
+	bb.check("Definition", len(v.Definition), 0, true)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewRAR_RARBuilder returns a builder for RAR_RAR.
+func NewRAR_RARBuilder() *RAR_RARBuilder {
+	return &RAR_RARBuilder{b: builder{path: "RAR_RAR", seq: 1}}
+}
+
+// Build returns the RAR_RAR and the validation errors, if any.
+func (b *RAR_RARBuilder) Build() (RAR_RAR, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// RAS_O02_PatientBuilder adds the groups and segments of a RAS_O02_Patient.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RAS_O02_PatientBuilder struct {
+	b builder
+	v RAS_O02_Patient
+}
+
+// PID sets the PID segment.
+func (b *RAS_O02_PatientBuilder) PID(v PID) *RAS_O02_PatientBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RAS_O02_PatientBuilder) NTE(v NTE) *RAS_O02_PatientBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// AL1 adds a AL1 segment.
+func (b *RAS_O02_PatientBuilder) AL1(v AL1) *RAS_O02_PatientBuilder {
+	b.v.AL1 = append(b.v.AL1, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *RAS_O02_PatientBuilder) PV1(v PV1) *RAS_O02_PatientBuilder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+func (b *RAS_O02_PatientBuilder) build() (RAS_O02_Patient, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("AL1", len(v.AL1), 0, false)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, false)
+	return v, bb.errs
+}
+
+// RAS_O02_OrderBuilder adds the groups and segments of a RAS_O02_Order.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RAS_O02_OrderBuilder struct {
+	b            builder
+	v            RAS_O02_Order
+	gOrderDetail *RAS_O02_OrderDetailBuilder
+	gEncoding    *RAS_O02_EncodingBuilder
+	gObservation []*RAS_O02_ObservationBuilder
+}
+
+// ORC sets the ORC segment.
+func (b *RAS_O02_OrderBuilder) ORC(v ORC) *RAS_O02_OrderBuilder {
+	setOnce(&b.b, "ORC", &b.v.ORC, v)
+	return b
+}
+
+// OrderDetail returns the OrderDetail group builder, adding the group the first time.
+func (b *RAS_O02_OrderBuilder) OrderDetail() *RAS_O02_OrderDetailBuilder {
+	if b.gOrderDetail == nil {
+		b.gOrderDetail = &RAS_O02_OrderDetailBuilder{b: b.b.child("OrderDetail", -1)}
+	}
+	return b.gOrderDetail
+}
+
+// Encoding returns the Encoding group builder, adding the group the first time.
+func (b *RAS_O02_OrderBuilder) Encoding() *RAS_O02_EncodingBuilder {
+	if b.gEncoding == nil {
+		b.gEncoding = &RAS_O02_EncodingBuilder{b: b.b.child("Encoding", -1)}
+	}
+	return b.gEncoding
+}
+
+// RXA adds a RXA segment.
+func (b *RAS_O02_OrderBuilder) RXA(v RXA) *RAS_O02_OrderBuilder {
+	b.v.RXA = append(b.v.RXA, v)
+	return b
+}
+
+// RXR sets the RXR segment.
+func (b *RAS_O02_OrderBuilder) RXR(v RXR) *RAS_O02_OrderBuilder {
+	setOnce(&b.b, "RXR", &b.v.RXR, v)
+	return b
+}
+
+// Observation adds a Observation group and returns the group builder.
+func (b *RAS_O02_OrderBuilder) Observation() *RAS_O02_ObservationBuilder {
+	g := &RAS_O02_ObservationBuilder{b: b.b.child("Observation", len(b.gObservation))}
+	b.gObservation = append(b.gObservation, g)
+	return g
+}
+
+func (b *RAS_O02_OrderBuilder) build() (RAS_O02_Order, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gOrderDetail; g != nil {
+		gv, errs := g.build()
+		v.OrderDetail = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	if g := b.gEncoding; g != nil {
+		gv, errs := g.build()
+		v.Encoding = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	for _, g := range b.gObservation {
+		gv, errs := g.build()
+		v.Observation = append(v.Observation, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("ORC", builderCount(v.ORC != nil), 0, true)
+	bb.check("OrderDetail", builderCount(v.OrderDetail != nil), 0, false)
+	bb.check("Encoding", builderCount(v.Encoding != nil), 0, false)
+	bb.check("RXA", len(v.RXA), 0, true)
+	bb.check("RXR", builderCount(v.RXR != nil), 0, true)
+	bb.check("Observation", len(v.Observation), 0, false)
+	return v, bb.errs
+}
+
+// RAS_O02_OrderDetailBuilder adds the groups and segments of a RAS_O02_OrderDetail.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RAS_O02_OrderDetailBuilder struct {
+	b                      builder
+	v                      RAS_O02_OrderDetail
+	gOrderDetailSupplement *RAS_O02_OrderDetailSupplementBuilder
+}
+
+// RXO sets the RXO segment.
+func (b *RAS_O02_OrderDetailBuilder) RXO(v RXO) *RAS_O02_OrderDetailBuilder {
+	setOnce(&b.b, "RXO", &b.v.RXO, v)
+	return b
+}
+
+// OrderDetailSupplement returns the OrderDetailSupplement group builder, adding the group the first time.
+func (b *RAS_O02_OrderDetailBuilder) OrderDetailSupplement() *RAS_O02_OrderDetailSupplementBuilder {
+	if b.gOrderDetailSupplement == nil {
+		b.gOrderDetailSupplement = &RAS_O02_OrderDetailSupplementBuilder{b: b.b.child("OrderDetailSupplement", -1)}
+	}
+	return b.gOrderDetailSupplement
+}
+
+func (b *RAS_O02_OrderDetailBuilder) build() (RAS_O02_OrderDetail, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gOrderDetailSupplement; g != nil {
+		gv, errs := g.build()
+		v.OrderDetailSupplement = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("RXO", builderCount(v.RXO != nil), 0, true)
+	bb.check("OrderDetailSupplement", builderCount(v.OrderDetailSupplement != nil), 0, false)
+	return v, bb.errs
+}
+
+// RAS_O02_OrderDetailSupplementBuilder adds the groups and segments of a RAS_O02_OrderDetailSupplement.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RAS_O02_OrderDetailSupplementBuilder struct {
+	b           builder
+	v           RAS_O02_OrderDetailSupplement
+	gComponents *RAS_O02_ComponentsBuilder
+}
+
+// NTE adds a NTE segment.
+func (b *RAS_O02_OrderDetailSupplementBuilder) NTE(v NTE) *RAS_O02_OrderDetailSupplementBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// RXR adds a RXR segment.
+func (b *RAS_O02_OrderDetailSupplementBuilder) RXR(v RXR) *RAS_O02_OrderDetailSupplementBuilder {
+	b.v.RXR = append(b.v.RXR, v)
+	return b
+}
+
+// Components returns the Components group builder, adding the group the first time.
+func (b *RAS_O02_OrderDetailSupplementBuilder) Components() *RAS_O02_ComponentsBuilder {
+	if b.gComponents == nil {
+		b.gComponents = &RAS_O02_ComponentsBuilder{b: b.b.child("Components", -1)}
+	}
+	return b.gComponents
+}
+
+func (b *RAS_O02_OrderDetailSupplementBuilder) build() (RAS_O02_OrderDetailSupplement, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gComponents; g != nil {
+		gv, errs := g.build()
+		v.Components = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("NTE", len(v.NTE), 0, true)
+	bb.check("RXR", len(v.RXR), 0, true)
+	bb.check("Components", builderCount(v.Components != nil), 0, false)
+	return v, bb.errs
+}
+
+// RAS_O02_ComponentsBuilder adds the groups and segments of a RAS_O02_Components.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RAS_O02_ComponentsBuilder struct {
+	b builder
+	v RAS_O02_Components
+}
+
+// RXC adds a RXC segment.
+func (b *RAS_O02_ComponentsBuilder) RXC(v RXC) *RAS_O02_ComponentsBuilder {
+	b.v.RXC = append(b.v.RXC, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RAS_O02_ComponentsBuilder) NTE(v NTE) *RAS_O02_ComponentsBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+func (b *RAS_O02_ComponentsBuilder) build() (RAS_O02_Components, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("RXC", len(v.RXC), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	return v, bb.errs
+}
+
+// RAS_O02_EncodingBuilder adds the groups and segments of a RAS_O02_Encoding.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RAS_O02_EncodingBuilder struct {
+	b builder
+	v RAS_O02_Encoding
+}
+
+// RXE sets the RXE segment.
+func (b *RAS_O02_EncodingBuilder) RXE(v RXE) *RAS_O02_EncodingBuilder {
+	setOnce(&b.b, "RXE", &b.v.RXE, v)
+	return b
+}
+
+// RXR adds a RXR segment.
+func (b *RAS_O02_EncodingBuilder) RXR(v RXR) *RAS_O02_EncodingBuilder {
+	b.v.RXR = append(b.v.RXR, v)
+	return b
+}
+
+// RXC adds a RXC segment.
+func (b *RAS_O02_EncodingBuilder) RXC(v RXC) *RAS_O02_EncodingBuilder {
+	b.v.RXC = append(b.v.RXC, v)
+	return b
+}
+
+func (b *RAS_O02_EncodingBuilder) build() (RAS_O02_Encoding, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("RXE", builderCount(v.RXE != nil), 0, true)
+	bb.check("RXR", len(v.RXR), 0, true)
+	bb.check("RXC", len(v.RXC), 0, false)
+	return v, bb.errs
+}
+
+// RAS_O02_ObservationBuilder adds the groups and segments of a RAS_O02_Observation.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RAS_O02_ObservationBuilder struct {
+	b builder
+	v RAS_O02_Observation
+}
+
+// OBX sets the OBX segment.
+func (b *RAS_O02_ObservationBuilder) OBX(v OBX) *RAS_O02_ObservationBuilder {
+	setOnce(&b.b, "OBX", &b.v.OBX, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RAS_O02_ObservationBuilder) NTE(v NTE) *RAS_O02_ObservationBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+func (b *RAS_O02_ObservationBuilder) build() (RAS_O02_Observation, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("OBX", builderCount(v.OBX != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	return v, bb.errs
+}
+
+// RAS_O02Builder adds the groups and segments of a RAS_O02.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RAS_O02Builder struct {
+	b        builder
+	v        RAS_O02
+	gPatient *RAS_O02_PatientBuilder
+	gOrder   []*RAS_O02_OrderBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *RAS_O02Builder) MSH(v MSH) *RAS_O02Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RAS_O02Builder) NTE(v NTE) *RAS_O02Builder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Patient returns the Patient group builder, adding the group the first time.
+func (b *RAS_O02Builder) Patient() *RAS_O02_PatientBuilder {
+	if b.gPatient == nil {
+		b.gPatient = &RAS_O02_PatientBuilder{b: b.b.child("Patient", -1)}
+	}
+	return b.gPatient
+}
+
+// Order adds a Order group and returns the group builder.
+func (b *RAS_O02Builder) Order() *RAS_O02_OrderBuilder {
+	g := &RAS_O02_OrderBuilder{b: b.b.child("Order", len(b.gOrder))}
+	b.gOrder = append(b.gOrder, g)
+	return g
+}
+
+func (b *RAS_O02Builder) build() (RAS_O02, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gPatient; g != nil {
+		gv, errs := g.build()
+		v.Patient = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	for _, g := range b.gOrder {
+		gv, errs := g.build()
+		v.Order = append(v.Order, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Patient", builderCount(v.Patient != nil), 0, false)
+	bb.check("Order", len(v.Order), 0, true)
+	return v, bb.errs
+}
+
+// NewRAS_O02Builder returns a builder for RAS_O02.
+func NewRAS_O02Builder() *RAS_O02Builder {
+	return &RAS_O02Builder{b: builder{path: "RAS_O02", seq: 1}}
+}
+
+// Build returns the RAS_O02 and the validation errors, if any.
+func (b *RAS_O02Builder) Build() (RAS_O02, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// RDE_O01_PatientBuilder adds the groups and segments of a RDE_O01_Patient.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RDE_O01_PatientBuilder struct {
+	b builder
+	v RDE_O01_Patient
+}
+
+// PID sets the PID segment.
+func (b *RDE_O01_PatientBuilder) PID(v PID) *RDE_O01_PatientBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RDE_O01_PatientBuilder) NTE(v NTE) *RDE_O01_PatientBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// AL1 adds a AL1 segment.
+func (b *RDE_O01_PatientBuilder) AL1(v AL1) *RDE_O01_PatientBuilder {
+	b.v.AL1 = append(b.v.AL1, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *RDE_O01_PatientBuilder) PV1(v PV1) *RDE_O01_PatientBuilder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+func (b *RDE_O01_PatientBuilder) build() (RDE_O01_Patient, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("AL1", len(v.AL1), 0, false)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, false)
+	return v, bb.errs
+}
+
+// RDE_O01_OrderBuilder adds the groups and segments of a RDE_O01_Order.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RDE_O01_OrderBuilder struct {
+	b            builder
+	v            RDE_O01_Order
+	gOrderdetail *RDE_O01_OrderdetailBuilder
+	gObservation []*RDE_O01_ObservationBuilder
+}
+
+// ORC sets the ORC segment.
+func (b *RDE_O01_OrderBuilder) ORC(v ORC) *RDE_O01_OrderBuilder {
+	setOnce(&b.b, "ORC", &b.v.ORC, v)
+	return b
+}
+
+// Orderdetail returns the Orderdetail group builder, adding the group the first time.
+func (b *RDE_O01_OrderBuilder) Orderdetail() *RDE_O01_OrderdetailBuilder {
+	if b.gOrderdetail == nil {
+		b.gOrderdetail = &RDE_O01_OrderdetailBuilder{b: b.b.child("Orderdetail", -1)}
+	}
+	return b.gOrderdetail
+}
+
+// RXE sets the RXE segment.
+func (b *RDE_O01_OrderBuilder) RXE(v RXE) *RDE_O01_OrderBuilder {
+	setOnce(&b.b, "RXE", &b.v.RXE, v)
+	return b
+}
+
+// RXR adds a RXR segment.
+func (b *RDE_O01_OrderBuilder) RXR(v RXR) *RDE_O01_OrderBuilder {
+	b.v.RXR = append(b.v.RXR, v)
+	return b
+}
+
+// RXC adds a RXC segment.
+func (b *RDE_O01_OrderBuilder) RXC(v RXC) *RDE_O01_OrderBuilder {
+	b.v.RXC = append(b.v.RXC, v)
+	return b
+}
+
+// Observation adds a Observation group and returns the group builder.
+func (b *RDE_O01_OrderBuilder) Observation() *RDE_O01_ObservationBuilder {
+	g := &RDE_O01_ObservationBuilder{b: b.b.child("Observation", len(b.gObservation))}
+	b.gObservation = append(b.gObservation, g)
+	return g
+}
+
+func (b *RDE_O01_OrderBuilder) build() (RDE_O01_Order, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gOrderdetail; g != nil {
+		gv, errs := g.build()
+		v.Orderdetail = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	for _, g := range b.gObservation {
+		gv, errs := g.build()
+		v.Observation = append(v.Observation, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("ORC", builderCount(v.ORC != nil), 0, true)
+	bb.check("Orderdetail", builderCount(v.Orderdetail != nil), 0, false)
+	bb.check("RXE", builderCount(v.RXE != nil), 0, true)
+	bb.check("RXR", len(v.RXR), 0, true)
+	bb.check("RXC", len(v.RXC), 0, false)
+	bb.check("Observation", len(v.Observation), 0, true)
+	return v, bb.errs
+}
+
+// RDE_O01_OrderdetailBuilder adds the groups and segments of a RDE_O01_Orderdetail.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RDE_O01_OrderdetailBuilder struct {
+	b          builder
+	v          RDE_O01_Orderdetail
+	gComponent *RDE_O01_ComponentBuilder
+}
+
+// RXO sets the RXO segment.
+func (b *RDE_O01_OrderdetailBuilder) RXO(v RXO) *RDE_O01_OrderdetailBuilder {
+	setOnce(&b.b, "RXO", &b.v.RXO, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RDE_O01_OrderdetailBuilder) NTE(v NTE) *RDE_O01_OrderdetailBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// RXR adds a RXR segment.
+func (b *RDE_O01_OrderdetailBuilder) RXR(v RXR) *RDE_O01_OrderdetailBuilder {
+	b.v.RXR = append(b.v.RXR, v)
+	return b
+}
+
+// Component returns the Component group builder, adding the group the first time.
+func (b *RDE_O01_OrderdetailBuilder) Component() *RDE_O01_ComponentBuilder {
+	if b.gComponent == nil {
+		b.gComponent = &RDE_O01_ComponentBuilder{b: b.b.child("Component", -1)}
+	}
+	return b.gComponent
+}
+
+func (b *RDE_O01_OrderdetailBuilder) build() (RDE_O01_Orderdetail, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gComponent; g != nil {
+		gv, errs := g.build()
+		v.Component = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("RXO", builderCount(v.RXO != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("RXR", len(v.RXR), 0, true)
+	bb.check("Component", builderCount(v.Component != nil), 0, false)
+	return v, bb.errs
+}
+
+// RDE_O01_ComponentBuilder adds the groups and segments of a RDE_O01_Component.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RDE_O01_ComponentBuilder struct {
+	b builder
+	v RDE_O01_Component
+}
+
+// RXC adds a RXC segment.
+func (b *RDE_O01_ComponentBuilder) RXC(v RXC) *RDE_O01_ComponentBuilder {
+	b.v.RXC = append(b.v.RXC, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RDE_O01_ComponentBuilder) NTE(v NTE) *RDE_O01_ComponentBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+func (b *RDE_O01_ComponentBuilder) build() (RDE_O01_Component, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("RXC", len(v.RXC), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	return v, bb.errs
+}
+
+// RDE_O01_ObservationBuilder adds the groups and segments of a RDE_O01_Observation.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RDE_O01_ObservationBuilder struct {
+	b builder
+	v RDE_O01_Observation
+}
+
+// OBX sets the OBX segment.
+func (b *RDE_O01_ObservationBuilder) OBX(v OBX) *RDE_O01_ObservationBuilder {
+	setOnce(&b.b, "OBX", &b.v.OBX, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RDE_O01_ObservationBuilder) NTE(v NTE) *RDE_O01_ObservationBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+func (b *RDE_O01_ObservationBuilder) build() (RDE_O01_Observation, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("OBX", builderCount(v.OBX != nil), 0, false)
+	bb.check("NTE", len(v.NTE), 0, false)
+	return v, bb.errs
+}
+
+// RDE_O01Builder adds the groups and segments of a RDE_O01.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RDE_O01Builder struct {
+	b        builder
+	v        RDE_O01
+	gPatient *RDE_O01_PatientBuilder
+	gOrder   []*RDE_O01_OrderBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *RDE_O01Builder) MSH(v MSH) *RDE_O01Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RDE_O01Builder) NTE(v NTE) *RDE_O01Builder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Patient returns the Patient group builder, adding the group the first time.
+func (b *RDE_O01Builder) Patient() *RDE_O01_PatientBuilder {
+	if b.gPatient == nil {
+		b.gPatient = &RDE_O01_PatientBuilder{b: b.b.child("Patient", -1)}
+	}
+	return b.gPatient
+}
+
+// Order adds a Order group and returns the group builder.
+func (b *RDE_O01Builder) Order() *RDE_O01_OrderBuilder {
+	g := &RDE_O01_OrderBuilder{b: b.b.child("Order", len(b.gOrder))}
+	b.gOrder = append(b.gOrder, g)
+	return g
+}
+
+func (b *RDE_O01Builder) build() (RDE_O01, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gPatient; g != nil {
+		gv, errs := g.build()
+		v.Patient = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	for _, g := range b.gOrder {
+		gv, errs := g.build()
+		v.Order = append(v.Order, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Patient", builderCount(v.Patient != nil), 0, false)
+	bb.check("Order", len(v.Order), 0, true)
+	return v, bb.errs
+}
+
+// NewRDE_O01Builder returns a builder for RDE_O01.
+func NewRDE_O01Builder() *RDE_O01Builder {
+	return &RDE_O01Builder{b: builder{path: "RDE_O01", seq: 1}}
+}
+
+// Build returns the RDE_O01 and the validation errors, if any.
+func (b *RDE_O01Builder) Build() (RDE_O01, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// RDR_RDR_DefinitionBuilder adds the groups and segments of a RDR_RDR_Definition.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RDR_RDR_DefinitionBuilder struct {
+	b        builder
+	v        RDR_RDR_Definition
+	gPatient *RDR_RDR_PatientBuilder
+	gOrder   []*RDR_RDR_OrderBuilder
+}
+
+// QRD sets the QRD segment.
+func (b *RDR_RDR_DefinitionBuilder) QRD(v QRD) *RDR_RDR_DefinitionBuilder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *RDR_RDR_DefinitionBuilder) QRF(v QRF) *RDR_RDR_DefinitionBuilder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// Patient returns the Patient group builder, adding the group the first time.
+func (b *RDR_RDR_DefinitionBuilder) Patient() *RDR_RDR_PatientBuilder {
+	if b.gPatient == nil {
+		b.gPatient = &RDR_RDR_PatientBuilder{b: b.b.child("Patient", -1)}
+	}
+	return b.gPatient
+}
+
+// Order adds a Order group and returns the group builder.
+func (b *RDR_RDR_DefinitionBuilder) Order() *RDR_RDR_OrderBuilder {
+	g := &RDR_RDR_OrderBuilder{b: b.b.child("Order", len(b.gOrder))}
+	b.gOrder = append(b.gOrder, g)
+	return g
+}
+
+func (b *RDR_RDR_DefinitionBuilder) build() (RDR_RDR_Definition, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gPatient; g != nil {
+		gv, errs := g.build()
+		v.Patient = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	for _, g := range b.gOrder {
+		gv, errs := g.build()
+		v.Order = append(v.Order, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("Patient", builderCount(v.Patient != nil), 0, false)
+	bb.check("Order", len(v.Order), 0, true)
+	return v, bb.errs
+}
+
+// RDR_RDR_PatientBuilder adds the groups and segments of a RDR_RDR_Patient.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RDR_RDR_PatientBuilder struct {
+	b builder
+	v RDR_RDR_Patient
+}
+
+// PID sets the PID segment.
+func (b *RDR_RDR_PatientBuilder) PID(v PID) *RDR_RDR_PatientBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RDR_RDR_PatientBuilder) NTE(v NTE) *RDR_RDR_PatientBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+func (b *RDR_RDR_PatientBuilder) build() (RDR_RDR_Patient, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	return v, bb.errs
+}
+
+// RDR_RDR_OrderBuilder adds the groups and segments of a RDR_RDR_Order.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RDR_RDR_OrderBuilder struct {
+	b         builder
+	v         RDR_RDR_Order
+	gEncoding *RDR_RDR_EncodingBuilder
+	gDispense []*RDR_RDR_DispenseBuilder
+}
+
+// ORC sets the ORC segment.
+func (b *RDR_RDR_OrderBuilder) ORC(v ORC) *RDR_RDR_OrderBuilder {
+	setOnce(&b.b, "ORC", &b.v.ORC, v)
+	return b
+}
+
+// Encoding returns the Encoding group builder, adding the group the first time.
+func (b *RDR_RDR_OrderBuilder) Encoding() *RDR_RDR_EncodingBuilder {
+	if b.gEncoding == nil {
+		b.gEncoding = &RDR_RDR_EncodingBuilder{b: b.b.child("Encoding", -1)}
+	}
+	return b.gEncoding
+}
+
+// Dispense adds a Dispense group and returns the group builder.
+func (b *RDR_RDR_OrderBuilder) Dispense() *RDR_RDR_DispenseBuilder {
+	g := &RDR_RDR_DispenseBuilder{b: b.b.child("Dispense", len(b.gDispense))}
+	b.gDispense = append(b.gDispense, g)
+	return g
+}
+
+func (b *RDR_RDR_OrderBuilder) build() (RDR_RDR_Order, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gEncoding; g != nil {
+		gv, errs := g.build()
+		v.Encoding = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	for _, g := range b.gDispense {
+		gv, errs := g.build()
+		v.Dispense = append(v.Dispense, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("ORC", builderCount(v.ORC != nil), 0, true)
+	bb.check("Encoding", builderCount(v.Encoding != nil), 0, false)
+	bb.check("Dispense", len(v.Dispense), 0, true)
+	return v, bb.errs
+}
+
+// RDR_RDR_EncodingBuilder adds the groups and segments of a RDR_RDR_Encoding.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RDR_RDR_EncodingBuilder struct {
+	b builder
+	v RDR_RDR_Encoding
+}
+
+// RXE sets the RXE segment.
+func (b *RDR_RDR_EncodingBuilder) RXE(v RXE) *RDR_RDR_EncodingBuilder {
+	setOnce(&b.b, "RXE", &b.v.RXE, v)
+	return b
+}
+
+// RXR sets the RXR segment.
+func (b *RDR_RDR_EncodingBuilder) RXR(v RXR) *RDR_RDR_EncodingBuilder {
+	setOnce(&b.b, "RXR", &b.v.RXR, v)
+	return b
+}
+
+// RXC adds a RXC segment.
+func (b *RDR_RDR_EncodingBuilder) RXC(v RXC) *RDR_RDR_EncodingBuilder {
+	b.v.RXC = append(b.v.RXC, v)
+	return b
+}
+
+func (b *RDR_RDR_EncodingBuilder) build() (RDR_RDR_Encoding, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("RXE", builderCount(v.RXE != nil), 0, true)
+	bb.check("RXR", builderCount(v.RXR != nil), 0, true)
+	bb.check("RXC", len(v.RXC), 0, false)
+	return v, bb.errs
+}
+
+// RDR_RDR_DispenseBuilder adds the groups and segments of a RDR_RDR_Dispense.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RDR_RDR_DispenseBuilder struct {
+	b builder
+	v RDR_RDR_Dispense
+}
+
+// RXD sets the RXD segment.
+func (b *RDR_RDR_DispenseBuilder) RXD(v RXD) *RDR_RDR_DispenseBuilder {
+	setOnce(&b.b, "RXD", &b.v.RXD, v)
+	return b
+}
+
+// RXR adds a RXR segment.
+func (b *RDR_RDR_DispenseBuilder) RXR(v RXR) *RDR_RDR_DispenseBuilder {
+	b.v.RXR = append(b.v.RXR, v)
+	return b
+}
+
+func (b *RDR_RDR_DispenseBuilder) build() (RDR_RDR_Dispense, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("RXD", builderCount(v.RXD != nil), 0, true)
+	bb.check("RXR", len(v.RXR), 0, true)
+	return v, bb.errs
+}
+
+// RDR_RDRBuilder adds the groups and segments of a RDR_RDR.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RDR_RDRBuilder struct {
+	b           builder
+	v           RDR_RDR
+	gDefinition []*RDR_RDR_DefinitionBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *RDR_RDRBuilder) MSH(v MSH) *RDR_RDRBuilder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MSA sets the MSA segment.
+func (b *RDR_RDRBuilder) MSA(v MSA) *RDR_RDRBuilder {
+	setOnce(&b.b, "MSA", &b.v.MSA, v)
+	return b
+}
+
+// ERR sets the ERR segment.
+func (b *RDR_RDRBuilder) ERR(v ERR) *RDR_RDRBuilder {
+	setOnce(&b.b, "ERR", &b.v.ERR, v)
+	return b
+}
+
+// Definition adds a Definition group and returns the group builder.
+func (b *RDR_RDRBuilder) Definition() *RDR_RDR_DefinitionBuilder {
+	g := &RDR_RDR_DefinitionBuilder{b: b.b.child("Definition", len(b.gDefinition))}
+	b.gDefinition = append(b.gDefinition, g)
+	return g
+}
+
+// DSC sets the DSC segment.
+func (b *RDR_RDRBuilder) DSC(v DSC) *RDR_RDRBuilder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *RDR_RDRBuilder) build() (RDR_RDR, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gDefinition {
+		gv, errs := g.build()
+		v.Definition = append(v.Definition, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MSA", builderCount(v.MSA != nil), 0, true)
+	bb.check("ERR", builderCount(v.ERR != nil), 0, false)
+	bb.check("Definition", len(v.Definition), 0, true)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewRDR_RDRBuilder returns a builder for RDR_RDR.
+func NewRDR_RDRBuilder() *RDR_RDRBuilder {
+	return &RDR_RDRBuilder{b: builder{path: "RDR_RDR", seq: 1}}
+}
+
+// Build returns the RDR_RDR and the validation errors, if any.
+func (b *RDR_RDRBuilder) Build() (RDR_RDR, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// RDS_O01_PatientBuilder adds the groups and segments of a RDS_O01_Patient.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RDS_O01_PatientBuilder struct {
+	b builder
+	v RDS_O01_Patient
+}
+
+// PID sets the PID segment.
+func (b *RDS_O01_PatientBuilder) PID(v PID) *RDS_O01_PatientBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RDS_O01_PatientBuilder) NTE(v NTE) *RDS_O01_PatientBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// AL1 adds a AL1 segment.
+func (b *RDS_O01_PatientBuilder) AL1(v AL1) *RDS_O01_PatientBuilder {
+	b.v.AL1 = append(b.v.AL1, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *RDS_O01_PatientBuilder) PV1(v PV1) *RDS_O01_PatientBuilder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+func (b *RDS_O01_PatientBuilder) build() (RDS_O01_Patient, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("AL1", len(v.AL1), 0, false)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, false)
+	return v, bb.errs
+}
+
+// RDS_O01_OrderBuilder adds the groups and segments of a RDS_O01_Order.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RDS_O01_OrderBuilder struct {
+	b            builder
+	v            RDS_O01_Order
+	gOrderdetail *RDS_O01_OrderdetailBuilder
+	gEncoding    *RDS_O01_EncodingBuilder
+	gObservation []*RDS_O01_ObservationBuilder
+}
+
+// ORC sets the ORC segment.
+func (b *RDS_O01_OrderBuilder) ORC(v ORC) *RDS_O01_OrderBuilder {
+	setOnce(&b.b, "ORC", &b.v.ORC, v)
+	return b
+}
+
+// Orderdetail returns the Orderdetail group builder, adding the group the first time.
+func (b *RDS_O01_OrderBuilder) Orderdetail() *RDS_O01_OrderdetailBuilder {
+	if b.gOrderdetail == nil {
+		b.gOrderdetail = &RDS_O01_OrderdetailBuilder{b: b.b.child("Orderdetail", -1)}
+	}
+	return b.gOrderdetail
+}
+
+// Encoding returns the Encoding group builder, adding the group the first time.
+func (b *RDS_O01_OrderBuilder) Encoding() *RDS_O01_EncodingBuilder {
+	if b.gEncoding == nil {
+		b.gEncoding = &RDS_O01_EncodingBuilder{b: b.b.child("Encoding", -1)}
+	}
+	return b.gEncoding
+}
+
+// RXD sets the RXD segment.
+func (b *RDS_O01_OrderBuilder) RXD(v RXD) *RDS_O01_OrderBuilder {
+	setOnce(&b.b, "RXD", &b.v.RXD, v)
+	return b
+}
+
+// RXR adds a RXR segment.
+func (b *RDS_O01_OrderBuilder) RXR(v RXR) *RDS_O01_OrderBuilder {
+	b.v.RXR = append(b.v.RXR, v)
+	return b
+}
+
+// RXE adds a RXE segment.
+func (b *RDS_O01_OrderBuilder) RXE(v RXE) *RDS_O01_OrderBuilder {
+	b.v.RXE = append(b.v.RXE, v)
+	return b
+}
+
+// Observation adds a Observation group and returns the group builder.
+func (b *RDS_O01_OrderBuilder) Observation() *RDS_O01_ObservationBuilder {
+	g := &RDS_O01_ObservationBuilder{b: b.b.child("Observation", len(b.gObservation))}
+	b.gObservation = append(b.gObservation, g)
+	return g
+}
+
+func (b *RDS_O01_OrderBuilder) build() (RDS_O01_Order, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gOrderdetail; g != nil {
+		gv, errs := g.build()
+		v.Orderdetail = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	if g := b.gEncoding; g != nil {
+		gv, errs := g.build()
+		v.Encoding = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	for _, g := range b.gObservation {
+		gv, errs := g.build()
+		v.Observation = append(v.Observation, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("ORC", builderCount(v.ORC != nil), 0, true)
+	bb.check("Orderdetail", builderCount(v.Orderdetail != nil), 0, false)
+	bb.check("Encoding", builderCount(v.Encoding != nil), 0, false)
+	bb.check("RXD", builderCount(v.RXD != nil), 0, true)
+	bb.check("RXR", len(v.RXR), 0, true)
+	bb.check("RXE", len(v.RXE), 0, false)
+	bb.check("Observation", len(v.Observation), 0, true)
+	return v, bb.errs
+}
+
+// RDS_O01_OrderdetailBuilder adds the groups and segments of a RDS_O01_Orderdetail.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RDS_O01_OrderdetailBuilder struct {
+	b                      builder
+	v                      RDS_O01_Orderdetail
+	gOrderDetailSupplement *RDS_O01_OrderDetailSupplementBuilder
+}
+
+// RXO sets the RXO segment.
+func (b *RDS_O01_OrderdetailBuilder) RXO(v RXO) *RDS_O01_OrderdetailBuilder {
+	setOnce(&b.b, "RXO", &b.v.RXO, v)
+	return b
+}
+
+// OrderDetailSupplement returns the OrderDetailSupplement group builder, adding the group the first time.
+func (b *RDS_O01_OrderdetailBuilder) OrderDetailSupplement() *RDS_O01_OrderDetailSupplementBuilder {
+	if b.gOrderDetailSupplement == nil {
+		b.gOrderDetailSupplement = &RDS_O01_OrderDetailSupplementBuilder{b: b.b.child("OrderDetailSupplement", -1)}
+	}
+	return b.gOrderDetailSupplement
+}
+
+func (b *RDS_O01_OrderdetailBuilder) build() (RDS_O01_Orderdetail, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gOrderDetailSupplement; g != nil {
+		gv, errs := g.build()
+		v.OrderDetailSupplement = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("RXO", builderCount(v.RXO != nil), 0, true)
+	bb.check("OrderDetailSupplement", builderCount(v.OrderDetailSupplement != nil), 0, false)
+	return v, bb.errs
+}
+
+// RDS_O01_OrderDetailSupplementBuilder adds the groups and segments of a RDS_O01_OrderDetailSupplement.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RDS_O01_OrderDetailSupplementBuilder struct {
+	b          builder
+	v          RDS_O01_OrderDetailSupplement
+	gComponent *RDS_O01_ComponentBuilder
+}
+
+// NTE adds a NTE segment.
+func (b *RDS_O01_OrderDetailSupplementBuilder) NTE(v NTE) *RDS_O01_OrderDetailSupplementBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// RXR adds a RXR segment.
+func (b *RDS_O01_OrderDetailSupplementBuilder) RXR(v RXR) *RDS_O01_OrderDetailSupplementBuilder {
+	b.v.RXR = append(b.v.RXR, v)
+	return b
+}
+
+// Component returns the Component group builder, adding the group the first time.
+func (b *RDS_O01_OrderDetailSupplementBuilder) Component() *RDS_O01_ComponentBuilder {
+	if b.gComponent == nil {
+		b.gComponent = &RDS_O01_ComponentBuilder{b: b.b.child("Component", -1)}
+	}
+	return b.gComponent
+}
+
+func (b *RDS_O01_OrderDetailSupplementBuilder) build() (RDS_O01_OrderDetailSupplement, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gComponent; g != nil {
+		gv, errs := g.build()
+		v.Component = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("NTE", len(v.NTE), 0, true)
+	bb.check("RXR", len(v.RXR), 0, true)
+	bb.check("Component", builderCount(v.Component != nil), 0, false)
+	return v, bb.errs
+}
+
+// RDS_O01_ComponentBuilder adds the groups and segments of a RDS_O01_Component.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RDS_O01_ComponentBuilder struct {
+	b builder
+	v RDS_O01_Component
+}
+
+// RXC adds a RXC segment.
+func (b *RDS_O01_ComponentBuilder) RXC(v RXC) *RDS_O01_ComponentBuilder {
+	b.v.RXC = append(b.v.RXC, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RDS_O01_ComponentBuilder) NTE(v NTE) *RDS_O01_ComponentBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+func (b *RDS_O01_ComponentBuilder) build() (RDS_O01_Component, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("RXC", len(v.RXC), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	return v, bb.errs
+}
+
+// RDS_O01_EncodingBuilder adds the groups and segments of a RDS_O01_Encoding.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RDS_O01_EncodingBuilder struct {
+	b builder
+	v RDS_O01_Encoding
+}
+
+// RXE sets the RXE segment.
+func (b *RDS_O01_EncodingBuilder) RXE(v RXE) *RDS_O01_EncodingBuilder {
+	setOnce(&b.b, "RXE", &b.v.RXE, v)
+	return b
+}
+
+// RXR adds a RXR segment.
+func (b *RDS_O01_EncodingBuilder) RXR(v RXR) *RDS_O01_EncodingBuilder {
+	b.v.RXR = append(b.v.RXR, v)
+	return b
+}
+
+// RXC adds a RXC segment.
+func (b *RDS_O01_EncodingBuilder) RXC(v RXC) *RDS_O01_EncodingBuilder {
+	b.v.RXC = append(b.v.RXC, v)
+	return b
+}
+
+func (b *RDS_O01_EncodingBuilder) build() (RDS_O01_Encoding, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("RXE", builderCount(v.RXE != nil), 0, true)
+	bb.check("RXR", len(v.RXR), 0, true)
+	bb.check("RXC", len(v.RXC), 0, false)
+	return v, bb.errs
+}
+
+// RDS_O01_ObservationBuilder adds the groups and segments of a RDS_O01_Observation.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RDS_O01_ObservationBuilder struct {
+	b builder
+	v RDS_O01_Observation
+}
+
+// OBX sets the OBX segment.
+func (b *RDS_O01_ObservationBuilder) OBX(v OBX) *RDS_O01_ObservationBuilder {
+	setOnce(&b.b, "OBX", &b.v.OBX, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RDS_O01_ObservationBuilder) NTE(v NTE) *RDS_O01_ObservationBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+func (b *RDS_O01_ObservationBuilder) build() (RDS_O01_Observation, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("OBX", builderCount(v.OBX != nil), 0, false)
+	bb.check("NTE", len(v.NTE), 0, false)
+	return v, bb.errs
+}
+
+// RDS_O01Builder adds the groups and segments of a RDS_O01.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RDS_O01Builder struct {
+	b        builder
+	v        RDS_O01
+	gPatient *RDS_O01_PatientBuilder
+	gOrder   []*RDS_O01_OrderBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *RDS_O01Builder) MSH(v MSH) *RDS_O01Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RDS_O01Builder) NTE(v NTE) *RDS_O01Builder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Patient returns the Patient group builder, adding the group the first time.
+func (b *RDS_O01Builder) Patient() *RDS_O01_PatientBuilder {
+	if b.gPatient == nil {
+		b.gPatient = &RDS_O01_PatientBuilder{b: b.b.child("Patient", -1)}
+	}
+	return b.gPatient
+}
+
+// Order adds a Order group and returns the group builder.
+func (b *RDS_O01Builder) Order() *RDS_O01_OrderBuilder {
+	g := &RDS_O01_OrderBuilder{b: b.b.child("Order", len(b.gOrder))}
+	b.gOrder = append(b.gOrder, g)
+	return g
+}
+
+func (b *RDS_O01Builder) build() (RDS_O01, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gPatient; g != nil {
+		gv, errs := g.build()
+		v.Patient = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	for _, g := range b.gOrder {
+		gv, errs := g.build()
+		v.Order = append(v.Order, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Patient", builderCount(v.Patient != nil), 0, false)
+	bb.check("Order", len(v.Order), 0, true)
+	return v, bb.errs
+}
+
+// NewRDS_O01Builder returns a builder for RDS_O01.
+func NewRDS_O01Builder() *RDS_O01Builder {
+	return &RDS_O01Builder{b: builder{path: "RDS_O01", seq: 1}}
+}
+
+// Build returns the RDS_O01 and the validation errors, if any.
+func (b *RDS_O01Builder) Build() (RDS_O01, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// RER_RER_DefinitionBuilder adds the groups and segments of a RER_RER_Definition.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RER_RER_DefinitionBuilder struct {
+	b        builder
+	v        RER_RER_Definition
+	gPatient *RER_RER_PatientBuilder
+	gOrder   []*RER_RER_OrderBuilder
+}
+
+// QRD sets the QRD segment.
+func (b *RER_RER_DefinitionBuilder) QRD(v QRD) *RER_RER_DefinitionBuilder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *RER_RER_DefinitionBuilder) QRF(v QRF) *RER_RER_DefinitionBuilder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// Patient returns the Patient group builder, adding the group the first time.
+func (b *RER_RER_DefinitionBuilder) Patient() *RER_RER_PatientBuilder {
+	if b.gPatient == nil {
+		b.gPatient = &RER_RER_PatientBuilder{b: b.b.child("Patient", -1)}
+	}
+	return b.gPatient
+}
+
+// Order adds a Order group and returns the group builder.
+func (b *RER_RER_DefinitionBuilder) Order() *RER_RER_OrderBuilder {
+	g := &RER_RER_OrderBuilder{b: b.b.child("Order", len(b.gOrder))}
+	b.gOrder = append(b.gOrder, g)
+	return g
+}
+
+func (b *RER_RER_DefinitionBuilder) build() (RER_RER_Definition, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gPatient; g != nil {
+		gv, errs := g.build()
+		v.Patient = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	for _, g := range b.gOrder {
+		gv, errs := g.build()
+		v.Order = append(v.Order, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("Patient", builderCount(v.Patient != nil), 0, false)
+	bb.check("Order", len(v.Order), 0, true)
+	return v, bb.errs
+}
+
+// RER_RER_PatientBuilder adds the groups and segments of a RER_RER_Patient.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RER_RER_PatientBuilder struct {
+	b builder
+	v RER_RER_Patient
+}
+
+// PID sets the PID segment.
+func (b *RER_RER_PatientBuilder) PID(v PID) *RER_RER_PatientBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RER_RER_PatientBuilder) NTE(v NTE) *RER_RER_PatientBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+func (b *RER_RER_PatientBuilder) build() (RER_RER_Patient, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	return v, bb.errs
+}
+
+// RER_RER_OrderBuilder adds the groups and segments of a RER_RER_Order.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RER_RER_OrderBuilder struct {
+	b builder
+	v RER_RER_Order
+}
+
+// ORC sets the ORC segment.
+func (b *RER_RER_OrderBuilder) ORC(v ORC) *RER_RER_OrderBuilder {
+	setOnce(&b.b, "ORC", &b.v.ORC, v)
+	return b
+}
+
+// RXE sets the RXE segment.
+func (b *RER_RER_OrderBuilder) RXE(v RXE) *RER_RER_OrderBuilder {
+	setOnce(&b.b, "RXE", &b.v.RXE, v)
+	return b
+}
+
+// RXR adds a RXR segment.
+func (b *RER_RER_OrderBuilder) RXR(v RXR) *RER_RER_OrderBuilder {
+	b.v.RXR = append(b.v.RXR, v)
+	return b
+}
+
+// RXC adds a RXC segment.
+func (b *RER_RER_OrderBuilder) RXC(v RXC) *RER_RER_OrderBuilder {
+	b.v.RXC = append(b.v.RXC, v)
+	return b
+}
+
+func (b *RER_RER_OrderBuilder) build() (RER_RER_Order, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("ORC", builderCount(v.ORC != nil), 0, true)
+	bb.check("RXE", builderCount(v.RXE != nil), 0, true)
+	bb.check("RXR", len(v.RXR), 0, true)
+	bb.check("RXC", len(v.RXC), 0, false)
+	return v, bb.errs
+}
+
+// RER_RERBuilder adds the groups and segments of a RER_RER.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RER_RERBuilder struct {
+	b           builder
+	v           RER_RER
+	gDefinition []*RER_RER_DefinitionBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *RER_RERBuilder) MSH(v MSH) *RER_RERBuilder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MSA sets the MSA segment.
+func (b *RER_RERBuilder) MSA(v MSA) *RER_RERBuilder {
+	setOnce(&b.b, "MSA", &b.v.MSA, v)
+	return b
+}
+
+// ERR sets the ERR segment.
+func (b *RER_RERBuilder) ERR(v ERR) *RER_RERBuilder {
+	setOnce(&b.b, "ERR", &b.v.ERR, v)
+	return b
+}
+
+// Definition adds a Definition group and returns the group builder.
+func (b *RER_RERBuilder) Definition() *RER_RER_DefinitionBuilder {
+	g := &RER_RER_DefinitionBuilder{b: b.b.child("Definition", len(b.gDefinition))}
+	b.gDefinition = append(b.gDefinition, g)
+	return g
+}
+
+// DSC sets the DSC segment.
+func (b *RER_RERBuilder) DSC(v DSC) *RER_RERBuilder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *RER_RERBuilder) build() (RER_RER, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gDefinition {
+		gv, errs := g.build()
+		v.Definition = append(v.Definition, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MSA", builderCount(v.MSA != nil), 0, true)
+	bb.check("ERR", builderCount(v.ERR != nil), 0, false)
+	bb.check("Definition", len(v.Definition), 0, true)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewRER_RERBuilder returns a builder for RER_RER.
+func NewRER_RERBuilder() *RER_RERBuilder {
+	return &RER_RERBuilder{b: builder{path: "RER_RER", seq: 1}}
+}
+
+// Build returns the RER_RER and the validation errors, if any.
+func (b *RER_RERBuilder) Build() (RER_RER, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// RGR_RGR_DefinitionBuilder adds the groups and segments of a RGR_RGR_Definition.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RGR_RGR_DefinitionBuilder struct {
+	b        builder
+	v        RGR_RGR_Definition
+	gPatient *RGR_RGR_PatientBuilder
+	gOrder   []*RGR_RGR_OrderBuilder
+}
+
+// QRD sets the QRD segment.
+func (b *RGR_RGR_DefinitionBuilder) QRD(v QRD) *RGR_RGR_DefinitionBuilder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *RGR_RGR_DefinitionBuilder) QRF(v QRF) *RGR_RGR_DefinitionBuilder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// Patient returns the Patient group builder, adding the group the first time.
+func (b *RGR_RGR_DefinitionBuilder) Patient() *RGR_RGR_PatientBuilder {
+	if b.gPatient == nil {
+		b.gPatient = &RGR_RGR_PatientBuilder{b: b.b.child("Patient", -1)}
+	}
+	return b.gPatient
+}
+
+// Order adds a Order group and returns the group builder.
+func (b *RGR_RGR_DefinitionBuilder) Order() *RGR_RGR_OrderBuilder {
+	g := &RGR_RGR_OrderBuilder{b: b.b.child("Order", len(b.gOrder))}
+	b.gOrder = append(b.gOrder, g)
+	return g
+}
+
+func (b *RGR_RGR_DefinitionBuilder) build() (RGR_RGR_Definition, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gPatient; g != nil {
+		gv, errs := g.build()
+		v.Patient = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	for _, g := range b.gOrder {
+		gv, errs := g.build()
+		v.Order = append(v.Order, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("Patient", builderCount(v.Patient != nil), 0, false)
+	bb.check("Order", len(v.Order), 0, true)
+	return v, bb.errs
+}
+
+// RGR_RGR_PatientBuilder adds the groups and segments of a RGR_RGR_Patient.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RGR_RGR_PatientBuilder struct {
+	b builder
+	v RGR_RGR_Patient
+}
+
+// PID sets the PID segment.
+func (b *RGR_RGR_PatientBuilder) PID(v PID) *RGR_RGR_PatientBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RGR_RGR_PatientBuilder) NTE(v NTE) *RGR_RGR_PatientBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+func (b *RGR_RGR_PatientBuilder) build() (RGR_RGR_Patient, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	return v, bb.errs
+}
+
+// RGR_RGR_OrderBuilder adds the groups and segments of a RGR_RGR_Order.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RGR_RGR_OrderBuilder struct {
+	b         builder
+	v         RGR_RGR_Order
+	gEncoding *RGR_RGR_EncodingBuilder
+}
+
+// ORC sets the ORC segment.
+func (b *RGR_RGR_OrderBuilder) ORC(v ORC) *RGR_RGR_OrderBuilder {
+	setOnce(&b.b, "ORC", &b.v.ORC, v)
+	return b
+}
+
+// Encoding returns the Encoding group builder, adding the group the first time.
+func (b *RGR_RGR_OrderBuilder) Encoding() *RGR_RGR_EncodingBuilder {
+	if b.gEncoding == nil {
+		b.gEncoding = &RGR_RGR_EncodingBuilder{b: b.b.child("Encoding", -1)}
+	}
+	return b.gEncoding
+}
+
+// RXG adds a RXG segment.
+func (b *RGR_RGR_OrderBuilder) RXG(v RXG) *RGR_RGR_OrderBuilder {
+	b.v.RXG = append(b.v.RXG, v)
+	return b
+}
+
+// RXR adds a RXR segment.
+func (b *RGR_RGR_OrderBuilder) RXR(v RXR) *RGR_RGR_OrderBuilder {
+	b.v.RXR = append(b.v.RXR, v)
+	return b
+}
+
+func (b *RGR_RGR_OrderBuilder) build() (RGR_RGR_Order, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gEncoding; g != nil {
+		gv, errs := g.build()
+		v.Encoding = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("ORC", builderCount(v.ORC != nil), 0, true)
+	bb.check("Encoding", builderCount(v.Encoding != nil), 0, false)
+	bb.check("RXG", len(v.RXG), 0, true)
+	bb.check("RXR", len(v.RXR), 0, true)
+	return v, bb.errs
+}
+
+// RGR_RGR_EncodingBuilder adds the groups and segments of a RGR_RGR_Encoding.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RGR_RGR_EncodingBuilder struct {
+	b builder
+	v RGR_RGR_Encoding
+}
+
+// RXE sets the RXE segment.
+func (b *RGR_RGR_EncodingBuilder) RXE(v RXE) *RGR_RGR_EncodingBuilder {
+	setOnce(&b.b, "RXE", &b.v.RXE, v)
+	return b
+}
+
+// RXR adds a RXR segment.
+func (b *RGR_RGR_EncodingBuilder) RXR(v RXR) *RGR_RGR_EncodingBuilder {
+	b.v.RXR = append(b.v.RXR, v)
+	return b
+}
+
+// RXC adds a RXC segment.
+func (b *RGR_RGR_EncodingBuilder) RXC(v RXC) *RGR_RGR_EncodingBuilder {
+	b.v.RXC = append(b.v.RXC, v)
+	return b
+}
+
+func (b *RGR_RGR_EncodingBuilder) build() (RGR_RGR_Encoding, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("RXE", builderCount(v.RXE != nil), 0, true)
+	bb.check("RXR", len(v.RXR), 0, true)
+	bb.check("RXC", len(v.RXC), 0, false)
+	return v, bb.errs
+}
+
+// RGR_RGRBuilder adds the groups and segments of a RGR_RGR.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RGR_RGRBuilder struct {
+	b           builder
+	v           RGR_RGR
+	gDefinition []*RGR_RGR_DefinitionBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *RGR_RGRBuilder) MSH(v MSH) *RGR_RGRBuilder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MSA sets the MSA segment.
+func (b *RGR_RGRBuilder) MSA(v MSA) *RGR_RGRBuilder {
+	setOnce(&b.b, "MSA", &b.v.MSA, v)
+	return b
+}
+
+// ERR sets the ERR segment.
+func (b *RGR_RGRBuilder) ERR(v ERR) *RGR_RGRBuilder {
+	setOnce(&b.b, "ERR", &b.v.ERR, v)
+	return b
+}
+
+// Definition adds a Definition group and returns the group builder.
+func (b *RGR_RGRBuilder) Definition() *RGR_RGR_DefinitionBuilder {
+	g := &RGR_RGR_DefinitionBuilder{b: b.b.child("Definition", len(b.gDefinition))}
+	b.gDefinition = append(b.gDefinition, g)
+	return g
+}
+
+// DSC sets the DSC segment.
+func (b *RGR_RGRBuilder) DSC(v DSC) *RGR_RGRBuilder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *RGR_RGRBuilder) build() (RGR_RGR, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gDefinition {
+		gv, errs := g.build()
+		v.Definition = append(v.Definition, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MSA", builderCount(v.MSA != nil), 0, true)
+	bb.check("ERR", builderCount(v.ERR != nil), 0, false)
+	bb.check("Definition", len(v.Definition), 0, true)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewRGR_RGRBuilder returns a builder for RGR_RGR.
+func NewRGR_RGRBuilder() *RGR_RGRBuilder {
+	return &RGR_RGRBuilder{b: builder{path: "RGR_RGR", seq: 1}}
+}
+
+// Build returns the RGR_RGR and the validation errors, if any.
+func (b *RGR_RGRBuilder) Build() (RGR_RGR, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// RGV_O01_PatientBuilder adds the groups and segments of a RGV_O01_Patient.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RGV_O01_PatientBuilder struct {
+	b builder
+	v RGV_O01_Patient
+}
+
+// PID sets the PID segment.
+func (b *RGV_O01_PatientBuilder) PID(v PID) *RGV_O01_PatientBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RGV_O01_PatientBuilder) NTE(v NTE) *RGV_O01_PatientBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// AL1 adds a AL1 segment.
+func (b *RGV_O01_PatientBuilder) AL1(v AL1) *RGV_O01_PatientBuilder {
+	b.v.AL1 = append(b.v.AL1, v)
+	return b
+}
+
+// PV1 sets the PV1 segment.
+func (b *RGV_O01_PatientBuilder) PV1(v PV1) *RGV_O01_PatientBuilder {
+	setOnce(&b.b, "PV1", &b.v.PV1, v)
+	return b
+}
+
+func (b *RGV_O01_PatientBuilder) build() (RGV_O01_Patient, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("AL1", len(v.AL1), 0, false)
+	bb.check("PV1", builderCount(v.PV1 != nil), 0, false)
+	return v, bb.errs
+}
+
+// RGV_O01_OrderBuilder adds the groups and segments of a RGV_O01_Order.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RGV_O01_OrderBuilder struct {
+	b            builder
+	v            RGV_O01_Order
+	gOrderdetail *RGV_O01_OrderdetailBuilder
+	gEncoding    *RGV_O01_EncodingBuilder
+	gGive        *RGV_O01_GiveBuilder
+}
+
+// ORC sets the ORC segment.
+func (b *RGV_O01_OrderBuilder) ORC(v ORC) *RGV_O01_OrderBuilder {
+	setOnce(&b.b, "ORC", &b.v.ORC, v)
+	return b
+}
+
+// Orderdetail returns the Orderdetail group builder, adding the group the first time.
+func (b *RGV_O01_OrderBuilder) Orderdetail() *RGV_O01_OrderdetailBuilder {
+	if b.gOrderdetail == nil {
+		b.gOrderdetail = &RGV_O01_OrderdetailBuilder{b: b.b.child("Orderdetail", -1)}
+	}
+	return b.gOrderdetail
+}
+
+// Encoding returns the Encoding group builder, adding the group the first time.
+func (b *RGV_O01_OrderBuilder) Encoding() *RGV_O01_EncodingBuilder {
+	if b.gEncoding == nil {
+		b.gEncoding = &RGV_O01_EncodingBuilder{b: b.b.child("Encoding", -1)}
+	}
+	return b.gEncoding
+}
+
+// Give returns the Give group builder, adding the group the first time.
+func (b *RGV_O01_OrderBuilder) Give() *RGV_O01_GiveBuilder {
+	if b.gGive == nil {
+		b.gGive = &RGV_O01_GiveBuilder{b: b.b.child("Give", -1)}
+	}
+	return b.gGive
+}
+
+func (b *RGV_O01_OrderBuilder) build() (RGV_O01_Order, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gOrderdetail; g != nil {
+		gv, errs := g.build()
+		v.Orderdetail = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	if g := b.gEncoding; g != nil {
+		gv, errs := g.build()
+		v.Encoding = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	if g := b.gGive; g != nil {
+		gv, errs := g.build()
+		v.Give = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("ORC", builderCount(v.ORC != nil), 0, true)
+	bb.check("Orderdetail", builderCount(v.Orderdetail != nil), 0, false)
+	bb.check("Encoding", builderCount(v.Encoding != nil), 0, false)
+	bb.check("Give", builderCount(v.Give != nil), 0, true)
+	return v, bb.errs
+}
+
+// RGV_O01_OrderdetailBuilder adds the groups and segments of a RGV_O01_Orderdetail.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RGV_O01_OrderdetailBuilder struct {
+	b                      builder
+	v                      RGV_O01_Orderdetail
+	gOrderDetailSupplement *RGV_O01_OrderDetailSupplementBuilder
+}
+
+// RXO sets the RXO segment.
+func (b *RGV_O01_OrderdetailBuilder) RXO(v RXO) *RGV_O01_OrderdetailBuilder {
+	setOnce(&b.b, "RXO", &b.v.RXO, v)
+	return b
+}
+
+// OrderDetailSupplement returns the OrderDetailSupplement group builder, adding the group the first time.
+func (b *RGV_O01_OrderdetailBuilder) OrderDetailSupplement() *RGV_O01_OrderDetailSupplementBuilder {
+	if b.gOrderDetailSupplement == nil {
+		b.gOrderDetailSupplement = &RGV_O01_OrderDetailSupplementBuilder{b: b.b.child("OrderDetailSupplement", -1)}
+	}
+	return b.gOrderDetailSupplement
+}
+
+func (b *RGV_O01_OrderdetailBuilder) build() (RGV_O01_Orderdetail, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gOrderDetailSupplement; g != nil {
+		gv, errs := g.build()
+		v.OrderDetailSupplement = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("RXO", builderCount(v.RXO != nil), 0, true)
+	bb.check("OrderDetailSupplement", builderCount(v.OrderDetailSupplement != nil), 0, false)
+	return v, bb.errs
+}
+
+// RGV_O01_OrderDetailSupplementBuilder adds the groups and segments of a RGV_O01_OrderDetailSupplement.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RGV_O01_OrderDetailSupplementBuilder struct {
+	b          builder
+	v          RGV_O01_OrderDetailSupplement
+	gComponent *RGV_O01_ComponentBuilder
+}
+
+// NTE adds a NTE segment.
+func (b *RGV_O01_OrderDetailSupplementBuilder) NTE(v NTE) *RGV_O01_OrderDetailSupplementBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// RXR adds a RXR segment.
+func (b *RGV_O01_OrderDetailSupplementBuilder) RXR(v RXR) *RGV_O01_OrderDetailSupplementBuilder {
+	b.v.RXR = append(b.v.RXR, v)
+	return b
+}
+
+// Component returns the Component group builder, adding the group the first time.
+func (b *RGV_O01_OrderDetailSupplementBuilder) Component() *RGV_O01_ComponentBuilder {
+	if b.gComponent == nil {
+		b.gComponent = &RGV_O01_ComponentBuilder{b: b.b.child("Component", -1)}
+	}
+	return b.gComponent
+}
+
+func (b *RGV_O01_OrderDetailSupplementBuilder) build() (RGV_O01_OrderDetailSupplement, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gComponent; g != nil {
+		gv, errs := g.build()
+		v.Component = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("NTE", len(v.NTE), 0, true)
+	bb.check("RXR", len(v.RXR), 0, true)
+	bb.check("Component", builderCount(v.Component != nil), 0, false)
+	return v, bb.errs
+}
+
+// RGV_O01_ComponentBuilder adds the groups and segments of a RGV_O01_Component.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RGV_O01_ComponentBuilder struct {
+	b builder
+	v RGV_O01_Component
+}
+
+// RXC adds a RXC segment.
+func (b *RGV_O01_ComponentBuilder) RXC(v RXC) *RGV_O01_ComponentBuilder {
+	b.v.RXC = append(b.v.RXC, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RGV_O01_ComponentBuilder) NTE(v NTE) *RGV_O01_ComponentBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+func (b *RGV_O01_ComponentBuilder) build() (RGV_O01_Component, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("RXC", len(v.RXC), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	return v, bb.errs
+}
+
+// RGV_O01_EncodingBuilder adds the groups and segments of a RGV_O01_Encoding.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RGV_O01_EncodingBuilder struct {
+	b builder
+	v RGV_O01_Encoding
+}
+
+// RXE sets the RXE segment.
+func (b *RGV_O01_EncodingBuilder) RXE(v RXE) *RGV_O01_EncodingBuilder {
+	setOnce(&b.b, "RXE", &b.v.RXE, v)
+	return b
+}
+
+// RXR adds a RXR segment.
+func (b *RGV_O01_EncodingBuilder) RXR(v RXR) *RGV_O01_EncodingBuilder {
+	b.v.RXR = append(b.v.RXR, v)
+	return b
+}
+
+// RXC adds a RXC segment.
+func (b *RGV_O01_EncodingBuilder) RXC(v RXC) *RGV_O01_EncodingBuilder {
+	b.v.RXC = append(b.v.RXC, v)
+	return b
+}
+
+func (b *RGV_O01_EncodingBuilder) build() (RGV_O01_Encoding, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("RXE", builderCount(v.RXE != nil), 0, true)
+	bb.check("RXR", len(v.RXR), 0, true)
+	bb.check("RXC", len(v.RXC), 0, false)
+	return v, bb.errs
+}
+
+// RGV_O01_GiveBuilder adds the groups and segments of a RGV_O01_Give.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RGV_O01_GiveBuilder struct {
+	b            builder
+	v            RGV_O01_Give
+	gObservation *RGV_O01_ObservationBuilder
+}
+
+// RXG sets the RXG segment.
+func (b *RGV_O01_GiveBuilder) RXG(v RXG) *RGV_O01_GiveBuilder {
+	setOnce(&b.b, "RXG", &b.v.RXG, v)
+	return b
+}
+
+// RXR adds a RXR segment.
+func (b *RGV_O01_GiveBuilder) RXR(v RXR) *RGV_O01_GiveBuilder {
+	b.v.RXR = append(b.v.RXR, v)
+	return b
+}
+
+// RXC adds a RXC segment.
+func (b *RGV_O01_GiveBuilder) RXC(v RXC) *RGV_O01_GiveBuilder {
+	b.v.RXC = append(b.v.RXC, v)
+	return b
+}
+
+// Observation returns the Observation group builder, adding the group the first time.
+func (b *RGV_O01_GiveBuilder) Observation() *RGV_O01_ObservationBuilder {
+	if b.gObservation == nil {
+		b.gObservation = &RGV_O01_ObservationBuilder{b: b.b.child("Observation", -1)}
+	}
+	return b.gObservation
+}
+
+func (b *RGV_O01_GiveBuilder) build() (RGV_O01_Give, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gObservation; g != nil {
+		gv, errs := g.build()
+		v.Observation = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("RXG", builderCount(v.RXG != nil), 0, true)
+	bb.check("RXR", len(v.RXR), 0, true)
+	bb.check("RXC", len(v.RXC), 0, false)
+	bb.check("Observation", builderCount(v.Observation != nil), 0, true)
+	return v, bb.errs
+}
+
+// RGV_O01_ObservationBuilder adds the groups and segments of a RGV_O01_Observation.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RGV_O01_ObservationBuilder struct {
+	b builder
+	v RGV_O01_Observation
+}
+
+// OBX sets the OBX segment.
+func (b *RGV_O01_ObservationBuilder) OBX(v OBX) *RGV_O01_ObservationBuilder {
+	setOnce(&b.b, "OBX", &b.v.OBX, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RGV_O01_ObservationBuilder) NTE(v NTE) *RGV_O01_ObservationBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+func (b *RGV_O01_ObservationBuilder) build() (RGV_O01_Observation, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("OBX", builderCount(v.OBX != nil), 0, false)
+	bb.check("NTE", len(v.NTE), 0, false)
+	return v, bb.errs
+}
+
+// RGV_O01Builder adds the groups and segments of a RGV_O01.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RGV_O01Builder struct {
+	b        builder
+	v        RGV_O01
+	gPatient *RGV_O01_PatientBuilder
+	gOrder   []*RGV_O01_OrderBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *RGV_O01Builder) MSH(v MSH) *RGV_O01Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RGV_O01Builder) NTE(v NTE) *RGV_O01Builder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Patient returns the Patient group builder, adding the group the first time.
+func (b *RGV_O01Builder) Patient() *RGV_O01_PatientBuilder {
+	if b.gPatient == nil {
+		b.gPatient = &RGV_O01_PatientBuilder{b: b.b.child("Patient", -1)}
+	}
+	return b.gPatient
+}
+
+// Order adds a Order group and returns the group builder.
+func (b *RGV_O01Builder) Order() *RGV_O01_OrderBuilder {
+	g := &RGV_O01_OrderBuilder{b: b.b.child("Order", len(b.gOrder))}
+	b.gOrder = append(b.gOrder, g)
+	return g
+}
+
+func (b *RGV_O01Builder) build() (RGV_O01, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gPatient; g != nil {
+		gv, errs := g.build()
+		v.Patient = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	for _, g := range b.gOrder {
+		gv, errs := g.build()
+		v.Order = append(v.Order, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Patient", builderCount(v.Patient != nil), 0, false)
+	bb.check("Order", len(v.Order), 0, true)
+	return v, bb.errs
+}
+
+// NewRGV_O01Builder returns a builder for RGV_O01.
+func NewRGV_O01Builder() *RGV_O01Builder {
+	return &RGV_O01Builder{b: builder{path: "RGV_O01", seq: 1}}
+}
+
+// Build returns the RGV_O01 and the validation errors, if any.
+func (b *RGV_O01Builder) Build() (RGV_O01, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// ROR_ROR_DefinitionBuilder adds the groups and segments of a ROR_ROR_Definition.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ROR_ROR_DefinitionBuilder struct {
+	b        builder
+	v        ROR_ROR_Definition
+	gPatient *ROR_ROR_PatientBuilder
+	gOrder   []*ROR_ROR_OrderBuilder
+}
+
+// QRD sets the QRD segment.
+func (b *ROR_ROR_DefinitionBuilder) QRD(v QRD) *ROR_ROR_DefinitionBuilder {
+	setOnce(&b.b, "QRD", &b.v.QRD, v)
+	return b
+}
+
+// QRF sets the QRF segment.
+func (b *ROR_ROR_DefinitionBuilder) QRF(v QRF) *ROR_ROR_DefinitionBuilder {
+	setOnce(&b.b, "QRF", &b.v.QRF, v)
+	return b
+}
+
+// Patient returns the Patient group builder, adding the group the first time.
+func (b *ROR_ROR_DefinitionBuilder) Patient() *ROR_ROR_PatientBuilder {
+	if b.gPatient == nil {
+		b.gPatient = &ROR_ROR_PatientBuilder{b: b.b.child("Patient", -1)}
+	}
+	return b.gPatient
+}
+
+// Order adds a Order group and returns the group builder.
+func (b *ROR_ROR_DefinitionBuilder) Order() *ROR_ROR_OrderBuilder {
+	g := &ROR_ROR_OrderBuilder{b: b.b.child("Order", len(b.gOrder))}
+	b.gOrder = append(b.gOrder, g)
+	return g
+}
+
+func (b *ROR_ROR_DefinitionBuilder) build() (ROR_ROR_Definition, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gPatient; g != nil {
+		gv, errs := g.build()
+		v.Patient = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	for _, g := range b.gOrder {
+		gv, errs := g.build()
+		v.Order = append(v.Order, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("QRD", builderCount(v.QRD != nil), 0, true)
+	bb.check("QRF", builderCount(v.QRF != nil), 0, false)
+	bb.check("Patient", builderCount(v.Patient != nil), 0, false)
+	bb.check("Order", len(v.Order), 0, true)
+	return v, bb.errs
+}
+
+// ROR_ROR_PatientBuilder adds the groups and segments of a ROR_ROR_Patient.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ROR_ROR_PatientBuilder struct {
+	b builder
+	v ROR_ROR_Patient
+}
+
+// PID sets the PID segment.
+func (b *ROR_ROR_PatientBuilder) PID(v PID) *ROR_ROR_PatientBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *ROR_ROR_PatientBuilder) NTE(v NTE) *ROR_ROR_PatientBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+func (b *ROR_ROR_PatientBuilder) build() (ROR_ROR_Patient, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	return v, bb.errs
+}
+
+// ROR_ROR_OrderBuilder adds the groups and segments of a ROR_ROR_Order.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ROR_ROR_OrderBuilder struct {
+	b builder
+	v ROR_ROR_Order
+}
+
+// ORC sets the ORC segment.
+func (b *ROR_ROR_OrderBuilder) ORC(v ORC) *ROR_ROR_OrderBuilder {
+	setOnce(&b.b, "ORC", &b.v.ORC, v)
+	return b
+}
+
+// RXO sets the RXO segment.
+func (b *ROR_ROR_OrderBuilder) RXO(v RXO) *ROR_ROR_OrderBuilder {
+	setOnce(&b.b, "RXO", &b.v.RXO, v)
+	return b
+}
+
+// RXR adds a RXR segment.
+func (b *ROR_ROR_OrderBuilder) RXR(v RXR) *ROR_ROR_OrderBuilder {
+	b.v.RXR = append(b.v.RXR, v)
+	return b
+}
+
+// RXC adds a RXC segment.
+func (b *ROR_ROR_OrderBuilder) RXC(v RXC) *ROR_ROR_OrderBuilder {
+	b.v.RXC = append(b.v.RXC, v)
+	return b
+}
+
+func (b *ROR_ROR_OrderBuilder) build() (ROR_ROR_Order, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("ORC", builderCount(v.ORC != nil), 0, true)
+	bb.check("RXO", builderCount(v.RXO != nil), 0, true)
+	bb.check("RXR", len(v.RXR), 0, true)
+	bb.check("RXC", len(v.RXC), 0, false)
+	return v, bb.errs
+}
+
+// ROR_RORBuilder adds the groups and segments of a ROR_ROR.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type ROR_RORBuilder struct {
+	b           builder
+	v           ROR_ROR
+	gDefinition []*ROR_ROR_DefinitionBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *ROR_RORBuilder) MSH(v MSH) *ROR_RORBuilder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MSA sets the MSA segment.
+func (b *ROR_RORBuilder) MSA(v MSA) *ROR_RORBuilder {
+	setOnce(&b.b, "MSA", &b.v.MSA, v)
+	return b
+}
+
+// ERR sets the ERR segment.
+func (b *ROR_RORBuilder) ERR(v ERR) *ROR_RORBuilder {
+	setOnce(&b.b, "ERR", &b.v.ERR, v)
+	return b
+}
+
+// Definition adds a Definition group and returns the group builder.
+func (b *ROR_RORBuilder) Definition() *ROR_ROR_DefinitionBuilder {
+	g := &ROR_ROR_DefinitionBuilder{b: b.b.child("Definition", len(b.gDefinition))}
+	b.gDefinition = append(b.gDefinition, g)
+	return g
+}
+
+// DSC sets the DSC segment.
+func (b *ROR_RORBuilder) DSC(v DSC) *ROR_RORBuilder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *ROR_RORBuilder) build() (ROR_ROR, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gDefinition {
+		gv, errs := g.build()
+		v.Definition = append(v.Definition, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MSA", builderCount(v.MSA != nil), 0, true)
+	bb.check("ERR", builderCount(v.ERR != nil), 0, false)
+	bb.check("Definition", len(v.Definition), 0, true)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewROR_RORBuilder returns a builder for ROR_ROR.
+func NewROR_RORBuilder() *ROR_RORBuilder {
+	return &ROR_RORBuilder{b: builder{path: "ROR_ROR", seq: 1}}
+}
+
+// Build returns the ROR_ROR and the validation errors, if any.
+func (b *ROR_RORBuilder) Build() (ROR_ROR, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// RRA_O02_ResponseBuilder adds the groups and segments of a RRA_O02_Response.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RRA_O02_ResponseBuilder struct {
+	b        builder
+	v        RRA_O02_Response
+	gPatient *RRA_O02_PatientBuilder
+	gOrder   []*RRA_O02_OrderBuilder
+}
+
+// Patient returns the Patient group builder, adding the group the first time.
+func (b *RRA_O02_ResponseBuilder) Patient() *RRA_O02_PatientBuilder {
+	if b.gPatient == nil {
+		b.gPatient = &RRA_O02_PatientBuilder{b: b.b.child("Patient", -1)}
+	}
+	return b.gPatient
+}
+
+// Order adds a Order group and returns the group builder.
+func (b *RRA_O02_ResponseBuilder) Order() *RRA_O02_OrderBuilder {
+	g := &RRA_O02_OrderBuilder{b: b.b.child("Order", len(b.gOrder))}
+	b.gOrder = append(b.gOrder, g)
+	return g
+}
+
+func (b *RRA_O02_ResponseBuilder) build() (RRA_O02_Response, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gPatient; g != nil {
+		gv, errs := g.build()
+		v.Patient = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	for _, g := range b.gOrder {
+		gv, errs := g.build()
+		v.Order = append(v.Order, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("Patient", builderCount(v.Patient != nil), 0, false)
+	bb.check("Order", len(v.Order), 0, true)
+	return v, bb.errs
+}
+
+// RRA_O02_PatientBuilder adds the groups and segments of a RRA_O02_Patient.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RRA_O02_PatientBuilder struct {
+	b builder
+	v RRA_O02_Patient
+}
+
+// PID sets the PID segment.
+func (b *RRA_O02_PatientBuilder) PID(v PID) *RRA_O02_PatientBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RRA_O02_PatientBuilder) NTE(v NTE) *RRA_O02_PatientBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+func (b *RRA_O02_PatientBuilder) build() (RRA_O02_Patient, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("PID", builderCount(v.PID != nil), 0, true)
+	bb.check("NTE", len(v.NTE), 0, false)
+	return v, bb.errs
+}
+
+// RRA_O02_OrderBuilder adds the groups and segments of a RRA_O02_Order.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RRA_O02_OrderBuilder struct {
+	b               builder
+	v               RRA_O02_Order
+	gAdministration []*RRA_O02_AdministrationBuilder
+}
+
+// ORC sets the ORC segment.
+func (b *RRA_O02_OrderBuilder) ORC(v ORC) *RRA_O02_OrderBuilder {
+	setOnce(&b.b, "ORC", &b.v.ORC, v)
+	return b
+}
+
+// Administration adds a Administration group and returns the group builder.
+func (b *RRA_O02_OrderBuilder) Administration() *RRA_O02_AdministrationBuilder {
+	g := &RRA_O02_AdministrationBuilder{b: b.b.child("Administration", len(b.gAdministration))}
+	b.gAdministration = append(b.gAdministration, g)
+	return g
+}
+
+func (b *RRA_O02_OrderBuilder) build() (RRA_O02_Order, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gAdministration {
+		gv, errs := g.build()
+		v.Administration = append(v.Administration, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("ORC", builderCount(v.ORC != nil), 0, true)
+	bb.check("Administration", len(v.Administration), 0, false)
+	return v, bb.errs
+}
+
+// RRA_O02_AdministrationBuilder adds the groups and segments of a RRA_O02_Administration.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RRA_O02_AdministrationBuilder struct {
+	b builder
+	v RRA_O02_Administration
+}
+
+// RXA adds a RXA segment.
+func (b *RRA_O02_AdministrationBuilder) RXA(v RXA) *RRA_O02_AdministrationBuilder {
+	b.v.RXA = append(b.v.RXA, v)
+	return b
+}
+
+// RXR sets the RXR segment.
+func (b *RRA_O02_AdministrationBuilder) RXR(v RXR) *RRA_O02_AdministrationBuilder {
+	setOnce(&b.b, "RXR", &b.v.RXR, v)
+	return b
+}
+
+func (b *RRA_O02_AdministrationBuilder) build() (RRA_O02_Administration, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("RXA", len(v.RXA), 0, true)
+	bb.check("RXR", builderCount(v.RXR != nil), 0, true)
+	return v, bb.errs
+}
+
+// RRA_O02Builder adds the groups and segments of a RRA_O02.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RRA_O02Builder struct {
+	b         builder
+	v         RRA_O02
+	gResponse *RRA_O02_ResponseBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *RRA_O02Builder) MSH(v MSH) *RRA_O02Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MSA sets the MSA segment.
+func (b *RRA_O02Builder) MSA(v MSA) *RRA_O02Builder {
+	setOnce(&b.b, "MSA", &b.v.MSA, v)
+	return b
+}
+
+// ERR sets the ERR segment.
+func (b *RRA_O02Builder) ERR(v ERR) *RRA_O02Builder {
+	setOnce(&b.b, "ERR", &b.v.ERR, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RRA_O02Builder) NTE(v NTE) *RRA_O02Builder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Response returns the Response group builder, adding the group the first time.
+func (b *RRA_O02Builder) Response() *RRA_O02_ResponseBuilder {
+	if b.gResponse == nil {
+		b.gResponse = &RRA_O02_ResponseBuilder{b: b.b.child("Response", -1)}
+	}
+	return b.gResponse
+}
+
+func (b *RRA_O02Builder) build() (RRA_O02, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gResponse; g != nil {
+		gv, errs := g.build()
+		v.Response = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MSA", builderCount(v.MSA != nil), 0, true)
+	bb.check("ERR", builderCount(v.ERR != nil), 0, false)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Response", builderCount(v.Response != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewRRA_O02Builder returns a builder for RRA_O02.
+func NewRRA_O02Builder() *RRA_O02Builder {
+	return &RRA_O02Builder{b: builder{path: "RRA_O02", seq: 1}}
+}
+
+// Build returns the RRA_O02 and the validation errors, if any.
+func (b *RRA_O02Builder) Build() (RRA_O02, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// RRD_O02_PatientBuilder adds the groups and segments of a RRD_O02_Patient.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RRD_O02_PatientBuilder struct {
+	b      builder
+	v      RRD_O02_Patient
+	gOrder []*RRD_O02_OrderBuilder
+}
+
+// PID sets the PID segment.
+func (b *RRD_O02_PatientBuilder) PID(v PID) *RRD_O02_PatientBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RRD_O02_PatientBuilder) NTE(v NTE) *RRD_O02_PatientBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Order adds a Order group and returns the group builder.
+func (b *RRD_O02_PatientBuilder) Order() *RRD_O02_OrderBuilder {
+	g := &RRD_O02_OrderBuilder{b: b.b.child("Order", len(b.gOrder))}
+	b.gOrder = append(b.gOrder, g)
+	return g
+}
+
+func (b *RRD_O02_PatientBuilder) build() (RRD_O02_Patient, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gOrder {
+		gv, errs := g.build()
+		v.Order = append(v.Order, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("PID", builderCount(v.PID != nil), 0, false)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Order", len(v.Order), 0, true)
+	return v, bb.errs
+}
+
+// RRD_O02_OrderBuilder adds the groups and segments of a RRD_O02_Order.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RRD_O02_OrderBuilder struct {
+	b            builder
+	v            RRD_O02_Order
+	gOrderdetail *RRD_O02_OrderdetailBuilder
+}
+
+// ORC sets the ORC segment.
+func (b *RRD_O02_OrderBuilder) ORC(v ORC) *RRD_O02_OrderBuilder {
+	setOnce(&b.b, "ORC", &b.v.ORC, v)
+	return b
+}
+
+// Orderdetail returns the Orderdetail group builder, adding the group the first time.
+func (b *RRD_O02_OrderBuilder) Orderdetail() *RRD_O02_OrderdetailBuilder {
+	if b.gOrderdetail == nil {
+		b.gOrderdetail = &RRD_O02_OrderdetailBuilder{b: b.b.child("Orderdetail", -1)}
+	}
+	return b.gOrderdetail
+}
+
+func (b *RRD_O02_OrderBuilder) build() (RRD_O02_Order, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gOrderdetail; g != nil {
+		gv, errs := g.build()
+		v.Orderdetail = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("ORC", builderCount(v.ORC != nil), 0, true)
+	bb.check("Orderdetail", builderCount(v.Orderdetail != nil), 0, false)
+	return v, bb.errs
+}
+
+// RRD_O02_OrderdetailBuilder adds the groups and segments of a RRD_O02_Orderdetail.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RRD_O02_OrderdetailBuilder struct {
+	b builder
+	v RRD_O02_Orderdetail
+}
+
+// RXD sets the RXD segment.
+func (b *RRD_O02_OrderdetailBuilder) RXD(v RXD) *RRD_O02_OrderdetailBuilder {
+	setOnce(&b.b, "RXD", &b.v.RXD, v)
+	return b
+}
+
+// RXR adds a RXR segment.
+func (b *RRD_O02_OrderdetailBuilder) RXR(v RXR) *RRD_O02_OrderdetailBuilder {
+	b.v.RXR = append(b.v.RXR, v)
+	return b
+}
+
+// RXC adds a RXC segment.
+func (b *RRD_O02_OrderdetailBuilder) RXC(v RXC) *RRD_O02_OrderdetailBuilder {
+	b.v.RXC = append(b.v.RXC, v)
+	return b
+}
+
+func (b *RRD_O02_OrderdetailBuilder) build() (RRD_O02_Orderdetail, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("RXD", builderCount(v.RXD != nil), 0, true)
+	bb.check("RXR", len(v.RXR), 0, true)
+	bb.check("RXC", len(v.RXC), 0, false)
+	return v, bb.errs
+}
+
+// RRD_O02Builder adds the groups and segments of a RRD_O02.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RRD_O02Builder struct {
+	b        builder
+	v        RRD_O02
+	gPatient *RRD_O02_PatientBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *RRD_O02Builder) MSH(v MSH) *RRD_O02Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MSA sets the MSA segment.
+func (b *RRD_O02Builder) MSA(v MSA) *RRD_O02Builder {
+	setOnce(&b.b, "MSA", &b.v.MSA, v)
+	return b
+}
+
+// ERR sets the ERR segment.
+func (b *RRD_O02Builder) ERR(v ERR) *RRD_O02Builder {
+	setOnce(&b.b, "ERR", &b.v.ERR, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RRD_O02Builder) NTE(v NTE) *RRD_O02Builder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Patient returns the Patient group builder, adding the group the first time.
+func (b *RRD_O02Builder) Patient() *RRD_O02_PatientBuilder {
+	if b.gPatient == nil {
+		b.gPatient = &RRD_O02_PatientBuilder{b: b.b.child("Patient", -1)}
+	}
+	return b.gPatient
+}
+
+func (b *RRD_O02Builder) build() (RRD_O02, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gPatient; g != nil {
+		gv, errs := g.build()
+		v.Patient = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MSA", builderCount(v.MSA != nil), 0, true)
+	bb.check("ERR", builderCount(v.ERR != nil), 0, false)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Patient", builderCount(v.Patient != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewRRD_O02Builder returns a builder for RRD_O02.
+func NewRRD_O02Builder() *RRD_O02Builder {
+	return &RRD_O02Builder{b: builder{path: "RRD_O02", seq: 1}}
+}
+
+// Build returns the RRD_O02 and the validation errors, if any.
+func (b *RRD_O02Builder) Build() (RRD_O02, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// RRE_O02_PatientBuilder adds the groups and segments of a RRE_O02_Patient.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RRE_O02_PatientBuilder struct {
+	b      builder
+	v      RRE_O02_Patient
+	gOrder []*RRE_O02_OrderBuilder
+}
+
+// PID sets the PID segment.
+func (b *RRE_O02_PatientBuilder) PID(v PID) *RRE_O02_PatientBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RRE_O02_PatientBuilder) NTE(v NTE) *RRE_O02_PatientBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Order adds a Order group and returns the group builder.
+func (b *RRE_O02_PatientBuilder) Order() *RRE_O02_OrderBuilder {
+	g := &RRE_O02_OrderBuilder{b: b.b.child("Order", len(b.gOrder))}
+	b.gOrder = append(b.gOrder, g)
+	return g
+}
+
+func (b *RRE_O02_PatientBuilder) build() (RRE_O02_Patient, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gOrder {
+		gv, errs := g.build()
+		v.Order = append(v.Order, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("PID", builderCount(v.PID != nil), 0, false)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Order", len(v.Order), 0, true)
+	return v, bb.errs
+}
+
+// RRE_O02_OrderBuilder adds the groups and segments of a RRE_O02_Order.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RRE_O02_OrderBuilder struct {
+	b            builder
+	v            RRE_O02_Order
+	gOrderdetail *RRE_O02_OrderdetailBuilder
+}
+
+// ORC sets the ORC segment.
+func (b *RRE_O02_OrderBuilder) ORC(v ORC) *RRE_O02_OrderBuilder {
+	setOnce(&b.b, "ORC", &b.v.ORC, v)
+	return b
+}
+
+// Orderdetail returns the Orderdetail group builder, adding the group the first time.
+func (b *RRE_O02_OrderBuilder) Orderdetail() *RRE_O02_OrderdetailBuilder {
+	if b.gOrderdetail == nil {
+		b.gOrderdetail = &RRE_O02_OrderdetailBuilder{b: b.b.child("Orderdetail", -1)}
+	}
+	return b.gOrderdetail
+}
+
+func (b *RRE_O02_OrderBuilder) build() (RRE_O02_Order, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gOrderdetail; g != nil {
+		gv, errs := g.build()
+		v.Orderdetail = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("ORC", builderCount(v.ORC != nil), 0, true)
+	bb.check("Orderdetail", builderCount(v.Orderdetail != nil), 0, false)
+	return v, bb.errs
+}
+
+// RRE_O02_OrderdetailBuilder adds the groups and segments of a RRE_O02_Orderdetail.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RRE_O02_OrderdetailBuilder struct {
+	b builder
+	v RRE_O02_Orderdetail
+}
+
+// RXE sets the RXE segment.
+func (b *RRE_O02_OrderdetailBuilder) RXE(v RXE) *RRE_O02_OrderdetailBuilder {
+	setOnce(&b.b, "RXE", &b.v.RXE, v)
+	return b
+}
+
+// RXR adds a RXR segment.
+func (b *RRE_O02_OrderdetailBuilder) RXR(v RXR) *RRE_O02_OrderdetailBuilder {
+	b.v.RXR = append(b.v.RXR, v)
+	return b
+}
+
+// RXC adds a RXC segment.
+func (b *RRE_O02_OrderdetailBuilder) RXC(v RXC) *RRE_O02_OrderdetailBuilder {
+	b.v.RXC = append(b.v.RXC, v)
+	return b
+}
+
+func (b *RRE_O02_OrderdetailBuilder) build() (RRE_O02_Orderdetail, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("RXE", builderCount(v.RXE != nil), 0, true)
+	bb.check("RXR", len(v.RXR), 0, true)
+	bb.check("RXC", len(v.RXC), 0, false)
+	return v, bb.errs
+}
+
+// RRE_O02Builder adds the groups and segments of a RRE_O02.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RRE_O02Builder struct {
+	b        builder
+	v        RRE_O02
+	gPatient *RRE_O02_PatientBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *RRE_O02Builder) MSH(v MSH) *RRE_O02Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MSA sets the MSA segment.
+func (b *RRE_O02Builder) MSA(v MSA) *RRE_O02Builder {
+	setOnce(&b.b, "MSA", &b.v.MSA, v)
+	return b
+}
+
+// ERR sets the ERR segment.
+func (b *RRE_O02Builder) ERR(v ERR) *RRE_O02Builder {
+	setOnce(&b.b, "ERR", &b.v.ERR, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RRE_O02Builder) NTE(v NTE) *RRE_O02Builder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Patient returns the Patient group builder, adding the group the first time.
+func (b *RRE_O02Builder) Patient() *RRE_O02_PatientBuilder {
+	if b.gPatient == nil {
+		b.gPatient = &RRE_O02_PatientBuilder{b: b.b.child("Patient", -1)}
+	}
+	return b.gPatient
+}
+
+func (b *RRE_O02Builder) build() (RRE_O02, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gPatient; g != nil {
+		gv, errs := g.build()
+		v.Patient = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MSA", builderCount(v.MSA != nil), 0, true)
+	bb.check("ERR", builderCount(v.ERR != nil), 0, false)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Patient", builderCount(v.Patient != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewRRE_O02Builder returns a builder for RRE_O02.
+func NewRRE_O02Builder() *RRE_O02Builder {
+	return &RRE_O02Builder{b: builder{path: "RRE_O02", seq: 1}}
+}
+
+// Build returns the RRE_O02 and the validation errors, if any.
+func (b *RRE_O02Builder) Build() (RRE_O02, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// RRG_O02_PatientBuilder adds the groups and segments of a RRG_O02_Patient.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RRG_O02_PatientBuilder struct {
+	b      builder
+	v      RRG_O02_Patient
+	gOrder []*RRG_O02_OrderBuilder
+}
+
+// PID sets the PID segment.
+func (b *RRG_O02_PatientBuilder) PID(v PID) *RRG_O02_PatientBuilder {
+	setOnce(&b.b, "PID", &b.v.PID, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RRG_O02_PatientBuilder) NTE(v NTE) *RRG_O02_PatientBuilder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Order adds a Order group and returns the group builder.
+func (b *RRG_O02_PatientBuilder) Order() *RRG_O02_OrderBuilder {
+	g := &RRG_O02_OrderBuilder{b: b.b.child("Order", len(b.gOrder))}
+	b.gOrder = append(b.gOrder, g)
+	return g
+}
+
+func (b *RRG_O02_PatientBuilder) build() (RRG_O02_Patient, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	for _, g := range b.gOrder {
+		gv, errs := g.build()
+		v.Order = append(v.Order, gv)
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("PID", builderCount(v.PID != nil), 0, false)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Order", len(v.Order), 0, true)
+	return v, bb.errs
+}
+
+// RRG_O02_OrderBuilder adds the groups and segments of a RRG_O02_Order.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RRG_O02_OrderBuilder struct {
+	b            builder
+	v            RRG_O02_Order
+	gOrderdetail *RRG_O02_OrderdetailBuilder
+}
+
+// ORC sets the ORC segment.
+func (b *RRG_O02_OrderBuilder) ORC(v ORC) *RRG_O02_OrderBuilder {
+	setOnce(&b.b, "ORC", &b.v.ORC, v)
+	return b
+}
+
+// Orderdetail returns the Orderdetail group builder, adding the group the first time.
+func (b *RRG_O02_OrderBuilder) Orderdetail() *RRG_O02_OrderdetailBuilder {
+	if b.gOrderdetail == nil {
+		b.gOrderdetail = &RRG_O02_OrderdetailBuilder{b: b.b.child("Orderdetail", -1)}
+	}
+	return b.gOrderdetail
+}
+
+func (b *RRG_O02_OrderBuilder) build() (RRG_O02_Order, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gOrderdetail; g != nil {
+		gv, errs := g.build()
+		v.Orderdetail = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("ORC", builderCount(v.ORC != nil), 0, true)
+	bb.check("Orderdetail", builderCount(v.Orderdetail != nil), 0, false)
+	return v, bb.errs
+}
+
+// RRG_O02_OrderdetailBuilder adds the groups and segments of a RRG_O02_Orderdetail.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RRG_O02_OrderdetailBuilder struct {
+	b builder
+	v RRG_O02_Orderdetail
+}
+
+// RXG sets the RXG segment.
+func (b *RRG_O02_OrderdetailBuilder) RXG(v RXG) *RRG_O02_OrderdetailBuilder {
+	setOnce(&b.b, "RXG", &b.v.RXG, v)
+	return b
+}
+
+// RXR adds a RXR segment.
+func (b *RRG_O02_OrderdetailBuilder) RXR(v RXR) *RRG_O02_OrderdetailBuilder {
+	b.v.RXR = append(b.v.RXR, v)
+	return b
+}
+
+// RXC adds a RXC segment.
+func (b *RRG_O02_OrderdetailBuilder) RXC(v RXC) *RRG_O02_OrderdetailBuilder {
+	b.v.RXC = append(b.v.RXC, v)
+	return b
+}
+
+func (b *RRG_O02_OrderdetailBuilder) build() (RRG_O02_Orderdetail, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("RXG", builderCount(v.RXG != nil), 0, true)
+	bb.check("RXR", len(v.RXR), 0, true)
+	bb.check("RXC", len(v.RXC), 0, false)
+	return v, bb.errs
+}
+
+// RRG_O02Builder adds the groups and segments of a RRG_O02.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type RRG_O02Builder struct {
+	b        builder
+	v        RRG_O02
+	gPatient *RRG_O02_PatientBuilder
+}
+
+// MSH sets the MSH segment.
+func (b *RRG_O02Builder) MSH(v MSH) *RRG_O02Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// MSA sets the MSA segment.
+func (b *RRG_O02Builder) MSA(v MSA) *RRG_O02Builder {
+	setOnce(&b.b, "MSA", &b.v.MSA, v)
+	return b
+}
+
+// ERR sets the ERR segment.
+func (b *RRG_O02Builder) ERR(v ERR) *RRG_O02Builder {
+	setOnce(&b.b, "ERR", &b.v.ERR, v)
+	return b
+}
+
+// NTE adds a NTE segment.
+func (b *RRG_O02Builder) NTE(v NTE) *RRG_O02Builder {
+	b.v.NTE = append(b.v.NTE, v)
+	return b
+}
+
+// Patient returns the Patient group builder, adding the group the first time.
+func (b *RRG_O02Builder) Patient() *RRG_O02_PatientBuilder {
+	if b.gPatient == nil {
+		b.gPatient = &RRG_O02_PatientBuilder{b: b.b.child("Patient", -1)}
+	}
+	return b.gPatient
+}
+
+func (b *RRG_O02Builder) build() (RRG_O02, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	if g := b.gPatient; g != nil {
+		gv, errs := g.build()
+		v.Patient = &gv
+		bb.errs = append(bb.errs, errs...)
+	}
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("MSA", builderCount(v.MSA != nil), 0, true)
+	bb.check("ERR", builderCount(v.ERR != nil), 0, false)
+	bb.check("NTE", len(v.NTE), 0, false)
+	bb.check("Patient", builderCount(v.Patient != nil), 0, false)
+	return v, bb.errs
+}
+
+// NewRRG_O02Builder returns a builder for RRG_O02.
+func NewRRG_O02Builder() *RRG_O02Builder {
+	return &RRG_O02Builder{b: builder{path: "RRG_O02", seq: 1}}
+}
+
+// Build returns the RRG_O02 and the validation errors, if any.
+func (b *RRG_O02Builder) Build() (RRG_O02, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
+
+// UDM_Q05Builder adds the groups and segments of a UDM_Q05.
+// Set IDs that are empty are set from the position of the segment, as the encoder does.
+type UDM_Q05Builder struct {
+	b builder
+	v UDM_Q05
+}
+
+// MSH sets the MSH segment.
+func (b *UDM_Q05Builder) MSH(v MSH) *UDM_Q05Builder {
+	setOnce(&b.b, "MSH", &b.v.MSH, v)
+	return b
+}
+
+// URD sets the URD segment.
+func (b *UDM_Q05Builder) URD(v URD) *UDM_Q05Builder {
+	setOnce(&b.b, "URD", &b.v.URD, v)
+	return b
+}
+
+// URS sets the URS segment.
+func (b *UDM_Q05Builder) URS(v URS) *UDM_Q05Builder {
+	setOnce(&b.b, "URS", &b.v.URS, v)
+	return b
+}
+
+// DSP adds a DSP segment.
+func (b *UDM_Q05Builder) DSP(v DSP) *UDM_Q05Builder {
+	b.v.DSP = append(b.v.DSP, v)
+	return b
+}
+
+// DSC sets the DSC segment.
+func (b *UDM_Q05Builder) DSC(v DSC) *UDM_Q05Builder {
+	setOnce(&b.b, "DSC", &b.v.DSC, v)
+	return b
+}
+
+func (b *UDM_Q05Builder) build() (UDM_Q05, []error) {
+	v := b.v
+	bb := b.b
+	bb.errs = append([]error(nil), b.b.errs...)
+	bb.check("MSH", builderCount(v.MSH != nil), 0, true)
+	bb.check("URD", builderCount(v.URD != nil), 0, true)
+	bb.check("URS", builderCount(v.URS != nil), 0, false)
+	bb.check("DSP", len(v.DSP), 0, true)
+	bb.check("DSC", builderCount(v.DSC != nil), 0, true)
+	return v, bb.errs
+}
+
+// NewUDM_Q05Builder returns a builder for UDM_Q05.
+func NewUDM_Q05Builder() *UDM_Q05Builder {
+	return &UDM_Q05Builder{b: builder{path: "UDM_Q05", seq: 1}}
+}
+
+// Build returns the UDM_Q05 and the validation errors, if any.
+func (b *UDM_Q05Builder) Build() (UDM_Q05, error) {
+	v, errs := b.build()
+	return v, errors.Join(errs...)
+}
@@ -1,4 +1,4 @@
-// Code generated by "hl7fetch -pkgdir h220 -root ./genjson -version 2.2"; DO NOT EDIT.
+// Code generated by "hl7fetch -pkgdir h220 -root ./genjson -version 2.2 -enum"; DO NOT EDIT.
 
 package h220
 
@@ -44,8 +44,8 @@ type CE struct {
 // |128952^6^M11^ADT01|
 type CK struct {
 	HL7                                        HL7Name `hl7:",name=CK,len=0,type=d"`
-	IDNumber                                   NM      `hl7:"1,display=ID Number"`
-	CheckDigit                                 NM      `hl7:"2,display=Check Digit"`
+	IDNumber                                   NM      `hl7:"1,format=NM,display=ID Number"`
+	CheckDigit                                 NM      `hl7:"2,format=NM,display=Check Digit"`
 	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"3,table=0061,display=The check digit scheme codes are defined in table 0061 - check digit scheme. "`
 	AssigningFacilityID                        ST      `hl7:"4,display=The assigning facility ID is a unique name (up to six characters in length) of the system that stores the data.  It is an ST data type.  It is equivalent to the application ID of the placer or filler order number (see Chapter 4).  Assigning facility ID's are unique across a given HL7 implementation."`
 }
@@ -64,9 +64,9 @@ type CM_AUI struct {
 // data type
 type CM_BATCH_TOTAL struct {
 	HL7         HL7Name `hl7:",name=CM_BATCH_TOTAL,len=0,type=d"`
-	BatchTotal  NM      `hl7:"1,display=Batch Total 1"`
-	BatchTotal2 NM      `hl7:"2,display=Batch Total 2"`
-	Value       NM      `hl7:"3,display=..."`
+	BatchTotal  NM      `hl7:"1,format=NM,display=Batch Total 1"`
+	BatchTotal2 NM      `hl7:"2,format=NM,display=Batch Total 2"`
+	Value       NM      `hl7:"3,format=NM,display=..."`
 }
 
 // Charge Time
@@ -80,8 +80,8 @@ type CM_CCD struct {
 type CM_DDI struct {
 	HL7          HL7Name `hl7:",name=CM_DDI,len=0,type=d"`
 	DelayDays    ST      `hl7:"1,display=Delay Days"`
-	Amount       NM      `hl7:"2,display=Amount"`
-	NumberOfDays NM      `hl7:"3,display=Number Of Days"`
+	Amount       NM      `hl7:"2,format=NM,display=Amount"`
+	NumberOfDays NM      `hl7:"3,format=NM,display=Number Of Days"`
 }
 
 // Discharge Location
@@ -95,7 +95,7 @@ type CM_DLD struct {
 type CM_DTN struct {
 	HL7          HL7Name `hl7:",name=CM_DTN,len=0,type=d"`
 	DayType      ID      `hl7:"1,table=0149,display=Day Type"`
-	NumberOfDays NM      `hl7:"2,display=Number Of Days"`
+	NumberOfDays NM      `hl7:"2,format=NM,display=Number Of Days"`
 }
 
 // Parent Order
@@ -109,8 +109,8 @@ type CM_EIP struct {
 type CM_ELD struct {
 	HL7                  HL7Name `hl7:",name=CM_ELD,len=0,type=d"`
 	SegmentID            ST      `hl7:"1,display=Segment-id"`
-	Sequence             NM      `hl7:"2,display=Sequence"`
-	FieldPosition        NM      `hl7:"3,display=Field-position"`
+	Sequence             NM      `hl7:"2,format=NM,display=Sequence"`
+	FieldPosition        NM      `hl7:"3,format=NM,display=Field-position"`
 	CodeIdentifyingError *CE     `hl7:"4,table=0060,display=Code Identifying Error"`
 }
 
@@ -212,7 +212,7 @@ type CM_OSD struct {
 	FillerOrderNumberEntityIdentifier ST      `hl7:"4,required,display=Uses two subcomponents since the filler order number has two components"`
 	FillerOrderNumberNamespaceID      IS      `hl7:"5,display=Uses two subcomponents since the filler order number has two components"`
 	SequenceConditionValue            ST      `hl7:"6,display=The acceptable condition values have the form commonly used in project planning methodologies  <one of 'SS'- 'EE'- 'SE'- or 'ES'> +/- <time>  The first letter stands for start (S) or end (E) of predecessor order- where the predecessor is defined by the placer or filler order number in subcomponents 1-2 or subcomponents 3-4.    The second letter stands for the start (S) or end (E) of the successor order- where the successor order is the order containing this quantity/timing specification.    The time specifies the interval between the predecessor and successor starts or ends "`
-	MaximumNumberOfRepeats            NM      `hl7:"7,display=The maximum number of repeats to be used only on cyclic groups.  The total number of repeats is constrained by the end date/time of the last repeat or the end date/time of the parent- whichever is first."`
+	MaximumNumberOfRepeats            NM      `hl7:"7,format=NM,display=The maximum number of repeats to be used only on cyclic groups.  The total number of repeats is constrained by the end date/time of the last repeat or the end date/time of the parent- whichever is first."`
 }
 
 // Occurence Span
@@ -227,7 +227,7 @@ type CM_OSP struct {
 type CM_PAT_ID struct {
 	HL7              HL7Name `hl7:",name=CM_PAT_ID,len=0,type=d"`
 	PatientID        ST      `hl7:"1,display=Patient Id"`
-	CheckDigit       NM      `hl7:"2,display=Check Digit"`
+	CheckDigit       NM      `hl7:"2,format=NM,display=Check Digit"`
 	CheckDigitScheme ID      `hl7:"3,table=0061,display=Check Digit Scheme"`
 	FacilityID       ID      `hl7:"4,display=Facility Id"`
 	Type             ID      `hl7:"5,display=Type"`
@@ -237,7 +237,7 @@ type CM_PAT_ID struct {
 type CM_PAT_ID_0192 struct {
 	HL7              HL7Name `hl7:",name=CM_PAT_ID_0192,len=0,type=d"`
 	PatientID        ST      `hl7:"1,display=Patient Id"`
-	CheckDigit       NM      `hl7:"2,display=Check Digit"`
+	CheckDigit       NM      `hl7:"2,format=NM,display=Check Digit"`
 	CheckDigitScheme ID      `hl7:"3,table=0061,display=Check Digit Scheme"`
 	FacilityID       ID      `hl7:"4,display=Facility Id"`
 	Type             ID      `hl7:"5,table=0192,display=Type"`
@@ -255,7 +255,7 @@ type CM_PCF struct {
 type CM_PEN struct {
 	HL7           HL7Name `hl7:",name=CM_PEN,len=0,type=d"`
 	PenaltyID     ID      `hl7:"1,table=0148,display=Penalty Id"`
-	PenaltyAmount NM      `hl7:"2,display=Penalty Amount"`
+	PenaltyAmount NM      `hl7:"2,format=NM,display=Penalty Amount"`
 }
 
 // Order Number Of The Client / The Contracting Authority
@@ -288,7 +288,7 @@ type CM_PTA struct {
 	HL7         HL7Name `hl7:",name=CM_PTA,len=0,type=d"`
 	PolicyType  ID      `hl7:"1,table=0147,display=Policy Type"`
 	AmountClass ID      `hl7:"2,table=0193,display=Amount Class"`
-	Amount      NM      `hl7:"3,display=Amount"`
+	Amount      NM      `hl7:"3,format=NM,display=Amount"`
 }
 
 // Interval
@@ -303,7 +303,7 @@ type CM_RMC struct {
 	HL7            HL7Name `hl7:",name=CM_RMC,len=0,type=d"`
 	RoomType       ID      `hl7:"1,table=0145,display=Room Type"`
 	AmountType     ID      `hl7:"2,table=0146,display=Amount Type"`
-	CoverageAmount NM      `hl7:"3,display=Coverage Amount"`
+	CoverageAmount NM      `hl7:"3,format=NM,display=Coverage Amount"`
 }
 
 // Specimen Source
@@ -320,7 +320,7 @@ type CM_SPS struct {
 type CM_UVC struct {
 	HL7         HL7Name `hl7:",name=CM_UVC,len=0,type=d"`
 	ValueCode   ID      `hl7:"1,table=0153,display=Value Code"`
-	ValueAmount NM      `hl7:"2,display=Value Amount"`
+	ValueAmount NM      `hl7:"2,format=NM,display=Value Amount"`
 }
 
 // Value Qualifier
@@ -381,7 +381,7 @@ type COMP_ID_DIGIT = string
 // |150^lb&&ANSI+| weight in pounds is a customary US unit defined within ANSI+
 type CQ struct {
 	HL7      HL7Name `hl7:",name=CQ,len=0,type=d"`
-	Quantity NM      `hl7:"1,display=Quantity"`
+	Quantity NM      `hl7:"1,format=NM,display=Quantity"`
 	Units    ST      `hl7:"2,display=The units in which the quantity is expressed.  Field-by-field- default units may be defined within the specifications.  When the observation is measured in the default units- the units need not be transmitted.  If the measure is recorded in units different from the default- the measurement units must be transmitted as the second component.  If the units are ISO+ units- then units should be recorded as lowercase abbreviations as specified in Chapter 7.  If the units are ANSI or local- the units and the source table must be recorded as specified in Chapter 7.  But in these cases the component separator should be replaced by the subcomponent delimiter "`
 }
 
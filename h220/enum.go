@@ -0,0 +1,2833 @@
+// Code generated by "hl7fetch -pkgdir h220 -root ./genjson -version 2.2 -enum"; DO NOT EDIT.
+
+package h220
+
+// Table0001 is a value of table 0001, SEX.
+// Fields in this table are strings; convert a field, such as Table0001(v), to use the helpers.
+type Table0001 string
+
+const (
+	Table0001Female  Table0001 = `F` // Female
+	Table0001Male    Table0001 = `M` // Male
+	Table0001Other   Table0001 = `O` // Other
+	Table0001Unknown Table0001 = `U` // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0001.
+func (v Table0001) Description() string {
+	return tableDescription(`0001`, string(v))
+}
+
+// Valid reports if the value is in table 0001.
+func (v Table0001) Valid() bool {
+	return TableValueLookup[`0001`][string(v)]
+}
+
+// Table 0001.
+func (v Table0001) Table() Table {
+	return TableLookup[`0001`]
+}
+
+// Table0002 is a value of table 0002, MARITAL STATUS.
+// Fields in this table are strings; convert a field, such as Table0002(v), to use the helpers.
+type Table0002 string
+
+const (
+	Table0002Separated Table0002 = `A` // Separated
+	Table0002Divorced  Table0002 = `D` // Divorced
+	Table0002Married   Table0002 = `M` // Married
+	Table0002Single    Table0002 = `S` // Single
+	Table0002Widowed   Table0002 = `W` // Widowed
+)
+
+// Description of the value, or empty if the value is not in table 0002.
+func (v Table0002) Description() string {
+	return tableDescription(`0002`, string(v))
+}
+
+// Valid reports if the value is in table 0002.
+func (v Table0002) Valid() bool {
+	return TableValueLookup[`0002`][string(v)]
+}
+
+// Table 0002.
+func (v Table0002) Table() Table {
+	return TableLookup[`0002`]
+}
+
+// Table0003 is a value of table 0003, EVENT TYPE CODE.
+// Fields in this table are strings; convert a field, such as Table0003(v), to use the helpers.
+type Table0003 string
+
+const (
+	Table0003AdmitAPatient                                 Table0003 = `A01` // Admit a patient
+	Table0003TransferAPatient                              Table0003 = `A02` // Transfer a patient
+	Table0003DischargeAPatient                             Table0003 = `A03` // Discharge a patient
+	Table0003RegisterAPatient                              Table0003 = `A04` // Register a patient
+	Table0003PreAdmitAPatient                              Table0003 = `A05` // Pre-admit a Patient
+	Table0003TransferAnOutpatientToInpatient               Table0003 = `A06` // Transfer an outpatient to inpatient
+	Table0003TransferAnInpatientToOutpatient               Table0003 = `A07` // Transfer an inpatient to outpatient
+	Table0003UpdatePatientInformation                      Table0003 = `A08` // Update patient information
+	Table0003PatientDeparting                              Table0003 = `A09` // Patient departing
+	Table0003PatientArriving                               Table0003 = `A10` // Patient arriving
+	Table0003CancelAdmit                                   Table0003 = `A11` // Cancel admit
+	Table0003CancelTransfer                                Table0003 = `A12` // Cancel transfer
+	Table0003CancelDischarge                               Table0003 = `A13` // Cancel discharge
+	Table0003PendingAdmit                                  Table0003 = `A14` // Pending admit
+	Table0003PendingTransfer                               Table0003 = `A15` // Pending transfer
+	Table0003PendingDischarge                              Table0003 = `A16` // Pending discharge
+	Table0003SwapPatients                                  Table0003 = `A17` // Swap patients
+	Table0003MergePatientInformation                       Table0003 = `A18` // Merge patient information
+	Table0003PatientQuery                                  Table0003 = `A19` // Patient query
+	Table0003BedStatusUpdate                               Table0003 = `A20` // Bed Status Update
+	Table0003LeaveOfAbsenceOutLeaving                      Table0003 = `A21` // Leave of absence - out (leaving)
+	Table0003LeaveOfAbsenceInReturning                     Table0003 = `A22` // Leave of absence - in (returning)
+	Table0003DeleteAPatientRecord                          Table0003 = `A23` // Delete a patient record
+	Table0003LinkPatientInformation                        Table0003 = `A24` // Link patient information
+	Table0003CancelPendingDischarge                        Table0003 = `A25` // Cancel pending discharge
+	Table0003CancelPendingTransfer                         Table0003 = `A26` // Cancel pending transfer
+	Table0003CancelPendingAdmit                            Table0003 = `A27` // Cancel pending admit
+	Table0003AddPersonInformation                          Table0003 = `A28` // Add person information
+	Table0003DeletePersonInformation                       Table0003 = `A29` // Delete person information
+	Table0003MergePatientInformation_A30                   Table0003 = `A30` // Merge Patient information
+	Table0003UpdatePersonInformation                       Table0003 = `A31` // Update person information
+	Table0003CancelPatientArriving                         Table0003 = `A32` // Cancel patient arriving
+	Table0003CancelPatientDeparting                        Table0003 = `A33` // Cancel patient departing
+	Table0003MergePatientInformationPatientIDOnly          Table0003 = `A34` // Merge patient information - patient ID only
+	Table0003MergePatientInformationAccountNumberOnly      Table0003 = `A35` // Merge patient information - account number only
+	Table0003MergePatientInformationPatientIDAnd           Table0003 = `A36` // Merge patient information - patient ID and account number
+	Table0003UnlinkPatientInformation                      Table0003 = `A37` // Unlink patient information
+	Table0003MasterFileNotOtherwiseSpecifiedFor            Table0003 = `M01` // Master file not otherwise specified (for backwards compatibility only)
+	Table0003MasterFileStaffPractioner                     Table0003 = `M02` // Master file - Staff Practioner
+	Table0003MasterFileTestObservation                     Table0003 = `M03` // Master file - test / observation
+	Table0003OrderMessage                                  Table0003 = `O01` // Order message
+	Table0003OrderResponse                                 Table0003 = `O02` // Order response
+	Table0003AddAndUpdatePatientAccounts                   Table0003 = `P01` // Add and Update Patient Accounts
+	Table0003PurgePatientAccounts                          Table0003 = `P02` // Purge Patient Accounts
+	Table0003PostDetailFinancialTransaction                Table0003 = `P03` // Post detail financial transaction
+	Table0003GenerateBillAndAccountsReceivableStatements   Table0003 = `P04` // Generate bill and accounts receivable statements
+	Table0003ImmediateAccess                               Table0003 = `Q01` // Immediate access
+	Table0003DeferredAccess                                Table0003 = `Q02` // Deferred access
+	Table0003DeferredResponseToQuery                       Table0003 = `Q03` // Deferred response to query
+	Table0003UnsolicitedDisplayUpdate                      Table0003 = `Q05` // Unsolicited display update
+	Table0003UnsolicitedTransmissionOfRequestedObservation Table0003 = `R01` // Unsolicited transmission of requested observation
+	Table0003QueryForResultsOfObservation                  Table0003 = `R02` // Query for results of observation
+	Table0003DisplayOrientedResultsQueryUnsolicitedUpdate  Table0003 = `R03` // Display-oriented results (query / unsolicited update)
+	Table0003ResponseToQueryTransmissionOfRequested        Table0003 = `R04` // Response to query / transmission of requested observation
+)
+
+// Description of the value, or empty if the value is not in table 0003.
+func (v Table0003) Description() string {
+	return tableDescription(`0003`, string(v))
+}
+
+// Valid reports if the value is in table 0003.
+func (v Table0003) Valid() bool {
+	return TableValueLookup[`0003`][string(v)]
+}
+
+// Table 0003.
+func (v Table0003) Table() Table {
+	return TableLookup[`0003`]
+}
+
+// Table0004 is a value of table 0004, PATIENT CLASS.
+// Fields in this table are strings; convert a field, such as Table0004(v), to use the helpers.
+type Table0004 string
+
+const (
+	Table0004Obstetrics       Table0004 = `B` // Obstetrics
+	Table0004Emergency        Table0004 = `E` // Emergency
+	Table0004Inpatient        Table0004 = `I` // Inpatient
+	Table0004Outpatient       Table0004 = `O` // Outpatient
+	Table0004Preadmit         Table0004 = `P` // Preadmit
+	Table0004RecurringPatient Table0004 = `R` // Recurring Patient
+)
+
+// Description of the value, or empty if the value is not in table 0004.
+func (v Table0004) Description() string {
+	return tableDescription(`0004`, string(v))
+}
+
+// Valid reports if the value is in table 0004.
+func (v Table0004) Valid() bool {
+	return TableValueLookup[`0004`][string(v)]
+}
+
+// Table 0004.
+func (v Table0004) Table() Table {
+	return TableLookup[`0004`]
+}
+
+// Table0007 is a value of table 0007, ADMISSION TYPE.
+// Fields in this table are strings; convert a field, such as Table0007(v), to use the helpers.
+type Table0007 string
+
+const (
+	Table0007Accident         Table0007 = `A` // Accident
+	Table0007Emergency        Table0007 = `E` // Emergency
+	Table0007LaborAndDelivery Table0007 = `L` // Labor and Delivery
+	Table0007Routine          Table0007 = `R` // Routine
+)
+
+// Description of the value, or empty if the value is not in table 0007.
+func (v Table0007) Description() string {
+	return tableDescription(`0007`, string(v))
+}
+
+// Valid reports if the value is in table 0007.
+func (v Table0007) Valid() bool {
+	return TableValueLookup[`0007`][string(v)]
+}
+
+// Table 0007.
+func (v Table0007) Table() Table {
+	return TableLookup[`0007`]
+}
+
+// Table0008 is a value of table 0008, ACKNOWLEDGMENT CODE.
+// Fields in this table are strings; convert a field, such as Table0008(v), to use the helpers.
+type Table0008 string
+
+const (
+	Table0008ApplicationAcceptOriginalModeApplicationAcknowledgement Table0008 = `AA` // Application accept (original mode) / Application acknowledgement: accept (enhanced mode)
+	Table0008ApplicationErrorOriginalModeApplicationAcknowledgement  Table0008 = `AE` // Application error (original mode) / Application acknowledgement: error (enhanced mode)
+	Table0008ApplicationRejectOriginalModeApplicationAcknowledgement Table0008 = `AR` // Application reject (original mode) / Application acknowledgement: reject (enhanced mode)
+	Table0008EnhancedModeApplicationAcknowledgementCommitAccept      Table0008 = `CA` // Enhanced mode:  Application acknowledgement:  Commit Accept
+	Table0008EnhancedModeApplicationAcknowledgementCommitError       Table0008 = `CE` // Enhanced mode:  Application acknowledgement:  Commit Error
+	Table0008EnhancedModeApplicationAcknowledgementCommitReject      Table0008 = `CR` // Enhanced mode:  Application acknowledgement:  Commit Reject
+)
+
+// Description of the value, or empty if the value is not in table 0008.
+func (v Table0008) Description() string {
+	return tableDescription(`0008`, string(v))
+}
+
+// Valid reports if the value is in table 0008.
+func (v Table0008) Valid() bool {
+	return TableValueLookup[`0008`][string(v)]
+}
+
+// Table 0008.
+func (v Table0008) Table() Table {
+	return TableLookup[`0008`]
+}
+
+// Table0009 is a value of table 0009, AMBULATORY STATUS.
+// Fields in this table are strings; convert a field, such as Table0009(v), to use the helpers.
+type Table0009 string
+
+const (
+	Table0009NoFunctionalLimitations           Table0009 = `A0` // No functional limitations
+	Table0009AmbulatesWithAssistiveDevice      Table0009 = `A1` // Ambulates with assistive device
+	Table0009WheelchairStretcherBound          Table0009 = `A2` // Wheelchair / stretcher bound
+	Table0009ComatoseNonResponsive             Table0009 = `A3` // Comatose; non-responsive
+	Table0009Disoriented                       Table0009 = `A4` // Disoriented
+	Table0009VisionImpaired                    Table0009 = `A5` // Vision impaired
+	Table0009HearingImpaired                   Table0009 = `A6` // Hearing impaired
+	Table0009SpeechImpaired                    Table0009 = `A7` // Speech impaired
+	Table0009NonenglishSpeaking                Table0009 = `A8` // Nonenglish speaking
+	Table0009FunctionalLevelUnknown            Table0009 = `A9` // Functional level unknown
+	Table0009OxygenTherapy                     Table0009 = `B1` // Oxygen Therapy
+	Table0009SpecialEquipmentTubesIvsCatheters Table0009 = `B2` // Special equipment (tubes, Ivs, catheters)
+	Table0009Amputee                           Table0009 = `B3` // Amputee
+	Table0009Mastectomy                        Table0009 = `B4` // Mastectomy
+	Table0009Paraplegic                        Table0009 = `B5` // Paraplegic
+	Table0009Pregnant                          Table0009 = `B6` // Pregnant
+)
+
+// Description of the value, or empty if the value is not in table 0009.
+func (v Table0009) Description() string {
+	return tableDescription(`0009`, string(v))
+}
+
+// Valid reports if the value is in table 0009.
+func (v Table0009) Valid() bool {
+	return TableValueLookup[`0009`][string(v)]
+}
+
+// Table 0009.
+func (v Table0009) Table() Table {
+	return TableLookup[`0009`]
+}
+
+// Table0018 is a value of table 0018, PATIENT TYPE.
+// Fields in this table are strings; convert a field, such as Table0018(v), to use the helpers.
+type Table0018 string
+
+const (
+	Table0018B Table0018 = `B`
+	Table0018E Table0018 = `E`
+	Table0018F Table0018 = `F`
+	Table0018G Table0018 = `G`
+	Table0018J Table0018 = `J`
+	Table0018K Table0018 = `K`
+	Table0018N Table0018 = `N`
+	Table0018P Table0018 = `P`
+	Table0018S Table0018 = `S`
+)
+
+// Description of the value, or empty if the value is not in table 0018.
+func (v Table0018) Description() string {
+	return tableDescription(`0018`, string(v))
+}
+
+// Valid reports if the value is in table 0018.
+func (v Table0018) Valid() bool {
+	return TableValueLookup[`0018`][string(v)]
+}
+
+// Table 0018.
+func (v Table0018) Table() Table {
+	return TableLookup[`0018`]
+}
+
+// Table0038 is a value of table 0038, ORDER STATUS.
+// Fields in this table are strings; convert a field, such as Table0038(v), to use the helpers.
+type Table0038 string
+
+const (
+	Table0038OrderWasCanceled     Table0038 = `CA` // Order was canceled
+	Table0038OrderIsCompleted     Table0038 = `CM` // Order is completed
+	Table0038OrderWasDiscontinued Table0038 = `DC` // Order was discontinued
+	Table0038ErrorOrderNotFound   Table0038 = `ER` // Error - order not found
+	Table0038OrderIsOnHold        Table0038 = `HD` // Order is on hold
+	Table0038InProcessUnspecified Table0038 = `IP` // In process - unspecified
+	Table0038OrderHasBeenReplaced Table0038 = `RP` // Order has been replaced
+	Table0038InProcessScheduled   Table0038 = `SC` // In process - scheduled
+)
+
+// Description of the value, or empty if the value is not in table 0038.
+func (v Table0038) Description() string {
+	return tableDescription(`0038`, string(v))
+}
+
+// Valid reports if the value is in table 0038.
+func (v Table0038) Valid() bool {
+	return TableValueLookup[`0038`][string(v)]
+}
+
+// Table 0038.
+func (v Table0038) Table() Table {
+	return TableLookup[`0038`]
+}
+
+// Table0048 is a value of table 0048, WHAT SUBJECT FILTER.
+// Fields in this table are strings; convert a field, such as Table0048(v), to use the helpers.
+type Table0048 string
+
+const (
+	Table0048AdviceDiagnosis                        Table0048 = `ADV` // Advice / diagnosis
+	Table0048NursingUnitLookupReturnsPatientsIn     Table0048 = `ANU` // Nursing unit lookup (returns patients in beds, excluding empty beds)
+	Table0048AccountNumberQueryReturnMatchingVisit  Table0048 = `APA` // Account number query, return matching visit
+	Table0048MedicalRecordNumberQueryReturnsVisits  Table0048 = `APM` // Medical record number query, returns visits for a medical record number
+	Table0048PatientNameLookup                      Table0048 = `APN` // Patient name lookup
+	Table0048PhysicianLookup                        Table0048 = `APP` // Physician lookup
+	Table0048NursingUnitLookupReturnsPatientsIn_ARN Table0048 = `ARN` // Nursing unit lookup (returns patients in beds, including empty beds)
+	Table0048CancelUsedToCancelAQuery               Table0048 = `CAN` // Cancel (used to cancel a query)
+	Table0048Demographics                           Table0048 = `DEM` // Demographics
+	Table0048Financial                              Table0048 = `FIN` // Financial
+	Table0048MasterFileQuery                        Table0048 = `MFQ` // Master file query
+	Table0048MostRecentInpatient                    Table0048 = `MRI` // Most recent inpatient
+	Table0048MostRecentOutpatient                   Table0048 = `MRO` // Most recent outpatient
+	Table0048NetworkClock                           Table0048 = `NCK` // Network clock
+	Table0048NetworkStatusChange                    Table0048 = `NSC` // Network status change
+	Table0048NetworkStatistic                       Table0048 = `NST` // Network statistic
+	Table0048Order                                  Table0048 = `ORD` // Order
+	Table0048Other                                  Table0048 = `OTH` // Other
+	Table0048Procedure                              Table0048 = `PRO` // Procedure
+	Table0048PharmacyAdministrationInformation      Table0048 = `RAR` // Pharmacy administration information
+	Table0048PharmacyDispenseInformation            Table0048 = `RDR` // Pharmacy dispense information
+	Table0048PharmacyEncodedOrderInformation        Table0048 = `RER` // Pharmacy encoded order information
+	Table0048Result                                 Table0048 = `RES` // Result
+	Table0048PharmacyGiveInformation                Table0048 = `RGR` // Pharmacy give information
+	Table0048PharmacyPrescriptionInformation        Table0048 = `ROR` // Pharmacy prescription information
+	Table0048Status                                 Table0048 = `STA` // Status
+)
+
+// Description of the value, or empty if the value is not in table 0048.
+func (v Table0048) Description() string {
+	return tableDescription(`0048`, string(v))
+}
+
+// Valid reports if the value is in table 0048.
+func (v Table0048) Valid() bool {
+	return TableValueLookup[`0048`][string(v)]
+}
+
+// Table 0048.
+func (v Table0048) Table() Table {
+	return TableLookup[`0048`]
+}
+
+// Table0053 is a value of table 0053, DIAGNOSIS CODING METHOD.
+// Fields in this table are strings; convert a field, such as Table0053(v), to use the helpers.
+type Table0053 string
+
+const (
+	Table0053ICD9 Table0053 = `I9` // ICD9
+)
+
+// Description of the value, or empty if the value is not in table 0053.
+func (v Table0053) Description() string {
+	return tableDescription(`0053`, string(v))
+}
+
+// Valid reports if the value is in table 0053.
+func (v Table0053) Valid() bool {
+	return TableValueLookup[`0053`][string(v)]
+}
+
+// Table 0053.
+func (v Table0053) Table() Table {
+	return TableLookup[`0053`]
+}
+
+// Table0061 is a value of table 0061, CHECK DIGIT SCHEME.
+// Fields in this table are strings; convert a field, such as Table0061(v), to use the helpers.
+type Table0061 string
+
+const (
+	Table0061Mod10Algorithm Table0061 = `M10` // Mod 10 algorithm
+	Table0061Mod11Algorithm Table0061 = `M11` // Mod 11 algorithm
+)
+
+// Description of the value, or empty if the value is not in table 0061.
+func (v Table0061) Description() string {
+	return tableDescription(`0061`, string(v))
+}
+
+// Valid reports if the value is in table 0061.
+func (v Table0061) Valid() bool {
+	return TableValueLookup[`0061`][string(v)]
+}
+
+// Table 0061.
+func (v Table0061) Table() Table {
+	return TableLookup[`0061`]
+}
+
+// Table0062 is a value of table 0062, EVENT REASON.
+// Fields in this table are strings; convert a field, such as Table0062(v), to use the helpers.
+type Table0062 string
+
+const (
+	Table0062PatientRequest   Table0062 = `01` // Patient request
+	Table0062PhysicianOrder   Table0062 = `02` // Physician order
+	Table0062CensusManagement Table0062 = `03` // Census management
+)
+
+// Description of the value, or empty if the value is not in table 0062.
+func (v Table0062) Description() string {
+	return tableDescription(`0062`, string(v))
+}
+
+// Valid reports if the value is in table 0062.
+func (v Table0062) Valid() bool {
+	return TableValueLookup[`0062`][string(v)]
+}
+
+// Table 0062.
+func (v Table0062) Table() Table {
+	return TableLookup[`0062`]
+}
+
+// Table0065 is a value of table 0065, ACTION CODE.
+// Fields in this table are strings; convert a field, such as Table0065(v), to use the helpers.
+type Table0065 string
+
+const (
+	Table0065AddOrderedTestsToTheExisting       Table0065 = `A` // Add ordered tests to the existing specimen
+	Table0065GeneratedOrderReflexOrder          Table0065 = `G` // Generated order / reflex order
+	Table0065LabToObtainSpecimenFromPatient     Table0065 = `L` // Lab to obtain specimen from patient
+	Table0065SpecimenObtainedByServiceOtherThan Table0065 = `O` // Specimen obtained by service other than Lab
+	Table0065PendingSpecimenOrderSentPriorTo    Table0065 = `P` // Pending specimen - order sent prior to delivery
+	Table0065RevisedOrder                       Table0065 = `R` // Revised order
+	Table0065ScheduleTheTestsSpecifiedBelow     Table0065 = `S` // Schedule the tests specified below
+)
+
+// Description of the value, or empty if the value is not in table 0065.
+func (v Table0065) Description() string {
+	return tableDescription(`0065`, string(v))
+}
+
+// Valid reports if the value is in table 0065.
+func (v Table0065) Valid() bool {
+	return TableValueLookup[`0065`][string(v)]
+}
+
+// Table 0065.
+func (v Table0065) Table() Table {
+	return TableLookup[`0065`]
+}
+
+// Table0070 is a value of table 0070, SOURCE OF SPECIMEN.
+// Fields in this table are strings; convert a field, such as Table0070(v), to use the helpers.
+type Table0070 string
+
+const (
+	Table0070ArterialBlood                Table0070 = `ABLD`  // Arterial blood
+	Table0070Abcess                       Table0070 = `ABS`   // Abcess
+	Table0070AmnioticFluid                Table0070 = `AMN`   // Amniotic fluid
+	Table0070Aspirate                     Table0070 = `ASP`   // Aspirate
+	Table0070BloodBag                     Table0070 = `BBL`   // Blood bag
+	Table0070WholeBody                    Table0070 = `BDY`   // Whole body
+	Table0070WholeBlood                   Table0070 = `BLD`   // Whole blood
+	Table0070Bone                         Table0070 = `BON`   // Bone
+	Table0070Basophils                    Table0070 = `BPH`   // Basophils
+	Table0070Burn                         Table0070 = `BRN`   // Burn
+	Table0070Bronchial                    Table0070 = `BRO`   // Bronchial
+	Table0070BreathUseEXHLD               Table0070 = `BRTH`  // Breath (use EXHLD)
+	Table0070CalculusStone                Table0070 = `CALC`  // Calculus (=Stone)
+	Table0070CordBlood                    Table0070 = `CBLD`  // Cord blood
+	Table0070CardiacMuscle                Table0070 = `CDM`   // Cardiac muscle
+	Table0070Conjunctiva                  Table0070 = `CNJT`  // Conjunctiva
+	Table0070Cannula                      Table0070 = `CNL`   // Cannula
+	Table0070Colostrum                    Table0070 = `COL`   // Colostrum
+	Table0070CerebralSpinalFluid          Table0070 = `CSF`   // Cerebral spinal fluid
+	Table0070CatheterTip                  Table0070 = `CTP`   // Catheter tip
+	Table0070Curettage                    Table0070 = `CUR`   // Curettage
+	Table0070CervicalMucus                Table0070 = `CVM`   // Cervical mucus
+	Table0070Cervix                       Table0070 = `CVX`   // Cervix
+	Table0070Cyst                         Table0070 = `CYST`  // Cyst
+	Table0070Drain                        Table0070 = `DRN`   // Drain
+	Table0070Ear                          Table0070 = `EAR`   // Ear
+	Table0070Electrode                    Table0070 = `ELT`   // Electrode
+	Table0070Endocardium                  Table0070 = `ENDC`  // Endocardium
+	Table0070Endometrium                  Table0070 = `ENDM`  // Endometrium
+	Table0070Eosinophils                  Table0070 = `EOS`   // Eosinophils
+	Table0070Fibroblasts                  Table0070 = `FIB`   // Fibroblasts
+	Table0070Fistula                      Table0070 = `FIST`  // Fistula
+	Table0070Filter                       Table0070 = `FLT`   // Filter
+	Table0070BodyFluidUnsp                Table0070 = `FLU`   // Body fluid, unsp
+	Table0070GastricFluidContents         Table0070 = `GAST`  // Gastric fluid/contents
+	Table0070Genital                      Table0070 = `GEN`   // Genital
+	Table0070GenitalCervix                Table0070 = `GENC`  // Genital cervix
+	Table0070GenitalLochia                Table0070 = `GENL`  // Genital lochia
+	Table0070GenitalVaginal               Table0070 = `GENV`  // Genital vaginal
+	Table0070Hair                         Table0070 = `HAR`   // Hair
+	Table0070IntubationTube               Table0070 = `IT`    // Intubation tube
+	Table0070Lamella                      Table0070 = `LAM`   // Lamella
+	Table0070Line                         Table0070 = `LN`    // Line
+	Table0070LineArterial                 Table0070 = `LNA`   // Line arterial
+	Table0070LineVenous                   Table0070 = `LNV`   // Line venous
+	Table0070Lymphocytes                  Table0070 = `LYM`   // Lymphocytes
+	Table0070Macrophages                  Table0070 = `MAC`   // Macrophages
+	Table0070Marrow                       Table0070 = `MAR`   // Marrow
+	Table0070MenstrualBlood               Table0070 = `MBLD`  // Menstrual blood
+	Table0070Meconium                     Table0070 = `MEC`   // Meconium
+	Table0070BreastMilk                   Table0070 = `MILK`  // Breast milk
+	Table0070Milk                         Table0070 = `MLK`   // Milk
+	Table0070Nail                         Table0070 = `NAIL`  // Nail
+	Table0070NoseNasalPassage             Table0070 = `NOS`   // Nose (nasal passage)
+	Table0070Other                        Table0070 = `ORH`   // Other
+	Table0070Peritoneum                   Table0070 = `PER`   // Peritoneum
+	Table0070Plasma                       Table0070 = `PLAS`  // Plasma
+	Table0070PlasmaBag                    Table0070 = `PLB`   // Plasma bag
+	Table0070Placenta                     Table0070 = `PLC`   // Placenta
+	Table0070PleuralFluidThoracentesisFld Table0070 = `PLR`   // Pleural fluid (thoracentesis fld)
+	Table0070PolymorphonuclearNeutrophils Table0070 = `PMN`   // Polymorphonuclear neutrophils
+	Table0070PeritonealFluidAscites       Table0070 = `PRT`   // Peritoneal fluid / ascites
+	Table0070Pus                          Table0070 = `PUS`   // Pus
+	Table0070Erythrocytes                 Table0070 = `RBC`   // Erythrocytes
+	Table0070Saliva                       Table0070 = `SAL`   // Saliva
+	Table0070SeminalFluid                 Table0070 = `SEM`   // Seminal fluid
+	Table0070Serum                        Table0070 = `SER`   // Serum
+	Table0070SkeletalMuscle               Table0070 = `SKM`   // Skeletal muscle
+	Table0070Skin                         Table0070 = `SKN`   // Skin
+	Table0070SynovialFluidJointFluid      Table0070 = `SNV`   // Synovial fluid (Joint fluid)
+	Table0070Spermatozoa                  Table0070 = `SPRM`  // Spermatozoa
+	Table0070Sputum                       Table0070 = `SPT`   // Sputum
+	Table0070SputumCoughed                Table0070 = `SPTC`  // Sputum - coughed
+	Table0070SputumTrachealAspirate       Table0070 = `SPTT`  // Sputum - tracheal aspirate
+	Table0070Stool                        Table0070 = `STL`   // Stool
+	Table0070StoneUseCALC                 Table0070 = `STON`  // Stone (use CALC)
+	Table0070Sweat                        Table0070 = `SWT`   // Sweat
+	Table0070Tears                        Table0070 = `TEAR`  // Tears
+	Table0070ThrombocytePlatelet          Table0070 = `THRB`  // Thrombocyte (platelet)
+	Table0070Throat                       Table0070 = `THRT`  // Throat
+	Table0070TissueBoneMarrow             Table0070 = `TISB`  // Tissue bone marrow
+	Table0070TissueCurettage              Table0070 = `TISC`  // Tissue curettage
+	Table0070TissueGallBladder            Table0070 = `TISG`  // Tissue gall bladder
+	Table0070TissueLung                   Table0070 = `TISL`  // Tissue lung
+	Table0070TissuePeritoneum             Table0070 = `TISP`  // Tissue peritoneum
+	Table0070TissuePlacenta               Table0070 = `TISPL` // Tissue placenta
+	Table0070Tissue                       Table0070 = `TISS`  // Tissue
+	Table0070TissueUlcer                  Table0070 = `TISU`  // Tissue ulcer
+	Table0070Ulcer                        Table0070 = `ULC`   // Ulcer
+	Table0070UmbilicalBlood               Table0070 = `UMB`   // Umbilical Blood
+	Table0070Urine                        Table0070 = `UR`    // Urine
+	Table0070UrineCleanCatch              Table0070 = `URC`   // Urine clean catch
+	Table0070UrineCatheter                Table0070 = `URT`   // Urine catheter
+	Table0070Urethra                      Table0070 = `URTH`  // Urethra
+	Table0070Vomitus                      Table0070 = `VOM`   // Vomitus
+	Table0070Leukocytes                   Table0070 = `WBC`   // Leukocytes
+	Table0070Wick                         Table0070 = `WICK`  // Wick
+	Table0070Wound                        Table0070 = `WND`   // Wound
+	Table0070WoundAbscess                 Table0070 = `WNDA`  // Wound abscess
+	Table0070WoundDrainage                Table0070 = `WNDD`  // Wound drainage
+	Table0070WoundExudate                 Table0070 = `WNDE`  // Wound exudate
+)
+
+// Description of the value, or empty if the value is not in table 0070.
+func (v Table0070) Description() string {
+	return tableDescription(`0070`, string(v))
+}
+
+// Valid reports if the value is in table 0070.
+func (v Table0070) Valid() bool {
+	return TableValueLookup[`0070`][string(v)]
+}
+
+// Table 0070.
+func (v Table0070) Table() Table {
+	return TableLookup[`0070`]
+}
+
+// Table0074 is a value of table 0074, DIAGNOSTIC SERVICE SECTION ID.
+// Fields in this table are strings; convert a field, such as Table0074(v), to use the helpers.
+type Table0074 string
+
+const (
+	Table0074Audiology                     Table0074 = `AU`  // Audiology
+	Table0074BloodGases                    Table0074 = `BG`  // Blood gases
+	Table0074BloodBank                     Table0074 = `BLB` // Blood bank
+	Table0074Chemistry                     Table0074 = `CH`  // Chemistry
+	Table0074Cytopathology                 Table0074 = `CP`  // Cytopathology
+	Table0074CATScan                       Table0074 = `CT`  // CAT scan
+	Table0074CardiacCatheterization        Table0074 = `CTH` // Cardiac catheterization
+	Table0074CardiacUltrasound             Table0074 = `CUS` // Cardiac Ultrasound
+	Table0074ElectrocardiacEGEKGEECHolter  Table0074 = `EC`  // Electrocardiac (e.g., EKG, EEC, Holter)
+	Table0074ElectroneuroEEGEMG            Table0074 = `EN`  // Electroneuro (EEG, EMG)
+	Table0074Hematology                    Table0074 = `HM`  // Hematology
+	Table0074Immunology                    Table0074 = `IMM` // Immunology
+	Table0074Microbiology                  Table0074 = `MB`  // Microbiology
+	Table0074Mycobacteriology              Table0074 = `MCB` // Mycobacteriology
+	Table0074Mycology                      Table0074 = `MYC` // Mycology
+	Table0074NuclearMagneticResonance      Table0074 = `NMR` // Nuclear magnetic resonance
+	Table0074NuclearMedicineScan           Table0074 = `NMS` // Nuclear medicine scan
+	Table0074NursingServiceMeasures        Table0074 = `NRS` // Nursing service measures
+	Table0074OutsideLab                    Table0074 = `OSL` // Outside Lab
+	Table0074OccupationalTherapy           Table0074 = `OT`  // Occupational therapy
+	Table0074Other                         Table0074 = `OTH` // Other
+	Table0074OBUltrasound                  Table0074 = `OUS` // OB Ultrasound
+	Table0074PulmonaryFunction             Table0074 = `PF`  // Pulmonary function
+	Table0074Pharmacy                      Table0074 = `PHR` // Pharmacy
+	Table0074PhysicianHxDxAdmissionNoteEtc Table0074 = `PHY` // Physician (Hx, Dx, admission note, etc.)
+	Table0074PhysicalTherapy               Table0074 = `PT`  // Physical therapy
+	Table0074RespiratoryCareTherapy        Table0074 = `RC`  // Respiratory care (therapy)
+	Table0074RadiationTherapy              Table0074 = `RT`  // Radiation therapy
+	Table0074RadiologyUltrasound           Table0074 = `RUS` // Radiology ultrasound
+	Table0074Radiograph                    Table0074 = `RX`  // Radiograph
+	Table0074SurgicalPathology             Table0074 = `SP`  // Surgical Pathology
+	Table0074Serology                      Table0074 = `SR`  // Serology
+	Table0074Toxicology                    Table0074 = `TX`  // Toxicology
+	Table0074Virology                      Table0074 = `VR`  // Virology
+	Table0074VascularUltrasound            Table0074 = `VUS` // Vascular Ultrasound
+	Table0074Cineradiograph                Table0074 = `XRC` // Cineradiograph
+)
+
+// Description of the value, or empty if the value is not in table 0074.
+func (v Table0074) Description() string {
+	return tableDescription(`0074`, string(v))
+}
+
+// Valid reports if the value is in table 0074.
+func (v Table0074) Valid() bool {
+	return TableValueLookup[`0074`][string(v)]
+}
+
+// Table 0074.
+func (v Table0074) Table() Table {
+	return TableLookup[`0074`]
+}
+
+// Table0076 is a value of table 0076, MESSAGE TYPE.
+// Fields in this table are strings; convert a field, such as Table0076(v), to use the helpers.
+type Table0076 string
+
+const (
+	Table0076GeneralAcknowledgementMessage                Table0076 = `ACK` // General acknowledgement message
+	Table0076ADTResponse                                  Table0076 = `ADR` // ADT response
+	Table0076ADTMessage                                   Table0076 = `ADT` // ADT message
+	Table0076AncillaryReportDisplay                       Table0076 = `ARD` // Ancillary report (display)
+	Table0076AddChangeBillingAccount                      Table0076 = `BAR` // Add / change billing account
+	Table0076DetailFinancialTransaction                   Table0076 = `DFT` // Detail financial transaction
+	Table0076DisplayResponse                              Table0076 = `DSR` // Display response
+	Table0076DelayedAcknowledgement                       Table0076 = `MCF` // Delayed acknowledgement
+	Table0076MasterFilesDelayedApplicationAcknowledgement Table0076 = `MFD` // Master files delayed application acknowledgement
+	Table0076MasterFileApplicationAcknowledgement         Table0076 = `MFK` // Master file application acknowledgement
+	Table0076MasterFilesNotification                      Table0076 = `MFN` // Master files notification
+	Table0076MasterFilesResponse                          Table0076 = `MFR` // Master files response
+	Table0076NetworkManagementData                        Table0076 = `NMD` // Network management data
+	Table0076NetworkManagementQuery                       Table0076 = `NMQ` // Network management query
+	Table0076NetworkManagementResponse                    Table0076 = `NMR` // Network management response
+	Table0076ObservationalResultRecordResponse            Table0076 = `ORF` // Observational result (record response)
+	Table0076OrderMessage                                 Table0076 = `ORM` // Order message
+	Table0076OrderAcknowledgementMessage                  Table0076 = `ORR` // Order acknowledgement message
+	Table0076ObservationalResultUnsolicited               Table0076 = `ORU` // Observational result (unsolicited)
+	Table0076OrderStatusQuery                             Table0076 = `OSQ` // Order status query
+	Table0076Query                                        Table0076 = `QRY` // Query
+	Table0076PharmacyAdministrationInformation            Table0076 = `RAR` // Pharmacy administration information
+	Table0076PharmacyAdministrationMessage                Table0076 = `RAS` // Pharmacy administration message
+	Table0076PharmacyEncodedOrderMessage                  Table0076 = `RDE` // Pharmacy encoded order message
+	Table0076PharmacyDispenseInformation                  Table0076 = `RDR` // Pharmacy dispense information
+	Table0076PharmacyDispenseMessage                      Table0076 = `RDS` // Pharmacy dispense message
+	Table0076PharmacyEncodedOrderInformation              Table0076 = `RER` // Pharmacy encoded order information
+	Table0076PharmacyDoseInformation                      Table0076 = `RGR` // Pharmacy dose information
+	Table0076PharmacyGiveMessage                          Table0076 = `RGV` // Pharmacy give message
+	Table0076PharmacyPrescriptionOrderResponse            Table0076 = `ROR` // Pharmacy prescription order response
+	Table0076PharmacyAdministrationAcknowledgment         Table0076 = `RRA` // Pharmacy administration acknowledgment
+	Table0076PharmacyDispenseAcknowledgment               Table0076 = `RRD` // Pharmacy dispense acknowledgment
+	Table0076PharmacyEncodedOrderAcknowledgment           Table0076 = `RRE` // Pharmacy encoded order acknowledgment
+	Table0076PharmacyGiveAcknowledgment                   Table0076 = `RRG` // Pharmacy give acknowledgment
+	Table0076UnsolicitedDisplayMessage                    Table0076 = `UDM` // Unsolicited display message
+)
+
+// Description of the value, or empty if the value is not in table 0076.
+func (v Table0076) Description() string {
+	return tableDescription(`0076`, string(v))
+}
+
+// Valid reports if the value is in table 0076.
+func (v Table0076) Valid() bool {
+	return TableValueLookup[`0076`][string(v)]
+}
+
+// Table 0076.
+func (v Table0076) Table() Table {
+	return TableLookup[`0076`]
+}
+
+// Table0078 is a value of table 0078, ABNORMAL FLAGS.
+// Fields in this table are strings; convert a field, such as Table0078(v), to use the helpers.
+type Table0078 string
+
+const (
+	Table0078BelowAbsoluteLowOffInstrumentScale  Table0078 = `<`    // Below absolute low-off instrument scale
+	Table0078AboveAbsoluteHighOffInstrumentScale Table0078 = `>`    // Above absolute high-off instrument scale
+	Table0078AbnormalAppliesToNonNumericResults  Table0078 = `A`    // Abnormal (applies to non-numeric results)
+	Table0078VeryAbnormalAppliesToNonNumeric     Table0078 = `AA`   // Very abnormal (applies to non-numeric units, analogous to panic limits for numerics limits)
+	Table0078BetterUseWhenDirectionNotRelevant   Table0078 = `B`    // Better (use when direction not relevant)
+	Table0078SignificantChangeDown               Table0078 = `D`    // Significant change down
+	Table0078AboveHighNormal                     Table0078 = `H`    // Above high normal
+	Table0078AboveUpperPanicLimits               Table0078 = `HH`   // Above upper panic limits
+	Table0078Intermediate                        Table0078 = `I`    // Intermediate
+	Table0078BelowLowNormal                      Table0078 = `L`    // Below low normal
+	Table0078BelowLowerPanicLimits               Table0078 = `LL`   // Below lower panic limits
+	Table0078ModeratelySensitive                 Table0078 = `MS`   // Moderately sensitive
+	Table0078NormalAppliesToNonNumericResults    Table0078 = `N`    // Normal (applies to non-numeric results)
+	Table0078NoRangeDefinedOrNormalRanges        Table0078 = `null` // No range defined, or normal ranges don't apply
+	Table0078Resistant                           Table0078 = `R`    // Resistant
+	Table0078Sensitive                           Table0078 = `S`    // Sensitive
+	Table0078SignificantChangeUp                 Table0078 = `U`    // Significant change up
+	Table0078VerySensitive                       Table0078 = `VS`   // Very sensitive
+	Table0078WorseUseWhenDirectionNotRelevant    Table0078 = `W`    // Worse (use when direction not relevant)
+)
+
+// Description of the value, or empty if the value is not in table 0078.
+func (v Table0078) Description() string {
+	return tableDescription(`0078`, string(v))
+}
+
+// Valid reports if the value is in table 0078.
+func (v Table0078) Valid() bool {
+	return TableValueLookup[`0078`][string(v)]
+}
+
+// Table 0078.
+func (v Table0078) Table() Table {
+	return TableLookup[`0078`]
+}
+
+// Table0080 is a value of table 0080, NATURE OF ABNORMAL TESTING.
+// Fields in this table are strings; convert a field, such as Table0080(v), to use the helpers.
+type Table0080 string
+
+const (
+	Table0080AnAgeBasedPopulation   Table0080 = `A` // An age-based population
+	Table0080NoneGenericNormalRange Table0080 = `N` // None - generic normal range
+	Table0080ARaceBasedPopulation   Table0080 = `R` // A race-based population
+	Table0080ASexBasedPopulation    Table0080 = `S` // A sex-based population
+)
+
+// Description of the value, or empty if the value is not in table 0080.
+func (v Table0080) Description() string {
+	return tableDescription(`0080`, string(v))
+}
+
+// Valid reports if the value is in table 0080.
+func (v Table0080) Valid() bool {
+	return TableValueLookup[`0080`][string(v)]
+}
+
+// Table 0080.
+func (v Table0080) Table() Table {
+	return TableLookup[`0080`]
+}
+
+// Table0085 is a value of table 0085, OBSERVATION RESULT STATUS CODES INTERPRETATION.
+// Fields in this table are strings; convert a field, such as Table0085(v), to use the helpers.
+type Table0085 string
+
+const (
+	Table0085RecordComingOverIsACorrection     Table0085 = `C` // Record coming over is a correction and thus replaces a result
+	Table0085DeletesTheOBXRecord               Table0085 = `D` // Deletes the OBX record
+	Table0085FinalResultsCanOnlyBeChanged      Table0085 = `F` // Final results (can only be changed with a corrected result)
+	Table0085SpecimenInLabResultsPending       Table0085 = `I` // Specimen in lab - results pending
+	Table0085PreliminaryResults                Table0085 = `P` // Preliminary results
+	Table0085ResultsEnteredNotVerified         Table0085 = `R` // Results entered - not verified
+	Table0085PartialResults                    Table0085 = `S` // Partial results
+	Table0085ResultsStatusChangeToFinalResults Table0085 = `U` // Results status change to Final - results did not change ( don't transmit test)
+	Table0085ResultsCannotBeObtainedForThis    Table0085 = `X` // Results cannot be obtained for this observation
+)
+
+// Description of the value, or empty if the value is not in table 0085.
+func (v Table0085) Description() string {
+	return tableDescription(`0085`, string(v))
+}
+
+// Valid reports if the value is in table 0085.
+func (v Table0085) Valid() bool {
+	return TableValueLookup[`0085`][string(v)]
+}
+
+// Table 0085.
+func (v Table0085) Table() Table {
+	return TableLookup[`0085`]
+}
+
+// Table0091 is a value of table 0091, QUERY PRIORITY.
+// Fields in this table are strings; convert a field, such as Table0091(v), to use the helpers.
+type Table0091 string
+
+const (
+	Table0091Deferred  Table0091 = `D` // Deferred
+	Table0091Immediate Table0091 = `I` // Immediate
+)
+
+// Description of the value, or empty if the value is not in table 0091.
+func (v Table0091) Description() string {
+	return tableDescription(`0091`, string(v))
+}
+
+// Valid reports if the value is in table 0091.
+func (v Table0091) Valid() bool {
+	return TableValueLookup[`0091`][string(v)]
+}
+
+// Table 0091.
+func (v Table0091) Table() Table {
+	return TableLookup[`0091`]
+}
+
+// Table0092 is a value of table 0092, RE-ADMISSION INDICATOR.
+// Fields in this table are strings; convert a field, such as Table0092(v), to use the helpers.
+type Table0092 string
+
+const (
+	Table0092Readmission Table0092 = `R` // Readmission
+)
+
+// Description of the value, or empty if the value is not in table 0092.
+func (v Table0092) Description() string {
+	return tableDescription(`0092`, string(v))
+}
+
+// Valid reports if the value is in table 0092.
+func (v Table0092) Valid() bool {
+	return TableValueLookup[`0092`][string(v)]
+}
+
+// Table 0092.
+func (v Table0092) Table() Table {
+	return TableLookup[`0092`]
+}
+
+// Table0093 is a value of table 0093, RELEASE OF INFORMATION.
+// Fields in this table are strings; convert a field, such as Table0093(v), to use the helpers.
+type Table0093 string
+
+const (
+	Table0093No  Table0093 = `N` // No
+	Table0093Yes Table0093 = `Y` // Yes
+)
+
+// Description of the value, or empty if the value is not in table 0093.
+func (v Table0093) Description() string {
+	return tableDescription(`0093`, string(v))
+}
+
+// Valid reports if the value is in table 0093.
+func (v Table0093) Valid() bool {
+	return TableValueLookup[`0093`][string(v)]
+}
+
+// Table 0093.
+func (v Table0093) Table() Table {
+	return TableLookup[`0093`]
+}
+
+// Table0100 is a value of table 0100, WHEN TO CHARGE.
+// Fields in this table are strings; convert a field, such as Table0100(v), to use the helpers.
+type Table0100 string
+
+const (
+	Table0100OnDischarge              Table0100 = `D` // On discharge
+	Table0100OnReceiptOfOrder         Table0100 = `O` // On receipt of order
+	Table0100AtTimeServiceIsCompleted Table0100 = `R` // At time service is completed
+	Table0100AtTimeServiceIsStarted   Table0100 = `S` // At time service is started
+	Table0100AtADesignatedDateTime    Table0100 = `T` // At a designated date / time
+)
+
+// Description of the value, or empty if the value is not in table 0100.
+func (v Table0100) Description() string {
+	return tableDescription(`0100`, string(v))
+}
+
+// Valid reports if the value is in table 0100.
+func (v Table0100) Valid() bool {
+	return TableValueLookup[`0100`][string(v)]
+}
+
+// Table 0100.
+func (v Table0100) Table() Table {
+	return TableLookup[`0100`]
+}
+
+// Table0102 is a value of table 0102, DELAYED ACKNOWLEDGMENT TYPE.
+// Fields in this table are strings; convert a field, such as Table0102(v), to use the helpers.
+type Table0102 string
+
+const (
+	Table0102MessageReceivedStoredForLaterProcessing Table0102 = `D` // Message Received, stored for later processing
+	Table0102AcknowledgementAfterProcessing          Table0102 = `F` // Acknowledgement after processing
+)
+
+// Description of the value, or empty if the value is not in table 0102.
+func (v Table0102) Description() string {
+	return tableDescription(`0102`, string(v))
+}
+
+// Valid reports if the value is in table 0102.
+func (v Table0102) Valid() bool {
+	return TableValueLookup[`0102`][string(v)]
+}
+
+// Table 0102.
+func (v Table0102) Table() Table {
+	return TableLookup[`0102`]
+}
+
+// Table0103 is a value of table 0103, PROCESSING ID.
+// Fields in this table are strings; convert a field, such as Table0103(v), to use the helpers.
+type Table0103 string
+
+const (
+	Table0103Debugging  Table0103 = `D` // Debugging
+	Table0103Production Table0103 = `P` // Production
+	Table0103Training   Table0103 = `T` // Training
+)
+
+// Description of the value, or empty if the value is not in table 0103.
+func (v Table0103) Description() string {
+	return tableDescription(`0103`, string(v))
+}
+
+// Valid reports if the value is in table 0103.
+func (v Table0103) Valid() bool {
+	return TableValueLookup[`0103`][string(v)]
+}
+
+// Table 0103.
+func (v Table0103) Table() Table {
+	return TableLookup[`0103`]
+}
+
+// Table0104 is a value of table 0104, VERSION ID.
+// Fields in this table are strings; convert a field, such as Table0104(v), to use the helpers.
+type Table0104 string
+
+const (
+	Table0104Version20September1988 Table0104 = `2.0`  // Version 2.0, September 1988
+	Table0104Demo20October1988      Table0104 = `2.0D` // Demo    2.0  October 1988
+	Table0104Release21March1990     Table0104 = `2.1`  // Release 2.1  March 1990
+	Table0104Release22December1994  Table0104 = `2.2`  // Release 2.2  December 1994
+)
+
+// Description of the value, or empty if the value is not in table 0104.
+func (v Table0104) Description() string {
+	return tableDescription(`0104`, string(v))
+}
+
+// Valid reports if the value is in table 0104.
+func (v Table0104) Valid() bool {
+	return TableValueLookup[`0104`][string(v)]
+}
+
+// Table 0104.
+func (v Table0104) Table() Table {
+	return TableLookup[`0104`]
+}
+
+// Table0105 is a value of table 0105, SOURCE OF COMMENT.
+// Fields in this table are strings; convert a field, such as Table0105(v), to use the helpers.
+type Table0105 string
+
+const (
+	Table0105AncillaryFillerDepartmentIsSourceOf Table0105 = `L` // Ancillary (filler) department is source of comment
+	Table0105OtherSystemIsSourceOfComment        Table0105 = `O` // Other system is source of comment
+	Table0105OrdererPlacerIsSourceOfComment      Table0105 = `P` // Orderer (placer) is source of comment
+)
+
+// Description of the value, or empty if the value is not in table 0105.
+func (v Table0105) Description() string {
+	return tableDescription(`0105`, string(v))
+}
+
+// Valid reports if the value is in table 0105.
+func (v Table0105) Valid() bool {
+	return TableValueLookup[`0105`][string(v)]
+}
+
+// Table 0105.
+func (v Table0105) Table() Table {
+	return TableLookup[`0105`]
+}
+
+// Table0106 is a value of table 0106, QUERY FORMAT CODE.
+// Fields in this table are strings; convert a field, such as Table0106(v), to use the helpers.
+type Table0106 string
+
+const (
+	Table0106ResponseIsInDisplayFormat        Table0106 = `D` // Response is in display format
+	Table0106ResponseIsInRecordOrientedFormat Table0106 = `R` // Response is in record-oriented format
+)
+
+// Description of the value, or empty if the value is not in table 0106.
+func (v Table0106) Description() string {
+	return tableDescription(`0106`, string(v))
+}
+
+// Valid reports if the value is in table 0106.
+func (v Table0106) Valid() bool {
+	return TableValueLookup[`0106`][string(v)]
+}
+
+// Table 0106.
+func (v Table0106) Table() Table {
+	return TableLookup[`0106`]
+}
+
+// Table0107 is a value of table 0107, DEFERRED RESPONSE TYPE.
+// Fields in this table are strings; convert a field, such as Table0107(v), to use the helpers.
+type Table0107 string
+
+const (
+	Table0107BeforeTheDateTimeSpecified    Table0107 = `B` // Before the date / time specified
+	Table0107LaterThanTheDateTimeSpecified Table0107 = `L` // Later than the date / time specified
+)
+
+// Description of the value, or empty if the value is not in table 0107.
+func (v Table0107) Description() string {
+	return tableDescription(`0107`, string(v))
+}
+
+// Valid reports if the value is in table 0107.
+func (v Table0107) Valid() bool {
+	return TableValueLookup[`0107`][string(v)]
+}
+
+// Table 0107.
+func (v Table0107) Table() Table {
+	return TableLookup[`0107`]
+}
+
+// Table0108 is a value of table 0108, QUERY RESULTS LEVEL.
+// Fields in this table are strings; convert a field, such as Table0108(v), to use the helpers.
+type Table0108 string
+
+const (
+	Table0108OrderPlusOrderStatus   Table0108 = `O` // Order plus order status
+	Table0108ResultsWithoutBulkText Table0108 = `R` // Results without bulk text
+	Table0108StatusOnly             Table0108 = `S` // Status only
+	Table0108FullResults            Table0108 = `T` // Full results
+)
+
+// Description of the value, or empty if the value is not in table 0108.
+func (v Table0108) Description() string {
+	return tableDescription(`0108`, string(v))
+}
+
+// Valid reports if the value is in table 0108.
+func (v Table0108) Valid() bool {
+	return TableValueLookup[`0108`][string(v)]
+}
+
+// Table 0108.
+func (v Table0108) Table() Table {
+	return TableLookup[`0108`]
+}
+
+// Table0109 is a value of table 0109, REPORT PRIORITY.
+// Fields in this table are strings; convert a field, such as Table0109(v), to use the helpers.
+type Table0109 string
+
+const (
+	Table0109Routine Table0109 = `R` // Routine
+	Table0109Stat    Table0109 = `S` // Stat
+)
+
+// Description of the value, or empty if the value is not in table 0109.
+func (v Table0109) Description() string {
+	return tableDescription(`0109`, string(v))
+}
+
+// Valid reports if the value is in table 0109.
+func (v Table0109) Valid() bool {
+	return TableValueLookup[`0109`][string(v)]
+}
+
+// Table 0109.
+func (v Table0109) Table() Table {
+	return TableLookup[`0109`]
+}
+
+// Table0116 is a value of table 0116, BED STATUS.
+// Fields in this table are strings; convert a field, such as Table0116(v), to use the helpers.
+type Table0116 string
+
+const (
+	Table0116Closed       Table0116 = `C` // Closed
+	Table0116Housekeeping Table0116 = `H` // Housekeeping
+	Table0116Isolated     Table0116 = `I` // Isolated
+	Table0116Contaminated Table0116 = `K` // Contaminated
+	Table0116Occupied     Table0116 = `O` // Occupied
+	Table0116Unoccupied   Table0116 = `U` // Unoccupied
+)
+
+// Description of the value, or empty if the value is not in table 0116.
+func (v Table0116) Description() string {
+	return tableDescription(`0116`, string(v))
+}
+
+// Valid reports if the value is in table 0116.
+func (v Table0116) Valid() bool {
+	return TableValueLookup[`0116`][string(v)]
+}
+
+// Table 0116.
+func (v Table0116) Table() Table {
+	return TableLookup[`0116`]
+}
+
+// Table0119 is a value of table 0119, ORDER CONTROL.
+// Fields in this table are strings; convert a field, such as Table0119(v), to use the helpers.
+type Table0119 string
+
+const (
+	Table0119CancelOrderRequest               Table0119 = `CA` // Cancel order request
+	Table0119ChildOrder                       Table0119 = `CH` // Child order
+	Table0119CombinedResult                   Table0119 = `CN` // Combined result
+	Table0119CanceledAsRequested              Table0119 = `CR` // Canceled as requested
+	Table0119DiscontinueOrderRequest          Table0119 = `DC` // Discontinue order request
+	Table0119DataErrors                       Table0119 = `DE` // Data Errors
+	Table0119DiscontinuedAsRequested          Table0119 = `DR` // Discontinued as requested
+	Table0119HoldOrderRequest                 Table0119 = `HD` // Hold order request
+	Table0119OnHoldAsRequested                Table0119 = `HR` // On hold as requested
+	Table0119NumberAssigned                   Table0119 = `NA` // Number assigned
+	Table0119NewOrder                         Table0119 = `NW` // New Order
+	Table0119OrderCanceled                    Table0119 = `OC` // Order canceled
+	Table0119OrderDiscontinued                Table0119 = `OD` // Order discontinued
+	Table0119OrderHeld                        Table0119 = `OH` // Order held
+	Table0119OrderAcceptedAndOK               Table0119 = `OK` // Order accepted and OK
+	Table0119ReleasedAsRequested              Table0119 = `OR` // Released as requested
+	Table0119ParentOrder                      Table0119 = `PA` // Parent order
+	Table0119ObservationsToFollow             Table0119 = `RE` // Observations to follow
+	Table0119ReleasePreviousHold              Table0119 = `RL` // Release previous hold
+	Table0119ReplacementOrder                 Table0119 = `RO` // Replacement order
+	Table0119OrderReplaceRequest              Table0119 = `RP` // Order replace request
+	Table0119ReplacedAsRequested              Table0119 = `RQ` // Replaced as requested
+	Table0119RequestReceived                  Table0119 = `RR` // Request received
+	Table0119ReplacedUnsolicited              Table0119 = `RU` // Replaced unsolicited
+	Table0119StatusChanged                    Table0119 = `SC` // Status changed
+	Table0119SendOrderNumber                  Table0119 = `SN` // Send order number
+	Table0119ResponseToSendOrderStatusRequest Table0119 = `SR` // Response to send order status request
+	Table0119SendOrderStatusRequest           Table0119 = `SS` // Send order status request
+	Table0119UnableToCancel                   Table0119 = `UC` // Unable to cancel
+	Table0119UnableToDiscontinue              Table0119 = `UD` // Unable to discontinue
+	Table0119UnableToPutOnHold                Table0119 = `UH` // Unable to put on hold
+	Table0119UnableToReplace                  Table0119 = `UM` // Unable to replace
+	Table0119UnableToRelease                  Table0119 = `UR` // Unable to release
+	Table0119UnableToChange                   Table0119 = `UX` // Unable to change
+	Table0119ChangeOrderRequest               Table0119 = `XO` // Change order request
+	Table0119ChangedAsRequested               Table0119 = `XR` // Changed as requested
+	Table0119OrderChangedUnsolicited          Table0119 = `XX` // Order changed, unsolicited
+)
+
+// Description of the value, or empty if the value is not in table 0119.
+func (v Table0119) Description() string {
+	return tableDescription(`0119`, string(v))
+}
+
+// Valid reports if the value is in table 0119.
+func (v Table0119) Valid() bool {
+	return TableValueLookup[`0119`][string(v)]
+}
+
+// Table 0119.
+func (v Table0119) Table() Table {
+	return TableLookup[`0119`]
+}
+
+// Table0121 is a value of table 0121, RESPONSE FLAG.
+// Fields in this table are strings; convert a field, such as Table0121(v), to use the helpers.
+type Table0121 string
+
+const (
+	Table0121SameAsRAlsoOtherAssociated         Table0121 = `D` // Same as R, also other associated segments
+	Table0121ReportExceptionsOnly               Table0121 = `E` // Report exceptions only
+	Table0121SameAsDPlusConfirmationsExplicitly Table0121 = `F` // Same as D, plus confirmations explicitly
+	Table0121OnlyTheMSASegmentIsReturned        Table0121 = `N` // Only the MSA segment is returned
+	Table0121SameAsEAlsoReplacementAnd          Table0121 = `R` // Same as E, also Replacement and Parent-Child
+)
+
+// Description of the value, or empty if the value is not in table 0121.
+func (v Table0121) Description() string {
+	return tableDescription(`0121`, string(v))
+}
+
+// Valid reports if the value is in table 0121.
+func (v Table0121) Valid() bool {
+	return TableValueLookup[`0121`][string(v)]
+}
+
+// Table 0121.
+func (v Table0121) Table() Table {
+	return TableLookup[`0121`]
+}
+
+// Table0122 is a value of table 0122, CHARGE TYPE.
+// Fields in this table are strings; convert a field, such as Table0122(v), to use the helpers.
+type Table0122 string
+
+const (
+	Table0122Charge       Table0122 = `CH` // Charge
+	Table0122Contract     Table0122 = `CO` // Contract
+	Table0122Credit       Table0122 = `CR` // Credit
+	Table0122Department   Table0122 = `DP` // Department
+	Table0122Grant        Table0122 = `GR` // Grant
+	Table0122NoCharge     Table0122 = `NC` // No Charge
+	Table0122Professional Table0122 = `PC` // Professional
+	Table0122Research     Table0122 = `RS` // Research
+)
+
+// Description of the value, or empty if the value is not in table 0122.
+func (v Table0122) Description() string {
+	return tableDescription(`0122`, string(v))
+}
+
+// Valid reports if the value is in table 0122.
+func (v Table0122) Valid() bool {
+	return TableValueLookup[`0122`][string(v)]
+}
+
+// Table 0122.
+func (v Table0122) Table() Table {
+	return TableLookup[`0122`]
+}
+
+// Table0123 is a value of table 0123, RESULT STATUS - OBR.
+// Fields in this table are strings; convert a field, such as Table0123(v), to use the helpers.
+type Table0123 string
+
+const (
+	Table0123CorrectionToResults                     Table0123 = `C` // Correction to results
+	Table0123FinalResultsResultsStoredVerified       Table0123 = `F` // Final results - results stored & verified
+	Table0123SpecimenInLabNotYetProcessed            Table0123 = `I` // Specimen in lab, not yet processed.
+	Table0123OrderReceivedSpecimenNotYetReceived     Table0123 = `O` // Order received; specimen not yet received
+	Table0123PreliminaryAVerifiedEarlyResultIs       Table0123 = `P` // Preliminary: A verified early result is available, final results not yet obtained
+	Table0123ResultsStoredNotYetVerified             Table0123 = `R` // Results stored; not yet verified
+	Table0123NoResultsAvailableProcedureScheduledBut Table0123 = `S` // No results available; procedure scheduled, but not done
+	Table0123NoResultsAvailableOrderCanceled         Table0123 = `X` // No results available; Order canceled.
+	Table0123NoOrderOnRecordForThis                  Table0123 = `Y` // No order on record for this test.  (Used only on queries)
+	Table0123NoRecordOfThisPatientUsed               Table0123 = `Z` // No record of this patient. (Used only on queries)
+)
+
+// Description of the value, or empty if the value is not in table 0123.
+func (v Table0123) Description() string {
+	return tableDescription(`0123`, string(v))
+}
+
+// Valid reports if the value is in table 0123.
+func (v Table0123) Valid() bool {
+	return TableValueLookup[`0123`][string(v)]
+}
+
+// Table 0123.
+func (v Table0123) Table() Table {
+	return TableLookup[`0123`]
+}
+
+// Table0124 is a value of table 0124, TRANSPORTATION MODE.
+// Fields in this table are strings; convert a field, such as Table0124(v), to use the helpers.
+type Table0124 string
+
+const (
+	Table0124CartPatientTravelsOnCartOr      Table0124 = `CART` // Cart - patient travels on cart or gurney
+	Table0124TheExaminingDeviceGoesToPatient Table0124 = `PORT` // The examining device goes to patient's location
+	Table0124PatientWalksToDiagnosticService Table0124 = `WALK` // Patient walks to diagnostic service
+	Table0124Wheelchair                      Table0124 = `WHLC` // Wheelchair
+)
+
+// Description of the value, or empty if the value is not in table 0124.
+func (v Table0124) Description() string {
+	return tableDescription(`0124`, string(v))
+}
+
+// Valid reports if the value is in table 0124.
+func (v Table0124) Valid() bool {
+	return TableValueLookup[`0124`][string(v)]
+}
+
+// Table 0124.
+func (v Table0124) Table() Table {
+	return TableLookup[`0124`]
+}
+
+// Table0125 is a value of table 0125, VALUE TYPE.
+// Fields in this table are strings; convert a field, such as Table0125(v), to use the helpers.
+type Table0125 string
+
+const (
+	Table0125Address                         Table0125 = `AD` // Address
+	Table0125CodedElement                    Table0125 = `CE` // Coded element
+	Table0125CodedElementWithFormattedValues Table0125 = `CF` // Coded element with formatted values
+	Table0125CompositeIDWithCheckDigit       Table0125 = `CK` // Composite ID with check digit
+	Table0125Composite                       Table0125 = `CM` // Composite
+	Table0125CompositeIDAndName              Table0125 = `CN` // Composite ID and name
+	Table0125CompositeQuantityWithUnits      Table0125 = `CQ` // Composite quantity with units
+	Table0125Date                            Table0125 = `DT` // Date
+	Table0125FormattedTextDisplay            Table0125 = `FT` // Formatted text (display)
+	Table0125CodedValue                      Table0125 = `ID` // Coded value
+	Table0125Money                           Table0125 = `MO` // Money
+	Table0125Numeric                         Table0125 = `NM` // Numeric
+	Table0125PersonName                      Table0125 = `PN` // Person name
+	Table0125ReferencePointer                Table0125 = `RP` // Reference pointer
+	Table0125SequenceID                      Table0125 = `SI` // Sequence ID
+	Table0125StringData                      Table0125 = `ST` // String data
+	Table0125Time                            Table0125 = `TM` // Time
+	Table0125TelephoneNumber                 Table0125 = `TN` // Telephone number
+	Table0125TimingQuantity                  Table0125 = `TQ` // Timing / quantity
+	Table0125TimeStampDateTime               Table0125 = `TS` // Time stamp ( date & time)
+	Table0125TextDataDisplay                 Table0125 = `TX` // Text data (display)
+)
+
+// Description of the value, or empty if the value is not in table 0125.
+func (v Table0125) Description() string {
+	return tableDescription(`0125`, string(v))
+}
+
+// Valid reports if the value is in table 0125.
+func (v Table0125) Valid() bool {
+	return TableValueLookup[`0125`][string(v)]
+}
+
+// Table 0125.
+func (v Table0125) Table() Table {
+	return TableLookup[`0125`]
+}
+
+// Table0127 is a value of table 0127, ALLERGY TYPE.
+// Fields in this table are strings; convert a field, such as Table0127(v), to use the helpers.
+type Table0127 string
+
+const (
+	Table0127DrugAllergy                   Table0127 = `DA` // Drug Allergy
+	Table0127FoodAllergy                   Table0127 = `FA` // Food Allergy
+	Table0127MiscellaneousAllergy          Table0127 = `MA` // Miscellaneous Allergy
+	Table0127MiscellaneousContraindication Table0127 = `MC` // Miscellaneous Contraindication
+)
+
+// Description of the value, or empty if the value is not in table 0127.
+func (v Table0127) Description() string {
+	return tableDescription(`0127`, string(v))
+}
+
+// Valid reports if the value is in table 0127.
+func (v Table0127) Valid() bool {
+	return TableValueLookup[`0127`][string(v)]
+}
+
+// Table 0127.
+func (v Table0127) Table() Table {
+	return TableLookup[`0127`]
+}
+
+// Table0128 is a value of table 0128, ALLERGY SEVERITY.
+// Fields in this table are strings; convert a field, such as Table0128(v), to use the helpers.
+type Table0128 string
+
+const (
+	Table0128Mild     Table0128 = `MI` // Mild
+	Table0128Moderate Table0128 = `MO` // Moderate
+	Table0128Severe   Table0128 = `SV` // Severe
+)
+
+// Description of the value, or empty if the value is not in table 0128.
+func (v Table0128) Description() string {
+	return tableDescription(`0128`, string(v))
+}
+
+// Valid reports if the value is in table 0128.
+func (v Table0128) Valid() bool {
+	return TableValueLookup[`0128`][string(v)]
+}
+
+// Table 0128.
+func (v Table0128) Table() Table {
+	return TableLookup[`0128`]
+}
+
+// Table0135 is a value of table 0135, ASSIGNMENT OF BENEFITS.
+// Fields in this table are strings; convert a field, such as Table0135(v), to use the helpers.
+type Table0135 string
+
+const (
+	Table0135ModifiedAssignment Table0135 = `M` // Modified assignment
+	Table0135No                 Table0135 = `N` // No
+	Table0135Yes                Table0135 = `Y` // Yes
+)
+
+// Description of the value, or empty if the value is not in table 0135.
+func (v Table0135) Description() string {
+	return tableDescription(`0135`, string(v))
+}
+
+// Valid reports if the value is in table 0135.
+func (v Table0135) Valid() bool {
+	return TableValueLookup[`0135`][string(v)]
+}
+
+// Table 0135.
+func (v Table0135) Table() Table {
+	return TableLookup[`0135`]
+}
+
+// Table0136 is a value of table 0136, Y/N INDICATOR.
+// Fields in this table are strings; convert a field, such as Table0136(v), to use the helpers.
+type Table0136 string
+
+const (
+	Table0136No  Table0136 = `N` // No
+	Table0136Yes Table0136 = `Y` // Yes
+)
+
+// Description of the value, or empty if the value is not in table 0136.
+func (v Table0136) Description() string {
+	return tableDescription(`0136`, string(v))
+}
+
+// Valid reports if the value is in table 0136.
+func (v Table0136) Valid() bool {
+	return TableValueLookup[`0136`][string(v)]
+}
+
+// Table 0136.
+func (v Table0136) Table() Table {
+	return TableLookup[`0136`]
+}
+
+// Table0137 is a value of table 0137, MAIL CLAIM PARTY.
+// Fields in this table are strings; convert a field, such as Table0137(v), to use the helpers.
+type Table0137 string
+
+const (
+	Table0137Employer         Table0137 = `E` // Employer
+	Table0137Guarantor        Table0137 = `G` // Guarantor
+	Table0137InsuranceCompany Table0137 = `I` // Insurance Company
+	Table0137Other            Table0137 = `O` // Other
+	Table0137Patient          Table0137 = `P` // Patient
+)
+
+// Description of the value, or empty if the value is not in table 0137.
+func (v Table0137) Description() string {
+	return tableDescription(`0137`, string(v))
+}
+
+// Valid reports if the value is in table 0137.
+func (v Table0137) Valid() bool {
+	return TableValueLookup[`0137`][string(v)]
+}
+
+// Table 0137.
+func (v Table0137) Table() Table {
+	return TableLookup[`0137`]
+}
+
+// Table0144 is a value of table 0144, ELIGIBILITY SOURCE.
+// Fields in this table are strings; convert a field, such as Table0144(v), to use the helpers.
+type Table0144 string
+
+const (
+	Table0144InsuranceCompany       Table0144 = `1` // Insurance Company
+	Table0144Employer               Table0144 = `2` // Employer
+	Table0144InsuredPresentedPolicy Table0144 = `3` // Insured Presented Policy
+	Table0144InsuredPresentedCard   Table0144 = `4` // Insured Presented Card
+	Table0144SignedStatementOnFile  Table0144 = `5` // Signed Statement on File
+	Table0144VerbalInformation      Table0144 = `6` // Verbal Information
+	Table0144None                   Table0144 = `7` // None
+)
+
+// Description of the value, or empty if the value is not in table 0144.
+func (v Table0144) Description() string {
+	return tableDescription(`0144`, string(v))
+}
+
+// Valid reports if the value is in table 0144.
+func (v Table0144) Valid() bool {
+	return TableValueLookup[`0144`][string(v)]
+}
+
+// Table 0144.
+func (v Table0144) Table() Table {
+	return TableLookup[`0144`]
+}
+
+// Table0145 is a value of table 0145, ROOM TYPE.
+// Fields in this table are strings; convert a field, such as Table0145(v), to use the helpers.
+type Table0145 string
+
+const (
+	Table0145SecondIntensiveCareUnit Table0145 = `2ICU` // Second Intensive Care Unit
+	Table0145SecondPrivateRoom       Table0145 = `2PRI` // Second Private Room
+	Table0145SecondSemiPrivateRoom   Table0145 = `2SPR` // Second Semi-private Room
+	Table0145IntensiveCareUnit       Table0145 = `ICU`  // Intensive Care Unit
+	Table0145PrivateRoom             Table0145 = `PRI`  // Private Room
+	Table0145SemiPrivateRoom         Table0145 = `SPR`  // Semi-private Room
+)
+
+// Description of the value, or empty if the value is not in table 0145.
+func (v Table0145) Description() string {
+	return tableDescription(`0145`, string(v))
+}
+
+// Valid reports if the value is in table 0145.
+func (v Table0145) Valid() bool {
+	return TableValueLookup[`0145`][string(v)]
+}
+
+// Table 0145.
+func (v Table0145) Table() Table {
+	return TableLookup[`0145`]
+}
+
+// Table0146 is a value of table 0146, AMOUNT TYPE.
+// Fields in this table are strings; convert a field, such as Table0146(v), to use the helpers.
+type Table0146 string
+
+const (
+	Table0146Differential Table0146 = `DF` // Differential
+	Table0146Limit        Table0146 = `LM` // Limit
+	Table0146Percentage   Table0146 = `PC` // Percentage
+	Table0146Rate         Table0146 = `RT` // Rate
+	Table0146Unlimited    Table0146 = `UL` // Unlimited
+)
+
+// Description of the value, or empty if the value is not in table 0146.
+func (v Table0146) Description() string {
+	return tableDescription(`0146`, string(v))
+}
+
+// Valid reports if the value is in table 0146.
+func (v Table0146) Valid() bool {
+	return TableValueLookup[`0146`][string(v)]
+}
+
+// Table 0146.
+func (v Table0146) Table() Table {
+	return TableLookup[`0146`]
+}
+
+// Table0147 is a value of table 0147, POLICY TYPE.
+// Fields in this table are strings; convert a field, such as Table0147(v), to use the helpers.
+type Table0147 string
+
+const (
+	Table0147SecondAncillary    Table0147 = `2ANC` // Second Ancillary
+	Table0147SecondMajorMedical Table0147 = `2MMD` // Second Major Medical
+	Table0147ThirdMajorMedical  Table0147 = `3MMD` // Third Major Medical
+	Table0147Ancillary          Table0147 = `ANC`  // Ancillary
+	Table0147MajorMedical       Table0147 = `MMD`  // Major Medical
+)
+
+// Description of the value, or empty if the value is not in table 0147.
+func (v Table0147) Description() string {
+	return tableDescription(`0147`, string(v))
+}
+
+// Valid reports if the value is in table 0147.
+func (v Table0147) Valid() bool {
+	return TableValueLookup[`0147`][string(v)]
+}
+
+// Table 0147.
+func (v Table0147) Table() Table {
+	return TableLookup[`0147`]
+}
+
+// Table0148 is a value of table 0148, PENALTY TYPE.
+// Fields in this table are strings; convert a field, such as Table0148(v), to use the helpers.
+type Table0148 string
+
+const (
+	Table0148CurrencyAmount Table0148 = `AT` // Currency Amount
+	Table0148Percentage     Table0148 = `PC` // Percentage
+)
+
+// Description of the value, or empty if the value is not in table 0148.
+func (v Table0148) Description() string {
+	return tableDescription(`0148`, string(v))
+}
+
+// Valid reports if the value is in table 0148.
+func (v Table0148) Valid() bool {
+	return TableValueLookup[`0148`][string(v)]
+}
+
+// Table 0148.
+func (v Table0148) Table() Table {
+	return TableLookup[`0148`]
+}
+
+// Table0149 is a value of table 0149, DAY TYPE.
+// Fields in this table are strings; convert a field, such as Table0149(v), to use the helpers.
+type Table0149 string
+
+const (
+	Table0149Approved Table0149 = `AP` // Approved
+	Table0149Denied   Table0149 = `DE` // Denied
+	Table0149Pending  Table0149 = `PE` // Pending
+)
+
+// Description of the value, or empty if the value is not in table 0149.
+func (v Table0149) Description() string {
+	return tableDescription(`0149`, string(v))
+}
+
+// Valid reports if the value is in table 0149.
+func (v Table0149) Valid() bool {
+	return TableValueLookup[`0149`][string(v)]
+}
+
+// Table 0149.
+func (v Table0149) Table() Table {
+	return TableLookup[`0149`]
+}
+
+// Table0150 is a value of table 0150, PRECERTIFICATION PATIENT TYPE.
+// Fields in this table are strings; convert a field, such as Table0150(v), to use the helpers.
+type Table0150 string
+
+const (
+	Table0150Emergency          Table0150 = `ER`  // Emergency
+	Table0150InpatientElective  Table0150 = `IPE` // Inpatient elective
+	Table0150OutpatientElective Table0150 = `OPE` // Outpatient elective
+	Table0150Urgent             Table0150 = `UR`  // Urgent
+)
+
+// Description of the value, or empty if the value is not in table 0150.
+func (v Table0150) Description() string {
+	return tableDescription(`0150`, string(v))
+}
+
+// Valid reports if the value is in table 0150.
+func (v Table0150) Valid() bool {
+	return TableValueLookup[`0150`][string(v)]
+}
+
+// Table 0150.
+func (v Table0150) Table() Table {
+	return TableLookup[`0150`]
+}
+
+// Table0155 is a value of table 0155, ACCEPT/APPLICATION ACKNOWLEDGEMENT CONDITIONS.
+// Fields in this table are strings; convert a field, such as Table0155(v), to use the helpers.
+type Table0155 string
+
+const (
+	Table0155Always                    Table0155 = `AL` // Always
+	Table0155ErrorRejectConditionsOnly Table0155 = `ER` // Error / reject conditions only
+	Table0155Never                     Table0155 = `NE` // Never
+	Table0155SuccessfulCompletionOnly  Table0155 = `SU` // Successful completion only
+)
+
+// Description of the value, or empty if the value is not in table 0155.
+func (v Table0155) Description() string {
+	return tableDescription(`0155`, string(v))
+}
+
+// Valid reports if the value is in table 0155.
+func (v Table0155) Valid() bool {
+	return TableValueLookup[`0155`][string(v)]
+}
+
+// Table 0155.
+func (v Table0155) Table() Table {
+	return TableLookup[`0155`]
+}
+
+// Table0156 is a value of table 0156, DATE/TIME QUALIFIER.
+// Fields in this table are strings; convert a field, such as Table0156(v), to use the helpers.
+type Table0156 string
+
+const (
+	Table0156AnyDateTimeWithinARange            Table0156 = `ANY`   // Any date / time within a range
+	Table0156CancellationDateTime               Table0156 = `CAN`   // Cancellation date / time
+	Table0156CollectionDateTimeEquivalentToFilm Table0156 = `COL`   // Collection date / time (equivalent to film or sample collection date / time)
+	Table0156OrderDateTime                      Table0156 = `ORD`   // Order date / time
+	Table0156SpecimenReceiptDateTimeReceiptOf   Table0156 = `RCT`   // Specimen receipt date / time (receipt of specimen in filling ancillary (lab))
+	Table0156ReportDateTimeReportDateTime       Table0156 = `REP`   // Report date / time (report date / time at filling ancillary (i.e., lab))
+	Table0156ScheduleDateTime                   Table0156 = `SCHED` // Schedule date / time
+)
+
+// Description of the value, or empty if the value is not in table 0156.
+func (v Table0156) Description() string {
+	return tableDescription(`0156`, string(v))
+}
+
+// Valid reports if the value is in table 0156.
+func (v Table0156) Valid() bool {
+	return TableValueLookup[`0156`][string(v)]
+}
+
+// Table 0156.
+func (v Table0156) Table() Table {
+	return TableLookup[`0156`]
+}
+
+// Table0157 is a value of table 0157, WHICH DATE/TIME STATUS QUALIFIER.
+// Fields in this table are strings; convert a field, such as Table0157(v), to use the helpers.
+type Table0157 string
+
+const (
+	Table0157AnyStatus                           Table0157 = `ANY` // Any status
+	Table0157CurrentFinalValueWhetherFinalOr     Table0157 = `CFN` // Current final value (whether final or corrected)
+	Table0157CorrectedOnlyNoFinalWithCorrections Table0157 = `COR` // Corrected only (no final with corrections)
+	Table0157FinalOnlyNoCorrections              Table0157 = `FIN` // Final only (no corrections)
+	Table0157Preliminary                         Table0157 = `PRE` // Preliminary
+	Table0157ReportCompletionDateTime            Table0157 = `REP` // Report completion date / time
+)
+
+// Description of the value, or empty if the value is not in table 0157.
+func (v Table0157) Description() string {
+	return tableDescription(`0157`, string(v))
+}
+
+// Valid reports if the value is in table 0157.
+func (v Table0157) Valid() bool {
+	return TableValueLookup[`0157`][string(v)]
+}
+
+// Table 0157.
+func (v Table0157) Table() Table {
+	return TableLookup[`0157`]
+}
+
+// Table0158 is a value of table 0158, DATE/TIME SELECTION QUALIFIER.
+// Fields in this table are strings; convert a field, such as Table0158(v), to use the helpers.
+type Table0158 string
+
+const (
+	Table0158FirstValueWithinRange           Table0158 = `1ST` // First value within range
+	Table0158AllValuesWithinTheRange         Table0158 = `ALL` // All values within the range
+	Table0158LastValueWithinTheRange         Table0158 = `LST` // Last value within the range
+	Table0158AllValuesWithinTheRangeReturned Table0158 = `REV` // All values within the range returned in reverse chronological order
+)
+
+// Description of the value, or empty if the value is not in table 0158.
+func (v Table0158) Description() string {
+	return tableDescription(`0158`, string(v))
+}
+
+// Valid reports if the value is in table 0158.
+func (v Table0158) Valid() bool {
+	return TableValueLookup[`0158`][string(v)]
+}
+
+// Table 0158.
+func (v Table0158) Table() Table {
+	return TableLookup[`0158`]
+}
+
+// Table0159 is a value of table 0159, DIET TYPE.
+// Fields in this table are strings; convert a field, such as Table0159(v), to use the helpers.
+type Table0159 string
+
+const (
+	Table0159Diet       Table0159 = `D` // Diet
+	Table0159Preference Table0159 = `P` // Preference
+	Table0159Supplement Table0159 = `S` // Supplement
+)
+
+// Description of the value, or empty if the value is not in table 0159.
+func (v Table0159) Description() string {
+	return tableDescription(`0159`, string(v))
+}
+
+// Valid reports if the value is in table 0159.
+func (v Table0159) Valid() bool {
+	return TableValueLookup[`0159`][string(v)]
+}
+
+// Table 0159.
+func (v Table0159) Table() Table {
+	return TableLookup[`0159`]
+}
+
+// Table0160 is a value of table 0160, TRAY TYPE.
+// Fields in this table are strings; convert a field, such as Table0160(v), to use the helpers.
+type Table0160 string
+
+const (
+	Table0160EarlyTray       Table0160 = `EARLY` // Early tray
+	Table0160GuestTray       Table0160 = `GUEST` // Guest tray
+	Table0160LateTray        Table0160 = `LATE`  // Late tray
+	Table0160TrayMessageOnly Table0160 = `MSG`   // Tray message only
+	Table0160NoTray          Table0160 = `NO`    // No tray
+)
+
+// Description of the value, or empty if the value is not in table 0160.
+func (v Table0160) Description() string {
+	return tableDescription(`0160`, string(v))
+}
+
+// Valid reports if the value is in table 0160.
+func (v Table0160) Valid() bool {
+	return TableValueLookup[`0160`][string(v)]
+}
+
+// Table 0160.
+func (v Table0160) Table() Table {
+	return TableLookup[`0160`]
+}
+
+// Table0161 is a value of table 0161, ALLOW SUBSTITUTION.
+// Fields in this table are strings; convert a field, such as Table0161(v), to use the helpers.
+type Table0161 string
+
+const (
+	Table0161AllowGenericSubstitutions     Table0161 = `G` // Allow generic substitutions
+	Table0161SubstitutionsAreNotAuthorized Table0161 = `N` // Substitutions are not authorized
+	Table0161AllowTherapeuticSubstitutions Table0161 = `T` // Allow therapeutic substitutions
+)
+
+// Description of the value, or empty if the value is not in table 0161.
+func (v Table0161) Description() string {
+	return tableDescription(`0161`, string(v))
+}
+
+// Valid reports if the value is in table 0161.
+func (v Table0161) Valid() bool {
+	return TableValueLookup[`0161`][string(v)]
+}
+
+// Table 0161.
+func (v Table0161) Table() Table {
+	return TableLookup[`0161`]
+}
+
+// Table0162 is a value of table 0162, ROUTE OF ADMINISTRATION.
+// Fields in this table are strings; convert a field, such as Table0162(v), to use the helpers.
+type Table0162 string
+
+const (
+	Table0162ApplyExternally Table0162 = `AP`  // Apply Externally
+	Table0162Buccal          Table0162 = `B`   // Buccal
+	Table0162Dental          Table0162 = `DT`  // Dental
+	Table0162GastronomyTube  Table0162 = `GTT` // Gastronomy Tube
+	Table0162MagenspLung     Table0162 = `GU`  // Magenspülung
+	Table0162IntraArterial   Table0162 = `IA`  // Intra-arterial
+	Table0162Intracardiac    Table0162 = `IC`  // Intracardiac
+	Table0162Intradermal     Table0162 = `ID`  // Intradermal
+	Table0162Inhalation      Table0162 = `IH`  // Inhalation
+	Table0162Intramuscular   Table0162 = `IM`  // Intramuscular
+	Table0162Intranasal      Table0162 = `IN`  // Intranasal
+	Table0162Intraocular     Table0162 = `IO`  // Intraocular
+	Table0162Intraperitoneal Table0162 = `IP`  // Intraperitoneal
+	Table0162Intrasynovial   Table0162 = `IS`  // Intrasynovial
+	Table0162Intrathecal     Table0162 = `IT`  // Intrathecal
+	Table0162Intravenous     Table0162 = `IV`  // Intravenous
+	Table0162Nasogastric     Table0162 = `NG`  // Nasogastric
+	Table0162Nasal           Table0162 = `NS`  // Nasal
+	Table0162Ophthalmic      Table0162 = `OP`  // Ophthalmic
+	Table0162Otic            Table0162 = `OT`  // Otic
+	Table0162Oral            Table0162 = `PO`  // Oral
+	Table0162Rectal          Table0162 = `PR`  // Rectal
+	Table0162Subcutaneous    Table0162 = `SC`  // Subcutaneous
+	Table0162Sublingual      Table0162 = `SL`  // Sublingual
+	Table0162Transdermal     Table0162 = `TD`  // Transdermal
+	Table0162Translingual    Table0162 = `TL`  // Translingual
+	Table0162Topical         Table0162 = `TP`  // Topical
+	Table0162Urethral        Table0162 = `UR`  // Urethral
+	Table0162Vaginal         Table0162 = `VG`  // Vaginal
+)
+
+// Description of the value, or empty if the value is not in table 0162.
+func (v Table0162) Description() string {
+	return tableDescription(`0162`, string(v))
+}
+
+// Valid reports if the value is in table 0162.
+func (v Table0162) Valid() bool {
+	return TableValueLookup[`0162`][string(v)]
+}
+
+// Table 0162.
+func (v Table0162) Table() Table {
+	return TableLookup[`0162`]
+}
+
+// Table0163 is a value of table 0163, ADMINISTRIVE SITE.
+// Fields in this table are strings; convert a field, such as Table0163(v), to use the helpers.
+type Table0163 string
+
+const (
+	Table0163BilateralEars         Table0163 = `BE`    // Bilateral Ears
+	Table0163BilateralNares        Table0163 = `BN`    // Bilateral Nares
+	Table0163Buttock               Table0163 = `BU`    // Buttock
+	Table0163Tubus                 Table0163 = `CT`    // Tubus
+	Table0163LeftArm               Table0163 = `LA`    // Left arm
+	Table0163LeftAnteriorChest     Table0163 = `LAC`   // Left Anterior Chest
+	Table0163LeftAntecubitalFossa  Table0163 = `LACF`  // Left Antecubital Fossa
+	Table0163LeftDeltoid           Table0163 = `LD`    // Left Deltoid
+	Table0163LeftEar               Table0163 = `LE`    // Left Ear
+	Table0163LeftExternalJugular   Table0163 = `LEJ`   // Left External Jugular
+	Table0163LeftFoot              Table0163 = `LF`    // Left Foot
+	Table0163LeftGluteusMedius     Table0163 = `LG`    // Left Gluteus Medius
+	Table0163LeftHand              Table0163 = `LH`    // Left Hand
+	Table0163LeftInternalJugular   Table0163 = `LIJ`   // Left Internal Jugular
+	Table0163LeftLowerAbdQuadrant  Table0163 = `LLAQ`  // Left Lower Abd Quadrant
+	Table0163LeftLowerForearm      Table0163 = `LLFA`  // Left Lower Forearm
+	Table0163LeftMidForearm        Table0163 = `LMFA`  // Left Mid Forearm
+	Table0163LeftNaris             Table0163 = `LN`    // Left Naris
+	Table0163LeftPosteriorChest    Table0163 = `LPC`   // Left Posterior Chest
+	Table0163LeftSubclavian        Table0163 = `LSC`   // Left Subclavian
+	Table0163LeftThigh             Table0163 = `LT`    // Left Thigh
+	Table0163LeftUpperArm          Table0163 = `LUA`   // Left Upper Arm
+	Table0163LeftUpperAbdQuadrant  Table0163 = `LUAQ`  // Left Upper Abd Quadrant
+	Table0163LeftUpperForearm      Table0163 = `LUFA`  // Left Upper Forearm
+	Table0163LeftVentragluteal     Table0163 = `LVG`   // Left Ventragluteal
+	Table0163LeftVastusLateralis   Table0163 = `LVL`   // Left Vastus Lateralis
+	Table0163Nebulized             Table0163 = `NB`    // Nebulized
+	Table0163RightEye              Table0163 = `OD`    // Right Eye
+	Table0163LeftEye               Table0163 = `OS`    // Left Eye
+	Table0163BilateralEyes         Table0163 = `OU`    // Bilateral Eyes
+	Table0163Perianal              Table0163 = `PA`    // Perianal
+	Table0163Perineal              Table0163 = `PERIN` // Perineal
+	Table0163RightArm              Table0163 = `RA`    // Right Arm
+	Table0163RightAnteriorChest    Table0163 = `RAC`   // Right Anterior Chest
+	Table0163RightAntecubitalFossa Table0163 = `RACF`  // Right Antecubital Fossa
+	Table0163RightDeltoid          Table0163 = `RD`    // Right Deltoid
+	Table0163RightEar              Table0163 = `RE`    // Right Ear
+	Table0163RightExternalJugular  Table0163 = `REJ`   // Right External Jugular
+	Table0163RightFoot             Table0163 = `RF`    // Right Foot
+	Table0163RightGluteusMedius    Table0163 = `RG`    // Right Gluteus Medius
+	Table0163RightHand             Table0163 = `RH`    // Right Hand
+	Table0163RightInternalJugular  Table0163 = `RIJ`   // Right Internal Jugular
+	Table0163RtLowerAbdQuadrant    Table0163 = `RLAQ`  // Rt Lower Abd Quadrant
+	Table0163RightLowerForearm     Table0163 = `RLFA`  // Right Lower Forearm
+	Table0163RightMidForearm       Table0163 = `RMFA`  // Right Mid Forearm
+	Table0163RightNaris            Table0163 = `RN`    // Right Naris
+	Table0163RightPosteriorChest   Table0163 = `RPC`   // Right Posterior Chest
+	Table0163RightSubclavian       Table0163 = `RSC`   // Right Subclavian
+	Table0163RightThigh            Table0163 = `RT`    // Right Thigh
+	Table0163RightUpperArm         Table0163 = `RUA`   // Right Upper Arm
+	Table0163RightUpperAbdQuadrant Table0163 = `RUAQ`  // Right Upper Abd Quadrant
+	Table0163RightUpperForearm     Table0163 = `RUFA`  // Right Upper Forearm
+	Table0163RightVentragluteal    Table0163 = `RVG`   // Right Ventragluteal
+	Table0163RightVastusLateralis  Table0163 = `RVL`   // Right Vastus Lateralis
+)
+
+// Description of the value, or empty if the value is not in table 0163.
+func (v Table0163) Description() string {
+	return tableDescription(`0163`, string(v))
+}
+
+// Valid reports if the value is in table 0163.
+func (v Table0163) Valid() bool {
+	return TableValueLookup[`0163`][string(v)]
+}
+
+// Table 0163.
+func (v Table0163) Table() Table {
+	return TableLookup[`0163`]
+}
+
+// Table0164 is a value of table 0164, ADMINISTRATION DEVICE.
+// Fields in this table are strings; convert a field, such as Table0164(v), to use the helpers.
+type Table0164 string
+
+const (
+	Table0164Applicator     Table0164 = `AP`   // Applicator
+	Table0164Buretrol       Table0164 = `BT`   // Buretrol
+	Table0164HeparinLock    Table0164 = `HL`   // Heparin Lock
+	Table0164IPPB           Table0164 = `IPPB` // IPPB
+	Table0164IVPump         Table0164 = `IVP`  // IV Pump
+	Table0164IVSoluset      Table0164 = `IVS`  // IV Soluset
+	Table0164MeteredInhaler Table0164 = `MI`   // Metered Inhaler
+	Table0164Nebulizer      Table0164 = `NEB`  // Nebulizer
+	Table0164PCAPump        Table0164 = `PCA`  // PCA Pump
+)
+
+// Description of the value, or empty if the value is not in table 0164.
+func (v Table0164) Description() string {
+	return tableDescription(`0164`, string(v))
+}
+
+// Valid reports if the value is in table 0164.
+func (v Table0164) Valid() bool {
+	return TableValueLookup[`0164`][string(v)]
+}
+
+// Table 0164.
+func (v Table0164) Table() Table {
+	return TableLookup[`0164`]
+}
+
+// Table0165 is a value of table 0165, ADMINISTRATION METHOD.
+// Fields in this table are strings; convert a field, such as Table0165(v), to use the helpers.
+type Table0165 string
+
+const (
+	Table0165Chew        Table0165 = `CH`   // Chew
+	Table0165Dissolve    Table0165 = `DI`   // Dissolve
+	Table0165Dust        Table0165 = `DU`   // Dust
+	Table0165Inflitrate  Table0165 = `IF`   // Inflitrate
+	Table0165Irrigate    Table0165 = `IR`   // Irrigate
+	Table0165Insert      Table0165 = `IS`   // Insert
+	Table0165IVPush      Table0165 = `IVP`  // IV Push
+	Table0165IVPiggyback Table0165 = `IVPB` // IV Piggyback
+	Table0165Nebulized   Table0165 = `NB`   // Nebulized
+	Table0165Perfuse     Table0165 = `PF`   // Perfuse
+	Table0165Pain        Table0165 = `PT`   // Pain
+	Table0165Shampoo     Table0165 = `SH`   // Shampoo
+	Table0165Soak        Table0165 = `SO`   // Soak
+	Table0165Wash        Table0165 = `WA`   // Wash
+	Table0165Wipe        Table0165 = `WI`   // Wipe
+)
+
+// Description of the value, or empty if the value is not in table 0165.
+func (v Table0165) Description() string {
+	return tableDescription(`0165`, string(v))
+}
+
+// Valid reports if the value is in table 0165.
+func (v Table0165) Valid() bool {
+	return TableValueLookup[`0165`][string(v)]
+}
+
+// Table 0165.
+func (v Table0165) Table() Table {
+	return TableLookup[`0165`]
+}
+
+// Table0166 is a value of table 0166, RX COMPONENT TYPE.
+// Fields in this table are strings; convert a field, such as Table0166(v), to use the helpers.
+type Table0166 string
+
+const (
+	Table0166Additive Table0166 = `A` // Additive
+	Table0166Base     Table0166 = `B` // Base
+)
+
+// Description of the value, or empty if the value is not in table 0166.
+func (v Table0166) Description() string {
+	return tableDescription(`0166`, string(v))
+}
+
+// Valid reports if the value is in table 0166.
+func (v Table0166) Valid() bool {
+	return TableValueLookup[`0166`][string(v)]
+}
+
+// Table 0166.
+func (v Table0166) Table() Table {
+	return TableLookup[`0166`]
+}
+
+// Table0167 is a value of table 0167, SUBSTITUTION STATUS.
+// Fields in this table are strings; convert a field, such as Table0167(v), to use the helpers.
+type Table0167 string
+
+const (
+	Table0167AGenericSubstitutionWasDispensed     Table0167 = `G` // A generic substitution was dispensed
+	Table0167NoSubstituteWasDispensed             Table0167 = `N` // No substitute was dispensed
+	Table0167ATherapeuticSubstitutionWasDispensed Table0167 = `T` // A therapeutic substitution was dispensed
+)
+
+// Description of the value, or empty if the value is not in table 0167.
+func (v Table0167) Description() string {
+	return tableDescription(`0167`, string(v))
+}
+
+// Valid reports if the value is in table 0167.
+func (v Table0167) Valid() bool {
+	return TableValueLookup[`0167`][string(v)]
+}
+
+// Table 0167.
+func (v Table0167) Table() Table {
+	return TableLookup[`0167`]
+}
+
+// Table0171 is a value of table 0171, COUNTRY CODE.
+// Fields in this table are strings; convert a field, such as Table0171(v), to use the helpers.
+type Table0171 string
+
+const (
+	Table0171Mongolia                  Table0171 = `_1`  // Mongolia
+	Table0171Comoros                   Table0171 = `_10` // Comoros
+	Table0171EquatorialGuinea          Table0171 = `_11` // Equatorial Guinea
+	Table0171MarshallIslands           Table0171 = `_12` // Marshall Islands
+	Table0171Armenia                   Table0171 = `_2`  // Armenia
+	Table0171Azerbaijan                Table0171 = `_3`  // Azerbaijan
+	Table0171Vanuatu                   Table0171 = `_4`  // Vanuatu
+	Table0171GuineaBissau              Table0171 = `_5`  // Guinea-Bissau
+	Table0171Tuvalu                    Table0171 = `_6`  // Tuvalu
+	Table0171Kiribati                  Table0171 = `_7`  // Kiribati
+	Table0171Tonga                     Table0171 = `_8`  // Tonga
+	Table0171Djibouti                  Table0171 = `_9`  // Djibouti
+	Table0171Austria                   Table0171 = `A`   // Austria
+	Table0171Yemen                     Table0171 = `ADN` // Yemen
+	Table0171Afghanistan               Table0171 = `AFG` // Afghanistan
+	Table0171Angola                    Table0171 = `AGO` // Angola
+	Table0171Albania                   Table0171 = `AL`  // Albania
+	Table0171Andorra                   Table0171 = `AND` // Andorra
+	Table0171AntiguaAndBarbuda         Table0171 = `ANT` // Antigua and Barbuda
+	Table0171Australia                 Table0171 = `AUS` // Australia
+	Table0171Belgium                   Table0171 = `B`   // Belgium
+	Table0171Bangladesh                Table0171 = `BD`  // Bangladesh
+	Table0171Barbados                  Table0171 = `BDS` // Barbados
+	Table0171Bulgaria                  Table0171 = `BG`  // Bulgaria
+	Table0171Belize                    Table0171 = `BH`  // Belize
+	Table0171Bhutan                    Table0171 = `BHT` // Bhutan
+	Table0171Maldives                  Table0171 = `BIO` // Maldives
+	Table0171Bolivia                   Table0171 = `BOL` // Bolivia
+	Table0171BosniaAndHerzegovina      Table0171 = `BOS` // Bosnia and Herzegovina
+	Table0171Brazil                    Table0171 = `BR`  // Brazil
+	Table0171Bahrain                   Table0171 = `BRN` // Bahrain
+	Table0171Brunei                    Table0171 = `BRU` // Brunei
+	Table0171Bahamas                   Table0171 = `BS`  // Bahamas
+	Table0171Myanmar                   Table0171 = `BUR` // Myanmar
+	Table0171Belarus                   Table0171 = `BY`  // Belarus
+	Table0171Cuba                      Table0171 = `C`   // Cuba
+	Table0171Cameroon                  Table0171 = `CAM` // Cameroon
+	Table0171Canada                    Table0171 = `CDN` // Canada
+	Table0171Switzerland               Table0171 = `CH`  // Switzerland
+	Table0171Chad                      Table0171 = `CHD` // Chad
+	Table0171IvoryCoast                Table0171 = `CI`  // Ivory Coast
+	Table0171SriLanka                  Table0171 = `CL`  // Sri Lanka
+	Table0171Colombia                  Table0171 = `CO`  // Colombia
+	Table0171CostaRica                 Table0171 = `CR`  // Costa Rica
+	Table0171CapeVerde                 Table0171 = `CV`  // Cape Verde
+	Table0171Cyprus                    Table0171 = `CY`  // Cyprus
+	Table0171CzechRepublic             Table0171 = `CZ`  // Czech Republic
+	Table0171Germany                   Table0171 = `D`   // Germany
+	Table0171DominicanRepublic         Table0171 = `DCM` // Dominican Republic
+	Table0171Denmark                   Table0171 = `DK`  // Denmark
+	Table0171Benin                     Table0171 = `DY`  // Benin
+	Table0171Algeria                   Table0171 = `DZ`  // Algeria
+	Table0171Spain                     Table0171 = `E`   // Spain
+	Table0171Kenya                     Table0171 = `EAK` // Kenya
+	Table0171Tanzania                  Table0171 = `EAT` // Tanzania
+	Table0171Uganda                    Table0171 = `EAU` // Uganda
+	Table0171Zanzibar                  Table0171 = `EAZ` // Zanzibar
+	Table0171Ecuador                   Table0171 = `EC`  // Ecuador
+	Table0171ElSalvador                Table0171 = `ES`  // El Salvador
+	Table0171Egypt                     Table0171 = `ET`  // Egypt
+	Table0171Ethiopia                  Table0171 = `ETH` // Ethiopia
+	Table0171Estonia                   Table0171 = `EW`  // Estonia
+	Table0171France                    Table0171 = `F`   // France
+	Table0171FalklandIslands           Table0171 = `FAL` // Falkland Islands
+	Table0171Fiji                      Table0171 = `FJL` // Fiji
+	Table0171Liechtenstein             Table0171 = `FL`  // Liechtenstein
+	Table0171FaeroeIslands             Table0171 = `FR`  // Faeroe Islands
+	Table0171Yugoslavia                Table0171 = `FRJ` // Yugoslavia
+	Table0171Micronesia                Table0171 = `FSM` // Micronesia
+	Table0171Gabon                     Table0171 = `GAB` // Gabon
+	Table0171UnitedKingdom             Table0171 = `GB`  // United Kingdom
+	Table0171ChannelIslandsAlderney    Table0171 = `GBA` // Channel Islands - Alderney
+	Table0171ChannelIslandsGuernsey    Table0171 = `GBG` // Channel Islands - Guernsey
+	Table0171ChannelIslandsJersey      Table0171 = `GBJ` // Channel Islands - Jersey
+	Table0171IsleOfMan                 Table0171 = `GBM` // Isle of Man
+	Table0171Gibraltar                 Table0171 = `GBZ` // Gibraltar
+	Table0171Guatemala                 Table0171 = `GCA` // Guatemala
+	Table0171Georgia                   Table0171 = `GEO` // Georgia
+	Table0171Ghana                     Table0171 = `GH`  // Ghana
+	Table0171Greece                    Table0171 = `GR`  // Greece
+	Table0171Guyana                    Table0171 = `GUY` // Guyana
+	Table0171Hungary                   Table0171 = `H`   // Hungary
+	Table0171Honduras                  Table0171 = `HCA` // Honduras
+	Table0171Hongkong                  Table0171 = `HK`  // Hongkong
+	Table0171Croatia                   Table0171 = `HR`  // Croatia
+	Table0171BurkinaFaso               Table0171 = `HV`  // Burkina Faso
+	Table0171Italy                     Table0171 = `I`   // Italy
+	Table0171Israel                    Table0171 = `IL`  // Israel
+	Table0171India                     Table0171 = `IND` // India
+	Table0171Iran                      Table0171 = `IR`  // Iran
+	Table0171Ireland                   Table0171 = `IRL` // Ireland
+	Table0171Iraq                      Table0171 = `IRQ` // Iraq
+	Table0171Iceland                   Table0171 = `IS`  // Iceland
+	Table0171Japan                     Table0171 = `J`   // Japan
+	Table0171Jamaica                   Table0171 = `JA`  // Jamaica
+	Table0171Jordan                    Table0171 = `JOR` // Jordan
+	Table0171Cambodia                  Table0171 = `K`   // Cambodia
+	Table0171Kazakhstan                Table0171 = `KAS` // Kazakhstan
+	Table0171Kyrgyzstan                Table0171 = `KIS` // Kyrgyzstan
+	Table0171Kuwait                    Table0171 = `KWT` // Kuwait
+	Table0171Luxembourg                Table0171 = `L`   // Luxembourg
+	Table0171Laos                      Table0171 = `LAO` // Laos
+	Table0171Libya                     Table0171 = `LAR` // Libya
+	Table0171Liberia                   Table0171 = `LB`  // Liberia
+	Table0171Lesotho                   Table0171 = `LS`  // Lesotho
+	Table0171Lithuania                 Table0171 = `LT`  // Lithuania
+	Table0171Latvia                    Table0171 = `LV`  // Latvia
+	Table0171Malta                     Table0171 = `M`   // Malta
+	Table0171Morocco                   Table0171 = `MA`  // Morocco
+	Table0171Macedonia                 Table0171 = `MAK` // Macedonia
+	Table0171Malaysia                  Table0171 = `MAL` // Malaysia
+	Table0171Oman                      Table0171 = `MAO` // Oman
+	Table0171Monaco                    Table0171 = `MC`  // Monaco
+	Table0171Mexico                    Table0171 = `MEX` // Mexico
+	Table0171Moldova                   Table0171 = `MOL` // Moldova
+	Table0171Mozambique                Table0171 = `MOZ` // Mozambique
+	Table0171Mauritius                 Table0171 = `MS`  // Mauritius
+	Table0171Malawi                    Table0171 = `MW`  // Malawi
+	Table0171Norway                    Table0171 = `N`   // Norway
+	Table0171NetherlandsAntilles       Table0171 = `NA`  // Netherlands Antilles
+	Table0171Nauru                     Table0171 = `NAU` // Nauru
+	Table0171Nepal                     Table0171 = `NEP` // Nepal
+	Table0171Nicaragua                 Table0171 = `NIC` // Nicaragua
+	Table0171Netherlands               Table0171 = `NL`  // Netherlands
+	Table0171NewZealand                Table0171 = `NZ`  // New Zealand
+	Table0171Portugal                  Table0171 = `P`   // Portugal
+	Table0171Panama                    Table0171 = `PA`  // Panama
+	Table0171Pakistan                  Table0171 = `PAK` // Pakistan
+	Table0171Peru                      Table0171 = `PE`  // Peru
+	Table0171Poland                    Table0171 = `PL`  // Poland
+	Table0171PapuaNewGuinea            Table0171 = `PNG` // Papua New Guinea
+	Table0171Paraguay                  Table0171 = `PY`  // Paraguay
+	Table0171Qatar                     Table0171 = `QAT` // Qatar
+	Table0171Argentina                 Table0171 = `RA`  // Argentina
+	Table0171Botswana                  Table0171 = `RB`  // Botswana
+	Table0171Taiwan                    Table0171 = `RC`  // Taiwan
+	Table0171CentralAfricanRepublic    Table0171 = `RCA` // Central African Republic
+	Table0171Congo                     Table0171 = `RCB` // Congo
+	Table0171Chile                     Table0171 = `RCH` // Chile
+	Table0171Guinea                    Table0171 = `RG`  // Guinea
+	Table0171Haiti                     Table0171 = `RH`  // Haiti
+	Table0171Indonesia                 Table0171 = `RI`  // Indonesia
+	Table0171Mauritania                Table0171 = `RIM` // Mauritania
+	Table0171Lebanon                   Table0171 = `RL`  // Lebanon
+	Table0171Madagascar                Table0171 = `RM`  // Madagascar
+	Table0171Mali                      Table0171 = `RMM` // Mali
+	Table0171Niger                     Table0171 = `RN`  // Niger
+	Table0171Romania                   Table0171 = `RO`  // Romania
+	Table0171Korea                     Table0171 = `ROK` // Korea
+	Table0171Uruguay                   Table0171 = `ROU` // Uruguay
+	Table0171Philippines               Table0171 = `RP`  // Philippines
+	Table0171SanMarino                 Table0171 = `RSM` // San Marino
+	Table0171Burundi                   Table0171 = `RU`  // Burundi
+	Table0171Russia                    Table0171 = `RUS` // Russia
+	Table0171Rwanda                    Table0171 = `RWA` // Rwanda
+	Table0171Sweden                    Table0171 = `S`   // Sweden
+	Table0171SaudiArabia               Table0171 = `SAU` // Saudi Arabia
+	Table0171StKittsAndNevis           Table0171 = `SCN` // St. Kitts and Nevis
+	Table0171Swaziland                 Table0171 = `SD`  // Swaziland
+	Table0171Finland                   Table0171 = `SF`  // Finland
+	Table0171Singapore                 Table0171 = `SGP` // Singapore
+	Table0171Slovakia                  Table0171 = `SK`  // Slovakia
+	Table0171Slovenia                  Table0171 = `SLO` // Slovenia
+	Table0171Suriname                  Table0171 = `SME` // Suriname
+	Table0171Senegal                   Table0171 = `SN`  // Senegal
+	Table0171SolomonIslands            Table0171 = `SOL` // Solomon Islands
+	Table0171Somalia                   Table0171 = `SP`  // Somalia
+	Table0171SaoTomeAndPrincipe        Table0171 = `STP` // Sao Tome and Principe
+	Table0171Sudan                     Table0171 = `SUD` // Sudan
+	Table0171Namibia                   Table0171 = `SWA` // Namibia
+	Table0171Seychelles                Table0171 = `SY`  // Seychelles
+	Table0171Syria                     Table0171 = `SYR` // Syria
+	Table0171Thailand                  Table0171 = `T`   // Thailand
+	Table0171Tajikistan                Table0171 = `TAD` // Tajikistan
+	Table0171Togo                      Table0171 = `TG`  // Togo
+	Table0171China                     Table0171 = `TJ`  // China
+	Table0171Turkmenistan              Table0171 = `TMN` // Turkmenistan
+	Table0171Tunisia                   Table0171 = `TN`  // Tunisia
+	Table0171Turkey                    Table0171 = `TR`  // Turkey
+	Table0171TrinidadAndTobago         Table0171 = `TT`  // Trinidad and Tobago
+	Table0171Ukraine                   Table0171 = `UA`  // Ukraine
+	Table0171UnitedArabEmirates        Table0171 = `UAE` // United Arab Emirates
+	Table0171UnitedStatesOfAmerica     Table0171 = `USA` // United States of America
+	Table0171Uzbekistan                Table0171 = `USB` // Uzbekistan
+	Table0171VaticanCityState          Table0171 = `V`   // Vatican City State
+	Table0171Vietnam                   Table0171 = `VN`  // Vietnam
+	Table0171Gambia                    Table0171 = `WAG` // Gambia
+	Table0171SierraLeone               Table0171 = `WAL` // Sierra Leone
+	Table0171Nigeria                   Table0171 = `WAN` // Nigeria
+	Table0171Dominica                  Table0171 = `WD`  // Dominica
+	Table0171Grenada                   Table0171 = `WG`  // Grenada
+	Table0171StLucia                   Table0171 = `WL`  // St. Lucia
+	Table0171WesternSamoa              Table0171 = `WS`  // Western Samoa
+	Table0171StVincentAndTheGrenadines Table0171 = `WV`  // St. Vincent and the Grenadines
+	Table0171Venezuela                 Table0171 = `YV`  // Venezuela
+	Table0171Zambia                    Table0171 = `Z`   // Zambia
+	Table0171SouthAfrica               Table0171 = `ZA`  // South Africa
+	Table0171Zaire                     Table0171 = `ZRE` // Zaire
+	Table0171Zimbabwe                  Table0171 = `ZW`  // Zimbabwe
+)
+
+// Description of the value, or empty if the value is not in table 0171.
+func (v Table0171) Description() string {
+	return tableDescription(`0171`, string(v))
+}
+
+// Valid reports if the value is in table 0171.
+func (v Table0171) Valid() bool {
+	return TableValueLookup[`0171`][string(v)]
+}
+
+// Table 0171.
+func (v Table0171) Table() Table {
+	return TableLookup[`0171`]
+}
+
+// Table0173 is a value of table 0173, COORDINATION OF BENEFITS.
+// Fields in this table are strings; convert a field, such as Table0173(v), to use the helpers.
+type Table0173 string
+
+const (
+	Table0173Coordination Table0173 = `CO` // Coordination
+	Table0173Independent  Table0173 = `IN` // Independent
+)
+
+// Description of the value, or empty if the value is not in table 0173.
+func (v Table0173) Description() string {
+	return tableDescription(`0173`, string(v))
+}
+
+// Valid reports if the value is in table 0173.
+func (v Table0173) Valid() bool {
+	return TableValueLookup[`0173`][string(v)]
+}
+
+// Table 0173.
+func (v Table0173) Table() Table {
+	return TableLookup[`0173`]
+}
+
+// Table0175 is a value of table 0175, MASTER FILE IDENTIFIER CODE.
+// Fields in this table are strings; convert a field, such as Table0175(v), to use the helpers.
+type Table0175 string
+
+const (
+	Table0175ChargeDescriptionMasterFileSeeChapter Table0175 = `CDM` // Charge description master file (see chapter 6, appendix)
+	Table0175ObservationTextMasterFileIE           Table0175 = `OM1` // Observation text master file (i.e., Lab) (see Chapter 7, Appendix)
+	Table0175ObservationTextMasterFileIE_OM2       Table0175 = `OM2` // Observation text master file (i.e., Lab) (see Chapter 7, Appendix)
+	Table0175ObservationTextMasterFileIE_OM3       Table0175 = `OM3` // Observation text master file (i.e., Lab) (see Chapter 7, Appendix)
+	Table0175ObservationTextMasterFileIE_OM4       Table0175 = `OM4` // Observation text master file (i.e., Lab) (see Chapter 7, Appendix)
+	Table0175ObservationTextMasterFileIE_OM5       Table0175 = `OM5` // Observation text master file (i.e., Lab) (see Chapter 7, Appendix)
+	Table0175ObservationTextMasterFileIE_OM6       Table0175 = `OM6` // Observation text master file (i.e., Lab) (see Chapter 7, Appendix)
+	Table0175PractitionerMasterFileSeeChapter8     Table0175 = `PRA` // Practitioner master file (see chapter 8, appendix)
+	Table0175StaffMasterFileSeeChapter8            Table0175 = `STF` // Staff master file (see chapter 8, Appendix)
+)
+
+// Description of the value, or empty if the value is not in table 0175.
+func (v Table0175) Description() string {
+	return tableDescription(`0175`, string(v))
+}
+
+// Valid reports if the value is in table 0175.
+func (v Table0175) Valid() bool {
+	return TableValueLookup[`0175`][string(v)]
+}
+
+// Table 0175.
+func (v Table0175) Table() Table {
+	return TableLookup[`0175`]
+}
+
+// Table0178 is a value of table 0178, FILE-LEVEL EVENT CODE.
+// Fields in this table are strings; convert a field, such as Table0178(v), to use the helpers.
+type Table0178 string
+
+const (
+	Table0178ReplaceCurrentVersionOfThisMaster Table0178 = `REP` // Replace current version of this master file with the version contained in this message
+	Table0178ChangeFileRecordsAsDefinedIn      Table0178 = `UPD` // Change file records as defined in the record level event codes for each record that follows
+)
+
+// Description of the value, or empty if the value is not in table 0178.
+func (v Table0178) Description() string {
+	return tableDescription(`0178`, string(v))
+}
+
+// Valid reports if the value is in table 0178.
+func (v Table0178) Valid() bool {
+	return TableValueLookup[`0178`][string(v)]
+}
+
+// Table 0178.
+func (v Table0178) Table() Table {
+	return TableLookup[`0178`]
+}
+
+// Table0179 is a value of table 0179, RESPONSE LEVEL.
+// Fields in this table are strings; convert a field, such as Table0179(v), to use the helpers.
+type Table0179 string
+
+const (
+	Table0179Always                                Table0179 = `AL` // Always
+	Table0179ErrorRejectConditionsOnly             Table0179 = `ER` // Error / reject conditions only
+	Table0179NeverNoApplicationLevelResponseNeeded Table0179 = `NE` // Never - no application level response needed
+	Table0179Success                               Table0179 = `SU` // Success
+)
+
+// Description of the value, or empty if the value is not in table 0179.
+func (v Table0179) Description() string {
+	return tableDescription(`0179`, string(v))
+}
+
+// Valid reports if the value is in table 0179.
+func (v Table0179) Valid() bool {
+	return TableValueLookup[`0179`][string(v)]
+}
+
+// Table 0179.
+func (v Table0179) Table() Table {
+	return TableLookup[`0179`]
+}
+
+// Table0180 is a value of table 0180, RECORD LEVEL EVENT CODE.
+// Fields in this table are strings; convert a field, such as Table0180(v), to use the helpers.
+type Table0180 string
+
+const (
+	Table0180ReactivateDeactivatedRecord              Table0180 = `MAC` // Reactivate deactivated record
+	Table0180AddRecordToMasterFile                    Table0180 = `MAD` // Add record to master file
+	Table0180DeactivateDiscontinueUsingRecordInMaster Table0180 = `MDC` // Deactivate - discontinue using record in master file, but do not delete from database
+	Table0180DeleteRecordFromMasterFile               Table0180 = `MDL` // Delete record from master file
+	Table0180UpdateRecordForMasterFile                Table0180 = `MUP` // Update record for master file
+)
+
+// Description of the value, or empty if the value is not in table 0180.
+func (v Table0180) Description() string {
+	return tableDescription(`0180`, string(v))
+}
+
+// Valid reports if the value is in table 0180.
+func (v Table0180) Valid() bool {
+	return TableValueLookup[`0180`][string(v)]
+}
+
+// Table 0180.
+func (v Table0180) Table() Table {
+	return TableLookup[`0180`]
+}
+
+// Table0181 is a value of table 0181, MFN RECORD-LEVEL ERROR RETURN.
+// Fields in this table are strings; convert a field, such as Table0181(v), to use the helpers.
+type Table0181 string
+
+const (
+	Table0181SuccessfulPostingOfTheRecordDefined   Table0181 = `S` // Successful posting of the record defined by the MFE segment
+	Table0181UnsuccessfulPostingOfTheRecordDefined Table0181 = `U` // Unsuccessful posting of the record defined by the MFE segment
+)
+
+// Description of the value, or empty if the value is not in table 0181.
+func (v Table0181) Description() string {
+	return tableDescription(`0181`, string(v))
+}
+
+// Valid reports if the value is in table 0181.
+func (v Table0181) Valid() bool {
+	return TableValueLookup[`0181`][string(v)]
+}
+
+// Table 0181.
+func (v Table0181) Table() Table {
+	return TableLookup[`0181`]
+}
+
+// Table0190 is a value of table 0190, ADDRESS TYPE.
+// Fields in this table are strings; convert a field, such as Table0190(v), to use the helpers.
+type Table0190 string
+
+const (
+	Table0190Business           Table0190 = `B` // Business
+	Table0190CurrentOrTemporary Table0190 = `C` // Current or Temporary
+	Table0190Home               Table0190 = `H` // Home
+	Table0190Mailing            Table0190 = `M` // Mailing
+	Table0190Office             Table0190 = `O` // Office
+	Table0190Permanent          Table0190 = `P` // Permanent
+)
+
+// Description of the value, or empty if the value is not in table 0190.
+func (v Table0190) Description() string {
+	return tableDescription(`0190`, string(v))
+}
+
+// Valid reports if the value is in table 0190.
+func (v Table0190) Valid() bool {
+	return TableValueLookup[`0190`][string(v)]
+}
+
+// Table 0190.
+func (v Table0190) Table() Table {
+	return TableLookup[`0190`]
+}
+
+// Table0193 is a value of table 0193, AMOUNT CLASS.
+// Fields in this table are strings; convert a field, such as Table0193(v), to use the helpers.
+type Table0193 string
+
+const (
+	Table0193Amount     Table0193 = `AT` // Amount
+	Table0193Limit      Table0193 = `LM` // Limit
+	Table0193Percentage Table0193 = `PC` // Percentage
+	Table0193Unlimited  Table0193 = `UL` // Unlimited
+)
+
+// Description of the value, or empty if the value is not in table 0193.
+func (v Table0193) Description() string {
+	return tableDescription(`0193`, string(v))
+}
+
+// Valid reports if the value is in table 0193.
+func (v Table0193) Valid() bool {
+	return TableValueLookup[`0193`][string(v)]
+}
+
+// Table 0193.
+func (v Table0193) Table() Table {
+	return TableLookup[`0193`]
+}
+
+// TableISO3166 is a value of table ISO3166, Country Codes.
+// Fields in this table are strings; convert a field, such as TableISO3166(v), to use the helpers.
+type TableISO3166 string
+
+const (
+	TableISO3166Aruba                            TableISO3166 = `ABW` // Aruba
+	TableISO3166Afghanistan                      TableISO3166 = `AFG` // Afghanistan
+	TableISO3166Angola                           TableISO3166 = `AGO` // Angola
+	TableISO3166Anguilla                         TableISO3166 = `AIA` // Anguilla
+	TableISO3166AlandIslands                     TableISO3166 = `ALA` // Aland Islands
+	TableISO3166Albania                          TableISO3166 = `ALB` // Albania
+	TableISO3166Andorra                          TableISO3166 = `AND` // Andorra
+	TableISO3166UnitedArabEmirates               TableISO3166 = `ARE` // United Arab Emirates
+	TableISO3166Argentina                        TableISO3166 = `ARG` // Argentina
+	TableISO3166Armenia                          TableISO3166 = `ARM` // Armenia
+	TableISO3166AmericanSamoa                    TableISO3166 = `ASM` // American Samoa
+	TableISO3166Antarctica                       TableISO3166 = `ATA` // Antarctica
+	TableISO3166FrenchSouthernTerritories        TableISO3166 = `ATF` // French Southern Territories
+	TableISO3166AntiguaAndBarbuda                TableISO3166 = `ATG` // Antigua and Barbuda
+	TableISO3166Australia                        TableISO3166 = `AUS` // Australia
+	TableISO3166Austria                          TableISO3166 = `AUT` // Austria
+	TableISO3166Azerbaijan                       TableISO3166 = `AZE` // Azerbaijan
+	TableISO3166Burundi                          TableISO3166 = `BDI` // Burundi
+	TableISO3166Belgium                          TableISO3166 = `BEL` // Belgium
+	TableISO3166Benin                            TableISO3166 = `BEN` // Benin
+	TableISO3166BonaireSintEustatiusAndSaba      TableISO3166 = `BES` // Bonaire, Sint Eustatius and Saba
+	TableISO3166BurkinaFaso                      TableISO3166 = `BFA` // Burkina Faso
+	TableISO3166Bangladesh                       TableISO3166 = `BGD` // Bangladesh
+	TableISO3166Bulgaria                         TableISO3166 = `BGR` // Bulgaria
+	TableISO3166Bahrain                          TableISO3166 = `BHR` // Bahrain
+	TableISO3166Bahamas                          TableISO3166 = `BHS` // Bahamas
+	TableISO3166BosniaAndHerzegovina             TableISO3166 = `BIH` // Bosnia and Herzegovina
+	TableISO3166SaintBarthLemy                   TableISO3166 = `BLM` // Saint BarthÃ©lemy
+	TableISO3166Belarus                          TableISO3166 = `BLR` // Belarus
+	TableISO3166Belize                           TableISO3166 = `BLZ` // Belize
+	TableISO3166Bermuda                          TableISO3166 = `BMU` // Bermuda
+	TableISO3166BoliviaPlurinationalStateOf      TableISO3166 = `BOL` // Bolivia (Plurinational State of)
+	TableISO3166Brazil                           TableISO3166 = `BRA` // Brazil
+	TableISO3166Barbados                         TableISO3166 = `BRB` // Barbados
+	TableISO3166BruneiDarussalam                 TableISO3166 = `BRN` // Brunei Darussalam
+	TableISO3166Bhutan                           TableISO3166 = `BTN` // Bhutan
+	TableISO3166BouvetIsland                     TableISO3166 = `BVT` // Bouvet Island
+	TableISO3166Botswana                         TableISO3166 = `BWA` // Botswana
+	TableISO3166CentralAfricanRepublic           TableISO3166 = `CAF` // Central African Republic
+	TableISO3166Canada                           TableISO3166 = `CAN` // Canada
+	TableISO3166CocosKeelingIslands              TableISO3166 = `CCK` // Cocos (Keeling) Islands
+	TableISO3166Switzerland                      TableISO3166 = `CHE` // Switzerland
+	TableISO3166Chile                            TableISO3166 = `CHL` // Chile
+	TableISO3166China                            TableISO3166 = `CHN` // China
+	TableISO3166CTeDIvoire                       TableISO3166 = `CIV` // CÃ´te d'Ivoire
+	TableISO3166Cameroon                         TableISO3166 = `CMR` // Cameroon
+	TableISO3166CongoDemocraticRepublicOfThe     TableISO3166 = `COD` // Congo, Democratic Republic of the
+	TableISO3166Congo                            TableISO3166 = `COG` // Congo
+	TableISO3166CookIslands                      TableISO3166 = `COK` // Cook Islands
+	TableISO3166Colombia                         TableISO3166 = `COL` // Colombia
+	TableISO3166Comoros                          TableISO3166 = `COM` // Comoros
+	TableISO3166CaboVerde                        TableISO3166 = `CPV` // Cabo Verde
+	TableISO3166CostaRica                        TableISO3166 = `CRI` // Costa Rica
+	TableISO3166Cuba                             TableISO3166 = `CUB` // Cuba
+	TableISO3166CuraAo                           TableISO3166 = `CUW` // CuraÃ§ao
+	TableISO3166ChristmasIsland                  TableISO3166 = `CXR` // Christmas Island
+	TableISO3166CaymanIslands                    TableISO3166 = `CYM` // Cayman Islands
+	TableISO3166Cyprus                           TableISO3166 = `CYP` // Cyprus
+	TableISO3166Czechia                          TableISO3166 = `CZE` // Czechia
+	TableISO3166Germany                          TableISO3166 = `DEU` // Germany
+	TableISO3166Djibouti                         TableISO3166 = `DJI` // Djibouti
+	TableISO3166Dominica                         TableISO3166 = `DMA` // Dominica
+	TableISO3166Denmark                          TableISO3166 = `DNK` // Denmark
+	TableISO3166DominicanRepublic                TableISO3166 = `DOM` // Dominican Republic
+	TableISO3166Algeria                          TableISO3166 = `DZA` // Algeria
+	TableISO3166Ecuador                          TableISO3166 = `ECU` // Ecuador
+	TableISO3166Egypt                            TableISO3166 = `EGY` // Egypt
+	TableISO3166Eritrea                          TableISO3166 = `ERI` // Eritrea
+	TableISO3166WesternSahara                    TableISO3166 = `ESH` // Western Sahara
+	TableISO3166Spain                            TableISO3166 = `ESP` // Spain
+	TableISO3166Estonia                          TableISO3166 = `EST` // Estonia
+	TableISO3166Ethiopia                         TableISO3166 = `ETH` // Ethiopia
+	TableISO3166Finland                          TableISO3166 = `FIN` // Finland
+	TableISO3166Fiji                             TableISO3166 = `FJI` // Fiji
+	TableISO3166FalklandIslandsMalvinas          TableISO3166 = `FLK` // Falkland Islands (Malvinas)
+	TableISO3166France                           TableISO3166 = `FRA` // France
+	TableISO3166FaroeIslands                     TableISO3166 = `FRO` // Faroe Islands
+	TableISO3166MicronesiaFederatedStatesOf      TableISO3166 = `FSM` // Micronesia (Federated States of)
+	TableISO3166Gabon                            TableISO3166 = `GAB` // Gabon
+	TableISO3166UnitedKingdomOfGreatBritainAnd   TableISO3166 = `GBR` // United Kingdom of Great Britain and Northern Ireland
+	TableISO3166Georgia                          TableISO3166 = `GEO` // Georgia
+	TableISO3166Guernsey                         TableISO3166 = `GGY` // Guernsey
+	TableISO3166Ghana                            TableISO3166 = `GHA` // Ghana
+	TableISO3166Gibraltar                        TableISO3166 = `GIB` // Gibraltar
+	TableISO3166Guinea                           TableISO3166 = `GIN` // Guinea
+	TableISO3166Guadeloupe                       TableISO3166 = `GLP` // Guadeloupe
+	TableISO3166Gambia                           TableISO3166 = `GMB` // Gambia
+	TableISO3166GuineaBissau                     TableISO3166 = `GNB` // Guinea-Bissau
+	TableISO3166EquatorialGuinea                 TableISO3166 = `GNQ` // Equatorial Guinea
+	TableISO3166Greece                           TableISO3166 = `GRC` // Greece
+	TableISO3166Grenada                          TableISO3166 = `GRD` // Grenada
+	TableISO3166Greenland                        TableISO3166 = `GRL` // Greenland
+	TableISO3166Guatemala                        TableISO3166 = `GTM` // Guatemala
+	TableISO3166FrenchGuiana                     TableISO3166 = `GUF` // French Guiana
+	TableISO3166Guam                             TableISO3166 = `GUM` // Guam
+	TableISO3166Guyana                           TableISO3166 = `GUY` // Guyana
+	TableISO3166HongKong                         TableISO3166 = `HKG` // Hong Kong
+	TableISO3166HeardIslandAndMcDonaldIslands    TableISO3166 = `HMD` // Heard Island and McDonald Islands
+	TableISO3166Honduras                         TableISO3166 = `HND` // Honduras
+	TableISO3166Croatia                          TableISO3166 = `HRV` // Croatia
+	TableISO3166Haiti                            TableISO3166 = `HTI` // Haiti
+	TableISO3166Hungary                          TableISO3166 = `HUN` // Hungary
+	TableISO3166Indonesia                        TableISO3166 = `IDN` // Indonesia
+	TableISO3166IsleOfMan                        TableISO3166 = `IMN` // Isle of Man
+	TableISO3166India                            TableISO3166 = `IND` // India
+	TableISO3166BritishIndianOceanTerritory      TableISO3166 = `IOT` // British Indian Ocean Territory
+	TableISO3166Ireland                          TableISO3166 = `IRL` // Ireland
+	TableISO3166IranIslamicRepublicOf            TableISO3166 = `IRN` // Iran (Islamic Republic of)
+	TableISO3166Iraq                             TableISO3166 = `IRQ` // Iraq
+	TableISO3166Iceland                          TableISO3166 = `ISL` // Iceland
+	TableISO3166Israel                           TableISO3166 = `ISR` // Israel
+	TableISO3166Italy                            TableISO3166 = `ITA` // Italy
+	TableISO3166Jamaica                          TableISO3166 = `JAM` // Jamaica
+	TableISO3166Jersey                           TableISO3166 = `JEY` // Jersey
+	TableISO3166Jordan                           TableISO3166 = `JOR` // Jordan
+	TableISO3166Japan                            TableISO3166 = `JPN` // Japan
+	TableISO3166Kazakhstan                       TableISO3166 = `KAZ` // Kazakhstan
+	TableISO3166Kenya                            TableISO3166 = `KEN` // Kenya
+	TableISO3166Kyrgyzstan                       TableISO3166 = `KGZ` // Kyrgyzstan
+	TableISO3166Cambodia                         TableISO3166 = `KHM` // Cambodia
+	TableISO3166Kiribati                         TableISO3166 = `KIR` // Kiribati
+	TableISO3166SaintKittsAndNevis               TableISO3166 = `KNA` // Saint Kitts and Nevis
+	TableISO3166KoreaRepublicOf                  TableISO3166 = `KOR` // Korea, Republic of
+	TableISO3166Kuwait                           TableISO3166 = `KWT` // Kuwait
+	TableISO3166LaoPeopleSDemocraticRepublic     TableISO3166 = `LAO` // Lao People's Democratic Republic
+	TableISO3166Lebanon                          TableISO3166 = `LBN` // Lebanon
+	TableISO3166Liberia                          TableISO3166 = `LBR` // Liberia
+	TableISO3166Libya                            TableISO3166 = `LBY` // Libya
+	TableISO3166SaintLucia                       TableISO3166 = `LCA` // Saint Lucia
+	TableISO3166Liechtenstein                    TableISO3166 = `LIE` // Liechtenstein
+	TableISO3166SriLanka                         TableISO3166 = `LKA` // Sri Lanka
+	TableISO3166Lesotho                          TableISO3166 = `LSO` // Lesotho
+	TableISO3166Lithuania                        TableISO3166 = `LTU` // Lithuania
+	TableISO3166Luxembourg                       TableISO3166 = `LUX` // Luxembourg
+	TableISO3166Latvia                           TableISO3166 = `LVA` // Latvia
+	TableISO3166Macao                            TableISO3166 = `MAC` // Macao
+	TableISO3166SaintMartinFrenchPart            TableISO3166 = `MAF` // Saint Martin (French part)
+	TableISO3166Morocco                          TableISO3166 = `MAR` // Morocco
+	TableISO3166Monaco                           TableISO3166 = `MCO` // Monaco
+	TableISO3166MoldovaRepublicOf                TableISO3166 = `MDA` // Moldova, Republic of
+	TableISO3166Madagascar                       TableISO3166 = `MDG` // Madagascar
+	TableISO3166Maldives                         TableISO3166 = `MDV` // Maldives
+	TableISO3166Mexico                           TableISO3166 = `MEX` // Mexico
+	TableISO3166MarshallIslands                  TableISO3166 = `MHL` // Marshall Islands
+	TableISO3166NorthMacedonia                   TableISO3166 = `MKD` // North Macedonia
+	TableISO3166Mali                             TableISO3166 = `MLI` // Mali
+	TableISO3166Malta                            TableISO3166 = `MLT` // Malta
+	TableISO3166Myanmar                          TableISO3166 = `MMR` // Myanmar
+	TableISO3166Montenegro                       TableISO3166 = `MNE` // Montenegro
+	TableISO3166Mongolia                         TableISO3166 = `MNG` // Mongolia
+	TableISO3166NorthernMarianaIslands           TableISO3166 = `MNP` // Northern Mariana Islands
+	TableISO3166Mozambique                       TableISO3166 = `MOZ` // Mozambique
+	TableISO3166Mauritania                       TableISO3166 = `MRT` // Mauritania
+	TableISO3166Montserrat                       TableISO3166 = `MSR` // Montserrat
+	TableISO3166Martinique                       TableISO3166 = `MTQ` // Martinique
+	TableISO3166Mauritius                        TableISO3166 = `MUS` // Mauritius
+	TableISO3166Malawi                           TableISO3166 = `MWI` // Malawi
+	TableISO3166Malaysia                         TableISO3166 = `MYS` // Malaysia
+	TableISO3166Mayotte                          TableISO3166 = `MYT` // Mayotte
+	TableISO3166Namibia                          TableISO3166 = `NAM` // Namibia
+	TableISO3166NewCaledonia                     TableISO3166 = `NCL` // New Caledonia
+	TableISO3166Niger                            TableISO3166 = `NER` // Niger
+	TableISO3166NorfolkIsland                    TableISO3166 = `NFK` // Norfolk Island
+	TableISO3166Nigeria                          TableISO3166 = `NGA` // Nigeria
+	TableISO3166Nicaragua                        TableISO3166 = `NIC` // Nicaragua
+	TableISO3166Niue                             TableISO3166 = `NIU` // Niue
+	TableISO3166Netherlands                      TableISO3166 = `NLD` // Netherlands
+	TableISO3166Norway                           TableISO3166 = `NOR` // Norway
+	TableISO3166Nepal                            TableISO3166 = `NPL` // Nepal
+	TableISO3166Nauru                            TableISO3166 = `NRU` // Nauru
+	TableISO3166NewZealand                       TableISO3166 = `NZL` // New Zealand
+	TableISO3166Oman                             TableISO3166 = `OMN` // Oman
+	TableISO3166Pakistan                         TableISO3166 = `PAK` // Pakistan
+	TableISO3166Panama                           TableISO3166 = `PAN` // Panama
+	TableISO3166Pitcairn                         TableISO3166 = `PCN` // Pitcairn
+	TableISO3166Peru                             TableISO3166 = `PER` // Peru
+	TableISO3166Philippines                      TableISO3166 = `PHL` // Philippines
+	TableISO3166Palau                            TableISO3166 = `PLW` // Palau
+	TableISO3166PapuaNewGuinea                   TableISO3166 = `PNG` // Papua New Guinea
+	TableISO3166Poland                           TableISO3166 = `POL` // Poland
+	TableISO3166PuertoRico                       TableISO3166 = `PRI` // Puerto Rico
+	TableISO3166KoreaDemocraticPeopleSRepublicOf TableISO3166 = `PRK` // Korea (Democratic People's Republic of)
+	TableISO3166Portugal                         TableISO3166 = `PRT` // Portugal
+	TableISO3166Paraguay                         TableISO3166 = `PRY` // Paraguay
+	TableISO3166PalestineStateOf                 TableISO3166 = `PSE` // Palestine, State of
+	TableISO3166FrenchPolynesia                  TableISO3166 = `PYF` // French Polynesia
+	TableISO3166Qatar                            TableISO3166 = `QAT` // Qatar
+	TableISO3166RUnion                           TableISO3166 = `REU` // RÃ©union
+	TableISO3166Romania                          TableISO3166 = `ROU` // Romania
+	TableISO3166RussianFederation                TableISO3166 = `RUS` // Russian Federation
+	TableISO3166Rwanda                           TableISO3166 = `RWA` // Rwanda
+	TableISO3166SaudiArabia                      TableISO3166 = `SAU` // Saudi Arabia
+	TableISO3166Sudan                            TableISO3166 = `SDN` // Sudan
+	TableISO3166Senegal                          TableISO3166 = `SEN` // Senegal
+	TableISO3166Singapore                        TableISO3166 = `SGP` // Singapore
+	TableISO3166SouthGeorgiaAndTheSouthSandwich  TableISO3166 = `SGS` // South Georgia and the South Sandwich Islands
+	TableISO3166SaintHelenaAscensionAndTristanDa TableISO3166 = `SHN` // Saint Helena, Ascension and Tristan da Cunha
+	TableISO3166SvalbardAndJanMayen              TableISO3166 = `SJM` // Svalbard and Jan Mayen
+	TableISO3166SolomonIslands                   TableISO3166 = `SLB` // Solomon Islands
+	TableISO3166SierraLeone                      TableISO3166 = `SLE` // Sierra Leone
+	TableISO3166ElSalvador                       TableISO3166 = `SLV` // El Salvador
+	TableISO3166SanMarino                        TableISO3166 = `SMR` // San Marino
+	TableISO3166Somalia                          TableISO3166 = `SOM` // Somalia
+	TableISO3166SaintPierreAndMiquelon           TableISO3166 = `SPM` // Saint Pierre and Miquelon
+	TableISO3166Serbia                           TableISO3166 = `SRB` // Serbia
+	TableISO3166SouthSudan                       TableISO3166 = `SSD` // South Sudan
+	TableISO3166SaoTomeAndPrincipe               TableISO3166 = `STP` // Sao Tome and Principe
+	TableISO3166Suriname                         TableISO3166 = `SUR` // Suriname
+	TableISO3166Slovakia                         TableISO3166 = `SVK` // Slovakia
+	TableISO3166Slovenia                         TableISO3166 = `SVN` // Slovenia
+	TableISO3166Sweden                           TableISO3166 = `SWE` // Sweden
+	TableISO3166Eswatini                         TableISO3166 = `SWZ` // Eswatini
+	TableISO3166SintMaartenDutchPart             TableISO3166 = `SXM` // Sint Maarten (Dutch part)
+	TableISO3166Seychelles                       TableISO3166 = `SYC` // Seychelles
+	TableISO3166SyrianArabRepublic               TableISO3166 = `SYR` // Syrian Arab Republic
+	TableISO3166TurksAndCaicosIslands            TableISO3166 = `TCA` // Turks and Caicos Islands
+	TableISO3166Chad                             TableISO3166 = `TCD` // Chad
+	TableISO3166Togo                             TableISO3166 = `TGO` // Togo
+	TableISO3166Thailand                         TableISO3166 = `THA` // Thailand
+	TableISO3166Tajikistan                       TableISO3166 = `TJK` // Tajikistan
+	TableISO3166Tokelau                          TableISO3166 = `TKL` // Tokelau
+	TableISO3166Turkmenistan                     TableISO3166 = `TKM` // Turkmenistan
+	TableISO3166TimorLeste                       TableISO3166 = `TLS` // Timor-Leste
+	TableISO3166Tonga                            TableISO3166 = `TON` // Tonga
+	TableISO3166TrinidadAndTobago                TableISO3166 = `TTO` // Trinidad and Tobago
+	TableISO3166Tunisia                          TableISO3166 = `TUN` // Tunisia
+	TableISO3166Turkey                           TableISO3166 = `TUR` // Turkey
+	TableISO3166Tuvalu                           TableISO3166 = `TUV` // Tuvalu
+	TableISO3166TaiwanProvinceOfChina            TableISO3166 = `TWN` // Taiwan, Province of China
+	TableISO3166TanzaniaUnitedRepublicOf         TableISO3166 = `TZA` // Tanzania, United Republic of
+	TableISO3166Uganda                           TableISO3166 = `UGA` // Uganda
+	TableISO3166Ukraine                          TableISO3166 = `UKR` // Ukraine
+	TableISO3166UnitedStatesMinorOutlyingIslands TableISO3166 = `UMI` // United States Minor Outlying Islands
+	TableISO3166Uruguay                          TableISO3166 = `URY` // Uruguay
+	TableISO3166UnitedStatesOfAmerica            TableISO3166 = `USA` // United States of America
+	TableISO3166Uzbekistan                       TableISO3166 = `UZB` // Uzbekistan
+	TableISO3166HolySee                          TableISO3166 = `VAT` // Holy See
+	TableISO3166SaintVincentAndTheGrenadines     TableISO3166 = `VCT` // Saint Vincent and the Grenadines
+	TableISO3166VenezuelaBolivarianRepublicOf    TableISO3166 = `VEN` // Venezuela (Bolivarian Republic of)
+	TableISO3166VirginIslandsBritish             TableISO3166 = `VGB` // Virgin Islands (British)
+	TableISO3166VirginIslandsUS                  TableISO3166 = `VIR` // Virgin Islands (U.S.)
+	TableISO3166VietNam                          TableISO3166 = `VNM` // Viet Nam
+	TableISO3166Vanuatu                          TableISO3166 = `VUT` // Vanuatu
+	TableISO3166WallisAndFutuna                  TableISO3166 = `WLF` // Wallis and Futuna
+	TableISO3166Samoa                            TableISO3166 = `WSM` // Samoa
+	TableISO3166Yemen                            TableISO3166 = `YEM` // Yemen
+	TableISO3166SouthAfrica                      TableISO3166 = `ZAF` // South Africa
+	TableISO3166Zambia                           TableISO3166 = `ZMB` // Zambia
+	TableISO3166Zimbabwe                         TableISO3166 = `ZWE` // Zimbabwe
+)
+
+// Description of the value, or empty if the value is not in table ISO3166.
+func (v TableISO3166) Description() string {
+	return tableDescription(`ISO3166`, string(v))
+}
+
+// Valid reports if the value is in table ISO3166.
+func (v TableISO3166) Valid() bool {
+	return TableValueLookup[`ISO3166`][string(v)]
+}
+
+// Table ISO3166.
+func (v TableISO3166) Table() Table {
+	return TableLookup[`ISO3166`]
+}
+
+// TableNSC1 is a value of table NSC1, Network Change Type.
+// Fields in this table are strings; convert a field, such as TableNSC1(v), to use the helpers.
+type TableNSC1 string
+
+const (
+	TableNSC1MigratesToDifferentCPU TableNSC1 = `M`  // Migrates to different CPU
+	TableNSC1ShutDown               TableNSC1 = `SD` // Shut down
+	TableNSC1StartUp                TableNSC1 = `SU` // Start up
+)
+
+// Description of the value, or empty if the value is not in table NSC1.
+func (v TableNSC1) Description() string {
+	return tableDescription(`NSC1`, string(v))
+}
+
+// Valid reports if the value is in table NSC1.
+func (v TableNSC1) Valid() bool {
+	return TableValueLookup[`NSC1`][string(v)]
+}
+
+// Table NSC1.
+func (v TableNSC1) Table() Table {
+	return TableLookup[`NSC1`]
+}
+
+// TableNST3 is a value of table NST3, Network Source Type.
+// Fields in this table are strings; convert a field, such as TableNST3(v), to use the helpers.
+type TableNST3 string
+
+const (
+	TableNST3Accept   TableNST3 = `A` // Accept
+	TableNST3Initiate TableNST3 = `I` // Initiate
+)
+
+// Description of the value, or empty if the value is not in table NST3.
+func (v TableNST3) Description() string {
+	return tableDescription(`NST3`, string(v))
+}
+
+// Valid reports if the value is in table NST3.
+func (v TableNST3) Valid() bool {
+	return TableValueLookup[`NST3`][string(v)]
+}
+
+// Table NST3.
+func (v TableNST3) Table() Table {
+	return TableLookup[`NST3`]
+}
@@ -1,4 +1,4 @@
-// Code generated by "hl7fetch -pkgdir h220 -root ./genjson -version 2.2"; DO NOT EDIT.
+// Code generated by "hl7fetch -pkgdir h220 -root ./genjson -version 2.2 -enum"; DO NOT EDIT.
 
 package h220
 
@@ -31,7 +31,7 @@ type ADD struct {
 // tables.  Each AL1 segment describes a single patient allergy
 type AL1 struct {
 	HL7                            HL7Name `hl7:",name=AL1,type=s"`
-	SetIDAllergy                   SI      `hl7:"1,required,len=4,display=Set Id - Allergy"`
+	SetIDAllergy                   SI      `hl7:"1,required,len=4,format=SI,display=Set Id - Allergy"`
 	AllergyType                    ID      `hl7:"2,len=2,table=0127,display=Allergy Type"`
 	AllergyCodeMnemonicDescription CE      `hl7:"3,required,len=60,display=Allergy Code / Mnemonic / Description"`
 	AllergySeverity                ID      `hl7:"4,len=2,table=0128,display=Allergy Severity"`
@@ -84,7 +84,7 @@ type BTS struct {
 // Final, etc.  Coding methodologies are also defined
 type DG1 struct {
 	HL7                     HL7Name    `hl7:",name=DG1,type=s"`
-	SetIDDiagnosis          SI         `hl7:"1,required,len=4,display=Set Id - Diagnosis"`
+	SetIDDiagnosis          SI         `hl7:"1,required,len=4,format=SI,display=Set Id - Diagnosis"`
 	DiagnosisCodingMethod   ID         `hl7:"2,required,len=2,table=0053,display=Diagnosis Coding Method"`
 	DiagnosisCode           ID         `hl7:"3,len=8,table=0051,display=Diagnosis Code"`
 	DiagnosisDescription    ST         `hl7:"4,len=40,display=Diagnosis Description"`
@@ -95,10 +95,10 @@ type DG1 struct {
 	DrgApprovalIndicator    ID         `hl7:"9,len=2,table=0136,display=Drg Approval Indicator"`
 	DrgGrouperReviewCode    ID         `hl7:"10,len=2,table=0056,display=Drg Grouper Review Code"`
 	OutlierType             ID         `hl7:"11,len=60,table=0083,display=Outlier Type"`
-	OutlierDays             NM         `hl7:"12,len=3,display=Outlier Days"`
-	OutlierCost             NM         `hl7:"13,len=12,display=Outlier Cost"`
+	OutlierDays             NM         `hl7:"12,len=3,format=NM,display=Outlier Days"`
+	OutlierCost             NM         `hl7:"13,len=12,format=NM,display=Outlier Cost"`
 	GrouperVersionAndType   ST         `hl7:"14,len=4,display=Grouper Version And Type"`
-	DiagnosisDrgPriority    NM         `hl7:"15,len=2,display=Diagnosis / Drg Priority"`
+	DiagnosisDrgPriority    NM         `hl7:"15,len=2,format=NM,display=Diagnosis / Drg Priority"`
 	DiagnosingClinician     *CN_PERSON `hl7:"16,len=60,display=Diagnosing Clinician"`
 }
 
@@ -116,8 +116,8 @@ type DSC struct {
 // data is lost; the data is simply treated as lines of text.
 type DSP struct {
 	HL7               HL7Name `hl7:",name=DSP,type=s"`
-	SetIDDisplayData  SI      `hl7:"1,len=4,display=Set Id - Display Data"`
-	DisplayLevel      SI      `hl7:"2,len=4,display=Display Level"`
+	SetIDDisplayData  SI      `hl7:"1,len=4,format=SI,display=Set Id - Display Data"`
+	DisplayLevel      SI      `hl7:"2,len=4,format=SI,display=Display Level"`
 	DataLine          TX      `hl7:"3,required,len=300,display=Data Line"`
 	LogicalBreakPoint ST      `hl7:"4,len=2,display=Logical Break Point"`
 	ResultID          TX      `hl7:"5,len=20,display=Result Id"`
@@ -168,7 +168,7 @@ type FHS struct {
 // The FT1 segment contains detail data necessary to post charges, payments, adjustments, etc. to patient accounting records.
 type FT1 struct {
 	HL7                             HL7Name               `hl7:",name=FT1,type=s"`
-	SetIDFinancialTransaction       SI                    `hl7:"1,len=4,display=Set Id - Financial Transaction"`
+	SetIDFinancialTransaction       SI                    `hl7:"1,len=4,format=SI,display=Set Id - Financial Transaction"`
 	TransactionID                   ST                    `hl7:"2,len=12,display=Transaction Id"`
 	TransactionBatchID              ST                    `hl7:"3,len=10,display=Transaction Batch Id"`
 	TransactionDate                 DT                    `hl7:"4,required,len=8,format=YMD,display=Transaction Date"`
@@ -177,19 +177,19 @@ type FT1 struct {
 	TransactionCode                 CE                    `hl7:"7,required,len=20,table=0132,display=Transaction Code"`
 	TransactionDescription          ST                    `hl7:"8,len=40,display=Transaction Description"`
 	TransactionDescriptionAlternate ST                    `hl7:"9,len=40,display=Transaction Description - Alternate"`
-	TransactionQuantity             NM                    `hl7:"10,len=4,display=Transaction Quantity"`
-	TransactionAmountExtended       NM                    `hl7:"11,len=12,display=Transaction Amount - Extended"`
-	TransactionAmountUnit           NM                    `hl7:"12,len=12,display=Transaction Amount - Unit"`
+	TransactionQuantity             NM                    `hl7:"10,len=4,format=NM,display=Transaction Quantity"`
+	TransactionAmountExtended       NM                    `hl7:"11,len=12,format=NM,display=Transaction Amount - Extended"`
+	TransactionAmountUnit           NM                    `hl7:"12,len=12,format=NM,display=Transaction Amount - Unit"`
 	DepartmentCode                  *CE                   `hl7:"13,len=60,table=0049,display=Department Code"`
 	InsurancePlanID                 ID                    `hl7:"14,len=8,table=0072,display=Insurance Plan Id"`
-	InsuranceAmount                 NM                    `hl7:"15,len=12,display=Insurance Amount"`
+	InsuranceAmount                 NM                    `hl7:"15,len=12,format=NM,display=Insurance Amount"`
 	AssignedPatientLocation         *CM_INTERNAL_LOCATION `hl7:"16,len=12,table=0079,display=Assigned Patient Location"`
 	FeeSchedule                     ID                    `hl7:"17,len=1,table=0024,display=Fee Schedule"`
 	PatientType                     ID                    `hl7:"18,len=2,table=0018,display=Patient Type"`
 	DiagnosisCode                   []CE                  `hl7:"19,len=8,table=0051,display=Diagnosis Code"`
 	PerformedByCode                 *CN_PERSON            `hl7:"20,len=60,table=0084,display=Performed By Code"`
 	OrderedByCode                   *CN_PERSON            `hl7:"21,len=60,display=Ordered By Code"`
-	UnitCost                        NM                    `hl7:"22,len=12,display=Unit Cost"`
+	UnitCost                        NM                    `hl7:"22,len=12,format=NM,display=Unit Cost"`
 	FillerOrderNumber               *CM_FILLER            `hl7:"23,conditional,len=75,display=Filler Order Number"`
 }
 
@@ -198,7 +198,7 @@ type FT1 struct {
 // The FTS segment defines the end of a file
 type FTS struct {
 	HL7                HL7Name `hl7:",name=FTS,type=s"`
-	FileBatchCount     NM      `hl7:"1,len=10,display=File Batch Count"`
+	FileBatchCount     NM      `hl7:"1,len=10,format=NM,display=File Batch Count"`
 	FileTrailerComment ST      `hl7:"2,len=80,display=File Trailer Comment"`
 }
 
@@ -208,7 +208,7 @@ type FTS struct {
 // for patient and insurance billing applications
 type GT1 struct {
 	HL7                           HL7Name       `hl7:",name=GT1,type=s"`
-	SetIDGuarantor                SI            `hl7:"1,required,len=4,display=Set Id - Guarantor"`
+	SetIDGuarantor                SI            `hl7:"1,required,len=4,format=SI,display=Set Id - Guarantor"`
 	GuarantorNumber               COMP_ID_DIGIT `hl7:"2,len=20,display=Guarantor Number"`
 	GuarantorName                 PN            `hl7:"3,required,len=48,display=Guarantor Name"`
 	GuarantorSpouseName           *PN           `hl7:"4,len=48,display=Guarantor Spouse Name"`
@@ -222,7 +222,7 @@ type GT1 struct {
 	GuarantorSocialSecurityNumber ST            `hl7:"12,len=11,display=Guarantor Social Security Number"`
 	GuarantorDateBegin            DT            `hl7:"13,len=8,format=YMD,display=Guarantor Date - Begin"`
 	GuarantorDateEnd              DT            `hl7:"14,len=8,format=YMD,display=Guarantor Date - End"`
-	GuarantorPriority             NM            `hl7:"15,len=2,display=Guarantor Priority"`
+	GuarantorPriority             NM            `hl7:"15,len=2,format=NM,display=Guarantor Priority"`
 	GuarantorEmployerName         ST            `hl7:"16,len=45,display=Guarantor Employer Name"`
 	GuarantorEmployerAddress      *AD           `hl7:"17,len=106,display=Guarantor Employer Address"`
 	GuarantorEmployPhoneNumber    []TN          `hl7:"18,max=3,len=40,display=Guarantor Employ Phone Number"`
@@ -237,7 +237,7 @@ type GT1 struct {
 // and insurance bills.
 type IN1 struct {
 	HL7                            HL7Name    `hl7:",name=IN1,type=s"`
-	SetIDInsurance                 SI         `hl7:"1,required,len=4,display=Set Id - Insurance"`
+	SetIDInsurance                 SI         `hl7:"1,required,len=4,format=SI,display=Set Id - Insurance"`
 	InsurancePlanID                ID         `hl7:"2,required,len=8,table=0072,display=Insurance Plan Id"`
 	InsuranceCompanyID             ST         `hl7:"3,required,len=9,display=Insurance Company Id"`
 	InsuranceCompanyName           ST         `hl7:"4,len=45,display=Insurance Company Name"`
@@ -269,15 +269,15 @@ type IN1 struct {
 	VerificationBy                 *CN_PERSON `hl7:"30,len=60,display=Verification By"`
 	TypeOfAgreementCode            ID         `hl7:"31,len=2,table=0098,display=Type Of Agreement Code"`
 	BillingStatus                  ID         `hl7:"32,len=2,table=0022,display=Billing Status"`
-	LifetimeReserveDays            NM         `hl7:"33,len=4,display=Lifetime Reserve Days"`
-	DelayBeforeLifetimeReserveDays NM         `hl7:"34,len=4,display=Delay Before Lifetime Reserve Days"`
+	LifetimeReserveDays            NM         `hl7:"33,len=4,format=NM,display=Lifetime Reserve Days"`
+	DelayBeforeLifetimeReserveDays NM         `hl7:"34,len=4,format=NM,display=Delay Before Lifetime Reserve Days"`
 	CompanyPlanCode                ID         `hl7:"35,len=8,table=0042,display=Company Plan Code"`
 	PolicyNumber                   ST         `hl7:"36,len=15,display=Policy Number"`
-	PolicyDeductible               NM         `hl7:"37,len=12,display=Policy Deductible"`
-	PolicyLimitAmount              NM         `hl7:"38,len=12,display=Policy Limit - Amount"`
-	PolicyLimitDays                NM         `hl7:"39,len=4,display=Policy Limit - Days"`
-	RoomRateSemiPrivate            NM         `hl7:"40,len=12,display=Room Rate - Semi-private"`
-	RoomRatePrivate                NM         `hl7:"41,len=12,display=Room Rate - Private"`
+	PolicyDeductible               NM         `hl7:"37,len=12,format=NM,display=Policy Deductible"`
+	PolicyLimitAmount              NM         `hl7:"38,len=12,format=NM,display=Policy Limit - Amount"`
+	PolicyLimitDays                NM         `hl7:"39,len=4,format=NM,display=Policy Limit - Days"`
+	RoomRateSemiPrivate            NM         `hl7:"40,len=12,format=NM,display=Room Rate - Semi-private"`
+	RoomRatePrivate                NM         `hl7:"41,len=12,format=NM,display=Room Rate - Private"`
 	InsuredsEmploymentStatus       *CE        `hl7:"42,len=60,table=0066,display=Insured's Employment Status"`
 	InsuredsSex                    ID         `hl7:"43,len=1,table=0001,display=Insured's Sex"`
 	InsuredsEmployerAddress        *AD        `hl7:"44,len=106,display=Insured's Employer Address"`
@@ -292,15 +292,15 @@ type IN1 struct {
 type IN2 struct {
 	HL7                                       HL7Name    `hl7:",name=IN2,type=s"`
 	InsuredsEmployeeID                        ST         `hl7:"1,len=15,display=Insured's Employee Id"`
-	InsuredsSocialSecurityNumber              NM         `hl7:"2,len=9,display=Insured's Social Security Number"`
+	InsuredsSocialSecurityNumber              NM         `hl7:"2,len=9,format=NM,display=Insured's Social Security Number"`
 	InsuredsEmployerName                      *CN_PERSON `hl7:"3,len=60,display=Insured's Employer Name"`
 	EmployerInformationData                   ID         `hl7:"4,len=1,table=0139,display=Employer Information Data"`
 	MailClaimParty                            ID         `hl7:"5,len=1,table=0137,display=Mail Claim Party"`
-	MedicareHealthInsuranceCardNumber         NM         `hl7:"6,len=15,display=Medicare Health Insurance Card Number"`
+	MedicareHealthInsuranceCardNumber         NM         `hl7:"6,len=15,format=NM,display=Medicare Health Insurance Card Number"`
 	MedicaidCaseName                          *PN        `hl7:"7,len=48,display=Medicaid Case Name"`
-	MedicaidCaseNumber                        NM         `hl7:"8,len=15,display=Medicaid Case Number"`
+	MedicaidCaseNumber                        NM         `hl7:"8,len=15,format=NM,display=Medicaid Case Number"`
 	ChampusSponsorName                        *PN        `hl7:"9,len=48,display=Champus Sponsor Name"`
-	ChampusIDNumber                           NM         `hl7:"10,len=20,display=Champus Id Number"`
+	ChampusIDNumber                           NM         `hl7:"10,len=20,format=NM,display=Champus Id Number"`
 	DependentOfChampusRecipient               ID         `hl7:"11,len=1,display=Dependent Of Champus Recipient"`
 	ChampusOrganization                       ST         `hl7:"12,len=25,display=Champus Organization"`
 	ChampusStation                            ST         `hl7:"13,len=25,display=Champus Station"`
@@ -311,7 +311,7 @@ type IN2 struct {
 	ChampusNonAvailabilityCertificationOnFile ID         `hl7:"18,len=1,table=0136,display=Champus Non-availability Certification On File"`
 	BabyCoverage                              ID         `hl7:"19,len=1,table=0136,display=Baby Coverage"`
 	CombineBabyBill                           ID         `hl7:"20,len=1,table=0136,display=Combine Baby Bill"`
-	BloodDeductible                           NM         `hl7:"21,len=1,display=Blood Deductible"`
+	BloodDeductible                           NM         `hl7:"21,len=1,format=NM,display=Blood Deductible"`
 	SpecialCoverageApprovalName               *PN        `hl7:"22,len=48,display=Special Coverage Approval Name"`
 	SpecialCoverageApprovalTitle              ST         `hl7:"23,len=30,display=Special Coverage Approval Title"`
 	NonCoveredInsuranceCode                   []ID       `hl7:"24,len=8,table=0143,display=Non-covered Insurance Code"`
@@ -329,7 +329,7 @@ type IN2 struct {
 // segment are defined by HICFA or other regulatory agencies
 type IN3 struct {
 	HL7                                HL7Name    `hl7:",name=IN3,type=s"`
-	SetIDInsuranceCertification        SI         `hl7:"1,required,len=4,display=Set Id - Insurance Certification"`
+	SetIDInsuranceCertification        SI         `hl7:"1,required,len=4,format=SI,display=Set Id - Insurance Certification"`
 	CertificationNumber                ST         `hl7:"2,len=25,display=Certification Number"`
 	CertifiedBy                        *CN_PERSON `hl7:"3,len=60,display=Certified By"`
 	CertificationRequired              ID         `hl7:"4,len=1,table=0136,display=Certification Required"`
@@ -407,7 +407,7 @@ type MSA struct {
 	AcknowledgementCode        ID      `hl7:"1,required,len=2,table=0008,display=Acknowledgement Code"`
 	MessageControlID           ST      `hl7:"2,required,len=20,display=Message Control Id"`
 	TextMessage                ST      `hl7:"3,len=80,display=Text Message"`
-	ExpectedSequenceNumber     NM      `hl7:"4,len=15,display=Expected Sequence Number"`
+	ExpectedSequenceNumber     NM      `hl7:"4,len=15,format=NM,display=Expected Sequence Number"`
 	DelayedAcknowledgementType ID      `hl7:"5,len=1,table=0102,display=Delayed Acknowledgement Type"`
 	ErrorCondition             *CE     `hl7:"6,len=100,display=Error Condition"`
 }
@@ -431,7 +431,7 @@ type MSH struct {
 	MessageControlID               ST      `hl7:"10,required,len=20,display=Message Control Id"`
 	ProcessingID                   ID      `hl7:"11,required,len=1,table=0103,display=Processing Id"`
 	VersionID                      ID      `hl7:"12,required,len=8,table=0104,display=Version Id"`
-	SequenceNumber                 NM      `hl7:"13,len=15,display=Sequence Number"`
+	SequenceNumber                 NM      `hl7:"13,len=15,format=NM,display=Sequence Number"`
 	ContinuationPointer            ST      `hl7:"14,len=180,display=Continuation Pointer"`
 	AcceptAcknowledgementType      ID      `hl7:"15,len=2,table=0155,display=Accept Acknowledgement Type"`
 	ApplicationAcknowledgementType ID      `hl7:"16,len=2,table=0155,display=Application Acknowledgement Type"`
@@ -458,7 +458,7 @@ type NCK struct {
 // Utilizing NK1-1-set ID, multiple NK1 segments can be sent to patient accounts
 type NK1 struct {
 	HL7                     HL7Name      `hl7:",name=NK1,type=s"`
-	SetIDNextOfKin          SI           `hl7:"1,required,len=4,display=Set Id - Next Of Kin"`
+	SetIDNextOfKin          SI           `hl7:"1,required,len=4,format=SI,display=Set Id - Next Of Kin"`
 	Name                    *PN          `hl7:"2,len=48,display=Name"`
 	Relationship            *CE          `hl7:"3,len=60,table=0063,display=Relationship"`
 	Address                 *AD          `hl7:"4,len=106,display=Address"`
@@ -513,16 +513,16 @@ type NST struct {
 	SourceType             ID      `hl7:"3,len=3,table=NST3,display=Source Type"`
 	StatisticsStart        TS      `hl7:"4,len=26,format=YMDHMS,display=Statistics Start"`
 	StatisticsEnd          TS      `hl7:"5,len=26,format=YMDHMS,display=Statistics End"`
-	ReceiveCharacterCount  NM      `hl7:"6,len=10,display=Receive Character Count"`
-	SendCharacterCount     NM      `hl7:"7,len=10,display=Send Character Count"`
-	MessageReceived        NM      `hl7:"8,len=10,display=Message Received"`
-	MessageSent            NM      `hl7:"9,len=10,display=Message Sent"`
-	ChecksumErrorsReceived NM      `hl7:"10,len=10,display=Checksum Errors Received"`
-	LengthErrorsReceived   NM      `hl7:"11,len=10,display=Length Errors Received"`
-	OtherErrorsReceived    NM      `hl7:"12,len=10,display=Other Errors Received"`
-	ConnectTimeouts        NM      `hl7:"13,len=10,display=Connect Timeouts"`
-	ReceiveTimeouts        NM      `hl7:"14,len=10,display=Receive Timeouts"`
-	NetworkErrors          NM      `hl7:"15,len=10,display=Network Errors"`
+	ReceiveCharacterCount  NM      `hl7:"6,len=10,format=NM,display=Receive Character Count"`
+	SendCharacterCount     NM      `hl7:"7,len=10,format=NM,display=Send Character Count"`
+	MessageReceived        NM      `hl7:"8,len=10,format=NM,display=Message Received"`
+	MessageSent            NM      `hl7:"9,len=10,format=NM,display=Message Sent"`
+	ChecksumErrorsReceived NM      `hl7:"10,len=10,format=NM,display=Checksum Errors Received"`
+	LengthErrorsReceived   NM      `hl7:"11,len=10,format=NM,display=Length Errors Received"`
+	OtherErrorsReceived    NM      `hl7:"12,len=10,format=NM,display=Other Errors Received"`
+	ConnectTimeouts        NM      `hl7:"13,len=10,format=NM,display=Connect Timeouts"`
+	ReceiveTimeouts        NM      `hl7:"14,len=10,format=NM,display=Receive Timeouts"`
+	NetworkErrors          NM      `hl7:"15,len=10,format=NM,display=Network Errors"`
 }
 
 // Notes And Comments
@@ -531,7 +531,7 @@ type NST struct {
 // and comments
 type NTE struct {
 	HL7                   HL7Name `hl7:",name=NTE,type=s"`
-	SetIDNotesAndComments SI      `hl7:"1,len=4,display=Set Id - Notes And Comments"`
+	SetIDNotesAndComments SI      `hl7:"1,len=4,format=SI,display=Set Id - Notes And Comments"`
 	SourceOfComment       ID      `hl7:"2,len=8,table=0105,display=Source Of Comment"`
 	Comment               []FT    `hl7:"3,len=65536,display=Comment"`
 }
@@ -553,7 +553,7 @@ type NTE struct {
 // obtained directly from a subject (eg., BP, Chest Xray), they represent the start and end time of the observation
 type OBR struct {
 	HL7                               HL7Name     `hl7:",name=OBR,type=s"`
-	SetIDObservationRequest           SI          `hl7:"1,conditional,len=4,display=Set Id - Observation Request"`
+	SetIDObservationRequest           SI          `hl7:"1,conditional,len=4,format=SI,display=Set Id - Observation Request"`
 	PlacerOrderNumber                 *CM_PLACER  `hl7:"2,conditional,len=75,display=Placer Order Number"`
 	FillerOrderNumber                 CM_FILLER   `hl7:"3,required,len=75,display=Filler Order Number +"`
 	UniversalServiceID                *CE         `hl7:"4,len=200,display=Universal Service Id"`
@@ -594,7 +594,7 @@ type OBR struct {
 // Observation Result
 type OBX struct {
 	HL7                                      HL7Name       `hl7:",name=OBX,type=s"`
-	SetIDObservationalSimple                 SI            `hl7:"1,len=4,display=Set Id - Observational Simple"`
+	SetIDObservationalSimple                 SI            `hl7:"1,len=4,format=SI,display=Set Id - Observational Simple"`
 	ValueType                                ID            `hl7:"2,required,len=2,table=0125,display=Value Type"`
 	ObservationIdentifier                    CE            `hl7:"3,required,len=80,display=Observation Identifier"`
 	ObservationSubID                         ST            `hl7:"4,conditional,len=20,display=Observation Sub-id"`
@@ -602,7 +602,7 @@ type OBX struct {
 	Units                                    *CE           `hl7:"6,len=60,display=Units"`
 	ReferencesRange                          ST            `hl7:"7,len=60,display=References Range"`
 	AbnormalFlags                            []ID          `hl7:"8,max=5,len=10,table=0078,display=Abnormal Flags"`
-	Probability                              NM            `hl7:"9,len=5,display=Probability"`
+	Probability                              NM            `hl7:"9,len=5,format=NM,display=Probability"`
 	NatureOfAbnormalTest                     ID            `hl7:"10,len=5,table=0080,display=Nature Of Abnormal Test"`
 	ObservationResultStatus                  ID            `hl7:"11,required,len=2,table=0085,display=Observation Result Status"`
 	EffectiveDateLastObservationNormalValues TS            `hl7:"12,len=26,format=YMDHMS,display=Effective Date Last Observation Normal Values"`
@@ -612,15 +612,25 @@ type OBX struct {
 	ResponsibleObserver                      *CN_PHYSICIAN `hl7:"16,len=60,display=Responsible Observer"`
 }
 
-func (v OBX) ChildVaries(reg func(string) (any, bool)) (reflect.Value, error) {
-	vt, ok := reg(v.ValueType)
+// FieldVaries returns the value to decode a repetition of a VARIES field into,
+// with the data type selected by another field of the segment.
+func (v OBX) FieldVaries(field int32, rep int, reg func(string) (any, bool)) (reflect.Value, error) {
+	var name string
+	switch field {
+	default:
+		return reflect.Value{}, fmt.Errorf("OBX-%d has no field to select the data type", field)
+	case 5:
+		name = v.ValueType
+	}
+	vt, ok := reg(name)
 	if !ok {
-		return reflect.Value{}, fmt.Errorf("unknown OBX data type %q", v.ValueType)
+		return reflect.Value{}, fmt.Errorf("unknown OBX data type %q", name)
 	}
+	return reflect.New(reflect.TypeOf(vt)).Elem(), nil
+}
 
-	rt := reflect.TypeOf(vt)
-	rv := reflect.New(rt)
-	return rv.Elem(), nil
+func (v OBX) ChildVaries(reg func(string) (any, bool)) (reflect.Value, error) {
+	return v.FieldVaries(5, 0, reg)
 }
 
 // Dietary Orders, Supplements, And Preferences
@@ -684,7 +694,7 @@ type ORC struct {
 // to change frequently
 type PID struct {
 	HL7                         HL7Name        `hl7:",name=PID,type=s"`
-	SetIDPatientID              SI             `hl7:"1,len=4,display=Set Id - Patient Id"`
+	SetIDPatientID              SI             `hl7:"1,len=4,format=SI,display=Set Id - Patient Id"`
 	PatientIDExternalID         *CK            `hl7:"2,len=16,display=Patient Id (external Id)"`
 	PatientIDInternalID         []CM_PAT_ID    `hl7:"3,required,len=20,display=Patient Id (internal Id)"`
 	AlternatePatientID          ST             `hl7:"4,len=12,display=Alternate Patient Id"`
@@ -708,7 +718,7 @@ type PID struct {
 	EthnicGroup                 ID             `hl7:"22,len=1,table=0189,display=Ethnic Group"`
 	BirthPlace                  ST             `hl7:"23,len=25,display=Birth Place"`
 	MultipleBirthIndicator      ID             `hl7:"24,len=2,table=0136,display=Multiple Birth Indicator"`
-	BirthOrder                  NM             `hl7:"25,len=2,display=Birth Order"`
+	BirthOrder                  NM             `hl7:"25,len=2,format=NM,display=Birth Order"`
 	Citizenship                 []ID           `hl7:"26,len=3,table=0171,display=Citizenship"`
 	VeteransMilitaryStatus      *CE            `hl7:"27,len=60,table=0172,display=Veterans Military Status"`
 }
@@ -719,20 +729,20 @@ type PID struct {
 // Surgical, Nuclear Medicine, X-Ray with contrast, etc
 type PR1 struct {
 	HL7                   HL7Name           `hl7:",name=PR1,type=s"`
-	SetIDProcedure        SI                `hl7:"1,required,len=4,display=Set Id - Procedure"`
+	SetIDProcedure        SI                `hl7:"1,required,len=4,format=SI,display=Set Id - Procedure"`
 	ProcedureCodingMethod []ID              `hl7:"2,required,len=2,table=0089,display=Procedure Coding Method"`
 	ProcedureCode         []ID              `hl7:"3,required,len=10,table=0088,display=Procedure Code"`
 	ProcedureDescription  []ST              `hl7:"4,len=40,display=Procedure Description"`
 	ProcedureDateTime     TS                `hl7:"5,required,len=26,format=YMDHMS,display=Procedure Date / Time"`
 	ProcedureType         ID                `hl7:"6,required,len=2,table=0090,display=Procedure Type"`
-	ProcedureMinutes      NM                `hl7:"7,len=4,display=Procedure Minutes"`
+	ProcedureMinutes      NM                `hl7:"7,len=4,format=NM,display=Procedure Minutes"`
 	Anesthesiologist      CN_PERSON         `hl7:"8,len=60,table=0010,display=Anesthesiologist"`
 	AnesthesiaCode        ID                `hl7:"9,len=2,table=0019,display=Anesthesia Code"`
-	AnesthesiaMinutes     NM                `hl7:"10,len=4,display=Anesthesia Minutes"`
+	AnesthesiaMinutes     NM                `hl7:"10,len=4,format=NM,display=Anesthesia Minutes"`
 	Surgeon               CN_PERSON         `hl7:"11,len=60,table=0010,display=Surgeon"`
 	ProcedurePractitioner []CM_PRACTITIONER `hl7:"12,len=60,table=0010,display=Procedure Practitioner"`
 	ConsentCode           ID                `hl7:"13,len=2,table=0059,display=Consent Code"`
-	ProcedurePriority     NM                `hl7:"14,len=2,display=Procedure Priority"`
+	ProcedurePriority     NM                `hl7:"14,len=2,format=NM,display=Procedure Priority"`
 }
 
 // Patient Visit
@@ -742,7 +752,7 @@ type PR1 struct {
 // account.  Individual sites must determine this segment's use
 type PV1 struct {
 	HL7                     HL7Name               `hl7:",name=PV1,type=s"`
-	SetIDPatientVisit       SI                    `hl7:"1,len=4,display=Set Id - Patient Visit"`
+	SetIDPatientVisit       SI                    `hl7:"1,len=4,format=SI,display=Set Id - Patient Visit"`
 	PatientClass            ID                    `hl7:"2,required,len=1,table=0004,display=Patient Class"`
 	AssignedPatientLocation *CM_INTERNAL_LOCATION `hl7:"3,len=12,table=0079,display=Assigned Patient Location"`
 	AdmissionType           ID                    `hl7:"4,len=2,table=0007,display=Admission Type"`
@@ -767,14 +777,14 @@ type PV1 struct {
 	CreditRating            ID                    `hl7:"23,len=2,table=0046,display=Credit Rating"`
 	ContractCode            []ID                  `hl7:"24,len=2,table=0044,display=Contract Code"`
 	ContractEffectiveDate   []DT                  `hl7:"25,len=8,format=YMD,display=Contract Effective Date"`
-	ContractAmount          []NM                  `hl7:"26,len=12,display=Contract Amount"`
-	ContractPeriod          []NM                  `hl7:"27,len=3,display=Contract Period"`
+	ContractAmount          []NM                  `hl7:"26,len=12,format=NM,display=Contract Amount"`
+	ContractPeriod          []NM                  `hl7:"27,len=3,format=NM,display=Contract Period"`
 	InterestCode            ID                    `hl7:"28,len=2,table=0073,display=Interest Code"`
 	TransferToBadDebtCode   ID                    `hl7:"29,len=1,table=0110,display=Transfer To Bad Debt - Code"`
 	TransferToBadDebtDate   DT                    `hl7:"30,len=8,format=YMD,display=Transfer To Bad Debt - Date"`
 	BadDebtAgencyCode       ID                    `hl7:"31,len=10,table=0021,display=Bad Debt Agency Code"`
-	BadDebtTransferAmount   NM                    `hl7:"32,len=12,display=Bad Debt Transfer Amount"`
-	BadDebtRecoveryAmount   NM                    `hl7:"33,len=12,display=Bad Debt Recovery Amount"`
+	BadDebtTransferAmount   NM                    `hl7:"32,len=12,format=NM,display=Bad Debt Transfer Amount"`
+	BadDebtRecoveryAmount   NM                    `hl7:"33,len=12,format=NM,display=Bad Debt Recovery Amount"`
 	DeleteAccountIndicator  ID                    `hl7:"34,len=1,table=0111,display=Delete Account Indicator"`
 	DeleteAccountDate       DT                    `hl7:"35,len=8,format=YMD,display=Delete Account Date"`
 	DischargeDisposition    ID                    `hl7:"36,len=3,table=0112,display=Discharge Disposition"`
@@ -787,10 +797,10 @@ type PV1 struct {
 	PriorTemporaryLocation  *CM_INTERNAL_LOCATION `hl7:"43,len=12,display=Prior Temporary Location"`
 	AdmitDateTime           TS                    `hl7:"44,len=26,format=YMDHMS,display=Admit Date / Time"`
 	DischargeDateTime       TS                    `hl7:"45,len=26,format=YMDHMS,display=Discharge Date / Time"`
-	CurrentPatientBalance   NM                    `hl7:"46,len=12,display=Current Patient Balance"`
-	TotalCharges            NM                    `hl7:"47,len=12,display=Total Charges"`
-	TotalAdjustments        NM                    `hl7:"48,len=12,display=Total Adjustments"`
-	TotalPayments           NM                    `hl7:"49,len=12,display=Total Payments"`
+	CurrentPatientBalance   NM                    `hl7:"46,len=12,format=NM,display=Current Patient Balance"`
+	TotalCharges            NM                    `hl7:"47,len=12,format=NM,display=Total Charges"`
+	TotalAdjustments        NM                    `hl7:"48,len=12,format=NM,display=Total Adjustments"`
+	TotalPayments           NM                    `hl7:"49,len=12,format=NM,display=Total Payments"`
 	AlternateVisitID        *CM_PAT_ID_0192       `hl7:"50,len=20,display=Alternate Visit Id"`
 }
 
@@ -848,7 +858,7 @@ type QRF struct {
 // RQD segment.
 type RQ1 struct {
 	HL7                  HL7Name `hl7:",name=RQ1,type=s"`
-	AnticipatedPrice     SI      `hl7:"1,len=10,display=Anticipated Price"`
+	AnticipatedPrice     SI      `hl7:"1,len=10,format=SI,display=Anticipated Price"`
 	ManufacturerID       *CE     `hl7:"2,len=60,display=Manufacturer Id"`
 	ManufacturersCatalog ST      `hl7:"3,len=16,display=Manufacturer's Catalog"`
 	VendorID             *CE     `hl7:"4,len=60,display=Vendor Id"`
@@ -862,11 +872,11 @@ type RQ1 struct {
 // RQD contains the detail for each requisitioned item.
 type RQD struct {
 	HL7                      HL7Name `hl7:",name=RQD,type=s"`
-	RequisitionLineNumber    SI      `hl7:"1,len=4,display=Requisition Line Number"`
+	RequisitionLineNumber    SI      `hl7:"1,len=4,format=SI,display=Requisition Line Number"`
 	ItemCodeInternal         *CE     `hl7:"2,len=60,display=Item Code - Internal"`
 	ItemCodeExternal         *CE     `hl7:"3,len=60,display=Item Code - External"`
 	HospitalItemCode         *CE     `hl7:"4,len=60,display=Hospital Item Code"`
-	RequisitionQuantity      NM      `hl7:"5,len=6,display=Requisition Quantity"`
+	RequisitionQuantity      NM      `hl7:"5,len=6,format=NM,display=Requisition Quantity"`
 	RequisitionUnitOfMeasure *CE     `hl7:"6,len=60,display=Requisition Unit Of Measure"`
 	DepartmentCostCenter     ID      `hl7:"7,len=30,display=Department Cost Center"`
 	ItemNaturalAccountCode   ID      `hl7:"8,len=30,display=Item Natural Account Code"`
@@ -881,12 +891,12 @@ type RQD struct {
 // the administration data.
 type RXA struct {
 	HL7                           HL7Name    `hl7:",name=RXA,type=s"`
-	GiveSubIDCounter              NM         `hl7:"1,required,len=4,display=Give Sub-id Counter"`
-	AdministrationSubIDCounter    NM         `hl7:"2,required,len=4,display=Administration Sub-id Counter"`
+	GiveSubIDCounter              NM         `hl7:"1,required,len=4,format=NM,display=Give Sub-id Counter"`
+	AdministrationSubIDCounter    NM         `hl7:"2,required,len=4,format=NM,display=Administration Sub-id Counter"`
 	DateTimeStartOfAdministration TS         `hl7:"3,required,len=26,format=YMDHMS,display=Date / Time Start Of Administration"`
 	DateTimeEndOfAdministration   TS         `hl7:"4,required,len=26,format=YMDHMS,display=Date / Time End Of Administration"`
 	AdministeredCode              CE         `hl7:"5,required,len=100,display=Administered Code"`
-	AdministeredAmount            NM         `hl7:"6,required,len=20,display=Administered Amount"`
+	AdministeredAmount            NM         `hl7:"6,required,len=20,format=NM,display=Administered Amount"`
 	AdministeredUnits             *CE        `hl7:"7,conditional,len=60,display=Administered Units"`
 	AdministeredDosageForm        *CE        `hl7:"8,len=60,display=Administered Dosage Form"`
 	AdministrationNotes           []ST       `hl7:"9,conditional,len=200,display=Administration Notes"`
@@ -905,25 +915,25 @@ type RXC struct {
 	HL7             HL7Name `hl7:",name=RXC,type=s"`
 	RxComponentType ID      `hl7:"1,required,len=1,table=0166,display=Rx Component Type"`
 	ComponentCode   CE      `hl7:"2,required,len=100,display=Component Code"`
-	ComponentAmount NM      `hl7:"3,required,len=20,display=Component Amount"`
+	ComponentAmount NM      `hl7:"3,required,len=20,format=NM,display=Component Amount"`
 	ComponentUnits  CE      `hl7:"4,required,len=20,display=Component Units"`
 }
 
 // Pharmacy Dispense
 type RXD struct {
 	HL7                                   HL7Name    `hl7:",name=RXD,type=s"`
-	DispenseSubIDCounter                  NM         `hl7:"1,required,len=4,display=Dispense Sub-id Counter"`
+	DispenseSubIDCounter                  NM         `hl7:"1,required,len=4,format=NM,display=Dispense Sub-id Counter"`
 	DispenseGiveCode                      CE         `hl7:"2,required,len=100,display=Dispense / Give Code"`
 	DateTimeDispensed                     TS         `hl7:"3,required,len=26,format=YMDHMS,display=Date / Time Dispensed"`
-	ActualDispenseAmount                  NM         `hl7:"4,required,len=20,display=Actual Dispense Amount"`
+	ActualDispenseAmount                  NM         `hl7:"4,required,len=20,format=NM,display=Actual Dispense Amount"`
 	ActualDispenseUnits                   *CE        `hl7:"5,conditional,len=60,display=Actual Dispense Units"`
 	ActualDosageForm                      *CE        `hl7:"6,len=60,display=Actual Dosage Form"`
-	PrescriptionNumber                    NM         `hl7:"7,conditional,len=20,display=Prescription Number"`
-	NumberOfRefillsRemaining              NM         `hl7:"8,conditional,len=20,display=Number Of Refills Remaining"`
+	PrescriptionNumber                    NM         `hl7:"7,conditional,len=20,format=NM,display=Prescription Number"`
+	NumberOfRefillsRemaining              NM         `hl7:"8,conditional,len=20,format=NM,display=Number Of Refills Remaining"`
 	DispenseNotes                         []ST       `hl7:"9,conditional,len=200,display=Dispense Notes"`
 	DispensingProvider                    *CN_PERSON `hl7:"10,len=200,display=Dispensing Provider"`
 	SubstitutionStatus                    ID         `hl7:"11,len=1,table=0167,display=Substitution Status"`
-	TotalDailyDose                        NM         `hl7:"12,len=10,display=Total Daily Dose"`
+	TotalDailyDose                        NM         `hl7:"12,len=10,format=NM,display=Total Daily Dose"`
 	DispenseToLocation                    *CM_LA1    `hl7:"13,conditional,len=12,display=Dispense-to location"`
 	NeedsHumanReview                      ID         `hl7:"14,len=1,table=0136,display=Needs Human Review"`
 	PharmacySpecialDispensingInstructions []CE       `hl7:"15,len=200,display=Pharmacy Special Dispensing Instructions"`
@@ -938,21 +948,21 @@ type RXE struct {
 	HL7                                       HL7Name    `hl7:",name=RXE,type=s"`
 	QuantityTiming                            TQ         `hl7:"1,required,len=200,display=Quantity / Timing"`
 	GiveCode                                  CE         `hl7:"2,required,len=100,display=Give Code"`
-	GiveAmountMinimum                         NM         `hl7:"3,required,len=20,display=Give Amount - Minimum"`
-	GiveAmountMaximum                         NM         `hl7:"4,len=20,display=Give Amount - Maximum"`
+	GiveAmountMinimum                         NM         `hl7:"3,required,len=20,format=NM,display=Give Amount - Minimum"`
+	GiveAmountMaximum                         NM         `hl7:"4,len=20,format=NM,display=Give Amount - Maximum"`
 	GiveUnits                                 CE         `hl7:"5,required,len=60,display=Give Units"`
 	GiveDosageForm                            *CE        `hl7:"6,len=60,display=Give Dosage Form"`
 	ProvidersAdministrationInstructions       []CE       `hl7:"7,len=200,display=Provider's Administration Instructions"`
 	DeliverToLocation                         *CM_LA1    `hl7:"8,conditional,len=12,display=Deliver-to Location"`
 	SubstitutionStatus                        ID         `hl7:"9,len=1,table=0167,display=Substitution Status"`
-	DispenseAmount                            NM         `hl7:"10,conditional,len=20,display=Dispense Amount"`
+	DispenseAmount                            NM         `hl7:"10,conditional,len=20,format=NM,display=Dispense Amount"`
 	DispenseUnits                             *CE        `hl7:"11,conditional,len=60,display=Dispense Units"`
-	NumberOfRefills                           NM         `hl7:"12,len=3,display=Number Of Refills"`
+	NumberOfRefills                           NM         `hl7:"12,len=3,format=NM,display=Number Of Refills"`
 	OrderingProvidersDeaNumber                *CN_PERSON `hl7:"13,conditional,len=60,display=Ordering Provider's Dea Number"`
 	PharmacistVerifierID                      *CN_PERSON `hl7:"14,conditional,len=60,display=Pharmacist Verifier Id"`
 	PrescriptionNumber                        ST         `hl7:"15,required,len=20,display=Prescription Number"`
-	NumberOfRefillsRemaining                  NM         `hl7:"16,conditional,len=20,display=Number Of Refills Remaining"`
-	NumberOfRefillsDosesDispensed             NM         `hl7:"17,conditional,len=20,display=Number Of Refills / Doses Dispensed"`
+	NumberOfRefillsRemaining                  NM         `hl7:"16,conditional,len=20,format=NM,display=Number Of Refills Remaining"`
+	NumberOfRefillsDosesDispensed             NM         `hl7:"17,conditional,len=20,format=NM,display=Number Of Refills / Doses Dispensed"`
 	DateTimeOfMostRecentRefillOrDoseDispensed TS         `hl7:"18,conditional,len=26,format=YMDHMS,display=Date / Time Of Most Recent Refill Or Dose Dispensed"`
 	TotalDailyDose                            *CQ        `hl7:"19,len=10,display=Total Daily Dose"`
 	NeedsHumanReview                          ID         `hl7:"20,len=1,table=0136,display=Needs Human Review"`
@@ -965,12 +975,12 @@ type RXE struct {
 // Pharmacy Give
 type RXG struct {
 	HL7                                       HL7Name `hl7:",name=RXG,type=s"`
-	GiveSubIDCounter                          NM      `hl7:"1,required,len=4,display=Give Sub-id Counter"`
-	DispenseSubIDCounter                      NM      `hl7:"2,len=4,display=Dispense Sub-id Counter"`
+	GiveSubIDCounter                          NM      `hl7:"1,required,len=4,format=NM,display=Give Sub-id Counter"`
+	DispenseSubIDCounter                      NM      `hl7:"2,len=4,format=NM,display=Dispense Sub-id Counter"`
 	QuantityTiming                            TQ      `hl7:"3,required,len=200,display=Quantity / Timing"`
 	GiveCode                                  CE      `hl7:"4,required,len=100,display=Give Code"`
-	GiveAmountMinimum                         NM      `hl7:"5,required,len=20,display=Give Amount - Minimum"`
-	GiveAmountMaximum                         NM      `hl7:"6,len=20,display=Give Amount - Maximum"`
+	GiveAmountMinimum                         NM      `hl7:"5,required,len=20,format=NM,display=Give Amount - Minimum"`
+	GiveAmountMaximum                         NM      `hl7:"6,len=20,format=NM,display=Give Amount - Maximum"`
 	GiveUnits                                 CE      `hl7:"7,required,len=60,display=Give Units"`
 	GiveDosageForm                            *CE     `hl7:"8,len=60,display=Give Dosage Form"`
 	AdministrationNotes                       []ST    `hl7:"9,conditional,len=200,display=Administration Notes"`
@@ -995,8 +1005,8 @@ type RXG struct {
 type RXO struct {
 	HL7                                 HL7Name    `hl7:",name=RXO,type=s"`
 	RequestedGiveCode                   CE         `hl7:"1,required,len=100,display=Requested Give Code"`
-	RequestedGiveAmountMinimum          NM         `hl7:"2,required,len=20,display=Requested Give Amount - Minimum"`
-	RequestedGiveAmountMaximum          NM         `hl7:"3,len=20,display=Requested Give Amount - Maximum"`
+	RequestedGiveAmountMinimum          NM         `hl7:"2,required,len=20,format=NM,display=Requested Give Amount - Minimum"`
+	RequestedGiveAmountMaximum          NM         `hl7:"3,len=20,format=NM,display=Requested Give Amount - Maximum"`
 	RequestedGiveUnits                  CE         `hl7:"4,required,len=60,display=Requested Give Units"`
 	RequestedDosageForm                 *CE        `hl7:"5,len=60,display=Requested Dosage Form"`
 	ProvidersPharmacyInstructions       []CE       `hl7:"6,len=200,display=Provider's Pharmacy Instructions"`
@@ -1004,9 +1014,9 @@ type RXO struct {
 	DeliverToLocation                   *CM_LA1    `hl7:"8,conditional,len=12,display=Deliver-to Location"`
 	AllowSubstitutions                  ID         `hl7:"9,len=1,table=0161,display=Allow Substitutions"`
 	RequestedDispenseCode               *CE        `hl7:"10,conditional,len=100,display=Requested Dispense Code"`
-	RequestedDispenseAmount             NM         `hl7:"11,conditional,len=20,display=Requested Dispense Amount"`
+	RequestedDispenseAmount             NM         `hl7:"11,conditional,len=20,format=NM,display=Requested Dispense Amount"`
 	RequestedDispenseUnits              *CE        `hl7:"12,conditional,len=60,display=Requested Dispense Units"`
-	NumberOfRefills                     NM         `hl7:"13,len=3,display=Number Of Refills"`
+	NumberOfRefills                     NM         `hl7:"13,len=3,format=NM,display=Number Of Refills"`
 	OrderingProvidersDeaNumber          *CN_PERSON `hl7:"14,conditional,len=60,display=Ordering Provider's Dea Number"`
 	PharmacistVerifierID                *CN_PERSON `hl7:"15,conditional,len=60,display=Pharmacist Verifier Id"`
 	NeedsHumanReview                    ID         `hl7:"16,len=1,table=0136,display=Needs Human Review"`
@@ -1033,17 +1043,17 @@ type RXR struct {
 // in the PID segment and therefore do not appear here
 type UB1 struct {
 	HL7                       HL7Name  `hl7:",name=UB1,type=s"`
-	SetIDUb82                 SI       `hl7:"1,len=4,display=Set Id - Ub82"`
-	BloodDeductible43         NM       `hl7:"2,len=1,display=Blood Deductible (43)"`
-	BloodFurnishedPintsOf40   NM       `hl7:"3,len=2,display=Blood Furnished Pints Of (40)"`
-	BloodReplacedPints41      NM       `hl7:"4,len=2,display=Blood Replaced Pints (41)"`
-	BloodNotReplacedPints42   NM       `hl7:"5,len=2,display=Blood Not Replaced Pints (42)"`
-	CoInsuranceDays25         NM       `hl7:"6,len=2,display=Co-insurance Days (25)"`
+	SetIDUb82                 SI       `hl7:"1,len=4,format=SI,display=Set Id - Ub82"`
+	BloodDeductible43         NM       `hl7:"2,len=1,format=NM,display=Blood Deductible (43)"`
+	BloodFurnishedPintsOf40   NM       `hl7:"3,len=2,format=NM,display=Blood Furnished Pints Of (40)"`
+	BloodReplacedPints41      NM       `hl7:"4,len=2,format=NM,display=Blood Replaced Pints (41)"`
+	BloodNotReplacedPints42   NM       `hl7:"5,len=2,format=NM,display=Blood Not Replaced Pints (42)"`
+	CoInsuranceDays25         NM       `hl7:"6,len=2,format=NM,display=Co-insurance Days (25)"`
 	ConditionCode3539         []ID     `hl7:"7,max=5,len=2,table=0043,display=Condition Code (35-39)"`
-	CoveredDays23             NM       `hl7:"8,len=3,display=Covered Days (23)"`
-	NonCoveredDays24          NM       `hl7:"9,len=3,display=Non-covered Days (24)"`
+	CoveredDays23             NM       `hl7:"8,len=3,format=NM,display=Covered Days (23)"`
+	NonCoveredDays24          NM       `hl7:"9,len=3,format=NM,display=Non-covered Days (24)"`
 	ValueAmountAndCode4649    []CM_UVC `hl7:"10,max=8,len=12,display=Value Amount And Code (46-49)"`
-	NumberOfGraceDays90       NM       `hl7:"11,len=2,display=Number Of Grace Days (90)"`
+	NumberOfGraceDays90       NM       `hl7:"11,len=2,format=NM,display=Number Of Grace Days (90)"`
 	SpecialProgramIndicator44 ID       `hl7:"12,len=2,display=Special Program Indicator (44)"`
 	PsroUrApprovalIndicator87 ID       `hl7:"13,len=1,display=Psro / Ur Approval Indicator (87)"`
 	PsroUrApprovedStayFrom88  DT       `hl7:"14,len=8,format=YMD,display=Psro / Ur Approved Stay - From (88)"`
@@ -1066,7 +1076,7 @@ type UB1 struct {
 // to the UB82, the element is listed with its new location in parentheses ().
 type UB2 struct {
 	HL7                       HL7Name  `hl7:",name=UB2,type=s"`
-	SetIDUb92                 SI       `hl7:"1,len=4,display=Set Id - Ub92"`
+	SetIDUb92                 SI       `hl7:"1,len=4,format=SI,display=Set Id - Ub92"`
 	CoInsuranceDays9          ST       `hl7:"2,len=3,display=Co-insurance Days (9)"`
 	ConditionCode2430         []ID     `hl7:"3,max=7,len=2,table=0043,display=Condition Code (24-30)"`
 	CoveredDays7              ST       `hl7:"4,len=3,display=Covered Days (7)"`
@@ -1,4 +1,4 @@
-// Code generated by "hl7fetch -pkgdir h231 -root ./genjson -version 2.3.1"; DO NOT EDIT.
+// Code generated by "hl7fetch -pkgdir h231 -root ./genjson -version 2.3.1 -enum"; DO NOT EDIT.
 
 package h231
 
@@ -61,8 +61,8 @@ type CE struct {
 // |128952^6^M11^ADT01|
 type CK struct {
 	HL7                                        HL7Name `hl7:",name=CK,len=0,type=d"`
-	IDNumber                                   NM      `hl7:"1,display=Id Number"`
-	CheckDigit                                 NM      `hl7:"2,display=The check digit in this data type is not an add-on produced by the message processor. It is the check digit that is part of the identifying number used in the sending application. If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null."`
+	IDNumber                                   NM      `hl7:"1,format=NM,display=Id Number"`
+	CheckDigit                                 NM      `hl7:"2,format=NM,display=The check digit in this data type is not an add-on produced by the message processor. It is the check digit that is part of the identifying number used in the sending application. If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null."`
 	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"3,table=0061,display=The check digit scheme codes are defined in HL7 table 0061 - Check digit scheme ."`
 	AssigningAuthority                         *HD     `hl7:"4,display=The assigning authority is a unique identifier of the system (or organization or agency or department) that creates the data. It is a HD data type. Assigning authorities are unique across a given HL7 implementation. User-defined table 0363 Assigning authority is used as the HL7 identifier for the user-defined table of values for the first sub-component- namespace ID."`
 }
@@ -71,9 +71,9 @@ type CK struct {
 type CM_ABS_RANGE struct {
 	HL7              HL7Name   `hl7:",name=CM_ABS_RANGE,len=0,type=d"`
 	Range            *CM_RANGE `hl7:"1,display=Range"`
-	NumericChange    NM        `hl7:"2,display=Numeric Change"`
-	PercentPerChange NM        `hl7:"3,display=Percent Per Change"`
-	Days             NM        `hl7:"4,display=Days"`
+	NumericChange    NM        `hl7:"2,format=NM,display=Numeric Change"`
+	PercentPerChange NM        `hl7:"3,format=NM,display=Percent Per Change"`
+	Days             NM        `hl7:"4,format=NM,display=Days"`
 }
 
 // Order Sequence
@@ -96,7 +96,7 @@ type CM_OSD struct {
 	FillerOrderNumberEntityIdentifier ST      `hl7:"4,required,display=Contains the first two components of the filler order number: entity identifier (ST) and namespace ID (IS) (respectively).  Uses two subcomponents since the filler order number is an EI data type.  We have not defined subsubcomponents in HL7."`
 	FillerOrderNumberNamespaceID      IS      `hl7:"5,display=Contains the first two components of the filler order number: entity identifier (ST) and namespace ID (IS) (respectively).  Uses two subcomponents since the filler order number is an EI data type.  We have not defined subsubcomponents in HL7."`
 	SequenceConditionValue            ST      `hl7:"6,display=The acceptable condition values have the form commonly used in project planning methodologies: <one of “SS”- “EE”- “SE”- or “ES”> +/- <time>   The first letter stands for start (S) or end (E) of predecessor order- where the predecessor is defined by the placer or filler order number in subcomponents 1-2 or subcomponents 3-4.   The second letter stands for the start (S) or end (E) of the successor order- where the successor order is the order containing this quantity/timing specification.   The time specifies the interval between the predecessor and successor starts or ends (see following examples). Where <time> is defined as:     - S<integer> do for <integer> seconds    - M<integer> do for <integer> minutes    - H<integer> do for <integer> hours    - D<integer> do for <integer> days    - W<integer> do for <integer> weeks    - L<integer> do for <integer> months"`
-	MaximumNumberOfRepeats            NM      `hl7:"7,display=The maximum number of repeats to be used only on cyclic groups.  The total number of repeats is constrained by the end date/time of the last repeat or the end date/time of the parent- whichever is first."`
+	MaximumNumberOfRepeats            NM      `hl7:"7,format=NM,display=The maximum number of repeats to be used only on cyclic groups.  The total number of repeats is constrained by the end date/time of the last repeat or the end date/time of the parent- whichever is first."`
 	PlacerOrderNumberUniversalID      ST      `hl7:"8,required,display=Contains the last two components of the placer order number: universal ID (ST) and universal ID type (ID) (respectively).  Uses two subcomponents since the placer order number is an EI data type.  We have not defined subsubcomponents in HL7."`
 	PlacerOrderNumberUniversalIDType  ID      `hl7:"9,display=Contains the last two components of the placer order number: universal ID (ST) and universal ID type (ID) (respectively).  Uses two subcomponents since the placer order number is an EI data type.  We have not defined subsubcomponents in HL7."`
 	FillerOrderNumberUniversalID      ST      `hl7:"10,required,display=Contains the last two components of the filler order number: universal ID (ST) and universal ID type (ID) (respectively).  Uses two subcomponents since the filler order number is an EI data type.  We have not defined subsubcomponents in HL7"`
@@ -107,7 +107,7 @@ type CM_OSD struct {
 type CM_PEN struct {
 	HL7           HL7Name `hl7:",name=CM_PEN,len=0,type=d"`
 	PenaltyType   IS      `hl7:"1,table=0148,display=Penalty Type"`
-	PenaltyAmount NM      `hl7:"2,display=Penalty Amount"`
+	PenaltyAmount NM      `hl7:"2,format=NM,display=Penalty Amount"`
 }
 
 // Wertebereich
@@ -149,8 +149,8 @@ type CP struct {
 	HL7        HL7Name `hl7:",name=CP,len=0,type=d"`
 	Price      *MO     `hl7:"1,display=Subcomponents of price: <quantity> & <denomination>"`
 	PriceType  ID      `hl7:"2,table=0205,display=A coded value- data type ID. Refer to HL7 table 0205 - Price type for valid values."`
-	FromValue  NM      `hl7:"3,display=Each is a NM data type; together they specify the range. The range can be defined as either time or quantity. For example- the range can indicate that the first 10 minutes of the procedure has one price. Another repetition of the data type can use the range to specify that the following 10 to 60 minutes of the procedure is charged at another price per; a final repetition can specify that the final 60 to N minutes of the procedure at a third price."`
-	ToValue    NM      `hl7:"4,display=See <from value> above."`
+	FromValue  NM      `hl7:"3,format=NM,display=Each is a NM data type; together they specify the range. The range can be defined as either time or quantity. For example- the range can indicate that the first 10 minutes of the procedure has one price. Another repetition of the data type can use the range to specify that the following 10 to 60 minutes of the procedure is charged at another price per; a final repetition can specify that the final 60 to N minutes of the procedure at a third price."`
+	ToValue    NM      `hl7:"4,format=NM,display=See <from value> above."`
 	RangeUnits *CE     `hl7:"5,display=Subcomponents of range units: <identifier (ID)> & <text (ST)> & <name of coding system (ST)> & <alternate identifier (ID)> & <alternate text (ST)> & <name of alternate coding system (ST)>"`
 	RangeType  ID      `hl7:"6,table=0298,display=Refers to HL7 table 0298 - CP range type for valid values."`
 }
@@ -165,7 +165,7 @@ type CP struct {
 // |150^lb&&ANSI+| weight in pounds is a customary US unit defined within ANSI+
 type CQ struct {
 	HL7      HL7Name `hl7:",name=CQ,len=0,type=d"`
-	Quantity NM      `hl7:"1,display=Quantity"`
+	Quantity NM      `hl7:"1,format=NM,display=Quantity"`
 	Units    ST      `hl7:"2,display=The units in which the quantity is expressed. Field-by-field- default units may be defined within the specifications. When the observation is measured in the default units- the units need not be transmitted. If the measure is recorded in units different from the default- the measurement units must be transmitted as the second component. If the units are ISO+ units- then units should be recorded as lowercase abbreviations as specified in Chapter 7. If the units are ANSI or local- the units and the source table must be recorded as specified in Chapter 7. But in these cases the component separator should be replaced by the subcomponent delimiter"`
 }
 
@@ -186,9 +186,9 @@ type CX struct {
 // Daily Deductible
 type DDI struct {
 	HL7          HL7Name `hl7:",name=DDI,len=0,type=d"`
-	DelayDays    NM      `hl7:"1,display=Delay Days"`
-	Amount       NM      `hl7:"2,display=Amount"`
-	NumberOfDays NM      `hl7:"3,display=Number Of Days"`
+	DelayDays    NM      `hl7:"1,format=NM,display=Delay Days"`
+	Amount       NM      `hl7:"2,format=NM,display=Amount"`
+	NumberOfDays NM      `hl7:"3,format=NM,display=Number Of Days"`
 }
 
 // Activation Date
@@ -220,9 +220,9 @@ type DLN struct {
 type DLT struct {
 	HL7               HL7Name `hl7:",name=DLT,len=0,type=d"`
 	Range             *NR     `hl7:"1,display=Range"`
-	NumericThreshold  NM      `hl7:"2,display=Numeric Threshold"`
+	NumericThreshold  NM      `hl7:"2,format=NM,display=Numeric Threshold"`
 	ChangeComputation ST      `hl7:"3,display=Change Computation"`
-	LengthOfTimeDays  NM      `hl7:"4,display=Length Of Time-days"`
+	LengthOfTimeDays  NM      `hl7:"4,format=NM,display=Length Of Time-days"`
 }
 
 // Date/time Range
@@ -250,7 +250,7 @@ type DT = time.Time
 type DTN struct {
 	HL7          HL7Name `hl7:",name=DTN,len=0,type=d"`
 	DayType      IS      `hl7:"1,table=0149,display=Day Type"`
-	NumberOfDays NM      `hl7:"2,display=Number Of Days"`
+	NumberOfDays NM      `hl7:"2,format=NM,display=Number Of Days"`
 }
 
 // Entity Identifier
@@ -275,8 +275,8 @@ type EIP struct {
 type ELD struct {
 	HL7                  HL7Name `hl7:",name=ELD,len=0,type=d"`
 	SegmentID            ST      `hl7:"1,display=Segment ID"`
-	Sequence             NM      `hl7:"2,display=Sequence"`
-	FieldPosition        NM      `hl7:"3,display=Field Position"`
+	Sequence             NM      `hl7:"2,format=NM,display=Sequence"`
+	FieldPosition        NM      `hl7:"3,format=NM,display=Field Position"`
 	CodeIdentifyingError *CE     `hl7:"4,table=0357,display=The fourth component (which references HL7 Table 0357 - Message error condition codes-  (as a CE data type)) is restricted from having any subcomponents as the subcomponent separator is now the CE’s component separator"`
 }
 
@@ -369,7 +369,7 @@ type LA2 struct {
 // Money
 type MO struct {
 	HL7          HL7Name `hl7:",name=MO,len=0,type=d"`
-	Quantity     NM      `hl7:"1,display=The first component is a quantity."`
+	Quantity     NM      `hl7:"1,format=NM,display=The first component is a quantity."`
 	Denomination ID      `hl7:"2,table=ISO4217,display=The second component is the denomination in which the quantity is expressed. The values for the denomination component are those specified in ISO-4217. If the denomination is not specified- MSH-17-country code is used to determine the default. Example:"`
 }
 
@@ -426,8 +426,8 @@ type NM = string
 // Wertebereich
 type NR struct {
 	HL7       HL7Name `hl7:",name=NR,len=0,type=d"`
-	LowValue  NM      `hl7:"1,display=Low Value"`
-	HighValue NM      `hl7:"2,display=High Value"`
+	LowValue  NM      `hl7:"1,format=NM,display=Low Value"`
+	HighValue NM      `hl7:"2,format=NM,display=High Value"`
 }
 
 // Occurence
@@ -544,7 +544,7 @@ type PTA struct {
 	HL7         HL7Name `hl7:",name=PTA,len=0,type=d"`
 	PolicyType  IS      `hl7:"1,table=0147,display=Policy Type"`
 	AmountClass IS      `hl7:"2,table=0193,display=Amount Class"`
-	Amount      NM      `hl7:"3,display=Amount"`
+	Amount      NM      `hl7:"3,format=NM,display=Amount"`
 }
 
 // Query Input Parameter List
@@ -576,7 +576,7 @@ type RCD struct {
 	HL7                HL7Name `hl7:",name=RCD,len=0,type=d"`
 	SegmentFieldName   ST      `hl7:"1,display=The HL7 segment field name- which identifies the field occupying the column. (Refer to Section 2.8.32- QIP - query input parameter list- for segment field name definition conventions.)"`
 	Hl7DataType        ST      `hl7:"2,display=The two or three character HL7 data type- as defined in Section 2.8- Data types."`
-	MaximumColumnWidth NM      `hl7:"3,display=The maximum width of the column- as dictated by the responding system. (This may vary from the HL7-defined maximum field length.)"`
+	MaximumColumnWidth NM      `hl7:"3,format=NM,display=The maximum width of the column- as dictated by the responding system. (This may vary from the HL7-defined maximum field length.)"`
 }
 
 // Reference Range
@@ -607,7 +607,7 @@ type RMC struct {
 	HL7            HL7Name `hl7:",name=RMC,len=0,type=d"`
 	RoomType       IS      `hl7:"1,table=0145,display=Room Type"`
 	AmountType     IS      `hl7:"2,table=0146,display=Amount Type"`
-	CoverageAmount NM      `hl7:"3,display=Coverage Amount"`
+	CoverageAmount NM      `hl7:"3,format=NM,display=Coverage Amount"`
 }
 
 // Scheduling Class Value Pair
@@ -686,7 +686,7 @@ type TQ struct {
 	Conjunction        ST      `hl7:"9,display=This non-null component indicates that a second timing specification is to follow using the repeat delimiter"`
 	OrderSequencing    *CM_OSD `hl7:"10,display=There are many situations- such as the creation of an order for a group of intravenous (IV) solutions- where the sequence of the individual intravenous solutions (each a service in itself) needs to be specified- e.g.- hyperalimentation with multi-vitamins in every third bottle."`
 	OccurrenceDuration *CE     `hl7:"11,display=This field contains the duration for a single performance of a service- e.g.- whirlpool twenty minutes three times per day for three days. It is optional within TQ and does not repeat."`
-	TotalOccurences    NM      `hl7:"12,display=This field contains the total number of occurrences of a service that should result from this order.  It is optional within TQ and does not repeat.  If both the end date/time and the total occurrences are valued and the occurrences would extend beyond the end date/time- then the end date/time takes precedence.  Otherwise the number of occurrences takes precedence."`
+	TotalOccurences    NM      `hl7:"12,format=NM,display=This field contains the total number of occurrences of a service that should result from this order.  It is optional within TQ and does not repeat.  If both the end date/time and the total occurrences are valued and the occurrences would extend beyond the end date/time- then the end date/time takes precedence.  Otherwise the number of occurrences takes precedence."`
 }
 
 // Time Stamp
@@ -709,7 +709,7 @@ type TX = string
 type UVC struct {
 	HL7         HL7Name `hl7:",name=UVC,len=0,type=d"`
 	ValueCode   IS      `hl7:"1,table=0153,display=Value Code"`
-	ValueAmount NM      `hl7:"2,display=Value Amount"`
+	ValueAmount NM      `hl7:"2,format=NM,display=Value Amount"`
 }
 
 // Variable Datatype
@@ -791,7 +791,7 @@ type XON struct {
 	HL7                                        HL7Name `hl7:",name=XON,len=0,type=d"`
 	OrganizationName                           ST      `hl7:"1,display=The name of the specified organization."`
 	OrganizationNameTypeCode                   IS      `hl7:"2,table=0204,display=A code that represents the type of name i.e.- legal name- display name. Refer to user-defined table 0204 - Organizational name type for suggested values."`
-	IDNumber                                   NM      `hl7:"3,display=Id Number"`
+	IDNumber                                   NM      `hl7:"3,format=NM,display=Id Number"`
 	CheckDigit                                 ST      `hl7:"4,display=The check digit in this data type is not an add-on produced by the message processor. It is the check digit that is part of the identifying number used in the sending application. If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null."`
 	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"5,table=0061,display=The check digit scheme codes are defined in HL7 table 0061 - Check digit scheme ."`
 	AssigningAuthority                         *HD     `hl7:"6,display=The assigning authority is a unique identifier of the system (or organization or agency or department) that creates the data. It is a HD data type. Assigning authorities are unique across a given HL7 implementation. User-defined table 0363 Assigning authority is used as the HL7 identifier for the user-defined table of values for the first sub-component of the HD component <namespace ID>."`
@@ -826,9 +826,9 @@ type XTN struct {
 	TelecommunicationUseCode       ID      `hl7:"2,table=0201,display=A code that represents a specific use of a telecommunicationnumber. Refer to HL7 table 0201 - Telecommunication use code for valid values."`
 	TelecommunicationEquipmentType ID      `hl7:"3,table=0202,display=A code that represents the type of telecommunicationequipment. Refer to HL7 table 0202 - Telecommunication equipment type for valid values."`
 	EmailAddress                   ST      `hl7:"4,display=Email Address"`
-	CountryCode                    NM      `hl7:"5,display=Country Code"`
-	AreaCityCode                   NM      `hl7:"6,display=Area/City Code"`
-	PhoneNumber                    NM      `hl7:"7,display=Phone Number"`
-	Extension                      NM      `hl7:"8,display=Extension"`
+	CountryCode                    NM      `hl7:"5,format=NM,display=Country Code"`
+	AreaCityCode                   NM      `hl7:"6,format=NM,display=Area/City Code"`
+	PhoneNumber                    NM      `hl7:"7,format=NM,display=Phone Number"`
+	Extension                      NM      `hl7:"8,format=NM,display=Extension"`
 	AnyText                        ST      `hl7:"9,display=Any Text"`
 }
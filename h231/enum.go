@@ -0,0 +1,13628 @@
+// Code generated by "hl7fetch -pkgdir h231 -root ./genjson -version 2.3.1 -enum"; DO NOT EDIT.
+
+package h231
+
+// Table0001 is a value of table 0001, Sex.
+// Fields in this table are strings; convert a field, such as Table0001(v), to use the helpers.
+type Table0001 string
+
+const (
+	Table0001Female  Table0001 = `F` // Female
+	Table0001Male    Table0001 = `M` // Male
+	Table0001Other   Table0001 = `O` // Other
+	Table0001Unknown Table0001 = `U` // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0001.
+func (v Table0001) Description() string {
+	return tableDescription(`0001`, string(v))
+}
+
+// Valid reports if the value is in table 0001.
+func (v Table0001) Valid() bool {
+	return TableValueLookup[`0001`][string(v)]
+}
+
+// Table 0001.
+func (v Table0001) Table() Table {
+	return TableLookup[`0001`]
+}
+
+// Table0002 is a value of table 0002, Marital status.
+// Fields in this table are strings; convert a field, such as Table0002(v), to use the helpers.
+type Table0002 string
+
+const (
+	Table0002Separated Table0002 = `A` // Separated
+	Table0002Divorced  Table0002 = `D` // Divorced
+	Table0002Married   Table0002 = `M` // Married
+	Table0002Single    Table0002 = `S` // Single
+	Table0002Widowed   Table0002 = `W` // Widowed
+)
+
+// Description of the value, or empty if the value is not in table 0002.
+func (v Table0002) Description() string {
+	return tableDescription(`0002`, string(v))
+}
+
+// Valid reports if the value is in table 0002.
+func (v Table0002) Valid() bool {
+	return TableValueLookup[`0002`][string(v)]
+}
+
+// Table 0002.
+func (v Table0002) Table() Table {
+	return TableLookup[`0002`]
+}
+
+// Table0003 is a value of table 0003, Event type.
+// Fields in this table are strings; convert a field, such as Table0003(v), to use the helpers.
+type Table0003 string
+
+const (
+	Table0003ADTACKAdmitVisitNotification                      Table0003 = `A01` // ADT/ACK - Admit/visit notification
+	Table0003ADTACKTransferAPatient                            Table0003 = `A02` // ADT/ACK - Transfer a patient
+	Table0003ADTACKDischargeEndVisit                           Table0003 = `A03` // ADT/ACK - Discharge/end visit
+	Table0003ADTACKRegisterAPatient                            Table0003 = `A04` // ADT/ACK - Register a patient
+	Table0003ADTACKPreAdmitAPatient                            Table0003 = `A05` // ADT/ACK - Pre-admit a patient
+	Table0003ADTACKChangeAnOutpatientTo                        Table0003 = `A06` // ADT/ACK - Change an outpatient to an inpatient
+	Table0003ADTACKChangeAnInpatientTo                         Table0003 = `A07` // ADT/ACK - Change an inpatient to an outpatient
+	Table0003ADTACKUpdatePatientInformation                    Table0003 = `A08` // ADT/ACK - Update patient information
+	Table0003ADTACKPatientDepartingTracking                    Table0003 = `A09` // ADT/ACK - Patient departing - tracking
+	Table0003ADTACKPatientArrivingTracking                     Table0003 = `A10` // ADT/ACK - Patient arriving - tracking
+	Table0003ADTACKCancelAdmitVisitNotification                Table0003 = `A11` // ADT/ACK - Cancel admit/visit notification
+	Table0003ADTACKCancelTransfer                              Table0003 = `A12` // ADT/ACK - Cancel transfer
+	Table0003ADTACKCancelDischargeEndVisit                     Table0003 = `A13` // ADT/ACK - Cancel discharge/end visit
+	Table0003ADTACKPendingAdmit                                Table0003 = `A14` // ADT/ACK - Pending admit
+	Table0003ADTACKPendingTransfer                             Table0003 = `A15` // ADT/ACK - Pending transfer
+	Table0003ADTACKPendingDischarge                            Table0003 = `A16` // ADT/ACK - Pending discharge
+	Table0003ADTACKSwapPatients                                Table0003 = `A17` // ADT/ACK - Swap patients
+	Table0003ADTACKMergePatientInformation                     Table0003 = `A18` // ADT/ACK - Merge patient information
+	Table0003QRYADRPatientQuery                                Table0003 = `A19` // QRY/ADR - Patient query
+	Table0003ADTACKBedStatusUpdate                             Table0003 = `A20` // ADT/ACK - Bed status update
+	Table0003ADTACKPatientGoesOnA                              Table0003 = `A21` // ADT/ACK - Patient goes on a leave of absence
+	Table0003ADTACKPatientReturnsFromA                         Table0003 = `A22` // ADT/ACK - Patient returns from a leave of absence
+	Table0003ADTACKDeleteAPatientRecord                        Table0003 = `A23` // ADT/ACK - Delete a patient record
+	Table0003ADTACKLinkPatientInformation                      Table0003 = `A24` // ADT/ACK - Link patient information
+	Table0003ADTACKCancelPendingDischarge                      Table0003 = `A25` // ADT/ACK - Cancel pending discharge
+	Table0003ADTACKCancelPendingTransfer                       Table0003 = `A26` // ADT/ACK - Cancel pending transfer
+	Table0003ADTACKCancelPendingAdmit                          Table0003 = `A27` // ADT/ACK - Cancel pending admit
+	Table0003ADTACKAddPersonInformation                        Table0003 = `A28` // ADT/ACK - Add person information
+	Table0003ADTACKDeletePersonInformation                     Table0003 = `A29` // ADT/ACK - Delete person information
+	Table0003ADTACKMergePersonInformation                      Table0003 = `A30` // ADT/ACK - Merge person information
+	Table0003ADTACKUpdatePersonInformation                     Table0003 = `A31` // ADT/ACK - Update person information
+	Table0003ADTACKCancelPatientArrivingTracking               Table0003 = `A32` // ADT/ACK - Cancel patient arriving - tracking
+	Table0003ADTACKCancelPatientDepartingTracking              Table0003 = `A33` // ADT/ACK - Cancel patient departing - tracking
+	Table0003ADTACKMergePatientInformationPatient              Table0003 = `A34` // ADT/ACK - Merge patient information - patient ID only
+	Table0003ADTACKMergePatientInformationAccount              Table0003 = `A35` // ADT/ACK - Merge patient information - account number only
+	Table0003ADTACKMergePatientInformationPatient_A36          Table0003 = `A36` // ADT/ACK - Merge patient information - patient ID and account number
+	Table0003ADTACKUnlinkPatientInformation                    Table0003 = `A37` // ADT/ACK - Unlink patient information
+	Table0003ADTACKCancelPreAdmit                              Table0003 = `A38` // ADT/ACK - Cancel pre-admit
+	Table0003ADTACKMergePersonPatientID                        Table0003 = `A39` // ADT/ACK - Merge person- patient ID
+	Table0003ADTACKMergePatientPatientIdentifier               Table0003 = `A40` // ADT/ACK - Merge patient - patient identifier list
+	Table0003ADTACKMergeAccountPatientAccount                  Table0003 = `A41` // ADT/ACK - Merge account - patient account number
+	Table0003ADTACKMergeVisitVisitNumber                       Table0003 = `A42` // ADT/ACK - Merge visit - visit number
+	Table0003ADTACKMovePatientInformationPatient               Table0003 = `A43` // ADT/ACK - Move patient information - patient identifier list
+	Table0003ADTACKMoveAccountInformationPatient               Table0003 = `A44` // ADT/ACK - Move account information - patient account number
+	Table0003ADTACKMoveVisitInformationVisit                   Table0003 = `A45` // ADT/ACK - Move visit information - visit number
+	Table0003ADTACKChangePatientID                             Table0003 = `A46` // ADT/ACK - Change patient ID
+	Table0003ADTACKChangePatientIdentifierList                 Table0003 = `A47` // ADT/ACK - Change patient identifier list
+	Table0003ADTACKChangeAlternatePatientID                    Table0003 = `A48` // ADT/ACK - Change alternate patient ID
+	Table0003ADTACKChangePatientAccountNumber                  Table0003 = `A49` // ADT/ACK - Change patient account number
+	Table0003ADTACKChangeVisitNumber                           Table0003 = `A50` // ADT/ACK - Change visit number
+	Table0003ADTACKChangeAlternateVisitID                      Table0003 = `A51` // ADT/ACK - Change alternate visit ID
+	Table0003CRMRegisterAPatientOnA                            Table0003 = `C01` // CRM - Register a patient on a clinical trial
+	Table0003CRMCancelAPatientRegistrationOn                   Table0003 = `C02` // CRM - Cancel a patient registration on clinical trial (for clerical mistakes only)
+	Table0003CRMCorrectUpdateRegistrationInformation           Table0003 = `C03` // CRM - Correct/update registration information
+	Table0003CRMPatientHasGoneOffA                             Table0003 = `C04` // CRM - Patient has gone off a clinical trial
+	Table0003CRMPatientEntersPhaseOfClinical                   Table0003 = `C05` // CRM - Patient enters phase of clinical trial
+	Table0003CRMCancelPatientEnteringAPhase                    Table0003 = `C06` // CRM - Cancel patient entering a phase (clerical mistake)
+	Table0003CRMCorrectUpdatePhaseInformation                  Table0003 = `C07` // CRM - Correct/update phase information
+	Table0003CRMPatientHasGoneOffPhase                         Table0003 = `C08` // CRM - Patient has gone off phase of clinical trial
+	Table0003CSUAutomatedTimeIntervalsForReporting             Table0003 = `C09` // CSU - Automated time intervals for reporting, like monthly
+	Table0003CSUPatientCompletesTheClinicalTrial               Table0003 = `C10` // CSU - Patient completes the clinical trial
+	Table0003CSUPatientCompletesAPhaseOf                       Table0003 = `C11` // CSU - Patient completes a phase of the clinical trial
+	Table0003CSUUpdateCorrectionOfPatientOrder                 Table0003 = `C12` // CSU - Update/correction of patient order/result information
+	Table0003QRYEQQSPQVQQRQQCancel                             Table0003 = `CNQ` // QRY/EQQ/SPQ/VQQ/RQQ - Cancel query
+	Table0003RQIRPIRequestForInsuranceInformation              Table0003 = `I01` // RQI/RPI - Request for insurance information
+	Table0003RQIRPLRequestReceiptOfPatient                     Table0003 = `I02` // RQI/RPL - Request/receipt of patient selection display list
+	Table0003RQIRPRRequestReceiptOfPatient                     Table0003 = `I03` // RQI/RPR - Request/receipt of patient selection list
+	Table0003RQDRPIRequestForPatientDemographic                Table0003 = `I04` // RQD/RPI - Request for patient demographic data
+	Table0003RQCRCIRequestForPatientClinical                   Table0003 = `I05` // RQC/RCI - Request for patient clinical information
+	Table0003RQCRCLRequestReceiptOfClinical                    Table0003 = `I06` // RQC/RCL - Request/receipt of clinical data listing
+	Table0003PINACKUnsolicitedInsuranceInformation             Table0003 = `I07` // PIN/ACK - Unsolicited insurance information
+	Table0003RQARPARequestForTreatmentAuthorization            Table0003 = `I08` // RQA/RPA - Request for treatment authorization information
+	Table0003RQARPARequestForModificationTo                    Table0003 = `I09` // RQA/RPA - Request for modification to an authorization
+	Table0003RQARPARequestForResubmissionOf                    Table0003 = `I10` // RQA/RPA - Request for resubmission of an authorization
+	Table0003RQARPARequestForCancellationOf                    Table0003 = `I11` // RQA/RPA - Request for cancellation of an authorization
+	Table0003REFRRIPatientReferral                             Table0003 = `I12` // REF/RRI - Patient referral
+	Table0003REFRRIModifyPatientReferral                       Table0003 = `I13` // REF/RRI - Modify patient referral
+	Table0003REFRRICancelPatientReferral                       Table0003 = `I14` // REF/RRI - Cancel patient referral
+	Table0003REFRRIRequestPatientReferralStatus                Table0003 = `I15` // REF/RRI - Request patient referral status
+	Table0003MFNMFKMasterFileNotOtherwise                      Table0003 = `M01` // MFN/MFK - Master file not otherwise specified (for backward compatibility only)
+	Table0003MFNMFKMasterFileStaffPractitioner                 Table0003 = `M02` // MFN/MFK - Master file - staff practitioner
+	Table0003MFNMFKMasterFileTestObservation                   Table0003 = `M03` // MFN/MFK - Master file - test/observation (for backward compatibility only)
+	Table0003MFNMFKMasterFilesChargeDescription                Table0003 = `M04` // MFN/MFK - Master files charge description
+	Table0003MFNMFKPatientLocationMasterFile                   Table0003 = `M05` // MFN/MFK - Patient location master file
+	Table0003MFNMFKClinicalStudyWithPhases                     Table0003 = `M06` // MFN/MFK - Clinical study with phases and schedules master file
+	Table0003MFNMFKClinicalStudyWithoutPhases                  Table0003 = `M07` // MFN/MFK - Clinical study without phases but with schedules master file
+	Table0003MFNMFKTestObservationNumericMaster                Table0003 = `M08` // MFN/MFK - Test/observation (numeric) master file
+	Table0003MFNMFKTestObservationCategoricalMaster            Table0003 = `M09` // MFN/MFK - Test/observation (categorical) master file
+	Table0003MFNMFKTestObservationBatteriesMaster              Table0003 = `M10` // MFN/MFK - Test /observation batteries master file
+	Table0003MFNMFKTestCalculatedObservationsMaster            Table0003 = `M11` // MFN/MFK - Test/calculated observations master file
+	Table0003ORMOrderMessageAlsoRDERDS                         Table0003 = `O01` // ORM - Order message (also RDE, RDS, RGV, RAS)
+	Table0003ORROrderResponseAlsoRRERRD                        Table0003 = `O02` // ORR - Order response (also RRE, RRD, RRG, RRA)
+	Table0003BARACKAddPatientAccounts                          Table0003 = `P01` // BAR/ACK - Add patient accounts
+	Table0003BARACKPurgePatientAccounts                        Table0003 = `P02` // BAR/ACK - Purge patient accounts
+	Table0003DFTACKPostDetailFinancialTransaction              Table0003 = `P03` // DFT/ACK - Post detail financial transaction
+	Table0003QRYDSPGenerateBillAndA                            Table0003 = `P04` // QRY/DSP - Generate bill and A/R statements
+	Table0003BARACKUpdateAccount                               Table0003 = `P05` // BAR/ACK - Update account
+	Table0003BARACKEndAccount                                  Table0003 = `P06` // BAR/ACK - End account
+	Table0003PEXUnsolicitedInitialIndividualProductExperience  Table0003 = `P07` // PEX - Unsolicited initial individual product experience report
+	Table0003PEXUnsolicitedUpdateIndividualProductExperience   Table0003 = `P08` // PEX - Unsolicited update individual product experience report
+	Table0003SURSummaryProductExperienceReport                 Table0003 = `P09` // SUR - Summary product experience report
+	Table0003PPRPCProblemAdd                                   Table0003 = `PC1` // PPR - PC/ problem add
+	Table0003PPRPCProblemUpdate                                Table0003 = `PC2` // PPR - PC/ problem update
+	Table0003PPRPCProblemDelete                                Table0003 = `PC3` // PPR - PC/ problem delete
+	Table0003QRYPCProblemQuery                                 Table0003 = `PC4` // QRY - PC/ problem query
+	Table0003PRRPCProblemResponse                              Table0003 = `PC5` // PRR - PC/ problem response
+	Table0003PGLPCGoalAdd                                      Table0003 = `PC6` // PGL - PC/ goal add
+	Table0003PGLPCGoalUpdate                                   Table0003 = `PC7` // PGL - PC/ goal update
+	Table0003PGLPCGoalDelete                                   Table0003 = `PC8` // PGL - PC/ goal delete
+	Table0003QRYPCGoalQuery                                    Table0003 = `PC9` // QRY - PC/ goal query
+	Table0003PPVPCGoalResponse                                 Table0003 = `PCA` // PPV - PC/ goal response
+	Table0003PPPPCPathwayProblemOrientedAdd                    Table0003 = `PCB` // PPP - PC/ pathway (problem-oriented) add
+	Table0003PPPPCPathwayProblemOrientedUpdate                 Table0003 = `PCC` // PPP - PC/ pathway (problem-oriented) update
+	Table0003PPPPCPathwayProblemOrientedDelete                 Table0003 = `PCD` // PPP - PC/ pathway (problem-oriented) delete
+	Table0003QRYPCPathwayProblemOrientedQuery                  Table0003 = `PCE` // QRY - PC/ pathway (problem-oriented) query
+	Table0003PTRPCPathwayProblemOrientedQuery                  Table0003 = `PCF` // PTR - PC/ pathway (problem-oriented) query response
+	Table0003PPGPCPathwayGoalOrientedAdd                       Table0003 = `PCG` // PPG - PC/ pathway (goal-oriented) add
+	Table0003PPGPCPathwayGoalOrientedUpdate                    Table0003 = `PCH` // PPG - PC/ pathway (goal-oriented) update
+	Table0003PPGPCPathwayGoalOrientedDelete                    Table0003 = `PCJ` // PPG - PC/ pathway (goal-oriented) delete
+	Table0003QRYPCPathwayGoalOrientedQuery                     Table0003 = `PCK` // QRY - PC/ pathway (goal-oriented) query
+	Table0003PPTPCPathwayGoalOrientedQuery                     Table0003 = `PCL` // PPT - PC/ pathway (goal-oriented) query response
+	Table0003QRYDSRQuerySentForImmediate                       Table0003 = `Q01` // QRY/DSR - Query sent for immediate response
+	Table0003QRYQCKQuerySentForDeferred                        Table0003 = `Q02` // QRY/QCK - Query sent for deferred response
+	Table0003DSRACKDeferredResponseToA                         Table0003 = `Q03` // DSR/ACK - Deferred response to a query
+	Table0003EQQEmbeddedQueryLanguageQuery                     Table0003 = `Q04` // EQQ - Embedded query language query
+	Table0003UDMACKUnsolicitedDisplayUpdateMessage             Table0003 = `Q05` // UDM/ACK - Unsolicited display update message
+	Table0003OSQOSRQueryForOrderStatus                         Table0003 = `Q06` // OSQ/OSR - Query for order status
+	Table0003VQQVirtualTableQuery                              Table0003 = `Q07` // VQQ - Virtual table query
+	Table0003SPQStoredProcedureRequest                         Table0003 = `Q08` // SPQ - Stored procedure request
+	Table0003RQQEventReplayQuery                               Table0003 = `Q09` // RQQ - event replay query
+	Table0003ORUACKUnsolicitedTransmissionOfAn                 Table0003 = `R01` // ORU/ACK - Unsolicited transmission of an observation message
+	Table0003QRYQueryForResultsOfObservation                   Table0003 = `R02` // QRY - Query for results of observation
+	Table0003QRYDSRDisplayOrientedResultsQuery                 Table0003 = `R03` // QRY/DSR Display-oriented results, query/unsol. update (for backward compatibility only)
+	Table0003ORFResponseToQueryTransmissionOf                  Table0003 = `R04` // ORF - Response to query; transmission of requested observation
+	Table0003QRYDSRQueryForDisplayResults                      Table0003 = `R05` // QRY/DSR - query for display results
+	Table0003UDMUnsolicitedUpdateDisplayResults                Table0003 = `R06` // UDM - unsolicited update/display results
+	Table0003EDREnhancedDisplayResponse                        Table0003 = `R07` // EDR - enhanced display response
+	Table0003TBRTabularDataResponse                            Table0003 = `R08` // TBR - tabular data response
+	Table0003ERPEventReplayResponse                            Table0003 = `R09` // ERP - event replay response
+	Table0003R0RPharmacyPrescriptionOrderQueryResponse         Table0003 = `R0R` // R0R - Pharmacy prescription order query response
+	Table0003RARPharmacyAdministrationInformationQueryResponse Table0003 = `RAR` // RAR - Pharmacy administration information query response
+	Table0003RDRPharmacyDispenseInformationQueryResponse       Table0003 = `RDR` // RDR - Pharmacy dispense information query response
+	Table0003RERPharmacyEncodedOrderInformationQuery           Table0003 = `RER` // RER - Pharmacy encoded order information query response
+	Table0003RGRPharmacyDoseInformationQueryResponse           Table0003 = `RGR` // RGR - Pharmacy dose information query response
+	Table0003SRMSRRRequestNewAppointmentBooking                Table0003 = `S01` // SRM/SRR - Request new appointment booking
+	Table0003SRMSRRRequestAppointmentRescheduling              Table0003 = `S02` // SRM/SRR - Request appointment rescheduling
+	Table0003SRMSRRRequestAppointmentModification              Table0003 = `S03` // SRM/SRR - Request appointment modification
+	Table0003SRMSRRRequestAppointmentCancellation              Table0003 = `S04` // SRM/SRR - Request appointment cancellation
+	Table0003SRMSRRRequestAppointmentDiscontinuation           Table0003 = `S05` // SRM/SRR - Request appointment discontinuation
+	Table0003SRMSRRRequestAppointmentDeletion                  Table0003 = `S06` // SRM/SRR - Request appointment deletion
+	Table0003SRMSRRRequestAdditionOfService                    Table0003 = `S07` // SRM/SRR - Request addition of service/resource on appointment
+	Table0003SRMSRRRequestModificationOfService                Table0003 = `S08` // SRM/SRR - Request modification of service/resource on appointment
+	Table0003SRMSRRRequestCancellationOfService                Table0003 = `S09` // SRM/SRR - Request cancellation of service/resource on appointment
+	Table0003SRMSRRRequestDiscontinuationOfService             Table0003 = `S10` // SRM/SRR - Request discontinuation of service/resource on appointment
+	Table0003SRMSRRRequestDeletionOfService                    Table0003 = `S11` // SRM/SRR - Request deletion of service/resource on appointment
+	Table0003SIUACKNotificationOfNewAppointment                Table0003 = `S12` // SIU/ACK - Notification of new appointment booking
+	Table0003SIUACKNotificationOfAppointmentRescheduling       Table0003 = `S13` // SIU/ACK - Notification of appointment rescheduling
+	Table0003SIUACKNotificationOfAppointmentModification       Table0003 = `S14` // SIU/ACK - Notification of appointment modification
+	Table0003SIUACKNotificationOfAppointmentCancellation       Table0003 = `S15` // SIU/ACK - Notification of appointment cancellation
+	Table0003SIUACKNotificationOfAppointmentDiscontinuation    Table0003 = `S16` // SIU/ACK - Notification of appointment discontinuation
+	Table0003SIUACKNotificationOfAppointmentDeletion           Table0003 = `S17` // SIU/ACK - Notification of appointment deletion
+	Table0003SIUACKNotificationOfAdditionOf                    Table0003 = `S18` // SIU/ACK - Notification of addition of service/resource on appointment
+	Table0003SIUACKNotificationOfModificationOf                Table0003 = `S19` // SIU/ACK - Notification of modification of service/resource on appointment
+	Table0003SIUACKNotificationOfCancellationOf                Table0003 = `S20` // SIU/ACK - Notification of cancellation of service/resource on appointment
+	Table0003SIUACKNotificationOfDiscontinuationOf             Table0003 = `S21` // SIU/ACK - Notification of discontinuation of service/resource on appointment
+	Table0003SIUACKNotificationOfDeletionOf                    Table0003 = `S22` // SIU/ACK - Notification of deletion of service/resource on appointment
+	Table0003SIUACKNotificationOfBlockedSchedule               Table0003 = `S23` // SIU/ACK - Notification of blocked schedule time slot(s)
+	Table0003SIUACKNotificationOfOpenedUnblocked               Table0003 = `S24` // SIU/ACK - Notification of opened (â€œunblockedâ€) schedule time slot(s)
+	Table0003SQMSQRScheduleQueryMessageAnd                     Table0003 = `S25` // SQM/SQR - Schedule query message and response
+	Table0003SIUACKNotificationThatPatientDid                  Table0003 = `S26` // SIU/ACK Notification that patient did not show up for schedule appointment
+	Table0003MDMACKOriginalDocumentNotification                Table0003 = `T01` // MDM/ACK - Original document notification
+	Table0003MDMACKOriginalDocumentNotificationAnd             Table0003 = `T02` // MDM/ACK - Original document notification and content
+	Table0003MDMACKDocumentStatusChangeNotification            Table0003 = `T03` // MDM/ACK - Document status change notification
+	Table0003MDMACKDocumentStatusChangeNotification_T04        Table0003 = `T04` // MDM/ACK - Document status change notification and content
+	Table0003MDMACKDocumentAddendumNotification                Table0003 = `T05` // MDM/ACK - Document addendum notification
+	Table0003MDMACKDocumentAddendumNotificationAnd             Table0003 = `T06` // MDM/ACK - Document addendum notification and content
+	Table0003MDMACKDocumentEditNotification                    Table0003 = `T07` // MDM/ACK - Document edit notification
+	Table0003MDMACKDocumentEditNotificationAnd                 Table0003 = `T08` // MDM/ACK - Document edit notification and content
+	Table0003MDMACKDocumentReplacementNotification             Table0003 = `T09` // MDM/ACK - Document replacement notification
+	Table0003MDMACKDocumentReplacementNotificationAnd          Table0003 = `T10` // MDM/ACK - Document replacement notification and content
+	Table0003MDMACKDocumentCancelNotification                  Table0003 = `T11` // MDM/ACK - Document cancel notification
+	Table0003QRYDOCDocumentQuery                               Table0003 = `T12` // QRY/DOC - Document query
+	Table0003VXQQueryForVaccinationRecord                      Table0003 = `V01` // VXQ - Query for vaccination record
+	Table0003VXXResponseToVaccinationQueryReturning            Table0003 = `V02` // VXX - Response to vaccination query returning multiple PID matches
+	Table0003VXRVaccinationRecordResponse                      Table0003 = `V03` // VXR - Vaccination record response
+	Table0003VXUUnsolicitedVaccinationRecordUpdate             Table0003 = `V04` // VXU - Unsolicited vaccination record update
+	Table0003ORUWaveformResultUnsolicitedTransmissionOf        Table0003 = `W01` // ORU - Waveform result, unsolicited transmission of requested information
+	Table0003QRFWaveformResultResponseToQuery                  Table0003 = `W02` // QRF - Waveform result, response to query
+)
+
+// Description of the value, or empty if the value is not in table 0003.
+func (v Table0003) Description() string {
+	return tableDescription(`0003`, string(v))
+}
+
+// Valid reports if the value is in table 0003.
+func (v Table0003) Valid() bool {
+	return TableValueLookup[`0003`][string(v)]
+}
+
+// Table 0003.
+func (v Table0003) Table() Table {
+	return TableLookup[`0003`]
+}
+
+// Table0004 is a value of table 0004, Patient class.
+// Fields in this table are strings; convert a field, such as Table0004(v), to use the helpers.
+type Table0004 string
+
+const (
+	Table0004Obstetrics       Table0004 = `B` // Obstetrics
+	Table0004Emergency        Table0004 = `E` // Emergency
+	Table0004Inpatient        Table0004 = `I` // Inpatient
+	Table0004Outpatient       Table0004 = `O` // Outpatient
+	Table0004Preadmit         Table0004 = `P` // Preadmit
+	Table0004RecurringPatient Table0004 = `R` // Recurring patient
+)
+
+// Description of the value, or empty if the value is not in table 0004.
+func (v Table0004) Description() string {
+	return tableDescription(`0004`, string(v))
+}
+
+// Valid reports if the value is in table 0004.
+func (v Table0004) Valid() bool {
+	return TableValueLookup[`0004`][string(v)]
+}
+
+// Table 0004.
+func (v Table0004) Table() Table {
+	return TableLookup[`0004`]
+}
+
+// Table0007 is a value of table 0007, Admission type.
+// Fields in this table are strings; convert a field, such as Table0007(v), to use the helpers.
+type Table0007 string
+
+const (
+	Table0007Accident         Table0007 = `A` // Accident
+	Table0007Emergency        Table0007 = `E` // Emergency
+	Table0007LaborAndDelivery Table0007 = `L` // Labor and Delivery
+	Table0007Routine          Table0007 = `R` // Routine
+)
+
+// Description of the value, or empty if the value is not in table 0007.
+func (v Table0007) Description() string {
+	return tableDescription(`0007`, string(v))
+}
+
+// Valid reports if the value is in table 0007.
+func (v Table0007) Valid() bool {
+	return TableValueLookup[`0007`][string(v)]
+}
+
+// Table 0007.
+func (v Table0007) Table() Table {
+	return TableLookup[`0007`]
+}
+
+// Table0008 is a value of table 0008, Acknowledgment code.
+// Fields in this table are strings; convert a field, such as Table0008(v), to use the helpers.
+type Table0008 string
+
+const (
+	Table0008OriginalModeApplicationAcceptEnhancedMode    Table0008 = `AA` // Original mode: Application Accept - Enhanced mode: Application acknowledgment: Accept
+	Table0008OriginalModeApplicationErrorEnhancedMode     Table0008 = `AE` // Original mode: Application Error - Enhanced mode: Application acknowledgment: Error
+	Table0008OriginalModeApplicationRejectEnhancedMode    Table0008 = `AR` // Original mode: Application Reject - Enhanced mode: Application acknowledgment: Reject
+	Table0008EnhancedModeAcceptAcknowledgmentCommitAccept Table0008 = `CA` // Enhanced mode: Accept acknowledgment: Commit Accept
+	Table0008EnhancedModeAcceptAcknowledgmentCommitError  Table0008 = `CE` // Enhanced mode: Accept acknowledgment: Commit Error
+	Table0008EnhancedModeAcceptAcknowledgmentCommitReject Table0008 = `CR` // Enhanced mode: Accept acknowledgment: Commit Reject
+)
+
+// Description of the value, or empty if the value is not in table 0008.
+func (v Table0008) Description() string {
+	return tableDescription(`0008`, string(v))
+}
+
+// Valid reports if the value is in table 0008.
+func (v Table0008) Valid() bool {
+	return TableValueLookup[`0008`][string(v)]
+}
+
+// Table 0008.
+func (v Table0008) Table() Table {
+	return TableLookup[`0008`]
+}
+
+// Table0009 is a value of table 0009, Ambulatory status.
+// Fields in this table are strings; convert a field, such as Table0009(v), to use the helpers.
+type Table0009 string
+
+const (
+	Table0009NoFunctionalLimitations           Table0009 = `A0` // No functional limitations
+	Table0009AmbulatesWithAssistiveDevice      Table0009 = `A1` // Ambulates with assistive device
+	Table0009WheelchairStretcherBound          Table0009 = `A2` // Wheelchair/stretcher bound
+	Table0009ComatoseNonResponsive             Table0009 = `A3` // Comatose; non-responsive
+	Table0009Disoriented                       Table0009 = `A4` // Disoriented
+	Table0009VisionImpaired                    Table0009 = `A5` // Vision impaired
+	Table0009HearingImpaired                   Table0009 = `A6` // Hearing impaired
+	Table0009SpeechImpaired                    Table0009 = `A7` // Speech impaired
+	Table0009NonEnglishSpeaking                Table0009 = `A8` // Non-English speaking
+	Table0009FunctionalLevelUnknown            Table0009 = `A9` // Functional level unknown
+	Table0009OxygenTherapy                     Table0009 = `B1` // Oxygen therapy
+	Table0009SpecialEquipmentTubesIVsCatheters Table0009 = `B2` // Special equipment (tubes, IVs, catheters)
+	Table0009Amputee                           Table0009 = `B3` // Amputee
+	Table0009Mastectomy                        Table0009 = `B4` // Mastectomy
+	Table0009Paraplegic                        Table0009 = `B5` // Paraplegic
+	Table0009Pregnant                          Table0009 = `B6` // Pregnant
+)
+
+// Description of the value, or empty if the value is not in table 0009.
+func (v Table0009) Description() string {
+	return tableDescription(`0009`, string(v))
+}
+
+// Valid reports if the value is in table 0009.
+func (v Table0009) Valid() bool {
+	return TableValueLookup[`0009`][string(v)]
+}
+
+// Table 0009.
+func (v Table0009) Table() Table {
+	return TableLookup[`0009`]
+}
+
+// Table0017 is a value of table 0017, Transaction type.
+// Fields in this table are strings; convert a field, such as Table0017(v), to use the helpers.
+type Table0017 string
+
+const (
+	Table0017Adjustment Table0017 = `AJ` // Adjustment
+	Table0017Credit     Table0017 = `CD` // Credit
+	Table0017Charge     Table0017 = `CG` // Charge
+	Table0017Payment    Table0017 = `PY` // Payment
+)
+
+// Description of the value, or empty if the value is not in table 0017.
+func (v Table0017) Description() string {
+	return tableDescription(`0017`, string(v))
+}
+
+// Valid reports if the value is in table 0017.
+func (v Table0017) Valid() bool {
+	return TableValueLookup[`0017`][string(v)]
+}
+
+// Table 0017.
+func (v Table0017) Table() Table {
+	return TableLookup[`0017`]
+}
+
+// Table0023 is a value of table 0023, Admit source.
+// Fields in this table are strings; convert a field, such as Table0023(v), to use the helpers.
+type Table0023 string
+
+const (
+	Table0023PhysicianReferral                     Table0023 = `1` // Physician referral
+	Table0023ClinicReferral                        Table0023 = `2` // Clinic referral
+	Table0023HMOReferral                           Table0023 = `3` // HMO referral
+	Table0023TransferFromAHospital                 Table0023 = `4` // Transfer from a hospital
+	Table0023TransferFromASkilledNursingFacility   Table0023 = `5` // Transfer from a skilled nursing facility
+	Table0023TransferFromAnotherHealthCareFacility Table0023 = `6` // Transfer from another health care facility
+	Table0023EmergencyRoom                         Table0023 = `7` // Emergency room
+	Table0023CourtLawEnforcement                   Table0023 = `8` // Court/law enforcement
+	Table0023InformationNotAvailable               Table0023 = `9` // Information not available
+)
+
+// Description of the value, or empty if the value is not in table 0023.
+func (v Table0023) Description() string {
+	return tableDescription(`0023`, string(v))
+}
+
+// Valid reports if the value is in table 0023.
+func (v Table0023) Valid() bool {
+	return TableValueLookup[`0023`][string(v)]
+}
+
+// Table 0023.
+func (v Table0023) Table() Table {
+	return TableLookup[`0023`]
+}
+
+// Table0027 is a value of table 0027, Priority.
+// Fields in this table are strings; convert a field, such as Table0027(v), to use the helpers.
+type Table0027 string
+
+const (
+	Table0027AsSoonAsPossibleAPriority   Table0027 = `A` // As soon as possible (a priority lower than stat)
+	Table0027PreoperativeToBeDonePriorTo Table0027 = `P` // Preoperative (to be done prior to surgery)
+	Table0027Routine                     Table0027 = `R` // Routine
+	Table0027StatDoImmediately           Table0027 = `S` // Stat (do immediately)
+	Table0027TimingCriticalDoAsNearAs    Table0027 = `T` // Timing critical (do as near as possible to requested time)
+)
+
+// Description of the value, or empty if the value is not in table 0027.
+func (v Table0027) Description() string {
+	return tableDescription(`0027`, string(v))
+}
+
+// Valid reports if the value is in table 0027.
+func (v Table0027) Valid() bool {
+	return TableValueLookup[`0027`][string(v)]
+}
+
+// Table 0027.
+func (v Table0027) Table() Table {
+	return TableLookup[`0027`]
+}
+
+// Table0038 is a value of table 0038, Order status.
+// Fields in this table are strings; convert a field, such as Table0038(v), to use the helpers.
+type Table0038 string
+
+const (
+	Table0038SomeButNotAllResultsAvailable Table0038 = `A`  // Some, but not all, results available
+	Table0038OrderWasCanceled              Table0038 = `CA` // Order was canceled
+	Table0038OrderIsCompleted              Table0038 = `CM` // Order is completed
+	Table0038OrderWasDiscontinued          Table0038 = `DC` // Order was discontinued
+	Table0038ErrorOrderNotFound            Table0038 = `ER` // Error, order not found
+	Table0038OrderIsOnHold                 Table0038 = `HD` // Order is on hold
+	Table0038InProcessUnspecified          Table0038 = `IP` // In process, unspecified
+	Table0038OrderHasBeenReplaced          Table0038 = `RP` // Order has been replaced
+	Table0038InProcessScheduled            Table0038 = `SC` // In process, scheduled
+)
+
+// Description of the value, or empty if the value is not in table 0038.
+func (v Table0038) Description() string {
+	return tableDescription(`0038`, string(v))
+}
+
+// Valid reports if the value is in table 0038.
+func (v Table0038) Valid() bool {
+	return TableValueLookup[`0038`][string(v)]
+}
+
+// Table 0038.
+func (v Table0038) Table() Table {
+	return TableLookup[`0038`]
+}
+
+// Table0043 is a value of table 0043, Condition code.
+// Fields in this table are strings; convert a field, such as Table0043(v), to use the helpers.
+type Table0043 string
+
+const (
+	Table0043MilitaryServiceRelated                            Table0043 = `01`        // Military service related
+	Table0043ConditionIsEmploymentRelated                      Table0043 = `02`        // Condition is employment related
+	Table0043PatientCoveredByInsuranceNotReflected             Table0043 = `03`        // Patient covered by insurance not reflected here
+	Table0043HMOEnrollee                                       Table0043 = `04`        // HMO enrollee
+	Table0043LienHasBeenFiled                                  Table0043 = `05`        // Lien has been filed
+	Table0043ESRDPatientInFirst18Months                        Table0043 = `06`        // ESRD patient in first 18 months of entitlement covered by employer group health insurance
+	Table0043TreatmentOfNonTerminalConditionFor                Table0043 = `07`        // Treatment of non-terminal condition for hospice patient
+	Table0043BeneficiaryWouldNotProvideInformationConcerning   Table0043 = `08`        // Beneficiary would not provide information concerning other insurance coverage
+	Table0043NeitherPatientNorSpouseIsEmployed                 Table0043 = `09`        // Neither patient nor spouse is employed
+	Table0043PatientAndOrSpouseIsEmployed                      Table0043 = `10`        // Patient and/or spouse is employed but no EGHP exists
+	Table0043DisabledBeneficiaryButNoLGHP                      Table0043 = `11`        // Disabled beneficiary but no LGHP
+	Table0043PayerCodes                                        Table0043 = `12 ... 16` // Payer codes.
+	Table0043MaidenNameRetained                                Table0043 = `18`        // Maiden name retained
+	Table0043ChildRetainsMotherSName                           Table0043 = `19`        // Child retains mother's name
+	Table0043BeneficiaryRequestedBilling                       Table0043 = `20`        // Beneficiary requested billing
+	Table0043BillingForDenialNotice                            Table0043 = `21`        // Billing for Denial Notice
+	Table0043VAEligiblePatientChoosesToReceive                 Table0043 = `26`        // VA eligible patient chooses to receive services in a Medicare certified facility
+	Table0043PatientReferredToASoleCommunity                   Table0043 = `27`        // Patient referred to a sole community hospital for a diagnostic laboratory test
+	Table0043PatientAndOrSpouseSEGHP                           Table0043 = `28`        // Patient and/or spouse's EGHP is secondary to Medicare
+	Table0043DisabledBeneficiaryAndOrFamilyMember              Table0043 = `29`        // Disabled beneficiary and/or family member's LGHP is secondary to Medicare
+	Table0043PatientIsStudentFullTimeDay                       Table0043 = `31`        // Patient is student (full time-day)
+	Table0043PatientIsStudentCooperativeWorkStudy              Table0043 = `32`        // Patient is student (cooperative/work study program)
+	Table0043PatientIsStudentFullTimeNight                     Table0043 = `33`        // Patient is student (full time-night)
+	Table0043PatientIsStudentPartTime                          Table0043 = `34`        // Patient is student (Part time)
+	Table0043GeneralCarePatientInASpecial                      Table0043 = `36`        // General care patient in a special unit
+	Table0043WardAccommodationAsPatientRequest                 Table0043 = `37`        // Ward accommodation as patient request
+	Table0043SemiPrivateRoomNotAvailable                       Table0043 = `38`        // Semi-private room not available
+	Table0043PrivateRoomMedicallyNecessary                     Table0043 = `39`        // Private room medically necessary
+	Table0043SameDayTransfer                                   Table0043 = `40`        // Same day transfer
+	Table0043PartialHospitalization                            Table0043 = `41`        // Partial hospitalization
+	Table0043NonAvailabilityStatementOnFile                    Table0043 = `46`        // Non-availability statement on file
+	Table0043PsychiatricResidentialTreatmentCentersForChildren Table0043 = `48`        // Psychiatric residential treatment centers for children and adolescents
+	Table0043SNFBedNotAvailable                                Table0043 = `55`        // SNF bed not available
+	Table0043MedicalAppropriateness                            Table0043 = `56`        // Medical appropriateness
+	Table0043SNFReadmission                                    Table0043 = `57`        // SNF readmission
+	Table0043DayOutlier                                        Table0043 = `60`        // Day outlier
+	Table0043CostOutlier                                       Table0043 = `61`        // Cost outlier
+	Table0043PayerCode                                         Table0043 = `62`        // Payer code
+	Table0043ProviderDoesNotWishCostOutlier                    Table0043 = `66`        // Provider does not wish cost outlier payment
+	Table0043BeneficiaryElectsNotToUseLife                     Table0043 = `67`        // Beneficiary elects not to use life time reserve (LTR) days
+	Table0043BeneficiaryElectsToUseLifeTime                    Table0043 = `68`        // Beneficiary elects to use life time reserve (LTR) days
+	Table0043SelfAdministeredEPO                               Table0043 = `70`        // Self-administered EPO
+	Table0043FullCareInUnit                                    Table0043 = `71`        // Full care in unit
+	Table0043SelfCareInUnit                                    Table0043 = `72`        // Self-care in unit
+	Table0043SelfCareTraining                                  Table0043 = `73`        // Self-care training
+	Table0043Home                                              Table0043 = `74`        // Home
+	Table0043Home100Reimbursement                              Table0043 = `75`        // Home - 100% reimbursement
+	Table0043BackUpInFacilityDialysis                          Table0043 = `76`        // Back-up in facility dialysis
+	Table0043ProviderAcceptsOrIsObligatedRequired              Table0043 = `77`        // Provider accepts or is obligated/required due to a contractual arrangement or law to accept payment by a primary payer as payment in full
+	Table0043NewCoverageNotImplementedByHMO                    Table0043 = `78`        // New coverage not implemented by HMO
+	Table0043CorfServicesProvidedOffSite                       Table0043 = `79`        // Corf services provided off-site
+	Table0043Pregnant                                          Table0043 = `80`        // Pregnant
+)
+
+// Description of the value, or empty if the value is not in table 0043.
+func (v Table0043) Description() string {
+	return tableDescription(`0043`, string(v))
+}
+
+// Valid reports if the value is in table 0043.
+func (v Table0043) Valid() bool {
+	return TableValueLookup[`0043`][string(v)]
+}
+
+// Table 0043.
+func (v Table0043) Table() Table {
+	return TableLookup[`0043`]
+}
+
+// Table0048 is a value of table 0048, What subject filter.
+// Fields in this table are strings; convert a field, such as Table0048(v), to use the helpers.
+type Table0048 string
+
+const (
+	Table0048AdviceDiagnosis                            Table0048 = `ADV` // Advice/diagnosis
+	Table0048NursingUnitLookupReturnsPatientsIn         Table0048 = `ANU` // Nursing unit lookup (returns patients in beds, excluding empty beds)
+	Table0048AccountNumberQueryReturnMatchingVisit      Table0048 = `APA` // Account number query, return matching visit
+	Table0048MedicalRecordNumberQueryReturnsVisits      Table0048 = `APM` // Medical record number query, returns visits for a medical record number
+	Table0048PatientNameLookup                          Table0048 = `APN` // Patient name lookup
+	Table0048PhysicianLookup                            Table0048 = `APP` // Physician lookup
+	Table0048NursingUnitLookupReturnsPatientsIn_ARN     Table0048 = `ARN` // Nursing unit lookup (returns patients in beds, including empty beds)
+	Table0048CancelUsedToCancelAQuery                   Table0048 = `CAN` // Cancel.  Used to cancel a query
+	Table0048Demographics                               Table0048 = `DEM` // Demographics
+	Table0048Financial                                  Table0048 = `FIN` // Financial
+	Table0048Goals                                      Table0048 = `GOL` // Goals
+	Table0048MostRecentInpatient                        Table0048 = `MRI` // Most recent inpatient
+	Table0048MostRecentOutpatient                       Table0048 = `MRO` // Most recent outpatient
+	Table0048NetworkClock                               Table0048 = `NCK` // Network clock
+	Table0048NetworkStatusChange                        Table0048 = `NSC` // Network status change
+	Table0048NetworkStatistic                           Table0048 = `NST` // Network statistic
+	Table0048Order                                      Table0048 = `ORD` // Order
+	Table0048Other                                      Table0048 = `OTH` // Other
+	Table0048Problems                                   Table0048 = `PRB` // Problems
+	Table0048Procedure                                  Table0048 = `PRO` // Procedure
+	Table0048PharmacyAdministrationInformation          Table0048 = `RAR` // Pharmacy administration information
+	Table0048PharmacyDispenseInformation                Table0048 = `RDR` // Pharmacy dispense information
+	Table0048PharmacyEncodedOrderInformation            Table0048 = `RER` // Pharmacy encoded order information
+	Table0048Result                                     Table0048 = `RES` // Result
+	Table0048PharmacyGiveInformation                    Table0048 = `RGR` // Pharmacy give information
+	Table0048PharmacyPrescriptionInformation            Table0048 = `ROR` // Pharmacy prescription information
+	Table0048AllScheduleRelatedInformationIncludingOpen Table0048 = `SAL` // All schedule related information, including open slots, booked slots, blocked slots
+	Table0048BookedSlotsOnTheIdentifiedSchedule         Table0048 = `SBK` // Booked slots on the identified schedule
+	Table0048BlockedSlotsOnTheIdentifiedSchedule        Table0048 = `SBL` // Blocked slots on the identified schedule
+	Table0048OpenSlotsOnTheIdentifiedSchedule           Table0048 = `SOP` // Open slots on the identified schedule
+	Table0048TimeSlotsAvailableForASingle               Table0048 = `SSA` // Time slots available for a single appointment
+	Table0048TimeSlotsAvailableForARecurring            Table0048 = `SSR` // Time slots available for a recurring appointment
+	Table0048Status                                     Table0048 = `STA` // Status
+	Table0048VaccineInformation                         Table0048 = `VXI` // Vaccine Information
+)
+
+// Description of the value, or empty if the value is not in table 0048.
+func (v Table0048) Description() string {
+	return tableDescription(`0048`, string(v))
+}
+
+// Valid reports if the value is in table 0048.
+func (v Table0048) Valid() bool {
+	return TableValueLookup[`0048`][string(v)]
+}
+
+// Table 0048.
+func (v Table0048) Table() Table {
+	return TableLookup[`0048`]
+}
+
+// Table0052 is a value of table 0052, Diagnosis type.
+// Fields in this table are strings; convert a field, such as Table0052(v), to use the helpers.
+type Table0052 string
+
+const (
+	Table0052Admitting Table0052 = `A` // Admitting
+	Table0052Final     Table0052 = `F` // Final
+	Table0052Working   Table0052 = `W` // Working
+)
+
+// Description of the value, or empty if the value is not in table 0052.
+func (v Table0052) Description() string {
+	return tableDescription(`0052`, string(v))
+}
+
+// Valid reports if the value is in table 0052.
+func (v Table0052) Valid() bool {
+	return TableValueLookup[`0052`][string(v)]
+}
+
+// Table 0052.
+func (v Table0052) Table() Table {
+	return TableLookup[`0052`]
+}
+
+// Table0061 is a value of table 0061, Check digit scheme.
+// Fields in this table are strings; convert a field, such as Table0061(v), to use the helpers.
+type Table0061 string
+
+const (
+	Table0061ISO70641983                Table0061 = `ISO` // ISO 7064: 1983
+	Table0061Mod10Algorithm             Table0061 = `M10` // Mod 10 algorithm
+	Table0061Mod11Algorithm             Table0061 = `M11` // Mod 11 algorithm
+	Table0061CheckDigitAlgorithmInTheUS Table0061 = `NPI` // Check digit algorithm in the US National Provider Identifier
+)
+
+// Description of the value, or empty if the value is not in table 0061.
+func (v Table0061) Description() string {
+	return tableDescription(`0061`, string(v))
+}
+
+// Valid reports if the value is in table 0061.
+func (v Table0061) Valid() bool {
+	return TableValueLookup[`0061`][string(v)]
+}
+
+// Table 0061.
+func (v Table0061) Table() Table {
+	return TableLookup[`0061`]
+}
+
+// Table0062 is a value of table 0062, Event reason.
+// Fields in this table are strings; convert a field, such as Table0062(v), to use the helpers.
+type Table0062 string
+
+const (
+	Table0062PatientRequest   Table0062 = `01` // Patient request
+	Table0062PhysicianOrder   Table0062 = `02` // Physician order
+	Table0062CensusManagement Table0062 = `03` // Census management
+)
+
+// Description of the value, or empty if the value is not in table 0062.
+func (v Table0062) Description() string {
+	return tableDescription(`0062`, string(v))
+}
+
+// Valid reports if the value is in table 0062.
+func (v Table0062) Valid() bool {
+	return TableValueLookup[`0062`][string(v)]
+}
+
+// Table 0062.
+func (v Table0062) Table() Table {
+	return TableLookup[`0062`]
+}
+
+// Table0065 is a value of table 0065, Specimen action code.
+// Fields in this table are strings; convert a field, such as Table0065(v), to use the helpers.
+type Table0065 string
+
+const (
+	Table0065AddOrderedTestsToTheExisting       Table0065 = `A` // Add ordered tests to the existing specimen
+	Table0065GeneratedOrderReflexOrder          Table0065 = `G` // Generated order; reflex order
+	Table0065LabToObtainSpecimenFromPatient     Table0065 = `L` // Lab to obtain specimen from patient
+	Table0065SpecimenObtainedByServiceOtherThan Table0065 = `O` // Specimen obtained by service other than Lab
+	Table0065PendingSpecimenOrderSentPriorTo    Table0065 = `P` // Pending specimen; Order sent prior to delivery
+	Table0065RevisedOrder                       Table0065 = `R` // Revised order
+	Table0065ScheduleTheTestsSpecifiedBelow     Table0065 = `S` // Schedule the tests specified below
+)
+
+// Description of the value, or empty if the value is not in table 0065.
+func (v Table0065) Description() string {
+	return tableDescription(`0065`, string(v))
+}
+
+// Valid reports if the value is in table 0065.
+func (v Table0065) Valid() bool {
+	return TableValueLookup[`0065`][string(v)]
+}
+
+// Table 0065.
+func (v Table0065) Table() Table {
+	return TableLookup[`0065`]
+}
+
+// Table0070 is a value of table 0070, Specimen source codes.
+// Fields in this table are strings; convert a field, such as Table0070(v), to use the helpers.
+type Table0070 string
+
+const (
+	Table0070Abscess                      Table0070 = `ABS`   // Abscess
+	Table0070AmnioticFluid                Table0070 = `AMN`   // Amniotic fluid
+	Table0070Aspirate                     Table0070 = `ASP`   // Aspirate
+	Table0070BloodBag                     Table0070 = `BBL`   // Blood bag
+	Table0070WholeBody                    Table0070 = `BDY`   // Whole body
+	Table0070BileFluid                    Table0070 = `BIFL`  // Bile fluid
+	Table0070WholeBlood                   Table0070 = `BLD`   // Whole blood
+	Table0070BloodArterial                Table0070 = `BLDA`  // Blood  arterial
+	Table0070BloodCapillary               Table0070 = `BLDC`  // Blood  capillary
+	Table0070BloodVenous                  Table0070 = `BLDV`  // Blood  venous
+	Table0070Bone                         Table0070 = `BON`   // Bone
+	Table0070Basophils                    Table0070 = `BPH`   // Basophils
+	Table0070BloodProductUnit             Table0070 = `BPU`   // Blood product unit
+	Table0070Burn                         Table0070 = `BRN`   // Burn
+	Table0070Bronchial                    Table0070 = `BRO`   // Bronchial
+	Table0070BreathUseEXHLD               Table0070 = `BRTH`  // Breath (use EXHLD)
+	Table0070CalculusStone                Table0070 = `CALC`  // Calculus (=Stone)
+	Table0070CordBlood                    Table0070 = `CBLD`  // Cord blood
+	Table0070CardiacMuscle                Table0070 = `CDM`   // Cardiac muscle
+	Table0070Conjunctiva                  Table0070 = `CNJT`  // Conjunctiva
+	Table0070Cannula                      Table0070 = `CNL`   // Cannula
+	Table0070Colostrum                    Table0070 = `COL`   // Colostrum
+	Table0070CerebralSpinalFluid          Table0070 = `CSF`   // Cerebral spinal fluid
+	Table0070CatheterTip                  Table0070 = `CTP`   // Catheter tip
+	Table0070Curettage                    Table0070 = `CUR`   // Curettage
+	Table0070CervicalMucus                Table0070 = `CVM`   // Cervical mucus
+	Table0070Cervix                       Table0070 = `CVX`   // Cervix
+	Table0070Cyst                         Table0070 = `CYST`  // Cyst
+	Table0070DialysisFluid                Table0070 = `DIAF`  // Dialysis fluid
+	Table0070DoseMedOrSubstance           Table0070 = `DOSE`  // Dose med or substance
+	Table0070Drain                        Table0070 = `DRN`   // Drain
+	Table0070DuodenalFluid                Table0070 = `DUFL`  // Duodenal fluid
+	Table0070Ear                          Table0070 = `EAR`   // Ear
+	Table0070EarWaxCerumen                Table0070 = `EARW`  // Ear wax (cerumen)
+	Table0070Electrode                    Table0070 = `ELT`   // Electrode
+	Table0070Endocardium                  Table0070 = `ENDC`  // Endocardium
+	Table0070Endometrium                  Table0070 = `ENDM`  // Endometrium
+	Table0070Eosinophils                  Table0070 = `EOS`   // Eosinophils
+	Table0070ExhaledGasBreath             Table0070 = `EXHLD` // Exhaled gas (=breath)
+	Table0070Eye                          Table0070 = `EYE`   // Eye
+	Table0070Fibroblasts                  Table0070 = `FIB`   // Fibroblasts
+	Table0070Fistula                      Table0070 = `FIST`  // Fistula
+	Table0070Filter                       Table0070 = `FLT`   // Filter
+	Table0070BodyFluidUnsp                Table0070 = `FLU`   // Body fluid, unsp
+	Table0070Gas                          Table0070 = `GAS`   // Gas
+	Table0070GastricFluidContents         Table0070 = `GAST`  // Gastric fluid/contents
+	Table0070Genital                      Table0070 = `GEN`   // Genital
+	Table0070GenitalCervix                Table0070 = `GENC`  // Genital cervix
+	Table0070GenitalLochia                Table0070 = `GENL`  // Genital lochia
+	Table0070GenitalVaginal               Table0070 = `GENV`  // Genital vaginal
+	Table0070Hair                         Table0070 = `HAR`   // Hair
+	Table0070InhaledGas                   Table0070 = `IHG`   // Inhaled Gas
+	Table0070Isolate                      Table0070 = `ISLT`  // Isolate
+	Table0070IntubationTube               Table0070 = `IT`    // Intubation tube
+	Table0070Lamella                      Table0070 = `LAM`   // Lamella
+	Table0070LiquidNOS                    Table0070 = `LIQ`   // Liquid NOS
+	Table0070Line                         Table0070 = `LN`    // Line
+	Table0070LineArterial                 Table0070 = `LNA`   // Line arterial
+	Table0070LineVenous                   Table0070 = `LNV`   // Line venous
+	Table0070Lymphocytes                  Table0070 = `LYM`   // Lymphocytes
+	Table0070Macrophages                  Table0070 = `MAC`   // Macrophages
+	Table0070Marrow                       Table0070 = `MAR`   // Marrow
+	Table0070MenstrualBlood               Table0070 = `MBLD`  // Menstrual blood
+	Table0070Meconium                     Table0070 = `MEC`   // Meconium
+	Table0070BreastMilk                   Table0070 = `MILK`  // Breast milk
+	Table0070Milk                         Table0070 = `MLK`   // Milk
+	Table0070Nail                         Table0070 = `NAIL`  // Nail
+	Table0070NoseNasalPassage             Table0070 = `NOS`   // Nose (nasal passage)
+	Table0070Other                        Table0070 = `ORH`   // Other
+	Table0070PancreaticFluid              Table0070 = `PAFL`  // Pancreatic fluid
+	Table0070Patient                      Table0070 = `PAT`   // Patient
+	Table0070Plasma                       Table0070 = `PLAS`  // Plasma
+	Table0070PlasmaBag                    Table0070 = `PLB`   // Plasma bag
+	Table0070Placenta                     Table0070 = `PLC`   // Placenta
+	Table0070PleuralFluidThoracentesisFld Table0070 = `PLR`   // Pleural fluid (thoracentesis fld)
+	Table0070PolymorphonuclearNeutrophils Table0070 = `PMN`   // Polymorphonuclear neutrophils
+	Table0070PlateletPoorPlasma           Table0070 = `PPP`   // Platelet poor plasma
+	Table0070PlateletRichPlasma           Table0070 = `PRP`   // Platelet rich plasma
+	Table0070PeritonealFluidAscites       Table0070 = `PRT`   // Peritoneal fluid /ascites
+	Table0070Pus                          Table0070 = `PUS`   // Pus
+	Table0070Erythrocytes                 Table0070 = `RBC`   // Erythrocytes
+	Table0070RouteOfMedicine              Table0070 = `RT`    // Route of medicine
+	Table0070Saliva                       Table0070 = `SAL`   // Saliva
+	Table0070SeminalFluid                 Table0070 = `SEM`   // Seminal fluid
+	Table0070Serum                        Table0070 = `SER`   // Serum
+	Table0070SkeletalMuscle               Table0070 = `SKM`   // Skeletal muscle
+	Table0070Skin                         Table0070 = `SKN`   // Skin
+	Table0070SynovialFluidJointFluid      Table0070 = `SNV`   // Synovial fluid (Joint fluid)
+	Table0070Spermatozoa                  Table0070 = `SPRM`  // Spermatozoa
+	Table0070Sputum                       Table0070 = `SPT`   // Sputum
+	Table0070SputumCoughed                Table0070 = `SPTC`  // Sputum - coughed
+	Table0070SputumTrachealAspirate       Table0070 = `SPTT`  // Sputum - tracheal aspirate
+	Table0070StoolFecal                   Table0070 = `STL`   // Stool = Fecal
+	Table0070StoneUseCALC                 Table0070 = `STON`  // Stone (use CALC)
+	Table0070Sweat                        Table0070 = `SWT`   // Sweat
+	Table0070Tears                        Table0070 = `TEAR`  // Tears
+	Table0070ThrombocytePlatelet          Table0070 = `THRB`  // Thrombocyte (platelet)
+	Table0070Throat                       Table0070 = `THRT`  // Throat
+	Table0070TissueGallBladder            Table0070 = `TISG`  // Tissue gall bladder
+	Table0070TissuePlacenta               Table0070 = `TISPL` // Tissue placenta
+	Table0070Tissue                       Table0070 = `TISS`  // Tissue
+	Table0070TissueUlcer                  Table0070 = `TISU`  // Tissue ulcer
+	Table0070TissueLargeIntestine         Table0070 = `TLGI`  // Tissue large intestine
+	Table0070TissueLung                   Table0070 = `TLNG`  // Tissue lung
+	Table0070TissueSmallIntestine         Table0070 = `TSMI`  // Tissue small intestine
+	Table0070TubeNOS                      Table0070 = `TUB`   // Tube NOS
+	Table0070Ulcer                        Table0070 = `ULC`   // Ulcer
+	Table0070UmbilicalBlood               Table0070 = `UMB`   // Umbilical blood
+	Table0070UnknownMedicine              Table0070 = `UMED`  // Unknown medicine
+	Table0070Urine                        Table0070 = `UR`    // Urine
+	Table0070UrineCleanCatch              Table0070 = `URC`   // Urine clean catch
+	Table0070UrineSediment                Table0070 = `URNS`  // Urine sediment
+	Table0070UrineCatheter                Table0070 = `URT`   // Urine catheter
+	Table0070Urethra                      Table0070 = `URTH`  // Urethra
+	Table0070UnknownSubstance             Table0070 = `USUB`  // Unknown substance
+	Table0070Vomitus                      Table0070 = `VOM`   // Vomitus
+	Table0070Water                        Table0070 = `WAT`   // Water
+	Table0070Leukocytes                   Table0070 = `WBC`   // Leukocytes
+	Table0070Wick                         Table0070 = `WICK`  // Wick
+	Table0070Wound                        Table0070 = `WND`   // Wound
+	Table0070WoundAbscess                 Table0070 = `WNDA`  // Wound abscess
+	Table0070WoundDrainage                Table0070 = `WNDD`  // Wound drainage
+	Table0070WoundExudate                 Table0070 = `WNDE`  // Wound exudate
+	Table0070ToBeSpecifiedInAnotherPart   Table0070 = `XXX`   // To be specified in another part of the 422.3.10070message
+)
+
+// Description of the value, or empty if the value is not in table 0070.
+func (v Table0070) Description() string {
+	return tableDescription(`0070`, string(v))
+}
+
+// Valid reports if the value is in table 0070.
+func (v Table0070) Valid() bool {
+	return TableValueLookup[`0070`][string(v)]
+}
+
+// Table 0070.
+func (v Table0070) Table() Table {
+	return TableLookup[`0070`]
+}
+
+// Table0074 is a value of table 0074, Diagnostic service section ID.
+// Fields in this table are strings; convert a field, such as Table0074(v), to use the helpers.
+type Table0074 string
+
+const (
+	Table0074Audiology                     Table0074 = `AU`  // Audiology
+	Table0074BloodGases                    Table0074 = `BG`  // Blood gases
+	Table0074BloodBank                     Table0074 = `BLB` // Blood bank
+	Table0074Chemistry                     Table0074 = `CH`  // Chemistry
+	Table0074Cytopathology                 Table0074 = `CP`  // Cytopathology
+	Table0074CATScan                       Table0074 = `CT`  // CAT scan
+	Table0074CardiacCatheterization        Table0074 = `CTH` // Cardiac catheterization
+	Table0074CardiacUltrasound             Table0074 = `CUS` // Cardiac Ultrasound
+	Table0074ElectrocardiacEGEKGEECHolter  Table0074 = `EC`  // Electrocardiac (e.g.  EKG,  EEC,  Holter)
+	Table0074ElectroneuroEEGEMGEPPSG       Table0074 = `EN`  // Electroneuro (EEG,  EMG, EP, PSG)
+	Table0074Hematology                    Table0074 = `HM`  // Hematology
+	Table0074BedsideICUMonitoring          Table0074 = `ICU` // Bedside ICU Monitoring
+	Table0074Immunology                    Table0074 = `IMM` // Immunology
+	Table0074Laboratory                    Table0074 = `LAB` // Laboratory
+	Table0074Microbiology                  Table0074 = `MB`  // Microbiology
+	Table0074Mycobacteriology              Table0074 = `MCB` // Mycobacteriology
+	Table0074Mycology                      Table0074 = `MYC` // Mycology
+	Table0074NuclearMagneticResonance      Table0074 = `NMR` // Nuclear magnetic resonance
+	Table0074NuclearMedicineScan           Table0074 = `NMS` // Nuclear medicine scan
+	Table0074NursingServiceMeasures        Table0074 = `NRS` // Nursing service measures
+	Table0074OutsideLab                    Table0074 = `OSL` // Outside Lab
+	Table0074OccupationalTherapy           Table0074 = `OT`  // Occupational Therapy
+	Table0074Other                         Table0074 = `OTH` // Other
+	Table0074OBUltrasound                  Table0074 = `OUS` // OB Ultrasound
+	Table0074PulmonaryFunction             Table0074 = `PF`  // Pulmonary function
+	Table0074Pharmacy                      Table0074 = `PHR` // Pharmacy
+	Table0074PhysicianHxDxAdmissionNoteEtc Table0074 = `PHY` // Physician (Hx. Dx,  admission note,  etc.l)
+	Table0074PhysicalTherapy               Table0074 = `PT`  // Physical Therapy
+	Table0074Radiology                     Table0074 = `RAD` // Radiology
+	Table0074RespiratoryCareTherapy        Table0074 = `RC`  // Respiratory Care (therapy)
+	Table0074RadiationTherapy              Table0074 = `RT`  // Radiation therapy
+	Table0074RadiologyUltrasound           Table0074 = `RUS` // Radiology ultrasound
+	Table0074Radiograph                    Table0074 = `RX`  // Radiograph
+	Table0074SurgidalPathology             Table0074 = `SP`  // Surgidal Pathology
+	Table0074Serology                      Table0074 = `SR`  // Serology
+	Table0074Toxicology                    Table0074 = `TX`  // Toxicology
+	Table0074Virology                      Table0074 = `VR`  // Virology
+	Table0074VascularUltrasound            Table0074 = `VUS` // Vascular Ultrasound
+	Table0074Cineradiograph                Table0074 = `XRC` // Cineradiograph
+)
+
+// Description of the value, or empty if the value is not in table 0074.
+func (v Table0074) Description() string {
+	return tableDescription(`0074`, string(v))
+}
+
+// Valid reports if the value is in table 0074.
+func (v Table0074) Valid() bool {
+	return TableValueLookup[`0074`][string(v)]
+}
+
+// Table 0074.
+func (v Table0074) Table() Table {
+	return TableLookup[`0074`]
+}
+
+// Table0076 is a value of table 0076, Message type.
+// Fields in this table are strings; convert a field, such as Table0076(v), to use the helpers.
+type Table0076 string
+
+const (
+	Table0076GeneralAcknowledgmentMessage                Table0076 = `ACK` // General acknowledgment message
+	Table0076ADTResponse                                 Table0076 = `ADR` // ADT response
+	Table0076ADTMessage                                  Table0076 = `ADT` // ADT message
+	Table0076AncillaryRPTDisplayForBackwardCompatibility Table0076 = `ARD` // Ancillary RPT (display) (for backward compatibility only)
+	Table0076AddChangeBillingAccount                     Table0076 = `BAR` // Add/change billing account
+	Table0076ClinicalStudyRegistration                   Table0076 = `CRM` // Clinical study registration
+	Table0076UnsolicitedClinicalStudyData                Table0076 = `CSU` // Unsolicited clinical study data
+	Table0076DetailFinancialTransaction                  Table0076 = `DFT` // Detail financial transaction
+	Table0076DocumentQuery                               Table0076 = `DOC` // Document query
+	Table0076DisplayResponse                             Table0076 = `DSR` // Display response
+	Table0076EnhancedDisplayResponse                     Table0076 = `EDR` // Enhanced display response
+	Table0076EmbeddedQueryLanguageQuery                  Table0076 = `EQQ` // Embedded query language query
+	Table0076EventReplayResponse                         Table0076 = `ERP` // Event replay response
+	Table0076DelayedAcknowledgment                       Table0076 = `MCF` // Delayed acknowledgment
+	Table0076DocumentationMessage                        Table0076 = `MDM` // Documentation message
+	Table0076MasterFilesDelayedApplicationAcknowledgment Table0076 = `MFD` // Master files delayed application acknowledgment
+	Table0076MasterFilesApplicationAcknowledgment        Table0076 = `MFK` // Master files application acknowledgment
+	Table0076MasterFilesNotification                     Table0076 = `MFN` // Master files notification
+	Table0076MasterFilesQuery                            Table0076 = `MFQ` // Master files query
+	Table0076MasterFilesQueryResponse                    Table0076 = `MFR` // Master files query response
+	Table0076NetworkManagementData                       Table0076 = `NMD` // Network management data
+	Table0076NetworkManagementQuery                      Table0076 = `NMQ` // Network management query
+	Table0076NetworkManagementResponse                   Table0076 = `NMR` // Network management response
+	Table0076ObservResultRecordResponse                  Table0076 = `ORF` // Observ. result/record response
+	Table0076OrderMessage                                Table0076 = `ORM` // Order message
+	Table0076OrderAcknowledgmentMessage                  Table0076 = `ORR` // Order acknowledgment message
+	Table0076ObservResultUnsolicited                     Table0076 = `ORU` // Observ result/unsolicited
+	Table0076OrderStatusQuery                            Table0076 = `OSQ` // Order status query
+	Table0076OrderStatusResponse                         Table0076 = `OSR` // Order status response
+	Table0076ProductExperience                           Table0076 = `PEX` // Product experience
+	Table0076PatientGoal                                 Table0076 = `PGL` // Patient goal
+	Table0076PatientInsuranceInformation                 Table0076 = `PIN` // Patient insurance information
+	Table0076PatientPathwayGoalOrientedMessage           Table0076 = `PPG` // Patient pathway (goal-oriented) message
+	Table0076PatientPathwayProblemOrientedMessage        Table0076 = `PPP` // Patient pathway (problem-oriented) message
+	Table0076PatientProblem                              Table0076 = `PPR` // Patient problem
+	Table0076PatientPathwayGoalOrientedResponse          Table0076 = `PPT` // Patient pathway (goal oriented) response
+	Table0076PatientGoalResponse                         Table0076 = `PPV` // Patient goal response
+	Table0076PatientProblemResponse                      Table0076 = `PRR` // Patient problem response
+	Table0076PatientPathwayProblemOrientedResponse       Table0076 = `PTR` // Patient pathway (problem-oriented) response
+	Table0076QueryGeneralAcknowledgment                  Table0076 = `QCK` // Query general acknowledgment
+	Table0076QueryOriginalMode                           Table0076 = `QRY` // Query, original mode
+	Table0076PharmacyAdministrationInformation           Table0076 = `RAR` // Pharmacy administration information
+	Table0076PharmacyAdministrationMessage               Table0076 = `RAS` // Pharmacy administration message
+	Table0076ReturnClinicalInformation                   Table0076 = `RCI` // Return clinical information
+	Table0076ReturnClinicalList                          Table0076 = `RCL` // Return clinical list
+	Table0076PharmacyEncodedOrderMessage                 Table0076 = `RDE` // Pharmacy encoded order message
+	Table0076PharmacyDispenseInformation                 Table0076 = `RDR` // Pharmacy dispense information
+	Table0076PharmacyDispenseMessage                     Table0076 = `RDS` // Pharmacy dispense message
+	Table0076PatientReferral                             Table0076 = `REF` // Patient referral
+	Table0076PharmacyEncodedOrderInformation             Table0076 = `RER` // Pharmacy encoded order information
+	Table0076PharmacyDoseInformation                     Table0076 = `RGR` // Pharmacy dose information
+	Table0076PharmacyGiveMessage                         Table0076 = `RGV` // Pharmacy give message
+	Table0076PharmacyPrescriptionOrderResponse           Table0076 = `ROR` // Pharmacy prescription order response
+	Table0076ReturnPatientAuthorization                  Table0076 = `RPA` // Return patient authorization
+	Table0076ReturnPatientInformation                    Table0076 = `RPI` // Return patient information
+	Table0076ReturnPatientDisplayList                    Table0076 = `RPL` // Return patient display list
+	Table0076ReturnPatientList                           Table0076 = `RPR` // Return patient list
+	Table0076RequestPatientAuthorization                 Table0076 = `RQA` // Request patient authorization
+	Table0076RequestClinicalInformation                  Table0076 = `RQC` // Request clinical information
+	Table0076RequestPatientInformation                   Table0076 = `RQI` // Request patient information
+	Table0076RequestPatientDemographics                  Table0076 = `RQP` // Request patient demographics
+	Table0076EventReplayQuery                            Table0076 = `RQQ` // Event replay query
+	Table0076PharmacyAdministrationAcknowledgment        Table0076 = `RRA` // Pharmacy administration acknowledgment
+	Table0076PharmacyDispenseAcknowledgment              Table0076 = `RRD` // Pharmacy dispense acknowledgment
+	Table0076PharmacyEncodedOrderAcknowledgment          Table0076 = `RRE` // Pharmacy encoded order acknowledgment
+	Table0076PharmacyGiveAcknowledgment                  Table0076 = `RRG` // Pharmacy give acknowledgment
+	Table0076ReturnPatientReferral                       Table0076 = `RRI` // Return patient referral
+	Table0076ScheduleInformationUnsolicited              Table0076 = `SIU` // Schedule information unsolicited
+	Table0076StoredProcedureRequest                      Table0076 = `SPQ` // Stored procedure request
+	Table0076ScheduleQuery                               Table0076 = `SQM` // Schedule query
+	Table0076ScheduleQueryResponse                       Table0076 = `SQR` // Schedule query response
+	Table0076ScheduleRequest                             Table0076 = `SRM` // Schedule request
+	Table0076ScheduledRequestResponse                    Table0076 = `SRR` // Scheduled request response
+	Table0076SummaryProductExperienceReport              Table0076 = `SUR` // Summary product experience report
+	Table0076TabularDataResponse                         Table0076 = `TBR` // Tabular data response
+	Table0076UnsolicitedDisplayMessage                   Table0076 = `UDM` // Unsolicited display message
+	Table0076VirtualTableQuery                           Table0076 = `VQQ` // Virtual table query
+	Table0076QueryForVaccinationRecord                   Table0076 = `VXQ` // Query for vaccination record
+	Table0076VaccinationQueryRecordResponse              Table0076 = `VXR` // Vaccination query record response
+	Table0076UnsolicitedVaccinationRecordUpdate          Table0076 = `VXU` // Unsolicited vaccination record update
+	Table0076VaccinationQueryResponseWithMultiplePID     Table0076 = `VXX` // Vaccination query response with multiple PID matches
+)
+
+// Description of the value, or empty if the value is not in table 0076.
+func (v Table0076) Description() string {
+	return tableDescription(`0076`, string(v))
+}
+
+// Valid reports if the value is in table 0076.
+func (v Table0076) Valid() bool {
+	return TableValueLookup[`0076`][string(v)]
+}
+
+// Table 0076.
+func (v Table0076) Table() Table {
+	return TableLookup[`0076`]
+}
+
+// Table0078 is a value of table 0078, Abnormal flags.
+// Fields in this table are strings; convert a field, such as Table0078(v), to use the helpers.
+type Table0078 string
+
+const (
+	Table0078BelowAbsoluteLowOffInstrumentScale                            Table0078 = `<`    // Below absolute low-off instrument scale
+	Table0078AboveAbsoluteHighOffInstrumentScale                           Table0078 = `>`    // Above absolute high-off instrument scale
+	Table0078AbnormalAppliesToNonNumericResults                            Table0078 = `A`    // Abnormal (applies to non-numeric results)
+	Table0078VeryAbnormalAppliesToNonNumeric                               Table0078 = `AA`   // Very abnormal (applies to non-numeric units, analogous to panic limits for numeric units)
+	Table0078BetterUseWhenDirectionNotRelevant                             Table0078 = `B`    // Better--use when direction not relevant
+	Table0078SignificantChangeDown                                         Table0078 = `D`    // Significant change down
+	Table0078AboveHighNormal                                               Table0078 = `H`    // Above high normal
+	Table0078AboveUpperPanicLimits                                         Table0078 = `HH`   // Above upper panic limits
+	Table0078IntermediateIndicatesForMicrobiologySusceptibilitiesOnly      Table0078 = `I`    // Intermediate. Indicates for microbiology susceptibilities only.
+	Table0078BelowLowNormal                                                Table0078 = `L`    // Below low normal
+	Table0078BelowLowerPanicLimits                                         Table0078 = `LL`   // Below lower panic limits
+	Table0078ModeratelySusceptibleIndicatesForMicrobiologySusceptibilities Table0078 = `MS`   // Moderately susceptible. Indicates for microbiology susceptibilities only.
+	Table0078NormalAppliesToNonNumericResults                              Table0078 = `N`    // Normal (applies to non-numeric results)
+	Table0078NoRangeDefinedOrNormalRanges                                  Table0078 = `null` // No range defined, or normal ranges don't apply
+	Table0078ResistantIndicatesForMicrobiologySusceptibilitiesOnly         Table0078 = `R`    // Resistant. Indicates for microbiology susceptibilities only.
+	Table0078SusceptibleIndicatesForMicrobiologySusceptibilitiesOnly       Table0078 = `S`    // Susceptible. Indicates for microbiology susceptibilities only.
+	Table0078SignificantChangeUp                                           Table0078 = `U`    // Significant change up
+	Table0078VerySusceptibleIndicatesForMicrobiologySusceptibilities       Table0078 = `VS`   // Very susceptible. Indicates for microbiology susceptibilities only.
+	Table0078WorseUseWhenDirectionNotRelevant                              Table0078 = `W`    // Worse--use when direction not relevant
+)
+
+// Description of the value, or empty if the value is not in table 0078.
+func (v Table0078) Description() string {
+	return tableDescription(`0078`, string(v))
+}
+
+// Valid reports if the value is in table 0078.
+func (v Table0078) Valid() bool {
+	return TableValueLookup[`0078`][string(v)]
+}
+
+// Table 0078.
+func (v Table0078) Table() Table {
+	return TableLookup[`0078`]
+}
+
+// Table0080 is a value of table 0080, Nature of abnormal testing.
+// Fields in this table are strings; convert a field, such as Table0080(v), to use the helpers.
+type Table0080 string
+
+const (
+	Table0080AnAgeBasedPopulation   Table0080 = `A` // An age-based population
+	Table0080NoneGenericNormalRange Table0080 = `N` // None - generic normal range
+	Table0080ARaceBasedPopulation   Table0080 = `R` // A race-based population
+	Table0080ASexBasedPopulation    Table0080 = `S` // A sex-based population
+)
+
+// Description of the value, or empty if the value is not in table 0080.
+func (v Table0080) Description() string {
+	return tableDescription(`0080`, string(v))
+}
+
+// Valid reports if the value is in table 0080.
+func (v Table0080) Valid() bool {
+	return TableValueLookup[`0080`][string(v)]
+}
+
+// Table 0080.
+func (v Table0080) Table() Table {
+	return TableLookup[`0080`]
+}
+
+// Table0083 is a value of table 0083, Outlier type.
+// Fields in this table are strings; convert a field, such as Table0083(v), to use the helpers.
+type Table0083 string
+
+const (
+	Table0083OutlierCost Table0083 = `C` // Outlier cost
+	Table0083OutlierDays Table0083 = `D` // Outlier days
+)
+
+// Description of the value, or empty if the value is not in table 0083.
+func (v Table0083) Description() string {
+	return tableDescription(`0083`, string(v))
+}
+
+// Valid reports if the value is in table 0083.
+func (v Table0083) Valid() bool {
+	return TableValueLookup[`0083`][string(v)]
+}
+
+// Table 0083.
+func (v Table0083) Table() Table {
+	return TableLookup[`0083`]
+}
+
+// Table0085 is a value of table 0085, Observation result status codes interpretation.
+// Fields in this table are strings; convert a field, such as Table0085(v), to use the helpers.
+type Table0085 string
+
+const (
+	Table0085RecordComingOverIsACorrection       Table0085 = `C` // Record coming over is a correction and thus replaces a final result
+	Table0085DeletesTheOBXRecord                 Table0085 = `D` // Deletes the OBX record
+	Table0085FinalResultsCanOnlyBeChanged        Table0085 = `F` // Final results; Can only be changed with a corrected result.
+	Table0085SpecimenInLabResultsPending         Table0085 = `I` // Specimen in lab; results pending
+	Table0085NotAskedUsedToAffirmativelyDocument Table0085 = `N` // Not asked; used to affirmatively document that the observation identified in the OBX was not sought when the universal service ID in OBR-4 implies that it would be sought.
+	Table0085OrderDetailDescriptionOnlyNoResult  Table0085 = `O` // Order detail description only (no result)
+	Table0085PreliminaryResults                  Table0085 = `P` // Preliminary results
+	Table0085ResultsEnteredNotVerified           Table0085 = `R` // Results entered -- not verified
+	Table0085PartialResults                      Table0085 = `S` // Partial results
+	Table0085ResultsStatusChangeToFinalWithout   Table0085 = `U` // Results status change to final without retransmitting results already sent as ‘preliminary.’ E.g., radiology changes status from preliminary to final
+	Table0085PostOriginalAsWrongEG               Table0085 = `W` // Post original as wrong, e.g., transmitted for wrong patient
+	Table0085ResultsCannotBeObtainedForThis      Table0085 = `X` // Results cannot be obtained for this observation
+)
+
+// Description of the value, or empty if the value is not in table 0085.
+func (v Table0085) Description() string {
+	return tableDescription(`0085`, string(v))
+}
+
+// Valid reports if the value is in table 0085.
+func (v Table0085) Valid() bool {
+	return TableValueLookup[`0085`][string(v)]
+}
+
+// Table 0085.
+func (v Table0085) Table() Table {
+	return TableLookup[`0085`]
+}
+
+// Table0091 is a value of table 0091, Query priority.
+// Fields in this table are strings; convert a field, such as Table0091(v), to use the helpers.
+type Table0091 string
+
+const (
+	Table0091Deferred  Table0091 = `D` // Deferred
+	Table0091Immediate Table0091 = `I` // Immediate
+)
+
+// Description of the value, or empty if the value is not in table 0091.
+func (v Table0091) Description() string {
+	return tableDescription(`0091`, string(v))
+}
+
+// Valid reports if the value is in table 0091.
+func (v Table0091) Valid() bool {
+	return TableValueLookup[`0091`][string(v)]
+}
+
+// Table 0091.
+func (v Table0091) Table() Table {
+	return TableLookup[`0091`]
+}
+
+// Table0092 is a value of table 0092, Re-admission indicator.
+// Fields in this table are strings; convert a field, such as Table0092(v), to use the helpers.
+type Table0092 string
+
+const (
+	Table0092Readmission Table0092 = `R` // Readmission
+)
+
+// Description of the value, or empty if the value is not in table 0092.
+func (v Table0092) Description() string {
+	return tableDescription(`0092`, string(v))
+}
+
+// Valid reports if the value is in table 0092.
+func (v Table0092) Valid() bool {
+	return TableValueLookup[`0092`][string(v)]
+}
+
+// Table 0092.
+func (v Table0092) Table() Table {
+	return TableLookup[`0092`]
+}
+
+// Table0093 is a value of table 0093, Release information.
+// Fields in this table are strings; convert a field, such as Table0093(v), to use the helpers.
+type Table0093 string
+
+const (
+	Table0093No  Table0093 = `N` // No
+	Table0093Yes Table0093 = `Y` // Yes
+)
+
+// Description of the value, or empty if the value is not in table 0093.
+func (v Table0093) Description() string {
+	return tableDescription(`0093`, string(v))
+}
+
+// Valid reports if the value is in table 0093.
+func (v Table0093) Valid() bool {
+	return TableValueLookup[`0093`][string(v)]
+}
+
+// Table 0093.
+func (v Table0093) Table() Table {
+	return TableLookup[`0093`]
+}
+
+// Table0098 is a value of table 0098, Type of agreement.
+// Fields in this table are strings; convert a field, such as Table0098(v), to use the helpers.
+type Table0098 string
+
+const (
+	Table0098Maternity Table0098 = `M` // Maternity
+	Table0098Standard  Table0098 = `S` // Standard
+	Table0098Unified   Table0098 = `U` // Unified
+)
+
+// Description of the value, or empty if the value is not in table 0098.
+func (v Table0098) Description() string {
+	return tableDescription(`0098`, string(v))
+}
+
+// Valid reports if the value is in table 0098.
+func (v Table0098) Valid() bool {
+	return TableValueLookup[`0098`][string(v)]
+}
+
+// Table 0098.
+func (v Table0098) Table() Table {
+	return TableLookup[`0098`]
+}
+
+// Table0100 is a value of table 0100, When to charge.
+// Fields in this table are strings; convert a field, such as Table0100(v), to use the helpers.
+type Table0100 string
+
+const (
+	Table0100OnDischarge              Table0100 = `D` // On discharge
+	Table0100OnReceiptOfOrder         Table0100 = `O` // On receipt of order
+	Table0100AtTimeServiceIsCompleted Table0100 = `R` // At time service is completed
+	Table0100AtTimeServiceIsStarted   Table0100 = `S` // At time service is started
+	Table0100AtADesignatedDateTime    Table0100 = `T` // At a designated date/time
+)
+
+// Description of the value, or empty if the value is not in table 0100.
+func (v Table0100) Description() string {
+	return tableDescription(`0100`, string(v))
+}
+
+// Valid reports if the value is in table 0100.
+func (v Table0100) Valid() bool {
+	return TableValueLookup[`0100`][string(v)]
+}
+
+// Table 0100.
+func (v Table0100) Table() Table {
+	return TableLookup[`0100`]
+}
+
+// Table0102 is a value of table 0102, Delayed Acknowledgment Type.
+// Fields in this table are strings; convert a field, such as Table0102(v), to use the helpers.
+type Table0102 string
+
+const (
+	Table0102MessageReceivedStoredForLaterProcessing Table0102 = `D` // Message received, stored for later processing
+	Table0102AcknowledgmentAfterProcessing           Table0102 = `F` // acknowledgment after processing
+)
+
+// Description of the value, or empty if the value is not in table 0102.
+func (v Table0102) Description() string {
+	return tableDescription(`0102`, string(v))
+}
+
+// Valid reports if the value is in table 0102.
+func (v Table0102) Valid() bool {
+	return TableValueLookup[`0102`][string(v)]
+}
+
+// Table 0102.
+func (v Table0102) Table() Table {
+	return TableLookup[`0102`]
+}
+
+// Table0103 is a value of table 0103, Processing ID.
+// Fields in this table are strings; convert a field, such as Table0103(v), to use the helpers.
+type Table0103 string
+
+const (
+	Table0103Debugging  Table0103 = `D` // Debugging
+	Table0103Production Table0103 = `P` // Production
+	Table0103Training   Table0103 = `T` // Training
+)
+
+// Description of the value, or empty if the value is not in table 0103.
+func (v Table0103) Description() string {
+	return tableDescription(`0103`, string(v))
+}
+
+// Valid reports if the value is in table 0103.
+func (v Table0103) Valid() bool {
+	return TableValueLookup[`0103`][string(v)]
+}
+
+// Table 0103.
+func (v Table0103) Table() Table {
+	return TableLookup[`0103`]
+}
+
+// Table0104 is a value of table 0104, Version ID.
+// Fields in this table are strings; convert a field, such as Table0104(v), to use the helpers.
+type Table0104 string
+
+const (
+	Table0104Release20  Table0104 = `2.0`   // Release 2.0
+	Table0104Demo20     Table0104 = `2.0D`  // Demo 2.0
+	Table0104Release21  Table0104 = `2.1`   // Release 2. 1
+	Table0104Release22  Table0104 = `2.2`   // Release 2.2
+	Table0104Release23  Table0104 = `2.3`   // Release 2.3
+	Table0104Release231 Table0104 = `2.3.1` // Release 2.3.1
+	Table0104Release232 Table0104 = `2.3.2` // Release 2.3.2
+)
+
+// Description of the value, or empty if the value is not in table 0104.
+func (v Table0104) Description() string {
+	return tableDescription(`0104`, string(v))
+}
+
+// Valid reports if the value is in table 0104.
+func (v Table0104) Valid() bool {
+	return TableValueLookup[`0104`][string(v)]
+}
+
+// Table 0104.
+func (v Table0104) Table() Table {
+	return TableLookup[`0104`]
+}
+
+// Table0105 is a value of table 0105, Source of comment.
+// Fields in this table are strings; convert a field, such as Table0105(v), to use the helpers.
+type Table0105 string
+
+const (
+	Table0105AncillaryFillerDepartmentIsSourceOf Table0105 = `L` // Ancillary (filler) department is source of comment
+	Table0105OtherSystemIsSourceOfComment        Table0105 = `O` // Other system is source of comment
+	Table0105OrdererPlacerIsSourceOfComment      Table0105 = `P` // Orderer (placer) is source of comment
+)
+
+// Description of the value, or empty if the value is not in table 0105.
+func (v Table0105) Description() string {
+	return tableDescription(`0105`, string(v))
+}
+
+// Valid reports if the value is in table 0105.
+func (v Table0105) Valid() bool {
+	return TableValueLookup[`0105`][string(v)]
+}
+
+// Table 0105.
+func (v Table0105) Table() Table {
+	return TableLookup[`0105`]
+}
+
+// Table0106 is a value of table 0106, Query/response format code.
+// Fields in this table are strings; convert a field, such as Table0106(v), to use the helpers.
+type Table0106 string
+
+const (
+	Table0106ResponseIsInDisplayFormat        Table0106 = `D` // Response is in display format
+	Table0106ResponseIsInRecordOrientedFormat Table0106 = `R` // Response is in record-oriented format
+	Table0106ResponseIsInTabularFormat        Table0106 = `T` // Response is in tabular format
+)
+
+// Description of the value, or empty if the value is not in table 0106.
+func (v Table0106) Description() string {
+	return tableDescription(`0106`, string(v))
+}
+
+// Valid reports if the value is in table 0106.
+func (v Table0106) Valid() bool {
+	return TableValueLookup[`0106`][string(v)]
+}
+
+// Table 0106.
+func (v Table0106) Table() Table {
+	return TableLookup[`0106`]
+}
+
+// Table0107 is a value of table 0107, Deferred response type.
+// Fields in this table are strings; convert a field, such as Table0107(v), to use the helpers.
+type Table0107 string
+
+const (
+	Table0107BeforeTheDateTimeSpecified    Table0107 = `B` // Before the Date/Time specified
+	Table0107LaterThanTheDateTimeSpecified Table0107 = `L` // Later than the Date/Time specified
+)
+
+// Description of the value, or empty if the value is not in table 0107.
+func (v Table0107) Description() string {
+	return tableDescription(`0107`, string(v))
+}
+
+// Valid reports if the value is in table 0107.
+func (v Table0107) Valid() bool {
+	return TableValueLookup[`0107`][string(v)]
+}
+
+// Table 0107.
+func (v Table0107) Table() Table {
+	return TableLookup[`0107`]
+}
+
+// Table0108 is a value of table 0108, Query results level.
+// Fields in this table are strings; convert a field, such as Table0108(v), to use the helpers.
+type Table0108 string
+
+const (
+	Table0108OrderPlusOrderStatus   Table0108 = `O` // Order plus order status
+	Table0108ResultsWithoutBulkText Table0108 = `R` // Results without bulk text
+	Table0108StatusOnly             Table0108 = `S` // Status only
+	Table0108FullResults            Table0108 = `T` // Full results
+)
+
+// Description of the value, or empty if the value is not in table 0108.
+func (v Table0108) Description() string {
+	return tableDescription(`0108`, string(v))
+}
+
+// Valid reports if the value is in table 0108.
+func (v Table0108) Valid() bool {
+	return TableValueLookup[`0108`][string(v)]
+}
+
+// Table 0108.
+func (v Table0108) Table() Table {
+	return TableLookup[`0108`]
+}
+
+// Table0109 is a value of table 0109, Report priority.
+// Fields in this table are strings; convert a field, such as Table0109(v), to use the helpers.
+type Table0109 string
+
+const (
+	Table0109Routine Table0109 = `R` // Routine
+	Table0109Stat    Table0109 = `S` // Stat
+)
+
+// Description of the value, or empty if the value is not in table 0109.
+func (v Table0109) Description() string {
+	return tableDescription(`0109`, string(v))
+}
+
+// Valid reports if the value is in table 0109.
+func (v Table0109) Valid() bool {
+	return TableValueLookup[`0109`][string(v)]
+}
+
+// Table 0109.
+func (v Table0109) Table() Table {
+	return TableLookup[`0109`]
+}
+
+// Table0112 is a value of table 0112, Discharge disposition.
+// Fields in this table are strings; convert a field, such as Table0112(v), to use the helpers.
+type Table0112 string
+
+const (
+	Table0112DischargedToHomeOrSelfCare                    Table0112 = `01` // Discharged to home or self care (routine discharge)
+	Table0112DischargedTransferredToAnotherShortTerm       Table0112 = `02` // Discharged/transferred to another short term general hospital for inpatient care
+	Table0112DischargedTransferredToSkilledNursingFacility Table0112 = `03` // Discharged/transferred to skilled nursing facility (SNF)
+	Table0112DischargedTransferredToAnIntermediateCare     Table0112 = `04` // Discharged/transferred to an intermediate care facility (ICF)
+	Table0112DischargedTransferredToAnotherTypeOf          Table0112 = `05` // Discharged/transferred to another type of institution for inpatient care or referred for outpatient services to another institution
+	Table0112DischargedTransferredToHomeUnderCare          Table0112 = `06` // Discharged/transferred to home under care of organized home health service organization
+	Table0112LeftAgainstMedicalAdviceOrDiscontinued        Table0112 = `07` // Left against medical advice or discontinued care
+	Table0112DischargedTransferredToHomeUnderCare_08       Table0112 = `08` // Discharged/transferred to home under care of Home IV provider
+	Table0112AdmittedAsAnInpatientToThis                   Table0112 = `09` // Admitted as an inpatient to this hospital
+	Table0112DischargeToBeDefinedAtState                   Table0112 = `10` // Discharge to be defined at state level, if necessary
+	Table0112DischargeToBeDefinedAtState_11                Table0112 = `11` // Discharge to be defined at state level, if necessary
+	Table0112DischargeToBeDefinedAtState_12                Table0112 = `12` // Discharge to be defined at state level, if necessary
+	Table0112DischargeToBeDefinedAtState_13                Table0112 = `13` // Discharge to be defined at state level, if necessary
+	Table0112DischargeToBeDefinedAtState_14                Table0112 = `14` // Discharge to be defined at state level, if necessary
+	Table0112DischargeToBeDefinedAtState_15                Table0112 = `15` // Discharge to be defined at state level, if necessary
+	Table0112DischargeToBeDefinedAtState_16                Table0112 = `16` // Discharge to be defined at state level, if necessary
+	Table0112DischargeToBeDefinedAtState_17                Table0112 = `17` // Discharge to be defined at state level, if necessary
+	Table0112DischargeToBeDefinedAtState_18                Table0112 = `18` // Discharge to be defined at state level, if necessary
+	Table0112DischargeToBeDefinedAtState_19                Table0112 = `19` // Discharge to be defined at state level, if necessary
+	Table0112Expired                                       Table0112 = `20` // Expired
+	Table0112ExpiredToBeDefinedAtState                     Table0112 = `21` // Expired to be defined at state level, if necessary
+	Table0112ExpiredToBeDefinedAtState_22                  Table0112 = `22` // Expired to be defined at state level, if necessary
+	Table0112ExpiredToBeDefinedAtState_23                  Table0112 = `23` // Expired to be defined at state level, if necessary
+	Table0112ExpiredToBeDefinedAtState_24                  Table0112 = `24` // Expired to be defined at state level, if necessary
+	Table0112ExpiredToBeDefinedAtState_25                  Table0112 = `25` // Expired to be defined at state level, if necessary
+	Table0112ExpiredToBeDefinedAtState_26                  Table0112 = `26` // Expired to be defined at state level, if necessary
+	Table0112ExpiredToBeDefinedAtState_27                  Table0112 = `27` // Expired to be defined at state level, if necessary
+	Table0112ExpiredToBeDefinedAtState_28                  Table0112 = `28` // Expired to be defined at state level, if necessary
+	Table0112ExpiredToBeDefinedAtState_29                  Table0112 = `29` // Expired to be defined at state level, if necessary
+	Table0112StillPatientOrExpectedToReturn                Table0112 = `30` // Still patient or expected to return for outpatient services
+	Table0112StillPatientToBeDefinedAt                     Table0112 = `31` // Still patient to be defined at state level, if necessary
+	Table0112StillPatientToBeDefinedAt_32                  Table0112 = `32` // Still patient to be defined at state level, if necessary
+	Table0112StillPatientToBeDefinedAt_33                  Table0112 = `33` // Still patient to be defined at state level, if necessary
+	Table0112StillPatientToBeDefinedAt_34                  Table0112 = `34` // Still patient to be defined at state level, if necessary
+	Table0112StillPatientToBeDefinedAt_35                  Table0112 = `35` // Still patient to be defined at state level, if necessary
+	Table0112StillPatientToBeDefinedAt_36                  Table0112 = `36` // Still patient to be defined at state level, if necessary
+	Table0112StillPatientToBeDefinedAt_37                  Table0112 = `37` // Still patient to be defined at state level, if necessary
+	Table0112StillPatientToBeDefinedAt_38                  Table0112 = `38` // Still patient to be defined at state level, if necessary
+	Table0112StillPatientToBeDefinedAt_39                  Table0112 = `39` // Still patient to be defined at state level, if necessary
+	Table0112ExpiredAtHome                                 Table0112 = `40` // Expired at home
+	Table0112ExpiredInAMedicalFacilityE                    Table0112 = `41` // Expired in a medical facility; e.g., hospital, SNF, ICF, or free standing hospice
+	Table0112ExpiredPlaceUnknown                           Table0112 = `42` // Expired - place unknown
+)
+
+// Description of the value, or empty if the value is not in table 0112.
+func (v Table0112) Description() string {
+	return tableDescription(`0112`, string(v))
+}
+
+// Valid reports if the value is in table 0112.
+func (v Table0112) Valid() bool {
+	return TableValueLookup[`0112`][string(v)]
+}
+
+// Table 0112.
+func (v Table0112) Table() Table {
+	return TableLookup[`0112`]
+}
+
+// Table0116 is a value of table 0116, Bed status.
+// Fields in this table are strings; convert a field, such as Table0116(v), to use the helpers.
+type Table0116 string
+
+const (
+	Table0116Closed       Table0116 = `C` // Closed
+	Table0116Housekeeping Table0116 = `H` // Housekeeping
+	Table0116Isolated     Table0116 = `I` // Isolated
+	Table0116Contaminated Table0116 = `K` // Contaminated
+	Table0116Occupied     Table0116 = `O` // Occupied
+	Table0116Unoccupied   Table0116 = `U` // Unoccupied
+)
+
+// Description of the value, or empty if the value is not in table 0116.
+func (v Table0116) Description() string {
+	return tableDescription(`0116`, string(v))
+}
+
+// Valid reports if the value is in table 0116.
+func (v Table0116) Valid() bool {
+	return TableValueLookup[`0116`][string(v)]
+}
+
+// Table 0116.
+func (v Table0116) Table() Table {
+	return TableLookup[`0116`]
+}
+
+// Table0119 is a value of table 0119, Order control codes.
+// Fields in this table are strings; convert a field, such as Table0119(v), to use the helpers.
+type Table0119 string
+
+const (
+	Table0119OrderRefillRequestApproval        Table0119 = `AF` // Order refill request approval
+	Table0119CancelOrderRequest                Table0119 = `CA` // Cancel order request
+	Table0119ChildOrder                        Table0119 = `CH` // Child order
+	Table0119CombinedResult                    Table0119 = `CN` // Combined result
+	Table0119CanceledAsRequested               Table0119 = `CR` // Canceled as requested
+	Table0119DiscontinueOrderRequest           Table0119 = `DC` // Discontinue order request
+	Table0119DataErrors                        Table0119 = `DE` // Data Errors
+	Table0119OrderRefillRequestDenied          Table0119 = `DF` // Order refill request denied
+	Table0119OrderRefilledOrUnsolicited        Table0119 = `FU` // Order refilled or unsolicited
+	Table0119HoldOrderRequest                  Table0119 = `HD` // Hold order request
+	Table0119OnHoldAsRequested                 Table0119 = `HR` // On hold as requested
+	Table0119LinkOrderToPatientCareMessage     Table0119 = `LI` // Link order to patient care message
+	Table0119NumberAssigned                    Table0119 = `NA` // Number assigned
+	Table0119NewOrder                          Table0119 = `NW` // New Order
+	Table0119OrderCanceled                     Table0119 = `OC` // Order canceled
+	Table0119OrderDiscontinued                 Table0119 = `OD` // Order discontinued
+	Table0119OrderReleased                     Table0119 = `OE` // Order released
+	Table0119OrderRefilledAsRequested          Table0119 = `OF` // Order refilled as requested
+	Table0119OrderHeld                         Table0119 = `OH` // Order held
+	Table0119OrderAcceptedAndOK                Table0119 = `OK` // Order accepted and OK
+	Table0119ReleasedAsRequested               Table0119 = `OR` // Released as requested
+	Table0119ParentOrder                       Table0119 = `PA` // Parent order
+	Table0119ObservationsToFollow              Table0119 = `RE` // Observations to follow
+	Table0119RefillOrderRequest                Table0119 = `RF` // Refill order request
+	Table0119ReleasePreviousHold               Table0119 = `RL` // Release previous hold
+	Table0119ReplacementOrder                  Table0119 = `RO` // Replacement order
+	Table0119OrderReplaceRequest               Table0119 = `RP` // Order replace request
+	Table0119ReplacedAsRequested               Table0119 = `RQ` // Replaced as requested
+	Table0119RequestReceived                   Table0119 = `RR` // Request received
+	Table0119ReplacedUnsolicited               Table0119 = `RU` // Replaced unsolicited
+	Table0119StatusChanged                     Table0119 = `SC` // Status changed
+	Table0119SendOrderNumber                   Table0119 = `SN` // Send order number
+	Table0119ResponseToSendOrderStatusRequest  Table0119 = `SR` // Response to send order status request
+	Table0119SendOrderStatusRequest            Table0119 = `SS` // Send order status request
+	Table0119UnableToAcceptOrder               Table0119 = `UA` // Unable to accept order
+	Table0119UnableToCancel                    Table0119 = `UC` // Unable to cancel
+	Table0119UnableToRefill                    Table0119 = `UF` // Unable to refill
+	Table0119UnableToPutOnHold                 Table0119 = `UH` // Unable to put on hold
+	Table0119UnableToReplace                   Table0119 = `UM` // Unable to replace
+	Table0119UnlinkOrderFromPatientCareMessage Table0119 = `UN` // Unlink order from patient care message
+	Table0119UnableToRelease                   Table0119 = `UR` // Unable to release
+	Table0119UnableToChange                    Table0119 = `UX` // Unable to change
+	Table0119ChangeOrderRequest                Table0119 = `XO` // Change order request
+	Table0119ChangedAsRequested                Table0119 = `XR` // Changed as requested
+	Table0119OrderChangedOrUnsolicited         Table0119 = `XX` // Order changed or unsolicited
+)
+
+// Description of the value, or empty if the value is not in table 0119.
+func (v Table0119) Description() string {
+	return tableDescription(`0119`, string(v))
+}
+
+// Valid reports if the value is in table 0119.
+func (v Table0119) Valid() bool {
+	return TableValueLookup[`0119`][string(v)]
+}
+
+// Table 0119.
+func (v Table0119) Table() Table {
+	return TableLookup[`0119`]
+}
+
+// Table0121 is a value of table 0121, Response flag.
+// Fields in this table are strings; convert a field, such as Table0121(v), to use the helpers.
+type Table0121 string
+
+const (
+	Table0121SameAsRAlsoOtherAssociated         Table0121 = `D` // Same as R, also other associated segments
+	Table0121ReportExceptionsOnly               Table0121 = `E` // Report exceptions only
+	Table0121SameAsDPlusConfirmationsExplicitly Table0121 = `F` // Same as D, plus confirmations explicitly
+	Table0121OnlyTheMSASegmentIsReturned        Table0121 = `N` // Only the MSA segment is returned
+	Table0121SameAsEAlsoReplacementAnd          Table0121 = `R` // Same as E, also Replacement and Parent-Child
+)
+
+// Description of the value, or empty if the value is not in table 0121.
+func (v Table0121) Description() string {
+	return tableDescription(`0121`, string(v))
+}
+
+// Valid reports if the value is in table 0121.
+func (v Table0121) Valid() bool {
+	return TableValueLookup[`0121`][string(v)]
+}
+
+// Table 0121.
+func (v Table0121) Table() Table {
+	return TableLookup[`0121`]
+}
+
+// Table0122 is a value of table 0122, Charge type.
+// Fields in this table are strings; convert a field, such as Table0122(v), to use the helpers.
+type Table0122 string
+
+const (
+	Table0122Charge       Table0122 = `CH` // Charge
+	Table0122Contract     Table0122 = `CO` // Contract
+	Table0122Credit       Table0122 = `CR` // Credit
+	Table0122Department   Table0122 = `DP` // Department
+	Table0122Grant        Table0122 = `GR` // Grant
+	Table0122NoCharge     Table0122 = `NC` // No Charge
+	Table0122Professional Table0122 = `PC` // Professional
+	Table0122Research     Table0122 = `RS` // Research
+)
+
+// Description of the value, or empty if the value is not in table 0122.
+func (v Table0122) Description() string {
+	return tableDescription(`0122`, string(v))
+}
+
+// Valid reports if the value is in table 0122.
+func (v Table0122) Valid() bool {
+	return TableValueLookup[`0122`][string(v)]
+}
+
+// Table 0122.
+func (v Table0122) Table() Table {
+	return TableLookup[`0122`]
+}
+
+// Table0123 is a value of table 0123, Result status.
+// Fields in this table are strings; convert a field, such as Table0123(v), to use the helpers.
+type Table0123 string
+
+const (
+	Table0123SomeButNotAllResultsAvailable               Table0123 = `A` // Some, but not all results available
+	Table0123CorrectionToResults                         Table0123 = `C` // Correction to results
+	Table0123FinalResultsResultsStoredAndVerified        Table0123 = `F` // Final results; results stored and verified. Can only be changed with a corrected result.
+	Table0123NoResultsAvailableSpecimenReceivedProcedure Table0123 = `I` // No results available; specimen received  procedure incomplete
+	Table0123OrderReceivedSpecimenNotYetReceived         Table0123 = `O` // Order received; specimen not yet received
+	Table0123PreliminaryAVerifiedEarlyResultIs           Table0123 = `P` // Preliminary: A verified early result is available  final results not yet obtained
+	Table0123ResultsStoredNotYetVerified                 Table0123 = `R` // Results stored; not yet verified
+	Table0123NoResultsAvailableProcedureScheduledBut     Table0123 = `S` // No results available; procedure scheduled  but not done
+	Table0123NoResultsAvailableOrderCanceled             Table0123 = `X` // No results available; Order canceled.
+	Table0123NoOrderOnRecordForThis                      Table0123 = `Y` // No order on record for this test. (Used only on queries)
+	Table0123NoRecordOfThisPatientUsed                   Table0123 = `Z` // No record of this patient. (Used only on queries)
+)
+
+// Description of the value, or empty if the value is not in table 0123.
+func (v Table0123) Description() string {
+	return tableDescription(`0123`, string(v))
+}
+
+// Valid reports if the value is in table 0123.
+func (v Table0123) Valid() bool {
+	return TableValueLookup[`0123`][string(v)]
+}
+
+// Table 0123.
+func (v Table0123) Table() Table {
+	return TableLookup[`0123`]
+}
+
+// Table0124 is a value of table 0124, Transportation mode.
+// Fields in this table are strings; convert a field, such as Table0124(v), to use the helpers.
+type Table0124 string
+
+const (
+	Table0124CartPatientTravelsOnCartOr      Table0124 = `CART` // Cart - patient travels on cart or gurney
+	Table0124TheExaminingDeviceGoesToPatient Table0124 = `PORT` // The examining device goes to patient’s location
+	Table0124PatientWalksToDiagnosticService Table0124 = `WALK` // Patient walks to diagnostic service
+	Table0124Wheelchair                      Table0124 = `WHLC` // Wheelchair
+)
+
+// Description of the value, or empty if the value is not in table 0124.
+func (v Table0124) Description() string {
+	return tableDescription(`0124`, string(v))
+}
+
+// Valid reports if the value is in table 0124.
+func (v Table0124) Valid() bool {
+	return TableValueLookup[`0124`][string(v)]
+}
+
+// Table 0124.
+func (v Table0124) Table() Table {
+	return TableLookup[`0124`]
+}
+
+// Table0125 is a value of table 0125, Value type.
+// Fields in this table are strings; convert a field, such as Table0125(v), to use the helpers.
+type Table0125 string
+
+const (
+	Table0125Address                               Table0125 = `AD`  // Address
+	Table0125CodedEntry                            Table0125 = `CE`  // Coded Entry
+	Table0125CodedElementWithFormattedValues       Table0125 = `CF`  // Coded Element With Formatted Values
+	Table0125CompositeIDWithCheckDigit             Table0125 = `CK`  // Composite ID With Check Digit
+	Table0125CompositeIDAndName                    Table0125 = `CN`  // Composite ID And Name
+	Table0125CompositePrice                        Table0125 = `CP`  // Composite Price
+	Table0125ExtendedCompositeIDWithCheckDigit     Table0125 = `CX`  // Extended Composite ID With Check Digit
+	Table0125Date                                  Table0125 = `DT`  // Date
+	Table0125EncapsulatedData                      Table0125 = `ED`  // Encapsulated Data
+	Table0125FormattedTextDisplay                  Table0125 = `FT`  // Formatted Text (Display)
+	Table0125CodedValue                            Table0125 = `ID`  // Coded Value
+	Table0125Money                                 Table0125 = `MO`  // Money
+	Table0125Numeric                               Table0125 = `NM`  // Numeric
+	Table0125PersonName                            Table0125 = `PN`  // Person Name
+	Table0125ReferencePointer                      Table0125 = `RP`  // Reference Pointer
+	Table0125StructuredNumeric                     Table0125 = `SN`  // Structured Numeric
+	Table0125StringData                            Table0125 = `ST`  // String Data.
+	Table0125Time                                  Table0125 = `TM`  // Time
+	Table0125TelephoneNumber                       Table0125 = `TN`  // Telephone Number
+	Table0125TimeStampDateTime                     Table0125 = `TS`  // Time Stamp (Date & Time)
+	Table0125TextDataDisplay                       Table0125 = `TX`  // Text Data (Display)
+	Table0125ExtendedAddress                       Table0125 = `XAD` // Extended Address
+	Table0125ExtendedCompositeNameAndNumberFor     Table0125 = `XCN` // Extended Composite Name And Number For Persons
+	Table0125ExtendedCompositeNameAndNumberFor_XON Table0125 = `XON` // Extended Composite Name And Number For Organizations
+	Table0125ExtendedPersonName                    Table0125 = `XPN` // Extended Person Name
+	Table0125ExtendedTelecommunicationsNumber      Table0125 = `XTN` // Extended Telecommunications Number
+)
+
+// Description of the value, or empty if the value is not in table 0125.
+func (v Table0125) Description() string {
+	return tableDescription(`0125`, string(v))
+}
+
+// Valid reports if the value is in table 0125.
+func (v Table0125) Valid() bool {
+	return TableValueLookup[`0125`][string(v)]
+}
+
+// Table 0125.
+func (v Table0125) Table() Table {
+	return TableLookup[`0125`]
+}
+
+// Table0127 is a value of table 0127, Allergy type.
+// Fields in this table are strings; convert a field, such as Table0127(v), to use the helpers.
+type Table0127 string
+
+const (
+	Table0127DrugAllergy                   Table0127 = `DA` // Drug allergy
+	Table0127FoodAllergy                   Table0127 = `FA` // Food allergy
+	Table0127MiscellaneousAllergy          Table0127 = `MA` // Miscellaneous allergy
+	Table0127MiscellaneousContraindication Table0127 = `MC` // Miscellaneous contraindication
+)
+
+// Description of the value, or empty if the value is not in table 0127.
+func (v Table0127) Description() string {
+	return tableDescription(`0127`, string(v))
+}
+
+// Valid reports if the value is in table 0127.
+func (v Table0127) Valid() bool {
+	return TableValueLookup[`0127`][string(v)]
+}
+
+// Table 0127.
+func (v Table0127) Table() Table {
+	return TableLookup[`0127`]
+}
+
+// Table0128 is a value of table 0128, Allergy severity.
+// Fields in this table are strings; convert a field, such as Table0128(v), to use the helpers.
+type Table0128 string
+
+const (
+	Table0128Mild     Table0128 = `MI` // Mild
+	Table0128Moderate Table0128 = `MO` // Moderate
+	Table0128Severe   Table0128 = `SV` // Severe
+)
+
+// Description of the value, or empty if the value is not in table 0128.
+func (v Table0128) Description() string {
+	return tableDescription(`0128`, string(v))
+}
+
+// Valid reports if the value is in table 0128.
+func (v Table0128) Valid() bool {
+	return TableValueLookup[`0128`][string(v)]
+}
+
+// Table 0128.
+func (v Table0128) Table() Table {
+	return TableLookup[`0128`]
+}
+
+// Table0135 is a value of table 0135, Assignment of benefits.
+// Fields in this table are strings; convert a field, such as Table0135(v), to use the helpers.
+type Table0135 string
+
+const (
+	Table0135ModifiedAssignment Table0135 = `M` // Modified assignment
+	Table0135No                 Table0135 = `N` // No
+	Table0135Yes                Table0135 = `Y` // Yes
+)
+
+// Description of the value, or empty if the value is not in table 0135.
+func (v Table0135) Description() string {
+	return tableDescription(`0135`, string(v))
+}
+
+// Valid reports if the value is in table 0135.
+func (v Table0135) Valid() bool {
+	return TableValueLookup[`0135`][string(v)]
+}
+
+// Table 0135.
+func (v Table0135) Table() Table {
+	return TableLookup[`0135`]
+}
+
+// Table0136 is a value of table 0136, Yes/no indicator.
+// Fields in this table are strings; convert a field, such as Table0136(v), to use the helpers.
+type Table0136 string
+
+const (
+	Table0136No  Table0136 = `N` // No
+	Table0136Yes Table0136 = `Y` // Yes
+)
+
+// Description of the value, or empty if the value is not in table 0136.
+func (v Table0136) Description() string {
+	return tableDescription(`0136`, string(v))
+}
+
+// Valid reports if the value is in table 0136.
+func (v Table0136) Valid() bool {
+	return TableValueLookup[`0136`][string(v)]
+}
+
+// Table 0136.
+func (v Table0136) Table() Table {
+	return TableLookup[`0136`]
+}
+
+// Table0137 is a value of table 0137, Mail claim party.
+// Fields in this table are strings; convert a field, such as Table0137(v), to use the helpers.
+type Table0137 string
+
+const (
+	Table0137Employer         Table0137 = `E` // Employer
+	Table0137Guarantor        Table0137 = `G` // Guarantor
+	Table0137InsuranceCompany Table0137 = `I` // Insurance company
+	Table0137Other            Table0137 = `O` // Other
+	Table0137Patient          Table0137 = `P` // Patient
+)
+
+// Description of the value, or empty if the value is not in table 0137.
+func (v Table0137) Description() string {
+	return tableDescription(`0137`, string(v))
+}
+
+// Valid reports if the value is in table 0137.
+func (v Table0137) Valid() bool {
+	return TableValueLookup[`0137`][string(v)]
+}
+
+// Table 0137.
+func (v Table0137) Table() Table {
+	return TableLookup[`0137`]
+}
+
+// Table0140 is a value of table 0140, Military service.
+// Fields in this table are strings; convert a field, such as Table0140(v), to use the helpers.
+type Table0140 string
+
+const (
+	Table0140NorthAtlanticTreatyOrganization             Table0140 = `NATO`  // North Atlantic Treaty Organization
+	Table0140NationalOceanicAndAtmosphericAdministration Table0140 = `NOAA`  // National Oceanic and Atmospheric Administration
+	Table0140USArmy                                      Table0140 = `USA`   // U.S. Army
+	Table0140USAirForce                                  Table0140 = `USAF`  // U.S. Air Force
+	Table0140USCoastGuard                                Table0140 = `USCG`  // U.S. Coast Guard
+	Table0140USMarines                                   Table0140 = `USMC`  // U.S. Marines
+	Table0140USNavy                                      Table0140 = `USN`   // U.S. Navy
+	Table0140USPublicHealthService                       Table0140 = `USPHS` // U.S. Public Health Service
+)
+
+// Description of the value, or empty if the value is not in table 0140.
+func (v Table0140) Description() string {
+	return tableDescription(`0140`, string(v))
+}
+
+// Valid reports if the value is in table 0140.
+func (v Table0140) Valid() bool {
+	return TableValueLookup[`0140`][string(v)]
+}
+
+// Table 0140.
+func (v Table0140) Table() Table {
+	return TableLookup[`0140`]
+}
+
+// Table0141 is a value of table 0141, Military rank/grade.
+// Fields in this table are strings; convert a field, such as Table0141(v), to use the helpers.
+type Table0141 string
+
+const (
+	Table0141Enlisted           Table0141 = `E1`  // Enlisted
+	Table0141Enlisted_E2        Table0141 = `E2`  // Enlisted
+	Table0141Enlisted_E3        Table0141 = `E3`  // Enlisted
+	Table0141Enlisted_E4        Table0141 = `E4`  // Enlisted
+	Table0141Enlisted_E5        Table0141 = `E5`  // Enlisted
+	Table0141Enlisted_E6        Table0141 = `E6`  // Enlisted
+	Table0141Enlisted_E7        Table0141 = `E7`  // Enlisted
+	Table0141Enlisted_E8        Table0141 = `E8`  // Enlisted
+	Table0141Enlisted_E9        Table0141 = `E9`  // Enlisted
+	Table0141Officers           Table0141 = `O1`  // Officers
+	Table0141Officers_O10       Table0141 = `O10` // Officers
+	Table0141Officers_O2        Table0141 = `O2`  // Officers
+	Table0141Officers_O3        Table0141 = `O3`  // Officers
+	Table0141Officers_O4        Table0141 = `O4`  // Officers
+	Table0141Officers_O5        Table0141 = `O5`  // Officers
+	Table0141Officers_O6        Table0141 = `O6`  // Officers
+	Table0141Officers_O7        Table0141 = `O7`  // Officers
+	Table0141Officers_O8        Table0141 = `O8`  // Officers
+	Table0141Officers_O9        Table0141 = `O9`  // Officers
+	Table0141WarrantOfficers    Table0141 = `W1`  // Warrant Officers
+	Table0141WarrantOfficers_W2 Table0141 = `W2`  // Warrant Officers
+	Table0141WarrantOfficers_W3 Table0141 = `W3`  // Warrant Officers
+	Table0141WarrantOfficers_W4 Table0141 = `W4`  // Warrant Officers
+)
+
+// Description of the value, or empty if the value is not in table 0141.
+func (v Table0141) Description() string {
+	return tableDescription(`0141`, string(v))
+}
+
+// Valid reports if the value is in table 0141.
+func (v Table0141) Valid() bool {
+	return TableValueLookup[`0141`][string(v)]
+}
+
+// Table 0141.
+func (v Table0141) Table() Table {
+	return TableLookup[`0141`]
+}
+
+// Table0142 is a value of table 0142, Military status.
+// Fields in this table are strings; convert a field, such as Table0142(v), to use the helpers.
+type Table0142 string
+
+const (
+	Table0142ActiveDuty Table0142 = `ACT` // Active duty
+	Table0142Deceased   Table0142 = `DEC` // Deceased
+	Table0142Retired    Table0142 = `RET` // Retired
+)
+
+// Description of the value, or empty if the value is not in table 0142.
+func (v Table0142) Description() string {
+	return tableDescription(`0142`, string(v))
+}
+
+// Valid reports if the value is in table 0142.
+func (v Table0142) Valid() bool {
+	return TableValueLookup[`0142`][string(v)]
+}
+
+// Table 0142.
+func (v Table0142) Table() Table {
+	return TableLookup[`0142`]
+}
+
+// Table0144 is a value of table 0144, Eligibility source.
+// Fields in this table are strings; convert a field, such as Table0144(v), to use the helpers.
+type Table0144 string
+
+const (
+	Table0144InsuranceCompany       Table0144 = `1` // Insurance company
+	Table0144Employer               Table0144 = `2` // Employer
+	Table0144InsuredPresentedPolicy Table0144 = `3` // Insured presented policy
+	Table0144InsuredPresentedCard   Table0144 = `4` // Insured presented card
+	Table0144SignedStatementOnFile  Table0144 = `5` // Signed statement on file
+	Table0144VerbalInformation      Table0144 = `6` // Verbal information
+	Table0144None                   Table0144 = `7` // None
+)
+
+// Description of the value, or empty if the value is not in table 0144.
+func (v Table0144) Description() string {
+	return tableDescription(`0144`, string(v))
+}
+
+// Valid reports if the value is in table 0144.
+func (v Table0144) Valid() bool {
+	return TableValueLookup[`0144`][string(v)]
+}
+
+// Table 0144.
+func (v Table0144) Table() Table {
+	return TableLookup[`0144`]
+}
+
+// Table0145 is a value of table 0145, Room type.
+// Fields in this table are strings; convert a field, such as Table0145(v), to use the helpers.
+type Table0145 string
+
+const (
+	Table0145SecondIntensiveCareUnit Table0145 = `2ICU` // Second intensive care unit
+	Table0145SecondPrivateRoom       Table0145 = `2PRI` // Second private room
+	Table0145SecondSemiPrivateRoom   Table0145 = `2SPR` // Second semi-private room
+	Table0145IntensiveCareUnit       Table0145 = `ICU`  // Intensive care unit
+	Table0145PrivateRoom             Table0145 = `PRI`  // Private room
+	Table0145SemiPrivateRoom         Table0145 = `SPR`  // Semi-private room
+)
+
+// Description of the value, or empty if the value is not in table 0145.
+func (v Table0145) Description() string {
+	return tableDescription(`0145`, string(v))
+}
+
+// Valid reports if the value is in table 0145.
+func (v Table0145) Valid() bool {
+	return TableValueLookup[`0145`][string(v)]
+}
+
+// Table 0145.
+func (v Table0145) Table() Table {
+	return TableLookup[`0145`]
+}
+
+// Table0146 is a value of table 0146, Amount type.
+// Fields in this table are strings; convert a field, such as Table0146(v), to use the helpers.
+type Table0146 string
+
+const (
+	Table0146Differential Table0146 = `DF` // Differential
+	Table0146Limit        Table0146 = `LM` // Limit
+	Table0146Percentage   Table0146 = `PC` // Percentage
+	Table0146Rate         Table0146 = `RT` // Rate
+	Table0146Unlimited    Table0146 = `UL` // Unlimited
+)
+
+// Description of the value, or empty if the value is not in table 0146.
+func (v Table0146) Description() string {
+	return tableDescription(`0146`, string(v))
+}
+
+// Valid reports if the value is in table 0146.
+func (v Table0146) Valid() bool {
+	return TableValueLookup[`0146`][string(v)]
+}
+
+// Table 0146.
+func (v Table0146) Table() Table {
+	return TableLookup[`0146`]
+}
+
+// Table0147 is a value of table 0147, Policy type.
+// Fields in this table are strings; convert a field, such as Table0147(v), to use the helpers.
+type Table0147 string
+
+const (
+	Table0147SecondAncillary    Table0147 = `2ANC` // Second ancillary
+	Table0147SecondMajorMedical Table0147 = `2MMD` // Second major medical
+	Table0147ThirdMajorMedical  Table0147 = `3MMD` // Third major medical
+	Table0147Ancillary          Table0147 = `ANC`  // Ancillary
+	Table0147MajorMedical       Table0147 = `MMD`  // Major medical
+)
+
+// Description of the value, or empty if the value is not in table 0147.
+func (v Table0147) Description() string {
+	return tableDescription(`0147`, string(v))
+}
+
+// Valid reports if the value is in table 0147.
+func (v Table0147) Valid() bool {
+	return TableValueLookup[`0147`][string(v)]
+}
+
+// Table 0147.
+func (v Table0147) Table() Table {
+	return TableLookup[`0147`]
+}
+
+// Table0148 is a value of table 0148, Penalty type.
+// Fields in this table are strings; convert a field, such as Table0148(v), to use the helpers.
+type Table0148 string
+
+const (
+	Table0148CurrencyAmount Table0148 = `AT` // Currency amount
+	Table0148Percentage     Table0148 = `PC` // Percentage
+)
+
+// Description of the value, or empty if the value is not in table 0148.
+func (v Table0148) Description() string {
+	return tableDescription(`0148`, string(v))
+}
+
+// Valid reports if the value is in table 0148.
+func (v Table0148) Valid() bool {
+	return TableValueLookup[`0148`][string(v)]
+}
+
+// Table 0148.
+func (v Table0148) Table() Table {
+	return TableLookup[`0148`]
+}
+
+// Table0149 is a value of table 0149, Day type.
+// Fields in this table are strings; convert a field, such as Table0149(v), to use the helpers.
+type Table0149 string
+
+const (
+	Table0149Approved Table0149 = `AP` // Approved
+	Table0149Denied   Table0149 = `DE` // Denied
+	Table0149Pending  Table0149 = `PE` // Pending
+)
+
+// Description of the value, or empty if the value is not in table 0149.
+func (v Table0149) Description() string {
+	return tableDescription(`0149`, string(v))
+}
+
+// Valid reports if the value is in table 0149.
+func (v Table0149) Valid() bool {
+	return TableValueLookup[`0149`][string(v)]
+}
+
+// Table 0149.
+func (v Table0149) Table() Table {
+	return TableLookup[`0149`]
+}
+
+// Table0150 is a value of table 0150, Pre-certification patient type.
+// Fields in this table are strings; convert a field, such as Table0150(v), to use the helpers.
+type Table0150 string
+
+const (
+	Table0150Emergency          Table0150 = `ER`  // Emergency
+	Table0150InpatientElective  Table0150 = `IPE` // Inpatient elective
+	Table0150OutpatientElective Table0150 = `OPE` // Outpatient elective
+	Table0150Urgent             Table0150 = `UR`  // Urgent
+)
+
+// Description of the value, or empty if the value is not in table 0150.
+func (v Table0150) Description() string {
+	return tableDescription(`0150`, string(v))
+}
+
+// Valid reports if the value is in table 0150.
+func (v Table0150) Valid() bool {
+	return TableValueLookup[`0150`][string(v)]
+}
+
+// Table 0150.
+func (v Table0150) Table() Table {
+	return TableLookup[`0150`]
+}
+
+// Table0153 is a value of table 0153, Value code.
+// Fields in this table are strings; convert a field, such as Table0153(v), to use the helpers.
+type Table0153 string
+
+const (
+	Table0153MostCommonSemiPrivateRate                     Table0153 = `01`        // Most common semi-private rate
+	Table0153HospitalHasNoSemiPrivateRooms                 Table0153 = `02`        // Hospital has no semi-private rooms
+	Table0153InpatientProfessionalComponentChargesWhichAre Table0153 = `04`        // Inpatient professional component charges which are combined billed
+	Table0153ProfessionalComponentIncludedInChargesAnd     Table0153 = `05`        // Professional component included in charges and also billed separate to carrier
+	Table0153MedicareBloodDeductible                       Table0153 = `06`        // Medicare blood deductible
+	Table0153MedicareLifeTimeReserveAmountIn               Table0153 = `08`        // Medicare life time reserve amount in the first calendar year
+	Table0153MedicareCoInsuranceAmountInThe                Table0153 = `09`        // Medicare co-insurance amount in the first calendar year
+	Table0153LifetimeReserveAmountInTheSecond              Table0153 = `10`        // Lifetime reserve amount in the second calendar year
+	Table0153CoInsuranceAmountInTheSecond                  Table0153 = `11`        // Co-insurance amount in the second calendar year
+	Table0153WorkingAgedBeneficiarySpouseWithEmployer      Table0153 = `12`        // Working aged beneficiary/spouse with employer group health plan
+	Table0153ESRDBeneficiaryInAMedicareCoordination        Table0153 = `13`        // ESRD beneficiary in a Medicare coordination period with an employer group health plan
+	Table0153NoFaultIncludingAutoOther                     Table0153 = `14`        // No Fault including auto/other
+	Table0153WorkerSCompensation                           Table0153 = `15`        // Worker's Compensation
+	Table0153PHSOrOtherFederalAgency                       Table0153 = `16`        // PHS, or other federal agency
+	Table0153PayerCode                                     Table0153 = `17`        // Payer code
+	Table0153Catastrophic                                  Table0153 = `21`        // Catastrophic
+	Table0153Surplus                                       Table0153 = `22`        // Surplus
+	Table0153RecurringMonthlyIncode                        Table0153 = `23`        // Recurring monthly incode
+	Table0153MedicaidRateCode                              Table0153 = `24`        // Medicaid rate code
+	Table0153PreAdmissionTesting                           Table0153 = `30`        // Pre-admission testing
+	Table0153PatientLiabilityAmount                        Table0153 = `31`        // Patient liability amount
+	Table0153PintsOfBloodFurnished                         Table0153 = `37`        // Pints of blood furnished
+	Table0153BloodDeductiblePints                          Table0153 = `38`        // Blood deductible pints
+	Table0153PintsOfBloodReplaced                          Table0153 = `39`        // Pints of blood replaced
+	Table0153NewCoverageNotImplementedByHMO                Table0153 = `40`        // New coverage not implemented by HMO (for inpatient service only)
+	Table0153BlackLung                                     Table0153 = `41`        // Black lung
+	Table0153VA                                            Table0153 = `42`        // VA
+	Table0153DisabledBeneficiaryUnderAge64With             Table0153 = `43`        // Disabled beneficiary under age 64 with LGHP
+	Table0153AmountProviderAgreedToAcceptFrom              Table0153 = `44`        // Amount provider agreed to accept from primary payer when this amount is less than charges but higher than payment received,, then a Medicare secondary payment is due
+	Table0153AccidentHour                                  Table0153 = `45`        // Accident hour
+	Table0153NumberOfGraceDays                             Table0153 = `46`        // Number of grace days
+	Table0153AnyLiabilityInsurance                         Table0153 = `47`        // Any liability insurance
+	Table0153HemoglobinReading                             Table0153 = `48`        // Hemoglobin reading
+	Table0153HematocritReading                             Table0153 = `49`        // Hematocrit reading
+	Table0153PhysicalTherapyVisits                         Table0153 = `50`        // Physical therapy visits
+	Table0153OccupationalTherapyVisits                     Table0153 = `51`        // Occupational therapy visits
+	Table0153SpeechTherapyVisits                           Table0153 = `52`        // Speech therapy visits
+	Table0153CardiacRehabVisits                            Table0153 = `53`        // Cardiac rehab visits
+	Table0153SkilledNurseHomeVisitHours                    Table0153 = `56`        // Skilled nurse - home visit hours
+	Table0153HomeHealthAideHomeVisitHours                  Table0153 = `57`        // Home health aide - home visit hours
+	Table0153ArterialBloodGas                              Table0153 = `58`        // Arterial blood gas
+	Table0153OxygenSaturation                              Table0153 = `59`        // Oxygen saturation
+	Table0153HHABranchMSA                                  Table0153 = `60`        // HHA branch MSA
+	Table0153PeritonealDialysis                            Table0153 = `67`        // Peritoneal dialysis
+	Table0153EPODrug                                       Table0153 = `68`        // EPO-drug
+	Table0153PayerCodes                                    Table0153 = `70 ... 72` // Payer codes
+	Table0153PayerCodes_7579                               Table0153 = `75 ... 79` // Payer codes
+	Table0153PsychiatricVisits                             Table0153 = `80`        // Psychiatric visits
+	Table0153VisitsSubjectToCoPayment                      Table0153 = `81`        // Visits subject to co-payment
+	Table0153DeductiblePayerA                              Table0153 = `A1`        // Deductible payer A
+	Table0153CoinsurancePayerA                             Table0153 = `A2`        // Coinsurance payer A
+	Table0153EstimatedResponsibilityPayerA                 Table0153 = `A3`        // Estimated responsibility payer A
+	Table0153ServiceExcludedOnPrimaryPolicy                Table0153 = `X0`        // Service excluded on primary policy
+	Table0153SupplementalCoverage                          Table0153 = `X4`        // Supplemental coverage
+)
+
+// Description of the value, or empty if the value is not in table 0153.
+func (v Table0153) Description() string {
+	return tableDescription(`0153`, string(v))
+}
+
+// Valid reports if the value is in table 0153.
+func (v Table0153) Valid() bool {
+	return TableValueLookup[`0153`][string(v)]
+}
+
+// Table 0153.
+func (v Table0153) Table() Table {
+	return TableLookup[`0153`]
+}
+
+// Table0155 is a value of table 0155, Accept/application acknowledgment conditions.
+// Fields in this table are strings; convert a field, such as Table0155(v), to use the helpers.
+type Table0155 string
+
+const (
+	Table0155Always                    Table0155 = `AL` // Always
+	Table0155ErrorRejectConditionsOnly Table0155 = `ER` // Error/reject conditions only
+	Table0155Never                     Table0155 = `NE` // Never
+	Table0155SuccessfulCompletionOnly  Table0155 = `SU` // Successful completion only
+)
+
+// Description of the value, or empty if the value is not in table 0155.
+func (v Table0155) Description() string {
+	return tableDescription(`0155`, string(v))
+}
+
+// Valid reports if the value is in table 0155.
+func (v Table0155) Valid() bool {
+	return TableValueLookup[`0155`][string(v)]
+}
+
+// Table 0155.
+func (v Table0155) Table() Table {
+	return TableLookup[`0155`]
+}
+
+// Table0156 is a value of table 0156, Which date/time qualifier.
+// Fields in this table are strings; convert a field, such as Table0156(v), to use the helpers.
+type Table0156 string
+
+const (
+	Table0156AnyDateTimeWithinARange            Table0156 = `ANY`   // Any date/time within a range
+	Table0156CollectionDateTimeEquivalentToFilm Table0156 = `COL`   // Collection date/time, equivalent to film or sample collection date/time
+	Table0156OrderDateTime                      Table0156 = `ORD`   // Order date/time
+	Table0156SpecimenReceiptDateTimeReceiptOf   Table0156 = `RCT`   // Specimen receipt date/time, receipt of specimen in filling ancillary (Lab)
+	Table0156ReportDateTimeReportDateTime       Table0156 = `REP`   // Report date/time, report date/time at filing ancillary (i.e., Lab)
+	Table0156ScheduleDateTime                   Table0156 = `SCHED` // Schedule date/time
+)
+
+// Description of the value, or empty if the value is not in table 0156.
+func (v Table0156) Description() string {
+	return tableDescription(`0156`, string(v))
+}
+
+// Valid reports if the value is in table 0156.
+func (v Table0156) Valid() bool {
+	return TableValueLookup[`0156`][string(v)]
+}
+
+// Table 0156.
+func (v Table0156) Table() Table {
+	return TableLookup[`0156`]
+}
+
+// Table0157 is a value of table 0157, Which date/time status qualifier.
+// Fields in this table are strings; convert a field, such as Table0157(v), to use the helpers.
+type Table0157 string
+
+const (
+	Table0157AnyStatus                           Table0157 = `ANY` // Any status
+	Table0157CurrentFinalValueWhetherFinalOr     Table0157 = `CFN` // Current final value, whether final or corrected
+	Table0157CorrectedOnlyNoFinalWithCorrections Table0157 = `COR` // Corrected only (no final with corrections)
+	Table0157FinalOnlyNoCorrections              Table0157 = `FIN` // Final only (no corrections)
+	Table0157Preliminary                         Table0157 = `PRE` // Preliminary
+	Table0157ReportCompletionDateTime            Table0157 = `REP` // Report completion date/time
+)
+
+// Description of the value, or empty if the value is not in table 0157.
+func (v Table0157) Description() string {
+	return tableDescription(`0157`, string(v))
+}
+
+// Valid reports if the value is in table 0157.
+func (v Table0157) Valid() bool {
+	return TableValueLookup[`0157`][string(v)]
+}
+
+// Table 0157.
+func (v Table0157) Table() Table {
+	return TableLookup[`0157`]
+}
+
+// Table0158 is a value of table 0158, Date/time selection qualifier.
+// Fields in this table are strings; convert a field, such as Table0158(v), to use the helpers.
+type Table0158 string
+
+const (
+	Table0158FirstValueWithinRange           Table0158 = `1ST` // First value within range
+	Table0158AllValuesWithinTheRange         Table0158 = `ALL` // All values within the range
+	Table0158LastValueWithinTheRange         Table0158 = `LST` // Last value within the range
+	Table0158AllValuesWithinTheRangeReturned Table0158 = `REV` // All values within the range returned in reverse chronological order (This is the default if not otherwise specified.)
+)
+
+// Description of the value, or empty if the value is not in table 0158.
+func (v Table0158) Description() string {
+	return tableDescription(`0158`, string(v))
+}
+
+// Valid reports if the value is in table 0158.
+func (v Table0158) Valid() bool {
+	return TableValueLookup[`0158`][string(v)]
+}
+
+// Table 0158.
+func (v Table0158) Table() Table {
+	return TableLookup[`0158`]
+}
+
+// Table0159 is a value of table 0159, Diet code specification type.
+// Fields in this table are strings; convert a field, such as Table0159(v), to use the helpers.
+type Table0159 string
+
+const (
+	Table0159Diet       Table0159 = `D` // Diet
+	Table0159Preference Table0159 = `P` // Preference
+	Table0159Supplement Table0159 = `S` // Supplement
+)
+
+// Description of the value, or empty if the value is not in table 0159.
+func (v Table0159) Description() string {
+	return tableDescription(`0159`, string(v))
+}
+
+// Valid reports if the value is in table 0159.
+func (v Table0159) Valid() bool {
+	return TableValueLookup[`0159`][string(v)]
+}
+
+// Table 0159.
+func (v Table0159) Table() Table {
+	return TableLookup[`0159`]
+}
+
+// Table0160 is a value of table 0160, Tray type.
+// Fields in this table are strings; convert a field, such as Table0160(v), to use the helpers.
+type Table0160 string
+
+const (
+	Table0160EarlyTray       Table0160 = `EARLY` // Early tray
+	Table0160GuestTray       Table0160 = `GUEST` // Guest tray
+	Table0160LateTray        Table0160 = `LATE`  // Late tray
+	Table0160TrayMessageOnly Table0160 = `MSG`   // Tray message only
+	Table0160NoTray          Table0160 = `NO`    // No tray
+)
+
+// Description of the value, or empty if the value is not in table 0160.
+func (v Table0160) Description() string {
+	return tableDescription(`0160`, string(v))
+}
+
+// Valid reports if the value is in table 0160.
+func (v Table0160) Valid() bool {
+	return TableValueLookup[`0160`][string(v)]
+}
+
+// Table 0160.
+func (v Table0160) Table() Table {
+	return TableLookup[`0160`]
+}
+
+// Table0161 is a value of table 0161, Allow substitution.
+// Fields in this table are strings; convert a field, such as Table0161(v), to use the helpers.
+type Table0161 string
+
+const (
+	Table0161AllowGenericSubstitutions           Table0161 = `G` // Allow generic substitutions.
+	Table0161SubstitutionsAreNOTAuthorizedThisIs Table0161 = `N` // Substitutions are NOT authorized. (This is the default - null.)
+	Table0161AllowTherapeuticSubstitutions       Table0161 = `T` // Allow therapeutic substitutions
+)
+
+// Description of the value, or empty if the value is not in table 0161.
+func (v Table0161) Description() string {
+	return tableDescription(`0161`, string(v))
+}
+
+// Valid reports if the value is in table 0161.
+func (v Table0161) Valid() bool {
+	return TableValueLookup[`0161`][string(v)]
+}
+
+// Table 0161.
+func (v Table0161) Table() Table {
+	return TableLookup[`0161`]
+}
+
+// Table0162 is a value of table 0162, Route of administration.
+// Fields in this table are strings; convert a field, such as Table0162(v), to use the helpers.
+type Table0162 string
+
+const (
+	Table0162ApplyExternally     Table0162 = `AP`  // Apply Externally
+	Table0162Buccal              Table0162 = `B`   // Buccal
+	Table0162Dental              Table0162 = `DT`  // Dental
+	Table0162Epidural            Table0162 = `EP`  // Epidural
+	Table0162EndotrachialTube    Table0162 = `ET`  // Endotrachial Tube
+	Table0162GastronomyTube      Table0162 = `GTT` // Gastronomy Tube
+	Table0162GUIrrigant          Table0162 = `GU`  // GU Irrigant
+	Table0162IntraArterial       Table0162 = `IA`  // Intra-arterial
+	Table0162Intrabursal         Table0162 = `IB`  // Intrabursal
+	Table0162Intracardiac        Table0162 = `IC`  // Intracardiac
+	Table0162IntracervicalUterus Table0162 = `ICV` // Intracervical (uterus)
+	Table0162Intradermal         Table0162 = `ID`  // Intradermal
+	Table0162Inhalation          Table0162 = `IH`  // Inhalation
+	Table0162IntrahepaticArtery  Table0162 = `IHA` // Intrahepatic Artery
+	Table0162ImmerseSoakBodyPart Table0162 = `IMR` // Immerse (Soak) Body Part
+	Table0162Intrauterine        Table0162 = `IU`  // Intrauterine
+	Table0162MucousMembrane      Table0162 = `MM`  // Mucous Membrane
+	Table0162MouthThroat         Table0162 = `MTH` // Mouth/Throat
+	Table0162Nasogastric         Table0162 = `NG`  // Nasogastric
+	Table0162NasalProngs         Table0162 = `NP`  // Nasal Prongs
+	Table0162Nasal               Table0162 = `NS`  // Nasal
+	Table0162NasotrachialTube    Table0162 = `NT`  // Nasotrachial Tube
+	Table0162Ophthalmic          Table0162 = `OP`  // Ophthalmic
+	Table0162Otic                Table0162 = `OT`  // Otic
+	Table0162OtherMiscellaneous  Table0162 = `OTH` // Other/Miscellaneous
+	Table0162Perfusion           Table0162 = `PF`  // Perfusion
+	Table0162Oral                Table0162 = `PO`  // Oral
+	Table0162Rectal              Table0162 = `PR`  // Rectal
+	Table0162RebreatherMask      Table0162 = `RM`  // Rebreather Mask
+	Table0162Subcutaneous        Table0162 = `SC`  // Subcutaneous
+	Table0162SoakedDressing      Table0162 = `SD`  // Soaked Dressing
+	Table0162Sublingual          Table0162 = `SL`  // Sublingual
+	Table0162Transdermal         Table0162 = `TD`  // Transdermal
+	Table0162Translingual        Table0162 = `TL`  // Translingual
+	Table0162Topical             Table0162 = `TP`  // Topical
+	Table0162Tracheostomy        Table0162 = `TRA` // Tracheostomy
+	Table0162Urethral            Table0162 = `UR`  // Urethral
+	Table0162Vaginal             Table0162 = `VG`  // Vaginal
+	Table0162Ventimask           Table0162 = `VM`  // Ventimask
+	Table0162Wound               Table0162 = `WND` // Wound
+)
+
+// Description of the value, or empty if the value is not in table 0162.
+func (v Table0162) Description() string {
+	return tableDescription(`0162`, string(v))
+}
+
+// Valid reports if the value is in table 0162.
+func (v Table0162) Valid() bool {
+	return TableValueLookup[`0162`][string(v)]
+}
+
+// Table 0162.
+func (v Table0162) Table() Table {
+	return TableLookup[`0162`]
+}
+
+// Table0163 is a value of table 0163, Administrative Site.
+// Fields in this table are strings; convert a field, such as Table0163(v), to use the helpers.
+type Table0163 string
+
+const (
+	Table0163BilateralEars         Table0163 = `BE`    // Bilateral Ears
+	Table0163BilateralNares        Table0163 = `BN`    // Bilateral Nares
+	Table0163Buttock               Table0163 = `BU`    // Buttock
+	Table0163ChestTube             Table0163 = `CT`    // Chest Tube
+	Table0163LeftArm               Table0163 = `LA`    // Left Arm
+	Table0163LeftAnteriorChest     Table0163 = `LAC`   // Left Anterior Chest
+	Table0163LeftAntecubitalFossa  Table0163 = `LACF`  // Left Antecubital Fossa
+	Table0163LeftDeltoid           Table0163 = `LD`    // Left Deltoid
+	Table0163LeftEar               Table0163 = `LE`    // Left Ear
+	Table0163LeftExternalJugular   Table0163 = `LEJ`   // Left External Jugular
+	Table0163LeftFoot              Table0163 = `LF`    // Left Foot
+	Table0163LeftGluteusMedius     Table0163 = `LG`    // Left Gluteus Medius
+	Table0163LeftHand              Table0163 = `LH`    // Left Hand
+	Table0163LeftInternalJugular   Table0163 = `LIJ`   // Left Internal Jugular
+	Table0163LeftLowerAbdQuadrant  Table0163 = `LLAQ`  // Left Lower Abd Quadrant
+	Table0163LeftLowerForearm      Table0163 = `LLFA`  // Left Lower Forearm
+	Table0163LeftMidForearm        Table0163 = `LMFA`  // Left Mid Forearm
+	Table0163LeftNaris             Table0163 = `LN`    // Left Naris
+	Table0163LeftPosteriorChest    Table0163 = `LPC`   // Left Posterior Chest
+	Table0163LeftSubclavian        Table0163 = `LSC`   // Left Subclavian
+	Table0163LeftThigh             Table0163 = `LT`    // Left Thigh
+	Table0163LeftUpperArm          Table0163 = `LUA`   // Left Upper Arm
+	Table0163LeftUpperAbdQuadrant  Table0163 = `LUAQ`  // Left Upper Abd Quadrant
+	Table0163LeftUpperForearm      Table0163 = `LUFA`  // Left Upper Forearm
+	Table0163LeftVentragluteal     Table0163 = `LVG`   // Left Ventragluteal
+	Table0163LeftVastusLateralis   Table0163 = `LVL`   // Left Vastus Lateralis
+	Table0163Nebulized             Table0163 = `NB`    // Nebulized
+	Table0163RightEye              Table0163 = `OD`    // Right Eye
+	Table0163LeftEye               Table0163 = `OS`    // Left Eye
+	Table0163BilateralEyes         Table0163 = `OU`    // Bilateral Eyes
+	Table0163Perianal              Table0163 = `PA`    // Perianal
+	Table0163Perineal              Table0163 = `PERIN` // Perineal
+	Table0163RightArm              Table0163 = `RA`    // Right Arm
+	Table0163RightAnteriorChest    Table0163 = `RAC`   // Right Anterior Chest
+	Table0163RightAntecubitalFossa Table0163 = `RACF`  // Right Antecubital Fossa
+	Table0163RightDeltoid          Table0163 = `RD`    // Right Deltoid
+	Table0163RightEar              Table0163 = `RE`    // Right Ear
+	Table0163RightExternalJugular  Table0163 = `REJ`   // Right External Jugular
+	Table0163RightFoot             Table0163 = `RF`    // Right Foot
+	Table0163RightGluteusMedius    Table0163 = `RG`    // Right Gluteus Medius
+	Table0163RightHand             Table0163 = `RH`    // Right Hand
+	Table0163RightInternalJugular  Table0163 = `RIJ`   // Right Internal Jugular
+	Table0163RtLowerAbdQuadrant    Table0163 = `RLAQ`  // Rt Lower Abd Quadrant
+	Table0163RightLowerForearm     Table0163 = `RLFA`  // Right Lower Forearm
+	Table0163RightMidForearm       Table0163 = `RMFA`  // Right Mid Forearm
+	Table0163RightNaris            Table0163 = `RN`    // Right Naris
+	Table0163RightPosteriorChest   Table0163 = `RPC`   // Right Posterior Chest
+	Table0163RightSubclavian       Table0163 = `RSC`   // Right Subclavian
+	Table0163RightThigh            Table0163 = `RT`    // Right Thigh
+	Table0163RightUpperArm         Table0163 = `RUA`   // Right Upper Arm
+	Table0163RightUpperAbdQuadrant Table0163 = `RUAQ`  // Right Upper Abd Quadrant
+	Table0163RightUpperForearm     Table0163 = `RUFA`  // Right Upper Forearm
+	Table0163RightVentragluteal    Table0163 = `RVG`   // Right Ventragluteal
+	Table0163RightVastusLateralis  Table0163 = `RVL`   // Right Vastus Lateralis
+)
+
+// Description of the value, or empty if the value is not in table 0163.
+func (v Table0163) Description() string {
+	return tableDescription(`0163`, string(v))
+}
+
+// Valid reports if the value is in table 0163.
+func (v Table0163) Valid() bool {
+	return TableValueLookup[`0163`][string(v)]
+}
+
+// Table 0163.
+func (v Table0163) Table() Table {
+	return TableLookup[`0163`]
+}
+
+// Table0164 is a value of table 0164, Administration device.
+// Fields in this table are strings; convert a field, such as Table0164(v), to use the helpers.
+type Table0164 string
+
+const (
+	Table0164Applicator     Table0164 = `AP`   // Applicator
+	Table0164Buretrol       Table0164 = `BT`   // Buretrol
+	Table0164HeparinLock    Table0164 = `HL`   // Heparin Lock
+	Table0164IPPB           Table0164 = `IPPB` // IPPB
+	Table0164IVPump         Table0164 = `IVP`  // IV Pump
+	Table0164IVSoluset      Table0164 = `IVS`  // IV Soluset
+	Table0164MeteredInhaler Table0164 = `MI`   // Metered Inhaler
+	Table0164Nebulizer      Table0164 = `NEB`  // Nebulizer
+	Table0164PCAPump        Table0164 = `PCA`  // PCA Pump
+)
+
+// Description of the value, or empty if the value is not in table 0164.
+func (v Table0164) Description() string {
+	return tableDescription(`0164`, string(v))
+}
+
+// Valid reports if the value is in table 0164.
+func (v Table0164) Valid() bool {
+	return TableValueLookup[`0164`][string(v)]
+}
+
+// Table 0164.
+func (v Table0164) Table() Table {
+	return TableLookup[`0164`]
+}
+
+// Table0165 is a value of table 0165, Administration method.
+// Fields in this table are strings; convert a field, such as Table0165(v), to use the helpers.
+type Table0165 string
+
+const (
+	Table0165Chew        Table0165 = `CH`   // Chew
+	Table0165Dissolve    Table0165 = `DI`   // Dissolve
+	Table0165Dust        Table0165 = `DU`   // Dust
+	Table0165Infiltrate  Table0165 = `IF`   // Infiltrate
+	Table0165Irrigate    Table0165 = `IR`   // Irrigate
+	Table0165Insert      Table0165 = `IS`   // Insert
+	Table0165IVPush      Table0165 = `IVP`  // IV Push
+	Table0165IVPiggyback Table0165 = `IVPB` // IV Piggyback
+	Table0165Nebulized   Table0165 = `NB`   // Nebulized
+	Table0165Perfuse     Table0165 = `PF`   // Perfuse
+	Table0165Pain        Table0165 = `PT`   // Pain
+	Table0165Shampoo     Table0165 = `SH`   // Shampoo
+	Table0165Soak        Table0165 = `SO`   // Soak
+	Table0165Wash        Table0165 = `WA`   // Wash
+	Table0165Wipe        Table0165 = `WI`   // Wipe
+)
+
+// Description of the value, or empty if the value is not in table 0165.
+func (v Table0165) Description() string {
+	return tableDescription(`0165`, string(v))
+}
+
+// Valid reports if the value is in table 0165.
+func (v Table0165) Valid() bool {
+	return TableValueLookup[`0165`][string(v)]
+}
+
+// Table 0165.
+func (v Table0165) Table() Table {
+	return TableLookup[`0165`]
+}
+
+// Table0166 is a value of table 0166, RX component type.
+// Fields in this table are strings; convert a field, such as Table0166(v), to use the helpers.
+type Table0166 string
+
+const (
+	Table0166Additive Table0166 = `A` // Additive
+	Table0166Base     Table0166 = `B` // Base
+)
+
+// Description of the value, or empty if the value is not in table 0166.
+func (v Table0166) Description() string {
+	return tableDescription(`0166`, string(v))
+}
+
+// Valid reports if the value is in table 0166.
+func (v Table0166) Valid() bool {
+	return TableValueLookup[`0166`][string(v)]
+}
+
+// Table 0166.
+func (v Table0166) Table() Table {
+	return TableLookup[`0166`]
+}
+
+// Table0167 is a value of table 0167, Substitution status.
+// Fields in this table are strings; convert a field, such as Table0167(v), to use the helpers.
+type Table0167 string
+
+const (
+	Table0167NoProductSelectionIndicated                           Table0167 = `0` // No product selection indicated
+	Table0167SubstitutionNotAllowedByPrescriber                    Table0167 = `1` // Substitution not allowed by prescriber
+	Table0167SubstitutionAllowedPatientRequestedProductDispensed   Table0167 = `2` // Substitution allowed - patient requested product dispensed
+	Table0167SubstitutionAllowedPharmacistSelectedProductDispensed Table0167 = `3` // Substitution allowed - pharmacist selected product dispensed
+	Table0167SubstitutionAllowedGenericDrugNotIn                   Table0167 = `4` // Substitution allowed - generic drug not in stock
+	Table0167SubstitutionAllowedBrandDrugDispensedAs               Table0167 = `5` // Substitution allowed - brand drug dispensed as a generic
+	Table0167SubstitutionNotAllowedBrandDrugMandated               Table0167 = `7` // Substitution not allowed - brand drug mandated by law
+	Table0167SubstitutionAllowedGenericDrugNotAvailable            Table0167 = `8` // Substitution allowed - generic drug not available in marketplace
+	Table0167AGenericSubstitutionWasDispensed                      Table0167 = `G` // A generic substitution was dispensed.
+	Table0167NoSubstituteWasDispensedThisIs                        Table0167 = `N` // No substitute was dispensed.  This is equivalent to the default (null) value.
+	Table0167ATherapeuticSubstitutionWasDispensed                  Table0167 = `T` // A therapeutic substitution was dispensed.
+)
+
+// Description of the value, or empty if the value is not in table 0167.
+func (v Table0167) Description() string {
+	return tableDescription(`0167`, string(v))
+}
+
+// Valid reports if the value is in table 0167.
+func (v Table0167) Valid() bool {
+	return TableValueLookup[`0167`][string(v)]
+}
+
+// Table 0167.
+func (v Table0167) Table() Table {
+	return TableLookup[`0167`]
+}
+
+// Table0168 is a value of table 0168, Processing priority.
+// Fields in this table are strings; convert a field, such as Table0168(v), to use the helpers.
+type Table0168 string
+
+const (
+	Table0168AsSoonAsPossibleAPriority         Table0168 = `A` // As soon as possible (a priority lower than stat)
+	Table0168DoAtBedsideOrPortableMay          Table0168 = `B` // Do at bedside or portable (may be used with other codes)
+	Table0168MeasureContinuouslyEGArterialLine Table0168 = `C` // Measure continuously (e.g., arterial line blood pressure)
+	Table0168PreoperativeToBeDonePriorTo       Table0168 = `P` // Preoperative (to be done prior to surgery)
+	Table0168Routine                           Table0168 = `R` // Routine
+	Table0168StatDoImmediately                 Table0168 = `S` // Stat (do immediately)
+	Table0168TimingCriticalDoAsNearAs          Table0168 = `T` // Timing critical (do as near as possible to requested time)
+)
+
+// Description of the value, or empty if the value is not in table 0168.
+func (v Table0168) Description() string {
+	return tableDescription(`0168`, string(v))
+}
+
+// Valid reports if the value is in table 0168.
+func (v Table0168) Valid() bool {
+	return TableValueLookup[`0168`][string(v)]
+}
+
+// Table 0168.
+func (v Table0168) Table() Table {
+	return TableLookup[`0168`]
+}
+
+// Table0169 is a value of table 0169, Reporting priority.
+// Fields in this table are strings; convert a field, such as Table0169(v), to use the helpers.
+type Table0169 string
+
+const (
+	Table0169CallBackResults Table0169 = `C` // Call back results
+	Table0169RushReporting   Table0169 = `R` // Rush reporting
+)
+
+// Description of the value, or empty if the value is not in table 0169.
+func (v Table0169) Description() string {
+	return tableDescription(`0169`, string(v))
+}
+
+// Valid reports if the value is in table 0169.
+func (v Table0169) Valid() bool {
+	return TableValueLookup[`0169`][string(v)]
+}
+
+// Table 0169.
+func (v Table0169) Table() Table {
+	return TableLookup[`0169`]
+}
+
+// Table0170 is a value of table 0170, Derived specimen.
+// Fields in this table are strings; convert a field, such as Table0170(v), to use the helpers.
+type Table0170 string
+
+const (
+	Table0170ChildObservation  Table0170 = `C` // Child Observation
+	Table0170NotApplicable     Table0170 = `N` // Not Applicable
+	Table0170ParentObservation Table0170 = `P` // Parent Observation
+)
+
+// Description of the value, or empty if the value is not in table 0170.
+func (v Table0170) Description() string {
+	return tableDescription(`0170`, string(v))
+}
+
+// Valid reports if the value is in table 0170.
+func (v Table0170) Valid() bool {
+	return TableValueLookup[`0170`][string(v)]
+}
+
+// Table 0170.
+func (v Table0170) Table() Table {
+	return TableLookup[`0170`]
+}
+
+// Table0173 is a value of table 0173, Coordination of benefits.
+// Fields in this table are strings; convert a field, such as Table0173(v), to use the helpers.
+type Table0173 string
+
+const (
+	Table0173Coordination Table0173 = `CO` // Coordination
+	Table0173Independent  Table0173 = `IN` // Independent
+)
+
+// Description of the value, or empty if the value is not in table 0173.
+func (v Table0173) Description() string {
+	return tableDescription(`0173`, string(v))
+}
+
+// Valid reports if the value is in table 0173.
+func (v Table0173) Valid() bool {
+	return TableValueLookup[`0173`][string(v)]
+}
+
+// Table 0173.
+func (v Table0173) Table() Table {
+	return TableLookup[`0173`]
+}
+
+// Table0174 is a value of table 0174, Nature of test/observation.
+// Fields in this table are strings; convert a field, such as Table0174(v), to use the helpers.
+type Table0174 string
+
+const (
+	Table0174AtomicTestObservationTestCodeOr     Table0174 = `A` // Atomic test/observation (test code or treatment code)
+	Table0174SingleObservationCalculatedViaARule Table0174 = `C` // Single observation calculated via a rule or formula from other independent observations (e.g., Alveolar--arterial ratio, cardiac output)
+	Table0174FunctionalProcedureThatMayConsistOf Table0174 = `F` // Functional procedure that may consist of one or more interrelated measures (e.g., glucose tolerance test, creatine clearance), usually done at different times and/or on different specimens
+	Table0174ProfileOrBatteryConsistingOfMany    Table0174 = `P` // Profile or battery consisting of many independent atomic observations (e.g., SMA12, electrolytes), usually done at one instrument on one specimen
+	Table0174SupersetASetOfBatteriesOr           Table0174 = `S` // Superset--a set of batteries or procedures ordered under a single code unit but processed as separate batteries (e.g., routines = CBC, UA, electrolytes) This set indicates that the code being described is used to order multiple test/observation batteries. For example, a client who routinely orders a CBC, a differential, and a thyroxine as an outpatient profile might use a single, special code to order all three test batteries, instead of having to submit three separate order codes.
+)
+
+// Description of the value, or empty if the value is not in table 0174.
+func (v Table0174) Description() string {
+	return tableDescription(`0174`, string(v))
+}
+
+// Valid reports if the value is in table 0174.
+func (v Table0174) Valid() bool {
+	return TableValueLookup[`0174`][string(v)]
+}
+
+// Table 0174.
+func (v Table0174) Table() Table {
+	return TableLookup[`0174`]
+}
+
+// Table0175 is a value of table 0175, Master file identifier code.
+// Fields in this table are strings; convert a field, such as Table0175(v), to use the helpers.
+type Table0175 string
+
+const (
+	Table0175ChargeDescriptionMasterFile           Table0175 = `CDM` // Charge description master file
+	Table0175ClinicalStudyMaster                   Table0175 = `CM0` // Clinical study master
+	Table0175ClinicalStudyPhaseMaster              Table0175 = `CM1` // Clinical study phase master
+	Table0175ClinicalStudyDataScheduleMaster       Table0175 = `CM2` // Clinical study Data Schedule Master
+	Table0175LocationMasterFile                    Table0175 = `LOC` // Location master file
+	Table0175ObservationTestMasterFileSegments     Table0175 = `OM1` // Observation test master file segments
+	Table0175ObservationTestMasterFileSegments_OM2 Table0175 = `OM2` // Observation test master file segments
+	Table0175ObservationTestMasterFileSegments_OM3 Table0175 = `OM3` // Observation test master file segments
+	Table0175ObservationTestMasterFileSegments_OM4 Table0175 = `OM4` // Observation test master file segments
+	Table0175ObservationTestMasterFileSegments_OM5 Table0175 = `OM5` // Observation test master file segments
+	Table0175ObservationTestMasterFileSegments_OM6 Table0175 = `OM6` // Observation test master file segments
+	Table0175PractitionerMasterFile                Table0175 = `PRA` // Practitioner master file
+	Table0175StaffMasterFile                       Table0175 = `STF` // Staff master file
+)
+
+// Description of the value, or empty if the value is not in table 0175.
+func (v Table0175) Description() string {
+	return tableDescription(`0175`, string(v))
+}
+
+// Valid reports if the value is in table 0175.
+func (v Table0175) Valid() bool {
+	return TableValueLookup[`0175`][string(v)]
+}
+
+// Table 0175.
+func (v Table0175) Table() Table {
+	return TableLookup[`0175`]
+}
+
+// Table0177 is a value of table 0177, Confidentiality code.
+// Fields in this table are strings; convert a field, such as Table0177(v), to use the helpers.
+type Table0177 string
+
+const (
+	Table0177AIDSPatient                    Table0177 = `AID` // AIDS patient
+	Table0177Employee                       Table0177 = `EMP` // Employee
+	Table0177AlcoholDrugTreatmentPatient    Table0177 = `ETH` // Alcohol/drug treatment patient
+	Table0177HIVPatient                     Table0177 = `HIV` // HIV(+) patient
+	Table0177PsychiatricPatient             Table0177 = `PSY` // Psychiatric patient
+	Table0177Restricted                     Table0177 = `R`   // Restricted
+	Table0177UsualControl                   Table0177 = `U`   // Usual control
+	Table0177UnwedMother                    Table0177 = `UWM` // Unwed mother
+	Table0177VeryRestricted                 Table0177 = `V`   // Very restricted
+	Table0177VeryImportantPersonOrCelebrity Table0177 = `VIP` // Very important person or celebrity
+)
+
+// Description of the value, or empty if the value is not in table 0177.
+func (v Table0177) Description() string {
+	return tableDescription(`0177`, string(v))
+}
+
+// Valid reports if the value is in table 0177.
+func (v Table0177) Valid() bool {
+	return TableValueLookup[`0177`][string(v)]
+}
+
+// Table 0177.
+func (v Table0177) Table() Table {
+	return TableLookup[`0177`]
+}
+
+// Table0178 is a value of table 0178, File level event code.
+// Fields in this table are strings; convert a field, such as Table0178(v), to use the helpers.
+type Table0178 string
+
+const (
+	Table0178ReplaceCurrentVersionOfThisMaster Table0178 = `REP` // Replace current version of this master file with the version contained in this message
+	Table0178ChangeFileRecordsAsDefinedIn      Table0178 = `UPD` // Change file records as defined in the record-level event codes for each record that follows
+)
+
+// Description of the value, or empty if the value is not in table 0178.
+func (v Table0178) Description() string {
+	return tableDescription(`0178`, string(v))
+}
+
+// Valid reports if the value is in table 0178.
+func (v Table0178) Valid() bool {
+	return TableValueLookup[`0178`][string(v)]
+}
+
+// Table 0178.
+func (v Table0178) Table() Table {
+	return TableLookup[`0178`]
+}
+
+// Table0179 is a value of table 0179, Response level.
+// Fields in this table are strings; convert a field, such as Table0179(v), to use the helpers.
+type Table0179 string
+
+const (
+	Table0179AlwaysAllMFASegmentsWhetherDenoting   Table0179 = `AL` // Always. All MFA segments (whether denoting errors or not) must be returned via the application-level acknowledgment message
+	Table0179ErrorRejectConditionsOnlyOnlyMFA      Table0179 = `ER` // Error/Reject conditions only. Only MFA segments denoting errors must be returned via the application-level acknowledgment for this message
+	Table0179NeverNoApplicationLevelResponseNeeded Table0179 = `NE` // Never. No application-level response needed
+	Table0179SuccessOnlyMFASegmentsDenotingSuccess Table0179 = `SU` // Success. Only MFA segments denoting success must be returned via the application-level acknowledgment for this message
+)
+
+// Description of the value, or empty if the value is not in table 0179.
+func (v Table0179) Description() string {
+	return tableDescription(`0179`, string(v))
+}
+
+// Valid reports if the value is in table 0179.
+func (v Table0179) Valid() bool {
+	return TableValueLookup[`0179`][string(v)]
+}
+
+// Table 0179.
+func (v Table0179) Table() Table {
+	return TableLookup[`0179`]
+}
+
+// Table0180 is a value of table 0180, Record Level Event Code .
+// Fields in this table are strings; convert a field, such as Table0180(v), to use the helpers.
+type Table0180 string
+
+const (
+	Table0180Reactivate Table0180 = `MAC` // Reactivate
+	Table0180Add        Table0180 = `MAD` // Add
+	Table0180Deactivate Table0180 = `MDC` // Deactivate
+	Table0180Delete     Table0180 = `MDL` // Delete
+	Table0180Update     Table0180 = `MUP` // Update
+)
+
+// Description of the value, or empty if the value is not in table 0180.
+func (v Table0180) Description() string {
+	return tableDescription(`0180`, string(v))
+}
+
+// Valid reports if the value is in table 0180.
+func (v Table0180) Valid() bool {
+	return TableValueLookup[`0180`][string(v)]
+}
+
+// Table 0180.
+func (v Table0180) Table() Table {
+	return TableLookup[`0180`]
+}
+
+// Table0181 is a value of table 0181, MFN record-level error return.
+// Fields in this table are strings; convert a field, such as Table0181(v), to use the helpers.
+type Table0181 string
+
+const (
+	Table0181SuccessfulPostingOfTheRecordDefined   Table0181 = `S` // Successful posting of the record defined by the MFE segment
+	Table0181UnsuccessfulPostingOfTheRecordDefined Table0181 = `U` // Unsuccessful posting of the record defined by the MFE segment
+)
+
+// Description of the value, or empty if the value is not in table 0181.
+func (v Table0181) Description() string {
+	return tableDescription(`0181`, string(v))
+}
+
+// Valid reports if the value is in table 0181.
+func (v Table0181) Valid() bool {
+	return TableValueLookup[`0181`][string(v)]
+}
+
+// Table 0181.
+func (v Table0181) Table() Table {
+	return TableLookup[`0181`]
+}
+
+// Table0183 is a value of table 0183, Active/Inactive.
+// Fields in this table are strings; convert a field, such as Table0183(v), to use the helpers.
+type Table0183 string
+
+const (
+	Table0183ActiveStaff   Table0183 = `A` // Active staff
+	Table0183InactiveStaff Table0183 = `I` // Inactive staff
+)
+
+// Description of the value, or empty if the value is not in table 0183.
+func (v Table0183) Description() string {
+	return tableDescription(`0183`, string(v))
+}
+
+// Valid reports if the value is in table 0183.
+func (v Table0183) Valid() bool {
+	return TableValueLookup[`0183`][string(v)]
+}
+
+// Table 0183.
+func (v Table0183) Table() Table {
+	return TableLookup[`0183`]
+}
+
+// Table0185 is a value of table 0185, Preferred method of contact.
+// Fields in this table are strings; convert a field, such as Table0185(v), to use the helpers.
+type Table0185 string
+
+const (
+	Table0185BeeperNumber        Table0185 = `B` // Beeper Number
+	Table0185CellularPhoneNumber Table0185 = `C` // Cellular Phone Number
+	Table0185EMailAddressNotInTN Table0185 = `E` // E-Mail Address (Not In TN Format)
+	Table0185FAXNumber           Table0185 = `F` // FAX Number
+	Table0185HomePhoneNumber     Table0185 = `H` // Home Phone Number
+	Table0185OfficePhoneNumber   Table0185 = `O` // Office Phone Number
+)
+
+// Description of the value, or empty if the value is not in table 0185.
+func (v Table0185) Description() string {
+	return tableDescription(`0185`, string(v))
+}
+
+// Valid reports if the value is in table 0185.
+func (v Table0185) Valid() bool {
+	return TableValueLookup[`0185`][string(v)]
+}
+
+// Table 0185.
+func (v Table0185) Table() Table {
+	return TableLookup[`0185`]
+}
+
+// Table0187 is a value of table 0187, Provider billing.
+// Fields in this table are strings; convert a field, such as Table0187(v), to use the helpers.
+type Table0187 string
+
+const (
+	Table0187InstitutionBillsForProvider Table0187 = `I` // Institution bills for provider
+	Table0187ProviderDoesOwnBilling      Table0187 = `P` // Provider does own billing
+)
+
+// Description of the value, or empty if the value is not in table 0187.
+func (v Table0187) Description() string {
+	return tableDescription(`0187`, string(v))
+}
+
+// Valid reports if the value is in table 0187.
+func (v Table0187) Valid() bool {
+	return TableValueLookup[`0187`][string(v)]
+}
+
+// Table 0187.
+func (v Table0187) Table() Table {
+	return TableLookup[`0187`]
+}
+
+// Table0190 is a value of table 0190, Address type.
+// Fields in this table are strings; convert a field, such as Table0190(v), to use the helpers.
+type Table0190 string
+
+const (
+	Table0190FirmBusiness                           Table0190 = `B`   // Firm/Business
+	Table0190BadAddress                             Table0190 = `BA`  // Bad address
+	Table0190BirthDeliveryLocationAddressWhereBirth Table0190 = `BDL` // Birth delivery location (address where birth occurred)
+	Table0190ResidenceAtBirthHomeAddressAt          Table0190 = `BR`  // Residence  at birth (home address at time of birth)
+	Table0190CurrentOrTemporary                     Table0190 = `C`   // Current Or Temporary
+	Table0190CountryOfOrigin                        Table0190 = `F`   // Country Of Origin
+	Table0190Home                                   Table0190 = `H`   // Home
+	Table0190LegalAddress                           Table0190 = `L`   // Legal Address
+	Table0190Mailing                                Table0190 = `M`   // Mailing
+	Table0190BirthNeeBirthAddressNotOtherwise       Table0190 = `N`   // Birth (nee) (birth address, not otherwise specified)
+	Table0190Office                                 Table0190 = `O`   // Office
+	Table0190Permanent                              Table0190 = `P`   // Permanent
+	Table0190RegistryHomeRefersToTheInformation     Table0190 = `RH`  // Registry home.  Refers to the information system, typically managed by a public health agency, that  stores patient information such as immunization histories or cancer data, regardless of where the patient obtains services.
+)
+
+// Description of the value, or empty if the value is not in table 0190.
+func (v Table0190) Description() string {
+	return tableDescription(`0190`, string(v))
+}
+
+// Valid reports if the value is in table 0190.
+func (v Table0190) Valid() bool {
+	return TableValueLookup[`0190`][string(v)]
+}
+
+// Table 0190.
+func (v Table0190) Table() Table {
+	return TableLookup[`0190`]
+}
+
+// Table0191 is a value of table 0191, Type of referenced data.
+// Fields in this table are strings; convert a field, such as Table0191(v), to use the helpers.
+type Table0191 string
+
+const (
+	Table0191OtherApplicationDataTypicallyUninterpretedBinary             Table0191 = `AP`          // Other application data, typically uninterpreted binary data
+	Table0191OtherApplicationDataTypicallyUninterpretedBinary_Application Table0191 = `Application` // Other application data, typically uninterpreted binary data
+	Table0191AudioData                                                    Table0191 = `AU`          // Audio data
+	Table0191AudioData_Audio                                              Table0191 = `Audio`       // Audio data
+	Table0191FormattedText                                                Table0191 = `FT`          // Formatted text
+	Table0191ImageData                                                    Table0191 = `IM`          // Image data
+	Table0191ImageData_Image                                              Table0191 = `Image`       // Image data
+	Table0191NonScannedImage                                              Table0191 = `NS`          // Non-scanned image
+	Table0191ScannedDocument                                              Table0191 = `SD`          // Scanned document
+	Table0191ScannedImage                                                 Table0191 = `SI`          // Scanned image
+	Table0191MachineReadableTextDocument                                  Table0191 = `TEXT`        // Machine readable text document
+	Table0191MachineReadableTextDocument_TX                               Table0191 = `TX`          // Machine readable text document
+)
+
+// Description of the value, or empty if the value is not in table 0191.
+func (v Table0191) Description() string {
+	return tableDescription(`0191`, string(v))
+}
+
+// Valid reports if the value is in table 0191.
+func (v Table0191) Valid() bool {
+	return TableValueLookup[`0191`][string(v)]
+}
+
+// Table 0191.
+func (v Table0191) Table() Table {
+	return TableLookup[`0191`]
+}
+
+// Table0193 is a value of table 0193, Amount class.
+// Fields in this table are strings; convert a field, such as Table0193(v), to use the helpers.
+type Table0193 string
+
+const (
+	Table0193Amount     Table0193 = `AT` // Amount
+	Table0193Limit      Table0193 = `LM` // Limit
+	Table0193Percentage Table0193 = `PC` // Percentage
+	Table0193Unlimited  Table0193 = `UL` // Unlimited
+)
+
+// Description of the value, or empty if the value is not in table 0193.
+func (v Table0193) Description() string {
+	return tableDescription(`0193`, string(v))
+}
+
+// Valid reports if the value is in table 0193.
+func (v Table0193) Valid() bool {
+	return TableValueLookup[`0193`][string(v)]
+}
+
+// Table 0193.
+func (v Table0193) Table() Table {
+	return TableLookup[`0193`]
+}
+
+// Table0200 is a value of table 0200, Name type.
+// Fields in this table are strings; convert a field, such as Table0200(v), to use the helpers.
+type Table0200 string
+
+const (
+	Table0200AliasName                        Table0200 = `A` // Alias Name
+	Table0200NameAtBirth                      Table0200 = `B` // Name at Birth
+	Table0200AdoptedName                      Table0200 = `C` // Adopted Name
+	Table0200DisplayName                      Table0200 = `D` // Display Name
+	Table0200LegalName                        Table0200 = `L` // Legal Name
+	Table0200MaidenName                       Table0200 = `M` // Maiden Name
+	Table0200NameOfPartnerSpouse              Table0200 = `P` // Name of Partner/Spouse
+	Table0200CodedPseudoNameToEnsureAnonymity Table0200 = `S` // Coded Pseudo-Name to ensure anonymity
+	Table0200TribalCommunityName              Table0200 = `T` // Tribal/Community Name
+	Table0200Unspecified                      Table0200 = `U` // Unspecified
+)
+
+// Description of the value, or empty if the value is not in table 0200.
+func (v Table0200) Description() string {
+	return tableDescription(`0200`, string(v))
+}
+
+// Valid reports if the value is in table 0200.
+func (v Table0200) Valid() bool {
+	return TableValueLookup[`0200`][string(v)]
+}
+
+// Table 0200.
+func (v Table0200) Table() Table {
+	return TableLookup[`0200`]
+}
+
+// Table0201 is a value of table 0201, Telecommunication use code.
+// Fields in this table are strings; convert a field, such as Table0201(v), to use the helpers.
+type Table0201 string
+
+const (
+	Table0201AnsweringServiceNumber Table0201 = `ASN` // Answering Service Number
+	Table0201BeeperNumber           Table0201 = `BPN` // Beeper Number
+	Table0201EmergencyNumber        Table0201 = `EMR` // Emergency Number
+	Table0201NetworkEmailAddress    Table0201 = `NET` // Network (email) Address
+	Table0201OtherResidenceNumber   Table0201 = `ORN` // Other Residence Number
+	Table0201PrimaryResidenceNumber Table0201 = `PRN` // Primary Residence Number
+	Table0201VacationHomeNumber     Table0201 = `VHN` // Vacation Home Number
+	Table0201WorkNumber             Table0201 = `WPN` // Work Number
+)
+
+// Description of the value, or empty if the value is not in table 0201.
+func (v Table0201) Description() string {
+	return tableDescription(`0201`, string(v))
+}
+
+// Valid reports if the value is in table 0201.
+func (v Table0201) Valid() bool {
+	return TableValueLookup[`0201`][string(v)]
+}
+
+// Table 0201.
+func (v Table0201) Table() Table {
+	return TableLookup[`0201`]
+}
+
+// Table0202 is a value of table 0202, Telecommunication equipment type.
+// Fields in this table are strings; convert a field, such as Table0202(v), to use the helpers.
+type Table0202 string
+
+const (
+	Table0202Beeper                                       Table0202 = `BP`       // Beeper
+	Table0202CellularPhone                                Table0202 = `CP`       // Cellular Phone
+	Table0202Fax                                          Table0202 = `FX`       // Fax
+	Table0202InternetAddressUseOnlyIfTelecommunicationUse Table0202 = `Internet` // Internet Address: Use Only If TelecommunicationUse Code Is NET
+	Table0202Modem                                        Table0202 = `MD`       // Modem
+	Table0202Telephone                                    Table0202 = `PH`       // Telephone
+	Table0202X400EmailAddressUseOnly                      Table0202 = `X.400`    // X.400 email address: Use Only If TelecommunicationUse Code Is NET
+)
+
+// Description of the value, or empty if the value is not in table 0202.
+func (v Table0202) Description() string {
+	return tableDescription(`0202`, string(v))
+}
+
+// Valid reports if the value is in table 0202.
+func (v Table0202) Valid() bool {
+	return TableValueLookup[`0202`][string(v)]
+}
+
+// Table 0202.
+func (v Table0202) Table() Table {
+	return TableLookup[`0202`]
+}
+
+// Table0203 is a value of table 0203, Identifier type.
+// Fields in this table are strings; convert a field, such as Table0203(v), to use the helpers.
+type Table0203 string
+
+const (
+	Table0203AmericanExpress                                      Table0203 = `AM`    // American Express
+	Table0203AccountNumber                                        Table0203 = `AN`    // Account number
+	Table0203BirthRegistryNumber                                  Table0203 = `BR`    // Birth registry number
+	Table0203DinersClubCard                                       Table0203 = `DI`    // Diners Club card
+	Table0203DriversLicenseNumber                                 Table0203 = `DL`    // Drivers license number
+	Table0203DoctorNumber                                         Table0203 = `DN`    // Doctor number
+	Table0203DiscoverCard                                         Table0203 = `DS`    // Discover Card
+	Table0203EmployeeNumber                                       Table0203 = `EI`    // Employee number
+	Table0203EmployerNumber                                       Table0203 = `EN`    // Employer number
+	Table0203FacilityID                                           Table0203 = `FI`    // Facility ID
+	Table0203GuarantorInternalIdentifier                          Table0203 = `GI`    // Guarantor internal identifier
+	Table0203GuarantorExternalIdentifier                          Table0203 = `GN`    // Guarantor external identifier
+	Table0203LicenseNumber                                        Table0203 = `LN`    // License number
+	Table0203LocalRegistryID                                      Table0203 = `LR`    // Local Registry ID
+	Table0203MedicaidNumber                                       Table0203 = `MA`    // Medicaid number
+	Table0203MedicareNumber                                       Table0203 = `MC`    // Medicare number
+	Table0203MedicalRecordNumber                                  Table0203 = `MR`    // Medical record number
+	Table0203MasterCard                                           Table0203 = `MS`    // MasterCard
+	Table0203NationalEmployerIdentifier                           Table0203 = `NE`    // National employer identifier
+	Table0203NationalHealthPlanIdentifier                         Table0203 = `NH`    // National Health Plan Identifier
+	Table0203NationalUniqueIndividualIdentifier                   Table0203 = `NI`    // National unique individual identifier
+	Table0203NationalPersonIdentifierWhereTheXxx                  Table0203 = `NNxxx` // National Person Identifier where the xxx is the ISO table 3166 3-character (alphabetic) country code
+	Table0203NationalProviderIdentifier                           Table0203 = `NPI`   // National provider identifier
+	Table0203PatientInternalIdentifier                            Table0203 = `PI`    // Patient internal identifier
+	Table0203PersonNumber                                         Table0203 = `PN`    // Person number
+	Table0203ProviderNumber                                       Table0203 = `PRN`   // Provider number
+	Table0203PatientExternalIdentifier                            Table0203 = `PT`    // Patient external identifier
+	Table0203RailroadRetirementNumber                             Table0203 = `RR`    // Railroad Retirement number
+	Table0203RegionalRegistryID                                   Table0203 = `RRI`   // Regional registry ID
+	Table0203StateLicense                                         Table0203 = `SL`    // State license
+	Table0203StateRegistryID                                      Table0203 = `SR`    // State registry ID
+	Table0203SocialSecurityNumber                                 Table0203 = `SS`    // Social Security number
+	Table0203Unspecified                                          Table0203 = `U`     // Unspecified
+	Table0203MedicareHCFAsUniversalPhysicianIdentificationNumbers Table0203 = `UPIN`  // Medicare/HCFAs Universal Physician Identification numbers
+	Table0203VisitNumber                                          Table0203 = `VN`    // Visit number
+	Table0203VISA                                                 Table0203 = `VS`    // VISA
+	Table0203WICIdentifier                                        Table0203 = `WC`    // WIC identifier
+	Table0203OrganizationIdentifier                               Table0203 = `XX`    // Organization identifier
+)
+
+// Description of the value, or empty if the value is not in table 0203.
+func (v Table0203) Description() string {
+	return tableDescription(`0203`, string(v))
+}
+
+// Valid reports if the value is in table 0203.
+func (v Table0203) Valid() bool {
+	return TableValueLookup[`0203`][string(v)]
+}
+
+// Table 0203.
+func (v Table0203) Table() Table {
+	return TableLookup[`0203`]
+}
+
+// Table0204 is a value of table 0204, Organizational name type.
+// Fields in this table are strings; convert a field, such as Table0204(v), to use the helpers.
+type Table0204 string
+
+const (
+	Table0204AliasName                Table0204 = `A`  // Alias name
+	Table0204DisplayName              Table0204 = `D`  // Display name
+	Table0204LegalName                Table0204 = `L`  // Legal name
+	Table0204StockExchangeListingName Table0204 = `SL` // Stock exchange listing name
+)
+
+// Description of the value, or empty if the value is not in table 0204.
+func (v Table0204) Description() string {
+	return tableDescription(`0204`, string(v))
+}
+
+// Valid reports if the value is in table 0204.
+func (v Table0204) Valid() bool {
+	return TableValueLookup[`0204`][string(v)]
+}
+
+// Table 0204.
+func (v Table0204) Table() Table {
+	return TableLookup[`0204`]
+}
+
+// Table0205 is a value of table 0205, Price type.
+// Fields in this table are strings; convert a field, such as Table0205(v), to use the helpers.
+type Table0205 string
+
+const (
+	Table0205AdministrativePriceOrHandlingFee     Table0205 = `AP` // administrative price or handling fee
+	Table0205DirectUnitCost                       Table0205 = `DC` // direct unit cost
+	Table0205IndirectUnitCost                     Table0205 = `IC` // indirect unit cost
+	Table0205ProfessionalFeeForPerformingProvider Table0205 = `PF` // professional fee for performing provider
+	Table0205TechnologyFeeForUseOfEquipment       Table0205 = `TF` // technology fee for use of equipment
+	Table0205TotalPrice                           Table0205 = `TP` // total price
+	Table0205UnitPriceMayBeBasedOn                Table0205 = `UP` // unit price, may be based on length of procedure or service
+)
+
+// Description of the value, or empty if the value is not in table 0205.
+func (v Table0205) Description() string {
+	return tableDescription(`0205`, string(v))
+}
+
+// Valid reports if the value is in table 0205.
+func (v Table0205) Valid() bool {
+	return TableValueLookup[`0205`][string(v)]
+}
+
+// Table 0205.
+func (v Table0205) Table() Table {
+	return TableLookup[`0205`]
+}
+
+// Table0206 is a value of table 0206, Segment action code.
+// Fields in this table are strings; convert a field, such as Table0206(v), to use the helpers.
+type Table0206 string
+
+const (
+	Table0206AddInsert Table0206 = `A` // Add/Insert
+	Table0206Delete    Table0206 = `D` // Delete
+	Table0206Update    Table0206 = `U` // Update
+)
+
+// Description of the value, or empty if the value is not in table 0206.
+func (v Table0206) Description() string {
+	return tableDescription(`0206`, string(v))
+}
+
+// Valid reports if the value is in table 0206.
+func (v Table0206) Valid() bool {
+	return TableValueLookup[`0206`][string(v)]
+}
+
+// Table 0206.
+func (v Table0206) Table() Table {
+	return TableLookup[`0206`]
+}
+
+// Table0207 is a value of table 0207, Processing mode.
+// Fields in this table are strings; convert a field, such as Table0207(v), to use the helpers.
+type Table0207 string
+
+const (
+	Table0207NotPresentTheDefaultMeaningCurrent Table0207 = ``  // Not present (the default, meaning current processing)
+	Table0207Archive                            Table0207 = `a` // Archive
+	Table0207InitialLoad                        Table0207 = `i` // Initial load
+	Table0207RestoreFromArchive                 Table0207 = `r` // Restore from archive
+)
+
+// Description of the value, or empty if the value is not in table 0207.
+func (v Table0207) Description() string {
+	return tableDescription(`0207`, string(v))
+}
+
+// Valid reports if the value is in table 0207.
+func (v Table0207) Valid() bool {
+	return TableValueLookup[`0207`][string(v)]
+}
+
+// Table 0207.
+func (v Table0207) Table() Table {
+	return TableLookup[`0207`]
+}
+
+// Table0208 is a value of table 0208, Query response status.
+// Fields in this table are strings; convert a field, such as Table0208(v), to use the helpers.
+type Table0208 string
+
+const (
+	Table0208ApplicationError        Table0208 = `AE` // Application error
+	Table0208ApplicationReject       Table0208 = `AR` // Application reject
+	Table0208NoDataFoundNoErrors     Table0208 = `NF` // No data found, no errors
+	Table0208DataFoundNoErrorsThisIs Table0208 = `OK` // Data found, no errors (this is the default)
+)
+
+// Description of the value, or empty if the value is not in table 0208.
+func (v Table0208) Description() string {
+	return tableDescription(`0208`, string(v))
+}
+
+// Valid reports if the value is in table 0208.
+func (v Table0208) Valid() bool {
+	return TableValueLookup[`0208`][string(v)]
+}
+
+// Table 0208.
+func (v Table0208) Table() Table {
+	return TableLookup[`0208`]
+}
+
+// Table0209 is a value of table 0209, Relational operator.
+// Fields in this table are strings; convert a field, such as Table0209(v), to use the helpers.
+type Table0209 string
+
+const (
+	Table0209Contains           Table0209 = `CT` // Contains
+	Table0209Equal              Table0209 = `EQ` // Equal
+	Table0209GreaterThanOrEqual Table0209 = `GE` // Greater than or equal
+	Table0209Generic            Table0209 = `GN` // Generic
+	Table0209GreaterThan        Table0209 = `GT` // Greater than
+	Table0209LessThanOrEqual    Table0209 = `LE` // Less than or equal
+	Table0209LessThan           Table0209 = `LT` // Less than
+	Table0209NotEqual           Table0209 = `NE` // Not Equal
+)
+
+// Description of the value, or empty if the value is not in table 0209.
+func (v Table0209) Description() string {
+	return tableDescription(`0209`, string(v))
+}
+
+// Valid reports if the value is in table 0209.
+func (v Table0209) Valid() bool {
+	return TableValueLookup[`0209`][string(v)]
+}
+
+// Table 0209.
+func (v Table0209) Table() Table {
+	return TableLookup[`0209`]
+}
+
+// Table0210 is a value of table 0210, Relational conjunction.
+// Fields in this table are strings; convert a field, such as Table0210(v), to use the helpers.
+type Table0210 string
+
+const (
+	Table0210Default Table0210 = `AND` // Default
+	Table0210OR      Table0210 = `OR`
+)
+
+// Description of the value, or empty if the value is not in table 0210.
+func (v Table0210) Description() string {
+	return tableDescription(`0210`, string(v))
+}
+
+// Valid reports if the value is in table 0210.
+func (v Table0210) Valid() bool {
+	return TableValueLookup[`0210`][string(v)]
+}
+
+// Table 0210.
+func (v Table0210) Table() Table {
+	return TableLookup[`0210`]
+}
+
+// Table0211 is a value of table 0211, Alternate character sets.
+// Fields in this table are strings; convert a field, such as Table0211(v), to use the helpers.
+type Table0211 string
+
+const (
+	Table0211ThePrintableCharactersFromTheISO       Table0211 = `8859/1`    // The printable characters from the ISO 8859/1 Character set
+	Table0211ThePrintableCharactersFromTheISO_88592 Table0211 = `8859/2`    // The printable characters from the ISO 8859/2 Character set
+	Table0211ThePrintableCharactersFromTheISO_88593 Table0211 = `8859/3`    // The printable characters from the ISO 8859/3 Character set
+	Table0211ThePrintableCharactersFromTheISO_88594 Table0211 = `8859/4`    // The printable characters from the ISO 8859/4 Character set
+	Table0211ThePrintableCharactersFromTheISO_88595 Table0211 = `8859/5`    // The printable characters from the ISO 8859/5 Character set
+	Table0211ThePrintableCharactersFromTheISO_88596 Table0211 = `8859/6`    // The printable characters from the ISO 8859/6 Character set
+	Table0211ThePrintableCharactersFromTheISO_88597 Table0211 = `8859/7`    // The printable characters from the ISO 8859/7 Character set
+	Table0211ThePrintableCharactersFromTheISO_88598 Table0211 = `8859/8`    // The printable characters from the ISO 8859/8 Character set
+	Table0211ThePrintableCharactersFromTheISO_88599 Table0211 = `8859/9`    // The printable characters from the ISO 8859/9 Character set
+	Table0211ThePrintable7BitASCIICharacter         Table0211 = `ASCII`     // The printable 7-bit ASCII character set. (This is the default if this field is omitted)
+	Table0211CodeForInformationExchangeOneByte      Table0211 = `ISO IR14`  // Code for Information Exchange (one byte)(JIS X 02011976).  Note that the code contains a  space, i.e. "ISO IR14".
+	Table0211CodeOfTheSupplementaryJapaneseGraphic  Table0211 = `ISO IR159` // Code of the supplementary Japanese Graphic Character set for information interchange (JIS X 0212-1990), Note that the code contains a space, i.e. "ISO IR159".
+	Table0211CodeForTheJapaneseGraphicCharacter     Table0211 = `ISO IR87`  // Code for the Japanese Graphic Character set for information interchange (JIS X 0208-1990), Note that the code contains a space, i.e. "ISO IR87".
+	Table0211TheWorldWideCharacterStandardFrom      Table0211 = `UNICODE`   // The world wide character standard from ISO/IEC 10646-1-1993[3]
+)
+
+// Description of the value, or empty if the value is not in table 0211.
+func (v Table0211) Description() string {
+	return tableDescription(`0211`, string(v))
+}
+
+// Valid reports if the value is in table 0211.
+func (v Table0211) Valid() bool {
+	return TableValueLookup[`0211`][string(v)]
+}
+
+// Table 0211.
+func (v Table0211) Table() Table {
+	return TableLookup[`0211`]
+}
+
+// Table0213 is a value of table 0213, Purge status.
+// Fields in this table are strings; convert a field, such as Table0213(v), to use the helpers.
+type Table0213 string
+
+const (
+	Table0213TheVisitIsMarkedForDeletion Table0213 = `D` // The visit is marked for deletion and the user cannot enter new data against it.
+	Table0213TheVisitIsMarkedInactiveAnd Table0213 = `I` // The visit is marked inactive and the user cannot enter new data against it.
+	Table0213MarkedForPurgeUserIsNo      Table0213 = `P` // Marked for purge. User is no longer able to update the visit.
+)
+
+// Description of the value, or empty if the value is not in table 0213.
+func (v Table0213) Description() string {
+	return tableDescription(`0213`, string(v))
+}
+
+// Valid reports if the value is in table 0213.
+func (v Table0213) Valid() bool {
+	return TableValueLookup[`0213`][string(v)]
+}
+
+// Table 0213.
+func (v Table0213) Table() Table {
+	return TableLookup[`0213`]
+}
+
+// Table0220 is a value of table 0220, Living arrangement.
+// Fields in this table are strings; convert a field, such as Table0220(v), to use the helpers.
+type Table0220 string
+
+const (
+	Table0220Alone       Table0220 = `A` // Alone
+	Table0220Family      Table0220 = `F` // Family
+	Table0220Institution Table0220 = `I` // Institution
+	Table0220Relative    Table0220 = `R` // Relative
+	Table0220SpouseOnly  Table0220 = `S` // Spouse Only
+	Table0220Unknown     Table0220 = `U` // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0220.
+func (v Table0220) Description() string {
+	return tableDescription(`0220`, string(v))
+}
+
+// Valid reports if the value is in table 0220.
+func (v Table0220) Valid() bool {
+	return TableValueLookup[`0220`][string(v)]
+}
+
+// Table 0220.
+func (v Table0220) Table() Table {
+	return TableLookup[`0220`]
+}
+
+// Table0223 is a value of table 0223, Living dependency.
+// Fields in this table are strings; convert a field, such as Table0223(v), to use the helpers.
+type Table0223 string
+
+const (
+	Table0223CommonBath                 Table0223 = `CB` // Common Bath
+	Table0223SpouseDependent            Table0223 = `D`  // Spouse dependent
+	Table0223MedicalSupervisionRequired Table0223 = `M`  // Medical Supervision Required
+	Table0223SmallChildren              Table0223 = `S`  // Small children
+	Table0223WalkUp                     Table0223 = `WU` // Walk up
+)
+
+// Description of the value, or empty if the value is not in table 0223.
+func (v Table0223) Description() string {
+	return tableDescription(`0223`, string(v))
+}
+
+// Valid reports if the value is in table 0223.
+func (v Table0223) Valid() bool {
+	return TableValueLookup[`0223`][string(v)]
+}
+
+// Table 0223.
+func (v Table0223) Table() Table {
+	return TableLookup[`0223`]
+}
+
+// Table0224 is a value of table 0224, Transport arranged.
+// Fields in this table are strings; convert a field, such as Table0224(v), to use the helpers.
+type Table0224 string
+
+const (
+	Table0224Arranged    Table0224 = `A` // Arranged
+	Table0224NotArranged Table0224 = `N` // Not Arranged
+	Table0224Unknown     Table0224 = `U` // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0224.
+func (v Table0224) Description() string {
+	return tableDescription(`0224`, string(v))
+}
+
+// Valid reports if the value is in table 0224.
+func (v Table0224) Valid() bool {
+	return TableValueLookup[`0224`][string(v)]
+}
+
+// Table 0224.
+func (v Table0224) Table() Table {
+	return TableLookup[`0224`]
+}
+
+// Table0225 is a value of table 0225, Escort required.
+// Fields in this table are strings; convert a field, such as Table0225(v), to use the helpers.
+type Table0225 string
+
+const (
+	Table0225NotRequired Table0225 = `N` // Not Required
+	Table0225Required    Table0225 = `R` // Required
+	Table0225Unknown     Table0225 = `U` // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0225.
+func (v Table0225) Description() string {
+	return tableDescription(`0225`, string(v))
+}
+
+// Valid reports if the value is in table 0225.
+func (v Table0225) Valid() bool {
+	return TableValueLookup[`0225`][string(v)]
+}
+
+// Table 0225.
+func (v Table0225) Table() Table {
+	return TableLookup[`0225`]
+}
+
+// Table0227 is a value of table 0227, Manufacturers of vaccines.
+// Fields in this table are strings; convert a field, such as Table0227(v), to use the helpers.
+type Table0227 string
+
+const (
+	Table0227AbbottLaboratories                                   Table0227 = `AB`  // Abbott Laboratories
+	Table0227AdamsLaboratories                                    Table0227 = `AD`  // Adams Laboratories
+	Table0227AlphaTherapeuticCorporation                          Table0227 = `ALP` // Alpha Therapeutic Corporation
+	Table0227ArmourInactiveUseCEN                                 Table0227 = `AR`  // Armour (Inactive - use CEN)
+	Table0227Aviron                                               Table0227 = `AVI` // Aviron
+	Table0227BaxterHealthcareCorporation                          Table0227 = `BA`  // Baxter Healthcare Corporation
+	Table0227BayerCorporationIncludesMilesIncAnd                  Table0227 = `BAY` // Bayer Corporation (includes Miles, Inc. and Cutter Laboratories)
+	Table0227BernaProductsInactiveUseBPC                          Table0227 = `BP`  // Berna Products (Inactive - use BPC)
+	Table0227BernaProductsCorporationIncludesSwissSerum           Table0227 = `BPC` // Berna Products Corporation (includes Swiss Serum and Vaccine Institute Berna)
+	Table0227CenteonLLCIncludesArmour                             Table0227 = `CEN` // Centeon L.L.C. (includes Armour Pharmaceutical Company)
+	Table0227ChironCorporation                                    Table0227 = `CHI` // Chiron Corporation
+	Table0227ConnaughtInactiveUsePMC                              Table0227 = `CON` // Connaught (inactive - use PMC)
+	Table0227EvansMedicalLimited                                  Table0227 = `EVN` // Evans Medical Limited
+	Table0227GreerLaboratoriesInc                                 Table0227 = `GRE` // Greer Laboratories, Inc.
+	Table0227ImmunoInternationalAG                                Table0227 = `IAG` // Immuno International AG
+	Table0227MerieuxInactiveUsePMC                                Table0227 = `IM`  // Merieux (inactive - Use PMC)
+	Table0227ImmunoUSInc                                          Table0227 = `IUS` // Immuno-US, Inc.
+	Table0227TheResearchFoundationForMicrobialDiseases            Table0227 = `JPN` // The Research Foundation for Microbial Diseases of Osaka University (BIKEN)
+	Table0227KoreaGreenCrossCorporation                           Table0227 = `KGC` // Korea Green Cross Corporation
+	Table0227LederleInactiveUseWAL                                Table0227 = `LED` // Lederle (inactive - use WAL)
+	Table0227MassachusettsPublicHealthBiologicLaboratories        Table0227 = `MA`  // Massachusetts Public Health Biologic Laboratories)
+	Table0227MedimmuneInc                                         Table0227 = `MED` // Medimmune, Inc.
+	Table0227MilesInactiveUseBAY                                  Table0227 = `MIL` // Miles (inactive - use BAY)
+	Table0227MichiganBiologicProductsInstitute                    Table0227 = `MIP` // Michigan Biologic Products Institute
+	Table0227MerckCoInc                                           Table0227 = `MSD` // Merck & Co., Inc.
+	Table0227NABIFormerlyNorthAmericanBiologicalsInc              Table0227 = `NAB` // NABI (formerly North American Biologicals, Inc.)
+	Table0227NorthAmericanVaccineInc                              Table0227 = `NAV` // North American Vaccine, Inc.
+	Table0227NovartisPharmaceuticalCorporation                    Table0227 = `NOV` // Novartis Pharmaceutical Corporation
+	Table0227NewYorkBloodCenter                                   Table0227 = `NYB` // New York Blood Center
+	Table0227OrthoDiagnosticSystemsInc                            Table0227 = `ORT` // Ortho Diagnostic Systems, Inc.
+	Table0227OrganonTeknikaCorporation                            Table0227 = `OTC` // Organon Teknika Corporation
+	Table0227Other                                                Table0227 = `OTH` // Other
+	Table0227ParkdalePharmaceuticalsFormerlyParkeDavis            Table0227 = `PD`  // Parkdale Pharmaceuticals (formerly Parke-Davis)
+	Table0227PasteurMerieuxConnaughtIncludesConnaughtLaboratories Table0227 = `PMC` // Pasteur Merieux Connaught (includes Connaught Laboratories and Pasteur Merieux)
+	Table0227PraxisBiologicsInactiveUseWAL                        Table0227 = `PRX` // Praxis Biologics (inactive - use WAL)
+	Table0227SclavoInc                                            Table0227 = `SCL` // Sclavo, Inc.
+	Table0227SwissSerumAndVaccineInstInactive                     Table0227 = `SI`  // Swiss Serum and Vaccine Inst. (inactive - use BPC)
+	Table0227SmithKlineBeecham                                    Table0227 = `SKB` // SmithKline Beecham
+	Table0227UnknownManufacturer                                  Table0227 = `UNK` // Unknown manufacturer
+	Table0227UnitedStatesArmyMedicalResearchAnd                   Table0227 = `USA` // United States Army Medical Research and Materiel Command
+	Table0227WyethAyerstInactiveUseWAL                            Table0227 = `WA`  // Wyeth-Ayerst (inactive - use WAL)
+	Table0227WyethAyerstIncludesWyethLederleVaccines              Table0227 = `WAL` // Wyeth-Ayerst (includes Wyeth-Lederle Vaccines and Pediatrics, Wyeth Laboratories, Lederle Laboratories, and Praxis Biologics)
+)
+
+// Description of the value, or empty if the value is not in table 0227.
+func (v Table0227) Description() string {
+	return tableDescription(`0227`, string(v))
+}
+
+// Valid reports if the value is in table 0227.
+func (v Table0227) Valid() bool {
+	return TableValueLookup[`0227`][string(v)]
+}
+
+// Table 0227.
+func (v Table0227) Table() Table {
+	return TableLookup[`0227`]
+}
+
+// Table0228 is a value of table 0228, Diagnosis classification.
+// Fields in this table are strings; convert a field, such as Table0228(v), to use the helpers.
+type Table0228 string
+
+const (
+	Table0228Consultation                             Table0228 = `C` // Consultation
+	Table0228Diagnosis                                Table0228 = `D` // Diagnosis
+	Table0228InvasiveProcedureNotClassifiedElsewhereI Table0228 = `I` // Invasive procedure not classified elsewhere (I.V., catheter, etc.)
+	Table0228MedicationAntibiotic                     Table0228 = `M` // Medication (antibiotic)
+	Table0228Other                                    Table0228 = `O` // Other
+	Table0228RadiologicalSchedulingNotUsingICDACodes  Table0228 = `R` // Radiological scheduling (not using ICDA codes)
+	Table0228SignAndSymptom                           Table0228 = `S` // Sign and symptom
+	Table0228TissueDiagnosis                          Table0228 = `T` // Tissue diagnosis
+)
+
+// Description of the value, or empty if the value is not in table 0228.
+func (v Table0228) Description() string {
+	return tableDescription(`0228`, string(v))
+}
+
+// Valid reports if the value is in table 0228.
+func (v Table0228) Valid() bool {
+	return TableValueLookup[`0228`][string(v)]
+}
+
+// Table 0228.
+func (v Table0228) Table() Table {
+	return TableLookup[`0228`]
+}
+
+// Table0229 is a value of table 0229, DRG payor.
+// Fields in this table are strings; convert a field, such as Table0229(v), to use the helpers.
+type Table0229 string
+
+const (
+	Table0229Champus                 Table0229 = `C` // Champus
+	Table0229ManagedCareOrganization Table0229 = `G` // Managed Care Organization
+	Table0229Medicare                Table0229 = `M` // Medicare
+)
+
+// Description of the value, or empty if the value is not in table 0229.
+func (v Table0229) Description() string {
+	return tableDescription(`0229`, string(v))
+}
+
+// Valid reports if the value is in table 0229.
+func (v Table0229) Valid() bool {
+	return TableValueLookup[`0229`][string(v)]
+}
+
+// Table 0229.
+func (v Table0229) Table() Table {
+	return TableLookup[`0229`]
+}
+
+// Table0230 is a value of table 0230, Procedure functional type.
+// Fields in this table are strings; convert a field, such as Table0230(v), to use the helpers.
+type Table0230 string
+
+const (
+	Table0230Anesthesia                                          Table0230 = `A` // Anesthesia
+	Table0230DiagnosticProcedure                                 Table0230 = `D` // Diagnostic procedure
+	Table0230InvasiveProcedureNotClassifiedElsewhereE            Table0230 = `I` // Invasive procedure not classified elsewhere (e.g., IV, catheter, etc.)
+	Table0230ProcedureForTreatmentTherapeuticIncludingOperations Table0230 = `P` // Procedure for treatment (therapeutic, including operations)
+)
+
+// Description of the value, or empty if the value is not in table 0230.
+func (v Table0230) Description() string {
+	return tableDescription(`0230`, string(v))
+}
+
+// Valid reports if the value is in table 0230.
+func (v Table0230) Valid() bool {
+	return TableValueLookup[`0230`][string(v)]
+}
+
+// Table 0230.
+func (v Table0230) Table() Table {
+	return TableLookup[`0230`]
+}
+
+// Table0231 is a value of table 0231, Student status.
+// Fields in this table are strings; convert a field, such as Table0231(v), to use the helpers.
+type Table0231 string
+
+const (
+	Table0231FullTimeStudent Table0231 = `F` // Full-time student
+	Table0231NotAStudent     Table0231 = `N` // Not a student
+	Table0231PartTimeStudent Table0231 = `P` // Part-time student
+)
+
+// Description of the value, or empty if the value is not in table 0231.
+func (v Table0231) Description() string {
+	return tableDescription(`0231`, string(v))
+}
+
+// Valid reports if the value is in table 0231.
+func (v Table0231) Valid() bool {
+	return TableValueLookup[`0231`][string(v)]
+}
+
+// Table 0231.
+func (v Table0231) Table() Table {
+	return TableLookup[`0231`]
+}
+
+// Table0232 is a value of table 0232, Insurance company contact reason.
+// Fields in this table are strings; convert a field, such as Table0232(v), to use the helpers.
+type Table0232 string
+
+const (
+	Table0232MedicareClaimStatus Table0232 = `01` // Medicare claim status
+	Table0232MedicaidClaimStatus Table0232 = `02` // Medicaid claim status
+	Table0232NameAddressChange   Table0232 = `03` // Name/address change
+)
+
+// Description of the value, or empty if the value is not in table 0232.
+func (v Table0232) Description() string {
+	return tableDescription(`0232`, string(v))
+}
+
+// Valid reports if the value is in table 0232.
+func (v Table0232) Valid() bool {
+	return TableValueLookup[`0232`][string(v)]
+}
+
+// Table 0232.
+func (v Table0232) Table() Table {
+	return TableLookup[`0232`]
+}
+
+// Table0234 is a value of table 0234, Report timing.
+// Fields in this table are strings; convert a field, such as Table0234(v), to use the helpers.
+type Table0234 string
+
+const (
+	Table023410DayReport           Table0234 = `10D` // 10 day report
+	Table023415DayReport           Table0234 = `15D` // 15 day report
+	Table023430DayReport           Table0234 = `30D` // 30 day report
+	Table02343DayReport            Table0234 = `3D`  // 3 day report
+	Table02347DayReport            Table0234 = `7D`  // 7 day report
+	Table0234AdditionalInformation Table0234 = `AD`  // Additional information
+	Table0234Correction            Table0234 = `CO`  // Correction
+	Table0234DeviceEvaluation      Table0234 = `DE`  // Device evaluation
+	Table0234Periodic              Table0234 = `PD`  // Periodic
+	Table0234RequestedInformation  Table0234 = `RQ`  // Requested information
+)
+
+// Description of the value, or empty if the value is not in table 0234.
+func (v Table0234) Description() string {
+	return tableDescription(`0234`, string(v))
+}
+
+// Valid reports if the value is in table 0234.
+func (v Table0234) Valid() bool {
+	return TableValueLookup[`0234`][string(v)]
+}
+
+// Table 0234.
+func (v Table0234) Table() Table {
+	return TableLookup[`0234`]
+}
+
+// Table0235 is a value of table 0235, Report source.
+// Fields in this table are strings; convert a field, such as Table0235(v), to use the helpers.
+type Table0235 string
+
+const (
+	Table0235ClinicalTrial                        Table0235 = `C` // Clinical trial
+	Table0235DatabaseRegistryPoisonControlCenter  Table0235 = `D` // Database/registry/poison control center
+	Table0235Distributor                          Table0235 = `E` // Distributor
+	Table0235HealthProfessional                   Table0235 = `H` // Health professional
+	Table0235Literature                           Table0235 = `L` // Literature
+	Table0235ManufacturerMarketingAuthorityHolder Table0235 = `M` // Manufacturer/marketing authority holder
+	Table0235NonHealthcareProfessional            Table0235 = `N` // Non-healthcare professional
+	Table0235Other                                Table0235 = `O` // Other
+	Table0235Patient                              Table0235 = `P` // Patient
+	Table0235RegulatoryAgency                     Table0235 = `R` // Regulatory agency
+)
+
+// Description of the value, or empty if the value is not in table 0235.
+func (v Table0235) Description() string {
+	return tableDescription(`0235`, string(v))
+}
+
+// Valid reports if the value is in table 0235.
+func (v Table0235) Valid() bool {
+	return TableValueLookup[`0235`][string(v)]
+}
+
+// Table 0235.
+func (v Table0235) Table() Table {
+	return TableLookup[`0235`]
+}
+
+// Table0236 is a value of table 0236, Event reported to.
+// Fields in this table are strings; convert a field, such as Table0236(v), to use the helpers.
+type Table0236 string
+
+const (
+	Table0236Distributor               Table0236 = `D` // Distributor
+	Table0236LocalFacilityUserFacility Table0236 = `L` // Local facility/user facility
+	Table0236Manufacturer              Table0236 = `M` // Manufacturer
+	Table0236RegulatoryAgency          Table0236 = `R` // Regulatory agency
+)
+
+// Description of the value, or empty if the value is not in table 0236.
+func (v Table0236) Description() string {
+	return tableDescription(`0236`, string(v))
+}
+
+// Valid reports if the value is in table 0236.
+func (v Table0236) Valid() bool {
+	return TableValueLookup[`0236`][string(v)]
+}
+
+// Table 0236.
+func (v Table0236) Table() Table {
+	return TableLookup[`0236`]
+}
+
+// Table0237 is a value of table 0237, Event qualification.
+// Fields in this table are strings; convert a field, such as Table0237(v), to use the helpers.
+type Table0237 string
+
+const (
+	Table0237Abuse                         Table0237 = `A` // Abuse
+	Table0237UnexpectedBeneficialEffect    Table0237 = `B` // Unexpected beneficial effect
+	Table0237Dependency                    Table0237 = `D` // Dependency
+	Table0237Interaction                   Table0237 = `I` // Interaction
+	Table0237LackOfExpectTherapeuticEffect Table0237 = `L` // Lack of expect therapeutic effect
+	Table0237Misuse                        Table0237 = `M` // Misuse
+	Table0237Overdose                      Table0237 = `O` // Overdose
+	Table0237DrugWithdrawal                Table0237 = `W` // Drug withdrawal
+)
+
+// Description of the value, or empty if the value is not in table 0237.
+func (v Table0237) Description() string {
+	return tableDescription(`0237`, string(v))
+}
+
+// Valid reports if the value is in table 0237.
+func (v Table0237) Valid() bool {
+	return TableValueLookup[`0237`][string(v)]
+}
+
+// Table 0237.
+func (v Table0237) Table() Table {
+	return TableLookup[`0237`]
+}
+
+// Table0238 is a value of table 0238, Event seriousness.
+// Fields in this table are strings; convert a field, such as Table0238(v), to use the helpers.
+type Table0238 string
+
+const (
+	Table0238No          Table0238 = `N` // No
+	Table0238Significant Table0238 = `S` // Significant
+	Table0238Yes         Table0238 = `Y` // Yes
+)
+
+// Description of the value, or empty if the value is not in table 0238.
+func (v Table0238) Description() string {
+	return tableDescription(`0238`, string(v))
+}
+
+// Valid reports if the value is in table 0238.
+func (v Table0238) Valid() bool {
+	return TableValueLookup[`0238`][string(v)]
+}
+
+// Table 0238.
+func (v Table0238) Table() Table {
+	return TableLookup[`0238`]
+}
+
+// Table0239 is a value of table 0239, Event expected.
+// Fields in this table are strings; convert a field, such as Table0239(v), to use the helpers.
+type Table0239 string
+
+const (
+	Table0239No      Table0239 = `N` // No
+	Table0239Unknown Table0239 = `U` // Unknown
+	Table0239Yes     Table0239 = `Y` // Yes
+)
+
+// Description of the value, or empty if the value is not in table 0239.
+func (v Table0239) Description() string {
+	return tableDescription(`0239`, string(v))
+}
+
+// Valid reports if the value is in table 0239.
+func (v Table0239) Valid() bool {
+	return TableValueLookup[`0239`][string(v)]
+}
+
+// Table 0239.
+func (v Table0239) Table() Table {
+	return TableLookup[`0239`]
+}
+
+// Table0240 is a value of table 0240, Event consequence.
+// Fields in this table are strings; convert a field, such as Table0240(v), to use the helpers.
+type Table0240 string
+
+const (
+	Table0240CongenitalAnomalyBirthDefect                     Table0240 = `C` // Congenital anomaly/birth defect
+	Table0240Death                                            Table0240 = `D` // Death
+	Table0240CausedHospitalized                               Table0240 = `H` // Caused hospitalized
+	Table0240IncapacityWhichIsSignificantPersistentOr         Table0240 = `I` // Incapacity which is significant, persistent or permanent
+	Table0240DisabilityWhichIsSignificantPersistentOr         Table0240 = `J` // Disability which is significant, persistent or permanent
+	Table0240LifeThreatening                                  Table0240 = `L` // Life threatening
+	Table0240Other                                            Table0240 = `O` // Other
+	Table0240ProlongedHospitalization                         Table0240 = `P` // Prolonged hospitalization
+	Table0240RequiredInterventionToPreventPermanentImpairment Table0240 = `R` // Required intervention to prevent permanent impairment/damage
+)
+
+// Description of the value, or empty if the value is not in table 0240.
+func (v Table0240) Description() string {
+	return tableDescription(`0240`, string(v))
+}
+
+// Valid reports if the value is in table 0240.
+func (v Table0240) Valid() bool {
+	return TableValueLookup[`0240`][string(v)]
+}
+
+// Table 0240.
+func (v Table0240) Table() Table {
+	return TableLookup[`0240`]
+}
+
+// Table0241 is a value of table 0241, Patient outcome.
+// Fields in this table are strings; convert a field, such as Table0241(v), to use the helpers.
+type Table0241 string
+
+const (
+	Table0241Died                   Table0241 = `D` // Died
+	Table0241FullyRecovered         Table0241 = `F` // Fully recovered
+	Table0241NotRecoveringUnchanged Table0241 = `N` // Not recovering/unchanged
+	Table0241Recovering             Table0241 = `R` // Recovering
+	Table0241Sequelae               Table0241 = `S` // Sequelae
+	Table0241Unknown                Table0241 = `U` // Unknown
+	Table0241Worsening              Table0241 = `W` // Worsening
+)
+
+// Description of the value, or empty if the value is not in table 0241.
+func (v Table0241) Description() string {
+	return tableDescription(`0241`, string(v))
+}
+
+// Valid reports if the value is in table 0241.
+func (v Table0241) Valid() bool {
+	return TableValueLookup[`0241`][string(v)]
+}
+
+// Table 0241.
+func (v Table0241) Table() Table {
+	return TableLookup[`0241`]
+}
+
+// Table0242 is a value of table 0242, Primary observer’s qualification.
+// Fields in this table are strings; convert a field, such as Table0242(v), to use the helpers.
+type Table0242 string
+
+const (
+	Table0242HealthCareConsumerPatient                  Table0242 = `C` // Health care consumer/patient
+	Table0242OtherHealthProfessional                    Table0242 = `H` // Other health professional
+	Table0242LawyerAttorney                             Table0242 = `L` // Lawyer/attorney
+	Table0242MidLevelProfessionalNurseNursePractitioner Table0242 = `M` // Mid-level professional (nurse, nurse practitioner, physician's assistant)
+	Table0242OtherNonHealthProfessional                 Table0242 = `O` // Other non-health professional
+	Table0242PhysicianOsteopathHomeopath                Table0242 = `P` // Physician (osteopath, homeopath)
+	Table0242Pharmacist                                 Table0242 = `R` // Pharmacist
+)
+
+// Description of the value, or empty if the value is not in table 0242.
+func (v Table0242) Description() string {
+	return tableDescription(`0242`, string(v))
+}
+
+// Valid reports if the value is in table 0242.
+func (v Table0242) Valid() bool {
+	return TableValueLookup[`0242`][string(v)]
+}
+
+// Table 0242.
+func (v Table0242) Table() Table {
+	return TableLookup[`0242`]
+}
+
+// Table0243 is a value of table 0243, Identity may be divulged.
+// Fields in this table are strings; convert a field, such as Table0243(v), to use the helpers.
+type Table0243 string
+
+const (
+	Table0243No            Table0243 = `N`  // No
+	Table0243NotApplicable Table0243 = `NA` // Not applicable
+	Table0243Yes           Table0243 = `Y`  // Yes
+)
+
+// Description of the value, or empty if the value is not in table 0243.
+func (v Table0243) Description() string {
+	return tableDescription(`0243`, string(v))
+}
+
+// Valid reports if the value is in table 0243.
+func (v Table0243) Valid() bool {
+	return TableValueLookup[`0243`][string(v)]
+}
+
+// Table 0243.
+func (v Table0243) Table() Table {
+	return TableLookup[`0243`]
+}
+
+// Table0247 is a value of table 0247, Status of evaluation.
+// Fields in this table are strings; convert a field, such as Table0247(v), to use the helpers.
+type Table0247 string
+
+const (
+	Table0247EvaluationAnticipatedButNotYetBegun        Table0247 = `A` // Evaluation anticipated, but not yet begun
+	Table0247ProductReceivedInConditionWhichMade        Table0247 = `C` // Product received in condition which made analysis impossible
+	Table0247ProductDiscardedUnableToFollowUp           Table0247 = `D` // Product discarded -- unable to follow up
+	Table0247ProductRemainsImplantedUnableToFollow      Table0247 = `I` // Product remains implanted -- unable to follow up
+	Table0247ProblemAlreadyKnownNoEvaluationNecessary   Table0247 = `K` // Problem already known, no evaluation necessary
+	Table0247Other                                      Table0247 = `O` // Other
+	Table0247EvaluationInProgress                       Table0247 = `P` // Evaluation in progress
+	Table0247ProductUnderQuarantineUnableToFollow       Table0247 = `Q` // Product under quarantine -- unable to follow up
+	Table0247ProductUnderRecallCorrectiveAction         Table0247 = `R` // Product under recall/corrective action
+	Table0247ProductUnavailableForFollowUpInvestigation Table0247 = `U` // Product unavailable for follow up investigation
+	Table0247ProductNotMadeByCompany                    Table0247 = `X` // Product not made by company
+	Table0247EvaluationCompleted                        Table0247 = `Y` // Evaluation completed
+)
+
+// Description of the value, or empty if the value is not in table 0247.
+func (v Table0247) Description() string {
+	return tableDescription(`0247`, string(v))
+}
+
+// Valid reports if the value is in table 0247.
+func (v Table0247) Valid() bool {
+	return TableValueLookup[`0247`][string(v)]
+}
+
+// Table 0247.
+func (v Table0247) Table() Table {
+	return TableLookup[`0247`]
+}
+
+// Table0248 is a value of table 0248, Product source.
+// Fields in this table are strings; convert a field, such as Table0248(v), to use the helpers.
+type Table0248 string
+
+const (
+	Table0248ActualProductInvolvedInIncidentWas Table0248 = `A` // Actual product involved in incident was evaluated
+	Table0248AProductFromTheSameLot             Table0248 = `L` // A product from the same lot as the actual product involved was evaluated
+	Table0248AProductFromAControlledNon         Table0248 = `N` // A product from a controlled/non-related inventory was evaluated
+	Table0248AProductFromAReserveSample         Table0248 = `R` // A product from a reserve sample was evaluated
+)
+
+// Description of the value, or empty if the value is not in table 0248.
+func (v Table0248) Description() string {
+	return tableDescription(`0248`, string(v))
+}
+
+// Valid reports if the value is in table 0248.
+func (v Table0248) Valid() bool {
+	return TableValueLookup[`0248`][string(v)]
+}
+
+// Table 0248.
+func (v Table0248) Table() Table {
+	return TableLookup[`0248`]
+}
+
+// Table0250 is a value of table 0250, Relatedness assessment.
+// Fields in this table are strings; convert a field, such as Table0250(v), to use the helpers.
+type Table0250 string
+
+const (
+	Table0250HighlyProbable     Table0250 = `H` // Highly probable
+	Table0250Improbable         Table0250 = `I` // Improbable
+	Table0250ModeratelyProbable Table0250 = `M` // Moderately probable
+	Table0250NotRelated         Table0250 = `N` // Not related
+	Table0250SomewhatProbable   Table0250 = `S` // Somewhat probable
+)
+
+// Description of the value, or empty if the value is not in table 0250.
+func (v Table0250) Description() string {
+	return tableDescription(`0250`, string(v))
+}
+
+// Valid reports if the value is in table 0250.
+func (v Table0250) Valid() bool {
+	return TableValueLookup[`0250`][string(v)]
+}
+
+// Table 0250.
+func (v Table0250) Table() Table {
+	return TableLookup[`0250`]
+}
+
+// Table0251 is a value of table 0251, Action taken in response to the event.
+// Fields in this table are strings; convert a field, such as Table0251(v), to use the helpers.
+type Table0251 string
+
+const (
+	Table0251ProductDoseOrFrequencyOfUse    Table0251 = `DI` // Product dose or frequency of use increased
+	Table0251ProductDoseOrFrequencyOfUse_DR Table0251 = `DR` // Product dose or frequency of use reduced
+	Table0251None                           Table0251 = `N`  // None
+	Table0251Other                          Table0251 = `OT` // Other
+	Table0251ProductWithdrawnPermanently    Table0251 = `WP` // Product withdrawn permanently
+	Table0251ProductWithdrawnTemporarily    Table0251 = `WT` // Product withdrawn temporarily
+)
+
+// Description of the value, or empty if the value is not in table 0251.
+func (v Table0251) Description() string {
+	return tableDescription(`0251`, string(v))
+}
+
+// Valid reports if the value is in table 0251.
+func (v Table0251) Valid() bool {
+	return TableValueLookup[`0251`][string(v)]
+}
+
+// Table 0251.
+func (v Table0251) Table() Table {
+	return TableLookup[`0251`]
+}
+
+// Table0252 is a value of table 0252, Causality observations.
+// Fields in this table are strings; convert a field, such as Table0252(v), to use the helpers.
+type Table0252 string
+
+const (
+	Table0252AbatementOfEventAfterProductWithdrawn       Table0252 = `AW` // Abatement of event after product withdrawn
+	Table0252EventRecurredAfterProductReintroduced       Table0252 = `BE` // Event recurred after product reintroduced
+	Table0252DoseResponseObserved                        Table0252 = `DR` // Dose response observed
+	Table0252AlternativeExplanationsForTheEventAvailable Table0252 = `EX` // Alternative explanations for the event available
+	Table0252EventOccurredAfterProductIntroduced         Table0252 = `IN` // Event occurred after product introduced
+	Table0252LiteratureReportsAssociationOfProductWith   Table0252 = `LI` // Literature reports association of product with event
+	Table0252OccurrenceOfEventWasConfirmedBy             Table0252 = `OE` // Occurrence of event was confirmed by objective evidence
+	Table0252Other                                       Table0252 = `OT` // Other
+	Table0252EffectObservedWhenPatientReceivesPlacebo    Table0252 = `PL` // Effect observed when patient receives placebo
+	Table0252SimilarEventsInPastForThis                  Table0252 = `SE` // Similar events in past for this patient
+	Table0252ToxicLevelsOfProductDocumentedIn            Table0252 = `TC` // Toxic levels of product documented in blood or body fluids
+)
+
+// Description of the value, or empty if the value is not in table 0252.
+func (v Table0252) Description() string {
+	return tableDescription(`0252`, string(v))
+}
+
+// Valid reports if the value is in table 0252.
+func (v Table0252) Valid() bool {
+	return TableValueLookup[`0252`][string(v)]
+}
+
+// Table 0252.
+func (v Table0252) Table() Table {
+	return TableLookup[`0252`]
+}
+
+// Table0253 is a value of table 0253, Indirect exposure mechanism.
+// Fields in this table are strings; convert a field, such as Table0253(v), to use the helpers.
+type Table0253 string
+
+const (
+	Table0253BreastMilk     Table0253 = `B` // Breast milk
+	Table0253Father         Table0253 = `F` // Father
+	Table0253Other          Table0253 = `O` // Other
+	Table0253Transplacental Table0253 = `P` // Transplacental
+	Table0253BloodProduct   Table0253 = `X` // Blood product
+)
+
+// Description of the value, or empty if the value is not in table 0253.
+func (v Table0253) Description() string {
+	return tableDescription(`0253`, string(v))
+}
+
+// Valid reports if the value is in table 0253.
+func (v Table0253) Valid() bool {
+	return TableValueLookup[`0253`][string(v)]
+}
+
+// Table 0253.
+func (v Table0253) Table() Table {
+	return TableLookup[`0253`]
+}
+
+// Table0254 is a value of table 0254, Kind of quantity.
+// Fields in this table are strings; convert a field, such as Table0254(v), to use the helpers.
+type Table0254 string
+
+const (
+	Table0254Absorbance                      Table0254 = `ABS`     // Absorbance
+	Table0254ConcentrationArbitrarySubstance Table0254 = `ACNC`    // Concentration, Arbitrary Substance
+	Table0254Activity                        Table0254 = `ACT`     // *Activity
+	Table0254Appearance                      Table0254 = `APER`    // Appearance
+	Table0254Arbitrary                       Table0254 = `ARB`     // *Arbitrary
+	Table0254Area                            Table0254 = `AREA`    // Area
+	Table0254Aspect                          Table0254 = `ASPECT`  // Aspect
+	Table0254CatalyticActivity               Table0254 = `CACT`    // *Catalytic Activity
+	Table0254CatalyticContent                Table0254 = `CCNT`    // *Catalytic Content
+	Table0254CatalyticConcentrationRatio     Table0254 = `CCRTO`   // Catalytic Concentration Ratio
+	Table0254CatalyticFraction               Table0254 = `CFR`     // *Catalytic Fraction
+	Table0254Class                           Table0254 = `CLAS`    // Class
+	Table0254CatalyticConcentration          Table0254 = `CNC`     // *Catalytic Concentration
+	Table0254Constant                        Table0254 = `CNST`    // *Constant
+	Table0254Coefficient                     Table0254 = `COEF`    // *Coefficient
+	Table0254Color                           Table0254 = `COLOR`   // Color
+	Table0254Consistency                     Table0254 = `CONS`    // Consistency
+	Table0254CatalyticRate                   Table0254 = `CRAT`    // *Catalytic Rate
+	Table0254CatalyticRatio                  Table0254 = `CRTO`    // Catalytic Ratio
+	Table0254Density                         Table0254 = `DEN`     // Density
+	Table0254Device                          Table0254 = `DEV`     // Device
+	Table0254Difference                      Table0254 = `DIFF`    // *Difference
+	Table0254Elasticity                      Table0254 = `ELAS`    // Elasticity
+	Table0254ElectricalPotentialVoltage      Table0254 = `ELPOT`   // Electrical Potential (Voltage)
+	Table0254ElectricalCurrentAmperage       Table0254 = `ELRAT`   // Electrical current (amperage)
+	Table0254ElectricalResistance            Table0254 = `ELRES`   // Electrical Resistance
+	Table0254Energy                          Table0254 = `ENGR`    // Energy
+	Table0254Entitic                         Table0254 = `ENT`     // *Entitic
+	Table0254EntiticCatalyticActivity        Table0254 = `ENTCAT`  // *Entitic Catalytic Activity
+	Table0254EntiticNumber                   Table0254 = `ENTNUM`  // *Entitic Number
+	Table0254EntiticSubstanceOfAmount        Table0254 = `ENTSUB`  // *Entitic Substance of Amount
+	Table0254EntiticVolume                   Table0254 = `ENTVOL`  // *Entitic Volume
+	Table0254Equilibrium                     Table0254 = `EQL`     // Equilibrium
+	Table0254MechanicalForce                 Table0254 = `FORCE`   // Mechanical force
+	Table0254Frequency                       Table0254 = `FREQ`    // Frequency
+	Table0254ImpressionInterpretationOfStudy Table0254 = `IMP`     // Impression/ interpretation of study
+	Table0254KinematicViscosity              Table0254 = `KINV`    // *Kinematic Viscosity
+	Table0254Length                          Table0254 = `LEN`     // Length
+	Table0254LengthIncrement                 Table0254 = `LINC`    // *Length Increment
+	Table0254Liquefaction                    Table0254 = `LIQ`     // *Liquefaction
+	Table0254Mass                            Table0254 = `MASS`    // *Mass
+	Table0254MassConcentration               Table0254 = `MCNC`    // *Mass Concentration
+	Table0254MassContent                     Table0254 = `MCNT`    // Mass Content
+	Table0254MassConcentrationRatio          Table0254 = `MCRTO`   // *Mass Concentration Ratio
+	Table0254MassFraction                    Table0254 = `MFR`     // *Mass Fraction
+	Table0254MagneticFlux                    Table0254 = `MGFLUX`  // Magnetic flux
+	Table0254MassIncrement                   Table0254 = `MINC`    // *Mass Increment
+	Table0254Morphology                      Table0254 = `MORPH`   // Morphology
+	Table0254Motility                        Table0254 = `MOTIL`   // Motility
+	Table0254MassRate                        Table0254 = `MRAT`    // *Mass Rate
+	Table0254MassRatio                       Table0254 = `MRTO`    // *Mass Ratio
+	Table0254NumberConcentration             Table0254 = `NCNC`    // *Number Concentration
+	Table0254NumberContent                   Table0254 = `NCNT`    // *Number Content
+	Table0254NumberFraction                  Table0254 = `NFR`     // *Number Fraction
+	Table0254NumberRatio                     Table0254 = `NRTO`    // *Number Ratio
+	Table0254Number                          Table0254 = `NUM`     // *Number
+	Table0254OpticalDensity                  Table0254 = `OD`      // Optical density
+	Table0254Osmolality                      Table0254 = `OSMOL`   // *Osmolality
+	Table0254PressurePartial                 Table0254 = `PRES`    // *Pressure (Partial)
+	Table0254PresenceIdentityExistence       Table0254 = `PRID`    // Presence/Identity/Existence
+	Table0254PowerWattage                    Table0254 = `PWR`     // Power (wattage)
+	Table0254Ranges                          Table0254 = `RANGE`   // *Ranges
+	Table0254Ratios                          Table0254 = `RATIO`   // *Ratios
+	Table0254ReciprocalRelativeTime          Table0254 = `RCRLTM`  // *Reciprocal Relative Time
+	Table0254RelativeDensity                 Table0254 = `RDEN`    // *Relative Density
+	Table0254Relative                        Table0254 = `REL`     // *Relative
+	Table0254RelativeMassConcentration       Table0254 = `RLMCNC`  // *Relative Mass Concentration
+	Table0254RelativeSubstanceConcentration  Table0254 = `RLSCNC`  // *Relative Substance Concentration
+	Table0254RelativeTime                    Table0254 = `RLTM`    // *Relative Time
+	Table0254SaturationFraction              Table0254 = `SATFR`   // *Saturation Fraction
+	Table0254SubstanceConcentration          Table0254 = `SCNC`    // *Substance Concentration
+	Table0254SubstanceConcentrationIncrement Table0254 = `SCNCIN`  // *Substance Concentration Increment
+	Table0254SubstanceContent                Table0254 = `SCNT`    // *Substance Content
+	Table0254SubstanceContentRate            Table0254 = `SCNTR`   // *Substance Content Rate
+	Table0254SubstanceConcentrationRatio     Table0254 = `SCRTO`   // *Substance Concentration Ratio
+	Table0254SubstanceFraction               Table0254 = `SFR`     // *Substance Fraction
+	Table0254Shape                           Table0254 = `SHAPE`   // Shape
+	Table0254Smell                           Table0254 = `SMELL`   // Smell
+	Table0254SubstanceRate                   Table0254 = `SRAT`    // *Substance Rate
+	Table0254SubstanceRatio                  Table0254 = `SRTO`    // *Substance Ratio
+	Table0254SubstanceAmount                 Table0254 = `SUB`     // *Substance Amount
+	Table0254Susceptibility                  Table0254 = `SUSC`    // *Susceptibility
+	Table0254Taste                           Table0254 = `TASTE`   // Taste
+	Table0254Temperature                     Table0254 = `TEMP`    // *Temperature
+	Table0254TemperatureDifference           Table0254 = `TEMPDF`  // *Temperature Difference
+	Table0254TemperatureIncrement            Table0254 = `TEMPIN`  // *Temperature Increment
+	Table0254ThresholdMassConcentration      Table0254 = `THRMCNC` // *Threshold Mass Concentration
+	Table0254ThresholdSubstanceConcentration Table0254 = `THRSCNC` // *Threshold Substance Concentration
+	Table0254TimeEGSeconds                   Table0254 = `TIME`    // *Time (e.g. seconds)
+	Table0254DilutionFactorTiter             Table0254 = `TITR`    // *Dilution Factor (Titer)
+	Table0254TimeDifference                  Table0254 = `TMDF`    // *Time Difference
+	Table0254TimeStampDateAndTime            Table0254 = `TMSTP`   // *Time Stamp -- Date and Time
+	Table0254TimeRatio                       Table0254 = `TRTO`    // *Time Ratio
+	Table0254Type                            Table0254 = `TYPE`    // *Type
+	Table0254VolumeContent                   Table0254 = `VCNT`    // *Volume Content
+	Table0254Velocity                        Table0254 = `VEL`     // *Velocity
+	Table0254VelocityRatio                   Table0254 = `VELRT`   // *Velocity Ratio
+	Table0254VolumeFraction                  Table0254 = `VFR`     // *Volume Fraction
+	Table0254Viscosity                       Table0254 = `VISC`    // *Viscosity
+	Table0254Volume                          Table0254 = `VOL`     // *Volume
+	Table0254VolumeRate                      Table0254 = `VRAT`    // *Volume Rate
+	Table0254VolumeRatio                     Table0254 = `VRTO`    // *Volume Ratio
+)
+
+// Description of the value, or empty if the value is not in table 0254.
+func (v Table0254) Description() string {
+	return tableDescription(`0254`, string(v))
+}
+
+// Valid reports if the value is in table 0254.
+func (v Table0254) Valid() bool {
+	return TableValueLookup[`0254`][string(v)]
+}
+
+// Table 0254.
+func (v Table0254) Table() Table {
+	return TableLookup[`0254`]
+}
+
+// Table0255 is a value of table 0255, Duration categories.
+// Fields in this table are strings; convert a field, such as Table0255(v), to use the helpers.
+type Table0255 string
+
+const (
+	Table0255LifeOfTheUnitUsedFor       Table0255 = `* (star)` // Life of the "unit." Used for blood products.
+	Table025512Hours                    Table0255 = `12H`      // 12 hours
+	Table02551Hour                      Table0255 = `1H`       // 1 hour
+	Table02551Months30Days              Table0255 = `1L`       // 1 months (30 days)
+	Table02551Week                      Table0255 = `1W`       // 1 week
+	Table02552Hours                     Table0255 = `2.5H`     // 2½ hours
+	Table025524Hours                    Table0255 = `24H`      // 24 hours
+	Table02552Days                      Table0255 = `2D`       // 2 days
+	Table02552Hours_2H                  Table0255 = `2H`       // 2 hours
+	Table02552Months                    Table0255 = `2L`       // 2 months
+	Table02552Weeks                     Table0255 = `2W`       // 2 weeks
+	Table025530Minutes                  Table0255 = `30M`      // 30 minutes
+	Table02553Days                      Table0255 = `3D`       // 3 days
+	Table02553Hours                     Table0255 = `3H`       // 3 hours
+	Table02553Months                    Table0255 = `3L`       // 3 months
+	Table02553Weeks                     Table0255 = `3W`       // 3 weeks
+	Table02554Days                      Table0255 = `4D`       // 4 days
+	Table02554Hours                     Table0255 = `4H`       // 4 hours
+	Table02554Weeks                     Table0255 = `4W`       // 4 weeks
+	Table02555Days                      Table0255 = `5D`       // 5 days
+	Table02555Hours                     Table0255 = `5H`       // 5 hours
+	Table02556Days                      Table0255 = `6D`       // 6 days
+	Table02556Hours                     Table0255 = `6H`       // 6 hours
+	Table02557Hours                     Table0255 = `7H`       // 7 hours
+	Table02558Hours                     Table0255 = `8H`       // 8 hours
+	Table0255ToIdentifyMeasuresAtAPoint Table0255 = `PT`       // To identify measures at a point in time. This is a synonym for "spot" or "random" as applied to urine measurements.
+)
+
+// Description of the value, or empty if the value is not in table 0255.
+func (v Table0255) Description() string {
+	return tableDescription(`0255`, string(v))
+}
+
+// Valid reports if the value is in table 0255.
+func (v Table0255) Valid() bool {
+	return TableValueLookup[`0255`][string(v)]
+}
+
+// Table 0255.
+func (v Table0255) Table() Table {
+	return TableLookup[`0255`]
+}
+
+// Table0258 is a value of table 0258, Relationship modifier.
+// Fields in this table are strings; convert a field, such as Table0258(v), to use the helpers.
+type Table0258 string
+
+const (
+	Table0258BloodProductUnit Table0258 = `BPU`     // Blood product unit
+	Table0258Control          Table0258 = `CONTROL` // Control
+	Table0258Donor            Table0258 = `DONOR`   // Donor
+	Table0258Patient          Table0258 = `PATIENT` // Patient
+)
+
+// Description of the value, or empty if the value is not in table 0258.
+func (v Table0258) Description() string {
+	return tableDescription(`0258`, string(v))
+}
+
+// Valid reports if the value is in table 0258.
+func (v Table0258) Valid() bool {
+	return TableValueLookup[`0258`][string(v)]
+}
+
+// Table 0258.
+func (v Table0258) Table() Table {
+	return TableLookup[`0258`]
+}
+
+// Table0259 is a value of table 0259, Modality.
+// Fields in this table are strings; convert a field, such as Table0259(v), to use the helpers.
+type Table0259 string
+
+const (
+	Table0259Angioscopy                                  Table0259 = `AS` // Angioscopy
+	Table0259BiomagneticImaging                          Table0259 = `BS` // Biomagnetic imaging
+	Table0259ColorFlowDoppler                            Table0259 = `CD` // Color flow doppler
+	Table0259Colposcopy                                  Table0259 = `CP` // Colposcopy
+	Table0259ComputedRadiography                         Table0259 = `CR` // Computed radiography
+	Table0259Cystoscopy                                  Table0259 = `CS` // Cystoscopy
+	Table0259ComputedTomography                          Table0259 = `CT` // Computed tomography
+	Table0259DuplexDoppler                               Table0259 = `DD` // Duplex doppler
+	Table0259Diapanography                               Table0259 = `DG` // Diapanography
+	Table0259DigitalMicroscopy                           Table0259 = `DM` // Digital microscopy
+	Table0259Echocardiography                            Table0259 = `EC` // Echocardiography
+	Table0259Endoscopy                                   Table0259 = `ES` // Endoscopy
+	Table0259FluoresceinAngiography                      Table0259 = `FA` // Fluorescein angiography
+	Table0259Fundoscopy                                  Table0259 = `FS` // Fundoscopy
+	Table0259Laparoscopy                                 Table0259 = `LP` // Laparoscopy
+	Table0259LaserSurfaceScan                            Table0259 = `LS` // Laser surface scan
+	Table0259MagneticResonanceAngiography                Table0259 = `MA` // Magnetic resonance angiography
+	Table0259MagneticResonanceSpectroscopy               Table0259 = `MS` // Magnetic resonance spectroscopy
+	Table0259NuclearMedicineRadioisotopeStudy            Table0259 = `NM` // Nuclear Medicine (radioisotope study)
+	Table0259Other                                       Table0259 = `OT` // Other
+	Table0259PositronEmissionTomographyPET               Table0259 = `PT` // Positron emission tomography (PET)
+	Table0259RadioFluoroscopy                            Table0259 = `RF` // Radio fluoroscopy
+	Table0259SinglePhotonEmissionComputedTomographySPECT Table0259 = `ST` // Single photon emission computed tomography (SPECT)
+	Table0259Thermography                                Table0259 = `TG` // Thermography
+	Table0259Ultrasound                                  Table0259 = `US` // Ultrasound
+	Table0259XRayAngiography                             Table0259 = `XA` // X-ray Angiography
+)
+
+// Description of the value, or empty if the value is not in table 0259.
+func (v Table0259) Description() string {
+	return tableDescription(`0259`, string(v))
+}
+
+// Valid reports if the value is in table 0259.
+func (v Table0259) Valid() bool {
+	return TableValueLookup[`0259`][string(v)]
+}
+
+// Table 0259.
+func (v Table0259) Table() Table {
+	return TableLookup[`0259`]
+}
+
+// Table0260 is a value of table 0260, Patient location type.
+// Fields in this table are strings; convert a field, such as Table0260(v), to use the helpers.
+type Table0260 string
+
+const (
+	Table0260Bed           Table0260 = `B` // Bed
+	Table0260Clinic        Table0260 = `C` // Clinic
+	Table0260Department    Table0260 = `D` // Department
+	Table0260ExamRoom      Table0260 = `E` // Exam Room
+	Table0260OtherLocation Table0260 = `L` // Other Location
+	Table0260NursingUnit   Table0260 = `N` // Nursing Unit
+	Table0260OperatingRoom Table0260 = `O` // Operating Room
+	Table0260Room          Table0260 = `R` // Room
+)
+
+// Description of the value, or empty if the value is not in table 0260.
+func (v Table0260) Description() string {
+	return tableDescription(`0260`, string(v))
+}
+
+// Valid reports if the value is in table 0260.
+func (v Table0260) Valid() bool {
+	return TableValueLookup[`0260`][string(v)]
+}
+
+// Table 0260.
+func (v Table0260) Table() Table {
+	return TableLookup[`0260`]
+}
+
+// Table0261 is a value of table 0261, Location equipment.
+// Fields in this table are strings; convert a field, such as Table0261(v), to use the helpers.
+type Table0261 string
+
+const (
+	Table0261ElectroEncephalogram Table0261 = `EEG` // Electro-Encephalogram
+	Table0261ElectroCardiogram    Table0261 = `EKG` // Electro-Cardiogram
+	Table0261InfusionPump         Table0261 = `INF` // Infusion pump
+	Table0261IVPump               Table0261 = `IVP` // IV pump
+	Table0261Oxygen               Table0261 = `OXY` // Oxygen
+	Table0261Suction              Table0261 = `SUC` // Suction
+	Table0261Ventilator           Table0261 = `VEN` // Ventilator
+	Table0261VitalSignsMonitor    Table0261 = `VIT` // Vital signs monitor
+)
+
+// Description of the value, or empty if the value is not in table 0261.
+func (v Table0261) Description() string {
+	return tableDescription(`0261`, string(v))
+}
+
+// Valid reports if the value is in table 0261.
+func (v Table0261) Valid() bool {
+	return TableValueLookup[`0261`][string(v)]
+}
+
+// Table 0261.
+func (v Table0261) Table() Table {
+	return TableLookup[`0261`]
+}
+
+// Table0265 is a value of table 0265, Specialty type.
+// Fields in this table are strings; convert a field, such as Table0265(v), to use the helpers.
+type Table0265 string
+
+const (
+	Table0265Allergy                        Table0265 = `ALC` // Allergy
+	Table0265Ambulatory                     Table0265 = `AMB` // Ambulatory
+	Table0265Cancer                         Table0265 = `CAN` // Cancer
+	Table0265CoronaryCardiacCare            Table0265 = `CAR` // Coronary/cardiac care
+	Table0265CriticalCare                   Table0265 = `CCR` // Critical care
+	Table0265Chiropractic                   Table0265 = `CHI` // Chiropractic
+	Table0265Education                      Table0265 = `EDI` // Education
+	Table0265Emergency                      Table0265 = `EMR` // Emergency
+	Table0265FamilyPlanning                 Table0265 = `FPC` // Family planning
+	Table0265IntensiveCare                  Table0265 = `INT` // Intensive care
+	Table0265Isolation                      Table0265 = `ISO` // Isolation
+	Table0265Naturopathic                   Table0265 = `NAT` // Naturopathic
+	Table0265NewbornNurseryInfants          Table0265 = `NBI` // Newborn, nursery, infants
+	Table0265ObstetricsGynecology           Table0265 = `OBG` // Obstetrics, gynecology
+	Table0265Observation                    Table0265 = `OBS` // Observation
+	Table0265OtherSpecialty                 Table0265 = `OTH` // Other specialty
+	Table0265Pediatrics                     Table0265 = `PED` // Pediatrics
+	Table0265GeneralFamilyPractice          Table0265 = `PHY` // General/family practice
+	Table0265PediatricNeonatalIntensiveCare Table0265 = `PIN` // Pediatric/neonatal intensive care
+	Table0265PediatricPsychiatric           Table0265 = `PPS` // Pediatric psychiatric
+	Table0265PediatricRehabilitation        Table0265 = `PRE` // Pediatric rehabilitation
+	Table0265PsychiatricIntensiveCare       Table0265 = `PSI` // Psychiatric intensive care
+	Table0265Psychiatric                    Table0265 = `PSY` // Psychiatric
+	Table0265Rehabilitation                 Table0265 = `REH` // Rehabilitation
+	Table0265Surgery                        Table0265 = `SUR` // Surgery
+	Table0265WalkInClinic                   Table0265 = `WIC` // Walk-in clinic
+)
+
+// Description of the value, or empty if the value is not in table 0265.
+func (v Table0265) Description() string {
+	return tableDescription(`0265`, string(v))
+}
+
+// Valid reports if the value is in table 0265.
+func (v Table0265) Valid() bool {
+	return TableValueLookup[`0265`][string(v)]
+}
+
+// Table 0265.
+func (v Table0265) Table() Table {
+	return TableLookup[`0265`]
+}
+
+// Table0267 is a value of table 0267, Days of the week.
+// Fields in this table are strings; convert a field, such as Table0267(v), to use the helpers.
+type Table0267 string
+
+const (
+	Table0267Friday    Table0267 = `FRI` // Friday
+	Table0267Monday    Table0267 = `MON` // Monday
+	Table0267Saturday  Table0267 = `SAT` // Saturday
+	Table0267Sunday    Table0267 = `SUN` // Sunday
+	Table0267Thursday  Table0267 = `THU` // Thursday
+	Table0267Tuesday   Table0267 = `TUE` // Tuesday
+	Table0267Wednesday Table0267 = `WED` // Wednesday
+)
+
+// Description of the value, or empty if the value is not in table 0267.
+func (v Table0267) Description() string {
+	return tableDescription(`0267`, string(v))
+}
+
+// Valid reports if the value is in table 0267.
+func (v Table0267) Valid() bool {
+	return TableValueLookup[`0267`][string(v)]
+}
+
+// Table 0267.
+func (v Table0267) Table() Table {
+	return TableLookup[`0267`]
+}
+
+// Table0268 is a value of table 0268, Override.
+// Fields in this table are strings; convert a field, such as Table0268(v), to use the helpers.
+type Table0268 string
+
+const (
+	Table0268OverrideAllowed    Table0268 = `A` // Override allowed
+	Table0268OverrideRequired   Table0268 = `R` // Override required
+	Table0268OverrideNotAllowed Table0268 = `X` // Override not allowed
+)
+
+// Description of the value, or empty if the value is not in table 0268.
+func (v Table0268) Description() string {
+	return tableDescription(`0268`, string(v))
+}
+
+// Valid reports if the value is in table 0268.
+func (v Table0268) Valid() bool {
+	return TableValueLookup[`0268`][string(v)]
+}
+
+// Table 0268.
+func (v Table0268) Table() Table {
+	return TableLookup[`0268`]
+}
+
+// Table0269 is a value of table 0269, Charge on indicator.
+// Fields in this table are strings; convert a field, such as Table0269(v), to use the helpers.
+type Table0269 string
+
+const (
+	Table0269ChargeOnOrder  Table0269 = `O` // Charge on Order
+	Table0269ChargeOnResult Table0269 = `R` // Charge on Result
+)
+
+// Description of the value, or empty if the value is not in table 0269.
+func (v Table0269) Description() string {
+	return tableDescription(`0269`, string(v))
+}
+
+// Valid reports if the value is in table 0269.
+func (v Table0269) Valid() bool {
+	return TableValueLookup[`0269`][string(v)]
+}
+
+// Table 0269.
+func (v Table0269) Table() Table {
+	return TableLookup[`0269`]
+}
+
+// Table0270 is a value of table 0270, Document type.
+// Fields in this table are strings; convert a field, such as Table0270(v), to use the helpers.
+type Table0270 string
+
+const (
+	Table0270AutopsyReport                            Table0270 = `AR` // Autopsy report
+	Table0270Cardiodiagnostics                        Table0270 = `CD` // Cardiodiagnostics
+	Table0270Consultation                             Table0270 = `CN` // Consultation
+	Table0270DiagnosticImaging                        Table0270 = `DI` // Diagnostic imaging
+	Table0270DischargeSummary                         Table0270 = `DS` // Discharge summary
+	Table0270EmergencyDepartmentReport                Table0270 = `ED` // Emergency department report
+	Table0270HistoryAndPhysicalExamination            Table0270 = `HP` // History and physical examination
+	Table0270OperativeReport                          Table0270 = `OP` // Operative report
+	Table0270PsychiatricConsultation                  Table0270 = `PC` // Psychiatric consultation
+	Table0270PsychiatricHistoryAndPhysicalExamination Table0270 = `PH` // Psychiatric history and physical examination
+	Table0270ProcedureNote                            Table0270 = `PN` // Procedure note
+	Table0270ProgressNote                             Table0270 = `PR` // Progress note
+	Table0270SurgicalPathology                        Table0270 = `SP` // Surgical pathology
+	Table0270TransferSummary                          Table0270 = `TS` // Transfer summary
+)
+
+// Description of the value, or empty if the value is not in table 0270.
+func (v Table0270) Description() string {
+	return tableDescription(`0270`, string(v))
+}
+
+// Valid reports if the value is in table 0270.
+func (v Table0270) Valid() bool {
+	return TableValueLookup[`0270`][string(v)]
+}
+
+// Table 0270.
+func (v Table0270) Table() Table {
+	return TableLookup[`0270`]
+}
+
+// Table0271 is a value of table 0271, Document completion status.
+// Fields in this table are strings; convert a field, such as Table0271(v), to use the helpers.
+type Table0271 string
+
+const (
+	Table0271Authenticated        Table0271 = `AU` // Authenticated
+	Table0271Dictated             Table0271 = `DI` // Dictated
+	Table0271Documented           Table0271 = `DO` // Documented
+	Table0271Incomplete           Table0271 = `IN` // Incomplete
+	Table0271InProgress           Table0271 = `IP` // In Progress
+	Table0271LegallyAuthenticated Table0271 = `LA` // Legally authenticated
+	Table0271PreAuthenticated     Table0271 = `PA` // Pre-authenticated
+)
+
+// Description of the value, or empty if the value is not in table 0271.
+func (v Table0271) Description() string {
+	return tableDescription(`0271`, string(v))
+}
+
+// Valid reports if the value is in table 0271.
+func (v Table0271) Valid() bool {
+	return TableValueLookup[`0271`][string(v)]
+}
+
+// Table 0271.
+func (v Table0271) Table() Table {
+	return TableLookup[`0271`]
+}
+
+// Table0272 is a value of table 0272, Document confidentiality status.
+// Fields in this table are strings; convert a field, such as Table0272(v), to use the helpers.
+type Table0272 string
+
+const (
+	Table0272Restricted     Table0272 = `R` // Restricted
+	Table0272UsualControl   Table0272 = `U` // Usual control
+	Table0272VeryRestricted Table0272 = `V` // Very restricted
+)
+
+// Description of the value, or empty if the value is not in table 0272.
+func (v Table0272) Description() string {
+	return tableDescription(`0272`, string(v))
+}
+
+// Valid reports if the value is in table 0272.
+func (v Table0272) Valid() bool {
+	return TableValueLookup[`0272`][string(v)]
+}
+
+// Table 0272.
+func (v Table0272) Table() Table {
+	return TableLookup[`0272`]
+}
+
+// Table0273 is a value of table 0273, Document availability status.
+// Fields in this table are strings; convert a field, such as Table0273(v), to use the helpers.
+type Table0273 string
+
+const (
+	Table0273AvailableForPatientCare   Table0273 = `AV` // Available for patient care
+	Table0273Deleted                   Table0273 = `CA` // Deleted
+	Table0273Obsolete                  Table0273 = `OB` // Obsolete
+	Table0273UnavailableForPatientCare Table0273 = `UN` // Unavailable for patient care
+)
+
+// Description of the value, or empty if the value is not in table 0273.
+func (v Table0273) Description() string {
+	return tableDescription(`0273`, string(v))
+}
+
+// Valid reports if the value is in table 0273.
+func (v Table0273) Valid() bool {
+	return TableValueLookup[`0273`][string(v)]
+}
+
+// Table 0273.
+func (v Table0273) Table() Table {
+	return TableLookup[`0273`]
+}
+
+// Table0275 is a value of table 0275, Document storage status.
+// Fields in this table are strings; convert a field, such as Table0275(v), to use the helpers.
+type Table0275 string
+
+const (
+	Table0275ActiveAndArchived Table0275 = `AA` // Active and archived
+	Table0275Active            Table0275 = `AC` // Active
+	Table0275ArchivedNotActive Table0275 = `AR` // Archived (not active)
+	Table0275Purged            Table0275 = `PU` // Purged
+)
+
+// Description of the value, or empty if the value is not in table 0275.
+func (v Table0275) Description() string {
+	return tableDescription(`0275`, string(v))
+}
+
+// Valid reports if the value is in table 0275.
+func (v Table0275) Valid() bool {
+	return TableValueLookup[`0275`][string(v)]
+}
+
+// Table 0275.
+func (v Table0275) Table() Table {
+	return TableLookup[`0275`]
+}
+
+// Table0276 is a value of table 0276, Appointment reason codes.
+// Fields in this table are strings; convert a field, such as Table0276(v), to use the helpers.
+type Table0276 string
+
+const (
+	Table0276ARoutineCheckUpSuchAs                Table0276 = `Checkup`   // A routine check-up, such as an annual physical
+	Table0276EmergencyAppointment                 Table0276 = `Emergency` // Emergency appointment
+	Table0276AFollowUpVisitFromA                  Table0276 = `Followup`  // A follow up visit from a previous appointment
+	Table0276RoutineAppointmentDefaultIfNotValued Table0276 = `Routine`   // Routine appointment - default if not valued
+	Table0276APreviouslyUnscheduledWalkInVisit    Table0276 = `Walkin`    // A previously unscheduled walk-in visit
+)
+
+// Description of the value, or empty if the value is not in table 0276.
+func (v Table0276) Description() string {
+	return tableDescription(`0276`, string(v))
+}
+
+// Valid reports if the value is in table 0276.
+func (v Table0276) Valid() bool {
+	return TableValueLookup[`0276`][string(v)]
+}
+
+// Table 0276.
+func (v Table0276) Table() Table {
+	return TableLookup[`0276`]
+}
+
+// Table0277 is a value of table 0277, Appointment type codes.
+// Fields in this table are strings; convert a field, such as Table0277(v), to use the helpers.
+type Table0277 string
+
+const (
+	Table0277ARequestToAddACompleted             Table0277 = `Complete`  // A request to add a completed appointment, used to maintain records of completed appointments
+	Table0277RoutineScheduleRequestTypeDefaultIf Table0277 = `Normal`    // Routine schedule request type - default if not valued
+	Table0277ARequestForATentativeE              Table0277 = `Tentative` // A request for a tentative (e.g., penciled in) appointment
+)
+
+// Description of the value, or empty if the value is not in table 0277.
+func (v Table0277) Description() string {
+	return tableDescription(`0277`, string(v))
+}
+
+// Valid reports if the value is in table 0277.
+func (v Table0277) Valid() bool {
+	return TableValueLookup[`0277`][string(v)]
+}
+
+// Table 0277.
+func (v Table0277) Table() Table {
+	return TableLookup[`0277`]
+}
+
+// Table0278 is a value of table 0278, Filler status codes.
+// Fields in this table are strings; convert a field, such as Table0278(v), to use the helpers.
+type Table0278 string
+
+const (
+	Table0278TheIndicatedTimeSlotSIs                     Table0278 = `Blocked`   // The indicated time slot(s) is(are) blocked
+	Table0278TheIndicatedAppointmentIsBooked             Table0278 = `Booked`    // The indicated appointment is booked
+	Table0278T7heIndicatedAppointmentWasStoppedFrom      Table0278 = `Cancelled` // T7he indicated appointment was stopped from occurring (canceled prior to starting)
+	Table0278TheIndicatedAppointmentHasCompletedNormally Table0278 = `Complete`  // The indicated appointment has completed normally (was not discontinued, canceled, or deleted)
+	Table0278TheIndicatedAppointmentWasDiscontinuedDC    Table0278 = `Dc`        // The indicated appointment was discontinued (DC'ed while in progress, discontinued parent appointment, or discontinued child appointment)
+	Table0278TheIndicatedAppointmentWasDeletedFrom       Table0278 = `Deleted`   // The indicated appointment was deleted from the filler application
+	Table0278TheAppointmentHasBeenConfirmedHowever       Table0278 = `Overbook`  // The appointment has been confirmed; however it is confirmed in an overbooked state
+	Table0278AppointmentHasNotYetBeenConfirmed           Table0278 = `Pending`   // Appointment has not yet been confirmed
+	Table0278TheIndicatedAppointmentHasBegunAnd          Table0278 = `Started`   // The indicated appointment has begun and is currently in progress
+	Table0278AppointmentHasBeenPlacedOnA                 Table0278 = `Waitlist`  // Appointment has been placed on a waiting list for a particular slot, or set of slots
+)
+
+// Description of the value, or empty if the value is not in table 0278.
+func (v Table0278) Description() string {
+	return tableDescription(`0278`, string(v))
+}
+
+// Valid reports if the value is in table 0278.
+func (v Table0278) Valid() bool {
+	return TableValueLookup[`0278`][string(v)]
+}
+
+// Table 0278.
+func (v Table0278) Table() Table {
+	return TableLookup[`0278`]
+}
+
+// Table0279 is a value of table 0279, Allow substitution codes.
+// Fields in this table are strings; convert a field, such as Table0279(v), to use the helpers.
+type Table0279 string
+
+const (
+	Table0279ContactThePlacerContactPersonPrior  Table0279 = `Confirm` // Contact the Placer Contact Person prior to making any substitutions of this resource
+	Table0279SubstitutionOfThisResourceIsNot     Table0279 = `No`      // Substitution of this resource is not allowed
+	Table0279NotifyThePlacerContactPersonThrough Table0279 = `Notify`  // Notify the Placer Contact Person, through normal institutional procedures, that a substitution of this resource has been made
+	Table0279SubstitutionOfThisResourceIsAllowed Table0279 = `Yes`     // Substitution of this resource is allowed
+)
+
+// Description of the value, or empty if the value is not in table 0279.
+func (v Table0279) Description() string {
+	return tableDescription(`0279`, string(v))
+}
+
+// Valid reports if the value is in table 0279.
+func (v Table0279) Valid() bool {
+	return TableValueLookup[`0279`][string(v)]
+}
+
+// Table 0279.
+func (v Table0279) Table() Table {
+	return TableLookup[`0279`]
+}
+
+// Table0280 is a value of table 0280, Referral priority.
+// Fields in this table are strings; convert a field, such as Table0280(v), to use the helpers.
+type Table0280 string
+
+const (
+	Table0280ASAP    Table0280 = `A` // ASAP
+	Table0280Routine Table0280 = `R` // Routine
+	Table0280STAT    Table0280 = `S` // STAT
+)
+
+// Description of the value, or empty if the value is not in table 0280.
+func (v Table0280) Description() string {
+	return tableDescription(`0280`, string(v))
+}
+
+// Valid reports if the value is in table 0280.
+func (v Table0280) Valid() bool {
+	return TableValueLookup[`0280`][string(v)]
+}
+
+// Table 0280.
+func (v Table0280) Table() Table {
+	return TableLookup[`0280`]
+}
+
+// Table0281 is a value of table 0281, Referral type.
+// Fields in this table are strings; convert a field, such as Table0281(v), to use the helpers.
+type Table0281 string
+
+const (
+	Table0281HomeCare       Table0281 = `Hom` // Home Care
+	Table0281Laboratory     Table0281 = `Lab` // Laboratory
+	Table0281Medical        Table0281 = `Med` // Medical
+	Table0281Psychiatric    Table0281 = `Psy` // Psychiatric
+	Table0281Radiology      Table0281 = `Rad` // Radiology
+	Table0281SkilledNursing Table0281 = `Skn` // Skilled Nursing
+)
+
+// Description of the value, or empty if the value is not in table 0281.
+func (v Table0281) Description() string {
+	return tableDescription(`0281`, string(v))
+}
+
+// Valid reports if the value is in table 0281.
+func (v Table0281) Valid() bool {
+	return TableValueLookup[`0281`][string(v)]
+}
+
+// Table 0281.
+func (v Table0281) Table() Table {
+	return TableLookup[`0281`]
+}
+
+// Table0282 is a value of table 0282, Referral disposition.
+// Fields in this table are strings; convert a field, such as Table0282(v), to use the helpers.
+type Table0282 string
+
+const (
+	Table0282AssumeManagement             Table0282 = `AM` // Assume Management
+	Table0282ReturnPatientAfterEvaluation Table0282 = `RP` // Return Patient After Evaluation
+	Table0282SecondOpinion                Table0282 = `SO` // Second Opinion
+	Table0282SendWrittenReport            Table0282 = `WR` // Send Written Report
+)
+
+// Description of the value, or empty if the value is not in table 0282.
+func (v Table0282) Description() string {
+	return tableDescription(`0282`, string(v))
+}
+
+// Valid reports if the value is in table 0282.
+func (v Table0282) Valid() bool {
+	return TableValueLookup[`0282`][string(v)]
+}
+
+// Table 0282.
+func (v Table0282) Table() Table {
+	return TableLookup[`0282`]
+}
+
+// Table0283 is a value of table 0283, Referral status.
+// Fields in this table are strings; convert a field, such as Table0283(v), to use the helpers.
+type Table0283 string
+
+const (
+	Table0283Accepted Table0283 = `A` // Accepted
+	Table0283Expired  Table0283 = `E` // Expired
+	Table0283Pending  Table0283 = `P` // Pending
+	Table0283Rejected Table0283 = `R` // Rejected
+)
+
+// Description of the value, or empty if the value is not in table 0283.
+func (v Table0283) Description() string {
+	return tableDescription(`0283`, string(v))
+}
+
+// Valid reports if the value is in table 0283.
+func (v Table0283) Valid() bool {
+	return TableValueLookup[`0283`][string(v)]
+}
+
+// Table 0283.
+func (v Table0283) Table() Table {
+	return TableLookup[`0283`]
+}
+
+// Table0284 is a value of table 0284, Referral category.
+// Fields in this table are strings; convert a field, such as Table0284(v), to use the helpers.
+type Table0284 string
+
+const (
+	Table0284Ambulatory Table0284 = `A` // Ambulatory
+	Table0284Emergency  Table0284 = `E` // Emergency
+	Table0284Inpatient  Table0284 = `I` // Inpatient
+	Table0284Outpatient Table0284 = `O` // Outpatient
+)
+
+// Description of the value, or empty if the value is not in table 0284.
+func (v Table0284) Description() string {
+	return tableDescription(`0284`, string(v))
+}
+
+// Valid reports if the value is in table 0284.
+func (v Table0284) Valid() bool {
+	return TableValueLookup[`0284`][string(v)]
+}
+
+// Table 0284.
+func (v Table0284) Table() Table {
+	return TableLookup[`0284`]
+}
+
+// Table0286 is a value of table 0286, Provider role.
+// Fields in this table are strings; convert a field, such as Table0286(v), to use the helpers.
+type Table0286 string
+
+const (
+	Table0286ConsultingProvider  Table0286 = `CP` // Consulting Provider
+	Table0286PrimaryCareProvider Table0286 = `PP` // Primary Care Provider
+	Table0286ReferringProvider   Table0286 = `RP` // Referring Provider
+	Table0286ReferredToProvider  Table0286 = `RT` // Referred to Provider
+)
+
+// Description of the value, or empty if the value is not in table 0286.
+func (v Table0286) Description() string {
+	return tableDescription(`0286`, string(v))
+}
+
+// Valid reports if the value is in table 0286.
+func (v Table0286) Valid() bool {
+	return TableValueLookup[`0286`][string(v)]
+}
+
+// Table 0286.
+func (v Table0286) Table() Table {
+	return TableLookup[`0286`]
+}
+
+// Table0287 is a value of table 0287, Problem/goal action code.
+// Fields in this table are strings; convert a field, such as Table0287(v), to use the helpers.
+type Table0287 string
+
+const (
+	Table0287ADD       Table0287 = `AD` // ADD
+	Table0287CORRECT   Table0287 = `CO` // CORRECT
+	Table0287DELETE    Table0287 = `DE` // DELETE
+	Table0287LINK      Table0287 = `LI` // LINK
+	Table0287UNCHANGED Table0287 = `UC` // UNCHANGED *
+	Table0287UNLINK    Table0287 = `UN` // UNLINK
+	Table0287UPDATE    Table0287 = `UP` // UPDATE
+)
+
+// Description of the value, or empty if the value is not in table 0287.
+func (v Table0287) Description() string {
+	return tableDescription(`0287`, string(v))
+}
+
+// Valid reports if the value is in table 0287.
+func (v Table0287) Valid() bool {
+	return TableValueLookup[`0287`][string(v)]
+}
+
+// Table 0287.
+func (v Table0287) Table() Table {
+	return TableLookup[`0287`]
+}
+
+// Table0292 is a value of table 0292, Vaccines administered.
+// Fields in this table are strings; convert a field, such as Table0292(v), to use the helpers.
+type Table0292 string
+
+const (
+	Table0292DTP                                      Table0292 = `1`  // DTP
+	Table0292IPV                                      Table0292 = `10` // IPV
+	Table0292Pertussis                                Table0292 = `11` // Pertussis
+	Table0292DiphtheriaAntitoxin                      Table0292 = `12` // Diphtheria antitoxin
+	Table0292TIG                                      Table0292 = `13` // TIG
+	Table0292IGNOS                                    Table0292 = `14` // IG, NOS
+	Table0292InfluenzaSplitInclPurifiedSurfaceAntigen Table0292 = `15` // Influenza-split (incl. purified surface antigen)
+	Table0292InfluenzaWhole                           Table0292 = `16` // Influenza-whole
+	Table0292HibNOS                                   Table0292 = `17` // Hib,  NOS
+	Table0292RabiesIntramuscularInjection             Table0292 = `18` // Rabies, intramuscular injection
+	Table0292BCG                                      Table0292 = `19` // BCG
+	Table0292OPV                                      Table0292 = `2`  // OPV
+	Table0292DTaP                                     Table0292 = `20` // DTaP
+	Table0292Varicella                                Table0292 = `21` // Varicella
+	Table0292DTPHib                                   Table0292 = `22` // DTP-Hib
+	Table0292Plague                                   Table0292 = `23` // Plague
+	Table0292Anthrax                                  Table0292 = `24` // Anthrax
+	Table0292TyphoidOral                              Table0292 = `25` // Typhoid-oral
+	Table0292Cholera                                  Table0292 = `26` // Cholera
+	Table0292BotulinumAntitoxin                       Table0292 = `27` // Botulinum antitoxin
+	Table0292DTPediatric                              Table0292 = `28` // DT(pediatric)
+	Table0292CMVIG                                    Table0292 = `29` // CMVIG
+	Table0292MMR                                      Table0292 = `3`  // MMR
+	Table0292HBIG                                     Table0292 = `30` // HBIG
+	Table0292HepAPediatricNOS                         Table0292 = `31` // Hep A, pediatric, NOS
+	Table0292Meningococcal                            Table0292 = `32` // Meningococcal
+	Table0292Pneumococcal                             Table0292 = `33` // Pneumococcal
+	Table0292RIG                                      Table0292 = `34` // RIG
+	Table0292TetanusToxoid                            Table0292 = `35` // Tetanus toxoid
+	Table0292VZIG                                     Table0292 = `36` // VZIG
+	Table0292YellowFever                              Table0292 = `37` // Yellow fever
+	Table0292RubellaMumps                             Table0292 = `38` // Rubella/Mumps
+	Table0292JapaneseEncephalitis                     Table0292 = `39` // Japanese encephalitis
+	Table0292MR                                       Table0292 = `4`  // M/R
+	Table0292RabiesIntradermalInjection               Table0292 = `40` // Rabies, intradermal injection
+	Table0292TyphoidParenteral                        Table0292 = `41` // Typhoid-parenteral
+	Table0292HepBAdolescentHighRiskInfant             Table0292 = `42` // Hep B, adolescent/high risk infant
+	Table0292HepBAdult                                Table0292 = `43` // Hep B, adult
+	Table0292HepBDialysis                             Table0292 = `44` // Hep B, dialysis
+	Table0292HepBNOS                                  Table0292 = `45` // Hep B,  NOS
+	Table0292HibPRPD                                  Table0292 = `46` // Hib (PRP-D)
+	Table0292HibHbOC                                  Table0292 = `47` // Hib (HbOC)
+	Table0292HibPRPT                                  Table0292 = `48` // Hib (PRP-T)
+	Table0292HibPRPOMP                                Table0292 = `49` // Hib (PRP-OMP)
+	Table0292Measles                                  Table0292 = `5`  // Measles
+	Table0292DtaPHib                                  Table0292 = `50` // DtaP-Hib
+	Table0292HibHepB                                  Table0292 = `51` // Hib-Hep B
+	Table0292HepAAdult                                Table0292 = `52` // Hep A - adult
+	Table0292TyphoidParenteralAKDUSMilitary           Table0292 = `53` // Typhoid, parenteral, AKD (U.S. military)
+	Table0292AdenovirusType4                          Table0292 = `54` // Adenovirus, type 4
+	Table0292AdenovirusType7                          Table0292 = `55` // Adenovirus, type 7
+	Table0292DengueFever                              Table0292 = `56` // Dengue fever
+	Table0292Hantavirus                               Table0292 = `57` // Hantavirus
+	Table0292HepC                                     Table0292 = `58` // Hep C
+	Table0292HepE                                     Table0292 = `59` // Hep E
+	Table0292Rubella                                  Table0292 = `6`  // Rubella
+	Table0292HerpesSimplex2                           Table0292 = `60` // Herpes simplex 2
+	Table0292HIV                                      Table0292 = `61` // HIV
+	Table0292HPV                                      Table0292 = `62` // HPV
+	Table0292JuninVirus                               Table0292 = `63` // Junin virus
+	Table0292Leishmaniasis                            Table0292 = `64` // Leishmaniasis
+	Table0292Leprosy                                  Table0292 = `65` // Leprosy
+	Table0292LymeDisease                              Table0292 = `66` // Lyme disease
+	Table0292Malaria                                  Table0292 = `67` // Malaria
+	Table0292Melanoma                                 Table0292 = `68` // Melanoma
+	Table0292Parainfluenza3                           Table0292 = `69` // Parainfluenza-3
+	Table0292Mumps                                    Table0292 = `7`  // Mumps
+	Table0292QFever                                   Table0292 = `70` // Q fever
+	Table0292RSVIGIV                                  Table0292 = `71` // RSV-IGIV
+	Table0292RheumaticFever                           Table0292 = `72` // Rheumatic fever
+	Table0292RiftValleyFever                          Table0292 = `73` // Rift Valley fever
+	Table0292Rotavirus                                Table0292 = `74` // Rotavirus
+	Table0292Smallpox                                 Table0292 = `75` // Smallpox
+	Table0292StaphylococcusBacterioLysate             Table0292 = `76` // Staphylococcus bacterio lysate
+	Table0292TickBorneEncephalitis                    Table0292 = `77` // Tick-borne encephalitis
+	Table0292TularemiaVaccine                         Table0292 = `78` // Tularemia vaccine
+	Table0292VacciniaImmuneGlobulin                   Table0292 = `79` // Vaccinia immune globulin
+	Table0292HepBAdolescentOrPediatric                Table0292 = `8`  // Hep B, adolescent or pediatric
+	Table0292VEELive                                  Table0292 = `80` // VEE, live
+	Table0292VEEInactivated                           Table0292 = `81` // VEE, inactivated
+	Table0292AdenovirusNOS                            Table0292 = `82` // Adenovirus, NOS
+	Table0292HepAPedAdol2Dose                         Table0292 = `83` // Hep A, ped/adol, 2 dose
+	Table0292HepAPedAdol3Dose                         Table0292 = `84` // Hep A, ped/adol, 3 dose
+	Table0292HepANOS                                  Table0292 = `85` // Hep A, NOS
+	Table0292IG                                       Table0292 = `86` // IG
+	Table0292IGIV                                     Table0292 = `87` // IGIV
+	Table0292InfluenzaNOS                             Table0292 = `88` // Influenza, NOS
+	Table0292PolioNOS                                 Table0292 = `89` // Polio, NOS
+	Table0292TdAdult                                  Table0292 = `9`  // Td (Adult)
+	Table0292RabiesNOS                                Table0292 = `90` // Rabies, NOS
+	Table0292TyphoidNOS                               Table0292 = `91` // Typhoid, NOS
+	Table0292VEENOS                                   Table0292 = `92` // VEE, NOS
+)
+
+// Description of the value, or empty if the value is not in table 0292.
+func (v Table0292) Description() string {
+	return tableDescription(`0292`, string(v))
+}
+
+// Valid reports if the value is in table 0292.
+func (v Table0292) Valid() bool {
+	return TableValueLookup[`0292`][string(v)]
+}
+
+// Table 0292.
+func (v Table0292) Table() Table {
+	return TableLookup[`0292`]
+}
+
+// Table0294 is a value of table 0294, Time selection criteria parameter class codes.
+// Fields in this table are strings; convert a field, such as Table0294(v), to use the helpers.
+type Table0294 string
+
+const (
+	Table0294AnIndicatorThatFridayIsOr    Table0294 = `FRI`       // An indicator that Friday is or is not preferred for the day on which the appointment will occur. OK = Preferred appointment day NO = Day is not preferred
+	Table0294AnIndicatorThatMondayIsOr    Table0294 = `MON`       // An indicator that Monday is or is not preferred for the day on which the appointment will occur. OK = Preferred appointment day NO = Day is not preferred
+	Table0294ThePreferredEndTimeForThe    Table0294 = `PREFEND`   // The preferred end time for the appointment request, service or resource. Any legal time specification in the format HHMM, using 24-hour clock notation
+	Table0294ThePreferredStartTimeForThe  Table0294 = `PREFSTART` // The preferred start time for the appointment request, service or resource. Any legal time specification in the format HHMM, using 24-hour clock notation
+	Table0294AnIndicatorThatSaturdayIsOr  Table0294 = `SAT`       // An indicator that Saturday is or is not preferred for the day on which the appointment will occur. OK = Preferred appointment day NO = Day is not preferred
+	Table0294AnIndicatorThatSundayIsOr    Table0294 = `SUN`       // An indicator that Sunday is or is not preferred for the day on which the appointment will occur. OK = Preferred appointment day NO = Day is not preferred
+	Table0294AnIndicatorThatThursdayIsOr  Table0294 = `THU`       // An indicator that Thursday is or is not preferred for the day on which the appointment will occur. OK = Preferred appointment day NO = Day is not preferred
+	Table0294AnIndicatorThatTuesdayIsOr   Table0294 = `TUE`       // An indicator that Tuesday is or is not preferred for the day on which the appointment will occur. OK = Preferred appointment day NO = Day is not preferred
+	Table0294AnIndicatorThatWednesdayIsOr Table0294 = `WED`       // An indicator that Wednesday is or is not preferred for the day on which the appointment will occur. OK = Preferred appointment day NO = Day is not preferred
+)
+
+// Description of the value, or empty if the value is not in table 0294.
+func (v Table0294) Description() string {
+	return tableDescription(`0294`, string(v))
+}
+
+// Valid reports if the value is in table 0294.
+func (v Table0294) Valid() bool {
+	return TableValueLookup[`0294`][string(v)]
+}
+
+// Table 0294.
+func (v Table0294) Table() Table {
+	return TableLookup[`0294`]
+}
+
+// Table0298 is a value of table 0298, CP range type.
+// Fields in this table are strings; convert a field, such as Table0298(v), to use the helpers.
+type Table0298 string
+
+const (
+	Table0298FlatRateApplyTheEntirePrice Table0298 = `F` // Flat-rate.  Apply the entire price to this interval, do not pro-rate the price if the full interval has not occurred/been consumed
+	Table0298ProRateApplyThisPriceTo     Table0298 = `P` // Pro-rate.  Apply this price to this interval, pro-rated by whatever portion of the interval has occurred/been consumed
+)
+
+// Description of the value, or empty if the value is not in table 0298.
+func (v Table0298) Description() string {
+	return tableDescription(`0298`, string(v))
+}
+
+// Valid reports if the value is in table 0298.
+func (v Table0298) Valid() bool {
+	return TableValueLookup[`0298`][string(v)]
+}
+
+// Table 0298.
+func (v Table0298) Table() Table {
+	return TableLookup[`0298`]
+}
+
+// Table0301 is a value of table 0301, Universal ID type.
+// Fields in this table are strings; convert a field, such as Table0301(v), to use the helpers.
+type Table0301 string
+
+const (
+	Table0301AnInternetDottedNameEitherIn                         Table0301 = `DNS`    // An Internet dotted name. Either in ASCII or as integers
+	Table0301SameAsUUID                                           Table0301 = `GUID`   // Same as UUID
+	Table0301TheCENHealthcareCodingSchemeDesignator               Table0301 = `HCD`    // The CEN Healthcare Coding Scheme Designator. (Identifiers used in DICOM follow this assignment scheme.)
+	Table0301ReservedForFutureHL7RegistrationSchemes              Table0301 = `HL7`    // Reserved for future HL7 registration schemes
+	Table0301AnInternationalStandardsOrganizationObjectIdentifier Table0301 = `ISO`    // An International Standards Organization Object Identifier
+	Table0301TheseAreReservedForLocallyDefined                    Table0301 = `L`      // These are reserved for locally defined coding schemes
+	Table0301TheseAreReservedForLocallyDefined_M                  Table0301 = `M`      // These are reserved for locally defined coding schemes
+	Table0301TheseAreReservedForLocallyDefined_N                  Table0301 = `N`      // These are reserved for locally defined coding schemes
+	Table0301UsuallyABase64EncodedStringOf                        Table0301 = `Random` // Usually a base64 encoded string of random bits. The uniqueness depends on the length of the bits.  Mail systems often generate ASCII string  "unique names," from a combination of random bits and system names.  Obviously, such identifiers will not be constrained to the base64 character set
+	Table0301TheDCEUniversalUniqueIdentifier                      Table0301 = `UUID`   // The DCE Universal Unique Identifier
+	Table0301AnX400MHSFormatIdentifier                            Table0301 = `x400`   // An X.400 MHS format identifier
+	Table0301AnX500DirectoryName                                  Table0301 = `x500`   // An X.500 directory name
+)
+
+// Description of the value, or empty if the value is not in table 0301.
+func (v Table0301) Description() string {
+	return tableDescription(`0301`, string(v))
+}
+
+// Valid reports if the value is in table 0301.
+func (v Table0301) Valid() bool {
+	return TableValueLookup[`0301`][string(v)]
+}
+
+// Table 0301.
+func (v Table0301) Table() Table {
+	return TableLookup[`0301`]
+}
+
+// Table0309 is a value of table 0309, Coverage type.
+// Fields in this table are strings; convert a field, such as Table0309(v), to use the helpers.
+type Table0309 string
+
+const (
+	Table0309BothHospitalAndPhysician Table0309 = `B` // Both hospital and physician
+	Table0309HospitalInstitutional    Table0309 = `H` // Hospital/institutional
+	Table0309PhysicianProfessional    Table0309 = `P` // Physician/professional
+)
+
+// Description of the value, or empty if the value is not in table 0309.
+func (v Table0309) Description() string {
+	return tableDescription(`0309`, string(v))
+}
+
+// Valid reports if the value is in table 0309.
+func (v Table0309) Valid() bool {
+	return TableValueLookup[`0309`][string(v)]
+}
+
+// Table 0309.
+func (v Table0309) Table() Table {
+	return TableLookup[`0309`]
+}
+
+// Table0311 is a value of table 0311, Job status.
+// Fields in this table are strings; convert a field, such as Table0311(v), to use the helpers.
+type Table0311 string
+
+const (
+	Table0311Other     Table0311 = `O` // Other
+	Table0311Permanent Table0311 = `P` // Permanent
+	Table0311Temporary Table0311 = `T` // Temporary
+	Table0311Unknown   Table0311 = `U` // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0311.
+func (v Table0311) Description() string {
+	return tableDescription(`0311`, string(v))
+}
+
+// Valid reports if the value is in table 0311.
+func (v Table0311) Valid() bool {
+	return TableValueLookup[`0311`][string(v)]
+}
+
+// Table 0311.
+func (v Table0311) Table() Table {
+	return TableLookup[`0311`]
+}
+
+// Table0315 is a value of table 0315, Living will.
+// Fields in this table are strings; convert a field, such as Table0315(v), to use the helpers.
+type Table0315 string
+
+const (
+	Table0315YesPatientHasALivingWill   Table0315 = `F` // Yes, patient has a living will but it is not on file
+	Table0315NoPatientDoesNotHaveA      Table0315 = `I` // No, patient does not have a living will but information was provided
+	Table0315NoPatientDoesNotHaveA_N    Table0315 = `N` // No, patient does not have a living will and no information was provided
+	Table0315Unknown                    Table0315 = `U` // Unknown
+	Table0315YesPatientHasALivingWill_Y Table0315 = `Y` // Yes, patient has a living will
+)
+
+// Description of the value, or empty if the value is not in table 0315.
+func (v Table0315) Description() string {
+	return tableDescription(`0315`, string(v))
+}
+
+// Valid reports if the value is in table 0315.
+func (v Table0315) Valid() bool {
+	return TableValueLookup[`0315`][string(v)]
+}
+
+// Table 0315.
+func (v Table0315) Table() Table {
+	return TableLookup[`0315`]
+}
+
+// Table0316 is a value of table 0316, Organ donor.
+// Fields in this table are strings; convert a field, such as Table0316(v), to use the helpers.
+type Table0316 string
+
+const (
+	Table0316YesPatientIsADonorBut Table0316 = `F` // Yes, patient is a donor, but card is not on file
+	Table0316NoPatientDoesNotHaveA Table0316 = `I` // No, patient does not have a living will but information was provided
+	Table0316Unknown               Table0316 = `U` // Unknown
+	Table0316YesPatientIsADonorAnd Table0316 = `Y` // Yes, patient is a donor and card is on file
+)
+
+// Description of the value, or empty if the value is not in table 0316.
+func (v Table0316) Description() string {
+	return tableDescription(`0316`, string(v))
+}
+
+// Valid reports if the value is in table 0316.
+func (v Table0316) Valid() bool {
+	return TableValueLookup[`0316`][string(v)]
+}
+
+// Table 0316.
+func (v Table0316) Table() Table {
+	return TableLookup[`0316`]
+}
+
+// Table0321 is a value of table 0321, Dispense method.
+// Fields in this table are strings; convert a field, such as Table0321(v), to use the helpers.
+type Table0321 string
+
+const (
+	Table0321AutomaticDispensing Table0321 = `AD` // Automatic Dispensing
+	Table0321FloorStock          Table0321 = `F`  // Floor Stock
+	Table0321Traditional         Table0321 = `TR` // Traditional
+	Table0321UnitDose            Table0321 = `UD` // Unit Dose
+)
+
+// Description of the value, or empty if the value is not in table 0321.
+func (v Table0321) Description() string {
+	return tableDescription(`0321`, string(v))
+}
+
+// Valid reports if the value is in table 0321.
+func (v Table0321) Valid() bool {
+	return TableValueLookup[`0321`][string(v)]
+}
+
+// Table 0321.
+func (v Table0321) Table() Table {
+	return TableLookup[`0321`]
+}
+
+// Table0322 is a value of table 0322, Completion status.
+// Fields in this table are strings; convert a field, such as Table0322(v), to use the helpers.
+type Table0322 string
+
+const (
+	Table0322Complete              Table0322 = `CP` // Complete
+	Table0322NotAdministered       Table0322 = `NA` // Not Administered
+	Table0322PartiallyAdministered Table0322 = `PA` // Partially Administered
+	Table0322Refused               Table0322 = `RE` // Refused
+)
+
+// Description of the value, or empty if the value is not in table 0322.
+func (v Table0322) Description() string {
+	return tableDescription(`0322`, string(v))
+}
+
+// Valid reports if the value is in table 0322.
+func (v Table0322) Valid() bool {
+	return TableValueLookup[`0322`][string(v)]
+}
+
+// Table 0322.
+func (v Table0322) Table() Table {
+	return TableLookup[`0322`]
+}
+
+// Table0323 is a value of table 0323, Action Code.
+// Fields in this table are strings; convert a field, such as Table0323(v), to use the helpers.
+type Table0323 string
+
+const (
+	Table0323Add    Table0323 = `A` // Add
+	Table0323Delete Table0323 = `D` // Delete
+	Table0323Update Table0323 = `U` // Update
+)
+
+// Description of the value, or empty if the value is not in table 0323.
+func (v Table0323) Description() string {
+	return tableDescription(`0323`, string(v))
+}
+
+// Valid reports if the value is in table 0323.
+func (v Table0323) Valid() bool {
+	return TableValueLookup[`0323`][string(v)]
+}
+
+// Table 0323.
+func (v Table0323) Table() Table {
+	return TableLookup[`0323`]
+}
+
+// Table0324 is a value of table 0324, Location characteristic ID.
+// Fields in this table are strings; convert a field, such as Table0324(v), to use the helpers.
+type Table0324 string
+
+const (
+	Table0324GenderOfPatientS                    Table0324 = `GEN` // Gender of patient(s)
+	Table0324ImplantCanBeUsedForRadiation        Table0324 = `IMP` // Implant: can be used for radiation implant patients
+	Table0324InfectiousDiseaseThisLocationCanBe  Table0324 = `INF` // Infectious disease: this location can be used for isolation
+	Table0324LevelOfCare                         Table0324 = `LCR` // Level of care
+	Table0324Licensed                            Table0324 = `LIC` // Licensed
+	Table0324Overflow                            Table0324 = `OVR` // Overflow
+	Table0324PrivacyLevelIndicatingTheLevelOf    Table0324 = `PRL` // Privacy level: indicating the level of private versus non-private room
+	Table0324BedIsSetUp                          Table0324 = `SET` // Bed is set up
+	Table0324ShadowATemporaryHoldingLocationThat Table0324 = `SHA` // Shadow: a temporary holding location that does not physically exist
+	Table0324Smoking                             Table0324 = `SMK` // Smoking
+	Table0324BedIsStaffed                        Table0324 = `STF` // Bed is staffed
+	Table0324TeachingLocation                    Table0324 = `TEA` // Teaching location
+)
+
+// Description of the value, or empty if the value is not in table 0324.
+func (v Table0324) Description() string {
+	return tableDescription(`0324`, string(v))
+}
+
+// Valid reports if the value is in table 0324.
+func (v Table0324) Valid() bool {
+	return TableValueLookup[`0324`][string(v)]
+}
+
+// Table 0324.
+func (v Table0324) Table() Table {
+	return TableLookup[`0324`]
+}
+
+// Table0325 is a value of table 0325, Location relationship ID.
+// Fields in this table are strings; convert a field, such as Table0325(v), to use the helpers.
+type Table0325 string
+
+const (
+	Table0325LocationAliasEs Table0325 = `ALI` // Location Alias(es)
+	Table0325NearestDietary  Table0325 = `DTY` // Nearest dietary
+	Table0325NearestLab      Table0325 = `LAB` // Nearest lab
+	Table0325SecondLab       Table0325 = `LB2` // Second lab
+	Table0325ParentLocation  Table0325 = `PAR` // Parent location
+	Table0325NearestPharmacy Table0325 = `RX`  // Nearest pharmacy
+	Table0325SecondPharmacy  Table0325 = `RX2` // Second pharmacy
+)
+
+// Description of the value, or empty if the value is not in table 0325.
+func (v Table0325) Description() string {
+	return tableDescription(`0325`, string(v))
+}
+
+// Valid reports if the value is in table 0325.
+func (v Table0325) Valid() bool {
+	return TableValueLookup[`0325`][string(v)]
+}
+
+// Table 0325.
+func (v Table0325) Table() Table {
+	return TableLookup[`0325`]
+}
+
+// Table0326 is a value of table 0326, Visit indicator.
+// Fields in this table are strings; convert a field, such as Table0326(v), to use the helpers.
+type Table0326 string
+
+const (
+	Table0326AccountLevelDefault Table0326 = `A` // Account level (default)
+	Table0326VisitLevel          Table0326 = `V` // Visit level
+)
+
+// Description of the value, or empty if the value is not in table 0326.
+func (v Table0326) Description() string {
+	return tableDescription(`0326`, string(v))
+}
+
+// Valid reports if the value is in table 0326.
+func (v Table0326) Valid() bool {
+	return TableValueLookup[`0326`][string(v)]
+}
+
+// Table 0326.
+func (v Table0326) Table() Table {
+	return TableLookup[`0326`]
+}
+
+// Table0329 is a value of table 0329, Quantity method.
+// Fields in this table are strings; convert a field, such as Table0329(v), to use the helpers.
+type Table0329 string
+
+const (
+	Table0329ActualCount         Table0329 = `A` // Actual count
+	Table0329EstimatedSeeComment Table0329 = `E` // Estimated (see comment)
+)
+
+// Description of the value, or empty if the value is not in table 0329.
+func (v Table0329) Description() string {
+	return tableDescription(`0329`, string(v))
+}
+
+// Valid reports if the value is in table 0329.
+func (v Table0329) Valid() bool {
+	return TableValueLookup[`0329`][string(v)]
+}
+
+// Table 0329.
+func (v Table0329) Table() Table {
+	return TableLookup[`0329`]
+}
+
+// Table0330 is a value of table 0330, Marketing basis.
+// Fields in this table are strings; convert a field, such as Table0330(v), to use the helpers.
+type Table0330 string
+
+const (
+	Table0330510KExempt                Table0330 = `510E` // 510 (K) exempt
+	Table0330510K                      Table0330 = `510K` // 510 (K)
+	Table0330PostMarketingStudy522     Table0330 = `522S` // Post marketing study (522)
+	Table0330PremarketingAuthorization Table0330 = `PMA`  // Premarketing authorization
+	Table0330Preamendment              Table0330 = `PRE`  // Preamendment
+	Table0330Transitional              Table0330 = `TXN`  // Transitional
+)
+
+// Description of the value, or empty if the value is not in table 0330.
+func (v Table0330) Description() string {
+	return tableDescription(`0330`, string(v))
+}
+
+// Valid reports if the value is in table 0330.
+func (v Table0330) Valid() bool {
+	return TableValueLookup[`0330`][string(v)]
+}
+
+// Table 0330.
+func (v Table0330) Table() Table {
+	return TableLookup[`0330`]
+}
+
+// Table0331 is a value of table 0331, Facility type.
+// Fields in this table are strings; convert a field, such as Table0331(v), to use the helpers.
+type Table0331 string
+
+const (
+	Table0331AgentForAForeignManufacturer Table0331 = `A` // Agent for a foreign manufacturer
+	Table0331Distributor                  Table0331 = `D` // Distributor
+	Table0331Manufacturer                 Table0331 = `M` // Manufacturer
+	Table0331User                         Table0331 = `U` // User
+)
+
+// Description of the value, or empty if the value is not in table 0331.
+func (v Table0331) Description() string {
+	return tableDescription(`0331`, string(v))
+}
+
+// Valid reports if the value is in table 0331.
+func (v Table0331) Valid() bool {
+	return TableValueLookup[`0331`][string(v)]
+}
+
+// Table 0331.
+func (v Table0331) Table() Table {
+	return TableLookup[`0331`]
+}
+
+// Table0332 is a value of table 0332, Network Source Type.
+// Fields in this table are strings; convert a field, such as Table0332(v), to use the helpers.
+type Table0332 string
+
+const (
+	Table0332Accept   Table0332 = `A` // Accept
+	Table0332Initiate Table0332 = `I` // Initiate
+)
+
+// Description of the value, or empty if the value is not in table 0332.
+func (v Table0332) Description() string {
+	return tableDescription(`0332`, string(v))
+}
+
+// Valid reports if the value is in table 0332.
+func (v Table0332) Valid() bool {
+	return TableValueLookup[`0332`][string(v)]
+}
+
+// Table 0332.
+func (v Table0332) Table() Table {
+	return TableLookup[`0332`]
+}
+
+// Table0333 is a value of table 0333, Network change type.
+// Fields in this table are strings; convert a field, such as Table0333(v), to use the helpers.
+type Table0333 string
+
+const (
+	Table0333MigratesToDifferentCPU Table0333 = `M`  // Migrates to different CPU
+	Table0333ShutDown               Table0333 = `SD` // Shut down
+	Table0333StartUp                Table0333 = `SU` // Start up
+)
+
+// Description of the value, or empty if the value is not in table 0333.
+func (v Table0333) Description() string {
+	return tableDescription(`0333`, string(v))
+}
+
+// Valid reports if the value is in table 0333.
+func (v Table0333) Valid() bool {
+	return TableValueLookup[`0333`][string(v)]
+}
+
+// Table 0333.
+func (v Table0333) Table() Table {
+	return TableLookup[`0333`]
+}
+
+// Table0334 is a value of table 0334, Disabled person.
+// Fields in this table are strings; convert a field, such as Table0334(v), to use the helpers.
+type Table0334 string
+
+const (
+	Table0334AssociatedParty Table0334 = `AP` // Associated party
+	Table0334Guarantor       Table0334 = `GT` // Guarantor
+	Table0334Insured         Table0334 = `IN` // Insured
+	Table0334Patient         Table0334 = `PT` // Patient
+)
+
+// Description of the value, or empty if the value is not in table 0334.
+func (v Table0334) Description() string {
+	return tableDescription(`0334`, string(v))
+}
+
+// Valid reports if the value is in table 0334.
+func (v Table0334) Valid() bool {
+	return TableValueLookup[`0334`][string(v)]
+}
+
+// Table 0334.
+func (v Table0334) Table() Table {
+	return TableLookup[`0334`]
+}
+
+// Table0337 is a value of table 0337, Certification status.
+// Fields in this table are strings; convert a field, such as Table0337(v), to use the helpers.
+type Table0337 string
+
+const (
+	Table0337Certified Table0337 = `C` // Certified
+	Table0337Eligible  Table0337 = `E` // Eligible
+)
+
+// Description of the value, or empty if the value is not in table 0337.
+func (v Table0337) Description() string {
+	return tableDescription(`0337`, string(v))
+}
+
+// Valid reports if the value is in table 0337.
+func (v Table0337) Valid() bool {
+	return TableValueLookup[`0337`][string(v)]
+}
+
+// Table 0337.
+func (v Table0337) Table() Table {
+	return TableLookup[`0337`]
+}
+
+// Table0338 is a value of table 0338, Practitioner ID number type.
+// Fields in this table are strings; convert a field, such as Table0338(v), to use the helpers.
+type Table0338 string
+
+const (
+	Table0338CountyNumber             Table0338 = `CY`   // County number
+	Table0338DrugEnforcementAgencyNo  Table0338 = `DEA`  // Drug Enforcement Agency no.
+	Table0338GeneralLedgerNumber      Table0338 = `GL`   // General ledger number
+	Table0338LaborAndIndustriesNumber Table0338 = `L&I`  // Labor and industries number
+	Table0338MedicaidNumber           Table0338 = `MCD`  // Medicaid number
+	Table0338MedicareNumber           Table0338 = `MCR`  // Medicare number
+	Table0338QANumber                 Table0338 = `QA`   // QA number
+	Table0338StateLicenseNumber       Table0338 = `SL`   // State license number
+	Table0338TaxIDNumber              Table0338 = `TAX`  // Tax ID number
+	Table0338TrainingLicenseNumber    Table0338 = `TRL`  // Training license number
+	Table0338UniquePhysicianIDNo      Table0338 = `UPIN` // Unique physician ID no.
+)
+
+// Description of the value, or empty if the value is not in table 0338.
+func (v Table0338) Description() string {
+	return tableDescription(`0338`, string(v))
+}
+
+// Valid reports if the value is in table 0338.
+func (v Table0338) Valid() bool {
+	return TableValueLookup[`0338`][string(v)]
+}
+
+// Table 0338.
+func (v Table0338) Table() Table {
+	return TableLookup[`0338`]
+}
+
+// Table0339 is a value of table 0339, Advanced beneficiary notice code.
+// Fields in this table are strings; convert a field, such as Table0339(v), to use the helpers.
+type Table0339 string
+
+const (
+	Table0339ServiceIsSubjectToMedicalNecessity       Table0339 = `1` // Service is subject to medical necessity procedures
+	Table0339PatientHasBeenInformedOfResponsibility   Table0339 = `2` // Patient has been informed of responsibility, and agrees to pay for service
+	Table0339PatientHasBeenInformedOfResponsibility_3 Table0339 = `3` // Patient has been informed of responsibility, and asks that the payer be billed
+	Table0339AdvancedBeneficiaryNoticeHasNotBeen      Table0339 = `4` // Advanced Beneficiary Notice has not been signed
+)
+
+// Description of the value, or empty if the value is not in table 0339.
+func (v Table0339) Description() string {
+	return tableDescription(`0339`, string(v))
+}
+
+// Valid reports if the value is in table 0339.
+func (v Table0339) Valid() bool {
+	return TableValueLookup[`0339`][string(v)]
+}
+
+// Table 0339.
+func (v Table0339) Table() Table {
+	return TableLookup[`0339`]
+}
+
+// Table0344 is a value of table 0344, Patient's relationship to insured.
+// Fields in this table are strings; convert a field, such as Table0344(v), to use the helpers.
+type Table0344 string
+
+const (
+	Table0344PatientIsInsured                           Table0344 = `01` // Patient is insured
+	Table0344Spouse                                     Table0344 = `02` // Spouse
+	Table0344NaturalChildInsuredFinancialResponsibility Table0344 = `03` // Natural child/insured financial responsibility
+	Table0344NaturalChildInsuredDoesNotHave             Table0344 = `04` // Natural child/Insured does not have financial responsibility
+	Table0344StepChild                                  Table0344 = `05` // Step child
+	Table0344FosterChild                                Table0344 = `06` // Foster child
+	Table0344WardOfTheCourt                             Table0344 = `07` // Ward of the court
+	Table0344Employee                                   Table0344 = `08` // Employee
+	Table0344Unknown                                    Table0344 = `09` // Unknown
+	Table0344HandicappedDependent                       Table0344 = `10` // Handicapped dependent
+	Table0344OrganDonor                                 Table0344 = `11` // Organ donor
+	Table0344CadaverDonor                               Table0344 = `12` // Cadaver donor
+	Table0344Grandchild                                 Table0344 = `13` // Grandchild
+	Table0344NieceNephew                                Table0344 = `14` // Niece/nephew
+	Table0344InjuredPlaintiff                           Table0344 = `15` // Injured plaintiff
+	Table0344SponsoredDependent                         Table0344 = `16` // Sponsored dependent
+	Table0344MinorDependentOfAMinorDependent            Table0344 = `17` // Minor dependent of a minor dependent
+	Table0344Parent                                     Table0344 = `18` // Parent
+	Table0344Grandparent                                Table0344 = `19` // Grandparent
+)
+
+// Description of the value, or empty if the value is not in table 0344.
+func (v Table0344) Description() string {
+	return tableDescription(`0344`, string(v))
+}
+
+// Valid reports if the value is in table 0344.
+func (v Table0344) Valid() bool {
+	return TableValueLookup[`0344`][string(v)]
+}
+
+// Table 0344.
+func (v Table0344) Table() Table {
+	return TableLookup[`0344`]
+}
+
+// Table0348 is a value of table 0348, Special program indicator.
+// Fields in this table are strings; convert a field, such as Table0348(v), to use the helpers.
+type Table0348 string
+
+const (
+	Table0348EPSDTCHAP                             Table0348 = `01` // EPSDT-CHAP
+	Table0348PhysicallyHandicappedChildrenSProgram Table0348 = `02` // Physically handicapped children's program
+	Table0348SpecialFederalFunding                 Table0348 = `03` // Special federal funding
+	Table0348FamilyPlanning                        Table0348 = `04` // Family planning
+	Table0348Disability                            Table0348 = `05` // Disability
+	Table0348PPVMedicare100Payment                 Table0348 = `06` // PPV/Medicare 100% payment
+	Table0348InducedAbortionDangerToLife           Table0348 = `07` // Induced abortion-danger to life
+	Table0348InducedAbortionVictimRapeIncest       Table0348 = `08` // Induced abortion victim rape/incest
+)
+
+// Description of the value, or empty if the value is not in table 0348.
+func (v Table0348) Description() string {
+	return tableDescription(`0348`, string(v))
+}
+
+// Valid reports if the value is in table 0348.
+func (v Table0348) Valid() bool {
+	return TableValueLookup[`0348`][string(v)]
+}
+
+// Table 0348.
+func (v Table0348) Table() Table {
+	return TableLookup[`0348`]
+}
+
+// Table0349 is a value of table 0349, PSRO/UR approval indicator.
+// Fields in this table are strings; convert a field, such as Table0349(v), to use the helpers.
+type Table0349 string
+
+const (
+	Table0349ApprovedByThePSROURAs            Table0349 = `1` // Approved by the PSRO/UR as billed
+	Table0349AutomaticApprovalAsBilledBasedOn Table0349 = `2` // Automatic approval as billed based on focused review
+	Table0349PartialApproval                  Table0349 = `3` // Partial approval
+	Table0349AdmissionDenied                  Table0349 = `4` // Admission denied
+	Table0349PostpaymentReviewApplicable      Table0349 = `5` // Postpayment review applicable
+)
+
+// Description of the value, or empty if the value is not in table 0349.
+func (v Table0349) Description() string {
+	return tableDescription(`0349`, string(v))
+}
+
+// Valid reports if the value is in table 0349.
+func (v Table0349) Valid() bool {
+	return TableValueLookup[`0349`][string(v)]
+}
+
+// Table 0349.
+func (v Table0349) Table() Table {
+	return TableLookup[`0349`]
+}
+
+// Table0350 is a value of table 0350, Occurrence code.
+// Fields in this table are strings; convert a field, such as Table0350(v), to use the helpers.
+type Table0350 string
+
+const (
+	Table0350AutoAccident                                     Table0350 = `01`        // Auto accident
+	Table0350NoFaultInsuranceInvolvedIncludingAuto            Table0350 = `02`        // No fault insurance involved-including auto accident/other
+	Table0350AccidentTortLiability                            Table0350 = `03`        // Accident/tort liability
+	Table0350AccidentEmploymentRelated                        Table0350 = `04`        // Accident/employment related
+	Table0350OtherAccident                                    Table0350 = `05`        // Other accident
+	Table0350CrimeVictim                                      Table0350 = `06`        // Crime victim
+	Table0350StartOfInfertilityTreatmentCycle                 Table0350 = `09`        // Start of infertility treatment cycle
+	Table0350LastMenstrualPeriod                              Table0350 = `10`        // Last menstrual period
+	Table0350OnsetOfSymptomsIllness                           Table0350 = `11`        // Onset of symptoms/illness
+	Table0350DateOfOnsetForAChronically                       Table0350 = `12`        // Date of onset for a chronically dependent individual
+	Table0350DateOutpatientOccupationalTherapyPlanEstablished Table0350 = `17`        // Date outpatient occupational therapy plan established or last reviewed
+	Table0350DateOfRetirementPatientBeneficiary               Table0350 = `18`        // Date of retirement patient/beneficiary
+	Table0350DateOfRetirementSpouse                           Table0350 = `19`        // Date of retirement spouse
+	Table0350GuaranteeOfPaymentBegan                          Table0350 = `20`        // Guarantee of payment began
+	Table0350URNoticeReceived                                 Table0350 = `21`        // UR notice received
+	Table0350DateActiveCareEnded                              Table0350 = `22`        // Date active care ended
+	Table0350DateInsuranceDenied                              Table0350 = `24`        // Date insurance denied
+	Table0350DateBenefitsTerminatedByPrimaryPayor             Table0350 = `25`        // Date benefits terminated by primary payor
+	Table0350DateSNFBedAvailable                              Table0350 = `26`        // Date SNF bed available
+	Table0350DateHomeHealthPlanEstablished                    Table0350 = `27`        // Date home health plan established
+	Table0350SpouseSDateOfBirth                               Table0350 = `28`        // Spouse's date of birth
+	Table0350DateOutpatientPhysicalTherapyPlanEstablished     Table0350 = `29`        // Date outpatient physical therapy plan established or last reviewed
+	Table0350DateOutpatientSpeechPathologyPlanEstablished     Table0350 = `30`        // Date outpatient speech pathology plan established or last reviewed
+	Table0350DateBeneficiaryNotifiedOfIntentTo                Table0350 = `31`        // Date beneficiary notified of intent to bill (accommodations)
+	Table0350DateBeneficiaryNotifiedOfIntentTo_32             Table0350 = `32`        // Date beneficiary notified of intent to bill (procedures or treatments)
+	Table0350FirstDayOfTheMedicareCoordination                Table0350 = `33`        // First day of the Medicare coordination period for ESRD beneficiaries covered by EGHP
+	Table0350DateOfElectionOfExtendedCare                     Table0350 = `34`        // Date of election of extended care facilities
+	Table0350DateTreatmentStartedForPT                        Table0350 = `35`        // Date treatment started for P.T.
+	Table0350DateOfInpatientHospitalDischargeFor              Table0350 = `36`        // Date of inpatient hospital discharge for covered transplant patients
+	Table0350DateOfInpatientHospitalDischargeFor_37           Table0350 = `37`        // Date of inpatient hospital discharge for non-covered transplant patient
+	Table0350ScheduledDateOfAdmission                         Table0350 = `40`        // Scheduled date of admission
+	Table0350DateOfFirstTestForPre                            Table0350 = `41`        // Date of first test for pre-admission testing
+	Table0350DateOfDischarge                                  Table0350 = `42`        // Date of discharge
+	Table0350ScheduledDateOfCanceledSurgery                   Table0350 = `43`        // Scheduled date of canceled surgery
+	Table0350DateTreatmentStartedForOT                        Table0350 = `44`        // Date treatment started for O.T.
+	Table0350DateTreatmentStartedForST                        Table0350 = `45`        // Date treatment started for S.T.
+	Table0350DateTreatmentStartedForCardiacRehab              Table0350 = `46`        // Date treatment started for cardiac rehab.
+	Table0350PayerCodes                                       Table0350 = `47 ... 49` // Payer codes
+	Table0350DateLienReleased                                 Table0350 = `50`        // Date lien released
+	Table0350DateTreatmentStartedForPsychiatricCare           Table0350 = `51`        // Date treatment started for psychiatric care
+	Table0350OccurrenceSpanCodesAndDates                      Table0350 = `70 ... 99` // Occurrence span codes and dates
+	Table0350BirthdateInsuredA                                Table0350 = `A1`        // Birthdate - insured A
+	Table0350EffectiveDateInsuredAPolicy                      Table0350 = `A2`        // Effective date - insured A policy
+	Table0350BenefitsExhaustedPayerA                          Table0350 = `A3`        // Benefits exhausted payer A
+)
+
+// Description of the value, or empty if the value is not in table 0350.
+func (v Table0350) Description() string {
+	return tableDescription(`0350`, string(v))
+}
+
+// Valid reports if the value is in table 0350.
+func (v Table0350) Valid() bool {
+	return TableValueLookup[`0350`][string(v)]
+}
+
+// Table 0350.
+func (v Table0350) Table() Table {
+	return TableLookup[`0350`]
+}
+
+// Table0351 is a value of table 0351, Occurrence span.
+// Fields in this table are strings; convert a field, such as Table0351(v), to use the helpers.
+type Table0351 string
+
+const (
+	Table0351QualifyingStayDatesForSNF Table0351 = `70` // Qualifying stay dates for SNF
+	Table0351PriorStayDates            Table0351 = `71` // Prior stay dates
+	Table0351FirstLastVisit            Table0351 = `72` // First/last visit
+	Table0351BenefitEligibilityPeriod  Table0351 = `73` // Benefit eligibility period
+	Table0351NonCoveredLevelOfCare     Table0351 = `74` // Non-covered level of care
+	Table0351SNFLevelOfCare            Table0351 = `75` // SNF level of care
+	Table0351PatientLiability          Table0351 = `76` // Patient liability
+	Table0351ProviderLiabilityPeriod   Table0351 = `77` // Provider liability period
+	Table0351SNFPriorStayDates         Table0351 = `78` // SNF prior stay dates
+	Table0351PayerCode                 Table0351 = `79` // Payer code
+	Table0351PROURApprovedStayDates    Table0351 = `M0` // PRO/UR approved stay dates
+)
+
+// Description of the value, or empty if the value is not in table 0351.
+func (v Table0351) Description() string {
+	return tableDescription(`0351`, string(v))
+}
+
+// Valid reports if the value is in table 0351.
+func (v Table0351) Valid() bool {
+	return TableValueLookup[`0351`][string(v)]
+}
+
+// Table 0351.
+func (v Table0351) Table() Table {
+	return TableLookup[`0351`]
+}
+
+// Table0354 is a value of table 0354, Message structure.
+// Fields in this table are strings; convert a field, such as Table0354(v), to use the helpers.
+type Table0354 string
+
+const (
+	Table0354A01A04A05A08A13A14        Table0354 = `ADT_A01`  // A01, A04, A05, A08, A13, A14, A28, A31
+	Table0354A02A21A22A23A25A26        Table0354 = `ADT_A02`  // A02, A21, A22, A23, A25, A26, A27, A29, A32, A33
+	Table0354A03                       Table0354 = `ADT_A03`  // A03
+	Table0354A06A07                    Table0354 = `ADT_A06`  // A06, A07
+	Table0354A09A10A11A15              Table0354 = `ADT_A09`  // A09, A10, A11, A15
+	Table0354A12                       Table0354 = `ADT_A12`  // A12
+	Table0354A16                       Table0354 = `ADT_A16`  // A16
+	Table0354A17                       Table0354 = `ADT_A17`  // A17
+	Table0354A18                       Table0354 = `ADT_A18`  // A18
+	Table0354A20                       Table0354 = `ADT_A20`  // A20
+	Table0354A24                       Table0354 = `ADT_A24`  // A24
+	Table0354A28A31                    Table0354 = `ADT_A28`  // A28, A31
+	Table0354A30A34A35136A46A47        Table0354 = `ADT_A30`  // A30, A34, A35, 136, A46, A47, A48, A49
+	Table0354A37                       Table0354 = `ADT_A37`  // A37
+	Table0354A38                       Table0354 = `ADT_A38`  // A38
+	Table0354A39A40A41A42              Table0354 = `ADT_A39`  // A39, A40, A41, A42
+	Table0354A43A44                    Table0354 = `ADT_A43`  // A43, A44
+	Table0354A45                       Table0354 = `ADT_A45`  // A45
+	Table0354A50A51                    Table0354 = `ADT_A50`  // A50, A51
+	Table0354A19                       Table0354 = `ARD_A19`  // A19
+	Table0354P01P05                    Table0354 = `BAR_P01`  // P01, P05
+	Table0354P02                       Table0354 = `BAR_P02`  // P02
+	Table0354P06                       Table0354 = `BAR_P06`  // P06
+	Table0354C01C02C03C04C05C06        Table0354 = `CRM_C01`  // C01, C02, C03, C04, C05, C06, C07, C08
+	Table0354C09C10C11C12              Table0354 = `CSU_C09`  // C09, C10, C11, C12
+	Table0354P03                       Table0354 = `DFT_P03`  // P03
+	Table0354T12                       Table0354 = `DOC_T12`  // T12
+	Table0354Q01                       Table0354 = `DSR_Q01`  // Q01
+	Table0354Q03                       Table0354 = `DSR_Q03`  // Q03
+	Table0354R07                       Table0354 = `EDR_R07`  // R07
+	Table0354Q04                       Table0354 = `EQQ_Q04`  // Q04
+	Table0354R09                       Table0354 = `ERP_R09`  // R09
+	Table0354T01T03T05T07T09T11        Table0354 = `MDM_T01`  // T01, T03, T05, T07, T09, T11
+	Table0354T02T04T06T08T10           Table0354 = `MDM_T02`  // T02, T04, T06, T08, T10
+	Table0354P09                       Table0354 = `MFD_P09`  // P09
+	Table0354M01M03M05M06M07M08        Table0354 = `MFK_M01`  // M01, M03, M05, M06, M07, M08, M09, M10, M11
+	Table0354M01                       Table0354 = `MFN_M01`  // M01
+	Table0354M02                       Table0354 = `MFN_M02`  // M02
+	Table0354M03                       Table0354 = `MFN_M03`  // M03
+	Table0354M05                       Table0354 = `MFN_M05`  // M05
+	Table0354M06                       Table0354 = `MFN_M06`  // M06
+	Table0354M07                       Table0354 = `MFN_M07`  // M07
+	Table0354M08                       Table0354 = `MFN_M08`  // M08
+	Table0354M09                       Table0354 = `MFN_M09`  // M09
+	Table0354M10                       Table0354 = `MFN_M10`  // M10
+	Table0354M11                       Table0354 = `MFN_M11`  // M11
+	Table0354R02R04                    Table0354 = `ORF_R02`  // R02, R04
+	Table0354O01                       Table0354 = `ORM__O01` // O01
+	Table0354Q06                       Table0354 = `ORM_Q06`  // Q06
+	Table0354O02                       Table0354 = `ORR_O02`  // O02
+	Table0354Q06_ORRQ06                Table0354 = `ORR_Q06`  // Q06
+	Table0354R01                       Table0354 = `ORU_R01`  // R01
+	Table0354W01                       Table0354 = `ORU_W01`  // W01
+	Table0354Q06_OSQQ06                Table0354 = `OSQ_Q06`  // Q06
+	Table0354Q06_OSRQ06                Table0354 = `OSR_Q06`  // Q06
+	Table0354P07P08                    Table0354 = `PEX_P07`  // P07, P08
+	Table0354PC6PC7PC8                 Table0354 = `PGL_PC6`  // PC6, PC7, PC8
+	Table0354I07                       Table0354 = `PIN_107`  // I07
+	Table0354PCCPCHPCJ                 Table0354 = `PPG_PCG`  // PCC, PCH, PCJ
+	Table0354PCBPCD                    Table0354 = `PPP_PCB`  // PCB, PCD
+	Table0354PC1PC2PC3                 Table0354 = `PPR_PC1`  // PC1, PC2, PC3
+	Table0354PCL                       Table0354 = `PPT_PCL`  // PCL
+	Table0354PCA                       Table0354 = `PPV_PCA`  // PCA
+	Table0354PC5                       Table0354 = `PRR_PC5`  // PC5
+	Table0354PCF                       Table0354 = `PTR_PCF`  // PCF
+	Table0354Q02                       Table0354 = `QCK_Q02`  // Q02
+	Table0354A19_QRYA19                Table0354 = `QRY_A19`  // A19
+	Table0354PC4PC9PCEPCK              Table0354 = `QRY_PC4`  // PC4, PC9, PCE, PCK
+	Table0354Q01_QRYQ01                Table0354 = `QRY_Q01`  // Q01
+	Table0354Q02_QRYQ02                Table0354 = `QRY_Q02`  // Q02
+	Table0354R02R04_QRYR02             Table0354 = `QRY_R02`  // R02, R04
+	Table0354T12_QRYT12                Table0354 = `QRY_T12`  // T12
+	Table0354RAR                       Table0354 = `RAR_RAR`  // RAR
+	Table0354O01_RASO01                Table0354 = `RAS_O01`  // O01
+	Table0354O022                      Table0354 = `RAS_O02`  // O022
+	Table0354I05                       Table0354 = `RCI_I05`  // I05
+	Table0354I06                       Table0354 = `RCL_I06`  // I06
+	Table0354O01_RDEO01                Table0354 = `RDE_O01`  // O01
+	Table0354RDR                       Table0354 = `RDR_RDR`  // RDR
+	Table0354O01_RDSO01                Table0354 = `RDS_O01`  // O01
+	Table0354I12I13I14I15              Table0354 = `REF_I12`  // I12, I13, I14, I15
+	Table0354RER                       Table0354 = `RER_RER`  // RER
+	Table0354RGR                       Table0354 = `RGR_RGR`  // RGR
+	Table0354O01_RGVO01                Table0354 = `RGV_O01`  // O01
+	Table0354I08I09I101II              Table0354 = `RPA_I08`  // I08, I09. I10, 1II
+	Table0354I01I04                    Table0354 = `RPI_I0I`  // I01, I04
+	Table0354I02                       Table0354 = `RPL_I02`  // I02
+	Table0354I03                       Table0354 = `RPR_I03`  // I03
+	Table0354I08I09I10I11              Table0354 = `RQA_I08`  // I08, I09, I10, I11
+	Table0354I05_RQCI05                Table0354 = `RQC_I05`  // I05
+	Table0354I06_RQCI06                Table0354 = `RQC_I06`  // I06
+	Table0354I01I02I03                 Table0354 = `RQI_I0I`  // I01, I02, I03
+	Table0354I04                       Table0354 = `RQP_I04`  // I04
+	Table0354Q09                       Table0354 = `RQQ_Q09`  // Q09
+	Table0354O02_RRAO02                Table0354 = `RRA_O02`  // O02
+	Table0354O02_RRDO02                Table0354 = `RRD_O02`  // O02
+	Table0354O01_RREO01                Table0354 = `RRE_O01`  // O01
+	Table0354O02_RRGO02                Table0354 = `RRG_O02`  // O02
+	Table0354I12I13I14I15_RRII12       Table0354 = `RRI_I12`  // I12, I13, I14, I15
+	Table0354ROR                       Table0354 = `RROR_ROR` // ROR
+	Table0354S12S13S14S15S16S17        Table0354 = `SIIU_S12` // S12, S13, S14, S15, S16, S17, S18, S19, S20, S21, S22, S23, S24, S26
+	Table0354Q08                       Table0354 = `SPQ_Q08`  // Q08
+	Table0354S25                       Table0354 = `SQM_S25`  // S25
+	Table0354S25_SQRS25                Table0354 = `SQR_S25`  // S25
+	Table0354S01S02S03S04S05S06        Table0354 = `SRM_S01`  // S01, S02, S03, S04, S05, S06, S07, S08, S09, S10, S11
+	Table0354T12_SRMT12                Table0354 = `SRM_T12`  // T12
+	Table0354S01S02S03S04S05S06_SRRS01 Table0354 = `SRR_S01`  // S01, S02, S03, S04, S05, S06, S07, S08, S09, S10, S11
+	Table0354T12_SRRT12                Table0354 = `SRR_T12`  // T12
+	Table0354P09_SURP09                Table0354 = `SUR_P09`  // P09
+	Table0354R09_TBRR09                Table0354 = `TBR_R09`  // R09
+	Table0354Q05                       Table0354 = `UDM_Q05`  // Q05
+	Table0354Q07                       Table0354 = `VQQ_Q07`  // Q07
+	Table0354V01                       Table0354 = `VXQ_V01`  // V01
+	Table0354V03                       Table0354 = `VXR_V03`  // V03
+	Table0354V04                       Table0354 = `VXU_V04`  // V04
+	Table0354V02                       Table0354 = `VXX_V02`  // V02
+)
+
+// Description of the value, or empty if the value is not in table 0354.
+func (v Table0354) Description() string {
+	return tableDescription(`0354`, string(v))
+}
+
+// Valid reports if the value is in table 0354.
+func (v Table0354) Valid() bool {
+	return TableValueLookup[`0354`][string(v)]
+}
+
+// Table 0354.
+func (v Table0354) Table() Table {
+	return TableLookup[`0354`]
+}
+
+// Table0355 is a value of table 0355, Primary key value type.
+// Fields in this table are strings; convert a field, such as Table0355(v), to use the helpers.
+type Table0355 string
+
+const (
+	Table0355CodedElement   Table0355 = `CE` // Coded element
+	Table0355PersonLocation Table0355 = `PL` // Person location
+)
+
+// Description of the value, or empty if the value is not in table 0355.
+func (v Table0355) Description() string {
+	return tableDescription(`0355`, string(v))
+}
+
+// Valid reports if the value is in table 0355.
+func (v Table0355) Valid() bool {
+	return TableValueLookup[`0355`][string(v)]
+}
+
+// Table 0355.
+func (v Table0355) Table() Table {
+	return TableLookup[`0355`]
+}
+
+// Table0356 is a value of table 0356, Alternate character set handling scheme.
+// Fields in this table are strings; convert a field, such as Table0356(v), to use the helpers.
+type Table0356 string
+
+const (
+	Table0356ThisIsTheDefaultIndicatingThat            Table0356 = `<null>`        // This is the default, indicating that there is no character set switching occurring in this message.
+	Table0356TheCharacterSetSwitchingModeSpecified     Table0356 = `2.3`           // The character set switching mode specified in HL7 2.3, sections 2.8.28.6.1, and 2.9.2. Note that the escape sequences used in this mode do not use the ASCII "esc" character. They are "HL7 escape sequences" as defined in HL7 2.3, sec. 2.9 as defined in ISO 2022-1994 (Also, note that sections 2.8.28.6.1and 2.9.2 in HL7 2.3 correspond to sections 2.8.31.6.1and 2.9.2 in HL7 2.4.)
+	Table0356ThisStandardIsTitledInformationTechnology Table0356 = `ISO 2022-1994` // This standard is titled "Information Technology - Character Code Structure and Extension Technique". This standard specifies an escape sequence from basic one byte character set to specified other character set, and vice versa. The escape sequence explicitly specifies what alternate character set to be evoked. Note that in this mode, the actual ASCII escape character is used as defined in the referenced ISO document. As noted in 1.6.1., escape sequences to/from alternate character set should occur within HL7 delimiters. In other words, HL7 delimiters are basic one byte characters only, and just before and just after delimiters, character encoding status should be the basic one byte set.
+)
+
+// Description of the value, or empty if the value is not in table 0356.
+func (v Table0356) Description() string {
+	return tableDescription(`0356`, string(v))
+}
+
+// Valid reports if the value is in table 0356.
+func (v Table0356) Valid() bool {
+	return TableValueLookup[`0356`][string(v)]
+}
+
+// Table 0356.
+func (v Table0356) Table() Table {
+	return TableLookup[`0356`]
+}
+
+// Table0357 is a value of table 0357, Message error condition codes.
+// Fields in this table are strings; convert a field, such as Table0357(v), to use the helpers.
+type Table0357 string
+
+const (
+	Table0357MessageAccepted          Table0357 = `0`   // Message accepted
+	Table0357SegmentSequenceError     Table0357 = `100` // Segment sequence error
+	Table0357RequiredFieldMissing     Table0357 = `101` // Required field missing
+	Table0357DataTypeError            Table0357 = `102` // Data type error
+	Table0357TableValueNotFound       Table0357 = `103` // Table value not found
+	Table0357UnsupportedMessageType   Table0357 = `200` // Unsupported message type
+	Table0357UnsupportedEventCode     Table0357 = `201` // Unsupported event code
+	Table0357UnsupportedProcessingId  Table0357 = `202` // Unsupported processing id
+	Table0357UnsupportedVersionId     Table0357 = `203` // Unsupported version id
+	Table0357UnknownKeyIdentifier     Table0357 = `204` // Unknown key identifier
+	Table0357DuplicateKeyIdentifier   Table0357 = `205` // Duplicate key identifier
+	Table0357ApplicationRecordLocked  Table0357 = `206` // Application record locked
+	Table0357ApplicationInternalError Table0357 = `207` // Application internal error
+)
+
+// Description of the value, or empty if the value is not in table 0357.
+func (v Table0357) Description() string {
+	return tableDescription(`0357`, string(v))
+}
+
+// Valid reports if the value is in table 0357.
+func (v Table0357) Valid() bool {
+	return TableValueLookup[`0357`][string(v)]
+}
+
+// Table 0357.
+func (v Table0357) Table() Table {
+	return TableLookup[`0357`]
+}
+
+// Table0359 is a value of table 0359, Diagnosis priority.
+// Fields in this table are strings; convert a field, such as Table0359(v), to use the helpers.
+type Table0359 string
+
+const (
+	Table0359NotIncludedInDiagnosisRanking Table0359 = `0` // Not included in diagnosis ranking
+	Table0359ThePrimaryDiagnosis           Table0359 = `1` // The primary diagnosis
+	Table0359ForRankedSecondaryDiagnoses   Table0359 = `2` // For ranked secondary diagnoses
+)
+
+// Description of the value, or empty if the value is not in table 0359.
+func (v Table0359) Description() string {
+	return tableDescription(`0359`, string(v))
+}
+
+// Valid reports if the value is in table 0359.
+func (v Table0359) Valid() bool {
+	return TableValueLookup[`0359`][string(v)]
+}
+
+// Table 0359.
+func (v Table0359) Table() Table {
+	return TableLookup[`0359`]
+}
+
+// Table0360 is a value of table 0360, Degree.
+// Fields in this table are strings; convert a field, such as Table0360(v), to use the helpers.
+type Table0360 string
+
+const (
+	Table0360AssociateOfArts                   Table0360 = `AA`  // Associate of Arts
+	Table0360AssociateOfAppliedScience         Table0360 = `AAS` // Associate of Applied Science
+	Table0360AssociateOfBusinessAdministration Table0360 = `ABA` // Associate of Business Administration
+	Table0360AssociateOfEngineering            Table0360 = `AE`  // Associate of Engineering
+	Table0360AssociateOfScience                Table0360 = `AS`  // Associate of Science
+	Table0360BachelorOfArts                    Table0360 = `BA`  // Bachelor of Arts
+	Table0360BachelorOfBusinessAdministration  Table0360 = `BBA` // Bachelor of Business Administration
+	Table0360BachelorOrEngineering             Table0360 = `BE`  // Bachelor or Engineering
+	Table0360BachelorOfFineArts                Table0360 = `BFA` // Bachelor of Fine Arts
+	Table0360BachelorOfNursing                 Table0360 = `BN`  // Bachelor of Nursing
+	Table0360BachelorOfScience                 Table0360 = `BS`  // Bachelor of Science
+	Table0360BachelorOfScienceLaw              Table0360 = `BSL` // Bachelor of Science Law
+	Table0360BachelorOfTheology                Table0360 = `BT`  // Bachelor of Theology
+	Table0360Certificate                       Table0360 = `CER` // Certificate
+	Table0360DoctorOfBusinessAdministration    Table0360 = `DBA` // Doctor of Business Administration
+	Table0360DoctorOfEducation                 Table0360 = `DED` // Doctor of Education
+	Table0360Diploma                           Table0360 = `DIP` // Diploma
+	Table0360DoctorOfOsteopathy                Table0360 = `DO`  // Doctor of Osteopathy
+	Table0360HighSchoolGraduate                Table0360 = `HS`  // High School Graduate
+	Table0360JurisDoctor                       Table0360 = `JD`  // Juris Doctor
+	Table0360MasterOfArts                      Table0360 = `MA`  // Master of Arts
+	Table0360MasterOfBusinessAdministration    Table0360 = `MBA` // Master of Business Administration
+	Table0360MasterOfCivilEngineering          Table0360 = `MCE` // Master of Civil Engineering
+	Table0360DoctorOfMedicine                  Table0360 = `MD`  // Doctor of Medicine
+	Table0360MasterOfDivinity                  Table0360 = `MDI` // Master of Divinity
+	Table0360MasterOfEngineering               Table0360 = `ME`  // Master of Engineering
+	Table0360MasterOfEducation                 Table0360 = `MED` // Master of Education
+	Table0360MasterOfElectricalEngineering     Table0360 = `MEE` // Master of Electrical Engineering
+	Table0360MasterOfFineArts                  Table0360 = `MFA` // Master of Fine Arts
+	Table0360MasterOfMechanicalEngineering     Table0360 = `MME` // Master of Mechanical Engineering
+	Table0360MasterOfScience                   Table0360 = `MS`  // Master of Science
+	Table0360MasterOfScienceLaw                Table0360 = `MSL` // Master of Science Law
+	Table0360MasterOfTheology                  Table0360 = `MT`  // Master of Theology
+	Table0360NonGraduate                       Table0360 = `NG`  // Non-Graduate
+	Table0360DoctorOfPhilosophy                Table0360 = `PHD` // Doctor of Philosophy
+	Table0360DoctorOfEngineering               Table0360 = `PHE` // Doctor of Engineering
+	Table0360DoctorOfScience                   Table0360 = `PHS` // Doctor of Science
+	Table0360SecretarialCertificate            Table0360 = `SEC` // Secretarial Certificate
+	Table0360TradeSchoolGraduate               Table0360 = `TS`  // Trade School Graduate
+)
+
+// Description of the value, or empty if the value is not in table 0360.
+func (v Table0360) Description() string {
+	return tableDescription(`0360`, string(v))
+}
+
+// Valid reports if the value is in table 0360.
+func (v Table0360) Valid() bool {
+	return TableValueLookup[`0360`][string(v)]
+}
+
+// Table 0360.
+func (v Table0360) Table() Table {
+	return TableLookup[`0360`]
+}
+
+// Table0364 is a value of table 0364, Comment type.
+// Fields in this table are strings; convert a field, such as Table0364(v), to use the helpers.
+type Table0364 string
+
+const (
+	Table0364PrimaryReason              Table0364 = `1R` // Primary Reason
+	Table0364SecondaryReason            Table0364 = `2R` // Secondary Reason
+	Table0364AncillaryInstructions      Table0364 = `AI` // Ancillary Instructions,
+	Table0364DuplicateInteractionReason Table0364 = `DR` // Duplicate/Interaction Reason
+	Table0364GeneralInstructions        Table0364 = `GI` // General Instructions
+	Table0364GeneralReason              Table0364 = `GR` // General Reason
+	Table0364PatientInstructions        Table0364 = `PI` // Patient Instructions
+	Table0364Remark                     Table0364 = `RE` // Remark
+)
+
+// Description of the value, or empty if the value is not in table 0364.
+func (v Table0364) Description() string {
+	return tableDescription(`0364`, string(v))
+}
+
+// Valid reports if the value is in table 0364.
+func (v Table0364) Valid() bool {
+	return TableValueLookup[`0364`][string(v)]
+}
+
+// Table 0364.
+func (v Table0364) Table() Table {
+	return TableLookup[`0364`]
+}
+
+// Table4000 is a value of table 4000, Name/address representation.
+// Fields in this table are strings; convert a field, such as Table4000(v), to use the helpers.
+type Table4000 string
+
+const (
+	Table4000AlphabeticIEDefaultOrSome       Table4000 = `A` // Alphabetic (i.e., Default or some single-byte)
+	Table4000IdeographicIEKanji              Table4000 = `I` // Ideographic (i.e., Kanji)
+	Table4000PhoneticIEASCIIKatakanaHiragana Table4000 = `P` // Phonetic (i.e., ASCII, Katakana, Hiragana, etc.)
+)
+
+// Description of the value, or empty if the value is not in table 4000.
+func (v Table4000) Description() string {
+	return tableDescription(`4000`, string(v))
+}
+
+// Valid reports if the value is in table 4000.
+func (v Table4000) Valid() bool {
+	return TableValueLookup[`4000`][string(v)]
+}
+
+// Table 4000.
+func (v Table4000) Table() Table {
+	return TableLookup[`4000`]
+}
+
+// TableCity is a value of table City, City.
+// Fields in this table are strings; convert a field, such as TableCity(v), to use the helpers.
+type TableCity string
+
+const (
+	TableCityAlbuquerque               TableCity = `Albuquerque`
+	TableCityArlington                 TableCity = `Arlington`
+	TableCityAtlanta                   TableCity = `Atlanta`
+	TableCityAustin                    TableCity = `Austin`
+	TableCityBaltimore                 TableCity = `Baltimore`
+	TableCityBoston                    TableCity = `Boston`
+	TableCityCharlotte                 TableCity = `Charlotte`
+	TableCityChicago                   TableCity = `Chicago`
+	TableCityCleveland                 TableCity = `Cleveland`
+	TableCityColoradoSprings           TableCity = `Colorado Springs`
+	TableCityColumbus                  TableCity = `Columbus`
+	TableCityDallas                    TableCity = `Dallas`
+	TableCityDenver                    TableCity = `Denver`
+	TableCityDetroit                   TableCity = `Detroit`
+	TableCityElPaso                    TableCity = `El Paso`
+	TableCityFortWorth                 TableCity = `Fort Worth`
+	TableCityFresno                    TableCity = `Fresno`
+	TableCityHonolulu                  TableCity = `Honolulu`
+	TableCityHouston                   TableCity = `Houston`
+	TableCityIndianapolis              TableCity = `Indianapolis`
+	TableCityJacksonville              TableCity = `Jacksonville`
+	TableCityKansasCity                TableCity = `Kansas City`
+	TableCityLasVegas                  TableCity = `Las Vegas`
+	TableCityLongBeach                 TableCity = `Long Beach`
+	TableCityLosAngeles                TableCity = `Los Angeles`
+	TableCityLouisvilleJeffersonCounty TableCity = `Louisville-Jefferson County`
+	TableCityMemphis                   TableCity = `Memphis`
+	TableCityMesa                      TableCity = `Mesa`
+	TableCityMiami                     TableCity = `Miami`
+	TableCityMilwaukee                 TableCity = `Milwaukee`
+	TableCityMinneapolis               TableCity = `Minneapolis`
+	TableCityNashvilleDavidson         TableCity = `Nashville-Davidson`
+	TableCityNewYork                   TableCity = `New York`
+	TableCityOakland                   TableCity = `Oakland`
+	TableCityOklahomaCity              TableCity = `Oklahoma City`
+	TableCityOmaha                     TableCity = `Omaha`
+	TableCityPhiladelphia              TableCity = `Philadelphia`
+	TableCityPhoenix                   TableCity = `Phoenix`
+	TableCityPortland                  TableCity = `Portland`
+	TableCityRaleigh                   TableCity = `Raleigh`
+	TableCitySacramento                TableCity = `Sacramento`
+	TableCitySanAntonio                TableCity = `San Antonio`
+	TableCitySanDiego                  TableCity = `San Diego`
+	TableCitySanFrancisco              TableCity = `San Francisco`
+	TableCitySanJose                   TableCity = `San Jose`
+	TableCitySeattle                   TableCity = `Seattle`
+	TableCityTucson                    TableCity = `Tucson`
+	TableCityTulsa                     TableCity = `Tulsa`
+	TableCityVirginiaBeach             TableCity = `Virginia Beach`
+	TableCityWashington                TableCity = `Washington`
+)
+
+// Description of the value, or empty if the value is not in table City.
+func (v TableCity) Description() string {
+	return tableDescription(`City`, string(v))
+}
+
+// Valid reports if the value is in table City.
+func (v TableCity) Valid() bool {
+	return TableValueLookup[`City`][string(v)]
+}
+
+// Table City.
+func (v TableCity) Table() Table {
+	return TableLookup[`City`]
+}
+
+// TableFirstName is a value of table FirstName, First Name.
+// Fields in this table are strings; convert a field, such as TableFirstName(v), to use the helpers.
+type TableFirstName string
+
+const (
+	TableFirstNameAaron       TableFirstName = `Aaron`
+	TableFirstNameAbby        TableFirstName = `Abby`
+	TableFirstNameAbe         TableFirstName = `Abe`
+	TableFirstNameAbel        TableFirstName = `Abel`
+	TableFirstNameAbigail     TableFirstName = `Abigail`
+	TableFirstNameAbraham     TableFirstName = `Abraham`
+	TableFirstNameAda         TableFirstName = `Ada`
+	TableFirstNameAdam        TableFirstName = `Adam`
+	TableFirstNameAddie       TableFirstName = `Addie`
+	TableFirstNameAdela       TableFirstName = `Adela`
+	TableFirstNameAdele       TableFirstName = `Adele`
+	TableFirstNameAdolfo      TableFirstName = `Adolfo`
+	TableFirstNameAdolph      TableFirstName = `Adolph`
+	TableFirstNameAdolphus    TableFirstName = `Adolphus`
+	TableFirstNameAdrian      TableFirstName = `Adrian`
+	TableFirstNameAdrienne    TableFirstName = `Adrienne`
+	TableFirstNameAgnes       TableFirstName = `Agnes`
+	TableFirstNameAgustin     TableFirstName = `Agustin`
+	TableFirstNameAida        TableFirstName = `Aida`
+	TableFirstNameAileen      TableFirstName = `Aileen`
+	TableFirstNameAimee       TableFirstName = `Aimee`
+	TableFirstNameAl          TableFirstName = `Al`
+	TableFirstNameAlan        TableFirstName = `Alan`
+	TableFirstNameAlana       TableFirstName = `Alana`
+	TableFirstNameAlbert      TableFirstName = `Albert`
+	TableFirstNameAlberta     TableFirstName = `Alberta`
+	TableFirstNameAlberto     TableFirstName = `Alberto`
+	TableFirstNameAlden       TableFirstName = `Alden`
+	TableFirstNameAlejandro   TableFirstName = `Alejandro`
+	TableFirstNameAleta       TableFirstName = `Aleta`
+	TableFirstNameAletha      TableFirstName = `Aletha`
+	TableFirstNameAlex        TableFirstName = `Alex`
+	TableFirstNameAlexander   TableFirstName = `Alexander`
+	TableFirstNameAlexandra   TableFirstName = `Alexandra`
+	TableFirstNameAlexis      TableFirstName = `Alexis`
+	TableFirstNameAlfonso     TableFirstName = `Alfonso`
+	TableFirstNameAlfonzo     TableFirstName = `Alfonzo`
+	TableFirstNameAlford      TableFirstName = `Alford`
+	TableFirstNameAlfred      TableFirstName = `Alfred`
+	TableFirstNameAlfreda     TableFirstName = `Alfreda`
+	TableFirstNameAlfredo     TableFirstName = `Alfredo`
+	TableFirstNameAlice       TableFirstName = `Alice`
+	TableFirstNameAlicia      TableFirstName = `Alicia`
+	TableFirstNameAlisa       TableFirstName = `Alisa`
+	TableFirstNameAlison      TableFirstName = `Alison`
+	TableFirstNameAllan       TableFirstName = `Allan`
+	TableFirstNameAllen       TableFirstName = `Allen`
+	TableFirstNameAllison     TableFirstName = `Allison`
+	TableFirstNameAllyn       TableFirstName = `Allyn`
+	TableFirstNameAlma        TableFirstName = `Alma`
+	TableFirstNameAlonzo      TableFirstName = `Alonzo`
+	TableFirstNameAlphonse    TableFirstName = `Alphonse`
+	TableFirstNameAlphonso    TableFirstName = `Alphonso`
+	TableFirstNameAlta        TableFirstName = `Alta`
+	TableFirstNameAlthea      TableFirstName = `Althea`
+	TableFirstNameAlton       TableFirstName = `Alton`
+	TableFirstNameAlva        TableFirstName = `Alva`
+	TableFirstNameAlvin       TableFirstName = `Alvin`
+	TableFirstNameAlyce       TableFirstName = `Alyce`
+	TableFirstNameAmanda      TableFirstName = `Amanda`
+	TableFirstNameAmber       TableFirstName = `Amber`
+	TableFirstNameAmelia      TableFirstName = `Amelia`
+	TableFirstNameAmos        TableFirstName = `Amos`
+	TableFirstNameAmy         TableFirstName = `Amy`
+	TableFirstNameAna         TableFirstName = `Ana`
+	TableFirstNameAnderson    TableFirstName = `Anderson`
+	TableFirstNameAndre       TableFirstName = `Andre`
+	TableFirstNameAndrea      TableFirstName = `Andrea`
+	TableFirstNameAndres      TableFirstName = `Andres`
+	TableFirstNameAndrew      TableFirstName = `Andrew`
+	TableFirstNameAndy        TableFirstName = `Andy`
+	TableFirstNameAngel       TableFirstName = `Angel`
+	TableFirstNameAngela      TableFirstName = `Angela`
+	TableFirstNameAngelia     TableFirstName = `Angelia`
+	TableFirstNameAngelina    TableFirstName = `Angelina`
+	TableFirstNameAngeline    TableFirstName = `Angeline`
+	TableFirstNameAngelita    TableFirstName = `Angelita`
+	TableFirstNameAngelo      TableFirstName = `Angelo`
+	TableFirstNameAngie       TableFirstName = `Angie`
+	TableFirstNameAnita       TableFirstName = `Anita`
+	TableFirstNameAnn         TableFirstName = `Ann`
+	TableFirstNameAnna        TableFirstName = `Anna`
+	TableFirstNameAnne        TableFirstName = `Anne`
+	TableFirstNameAnnemarie   TableFirstName = `Annemarie`
+	TableFirstNameAnnetta     TableFirstName = `Annetta`
+	TableFirstNameAnnette     TableFirstName = `Annette`
+	TableFirstNameAnnie       TableFirstName = `Annie`
+	TableFirstNameAnnmarie    TableFirstName = `Annmarie`
+	TableFirstNameAnthony     TableFirstName = `Anthony`
+	TableFirstNameAntionette  TableFirstName = `Antionette`
+	TableFirstNameAntoinette  TableFirstName = `Antoinette`
+	TableFirstNameAnton       TableFirstName = `Anton`
+	TableFirstNameAntonia     TableFirstName = `Antonia`
+	TableFirstNameAntonio     TableFirstName = `Antonio`
+	TableFirstNameApril       TableFirstName = `April`
+	TableFirstNameArchie      TableFirstName = `Archie`
+	TableFirstNameArden       TableFirstName = `Arden`
+	TableFirstNameArleen      TableFirstName = `Arleen`
+	TableFirstNameArlen       TableFirstName = `Arlen`
+	TableFirstNameArlene      TableFirstName = `Arlene`
+	TableFirstNameArlie       TableFirstName = `Arlie`
+	TableFirstNameArmand      TableFirstName = `Armand`
+	TableFirstNameArmando     TableFirstName = `Armando`
+	TableFirstNameArnold      TableFirstName = `Arnold`
+	TableFirstNameArnulfo     TableFirstName = `Arnulfo`
+	TableFirstNameArt         TableFirstName = `Art`
+	TableFirstNameArthur      TableFirstName = `Arthur`
+	TableFirstNameArtie       TableFirstName = `Artie`
+	TableFirstNameArtis       TableFirstName = `Artis`
+	TableFirstNameArturo      TableFirstName = `Arturo`
+	TableFirstNameAshley      TableFirstName = `Ashley`
+	TableFirstNameAthena      TableFirstName = `Athena`
+	TableFirstNameAubrey      TableFirstName = `Aubrey`
+	TableFirstNameAudie       TableFirstName = `Audie`
+	TableFirstNameAudrey      TableFirstName = `Audrey`
+	TableFirstNameAugust      TableFirstName = `August`
+	TableFirstNameAugustine   TableFirstName = `Augustine`
+	TableFirstNameAugustus    TableFirstName = `Augustus`
+	TableFirstNameAurelio     TableFirstName = `Aurelio`
+	TableFirstNameAurora      TableFirstName = `Aurora`
+	TableFirstNameAustin      TableFirstName = `Austin`
+	TableFirstNameAva         TableFirstName = `Ava`
+	TableFirstNameAvery       TableFirstName = `Avery`
+	TableFirstNameAvis        TableFirstName = `Avis`
+	TableFirstNameBambi       TableFirstName = `Bambi`
+	TableFirstNameBarbara     TableFirstName = `Barbara`
+	TableFirstNameBarbra      TableFirstName = `Barbra`
+	TableFirstNameBarney      TableFirstName = `Barney`
+	TableFirstNameBarrett     TableFirstName = `Barrett`
+	TableFirstNameBarry       TableFirstName = `Barry`
+	TableFirstNameBart        TableFirstName = `Bart`
+	TableFirstNameBarton      TableFirstName = `Barton`
+	TableFirstNameBasil       TableFirstName = `Basil`
+	TableFirstNameBeatrice    TableFirstName = `Beatrice`
+	TableFirstNameBecky       TableFirstName = `Becky`
+	TableFirstNameBelinda     TableFirstName = `Belinda`
+	TableFirstNameBen         TableFirstName = `Ben`
+	TableFirstNameBenedict    TableFirstName = `Benedict`
+	TableFirstNameBenita      TableFirstName = `Benita`
+	TableFirstNameBenito      TableFirstName = `Benito`
+	TableFirstNameBenjamin    TableFirstName = `Benjamin`
+	TableFirstNameBennett     TableFirstName = `Bennett`
+	TableFirstNameBennie      TableFirstName = `Bennie`
+	TableFirstNameBenny       TableFirstName = `Benny`
+	TableFirstNameBenton      TableFirstName = `Benton`
+	TableFirstNameBernadette  TableFirstName = `Bernadette`
+	TableFirstNameBernadine   TableFirstName = `Bernadine`
+	TableFirstNameBernard     TableFirstName = `Bernard`
+	TableFirstNameBernice     TableFirstName = `Bernice`
+	TableFirstNameBernie      TableFirstName = `Bernie`
+	TableFirstNameBerry       TableFirstName = `Berry`
+	TableFirstNameBert        TableFirstName = `Bert`
+	TableFirstNameBertha      TableFirstName = `Bertha`
+	TableFirstNameBertram     TableFirstName = `Bertram`
+	TableFirstNameBeryl       TableFirstName = `Beryl`
+	TableFirstNameBessie      TableFirstName = `Bessie`
+	TableFirstNameBeth        TableFirstName = `Beth`
+	TableFirstNameBethany     TableFirstName = `Bethany`
+	TableFirstNameBetsy       TableFirstName = `Betsy`
+	TableFirstNameBette       TableFirstName = `Bette`
+	TableFirstNameBettie      TableFirstName = `Bettie`
+	TableFirstNameBetty       TableFirstName = `Betty`
+	TableFirstNameBettye      TableFirstName = `Bettye`
+	TableFirstNameBeulah      TableFirstName = `Beulah`
+	TableFirstNameBeverley    TableFirstName = `Beverley`
+	TableFirstNameBeverly     TableFirstName = `Beverly`
+	TableFirstNameBill        TableFirstName = `Bill`
+	TableFirstNameBillie      TableFirstName = `Billie`
+	TableFirstNameBilly       TableFirstName = `Billy`
+	TableFirstNameBlaine      TableFirstName = `Blaine`
+	TableFirstNameBlair       TableFirstName = `Blair`
+	TableFirstNameBlake       TableFirstName = `Blake`
+	TableFirstNameBlanca      TableFirstName = `Blanca`
+	TableFirstNameBlanche     TableFirstName = `Blanche`
+	TableFirstNameBlane       TableFirstName = `Blane`
+	TableFirstNameBob         TableFirstName = `Bob`
+	TableFirstNameBobbi       TableFirstName = `Bobbi`
+	TableFirstNameBobbie      TableFirstName = `Bobbie`
+	TableFirstNameBobby       TableFirstName = `Bobby`
+	TableFirstNameBonita      TableFirstName = `Bonita`
+	TableFirstNameBonnie      TableFirstName = `Bonnie`
+	TableFirstNameBonny       TableFirstName = `Bonny`
+	TableFirstNameBooker      TableFirstName = `Booker`
+	TableFirstNameBoyce       TableFirstName = `Boyce`
+	TableFirstNameBoyd        TableFirstName = `Boyd`
+	TableFirstNameBrad        TableFirstName = `Brad`
+	TableFirstNameBradford    TableFirstName = `Bradford`
+	TableFirstNameBradley     TableFirstName = `Bradley`
+	TableFirstNameBradly      TableFirstName = `Bradly`
+	TableFirstNameBrady       TableFirstName = `Brady`
+	TableFirstNameBrandon     TableFirstName = `Brandon`
+	TableFirstNameBrant       TableFirstName = `Brant`
+	TableFirstNameBrenda      TableFirstName = `Brenda`
+	TableFirstNameBrendan     TableFirstName = `Brendan`
+	TableFirstNameBrent       TableFirstName = `Brent`
+	TableFirstNameBret        TableFirstName = `Bret`
+	TableFirstNameBrett       TableFirstName = `Brett`
+	TableFirstNameBrian       TableFirstName = `Brian`
+	TableFirstNameBrice       TableFirstName = `Brice`
+	TableFirstNameBridget     TableFirstName = `Bridget`
+	TableFirstNameBritt       TableFirstName = `Britt`
+	TableFirstNameBrooks      TableFirstName = `Brooks`
+	TableFirstNameBruce       TableFirstName = `Bruce`
+	TableFirstNameBruno       TableFirstName = `Bruno`
+	TableFirstNameBryan       TableFirstName = `Bryan`
+	TableFirstNameBryant      TableFirstName = `Bryant`
+	TableFirstNameBryce       TableFirstName = `Bryce`
+	TableFirstNameBryon       TableFirstName = `Bryon`
+	TableFirstNameBud         TableFirstName = `Bud`
+	TableFirstNameBuddy       TableFirstName = `Buddy`
+	TableFirstNameBuford      TableFirstName = `Buford`
+	TableFirstNameBurl        TableFirstName = `Burl`
+	TableFirstNameBurt        TableFirstName = `Burt`
+	TableFirstNameBurton      TableFirstName = `Burton`
+	TableFirstNameBuster      TableFirstName = `Buster`
+	TableFirstNameButch       TableFirstName = `Butch`
+	TableFirstNameByron       TableFirstName = `Byron`
+	TableFirstNameCallie      TableFirstName = `Callie`
+	TableFirstNameCalvin      TableFirstName = `Calvin`
+	TableFirstNameCameron     TableFirstName = `Cameron`
+	TableFirstNameCamilla     TableFirstName = `Camilla`
+	TableFirstNameCamille     TableFirstName = `Camille`
+	TableFirstNameCandace     TableFirstName = `Candace`
+	TableFirstNameCandice     TableFirstName = `Candice`
+	TableFirstNameCandy       TableFirstName = `Candy`
+	TableFirstNameCara        TableFirstName = `Cara`
+	TableFirstNameCaren       TableFirstName = `Caren`
+	TableFirstNameCarey       TableFirstName = `Carey`
+	TableFirstNameCarl        TableFirstName = `Carl`
+	TableFirstNameCarla       TableFirstName = `Carla`
+	TableFirstNameCarleen     TableFirstName = `Carleen`
+	TableFirstNameCarlene     TableFirstName = `Carlene`
+	TableFirstNameCarleton    TableFirstName = `Carleton`
+	TableFirstNameCarlo       TableFirstName = `Carlo`
+	TableFirstNameCarlos      TableFirstName = `Carlos`
+	TableFirstNameCarlotta    TableFirstName = `Carlotta`
+	TableFirstNameCarlton     TableFirstName = `Carlton`
+	TableFirstNameCarmel      TableFirstName = `Carmel`
+	TableFirstNameCarmela     TableFirstName = `Carmela`
+	TableFirstNameCarmella    TableFirstName = `Carmella`
+	TableFirstNameCarmelo     TableFirstName = `Carmelo`
+	TableFirstNameCarmen      TableFirstName = `Carmen`
+	TableFirstNameCarmine     TableFirstName = `Carmine`
+	TableFirstNameCarnell     TableFirstName = `Carnell`
+	TableFirstNameCarol       TableFirstName = `Carol`
+	TableFirstNameCarole      TableFirstName = `Carole`
+	TableFirstNameCarolina    TableFirstName = `Carolina`
+	TableFirstNameCaroline    TableFirstName = `Caroline`
+	TableFirstNameCarolyn     TableFirstName = `Carolyn`
+	TableFirstNameCaron       TableFirstName = `Caron`
+	TableFirstNameCarrie      TableFirstName = `Carrie`
+	TableFirstNameCarroll     TableFirstName = `Carroll`
+	TableFirstNameCarson      TableFirstName = `Carson`
+	TableFirstNameCarter      TableFirstName = `Carter`
+	TableFirstNameCary        TableFirstName = `Cary`
+	TableFirstNameCaryl       TableFirstName = `Caryl`
+	TableFirstNameCaryn       TableFirstName = `Caryn`
+	TableFirstNameCasey       TableFirstName = `Casey`
+	TableFirstNameCassandra   TableFirstName = `Cassandra`
+	TableFirstNameCatharine   TableFirstName = `Catharine`
+	TableFirstNameCatherine   TableFirstName = `Catherine`
+	TableFirstNameCathey      TableFirstName = `Cathey`
+	TableFirstNameCathie      TableFirstName = `Cathie`
+	TableFirstNameCathleen    TableFirstName = `Cathleen`
+	TableFirstNameCathrine    TableFirstName = `Cathrine`
+	TableFirstNameCathryn     TableFirstName = `Cathryn`
+	TableFirstNameCathy       TableFirstName = `Cathy`
+	TableFirstNameCecelia     TableFirstName = `Cecelia`
+	TableFirstNameCecil       TableFirstName = `Cecil`
+	TableFirstNameCecile      TableFirstName = `Cecile`
+	TableFirstNameCecilia     TableFirstName = `Cecilia`
+	TableFirstNameCedric      TableFirstName = `Cedric`
+	TableFirstNameCeleste     TableFirstName = `Celeste`
+	TableFirstNameCelestine   TableFirstName = `Celestine`
+	TableFirstNameCelia       TableFirstName = `Celia`
+	TableFirstNameCesar       TableFirstName = `Cesar`
+	TableFirstNameChad        TableFirstName = `Chad`
+	TableFirstNameCharla      TableFirstName = `Charla`
+	TableFirstNameCharleen    TableFirstName = `Charleen`
+	TableFirstNameCharlene    TableFirstName = `Charlene`
+	TableFirstNameCharles     TableFirstName = `Charles`
+	TableFirstNameCharley     TableFirstName = `Charley`
+	TableFirstNameCharlie     TableFirstName = `Charlie`
+	TableFirstNameCharlotte   TableFirstName = `Charlotte`
+	TableFirstNameCharmaine   TableFirstName = `Charmaine`
+	TableFirstNameChauncey    TableFirstName = `Chauncey`
+	TableFirstNameCheri       TableFirstName = `Cheri`
+	TableFirstNameCherie      TableFirstName = `Cherie`
+	TableFirstNameCherri      TableFirstName = `Cherri`
+	TableFirstNameCherrie     TableFirstName = `Cherrie`
+	TableFirstNameCherry      TableFirstName = `Cherry`
+	TableFirstNameCheryl      TableFirstName = `Cheryl`
+	TableFirstNameCheryle     TableFirstName = `Cheryle`
+	TableFirstNameCheryll     TableFirstName = `Cheryll`
+	TableFirstNameChester     TableFirstName = `Chester`
+	TableFirstNameChip        TableFirstName = `Chip`
+	TableFirstNameChris       TableFirstName = `Chris`
+	TableFirstNameChrista     TableFirstName = `Christa`
+	TableFirstNameChristi     TableFirstName = `Christi`
+	TableFirstNameChristian   TableFirstName = `Christian`
+	TableFirstNameChristie    TableFirstName = `Christie`
+	TableFirstNameChristina   TableFirstName = `Christina`
+	TableFirstNameChristine   TableFirstName = `Christine`
+	TableFirstNameChristophe  TableFirstName = `Christophe`
+	TableFirstNameChristopher TableFirstName = `Christopher`
+	TableFirstNameChristy     TableFirstName = `Christy`
+	TableFirstNameChuck       TableFirstName = `Chuck`
+	TableFirstNameCinda       TableFirstName = `Cinda`
+	TableFirstNameCindi       TableFirstName = `Cindi`
+	TableFirstNameCindy       TableFirstName = `Cindy`
+	TableFirstNameClair       TableFirstName = `Clair`
+	TableFirstNameClaire      TableFirstName = `Claire`
+	TableFirstNameClara       TableFirstName = `Clara`
+	TableFirstNameClare       TableFirstName = `Clare`
+	TableFirstNameClarence    TableFirstName = `Clarence`
+	TableFirstNameClarice     TableFirstName = `Clarice`
+	TableFirstNameClarissa    TableFirstName = `Clarissa`
+	TableFirstNameClark       TableFirstName = `Clark`
+	TableFirstNameClarke      TableFirstName = `Clarke`
+	TableFirstNameClaud       TableFirstName = `Claud`
+	TableFirstNameClaude      TableFirstName = `Claude`
+	TableFirstNameClaudette   TableFirstName = `Claudette`
+	TableFirstNameClaudia     TableFirstName = `Claudia`
+	TableFirstNameClay        TableFirstName = `Clay`
+	TableFirstNameClayton     TableFirstName = `Clayton`
+	TableFirstNameClement     TableFirstName = `Clement`
+	TableFirstNameCleo        TableFirstName = `Cleo`
+	TableFirstNameCletus      TableFirstName = `Cletus`
+	TableFirstNameCleve       TableFirstName = `Cleve`
+	TableFirstNameCleveland   TableFirstName = `Cleveland`
+	TableFirstNameCliff       TableFirstName = `Cliff`
+	TableFirstNameClifford    TableFirstName = `Clifford`
+	TableFirstNameClifton     TableFirstName = `Clifton`
+	TableFirstNameClint       TableFirstName = `Clint`
+	TableFirstNameClinton     TableFirstName = `Clinton`
+	TableFirstNameClyde       TableFirstName = `Clyde`
+	TableFirstNameCody        TableFirstName = `Cody`
+	TableFirstNameCole        TableFirstName = `Cole`
+	TableFirstNameColeen      TableFirstName = `Coleen`
+	TableFirstNameColeman     TableFirstName = `Coleman`
+	TableFirstNameColette     TableFirstName = `Colette`
+	TableFirstNameColin       TableFirstName = `Colin`
+	TableFirstNameColleen     TableFirstName = `Colleen`
+	TableFirstNameCollette    TableFirstName = `Collette`
+	TableFirstNameColumbus    TableFirstName = `Columbus`
+	TableFirstNameConcetta    TableFirstName = `Concetta`
+	TableFirstNameConnie      TableFirstName = `Connie`
+	TableFirstNameConrad      TableFirstName = `Conrad`
+	TableFirstNameConstance   TableFirstName = `Constance`
+	TableFirstNameConsuelo    TableFirstName = `Consuelo`
+	TableFirstNameCora        TableFirstName = `Cora`
+	TableFirstNameCordell     TableFirstName = `Cordell`
+	TableFirstNameCorey       TableFirstName = `Corey`
+	TableFirstNameCorine      TableFirstName = `Corine`
+	TableFirstNameCorinne     TableFirstName = `Corinne`
+	TableFirstNameCorliss     TableFirstName = `Corliss`
+	TableFirstNameCornelia    TableFirstName = `Cornelia`
+	TableFirstNameCornelius   TableFirstName = `Cornelius`
+	TableFirstNameCornell     TableFirstName = `Cornell`
+	TableFirstNameCorrine     TableFirstName = `Corrine`
+	TableFirstNameCory        TableFirstName = `Cory`
+	TableFirstNameCourtney    TableFirstName = `Courtney`
+	TableFirstNameCoy         TableFirstName = `Coy`
+	TableFirstNameCraig       TableFirstName = `Craig`
+	TableFirstNameCris        TableFirstName = `Cris`
+	TableFirstNameCristina    TableFirstName = `Cristina`
+	TableFirstNameCruz        TableFirstName = `Cruz`
+	TableFirstNameCrystal     TableFirstName = `Crystal`
+	TableFirstNameCurt        TableFirstName = `Curt`
+	TableFirstNameCurtis      TableFirstName = `Curtis`
+	TableFirstNameCurtiss     TableFirstName = `Curtiss`
+	TableFirstNameCynthia     TableFirstName = `Cynthia`
+	TableFirstNameCyril       TableFirstName = `Cyril`
+	TableFirstNameCyrus       TableFirstName = `Cyrus`
+	TableFirstNameDaisy       TableFirstName = `Daisy`
+	TableFirstNameDale        TableFirstName = `Dale`
+	TableFirstNameDallas      TableFirstName = `Dallas`
+	TableFirstNameDalton      TableFirstName = `Dalton`
+	TableFirstNameDamian      TableFirstName = `Damian`
+	TableFirstNameDamon       TableFirstName = `Damon`
+	TableFirstNameDan         TableFirstName = `Dan`
+	TableFirstNameDana        TableFirstName = `Dana`
+	TableFirstNameDane        TableFirstName = `Dane`
+	TableFirstNameDanette     TableFirstName = `Danette`
+	TableFirstNameDanial      TableFirstName = `Danial`
+	TableFirstNameDaniel      TableFirstName = `Daniel`
+	TableFirstNameDanielle    TableFirstName = `Danielle`
+	TableFirstNameDanita      TableFirstName = `Danita`
+	TableFirstNameDann        TableFirstName = `Dann`
+	TableFirstNameDanna       TableFirstName = `Danna`
+	TableFirstNameDannie      TableFirstName = `Dannie`
+	TableFirstNameDanny       TableFirstName = `Danny`
+	TableFirstNameDante       TableFirstName = `Dante`
+	TableFirstNameDaphne      TableFirstName = `Daphne`
+	TableFirstNameDarcy       TableFirstName = `Darcy`
+	TableFirstNameDarell      TableFirstName = `Darell`
+	TableFirstNameDaria       TableFirstName = `Daria`
+	TableFirstNameDarius      TableFirstName = `Darius`
+	TableFirstNameDarla       TableFirstName = `Darla`
+	TableFirstNameDarleen     TableFirstName = `Darleen`
+	TableFirstNameDarlene     TableFirstName = `Darlene`
+	TableFirstNameDarnell     TableFirstName = `Darnell`
+	TableFirstNameDarold      TableFirstName = `Darold`
+	TableFirstNameDarrel      TableFirstName = `Darrel`
+	TableFirstNameDarrell     TableFirstName = `Darrell`
+	TableFirstNameDarryl      TableFirstName = `Darryl`
+	TableFirstNameDarwin      TableFirstName = `Darwin`
+	TableFirstNameDaryl       TableFirstName = `Daryl`
+	TableFirstNameDaryle      TableFirstName = `Daryle`
+	TableFirstNameDave        TableFirstName = `Dave`
+	TableFirstNameDavey       TableFirstName = `Davey`
+	TableFirstNameDavid       TableFirstName = `David`
+	TableFirstNameDavie       TableFirstName = `Davie`
+	TableFirstNameDavis       TableFirstName = `Davis`
+	TableFirstNameDavy        TableFirstName = `Davy`
+	TableFirstNameDawn        TableFirstName = `Dawn`
+	TableFirstNameDayna       TableFirstName = `Dayna`
+	TableFirstNameDean        TableFirstName = `Dean`
+	TableFirstNameDeana       TableFirstName = `Deana`
+	TableFirstNameDeann       TableFirstName = `Deann`
+	TableFirstNameDeanna      TableFirstName = `Deanna`
+	TableFirstNameDeanne      TableFirstName = `Deanne`
+	TableFirstNameDebbi       TableFirstName = `Debbi`
+	TableFirstNameDebbie      TableFirstName = `Debbie`
+	TableFirstNameDebbra      TableFirstName = `Debbra`
+	TableFirstNameDebby       TableFirstName = `Debby`
+	TableFirstNameDebi        TableFirstName = `Debi`
+	TableFirstNameDebora      TableFirstName = `Debora`
+	TableFirstNameDeborah     TableFirstName = `Deborah`
+	TableFirstNameDebra       TableFirstName = `Debra`
+	TableFirstNameDebrah      TableFirstName = `Debrah`
+	TableFirstNameDebroah     TableFirstName = `Debroah`
+	TableFirstNameDee         TableFirstName = `Dee`
+	TableFirstNameDeena       TableFirstName = `Deena`
+	TableFirstNameDeidre      TableFirstName = `Deidre`
+	TableFirstNameDeirdre     TableFirstName = `Deirdre`
+	TableFirstNameDel         TableFirstName = `Del`
+	TableFirstNameDelbert     TableFirstName = `Delbert`
+	TableFirstNameDelia       TableFirstName = `Delia`
+	TableFirstNameDelilah     TableFirstName = `Delilah`
+	TableFirstNameDell        TableFirstName = `Dell`
+	TableFirstNameDella       TableFirstName = `Della`
+	TableFirstNameDelma       TableFirstName = `Delma`
+	TableFirstNameDelmar      TableFirstName = `Delmar`
+	TableFirstNameDelmer      TableFirstName = `Delmer`
+	TableFirstNameDelois      TableFirstName = `Delois`
+	TableFirstNameDelores     TableFirstName = `Delores`
+	TableFirstNameDeloris     TableFirstName = `Deloris`
+	TableFirstNameDelphine    TableFirstName = `Delphine`
+	TableFirstNameDelton      TableFirstName = `Delton`
+	TableFirstNameDemetrius   TableFirstName = `Demetrius`
+	TableFirstNameDena        TableFirstName = `Dena`
+	TableFirstNameDenice      TableFirstName = `Denice`
+	TableFirstNameDenis       TableFirstName = `Denis`
+	TableFirstNameDenise      TableFirstName = `Denise`
+	TableFirstNameDennie      TableFirstName = `Dennie`
+	TableFirstNameDennis      TableFirstName = `Dennis`
+	TableFirstNameDenny       TableFirstName = `Denny`
+	TableFirstNameDenver      TableFirstName = `Denver`
+	TableFirstNameDerek       TableFirstName = `Derek`
+	TableFirstNameDerrell     TableFirstName = `Derrell`
+	TableFirstNameDerrick     TableFirstName = `Derrick`
+	TableFirstNameDesiree     TableFirstName = `Desiree`
+	TableFirstNameDesmond     TableFirstName = `Desmond`
+	TableFirstNameDewayne     TableFirstName = `Dewayne`
+	TableFirstNameDewey       TableFirstName = `Dewey`
+	TableFirstNameDewitt      TableFirstName = `Dewitt`
+	TableFirstNameDexter      TableFirstName = `Dexter`
+	TableFirstNameDian        TableFirstName = `Dian`
+	TableFirstNameDiana       TableFirstName = `Diana`
+	TableFirstNameDiane       TableFirstName = `Diane`
+	TableFirstNameDiann       TableFirstName = `Diann`
+	TableFirstNameDianna      TableFirstName = `Dianna`
+	TableFirstNameDianne      TableFirstName = `Dianne`
+	TableFirstNameDick        TableFirstName = `Dick`
+	TableFirstNameDickie      TableFirstName = `Dickie`
+	TableFirstNameDina        TableFirstName = `Dina`
+	TableFirstNameDinah       TableFirstName = `Dinah`
+	TableFirstNameDino        TableFirstName = `Dino`
+	TableFirstNameDirk        TableFirstName = `Dirk`
+	TableFirstNameDixie       TableFirstName = `Dixie`
+	TableFirstNameDollie      TableFirstName = `Dollie`
+	TableFirstNameDolly       TableFirstName = `Dolly`
+	TableFirstNameDolores     TableFirstName = `Dolores`
+	TableFirstNameDomenic     TableFirstName = `Domenic`
+	TableFirstNameDomingo     TableFirstName = `Domingo`
+	TableFirstNameDominic     TableFirstName = `Dominic`
+	TableFirstNameDominick    TableFirstName = `Dominick`
+	TableFirstNameDon         TableFirstName = `Don`
+	TableFirstNameDona        TableFirstName = `Dona`
+	TableFirstNameDonald      TableFirstName = `Donald`
+	TableFirstNameDonell      TableFirstName = `Donell`
+	TableFirstNameDonita      TableFirstName = `Donita`
+	TableFirstNameDonn        TableFirstName = `Donn`
+	TableFirstNameDonna       TableFirstName = `Donna`
+	TableFirstNameDonnell     TableFirstName = `Donnell`
+	TableFirstNameDonnie      TableFirstName = `Donnie`
+	TableFirstNameDonny       TableFirstName = `Donny`
+	TableFirstNameDonovan     TableFirstName = `Donovan`
+	TableFirstNameDora        TableFirstName = `Dora`
+	TableFirstNameDorcas      TableFirstName = `Dorcas`
+	TableFirstNameDoreen      TableFirstName = `Doreen`
+	TableFirstNameDorene      TableFirstName = `Dorene`
+	TableFirstNameDoretha     TableFirstName = `Doretha`
+	TableFirstNameDorian      TableFirstName = `Dorian`
+	TableFirstNameDorinda     TableFirstName = `Dorinda`
+	TableFirstNameDoris       TableFirstName = `Doris`
+	TableFirstNameDorothea    TableFirstName = `Dorothea`
+	TableFirstNameDorothy     TableFirstName = `Dorothy`
+	TableFirstNameDorsey      TableFirstName = `Dorsey`
+	TableFirstNameDorthy      TableFirstName = `Dorthy`
+	TableFirstNameDottie      TableFirstName = `Dottie`
+	TableFirstNameDoug        TableFirstName = `Doug`
+	TableFirstNameDouglas     TableFirstName = `Douglas`
+	TableFirstNameDouglass    TableFirstName = `Douglass`
+	TableFirstNameDoyle       TableFirstName = `Doyle`
+	TableFirstNameDrew        TableFirstName = `Drew`
+	TableFirstNameDuane       TableFirstName = `Duane`
+	TableFirstNameDudley      TableFirstName = `Dudley`
+	TableFirstNameDuke        TableFirstName = `Duke`
+	TableFirstNameDuncan      TableFirstName = `Duncan`
+	TableFirstNameDusty       TableFirstName = `Dusty`
+	TableFirstNameDuwayne     TableFirstName = `Duwayne`
+	TableFirstNameDwain       TableFirstName = `Dwain`
+	TableFirstNameDwaine      TableFirstName = `Dwaine`
+	TableFirstNameDwayne      TableFirstName = `Dwayne`
+	TableFirstNameDwight      TableFirstName = `Dwight`
+	TableFirstNameEarl        TableFirstName = `Earl`
+	TableFirstNameEarle       TableFirstName = `Earle`
+	TableFirstNameEarlene     TableFirstName = `Earlene`
+	TableFirstNameEarline     TableFirstName = `Earline`
+	TableFirstNameEarnest     TableFirstName = `Earnest`
+	TableFirstNameEarnestine  TableFirstName = `Earnestine`
+	TableFirstNameEartha      TableFirstName = `Eartha`
+	TableFirstNameEd          TableFirstName = `Ed`
+	TableFirstNameEddie       TableFirstName = `Eddie`
+	TableFirstNameEddy        TableFirstName = `Eddy`
+	TableFirstNameEdgar       TableFirstName = `Edgar`
+	TableFirstNameEdith       TableFirstName = `Edith`
+	TableFirstNameEdmund      TableFirstName = `Edmund`
+	TableFirstNameEdna        TableFirstName = `Edna`
+	TableFirstNameEduardo     TableFirstName = `Eduardo`
+	TableFirstNameEdward      TableFirstName = `Edward`
+	TableFirstNameEdwardo     TableFirstName = `Edwardo`
+	TableFirstNameEdwin       TableFirstName = `Edwin`
+	TableFirstNameEdwina      TableFirstName = `Edwina`
+	TableFirstNameEffie       TableFirstName = `Effie`
+	TableFirstNameEfrain      TableFirstName = `Efrain`
+	TableFirstNameEileen      TableFirstName = `Eileen`
+	TableFirstNameElaine      TableFirstName = `Elaine`
+	TableFirstNameElbert      TableFirstName = `Elbert`
+	TableFirstNameEldon       TableFirstName = `Eldon`
+	TableFirstNameEldridge    TableFirstName = `Eldridge`
+	TableFirstNameEleanor     TableFirstName = `Eleanor`
+	TableFirstNameElena       TableFirstName = `Elena`
+	TableFirstNameEli         TableFirstName = `Eli`
+	TableFirstNameElias       TableFirstName = `Elias`
+	TableFirstNameElijah      TableFirstName = `Elijah`
+	TableFirstNameElisa       TableFirstName = `Elisa`
+	TableFirstNameElisabeth   TableFirstName = `Elisabeth`
+	TableFirstNameElise       TableFirstName = `Elise`
+	TableFirstNameEliseo      TableFirstName = `Eliseo`
+	TableFirstNameElissa      TableFirstName = `Elissa`
+	TableFirstNameEliza       TableFirstName = `Eliza`
+	TableFirstNameElizabeth   TableFirstName = `Elizabeth`
+	TableFirstNameElla        TableFirstName = `Ella`
+	TableFirstNameEllen       TableFirstName = `Ellen`
+	TableFirstNameElliot      TableFirstName = `Elliot`
+	TableFirstNameElliott     TableFirstName = `Elliott`
+	TableFirstNameEllis       TableFirstName = `Ellis`
+	TableFirstNameElma        TableFirstName = `Elma`
+	TableFirstNameElmer       TableFirstName = `Elmer`
+	TableFirstNameElmo        TableFirstName = `Elmo`
+	TableFirstNameElnora      TableFirstName = `Elnora`
+	TableFirstNameEloise      TableFirstName = `Eloise`
+	TableFirstNameEloy        TableFirstName = `Eloy`
+	TableFirstNameElroy       TableFirstName = `Elroy`
+	TableFirstNameElsa        TableFirstName = `Elsa`
+	TableFirstNameElsie       TableFirstName = `Elsie`
+	TableFirstNameElton       TableFirstName = `Elton`
+	TableFirstNameElva        TableFirstName = `Elva`
+	TableFirstNameElvin       TableFirstName = `Elvin`
+	TableFirstNameElvira      TableFirstName = `Elvira`
+	TableFirstNameElvis       TableFirstName = `Elvis`
+	TableFirstNameElwood      TableFirstName = `Elwood`
+	TableFirstNameElyse       TableFirstName = `Elyse`
+	TableFirstNameEmanuel     TableFirstName = `Emanuel`
+	TableFirstNameEmerson     TableFirstName = `Emerson`
+	TableFirstNameEmery       TableFirstName = `Emery`
+	TableFirstNameEmil        TableFirstName = `Emil`
+	TableFirstNameEmilio      TableFirstName = `Emilio`
+	TableFirstNameEmily       TableFirstName = `Emily`
+	TableFirstNameEmma        TableFirstName = `Emma`
+	TableFirstNameEmmanuel    TableFirstName = `Emmanuel`
+	TableFirstNameEmmett      TableFirstName = `Emmett`
+	TableFirstNameEmmitt      TableFirstName = `Emmitt`
+	TableFirstNameEmory       TableFirstName = `Emory`
+	TableFirstNameEnoch       TableFirstName = `Enoch`
+	TableFirstNameEnrique     TableFirstName = `Enrique`
+	TableFirstNameEric        TableFirstName = `Eric`
+	TableFirstNameErica       TableFirstName = `Erica`
+	TableFirstNameErich       TableFirstName = `Erich`
+	TableFirstNameErick       TableFirstName = `Erick`
+	TableFirstNameErik        TableFirstName = `Erik`
+	TableFirstNameErin        TableFirstName = `Erin`
+	TableFirstNameErma        TableFirstName = `Erma`
+	TableFirstNameErnest      TableFirstName = `Ernest`
+	TableFirstNameErnestine   TableFirstName = `Ernestine`
+	TableFirstNameErnesto     TableFirstName = `Ernesto`
+	TableFirstNameErnie       TableFirstName = `Ernie`
+	TableFirstNameErrol       TableFirstName = `Errol`
+	TableFirstNameErvin       TableFirstName = `Ervin`
+	TableFirstNameErwin       TableFirstName = `Erwin`
+	TableFirstNameEsmeralda   TableFirstName = `Esmeralda`
+	TableFirstNameEsperanza   TableFirstName = `Esperanza`
+	TableFirstNameEssie       TableFirstName = `Essie`
+	TableFirstNameEsteban     TableFirstName = `Esteban`
+	TableFirstNameEstela      TableFirstName = `Estela`
+	TableFirstNameEstella     TableFirstName = `Estella`
+	TableFirstNameEstelle     TableFirstName = `Estelle`
+	TableFirstNameEster       TableFirstName = `Ester`
+	TableFirstNameEsther      TableFirstName = `Esther`
+	TableFirstNameEthel       TableFirstName = `Ethel`
+	TableFirstNameEtta        TableFirstName = `Etta`
+	TableFirstNameEugene      TableFirstName = `Eugene`
+	TableFirstNameEugenia     TableFirstName = `Eugenia`
+	TableFirstNameEula        TableFirstName = `Eula`
+	TableFirstNameEunice      TableFirstName = `Eunice`
+	TableFirstNameEva         TableFirstName = `Eva`
+	TableFirstNameEvan        TableFirstName = `Evan`
+	TableFirstNameEvangeline  TableFirstName = `Evangeline`
+	TableFirstNameEve         TableFirstName = `Eve`
+	TableFirstNameEvelyn      TableFirstName = `Evelyn`
+	TableFirstNameEverett     TableFirstName = `Everett`
+	TableFirstNameEverette    TableFirstName = `Everette`
+	TableFirstNameEzra        TableFirstName = `Ezra`
+	TableFirstNameFaith       TableFirstName = `Faith`
+	TableFirstNameFannie      TableFirstName = `Fannie`
+	TableFirstNameFaron       TableFirstName = `Faron`
+	TableFirstNameFarrell     TableFirstName = `Farrell`
+	TableFirstNameFay         TableFirstName = `Fay`
+	TableFirstNameFaye        TableFirstName = `Faye`
+	TableFirstNameFederico    TableFirstName = `Federico`
+	TableFirstNameFelecia     TableFirstName = `Felecia`
+	TableFirstNameFelicia     TableFirstName = `Felicia`
+	TableFirstNameFelipe      TableFirstName = `Felipe`
+	TableFirstNameFelix       TableFirstName = `Felix`
+	TableFirstNameFelton      TableFirstName = `Felton`
+	TableFirstNameFerdinand   TableFirstName = `Ferdinand`
+	TableFirstNameFern        TableFirstName = `Fern`
+	TableFirstNameFernando    TableFirstName = `Fernando`
+	TableFirstNameFidel       TableFirstName = `Fidel`
+	TableFirstNameFletcher    TableFirstName = `Fletcher`
+	TableFirstNameFlora       TableFirstName = `Flora`
+	TableFirstNameFlorence    TableFirstName = `Florence`
+	TableFirstNameFlorine     TableFirstName = `Florine`
+	TableFirstNameFloyd       TableFirstName = `Floyd`
+	TableFirstNameForest      TableFirstName = `Forest`
+	TableFirstNameForrest     TableFirstName = `Forrest`
+	TableFirstNameFoster      TableFirstName = `Foster`
+	TableFirstNameFran        TableFirstName = `Fran`
+	TableFirstNameFrances     TableFirstName = `Frances`
+	TableFirstNameFrancesca   TableFirstName = `Francesca`
+	TableFirstNameFrancine    TableFirstName = `Francine`
+	TableFirstNameFrancis     TableFirstName = `Francis`
+	TableFirstNameFrancisco   TableFirstName = `Francisco`
+	TableFirstNameFrank       TableFirstName = `Frank`
+	TableFirstNameFrankie     TableFirstName = `Frankie`
+	TableFirstNameFranklin    TableFirstName = `Franklin`
+	TableFirstNameFranklyn    TableFirstName = `Franklyn`
+	TableFirstNameFred        TableFirstName = `Fred`
+	TableFirstNameFreda       TableFirstName = `Freda`
+	TableFirstNameFreddie     TableFirstName = `Freddie`
+	TableFirstNameFreddy      TableFirstName = `Freddy`
+	TableFirstNameFrederic    TableFirstName = `Frederic`
+	TableFirstNameFrederick   TableFirstName = `Frederick`
+	TableFirstNameFredric     TableFirstName = `Fredric`
+	TableFirstNameFredrick    TableFirstName = `Fredrick`
+	TableFirstNameFreeman     TableFirstName = `Freeman`
+	TableFirstNameFreida      TableFirstName = `Freida`
+	TableFirstNameFrieda      TableFirstName = `Frieda`
+	TableFirstNameFritz       TableFirstName = `Fritz`
+	TableFirstNameGabriel     TableFirstName = `Gabriel`
+	TableFirstNameGail        TableFirstName = `Gail`
+	TableFirstNameGale        TableFirstName = `Gale`
+	TableFirstNameGalen       TableFirstName = `Galen`
+	TableFirstNameGarland     TableFirstName = `Garland`
+	TableFirstNameGarold      TableFirstName = `Garold`
+	TableFirstNameGarrett     TableFirstName = `Garrett`
+	TableFirstNameGarry       TableFirstName = `Garry`
+	TableFirstNameGarth       TableFirstName = `Garth`
+	TableFirstNameGary        TableFirstName = `Gary`
+	TableFirstNameGavin       TableFirstName = `Gavin`
+	TableFirstNameGay         TableFirstName = `Gay`
+	TableFirstNameGaye        TableFirstName = `Gaye`
+	TableFirstNameGayla       TableFirstName = `Gayla`
+	TableFirstNameGayle       TableFirstName = `Gayle`
+	TableFirstNameGaylon      TableFirstName = `Gaylon`
+	TableFirstNameGaylord     TableFirstName = `Gaylord`
+	TableFirstNameGearld      TableFirstName = `Gearld`
+	TableFirstNameGeary       TableFirstName = `Geary`
+	TableFirstNameGena        TableFirstName = `Gena`
+	TableFirstNameGene        TableFirstName = `Gene`
+	TableFirstNameGeneva      TableFirstName = `Geneva`
+	TableFirstNameGenevieve   TableFirstName = `Genevieve`
+	TableFirstNameGeoffrey    TableFirstName = `Geoffrey`
+	TableFirstNameGeorge      TableFirstName = `George`
+	TableFirstNameGeorgette   TableFirstName = `Georgette`
+	TableFirstNameGeorgia     TableFirstName = `Georgia`
+	TableFirstNameGeorgina    TableFirstName = `Georgina`
+	TableFirstNameGerald      TableFirstName = `Gerald`
+	TableFirstNameGeraldine   TableFirstName = `Geraldine`
+	TableFirstNameGeralyn     TableFirstName = `Geralyn`
+	TableFirstNameGerard      TableFirstName = `Gerard`
+	TableFirstNameGerardo     TableFirstName = `Gerardo`
+	TableFirstNameGeri        TableFirstName = `Geri`
+	TableFirstNameGerri       TableFirstName = `Gerri`
+	TableFirstNameGerry       TableFirstName = `Gerry`
+	TableFirstNameGertrude    TableFirstName = `Gertrude`
+	TableFirstNameGil         TableFirstName = `Gil`
+	TableFirstNameGilbert     TableFirstName = `Gilbert`
+	TableFirstNameGilberto    TableFirstName = `Gilberto`
+	TableFirstNameGilda       TableFirstName = `Gilda`
+	TableFirstNameGiles       TableFirstName = `Giles`
+	TableFirstNameGina        TableFirstName = `Gina`
+	TableFirstNameGinger      TableFirstName = `Ginger`
+	TableFirstNameGino        TableFirstName = `Gino`
+	TableFirstNameGisele      TableFirstName = `Gisele`
+	TableFirstNameGladys      TableFirstName = `Gladys`
+	TableFirstNameGlen        TableFirstName = `Glen`
+	TableFirstNameGlenda      TableFirstName = `Glenda`
+	TableFirstNameGlenn       TableFirstName = `Glenn`
+	TableFirstNameGlenna      TableFirstName = `Glenna`
+	TableFirstNameGlinda      TableFirstName = `Glinda`
+	TableFirstNameGloria      TableFirstName = `Gloria`
+	TableFirstNameGlynn       TableFirstName = `Glynn`
+	TableFirstNameGoldie      TableFirstName = `Goldie`
+	TableFirstNameGordon      TableFirstName = `Gordon`
+	TableFirstNameGrace       TableFirstName = `Grace`
+	TableFirstNameGracie      TableFirstName = `Gracie`
+	TableFirstNameGraciela    TableFirstName = `Graciela`
+	TableFirstNameGrady       TableFirstName = `Grady`
+	TableFirstNameGraham      TableFirstName = `Graham`
+	TableFirstNameGrant       TableFirstName = `Grant`
+	TableFirstNameGreg        TableFirstName = `Greg`
+	TableFirstNameGregg       TableFirstName = `Gregg`
+	TableFirstNameGreggory    TableFirstName = `Greggory`
+	TableFirstNameGregorio    TableFirstName = `Gregorio`
+	TableFirstNameGregory     TableFirstName = `Gregory`
+	TableFirstNameGreta       TableFirstName = `Greta`
+	TableFirstNameGretchen    TableFirstName = `Gretchen`
+	TableFirstNameGrover      TableFirstName = `Grover`
+	TableFirstNameGuadalupe   TableFirstName = `Guadalupe`
+	TableFirstNameGuillermo   TableFirstName = `Guillermo`
+	TableFirstNameGus         TableFirstName = `Gus`
+	TableFirstNameGustavo     TableFirstName = `Gustavo`
+	TableFirstNameGuy         TableFirstName = `Guy`
+	TableFirstNameGwen        TableFirstName = `Gwen`
+	TableFirstNameGwendolyn   TableFirstName = `Gwendolyn`
+	TableFirstNameHal         TableFirstName = `Hal`
+	TableFirstNameHank        TableFirstName = `Hank`
+	TableFirstNameHannah      TableFirstName = `Hannah`
+	TableFirstNameHans        TableFirstName = `Hans`
+	TableFirstNameHarlan      TableFirstName = `Harlan`
+	TableFirstNameHarley      TableFirstName = `Harley`
+	TableFirstNameHarmon      TableFirstName = `Harmon`
+	TableFirstNameHarold      TableFirstName = `Harold`
+	TableFirstNameHarriet     TableFirstName = `Harriet`
+	TableFirstNameHarriett    TableFirstName = `Harriett`
+	TableFirstNameHarris      TableFirstName = `Harris`
+	TableFirstNameHarrison    TableFirstName = `Harrison`
+	TableFirstNameHarry       TableFirstName = `Harry`
+	TableFirstNameHarvey      TableFirstName = `Harvey`
+	TableFirstNameHattie      TableFirstName = `Hattie`
+	TableFirstNameHayward     TableFirstName = `Hayward`
+	TableFirstNameHaywood     TableFirstName = `Haywood`
+	TableFirstNameHazel       TableFirstName = `Hazel`
+	TableFirstNameHeather     TableFirstName = `Heather`
+	TableFirstNameHector      TableFirstName = `Hector`
+	TableFirstNameHeidi       TableFirstName = `Heidi`
+	TableFirstNameHelen       TableFirstName = `Helen`
+	TableFirstNameHelena      TableFirstName = `Helena`
+	TableFirstNameHelene      TableFirstName = `Helene`
+	TableFirstNameHenrietta   TableFirstName = `Henrietta`
+	TableFirstNameHenry       TableFirstName = `Henry`
+	TableFirstNameHerbert     TableFirstName = `Herbert`
+	TableFirstNameHeriberto   TableFirstName = `Heriberto`
+	TableFirstNameHerman      TableFirstName = `Herman`
+	TableFirstNameHerschel    TableFirstName = `Herschel`
+	TableFirstNameHershel     TableFirstName = `Hershel`
+	TableFirstNameHilary      TableFirstName = `Hilary`
+	TableFirstNameHilda       TableFirstName = `Hilda`
+	TableFirstNameHilton      TableFirstName = `Hilton`
+	TableFirstNameHiram       TableFirstName = `Hiram`
+	TableFirstNameHollis      TableFirstName = `Hollis`
+	TableFirstNameHolly       TableFirstName = `Holly`
+	TableFirstNameHomer       TableFirstName = `Homer`
+	TableFirstNameHope        TableFirstName = `Hope`
+	TableFirstNameHorace      TableFirstName = `Horace`
+	TableFirstNameHosea       TableFirstName = `Hosea`
+	TableFirstNameHouston     TableFirstName = `Houston`
+	TableFirstNameHoward      TableFirstName = `Howard`
+	TableFirstNameHoyt        TableFirstName = `Hoyt`
+	TableFirstNameHubert      TableFirstName = `Hubert`
+	TableFirstNameHuey        TableFirstName = `Huey`
+	TableFirstNameHugh        TableFirstName = `Hugh`
+	TableFirstNameHugo        TableFirstName = `Hugo`
+	TableFirstNameHumberto    TableFirstName = `Humberto`
+	TableFirstNameIan         TableFirstName = `Ian`
+	TableFirstNameIda         TableFirstName = `Ida`
+	TableFirstNameIgnacio     TableFirstName = `Ignacio`
+	TableFirstNameIke         TableFirstName = `Ike`
+	TableFirstNameIlene       TableFirstName = `Ilene`
+	TableFirstNameImogene     TableFirstName = `Imogene`
+	TableFirstNameIna         TableFirstName = `Ina`
+	TableFirstNameInez        TableFirstName = `Inez`
+	TableFirstNameIngrid      TableFirstName = `Ingrid`
+	TableFirstNameIra         TableFirstName = `Ira`
+	TableFirstNameIrene       TableFirstName = `Irene`
+	TableFirstNameIris        TableFirstName = `Iris`
+	TableFirstNameIrma        TableFirstName = `Irma`
+	TableFirstNameIrvin       TableFirstName = `Irvin`
+	TableFirstNameIrving      TableFirstName = `Irving`
+	TableFirstNameIrwin       TableFirstName = `Irwin`
+	TableFirstNameIsaac       TableFirstName = `Isaac`
+	TableFirstNameIsabel      TableFirstName = `Isabel`
+	TableFirstNameIsaiah      TableFirstName = `Isaiah`
+	TableFirstNameIsiah       TableFirstName = `Isiah`
+	TableFirstNameIsmael      TableFirstName = `Ismael`
+	TableFirstNameIsrael      TableFirstName = `Israel`
+	TableFirstNameIssac       TableFirstName = `Issac`
+	TableFirstNameIva         TableFirstName = `Iva`
+	TableFirstNameIvan        TableFirstName = `Ivan`
+	TableFirstNameIvory       TableFirstName = `Ivory`
+	TableFirstNameIvy         TableFirstName = `Ivy`
+	TableFirstNameJacalyn     TableFirstName = `Jacalyn`
+	TableFirstNameJack        TableFirstName = `Jack`
+	TableFirstNameJackie      TableFirstName = `Jackie`
+	TableFirstNameJacklyn     TableFirstName = `Jacklyn`
+	TableFirstNameJackson     TableFirstName = `Jackson`
+	TableFirstNameJacky       TableFirstName = `Jacky`
+	TableFirstNameJacob       TableFirstName = `Jacob`
+	TableFirstNameJacque      TableFirstName = `Jacque`
+	TableFirstNameJacqueline  TableFirstName = `Jacqueline`
+	TableFirstNameJacquelyn   TableFirstName = `Jacquelyn`
+	TableFirstNameJacques     TableFirstName = `Jacques`
+	TableFirstNameJacquline   TableFirstName = `Jacquline`
+	TableFirstNameJaime       TableFirstName = `Jaime`
+	TableFirstNameJake        TableFirstName = `Jake`
+	TableFirstNameJame        TableFirstName = `Jame`
+	TableFirstNameJames       TableFirstName = `James`
+	TableFirstNameJamie       TableFirstName = `Jamie`
+	TableFirstNameJan         TableFirstName = `Jan`
+	TableFirstNameJana        TableFirstName = `Jana`
+	TableFirstNameJane        TableFirstName = `Jane`
+	TableFirstNameJaneen      TableFirstName = `Janeen`
+	TableFirstNameJanell      TableFirstName = `Janell`
+	TableFirstNameJanelle     TableFirstName = `Janelle`
+	TableFirstNameJanet       TableFirstName = `Janet`
+	TableFirstNameJanette     TableFirstName = `Janette`
+	TableFirstNameJanice      TableFirstName = `Janice`
+	TableFirstNameJanie       TableFirstName = `Janie`
+	TableFirstNameJanine      TableFirstName = `Janine`
+	TableFirstNameJanis       TableFirstName = `Janis`
+	TableFirstNameJann        TableFirstName = `Jann`
+	TableFirstNameJanna       TableFirstName = `Janna`
+	TableFirstNameJannette    TableFirstName = `Jannette`
+	TableFirstNameJannie      TableFirstName = `Jannie`
+	TableFirstNameJared       TableFirstName = `Jared`
+	TableFirstNameJarvis      TableFirstName = `Jarvis`
+	TableFirstNameJason       TableFirstName = `Jason`
+	TableFirstNameJasper      TableFirstName = `Jasper`
+	TableFirstNameJavier      TableFirstName = `Javier`
+	TableFirstNameJay         TableFirstName = `Jay`
+	TableFirstNameJaye        TableFirstName = `Jaye`
+	TableFirstNameJayne       TableFirstName = `Jayne`
+	TableFirstNameJean        TableFirstName = `Jean`
+	TableFirstNameJeanette    TableFirstName = `Jeanette`
+	TableFirstNameJeanie      TableFirstName = `Jeanie`
+	TableFirstNameJeanine     TableFirstName = `Jeanine`
+	TableFirstNameJeanne      TableFirstName = `Jeanne`
+	TableFirstNameJeannette   TableFirstName = `Jeannette`
+	TableFirstNameJeannie     TableFirstName = `Jeannie`
+	TableFirstNameJeannine    TableFirstName = `Jeannine`
+	TableFirstNameJed         TableFirstName = `Jed`
+	TableFirstNameJeff        TableFirstName = `Jeff`
+	TableFirstNameJefferey    TableFirstName = `Jefferey`
+	TableFirstNameJefferson   TableFirstName = `Jefferson`
+	TableFirstNameJeffery     TableFirstName = `Jeffery`
+	TableFirstNameJeffry      TableFirstName = `Jeffry`
+	TableFirstNameJenifer     TableFirstName = `Jenifer`
+	TableFirstNameJennie      TableFirstName = `Jennie`
+	TableFirstNameJennifer    TableFirstName = `Jennifer`
+	TableFirstNameJenny       TableFirstName = `Jenny`
+	TableFirstNameJerald      TableFirstName = `Jerald`
+	TableFirstNameJere        TableFirstName = `Jere`
+	TableFirstNameJeremiah    TableFirstName = `Jeremiah`
+	TableFirstNameJeremy      TableFirstName = `Jeremy`
+	TableFirstNameJeri        TableFirstName = `Jeri`
+	TableFirstNameJerilyn     TableFirstName = `Jerilyn`
+	TableFirstNameJerold      TableFirstName = `Jerold`
+	TableFirstNameJerome      TableFirstName = `Jerome`
+	TableFirstNameJerri       TableFirstName = `Jerri`
+	TableFirstNameJerrie      TableFirstName = `Jerrie`
+	TableFirstNameJerrold     TableFirstName = `Jerrold`
+	TableFirstNameJerry       TableFirstName = `Jerry`
+	TableFirstNameJeryl       TableFirstName = `Jeryl`
+	TableFirstNameJess        TableFirstName = `Jess`
+	TableFirstNameJesse       TableFirstName = `Jesse`
+	TableFirstNameJessica     TableFirstName = `Jessica`
+	TableFirstNameJessie      TableFirstName = `Jessie`
+	TableFirstNameJesus       TableFirstName = `Jesus`
+	TableFirstNameJewel       TableFirstName = `Jewel`
+	TableFirstNameJewell      TableFirstName = `Jewell`
+	TableFirstNameJill        TableFirstName = `Jill`
+	TableFirstNameJim         TableFirstName = `Jim`
+	TableFirstNameJimmie      TableFirstName = `Jimmie`
+	TableFirstNameJimmy       TableFirstName = `Jimmy`
+	TableFirstNameJo          TableFirstName = `Jo`
+	TableFirstNameJoan        TableFirstName = `Joan`
+	TableFirstNameJoanie      TableFirstName = `Joanie`
+	TableFirstNameJoann       TableFirstName = `Joann`
+	TableFirstNameJoanna      TableFirstName = `Joanna`
+	TableFirstNameJoanne      TableFirstName = `Joanne`
+	TableFirstNameJoaquin     TableFirstName = `Joaquin`
+	TableFirstNameJocelyn     TableFirstName = `Jocelyn`
+	TableFirstNameJodi        TableFirstName = `Jodi`
+	TableFirstNameJodie       TableFirstName = `Jodie`
+	TableFirstNameJody        TableFirstName = `Jody`
+	TableFirstNameJoe         TableFirstName = `Joe`
+	TableFirstNameJoel        TableFirstName = `Joel`
+	TableFirstNameJoellen     TableFirstName = `Joellen`
+	TableFirstNameJoesph      TableFirstName = `Joesph`
+	TableFirstNameJoette      TableFirstName = `Joette`
+	TableFirstNameJoey        TableFirstName = `Joey`
+	TableFirstNameJohanna     TableFirstName = `Johanna`
+	TableFirstNameJohn        TableFirstName = `John`
+	TableFirstNameJohnathan   TableFirstName = `Johnathan`
+	TableFirstNameJohnie      TableFirstName = `Johnie`
+	TableFirstNameJohnnie     TableFirstName = `Johnnie`
+	TableFirstNameJohnny      TableFirstName = `Johnny`
+	TableFirstNameJoleen      TableFirstName = `Joleen`
+	TableFirstNameJolene      TableFirstName = `Jolene`
+	TableFirstNameJon         TableFirstName = `Jon`
+	TableFirstNameJonas       TableFirstName = `Jonas`
+	TableFirstNameJonathan    TableFirstName = `Jonathan`
+	TableFirstNameJonathon    TableFirstName = `Jonathon`
+	TableFirstNameJoni        TableFirstName = `Joni`
+	TableFirstNameJordan      TableFirstName = `Jordan`
+	TableFirstNameJorge       TableFirstName = `Jorge`
+	TableFirstNameJose        TableFirstName = `Jose`
+	TableFirstNameJosefina    TableFirstName = `Josefina`
+	TableFirstNameJoseph      TableFirstName = `Joseph`
+	TableFirstNameJosephine   TableFirstName = `Josephine`
+	TableFirstNameJoshua      TableFirstName = `Joshua`
+	TableFirstNameJosie       TableFirstName = `Josie`
+	TableFirstNameJoy         TableFirstName = `Joy`
+	TableFirstNameJoyce       TableFirstName = `Joyce`
+	TableFirstNameJoycelyn    TableFirstName = `Joycelyn`
+	TableFirstNameJuan        TableFirstName = `Juan`
+	TableFirstNameJuana       TableFirstName = `Juana`
+	TableFirstNameJuanita     TableFirstName = `Juanita`
+	TableFirstNameJude        TableFirstName = `Jude`
+	TableFirstNameJudi        TableFirstName = `Judi`
+	TableFirstNameJudith      TableFirstName = `Judith`
+	TableFirstNameJudson      TableFirstName = `Judson`
+	TableFirstNameJudy        TableFirstName = `Judy`
+	TableFirstNameJules       TableFirstName = `Jules`
+	TableFirstNameJulia       TableFirstName = `Julia`
+	TableFirstNameJulian      TableFirstName = `Julian`
+	TableFirstNameJuliana     TableFirstName = `Juliana`
+	TableFirstNameJuliann     TableFirstName = `Juliann`
+	TableFirstNameJulianne    TableFirstName = `Julianne`
+	TableFirstNameJulie       TableFirstName = `Julie`
+	TableFirstNameJuliet      TableFirstName = `Juliet`
+	TableFirstNameJuliette    TableFirstName = `Juliette`
+	TableFirstNameJulio       TableFirstName = `Julio`
+	TableFirstNameJulius      TableFirstName = `Julius`
+	TableFirstNameJune        TableFirstName = `June`
+	TableFirstNameJunior      TableFirstName = `Junior`
+	TableFirstNameJustin      TableFirstName = `Justin`
+	TableFirstNameJustine     TableFirstName = `Justine`
+	TableFirstNameKandy       TableFirstName = `Kandy`
+	TableFirstNameKaran       TableFirstName = `Karan`
+	TableFirstNameKaren       TableFirstName = `Karen`
+	TableFirstNameKari        TableFirstName = `Kari`
+	TableFirstNameKarin       TableFirstName = `Karin`
+	TableFirstNameKarl        TableFirstName = `Karl`
+	TableFirstNameKarla       TableFirstName = `Karla`
+	TableFirstNameKarol       TableFirstName = `Karol`
+	TableFirstNameKaron       TableFirstName = `Karon`
+	TableFirstNameKaryn       TableFirstName = `Karyn`
+	TableFirstNameKate        TableFirstName = `Kate`
+	TableFirstNameKathaleen   TableFirstName = `Kathaleen`
+	TableFirstNameKatharine   TableFirstName = `Katharine`
+	TableFirstNameKatherine   TableFirstName = `Katherine`
+	TableFirstNameKatheryn    TableFirstName = `Katheryn`
+	TableFirstNameKathi       TableFirstName = `Kathi`
+	TableFirstNameKathie      TableFirstName = `Kathie`
+	TableFirstNameKathleen    TableFirstName = `Kathleen`
+	TableFirstNameKathrine    TableFirstName = `Kathrine`
+	TableFirstNameKathryn     TableFirstName = `Kathryn`
+	TableFirstNameKathy       TableFirstName = `Kathy`
+	TableFirstNameKatie       TableFirstName = `Katie`
+	TableFirstNameKatrina     TableFirstName = `Katrina`
+	TableFirstNameKay         TableFirstName = `Kay`
+	TableFirstNameKaye        TableFirstName = `Kaye`
+	TableFirstNameKeith       TableFirstName = `Keith`
+	TableFirstNameKelley      TableFirstName = `Kelley`
+	TableFirstNameKelly       TableFirstName = `Kelly`
+	TableFirstNameKelvin      TableFirstName = `Kelvin`
+	TableFirstNameKen         TableFirstName = `Ken`
+	TableFirstNameKendall     TableFirstName = `Kendall`
+	TableFirstNameKendra      TableFirstName = `Kendra`
+	TableFirstNameKenneth     TableFirstName = `Kenneth`
+	TableFirstNameKennith     TableFirstName = `Kennith`
+	TableFirstNameKenny       TableFirstName = `Kenny`
+	TableFirstNameKent        TableFirstName = `Kent`
+	TableFirstNameKenton      TableFirstName = `Kenton`
+	TableFirstNameKermit      TableFirstName = `Kermit`
+	TableFirstNameKerri       TableFirstName = `Kerri`
+	TableFirstNameKerry       TableFirstName = `Kerry`
+	TableFirstNameKevan       TableFirstName = `Kevan`
+	TableFirstNameKeven       TableFirstName = `Keven`
+	TableFirstNameKevin       TableFirstName = `Kevin`
+	TableFirstNameKim         TableFirstName = `Kim`
+	TableFirstNameKimberlee   TableFirstName = `Kimberlee`
+	TableFirstNameKimberley   TableFirstName = `Kimberley`
+	TableFirstNameKimberly    TableFirstName = `Kimberly`
+	TableFirstNameKing        TableFirstName = `King`
+	TableFirstNameKip         TableFirstName = `Kip`
+	TableFirstNameKirby       TableFirstName = `Kirby`
+	TableFirstNameKirk        TableFirstName = `Kirk`
+	TableFirstNameKirt        TableFirstName = `Kirt`
+	TableFirstNameKit         TableFirstName = `Kit`
+	TableFirstNameKitty       TableFirstName = `Kitty`
+	TableFirstNameKraig       TableFirstName = `Kraig`
+	TableFirstNameKris        TableFirstName = `Kris`
+	TableFirstNameKrista      TableFirstName = `Krista`
+	TableFirstNameKristen     TableFirstName = `Kristen`
+	TableFirstNameKristi      TableFirstName = `Kristi`
+	TableFirstNameKristie     TableFirstName = `Kristie`
+	TableFirstNameKristin     TableFirstName = `Kristin`
+	TableFirstNameKristina    TableFirstName = `Kristina`
+	TableFirstNameKristine    TableFirstName = `Kristine`
+	TableFirstNameKristy      TableFirstName = `Kristy`
+	TableFirstNameKurt        TableFirstName = `Kurt`
+	TableFirstNameKurtis      TableFirstName = `Kurtis`
+	TableFirstNameKyle        TableFirstName = `Kyle`
+	TableFirstNameLacy        TableFirstName = `Lacy`
+	TableFirstNameLadonna     TableFirstName = `Ladonna`
+	TableFirstNameLafayette   TableFirstName = `Lafayette`
+	TableFirstNameLamar       TableFirstName = `Lamar`
+	TableFirstNameLamont      TableFirstName = `Lamont`
+	TableFirstNameLana        TableFirstName = `Lana`
+	TableFirstNameLance       TableFirstName = `Lance`
+	TableFirstNameLane        TableFirstName = `Lane`
+	TableFirstNameLanette     TableFirstName = `Lanette`
+	TableFirstNameLanny       TableFirstName = `Lanny`
+	TableFirstNameLarry       TableFirstName = `Larry`
+	TableFirstNameLaura       TableFirstName = `Laura`
+	TableFirstNameLaureen     TableFirstName = `Laureen`
+	TableFirstNameLaurel      TableFirstName = `Laurel`
+	TableFirstNameLauren      TableFirstName = `Lauren`
+	TableFirstNameLaurence    TableFirstName = `Laurence`
+	TableFirstNameLaurene     TableFirstName = `Laurene`
+	TableFirstNameLauretta    TableFirstName = `Lauretta`
+	TableFirstNameLauri       TableFirstName = `Lauri`
+	TableFirstNameLaurie      TableFirstName = `Laurie`
+	TableFirstNameLavern      TableFirstName = `Lavern`
+	TableFirstNameLaverne     TableFirstName = `Laverne`
+	TableFirstNameLavonne     TableFirstName = `Lavonne`
+	TableFirstNameLawanda     TableFirstName = `Lawanda`
+	TableFirstNameLawerence   TableFirstName = `Lawerence`
+	TableFirstNameLawrence    TableFirstName = `Lawrence`
+	TableFirstNameLayne       TableFirstName = `Layne`
+	TableFirstNameLea         TableFirstName = `Lea`
+	TableFirstNameLeah        TableFirstName = `Leah`
+	TableFirstNameLeander     TableFirstName = `Leander`
+	TableFirstNameLeann       TableFirstName = `Leann`
+	TableFirstNameLeanna      TableFirstName = `Leanna`
+	TableFirstNameLeanne      TableFirstName = `Leanne`
+	TableFirstNameLee         TableFirstName = `Lee`
+	TableFirstNameLeeann      TableFirstName = `Leeann`
+	TableFirstNameLeigh       TableFirstName = `Leigh`
+	TableFirstNameLeila       TableFirstName = `Leila`
+	TableFirstNameLela        TableFirstName = `Lela`
+	TableFirstNameLeland      TableFirstName = `Leland`
+	TableFirstNameLelia       TableFirstName = `Lelia`
+	TableFirstNameLemuel      TableFirstName = `Lemuel`
+	TableFirstNameLen         TableFirstName = `Len`
+	TableFirstNameLena        TableFirstName = `Lena`
+	TableFirstNameLenard      TableFirstName = `Lenard`
+	TableFirstNameLennie      TableFirstName = `Lennie`
+	TableFirstNameLenny       TableFirstName = `Lenny`
+	TableFirstNameLenora      TableFirstName = `Lenora`
+	TableFirstNameLenore      TableFirstName = `Lenore`
+	TableFirstNameLeo         TableFirstName = `Leo`
+	TableFirstNameLeola       TableFirstName = `Leola`
+	TableFirstNameLeon        TableFirstName = `Leon`
+	TableFirstNameLeona       TableFirstName = `Leona`
+	TableFirstNameLeonard     TableFirstName = `Leonard`
+	TableFirstNameLeonardo    TableFirstName = `Leonardo`
+	TableFirstNameLeroy       TableFirstName = `Leroy`
+	TableFirstNameLes         TableFirstName = `Les`
+	TableFirstNameLesa        TableFirstName = `Lesa`
+	TableFirstNameLeslee      TableFirstName = `Leslee`
+	TableFirstNameLesley      TableFirstName = `Lesley`
+	TableFirstNameLeslie      TableFirstName = `Leslie`
+	TableFirstNameLessie      TableFirstName = `Lessie`
+	TableFirstNameLester      TableFirstName = `Lester`
+	TableFirstNameLeta        TableFirstName = `Leta`
+	TableFirstNameLetha       TableFirstName = `Letha`
+	TableFirstNameLeticia     TableFirstName = `Leticia`
+	TableFirstNameLetitia     TableFirstName = `Letitia`
+	TableFirstNameLevern      TableFirstName = `Levern`
+	TableFirstNameLevi        TableFirstName = `Levi`
+	TableFirstNameLevon       TableFirstName = `Levon`
+	TableFirstNameLewis       TableFirstName = `Lewis`
+	TableFirstNameLex         TableFirstName = `Lex`
+	TableFirstNameLibby       TableFirstName = `Libby`
+	TableFirstNameLila        TableFirstName = `Lila`
+	TableFirstNameLillian     TableFirstName = `Lillian`
+	TableFirstNameLillie      TableFirstName = `Lillie`
+	TableFirstNameLilly       TableFirstName = `Lilly`
+	TableFirstNameLily        TableFirstName = `Lily`
+	TableFirstNameLincoln     TableFirstName = `Lincoln`
+	TableFirstNameLinda       TableFirstName = `Linda`
+	TableFirstNameLindsay     TableFirstName = `Lindsay`
+	TableFirstNameLindsey     TableFirstName = `Lindsey`
+	TableFirstNameLindy       TableFirstName = `Lindy`
+	TableFirstNameLinnea      TableFirstName = `Linnea`
+	TableFirstNameLinwood     TableFirstName = `Linwood`
+	TableFirstNameLionel      TableFirstName = `Lionel`
+	TableFirstNameLisa        TableFirstName = `Lisa`
+	TableFirstNameLise        TableFirstName = `Lise`
+	TableFirstNameLizabeth    TableFirstName = `Lizabeth`
+	TableFirstNameLizzie      TableFirstName = `Lizzie`
+	TableFirstNameLloyd       TableFirstName = `Lloyd`
+	TableFirstNameLogan       TableFirstName = `Logan`
+	TableFirstNameLois        TableFirstName = `Lois`
+	TableFirstNameLola        TableFirstName = `Lola`
+	TableFirstNameLolita      TableFirstName = `Lolita`
+	TableFirstNameLon         TableFirstName = `Lon`
+	TableFirstNameLona        TableFirstName = `Lona`
+	TableFirstNameLonnie      TableFirstName = `Lonnie`
+	TableFirstNameLonny       TableFirstName = `Lonny`
+	TableFirstNameLora        TableFirstName = `Lora`
+	TableFirstNameLoraine     TableFirstName = `Loraine`
+	TableFirstNameLorelei     TableFirstName = `Lorelei`
+	TableFirstNameLoren       TableFirstName = `Loren`
+	TableFirstNameLorena      TableFirstName = `Lorena`
+	TableFirstNameLorene      TableFirstName = `Lorene`
+	TableFirstNameLorenzo     TableFirstName = `Lorenzo`
+	TableFirstNameLoretta     TableFirstName = `Loretta`
+	TableFirstNameLori        TableFirstName = `Lori`
+	TableFirstNameLorie       TableFirstName = `Lorie`
+	TableFirstNameLorin       TableFirstName = `Lorin`
+	TableFirstNameLorinda     TableFirstName = `Lorinda`
+	TableFirstNameLorna       TableFirstName = `Lorna`
+	TableFirstNameLorraine    TableFirstName = `Lorraine`
+	TableFirstNameLorri       TableFirstName = `Lorri`
+	TableFirstNameLorrie      TableFirstName = `Lorrie`
+	TableFirstNameLottie      TableFirstName = `Lottie`
+	TableFirstNameLou         TableFirstName = `Lou`
+	TableFirstNameLouann      TableFirstName = `Louann`
+	TableFirstNameLouella     TableFirstName = `Louella`
+	TableFirstNameLouie       TableFirstName = `Louie`
+	TableFirstNameLouis       TableFirstName = `Louis`
+	TableFirstNameLouisa      TableFirstName = `Louisa`
+	TableFirstNameLouise      TableFirstName = `Louise`
+	TableFirstNameLourdes     TableFirstName = `Lourdes`
+	TableFirstNameLowell      TableFirstName = `Lowell`
+	TableFirstNameLoyd        TableFirstName = `Loyd`
+	TableFirstNameLu          TableFirstName = `Lu`
+	TableFirstNameLuann       TableFirstName = `Luann`
+	TableFirstNameLuanne      TableFirstName = `Luanne`
+	TableFirstNameLucia       TableFirstName = `Lucia`
+	TableFirstNameLucille     TableFirstName = `Lucille`
+	TableFirstNameLucinda     TableFirstName = `Lucinda`
+	TableFirstNameLucius      TableFirstName = `Lucius`
+	TableFirstNameLucretia    TableFirstName = `Lucretia`
+	TableFirstNameLucy        TableFirstName = `Lucy`
+	TableFirstNameLuella      TableFirstName = `Luella`
+	TableFirstNameLuis        TableFirstName = `Luis`
+	TableFirstNameLuke        TableFirstName = `Luke`
+	TableFirstNameLula        TableFirstName = `Lula`
+	TableFirstNameLupe        TableFirstName = `Lupe`
+	TableFirstNameLuther      TableFirstName = `Luther`
+	TableFirstNameLuz         TableFirstName = `Luz`
+	TableFirstNameLydia       TableFirstName = `Lydia`
+	TableFirstNameLyle        TableFirstName = `Lyle`
+	TableFirstNameLyman       TableFirstName = `Lyman`
+	TableFirstNameLyn         TableFirstName = `Lyn`
+	TableFirstNameLynda       TableFirstName = `Lynda`
+	TableFirstNameLyndon      TableFirstName = `Lyndon`
+	TableFirstNameLynette     TableFirstName = `Lynette`
+	TableFirstNameLynn        TableFirstName = `Lynn`
+	TableFirstNameLynne       TableFirstName = `Lynne`
+	TableFirstNameLynnette    TableFirstName = `Lynnette`
+	TableFirstNameLynwood     TableFirstName = `Lynwood`
+	TableFirstNameMabel       TableFirstName = `Mabel`
+	TableFirstNameMable       TableFirstName = `Mable`
+	TableFirstNameMac         TableFirstName = `Mac`
+	TableFirstNameMack        TableFirstName = `Mack`
+	TableFirstNameMadeleine   TableFirstName = `Madeleine`
+	TableFirstNameMadeline    TableFirstName = `Madeline`
+	TableFirstNameMadelyn     TableFirstName = `Madelyn`
+	TableFirstNameMadonna     TableFirstName = `Madonna`
+	TableFirstNameMae         TableFirstName = `Mae`
+	TableFirstNameMagdalena   TableFirstName = `Magdalena`
+	TableFirstNameMaggie      TableFirstName = `Maggie`
+	TableFirstNameMajor       TableFirstName = `Major`
+	TableFirstNameMalcolm     TableFirstName = `Malcolm`
+	TableFirstNameMalinda     TableFirstName = `Malinda`
+	TableFirstNameMamie       TableFirstName = `Mamie`
+	TableFirstNameManuel      TableFirstName = `Manuel`
+	TableFirstNameMara        TableFirstName = `Mara`
+	TableFirstNameMarc        TableFirstName = `Marc`
+	TableFirstNameMarcel      TableFirstName = `Marcel`
+	TableFirstNameMarcella    TableFirstName = `Marcella`
+	TableFirstNameMarci       TableFirstName = `Marci`
+	TableFirstNameMarcia      TableFirstName = `Marcia`
+	TableFirstNameMarcie      TableFirstName = `Marcie`
+	TableFirstNameMarco       TableFirstName = `Marco`
+	TableFirstNameMarcos      TableFirstName = `Marcos`
+	TableFirstNameMarcus      TableFirstName = `Marcus`
+	TableFirstNameMarcy       TableFirstName = `Marcy`
+	TableFirstNameMargaret    TableFirstName = `Margaret`
+	TableFirstNameMargarita   TableFirstName = `Margarita`
+	TableFirstNameMargarito   TableFirstName = `Margarito`
+	TableFirstNameMargery     TableFirstName = `Margery`
+	TableFirstNameMargie      TableFirstName = `Margie`
+	TableFirstNameMargo       TableFirstName = `Margo`
+	TableFirstNameMargot      TableFirstName = `Margot`
+	TableFirstNameMargret     TableFirstName = `Margret`
+	TableFirstNameMarguerite  TableFirstName = `Marguerite`
+	TableFirstNameMari        TableFirstName = `Mari`
+	TableFirstNameMaria       TableFirstName = `Maria`
+	TableFirstNameMarian      TableFirstName = `Marian`
+	TableFirstNameMariann     TableFirstName = `Mariann`
+	TableFirstNameMarianna    TableFirstName = `Marianna`
+	TableFirstNameMarianne    TableFirstName = `Marianne`
+	TableFirstNameMaribeth    TableFirstName = `Maribeth`
+	TableFirstNameMarie       TableFirstName = `Marie`
+	TableFirstNameMarietta    TableFirstName = `Marietta`
+	TableFirstNameMarilee     TableFirstName = `Marilee`
+	TableFirstNameMarilyn     TableFirstName = `Marilyn`
+	TableFirstNameMarilynn    TableFirstName = `Marilynn`
+	TableFirstNameMarina      TableFirstName = `Marina`
+	TableFirstNameMario       TableFirstName = `Mario`
+	TableFirstNameMarion      TableFirstName = `Marion`
+	TableFirstNameMarita      TableFirstName = `Marita`
+	TableFirstNameMarjorie    TableFirstName = `Marjorie`
+	TableFirstNameMark        TableFirstName = `Mark`
+	TableFirstNameMarla       TableFirstName = `Marla`
+	TableFirstNameMarlene     TableFirstName = `Marlene`
+	TableFirstNameMarlin      TableFirstName = `Marlin`
+	TableFirstNameMarlon      TableFirstName = `Marlon`
+	TableFirstNameMarlys      TableFirstName = `Marlys`
+	TableFirstNameMarsha      TableFirstName = `Marsha`
+	TableFirstNameMarshall    TableFirstName = `Marshall`
+	TableFirstNameMarta       TableFirstName = `Marta`
+	TableFirstNameMartha      TableFirstName = `Martha`
+	TableFirstNameMartin      TableFirstName = `Martin`
+	TableFirstNameMartina     TableFirstName = `Martina`
+	TableFirstNameMarty       TableFirstName = `Marty`
+	TableFirstNameMarva       TableFirstName = `Marva`
+	TableFirstNameMarvin      TableFirstName = `Marvin`
+	TableFirstNameMary        TableFirstName = `Mary`
+	TableFirstNameMaryann     TableFirstName = `Maryann`
+	TableFirstNameMaryanne    TableFirstName = `Maryanne`
+	TableFirstNameMarybeth    TableFirstName = `Marybeth`
+	TableFirstNameMaryellen   TableFirstName = `Maryellen`
+	TableFirstNameMaryjane    TableFirstName = `Maryjane`
+	TableFirstNameMaryjo      TableFirstName = `Maryjo`
+	TableFirstNameMarylou     TableFirstName = `Marylou`
+	TableFirstNameMason       TableFirstName = `Mason`
+	TableFirstNameMathew      TableFirstName = `Mathew`
+	TableFirstNameMatilda     TableFirstName = `Matilda`
+	TableFirstNameMatt        TableFirstName = `Matt`
+	TableFirstNameMatthew     TableFirstName = `Matthew`
+	TableFirstNameMattie      TableFirstName = `Mattie`
+	TableFirstNameMaura       TableFirstName = `Maura`
+	TableFirstNameMaureen     TableFirstName = `Maureen`
+	TableFirstNameMaurice     TableFirstName = `Maurice`
+	TableFirstNameMavis       TableFirstName = `Mavis`
+	TableFirstNameMax         TableFirstName = `Max`
+	TableFirstNameMaxine      TableFirstName = `Maxine`
+	TableFirstNameMaxwell     TableFirstName = `Maxwell`
+	TableFirstNameMay         TableFirstName = `May`
+	TableFirstNameMaynard     TableFirstName = `Maynard`
+	TableFirstNameMckinley    TableFirstName = `Mckinley`
+	TableFirstNameMegan       TableFirstName = `Megan`
+	TableFirstNameMel         TableFirstName = `Mel`
+	TableFirstNameMelanie     TableFirstName = `Melanie`
+	TableFirstNameMelba       TableFirstName = `Melba`
+	TableFirstNameMelinda     TableFirstName = `Melinda`
+	TableFirstNameMelissa     TableFirstName = `Melissa`
+	TableFirstNameMelodee     TableFirstName = `Melodee`
+	TableFirstNameMelodie     TableFirstName = `Melodie`
+	TableFirstNameMelody      TableFirstName = `Melody`
+	TableFirstNameMelva       TableFirstName = `Melva`
+	TableFirstNameMelvin      TableFirstName = `Melvin`
+	TableFirstNameMercedes    TableFirstName = `Mercedes`
+	TableFirstNameMeredith    TableFirstName = `Meredith`
+	TableFirstNameMerle       TableFirstName = `Merle`
+	TableFirstNameMerlin      TableFirstName = `Merlin`
+	TableFirstNameMerri       TableFirstName = `Merri`
+	TableFirstNameMerrill     TableFirstName = `Merrill`
+	TableFirstNameMerry       TableFirstName = `Merry`
+	TableFirstNameMervin      TableFirstName = `Mervin`
+	TableFirstNameMeryl       TableFirstName = `Meryl`
+	TableFirstNameMichael     TableFirstName = `Michael`
+	TableFirstNameMichal      TableFirstName = `Michal`
+	TableFirstNameMichale     TableFirstName = `Michale`
+	TableFirstNameMicheal     TableFirstName = `Micheal`
+	TableFirstNameMichel      TableFirstName = `Michel`
+	TableFirstNameMichele     TableFirstName = `Michele`
+	TableFirstNameMichelle    TableFirstName = `Michelle`
+	TableFirstNameMickey      TableFirstName = `Mickey`
+	TableFirstNameMickie      TableFirstName = `Mickie`
+	TableFirstNameMicky       TableFirstName = `Micky`
+	TableFirstNameMigdalia    TableFirstName = `Migdalia`
+	TableFirstNameMiguel      TableFirstName = `Miguel`
+	TableFirstNameMike        TableFirstName = `Mike`
+	TableFirstNameMikel       TableFirstName = `Mikel`
+	TableFirstNameMilagros    TableFirstName = `Milagros`
+	TableFirstNameMilan       TableFirstName = `Milan`
+	TableFirstNameMildred     TableFirstName = `Mildred`
+	TableFirstNameMiles       TableFirstName = `Miles`
+	TableFirstNameMilford     TableFirstName = `Milford`
+	TableFirstNameMillard     TableFirstName = `Millard`
+	TableFirstNameMillicent   TableFirstName = `Millicent`
+	TableFirstNameMillie      TableFirstName = `Millie`
+	TableFirstNameMilo        TableFirstName = `Milo`
+	TableFirstNameMilton      TableFirstName = `Milton`
+	TableFirstNameMimi        TableFirstName = `Mimi`
+	TableFirstNameMindy       TableFirstName = `Mindy`
+	TableFirstNameMinerva     TableFirstName = `Minerva`
+	TableFirstNameMinnie      TableFirstName = `Minnie`
+	TableFirstNameMiriam      TableFirstName = `Miriam`
+	TableFirstNameMitch       TableFirstName = `Mitch`
+	TableFirstNameMitchel     TableFirstName = `Mitchel`
+	TableFirstNameMitchell    TableFirstName = `Mitchell`
+	TableFirstNameMitzi       TableFirstName = `Mitzi`
+	TableFirstNameMoira       TableFirstName = `Moira`
+	TableFirstNameMoises      TableFirstName = `Moises`
+	TableFirstNameMollie      TableFirstName = `Mollie`
+	TableFirstNameMolly       TableFirstName = `Molly`
+	TableFirstNameMona        TableFirstName = `Mona`
+	TableFirstNameMonica      TableFirstName = `Monica`
+	TableFirstNameMonique     TableFirstName = `Monique`
+	TableFirstNameMonroe      TableFirstName = `Monroe`
+	TableFirstNameMonte       TableFirstName = `Monte`
+	TableFirstNameMonty       TableFirstName = `Monty`
+	TableFirstNameMorgan      TableFirstName = `Morgan`
+	TableFirstNameMorris      TableFirstName = `Morris`
+	TableFirstNameMose        TableFirstName = `Mose`
+	TableFirstNameMoses       TableFirstName = `Moses`
+	TableFirstNameMuriel      TableFirstName = `Muriel`
+	TableFirstNameMurphy      TableFirstName = `Murphy`
+	TableFirstNameMurray      TableFirstName = `Murray`
+	TableFirstNameMyles       TableFirstName = `Myles`
+	TableFirstNameMyra        TableFirstName = `Myra`
+	TableFirstNameMyrna       TableFirstName = `Myrna`
+	TableFirstNameMyron       TableFirstName = `Myron`
+	TableFirstNameMyrtle      TableFirstName = `Myrtle`
+	TableFirstNameNadine      TableFirstName = `Nadine`
+	TableFirstNameNan         TableFirstName = `Nan`
+	TableFirstNameNanci       TableFirstName = `Nanci`
+	TableFirstNameNancy       TableFirstName = `Nancy`
+	TableFirstNameNanette     TableFirstName = `Nanette`
+	TableFirstNameNannette    TableFirstName = `Nannette`
+	TableFirstNameNaomi       TableFirstName = `Naomi`
+	TableFirstNameNapoleon    TableFirstName = `Napoleon`
+	TableFirstNameNatalie     TableFirstName = `Natalie`
+	TableFirstNameNathan      TableFirstName = `Nathan`
+	TableFirstNameNathaniel   TableFirstName = `Nathaniel`
+	TableFirstNameNeal        TableFirstName = `Neal`
+	TableFirstNameNed         TableFirstName = `Ned`
+	TableFirstNameNedra       TableFirstName = `Nedra`
+	TableFirstNameNeil        TableFirstName = `Neil`
+	TableFirstNameNelda       TableFirstName = `Nelda`
+	TableFirstNameNellie      TableFirstName = `Nellie`
+	TableFirstNameNelson      TableFirstName = `Nelson`
+	TableFirstNameNettie      TableFirstName = `Nettie`
+	TableFirstNameNeva        TableFirstName = `Neva`
+	TableFirstNameNewton      TableFirstName = `Newton`
+	TableFirstNameNicholas    TableFirstName = `Nicholas`
+	TableFirstNameNick        TableFirstName = `Nick`
+	TableFirstNameNicki       TableFirstName = `Nicki`
+	TableFirstNameNickolas    TableFirstName = `Nickolas`
+	TableFirstNameNicky       TableFirstName = `Nicky`
+	TableFirstNameNicolas     TableFirstName = `Nicolas`
+	TableFirstNameNicole      TableFirstName = `Nicole`
+	TableFirstNameNikki       TableFirstName = `Nikki`
+	TableFirstNameNina        TableFirstName = `Nina`
+	TableFirstNameNita        TableFirstName = `Nita`
+	TableFirstNameNoah        TableFirstName = `Noah`
+	TableFirstNameNoe         TableFirstName = `Noe`
+	TableFirstNameNoel        TableFirstName = `Noel`
+	TableFirstNameNoemi       TableFirstName = `Noemi`
+	TableFirstNameNola        TableFirstName = `Nola`
+	TableFirstNameNolan       TableFirstName = `Nolan`
+	TableFirstNameNona        TableFirstName = `Nona`
+	TableFirstNameNora        TableFirstName = `Nora`
+	TableFirstNameNorbert     TableFirstName = `Norbert`
+	TableFirstNameNoreen      TableFirstName = `Noreen`
+	TableFirstNameNorma       TableFirstName = `Norma`
+	TableFirstNameNorman      TableFirstName = `Norman`
+	TableFirstNameNormand     TableFirstName = `Normand`
+	TableFirstNameNorris      TableFirstName = `Norris`
+	TableFirstNameOdell       TableFirstName = `Odell`
+	TableFirstNameOdessa      TableFirstName = `Odessa`
+	TableFirstNameOdis        TableFirstName = `Odis`
+	TableFirstNameOfelia      TableFirstName = `Ofelia`
+	TableFirstNameOla         TableFirstName = `Ola`
+	TableFirstNameOlen        TableFirstName = `Olen`
+	TableFirstNameOlga        TableFirstName = `Olga`
+	TableFirstNameOlin        TableFirstName = `Olin`
+	TableFirstNameOliver      TableFirstName = `Oliver`
+	TableFirstNameOlivia      TableFirstName = `Olivia`
+	TableFirstNameOllie       TableFirstName = `Ollie`
+	TableFirstNameOmar        TableFirstName = `Omar`
+	TableFirstNameOpal        TableFirstName = `Opal`
+	TableFirstNameOphelia     TableFirstName = `Ophelia`
+	TableFirstNameOra         TableFirstName = `Ora`
+	TableFirstNameOralia      TableFirstName = `Oralia`
+	TableFirstNameOrlando     TableFirstName = `Orlando`
+	TableFirstNameOrval       TableFirstName = `Orval`
+	TableFirstNameOrville     TableFirstName = `Orville`
+	TableFirstNameOscar       TableFirstName = `Oscar`
+	TableFirstNameOtha        TableFirstName = `Otha`
+	TableFirstNameOtis        TableFirstName = `Otis`
+	TableFirstNameOtto        TableFirstName = `Otto`
+	TableFirstNameOwen        TableFirstName = `Owen`
+	TableFirstNamePablo       TableFirstName = `Pablo`
+	TableFirstNamePaige       TableFirstName = `Paige`
+	TableFirstNamePam         TableFirstName = `Pam`
+	TableFirstNamePamala      TableFirstName = `Pamala`
+	TableFirstNamePamela      TableFirstName = `Pamela`
+	TableFirstNamePamella     TableFirstName = `Pamella`
+	TableFirstNamePasquale    TableFirstName = `Pasquale`
+	TableFirstNamePat         TableFirstName = `Pat`
+	TableFirstNamePatrica     TableFirstName = `Patrica`
+	TableFirstNamePatrice     TableFirstName = `Patrice`
+	TableFirstNamePatricia    TableFirstName = `Patricia`
+	TableFirstNamePatrick     TableFirstName = `Patrick`
+	TableFirstNamePatsy       TableFirstName = `Patsy`
+	TableFirstNamePatti       TableFirstName = `Patti`
+	TableFirstNamePattie      TableFirstName = `Pattie`
+	TableFirstNamePatty       TableFirstName = `Patty`
+	TableFirstNamePaul        TableFirstName = `Paul`
+	TableFirstNamePaula       TableFirstName = `Paula`
+	TableFirstNamePaulette    TableFirstName = `Paulette`
+	TableFirstNamePauline     TableFirstName = `Pauline`
+	TableFirstNamePearl       TableFirstName = `Pearl`
+	TableFirstNamePearlie     TableFirstName = `Pearlie`
+	TableFirstNamePedro       TableFirstName = `Pedro`
+	TableFirstNamePeggie      TableFirstName = `Peggie`
+	TableFirstNamePeggy       TableFirstName = `Peggy`
+	TableFirstNamePenelope    TableFirstName = `Penelope`
+	TableFirstNamePennie      TableFirstName = `Pennie`
+	TableFirstNamePenny       TableFirstName = `Penny`
+	TableFirstNamePercy       TableFirstName = `Percy`
+	TableFirstNamePerry       TableFirstName = `Perry`
+	TableFirstNamePete        TableFirstName = `Pete`
+	TableFirstNamePeter       TableFirstName = `Peter`
+	TableFirstNamePhil        TableFirstName = `Phil`
+	TableFirstNamePhilip      TableFirstName = `Philip`
+	TableFirstNamePhoebe      TableFirstName = `Phoebe`
+	TableFirstNamePhyllis     TableFirstName = `Phyllis`
+	TableFirstNamePierre      TableFirstName = `Pierre`
+	TableFirstNamePolly       TableFirstName = `Polly`
+	TableFirstNamePorter      TableFirstName = `Porter`
+	TableFirstNamePortia      TableFirstName = `Portia`
+	TableFirstNamePreston     TableFirstName = `Preston`
+	TableFirstNamePrince      TableFirstName = `Prince`
+	TableFirstNamePriscilla   TableFirstName = `Priscilla`
+	TableFirstNameQueen       TableFirstName = `Queen`
+	TableFirstNameQuentin     TableFirstName = `Quentin`
+	TableFirstNameQuincy      TableFirstName = `Quincy`
+	TableFirstNameQuinton     TableFirstName = `Quinton`
+	TableFirstNameRachael     TableFirstName = `Rachael`
+	TableFirstNameRachel      TableFirstName = `Rachel`
+	TableFirstNameRachelle    TableFirstName = `Rachelle`
+	TableFirstNameRae         TableFirstName = `Rae`
+	TableFirstNameRafael      TableFirstName = `Rafael`
+	TableFirstNameRaleigh     TableFirstName = `Raleigh`
+	TableFirstNameRalph       TableFirstName = `Ralph`
+	TableFirstNameRamiro      TableFirstName = `Ramiro`
+	TableFirstNameRamon       TableFirstName = `Ramon`
+	TableFirstNameRamona      TableFirstName = `Ramona`
+	TableFirstNameRand        TableFirstName = `Rand`
+	TableFirstNameRandal      TableFirstName = `Randal`
+	TableFirstNameRandall     TableFirstName = `Randall`
+	TableFirstNameRandel      TableFirstName = `Randel`
+	TableFirstNameRandi       TableFirstName = `Randi`
+	TableFirstNameRandle      TableFirstName = `Randle`
+	TableFirstNameRandolf     TableFirstName = `Randolf`
+	TableFirstNameRandolph    TableFirstName = `Randolph`
+	TableFirstNameRandy       TableFirstName = `Randy`
+	TableFirstNameRaphael     TableFirstName = `Raphael`
+	TableFirstNameRaquel      TableFirstName = `Raquel`
+	TableFirstNameRaul        TableFirstName = `Raul`
+	TableFirstNameRay         TableFirstName = `Ray`
+	TableFirstNameRayford     TableFirstName = `Rayford`
+	TableFirstNameRaymon      TableFirstName = `Raymon`
+	TableFirstNameRaymond     TableFirstName = `Raymond`
+	TableFirstNameRaymundo    TableFirstName = `Raymundo`
+	TableFirstNameReba        TableFirstName = `Reba`
+	TableFirstNameRebecca     TableFirstName = `Rebecca`
+	TableFirstNameRebekah     TableFirstName = `Rebekah`
+	TableFirstNameReed        TableFirstName = `Reed`
+	TableFirstNameRegenia     TableFirstName = `Regenia`
+	TableFirstNameReggie      TableFirstName = `Reggie`
+	TableFirstNameRegina      TableFirstName = `Regina`
+	TableFirstNameReginald    TableFirstName = `Reginald`
+	TableFirstNameRegis       TableFirstName = `Regis`
+	TableFirstNameReid        TableFirstName = `Reid`
+	TableFirstNameRena        TableFirstName = `Rena`
+	TableFirstNameRenae       TableFirstName = `Renae`
+	TableFirstNameRene        TableFirstName = `Rene`
+	TableFirstNameRenee       TableFirstName = `Renee`
+	TableFirstNameRenita      TableFirstName = `Renita`
+	TableFirstNameReta        TableFirstName = `Reta`
+	TableFirstNameRetha       TableFirstName = `Retha`
+	TableFirstNameReuben      TableFirstName = `Reuben`
+	TableFirstNameReva        TableFirstName = `Reva`
+	TableFirstNameRex         TableFirstName = `Rex`
+	TableFirstNameReynaldo    TableFirstName = `Reynaldo`
+	TableFirstNameReynold     TableFirstName = `Reynold`
+	TableFirstNameRhea        TableFirstName = `Rhea`
+	TableFirstNameRhett       TableFirstName = `Rhett`
+	TableFirstNameRhoda       TableFirstName = `Rhoda`
+	TableFirstNameRhonda      TableFirstName = `Rhonda`
+	TableFirstNameRicardo     TableFirstName = `Ricardo`
+	TableFirstNameRichard     TableFirstName = `Richard`
+	TableFirstNameRick        TableFirstName = `Rick`
+	TableFirstNameRickey      TableFirstName = `Rickey`
+	TableFirstNameRicki       TableFirstName = `Ricki`
+	TableFirstNameRickie      TableFirstName = `Rickie`
+	TableFirstNameRicky       TableFirstName = `Ricky`
+	TableFirstNameRiley       TableFirstName = `Riley`
+	TableFirstNameRita        TableFirstName = `Rita`
+	TableFirstNameRitchie     TableFirstName = `Ritchie`
+	TableFirstNameRob         TableFirstName = `Rob`
+	TableFirstNameRobbie      TableFirstName = `Robbie`
+	TableFirstNameRobbin      TableFirstName = `Robbin`
+	TableFirstNameRobby       TableFirstName = `Robby`
+	TableFirstNameRobert      TableFirstName = `Robert`
+	TableFirstNameRoberta     TableFirstName = `Roberta`
+	TableFirstNameRoberto     TableFirstName = `Roberto`
+	TableFirstNameRobin       TableFirstName = `Robin`
+	TableFirstNameRobyn       TableFirstName = `Robyn`
+	TableFirstNameRocco       TableFirstName = `Rocco`
+	TableFirstNameRochelle    TableFirstName = `Rochelle`
+	TableFirstNameRock        TableFirstName = `Rock`
+	TableFirstNameRocky       TableFirstName = `Rocky`
+	TableFirstNameRod         TableFirstName = `Rod`
+	TableFirstNameRoderick    TableFirstName = `Roderick`
+	TableFirstNameRodger      TableFirstName = `Rodger`
+	TableFirstNameRodney      TableFirstName = `Rodney`
+	TableFirstNameRodolfo     TableFirstName = `Rodolfo`
+	TableFirstNameRodrick     TableFirstName = `Rodrick`
+	TableFirstNameRogelio     TableFirstName = `Rogelio`
+	TableFirstNameRoger       TableFirstName = `Roger`
+	TableFirstNameRogers      TableFirstName = `Rogers`
+	TableFirstNameRoland      TableFirstName = `Roland`
+	TableFirstNameRolando     TableFirstName = `Rolando`
+	TableFirstNameRolf        TableFirstName = `Rolf`
+	TableFirstNameRolland     TableFirstName = `Rolland`
+	TableFirstNameRoman       TableFirstName = `Roman`
+	TableFirstNameRomona      TableFirstName = `Romona`
+	TableFirstNameRon         TableFirstName = `Ron`
+	TableFirstNameRona        TableFirstName = `Rona`
+	TableFirstNameRonald      TableFirstName = `Ronald`
+	TableFirstNameRonda       TableFirstName = `Ronda`
+	TableFirstNameRoni        TableFirstName = `Roni`
+	TableFirstNameRonna       TableFirstName = `Ronna`
+	TableFirstNameRonnie      TableFirstName = `Ronnie`
+	TableFirstNameRonny       TableFirstName = `Ronny`
+	TableFirstNameRoosevelt   TableFirstName = `Roosevelt`
+	TableFirstNameRory        TableFirstName = `Rory`
+	TableFirstNameRosa        TableFirstName = `Rosa`
+	TableFirstNameRosalie     TableFirstName = `Rosalie`
+	TableFirstNameRosalind    TableFirstName = `Rosalind`
+	TableFirstNameRosalinda   TableFirstName = `Rosalinda`
+	TableFirstNameRosalyn     TableFirstName = `Rosalyn`
+	TableFirstNameRosanna     TableFirstName = `Rosanna`
+	TableFirstNameRosanne     TableFirstName = `Rosanne`
+	TableFirstNameRosario     TableFirstName = `Rosario`
+	TableFirstNameRoscoe      TableFirstName = `Roscoe`
+	TableFirstNameRose        TableFirstName = `Rose`
+	TableFirstNameRoseann     TableFirstName = `Roseann`
+	TableFirstNameRoseanne    TableFirstName = `Roseanne`
+	TableFirstNameRosemarie   TableFirstName = `Rosemarie`
+	TableFirstNameRosemary    TableFirstName = `Rosemary`
+	TableFirstNameRosendo     TableFirstName = `Rosendo`
+	TableFirstNameRosetta     TableFirstName = `Rosetta`
+	TableFirstNameRosie       TableFirstName = `Rosie`
+	TableFirstNameRosita      TableFirstName = `Rosita`
+	TableFirstNameRoslyn      TableFirstName = `Roslyn`
+	TableFirstNameRoss        TableFirstName = `Ross`
+	TableFirstNameRowena      TableFirstName = `Rowena`
+	TableFirstNameRowland     TableFirstName = `Rowland`
+	TableFirstNameRoxane      TableFirstName = `Roxane`
+	TableFirstNameRoxann      TableFirstName = `Roxann`
+	TableFirstNameRoxanna     TableFirstName = `Roxanna`
+	TableFirstNameRoxanne     TableFirstName = `Roxanne`
+	TableFirstNameRoxie       TableFirstName = `Roxie`
+	TableFirstNameRoy         TableFirstName = `Roy`
+	TableFirstNameRoyal       TableFirstName = `Royal`
+	TableFirstNameRoyce       TableFirstName = `Royce`
+	TableFirstNameRuben       TableFirstName = `Ruben`
+	TableFirstNameRubin       TableFirstName = `Rubin`
+	TableFirstNameRuby        TableFirstName = `Ruby`
+	TableFirstNameRudolfo     TableFirstName = `Rudolfo`
+	TableFirstNameRudolph     TableFirstName = `Rudolph`
+	TableFirstNameRudy        TableFirstName = `Rudy`
+	TableFirstNameRufus       TableFirstName = `Rufus`
+	TableFirstNameRuss        TableFirstName = `Russ`
+	TableFirstNameRussel      TableFirstName = `Russel`
+	TableFirstNameRussell     TableFirstName = `Russell`
+	TableFirstNameRusty       TableFirstName = `Rusty`
+	TableFirstNameRuth        TableFirstName = `Ruth`
+	TableFirstNameRuthie      TableFirstName = `Ruthie`
+	TableFirstNameRyan        TableFirstName = `Ryan`
+	TableFirstNameSabrina     TableFirstName = `Sabrina`
+	TableFirstNameSadie       TableFirstName = `Sadie`
+	TableFirstNameSallie      TableFirstName = `Sallie`
+	TableFirstNameSally       TableFirstName = `Sally`
+	TableFirstNameSalvador    TableFirstName = `Salvador`
+	TableFirstNameSalvatore   TableFirstName = `Salvatore`
+	TableFirstNameSam         TableFirstName = `Sam`
+	TableFirstNameSammie      TableFirstName = `Sammie`
+	TableFirstNameSammy       TableFirstName = `Sammy`
+	TableFirstNameSamuel      TableFirstName = `Samuel`
+	TableFirstNameSandi       TableFirstName = `Sandi`
+	TableFirstNameSandra      TableFirstName = `Sandra`
+	TableFirstNameSandy       TableFirstName = `Sandy`
+	TableFirstNameSanford     TableFirstName = `Sanford`
+	TableFirstNameSantiago    TableFirstName = `Santiago`
+	TableFirstNameSantos      TableFirstName = `Santos`
+	TableFirstNameSara        TableFirstName = `Sara`
+	TableFirstNameSarah       TableFirstName = `Sarah`
+	TableFirstNameSaul        TableFirstName = `Saul`
+	TableFirstNameSaundra     TableFirstName = `Saundra`
+	TableFirstNameScot        TableFirstName = `Scot`
+	TableFirstNameScott       TableFirstName = `Scott`
+	TableFirstNameScottie     TableFirstName = `Scottie`
+	TableFirstNameScotty      TableFirstName = `Scotty`
+	TableFirstNameSean        TableFirstName = `Sean`
+	TableFirstNameSelma       TableFirstName = `Selma`
+	TableFirstNameSerena      TableFirstName = `Serena`
+	TableFirstNameSergio      TableFirstName = `Sergio`
+	TableFirstNameSeth        TableFirstName = `Seth`
+	TableFirstNameShane       TableFirstName = `Shane`
+	TableFirstNameShannon     TableFirstName = `Shannon`
+	TableFirstNameSharen      TableFirstName = `Sharen`
+	TableFirstNameShari       TableFirstName = `Shari`
+	TableFirstNameSharlene    TableFirstName = `Sharlene`
+	TableFirstNameSharon      TableFirstName = `Sharon`
+	TableFirstNameSharron     TableFirstName = `Sharron`
+	TableFirstNameShaun       TableFirstName = `Shaun`
+	TableFirstNameShauna      TableFirstName = `Shauna`
+	TableFirstNameShawn       TableFirstName = `Shawn`
+	TableFirstNameSheila      TableFirstName = `Sheila`
+	TableFirstNameSheilah     TableFirstName = `Sheilah`
+	TableFirstNameShelby      TableFirstName = `Shelby`
+	TableFirstNameSheldon     TableFirstName = `Sheldon`
+	TableFirstNameShelia      TableFirstName = `Shelia`
+	TableFirstNameShelley     TableFirstName = `Shelley`
+	TableFirstNameShelly      TableFirstName = `Shelly`
+	TableFirstNameShelton     TableFirstName = `Shelton`
+	TableFirstNameSheree      TableFirstName = `Sheree`
+	TableFirstNameSheri       TableFirstName = `Sheri`
+	TableFirstNameSherie      TableFirstName = `Sherie`
+	TableFirstNameSherman     TableFirstName = `Sherman`
+	TableFirstNameSheron      TableFirstName = `Sheron`
+	TableFirstNameSherree     TableFirstName = `Sherree`
+	TableFirstNameSherri      TableFirstName = `Sherri`
+	TableFirstNameSherrie     TableFirstName = `Sherrie`
+	TableFirstNameSherrill    TableFirstName = `Sherrill`
+	TableFirstNameSherry      TableFirstName = `Sherry`
+	TableFirstNameSherryl     TableFirstName = `Sherryl`
+	TableFirstNameSherwood    TableFirstName = `Sherwood`
+	TableFirstNameSheryl      TableFirstName = `Sheryl`
+	TableFirstNameSheryll     TableFirstName = `Sheryll`
+	TableFirstNameShirlene    TableFirstName = `Shirlene`
+	TableFirstNameShirley     TableFirstName = `Shirley`
+	TableFirstNameSidney      TableFirstName = `Sidney`
+	TableFirstNameSilas       TableFirstName = `Silas`
+	TableFirstNameSilvia      TableFirstName = `Silvia`
+	TableFirstNameSimon       TableFirstName = `Simon`
+	TableFirstNameSkip        TableFirstName = `Skip`
+	TableFirstNameSolomon     TableFirstName = `Solomon`
+	TableFirstNameSondra      TableFirstName = `Sondra`
+	TableFirstNameSonia       TableFirstName = `Sonia`
+	TableFirstNameSonja       TableFirstName = `Sonja`
+	TableFirstNameSonny       TableFirstName = `Sonny`
+	TableFirstNameSonya       TableFirstName = `Sonya`
+	TableFirstNameSophia      TableFirstName = `Sophia`
+	TableFirstNameSophie      TableFirstName = `Sophie`
+	TableFirstNameSpencer     TableFirstName = `Spencer`
+	TableFirstNameStacey      TableFirstName = `Stacey`
+	TableFirstNameStacy       TableFirstName = `Stacy`
+	TableFirstNameStan        TableFirstName = `Stan`
+	TableFirstNameStanford    TableFirstName = `Stanford`
+	TableFirstNameStanley     TableFirstName = `Stanley`
+	TableFirstNameStanton     TableFirstName = `Stanton`
+	TableFirstNameStarla      TableFirstName = `Starla`
+	TableFirstNameStella      TableFirstName = `Stella`
+	TableFirstNameStephan     TableFirstName = `Stephan`
+	TableFirstNameStephanie   TableFirstName = `Stephanie`
+	TableFirstNameStephen     TableFirstName = `Stephen`
+	TableFirstNameSterling    TableFirstName = `Sterling`
+	TableFirstNameStevan      TableFirstName = `Stevan`
+	TableFirstNameSteve       TableFirstName = `Steve`
+	TableFirstNameSteven      TableFirstName = `Steven`
+	TableFirstNameStevie      TableFirstName = `Stevie`
+	TableFirstNameStewart     TableFirstName = `Stewart`
+	TableFirstNameStuart      TableFirstName = `Stuart`
+	TableFirstNameSue         TableFirstName = `Sue`
+	TableFirstNameSuellen     TableFirstName = `Suellen`
+	TableFirstNameSusan       TableFirstName = `Susan`
+	TableFirstNameSusana      TableFirstName = `Susana`
+	TableFirstNameSusanna     TableFirstName = `Susanna`
+	TableFirstNameSusanne     TableFirstName = `Susanne`
+	TableFirstNameSusie       TableFirstName = `Susie`
+	TableFirstNameSuzan       TableFirstName = `Suzan`
+	TableFirstNameSuzann      TableFirstName = `Suzann`
+	TableFirstNameSuzanne     TableFirstName = `Suzanne`
+	TableFirstNameSuzette     TableFirstName = `Suzette`
+	TableFirstNameSybil       TableFirstName = `Sybil`
+	TableFirstNameSydney      TableFirstName = `Sydney`
+	TableFirstNameSylvester   TableFirstName = `Sylvester`
+	TableFirstNameSylvia      TableFirstName = `Sylvia`
+	TableFirstNameTad         TableFirstName = `Tad`
+	TableFirstNameTalmadge    TableFirstName = `Talmadge`
+	TableFirstNameTamara      TableFirstName = `Tamara`
+	TableFirstNameTami        TableFirstName = `Tami`
+	TableFirstNameTammy       TableFirstName = `Tammy`
+	TableFirstNameTana        TableFirstName = `Tana`
+	TableFirstNameTanya       TableFirstName = `Tanya`
+	TableFirstNameTara        TableFirstName = `Tara`
+	TableFirstNameTaryn       TableFirstName = `Taryn`
+	TableFirstNameTaylor      TableFirstName = `Taylor`
+	TableFirstNameTed         TableFirstName = `Ted`
+	TableFirstNameTeddy       TableFirstName = `Teddy`
+	TableFirstNameTeena       TableFirstName = `Teena`
+	TableFirstNameTena        TableFirstName = `Tena`
+	TableFirstNameTerence     TableFirstName = `Terence`
+	TableFirstNameTeresa      TableFirstName = `Teresa`
+	TableFirstNameTerese      TableFirstName = `Terese`
+	TableFirstNameTeressa     TableFirstName = `Teressa`
+	TableFirstNameTeri        TableFirstName = `Teri`
+	TableFirstNameTerrance    TableFirstName = `Terrance`
+	TableFirstNameTerrell     TableFirstName = `Terrell`
+	TableFirstNameTerrence    TableFirstName = `Terrence`
+	TableFirstNameTerri       TableFirstName = `Terri`
+	TableFirstNameTerrie      TableFirstName = `Terrie`
+	TableFirstNameTerry       TableFirstName = `Terry`
+	TableFirstNameThad        TableFirstName = `Thad`
+	TableFirstNameThaddeus    TableFirstName = `Thaddeus`
+	TableFirstNameThea        TableFirstName = `Thea`
+	TableFirstNameTheadore    TableFirstName = `Theadore`
+	TableFirstNameThelma      TableFirstName = `Thelma`
+	TableFirstNameTheodis     TableFirstName = `Theodis`
+	TableFirstNameTheodore    TableFirstName = `Theodore`
+	TableFirstNameTheresa     TableFirstName = `Theresa`
+	TableFirstNameTherese     TableFirstName = `Therese`
+	TableFirstNameTheron      TableFirstName = `Theron`
+	TableFirstNameThomas      TableFirstName = `Thomas`
+	TableFirstNameThurman     TableFirstName = `Thurman`
+	TableFirstNameTim         TableFirstName = `Tim`
+	TableFirstNameTimmothy    TableFirstName = `Timmothy`
+	TableFirstNameTimmy       TableFirstName = `Timmy`
+	TableFirstNameTimothy     TableFirstName = `Timothy`
+	TableFirstNameTina        TableFirstName = `Tina`
+	TableFirstNameToby        TableFirstName = `Toby`
+	TableFirstNameTod         TableFirstName = `Tod`
+	TableFirstNameTodd        TableFirstName = `Todd`
+	TableFirstNameTom         TableFirstName = `Tom`
+	TableFirstNameTomas       TableFirstName = `Tomas`
+	TableFirstNameTommie      TableFirstName = `Tommie`
+	TableFirstNameTommy       TableFirstName = `Tommy`
+	TableFirstNameToney       TableFirstName = `Toney`
+	TableFirstNameToni        TableFirstName = `Toni`
+	TableFirstNameTonia       TableFirstName = `Tonia`
+	TableFirstNameTony        TableFirstName = `Tony`
+	TableFirstNameTonya       TableFirstName = `Tonya`
+	TableFirstNameTracey      TableFirstName = `Tracey`
+	TableFirstNameTracy       TableFirstName = `Tracy`
+	TableFirstNameTravis      TableFirstName = `Travis`
+	TableFirstNameTrena       TableFirstName = `Trena`
+	TableFirstNameTrent       TableFirstName = `Trent`
+	TableFirstNameTreva       TableFirstName = `Treva`
+	TableFirstNameTricia      TableFirstName = `Tricia`
+	TableFirstNameTrina       TableFirstName = `Trina`
+	TableFirstNameTroy        TableFirstName = `Troy`
+	TableFirstNameTrudy       TableFirstName = `Trudy`
+	TableFirstNameTruman      TableFirstName = `Truman`
+	TableFirstNameTwila       TableFirstName = `Twila`
+	TableFirstNameTwyla       TableFirstName = `Twyla`
+	TableFirstNameTy          TableFirstName = `Ty`
+	TableFirstNameTyler       TableFirstName = `Tyler`
+	TableFirstNameTyrone      TableFirstName = `Tyrone`
+	TableFirstNameUlysses     TableFirstName = `Ulysses`
+	TableFirstNameUrsula      TableFirstName = `Ursula`
+	TableFirstNameVal         TableFirstName = `Val`
+	TableFirstNameValarie     TableFirstName = `Valarie`
+	TableFirstNameValentine   TableFirstName = `Valentine`
+	TableFirstNameValeria     TableFirstName = `Valeria`
+	TableFirstNameValerie     TableFirstName = `Valerie`
+	TableFirstNameValorie     TableFirstName = `Valorie`
+	TableFirstNameVan         TableFirstName = `Van`
+	TableFirstNameVance       TableFirstName = `Vance`
+	TableFirstNameVanessa     TableFirstName = `Vanessa`
+	TableFirstNameVaughn      TableFirstName = `Vaughn`
+	TableFirstNameVelda       TableFirstName = `Velda`
+	TableFirstNameVelma       TableFirstName = `Velma`
+	TableFirstNameVenita      TableFirstName = `Venita`
+	TableFirstNameVera        TableFirstName = `Vera`
+	TableFirstNameVern        TableFirstName = `Vern`
+	TableFirstNameVerna       TableFirstName = `Verna`
+	TableFirstNameVerne       TableFirstName = `Verne`
+	TableFirstNameVernell     TableFirstName = `Vernell`
+	TableFirstNameVernita     TableFirstName = `Vernita`
+	TableFirstNameVernon      TableFirstName = `Vernon`
+	TableFirstNameVeronica    TableFirstName = `Veronica`
+	TableFirstNameVicente     TableFirstName = `Vicente`
+	TableFirstNameVickey      TableFirstName = `Vickey`
+	TableFirstNameVicki       TableFirstName = `Vicki`
+	TableFirstNameVickie      TableFirstName = `Vickie`
+	TableFirstNameVicky       TableFirstName = `Vicky`
+	TableFirstNameVictor      TableFirstName = `Victor`
+	TableFirstNameVictoria    TableFirstName = `Victoria`
+	TableFirstNameVikki       TableFirstName = `Vikki`
+	TableFirstNameVince       TableFirstName = `Vince`
+	TableFirstNameVincent     TableFirstName = `Vincent`
+	TableFirstNameViola       TableFirstName = `Viola`
+	TableFirstNameViolet      TableFirstName = `Violet`
+	TableFirstNameVirgie      TableFirstName = `Virgie`
+	TableFirstNameVirgil      TableFirstName = `Virgil`
+	TableFirstNameVirginia    TableFirstName = `Virginia`
+	TableFirstNameVito        TableFirstName = `Vito`
+	TableFirstNameVivian      TableFirstName = `Vivian`
+	TableFirstNameVon         TableFirstName = `Von`
+	TableFirstNameVonda       TableFirstName = `Vonda`
+	TableFirstNameWade        TableFirstName = `Wade`
+	TableFirstNameWalker      TableFirstName = `Walker`
+	TableFirstNameWallace     TableFirstName = `Wallace`
+	TableFirstNameWally       TableFirstName = `Wally`
+	TableFirstNameWalter      TableFirstName = `Walter`
+	TableFirstNameWanda       TableFirstName = `Wanda`
+	TableFirstNameWard        TableFirstName = `Ward`
+	TableFirstNameWardell     TableFirstName = `Wardell`
+	TableFirstNameWarner      TableFirstName = `Warner`
+	TableFirstNameWarren      TableFirstName = `Warren`
+	TableFirstNameWaymon      TableFirstName = `Waymon`
+	TableFirstNameWayne       TableFirstName = `Wayne`
+	TableFirstNameWeldon      TableFirstName = `Weldon`
+	TableFirstNameWendell     TableFirstName = `Wendell`
+	TableFirstNameWendy       TableFirstName = `Wendy`
+	TableFirstNameWesley      TableFirstName = `Wesley`
+	TableFirstNameWilbert     TableFirstName = `Wilbert`
+	TableFirstNameWilbur      TableFirstName = `Wilbur`
+	TableFirstNameWilburn     TableFirstName = `Wilburn`
+	TableFirstNameWiley       TableFirstName = `Wiley`
+	TableFirstNameWilford     TableFirstName = `Wilford`
+	TableFirstNameWilfred     TableFirstName = `Wilfred`
+	TableFirstNameWilfredo    TableFirstName = `Wilfredo`
+	TableFirstNameWill        TableFirstName = `Will`
+	TableFirstNameWilla       TableFirstName = `Willa`
+	TableFirstNameWillard     TableFirstName = `Willard`
+	TableFirstNameWilliam     TableFirstName = `William`
+	TableFirstNameWilliams    TableFirstName = `Williams`
+	TableFirstNameWillie      TableFirstName = `Willie`
+	TableFirstNameWillis      TableFirstName = `Willis`
+	TableFirstNameWilma       TableFirstName = `Wilma`
+	TableFirstNameWilmer      TableFirstName = `Wilmer`
+	TableFirstNameWilson      TableFirstName = `Wilson`
+	TableFirstNameWilton      TableFirstName = `Wilton`
+	TableFirstNameWinford     TableFirstName = `Winford`
+	TableFirstNameWinfred     TableFirstName = `Winfred`
+	TableFirstNameWinifred    TableFirstName = `Winifred`
+	TableFirstNameWinona      TableFirstName = `Winona`
+	TableFirstNameWinston     TableFirstName = `Winston`
+	TableFirstNameWoodrow     TableFirstName = `Woodrow`
+	TableFirstNameWoody       TableFirstName = `Woody`
+	TableFirstNameWyatt       TableFirstName = `Wyatt`
+	TableFirstNameXavier      TableFirstName = `Xavier`
+	TableFirstNameYolanda     TableFirstName = `Yolanda`
+	TableFirstNameYvette      TableFirstName = `Yvette`
+	TableFirstNameYvonne      TableFirstName = `Yvonne`
+	TableFirstNameZachary     TableFirstName = `Zachary`
+	TableFirstNameZane        TableFirstName = `Zane`
+	TableFirstNameZelda       TableFirstName = `Zelda`
+	TableFirstNameZelma       TableFirstName = `Zelma`
+	TableFirstNameZoe         TableFirstName = `Zoe`
+)
+
+// Description of the value, or empty if the value is not in table FirstName.
+func (v TableFirstName) Description() string {
+	return tableDescription(`FirstName`, string(v))
+}
+
+// Valid reports if the value is in table FirstName.
+func (v TableFirstName) Valid() bool {
+	return TableValueLookup[`FirstName`][string(v)]
+}
+
+// Table FirstName.
+func (v TableFirstName) Table() Table {
+	return TableLookup[`FirstName`]
+}
+
+// TableISO3166 is a value of table ISO3166, Country Codes.
+// Fields in this table are strings; convert a field, such as TableISO3166(v), to use the helpers.
+type TableISO3166 string
+
+const (
+	TableISO3166Aruba                            TableISO3166 = `ABW` // Aruba
+	TableISO3166Afghanistan                      TableISO3166 = `AFG` // Afghanistan
+	TableISO3166Angola                           TableISO3166 = `AGO` // Angola
+	TableISO3166Anguilla                         TableISO3166 = `AIA` // Anguilla
+	TableISO3166AlandIslands                     TableISO3166 = `ALA` // Aland Islands
+	TableISO3166Albania                          TableISO3166 = `ALB` // Albania
+	TableISO3166Andorra                          TableISO3166 = `AND` // Andorra
+	TableISO3166UnitedArabEmirates               TableISO3166 = `ARE` // United Arab Emirates
+	TableISO3166Argentina                        TableISO3166 = `ARG` // Argentina
+	TableISO3166Armenia                          TableISO3166 = `ARM` // Armenia
+	TableISO3166AmericanSamoa                    TableISO3166 = `ASM` // American Samoa
+	TableISO3166Antarctica                       TableISO3166 = `ATA` // Antarctica
+	TableISO3166FrenchSouthernTerritories        TableISO3166 = `ATF` // French Southern Territories
+	TableISO3166AntiguaAndBarbuda                TableISO3166 = `ATG` // Antigua and Barbuda
+	TableISO3166Australia                        TableISO3166 = `AUS` // Australia
+	TableISO3166Austria                          TableISO3166 = `AUT` // Austria
+	TableISO3166Azerbaijan                       TableISO3166 = `AZE` // Azerbaijan
+	TableISO3166Burundi                          TableISO3166 = `BDI` // Burundi
+	TableISO3166Belgium                          TableISO3166 = `BEL` // Belgium
+	TableISO3166Benin                            TableISO3166 = `BEN` // Benin
+	TableISO3166BonaireSintEustatiusAndSaba      TableISO3166 = `BES` // Bonaire, Sint Eustatius and Saba
+	TableISO3166BurkinaFaso                      TableISO3166 = `BFA` // Burkina Faso
+	TableISO3166Bangladesh                       TableISO3166 = `BGD` // Bangladesh
+	TableISO3166Bulgaria                         TableISO3166 = `BGR` // Bulgaria
+	TableISO3166Bahrain                          TableISO3166 = `BHR` // Bahrain
+	TableISO3166Bahamas                          TableISO3166 = `BHS` // Bahamas
+	TableISO3166BosniaAndHerzegovina             TableISO3166 = `BIH` // Bosnia and Herzegovina
+	TableISO3166SaintBarthLemy                   TableISO3166 = `BLM` // Saint BarthÃ©lemy
+	TableISO3166Belarus                          TableISO3166 = `BLR` // Belarus
+	TableISO3166Belize                           TableISO3166 = `BLZ` // Belize
+	TableISO3166Bermuda                          TableISO3166 = `BMU` // Bermuda
+	TableISO3166BoliviaPlurinationalStateOf      TableISO3166 = `BOL` // Bolivia (Plurinational State of)
+	TableISO3166Brazil                           TableISO3166 = `BRA` // Brazil
+	TableISO3166Barbados                         TableISO3166 = `BRB` // Barbados
+	TableISO3166BruneiDarussalam                 TableISO3166 = `BRN` // Brunei Darussalam
+	TableISO3166Bhutan                           TableISO3166 = `BTN` // Bhutan
+	TableISO3166BouvetIsland                     TableISO3166 = `BVT` // Bouvet Island
+	TableISO3166Botswana                         TableISO3166 = `BWA` // Botswana
+	TableISO3166CentralAfricanRepublic           TableISO3166 = `CAF` // Central African Republic
+	TableISO3166Canada                           TableISO3166 = `CAN` // Canada
+	TableISO3166CocosKeelingIslands              TableISO3166 = `CCK` // Cocos (Keeling) Islands
+	TableISO3166Switzerland                      TableISO3166 = `CHE` // Switzerland
+	TableISO3166Chile                            TableISO3166 = `CHL` // Chile
+	TableISO3166China                            TableISO3166 = `CHN` // China
+	TableISO3166CTeDIvoire                       TableISO3166 = `CIV` // CÃ´te d'Ivoire
+	TableISO3166Cameroon                         TableISO3166 = `CMR` // Cameroon
+	TableISO3166CongoDemocraticRepublicOfThe     TableISO3166 = `COD` // Congo, Democratic Republic of the
+	TableISO3166Congo                            TableISO3166 = `COG` // Congo
+	TableISO3166CookIslands                      TableISO3166 = `COK` // Cook Islands
+	TableISO3166Colombia                         TableISO3166 = `COL` // Colombia
+	TableISO3166Comoros                          TableISO3166 = `COM` // Comoros
+	TableISO3166CaboVerde                        TableISO3166 = `CPV` // Cabo Verde
+	TableISO3166CostaRica                        TableISO3166 = `CRI` // Costa Rica
+	TableISO3166Cuba                             TableISO3166 = `CUB` // Cuba
+	TableISO3166CuraAo                           TableISO3166 = `CUW` // CuraÃ§ao
+	TableISO3166ChristmasIsland                  TableISO3166 = `CXR` // Christmas Island
+	TableISO3166CaymanIslands                    TableISO3166 = `CYM` // Cayman Islands
+	TableISO3166Cyprus                           TableISO3166 = `CYP` // Cyprus
+	TableISO3166Czechia                          TableISO3166 = `CZE` // Czechia
+	TableISO3166Germany                          TableISO3166 = `DEU` // Germany
+	TableISO3166Djibouti                         TableISO3166 = `DJI` // Djibouti
+	TableISO3166Dominica                         TableISO3166 = `DMA` // Dominica
+	TableISO3166Denmark                          TableISO3166 = `DNK` // Denmark
+	TableISO3166DominicanRepublic                TableISO3166 = `DOM` // Dominican Republic
+	TableISO3166Algeria                          TableISO3166 = `DZA` // Algeria
+	TableISO3166Ecuador                          TableISO3166 = `ECU` // Ecuador
+	TableISO3166Egypt                            TableISO3166 = `EGY` // Egypt
+	TableISO3166Eritrea                          TableISO3166 = `ERI` // Eritrea
+	TableISO3166WesternSahara                    TableISO3166 = `ESH` // Western Sahara
+	TableISO3166Spain                            TableISO3166 = `ESP` // Spain
+	TableISO3166Estonia                          TableISO3166 = `EST` // Estonia
+	TableISO3166Ethiopia                         TableISO3166 = `ETH` // Ethiopia
+	TableISO3166Finland                          TableISO3166 = `FIN` // Finland
+	TableISO3166Fiji                             TableISO3166 = `FJI` // Fiji
+	TableISO3166FalklandIslandsMalvinas          TableISO3166 = `FLK` // Falkland Islands (Malvinas)
+	TableISO3166France                           TableISO3166 = `FRA` // France
+	TableISO3166FaroeIslands                     TableISO3166 = `FRO` // Faroe Islands
+	TableISO3166MicronesiaFederatedStatesOf      TableISO3166 = `FSM` // Micronesia (Federated States of)
+	TableISO3166Gabon                            TableISO3166 = `GAB` // Gabon
+	TableISO3166UnitedKingdomOfGreatBritainAnd   TableISO3166 = `GBR` // United Kingdom of Great Britain and Northern Ireland
+	TableISO3166Georgia                          TableISO3166 = `GEO` // Georgia
+	TableISO3166Guernsey                         TableISO3166 = `GGY` // Guernsey
+	TableISO3166Ghana                            TableISO3166 = `GHA` // Ghana
+	TableISO3166Gibraltar                        TableISO3166 = `GIB` // Gibraltar
+	TableISO3166Guinea                           TableISO3166 = `GIN` // Guinea
+	TableISO3166Guadeloupe                       TableISO3166 = `GLP` // Guadeloupe
+	TableISO3166Gambia                           TableISO3166 = `GMB` // Gambia
+	TableISO3166GuineaBissau                     TableISO3166 = `GNB` // Guinea-Bissau
+	TableISO3166EquatorialGuinea                 TableISO3166 = `GNQ` // Equatorial Guinea
+	TableISO3166Greece                           TableISO3166 = `GRC` // Greece
+	TableISO3166Grenada                          TableISO3166 = `GRD` // Grenada
+	TableISO3166Greenland                        TableISO3166 = `GRL` // Greenland
+	TableISO3166Guatemala                        TableISO3166 = `GTM` // Guatemala
+	TableISO3166FrenchGuiana                     TableISO3166 = `GUF` // French Guiana
+	TableISO3166Guam                             TableISO3166 = `GUM` // Guam
+	TableISO3166Guyana                           TableISO3166 = `GUY` // Guyana
+	TableISO3166HongKong                         TableISO3166 = `HKG` // Hong Kong
+	TableISO3166HeardIslandAndMcDonaldIslands    TableISO3166 = `HMD` // Heard Island and McDonald Islands
+	TableISO3166Honduras                         TableISO3166 = `HND` // Honduras
+	TableISO3166Croatia                          TableISO3166 = `HRV` // Croatia
+	TableISO3166Haiti                            TableISO3166 = `HTI` // Haiti
+	TableISO3166Hungary                          TableISO3166 = `HUN` // Hungary
+	TableISO3166Indonesia                        TableISO3166 = `IDN` // Indonesia
+	TableISO3166IsleOfMan                        TableISO3166 = `IMN` // Isle of Man
+	TableISO3166India                            TableISO3166 = `IND` // India
+	TableISO3166BritishIndianOceanTerritory      TableISO3166 = `IOT` // British Indian Ocean Territory
+	TableISO3166Ireland                          TableISO3166 = `IRL` // Ireland
+	TableISO3166IranIslamicRepublicOf            TableISO3166 = `IRN` // Iran (Islamic Republic of)
+	TableISO3166Iraq                             TableISO3166 = `IRQ` // Iraq
+	TableISO3166Iceland                          TableISO3166 = `ISL` // Iceland
+	TableISO3166Israel                           TableISO3166 = `ISR` // Israel
+	TableISO3166Italy                            TableISO3166 = `ITA` // Italy
+	TableISO3166Jamaica                          TableISO3166 = `JAM` // Jamaica
+	TableISO3166Jersey                           TableISO3166 = `JEY` // Jersey
+	TableISO3166Jordan                           TableISO3166 = `JOR` // Jordan
+	TableISO3166Japan                            TableISO3166 = `JPN` // Japan
+	TableISO3166Kazakhstan                       TableISO3166 = `KAZ` // Kazakhstan
+	TableISO3166Kenya                            TableISO3166 = `KEN` // Kenya
+	TableISO3166Kyrgyzstan                       TableISO3166 = `KGZ` // Kyrgyzstan
+	TableISO3166Cambodia                         TableISO3166 = `KHM` // Cambodia
+	TableISO3166Kiribati                         TableISO3166 = `KIR` // Kiribati
+	TableISO3166SaintKittsAndNevis               TableISO3166 = `KNA` // Saint Kitts and Nevis
+	TableISO3166KoreaRepublicOf                  TableISO3166 = `KOR` // Korea, Republic of
+	TableISO3166Kuwait                           TableISO3166 = `KWT` // Kuwait
+	TableISO3166LaoPeopleSDemocraticRepublic     TableISO3166 = `LAO` // Lao People's Democratic Republic
+	TableISO3166Lebanon                          TableISO3166 = `LBN` // Lebanon
+	TableISO3166Liberia                          TableISO3166 = `LBR` // Liberia
+	TableISO3166Libya                            TableISO3166 = `LBY` // Libya
+	TableISO3166SaintLucia                       TableISO3166 = `LCA` // Saint Lucia
+	TableISO3166Liechtenstein                    TableISO3166 = `LIE` // Liechtenstein
+	TableISO3166SriLanka                         TableISO3166 = `LKA` // Sri Lanka
+	TableISO3166Lesotho                          TableISO3166 = `LSO` // Lesotho
+	TableISO3166Lithuania                        TableISO3166 = `LTU` // Lithuania
+	TableISO3166Luxembourg                       TableISO3166 = `LUX` // Luxembourg
+	TableISO3166Latvia                           TableISO3166 = `LVA` // Latvia
+	TableISO3166Macao                            TableISO3166 = `MAC` // Macao
+	TableISO3166SaintMartinFrenchPart            TableISO3166 = `MAF` // Saint Martin (French part)
+	TableISO3166Morocco                          TableISO3166 = `MAR` // Morocco
+	TableISO3166Monaco                           TableISO3166 = `MCO` // Monaco
+	TableISO3166MoldovaRepublicOf                TableISO3166 = `MDA` // Moldova, Republic of
+	TableISO3166Madagascar                       TableISO3166 = `MDG` // Madagascar
+	TableISO3166Maldives                         TableISO3166 = `MDV` // Maldives
+	TableISO3166Mexico                           TableISO3166 = `MEX` // Mexico
+	TableISO3166MarshallIslands                  TableISO3166 = `MHL` // Marshall Islands
+	TableISO3166NorthMacedonia                   TableISO3166 = `MKD` // North Macedonia
+	TableISO3166Mali                             TableISO3166 = `MLI` // Mali
+	TableISO3166Malta                            TableISO3166 = `MLT` // Malta
+	TableISO3166Myanmar                          TableISO3166 = `MMR` // Myanmar
+	TableISO3166Montenegro                       TableISO3166 = `MNE` // Montenegro
+	TableISO3166Mongolia                         TableISO3166 = `MNG` // Mongolia
+	TableISO3166NorthernMarianaIslands           TableISO3166 = `MNP` // Northern Mariana Islands
+	TableISO3166Mozambique                       TableISO3166 = `MOZ` // Mozambique
+	TableISO3166Mauritania                       TableISO3166 = `MRT` // Mauritania
+	TableISO3166Montserrat                       TableISO3166 = `MSR` // Montserrat
+	TableISO3166Martinique                       TableISO3166 = `MTQ` // Martinique
+	TableISO3166Mauritius                        TableISO3166 = `MUS` // Mauritius
+	TableISO3166Malawi                           TableISO3166 = `MWI` // Malawi
+	TableISO3166Malaysia                         TableISO3166 = `MYS` // Malaysia
+	TableISO3166Mayotte                          TableISO3166 = `MYT` // Mayotte
+	TableISO3166Namibia                          TableISO3166 = `NAM` // Namibia
+	TableISO3166NewCaledonia                     TableISO3166 = `NCL` // New Caledonia
+	TableISO3166Niger                            TableISO3166 = `NER` // Niger
+	TableISO3166NorfolkIsland                    TableISO3166 = `NFK` // Norfolk Island
+	TableISO3166Nigeria                          TableISO3166 = `NGA` // Nigeria
+	TableISO3166Nicaragua                        TableISO3166 = `NIC` // Nicaragua
+	TableISO3166Niue                             TableISO3166 = `NIU` // Niue
+	TableISO3166Netherlands                      TableISO3166 = `NLD` // Netherlands
+	TableISO3166Norway                           TableISO3166 = `NOR` // Norway
+	TableISO3166Nepal                            TableISO3166 = `NPL` // Nepal
+	TableISO3166Nauru                            TableISO3166 = `NRU` // Nauru
+	TableISO3166NewZealand                       TableISO3166 = `NZL` // New Zealand
+	TableISO3166Oman                             TableISO3166 = `OMN` // Oman
+	TableISO3166Pakistan                         TableISO3166 = `PAK` // Pakistan
+	TableISO3166Panama                           TableISO3166 = `PAN` // Panama
+	TableISO3166Pitcairn                         TableISO3166 = `PCN` // Pitcairn
+	TableISO3166Peru                             TableISO3166 = `PER` // Peru
+	TableISO3166Philippines                      TableISO3166 = `PHL` // Philippines
+	TableISO3166Palau                            TableISO3166 = `PLW` // Palau
+	TableISO3166PapuaNewGuinea                   TableISO3166 = `PNG` // Papua New Guinea
+	TableISO3166Poland                           TableISO3166 = `POL` // Poland
+	TableISO3166PuertoRico                       TableISO3166 = `PRI` // Puerto Rico
+	TableISO3166KoreaDemocraticPeopleSRepublicOf TableISO3166 = `PRK` // Korea (Democratic People's Republic of)
+	TableISO3166Portugal                         TableISO3166 = `PRT` // Portugal
+	TableISO3166Paraguay                         TableISO3166 = `PRY` // Paraguay
+	TableISO3166PalestineStateOf                 TableISO3166 = `PSE` // Palestine, State of
+	TableISO3166FrenchPolynesia                  TableISO3166 = `PYF` // French Polynesia
+	TableISO3166Qatar                            TableISO3166 = `QAT` // Qatar
+	TableISO3166RUnion                           TableISO3166 = `REU` // RÃ©union
+	TableISO3166Romania                          TableISO3166 = `ROU` // Romania
+	TableISO3166RussianFederation                TableISO3166 = `RUS` // Russian Federation
+	TableISO3166Rwanda                           TableISO3166 = `RWA` // Rwanda
+	TableISO3166SaudiArabia                      TableISO3166 = `SAU` // Saudi Arabia
+	TableISO3166Sudan                            TableISO3166 = `SDN` // Sudan
+	TableISO3166Senegal                          TableISO3166 = `SEN` // Senegal
+	TableISO3166Singapore                        TableISO3166 = `SGP` // Singapore
+	TableISO3166SouthGeorgiaAndTheSouthSandwich  TableISO3166 = `SGS` // South Georgia and the South Sandwich Islands
+	TableISO3166SaintHelenaAscensionAndTristanDa TableISO3166 = `SHN` // Saint Helena, Ascension and Tristan da Cunha
+	TableISO3166SvalbardAndJanMayen              TableISO3166 = `SJM` // Svalbard and Jan Mayen
+	TableISO3166SolomonIslands                   TableISO3166 = `SLB` // Solomon Islands
+	TableISO3166SierraLeone                      TableISO3166 = `SLE` // Sierra Leone
+	TableISO3166ElSalvador                       TableISO3166 = `SLV` // El Salvador
+	TableISO3166SanMarino                        TableISO3166 = `SMR` // San Marino
+	TableISO3166Somalia                          TableISO3166 = `SOM` // Somalia
+	TableISO3166SaintPierreAndMiquelon           TableISO3166 = `SPM` // Saint Pierre and Miquelon
+	TableISO3166Serbia                           TableISO3166 = `SRB` // Serbia
+	TableISO3166SouthSudan                       TableISO3166 = `SSD` // South Sudan
+	TableISO3166SaoTomeAndPrincipe               TableISO3166 = `STP` // Sao Tome and Principe
+	TableISO3166Suriname                         TableISO3166 = `SUR` // Suriname
+	TableISO3166Slovakia                         TableISO3166 = `SVK` // Slovakia
+	TableISO3166Slovenia                         TableISO3166 = `SVN` // Slovenia
+	TableISO3166Sweden                           TableISO3166 = `SWE` // Sweden
+	TableISO3166Eswatini                         TableISO3166 = `SWZ` // Eswatini
+	TableISO3166SintMaartenDutchPart             TableISO3166 = `SXM` // Sint Maarten (Dutch part)
+	TableISO3166Seychelles                       TableISO3166 = `SYC` // Seychelles
+	TableISO3166SyrianArabRepublic               TableISO3166 = `SYR` // Syrian Arab Republic
+	TableISO3166TurksAndCaicosIslands            TableISO3166 = `TCA` // Turks and Caicos Islands
+	TableISO3166Chad                             TableISO3166 = `TCD` // Chad
+	TableISO3166Togo                             TableISO3166 = `TGO` // Togo
+	TableISO3166Thailand                         TableISO3166 = `THA` // Thailand
+	TableISO3166Tajikistan                       TableISO3166 = `TJK` // Tajikistan
+	TableISO3166Tokelau                          TableISO3166 = `TKL` // Tokelau
+	TableISO3166Turkmenistan                     TableISO3166 = `TKM` // Turkmenistan
+	TableISO3166TimorLeste                       TableISO3166 = `TLS` // Timor-Leste
+	TableISO3166Tonga                            TableISO3166 = `TON` // Tonga
+	TableISO3166TrinidadAndTobago                TableISO3166 = `TTO` // Trinidad and Tobago
+	TableISO3166Tunisia                          TableISO3166 = `TUN` // Tunisia
+	TableISO3166Turkey                           TableISO3166 = `TUR` // Turkey
+	TableISO3166Tuvalu                           TableISO3166 = `TUV` // Tuvalu
+	TableISO3166TaiwanProvinceOfChina            TableISO3166 = `TWN` // Taiwan, Province of China
+	TableISO3166TanzaniaUnitedRepublicOf         TableISO3166 = `TZA` // Tanzania, United Republic of
+	TableISO3166Uganda                           TableISO3166 = `UGA` // Uganda
+	TableISO3166Ukraine                          TableISO3166 = `UKR` // Ukraine
+	TableISO3166UnitedStatesMinorOutlyingIslands TableISO3166 = `UMI` // United States Minor Outlying Islands
+	TableISO3166Uruguay                          TableISO3166 = `URY` // Uruguay
+	TableISO3166UnitedStatesOfAmerica            TableISO3166 = `USA` // United States of America
+	TableISO3166Uzbekistan                       TableISO3166 = `UZB` // Uzbekistan
+	TableISO3166HolySee                          TableISO3166 = `VAT` // Holy See
+	TableISO3166SaintVincentAndTheGrenadines     TableISO3166 = `VCT` // Saint Vincent and the Grenadines
+	TableISO3166VenezuelaBolivarianRepublicOf    TableISO3166 = `VEN` // Venezuela (Bolivarian Republic of)
+	TableISO3166VirginIslandsBritish             TableISO3166 = `VGB` // Virgin Islands (British)
+	TableISO3166VirginIslandsUS                  TableISO3166 = `VIR` // Virgin Islands (U.S.)
+	TableISO3166VietNam                          TableISO3166 = `VNM` // Viet Nam
+	TableISO3166Vanuatu                          TableISO3166 = `VUT` // Vanuatu
+	TableISO3166WallisAndFutuna                  TableISO3166 = `WLF` // Wallis and Futuna
+	TableISO3166Samoa                            TableISO3166 = `WSM` // Samoa
+	TableISO3166Yemen                            TableISO3166 = `YEM` // Yemen
+	TableISO3166SouthAfrica                      TableISO3166 = `ZAF` // South Africa
+	TableISO3166Zambia                           TableISO3166 = `ZMB` // Zambia
+	TableISO3166Zimbabwe                         TableISO3166 = `ZWE` // Zimbabwe
+)
+
+// Description of the value, or empty if the value is not in table ISO3166.
+func (v TableISO3166) Description() string {
+	return tableDescription(`ISO3166`, string(v))
+}
+
+// Valid reports if the value is in table ISO3166.
+func (v TableISO3166) Valid() bool {
+	return TableValueLookup[`ISO3166`][string(v)]
+}
+
+// Table ISO3166.
+func (v TableISO3166) Table() Table {
+	return TableLookup[`ISO3166`]
+}
+
+// TableISO4217 is a value of table ISO4217, Currency Codes.
+// Fields in this table are strings; convert a field, such as TableISO4217(v), to use the helpers.
+type TableISO4217 string
+
+const (
+	TableISO4217NoUniversalCurrency                         TableISO4217 = ``    // No universal currency
+	TableISO4217UAEDirham                                   TableISO4217 = `AED` // UAE Dirham
+	TableISO4217Afghani                                     TableISO4217 = `AFN` // Afghani
+	TableISO4217Lek                                         TableISO4217 = `ALL` // Lek
+	TableISO4217ArmenianDram                                TableISO4217 = `AMD` // Armenian Dram
+	TableISO4217NetherlandsAntilleanGuilder                 TableISO4217 = `ANG` // Netherlands Antillean Guilder
+	TableISO4217Kwanza                                      TableISO4217 = `AOA` // Kwanza
+	TableISO4217ArgentinePeso                               TableISO4217 = `ARS` // Argentine Peso
+	TableISO4217AustralianDollar                            TableISO4217 = `AUD` // Australian Dollar
+	TableISO4217ArubanFlorin                                TableISO4217 = `AWG` // Aruban Florin
+	TableISO4217AzerbaijanManat                             TableISO4217 = `AZN` // Azerbaijan Manat
+	TableISO4217ConvertibleMark                             TableISO4217 = `BAM` // Convertible Mark
+	TableISO4217BarbadosDollar                              TableISO4217 = `BBD` // Barbados Dollar
+	TableISO4217Taka                                        TableISO4217 = `BDT` // Taka
+	TableISO4217BulgarianLev                                TableISO4217 = `BGN` // Bulgarian Lev
+	TableISO4217BahrainiDinar                               TableISO4217 = `BHD` // Bahraini Dinar
+	TableISO4217BurundiFranc                                TableISO4217 = `BIF` // Burundi Franc
+	TableISO4217BermudianDollar                             TableISO4217 = `BMD` // Bermudian Dollar
+	TableISO4217BruneiDollar                                TableISO4217 = `BND` // Brunei Dollar
+	TableISO4217Boliviano                                   TableISO4217 = `BOB` // Boliviano
+	TableISO4217Mvdol                                       TableISO4217 = `BOV` // Mvdol
+	TableISO4217BrazilianReal                               TableISO4217 = `BRL` // Brazilian Real
+	TableISO4217BahamianDollar                              TableISO4217 = `BSD` // Bahamian Dollar
+	TableISO4217Ngultrum                                    TableISO4217 = `BTN` // Ngultrum
+	TableISO4217Pula                                        TableISO4217 = `BWP` // Pula
+	TableISO4217BelarusianRuble                             TableISO4217 = `BYN` // Belarusian Ruble
+	TableISO4217BelizeDollar                                TableISO4217 = `BZD` // Belize Dollar
+	TableISO4217CanadianDollar                              TableISO4217 = `CAD` // Canadian Dollar
+	TableISO4217CongoleseFranc                              TableISO4217 = `CDF` // Congolese Franc
+	TableISO4217WIREuro                                     TableISO4217 = `CHE` // WIR Euro
+	TableISO4217SwissFranc                                  TableISO4217 = `CHF` // Swiss Franc
+	TableISO4217WIRFranc                                    TableISO4217 = `CHW` // WIR Franc
+	TableISO4217UnidadDeFomento                             TableISO4217 = `CLF` // Unidad de Fomento
+	TableISO4217ChileanPeso                                 TableISO4217 = `CLP` // Chilean Peso
+	TableISO4217YuanRenminbi                                TableISO4217 = `CNY` // Yuan Renminbi
+	TableISO4217ColombianPeso                               TableISO4217 = `COP` // Colombian Peso
+	TableISO4217UnidadDeValorReal                           TableISO4217 = `COU` // Unidad de Valor Real
+	TableISO4217CostaRicanColon                             TableISO4217 = `CRC` // Costa Rican Colon
+	TableISO4217PesoConvertible                             TableISO4217 = `CUC` // Peso Convertible
+	TableISO4217CubanPeso                                   TableISO4217 = `CUP` // Cuban Peso
+	TableISO4217CaboVerdeEscudo                             TableISO4217 = `CVE` // Cabo Verde Escudo
+	TableISO4217CzechKoruna                                 TableISO4217 = `CZK` // Czech Koruna
+	TableISO4217DjiboutiFranc                               TableISO4217 = `DJF` // Djibouti Franc
+	TableISO4217DanishKrone                                 TableISO4217 = `DKK` // Danish Krone
+	TableISO4217DominicanPeso                               TableISO4217 = `DOP` // Dominican Peso
+	TableISO4217AlgerianDinar                               TableISO4217 = `DZD` // Algerian Dinar
+	TableISO4217EgyptianPound                               TableISO4217 = `EGP` // Egyptian Pound
+	TableISO4217Nakfa                                       TableISO4217 = `ERN` // Nakfa
+	TableISO4217EthiopianBirr                               TableISO4217 = `ETB` // Ethiopian Birr
+	TableISO4217Euro                                        TableISO4217 = `EUR` // Euro
+	TableISO4217FijiDollar                                  TableISO4217 = `FJD` // Fiji Dollar
+	TableISO4217FalklandIslandsPound                        TableISO4217 = `FKP` // Falkland Islands Pound
+	TableISO4217PoundSterling                               TableISO4217 = `GBP` // Pound Sterling
+	TableISO4217Lari                                        TableISO4217 = `GEL` // Lari
+	TableISO4217GhanaCedi                                   TableISO4217 = `GHS` // Ghana Cedi
+	TableISO4217GibraltarPound                              TableISO4217 = `GIP` // Gibraltar Pound
+	TableISO4217Dalasi                                      TableISO4217 = `GMD` // Dalasi
+	TableISO4217GuineanFranc                                TableISO4217 = `GNF` // Guinean Franc
+	TableISO4217Quetzal                                     TableISO4217 = `GTQ` // Quetzal
+	TableISO4217GuyanaDollar                                TableISO4217 = `GYD` // Guyana Dollar
+	TableISO4217HongKongDollar                              TableISO4217 = `HKD` // Hong Kong Dollar
+	TableISO4217Lempira                                     TableISO4217 = `HNL` // Lempira
+	TableISO4217Kuna                                        TableISO4217 = `HRK` // Kuna
+	TableISO4217Gourde                                      TableISO4217 = `HTG` // Gourde
+	TableISO4217Forint                                      TableISO4217 = `HUF` // Forint
+	TableISO4217Rupiah                                      TableISO4217 = `IDR` // Rupiah
+	TableISO4217NewIsraeliSheqel                            TableISO4217 = `ILS` // New Israeli Sheqel
+	TableISO4217IndianRupee                                 TableISO4217 = `INR` // Indian Rupee
+	TableISO4217IraqiDinar                                  TableISO4217 = `IQD` // Iraqi Dinar
+	TableISO4217IranianRial                                 TableISO4217 = `IRR` // Iranian Rial
+	TableISO4217IcelandKrona                                TableISO4217 = `ISK` // Iceland Krona
+	TableISO4217JamaicanDollar                              TableISO4217 = `JMD` // Jamaican Dollar
+	TableISO4217JordanianDinar                              TableISO4217 = `JOD` // Jordanian Dinar
+	TableISO4217Yen                                         TableISO4217 = `JPY` // Yen
+	TableISO4217KenyanShilling                              TableISO4217 = `KES` // Kenyan Shilling
+	TableISO4217Som                                         TableISO4217 = `KGS` // Som
+	TableISO4217Riel                                        TableISO4217 = `KHR` // Riel
+	TableISO4217ComorianFranc                               TableISO4217 = `KMF` // Comorian Franc
+	TableISO4217NorthKoreanWon                              TableISO4217 = `KPW` // North Korean Won
+	TableISO4217Won                                         TableISO4217 = `KRW` // Won
+	TableISO4217KuwaitiDinar                                TableISO4217 = `KWD` // Kuwaiti Dinar
+	TableISO4217CaymanIslandsDollar                         TableISO4217 = `KYD` // Cayman Islands Dollar
+	TableISO4217Tenge                                       TableISO4217 = `KZT` // Tenge
+	TableISO4217LaoKip                                      TableISO4217 = `LAK` // Lao Kip
+	TableISO4217LebanesePound                               TableISO4217 = `LBP` // Lebanese Pound
+	TableISO4217SriLankaRupee                               TableISO4217 = `LKR` // Sri Lanka Rupee
+	TableISO4217LiberianDollar                              TableISO4217 = `LRD` // Liberian Dollar
+	TableISO4217Loti                                        TableISO4217 = `LSL` // Loti
+	TableISO4217LibyanDinar                                 TableISO4217 = `LYD` // Libyan Dinar
+	TableISO4217MoroccanDirham                              TableISO4217 = `MAD` // Moroccan Dirham
+	TableISO4217MoldovanLeu                                 TableISO4217 = `MDL` // Moldovan Leu
+	TableISO4217MalagasyAriary                              TableISO4217 = `MGA` // Malagasy Ariary
+	TableISO4217Denar                                       TableISO4217 = `MKD` // Denar
+	TableISO4217Kyat                                        TableISO4217 = `MMK` // Kyat
+	TableISO4217Tugrik                                      TableISO4217 = `MNT` // Tugrik
+	TableISO4217Pataca                                      TableISO4217 = `MOP` // Pataca
+	TableISO4217Ouguiya                                     TableISO4217 = `MRU` // Ouguiya
+	TableISO4217MauritiusRupee                              TableISO4217 = `MUR` // Mauritius Rupee
+	TableISO4217Rufiyaa                                     TableISO4217 = `MVR` // Rufiyaa
+	TableISO4217MalawiKwacha                                TableISO4217 = `MWK` // Malawi Kwacha
+	TableISO4217MexicanPeso                                 TableISO4217 = `MXN` // Mexican Peso
+	TableISO4217MexicanUnidadDeInversionUDI                 TableISO4217 = `MXV` // Mexican Unidad de Inversion (UDI)
+	TableISO4217MalaysianRinggit                            TableISO4217 = `MYR` // Malaysian Ringgit
+	TableISO4217MozambiqueMetical                           TableISO4217 = `MZN` // Mozambique Metical
+	TableISO4217NamibiaDollar                               TableISO4217 = `NAD` // Namibia Dollar
+	TableISO4217Naira                                       TableISO4217 = `NGN` // Naira
+	TableISO4217CordobaOro                                  TableISO4217 = `NIO` // Cordoba Oro
+	TableISO4217NorwegianKrone                              TableISO4217 = `NOK` // Norwegian Krone
+	TableISO4217NepaleseRupee                               TableISO4217 = `NPR` // Nepalese Rupee
+	TableISO4217NewZealandDollar                            TableISO4217 = `NZD` // New Zealand Dollar
+	TableISO4217RialOmani                                   TableISO4217 = `OMR` // Rial Omani
+	TableISO4217Balboa                                      TableISO4217 = `PAB` // Balboa
+	TableISO4217Sol                                         TableISO4217 = `PEN` // Sol
+	TableISO4217Kina                                        TableISO4217 = `PGK` // Kina
+	TableISO4217PhilippinePeso                              TableISO4217 = `PHP` // Philippine Peso
+	TableISO4217PakistanRupee                               TableISO4217 = `PKR` // Pakistan Rupee
+	TableISO4217Zloty                                       TableISO4217 = `PLN` // Zloty
+	TableISO4217Guarani                                     TableISO4217 = `PYG` // Guarani
+	TableISO4217QatariRial                                  TableISO4217 = `QAR` // Qatari Rial
+	TableISO4217RomanianLeu                                 TableISO4217 = `RON` // Romanian Leu
+	TableISO4217SerbianDinar                                TableISO4217 = `RSD` // Serbian Dinar
+	TableISO4217RussianRuble                                TableISO4217 = `RUB` // Russian Ruble
+	TableISO4217RwandaFranc                                 TableISO4217 = `RWF` // Rwanda Franc
+	TableISO4217SaudiRiyal                                  TableISO4217 = `SAR` // Saudi Riyal
+	TableISO4217SolomonIslandsDollar                        TableISO4217 = `SBD` // Solomon Islands Dollar
+	TableISO4217SeychellesRupee                             TableISO4217 = `SCR` // Seychelles Rupee
+	TableISO4217SudanesePound                               TableISO4217 = `SDG` // Sudanese Pound
+	TableISO4217SwedishKrona                                TableISO4217 = `SEK` // Swedish Krona
+	TableISO4217SingaporeDollar                             TableISO4217 = `SGD` // Singapore Dollar
+	TableISO4217SaintHelenaPound                            TableISO4217 = `SHP` // Saint Helena Pound
+	TableISO4217Leone                                       TableISO4217 = `SLL` // Leone
+	TableISO4217SomaliShilling                              TableISO4217 = `SOS` // Somali Shilling
+	TableISO4217SurinamDollar                               TableISO4217 = `SRD` // Surinam Dollar
+	TableISO4217SouthSudanesePound                          TableISO4217 = `SSP` // South Sudanese Pound
+	TableISO4217Dobra                                       TableISO4217 = `STN` // Dobra
+	TableISO4217ElSalvadorColon                             TableISO4217 = `SVC` // El Salvador Colon
+	TableISO4217SyrianPound                                 TableISO4217 = `SYP` // Syrian Pound
+	TableISO4217Lilangeni                                   TableISO4217 = `SZL` // Lilangeni
+	TableISO4217Baht                                        TableISO4217 = `THB` // Baht
+	TableISO4217Somoni                                      TableISO4217 = `TJS` // Somoni
+	TableISO4217TurkmenistanNewManat                        TableISO4217 = `TMT` // Turkmenistan New Manat
+	TableISO4217TunisianDinar                               TableISO4217 = `TND` // Tunisian Dinar
+	TableISO4217PaAnga                                      TableISO4217 = `TOP` // Pa’anga
+	TableISO4217TurkishLira                                 TableISO4217 = `TRY` // Turkish Lira
+	TableISO4217TrinidadAndTobagoDollar                     TableISO4217 = `TTD` // Trinidad and Tobago Dollar
+	TableISO4217NewTaiwanDollar                             TableISO4217 = `TWD` // New Taiwan Dollar
+	TableISO4217TanzanianShilling                           TableISO4217 = `TZS` // Tanzanian Shilling
+	TableISO4217Hryvnia                                     TableISO4217 = `UAH` // Hryvnia
+	TableISO4217UgandaShilling                              TableISO4217 = `UGX` // Uganda Shilling
+	TableISO4217USDollar                                    TableISO4217 = `USD` // US Dollar
+	TableISO4217USDollarNextDay                             TableISO4217 = `USN` // US Dollar (Next day)
+	TableISO4217UruguayPesoEnUnidadesIndexadasUI            TableISO4217 = `UYI` // Uruguay Peso en Unidades Indexadas (UI)
+	TableISO4217PesoUruguayo                                TableISO4217 = `UYU` // Peso Uruguayo
+	TableISO4217UnidadPrevisional                           TableISO4217 = `UYW` // Unidad Previsional
+	TableISO4217UzbekistanSum                               TableISO4217 = `UZS` // Uzbekistan Sum
+	TableISO4217BolVarSoberano                              TableISO4217 = `VES` // Bolívar Soberano
+	TableISO4217Dong                                        TableISO4217 = `VND` // Dong
+	TableISO4217Vatu                                        TableISO4217 = `VUV` // Vatu
+	TableISO4217Tala                                        TableISO4217 = `WST` // Tala
+	TableISO4217CFAFrancBEAC                                TableISO4217 = `XAF` // CFA Franc BEAC
+	TableISO4217Silver                                      TableISO4217 = `XAG` // Silver
+	TableISO4217Gold                                        TableISO4217 = `XAU` // Gold
+	TableISO4217BondMarketsUnitEuropeanCompositeUnit        TableISO4217 = `XBA` // Bond Markets Unit European Composite Unit (EURCO)
+	TableISO4217BondMarketsUnitEuropeanMonetaryUnit         TableISO4217 = `XBB` // Bond Markets Unit European Monetary Unit (E.M.U.-6)
+	TableISO4217BondMarketsUnitEuropeanUnitOf               TableISO4217 = `XBC` // Bond Markets Unit European Unit of Account 9 (E.U.A.-9)
+	TableISO4217BondMarketsUnitEuropeanUnitOf_XBD           TableISO4217 = `XBD` // Bond Markets Unit European Unit of Account 17 (E.U.A.-17)
+	TableISO4217EastCaribbeanDollar                         TableISO4217 = `XCD` // East Caribbean Dollar
+	TableISO4217SDRSpecialDrawingRight                      TableISO4217 = `XDR` // SDR (Special Drawing Right)
+	TableISO4217CFAFrancBCEAO                               TableISO4217 = `XOF` // CFA Franc BCEAO
+	TableISO4217Palladium                                   TableISO4217 = `XPD` // Palladium
+	TableISO4217CFPFranc                                    TableISO4217 = `XPF` // CFP Franc
+	TableISO4217Platinum                                    TableISO4217 = `XPT` // Platinum
+	TableISO4217Sucre                                       TableISO4217 = `XSU` // Sucre
+	TableISO4217CodesSpecificallyReservedForTestingPurposes TableISO4217 = `XTS` // Codes specifically reserved for testing purposes
+	TableISO4217ADBUnitOfAccount                            TableISO4217 = `XUA` // ADB Unit of Account
+	TableISO4217TheCodesAssignedForTransactionsWhere        TableISO4217 = `XXX` // The codes assigned for transactions where no currency is involved
+	TableISO4217YemeniRial                                  TableISO4217 = `YER` // Yemeni Rial
+	TableISO4217Rand                                        TableISO4217 = `ZAR` // Rand
+	TableISO4217ZambianKwacha                               TableISO4217 = `ZMW` // Zambian Kwacha
+	TableISO4217ZimbabweDollar                              TableISO4217 = `ZWL` // Zimbabwe Dollar
+)
+
+// Description of the value, or empty if the value is not in table ISO4217.
+func (v TableISO4217) Description() string {
+	return tableDescription(`ISO4217`, string(v))
+}
+
+// Valid reports if the value is in table ISO4217.
+func (v TableISO4217) Valid() bool {
+	return TableValueLookup[`ISO4217`][string(v)]
+}
+
+// Table ISO4217.
+func (v TableISO4217) Table() Table {
+	return TableLookup[`ISO4217`]
+}
+
+// TableLastName is a value of table LastName, LastName.
+// Fields in this table are strings; convert a field, such as TableLastName(v), to use the helpers.
+type TableLastName string
+
+const (
+	TableLastNameAbbott      TableLastName = `Abbott`
+	TableLastNameAcevedo     TableLastName = `Acevedo`
+	TableLastNameAcosta      TableLastName = `Acosta`
+	TableLastNameAdams       TableLastName = `Adams`
+	TableLastNameAdkins      TableLastName = `Adkins`
+	TableLastNameAguilar     TableLastName = `Aguilar`
+	TableLastNameAguirre     TableLastName = `Aguirre`
+	TableLastNameAlbert      TableLastName = `Albert`
+	TableLastNameAlexander   TableLastName = `Alexander`
+	TableLastNameAlford      TableLastName = `Alford`
+	TableLastNameAllen       TableLastName = `Allen`
+	TableLastNameAllison     TableLastName = `Allison`
+	TableLastNameAlston      TableLastName = `Alston`
+	TableLastNameAlvarado    TableLastName = `Alvarado`
+	TableLastNameAlvarez     TableLastName = `Alvarez`
+	TableLastNameAnderson    TableLastName = `Anderson`
+	TableLastNameAndrews     TableLastName = `Andrews`
+	TableLastNameAnthony     TableLastName = `Anthony`
+	TableLastNameArmstrong   TableLastName = `Armstrong`
+	TableLastNameArnold      TableLastName = `Arnold`
+	TableLastNameAshley      TableLastName = `Ashley`
+	TableLastNameAtkins      TableLastName = `Atkins`
+	TableLastNameAtkinson    TableLastName = `Atkinson`
+	TableLastNameAustin      TableLastName = `Austin`
+	TableLastNameAvery       TableLastName = `Avery`
+	TableLastNameAvila       TableLastName = `Avila`
+	TableLastNameAyala       TableLastName = `Ayala`
+	TableLastNameAyers       TableLastName = `Ayers`
+	TableLastNameBailey      TableLastName = `Bailey`
+	TableLastNameBaird       TableLastName = `Baird`
+	TableLastNameBaker       TableLastName = `Baker`
+	TableLastNameBaldwin     TableLastName = `Baldwin`
+	TableLastNameBall        TableLastName = `Ball`
+	TableLastNameBallard     TableLastName = `Ballard`
+	TableLastNameBanks       TableLastName = `Banks`
+	TableLastNameBarber      TableLastName = `Barber`
+	TableLastNameBarker      TableLastName = `Barker`
+	TableLastNameBarlow      TableLastName = `Barlow`
+	TableLastNameBarnes      TableLastName = `Barnes`
+	TableLastNameBarnett     TableLastName = `Barnett`
+	TableLastNameBarr        TableLastName = `Barr`
+	TableLastNameBarrera     TableLastName = `Barrera`
+	TableLastNameBarrett     TableLastName = `Barrett`
+	TableLastNameBarron      TableLastName = `Barron`
+	TableLastNameBarry       TableLastName = `Barry`
+	TableLastNameBartlett    TableLastName = `Bartlett`
+	TableLastNameBarton      TableLastName = `Barton`
+	TableLastNameBass        TableLastName = `Bass`
+	TableLastNameBates       TableLastName = `Bates`
+	TableLastNameBattle      TableLastName = `Battle`
+	TableLastNameBauer       TableLastName = `Bauer`
+	TableLastNameBaxter      TableLastName = `Baxter`
+	TableLastNameBeach       TableLastName = `Beach`
+	TableLastNameBean        TableLastName = `Bean`
+	TableLastNameBeard       TableLastName = `Beard`
+	TableLastNameBeasley     TableLastName = `Beasley`
+	TableLastNameBeck        TableLastName = `Beck`
+	TableLastNameBecker      TableLastName = `Becker`
+	TableLastNameBell        TableLastName = `Bell`
+	TableLastNameBender      TableLastName = `Bender`
+	TableLastNameBenjamin    TableLastName = `Benjamin`
+	TableLastNameBennett     TableLastName = `Bennett`
+	TableLastNameBenson      TableLastName = `Benson`
+	TableLastNameBentley     TableLastName = `Bentley`
+	TableLastNameBenton      TableLastName = `Benton`
+	TableLastNameBerg        TableLastName = `Berg`
+	TableLastNameBerger      TableLastName = `Berger`
+	TableLastNameBernard     TableLastName = `Bernard`
+	TableLastNameBerry       TableLastName = `Berry`
+	TableLastNameBest        TableLastName = `Best`
+	TableLastNameBird        TableLastName = `Bird`
+	TableLastNameBishop      TableLastName = `Bishop`
+	TableLastNameBlack       TableLastName = `Black`
+	TableLastNameBlackburn   TableLastName = `Blackburn`
+	TableLastNameBlackwell   TableLastName = `Blackwell`
+	TableLastNameBlair       TableLastName = `Blair`
+	TableLastNameBlake       TableLastName = `Blake`
+	TableLastNameBlanchard   TableLastName = `Blanchard`
+	TableLastNameBlankenship TableLastName = `Blankenship`
+	TableLastNameBlevins     TableLastName = `Blevins`
+	TableLastNameBolton      TableLastName = `Bolton`
+	TableLastNameBond        TableLastName = `Bond`
+	TableLastNameBonner      TableLastName = `Bonner`
+	TableLastNameBooker      TableLastName = `Booker`
+	TableLastNameBoone       TableLastName = `Boone`
+	TableLastNameBooth       TableLastName = `Booth`
+	TableLastNameBowen       TableLastName = `Bowen`
+	TableLastNameBowers      TableLastName = `Bowers`
+	TableLastNameBowman      TableLastName = `Bowman`
+	TableLastNameBoyd        TableLastName = `Boyd`
+	TableLastNameBoyer       TableLastName = `Boyer`
+	TableLastNameBoyle       TableLastName = `Boyle`
+	TableLastNameBradford    TableLastName = `Bradford`
+	TableLastNameBradley     TableLastName = `Bradley`
+	TableLastNameBradshaw    TableLastName = `Bradshaw`
+	TableLastNameBrady       TableLastName = `Brady`
+	TableLastNameBranch      TableLastName = `Branch`
+	TableLastNameBray        TableLastName = `Bray`
+	TableLastNameBrennan     TableLastName = `Brennan`
+	TableLastNameBrewer      TableLastName = `Brewer`
+	TableLastNameBridges     TableLastName = `Bridges`
+	TableLastNameBriggs      TableLastName = `Briggs`
+	TableLastNameBright      TableLastName = `Bright`
+	TableLastNameBritt       TableLastName = `Britt`
+	TableLastNameBrock       TableLastName = `Brock`
+	TableLastNameBrooks      TableLastName = `Brooks`
+	TableLastNameBrown       TableLastName = `Brown`
+	TableLastNameBrowning    TableLastName = `Browning`
+	TableLastNameBruce       TableLastName = `Bruce`
+	TableLastNameBryan       TableLastName = `Bryan`
+	TableLastNameBryant      TableLastName = `Bryant`
+	TableLastNameBuchanan    TableLastName = `Buchanan`
+	TableLastNameBuck        TableLastName = `Buck`
+	TableLastNameBuckley     TableLastName = `Buckley`
+	TableLastNameBuckner     TableLastName = `Buckner`
+	TableLastNameBullock     TableLastName = `Bullock`
+	TableLastNameBurch       TableLastName = `Burch`
+	TableLastNameBurgess     TableLastName = `Burgess`
+	TableLastNameBurke       TableLastName = `Burke`
+	TableLastNameBurks       TableLastName = `Burks`
+	TableLastNameBurnett     TableLastName = `Burnett`
+	TableLastNameBurns       TableLastName = `Burns`
+	TableLastNameBurris      TableLastName = `Burris`
+	TableLastNameBurt        TableLastName = `Burt`
+	TableLastNameBurton      TableLastName = `Burton`
+	TableLastNameBush        TableLastName = `Bush`
+	TableLastNameButler      TableLastName = `Butler`
+	TableLastNameByers       TableLastName = `Byers`
+	TableLastNameByrd        TableLastName = `Byrd`
+	TableLastNameCabrera     TableLastName = `Cabrera`
+	TableLastNameCain        TableLastName = `Cain`
+	TableLastNameCalderon    TableLastName = `Calderon`
+	TableLastNameCaldwell    TableLastName = `Caldwell`
+	TableLastNameCalhoun     TableLastName = `Calhoun`
+	TableLastNameCallahan    TableLastName = `Callahan`
+	TableLastNameCamacho     TableLastName = `Camacho`
+	TableLastNameCameron     TableLastName = `Cameron`
+	TableLastNameCampbell    TableLastName = `Campbell`
+	TableLastNameCampos      TableLastName = `Campos`
+	TableLastNameCannon      TableLastName = `Cannon`
+	TableLastNameCantrell    TableLastName = `Cantrell`
+	TableLastNameCantu       TableLastName = `Cantu`
+	TableLastNameCardenas    TableLastName = `Cardenas`
+	TableLastNameCarey       TableLastName = `Carey`
+	TableLastNameCarlson     TableLastName = `Carlson`
+	TableLastNameCarney      TableLastName = `Carney`
+	TableLastNameCarpenter   TableLastName = `Carpenter`
+	TableLastNameCarr        TableLastName = `Carr`
+	TableLastNameCarrillo    TableLastName = `Carrillo`
+	TableLastNameCarroll     TableLastName = `Carroll`
+	TableLastNameCarson      TableLastName = `Carson`
+	TableLastNameCarter      TableLastName = `Carter`
+	TableLastNameCarver      TableLastName = `Carver`
+	TableLastNameCase        TableLastName = `Case`
+	TableLastNameCasey       TableLastName = `Casey`
+	TableLastNameCash        TableLastName = `Cash`
+	TableLastNameCastaneda   TableLastName = `Castaneda`
+	TableLastNameCastillo    TableLastName = `Castillo`
+	TableLastNameCastro      TableLastName = `Castro`
+	TableLastNameCervantes   TableLastName = `Cervantes`
+	TableLastNameChambers    TableLastName = `Chambers`
+	TableLastNameChan        TableLastName = `Chan`
+	TableLastNameChandler    TableLastName = `Chandler`
+	TableLastNameChaney      TableLastName = `Chaney`
+	TableLastNameChang       TableLastName = `Chang`
+	TableLastNameChapman     TableLastName = `Chapman`
+	TableLastNameCharles     TableLastName = `Charles`
+	TableLastNameChase       TableLastName = `Chase`
+	TableLastNameChavez      TableLastName = `Chavez`
+	TableLastNameChen        TableLastName = `Chen`
+	TableLastNameCherry      TableLastName = `Cherry`
+	TableLastNameChristensen TableLastName = `Christensen`
+	TableLastNameChristian   TableLastName = `Christian`
+	TableLastNameChurch      TableLastName = `Church`
+	TableLastNameClark       TableLastName = `Clark`
+	TableLastNameClarke      TableLastName = `Clarke`
+	TableLastNameClay        TableLastName = `Clay`
+	TableLastNameClayton     TableLastName = `Clayton`
+	TableLastNameClements    TableLastName = `Clements`
+	TableLastNameClemons     TableLastName = `Clemons`
+	TableLastNameCleveland   TableLastName = `Cleveland`
+	TableLastNameCline       TableLastName = `Cline`
+	TableLastNameCobb        TableLastName = `Cobb`
+	TableLastNameCochran     TableLastName = `Cochran`
+	TableLastNameCoffey      TableLastName = `Coffey`
+	TableLastNameCohen       TableLastName = `Cohen`
+	TableLastNameCole        TableLastName = `Cole`
+	TableLastNameColeman     TableLastName = `Coleman`
+	TableLastNameCollier     TableLastName = `Collier`
+	TableLastNameCollins     TableLastName = `Collins`
+	TableLastNameColon       TableLastName = `Colon`
+	TableLastNameCombs       TableLastName = `Combs`
+	TableLastNameCompton     TableLastName = `Compton`
+	TableLastNameConley      TableLastName = `Conley`
+	TableLastNameConner      TableLastName = `Conner`
+	TableLastNameConrad      TableLastName = `Conrad`
+	TableLastNameContreras   TableLastName = `Contreras`
+	TableLastNameConway      TableLastName = `Conway`
+	TableLastNameCook        TableLastName = `Cook`
+	TableLastNameCooke       TableLastName = `Cooke`
+	TableLastNameCooley      TableLastName = `Cooley`
+	TableLastNameCooper      TableLastName = `Cooper`
+	TableLastNameCopeland    TableLastName = `Copeland`
+	TableLastNameCortez      TableLastName = `Cortez`
+	TableLastNameCote        TableLastName = `Cote`
+	TableLastNameCotton      TableLastName = `Cotton`
+	TableLastNameCox         TableLastName = `Cox`
+	TableLastNameCraft       TableLastName = `Craft`
+	TableLastNameCraig       TableLastName = `Craig`
+	TableLastNameCrane       TableLastName = `Crane`
+	TableLastNameCrawford    TableLastName = `Crawford`
+	TableLastNameCrosby      TableLastName = `Crosby`
+	TableLastNameCross       TableLastName = `Cross`
+	TableLastNameCruz        TableLastName = `Cruz`
+	TableLastNameCummings    TableLastName = `Cummings`
+	TableLastNameCunningham  TableLastName = `Cunningham`
+	TableLastNameCurry       TableLastName = `Curry`
+	TableLastNameCurtis      TableLastName = `Curtis`
+	TableLastNameDale        TableLastName = `Dale`
+	TableLastNameDalton      TableLastName = `Dalton`
+	TableLastNameDaniel      TableLastName = `Daniel`
+	TableLastNameDaniels     TableLastName = `Daniels`
+	TableLastNameDaugherty   TableLastName = `Daugherty`
+	TableLastNameDavenport   TableLastName = `Davenport`
+	TableLastNameDavid       TableLastName = `David`
+	TableLastNameDavidson    TableLastName = `Davidson`
+	TableLastNameDavis       TableLastName = `Davis`
+	TableLastNameDawson      TableLastName = `Dawson`
+	TableLastNameDay         TableLastName = `Day`
+	TableLastNameDean        TableLastName = `Dean`
+	TableLastNameDecker      TableLastName = `Decker`
+	TableLastNameDejesus     TableLastName = `Dejesus`
+	TableLastNameDelacruz    TableLastName = `Delacruz`
+	TableLastNameDelaney     TableLastName = `Delaney`
+	TableLastNameDeleon      TableLastName = `Deleon`
+	TableLastNameDelgado     TableLastName = `Delgado`
+	TableLastNameDennis      TableLastName = `Dennis`
+	TableLastNameDiaz        TableLastName = `Diaz`
+	TableLastNameDickerson   TableLastName = `Dickerson`
+	TableLastNameDickson     TableLastName = `Dickson`
+	TableLastNameDillard     TableLastName = `Dillard`
+	TableLastNameDillon      TableLastName = `Dillon`
+	TableLastNameDixon       TableLastName = `Dixon`
+	TableLastNameDodson      TableLastName = `Dodson`
+	TableLastNameDominguez   TableLastName = `Dominguez`
+	TableLastNameDonaldson   TableLastName = `Donaldson`
+	TableLastNameDonovan     TableLastName = `Donovan`
+	TableLastNameDorsey      TableLastName = `Dorsey`
+	TableLastNameDotson      TableLastName = `Dotson`
+	TableLastNameDouglas     TableLastName = `Douglas`
+	TableLastNameDowns       TableLastName = `Downs`
+	TableLastNameDoyle       TableLastName = `Doyle`
+	TableLastNameDrake       TableLastName = `Drake`
+	TableLastNameDudley      TableLastName = `Dudley`
+	TableLastNameDuffy       TableLastName = `Duffy`
+	TableLastNameDuke        TableLastName = `Duke`
+	TableLastNameDuncan      TableLastName = `Duncan`
+	TableLastNameDunlap      TableLastName = `Dunlap`
+	TableLastNameDunn        TableLastName = `Dunn`
+	TableLastNameDuran       TableLastName = `Duran`
+	TableLastNameDurham      TableLastName = `Durham`
+	TableLastNameDyer        TableLastName = `Dyer`
+	TableLastNameEaton       TableLastName = `Eaton`
+	TableLastNameEdwards     TableLastName = `Edwards`
+	TableLastNameElliott     TableLastName = `Elliott`
+	TableLastNameEllis       TableLastName = `Ellis`
+	TableLastNameEllison     TableLastName = `Ellison`
+	TableLastNameEmerson     TableLastName = `Emerson`
+	TableLastNameEngland     TableLastName = `England`
+	TableLastNameEnglish     TableLastName = `English`
+	TableLastNameErickson    TableLastName = `Erickson`
+	TableLastNameEspinoza    TableLastName = `Espinoza`
+	TableLastNameEstes       TableLastName = `Estes`
+	TableLastNameEstrada     TableLastName = `Estrada`
+	TableLastNameEvans       TableLastName = `Evans`
+	TableLastNameEverett     TableLastName = `Everett`
+	TableLastNameEwing       TableLastName = `Ewing`
+	TableLastNameFarley      TableLastName = `Farley`
+	TableLastNameFarmer      TableLastName = `Farmer`
+	TableLastNameFarrell     TableLastName = `Farrell`
+	TableLastNameFaulkner    TableLastName = `Faulkner`
+	TableLastNameFerguson    TableLastName = `Ferguson`
+	TableLastNameFernandez   TableLastName = `Fernandez`
+	TableLastNameFerrell     TableLastName = `Ferrell`
+	TableLastNameFields      TableLastName = `Fields`
+	TableLastNameFigueroa    TableLastName = `Figueroa`
+	TableLastNameFinch       TableLastName = `Finch`
+	TableLastNameFinley      TableLastName = `Finley`
+	TableLastNameFischer     TableLastName = `Fischer`
+	TableLastNameFisher      TableLastName = `Fisher`
+	TableLastNameFitzgerald  TableLastName = `Fitzgerald`
+	TableLastNameFitzpatrick TableLastName = `Fitzpatrick`
+	TableLastNameFleming     TableLastName = `Fleming`
+	TableLastNameFletcher    TableLastName = `Fletcher`
+	TableLastNameFlores      TableLastName = `Flores`
+	TableLastNameFlowers     TableLastName = `Flowers`
+	TableLastNameFloyd       TableLastName = `Floyd`
+	TableLastNameFlynn       TableLastName = `Flynn`
+	TableLastNameFoley       TableLastName = `Foley`
+	TableLastNameForbes      TableLastName = `Forbes`
+	TableLastNameFord        TableLastName = `Ford`
+	TableLastNameForeman     TableLastName = `Foreman`
+	TableLastNameFoster      TableLastName = `Foster`
+	TableLastNameFowler      TableLastName = `Fowler`
+	TableLastNameFox         TableLastName = `Fox`
+	TableLastNameFrancis     TableLastName = `Francis`
+	TableLastNameFranco      TableLastName = `Franco`
+	TableLastNameFrank       TableLastName = `Frank`
+	TableLastNameFranklin    TableLastName = `Franklin`
+	TableLastNameFranks      TableLastName = `Franks`
+	TableLastNameFrazier     TableLastName = `Frazier`
+	TableLastNameFrederick   TableLastName = `Frederick`
+	TableLastNameFreeman     TableLastName = `Freeman`
+	TableLastNameFrench      TableLastName = `French`
+	TableLastNameFrost       TableLastName = `Frost`
+	TableLastNameFry         TableLastName = `Fry`
+	TableLastNameFrye        TableLastName = `Frye`
+	TableLastNameFuentes     TableLastName = `Fuentes`
+	TableLastNameFuller      TableLastName = `Fuller`
+	TableLastNameFulton      TableLastName = `Fulton`
+	TableLastNameGaines      TableLastName = `Gaines`
+	TableLastNameGallagher   TableLastName = `Gallagher`
+	TableLastNameGallegos    TableLastName = `Gallegos`
+	TableLastNameGalloway    TableLastName = `Galloway`
+	TableLastNameGamble      TableLastName = `Gamble`
+	TableLastNameGarcia      TableLastName = `Garcia`
+	TableLastNameGardner     TableLastName = `Gardner`
+	TableLastNameGarner      TableLastName = `Garner`
+	TableLastNameGarrett     TableLastName = `Garrett`
+	TableLastNameGarrison    TableLastName = `Garrison`
+	TableLastNameGarza       TableLastName = `Garza`
+	TableLastNameGates       TableLastName = `Gates`
+	TableLastNameGay         TableLastName = `Gay`
+	TableLastNameGentry      TableLastName = `Gentry`
+	TableLastNameGeorge      TableLastName = `George`
+	TableLastNameGibbs       TableLastName = `Gibbs`
+	TableLastNameGibson      TableLastName = `Gibson`
+	TableLastNameGilbert     TableLastName = `Gilbert`
+	TableLastNameGiles       TableLastName = `Giles`
+	TableLastNameGill        TableLastName = `Gill`
+	TableLastNameGillespie   TableLastName = `Gillespie`
+	TableLastNameGilliam     TableLastName = `Gilliam`
+	TableLastNameGilmore     TableLastName = `Gilmore`
+	TableLastNameGlass       TableLastName = `Glass`
+	TableLastNameGlenn       TableLastName = `Glenn`
+	TableLastNameGlover      TableLastName = `Glover`
+	TableLastNameGoff        TableLastName = `Goff`
+	TableLastNameGolden      TableLastName = `Golden`
+	TableLastNameGomez       TableLastName = `Gomez`
+	TableLastNameGonzales    TableLastName = `Gonzales`
+	TableLastNameGonzalez    TableLastName = `Gonzalez`
+	TableLastNameGood        TableLastName = `Good`
+	TableLastNameGoodman     TableLastName = `Goodman`
+	TableLastNameGoodwin     TableLastName = `Goodwin`
+	TableLastNameGordon      TableLastName = `Gordon`
+	TableLastNameGould       TableLastName = `Gould`
+	TableLastNameGraham      TableLastName = `Graham`
+	TableLastNameGrant       TableLastName = `Grant`
+	TableLastNameGraves      TableLastName = `Graves`
+	TableLastNameGray        TableLastName = `Gray`
+	TableLastNameGreen       TableLastName = `Green`
+	TableLastNameGreene      TableLastName = `Greene`
+	TableLastNameGreer       TableLastName = `Greer`
+	TableLastNameGregory     TableLastName = `Gregory`
+	TableLastNameGriffin     TableLastName = `Griffin`
+	TableLastNameGriffith    TableLastName = `Griffith`
+	TableLastNameGrimes      TableLastName = `Grimes`
+	TableLastNameGross       TableLastName = `Gross`
+	TableLastNameGuerra      TableLastName = `Guerra`
+	TableLastNameGuerrero    TableLastName = `Guerrero`
+	TableLastNameGuthrie     TableLastName = `Guthrie`
+	TableLastNameGutierrez   TableLastName = `Gutierrez`
+	TableLastNameGuy         TableLastName = `Guy`
+	TableLastNameGuzman      TableLastName = `Guzman`
+	TableLastNameHahn        TableLastName = `Hahn`
+	TableLastNameHale        TableLastName = `Hale`
+	TableLastNameHaley       TableLastName = `Haley`
+	TableLastNameHall        TableLastName = `Hall`
+	TableLastNameHamilton    TableLastName = `Hamilton`
+	TableLastNameHammond     TableLastName = `Hammond`
+	TableLastNameHampton     TableLastName = `Hampton`
+	TableLastNameHancock     TableLastName = `Hancock`
+	TableLastNameHaney       TableLastName = `Haney`
+	TableLastNameHansen      TableLastName = `Hansen`
+	TableLastNameHanson      TableLastName = `Hanson`
+	TableLastNameHardin      TableLastName = `Hardin`
+	TableLastNameHarding     TableLastName = `Harding`
+	TableLastNameHardy       TableLastName = `Hardy`
+	TableLastNameHarmon      TableLastName = `Harmon`
+	TableLastNameHarper      TableLastName = `Harper`
+	TableLastNameHarrell     TableLastName = `Harrell`
+	TableLastNameHarrington  TableLastName = `Harrington`
+	TableLastNameHarris      TableLastName = `Harris`
+	TableLastNameHarrison    TableLastName = `Harrison`
+	TableLastNameHart        TableLastName = `Hart`
+	TableLastNameHartman     TableLastName = `Hartman`
+	TableLastNameHarvey      TableLastName = `Harvey`
+	TableLastNameHatfield    TableLastName = `Hatfield`
+	TableLastNameHawkins     TableLastName = `Hawkins`
+	TableLastNameHayden      TableLastName = `Hayden`
+	TableLastNameHayes       TableLastName = `Hayes`
+	TableLastNameHaynes      TableLastName = `Haynes`
+	TableLastNameHays        TableLastName = `Hays`
+	TableLastNameHead        TableLastName = `Head`
+	TableLastNameHeath       TableLastName = `Heath`
+	TableLastNameHebert      TableLastName = `Hebert`
+	TableLastNameHenderson   TableLastName = `Henderson`
+	TableLastNameHendricks   TableLastName = `Hendricks`
+	TableLastNameHendrix     TableLastName = `Hendrix`
+	TableLastNameHenry       TableLastName = `Henry`
+	TableLastNameHensley     TableLastName = `Hensley`
+	TableLastNameHenson      TableLastName = `Henson`
+	TableLastNameHerman      TableLastName = `Herman`
+	TableLastNameHernandez   TableLastName = `Hernandez`
+	TableLastNameHerrera     TableLastName = `Herrera`
+	TableLastNameHerring     TableLastName = `Herring`
+	TableLastNameHess        TableLastName = `Hess`
+	TableLastNameHester      TableLastName = `Hester`
+	TableLastNameHewitt      TableLastName = `Hewitt`
+	TableLastNameHickman     TableLastName = `Hickman`
+	TableLastNameHicks       TableLastName = `Hicks`
+	TableLastNameHiggins     TableLastName = `Higgins`
+	TableLastNameHill        TableLastName = `Hill`
+	TableLastNameHines       TableLastName = `Hines`
+	TableLastNameHinton      TableLastName = `Hinton`
+	TableLastNameHobbs       TableLastName = `Hobbs`
+	TableLastNameHodge       TableLastName = `Hodge`
+	TableLastNameHodges      TableLastName = `Hodges`
+	TableLastNameHoffman     TableLastName = `Hoffman`
+	TableLastNameHogan       TableLastName = `Hogan`
+	TableLastNameHolcomb     TableLastName = `Holcomb`
+	TableLastNameHolden      TableLastName = `Holden`
+	TableLastNameHolder      TableLastName = `Holder`
+	TableLastNameHolland     TableLastName = `Holland`
+	TableLastNameHolloway    TableLastName = `Holloway`
+	TableLastNameHolman      TableLastName = `Holman`
+	TableLastNameHolmes      TableLastName = `Holmes`
+	TableLastNameHolt        TableLastName = `Holt`
+	TableLastNameHood        TableLastName = `Hood`
+	TableLastNameHooper      TableLastName = `Hooper`
+	TableLastNameHoover      TableLastName = `Hoover`
+	TableLastNameHopkins     TableLastName = `Hopkins`
+	TableLastNameHopper      TableLastName = `Hopper`
+	TableLastNameHorn        TableLastName = `Horn`
+	TableLastNameHorne       TableLastName = `Horne`
+	TableLastNameHorton      TableLastName = `Horton`
+	TableLastNameHouse       TableLastName = `House`
+	TableLastNameHouston     TableLastName = `Houston`
+	TableLastNameHoward      TableLastName = `Howard`
+	TableLastNameHowe        TableLastName = `Howe`
+	TableLastNameHowell      TableLastName = `Howell`
+	TableLastNameHubbard     TableLastName = `Hubbard`
+	TableLastNameHuber       TableLastName = `Huber`
+	TableLastNameHudson      TableLastName = `Hudson`
+	TableLastNameHuff        TableLastName = `Huff`
+	TableLastNameHuffman     TableLastName = `Huffman`
+	TableLastNameHughes      TableLastName = `Hughes`
+	TableLastNameHull        TableLastName = `Hull`
+	TableLastNameHumphrey    TableLastName = `Humphrey`
+	TableLastNameHunt        TableLastName = `Hunt`
+	TableLastNameHunter      TableLastName = `Hunter`
+	TableLastNameHurley      TableLastName = `Hurley`
+	TableLastNameHurst       TableLastName = `Hurst`
+	TableLastNameHutchinson  TableLastName = `Hutchinson`
+	TableLastNameHyde        TableLastName = `Hyde`
+	TableLastNameIngram      TableLastName = `Ingram`
+	TableLastNameIrwin       TableLastName = `Irwin`
+	TableLastNameJackson     TableLastName = `Jackson`
+	TableLastNameJacobs      TableLastName = `Jacobs`
+	TableLastNameJacobson    TableLastName = `Jacobson`
+	TableLastNameJames       TableLastName = `James`
+	TableLastNameJarvis      TableLastName = `Jarvis`
+	TableLastNameJefferson   TableLastName = `Jefferson`
+	TableLastNameJenkins     TableLastName = `Jenkins`
+	TableLastNameJennings    TableLastName = `Jennings`
+	TableLastNameJensen      TableLastName = `Jensen`
+	TableLastNameJimenez     TableLastName = `Jimenez`
+	TableLastNameJohns       TableLastName = `Johns`
+	TableLastNameJohnson     TableLastName = `Johnson`
+	TableLastNameJohnston    TableLastName = `Johnston`
+	TableLastNameJones       TableLastName = `Jones`
+	TableLastNameJordan      TableLastName = `Jordan`
+	TableLastNameJoseph      TableLastName = `Joseph`
+	TableLastNameJoyce       TableLastName = `Joyce`
+	TableLastNameJoyner      TableLastName = `Joyner`
+	TableLastNameJuarez      TableLastName = `Juarez`
+	TableLastNameJustice     TableLastName = `Justice`
+	TableLastNameKane        TableLastName = `Kane`
+	TableLastNameKaufman     TableLastName = `Kaufman`
+	TableLastNameKeith       TableLastName = `Keith`
+	TableLastNameKeller      TableLastName = `Keller`
+	TableLastNameKelley      TableLastName = `Kelley`
+	TableLastNameKelly       TableLastName = `Kelly`
+	TableLastNameKemp        TableLastName = `Kemp`
+	TableLastNameKennedy     TableLastName = `Kennedy`
+	TableLastNameKent        TableLastName = `Kent`
+	TableLastNameKerr        TableLastName = `Kerr`
+	TableLastNameKey         TableLastName = `Key`
+	TableLastNameKidd        TableLastName = `Kidd`
+	TableLastNameKim         TableLastName = `Kim`
+	TableLastNameKing        TableLastName = `King`
+	TableLastNameKinney      TableLastName = `Kinney`
+	TableLastNameKirby       TableLastName = `Kirby`
+	TableLastNameKirk        TableLastName = `Kirk`
+	TableLastNameKirkland    TableLastName = `Kirkland`
+	TableLastNameKlein       TableLastName = `Klein`
+	TableLastNameKline       TableLastName = `Kline`
+	TableLastNameKnapp       TableLastName = `Knapp`
+	TableLastNameKnight      TableLastName = `Knight`
+	TableLastNameKnowles     TableLastName = `Knowles`
+	TableLastNameKnox        TableLastName = `Knox`
+	TableLastNameKoch        TableLastName = `Koch`
+	TableLastNameKramer      TableLastName = `Kramer`
+	TableLastNameLamb        TableLastName = `Lamb`
+	TableLastNameLambert     TableLastName = `Lambert`
+	TableLastNameLancaster   TableLastName = `Lancaster`
+	TableLastNameLandry      TableLastName = `Landry`
+	TableLastNameLane        TableLastName = `Lane`
+	TableLastNameLang        TableLastName = `Lang`
+	TableLastNameLangley     TableLastName = `Langley`
+	TableLastNameLara        TableLastName = `Lara`
+	TableLastNameLarsen      TableLastName = `Larsen`
+	TableLastNameLarson      TableLastName = `Larson`
+	TableLastNameLawrence    TableLastName = `Lawrence`
+	TableLastNameLawson      TableLastName = `Lawson`
+	TableLastNameLe          TableLastName = `Le`
+	TableLastNameLeach       TableLastName = `Leach`
+	TableLastNameLeblanc     TableLastName = `Leblanc`
+	TableLastNameLee         TableLastName = `Lee`
+	TableLastNameLeon        TableLastName = `Leon`
+	TableLastNameLeonard     TableLastName = `Leonard`
+	TableLastNameLester      TableLastName = `Lester`
+	TableLastNameLevine      TableLastName = `Levine`
+	TableLastNameLevy        TableLastName = `Levy`
+	TableLastNameLewis       TableLastName = `Lewis`
+	TableLastNameLindsay     TableLastName = `Lindsay`
+	TableLastNameLindsey     TableLastName = `Lindsey`
+	TableLastNameLittle      TableLastName = `Little`
+	TableLastNameLivingston  TableLastName = `Livingston`
+	TableLastNameLloyd       TableLastName = `Lloyd`
+	TableLastNameLogan       TableLastName = `Logan`
+	TableLastNameLong        TableLastName = `Long`
+	TableLastNameLopez       TableLastName = `Lopez`
+	TableLastNameLott        TableLastName = `Lott`
+	TableLastNameLove        TableLastName = `Love`
+	TableLastNameLowe        TableLastName = `Lowe`
+	TableLastNameLowery      TableLastName = `Lowery`
+	TableLastNameLucas       TableLastName = `Lucas`
+	TableLastNameLuna        TableLastName = `Luna`
+	TableLastNameLynch       TableLastName = `Lynch`
+	TableLastNameLynn        TableLastName = `Lynn`
+	TableLastNameLyons       TableLastName = `Lyons`
+	TableLastNameMacdonald   TableLastName = `Macdonald`
+	TableLastNameMacias      TableLastName = `Macias`
+	TableLastNameMack        TableLastName = `Mack`
+	TableLastNameMadden      TableLastName = `Madden`
+	TableLastNameMaddox      TableLastName = `Maddox`
+	TableLastNameMaldonado   TableLastName = `Maldonado`
+	TableLastNameMalone      TableLastName = `Malone`
+	TableLastNameMann        TableLastName = `Mann`
+	TableLastNameManning     TableLastName = `Manning`
+	TableLastNameMarks       TableLastName = `Marks`
+	TableLastNameMarquez     TableLastName = `Marquez`
+	TableLastNameMarsh       TableLastName = `Marsh`
+	TableLastNameMarshall    TableLastName = `Marshall`
+	TableLastNameMartin      TableLastName = `Martin`
+	TableLastNameMartinez    TableLastName = `Martinez`
+	TableLastNameMason       TableLastName = `Mason`
+	TableLastNameMassey      TableLastName = `Massey`
+	TableLastNameMathews     TableLastName = `Mathews`
+	TableLastNameMathis      TableLastName = `Mathis`
+	TableLastNameMatthews    TableLastName = `Matthews`
+	TableLastNameMaxwell     TableLastName = `Maxwell`
+	TableLastNameMay         TableLastName = `May`
+	TableLastNameMayer       TableLastName = `Mayer`
+	TableLastNameMaynard     TableLastName = `Maynard`
+	TableLastNameMayo        TableLastName = `Mayo`
+	TableLastNameMays        TableLastName = `Mays`
+	TableLastNameMcbride     TableLastName = `Mcbride`
+	TableLastNameMccall      TableLastName = `Mccall`
+	TableLastNameMccarthy    TableLastName = `Mccarthy`
+	TableLastNameMccarty     TableLastName = `Mccarty`
+	TableLastNameMcclain     TableLastName = `Mcclain`
+	TableLastNameMcclure     TableLastName = `Mcclure`
+	TableLastNameMcconnell   TableLastName = `Mcconnell`
+	TableLastNameMccormick   TableLastName = `Mccormick`
+	TableLastNameMccoy       TableLastName = `Mccoy`
+	TableLastNameMccray      TableLastName = `Mccray`
+	TableLastNameMccullough  TableLastName = `Mccullough`
+	TableLastNameMcdaniel    TableLastName = `Mcdaniel`
+	TableLastNameMcdonald    TableLastName = `Mcdonald`
+	TableLastNameMcdowell    TableLastName = `Mcdowell`
+	TableLastNameMcfadden    TableLastName = `Mcfadden`
+	TableLastNameMcfarland   TableLastName = `Mcfarland`
+	TableLastNameMcgee       TableLastName = `Mcgee`
+	TableLastNameMcgowan     TableLastName = `Mcgowan`
+	TableLastNameMcguire     TableLastName = `Mcguire`
+	TableLastNameMcintosh    TableLastName = `Mcintosh`
+	TableLastNameMcintyre    TableLastName = `Mcintyre`
+	TableLastNameMckay       TableLastName = `Mckay`
+	TableLastNameMckee       TableLastName = `Mckee`
+	TableLastNameMckenzie    TableLastName = `Mckenzie`
+	TableLastNameMckinney    TableLastName = `Mckinney`
+	TableLastNameMcknight    TableLastName = `Mcknight`
+	TableLastNameMclaughlin  TableLastName = `Mclaughlin`
+	TableLastNameMclean      TableLastName = `Mclean`
+	TableLastNameMcleod      TableLastName = `Mcleod`
+	TableLastNameMcmahon     TableLastName = `Mcmahon`
+	TableLastNameMcmillan    TableLastName = `Mcmillan`
+	TableLastNameMcneil      TableLastName = `Mcneil`
+	TableLastNameMcpherson   TableLastName = `Mcpherson`
+	TableLastNameMeadows     TableLastName = `Meadows`
+	TableLastNameMedina      TableLastName = `Medina`
+	TableLastNameMejia       TableLastName = `Mejia`
+	TableLastNameMelendez    TableLastName = `Melendez`
+	TableLastNameMelton      TableLastName = `Melton`
+	TableLastNameMendez      TableLastName = `Mendez`
+	TableLastNameMendoza     TableLastName = `Mendoza`
+	TableLastNameMercado     TableLastName = `Mercado`
+	TableLastNameMercer      TableLastName = `Mercer`
+	TableLastNameMerrill     TableLastName = `Merrill`
+	TableLastNameMerritt     TableLastName = `Merritt`
+	TableLastNameMeyer       TableLastName = `Meyer`
+	TableLastNameMeyers      TableLastName = `Meyers`
+	TableLastNameMichael     TableLastName = `Michael`
+	TableLastNameMiddleton   TableLastName = `Middleton`
+	TableLastNameMiles       TableLastName = `Miles`
+	TableLastNameMiller      TableLastName = `Miller`
+	TableLastNameMills       TableLastName = `Mills`
+	TableLastNameMiranda     TableLastName = `Miranda`
+	TableLastNameMitchell    TableLastName = `Mitchell`
+	TableLastNameMolina      TableLastName = `Molina`
+	TableLastNameMonroe      TableLastName = `Monroe`
+	TableLastNameMontgomery  TableLastName = `Montgomery`
+	TableLastNameMontoya     TableLastName = `Montoya`
+	TableLastNameMoody       TableLastName = `Moody`
+	TableLastNameMoon        TableLastName = `Moon`
+	TableLastNameMooney      TableLastName = `Mooney`
+	TableLastNameMoore       TableLastName = `Moore`
+	TableLastNameMorales     TableLastName = `Morales`
+	TableLastNameMoran       TableLastName = `Moran`
+	TableLastNameMoreno      TableLastName = `Moreno`
+	TableLastNameMorgan      TableLastName = `Morgan`
+	TableLastNameMorin       TableLastName = `Morin`
+	TableLastNameMorris      TableLastName = `Morris`
+	TableLastNameMorrison    TableLastName = `Morrison`
+	TableLastNameMorrow      TableLastName = `Morrow`
+	TableLastNameMorse       TableLastName = `Morse`
+	TableLastNameMorton      TableLastName = `Morton`
+	TableLastNameMoses       TableLastName = `Moses`
+	TableLastNameMosley      TableLastName = `Mosley`
+	TableLastNameMoss        TableLastName = `Moss`
+	TableLastNameMueller     TableLastName = `Mueller`
+	TableLastNameMullen      TableLastName = `Mullen`
+	TableLastNameMullins     TableLastName = `Mullins`
+	TableLastNameMunoz       TableLastName = `Munoz`
+	TableLastNameMurphy      TableLastName = `Murphy`
+	TableLastNameMurray      TableLastName = `Murray`
+	TableLastNameMyers       TableLastName = `Myers`
+	TableLastNameNash        TableLastName = `Nash`
+	TableLastNameNavarro     TableLastName = `Navarro`
+	TableLastNameNeal        TableLastName = `Neal`
+	TableLastNameNelson      TableLastName = `Nelson`
+	TableLastNameNewman      TableLastName = `Newman`
+	TableLastNameNewton      TableLastName = `Newton`
+	TableLastNameNguyen      TableLastName = `Nguyen`
+	TableLastNameNichols     TableLastName = `Nichols`
+	TableLastNameNicholson   TableLastName = `Nicholson`
+	TableLastNameNielsen     TableLastName = `Nielsen`
+	TableLastNameNieves      TableLastName = `Nieves`
+	TableLastNameNixon       TableLastName = `Nixon`
+	TableLastNameNoble       TableLastName = `Noble`
+	TableLastNameNoel        TableLastName = `Noel`
+	TableLastNameNolan       TableLastName = `Nolan`
+	TableLastNameNorman      TableLastName = `Norman`
+	TableLastNameNorris      TableLastName = `Norris`
+	TableLastNameNorton      TableLastName = `Norton`
+	TableLastNameNunez       TableLastName = `Nunez`
+	TableLastNameObrien      TableLastName = `Obrien`
+	TableLastNameOchoa       TableLastName = `Ochoa`
+	TableLastNameOconnor     TableLastName = `Oconnor`
+	TableLastNameOdom        TableLastName = `Odom`
+	TableLastNameOdonnell    TableLastName = `Odonnell`
+	TableLastNameOliver      TableLastName = `Oliver`
+	TableLastNameOlsen       TableLastName = `Olsen`
+	TableLastNameOlson       TableLastName = `Olson`
+	TableLastNameOneal       TableLastName = `Oneal`
+	TableLastNameOneil       TableLastName = `Oneil`
+	TableLastNameOneill      TableLastName = `Oneill`
+	TableLastNameOrr         TableLastName = `Orr`
+	TableLastNameOrtega      TableLastName = `Ortega`
+	TableLastNameOrtiz       TableLastName = `Ortiz`
+	TableLastNameOsborn      TableLastName = `Osborn`
+	TableLastNameOsborne     TableLastName = `Osborne`
+	TableLastNameOwen        TableLastName = `Owen`
+	TableLastNameOwens       TableLastName = `Owens`
+	TableLastNamePace        TableLastName = `Pace`
+	TableLastNamePacheco     TableLastName = `Pacheco`
+	TableLastNamePadilla     TableLastName = `Padilla`
+	TableLastNamePage        TableLastName = `Page`
+	TableLastNamePalmer      TableLastName = `Palmer`
+	TableLastNamePark        TableLastName = `Park`
+	TableLastNameParker      TableLastName = `Parker`
+	TableLastNameParks       TableLastName = `Parks`
+	TableLastNameParrish     TableLastName = `Parrish`
+	TableLastNameParsons     TableLastName = `Parsons`
+	TableLastNamePate        TableLastName = `Pate`
+	TableLastNamePatel       TableLastName = `Patel`
+	TableLastNamePatrick     TableLastName = `Patrick`
+	TableLastNamePatterson   TableLastName = `Patterson`
+	TableLastNamePatton      TableLastName = `Patton`
+	TableLastNamePaul        TableLastName = `Paul`
+	TableLastNamePayne       TableLastName = `Payne`
+	TableLastNamePearson     TableLastName = `Pearson`
+	TableLastNamePeck        TableLastName = `Peck`
+	TableLastNamePena        TableLastName = `Pena`
+	TableLastNamePennington  TableLastName = `Pennington`
+	TableLastNamePerez       TableLastName = `Perez`
+	TableLastNamePerkins     TableLastName = `Perkins`
+	TableLastNamePerry       TableLastName = `Perry`
+	TableLastNamePeters      TableLastName = `Peters`
+	TableLastNamePetersen    TableLastName = `Petersen`
+	TableLastNamePeterson    TableLastName = `Peterson`
+	TableLastNamePetty       TableLastName = `Petty`
+	TableLastNamePhelps      TableLastName = `Phelps`
+	TableLastNamePhillips    TableLastName = `Phillips`
+	TableLastNamePickett     TableLastName = `Pickett`
+	TableLastNamePierce      TableLastName = `Pierce`
+	TableLastNamePittman     TableLastName = `Pittman`
+	TableLastNamePitts       TableLastName = `Pitts`
+	TableLastNamePollard     TableLastName = `Pollard`
+	TableLastNamePoole       TableLastName = `Poole`
+	TableLastNamePope        TableLastName = `Pope`
+	TableLastNamePorter      TableLastName = `Porter`
+	TableLastNamePotter      TableLastName = `Potter`
+	TableLastNamePotts       TableLastName = `Potts`
+	TableLastNamePowell      TableLastName = `Powell`
+	TableLastNamePowers      TableLastName = `Powers`
+	TableLastNamePratt       TableLastName = `Pratt`
+	TableLastNamePreston     TableLastName = `Preston`
+	TableLastNamePrice       TableLastName = `Price`
+	TableLastNamePrince      TableLastName = `Prince`
+	TableLastNamePruitt      TableLastName = `Pruitt`
+	TableLastNamePuckett     TableLastName = `Puckett`
+	TableLastNamePugh        TableLastName = `Pugh`
+	TableLastNameQuinn       TableLastName = `Quinn`
+	TableLastNameRamirez     TableLastName = `Ramirez`
+	TableLastNameRamos       TableLastName = `Ramos`
+	TableLastNameRamsey      TableLastName = `Ramsey`
+	TableLastNameRandall     TableLastName = `Randall`
+	TableLastNameRandolph    TableLastName = `Randolph`
+	TableLastNameRasmussen   TableLastName = `Rasmussen`
+	TableLastNameRatliff     TableLastName = `Ratliff`
+	TableLastNameRay         TableLastName = `Ray`
+	TableLastNameRaymond     TableLastName = `Raymond`
+	TableLastNameReed        TableLastName = `Reed`
+	TableLastNameReese       TableLastName = `Reese`
+	TableLastNameReeves      TableLastName = `Reeves`
+	TableLastNameReid        TableLastName = `Reid`
+	TableLastNameReilly      TableLastName = `Reilly`
+	TableLastNameReyes       TableLastName = `Reyes`
+	TableLastNameReynolds    TableLastName = `Reynolds`
+	TableLastNameRhodes      TableLastName = `Rhodes`
+	TableLastNameRice        TableLastName = `Rice`
+	TableLastNameRich        TableLastName = `Rich`
+	TableLastNameRichard     TableLastName = `Richard`
+	TableLastNameRichards    TableLastName = `Richards`
+	TableLastNameRichardson  TableLastName = `Richardson`
+	TableLastNameRichmond    TableLastName = `Richmond`
+	TableLastNameRiddle      TableLastName = `Riddle`
+	TableLastNameRiggs       TableLastName = `Riggs`
+	TableLastNameRiley       TableLastName = `Riley`
+	TableLastNameRios        TableLastName = `Rios`
+	TableLastNameRivas       TableLastName = `Rivas`
+	TableLastNameRivera      TableLastName = `Rivera`
+	TableLastNameRivers      TableLastName = `Rivers`
+	TableLastNameRoach       TableLastName = `Roach`
+	TableLastNameRobbins     TableLastName = `Robbins`
+	TableLastNameRoberson    TableLastName = `Roberson`
+	TableLastNameRoberts     TableLastName = `Roberts`
+	TableLastNameRobertson   TableLastName = `Robertson`
+	TableLastNameRobinson    TableLastName = `Robinson`
+	TableLastNameRobles      TableLastName = `Robles`
+	TableLastNameRocha       TableLastName = `Rocha`
+	TableLastNameRodgers     TableLastName = `Rodgers`
+	TableLastNameRodriguez   TableLastName = `Rodriguez`
+	TableLastNameRodriquez   TableLastName = `Rodriquez`
+	TableLastNameRogers      TableLastName = `Rogers`
+	TableLastNameRojas       TableLastName = `Rojas`
+	TableLastNameRollins     TableLastName = `Rollins`
+	TableLastNameRoman       TableLastName = `Roman`
+	TableLastNameRomero      TableLastName = `Romero`
+	TableLastNameRosa        TableLastName = `Rosa`
+	TableLastNameRosales     TableLastName = `Rosales`
+	TableLastNameRosario     TableLastName = `Rosario`
+	TableLastNameRose        TableLastName = `Rose`
+	TableLastNameRoss        TableLastName = `Ross`
+	TableLastNameRoth        TableLastName = `Roth`
+	TableLastNameRowe        TableLastName = `Rowe`
+	TableLastNameRowland     TableLastName = `Rowland`
+	TableLastNameRoy         TableLastName = `Roy`
+	TableLastNameRuiz        TableLastName = `Ruiz`
+	TableLastNameRush        TableLastName = `Rush`
+	TableLastNameRussell     TableLastName = `Russell`
+	TableLastNameRusso       TableLastName = `Russo`
+	TableLastNameRutledg     TableLastName = `Rutledg`
+	TableLastNameRyan        TableLastName = `Ryan`
+	TableLastNameSalas       TableLastName = `Salas`
+	TableLastNameSalazar     TableLastName = `Salazar`
+	TableLastNameSalinas     TableLastName = `Salinas`
+	TableLastNameSampson     TableLastName = `Sampson`
+	TableLastNameSanchez     TableLastName = `Sanchez`
+	TableLastNameSanders     TableLastName = `Sanders`
+	TableLastNameSandoval    TableLastName = `Sandoval`
+	TableLastNameSanford     TableLastName = `Sanford`
+	TableLastNameSantana     TableLastName = `Santana`
+	TableLastNameSantiago    TableLastName = `Santiago`
+	TableLastNameSantos      TableLastName = `Santos`
+	TableLastNameSargent     TableLastName = `Sargent`
+	TableLastNameSaunders    TableLastName = `Saunders`
+	TableLastNameSavage      TableLastName = `Savage`
+	TableLastNameSawyer      TableLastName = `Sawyer`
+	TableLastNameSchmidt     TableLastName = `Schmidt`
+	TableLastNameSchneider   TableLastName = `Schneider`
+	TableLastNameSchroeder   TableLastName = `Schroeder`
+	TableLastNameSchultz     TableLastName = `Schultz`
+	TableLastNameSchwartz    TableLastName = `Schwartz`
+	TableLastNameScott       TableLastName = `Scott`
+	TableLastNameSears       TableLastName = `Sears`
+	TableLastNameSellers     TableLastName = `Sellers`
+	TableLastNameSerrano     TableLastName = `Serrano`
+	TableLastNameSexton      TableLastName = `Sexton`
+	TableLastNameShaffer     TableLastName = `Shaffer`
+	TableLastNameShannon     TableLastName = `Shannon`
+	TableLastNameSharp       TableLastName = `Sharp`
+	TableLastNameSharpe      TableLastName = `Sharpe`
+	TableLastNameShaw        TableLastName = `Shaw`
+	TableLastNameShelton     TableLastName = `Shelton`
+	TableLastNameShepard     TableLastName = `Shepard`
+	TableLastNameShepherd    TableLastName = `Shepherd`
+	TableLastNameSheppard    TableLastName = `Sheppard`
+	TableLastNameSherman     TableLastName = `Sherman`
+	TableLastNameShields     TableLastName = `Shields`
+	TableLastNameShort       TableLastName = `Short`
+	TableLastNameSilva       TableLastName = `Silva`
+	TableLastNameSimmons     TableLastName = `Simmons`
+	TableLastNameSimon       TableLastName = `Simon`
+	TableLastNameSimpson     TableLastName = `Simpson`
+	TableLastNameSims        TableLastName = `Sims`
+	TableLastNameSingleton   TableLastName = `Singleton`
+	TableLastNameSkinner     TableLastName = `Skinner`
+	TableLastNameSlater      TableLastName = `Slater`
+	TableLastNameSloan       TableLastName = `Sloan`
+	TableLastNameSmall       TableLastName = `Small`
+	TableLastNameSmith       TableLastName = `Smith`
+	TableLastNameSnider      TableLastName = `Snider`
+	TableLastNameSnow        TableLastName = `Snow`
+	TableLastNameSnyder      TableLastName = `Snyder`
+	TableLastNameSolis       TableLastName = `Solis`
+	TableLastNameSolomon     TableLastName = `Solomon`
+	TableLastNameSosa        TableLastName = `Sosa`
+	TableLastNameSoto        TableLastName = `Soto`
+	TableLastNameSparks      TableLastName = `Sparks`
+	TableLastNameSpears      TableLastName = `Spears`
+	TableLastNameSpence      TableLastName = `Spence`
+	TableLastNameSpencer     TableLastName = `Spencer`
+	TableLastNameStafford    TableLastName = `Stafford`
+	TableLastNameStanley     TableLastName = `Stanley`
+	TableLastNameStanton     TableLastName = `Stanton`
+	TableLastNameStark       TableLastName = `Stark`
+	TableLastNameSteele      TableLastName = `Steele`
+	TableLastNameStein       TableLastName = `Stein`
+	TableLastNameStephens    TableLastName = `Stephens`
+	TableLastNameStephenson  TableLastName = `Stephenson`
+	TableLastNameStevens     TableLastName = `Stevens`
+	TableLastNameStevenson   TableLastName = `Stevenson`
+	TableLastNameStewart     TableLastName = `Stewart`
+	TableLastNameStokes      TableLastName = `Stokes`
+	TableLastNameStone       TableLastName = `Stone`
+	TableLastNameStout       TableLastName = `Stout`
+	TableLastNameStrickland  TableLastName = `Strickland`
+	TableLastNameStrong      TableLastName = `Strong`
+	TableLastNameStuart      TableLastName = `Stuart`
+	TableLastNameSuarez      TableLastName = `Suarez`
+	TableLastNameSullivan    TableLastName = `Sullivan`
+	TableLastNameSummers     TableLastName = `Summers`
+	TableLastNameSutton      TableLastName = `Sutton`
+	TableLastNameSwanson     TableLastName = `Swanson`
+	TableLastNameSweeney     TableLastName = `Sweeney`
+	TableLastNameSweet       TableLastName = `Sweet`
+	TableLastNameSykes       TableLastName = `Sykes`
+	TableLastNameTalley      TableLastName = `Talley`
+	TableLastNameTanner      TableLastName = `Tanner`
+	TableLastNameTate        TableLastName = `Tate`
+	TableLastNameTaylor      TableLastName = `Taylor`
+	TableLastNameTerrell     TableLastName = `Terrell`
+	TableLastNameTerry       TableLastName = `Terry`
+	TableLastNameThomas      TableLastName = `Thomas`
+	TableLastNameThompson    TableLastName = `Thompson`
+	TableLastNameThornton    TableLastName = `Thornton`
+	TableLastNameTillman     TableLastName = `Tillman`
+	TableLastNameTodd        TableLastName = `Todd`
+	TableLastNameTorres      TableLastName = `Torres`
+	TableLastNameTownsend    TableLastName = `Townsend`
+	TableLastNameTran        TableLastName = `Tran`
+	TableLastNameTravis      TableLastName = `Travis`
+	TableLastNameTrevino     TableLastName = `Trevino`
+	TableLastNameTrujillo    TableLastName = `Trujillo`
+	TableLastNameTucker      TableLastName = `Tucker`
+	TableLastNameTurner      TableLastName = `Turner`
+	TableLastNameTyler       TableLastName = `Tyler`
+	TableLastNameTyson       TableLastName = `Tyson`
+	TableLastNameUnderwood   TableLastName = `Underwood`
+	TableLastNameValdez      TableLastName = `Valdez`
+	TableLastNameValencia    TableLastName = `Valencia`
+	TableLastNameValentine   TableLastName = `Valentine`
+	TableLastNameValenzuela  TableLastName = `Valenzuela`
+	TableLastNameVance       TableLastName = `Vance`
+	TableLastNameVang        TableLastName = `Vang`
+	TableLastNameVargas      TableLastName = `Vargas`
+	TableLastNameVasquez     TableLastName = `Vasquez`
+	TableLastNameVaughan     TableLastName = `Vaughan`
+	TableLastNameVaughn      TableLastName = `Vaughn`
+	TableLastNameVazquez     TableLastName = `Vazquez`
+	TableLastNameVega        TableLastName = `Vega`
+	TableLastNameVelasquez   TableLastName = `Velasquez`
+	TableLastNameVelazquez   TableLastName = `Velazquez`
+	TableLastNameVelez       TableLastName = `Velez`
+	TableLastNameVillarreal  TableLastName = `Villarreal`
+	TableLastNameVincent     TableLastName = `Vincent`
+	TableLastNameVinson      TableLastName = `Vinson`
+	TableLastNameWade        TableLastName = `Wade`
+	TableLastNameWagner      TableLastName = `Wagner`
+	TableLastNameWalker      TableLastName = `Walker`
+	TableLastNameWall        TableLastName = `Wall`
+	TableLastNameWallace     TableLastName = `Wallace`
+	TableLastNameWaller      TableLastName = `Waller`
+	TableLastNameWalls       TableLastName = `Walls`
+	TableLastNameWalsh       TableLastName = `Walsh`
+	TableLastNameWalter      TableLastName = `Walter`
+	TableLastNameWalters     TableLastName = `Walters`
+	TableLastNameWalton      TableLastName = `Walton`
+	TableLastNameWard        TableLastName = `Ward`
+	TableLastNameWare        TableLastName = `Ware`
+	TableLastNameWarner      TableLastName = `Warner`
+	TableLastNameWarren      TableLastName = `Warren`
+	TableLastNameWashington  TableLastName = `Washington`
+	TableLastNameWaters      TableLastName = `Waters`
+	TableLastNameWatkins     TableLastName = `Watkins`
+	TableLastNameWatson      TableLastName = `Watson`
+	TableLastNameWatts       TableLastName = `Watts`
+	TableLastNameWeaver      TableLastName = `Weaver`
+	TableLastNameWebb        TableLastName = `Webb`
+	TableLastNameWeber       TableLastName = `Weber`
+	TableLastNameWebster     TableLastName = `Webster`
+	TableLastNameWeeks       TableLastName = `Weeks`
+	TableLastNameWeiss       TableLastName = `Weiss`
+	TableLastNameWelch       TableLastName = `Welch`
+	TableLastNameWells       TableLastName = `Wells`
+	TableLastNameWest        TableLastName = `West`
+	TableLastNameWheeler     TableLastName = `Wheeler`
+	TableLastNameWhitaker    TableLastName = `Whitaker`
+	TableLastNameWhite       TableLastName = `White`
+	TableLastNameWhitehead   TableLastName = `Whitehead`
+	TableLastNameWhitfield   TableLastName = `Whitfield`
+	TableLastNameWhitley     TableLastName = `Whitley`
+	TableLastNameWhitney     TableLastName = `Whitney`
+	TableLastNameWiggins     TableLastName = `Wiggins`
+	TableLastNameWilcox      TableLastName = `Wilcox`
+	TableLastNameWilder      TableLastName = `Wilder`
+	TableLastNameWiley       TableLastName = `Wiley`
+	TableLastNameWilkerson   TableLastName = `Wilkerson`
+	TableLastNameWilkins     TableLastName = `Wilkins`
+	TableLastNameWilkinson   TableLastName = `Wilkinson`
+	TableLastNameWilliam     TableLastName = `William`
+	TableLastNameWilliams    TableLastName = `Williams`
+	TableLastNameWilliamson  TableLastName = `Williamson`
+	TableLastNameWillis      TableLastName = `Willis`
+	TableLastNameWilson      TableLastName = `Wilson`
+	TableLastNameWinters     TableLastName = `Winters`
+	TableLastNameWise        TableLastName = `Wise`
+	TableLastNameWitt        TableLastName = `Witt`
+	TableLastNameWolf        TableLastName = `Wolf`
+	TableLastNameWolfe       TableLastName = `Wolfe`
+	TableLastNameWong        TableLastName = `Wong`
+	TableLastNameWood        TableLastName = `Wood`
+	TableLastNameWoodard     TableLastName = `Woodard`
+	TableLastNameWoods       TableLastName = `Woods`
+	TableLastNameWoodward    TableLastName = `Woodward`
+	TableLastNameWooten      TableLastName = `Wooten`
+	TableLastNameWorkman     TableLastName = `Workman`
+	TableLastNameWright      TableLastName = `Wright`
+	TableLastNameWyatt       TableLastName = `Wyatt`
+	TableLastNameWynn        TableLastName = `Wynn`
+	TableLastNameYang        TableLastName = `Yang`
+	TableLastNameYates       TableLastName = `Yates`
+	TableLastNameYork        TableLastName = `York`
+	TableLastNameYoung       TableLastName = `Young`
+	TableLastNameZamora      TableLastName = `Zamora`
+	TableLastNameZimmerman   TableLastName = `Zimmerman`
+)
+
+// Description of the value, or empty if the value is not in table LastName.
+func (v TableLastName) Description() string {
+	return tableDescription(`LastName`, string(v))
+}
+
+// Valid reports if the value is in table LastName.
+func (v TableLastName) Valid() bool {
+	return TableValueLookup[`LastName`][string(v)]
+}
+
+// Table LastName.
+func (v TableLastName) Table() Table {
+	return TableLookup[`LastName`]
+}
+
+// TableOSD1 is a value of table OSD1, Sequence condition.
+// Fields in this table are strings; convert a field, such as TableOSD1(v), to use the helpers.
+type TableOSD1 string
+
+const (
+	TableOSD1RepeatingCycleOfOrders       TableOSD1 = `C` // Repeating cycle of orders
+	TableOSD1ReservedForPossibleFutureUse TableOSD1 = `R` // Reserved for possible future use
+	TableOSD1SequenceConditions           TableOSD1 = `S` // Sequence conditions
+)
+
+// Description of the value, or empty if the value is not in table OSD1.
+func (v TableOSD1) Description() string {
+	return tableDescription(`OSD1`, string(v))
+}
+
+// Valid reports if the value is in table OSD1.
+func (v TableOSD1) Valid() bool {
+	return TableValueLookup[`OSD1`][string(v)]
+}
+
+// Table OSD1.
+func (v TableOSD1) Table() Table {
+	return TableLookup[`OSD1`]
+}
+
+// TablePhoneNumber is a value of table PhoneNumber, Phone Number.
+// Fields in this table are strings; convert a field, such as TablePhoneNumber(v), to use the helpers.
+type TablePhoneNumber string
+
+const (
+	TablePhoneNumber0005033290 TablePhoneNumber = `(000)503-3290`
+	TablePhoneNumber0029128668 TablePhoneNumber = `(002)912-8668`
+	TablePhoneNumber0030600974 TablePhoneNumber = `(003)060-0974`
+	TablePhoneNumber0037912955 TablePhoneNumber = `(003)791-2955`
+	TablePhoneNumber0043713089 TablePhoneNumber = `(004)371-3089`
+	TablePhoneNumber0047067495 TablePhoneNumber = `(004)706-7495`
+	TablePhoneNumber0047238271 TablePhoneNumber = `(004)723-8271`
+	TablePhoneNumber0071052079 TablePhoneNumber = `(007)105-2079`
+	TablePhoneNumber0093844587 TablePhoneNumber = `(009)384-4587`
+	TablePhoneNumber0094892523 TablePhoneNumber = `(009)489-2523`
+	TablePhoneNumber0106471327 TablePhoneNumber = `(010)647-1327`
+	TablePhoneNumber0136911470 TablePhoneNumber = `(013)691-1470`
+	TablePhoneNumber0150363156 TablePhoneNumber = `(015)036-3156`
+	TablePhoneNumber0152896392 TablePhoneNumber = `(015)289-6392`
+	TablePhoneNumber0165401454 TablePhoneNumber = `(016)540-1454`
+	TablePhoneNumber0170706374 TablePhoneNumber = `(017)070-6374`
+	TablePhoneNumber0187154178 TablePhoneNumber = `(018)715-4178`
+	TablePhoneNumber0191390416 TablePhoneNumber = `(019)139-0416`
+	TablePhoneNumber0197160500 TablePhoneNumber = `(019)716-0500`
+	TablePhoneNumber0201940034 TablePhoneNumber = `(020)194-0034`
+	TablePhoneNumber0208494973 TablePhoneNumber = `(020)849-4973`
+	TablePhoneNumber0212234523 TablePhoneNumber = `(021)223-4523`
+	TablePhoneNumber0214222184 TablePhoneNumber = `(021)422-2184`
+	TablePhoneNumber0228692197 TablePhoneNumber = `(022)869-2197`
+	TablePhoneNumber0240659119 TablePhoneNumber = `(024)065-9119`
+	TablePhoneNumber0259041039 TablePhoneNumber = `(025)904-1039`
+	TablePhoneNumber0272082365 TablePhoneNumber = `(027)208-2365`
+	TablePhoneNumber0274756720 TablePhoneNumber = `(027)475-6720`
+	TablePhoneNumber0281080238 TablePhoneNumber = `(028)108-0238`
+	TablePhoneNumber0290367289 TablePhoneNumber = `(029)036-7289`
+	TablePhoneNumber0305824128 TablePhoneNumber = `(030)582-4128`
+	TablePhoneNumber0312694560 TablePhoneNumber = `(031)269-4560`
+	TablePhoneNumber0314249609 TablePhoneNumber = `(031)424-9609`
+	TablePhoneNumber0328156760 TablePhoneNumber = `(032)815-6760`
+	TablePhoneNumber0332440726 TablePhoneNumber = `(033)244-0726`
+	TablePhoneNumber0334384988 TablePhoneNumber = `(033)438-4988`
+	TablePhoneNumber0335860374 TablePhoneNumber = `(033)586-0374`
+	TablePhoneNumber0341119582 TablePhoneNumber = `(034)111-9582`
+	TablePhoneNumber0366761372 TablePhoneNumber = `(036)676-1372`
+	TablePhoneNumber0382111102 TablePhoneNumber = `(038)211-1102`
+	TablePhoneNumber0408245847 TablePhoneNumber = `(040)824-5847`
+	TablePhoneNumber0430656356 TablePhoneNumber = `(043)065-6356`
+	TablePhoneNumber0435962806 TablePhoneNumber = `(043)596-2806`
+	TablePhoneNumber0438232538 TablePhoneNumber = `(043)823-2538`
+	TablePhoneNumber0446564942 TablePhoneNumber = `(044)656-4942`
+	TablePhoneNumber0463484079 TablePhoneNumber = `(046)348-4079`
+	TablePhoneNumber0464147849 TablePhoneNumber = `(046)414-7849`
+	TablePhoneNumber0471099173 TablePhoneNumber = `(047)109-9173`
+	TablePhoneNumber0481156190 TablePhoneNumber = `(048)115-6190`
+	TablePhoneNumber0518389519 TablePhoneNumber = `(051)838-9519`
+	TablePhoneNumber0526048044 TablePhoneNumber = `(052)604-8044`
+	TablePhoneNumber0529212833 TablePhoneNumber = `(052)921-2833`
+	TablePhoneNumber0553866569 TablePhoneNumber = `(055)386-6569`
+	TablePhoneNumber0554324112 TablePhoneNumber = `(055)432-4112`
+	TablePhoneNumber0569151096 TablePhoneNumber = `(056)915-1096`
+	TablePhoneNumber0569922232 TablePhoneNumber = `(056)992-2232`
+	TablePhoneNumber0581090276 TablePhoneNumber = `(058)109-0276`
+	TablePhoneNumber0588642295 TablePhoneNumber = `(058)864-2295`
+	TablePhoneNumber0592805179 TablePhoneNumber = `(059)280-5179`
+	TablePhoneNumber0595205259 TablePhoneNumber = `(059)520-5259`
+	TablePhoneNumber0597794344 TablePhoneNumber = `(059)779-4344`
+	TablePhoneNumber0608585109 TablePhoneNumber = `(060)858-5109`
+	TablePhoneNumber0609831989 TablePhoneNumber = `(060)983-1989`
+	TablePhoneNumber0615164952 TablePhoneNumber = `(061)516-4952`
+	TablePhoneNumber0626576469 TablePhoneNumber = `(062)657-6469`
+	TablePhoneNumber0631551229 TablePhoneNumber = `(063)155-1229`
+	TablePhoneNumber0644571271 TablePhoneNumber = `(064)457-1271`
+	TablePhoneNumber0653730732 TablePhoneNumber = `(065)373-0732`
+	TablePhoneNumber0660746490 TablePhoneNumber = `(066)074-6490`
+	TablePhoneNumber0692301887 TablePhoneNumber = `(069)230-1887`
+	TablePhoneNumber0697428257 TablePhoneNumber = `(069)742-8257`
+	TablePhoneNumber0697549611 TablePhoneNumber = `(069)754-9611`
+	TablePhoneNumber0704621205 TablePhoneNumber = `(070)462-1205`
+	TablePhoneNumber0723951492 TablePhoneNumber = `(072)395-1492`
+	TablePhoneNumber0724203523 TablePhoneNumber = `(072)420-3523`
+	TablePhoneNumber0754891136 TablePhoneNumber = `(075)489-1136`
+	TablePhoneNumber0771567343 TablePhoneNumber = `(077)156-7343`
+	TablePhoneNumber0775412856 TablePhoneNumber = `(077)541-2856`
+	TablePhoneNumber0791457971 TablePhoneNumber = `(079)145-7971`
+	TablePhoneNumber0806644237 TablePhoneNumber = `(080)664-4237`
+	TablePhoneNumber0820405891 TablePhoneNumber = `(082)040-5891`
+	TablePhoneNumber0821954821 TablePhoneNumber = `(082)195-4821`
+	TablePhoneNumber0834066723 TablePhoneNumber = `(083)406-6723`
+	TablePhoneNumber0834835991 TablePhoneNumber = `(083)483-5991`
+	TablePhoneNumber0872415023 TablePhoneNumber = `(087)241-5023`
+	TablePhoneNumber0884898724 TablePhoneNumber = `(088)489-8724`
+	TablePhoneNumber0886966852 TablePhoneNumber = `(088)696-6852`
+	TablePhoneNumber0898111888 TablePhoneNumber = `(089)811-1888`
+	TablePhoneNumber0916398297 TablePhoneNumber = `(091)639-8297`
+	TablePhoneNumber0929663934 TablePhoneNumber = `(092)966-3934`
+	TablePhoneNumber0932568654 TablePhoneNumber = `(093)256-8654`
+	TablePhoneNumber0956724654 TablePhoneNumber = `(095)672-4654`
+	TablePhoneNumber0983918341 TablePhoneNumber = `(098)391-8341`
+	TablePhoneNumber0984168830 TablePhoneNumber = `(098)416-8830`
+	TablePhoneNumber0996285848 TablePhoneNumber = `(099)628-5848`
+	TablePhoneNumber1027096111 TablePhoneNumber = `(102)709-6111`
+	TablePhoneNumber1032287215 TablePhoneNumber = `(103)228-7215`
+	TablePhoneNumber1032620320 TablePhoneNumber = `(103)262-0320`
+	TablePhoneNumber1032925439 TablePhoneNumber = `(103)292-5439`
+	TablePhoneNumber1046227908 TablePhoneNumber = `(104)622-7908`
+	TablePhoneNumber1046890166 TablePhoneNumber = `(104)689-0166`
+	TablePhoneNumber1056155856 TablePhoneNumber = `(105)615-5856`
+	TablePhoneNumber1056280336 TablePhoneNumber = `(105)628-0336`
+	TablePhoneNumber1057020880 TablePhoneNumber = `(105)702-0880`
+	TablePhoneNumber1065340914 TablePhoneNumber = `(106)534-0914`
+	TablePhoneNumber1077322379 TablePhoneNumber = `(107)732-2379`
+	TablePhoneNumber1077843345 TablePhoneNumber = `(107)784-3345`
+	TablePhoneNumber1088199427 TablePhoneNumber = `(108)819-9427`
+	TablePhoneNumber1088736082 TablePhoneNumber = `(108)873-6082`
+	TablePhoneNumber1093477690 TablePhoneNumber = `(109)347-7690`
+	TablePhoneNumber1096083776 TablePhoneNumber = `(109)608-3776`
+	TablePhoneNumber1099167853 TablePhoneNumber = `(109)916-7853`
+	TablePhoneNumber1126338283 TablePhoneNumber = `(112)633-8283`
+	TablePhoneNumber1127520942 TablePhoneNumber = `(112)752-0942`
+	TablePhoneNumber1152221944 TablePhoneNumber = `(115)222-1944`
+	TablePhoneNumber1158896818 TablePhoneNumber = `(115)889-6818`
+	TablePhoneNumber1183938780 TablePhoneNumber = `(118)393-8780`
+	TablePhoneNumber1187621615 TablePhoneNumber = `(118)762-1615`
+	TablePhoneNumber1192166501 TablePhoneNumber = `(119)216-6501`
+	TablePhoneNumber1199777977 TablePhoneNumber = `(119)977-7977`
+	TablePhoneNumber1218647287 TablePhoneNumber = `(121)864-7287`
+	TablePhoneNumber1220916358 TablePhoneNumber = `(122)091-6358`
+	TablePhoneNumber1232749613 TablePhoneNumber = `(123)274-9613`
+	TablePhoneNumber1236852708 TablePhoneNumber = `(123)685-2708`
+	TablePhoneNumber1254417663 TablePhoneNumber = `(125)441-7663`
+	TablePhoneNumber1262258991 TablePhoneNumber = `(126)225-8991`
+	TablePhoneNumber1265244596 TablePhoneNumber = `(126)524-4596`
+	TablePhoneNumber1300912416 TablePhoneNumber = `(130)091-2416`
+	TablePhoneNumber1317883073 TablePhoneNumber = `(131)788-3073`
+	TablePhoneNumber1348946688 TablePhoneNumber = `(134)894-6688`
+	TablePhoneNumber1349702755 TablePhoneNumber = `(134)970-2755`
+	TablePhoneNumber1354508105 TablePhoneNumber = `(135)450-8105`
+	TablePhoneNumber1374921205 TablePhoneNumber = `(137)492-1205`
+	TablePhoneNumber1380601315 TablePhoneNumber = `(138)060-1315`
+	TablePhoneNumber1389282523 TablePhoneNumber = `(138)928-2523`
+	TablePhoneNumber1390301713 TablePhoneNumber = `(139)030-1713`
+	TablePhoneNumber1394552779 TablePhoneNumber = `(139)455-2779`
+	TablePhoneNumber1394746928 TablePhoneNumber = `(139)474-6928`
+	TablePhoneNumber1425645602 TablePhoneNumber = `(142)564-5602`
+	TablePhoneNumber1434333909 TablePhoneNumber = `(143)433-3909`
+	TablePhoneNumber1439500817 TablePhoneNumber = `(143)950-0817`
+	TablePhoneNumber1467047067 TablePhoneNumber = `(146)704-7067`
+	TablePhoneNumber1473408591 TablePhoneNumber = `(147)340-8591`
+	TablePhoneNumber1484125568 TablePhoneNumber = `(148)412-5568`
+	TablePhoneNumber1487992429 TablePhoneNumber = `(148)799-2429`
+	TablePhoneNumber1500584475 TablePhoneNumber = `(150)058-4475`
+	TablePhoneNumber1514988566 TablePhoneNumber = `(151)498-8566`
+	TablePhoneNumber1519020269 TablePhoneNumber = `(151)902-0269`
+	TablePhoneNumber1527109598 TablePhoneNumber = `(152)710-9598`
+	TablePhoneNumber1529063006 TablePhoneNumber = `(152)906-3006`
+	TablePhoneNumber1563567137 TablePhoneNumber = `(156)356-7137`
+	TablePhoneNumber1570243918 TablePhoneNumber = `(157)024-3918`
+	TablePhoneNumber1576873493 TablePhoneNumber = `(157)687-3493`
+	TablePhoneNumber1579278202 TablePhoneNumber = `(157)927-8202`
+	TablePhoneNumber1595236517 TablePhoneNumber = `(159)523-6517`
+	TablePhoneNumber1600684907 TablePhoneNumber = `(160)068-4907`
+	TablePhoneNumber1614635188 TablePhoneNumber = `(161)463-5188`
+	TablePhoneNumber1621537433 TablePhoneNumber = `(162)153-7433`
+	TablePhoneNumber1630543027 TablePhoneNumber = `(163)054-3027`
+	TablePhoneNumber1634585291 TablePhoneNumber = `(163)458-5291`
+	TablePhoneNumber1647440036 TablePhoneNumber = `(164)744-0036`
+	TablePhoneNumber1649093183 TablePhoneNumber = `(164)909-3183`
+	TablePhoneNumber1669916655 TablePhoneNumber = `(166)991-6655`
+	TablePhoneNumber1670823478 TablePhoneNumber = `(167)082-3478`
+	TablePhoneNumber1678203863 TablePhoneNumber = `(167)820-3863`
+	TablePhoneNumber1683040302 TablePhoneNumber = `(168)304-0302`
+	TablePhoneNumber1690082343 TablePhoneNumber = `(169)008-2343`
+	TablePhoneNumber1693552528 TablePhoneNumber = `(169)355-2528`
+	TablePhoneNumber1712952312 TablePhoneNumber = `(171)295-2312`
+	TablePhoneNumber1718940025 TablePhoneNumber = `(171)894-0025`
+	TablePhoneNumber1719594074 TablePhoneNumber = `(171)959-4074`
+	TablePhoneNumber1734563664 TablePhoneNumber = `(173)456-3664`
+	TablePhoneNumber1764948246 TablePhoneNumber = `(176)494-8246`
+	TablePhoneNumber1766807428 TablePhoneNumber = `(176)680-7428`
+	TablePhoneNumber1794041075 TablePhoneNumber = `(179)404-1075`
+	TablePhoneNumber1804328504 TablePhoneNumber = `(180)432-8504`
+	TablePhoneNumber1805958939 TablePhoneNumber = `(180)595-8939`
+	TablePhoneNumber1811562076 TablePhoneNumber = `(181)156-2076`
+	TablePhoneNumber1834309688 TablePhoneNumber = `(183)430-9688`
+	TablePhoneNumber1856394760 TablePhoneNumber = `(185)639-4760`
+	TablePhoneNumber1863866132 TablePhoneNumber = `(186)386-6132`
+	TablePhoneNumber1865922245 TablePhoneNumber = `(186)592-2245`
+	TablePhoneNumber1885916136 TablePhoneNumber = `(188)591-6136`
+	TablePhoneNumber1897104631 TablePhoneNumber = `(189)710-4631`
+	TablePhoneNumber1904215552 TablePhoneNumber = `(190)421-5552`
+	TablePhoneNumber1920730277 TablePhoneNumber = `(192)073-0277`
+	TablePhoneNumber1926859015 TablePhoneNumber = `(192)685-9015`
+	TablePhoneNumber1992107188 TablePhoneNumber = `(199)210-7188`
+	TablePhoneNumber2003548721 TablePhoneNumber = `(200)354-8721`
+	TablePhoneNumber2022175102 TablePhoneNumber = `(202)217-5102`
+	TablePhoneNumber2026249486 TablePhoneNumber = `(202)624-9486`
+	TablePhoneNumber2028666263 TablePhoneNumber = `(202)866-6263`
+	TablePhoneNumber2038317337 TablePhoneNumber = `(203)831-7337`
+	TablePhoneNumber2054993441 TablePhoneNumber = `(205)499-3441`
+	TablePhoneNumber2058918016 TablePhoneNumber = `(205)891-8016`
+	TablePhoneNumber2073082766 TablePhoneNumber = `(207)308-2766`
+	TablePhoneNumber2073448223 TablePhoneNumber = `(207)344-8223`
+	TablePhoneNumber2081873910 TablePhoneNumber = `(208)187-3910`
+	TablePhoneNumber2087598568 TablePhoneNumber = `(208)759-8568`
+	TablePhoneNumber2097323442 TablePhoneNumber = `(209)732-3442`
+	TablePhoneNumber2097340145 TablePhoneNumber = `(209)734-0145`
+	TablePhoneNumber2105347153 TablePhoneNumber = `(210)534-7153`
+	TablePhoneNumber2119166352 TablePhoneNumber = `(211)916-6352`
+	TablePhoneNumber2119712024 TablePhoneNumber = `(211)971-2024`
+	TablePhoneNumber2126030058 TablePhoneNumber = `(212)603-0058`
+	TablePhoneNumber2128216961 TablePhoneNumber = `(212)821-6961`
+	TablePhoneNumber2165559335 TablePhoneNumber = `(216)555-9335`
+	TablePhoneNumber2189881372 TablePhoneNumber = `(218)988-1372`
+	TablePhoneNumber2215586885 TablePhoneNumber = `(221)558-6885`
+	TablePhoneNumber2232939838 TablePhoneNumber = `(223)293-9838`
+	TablePhoneNumber2233434132 TablePhoneNumber = `(223)343-4132`
+	TablePhoneNumber2240883864 TablePhoneNumber = `(224)088-3864`
+	TablePhoneNumber2243337890 TablePhoneNumber = `(224)333-7890`
+	TablePhoneNumber2260933014 TablePhoneNumber = `(226)093-3014`
+	TablePhoneNumber2269348448 TablePhoneNumber = `(226)934-8448`
+	TablePhoneNumber2280572396 TablePhoneNumber = `(228)057-2396`
+	TablePhoneNumber2282020426 TablePhoneNumber = `(228)202-0426`
+	TablePhoneNumber2282731420 TablePhoneNumber = `(228)273-1420`
+	TablePhoneNumber2282943872 TablePhoneNumber = `(228)294-3872`
+	TablePhoneNumber2288283818 TablePhoneNumber = `(228)828-3818`
+	TablePhoneNumber2293277533 TablePhoneNumber = `(229)327-7533`
+	TablePhoneNumber2311451584 TablePhoneNumber = `(231)145-1584`
+	TablePhoneNumber2312477563 TablePhoneNumber = `(231)247-7563`
+	TablePhoneNumber2327142933 TablePhoneNumber = `(232)714-2933`
+	TablePhoneNumber2343860989 TablePhoneNumber = `(234)386-0989`
+	TablePhoneNumber2348157007 TablePhoneNumber = `(234)815-7007`
+	TablePhoneNumber2364989009 TablePhoneNumber = `(236)498-9009`
+	TablePhoneNumber2383353862 TablePhoneNumber = `(238)335-3862`
+	TablePhoneNumber2411565823 TablePhoneNumber = `(241)156-5823`
+	TablePhoneNumber2455353716 TablePhoneNumber = `(245)535-3716`
+	TablePhoneNumber2458351382 TablePhoneNumber = `(245)835-1382`
+	TablePhoneNumber2489930603 TablePhoneNumber = `(248)993-0603`
+	TablePhoneNumber2497459320 TablePhoneNumber = `(249)745-9320`
+	TablePhoneNumber2513215064 TablePhoneNumber = `(251)321-5064`
+	TablePhoneNumber2526232197 TablePhoneNumber = `(252)623-2197`
+	TablePhoneNumber2532912275 TablePhoneNumber = `(253)291-2275`
+	TablePhoneNumber2544421760 TablePhoneNumber = `(254)442-1760`
+	TablePhoneNumber2558090577 TablePhoneNumber = `(255)809-0577`
+	TablePhoneNumber2568819253 TablePhoneNumber = `(256)881-9253`
+	TablePhoneNumber2570515138 TablePhoneNumber = `(257)051-5138`
+	TablePhoneNumber2576360217 TablePhoneNumber = `(257)636-0217`
+	TablePhoneNumber2583160310 TablePhoneNumber = `(258)316-0310`
+	TablePhoneNumber2585087852 TablePhoneNumber = `(258)508-7852`
+	TablePhoneNumber2588339222 TablePhoneNumber = `(258)833-9222`
+	TablePhoneNumber2589382039 TablePhoneNumber = `(258)938-2039`
+	TablePhoneNumber2593424512 TablePhoneNumber = `(259)342-4512`
+	TablePhoneNumber2613000096 TablePhoneNumber = `(261)300-0096`
+	TablePhoneNumber2671127989 TablePhoneNumber = `(267)112-7989`
+	TablePhoneNumber2675542469 TablePhoneNumber = `(267)554-2469`
+	TablePhoneNumber2689224967 TablePhoneNumber = `(268)922-4967`
+	TablePhoneNumber2689492505 TablePhoneNumber = `(268)949-2505`
+	TablePhoneNumber2705469048 TablePhoneNumber = `(270)546-9048`
+	TablePhoneNumber2710922658 TablePhoneNumber = `(271)092-2658`
+	TablePhoneNumber2734307094 TablePhoneNumber = `(273)430-7094`
+	TablePhoneNumber2747697713 TablePhoneNumber = `(274)769-7713`
+	TablePhoneNumber2768777439 TablePhoneNumber = `(276)877-7439`
+	TablePhoneNumber2786821772 TablePhoneNumber = `(278)682-1772`
+	TablePhoneNumber2791630060 TablePhoneNumber = `(279)163-0060`
+	TablePhoneNumber2794522109 TablePhoneNumber = `(279)452-2109`
+	TablePhoneNumber2806982335 TablePhoneNumber = `(280)698-2335`
+	TablePhoneNumber2812154807 TablePhoneNumber = `(281)215-4807`
+	TablePhoneNumber2815448581 TablePhoneNumber = `(281)544-8581`
+	TablePhoneNumber2821519460 TablePhoneNumber = `(282)151-9460`
+	TablePhoneNumber2823062569 TablePhoneNumber = `(282)306-2569`
+	TablePhoneNumber2830166536 TablePhoneNumber = `(283)016-6536`
+	TablePhoneNumber2867431038 TablePhoneNumber = `(286)743-1038`
+	TablePhoneNumber2875278113 TablePhoneNumber = `(287)527-8113`
+	TablePhoneNumber2878891233 TablePhoneNumber = `(287)889-1233`
+	TablePhoneNumber2878892501 TablePhoneNumber = `(287)889-2501`
+	TablePhoneNumber2888170784 TablePhoneNumber = `(288)817-0784`
+	TablePhoneNumber2896091858 TablePhoneNumber = `(289)609-1858`
+	TablePhoneNumber2898708706 TablePhoneNumber = `(289)870-8706`
+	TablePhoneNumber2902288981 TablePhoneNumber = `(290)228-8981`
+	TablePhoneNumber2912018175 TablePhoneNumber = `(291)201-8175`
+	TablePhoneNumber2914990374 TablePhoneNumber = `(291)499-0374`
+	TablePhoneNumber2916276797 TablePhoneNumber = `(291)627-6797`
+	TablePhoneNumber2918061698 TablePhoneNumber = `(291)806-1698`
+	TablePhoneNumber2919829942 TablePhoneNumber = `(291)982-9942`
+	TablePhoneNumber2922700243 TablePhoneNumber = `(292)270-0243`
+	TablePhoneNumber2928241320 TablePhoneNumber = `(292)824-1320`
+	TablePhoneNumber2931818432 TablePhoneNumber = `(293)181-8432`
+	TablePhoneNumber2948502124 TablePhoneNumber = `(294)850-2124`
+	TablePhoneNumber2970065022 TablePhoneNumber = `(297)006-5022`
+	TablePhoneNumber2983407100 TablePhoneNumber = `(298)340-7100`
+	TablePhoneNumber3009862736 TablePhoneNumber = `(300)986-2736`
+	TablePhoneNumber3014343859 TablePhoneNumber = `(301)434-3859`
+	TablePhoneNumber3037413296 TablePhoneNumber = `(303)741-3296`
+	TablePhoneNumber3042968457 TablePhoneNumber = `(304)296-8457`
+	TablePhoneNumber3071434944 TablePhoneNumber = `(307)143-4944`
+	TablePhoneNumber3076838701 TablePhoneNumber = `(307)683-8701`
+	TablePhoneNumber3077004692 TablePhoneNumber = `(307)700-4692`
+	TablePhoneNumber3089915267 TablePhoneNumber = `(308)991-5267`
+	TablePhoneNumber3094828110 TablePhoneNumber = `(309)482-8110`
+	TablePhoneNumber3095487794 TablePhoneNumber = `(309)548-7794`
+	TablePhoneNumber3103879193 TablePhoneNumber = `(310)387-9193`
+	TablePhoneNumber3104408045 TablePhoneNumber = `(310)440-8045`
+	TablePhoneNumber3116783486 TablePhoneNumber = `(311)678-3486`
+	TablePhoneNumber3130770397 TablePhoneNumber = `(313)077-0397`
+	TablePhoneNumber3135962652 TablePhoneNumber = `(313)596-2652`
+	TablePhoneNumber3147973015 TablePhoneNumber = `(314)797-3015`
+	TablePhoneNumber3168615772 TablePhoneNumber = `(316)861-5772`
+	TablePhoneNumber3185224088 TablePhoneNumber = `(318)522-4088`
+	TablePhoneNumber3187560731 TablePhoneNumber = `(318)756-0731`
+	TablePhoneNumber3199729529 TablePhoneNumber = `(319)972-9529`
+	TablePhoneNumber3204708698 TablePhoneNumber = `(320)470-8698`
+	TablePhoneNumber3236795219 TablePhoneNumber = `(323)679-5219`
+	TablePhoneNumber3238054742 TablePhoneNumber = `(323)805-4742`
+	TablePhoneNumber3238618409 TablePhoneNumber = `(323)861-8409`
+	TablePhoneNumber3254187973 TablePhoneNumber = `(325)418-7973`
+	TablePhoneNumber3259387699 TablePhoneNumber = `(325)938-7699`
+	TablePhoneNumber3278414635 TablePhoneNumber = `(327)841-4635`
+	TablePhoneNumber3281099783 TablePhoneNumber = `(328)109-9783`
+	TablePhoneNumber3342354488 TablePhoneNumber = `(334)235-4488`
+	TablePhoneNumber3346865697 TablePhoneNumber = `(334)686-5697`
+	TablePhoneNumber3352977433 TablePhoneNumber = `(335)297-7433`
+	TablePhoneNumber3368330445 TablePhoneNumber = `(336)833-0445`
+	TablePhoneNumber3388951370 TablePhoneNumber = `(338)895-1370`
+	TablePhoneNumber3389656696 TablePhoneNumber = `(338)965-6696`
+	TablePhoneNumber3394314537 TablePhoneNumber = `(339)431-4537`
+	TablePhoneNumber3399474189 TablePhoneNumber = `(339)947-4189`
+	TablePhoneNumber3399496401 TablePhoneNumber = `(339)949-6401`
+	TablePhoneNumber3416035104 TablePhoneNumber = `(341)603-5104`
+	TablePhoneNumber3422520512 TablePhoneNumber = `(342)252-0512`
+	TablePhoneNumber3423252247 TablePhoneNumber = `(342)325-2247`
+	TablePhoneNumber3425355615 TablePhoneNumber = `(342)535-5615`
+	TablePhoneNumber3427731673 TablePhoneNumber = `(342)773-1673`
+	TablePhoneNumber3438138373 TablePhoneNumber = `(343)813-8373`
+	TablePhoneNumber3468188636 TablePhoneNumber = `(346)818-8636`
+	TablePhoneNumber3469234272 TablePhoneNumber = `(346)923-4272`
+	TablePhoneNumber3472495996 TablePhoneNumber = `(347)249-5996`
+	TablePhoneNumber3476592569 TablePhoneNumber = `(347)659-2569`
+	TablePhoneNumber3478136348 TablePhoneNumber = `(347)813-6348`
+	TablePhoneNumber3478984726 TablePhoneNumber = `(347)898-4726`
+	TablePhoneNumber3481052636 TablePhoneNumber = `(348)105-2636`
+	TablePhoneNumber3486335659 TablePhoneNumber = `(348)633-5659`
+	TablePhoneNumber3498347568 TablePhoneNumber = `(349)834-7568`
+	TablePhoneNumber3499403903 TablePhoneNumber = `(349)940-3903`
+	TablePhoneNumber3505511949 TablePhoneNumber = `(350)551-1949`
+	TablePhoneNumber3509693384 TablePhoneNumber = `(350)969-3384`
+	TablePhoneNumber3515218096 TablePhoneNumber = `(351)521-8096`
+	TablePhoneNumber3516579527 TablePhoneNumber = `(351)657-9527`
+	TablePhoneNumber3519209979 TablePhoneNumber = `(351)920-9979`
+	TablePhoneNumber3523463192 TablePhoneNumber = `(352)346-3192`
+	TablePhoneNumber3525723753 TablePhoneNumber = `(352)572-3753`
+	TablePhoneNumber3528137699 TablePhoneNumber = `(352)813-7699`
+	TablePhoneNumber3535719328 TablePhoneNumber = `(353)571-9328`
+	TablePhoneNumber3537344437 TablePhoneNumber = `(353)734-4437`
+	TablePhoneNumber3572063921 TablePhoneNumber = `(357)206-3921`
+	TablePhoneNumber3582602906 TablePhoneNumber = `(358)260-2906`
+	TablePhoneNumber3584433105 TablePhoneNumber = `(358)443-3105`
+	TablePhoneNumber3590557442 TablePhoneNumber = `(359)055-7442`
+	TablePhoneNumber3596602174 TablePhoneNumber = `(359)660-2174`
+	TablePhoneNumber3625666872 TablePhoneNumber = `(362)566-6872`
+	TablePhoneNumber3636965662 TablePhoneNumber = `(363)696-5662`
+	TablePhoneNumber3644556039 TablePhoneNumber = `(364)455-6039`
+	TablePhoneNumber3656181800 TablePhoneNumber = `(365)618-1800`
+	TablePhoneNumber3659875723 TablePhoneNumber = `(365)987-5723`
+	TablePhoneNumber3667455764 TablePhoneNumber = `(366)745-5764`
+	TablePhoneNumber3681503525 TablePhoneNumber = `(368)150-3525`
+	TablePhoneNumber3696750347 TablePhoneNumber = `(369)675-0347`
+	TablePhoneNumber3708522804 TablePhoneNumber = `(370)852-2804`
+	TablePhoneNumber3714573138 TablePhoneNumber = `(371)457-3138`
+	TablePhoneNumber3718248676 TablePhoneNumber = `(371)824-8676`
+	TablePhoneNumber3728739163 TablePhoneNumber = `(372)873-9163`
+	TablePhoneNumber3733065227 TablePhoneNumber = `(373)306-5227`
+	TablePhoneNumber3744726944 TablePhoneNumber = `(374)472-6944`
+	TablePhoneNumber3755790962 TablePhoneNumber = `(375)579-0962`
+	TablePhoneNumber3773429398 TablePhoneNumber = `(377)342-9398`
+	TablePhoneNumber3781052471 TablePhoneNumber = `(378)105-2471`
+	TablePhoneNumber3782219592 TablePhoneNumber = `(378)221-9592`
+	TablePhoneNumber3791309890 TablePhoneNumber = `(379)130-9890`
+	TablePhoneNumber3792116896 TablePhoneNumber = `(379)211-6896`
+	TablePhoneNumber3792846108 TablePhoneNumber = `(379)284-6108`
+	TablePhoneNumber3812633395 TablePhoneNumber = `(381)263-3395`
+	TablePhoneNumber3816737468 TablePhoneNumber = `(381)673-7468`
+	TablePhoneNumber3836011058 TablePhoneNumber = `(383)601-1058`
+	TablePhoneNumber3841802584 TablePhoneNumber = `(384)180-2584`
+	TablePhoneNumber3849386496 TablePhoneNumber = `(384)938-6496`
+	TablePhoneNumber3852908460 TablePhoneNumber = `(385)290-8460`
+	TablePhoneNumber3858336509 TablePhoneNumber = `(385)833-6509`
+	TablePhoneNumber3881192749 TablePhoneNumber = `(388)119-2749`
+	TablePhoneNumber3882581387 TablePhoneNumber = `(388)258-1387`
+	TablePhoneNumber3883757301 TablePhoneNumber = `(388)375-7301`
+	TablePhoneNumber3909782697 TablePhoneNumber = `(390)978-2697`
+	TablePhoneNumber3952914430 TablePhoneNumber = `(395)291-4430`
+	TablePhoneNumber3958786308 TablePhoneNumber = `(395)878-6308`
+	TablePhoneNumber3959317094 TablePhoneNumber = `(395)931-7094`
+	TablePhoneNumber3990544627 TablePhoneNumber = `(399)054-4627`
+	TablePhoneNumber3992495648 TablePhoneNumber = `(399)249-5648`
+	TablePhoneNumber4001472102 TablePhoneNumber = `(400)147-2102`
+	TablePhoneNumber4004559848 TablePhoneNumber = `(400)455-9848`
+	TablePhoneNumber4004704740 TablePhoneNumber = `(400)470-4740`
+	TablePhoneNumber4035598817 TablePhoneNumber = `(403)559-8817`
+	TablePhoneNumber4043852422 TablePhoneNumber = `(404)385-2422`
+	TablePhoneNumber4046382932 TablePhoneNumber = `(404)638-2932`
+	TablePhoneNumber4051450317 TablePhoneNumber = `(405)145-0317`
+	TablePhoneNumber4060519985 TablePhoneNumber = `(406)051-9985`
+	TablePhoneNumber4061762347 TablePhoneNumber = `(406)176-2347`
+	TablePhoneNumber4065697233 TablePhoneNumber = `(406)569-7233`
+	TablePhoneNumber4071556201 TablePhoneNumber = `(407)155-6201`
+	TablePhoneNumber4090900084 TablePhoneNumber = `(409)090-0084`
+	TablePhoneNumber4093466541 TablePhoneNumber = `(409)346-6541`
+	TablePhoneNumber4097651451 TablePhoneNumber = `(409)765-1451`
+	TablePhoneNumber4106254841 TablePhoneNumber = `(410)625-4841`
+	TablePhoneNumber4116851825 TablePhoneNumber = `(411)685-1825`
+	TablePhoneNumber4117383402 TablePhoneNumber = `(411)738-3402`
+	TablePhoneNumber4132045839 TablePhoneNumber = `(413)204-5839`
+	TablePhoneNumber4146653249 TablePhoneNumber = `(414)665-3249`
+	TablePhoneNumber4153193966 TablePhoneNumber = `(415)319-3966`
+	TablePhoneNumber4157666735 TablePhoneNumber = `(415)766-6735`
+	TablePhoneNumber4182697776 TablePhoneNumber = `(418)269-7776`
+	TablePhoneNumber4188669389 TablePhoneNumber = `(418)866-9389`
+	TablePhoneNumber4191795498 TablePhoneNumber = `(419)179-5498`
+	TablePhoneNumber4192960568 TablePhoneNumber = `(419)296-0568`
+	TablePhoneNumber4201777801 TablePhoneNumber = `(420)177-7801`
+	TablePhoneNumber4218035499 TablePhoneNumber = `(421)803-5499`
+	TablePhoneNumber4236758558 TablePhoneNumber = `(423)675-8558`
+	TablePhoneNumber4242204883 TablePhoneNumber = `(424)220-4883`
+	TablePhoneNumber4248377127 TablePhoneNumber = `(424)837-7127`
+	TablePhoneNumber4253947431 TablePhoneNumber = `(425)394-7431`
+	TablePhoneNumber4273223615 TablePhoneNumber = `(427)322-3615`
+	TablePhoneNumber4285596652 TablePhoneNumber = `(428)559-6652`
+	TablePhoneNumber4287472642 TablePhoneNumber = `(428)747-2642`
+	TablePhoneNumber4297192829 TablePhoneNumber = `(429)719-2829`
+	TablePhoneNumber4300319275 TablePhoneNumber = `(430)031-9275`
+	TablePhoneNumber4316958541 TablePhoneNumber = `(431)695-8541`
+	TablePhoneNumber4324834111 TablePhoneNumber = `(432)483-4111`
+	TablePhoneNumber4338972204 TablePhoneNumber = `(433)897-2204`
+	TablePhoneNumber4351728495 TablePhoneNumber = `(435)172-8495`
+	TablePhoneNumber4364283533 TablePhoneNumber = `(436)428-3533`
+	TablePhoneNumber4374904436 TablePhoneNumber = `(437)490-4436`
+	TablePhoneNumber4384186258 TablePhoneNumber = `(438)418-6258`
+	TablePhoneNumber4391568045 TablePhoneNumber = `(439)156-8045`
+	TablePhoneNumber4395200144 TablePhoneNumber = `(439)520-0144`
+	TablePhoneNumber4400350301 TablePhoneNumber = `(440)035-0301`
+	TablePhoneNumber4406613829 TablePhoneNumber = `(440)661-3829`
+	TablePhoneNumber4409642847 TablePhoneNumber = `(440)964-2847`
+	TablePhoneNumber4432087431 TablePhoneNumber = `(443)208-7431`
+	TablePhoneNumber4445314151 TablePhoneNumber = `(444)531-4151`
+	TablePhoneNumber4478671650 TablePhoneNumber = `(447)867-1650`
+	TablePhoneNumber4506617337 TablePhoneNumber = `(450)661-7337`
+	TablePhoneNumber4514453454 TablePhoneNumber = `(451)445-3454`
+	TablePhoneNumber4534539496 TablePhoneNumber = `(453)453-9496`
+	TablePhoneNumber4537426569 TablePhoneNumber = `(453)742-6569`
+	TablePhoneNumber4538419597 TablePhoneNumber = `(453)841-9597`
+	TablePhoneNumber4546124389 TablePhoneNumber = `(454)612-4389`
+	TablePhoneNumber4557661641 TablePhoneNumber = `(455)766-1641`
+	TablePhoneNumber4563441269 TablePhoneNumber = `(456)344-1269`
+	TablePhoneNumber4572112990 TablePhoneNumber = `(457)211-2990`
+	TablePhoneNumber4587449454 TablePhoneNumber = `(458)744-9454`
+	TablePhoneNumber4588419455 TablePhoneNumber = `(458)841-9455`
+	TablePhoneNumber4609234708 TablePhoneNumber = `(460)923-4708`
+	TablePhoneNumber4622491126 TablePhoneNumber = `(462)249-1126`
+	TablePhoneNumber4627697484 TablePhoneNumber = `(462)769-7484`
+	TablePhoneNumber4637927207 TablePhoneNumber = `(463)792-7207`
+	TablePhoneNumber4637930089 TablePhoneNumber = `(463)793-0089`
+	TablePhoneNumber4640437396 TablePhoneNumber = `(464)043-7396`
+	TablePhoneNumber4660208794 TablePhoneNumber = `(466)020-8794`
+	TablePhoneNumber4667195502 TablePhoneNumber = `(466)719-5502`
+	TablePhoneNumber4676955419 TablePhoneNumber = `(467)695-5419`
+	TablePhoneNumber4682652733 TablePhoneNumber = `(468)265-2733`
+	TablePhoneNumber4695040680 TablePhoneNumber = `(469)504-0680`
+	TablePhoneNumber4704193270 TablePhoneNumber = `(470)419-3270`
+	TablePhoneNumber4709745922 TablePhoneNumber = `(470)974-5922`
+	TablePhoneNumber4709788523 TablePhoneNumber = `(470)978-8523`
+	TablePhoneNumber4715514277 TablePhoneNumber = `(471)551-4277`
+	TablePhoneNumber4724986104 TablePhoneNumber = `(472)498-6104`
+	TablePhoneNumber4740920985 TablePhoneNumber = `(474)092-0985`
+	TablePhoneNumber4745152118 TablePhoneNumber = `(474)515-2118`
+	TablePhoneNumber4773557502 TablePhoneNumber = `(477)355-7502`
+	TablePhoneNumber4778160873 TablePhoneNumber = `(477)816-0873`
+	TablePhoneNumber4789517242 TablePhoneNumber = `(478)951-7242`
+	TablePhoneNumber4796416937 TablePhoneNumber = `(479)641-6937`
+	TablePhoneNumber4818906449 TablePhoneNumber = `(481)890-6449`
+	TablePhoneNumber4825379094 TablePhoneNumber = `(482)537-9094`
+	TablePhoneNumber4826081906 TablePhoneNumber = `(482)608-1906`
+	TablePhoneNumber4850444689 TablePhoneNumber = `(485)044-4689`
+	TablePhoneNumber4858782778 TablePhoneNumber = `(485)878-2778`
+	TablePhoneNumber4861960236 TablePhoneNumber = `(486)196-0236`
+	TablePhoneNumber4873619472 TablePhoneNumber = `(487)361-9472`
+	TablePhoneNumber4882271192 TablePhoneNumber = `(488)227-1192`
+	TablePhoneNumber4903631724 TablePhoneNumber = `(490)363-1724`
+	TablePhoneNumber4905811683 TablePhoneNumber = `(490)581-1683`
+	TablePhoneNumber4910562691 TablePhoneNumber = `(491)056-2691`
+	TablePhoneNumber4923402105 TablePhoneNumber = `(492)340-2105`
+	TablePhoneNumber4934499353 TablePhoneNumber = `(493)449-9353`
+	TablePhoneNumber4935582330 TablePhoneNumber = `(493)558-2330`
+	TablePhoneNumber4958818656 TablePhoneNumber = `(495)881-8656`
+	TablePhoneNumber4966493490 TablePhoneNumber = `(496)649-3490`
+	TablePhoneNumber4984836438 TablePhoneNumber = `(498)483-6438`
+	TablePhoneNumber5001263629 TablePhoneNumber = `(500)126-3629`
+	TablePhoneNumber5025416350 TablePhoneNumber = `(502)541-6350`
+	TablePhoneNumber5037999651 TablePhoneNumber = `(503)799-9651`
+	TablePhoneNumber5044005778 TablePhoneNumber = `(504)400-5778`
+	TablePhoneNumber5046009630 TablePhoneNumber = `(504)600-9630`
+	TablePhoneNumber5065189133 TablePhoneNumber = `(506)518-9133`
+	TablePhoneNumber5080090311 TablePhoneNumber = `(508)009-0311`
+	TablePhoneNumber5138438210 TablePhoneNumber = `(513)843-8210`
+	TablePhoneNumber5142184313 TablePhoneNumber = `(514)218-4313`
+	TablePhoneNumber5144637083 TablePhoneNumber = `(514)463-7083`
+	TablePhoneNumber5151419299 TablePhoneNumber = `(515)141-9299`
+	TablePhoneNumber5160594087 TablePhoneNumber = `(516)059-4087`
+	TablePhoneNumber5166363997 TablePhoneNumber = `(516)636-3997`
+	TablePhoneNumber5176433356 TablePhoneNumber = `(517)643-3356`
+	TablePhoneNumber5191919417 TablePhoneNumber = `(519)191-9417`
+	TablePhoneNumber5231657674 TablePhoneNumber = `(523)165-7674`
+	TablePhoneNumber5251425695 TablePhoneNumber = `(525)142-5695`
+	TablePhoneNumber5255800351 TablePhoneNumber = `(525)580-0351`
+	TablePhoneNumber5262253999 TablePhoneNumber = `(526)225-3999`
+	TablePhoneNumber5263684043 TablePhoneNumber = `(526)368-4043`
+	TablePhoneNumber5268552737 TablePhoneNumber = `(526)855-2737`
+	TablePhoneNumber5276756113 TablePhoneNumber = `(527)675-6113`
+	TablePhoneNumber5302874456 TablePhoneNumber = `(530)287-4456`
+	TablePhoneNumber5308141162 TablePhoneNumber = `(530)814-1162`
+	TablePhoneNumber5309358616 TablePhoneNumber = `(530)935-8616`
+	TablePhoneNumber5311341587 TablePhoneNumber = `(531)134-1587`
+	TablePhoneNumber5337217542 TablePhoneNumber = `(533)721-7542`
+	TablePhoneNumber5338851179 TablePhoneNumber = `(533)885-1179`
+	TablePhoneNumber5358961109 TablePhoneNumber = `(535)896-1109`
+	TablePhoneNumber5360404796 TablePhoneNumber = `(536)040-4796`
+	TablePhoneNumber5371193022 TablePhoneNumber = `(537)119-3022`
+	TablePhoneNumber5393274819 TablePhoneNumber = `(539)327-4819`
+	TablePhoneNumber5397473658 TablePhoneNumber = `(539)747-3658`
+	TablePhoneNumber5416447108 TablePhoneNumber = `(541)644-7108`
+	TablePhoneNumber5420397083 TablePhoneNumber = `(542)039-7083`
+	TablePhoneNumber5443338413 TablePhoneNumber = `(544)333-8413`
+	TablePhoneNumber5448811615 TablePhoneNumber = `(544)881-1615`
+	TablePhoneNumber5472051413 TablePhoneNumber = `(547)205-1413`
+	TablePhoneNumber5493001441 TablePhoneNumber = `(549)300-1441`
+	TablePhoneNumber5493282327 TablePhoneNumber = `(549)328-2327`
+	TablePhoneNumber5514233511 TablePhoneNumber = `(551)423-3511`
+	TablePhoneNumber5519016452 TablePhoneNumber = `(551)901-6452`
+	TablePhoneNumber5522652807 TablePhoneNumber = `(552)265-2807`
+	TablePhoneNumber5537483267 TablePhoneNumber = `(553)748-3267`
+	TablePhoneNumber5560120350 TablePhoneNumber = `(556)012-0350`
+	TablePhoneNumber5566664825 TablePhoneNumber = `(556)666-4825`
+	TablePhoneNumber5570804887 TablePhoneNumber = `(557)080-4887`
+	TablePhoneNumber5574136179 TablePhoneNumber = `(557)413-6179`
+	TablePhoneNumber5578917243 TablePhoneNumber = `(557)891-7243`
+	TablePhoneNumber5581105674 TablePhoneNumber = `(558)110-5674`
+	TablePhoneNumber5590192177 TablePhoneNumber = `(559)019-2177`
+	TablePhoneNumber5603982890 TablePhoneNumber = `(560)398-2890`
+	TablePhoneNumber5604922803 TablePhoneNumber = `(560)492-2803`
+	TablePhoneNumber5608244296 TablePhoneNumber = `(560)824-4296`
+	TablePhoneNumber5630579593 TablePhoneNumber = `(563)057-9593`
+	TablePhoneNumber5631357239 TablePhoneNumber = `(563)135-7239`
+	TablePhoneNumber5663436158 TablePhoneNumber = `(566)343-6158`
+	TablePhoneNumber5673433601 TablePhoneNumber = `(567)343-3601`
+	TablePhoneNumber5676990964 TablePhoneNumber = `(567)699-0964`
+	TablePhoneNumber5679554755 TablePhoneNumber = `(567)955-4755`
+	TablePhoneNumber5684555518 TablePhoneNumber = `(568)455-5518`
+	TablePhoneNumber5694851351 TablePhoneNumber = `(569)485-1351`
+	TablePhoneNumber5696755592 TablePhoneNumber = `(569)675-5592`
+	TablePhoneNumber5722565255 TablePhoneNumber = `(572)256-5255`
+	TablePhoneNumber5725836670 TablePhoneNumber = `(572)583-6670`
+	TablePhoneNumber5725998576 TablePhoneNumber = `(572)599-8576`
+	TablePhoneNumber5743703785 TablePhoneNumber = `(574)370-3785`
+	TablePhoneNumber5744486569 TablePhoneNumber = `(574)448-6569`
+	TablePhoneNumber5751491800 TablePhoneNumber = `(575)149-1800`
+	TablePhoneNumber5755196399 TablePhoneNumber = `(575)519-6399`
+	TablePhoneNumber5759689030 TablePhoneNumber = `(575)968-9030`
+	TablePhoneNumber5760089199 TablePhoneNumber = `(576)008-9199`
+	TablePhoneNumber5785131672 TablePhoneNumber = `(578)513-1672`
+	TablePhoneNumber5785133257 TablePhoneNumber = `(578)513-3257`
+	TablePhoneNumber5787374341 TablePhoneNumber = `(578)737-4341`
+	TablePhoneNumber5805613207 TablePhoneNumber = `(580)561-3207`
+	TablePhoneNumber5848464230 TablePhoneNumber = `(584)846-4230`
+	TablePhoneNumber5863020737 TablePhoneNumber = `(586)302-0737`
+	TablePhoneNumber5872860462 TablePhoneNumber = `(587)286-0462`
+	TablePhoneNumber5881576521 TablePhoneNumber = `(588)157-6521`
+	TablePhoneNumber5892194327 TablePhoneNumber = `(589)219-4327`
+	TablePhoneNumber5903820464 TablePhoneNumber = `(590)382-0464`
+	TablePhoneNumber5947808689 TablePhoneNumber = `(594)780-8689`
+	TablePhoneNumber5951714971 TablePhoneNumber = `(595)171-4971`
+	TablePhoneNumber5956095113 TablePhoneNumber = `(595)609-5113`
+	TablePhoneNumber5957421887 TablePhoneNumber = `(595)742-1887`
+	TablePhoneNumber5962425986 TablePhoneNumber = `(596)242-5986`
+	TablePhoneNumber5967871563 TablePhoneNumber = `(596)787-1563`
+	TablePhoneNumber5970634595 TablePhoneNumber = `(597)063-4595`
+	TablePhoneNumber5977047408 TablePhoneNumber = `(597)704-7408`
+	TablePhoneNumber6005998053 TablePhoneNumber = `(600)599-8053`
+	TablePhoneNumber6012920226 TablePhoneNumber = `(601)292-0226`
+	TablePhoneNumber6014304410 TablePhoneNumber = `(601)430-4410`
+	TablePhoneNumber6023861964 TablePhoneNumber = `(602)386-1964`
+	TablePhoneNumber6033390991 TablePhoneNumber = `(603)339-0991`
+	TablePhoneNumber6034545797 TablePhoneNumber = `(603)454-5797`
+	TablePhoneNumber6039229921 TablePhoneNumber = `(603)922-9921`
+	TablePhoneNumber6051662693 TablePhoneNumber = `(605)166-2693`
+	TablePhoneNumber6064916899 TablePhoneNumber = `(606)491-6899`
+	TablePhoneNumber6081591463 TablePhoneNumber = `(608)159-1463`
+	TablePhoneNumber6090759738 TablePhoneNumber = `(609)075-9738`
+	TablePhoneNumber6102586392 TablePhoneNumber = `(610)258-6392`
+	TablePhoneNumber6104513175 TablePhoneNumber = `(610)451-3175`
+	TablePhoneNumber6160845568 TablePhoneNumber = `(616)084-5568`
+	TablePhoneNumber6163675125 TablePhoneNumber = `(616)367-5125`
+	TablePhoneNumber6165919407 TablePhoneNumber = `(616)591-9407`
+	TablePhoneNumber6179335027 TablePhoneNumber = `(617)933-5027`
+	TablePhoneNumber6193845684 TablePhoneNumber = `(619)384-5684`
+	TablePhoneNumber6200917168 TablePhoneNumber = `(620)091-7168`
+	TablePhoneNumber6208480484 TablePhoneNumber = `(620)848-0484`
+	TablePhoneNumber6219939659 TablePhoneNumber = `(621)993-9659`
+	TablePhoneNumber6220215399 TablePhoneNumber = `(622)021-5399`
+	TablePhoneNumber6226659770 TablePhoneNumber = `(622)665-9770`
+	TablePhoneNumber6235515738 TablePhoneNumber = `(623)551-5738`
+	TablePhoneNumber6241929953 TablePhoneNumber = `(624)192-9953`
+	TablePhoneNumber6243303619 TablePhoneNumber = `(624)330-3619`
+	TablePhoneNumber6260543967 TablePhoneNumber = `(626)054-3967`
+	TablePhoneNumber6279669063 TablePhoneNumber = `(627)966-9063`
+	TablePhoneNumber6293289582 TablePhoneNumber = `(629)328-9582`
+	TablePhoneNumber6294268169 TablePhoneNumber = `(629)426-8169`
+	TablePhoneNumber6306494087 TablePhoneNumber = `(630)649-4087`
+	TablePhoneNumber6306887013 TablePhoneNumber = `(630)688-7013`
+	TablePhoneNumber6312839658 TablePhoneNumber = `(631)283-9658`
+	TablePhoneNumber6318610610 TablePhoneNumber = `(631)861-0610`
+	TablePhoneNumber6332717066 TablePhoneNumber = `(633)271-7066`
+	TablePhoneNumber6347677614 TablePhoneNumber = `(634)767-7614`
+	TablePhoneNumber6358174500 TablePhoneNumber = `(635)817-4500`
+	TablePhoneNumber6360199596 TablePhoneNumber = `(636)019-9596`
+	TablePhoneNumber6366485663 TablePhoneNumber = `(636)648-5663`
+	TablePhoneNumber6374691401 TablePhoneNumber = `(637)469-1401`
+	TablePhoneNumber6379921529 TablePhoneNumber = `(637)992-1529`
+	TablePhoneNumber6405327532 TablePhoneNumber = `(640)532-7532`
+	TablePhoneNumber6408082950 TablePhoneNumber = `(640)808-2950`
+	TablePhoneNumber6434065013 TablePhoneNumber = `(643)406-5013`
+	TablePhoneNumber6436171328 TablePhoneNumber = `(643)617-1328`
+	TablePhoneNumber6438157142 TablePhoneNumber = `(643)815-7142`
+	TablePhoneNumber6459391884 TablePhoneNumber = `(645)939-1884`
+	TablePhoneNumber6463307552 TablePhoneNumber = `(646)330-7552`
+	TablePhoneNumber6464304425 TablePhoneNumber = `(646)430-4425`
+	TablePhoneNumber6467579645 TablePhoneNumber = `(646)757-9645`
+	TablePhoneNumber6485766542 TablePhoneNumber = `(648)576-6542`
+	TablePhoneNumber6492815817 TablePhoneNumber = `(649)281-5817`
+	TablePhoneNumber6514347877 TablePhoneNumber = `(651)434-7877`
+	TablePhoneNumber6518555503 TablePhoneNumber = `(651)855-5503`
+	TablePhoneNumber6519079152 TablePhoneNumber = `(651)907-9152`
+	TablePhoneNumber6522011937 TablePhoneNumber = `(652)201-1937`
+	TablePhoneNumber6526779302 TablePhoneNumber = `(652)677-9302`
+	TablePhoneNumber6539133561 TablePhoneNumber = `(653)913-3561`
+	TablePhoneNumber6539190290 TablePhoneNumber = `(653)919-0290`
+	TablePhoneNumber6542140363 TablePhoneNumber = `(654)214-0363`
+	TablePhoneNumber6545843638 TablePhoneNumber = `(654)584-3638`
+	TablePhoneNumber6556283423 TablePhoneNumber = `(655)628-3423`
+	TablePhoneNumber6562058838 TablePhoneNumber = `(656)205-8838`
+	TablePhoneNumber6581860584 TablePhoneNumber = `(658)186-0584`
+	TablePhoneNumber6605532359 TablePhoneNumber = `(660)553-2359`
+	TablePhoneNumber6606146256 TablePhoneNumber = `(660)614-6256`
+	TablePhoneNumber6618888175 TablePhoneNumber = `(661)888-8175`
+	TablePhoneNumber6629180585 TablePhoneNumber = `(662)918-0585`
+	TablePhoneNumber6644867933 TablePhoneNumber = `(664)486-7933`
+	TablePhoneNumber6646440588 TablePhoneNumber = `(664)644-0588`
+	TablePhoneNumber6663739068 TablePhoneNumber = `(666)373-9068`
+	TablePhoneNumber6666109788 TablePhoneNumber = `(666)610-9788`
+	TablePhoneNumber6667110630 TablePhoneNumber = `(666)711-0630`
+	TablePhoneNumber6669364637 TablePhoneNumber = `(666)936-4637`
+	TablePhoneNumber6675814428 TablePhoneNumber = `(667)581-4428`
+	TablePhoneNumber6677945040 TablePhoneNumber = `(667)794-5040`
+	TablePhoneNumber6694124853 TablePhoneNumber = `(669)412-4853`
+	TablePhoneNumber6713771515 TablePhoneNumber = `(671)377-1515`
+	TablePhoneNumber6728614357 TablePhoneNumber = `(672)861-4357`
+	TablePhoneNumber6732092035 TablePhoneNumber = `(673)209-2035`
+	TablePhoneNumber6738999851 TablePhoneNumber = `(673)899-9851`
+	TablePhoneNumber6748242613 TablePhoneNumber = `(674)824-2613`
+	TablePhoneNumber6752898662 TablePhoneNumber = `(675)289-8662`
+	TablePhoneNumber6774340724 TablePhoneNumber = `(677)434-0724`
+	TablePhoneNumber6775219206 TablePhoneNumber = `(677)521-9206`
+	TablePhoneNumber6785087427 TablePhoneNumber = `(678)508-7427`
+	TablePhoneNumber6825954806 TablePhoneNumber = `(682)595-4806`
+	TablePhoneNumber6832230630 TablePhoneNumber = `(683)223-0630`
+	TablePhoneNumber6837708839 TablePhoneNumber = `(683)770-8839`
+	TablePhoneNumber6849354057 TablePhoneNumber = `(684)935-4057`
+	TablePhoneNumber6855840855 TablePhoneNumber = `(685)584-0855`
+	TablePhoneNumber6860665474 TablePhoneNumber = `(686)066-5474`
+	TablePhoneNumber6870582573 TablePhoneNumber = `(687)058-2573`
+	TablePhoneNumber6871139639 TablePhoneNumber = `(687)113-9639`
+	TablePhoneNumber6871365457 TablePhoneNumber = `(687)136-5457`
+	TablePhoneNumber6882334463 TablePhoneNumber = `(688)233-4463`
+	TablePhoneNumber6911044382 TablePhoneNumber = `(691)104-4382`
+	TablePhoneNumber6913277872 TablePhoneNumber = `(691)327-7872`
+	TablePhoneNumber6941029428 TablePhoneNumber = `(694)102-9428`
+	TablePhoneNumber6980089712 TablePhoneNumber = `(698)008-9712`
+	TablePhoneNumber6984656001 TablePhoneNumber = `(698)465-6001`
+	TablePhoneNumber6986517847 TablePhoneNumber = `(698)651-7847`
+	TablePhoneNumber6986608633 TablePhoneNumber = `(698)660-8633`
+	TablePhoneNumber6988790511 TablePhoneNumber = `(698)879-0511`
+	TablePhoneNumber6990481691 TablePhoneNumber = `(699)048-1691`
+	TablePhoneNumber7017539540 TablePhoneNumber = `(701)753-9540`
+	TablePhoneNumber7043858282 TablePhoneNumber = `(704)385-8282`
+	TablePhoneNumber7052697628 TablePhoneNumber = `(705)269-7628`
+	TablePhoneNumber7054243598 TablePhoneNumber = `(705)424-3598`
+	TablePhoneNumber7058955731 TablePhoneNumber = `(705)895-5731`
+	TablePhoneNumber7060217859 TablePhoneNumber = `(706)021-7859`
+	TablePhoneNumber7073578230 TablePhoneNumber = `(707)357-8230`
+	TablePhoneNumber7082450694 TablePhoneNumber = `(708)245-0694`
+	TablePhoneNumber7089079137 TablePhoneNumber = `(708)907-9137`
+	TablePhoneNumber7096797726 TablePhoneNumber = `(709)679-7726`
+	TablePhoneNumber7103156149 TablePhoneNumber = `(710)315-6149`
+	TablePhoneNumber7114996820 TablePhoneNumber = `(711)499-6820`
+	TablePhoneNumber7121822385 TablePhoneNumber = `(712)182-2385`
+	TablePhoneNumber7129431067 TablePhoneNumber = `(712)943-1067`
+	TablePhoneNumber7130787309 TablePhoneNumber = `(713)078-7309`
+	TablePhoneNumber7132821103 TablePhoneNumber = `(713)282-1103`
+	TablePhoneNumber7138881609 TablePhoneNumber = `(713)888-1609`
+	TablePhoneNumber7144894836 TablePhoneNumber = `(714)489-4836`
+	TablePhoneNumber7145329784 TablePhoneNumber = `(714)532-9784`
+	TablePhoneNumber7145892697 TablePhoneNumber = `(714)589-2697`
+	TablePhoneNumber7147253320 TablePhoneNumber = `(714)725-3320`
+	TablePhoneNumber7159268554 TablePhoneNumber = `(715)926-8554`
+	TablePhoneNumber7172963780 TablePhoneNumber = `(717)296-3780`
+	TablePhoneNumber7173929508 TablePhoneNumber = `(717)392-9508`
+	TablePhoneNumber7188383600 TablePhoneNumber = `(718)838-3600`
+	TablePhoneNumber7198317376 TablePhoneNumber = `(719)831-7376`
+	TablePhoneNumber7199543815 TablePhoneNumber = `(719)954-3815`
+	TablePhoneNumber7204267959 TablePhoneNumber = `(720)426-7959`
+	TablePhoneNumber7204376393 TablePhoneNumber = `(720)437-6393`
+	TablePhoneNumber7241269900 TablePhoneNumber = `(724)126-9900`
+	TablePhoneNumber7242293105 TablePhoneNumber = `(724)229-3105`
+	TablePhoneNumber7253968121 TablePhoneNumber = `(725)396-8121`
+	TablePhoneNumber7269002631 TablePhoneNumber = `(726)900-2631`
+	TablePhoneNumber7276952782 TablePhoneNumber = `(727)695-2782`
+	TablePhoneNumber7281796849 TablePhoneNumber = `(728)179-6849`
+	TablePhoneNumber7300069963 TablePhoneNumber = `(730)006-9963`
+	TablePhoneNumber7310872021 TablePhoneNumber = `(731)087-2021`
+	TablePhoneNumber7317479962 TablePhoneNumber = `(731)747-9962`
+	TablePhoneNumber7322123121 TablePhoneNumber = `(732)212-3121`
+	TablePhoneNumber7330365331 TablePhoneNumber = `(733)036-5331`
+	TablePhoneNumber7335278173 TablePhoneNumber = `(733)527-8173`
+	TablePhoneNumber7339348906 TablePhoneNumber = `(733)934-8906`
+	TablePhoneNumber7348050698 TablePhoneNumber = `(734)805-0698`
+	TablePhoneNumber7354475288 TablePhoneNumber = `(735)447-5288`
+	TablePhoneNumber7360687905 TablePhoneNumber = `(736)068-7905`
+	TablePhoneNumber7361413190 TablePhoneNumber = `(736)141-3190`
+	TablePhoneNumber7378347922 TablePhoneNumber = `(737)834-7922`
+	TablePhoneNumber7397127981 TablePhoneNumber = `(739)712-7981`
+	TablePhoneNumber7404394080 TablePhoneNumber = `(740)439-4080`
+	TablePhoneNumber7416512611 TablePhoneNumber = `(741)651-2611`
+	TablePhoneNumber7420573047 TablePhoneNumber = `(742)057-3047`
+	TablePhoneNumber7428511109 TablePhoneNumber = `(742)851-1109`
+	TablePhoneNumber7437711953 TablePhoneNumber = `(743)771-1953`
+	TablePhoneNumber7440384911 TablePhoneNumber = `(744)038-4911`
+	TablePhoneNumber7442692466 TablePhoneNumber = `(744)269-2466`
+	TablePhoneNumber7456254492 TablePhoneNumber = `(745)625-4492`
+	TablePhoneNumber7460526260 TablePhoneNumber = `(746)052-6260`
+	TablePhoneNumber7470470854 TablePhoneNumber = `(747)047-0854`
+	TablePhoneNumber7484966638 TablePhoneNumber = `(748)496-6638`
+	TablePhoneNumber7486405986 TablePhoneNumber = `(748)640-5986`
+	TablePhoneNumber7489419575 TablePhoneNumber = `(748)941-9575`
+	TablePhoneNumber7493280833 TablePhoneNumber = `(749)328-0833`
+	TablePhoneNumber7496755619 TablePhoneNumber = `(749)675-5619`
+	TablePhoneNumber7511172370 TablePhoneNumber = `(751)117-2370`
+	TablePhoneNumber7514680594 TablePhoneNumber = `(751)468-0594`
+	TablePhoneNumber7517249503 TablePhoneNumber = `(751)724-9503`
+	TablePhoneNumber7526238555 TablePhoneNumber = `(752)623-8555`
+	TablePhoneNumber7552700373 TablePhoneNumber = `(755)270-0373`
+	TablePhoneNumber7553751935 TablePhoneNumber = `(755)375-1935`
+	TablePhoneNumber7554839014 TablePhoneNumber = `(755)483-9014`
+	TablePhoneNumber7560678075 TablePhoneNumber = `(756)067-8075`
+	TablePhoneNumber7571931109 TablePhoneNumber = `(757)193-1109`
+	TablePhoneNumber7599572741 TablePhoneNumber = `(759)957-2741`
+	TablePhoneNumber7600325379 TablePhoneNumber = `(760)032-5379`
+	TablePhoneNumber7602264153 TablePhoneNumber = `(760)226-4153`
+	TablePhoneNumber7605088473 TablePhoneNumber = `(760)508-8473`
+	TablePhoneNumber7612921159 TablePhoneNumber = `(761)292-1159`
+	TablePhoneNumber7617707382 TablePhoneNumber = `(761)770-7382`
+	TablePhoneNumber7619869054 TablePhoneNumber = `(761)986-9054`
+	TablePhoneNumber7621486339 TablePhoneNumber = `(762)148-6339`
+	TablePhoneNumber7635953724 TablePhoneNumber = `(763)595-3724`
+	TablePhoneNumber7644083515 TablePhoneNumber = `(764)408-3515`
+	TablePhoneNumber7661678587 TablePhoneNumber = `(766)167-8587`
+	TablePhoneNumber7685840342 TablePhoneNumber = `(768)584-0342`
+	TablePhoneNumber7691521601 TablePhoneNumber = `(769)152-1601`
+	TablePhoneNumber7692755693 TablePhoneNumber = `(769)275-5693`
+	TablePhoneNumber7703498258 TablePhoneNumber = `(770)349-8258`
+	TablePhoneNumber7704672443 TablePhoneNumber = `(770)467-2443`
+	TablePhoneNumber7707023494 TablePhoneNumber = `(770)702-3494`
+	TablePhoneNumber7711378946 TablePhoneNumber = `(771)137-8946`
+	TablePhoneNumber7716136848 TablePhoneNumber = `(771)613-6848`
+	TablePhoneNumber7717698105 TablePhoneNumber = `(771)769-8105`
+	TablePhoneNumber7718679665 TablePhoneNumber = `(771)867-9665`
+	TablePhoneNumber7738410055 TablePhoneNumber = `(773)841-0055`
+	TablePhoneNumber7746199237 TablePhoneNumber = `(774)619-9237`
+	TablePhoneNumber7747805604 TablePhoneNumber = `(774)780-5604`
+	TablePhoneNumber7748406740 TablePhoneNumber = `(774)840-6740`
+	TablePhoneNumber7758629463 TablePhoneNumber = `(775)862-9463`
+	TablePhoneNumber7795889955 TablePhoneNumber = `(779)588-9955`
+	TablePhoneNumber7801294538 TablePhoneNumber = `(780)129-4538`
+	TablePhoneNumber7812733064 TablePhoneNumber = `(781)273-3064`
+	TablePhoneNumber7818345371 TablePhoneNumber = `(781)834-5371`
+	TablePhoneNumber7846381850 TablePhoneNumber = `(784)638-1850`
+	TablePhoneNumber7852384683 TablePhoneNumber = `(785)238-4683`
+	TablePhoneNumber7852991011 TablePhoneNumber = `(785)299-1011`
+	TablePhoneNumber7859103080 TablePhoneNumber = `(785)910-3080`
+	TablePhoneNumber7875421431 TablePhoneNumber = `(787)542-1431`
+	TablePhoneNumber7876240514 TablePhoneNumber = `(787)624-0514`
+	TablePhoneNumber7882889544 TablePhoneNumber = `(788)288-9544`
+	TablePhoneNumber7882933465 TablePhoneNumber = `(788)293-3465`
+	TablePhoneNumber7902480778 TablePhoneNumber = `(790)248-0778`
+	TablePhoneNumber7902581436 TablePhoneNumber = `(790)258-1436`
+	TablePhoneNumber7902927346 TablePhoneNumber = `(790)292-7346`
+	TablePhoneNumber7914142366 TablePhoneNumber = `(791)414-2366`
+	TablePhoneNumber7944051423 TablePhoneNumber = `(794)405-1423`
+	TablePhoneNumber7965476357 TablePhoneNumber = `(796)547-6357`
+	TablePhoneNumber7969934781 TablePhoneNumber = `(796)993-4781`
+	TablePhoneNumber7970847316 TablePhoneNumber = `(797)084-7316`
+	TablePhoneNumber7973878553 TablePhoneNumber = `(797)387-8553`
+	TablePhoneNumber8001131772 TablePhoneNumber = `(800)113-1772`
+	TablePhoneNumber8012828040 TablePhoneNumber = `(801)282-8040`
+	TablePhoneNumber8015347997 TablePhoneNumber = `(801)534-7997`
+	TablePhoneNumber8021717049 TablePhoneNumber = `(802)171-7049`
+	TablePhoneNumber8024574026 TablePhoneNumber = `(802)457-4026`
+	TablePhoneNumber8036679121 TablePhoneNumber = `(803)667-9121`
+	TablePhoneNumber8043566528 TablePhoneNumber = `(804)356-6528`
+	TablePhoneNumber8059080773 TablePhoneNumber = `(805)908-0773`
+	TablePhoneNumber8080268750 TablePhoneNumber = `(808)026-8750`
+	TablePhoneNumber8087261797 TablePhoneNumber = `(808)726-1797`
+	TablePhoneNumber8091377017 TablePhoneNumber = `(809)137-7017`
+	TablePhoneNumber8098592894 TablePhoneNumber = `(809)859-2894`
+	TablePhoneNumber8110841723 TablePhoneNumber = `(811)084-1723`
+	TablePhoneNumber8128142808 TablePhoneNumber = `(812)814-2808`
+	TablePhoneNumber8132325375 TablePhoneNumber = `(813)232-5375`
+	TablePhoneNumber8151193354 TablePhoneNumber = `(815)119-3354`
+	TablePhoneNumber8155746110 TablePhoneNumber = `(815)574-6110`
+	TablePhoneNumber8159229990 TablePhoneNumber = `(815)922-9990`
+	TablePhoneNumber8159889936 TablePhoneNumber = `(815)988-9936`
+	TablePhoneNumber8169326273 TablePhoneNumber = `(816)932-6273`
+	TablePhoneNumber8180809652 TablePhoneNumber = `(818)080-9652`
+	TablePhoneNumber8182075326 TablePhoneNumber = `(818)207-5326`
+	TablePhoneNumber8190852607 TablePhoneNumber = `(819)085-2607`
+	TablePhoneNumber8192745153 TablePhoneNumber = `(819)274-5153`
+	TablePhoneNumber8211436569 TablePhoneNumber = `(821)143-6569`
+	TablePhoneNumber8240006213 TablePhoneNumber = `(824)000-6213`
+	TablePhoneNumber8261461471 TablePhoneNumber = `(826)146-1471`
+	TablePhoneNumber8270554196 TablePhoneNumber = `(827)055-4196`
+	TablePhoneNumber8315177557 TablePhoneNumber = `(831)517-7557`
+	TablePhoneNumber8350444685 TablePhoneNumber = `(835)044-4685`
+	TablePhoneNumber8355664747 TablePhoneNumber = `(835)566-4747`
+	TablePhoneNumber8362145561 TablePhoneNumber = `(836)214-5561`
+	TablePhoneNumber8366677541 TablePhoneNumber = `(836)667-7541`
+	TablePhoneNumber8384302097 TablePhoneNumber = `(838)430-2097`
+	TablePhoneNumber8396118354 TablePhoneNumber = `(839)611-8354`
+	TablePhoneNumber8402141443 TablePhoneNumber = `(840)214-1443`
+	TablePhoneNumber8422069652 TablePhoneNumber = `(842)206-9652`
+	TablePhoneNumber8425539277 TablePhoneNumber = `(842)553-9277`
+	TablePhoneNumber8429842217 TablePhoneNumber = `(842)984-2217`
+	TablePhoneNumber8436096669 TablePhoneNumber = `(843)609-6669`
+	TablePhoneNumber8439544932 TablePhoneNumber = `(843)954-4932`
+	TablePhoneNumber8451258340 TablePhoneNumber = `(845)125-8340`
+	TablePhoneNumber8464156832 TablePhoneNumber = `(846)415-6832`
+	TablePhoneNumber8491761461 TablePhoneNumber = `(849)176-1461`
+	TablePhoneNumber8504560085 TablePhoneNumber = `(850)456-0085`
+	TablePhoneNumber8515440548 TablePhoneNumber = `(851)544-0548`
+	TablePhoneNumber8519509071 TablePhoneNumber = `(851)950-9071`
+	TablePhoneNumber8530549411 TablePhoneNumber = `(853)054-9411`
+	TablePhoneNumber8537147336 TablePhoneNumber = `(853)714-7336`
+	TablePhoneNumber8540551538 TablePhoneNumber = `(854)055-1538`
+	TablePhoneNumber8547689065 TablePhoneNumber = `(854)768-9065`
+	TablePhoneNumber8547726556 TablePhoneNumber = `(854)772-6556`
+	TablePhoneNumber8551489530 TablePhoneNumber = `(855)148-9530`
+	TablePhoneNumber8559211046 TablePhoneNumber = `(855)921-1046`
+	TablePhoneNumber8563682122 TablePhoneNumber = `(856)368-2122`
+	TablePhoneNumber8570774220 TablePhoneNumber = `(857)077-4220`
+	TablePhoneNumber8574543787 TablePhoneNumber = `(857)454-3787`
+	TablePhoneNumber8591313651 TablePhoneNumber = `(859)131-3651`
+	TablePhoneNumber8627318562 TablePhoneNumber = `(862)731-8562`
+	TablePhoneNumber8635951623 TablePhoneNumber = `(863)595-1623`
+	TablePhoneNumber8641130448 TablePhoneNumber = `(864)113-0448`
+	TablePhoneNumber8666904927 TablePhoneNumber = `(866)690-4927`
+	TablePhoneNumber8691683379 TablePhoneNumber = `(869)168-3379`
+	TablePhoneNumber8692199586 TablePhoneNumber = `(869)219-9586`
+	TablePhoneNumber8696368984 TablePhoneNumber = `(869)636-8984`
+	TablePhoneNumber8720629910 TablePhoneNumber = `(872)062-9910`
+	TablePhoneNumber8731382124 TablePhoneNumber = `(873)138-2124`
+	TablePhoneNumber8735911697 TablePhoneNumber = `(873)591-1697`
+	TablePhoneNumber8763422217 TablePhoneNumber = `(876)342-2217`
+	TablePhoneNumber8768161712 TablePhoneNumber = `(876)816-1712`
+	TablePhoneNumber8774282087 TablePhoneNumber = `(877)428-2087`
+	TablePhoneNumber8796466310 TablePhoneNumber = `(879)646-6310`
+	TablePhoneNumber8816262391 TablePhoneNumber = `(881)626-2391`
+	TablePhoneNumber8817606150 TablePhoneNumber = `(881)760-6150`
+	TablePhoneNumber8834220394 TablePhoneNumber = `(883)422-0394`
+	TablePhoneNumber8836382566 TablePhoneNumber = `(883)638-2566`
+	TablePhoneNumber8855788821 TablePhoneNumber = `(885)578-8821`
+	TablePhoneNumber8884890328 TablePhoneNumber = `(888)489-0328`
+	TablePhoneNumber8902154593 TablePhoneNumber = `(890)215-4593`
+	TablePhoneNumber8913402881 TablePhoneNumber = `(891)340-2881`
+	TablePhoneNumber8915503544 TablePhoneNumber = `(891)550-3544`
+	TablePhoneNumber8923280619 TablePhoneNumber = `(892)328-0619`
+	TablePhoneNumber8931209009 TablePhoneNumber = `(893)120-9009`
+	TablePhoneNumber8943258747 TablePhoneNumber = `(894)325-8747`
+	TablePhoneNumber8943715424 TablePhoneNumber = `(894)371-5424`
+	TablePhoneNumber8945772581 TablePhoneNumber = `(894)577-2581`
+	TablePhoneNumber8952540384 TablePhoneNumber = `(895)254-0384`
+	TablePhoneNumber8957564212 TablePhoneNumber = `(895)756-4212`
+	TablePhoneNumber8961611085 TablePhoneNumber = `(896)161-1085`
+	TablePhoneNumber8967459705 TablePhoneNumber = `(896)745-9705`
+	TablePhoneNumber8969888817 TablePhoneNumber = `(896)988-8817`
+	TablePhoneNumber8983354657 TablePhoneNumber = `(898)335-4657`
+	TablePhoneNumber9005919222 TablePhoneNumber = `(900)591-9222`
+	TablePhoneNumber9018684243 TablePhoneNumber = `(901)868-4243`
+	TablePhoneNumber9020630631 TablePhoneNumber = `(902)063-0631`
+	TablePhoneNumber9039398820 TablePhoneNumber = `(903)939-8820`
+	TablePhoneNumber9043192997 TablePhoneNumber = `(904)319-2997`
+	TablePhoneNumber9056538129 TablePhoneNumber = `(905)653-8129`
+	TablePhoneNumber9064662298 TablePhoneNumber = `(906)466-2298`
+	TablePhoneNumber9077598056 TablePhoneNumber = `(907)759-8056`
+	TablePhoneNumber9092461852 TablePhoneNumber = `(909)246-1852`
+	TablePhoneNumber9093002377 TablePhoneNumber = `(909)300-2377`
+	TablePhoneNumber9093450034 TablePhoneNumber = `(909)345-0034`
+	TablePhoneNumber9108614300 TablePhoneNumber = `(910)861-4300`
+	TablePhoneNumber9110217823 TablePhoneNumber = `(911)021-7823`
+	TablePhoneNumber9112813420 TablePhoneNumber = `(911)281-3420`
+	TablePhoneNumber9117244584 TablePhoneNumber = `(911)724-4584`
+	TablePhoneNumber9135038339 TablePhoneNumber = `(913)503-8339`
+	TablePhoneNumber9140215994 TablePhoneNumber = `(914)021-5994`
+	TablePhoneNumber9157572966 TablePhoneNumber = `(915)757-2966`
+	TablePhoneNumber9161477644 TablePhoneNumber = `(916)147-7644`
+	TablePhoneNumber9171411025 TablePhoneNumber = `(917)141-1025`
+	TablePhoneNumber9176945537 TablePhoneNumber = `(917)694-5537`
+	TablePhoneNumber9188039971 TablePhoneNumber = `(918)803-9971`
+	TablePhoneNumber9195325858 TablePhoneNumber = `(919)532-5858`
+	TablePhoneNumber9196936668 TablePhoneNumber = `(919)693-6668`
+	TablePhoneNumber9212514862 TablePhoneNumber = `(921)251-4862`
+	TablePhoneNumber9217759266 TablePhoneNumber = `(921)775-9266`
+	TablePhoneNumber9227504564 TablePhoneNumber = `(922)750-4564`
+	TablePhoneNumber9242298600 TablePhoneNumber = `(924)229-8600`
+	TablePhoneNumber9250205381 TablePhoneNumber = `(925)020-5381`
+	TablePhoneNumber9256925744 TablePhoneNumber = `(925)692-5744`
+	TablePhoneNumber9258789730 TablePhoneNumber = `(925)878-9730`
+	TablePhoneNumber9264875368 TablePhoneNumber = `(926)487-5368`
+	TablePhoneNumber9286315868 TablePhoneNumber = `(928)631-5868`
+	TablePhoneNumber9295505648 TablePhoneNumber = `(929)550-5648`
+	TablePhoneNumber9299078014 TablePhoneNumber = `(929)907-8014`
+	TablePhoneNumber9322183738 TablePhoneNumber = `(932)218-3738`
+	TablePhoneNumber9355803106 TablePhoneNumber = `(935)580-3106`
+	TablePhoneNumber9356063273 TablePhoneNumber = `(935)606-3273`
+	TablePhoneNumber9357513561 TablePhoneNumber = `(935)751-3561`
+	TablePhoneNumber9361352310 TablePhoneNumber = `(936)135-2310`
+	TablePhoneNumber9384136458 TablePhoneNumber = `(938)413-6458`
+	TablePhoneNumber9410472527 TablePhoneNumber = `(941)047-2527`
+	TablePhoneNumber9412968588 TablePhoneNumber = `(941)296-8588`
+	TablePhoneNumber9419346234 TablePhoneNumber = `(941)934-6234`
+	TablePhoneNumber9423541999 TablePhoneNumber = `(942)354-1999`
+	TablePhoneNumber9427226917 TablePhoneNumber = `(942)722-6917`
+	TablePhoneNumber9432171571 TablePhoneNumber = `(943)217-1571`
+	TablePhoneNumber9437745357 TablePhoneNumber = `(943)774-5357`
+	TablePhoneNumber9448868208 TablePhoneNumber = `(944)886-8208`
+	TablePhoneNumber9471082454 TablePhoneNumber = `(947)108-2454`
+	TablePhoneNumber9475382838 TablePhoneNumber = `(947)538-2838`
+	TablePhoneNumber9483046100 TablePhoneNumber = `(948)304-6100`
+	TablePhoneNumber9487333149 TablePhoneNumber = `(948)733-3149`
+	TablePhoneNumber9498000810 TablePhoneNumber = `(949)800-0810`
+	TablePhoneNumber9512709835 TablePhoneNumber = `(951)270-9835`
+	TablePhoneNumber9521621559 TablePhoneNumber = `(952)162-1559`
+	TablePhoneNumber9528533566 TablePhoneNumber = `(952)853-3566`
+	TablePhoneNumber9531891330 TablePhoneNumber = `(953)189-1330`
+	TablePhoneNumber9559470459 TablePhoneNumber = `(955)947-0459`
+	TablePhoneNumber9565170702 TablePhoneNumber = `(956)517-0702`
+	TablePhoneNumber9571037685 TablePhoneNumber = `(957)103-7685`
+	TablePhoneNumber9573761507 TablePhoneNumber = `(957)376-1507`
+	TablePhoneNumber9575685503 TablePhoneNumber = `(957)568-5503`
+	TablePhoneNumber9592524837 TablePhoneNumber = `(959)252-4837`
+	TablePhoneNumber9598666711 TablePhoneNumber = `(959)866-6711`
+	TablePhoneNumber9601599490 TablePhoneNumber = `(960)159-9490`
+	TablePhoneNumber9610127334 TablePhoneNumber = `(961)012-7334`
+	TablePhoneNumber9617727344 TablePhoneNumber = `(961)772-7344`
+	TablePhoneNumber9625580335 TablePhoneNumber = `(962)558-0335`
+	TablePhoneNumber9635924777 TablePhoneNumber = `(963)592-4777`
+	TablePhoneNumber9649212640 TablePhoneNumber = `(964)921-2640`
+	TablePhoneNumber9670343119 TablePhoneNumber = `(967)034-3119`
+	TablePhoneNumber9678037125 TablePhoneNumber = `(967)803-7125`
+	TablePhoneNumber9680596712 TablePhoneNumber = `(968)059-6712`
+	TablePhoneNumber9689234624 TablePhoneNumber = `(968)923-4624`
+	TablePhoneNumber9695459064 TablePhoneNumber = `(969)545-9064`
+	TablePhoneNumber9706393627 TablePhoneNumber = `(970)639-3627`
+	TablePhoneNumber9708197458 TablePhoneNumber = `(970)819-7458`
+	TablePhoneNumber9713878213 TablePhoneNumber = `(971)387-8213`
+	TablePhoneNumber9731292831 TablePhoneNumber = `(973)129-2831`
+	TablePhoneNumber9731473696 TablePhoneNumber = `(973)147-3696`
+	TablePhoneNumber9737247344 TablePhoneNumber = `(973)724-7344`
+	TablePhoneNumber9747186899 TablePhoneNumber = `(974)718-6899`
+	TablePhoneNumber9747938041 TablePhoneNumber = `(974)793-8041`
+	TablePhoneNumber9761352972 TablePhoneNumber = `(976)135-2972`
+	TablePhoneNumber9789653841 TablePhoneNumber = `(978)965-3841`
+	TablePhoneNumber9797227875 TablePhoneNumber = `(979)722-7875`
+	TablePhoneNumber9803411664 TablePhoneNumber = `(980)341-1664`
+	TablePhoneNumber9809304619 TablePhoneNumber = `(980)930-4619`
+	TablePhoneNumber9817328982 TablePhoneNumber = `(981)732-8982`
+	TablePhoneNumber9836278743 TablePhoneNumber = `(983)627-8743`
+	TablePhoneNumber9848142316 TablePhoneNumber = `(984)814-2316`
+	TablePhoneNumber9851733767 TablePhoneNumber = `(985)173-3767`
+	TablePhoneNumber9862946279 TablePhoneNumber = `(986)294-6279`
+	TablePhoneNumber9892510197 TablePhoneNumber = `(989)251-0197`
+	TablePhoneNumber9902662799 TablePhoneNumber = `(990)266-2799`
+	TablePhoneNumber9912311452 TablePhoneNumber = `(991)231-1452`
+	TablePhoneNumber9926430429 TablePhoneNumber = `(992)643-0429`
+	TablePhoneNumber9952896049 TablePhoneNumber = `(995)289-6049`
+	TablePhoneNumber9959071091 TablePhoneNumber = `(995)907-1091`
+	TablePhoneNumber9959525150 TablePhoneNumber = `(995)952-5150`
+	TablePhoneNumber9961396132 TablePhoneNumber = `(996)139-6132`
+	TablePhoneNumber9996730589 TablePhoneNumber = `(999)673-0589`
+	TablePhoneNumber9998791284 TablePhoneNumber = `(999)879-1284`
+)
+
+// Description of the value, or empty if the value is not in table PhoneNumber.
+func (v TablePhoneNumber) Description() string {
+	return tableDescription(`PhoneNumber`, string(v))
+}
+
+// Valid reports if the value is in table PhoneNumber.
+func (v TablePhoneNumber) Valid() bool {
+	return TableValueLookup[`PhoneNumber`][string(v)]
+}
+
+// Table PhoneNumber.
+func (v TablePhoneNumber) Table() Table {
+	return TableLookup[`PhoneNumber`]
+}
+
+// TableState is a value of table State, State.
+// Fields in this table are strings; convert a field, such as TableState(v), to use the helpers.
+type TableState string
+
+const (
+	TableStateAlabama       TableState = `Alabama`
+	TableStateAlaska        TableState = `Alaska`
+	TableStateArizona       TableState = `Arizona`
+	TableStateArkansas      TableState = `Arkansas`
+	TableStateCalifornia    TableState = `California`
+	TableStateColorado      TableState = `Colorado`
+	TableStateConnecticut   TableState = `Connecticut`
+	TableStateDelaware      TableState = `Delaware`
+	TableStateFlorida       TableState = `Florida`
+	TableStateGeorgia       TableState = `Georgia`
+	TableStateHawaii        TableState = `Hawaii`
+	TableStateIdaho         TableState = `Idaho`
+	TableStateIllinois      TableState = `Illinois`
+	TableStateIndiana       TableState = `Indiana`
+	TableStateIowa          TableState = `Iowa`
+	TableStateKansas        TableState = `Kansas`
+	TableStateKentucky      TableState = `Kentucky`
+	TableStateLouisiana     TableState = `Louisiana`
+	TableStateMaine         TableState = `Maine`
+	TableStateMaryland      TableState = `Maryland`
+	TableStateMassachusetts TableState = `Massachusetts`
+	TableStateMichigan      TableState = `Michigan`
+	TableStateMinnesota     TableState = `Minnesota`
+	TableStateMississippi   TableState = `Mississippi`
+	TableStateMissouri      TableState = `Missouri`
+	TableStateMontana       TableState = `Montana`
+	TableStateNebraska      TableState = `Nebraska`
+	TableStateNevada        TableState = `Nevada`
+	TableStateNewHampshire  TableState = `New Hampshire`
+	TableStateNewJersey     TableState = `New Jersey`
+	TableStateNewMexico     TableState = `New Mexico`
+	TableStateNewYork       TableState = `New York  `
+	TableStateNorthCarolina TableState = `North Carolina`
+	TableStateNorthDakota   TableState = `North Dakota`
+	TableStateOhio          TableState = `Ohio`
+	TableStateOklahoma      TableState = `Oklahoma`
+	TableStateOregon        TableState = `Oregon`
+	TableStatePennsylvania  TableState = `Pennsylvania`
+	TableStateRhodeIsland   TableState = `Rhode Island`
+	TableStateSouthCarolina TableState = `South Carolina`
+	TableStateSouthDakota   TableState = `South Dakota`
+	TableStateTennessee     TableState = `Tennessee`
+	TableStateTexas         TableState = `Texas`
+	TableStateUtah          TableState = `Utah`
+	TableStateVermont       TableState = `Vermont`
+	TableStateVirginia      TableState = `Virginia`
+	TableStateWashington    TableState = `Washington`
+	TableStateWestVirginia  TableState = `West Virginia`
+	TableStateWisconsin     TableState = `Wisconsin`
+	TableStateWyoming       TableState = `Wyoming`
+)
+
+// Description of the value, or empty if the value is not in table State.
+func (v TableState) Description() string {
+	return tableDescription(`State`, string(v))
+}
+
+// Valid reports if the value is in table State.
+func (v TableState) Valid() bool {
+	return TableValueLookup[`State`][string(v)]
+}
+
+// Table State.
+func (v TableState) Table() Table {
+	return TableLookup[`State`]
+}
+
+// TableStreet is a value of table Street, Street.
+// Fields in this table are strings; convert a field, such as TableStreet(v), to use the helpers.
+type TableStreet string
+
+const (
+	TableStreet11thSt                       TableStreet = `11th St`
+	TableStreet1stSt                        TableStreet = `1st St`
+	TableStreet2ndSt                        TableStreet = `2nd St`
+	TableStreet3rdSt                        TableStreet = `3rd St`
+	TableStreet41stSt                       TableStreet = `41st St`
+	TableStreet4thSt                        TableStreet = `4th St`
+	TableStreet6thSt                        TableStreet = `6th St`
+	TableStreet7thSt                        TableStreet = `7th St`
+	TableStreet8thSt                        TableStreet = `8th St`
+	TableStreet9thSt                        TableStreet = `9th St`
+	TableStreetAaronLn                      TableStreet = `Aaron Ln`
+	TableStreetAbbeyLn                      TableStreet = `Abbey Ln`
+	TableStreetAbercrombieSt                TableStreet = `Abercrombie St`
+	TableStreetAberdeenCt                   TableStreet = `Aberdeen Ct`
+	TableStreetAbernathyRd                  TableStreet = `Abernathy Rd`
+	TableStreetAbingtonCt                   TableStreet = `Abington Ct`
+	TableStreetAccessRd                     TableStreet = `Access Rd`
+	TableStreetAckermanRd                   TableStreet = `Ackerman Rd`
+	TableStreetAcornHillNW                  TableStreet = `Acorn Hill NW`
+	TableStreetAdairDr                      TableStreet = `Adair Dr`
+	TableStreetAdairHollowRd                TableStreet = `Adair Hollow Rd`
+	TableStreetAdairsvillePleasantValleyRd  TableStreet = `Adairsville Pleasant Valley Rd`
+	TableStreetAdamsChapelRd                TableStreet = `Adams Chapel Rd`
+	TableStreetAdamsWay                     TableStreet = `Adams Way`
+	TableStreetAdcockLoop                   TableStreet = `Adcock Loop`
+	TableStreetAdcockRd                     TableStreet = `Adcock Rd`
+	TableStreetAikenSt                      TableStreet = `Aiken St`
+	TableStreetAkinDr                       TableStreet = `Akin Dr`
+	TableStreetAkinRd                       TableStreet = `Akin Rd`
+	TableStreetAkinWay                      TableStreet = `Akin Way`
+	TableStreetAkronSt                      TableStreet = `Akron St`
+	TableStreetAlexDr                       TableStreet = `Alex Dr`
+	TableStreetAlexanderSt                  TableStreet = `Alexander St`
+	TableStreetAlfordRd                     TableStreet = `Alford Rd`
+	TableStreetAlishaCir                    TableStreet = `Alisha Cir`
+	TableStreetAllMetalsDr                  TableStreet = `All Metals Dr`
+	TableStreetAllatoonaDamRd               TableStreet = `Allatoona Dam Rd`
+	TableStreetAllatoonaDr                  TableStreet = `Allatoona Dr`
+	TableStreetAllatoonaEstatesDr           TableStreet = `Allatoona Estates Dr`
+	TableStreetAllatoonaLandingRd           TableStreet = `Allatoona Landing Rd`
+	TableStreetAllatoonaPassRd              TableStreet = `Allatoona Pass Rd`
+	TableStreetAllatoonaTraceDr             TableStreet = `Allatoona Trace Dr`
+	TableStreetAllatoonaWoodsTrl            TableStreet = `Allatoona Woods Trl`
+	TableStreetAllegianceCt                 TableStreet = `Allegiance Ct`
+	TableStreetAllenCir                     TableStreet = `Allen Cir`
+	TableStreetAllenRd                      TableStreet = `Allen Rd`
+	TableStreetAllisonCir                   TableStreet = `Allison Cir`
+	TableStreetAllweatherSt                 TableStreet = `Allweather St`
+	TableStreetAlpineDr                     TableStreet = `Alpine Dr`
+	TableStreetAmandaLn                     TableStreet = `Amanda Ln`
+	TableStreetAmandasCt                    TableStreet = `Amandas Ct`
+	TableStreetAmberidgeDr                  TableStreet = `Amberidge Dr`
+	TableStreetAmberlyLn                    TableStreet = `Amberly Ln`
+	TableStreetAmberwoodConn                TableStreet = `Amberwood Conn`
+	TableStreetAmberwoodCt                  TableStreet = `Amberwood Ct`
+	TableStreetAmberwoodLn                  TableStreet = `Amberwood Ln`
+	TableStreetAmberwoodPl                  TableStreet = `Amberwood Pl`
+	TableStreetAmberwoodTrl                 TableStreet = `Amberwood Trl`
+	TableStreetAmberwoodWay                 TableStreet = `Amberwood Way`
+	TableStreetAmpacetDr                    TableStreet = `Ampacet Dr`
+	TableStreetAnchorRd                     TableStreet = `Anchor Rd`
+	TableStreetAndersonSt                   TableStreet = `Anderson St`
+	TableStreetAndrewColeTrl                TableStreet = `Andrew Cole Trl`
+	TableStreetAngusTrl                     TableStreet = `Angus Trl`
+	TableStreetAnnCir                       TableStreet = `Ann Cir`
+	TableStreetAnnRd                        TableStreet = `Ann Rd`
+	TableStreetAnnaPl                       TableStreet = `Anna Pl`
+	TableStreetAnnsCt                       TableStreet = `Anns Ct`
+	TableStreetAnnsTrc                      TableStreet = `Anns Trc`
+	TableStreetAnsleyWay                    TableStreet = `Ansley Way`
+	TableStreetAnsubetDr                    TableStreet = `Ansubet Dr`
+	TableStreetAntebellumCt                 TableStreet = `Antebellum Ct`
+	TableStreetApacheDr                     TableStreet = `Apache Dr`
+	TableStreetApacheTrl                    TableStreet = `Apache Trl`
+	TableStreetApexDr                       TableStreet = `Apex Dr`
+	TableStreetAppaloosaCt                  TableStreet = `Appaloosa Ct`
+	TableStreetAppleBarrelWay               TableStreet = `Apple Barrel Way`
+	TableStreetAppleLn                      TableStreet = `Apple Ln`
+	TableStreetAppleTreeLn                  TableStreet = `Apple Tree Ln`
+	TableStreetAppleWoodLn                  TableStreet = `Apple Wood Ln`
+	TableStreetAppletreeCt                  TableStreet = `Appletree Ct`
+	TableStreetApplingWay                   TableStreet = `Appling Way`
+	TableStreetAprilLn                      TableStreet = `April Ln`
+	TableStreetAragonRd                     TableStreet = `Aragon Rd`
+	TableStreetArapahoDr                    TableStreet = `Arapaho Dr`
+	TableStreetArborLn                      TableStreet = `Arbor Ln`
+	TableStreetArborsWay                    TableStreet = `Arbors Way`
+	TableStreetArbutusTrl                   TableStreet = `Arbutus Trl`
+	TableStreetArdmoreCir                   TableStreet = `Ardmore Cir`
+	TableStreetArizonaAve                   TableStreet = `Arizona Ave`
+	TableStreetArlingtonWay                 TableStreet = `Arlington Way`
+	TableStreetArnoldRd                     TableStreet = `Arnold Rd`
+	TableStreetArnoldSpur                   TableStreet = `Arnold Spur`
+	TableStreetArringtonRd                  TableStreet = `Arrington Rd`
+	TableStreetArrowMountainDr              TableStreet = `Arrow Mountain Dr`
+	TableStreetArrowheadCt                  TableStreet = `Arrowhead Ct`
+	TableStreetArrowheadDr                  TableStreet = `Arrowhead Dr`
+	TableStreetArrowheadLn                  TableStreet = `Arrowhead Ln`
+	TableStreetAshCt                        TableStreet = `Ash Ct`
+	TableStreetAshWay                       TableStreet = `Ash Way`
+	TableStreetAshfordCt                    TableStreet = `Ashford Ct`
+	TableStreetAshfordPt                    TableStreet = `Ashford Pt`
+	TableStreetAshleyCt                     TableStreet = `Ashley Ct`
+	TableStreetAshleyRd                     TableStreet = `Ashley Rd`
+	TableStreetAshwoodDr                    TableStreet = `Ashwood Dr`
+	TableStreetAspenDr                      TableStreet = `Aspen Dr`
+	TableStreetAspenLn                      TableStreet = `Aspen Ln`
+	TableStreetAssemblyDr                   TableStreet = `Assembly Dr`
+	TableStreetAtcoAll                      TableStreet = `Atco All`
+	TableStreetAttawayDr                    TableStreet = `Attaway Dr`
+	TableStreetAtwoodRd                     TableStreet = `Atwood Rd`
+	TableStreetAubreyLakeRd                 TableStreet = `Aubrey Lake Rd`
+	TableStreetAubreyRd                     TableStreet = `Aubrey Rd`
+	TableStreetAubreySt                     TableStreet = `Aubrey St`
+	TableStreetAuburnDr                     TableStreet = `Auburn Dr`
+	TableStreetAustinRd                     TableStreet = `Austin Rd`
+	TableStreetAutryRd                      TableStreet = `Autry Rd`
+	TableStreetAutumnPl                     TableStreet = `Autumn Pl`
+	TableStreetAutumnRidgeDr                TableStreet = `Autumn Ridge Dr`
+	TableStreetAutumnTurn                   TableStreet = `Autumn Turn`
+	TableStreetAutumnWoodDr                 TableStreet = `Autumn Wood Dr`
+	TableStreetAvenueOfSavannah             TableStreet = `Avenue Of Savannah`
+	TableStreetAvonCt                       TableStreet = `Avon Ct`
+	TableStreetAzaleaDr                     TableStreet = `Azalea Dr`
+	TableStreetAztecWay                     TableStreet = `Aztec Way`
+	TableStreetBDTrl                        TableStreet = `B D Trl`
+	TableStreetBackusRd                     TableStreet = `Backus Rd`
+	TableStreetBagwellLn                    TableStreet = `Bagwell Ln`
+	TableStreetBaileyHillRd                 TableStreet = `Bailey Hill Rd`
+	TableStreetBaileyRd                     TableStreet = `Bailey Rd`
+	TableStreetBakerRd                      TableStreet = `Baker Rd`
+	TableStreetBakerSt                      TableStreet = `Baker St`
+	TableStreetBaldEagleLndg                TableStreet = `Bald Eagle Lndg`
+	TableStreetBaldwellCt                   TableStreet = `Baldwell Ct`
+	TableStreetBalfourDr                    TableStreet = `Balfour Dr`
+	TableStreetBalsamDr                     TableStreet = `Balsam Dr`
+	TableStreetBanditLn                     TableStreet = `Bandit Ln`
+	TableStreetBangorSt                     TableStreet = `Bangor St`
+	TableStreetBaptistCir                   TableStreet = `Baptist Cir`
+	TableStreetBarnsleyChurchRd             TableStreet = `Barnsley Church Rd`
+	TableStreetBarnsleyCt                   TableStreet = `Barnsley Ct`
+	TableStreetBarnsleyDr                   TableStreet = `Barnsley Dr`
+	TableStreetBarnsleyGardenRd             TableStreet = `Barnsley Garden Rd`
+	TableStreetBarnsleyVillageDr            TableStreet = `Barnsley Village Dr`
+	TableStreetBarnsleyVillageTrl           TableStreet = `Barnsley Village Trl`
+	TableStreetBarrettLn                    TableStreet = `Barrett Ln`
+	TableStreetBarrettRd                    TableStreet = `Barrett Rd`
+	TableStreetBarryDr                      TableStreet = `Barry Dr`
+	TableStreetBartowBeachRd                TableStreet = `Bartow Beach Rd`
+	TableStreetBartowCarverRd               TableStreet = `Bartow Carver Rd`
+	TableStreetBartowSt                     TableStreet = `Bartow St`
+	TableStreetBatesRd                      TableStreet = `Bates Rd`
+	TableStreetBayCt                        TableStreet = `Bay Ct`
+	TableStreetBeardenRd                    TableStreet = `Bearden Rd`
+	TableStreetBeasleyRd                    TableStreet = `Beasley Rd`
+	TableStreetBeaureguardSt                TableStreet = `Beaureguard St`
+	TableStreetBeaverTrl                    TableStreet = `Beaver Trl`
+	TableStreetBeaversDr                    TableStreet = `Beavers Dr`
+	TableStreetBedfordCt                    TableStreet = `Bedford Ct`
+	TableStreetBedfordRdg                   TableStreet = `Bedford Rdg`
+	TableStreetBeeTreeRd                    TableStreet = `Bee Tree Rd`
+	TableStreetBeechwoodDr                  TableStreet = `Beechwood Dr`
+	TableStreetBeguineDr                    TableStreet = `Beguine Dr`
+	TableStreetBellDr                       TableStreet = `Bell Dr`
+	TableStreetBellsFerryRd                 TableStreet = `Bells Ferry Rd`
+	TableStreetBelmontDr                    TableStreet = `Belmont Dr`
+	TableStreetBelmontWay                   TableStreet = `Belmont Way`
+	TableStreetBenfieldCir                  TableStreet = `Benfield Cir`
+	TableStreetBenhamCir                    TableStreet = `Benham Cir`
+	TableStreetBennettKimbralRd             TableStreet = `Bennett Kimbral Rd`
+	TableStreetBennettWay                   TableStreet = `Bennett Way`
+	TableStreetBentWaterDr                  TableStreet = `Bent Water Dr`
+	TableStreetBerkeleyPl                   TableStreet = `Berkeley Pl`
+	TableStreetBerkshireDr                  TableStreet = `Berkshire Dr`
+	TableStreetBestwayDr                    TableStreet = `Bestway Dr`
+	TableStreetBestwayPkwy                  TableStreet = `Bestway Pkwy`
+	TableStreetBetsyLockePt                 TableStreet = `Betsy Locke Pt`
+	TableStreetBeverlieDr                   TableStreet = `Beverlie Dr`
+	TableStreetBevilRidgeRd                 TableStreet = `Bevil Ridge Rd`
+	TableStreetBiddyRd                      TableStreet = `Biddy Rd`
+	TableStreetBigBranchCt                  TableStreet = `Big Branch Ct`
+	TableStreetBigDitchRd                   TableStreet = `Big Ditch Rd`
+	TableStreetBigOakTreeRd                 TableStreet = `Big Oak Tree Rd`
+	TableStreetBigPondRd                    TableStreet = `Big Pond Rd`
+	TableStreetBillBlackRd                  TableStreet = `Bill Black Rd`
+	TableStreetBillNelsonRd                 TableStreet = `Bill Nelson Rd`
+	TableStreetBilliesCv                    TableStreet = `Billies Cv`
+	TableStreetBinghamRd                    TableStreet = `Bingham Rd`
+	TableStreetBirchPl                      TableStreet = `Birch Pl`
+	TableStreetBirchWay                     TableStreet = `Birch Way`
+	TableStreetBishopCv                     TableStreet = `Bishop Cv`
+	TableStreetBishopDr                     TableStreet = `Bishop Dr`
+	TableStreetBishopLoop                   TableStreet = `Bishop Loop`
+	TableStreetBishopMillDr                 TableStreet = `Bishop Mill Dr`
+	TableStreetBishopRd                     TableStreet = `Bishop Rd`
+	TableStreetBlackJackMountainCir         TableStreet = `Black Jack Mountain Cir`
+	TableStreetBlackOakRd                   TableStreet = `Black Oak Rd`
+	TableStreetBlackRd                      TableStreet = `Black Rd`
+	TableStreetBlackWaterDr                 TableStreet = `Black Water Dr`
+	TableStreetBlackacreTrl                 TableStreet = `Blackacre Trl`
+	TableStreetBlackberryRdg                TableStreet = `Blackberry Rdg`
+	TableStreetBlackfootTrl                 TableStreet = `Blackfoot Trl`
+	TableStreetBlacksmithLn                 TableStreet = `Blacksmith Ln`
+	TableStreetBloomfieldCt                 TableStreet = `Bloomfield Ct`
+	TableStreetBlueberryRdg                 TableStreet = `Blueberry Rdg`
+	TableStreetBluebirdCir                  TableStreet = `Bluebird Cir`
+	TableStreetBluegrassCv                  TableStreet = `Bluegrass Cv`
+	TableStreetBluffCt                      TableStreet = `Bluff Ct`
+	TableStreetBoardwalkCt                  TableStreet = `Boardwalk Ct`
+	TableStreetBoatnerAve                   TableStreet = `Boatner Ave`
+	TableStreetBobOLinkDr                   TableStreet = `Bob O Link Dr`
+	TableStreetBobWhiteTrl                  TableStreet = `Bob White Trl`
+	TableStreetBobcatCt                     TableStreet = `Bobcat Ct`
+	TableStreetBoliverRd                    TableStreet = `Boliver Rd`
+	TableStreetBonairSt                     TableStreet = `Bonair St`
+	TableStreetBoonesFarm                   TableStreet = `Boones Farm`
+	TableStreetBoonesRidgeDr                TableStreet = `Boones Ridge Dr`
+	TableStreetBoonesRidgePkwy              TableStreet = `Boones Ridge Pkwy`
+	TableStreetBossRd                       TableStreet = `Boss Rd`
+	TableStreetBoulderCrestRd               TableStreet = `Boulder Crest Rd`
+	TableStreetBoulderRd                    TableStreet = `Boulder Rd`
+	TableStreetBowenRd                      TableStreet = `Bowen Rd`
+	TableStreetBowensCt                     TableStreet = `Bowens Ct`
+	TableStreetBoydMorrisDr                 TableStreet = `Boyd Morris Dr`
+	TableStreetBoydMountainRd               TableStreet = `Boyd Mountain Rd`
+	TableStreetBoysenberryCt                TableStreet = `Boysenberry Ct`
+	TableStreetBozemanRd                    TableStreet = `Bozeman Rd`
+	TableStreetBradfordCt                   TableStreet = `Bradford Ct`
+	TableStreetBradfordDr                   TableStreet = `Bradford Dr`
+	TableStreetBradleyLn                    TableStreet = `Bradley Ln`
+	TableStreetBradleyTrl                   TableStreet = `Bradley Trl`
+	TableStreetBramblewoodCt                TableStreet = `Bramblewood Ct`
+	TableStreetBramblewoodDr                TableStreet = `Bramblewood Dr`
+	TableStreetBramblewoodPl                TableStreet = `Bramblewood Pl`
+	TableStreetBramblewoodTrl               TableStreet = `Bramblewood Trl`
+	TableStreetBramblewoodWay               TableStreet = `Bramblewood Way`
+	TableStreetBrandonFarmRd                TableStreet = `Brandon Farm Rd`
+	TableStreetBrandonRdg                   TableStreet = `Brandon Rdg`
+	TableStreetBrandyLn                     TableStreet = `Brandy Ln`
+	TableStreetBransonCrossingRd            TableStreet = `Branson Crossing Rd`
+	TableStreetBransonMillDr                TableStreet = `Branson Mill Dr`
+	TableStreetBrantonRd                    TableStreet = `Branton Rd`
+	TableStreetBrentCir                     TableStreet = `Brent Cir`
+	TableStreetBrentwoodDr                  TableStreet = `Brentwood Dr`
+	TableStreetBrettWay                     TableStreet = `Brett Way`
+	TableStreetBriarChaseCt                 TableStreet = `Briar Chase Ct`
+	TableStreetBriarPatchLn                 TableStreet = `Briar Patch Ln`
+	TableStreetBriarcrestWay                TableStreet = `Briarcrest Way`
+	TableStreetBriarwoodLn                  TableStreet = `Briarwood Ln`
+	TableStreetBrickshire                   TableStreet = `Brickshire`
+	TableStreetBrickshireDr                 TableStreet = `Brickshire Dr`
+	TableStreetBridgestoneWay               TableStreet = `Bridgestone Way`
+	TableStreetBridlewoodCt                 TableStreet = `Bridlewood Ct`
+	TableStreetBrightonCt                   TableStreet = `Brighton Ct`
+	TableStreetBrightwellPl                 TableStreet = `Brightwell Pl`
+	TableStreetBristolCt                    TableStreet = `Bristol Ct`
+	TableStreetBrittaniLn                   TableStreet = `Brittani Ln`
+	TableStreetBroadlandsDr                 TableStreet = `Broadlands Dr`
+	TableStreetBrokenArrowCt                TableStreet = `Broken Arrow Ct`
+	TableStreetBroncoCt                     TableStreet = `Bronco Ct`
+	TableStreetBrookDr                      TableStreet = `Brook Dr`
+	TableStreetBrookKnollCt                 TableStreet = `Brook Knoll Ct`
+	TableStreetBrookeRd                     TableStreet = `Brooke Rd`
+	TableStreetBrookhollowLn                TableStreet = `Brookhollow Ln`
+	TableStreetBrooklandDr                  TableStreet = `Brookland Dr`
+	TableStreetBrookshireDr                 TableStreet = `Brookshire Dr`
+	TableStreetBrooksideCt                  TableStreet = `Brookside Ct`
+	TableStreetBrooksideWay                 TableStreet = `Brookside Way`
+	TableStreetBrookwoodDr                  TableStreet = `Brookwood Dr`
+	TableStreetBrownDr                      TableStreet = `Brown Dr`
+	TableStreetBrownFarmRd                  TableStreet = `Brown Farm Rd`
+	TableStreetBrownLoop                    TableStreet = `Brown Loop`
+	TableStreetBrownLoopSpur                TableStreet = `Brown Loop Spur`
+	TableStreetBrownRd                      TableStreet = `Brown Rd`
+	TableStreetBrownwoodDr                  TableStreet = `Brownwood Dr`
+	TableStreetBruceSt                      TableStreet = `Bruce St`
+	TableStreetBrutisRd                     TableStreet = `Brutis Rd`
+	TableStreetBryanDr                      TableStreet = `Bryan Dr`
+	TableStreetBryanLn                      TableStreet = `Bryan Ln`
+	TableStreetBuckhornTrl                  TableStreet = `Buckhorn Trl`
+	TableStreetBuckinghamCt                 TableStreet = `Buckingham Ct`
+	TableStreetBucklandCt                   TableStreet = `Buckland Ct`
+	TableStreetBuckskinDr                   TableStreet = `Buckskin Dr`
+	TableStreetBuckySt                      TableStreet = `Bucky St`
+	TableStreetBuenaVistaCir                TableStreet = `Buena Vista Cir`
+	TableStreetBufordSt                     TableStreet = `Buford St`
+	TableStreetBunchMountainRd              TableStreet = `Bunch Mountain Rd`
+	TableStreetBurchLn                      TableStreet = `Burch Ln`
+	TableStreetBurgesMillRd                 TableStreet = `Burges Mill Rd`
+	TableStreetBurntHickoryDr               TableStreet = `Burnt Hickory Dr`
+	TableStreetBurntHickoryRd               TableStreet = `Burnt Hickory Rd`
+	TableStreetBuschDr                      TableStreet = `Busch Dr`
+	TableStreetBusheyTrl                    TableStreet = `Bushey Trl`
+	TableStreetButtonwoodDr                 TableStreet = `Buttonwood Dr`
+	TableStreetButtramRd                    TableStreet = `Buttram Rd`
+	TableStreetByarsRd                      TableStreet = `Byars Rd`
+	TableStreetCJCt                         TableStreet = `C J Ct`
+	TableStreetCJDr                         TableStreet = `C J Dr`
+	TableStreetCabooseCt                    TableStreet = `Caboose Ct`
+	TableStreetCadeDr                       TableStreet = `Cade Dr`
+	TableStreetCagleRd                      TableStreet = `Cagle Rd`
+	TableStreetCainDr                       TableStreet = `Cain Dr`
+	TableStreetCalicoValleyRd               TableStreet = `Calico Valley Rd`
+	TableStreetCallowayLn                   TableStreet = `Calloway Ln`
+	TableStreetCalvaryCt                    TableStreet = `Calvary Ct`
+	TableStreetCambridgeCt                  TableStreet = `Cambridge Ct`
+	TableStreetCambridgeWay                 TableStreet = `Cambridge Way`
+	TableStreetCamdenWoodsDr                TableStreet = `Camden Woods Dr`
+	TableStreetCamelliaLn                   TableStreet = `Camellia Ln`
+	TableStreetCamelotDr                    TableStreet = `Camelot Dr`
+	TableStreetCampCreekRd                  TableStreet = `Camp Creek Rd`
+	TableStreetCampDr                       TableStreet = `Camp Dr`
+	TableStreetCampPl                       TableStreet = `Camp Pl`
+	TableStreetCampSunriseRd                TableStreet = `Camp Sunrise Rd`
+	TableStreetCampTrl                      TableStreet = `Camp Trl`
+	TableStreetCampbellDr                   TableStreet = `Campbell Dr`
+	TableStreetCampfireTrl                  TableStreet = `Campfire Trl`
+	TableStreetCandlestickComm              TableStreet = `Candlestick Comm`
+	TableStreetCanefieldDr                  TableStreet = `Canefield Dr`
+	TableStreetCannonCir                    TableStreet = `Cannon Cir`
+	TableStreetCannonCt                     TableStreet = `Cannon Ct`
+	TableStreetCannonadeRun                 TableStreet = `Cannonade Run`
+	TableStreetCanterLn                     TableStreet = `Canter Ln`
+	TableStreetCanterburyCt                 TableStreet = `Canterbury Ct`
+	TableStreetCanterburyWalk               TableStreet = `Canterbury Walk`
+	TableStreetCantrellLn                   TableStreet = `Cantrell Ln`
+	TableStreetCantrellPt                   TableStreet = `Cantrell Pt`
+	TableStreetCantrellSt                   TableStreet = `Cantrell St`
+	TableStreetCantyRd                      TableStreet = `Canty Rd`
+	TableStreetCanyonPkwy                   TableStreet = `Canyon Pkwy`
+	TableStreetCaptainsTurn                 TableStreet = `Captains Turn`
+	TableStreetCaptainsWalk                 TableStreet = `Captains Walk`
+	TableStreetCardinalCt                   TableStreet = `Cardinal Ct`
+	TableStreetCardinalRd                   TableStreet = `Cardinal Rd`
+	TableStreetCarlDuvallDr                 TableStreet = `Carl Duvall Dr`
+	TableStreetCarliePass                   TableStreet = `Carlie Pass`
+	TableStreetCarnesDr                     TableStreet = `Carnes Dr`
+	TableStreetCarnesRd                     TableStreet = `Carnes Rd`
+	TableStreetCarolynCt                    TableStreet = `Carolyn Ct`
+	TableStreetCarrRd                       TableStreet = `Carr Rd`
+	TableStreetCarriageLn                   TableStreet = `Carriage Ln`
+	TableStreetCarriageTrc                  TableStreet = `Carriage Trc`
+	TableStreetCarringtonDr                 TableStreet = `Carrington Dr`
+	TableStreetCarringtonTrc                TableStreet = `Carrington Trc`
+	TableStreetCarrolSloughRd               TableStreet = `Carrol Slough Rd`
+	TableStreetCarsonCt                     TableStreet = `Carson Ct`
+	TableStreetCarsonLoop                   TableStreet = `Carson Loop`
+	TableStreetCarsonRd                     TableStreet = `Carson Rd`
+	TableStreetCarterGroveBlvd              TableStreet = `Carter Grove Blvd`
+	TableStreetCarterLn                     TableStreet = `Carter Ln`
+	TableStreetCarterRd                     TableStreet = `Carter Rd`
+	TableStreetCarverLandingRd              TableStreet = `Carver Landing Rd`
+	TableStreetCaseyDr                      TableStreet = `Casey Dr`
+	TableStreetCaseyLn                      TableStreet = `Casey Ln`
+	TableStreetCaseySt                      TableStreet = `Casey St`
+	TableStreetCassDr                       TableStreet = `Cass Dr`
+	TableStreetCassStationDr                TableStreet = `Cass Station Dr`
+	TableStreetCassStationPass              TableStreet = `Cass Station Pass`
+	TableStreetCassandraView                TableStreet = `Cassandra View`
+	TableStreetCassvillePineLogRd           TableStreet = `Cassville Pine Log Rd`
+	TableStreetCassvilleRd                  TableStreet = `Cassville Rd`
+	TableStreetCassvilleWhiteRd             TableStreet = `Cassville White Rd`
+	TableStreetCatalpaCt                    TableStreet = `Catalpa Ct`
+	TableStreetCathedralHght                TableStreet = `Cathedral Hght`
+	TableStreetCatherineCir                 TableStreet = `Catherine Cir`
+	TableStreetCatherineWay                 TableStreet = `Catherine Way`
+	TableStreetCedarCreekChurchRd           TableStreet = `Cedar Creek Church Rd`
+	TableStreetCedarCreekRd                 TableStreet = `Cedar Creek Rd`
+	TableStreetCedarGateLn                  TableStreet = `Cedar Gate Ln`
+	TableStreetCedarLn                      TableStreet = `Cedar Ln`
+	TableStreetCedarRd                      TableStreet = `Cedar Rd`
+	TableStreetCedarRdg                     TableStreet = `Cedar Rdg`
+	TableStreetCedarSpringsDr               TableStreet = `Cedar Springs Dr`
+	TableStreetCedarSt                      TableStreet = `Cedar St`
+	TableStreetCedarWay                     TableStreet = `Cedar Way`
+	TableStreetCemetaryRd                   TableStreet = `Cemetary Rd`
+	TableStreetCenterBluff                  TableStreet = `Center Bluff`
+	TableStreetCenterRd                     TableStreet = `Center Rd`
+	TableStreetCenterSt                     TableStreet = `Center St`
+	TableStreetCenterportDr                 TableStreet = `Centerport Dr`
+	TableStreetCentralAve                   TableStreet = `Central Ave`
+	TableStreetChanceCir                    TableStreet = `Chance Cir`
+	TableStreetChandlerLn                   TableStreet = `Chandler Ln`
+	TableStreetChapelWay                    TableStreet = `Chapel Way`
+	TableStreetCharismaticRd                TableStreet = `Charismatic Rd`
+	TableStreetCharlesCt                    TableStreet = `Charles Ct`
+	TableStreetCharlesSt                    TableStreet = `Charles St`
+	TableStreetCharlotteDr                  TableStreet = `Charlotte Dr`
+	TableStreetChasePl                      TableStreet = `Chase Pl`
+	TableStreetChateauDr                    TableStreet = `Chateau Dr`
+	TableStreetCherokeeCir                  TableStreet = `Cherokee Cir`
+	TableStreetCherokeeDr                   TableStreet = `Cherokee Dr`
+	TableStreetCherokeeHght                 TableStreet = `Cherokee Hght`
+	TableStreetCherokeeHillsDr              TableStreet = `Cherokee Hills Dr`
+	TableStreetCherokeePl                   TableStreet = `Cherokee Pl`
+	TableStreetCherokeeSt                   TableStreet = `Cherokee St`
+	TableStreetCherrySt                     TableStreet = `Cherry St`
+	TableStreetCherrywoodLn                 TableStreet = `Cherrywood Ln`
+	TableStreetCherrywoodWay                TableStreet = `Cherrywood Way`
+	TableStreetCherylDr                     TableStreet = `Cheryl Dr`
+	TableStreetChestnutRidgeCt              TableStreet = `Chestnut Ridge Ct`
+	TableStreetChestnutRidgeDr              TableStreet = `Chestnut Ridge Dr`
+	TableStreetChestnutSt                   TableStreet = `Chestnut St`
+	TableStreetCheyenneWay                  TableStreet = `Cheyenne Way`
+	TableStreetChickasawTrl                 TableStreet = `Chickasaw Trl`
+	TableStreetChickasawWay                 TableStreet = `Chickasaw Way`
+	TableStreetChimneyLn                    TableStreet = `Chimney Ln`
+	TableStreetChimneySpringsDr             TableStreet = `Chimney Springs Dr`
+	TableStreetChippewaDr                   TableStreet = `Chippewa Dr`
+	TableStreetChippewaWay                  TableStreet = `Chippewa Way`
+	TableStreetChitwoodCemetaryRd           TableStreet = `Chitwood Cemetary Rd`
+	TableStreetChoctawCt                    TableStreet = `Choctaw Ct`
+	TableStreetChristonDr                   TableStreet = `Christon Dr`
+	TableStreetChristopherPl                TableStreet = `Christopher Pl`
+	TableStreetChristopherRdg               TableStreet = `Christopher Rdg`
+	TableStreetChulioDr                     TableStreet = `Chulio Dr`
+	TableStreetChunnDr                      TableStreet = `Chunn Dr`
+	TableStreetChunnFacinRd                 TableStreet = `Chunn Facin Rd`
+	TableStreetChurchSt                     TableStreet = `Church St`
+	TableStreetChurchillDown                TableStreet = `Churchill Down`
+	TableStreetChurchillDownsDown           TableStreet = `Churchill Downs Down`
+	TableStreetCindyLn                      TableStreet = `Cindy Ln`
+	TableStreetCitationPte                  TableStreet = `Citation Pte`
+	TableStreetClaireCv                     TableStreet = `Claire Cv`
+	TableStreetClarkCir                     TableStreet = `Clark Cir`
+	TableStreetClarkWay                     TableStreet = `Clark Way`
+	TableStreetClearCreekRd                 TableStreet = `Clear Creek Rd`
+	TableStreetClearLakeDr                  TableStreet = `Clear Lake Dr`
+	TableStreetClearPass                    TableStreet = `Clear Pass`
+	TableStreetClearviewDr                  TableStreet = `Clearview Dr`
+	TableStreetClearwaterSt                 TableStreet = `Clearwater St`
+	TableStreetCliffNelsonRd                TableStreet = `Cliff Nelson Rd`
+	TableStreetCliffhangerPte               TableStreet = `Cliffhanger Pte`
+	TableStreetClineDr                      TableStreet = `Cline Dr`
+	TableStreetClineSmithRd                 TableStreet = `Cline Smith Rd`
+	TableStreetClineSt                      TableStreet = `Cline St`
+	TableStreetCloverDr                     TableStreet = `Clover Dr`
+	TableStreetCloverLn                     TableStreet = `Clover Ln`
+	TableStreetCloverPass                   TableStreet = `Clover Pass`
+	TableStreetClubhouseCt                  TableStreet = `Clubhouse Ct`
+	TableStreetClubviewDr                   TableStreet = `Clubview Dr`
+	TableStreetClydesdaleTrl                TableStreet = `Clydesdale Trl`
+	TableStreetCobblestoneDr                TableStreet = `Cobblestone Dr`
+	TableStreetCochransWay                  TableStreet = `Cochrans Way`
+	TableStreetCoffeeSt                     TableStreet = `Coffee St`
+	TableStreetColeCt                       TableStreet = `Cole Ct`
+	TableStreetColeDr                       TableStreet = `Cole Dr`
+	TableStreetColemanSt                    TableStreet = `Coleman St`
+	TableStreetColleenAndKarenRd            TableStreet = `Colleen and Karen Rd`
+	TableStreetCollegeSt                    TableStreet = `College St`
+	TableStreetCollinsDr                    TableStreet = `Collins Dr`
+	TableStreetCollinsPl                    TableStreet = `Collins Pl`
+	TableStreetCollinsSt                    TableStreet = `Collins St`
+	TableStreetCollumRd                     TableStreet = `Collum Rd`
+	TableStreetColonialCir                  TableStreet = `Colonial Cir`
+	TableStreetColonialClubDr               TableStreet = `Colonial Club Dr`
+	TableStreetColonyCt                     TableStreet = `Colony Ct`
+	TableStreetColonyDr                     TableStreet = `Colony Dr`
+	TableStreetColtCt                       TableStreet = `Colt Ct`
+	TableStreetColtWay                      TableStreet = `Colt Way`
+	TableStreetColumbiaSt                   TableStreet = `Columbia St`
+	TableStreetComedyLn                     TableStreet = `Comedy Ln`
+	TableStreetCommancheDr                  TableStreet = `Commanche Dr`
+	TableStreetCommancheTrl                 TableStreet = `Commanche Trl`
+	TableStreetCommerceDr                   TableStreet = `Commerce Dr`
+	TableStreetCommerceRow                  TableStreet = `Commerce Row`
+	TableStreetCommerceWay                  TableStreet = `Commerce Way`
+	TableStreetConeRd                       TableStreet = `Cone Rd`
+	TableStreetConfederateSt                TableStreet = `Confederate St`
+	TableStreetConnesenaRd                  TableStreet = `Connesena Rd`
+	TableStreetConnieSt                     TableStreet = `Connie St`
+	TableStreetConyersIndustrialDr          TableStreet = `Conyers Industrial Dr`
+	TableStreetCookSt                       TableStreet = `Cook St`
+	TableStreetCooperMillRd                 TableStreet = `Cooper Mill Rd`
+	TableStreetCopelandRd                   TableStreet = `Copeland Rd`
+	TableStreetCoperitePl                   TableStreet = `Coperite Pl`
+	TableStreetCopperheadRd                 TableStreet = `Copperhead Rd`
+	TableStreetCorbittDr                    TableStreet = `Corbitt Dr`
+	TableStreetCorinthRd                    TableStreet = `Corinth Rd`
+	TableStreetCorsonTrl                    TableStreet = `Corson Trl`
+	TableStreetCottageDr                    TableStreet = `Cottage Dr`
+	TableStreetCottageTrc                   TableStreet = `Cottage Trc`
+	TableStreetCottageWalk                  TableStreet = `Cottage Walk`
+	TableStreetCottonBend                   TableStreet = `Cotton Bend`
+	TableStreetCottonDr                     TableStreet = `Cotton Dr`
+	TableStreetCottonSt                     TableStreet = `Cotton St`
+	TableStreetCottonwoodCt                 TableStreet = `Cottonwood Ct`
+	TableStreetCountryClubDr                TableStreet = `Country Club Dr`
+	TableStreetCountryCoveLn                TableStreet = `Country Cove Ln`
+	TableStreetCountryCreekRd               TableStreet = `Country Creek Rd`
+	TableStreetCountryDr                    TableStreet = `Country Dr`
+	TableStreetCountryLn                    TableStreet = `Country Ln`
+	TableStreetCountryMeadowWay             TableStreet = `Country Meadow Way`
+	TableStreetCountryWalk                  TableStreet = `Country Walk`
+	TableStreetCountyLineLoopNo1            TableStreet = `County Line Loop No 1`
+	TableStreetCountyLineRd                 TableStreet = `County Line Rd`
+	TableStreetCountyLineRoadNo2            TableStreet = `County Line Road No 2`
+	TableStreetCourrantSt                   TableStreet = `Courrant St`
+	TableStreetCourtXing                    TableStreet = `Court Xing`
+	TableStreetCourtyardDr                  TableStreet = `Courtyard Dr`
+	TableStreetCourtyardLn                  TableStreet = `Courtyard Ln`
+	TableStreetCoveredBridgeRd              TableStreet = `Covered Bridge Rd`
+	TableStreetCowanDr                      TableStreet = `Cowan Dr`
+	TableStreetCoxFarmRd                    TableStreet = `Cox Farm Rd`
+	TableStreetCoxFarmSpur                  TableStreet = `Cox Farm Spur`
+	TableStreetCoxRd                        TableStreet = `Cox Rd`
+	TableStreetCoxSt                        TableStreet = `Cox St`
+	TableStreetCozyLn                       TableStreet = `Cozy Ln`
+	TableStreetCraigsRd                     TableStreet = `Craigs Rd`
+	TableStreetCraneCir                     TableStreet = `Crane Cir`
+	TableStreetCreedDr                      TableStreet = `Creed Dr`
+	TableStreetCreekBendCt                  TableStreet = `Creek Bend Ct`
+	TableStreetCreekDr                      TableStreet = `Creek Dr`
+	TableStreetCreekTrl                     TableStreet = `Creek Trl`
+	TableStreetCreekbendDr                  TableStreet = `Creekbend Dr`
+	TableStreetCreeksideDr                  TableStreet = `Creekside Dr`
+	TableStreetCreeksideLn                  TableStreet = `Creekside Ln`
+	TableStreetCreekstoneCt                 TableStreet = `Creekstone Ct`
+	TableStreetCreekviewDr                  TableStreet = `Creekview Dr`
+	TableStreetCrescentDr                   TableStreet = `Crescent Dr`
+	TableStreetCrestbrookDr                 TableStreet = `Crestbrook Dr`
+	TableStreetCrestviewLn                  TableStreet = `Crestview Ln`
+	TableStreetCrestwoodCt                  TableStreet = `Crestwood Ct`
+	TableStreetCrestwoodRd                  TableStreet = `Crestwood Rd`
+	TableStreetCrimsonHillDr                TableStreet = `Crimson Hill Dr`
+	TableStreetCrissBlackRd                 TableStreet = `Criss Black Rd`
+	TableStreetCrolleyLn                    TableStreet = `Crolley Ln`
+	TableStreetCromwellCt                   TableStreet = `Cromwell Ct`
+	TableStreetCrossCreekDr                 TableStreet = `Cross Creek Dr`
+	TableStreetCrossSt                      TableStreet = `Cross St`
+	TableStreetCrossTieCt                   TableStreet = `Cross Tie Ct`
+	TableStreetCrossbridgeCT                TableStreet = `Crossbridge CT`
+	TableStreetCrossfieldCir                TableStreet = `Crossfield Cir`
+	TableStreetCroweDr                      TableStreet = `Crowe Dr`
+	TableStreetCroweSpringsRd               TableStreet = `Crowe Springs Rd`
+	TableStreetCroweSpringsSpur             TableStreet = `Crowe Springs Spur`
+	TableStreetCrumpRd                      TableStreet = `Crump Rd`
+	TableStreetCrystalLn                    TableStreet = `Crystal Ln`
+	TableStreetCrystalMountainRd            TableStreet = `Crystal Mountain Rd`
+	TableStreetCulverRd                     TableStreet = `Culver Rd`
+	TableStreetCumberlandGap                TableStreet = `Cumberland Gap`
+	TableStreetCumberlandRd                 TableStreet = `Cumberland Rd`
+	TableStreetCummingsRd                   TableStreet = `Cummings Rd`
+	TableStreetCurtisCt                     TableStreet = `Curtis Ct`
+	TableStreetCutOffRd                     TableStreet = `Cut Off Rd`
+	TableStreetCypressWay                   TableStreet = `Cypress Way`
+	TableStreetDabbsDr                      TableStreet = `Dabbs Dr`
+	TableStreetDanDr                        TableStreet = `Dan Dr`
+	TableStreetDanaWay                      TableStreet = `Dana Way`
+	TableStreetDanbyCt                      TableStreet = `Danby Ct`
+	TableStreetDarnellRd                    TableStreet = `Darnell Rd`
+	TableStreetDavidRd                      TableStreet = `David Rd`
+	TableStreetDavidSt                      TableStreet = `David St`
+	TableStreetDavidsWay                    TableStreet = `Davids Way`
+	TableStreetDavisDr                      TableStreet = `Davis Dr`
+	TableStreetDavisLoop                    TableStreet = `Davis Loop`
+	TableStreetDavisSt                      TableStreet = `Davis St`
+	TableStreetDavistownRd                  TableStreet = `Davistown Rd`
+	TableStreetDawnDr                       TableStreet = `Dawn Dr`
+	TableStreetDawnsWay                     TableStreet = `Dawns Way`
+	TableStreetDawsonSt                     TableStreet = `Dawson St`
+	TableStreetDeanDr                       TableStreet = `Dean Dr`
+	TableStreetDeanRd                       TableStreet = `Dean Rd`
+	TableStreetDeanSt                       TableStreet = `Dean St`
+	TableStreetDebraCir                     TableStreet = `Debra Cir`
+	TableStreetDeensRd                      TableStreet = `Deens Rd`
+	TableStreetDeerLodgeRd                  TableStreet = `Deer Lodge Rd`
+	TableStreetDeerRunDr                    TableStreet = `Deer Run Dr`
+	TableStreetDeerRunLn                    TableStreet = `Deer Run Ln`
+	TableStreetDeeringWay                   TableStreet = `Deering Way`
+	TableStreetDeerviewCt                   TableStreet = `Deerview Ct`
+	TableStreetDefenderSt                   TableStreet = `Defender St`
+	TableStreetDempseyLoop                  TableStreet = `Dempsey Loop`
+	TableStreetDempseyRd                    TableStreet = `Dempsey Rd`
+	TableStreetDentDr                       TableStreet = `Dent Dr`
+	TableStreetDenverDr                     TableStreet = `Denver Dr`
+	TableStreetDerbyWay                     TableStreet = `Derby Way`
+	TableStreetDerrickLn                    TableStreet = `Derrick Ln`
+	TableStreetDevinLn                      TableStreet = `Devin Ln`
+	TableStreetDevonCt                      TableStreet = `Devon Ct`
+	TableStreetDewberryLn                   TableStreet = `Dewberry Ln`
+	TableStreetDeweyDr                      TableStreet = `Dewey Dr`
+	TableStreetDianneDr                     TableStreet = `Dianne Dr`
+	TableStreetDixieDr                      TableStreet = `Dixie Dr`
+	TableStreetDobsonDr                     TableStreet = `Dobson Dr`
+	TableStreetDoddRd                       TableStreet = `Dodd Rd`
+	TableStreetDodsonRd                     TableStreet = `Dodson Rd`
+	TableStreetDoeCt                        TableStreet = `Doe Ct`
+	TableStreetDogLn                        TableStreet = `Dog Ln`
+	TableStreetDogwoodCir                   TableStreet = `Dogwood Cir`
+	TableStreetDogwoodDr                    TableStreet = `Dogwood Dr`
+	TableStreetDogwoodLn                    TableStreet = `Dogwood Ln`
+	TableStreetDogwoodPl                    TableStreet = `Dogwood Pl`
+	TableStreetDogwoodTrl                   TableStreet = `Dogwood Trl`
+	TableStreetDolphinDr                    TableStreet = `Dolphin Dr`
+	TableStreetDonnDr                       TableStreet = `Donn Dr`
+	TableStreetDooleySpringsRd              TableStreet = `Dooley Springs Rd`
+	TableStreetDorisRd                      TableStreet = `Doris Rd`
+	TableStreetDossDr                       TableStreet = `Doss Dr`
+	TableStreetDouglasSt                    TableStreet = `Douglas St`
+	TableStreetDouthitBridgeRd              TableStreet = `Douthit Bridge Rd`
+	TableStreetDouthitFerryRd               TableStreet = `Douthit Ferry Rd`
+	TableStreetDoveCt                       TableStreet = `Dove Ct`
+	TableStreetDoveDr                       TableStreet = `Dove Dr`
+	TableStreetDoveTrl                      TableStreet = `Dove Trl`
+	TableStreetDoverRd                      TableStreet = `Dover Rd`
+	TableStreetDowningWay                   TableStreet = `Downing Way`
+	TableStreetDrippingRockTrl              TableStreet = `Dripping Rock Trl`
+	TableStreetDruryLn                      TableStreet = `Drury Ln`
+	TableStreetDryCreekRd                   TableStreet = `Dry Creek Rd`
+	TableStreetDuncanDr                     TableStreet = `Duncan Dr`
+	TableStreetDunhillCt                    TableStreet = `Dunhill Ct`
+	TableStreetDureyCt                      TableStreet = `Durey Ct`
+	TableStreetDyarSt                       TableStreet = `Dyar St`
+	TableStreetDysartRd                     TableStreet = `Dysart Rd`
+	TableStreetECarterSt                    TableStreet = `E Carter St`
+	TableStreetECherokeeAve                 TableStreet = `E Cherokee Ave`
+	TableStreetEChurchSt                    TableStreet = `E Church St`
+	TableStreetEFeltonRd                    TableStreet = `E Felton Rd`
+	TableStreetEGeorgeSt                    TableStreet = `E George St`
+	TableStreetEGreenridgeRd                TableStreet = `E Greenridge Rd`
+	TableStreetEHolidayCt                   TableStreet = `E Holiday Ct`
+	TableStreetEHowardSt                    TableStreet = `E Howard St`
+	TableStreetEIndianaAve                  TableStreet = `E Indiana Ave`
+	TableStreetELeeSt                       TableStreet = `E Lee St`
+	TableStreetEMainSt                      TableStreet = `E Main St`
+	TableStreetEMitchellRd                  TableStreet = `E Mitchell Rd`
+	TableStreetEPleasantValleyRd            TableStreet = `E Pleasant Valley Rd`
+	TableStreetEPorterSt                    TableStreet = `E Porter St`
+	TableStreetERailroadSt                  TableStreet = `E Railroad St`
+	TableStreetERockySt                     TableStreet = `E Rocky St`
+	TableStreetEagleDr                      TableStreet = `Eagle Dr`
+	TableStreetEagleGlenDr                  TableStreet = `Eagle Glen Dr`
+	TableStreetEagleLn                      TableStreet = `Eagle Ln`
+	TableStreetEagleMountainTrl             TableStreet = `Eagle Mountain Trl`
+	TableStreetEaglePkwy                    TableStreet = `Eagle Pkwy`
+	TableStreetEaglesCt                     TableStreet = `Eagles Ct`
+	TableStreetEaglesNestCv                 TableStreet = `Eagles Nest Cv`
+	TableStreetEaglesViewDr                 TableStreet = `Eagles View Dr`
+	TableStreetEarleDr                      TableStreet = `Earle Dr`
+	TableStreetEastBoxwoodDr                TableStreet = `East Boxwood Dr`
+	TableStreetEastHeritageDr               TableStreet = `East Heritage Dr`
+	TableStreetEastValleyRd                 TableStreet = `East Valley Rd`
+	TableStreetEastonTrc                    TableStreet = `Easton Trc`
+	TableStreetEastviewCt                   TableStreet = `Eastview Ct`
+	TableStreetEastviewTer                  TableStreet = `Eastview Ter`
+	TableStreetEastwoodDr                   TableStreet = `Eastwood Dr`
+	TableStreetEchotaRd                     TableStreet = `Echota Rd`
+	TableStreetEdgewaterDr                  TableStreet = `Edgewater Dr`
+	TableStreetEdgewoodRd                   TableStreet = `Edgewood Rd`
+	TableStreetEdselDr                      TableStreet = `Edsel Dr`
+	TableStreetEidsonSt                     TableStreet = `Eidson St`
+	TableStreetElizabethRd                  TableStreet = `Elizabeth Rd`
+	TableStreetElizabethSt                  TableStreet = `Elizabeth St`
+	TableStreetElkhornCt                    TableStreet = `Elkhorn Ct`
+	TableStreetElliottSt                    TableStreet = `Elliott St`
+	TableStreetEllisRd                      TableStreet = `Ellis Rd`
+	TableStreetElmDr                        TableStreet = `Elm Dr`
+	TableStreetElmSt                        TableStreet = `Elm St`
+	TableStreetElmwoodPl                    TableStreet = `Elmwood Pl`
+	TableStreetElrodRdg                     TableStreet = `Elrod Rdg`
+	TableStreetElrodSt                      TableStreet = `Elrod St`
+	TableStreetEmberWay                     TableStreet = `Ember Way`
+	TableStreetEmeraldPass                  TableStreet = `Emerald Pass`
+	TableStreetEmeraldRun                   TableStreet = `Emerald Run`
+	TableStreetEmeraldSpringsCt             TableStreet = `Emerald Springs Ct`
+	TableStreetEmeraldSpringsDr             TableStreet = `Emerald Springs Dr`
+	TableStreetEmeraldSpringsWay            TableStreet = `Emerald Springs Way`
+	TableStreetEmeraldTrl                   TableStreet = `Emerald Trl`
+	TableStreetEngineerLn                   TableStreet = `Engineer Ln`
+	TableStreetEnglishTurn                  TableStreet = `English Turn`
+	TableStreetEnonRidgeRd                  TableStreet = `Enon Ridge Rd`
+	TableStreetEnterpriseDr                 TableStreet = `Enterprise Dr`
+	TableStreetEquestrianWay                TableStreet = `Equestrian Way`
+	TableStreetEstateDr                     TableStreet = `Estate Dr`
+	TableStreetEstatesRdg                   TableStreet = `Estates Rdg`
+	TableStreetEtowahCt                     TableStreet = `Etowah Ct`
+	TableStreetEtowahDr                     TableStreet = `Etowah Dr`
+	TableStreetEtowahLn                     TableStreet = `Etowah Ln`
+	TableStreetEtowahRidgeDr                TableStreet = `Etowah Ridge Dr`
+	TableStreetEtowahRidgeOvlk              TableStreet = `Etowah Ridge Ovlk`
+	TableStreetEtowahRidgeTrl               TableStreet = `Etowah Ridge Trl`
+	TableStreetEuharleeFiveForksRd          TableStreet = `Euharlee Five Forks Rd`
+	TableStreetEuharleeRd                   TableStreet = `Euharlee Rd`
+	TableStreetEuharleeSt                   TableStreet = `Euharlee St`
+	TableStreetEvansHightowerRd             TableStreet = `Evans Hightower Rd`
+	TableStreetEvansRd                      TableStreet = `Evans Rd`
+	TableStreetEveningTrl                   TableStreet = `Evening Trl`
+	TableStreetEverestDr                    TableStreet = `Everest Dr`
+	TableStreetEverettCir                   TableStreet = `Everett Cir`
+	TableStreetEvergreenTrl                 TableStreet = `Evergreen Trl`
+	TableStreetEzellRd                      TableStreet = `Ezell Rd`
+	TableStreetFairfieldCt                  TableStreet = `Fairfield Ct`
+	TableStreetFairfieldDr                  TableStreet = `Fairfield Dr`
+	TableStreetFairfieldLn                  TableStreet = `Fairfield Ln`
+	TableStreetFairfieldWay                 TableStreet = `Fairfield Way`
+	TableStreetFairmountRd                  TableStreet = `Fairmount Rd`
+	TableStreetFairviewChurchRd             TableStreet = `Fairview Church Rd`
+	TableStreetFairviewCir                  TableStreet = `Fairview Cir`
+	TableStreetFairviewDr                   TableStreet = `Fairview Dr`
+	TableStreetFairviewSt                   TableStreet = `Fairview St`
+	TableStreetFaithLn                      TableStreet = `Faith Ln`
+	TableStreetFalconCir                    TableStreet = `Falcon Cir`
+	TableStreetFallDr                       TableStreet = `Fall Dr`
+	TableStreetFallingSpringsRd             TableStreet = `Falling Springs Rd`
+	TableStreetFarmRd                       TableStreet = `Farm Rd`
+	TableStreetFarmbrookDr                  TableStreet = `Farmbrook Dr`
+	TableStreetFarmerRd                     TableStreet = `Farmer Rd`
+	TableStreetFarmsteadCt                  TableStreet = `Farmstead Ct`
+	TableStreetFarrCir                      TableStreet = `Farr Cir`
+	TableStreetFawnLakeTrl                  TableStreet = `Fawn Lake Trl`
+	TableStreetFawnRidgeDr                  TableStreet = `Fawn Ridge Dr`
+	TableStreetFawnView                     TableStreet = `Fawn View`
+	TableStreetFeltonPl                     TableStreet = `Felton Pl`
+	TableStreetFeltonSt                     TableStreet = `Felton St`
+	TableStreetFergusonDr                   TableStreet = `Ferguson Dr`
+	TableStreetFerryViews                   TableStreet = `Ferry Views`
+	TableStreetFieldsRd                     TableStreet = `Fields Rd`
+	TableStreetFieldstoneCt                 TableStreet = `Fieldstone Ct`
+	TableStreetFieldstonePath               TableStreet = `Fieldstone Path`
+	TableStreetFieldwoodDr                  TableStreet = `Fieldwood Dr`
+	TableStreetFinchCt                      TableStreet = `Finch Ct`
+	TableStreetFindleyRd                    TableStreet = `Findley Rd`
+	TableStreetFinleySt                     TableStreet = `Finley St`
+	TableStreetFireTowerRd                  TableStreet = `Fire Tower Rd`
+	TableStreetFiresideCt                   TableStreet = `Fireside Ct`
+	TableStreetFiteSt                       TableStreet = `Fite St`
+	TableStreetFiveForksRd                  TableStreet = `Five Forks Rd`
+	TableStreetFlagstoneCt                  TableStreet = `Flagstone Ct`
+	TableStreetFlintHillRd                  TableStreet = `Flint Hill Rd`
+	TableStreetFloralDr                     TableStreet = `Floral Dr`
+	TableStreetFlorenceDr                   TableStreet = `Florence Dr`
+	TableStreetFloridaAve                   TableStreet = `Florida Ave`
+	TableStreetFloydCreekChurchRd           TableStreet = `Floyd Creek Church Rd`
+	TableStreetFloydRd                      TableStreet = `Floyd Rd`
+	TableStreetFolsomGladeRd                TableStreet = `Folsom Glade Rd`
+	TableStreetFolsomRd                     TableStreet = `Folsom Rd`
+	TableStreetFoolsGoldRd                  TableStreet = `Fools Gold Rd`
+	TableStreetFoothillsPkwy                TableStreet = `Foothills Pkwy`
+	TableStreetFordSt                       TableStreet = `Ford St`
+	TableStreetForestHillDr                 TableStreet = `Forest Hill Dr`
+	TableStreetForestTrl                    TableStreet = `Forest Trl`
+	TableStreetForrestAve                   TableStreet = `Forrest Ave`
+	TableStreetForrestHillDr                TableStreet = `Forrest Hill Dr`
+	TableStreetForrestLn                    TableStreet = `Forrest Ln`
+	TableStreetFossettsCv                   TableStreet = `Fossetts Cv`
+	TableStreetFoucheCt                     TableStreet = `Fouche Ct`
+	TableStreetFoucheDr                     TableStreet = `Fouche Dr`
+	TableStreetFourFeathersLn               TableStreet = `Four Feathers Ln`
+	TableStreetFowlerDr                     TableStreet = `Fowler Dr`
+	TableStreetFowlerRd                     TableStreet = `Fowler Rd`
+	TableStreetFoxChas                      TableStreet = `Fox Chas`
+	TableStreetFoxHillDr                    TableStreet = `Fox Hill Dr`
+	TableStreetFoxMeadowCt                  TableStreet = `Fox Meadow Ct`
+	TableStreetFoxfireLn                    TableStreet = `Foxfire Ln`
+	TableStreetFoxhoundWay                  TableStreet = `Foxhound Way`
+	TableStreetFoxrunCt                     TableStreet = `Foxrun Ct`
+	TableStreetFrancesWay                   TableStreet = `Frances Way`
+	TableStreetFranklinDr                   TableStreet = `Franklin Dr`
+	TableStreetFranklinJohnsonDr            TableStreet = `Franklin Johnson Dr`
+	TableStreetFranklinLoop                 TableStreet = `Franklin Loop`
+	TableStreetFreddaLn                     TableStreet = `Fredda Ln`
+	TableStreetFreedomDr                    TableStreet = `Freedom Dr`
+	TableStreetFreemanSt                    TableStreet = `Freeman St`
+	TableStreetFrictionDr                   TableStreet = `Friction Dr`
+	TableStreetFriendlyLn                   TableStreet = `Friendly Ln`
+	TableStreetFriendshipRd                 TableStreet = `Friendship Rd`
+	TableStreetFugerLn                      TableStreet = `Fuger Ln`
+	TableStreetFunDr                        TableStreet = `Fun Dr`
+	TableStreetFunkhouserIndustrialAccessRd TableStreet = `Funkhouser Industrial Access Rd`
+	TableStreetGaddisRd                     TableStreet = `Gaddis Rd`
+	TableStreetGailSt                       TableStreet = `Gail St`
+	TableStreetGainesRd                     TableStreet = `Gaines Rd`
+	TableStreetGaitherBoggsRd               TableStreet = `Gaither Boggs Rd`
+	TableStreetGaltSt                       TableStreet = `Galt St`
+	TableStreetGalwayDr                     TableStreet = `Galway Dr`
+	TableStreetGardenGate                   TableStreet = `Garden Gate`
+	TableStreetGarlandNo1Rd                 TableStreet = `Garland No 1 Rd`
+	TableStreetGarlandNo2Rd                 TableStreet = `Garland No 2 Rd`
+	TableStreetGarrisonDr                   TableStreet = `Garrison Dr`
+	TableStreetGastonWestbrookAve           TableStreet = `Gaston Westbrook Ave`
+	TableStreetGatepostLn                   TableStreet = `Gatepost Ln`
+	TableStreetGatlinRd                     TableStreet = `Gatlin Rd`
+	TableStreetGayleDr                      TableStreet = `Gayle Dr`
+	TableStreetGenevaLn                     TableStreet = `Geneva Ln`
+	TableStreetGentillyBlvd                 TableStreet = `Gentilly Blvd`
+	TableStreetGentryDr                     TableStreet = `Gentry Dr`
+	TableStreetGeorgeSt                     TableStreet = `George St`
+	TableStreetGeorgiaAve                   TableStreet = `Georgia Ave`
+	TableStreetGeorgiaBlvd                  TableStreet = `Georgia Blvd`
+	TableStreetGeorgiaNorthCir              TableStreet = `Georgia North Cir`
+	TableStreetGeorgiaNorthIndustrialRd     TableStreet = `Georgia North Industrial Rd`
+	TableStreetGertrudeLn                   TableStreet = `Gertrude Ln`
+	TableStreetGibbonsRd                    TableStreet = `Gibbons Rd`
+	TableStreetGillRd                       TableStreet = `Gill Rd`
+	TableStreetGilliamsXing                 TableStreet = `Gilliams Xing`
+	TableStreetGilmerSt                     TableStreet = `Gilmer St`
+	TableStreetGilreathRd                   TableStreet = `Gilreath Rd`
+	TableStreetGilreathTrl                  TableStreet = `Gilreath Trl`
+	TableStreetGladeRd                      TableStreet = `Glade Rd`
+	TableStreetGlenCoveDr                   TableStreet = `Glen Cove Dr`
+	TableStreetGlenabbyDr                   TableStreet = `Glenabby Dr`
+	TableStreetGlenmarAve                   TableStreet = `Glenmar Ave`
+	TableStreetGlenmauraWay                 TableStreet = `Glenmaura Way`
+	TableStreetGlenmoreDr                   TableStreet = `Glenmore Dr`
+	TableStreetGlennDr                      TableStreet = `Glenn Dr`
+	TableStreetGloryLn                      TableStreet = `Glory Ln`
+	TableStreetGoldCreekTrl                 TableStreet = `Gold Creek Trl`
+	TableStreetGoldHillDr                   TableStreet = `Gold Hill Dr`
+	TableStreetGoldenEagleDr                TableStreet = `Golden Eagle Dr`
+	TableStreetGoodeDr                      TableStreet = `Goode Dr`
+	TableStreetGoodsonCemetaryRd            TableStreet = `Goodson Cemetary Rd`
+	TableStreetGoodwinSt                    TableStreet = `Goodwin St`
+	TableStreetGoodyearAve                  TableStreet = `Goodyear Ave`
+	TableStreetGordonMcCormickRd            TableStreet = `Gordon McCormick Rd`
+	TableStreetGordonRd                     TableStreet = `Gordon Rd`
+	TableStreetGoreRd                       TableStreet = `Gore Rd`
+	TableStreetGoreSpringsRd                TableStreet = `Gore Springs Rd`
+	TableStreetGossSt                       TableStreet = `Goss St`
+	TableStreetGovernorsCt                  TableStreet = `Governors Ct`
+	TableStreetGraceParkDr                  TableStreet = `Grace Park Dr`
+	TableStreetGraceSt                      TableStreet = `Grace St`
+	TableStreetGrandMainSt                  TableStreet = `Grand Main St`
+	TableStreetGrandviewCt                  TableStreet = `Grandview Ct`
+	TableStreetGrandviewDr                  TableStreet = `Grandview Dr`
+	TableStreetGrangerDr                    TableStreet = `Granger Dr`
+	TableStreetGrantDr                      TableStreet = `Grant Dr`
+	TableStreetGrapevineWay                 TableStreet = `Grapevine Way`
+	TableStreetGrassdaleRd                  TableStreet = `Grassdale Rd`
+	TableStreetGrayRd                       TableStreet = `Gray Rd`
+	TableStreetGraySt                       TableStreet = `Gray St`
+	TableStreetGraysburgDr                  TableStreet = `Graysburg Dr`
+	TableStreetGraystoneDr                  TableStreet = `Graystone Dr`
+	TableStreetGreatwoodDr                  TableStreet = `Greatwood Dr`
+	TableStreetGreenAcreLn                  TableStreet = `Green Acre Ln`
+	TableStreetGreenAppleCt                 TableStreet = `Green Apple Ct`
+	TableStreetGreenGablePt                 TableStreet = `Green Gable Pt`
+	TableStreetGreenIvesLn                  TableStreet = `Green Ives Ln`
+	TableStreetGreenLoopRd                  TableStreet = `Green Loop Rd`
+	TableStreetGreenRd                      TableStreet = `Green Rd`
+	TableStreetGreenSt                      TableStreet = `Green St`
+	TableStreetGreenValleyTrl               TableStreet = `Green Valley Trl`
+	TableStreetGreenbriarAve                TableStreet = `Greenbriar Ave`
+	TableStreetGreencliffWay                TableStreet = `Greencliff Way`
+	TableStreetGreenhouseDr                 TableStreet = `Greenhouse Dr`
+	TableStreetGreenmontCt                  TableStreet = `Greenmont Ct`
+	TableStreetGreenridgeRd                 TableStreet = `Greenridge Rd`
+	TableStreetGreenridgeTrl                TableStreet = `Greenridge Trl`
+	TableStreetGreenwayLn                   TableStreet = `Greenway Ln`
+	TableStreetGreenwoodDr                  TableStreet = `Greenwood Dr`
+	TableStreetGreyrock                     TableStreet = `Greyrock`
+	TableStreetGreystoneLn                  TableStreet = `Greystone Ln`
+	TableStreetGreystoneWay                 TableStreet = `Greystone Way`
+	TableStreetGreywoodLn                   TableStreet = `Greywood Ln`
+	TableStreetGriffinMillDr                TableStreet = `Griffin Mill Dr`
+	TableStreetGriffinRd                    TableStreet = `Griffin Rd`
+	TableStreetGrimshawRd                   TableStreet = `Grimshaw Rd`
+	TableStreetGristMillLn                  TableStreet = `Grist Mill Ln`
+	TableStreetGristmillParish              TableStreet = `Gristmill Parish`
+	TableStreetGroganRd                     TableStreet = `Grogan Rd`
+	TableStreetGrooversLandingRd            TableStreet = `Groovers Landing Rd`
+	TableStreetGroveCir                     TableStreet = `Grove Cir`
+	TableStreetGroveParkCir                 TableStreet = `Grove Park Cir`
+	TableStreetGrovePointeWay               TableStreet = `Grove Pointe Way`
+	TableStreetGroveSpringsCt               TableStreet = `Grove Springs Ct`
+	TableStreetGroveWay                     TableStreet = `Grove Way`
+	TableStreetGroverRd                     TableStreet = `Grover Rd`
+	TableStreetGuestHoll                    TableStreet = `Guest Holl`
+	TableStreetGumDr                        TableStreet = `Gum Dr`
+	TableStreetGumSpringsLn                 TableStreet = `Gum Springs Ln`
+	TableStreetGunstonHall                  TableStreet = `Gunston Hall`
+	TableStreetGuytonIndustrialDr           TableStreet = `Guyton Industrial Dr`
+	TableStreetGuytonSt                     TableStreet = `Guyton St`
+	TableStreetHabershamCir                 TableStreet = `Habersham Cir`
+	TableStreetHaleyPl                      TableStreet = `Haley Pl`
+	TableStreetHallSt                       TableStreet = `Hall St`
+	TableStreetHallStationRd                TableStreet = `Hall Station Rd`
+	TableStreetHamesPte                     TableStreet = `Hames Pte`
+	TableStreetHamilCt                      TableStreet = `Hamil Ct`
+	TableStreetHamiltonBlvd                 TableStreet = `Hamilton Blvd`
+	TableStreetHamiltonCrossingRd           TableStreet = `Hamilton Crossing Rd`
+	TableStreetHamptonDr                    TableStreet = `Hampton Dr`
+	TableStreetHamptonLn                    TableStreet = `Hampton Ln`
+	TableStreetHaneyHollowWay               TableStreet = `Haney Hollow Way`
+	TableStreetHannonWay                    TableStreet = `Hannon Way`
+	TableStreetHappyHollowRd                TableStreet = `Happy Hollow Rd`
+	TableStreetHappyValleyLn                TableStreet = `Happy Valley Ln`
+	TableStreetHarborDr                     TableStreet = `Harbor Dr`
+	TableStreetHarborLn                     TableStreet = `Harbor Ln`
+	TableStreetHardinBridgeRd               TableStreet = `Hardin Bridge Rd`
+	TableStreetHardinRd                     TableStreet = `Hardin Rd`
+	TableStreetHardwoodCt                   TableStreet = `Hardwood Ct`
+	TableStreetHardwoodRidgeDr              TableStreet = `Hardwood Ridge Dr`
+	TableStreetHardwoodRidgePkwy            TableStreet = `Hardwood Ridge Pkwy`
+	TableStreetHardyRd                      TableStreet = `Hardy Rd`
+	TableStreetHargisWay                    TableStreet = `Hargis Way`
+	TableStreetHarrisRd                     TableStreet = `Harris Rd`
+	TableStreetHarrisSt                     TableStreet = `Harris St`
+	TableStreetHarrisonRd                   TableStreet = `Harrison Rd`
+	TableStreetHarvestWay                   TableStreet = `Harvest Way`
+	TableStreetHarveyKnightRd               TableStreet = `Harvey Knight Rd`
+	TableStreetHastingsDr                   TableStreet = `Hastings Dr`
+	TableStreetHatfieldRd                   TableStreet = `Hatfield Rd`
+	TableStreetHattieSt                     TableStreet = `Hattie St`
+	TableStreetHawkRd                       TableStreet = `Hawk Rd`
+	TableStreetHawkinsRd                    TableStreet = `Hawkins Rd`
+	TableStreetHawksBranchLn                TableStreet = `Hawks Branch Ln`
+	TableStreetHawksFarmRd                  TableStreet = `Hawks Farm Rd`
+	TableStreetHawkstoneCt                  TableStreet = `Hawkstone Ct`
+	TableStreetHawthorneDr                  TableStreet = `Hawthorne Dr`
+	TableStreetHayesCemetaryLoop            TableStreet = `Hayes Cemetary Loop`
+	TableStreetHazelwoodRd                  TableStreet = `Hazelwood Rd`
+	TableStreetHeaddenRdg                   TableStreet = `Headden Rdg`
+	TableStreetHeartwoodDr                  TableStreet = `Heartwood Dr`
+	TableStreetHeatcoCt                     TableStreet = `Heatco Ct`
+	TableStreetHedgerowCt                   TableStreet = `Hedgerow Ct`
+	TableStreetHeidelbergLn                 TableStreet = `Heidelberg Ln`
+	TableStreetHelenLn                      TableStreet = `Helen Ln`
+	TableStreetHemlockPl                    TableStreet = `Hemlock Pl`
+	TableStreetHendersonDr                  TableStreet = `Henderson Dr`
+	TableStreetHendersonRd                  TableStreet = `Henderson Rd`
+	TableStreetHendricksRd                  TableStreet = `Hendricks Rd`
+	TableStreetHendricksSt                  TableStreet = `Hendricks St`
+	TableStreetHenryMackHillRd              TableStreet = `Henry Mack Hill Rd`
+	TableStreetHepworthLn                   TableStreet = `Hepworth Ln`
+	TableStreetHeritageAve                  TableStreet = `Heritage Ave`
+	TableStreetHeritageCv                   TableStreet = `Heritage Cv`
+	TableStreetHeritageDr                   TableStreet = `Heritage Dr`
+	TableStreetHeritageWay                  TableStreet = `Heritage Way`
+	TableStreetHerringSt                    TableStreet = `Herring St`
+	TableStreetHickoryForestLn              TableStreet = `Hickory Forest Ln`
+	TableStreetHickoryHillDr                TableStreet = `Hickory Hill Dr`
+	TableStreetHickoryHoll                  TableStreet = `Hickory Holl`
+	TableStreetHickoryLn                    TableStreet = `Hickory Ln`
+	TableStreetHiddenValleyLn               TableStreet = `Hidden Valley Ln`
+	TableStreetHighCt                       TableStreet = `High Ct`
+	TableStreetHighMoonSt                   TableStreet = `High Moon St`
+	TableStreetHighPointCt                  TableStreet = `High Point Ct`
+	TableStreetHighPointeDr                 TableStreet = `High Pointe Dr`
+	TableStreetHighlandCir                  TableStreet = `Highland Cir`
+	TableStreetHighlandCrestDr              TableStreet = `Highland Crest Dr`
+	TableStreetHighlandDr                   TableStreet = `Highland Dr`
+	TableStreetHighlandLn                   TableStreet = `Highland Ln`
+	TableStreetHighlandTrl                  TableStreet = `Highland Trl`
+	TableStreetHighlandWay                  TableStreet = `Highland Way`
+	TableStreetHighpineDr                   TableStreet = `Highpine Dr`
+	TableStreetHillSt                       TableStreet = `Hill St`
+	TableStreetHillsideDr                   TableStreet = `Hillside Dr`
+	TableStreetHillstoneWay                 TableStreet = `Hillstone Way`
+	TableStreetHilltopCt                    TableStreet = `Hilltop Ct`
+	TableStreetHilltopDr                    TableStreet = `Hilltop Dr`
+	TableStreetHistorySt                    TableStreet = `History St`
+	TableStreetHitchcockSta                 TableStreet = `Hitchcock Sta`
+	TableStreetHobgoodRd                    TableStreet = `Hobgood Rd`
+	TableStreetHodgesMineRd                 TableStreet = `Hodges Mine Rd`
+	TableStreetHolcombRd                    TableStreet = `Holcomb Rd`
+	TableStreetHolcombSpur                  TableStreet = `Holcomb Spur`
+	TableStreetHolcombTrl                   TableStreet = `Holcomb Trl`
+	TableStreetHollowayRd                   TableStreet = `Holloway Rd`
+	TableStreetHollowsDr                    TableStreet = `Hollows Dr`
+	TableStreetHollyAnnSt                   TableStreet = `Holly Ann St`
+	TableStreetHollyDr                      TableStreet = `Holly Dr`
+	TableStreetHollySpringsRd               TableStreet = `Holly Springs Rd`
+	TableStreetHollyhockLn                  TableStreet = `Hollyhock Ln`
+	TableStreetHoltRd                       TableStreet = `Holt Rd`
+	TableStreetHomePlaceDr                  TableStreet = `Home Place Dr`
+	TableStreetHomePlaceRd                  TableStreet = `Home Place Rd`
+	TableStreetHomesteadDr                  TableStreet = `Homestead Dr`
+	TableStreetHometownCt                   TableStreet = `Hometown Ct`
+	TableStreetHoneyVineTrl                 TableStreet = `Honey Vine Trl`
+	TableStreetHoneylocustCt                TableStreet = `Honeylocust Ct`
+	TableStreetHoneysuckleDr                TableStreet = `Honeysuckle Dr`
+	TableStreetHopkinsBreeze                TableStreet = `Hopkins Breeze`
+	TableStreetHopkinsFarmDr                TableStreet = `Hopkins Farm Dr`
+	TableStreetHopkinsRd                    TableStreet = `Hopkins Rd`
+	TableStreetHopsonRd                     TableStreet = `Hopson Rd`
+	TableStreetHorizonTrl                   TableStreet = `Horizon Trl`
+	TableStreetHorseshoeCt                  TableStreet = `Horseshoe Ct`
+	TableStreetHorseshoeLoop                TableStreet = `Horseshoe Loop`
+	TableStreetHorseshowRd                  TableStreet = `Horseshow Rd`
+	TableStreetHotelSt                      TableStreet = `Hotel St`
+	TableStreetHowardAve                    TableStreet = `Howard Ave`
+	TableStreetHowardDr                     TableStreet = `Howard Dr`
+	TableStreetHowardHeightsSt              TableStreet = `Howard Heights St`
+	TableStreetHowardSt                     TableStreet = `Howard St`
+	TableStreetHowellBendRd                 TableStreet = `Howell Bend Rd`
+	TableStreetHowellRd                     TableStreet = `Howell Rd`
+	TableStreetHowellSt                     TableStreet = `Howell St`
+	TableStreetHughsPl                      TableStreet = `Hughs Pl`
+	TableStreetHuntClubLn                   TableStreet = `Hunt Club Ln`
+	TableStreetHuntcliffDr                  TableStreet = `Huntcliff Dr`
+	TableStreetHuntersCv                    TableStreet = `Hunters Cv`
+	TableStreetHuntersRdg                   TableStreet = `Hunters Rdg`
+	TableStreetHuntersView                  TableStreet = `Hunters View`
+	TableStreetHuntingtonCt                 TableStreet = `Huntington Ct`
+	TableStreetHwy293                       TableStreet = `Hwy 293`
+	TableStreetHwy411                       TableStreet = `Hwy 411`
+	TableStreetHyattCt                      TableStreet = `Hyatt Ct`
+	TableStreetI75                          TableStreet = `I-75`
+	TableStreetIdlewoodDr                   TableStreet = `Idlewood Dr`
+	TableStreetImperialCt                   TableStreet = `Imperial Ct`
+	TableStreetIndependenceWay              TableStreet = `Independence Way`
+	TableStreetIndianHillsDr                TableStreet = `Indian Hills Dr`
+	TableStreetIndianHillsHght              TableStreet = `Indian Hills Hght`
+	TableStreetIndianMoundsRd               TableStreet = `Indian Mounds Rd`
+	TableStreetIndianRidgeCt                TableStreet = `Indian Ridge Ct`
+	TableStreetIndianSpringsDr              TableStreet = `Indian Springs Dr`
+	TableStreetIndianTrl                    TableStreet = `Indian Trl`
+	TableStreetIndianValleyWay              TableStreet = `Indian Valley Way`
+	TableStreetIndianWoodsDr                TableStreet = `Indian Woods Dr`
+	TableStreetIndustrialDr                 TableStreet = `Industrial Dr`
+	TableStreetIndustrialParkRd             TableStreet = `Industrial Park Rd`
+	TableStreetIngramRd                     TableStreet = `Ingram Rd`
+	TableStreetIronBeltCt                   TableStreet = `Iron Belt Ct`
+	TableStreetIronBeltRd                   TableStreet = `Iron Belt Rd`
+	TableStreetIronHillCv                   TableStreet = `Iron Hill Cv`
+	TableStreetIronHillRd                   TableStreet = `Iron Hill Rd`
+	TableStreetIronMountainRd               TableStreet = `Iron Mountain Rd`
+	TableStreetIrwinSt                      TableStreet = `Irwin St`
+	TableStreetIsabellaCt                   TableStreet = `Isabella Ct`
+	TableStreetIslandFordRd                 TableStreet = `Island Ford Rd`
+	TableStreetIslandMillRd                 TableStreet = `Island Mill Rd`
+	TableStreetIvanEvansRd                  TableStreet = `Ivan Evans Rd`
+	TableStreetIvanhoePl                    TableStreet = `Ivanhoe Pl`
+	TableStreetIvyChaseWay                  TableStreet = `Ivy Chase Way`
+	TableStreetIvyLn                        TableStreet = `Ivy Ln`
+	TableStreetIvyStoneCt                   TableStreet = `Ivy Stone Ct`
+	TableStreetJRRd                         TableStreet = `J R Rd`
+	TableStreetJacksonDr                    TableStreet = `Jackson Dr`
+	TableStreetJacksonPl                    TableStreet = `Jackson Pl`
+	TableStreetJacksonRd                    TableStreet = `Jackson Rd`
+	TableStreetJacksonRow                   TableStreet = `Jackson Row`
+	TableStreetJacksonSt                    TableStreet = `Jackson St`
+	TableStreetJacobDr                      TableStreet = `Jacob Dr`
+	TableStreetJacobsRd                     TableStreet = `Jacobs Rd`
+	TableStreetJamesAve                     TableStreet = `James Ave`
+	TableStreetJamesPlace                   TableStreet = `James Place`
+	TableStreetJamesRd                      TableStreet = `James Rd`
+	TableStreetJamesSt                      TableStreet = `James St`
+	TableStreetJamesportLn                  TableStreet = `Jamesport Ln`
+	TableStreetJaniceDr                     TableStreet = `Janice Dr`
+	TableStreetJaniceLn                     TableStreet = `Janice Ln`
+	TableStreetJarrettLoop                  TableStreet = `Jarrett Loop`
+	TableStreetJasmineLn                    TableStreet = `Jasmine Ln`
+	TableStreetJazminSq                     TableStreet = `Jazmin Sq`
+	TableStreetJefferyLn                    TableStreet = `Jeffery Ln`
+	TableStreetJefferyRd                    TableStreet = `Jeffery Rd`
+	TableStreetJenkinsDr                    TableStreet = `Jenkins Dr`
+	TableStreetJenniferLn                   TableStreet = `Jennifer Ln`
+	TableStreetJennyLn                      TableStreet = `Jenny Ln`
+	TableStreetJewellRd                     TableStreet = `Jewell Rd`
+	TableStreetJillLn                       TableStreet = `Jill Ln`
+	TableStreetJimDr                        TableStreet = `Jim Dr`
+	TableStreetJimKnightRd                  TableStreet = `Jim Knight Rd`
+	TableStreetJimLn                        TableStreet = `Jim Ln`
+	TableStreetJimmieNelsonRd               TableStreet = `Jimmie Nelson Rd`
+	TableStreetJockeyWay                    TableStreet = `Jockey Way`
+	TableStreetJoeFrankHarrisPkwy           TableStreet = `Joe Frank Harris Pkwy`
+	TableStreetJoeLeeRd                     TableStreet = `Joe Lee Rd`
+	TableStreetJoeStellaDr                  TableStreet = `Joe Stella Dr`
+	TableStreetJohnHuskinsSt                TableStreet = `John Huskins St`
+	TableStreetJohnKayRd                    TableStreet = `John Kay Rd`
+	TableStreetJohnsonCove                  TableStreet = `Johnson Cove`
+	TableStreetJohnsonDr                    TableStreet = `Johnson Dr`
+	TableStreetJohnsonLn                    TableStreet = `Johnson Ln`
+	TableStreetJohnsonMountainRd            TableStreet = `Johnson Mountain Rd`
+	TableStreetJohnsonSt                    TableStreet = `Johnson St`
+	TableStreetJohnstonRow                  TableStreet = `Johnston Row`
+	TableStreetJollyCir                     TableStreet = `Jolly Cir`
+	TableStreetJonesCt                      TableStreet = `Jones Ct`
+	TableStreetJonesMillPl                  TableStreet = `Jones Mill Pl`
+	TableStreetJonesMillRd                  TableStreet = `Jones Mill Rd`
+	TableStreetJonesRd                      TableStreet = `Jones Rd`
+	TableStreetJonesSloughRd                TableStreet = `Jones Slough Rd`
+	TableStreetJonesSt                      TableStreet = `Jones St`
+	TableStreetJordanRd                     TableStreet = `Jordan Rd`
+	TableStreetJordanSt                     TableStreet = `Jordan St`
+	TableStreetJoreeRd                      TableStreet = `Joree Rd`
+	TableStreetJosephineAve                 TableStreet = `Josephine Ave`
+	TableStreetJudeDr                       TableStreet = `Jude Dr`
+	TableStreetJudithAve                    TableStreet = `Judith Ave`
+	TableStreetJulianaWay                   TableStreet = `Juliana Way`
+	TableStreetJuniperLn                    TableStreet = `Juniper Ln`
+	TableStreetJustineRd                    TableStreet = `Justine Rd`
+	TableStreetKakkiCt                      TableStreet = `Kakki Ct`
+	TableStreetKaleighCt                    TableStreet = `Kaleigh Ct`
+	TableStreetKatieDr                      TableStreet = `Katie Dr`
+	TableStreetKatieRdg                     TableStreet = `Katie Rdg`
+	TableStreetKatrinaDr                    TableStreet = `Katrina Dr`
+	TableStreetKayRd                        TableStreet = `Kay Rd`
+	TableStreetKaylaCt                      TableStreet = `Kayla Ct`
+	TableStreetKeelingLakeRd                TableStreet = `Keeling Lake Rd`
+	TableStreetKeelingMountainRd            TableStreet = `Keeling Mountain Rd`
+	TableStreetKeithRd                      TableStreet = `Keith Rd`
+	TableStreetKelleyTrl                    TableStreet = `Kelley Trl`
+	TableStreetKelliClarkCt                 TableStreet = `Kelli Clark Ct`
+	TableStreetKelloggCir                   TableStreet = `Kellogg Cir`
+	TableStreetKellyDr                      TableStreet = `Kelly Dr`
+	TableStreetKenSt                        TableStreet = `Ken St`
+	TableStreetKentDr                       TableStreet = `Kent Dr`
+	TableStreetKentuckyAve                  TableStreet = `Kentucky Ave`
+	TableStreetKentuckyWalk                 TableStreet = `Kentucky Walk`
+	TableStreetKenwoodLn                    TableStreet = `Kenwood Ln`
+	TableStreetKerrySt                      TableStreet = `Kerry St`
+	TableStreetKimberlyDr                   TableStreet = `Kimberly Dr`
+	TableStreetKimseyCir                    TableStreet = `Kimsey Cir`
+	TableStreetKincannonRd                  TableStreet = `Kincannon Rd`
+	TableStreetKingRd                       TableStreet = `King Rd`
+	TableStreetKingSt                       TableStreet = `King St`
+	TableStreetKingsCampRd                  TableStreet = `Kings Camp Rd`
+	TableStreetKingsDr                      TableStreet = `Kings Dr`
+	TableStreetKingstonHwy                  TableStreet = `Kingston Hwy`
+	TableStreetKingstonPointeDr             TableStreet = `Kingston Pointe Dr`
+	TableStreetKiowaCt                      TableStreet = `Kiowa Ct`
+	TableStreetKirbyCt                      TableStreet = `Kirby Ct`
+	TableStreetKirbyDr                      TableStreet = `Kirby Dr`
+	TableStreetKirkRd                       TableStreet = `Kirk Rd`
+	TableStreetKitchenMountainRd            TableStreet = `Kitchen Mountain Rd`
+	TableStreetKitchensAll                  TableStreet = `Kitchens All`
+	TableStreetKnightDr                     TableStreet = `Knight Dr`
+	TableStreetKnightSt                     TableStreet = `Knight St`
+	TableStreetKnightWay                    TableStreet = `Knight Way`
+	TableStreetKnollwoodWay                 TableStreet = `Knollwood Way`
+	TableStreetKnucklesvilleRd              TableStreet = `Knucklesville Rd`
+	TableStreetKooweskooweBlvd              TableStreet = `Kooweskoowe Blvd`
+	TableStreetKortlynPl                    TableStreet = `Kortlyn Pl`
+	TableStreetKovellsDr                    TableStreet = `Kovells Dr`
+	TableStreetKristenCt                    TableStreet = `Kristen Ct`
+	TableStreetKristenLn                    TableStreet = `Kristen Ln`
+	TableStreetKuhlmanSt                    TableStreet = `Kuhlman St`
+	TableStreetLaceyRd                      TableStreet = `Lacey Rd`
+	TableStreetLaddsMountainRd              TableStreet = `Ladds Mountain Rd`
+	TableStreetLakeCt                       TableStreet = `Lake Ct`
+	TableStreetLakeDr                       TableStreet = `Lake Dr`
+	TableStreetLakeDriveNo1                 TableStreet = `Lake Drive No 1`
+	TableStreetLakeEagleCt                  TableStreet = `Lake Eagle Ct`
+	TableStreetLakeHavenDr                  TableStreet = `Lake Haven Dr`
+	TableStreetLakeMargueriteRd             TableStreet = `Lake Marguerite Rd`
+	TableStreetLakeOverlookDr               TableStreet = `Lake Overlook Dr`
+	TableStreetLakePointDr                  TableStreet = `Lake Point Dr`
+	TableStreetLakeTopDr                    TableStreet = `Lake Top Dr`
+	TableStreetLakeport                     TableStreet = `Lakeport`
+	TableStreetLakeshoreCir                 TableStreet = `Lakeshore Cir`
+	TableStreetLakesideTrl                  TableStreet = `Lakeside Trl`
+	TableStreetLakesideWay                  TableStreet = `Lakeside Way`
+	TableStreetLakeviewCt                   TableStreet = `Lakeview Ct`
+	TableStreetLakeviewDriveNo1             TableStreet = `Lakeview Drive No 1`
+	TableStreetLakeviewDriveNo2             TableStreet = `Lakeview Drive No 2`
+	TableStreetLakeviewDriveNo3             TableStreet = `Lakeview Drive No 3`
+	TableStreetLakewoodCt                   TableStreet = `Lakewood Ct`
+	TableStreetLamplighterCv                TableStreet = `Lamplighter Cv`
+	TableStreetLancelotCt                   TableStreet = `Lancelot Ct`
+	TableStreetLancerCt                     TableStreet = `Lancer Ct`
+	TableStreetLandersDr                    TableStreet = `Landers Dr`
+	TableStreetLandersRd                    TableStreet = `Landers Rd`
+	TableStreetLanhamFieldRd                TableStreet = `Lanham Field Rd`
+	TableStreetLanhamRd                     TableStreet = `Lanham Rd`
+	TableStreetLanierDr                     TableStreet = `Lanier Dr`
+	TableStreetLanternCir                   TableStreet = `Lantern Cir`
+	TableStreetLanternLightTrl              TableStreet = `Lantern Light Trl`
+	TableStreetLarkCir                      TableStreet = `Lark Cir`
+	TableStreetLarkspurLn                   TableStreet = `Larkspur Ln`
+	TableStreetLarkspurRd                   TableStreet = `Larkspur Rd`
+	TableStreetLarkwoodCir                  TableStreet = `Larkwood Cir`
+	TableStreetLarsenRdg                    TableStreet = `Larsen Rdg`
+	TableStreetLatimerLn                    TableStreet = `Latimer Ln`
+	TableStreetLatimerRd                    TableStreet = `Latimer Rd`
+	TableStreetLauraDr                      TableStreet = `Laura Dr`
+	TableStreetLaurelCv                     TableStreet = `Laurel Cv`
+	TableStreetLaurelDr                     TableStreet = `Laurel Dr`
+	TableStreetLaurelTrc                    TableStreet = `Laurel Trc`
+	TableStreetLaurelWay                    TableStreet = `Laurel Way`
+	TableStreetLaurelwoodLn                 TableStreet = `Laurelwood Ln`
+	TableStreetLaurenLn                     TableStreet = `Lauren Ln`
+	TableStreetLavanesWay                   TableStreet = `Lavanes Way`
+	TableStreetLawRd                        TableStreet = `Law Rd`
+	TableStreetLawrenceSt                   TableStreet = `Lawrence St`
+	TableStreetLazyWaterDr                  TableStreet = `Lazy Water Dr`
+	TableStreetLeadMountainRd               TableStreet = `Lead Mountain Rd`
+	TableStreetLeakeSt                      TableStreet = `Leake St`
+	TableStreetLedfordLn                    TableStreet = `Ledford Ln`
+	TableStreetLeeLn                        TableStreet = `Lee Ln`
+	TableStreetLeeRd                        TableStreet = `Lee Rd`
+	TableStreetLeeSt                        TableStreet = `Lee St`
+	TableStreetLegacyWay                    TableStreet = `Legacy Way`
+	TableStreetLegionSt                     TableStreet = `Legion St`
+	TableStreetLeilaCv                      TableStreet = `Leila Cv`
+	TableStreetLennoxParkAve                TableStreet = `Lennox Park Ave`
+	TableStreetLenoxDr                      TableStreet = `Lenox Dr`
+	TableStreetLeonardDr                    TableStreet = `Leonard Dr`
+	TableStreetLeslieCv                     TableStreet = `Leslie Cv`
+	TableStreetLewisDr                      TableStreet = `Lewis Dr`
+	TableStreetLewisFarmRd                  TableStreet = `Lewis Farm Rd`
+	TableStreetLewisRd                      TableStreet = `Lewis Rd`
+	TableStreetLewisWay                     TableStreet = `Lewis Way`
+	TableStreetLexingtonCt                  TableStreet = `Lexington Ct`
+	TableStreetLexyWay                      TableStreet = `Lexy Way`
+	TableStreetLibertyCrossingDr            TableStreet = `Liberty Crossing Dr`
+	TableStreetLibertyDr                    TableStreet = `Liberty Dr`
+	TableStreetLibertySquareDr              TableStreet = `Liberty Square Dr`
+	TableStreetLilyWay                      TableStreet = `Lily Way`
+	TableStreetLimerickCt                   TableStreet = `Limerick Ct`
+	TableStreetLindaRd                      TableStreet = `Linda Rd`
+	TableStreetLindseyDr                    TableStreet = `Lindsey Dr`
+	TableStreetLingerfeltLn                 TableStreet = `Lingerfelt Ln`
+	TableStreetLinwoodRd                    TableStreet = `Linwood Rd`
+	TableStreetLipscombCir                  TableStreet = `Lipscomb Cir`
+	TableStreetLitchfieldSt                 TableStreet = `Litchfield St`
+	TableStreetLittleGateWay                TableStreet = `Little Gate Way`
+	TableStreetLittleJohnLn                 TableStreet = `Little John Ln`
+	TableStreetLittleJohnTrl                TableStreet = `Little John Trl`
+	TableStreetLittleSt                     TableStreet = `Little St`
+	TableStreetLittleValleyRd               TableStreet = `Little Valley Rd`
+	TableStreetLittlefieldRd                TableStreet = `Littlefield Rd`
+	TableStreetLiveOakRun                   TableStreet = `Live Oak Run`
+	TableStreetLivseyRd                     TableStreet = `Livsey Rd`
+	TableStreetLodgeRd                      TableStreet = `Lodge Rd`
+	TableStreetLoisLn                       TableStreet = `Lois Ln`
+	TableStreetLoisRd                       TableStreet = `Lois Rd`
+	TableStreetLondonCt                     TableStreet = `London Ct`
+	TableStreetLondonderryWay               TableStreet = `Londonderry Way`
+	TableStreetLongBranchCt                 TableStreet = `Long Branch Ct`
+	TableStreetLongRd                       TableStreet = `Long Rd`
+	TableStreetLongTrl                      TableStreet = `Long Trl`
+	TableStreetLongviewPte                  TableStreet = `Longview Pte`
+	TableStreetLovellSt                     TableStreet = `Lovell St`
+	TableStreetLowCt                        TableStreet = `Low Ct`
+	TableStreetLoweryRd                     TableStreet = `Lowery Rd`
+	TableStreetLowryWay                     TableStreet = `Lowry Way`
+	TableStreetLucasLn                      TableStreet = `Lucas Ln`
+	TableStreetLucasRd                      TableStreet = `Lucas Rd`
+	TableStreetLucilleRd                    TableStreet = `Lucille Rd`
+	TableStreetLuckieSt                     TableStreet = `Luckie St`
+	TableStreetLukeLn                       TableStreet = `Luke Ln`
+	TableStreetLumpkinDr                    TableStreet = `Lumpkin Dr`
+	TableStreetLuskAve                      TableStreet = `Lusk Ave`
+	TableStreetLutherKnightRd               TableStreet = `Luther Knight Rd`
+	TableStreetLuwandaTrl                   TableStreet = `Luwanda Trl`
+	TableStreetLynchSt                      TableStreet = `Lynch St`
+	TableStreetMacJohnsonRd                 TableStreet = `Mac Johnson Rd`
+	TableStreetMacedoniaCemetaryRd          TableStreet = `Macedonia Cemetary Rd`
+	TableStreetMacedoniaRd                  TableStreet = `Macedonia Rd`
+	TableStreetMacraDr                      TableStreet = `Macra Dr`
+	TableStreetMaddenRd                     TableStreet = `Madden Rd`
+	TableStreetMaddenSt                     TableStreet = `Madden St`
+	TableStreetMaddoxRd                     TableStreet = `Maddox Rd`
+	TableStreetMadisonCir                   TableStreet = `Madison Cir`
+	TableStreetMadisonCt                    TableStreet = `Madison Ct`
+	TableStreetMadisonLn                    TableStreet = `Madison Ln`
+	TableStreetMadisonPl                    TableStreet = `Madison Pl`
+	TableStreetMadisonWay                   TableStreet = `Madison Way`
+	TableStreetMagnoliaCt                   TableStreet = `Magnolia Ct`
+	TableStreetMagnoliaDr                   TableStreet = `Magnolia Dr`
+	TableStreetMagnoliaFarmRd               TableStreet = `Magnolia Farm Rd`
+	TableStreetMahanLn                      TableStreet = `Mahan Ln`
+	TableStreetMahanRd                      TableStreet = `Mahan Rd`
+	TableStreetMainLakeDr                   TableStreet = `Main Lake Dr`
+	TableStreetMainSt                       TableStreet = `Main St`
+	TableStreetMalletPte                    TableStreet = `Mallet Pte`
+	TableStreetMalloryCt                    TableStreet = `Mallory Ct`
+	TableStreetMalloryDr                    TableStreet = `Mallory Dr`
+	TableStreetManningMillRd                TableStreet = `Manning Mill Rd`
+	TableStreetManningMillWay               TableStreet = `Manning Mill Way`
+	TableStreetManningRd                    TableStreet = `Manning Rd`
+	TableStreetManorHouseLn                 TableStreet = `Manor House Ln`
+	TableStreetManorWay                     TableStreet = `Manor Way`
+	TableStreetMansfieldRd                  TableStreet = `Mansfield Rd`
+	TableStreetMapleAve                     TableStreet = `Maple Ave`
+	TableStreetMapleDr                      TableStreet = `Maple Dr`
+	TableStreetMapleGroveDr                 TableStreet = `Maple Grove Dr`
+	TableStreetMapleLn                      TableStreet = `Maple Ln`
+	TableStreetMapleRidgeDr                 TableStreet = `Maple Ridge Dr`
+	TableStreetMapleSt                      TableStreet = `Maple St`
+	TableStreetMaplewoodPl                  TableStreet = `Maplewood Pl`
+	TableStreetMaplewoodTrl                 TableStreet = `Maplewood Trl`
+	TableStreetMargueriteDr                 TableStreet = `Marguerite Dr`
+	TableStreetMarinaRd                     TableStreet = `Marina Rd`
+	TableStreetMarinerWay                   TableStreet = `Mariner Way`
+	TableStreetMarkTrl                      TableStreet = `Mark Trl`
+	TableStreetMarketPlaceBlvd              TableStreet = `Market Place Blvd`
+	TableStreetMarlinLn                     TableStreet = `Marlin Ln`
+	TableStreetMarrRd                       TableStreet = `Marr Rd`
+	TableStreetMarshwoodGlen                TableStreet = `Marshwood Glen`
+	TableStreetMarthaCt                     TableStreet = `Martha Ct`
+	TableStreetMarthasPl                    TableStreet = `Marthas Pl`
+	TableStreetMarthasWalk                  TableStreet = `Marthas Walk`
+	TableStreetMartinCir                    TableStreet = `Martin Cir`
+	TableStreetMartinLoop                   TableStreet = `Martin Loop`
+	TableStreetMartinLutherKingJrCir        TableStreet = `Martin Luther King Jr Cir`
+	TableStreetMartinLutherKingJrDr         TableStreet = `Martin Luther King Jr Dr`
+	TableStreetMartinRd                     TableStreet = `Martin Rd`
+	TableStreetMaryGraceLn                  TableStreet = `Mary Grace Ln`
+	TableStreetMaryLn                       TableStreet = `Mary Ln`
+	TableStreetMarySt                       TableStreet = `Mary St`
+	TableStreetMassellDr                    TableStreet = `Massell Dr`
+	TableStreetMassingaleRd                 TableStreet = `Massingale Rd`
+	TableStreetMathewsRd                    TableStreet = `Mathews Rd`
+	TableStreetMaudeWhiteRd                 TableStreet = `Maude White Rd`
+	TableStreetMauldinCir                   TableStreet = `Mauldin Cir`
+	TableStreetMaxwellRd                    TableStreet = `Maxwell Rd`
+	TableStreetMaySt                        TableStreet = `May St`
+	TableStreetMaybelleSt                   TableStreet = `Maybelle St`
+	TableStreetMayfieldRd                   TableStreet = `Mayfield Rd`
+	TableStreetMayflowerCir                 TableStreet = `Mayflower Cir`
+	TableStreetMayflowerSt                  TableStreet = `Mayflower St`
+	TableStreetMayflowerWay                 TableStreet = `Mayflower Way`
+	TableStreetMaysRd                       TableStreet = `Mays Rd`
+	TableStreetMcCanlessSt                  TableStreet = `McCanless St`
+	TableStreetMcClureDr                    TableStreet = `McClure Dr`
+	TableStreetMcCormickRd                  TableStreet = `McCormick Rd`
+	TableStreetMcCoyRd                      TableStreet = `McCoy Rd`
+	TableStreetMcElreathSt                  TableStreet = `McElreath St`
+	TableStreetMcEverSt                     TableStreet = `McEver St`
+	TableStreetMcKaskeyCreekRd              TableStreet = `McKaskey Creek Rd`
+	TableStreetMcKayDr                      TableStreet = `McKay Dr`
+	TableStreetMcKelveyCt                   TableStreet = `McKelvey Ct`
+	TableStreetMcKennaRd                    TableStreet = `McKenna Rd`
+	TableStreetMcKenzieCir                  TableStreet = `McKenzie Cir`
+	TableStreetMcKenzieSt                   TableStreet = `McKenzie St`
+	TableStreetMcKinleyCt                   TableStreet = `McKinley Ct`
+	TableStreetMcKinneyCampgroundRd         TableStreet = `McKinney Campground Rd`
+	TableStreetMcMillanRd                   TableStreet = `McMillan Rd`
+	TableStreetMcStottsRd                   TableStreet = `McStotts Rd`
+	TableStreetMcTierCir                    TableStreet = `McTier Cir`
+	TableStreetMeadowLn                     TableStreet = `Meadow Ln`
+	TableStreetMeadowbridgeDr               TableStreet = `Meadowbridge Dr`
+	TableStreetMeadowviewCir                TableStreet = `Meadowview Cir`
+	TableStreetMeadowviewRd                 TableStreet = `Meadowview Rd`
+	TableStreetMedicalDr                    TableStreet = `Medical Dr`
+	TableStreetMelhanaDr                    TableStreet = `Melhana Dr`
+	TableStreetMeloneDr                     TableStreet = `Melone Dr`
+	TableStreetMercerDr                     TableStreet = `Mercer Dr`
+	TableStreetMercerLn                     TableStreet = `Mercer Ln`
+	TableStreetMerchantsSquareDr            TableStreet = `Merchants Square Dr`
+	TableStreetMichaelLn                    TableStreet = `Michael Ln`
+	TableStreetMichiganAve                  TableStreet = `Michigan Ave`
+	TableStreetMiddleCt                     TableStreet = `Middle Ct`
+	TableStreetMiddlebrookDr                TableStreet = `Middlebrook Dr`
+	TableStreetMiddletonCt                  TableStreet = `Middleton Ct`
+	TableStreetMilamBridgeRd                TableStreet = `Milam Bridge Rd`
+	TableStreetMilamCir                     TableStreet = `Milam Cir`
+	TableStreetMilamSt                      TableStreet = `Milam St`
+	TableStreetMilesDr                      TableStreet = `Miles Dr`
+	TableStreetMillCreekRd                  TableStreet = `Mill Creek Rd`
+	TableStreetMillRockDr                   TableStreet = `Mill Rock Dr`
+	TableStreetMillViewCt                   TableStreet = `Mill View Ct`
+	TableStreetMillerFarmRd                 TableStreet = `Miller Farm Rd`
+	TableStreetMillersWay                   TableStreet = `Millers Way`
+	TableStreetMillstonePt                  TableStreet = `Millstone Pt`
+	TableStreetMillstreamCt                 TableStreet = `Millstream Ct`
+	TableStreetMilnerRd                     TableStreet = `Milner Rd`
+	TableStreetMilnerSt                     TableStreet = `Milner St`
+	TableStreetMiltonsWalk                  TableStreet = `Miltons Walk`
+	TableStreetMimosaLn                     TableStreet = `Mimosa Ln`
+	TableStreetMimosaTer                    TableStreet = `Mimosa Ter`
+	TableStreetMineralMuseumDr              TableStreet = `Mineral Museum Dr`
+	TableStreetMinersPt                     TableStreet = `Miners Pt`
+	TableStreetMintRd                       TableStreet = `Mint Rd`
+	TableStreetMirrorLakeDr                 TableStreet = `Mirror Lake Dr`
+	TableStreetMissionHillsDr               TableStreet = `Mission Hills Dr`
+	TableStreetMissionMountainRd            TableStreet = `Mission Mountain Rd`
+	TableStreetMissionRd                    TableStreet = `Mission Rd`
+	TableStreetMissionRidgeDr               TableStreet = `Mission Ridge Dr`
+	TableStreetMistyHollowCt                TableStreet = `Misty Hollow Ct`
+	TableStreetMistyRidgeDr                 TableStreet = `Misty Ridge Dr`
+	TableStreetMistyValleyDr                TableStreet = `Misty Valley Dr`
+	TableStreetMitchellAve                  TableStreet = `Mitchell Ave`
+	TableStreetMitchellRd                   TableStreet = `Mitchell Rd`
+	TableStreetMockingbirdDr                TableStreet = `Mockingbird Dr`
+	TableStreetMohawkDr                     TableStreet = `Mohawk Dr`
+	TableStreetMonroeXing                   TableStreet = `Monroe Xing`
+	TableStreetMontclairCt                  TableStreet = `Montclair Ct`
+	TableStreetMontgomerySt                 TableStreet = `Montgomery St`
+	TableStreetMontviewCir                  TableStreet = `Montview Cir`
+	TableStreetMoodySt                      TableStreet = `Moody St`
+	TableStreetMoonlightDr                  TableStreet = `Moonlight Dr`
+	TableStreetMooreRd                      TableStreet = `Moore Rd`
+	TableStreetMooreSt                      TableStreet = `Moore St`
+	TableStreetMooresSpringRd               TableStreet = `Moores Spring Rd`
+	TableStreetMorganAve                    TableStreet = `Morgan Ave`
+	TableStreetMorganDr                     TableStreet = `Morgan Dr`
+	TableStreetMoriahWay                    TableStreet = `Moriah Way`
+	TableStreetMorrisDr                     TableStreet = `Morris Dr`
+	TableStreetMorrisRd                     TableStreet = `Morris Rd`
+	TableStreetMosleyDr                     TableStreet = `Mosley Dr`
+	TableStreetMossLandingRd                TableStreet = `Moss Landing Rd`
+	TableStreetMossLn                       TableStreet = `Moss Ln`
+	TableStreetMossWay                      TableStreet = `Moss Way`
+	TableStreetMossyRockLn                  TableStreet = `Mossy Rock Ln`
+	TableStreetMostellersMillRd             TableStreet = `Mostellers Mill Rd`
+	TableStreetMotorsportsDr                TableStreet = `Motorsports Dr`
+	TableStreetMountOliveSt                 TableStreet = `Mount Olive St`
+	TableStreetMountPleasantRd              TableStreet = `Mount Pleasant Rd`
+	TableStreetMountainBreezeRd             TableStreet = `Mountain Breeze Rd`
+	TableStreetMountainChaseDr              TableStreet = `Mountain Chase Dr`
+	TableStreetMountainCreekTrl             TableStreet = `Mountain Creek Trl`
+	TableStreetMountainRidgeDr              TableStreet = `Mountain Ridge Dr`
+	TableStreetMountainRidgeRd              TableStreet = `Mountain Ridge Rd`
+	TableStreetMountainTrailCt              TableStreet = `Mountain Trail Ct`
+	TableStreetMountainViewCt               TableStreet = `Mountain View Ct`
+	TableStreetMountainViewDr               TableStreet = `Mountain View Dr`
+	TableStreetMountainViewRd               TableStreet = `Mountain View Rd`
+	TableStreetMountainbrookDr              TableStreet = `Mountainbrook Dr`
+	TableStreetMuirfieldWalk                TableStreet = `Muirfield Walk`
+	TableStreetMulberryLn                   TableStreet = `Mulberry Ln`
+	TableStreetMulberryWay                  TableStreet = `Mulberry Way`
+	TableStreetMulinixRd                    TableStreet = `Mulinix Rd`
+	TableStreetMullSt                       TableStreet = `Mull St`
+	TableStreetMullinaxRd                   TableStreet = `Mullinax Rd`
+	TableStreetMundyRd                      TableStreet = `Mundy Rd`
+	TableStreetMundySt                      TableStreet = `Mundy St`
+	TableStreetMurphyLn                     TableStreet = `Murphy Ln`
+	TableStreetMurrayAve                    TableStreet = `Murray Ave`
+	TableStreetNBartowSt                    TableStreet = `N Bartow St`
+	TableStreetNCassSt                      TableStreet = `N Cass St`
+	TableStreetNCentralAve                  TableStreet = `N Central Ave`
+	TableStreetNDixieAve                    TableStreet = `N Dixie Ave`
+	TableStreetNErwinSt                     TableStreet = `N Erwin St`
+	TableStreetNFranklinSt                  TableStreet = `N Franklin St`
+	TableStreetNGilmerSt                    TableStreet = `N Gilmer St`
+	TableStreetNMainSt                      TableStreet = `N Main St`
+	TableStreetNMorningsideDr               TableStreet = `N Morningside Dr`
+	TableStreetNMuseumDr                    TableStreet = `N Museum Dr`
+	TableStreetNPublicSq                    TableStreet = `N Public Sq`
+	TableStreetNRailroadSt                  TableStreet = `N Railroad St`
+	TableStreetNTennesseeSt                 TableStreet = `N Tennessee St`
+	TableStreetNWallSt                      TableStreet = `N Wall St`
+	TableStreetNallyRd                      TableStreet = `Nally Rd`
+	TableStreetNatalieDr                    TableStreet = `Natalie Dr`
+	TableStreetNatchiTrl                    TableStreet = `Natchi Trl`
+	TableStreetNavajoLn                     TableStreet = `Navajo Ln`
+	TableStreetNavajoTrl                    TableStreet = `Navajo Trl`
+	TableStreetNavigationPte                TableStreet = `Navigation Pte`
+	TableStreetNeedlePointRdg               TableStreet = `Needle Point Rdg`
+	TableStreetNeelSt                       TableStreet = `Neel St`
+	TableStreetNelsonSt                     TableStreet = `Nelson St`
+	TableStreetNewHopeChurchRd              TableStreet = `New Hope Church Rd`
+	TableStreetNicholasLn                   TableStreet = `Nicholas Ln`
+	TableStreetNobleHill                    TableStreet = `Noble Hill`
+	TableStreetNobleSt                      TableStreet = `Noble St`
+	TableStreetNolandSt                     TableStreet = `Noland St`
+	TableStreetNorlandCt                    TableStreet = `Norland Ct`
+	TableStreetNorthAve                     TableStreet = `North Ave`
+	TableStreetNorthDr                      TableStreet = `North Dr`
+	TableStreetNorthHamptonDr               TableStreet = `North Hampton Dr`
+	TableStreetNorthOaksCir                 TableStreet = `North Oaks Cir`
+	TableStreetNorthPointDr                 TableStreet = `North Point Dr`
+	TableStreetNorthRidgeCir                TableStreet = `North Ridge Cir`
+	TableStreetNorthRidgeCt                 TableStreet = `North Ridge Ct`
+	TableStreetNorthRidgeDr                 TableStreet = `North Ridge Dr`
+	TableStreetNorthRidgePt                 TableStreet = `North Ridge Pt`
+	TableStreetNorthValleyRd                TableStreet = `North Valley Rd`
+	TableStreetNorthVillageCir              TableStreet = `North Village Cir`
+	TableStreetNorthWesleyRdg               TableStreet = `North Wesley Rdg`
+	TableStreetNorthWoodsDr                 TableStreet = `North Woods Dr`
+	TableStreetNorthpointPkwy               TableStreet = `Northpoint Pkwy`
+	TableStreetNorthsidePkwy                TableStreet = `Northside Pkwy`
+	TableStreetNortonRd                     TableStreet = `Norton Rd`
+	TableStreetNottinghamDr                 TableStreet = `Nottingham Dr`
+	TableStreetNottinghamWay                TableStreet = `Nottingham Way`
+	TableStreetNovemberLn                   TableStreet = `November Ln`
+	TableStreetOakBeachDr                   TableStreet = `Oak Beach Dr`
+	TableStreetOakCt                        TableStreet = `Oak Ct`
+	TableStreetOakDr                        TableStreet = `Oak Dr`
+	TableStreetOakFarmDr                    TableStreet = `Oak Farm Dr`
+	TableStreetOakGroveCt                   TableStreet = `Oak Grove Ct`
+	TableStreetOakGroveLn                   TableStreet = `Oak Grove Ln`
+	TableStreetOakGroveRd                   TableStreet = `Oak Grove Rd`
+	TableStreetOakHillCir                   TableStreet = `Oak Hill Cir`
+	TableStreetOakHillDr                    TableStreet = `Oak Hill Dr`
+	TableStreetOakHillLn                    TableStreet = `Oak Hill Ln`
+	TableStreetOakHillWay                   TableStreet = `Oak Hill Way`
+	TableStreetOakHollowRd                  TableStreet = `Oak Hollow Rd`
+	TableStreetOakLeafDr                    TableStreet = `Oak Leaf Dr`
+	TableStreetOakLn                        TableStreet = `Oak Ln`
+	TableStreetOakSt                        TableStreet = `Oak St`
+	TableStreetOakStreetNo1                 TableStreet = `Oak Street No 1`
+	TableStreetOakStreetNo2                 TableStreet = `Oak Street No 2`
+	TableStreetOakWay                       TableStreet = `Oak Way`
+	TableStreetOakbrookDr                   TableStreet = `Oakbrook Dr`
+	TableStreetOakdaleDr                    TableStreet = `Oakdale Dr`
+	TableStreetOaklandSt                    TableStreet = `Oakland St`
+	TableStreetOakridgeDr                   TableStreet = `Oakridge Dr`
+	TableStreetOaksideCt                    TableStreet = `Oakside Ct`
+	TableStreetOaksideTrl                   TableStreet = `Oakside Trl`
+	TableStreetOakwoodWay                   TableStreet = `Oakwood Way`
+	TableStreetOhioAve                      TableStreet = `Ohio Ave`
+	TableStreetOhioSt                       TableStreet = `Ohio St`
+	TableStreetOld140Hwy                    TableStreet = `Old 140 Hwy`
+	TableStreetOld41Hwy                     TableStreet = `Old 41 Hwy`
+	TableStreetOldAlabamaRd                 TableStreet = `Old Alabama Rd`
+	TableStreetOldAlabamaSpur               TableStreet = `Old Alabama Spur`
+	TableStreetOldAllatoonaRd               TableStreet = `Old Allatoona Rd`
+	TableStreetOldBarnWay                   TableStreet = `Old Barn Way`
+	TableStreetOldBellsFerryRd              TableStreet = `Old Bells Ferry Rd`
+	TableStreetOldCCCRd                     TableStreet = `Old C C C Rd`
+	TableStreetOldCantonRd                  TableStreet = `Old Canton Rd`
+	TableStreetOldCassvilleWhiteRd          TableStreet = `Old Cassville White Rd`
+	TableStreetOldClineSmithRd              TableStreet = `Old Cline Smith Rd`
+	TableStreetOldDallasHwy                 TableStreet = `Old Dallas Hwy`
+	TableStreetOldDallasRd                  TableStreet = `Old Dallas Rd`
+	TableStreetOldDixieHwy                  TableStreet = `Old Dixie Hwy`
+	TableStreetOldFarmRd                    TableStreet = `Old Farm Rd`
+	TableStreetOldFieldRd                   TableStreet = `Old Field Rd`
+	TableStreetOldFurnaceRd                 TableStreet = `Old Furnace Rd`
+	TableStreetOldGilliamSpringsRd          TableStreet = `Old Gilliam Springs Rd`
+	TableStreetOldGoodieMountainRd          TableStreet = `Old Goodie Mountain Rd`
+	TableStreetOldGrassdaleRd               TableStreet = `Old Grassdale Rd`
+	TableStreetOldHallStationRd             TableStreet = `Old Hall Station Rd`
+	TableStreetOldHardinBridgeRd            TableStreet = `Old Hardin Bridge Rd`
+	TableStreetOldHemlockCv                 TableStreet = `Old Hemlock Cv`
+	TableStreetOldHomePlaceRd               TableStreet = `Old Home Place Rd`
+	TableStreetOldJonesMillRd               TableStreet = `Old Jones Mill Rd`
+	TableStreetOldMacedoniaCampgroundRd     TableStreet = `Old Macedonia Campground Rd`
+	TableStreetOldMartinRd                  TableStreet = `Old Martin Rd`
+	TableStreetOldMcKaskeyCreekRd           TableStreet = `Old McKaskey Creek Rd`
+	TableStreetOldMillFarmRd                TableStreet = `Old Mill Farm Rd`
+	TableStreetOldMillRd                    TableStreet = `Old Mill Rd`
+	TableStreetOldOldAlabamaRd              TableStreet = `Old Old Alabama Rd`
+	TableStreetOldPostRd                    TableStreet = `Old Post Rd`
+	TableStreetOldRiverRd                   TableStreet = `Old River Rd`
+	TableStreetOldRomeRd                    TableStreet = `Old Rome Rd`
+	TableStreetOldRovingRd                  TableStreet = `Old Roving Rd`
+	TableStreetOldRudyYorkRd                TableStreet = `Old Rudy York Rd`
+	TableStreetOldSandtownRd                TableStreet = `Old Sandtown Rd`
+	TableStreetOldSpringPlaceRd             TableStreet = `Old Spring Place Rd`
+	TableStreetOldStilesboroRd              TableStreet = `Old Stilesboro Rd`
+	TableStreetOldTennesseeHwy              TableStreet = `Old Tennessee Hwy`
+	TableStreetOldTennesseeRd               TableStreet = `Old Tennessee Rd`
+	TableStreetOldWadeRd                    TableStreet = `Old Wade Rd`
+	TableStreetOliveVineChurchRd            TableStreet = `Olive Vine Church Rd`
+	TableStreetOpalSt                       TableStreet = `Opal St`
+	TableStreetOrchardRd                    TableStreet = `Orchard Rd`
+	TableStreetOreMineRd                    TableStreet = `Ore Mine Rd`
+	TableStreetOrioleDr                     TableStreet = `Oriole Dr`
+	TableStreetOttingDr                     TableStreet = `Otting Dr`
+	TableStreetOverlookCir                  TableStreet = `Overlook Cir`
+	TableStreetOverlookWay                  TableStreet = `Overlook Way`
+	TableStreetOwensbyLn                    TableStreet = `Owensby Ln`
+	TableStreetOxfordCt                     TableStreet = `Oxford Ct`
+	TableStreetOxfordDr                     TableStreet = `Oxford Dr`
+	TableStreetOxfordLn                     TableStreet = `Oxford Ln`
+	TableStreetOxfordMillWay                TableStreet = `Oxford Mill Way`
+	TableStreetPMBYoungRd                   TableStreet = `P M B Young Rd`
+	TableStreetPaddockWay                   TableStreet = `Paddock Way`
+	TableStreetPadgettRd                    TableStreet = `Padgett Rd`
+	TableStreetPagaMineRd                   TableStreet = `Paga Mine Rd`
+	TableStreetPaigeDr                      TableStreet = `Paige Dr`
+	TableStreetPaigeSt                      TableStreet = `Paige St`
+	TableStreetPalmerRd                     TableStreet = `Palmer Rd`
+	TableStreetPamCir                       TableStreet = `Pam Cir`
+	TableStreetParkCt                       TableStreet = `Park Ct`
+	TableStreetParkMarinaRd                 TableStreet = `Park Marina Rd`
+	TableStreetParkPlaceDr                  TableStreet = `Park Place Dr`
+	TableStreetParkSt                       TableStreet = `Park St`
+	TableStreetParkerAve                    TableStreet = `Parker Ave`
+	TableStreetParkerWoodRd                 TableStreet = `Parker Wood Rd`
+	TableStreetParksideView                 TableStreet = `Parkside View`
+	TableStreetParkviewDr                   TableStreet = `Parkview Dr`
+	TableStreetParmenterSt                  TableStreet = `Parmenter St`
+	TableStreetParrWadeRd                   TableStreet = `Parr Wade Rd`
+	TableStreetPasleyRd                     TableStreet = `Pasley Rd`
+	TableStreetPathfinderSt                 TableStreet = `Pathfinder St`
+	TableStreetPatriciaDr                   TableStreet = `Patricia Dr`
+	TableStreetPattersonDr                  TableStreet = `Patterson Dr`
+	TableStreetPattersonLn                  TableStreet = `Patterson Ln`
+	TableStreetPattonLn                     TableStreet = `Patton Ln`
+	TableStreetPattonSt                     TableStreet = `Patton St`
+	TableStreetPawneeTrl                    TableStreet = `Pawnee Trl`
+	TableStreetPeaceTreeLn                  TableStreet = `Peace Tree Ln`
+	TableStreetPeachBlossomCir              TableStreet = `Peach Blossom Cir`
+	TableStreetPeachtreeSt                  TableStreet = `Peachtree St`
+	TableStreetPearlLn                      TableStreet = `Pearl Ln`
+	TableStreetPearlSt                      TableStreet = `Pearl St`
+	TableStreetPearsonRd                    TableStreet = `Pearson Rd`
+	TableStreetPearsonSt                    TableStreet = `Pearson St`
+	TableStreetPebbleBrookCt                TableStreet = `Pebble Brook Ct`
+	TableStreetPebbleHillCt                 TableStreet = `Pebble Hill Ct`
+	TableStreetPecanLn                      TableStreet = `Pecan Ln`
+	TableStreetPeddlersWay                  TableStreet = `Peddlers Way`
+	TableStreetPeeplesValleyRd              TableStreet = `Peeples Valley Rd`
+	TableStreetPembrokeLn                   TableStreet = `Pembroke Ln`
+	TableStreetPendleyTrl                   TableStreet = `Pendley Trl`
+	TableStreetPenelopeLn                   TableStreet = `Penelope Ln`
+	TableStreetPenfieldDr                   TableStreet = `Penfield Dr`
+	TableStreetPennyCt                      TableStreet = `Penny Ct`
+	TableStreetPennyLn                      TableStreet = `Penny Ln`
+	TableStreetPeppermillDr                 TableStreet = `Peppermill Dr`
+	TableStreetPercheronTrl                 TableStreet = `Percheron Trl`
+	TableStreetPerkinsDr                    TableStreet = `Perkins Dr`
+	TableStreetPerkinsMountainRd            TableStreet = `Perkins Mountain Rd`
+	TableStreetPerryRd                      TableStreet = `Perry Rd`
+	TableStreetPettitCir                    TableStreet = `Pettit Cir`
+	TableStreetPettitRd                     TableStreet = `Pettit Rd`
+	TableStreetPhillipsDr                   TableStreet = `Phillips Dr`
+	TableStreetPhoenixAirDr                 TableStreet = `Phoenix Air Dr`
+	TableStreetPickersRow                   TableStreet = `Pickers Row`
+	TableStreetPicklesimerRd                TableStreet = `Picklesimer Rd`
+	TableStreetPickysHoll                   TableStreet = `Pickys Holl`
+	TableStreetPiedmontAve                  TableStreet = `Piedmont Ave`
+	TableStreetPiedmontLn                   TableStreet = `Piedmont Ln`
+	TableStreetPilgrimSt                    TableStreet = `Pilgrim St`
+	TableStreetPineBowRd                    TableStreet = `Pine Bow Rd`
+	TableStreetPineCir                      TableStreet = `Pine Cir`
+	TableStreetPineDr                       TableStreet = `Pine Dr`
+	TableStreetPineForrestRd                TableStreet = `Pine Forrest Rd`
+	TableStreetPineGroveChurchRd            TableStreet = `Pine Grove Church Rd`
+	TableStreetPineGroveCir                 TableStreet = `Pine Grove Cir`
+	TableStreetPineGroveRd                  TableStreet = `Pine Grove Rd`
+	TableStreetPineLogRd                    TableStreet = `Pine Log Rd`
+	TableStreetPineLoop                     TableStreet = `Pine Loop`
+	TableStreetPineNeedleTrl                TableStreet = `Pine Needle Trl`
+	TableStreetPineOakCt                    TableStreet = `Pine Oak Ct`
+	TableStreetPineRidgeCt                  TableStreet = `Pine Ridge Ct`
+	TableStreetPineRidgeDr                  TableStreet = `Pine Ridge Dr`
+	TableStreetPineRidgeLn                  TableStreet = `Pine Ridge Ln`
+	TableStreetPineRidgeRd                  TableStreet = `Pine Ridge Rd`
+	TableStreetPineSt                       TableStreet = `Pine St`
+	TableStreetPineStreetNo1                TableStreet = `Pine Street No 1`
+	TableStreetPineStreetNo2                TableStreet = `Pine Street No 2`
+	TableStreetPineValleyCir                TableStreet = `Pine Valley Cir`
+	TableStreetPineValleyDr                 TableStreet = `Pine Valley Dr`
+	TableStreetPineVistaCir                 TableStreet = `Pine Vista Cir`
+	TableStreetPineWaterCt                  TableStreet = `Pine Water Ct`
+	TableStreetPineWoodRd                   TableStreet = `Pine Wood Rd`
+	TableStreetPinecrestDr                  TableStreet = `Pinecrest Dr`
+	TableStreetPinehillDr                   TableStreet = `Pinehill Dr`
+	TableStreetPineryDr                     TableStreet = `Pinery Dr`
+	TableStreetPineyWoodsRd                 TableStreet = `Piney Woods Rd`
+	TableStreetPinsonDr                     TableStreet = `Pinson Dr`
+	TableStreetPioneerTrl                   TableStreet = `Pioneer Trl`
+	TableStreetPittmanSt                    TableStreet = `Pittman St`
+	TableStreetPiutePl                      TableStreet = `Piute Pl`
+	TableStreetPlainviewDr                  TableStreet = `Plainview Dr`
+	TableStreetPlantationRd                 TableStreet = `Plantation Rd`
+	TableStreetPlantationRidgeDr            TableStreet = `Plantation Ridge Dr`
+	TableStreetPlantersDr                   TableStreet = `Planters Dr`
+	TableStreetPleasantGroveChurchRd        TableStreet = `Pleasant Grove Church Rd`
+	TableStreetPleasantLn                   TableStreet = `Pleasant Ln`
+	TableStreetPleasantRunDr                TableStreet = `Pleasant Run Dr`
+	TableStreetPleasantValleyRd             TableStreet = `Pleasant Valley Rd`
+	TableStreetPlymouthCt                   TableStreet = `Plymouth Ct`
+	TableStreetPlymouthDr                   TableStreet = `Plymouth Dr`
+	TableStreetPointDr                      TableStreet = `Point Dr`
+	TableStreetPointPlaceDr                 TableStreet = `Point Place Dr`
+	TableStreetPointeNorthDr                TableStreet = `Pointe North Dr`
+	TableStreetPointeWay                    TableStreet = `Pointe Way`
+	TableStreetPoloFlds                     TableStreet = `Polo Flds`
+	TableStreetPompanoLn                    TableStreet = `Pompano Ln`
+	TableStreetPondersRd                    TableStreet = `Ponders Rd`
+	TableStreetPophamRd                     TableStreet = `Popham Rd`
+	TableStreetPoplarSpringsRd              TableStreet = `Poplar Springs Rd`
+	TableStreetPoplarSt                     TableStreet = `Poplar St`
+	TableStreetPopularDr                    TableStreet = `Popular Dr`
+	TableStreetPostOfficeCir                TableStreet = `Post Office Cir`
+	TableStreetPostelleSt                   TableStreet = `Postelle St`
+	TableStreetPowellRd                     TableStreet = `Powell Rd`
+	TableStreetPowersCt                     TableStreet = `Powers Ct`
+	TableStreetPowersportsCir               TableStreet = `Powersports Cir`
+	TableStreetPratherSt                    TableStreet = `Prather St`
+	TableStreetPrattRd                      TableStreet = `Pratt Rd`
+	TableStreetPrayerTrl                    TableStreet = `Prayer Trl`
+	TableStreetPrestwickLoop                TableStreet = `Prestwick Loop`
+	TableStreetPrincetonAve                 TableStreet = `Princeton Ave`
+	TableStreetPrincetonBlvd                TableStreet = `Princeton Blvd`
+	TableStreetPrincetonGlenCt              TableStreet = `Princeton Glen Ct`
+	TableStreetPrincetonPl                  TableStreet = `Princeton Pl`
+	TableStreetPrincetonPlaceDr             TableStreet = `Princeton Place Dr`
+	TableStreetPrincetonWalk                TableStreet = `Princeton Walk`
+	TableStreetPrioryClubDr                 TableStreet = `Priory Club Dr`
+	TableStreetPublicSq                     TableStreet = `Public Sq`
+	TableStreetPuckettRd                    TableStreet = `Puckett Rd`
+	TableStreetPuckettSt                    TableStreet = `Puckett St`
+	TableStreetPumpkinvineTrl               TableStreet = `Pumpkinvine Trl`
+	TableStreetPuritanSt                    TableStreet = `Puritan St`
+	TableStreetPyronCt                      TableStreet = `Pyron Ct`
+	TableStreetQuailCt                      TableStreet = `Quail Ct`
+	TableStreetQuailHollowDr                TableStreet = `Quail Hollow Dr`
+	TableStreetQuailRidgeDr                 TableStreet = `Quail Ridge Dr`
+	TableStreetQuailRidgeRd                 TableStreet = `Quail Ridge Rd`
+	TableStreetQuailRun                     TableStreet = `Quail Run`
+	TableStreetQualityDr                    TableStreet = `Quality Dr`
+	TableStreetREFieldsRd                   TableStreet = `R E Fields Rd`
+	TableStreetRailDr                       TableStreet = `Rail Dr`
+	TableStreetRailOvlk                     TableStreet = `Rail Ovlk`
+	TableStreetRailroadAve                  TableStreet = `Railroad Ave`
+	TableStreetRailroadPass                 TableStreet = `Railroad Pass`
+	TableStreetRailroadSt                   TableStreet = `Railroad St`
+	TableStreetRaindropLn                   TableStreet = `Raindrop Ln`
+	TableStreetRandolphRd                   TableStreet = `Randolph Rd`
+	TableStreetRandyWay                     TableStreet = `Randy Way`
+	TableStreetRanellPl                     TableStreet = `Ranell Pl`
+	TableStreetRangerRd                     TableStreet = `Ranger Rd`
+	TableStreetRattlerRd                    TableStreet = `Rattler Rd`
+	TableStreetRavenfieldRd                 TableStreet = `Ravenfield Rd`
+	TableStreetRaymondJenkinsRd             TableStreet = `Raymond Jenkins Rd`
+	TableStreetRecessRd                     TableStreet = `Recess Rd`
+	TableStreetRedAppleTer                  TableStreet = `Red Apple Ter`
+	TableStreetRedBarnRd                    TableStreet = `Red Barn Rd`
+	TableStreetRedBugPt                     TableStreet = `Red Bug Pt`
+	TableStreetRedFoxTrl                    TableStreet = `Red Fox Trl`
+	TableStreetRedOakDr                     TableStreet = `Red Oak Dr`
+	TableStreetRedTipDr                     TableStreet = `Red Tip Dr`
+	TableStreetRedTopBeachRd                TableStreet = `Red Top Beach Rd`
+	TableStreetRedTopCir                    TableStreet = `Red Top Cir`
+	TableStreetRedTopDr                     TableStreet = `Red Top Dr`
+	TableStreetRedTopMountainRd             TableStreet = `Red Top Mountain Rd`
+	TableStreetRedcombDr                    TableStreet = `Redcomb Dr`
+	TableStreetReddRd                       TableStreet = `Redd Rd`
+	TableStreetReddingRd                    TableStreet = `Redding Rd`
+	TableStreetRedfieldCt                   TableStreet = `Redfield Ct`
+	TableStreetRedigerCt                    TableStreet = `Rediger Ct`
+	TableStreetRedwoodDr                    TableStreet = `Redwood Dr`
+	TableStreetRejectRd                     TableStreet = `Reject Rd`
+	TableStreetRemingtonCt                  TableStreet = `Remington Ct`
+	TableStreetRemingtonDr                  TableStreet = `Remington Dr`
+	TableStreetRemingtonLn                  TableStreet = `Remington Ln`
+	TableStreetRetreatRdg                   TableStreet = `Retreat Rdg`
+	TableStreetRexLn                        TableStreet = `Rex Ln`
+	TableStreetReynoldsBendRd               TableStreet = `Reynolds Bend Rd`
+	TableStreetReynoldsBridgeRd             TableStreet = `Reynolds Bridge Rd`
+	TableStreetReynoldsLn                   TableStreet = `Reynolds Ln`
+	TableStreetRhondaLn                     TableStreet = `Rhonda Ln`
+	TableStreetRiceDr                       TableStreet = `Rice Dr`
+	TableStreetRichardsRd                   TableStreet = `Richards Rd`
+	TableStreetRichlandDr                   TableStreet = `Richland Dr`
+	TableStreetRiddleMillRd                 TableStreet = `Riddle Mill Rd`
+	TableStreetRidgeCrossRd                 TableStreet = `Ridge Cross Rd`
+	TableStreetRidgeRd                      TableStreet = `Ridge Rd`
+	TableStreetRidgeRowDr                   TableStreet = `Ridge Row Dr`
+	TableStreetRidgeViewDr                  TableStreet = `Ridge View Dr`
+	TableStreetRidgeWay                     TableStreet = `Ridge Way`
+	TableStreetRidgedaleRd                  TableStreet = `Ridgedale Rd`
+	TableStreetRidgelineWay                 TableStreet = `Ridgeline Way`
+	TableStreetRidgemontWay                 TableStreet = `Ridgemont Way`
+	TableStreetRidgeviewCt                  TableStreet = `Ridgeview Ct`
+	TableStreetRidgeviewDr                  TableStreet = `Ridgeview Dr`
+	TableStreetRidgeviewTrl                 TableStreet = `Ridgeview Trl`
+	TableStreetRidgewaterDr                 TableStreet = `Ridgewater Dr`
+	TableStreetRidgewayCt                   TableStreet = `Ridgeway Ct`
+	TableStreetRidgewoodDr                  TableStreet = `Ridgewood Dr`
+	TableStreetRingersRd                    TableStreet = `Ringers Rd`
+	TableStreetRipRd                        TableStreet = `Rip Rd`
+	TableStreetRipleyAve                    TableStreet = `Ripley Ave`
+	TableStreetRiverBirchCir                TableStreet = `River Birch Cir`
+	TableStreetRiverBirchCt                 TableStreet = `River Birch Ct`
+	TableStreetRiverBirchDr                 TableStreet = `River Birch Dr`
+	TableStreetRiverBirchRd                 TableStreet = `River Birch Rd`
+	TableStreetRiverCt                      TableStreet = `River Ct`
+	TableStreetRiverDr                      TableStreet = `River Dr`
+	TableStreetRiverOaksCt                  TableStreet = `River Oaks Ct`
+	TableStreetRiverOaksDr                  TableStreet = `River Oaks Dr`
+	TableStreetRiverShoalsDr                TableStreet = `River Shoals Dr`
+	TableStreetRiverWalkPkwy                TableStreet = `River Walk Pkwy`
+	TableStreetRiverbendRd                  TableStreet = `Riverbend Rd`
+	TableStreetRivercreekXing               TableStreet = `Rivercreek Xing`
+	TableStreetRiversideCt                  TableStreet = `Riverside Ct`
+	TableStreetRiversideDr                  TableStreet = `Riverside Dr`
+	TableStreetRiverviewCt                  TableStreet = `Riverview Ct`
+	TableStreetRiverviewTrl                 TableStreet = `Riverview Trl`
+	TableStreetRiverwoodCv                  TableStreet = `Riverwood Cv`
+	TableStreetRoachDr                      TableStreet = `Roach Dr`
+	TableStreetRoadNo1South                 TableStreet = `Road No 1 South`
+	TableStreetRoadNo2South                 TableStreet = `Road No 2 South`
+	TableStreetRoadNo3South                 TableStreet = `Road No 3 South`
+	TableStreetRobersonDr                   TableStreet = `Roberson Dr`
+	TableStreetRobersonRd                   TableStreet = `Roberson Rd`
+	TableStreetRobertSt                     TableStreet = `Robert St`
+	TableStreetRobertsDr                    TableStreet = `Roberts Dr`
+	TableStreetRobertsWay                   TableStreet = `Roberts Way`
+	TableStreetRobinDr                      TableStreet = `Robin Dr`
+	TableStreetRobinHoodDr                  TableStreet = `Robin Hood Dr`
+	TableStreetRobinsonLoop                 TableStreet = `Robinson Loop`
+	TableStreetRockFenceCir                 TableStreet = `Rock Fence Cir`
+	TableStreetRockFenceRd                  TableStreet = `Rock Fence Rd`
+	TableStreetRockFenceRoadNo2             TableStreet = `Rock Fence Road No 2`
+	TableStreetRockRidgeCt                  TableStreet = `Rock Ridge Ct`
+	TableStreetRockRidgeRd                  TableStreet = `Rock Ridge Rd`
+	TableStreetRockawayCt                   TableStreet = `Rockaway Ct`
+	TableStreetRockcrestCir                 TableStreet = `Rockcrest Cir`
+	TableStreetRockpoint                    TableStreet = `Rockpoint`
+	TableStreetRockridgeDr                  TableStreet = `Rockridge Dr`
+	TableStreetRockyAve                     TableStreet = `Rocky Ave`
+	TableStreetRockyCir                     TableStreet = `Rocky Cir`
+	TableStreetRockyMountainPass            TableStreet = `Rocky Mountain Pass`
+	TableStreetRockyRd                      TableStreet = `Rocky Rd`
+	TableStreetRockySt                      TableStreet = `Rocky St`
+	TableStreetRogersCutOffRd               TableStreet = `Rogers Cut Off Rd`
+	TableStreetRogersRd                     TableStreet = `Rogers Rd`
+	TableStreetRogersSt                     TableStreet = `Rogers St`
+	TableStreetRollingHillsDr               TableStreet = `Rolling Hills Dr`
+	TableStreetRonSt                        TableStreet = `Ron St`
+	TableStreetRonsonSt                     TableStreet = `Ronson St`
+	TableStreetRooseveltSt                  TableStreet = `Roosevelt St`
+	TableStreetRoseBrookCir                 TableStreet = `Rose Brook Cir`
+	TableStreetRoseTrl                      TableStreet = `Rose Trl`
+	TableStreetRoseWay                      TableStreet = `Rose Way`
+	TableStreetRoseburyCt                   TableStreet = `Rosebury Ct`
+	TableStreetRosenSt                      TableStreet = `Rosen St`
+	TableStreetRosewoodLn                   TableStreet = `Rosewood Ln`
+	TableStreetRossRd                       TableStreet = `Ross Rd`
+	TableStreetRothLn                       TableStreet = `Roth Ln`
+	TableStreetRoundtableCt                 TableStreet = `Roundtable Ct`
+	TableStreetRovingHillsCir               TableStreet = `Roving Hills Cir`
+	TableStreetRovingRd                     TableStreet = `Roving Rd`
+	TableStreetRowlandSpringsRd             TableStreet = `Rowland Springs Rd`
+	TableStreetRoxburghTrl                  TableStreet = `Roxburgh Trl`
+	TableStreetRoxyPl                       TableStreet = `Roxy Pl`
+	TableStreetRoyalLakeCt                  TableStreet = `Royal Lake Ct`
+	TableStreetRoyalLakeCv                  TableStreet = `Royal Lake Cv`
+	TableStreetRoyalLakeDr                  TableStreet = `Royal Lake Dr`
+	TableStreetRoycoDr                      TableStreet = `Royco Dr`
+	TableStreetRubieLn                      TableStreet = `Rubie Ln`
+	TableStreetRubyDabbsLn                  TableStreet = `Ruby Dabbs Ln`
+	TableStreetRubySt                       TableStreet = `Ruby St`
+	TableStreetRudyYorkRd                   TableStreet = `Rudy York Rd`
+	TableStreetRuffDr                       TableStreet = `Ruff Dr`
+	TableStreetRunningDeerTrl               TableStreet = `Running Deer Trl`
+	TableStreetRunningTerraceWay            TableStreet = `Running Terrace Way`
+	TableStreetRussellDr                    TableStreet = `Russell Dr`
+	TableStreetRussellRdg                   TableStreet = `Russell Rdg`
+	TableStreetRusslerWay                   TableStreet = `Russler Way`
+	TableStreetRyanBoulevard                TableStreet = `Ryan Boulevard`
+	TableStreetRyanRd                       TableStreet = `Ryan Rd`
+	TableStreetRylesRd                      TableStreet = `Ryles Rd`
+	TableStreetSBartowSt                    TableStreet = `S Bartow St`
+	TableStreetSCassSt                      TableStreet = `S Cass St`
+	TableStreetSCentralAve                  TableStreet = `S Central Ave`
+	TableStreetSDixieAve                    TableStreet = `S Dixie Ave`
+	TableStreetSErwinSt                     TableStreet = `S Erwin St`
+	TableStreetSFranklinSt                  TableStreet = `S Franklin St`
+	TableStreetSGilmerSt                    TableStreet = `S Gilmer St`
+	TableStreetSMainSt                      TableStreet = `S Main St`
+	TableStreetSMorningsideDr               TableStreet = `S Morningside Dr`
+	TableStreetSMuseumDr                    TableStreet = `S Museum Dr`
+	TableStreetSPublicSq                    TableStreet = `S Public Sq`
+	TableStreetSRailroadSt                  TableStreet = `S Railroad St`
+	TableStreetSTennesseeSt                 TableStreet = `S Tennessee St`
+	TableStreetSWallSt                      TableStreet = `S Wall St`
+	TableStreetSaddleClubDr                 TableStreet = `Saddle Club Dr`
+	TableStreetSaddleFieldCir               TableStreet = `Saddle Field Cir`
+	TableStreetSaddleLn                     TableStreet = `Saddle Ln`
+	TableStreetSaddleRidgeDr                TableStreet = `Saddle Ridge Dr`
+	TableStreetSaddlebrookDr                TableStreet = `Saddlebrook Dr`
+	TableStreetSaddlemanCt                  TableStreet = `Saddleman Ct`
+	TableStreetSageWay                      TableStreet = `Sage Way`
+	TableStreetSaggusRd                     TableStreet = `Saggus Rd`
+	TableStreetSailorsCv                    TableStreet = `Sailors Cv`
+	TableStreetSaintAndrewsDr               TableStreet = `Saint Andrews Dr`
+	TableStreetSaintElmoCir                 TableStreet = `Saint Elmo Cir`
+	TableStreetSaintFrancisPl               TableStreet = `Saint Francis Pl`
+	TableStreetSaintIvesWay                 TableStreet = `Saint Ives Way`
+	TableStreetSalacoaCreekRd               TableStreet = `Salacoa Creek Rd`
+	TableStreetSalacoaRd                    TableStreet = `Salacoa Rd`
+	TableStreetSamSuttonRd                  TableStreet = `Sam Sutton Rd`
+	TableStreetSamuelWay                    TableStreet = `Samuel Way`
+	TableStreetSandcliffeLn                 TableStreet = `Sandcliffe Ln`
+	TableStreetSandtownRd                   TableStreet = `Sandtown Rd`
+	TableStreetSaratogaDr                   TableStreet = `Saratoga Dr`
+	TableStreetSassafrasTrl                 TableStreet = `Sassafras Trl`
+	TableStreetSassyLn                      TableStreet = `Sassy Ln`
+	TableStreetSatcherRd                    TableStreet = `Satcher Rd`
+	TableStreetScaleHouseDr                 TableStreet = `Scale House Dr`
+	TableStreetScarlettOakDr                TableStreet = `Scarlett Oak Dr`
+	TableStreetScenicMountainDr             TableStreet = `Scenic Mountain Dr`
+	TableStreetSchoolSt                     TableStreet = `School St`
+	TableStreetScottDr                      TableStreet = `Scott Dr`
+	TableStreetScreamingEagleCt             TableStreet = `Screaming Eagle Ct`
+	TableStreetSeaHorseCir                  TableStreet = `Sea Horse Cir`
+	TableStreetSecretariatCt                TableStreet = `Secretariat Ct`
+	TableStreetSecretariatRd                TableStreet = `Secretariat Rd`
+	TableStreetSeminoleRd                   TableStreet = `Seminole Rd`
+	TableStreetSeminoleTrl                  TableStreet = `Seminole Trl`
+	TableStreetSenecaLn                     TableStreet = `Seneca Ln`
+	TableStreetSentryDr                     TableStreet = `Sentry Dr`
+	TableStreetSequoiaPl                    TableStreet = `Sequoia Pl`
+	TableStreetSequoyahCir                  TableStreet = `Sequoyah Cir`
+	TableStreetSequoyahTrl                  TableStreet = `Sequoyah Trl`
+	TableStreetSerenaSt                     TableStreet = `Serena St`
+	TableStreetServiceRoadNo3               TableStreet = `Service Road No 3`
+	TableStreetSettersPte                   TableStreet = `Setters Pte`
+	TableStreetSettlersCv                   TableStreet = `Settlers Cv`
+	TableStreetSewellRd                     TableStreet = `Sewell Rd`
+	TableStreetShadowLn                     TableStreet = `Shadow Ln`
+	TableStreetShadyOakLn                   TableStreet = `Shady Oak Ln`
+	TableStreetShadyValleyDr                TableStreet = `Shady Valley Dr`
+	TableStreetShagbarkDr                   TableStreet = `Shagbark Dr`
+	TableStreetShakeRagCir                  TableStreet = `Shake Rag Cir`
+	TableStreetShakeRagRd                   TableStreet = `Shake Rag Rd`
+	TableStreetShakerCt                     TableStreet = `Shaker Ct`
+	TableStreetShallowoodPl                 TableStreet = `Shallowood Pl`
+	TableStreetSharpWay                     TableStreet = `Sharp Way`
+	TableStreetShawBlvd                     TableStreet = `Shaw Blvd`
+	TableStreetShawSt                       TableStreet = `Shaw St`
+	TableStreetSheffieldPl                  TableStreet = `Sheffield Pl`
+	TableStreetSheilaLn                     TableStreet = `Sheila Ln`
+	TableStreetSheilaRdg                    TableStreet = `Sheila Rdg`
+	TableStreetShenandoahPkwy               TableStreet = `Shenandoah Pkwy`
+	TableStreetShermanLn                    TableStreet = `Sherman Ln`
+	TableStreetSherwoodDr                   TableStreet = `Sherwood Dr`
+	TableStreetSherwoodLn                   TableStreet = `Sherwood Ln`
+	TableStreetSherwoodWay                  TableStreet = `Sherwood Way`
+	TableStreetShilohChurchRd               TableStreet = `Shiloh Church Rd`
+	TableStreetShinallGainesRd              TableStreet = `Shinall Gaines Rd`
+	TableStreetShinallRd                    TableStreet = `Shinall Rd`
+	TableStreetShirleyLn                    TableStreet = `Shirley Ln`
+	TableStreetShotgunRd                    TableStreet = `Shotgun Rd`
+	TableStreetShropshireLn                 TableStreet = `Shropshire Ln`
+	TableStreetSignalMountainCir            TableStreet = `Signal Mountain Cir`
+	TableStreetSignalMountainDr             TableStreet = `Signal Mountain Dr`
+	TableStreetSilversmithTrl               TableStreet = `Silversmith Trl`
+	TableStreetSimmonsDr                    TableStreet = `Simmons Dr`
+	TableStreetSimpsonCir                   TableStreet = `Simpson Cir`
+	TableStreetSimpsonRd                    TableStreet = `Simpson Rd`
+	TableStreetSimpsonTrl                   TableStreet = `Simpson Trl`
+	TableStreetSingletreeRdg                TableStreet = `Singletree Rdg`
+	TableStreetSiniardDr                    TableStreet = `Siniard Dr`
+	TableStreetSiniardRd                    TableStreet = `Siniard Rd`
+	TableStreetSiouxRd                      TableStreet = `Sioux Rd`
+	TableStreetSkinnerSt                    TableStreet = `Skinner St`
+	TableStreetSkylineDr                    TableStreet = `Skyline Dr`
+	TableStreetSkyviewDr                    TableStreet = `Skyview Dr`
+	TableStreetSkyviewPte                   TableStreet = `Skyview Pte`
+	TableStreetSlopesDr                     TableStreet = `Slopes Dr`
+	TableStreetSmileyIngramRd               TableStreet = `Smiley Ingram Rd`
+	TableStreetSmithCir                     TableStreet = `Smith Cir`
+	TableStreetSmithDr                      TableStreet = `Smith Dr`
+	TableStreetSmithRd                      TableStreet = `Smith Rd`
+	TableStreetSmyrnaSt                     TableStreet = `Smyrna St`
+	TableStreetSnapperLn                    TableStreet = `Snapper Ln`
+	TableStreetSnowSpringsChurchRd          TableStreet = `Snow Springs Church Rd`
+	TableStreetSnowSpringsRd                TableStreet = `Snow Springs Rd`
+	TableStreetSnugHarborDr                 TableStreet = `Snug Harbor Dr`
+	TableStreetSoaringHeightsDr             TableStreet = `Soaring Heights Dr`
+	TableStreetSohoDr                       TableStreet = `Soho Dr`
+	TableStreetSomersetClubDr               TableStreet = `Somerset Club Dr`
+	TableStreetSomersetLn                   TableStreet = `Somerset Ln`
+	TableStreetSonyaPl                      TableStreet = `Sonya Pl`
+	TableStreetSouthAve                     TableStreet = `South Ave`
+	TableStreetSouthBridgeDr                TableStreet = `South Bridge Dr`
+	TableStreetSouthDr                      TableStreet = `South Dr`
+	TableStreetSouthOaksDr                  TableStreet = `South Oaks Dr`
+	TableStreetSouthValleyRd                TableStreet = `South Valley Rd`
+	TableStreetSouthernRd                   TableStreet = `Southern Rd`
+	TableStreetSouthridgeTrl                TableStreet = `Southridge Trl`
+	TableStreetSouthviewDr                  TableStreet = `Southview Dr`
+	TableStreetSouthwellAve                 TableStreet = `Southwell Ave`
+	TableStreetSouthwoodDr                  TableStreet = `Southwood Dr`
+	TableStreetSparksRd                     TableStreet = `Sparks Rd`
+	TableStreetSparksSt                     TableStreet = `Sparks St`
+	TableStreetSparkyTrl                    TableStreet = `Sparky Trl`
+	TableStreetSparrowCt                    TableStreet = `Sparrow Ct`
+	TableStreetSpigsSt                      TableStreet = `Spigs St`
+	TableStreetSplitOakDr                   TableStreet = `Split Oak Dr`
+	TableStreetSplitRailCt                  TableStreet = `Split Rail Ct`
+	TableStreetSpringCreekCir               TableStreet = `Spring Creek Cir`
+	TableStreetSpringFolly                  TableStreet = `Spring Folly`
+	TableStreetSpringLakeTrl                TableStreet = `Spring Lake Trl`
+	TableStreetSpringMeadowsLn              TableStreet = `Spring Meadows Ln`
+	TableStreetSpringPlaceRd                TableStreet = `Spring Place Rd`
+	TableStreetSpringSt                     TableStreet = `Spring St`
+	TableStreetSpringhouseCt                TableStreet = `Springhouse Ct`
+	TableStreetSpringmontDr                 TableStreet = `Springmont Dr`
+	TableStreetSpringwellLn                 TableStreet = `Springwell Ln`
+	TableStreetSpruceLn                     TableStreet = `Spruce Ln`
+	TableStreetSpruceSt                     TableStreet = `Spruce St`
+	TableStreetSquiresCt                    TableStreet = `Squires Ct`
+	TableStreetSR113                        TableStreet = `SR 113`
+	TableStreetSR140                        TableStreet = `SR 140`
+	TableStreetSR20                         TableStreet = `SR 20`
+	TableStreetSR294                        TableStreet = `SR 294`
+	TableStreetSR61                         TableStreet = `SR 61`
+	TableStreetStampCreekRd                 TableStreet = `Stamp Creek Rd`
+	TableStreetStampedePass                 TableStreet = `Stampede Pass`
+	TableStreetStandardCt                   TableStreet = `Standard Ct`
+	TableStreetStarDustTrl                  TableStreet = `Star Dust Trl`
+	TableStreetStarlightDr                  TableStreet = `Starlight Dr`
+	TableStreetStarnesRd                    TableStreet = `Starnes Rd`
+	TableStreetStartingGateDr               TableStreet = `Starting Gate Dr`
+	TableStreetStatelyOaksDr                TableStreet = `Stately Oaks Dr`
+	TableStreetStationCt                    TableStreet = `Station Ct`
+	TableStreetStationRailDr                TableStreet = `Station Rail Dr`
+	TableStreetStationWay                   TableStreet = `Station Way`
+	TableStreetStatonPl                     TableStreet = `Staton Pl`
+	TableStreetSteeplechaseLn               TableStreet = `Steeplechase Ln`
+	TableStreetStephenWay                   TableStreet = `Stephen Way`
+	TableStreetStephensRd                   TableStreet = `Stephens Rd`
+	TableStreetStephensSt                   TableStreet = `Stephens St`
+	TableStreetSterlingCt                   TableStreet = `Sterling Ct`
+	TableStreetStewartDr                    TableStreet = `Stewart Dr`
+	TableStreetStewartLn                    TableStreet = `Stewart Ln`
+	TableStreetStilesCt                     TableStreet = `Stiles Ct`
+	TableStreetStilesFairway                TableStreet = `Stiles Fairway`
+	TableStreetStilesRd                     TableStreet = `Stiles Rd`
+	TableStreetStillmontWay                 TableStreet = `Stillmont Way`
+	TableStreetStillwaterCt                 TableStreet = `Stillwater Ct`
+	TableStreetStokerRd                     TableStreet = `Stoker Rd`
+	TableStreetStokleySt                    TableStreet = `Stokley St`
+	TableStreetStoneGateDr                  TableStreet = `Stone Gate Dr`
+	TableStreetStoneMillDr                  TableStreet = `Stone Mill Dr`
+	TableStreetStonebridgeCt                TableStreet = `Stonebridge Ct`
+	TableStreetStonebrookDr                 TableStreet = `Stonebrook Dr`
+	TableStreetStonecreekDr                 TableStreet = `Stonecreek Dr`
+	TableStreetStonehavenCir                TableStreet = `Stonehaven Cir`
+	TableStreetStonehengeCt                 TableStreet = `Stonehenge Ct`
+	TableStreetStonerRd                     TableStreet = `Stoner Rd`
+	TableStreetStoneridgePl                 TableStreet = `Stoneridge Pl`
+	TableStreetStonersChapelRd              TableStreet = `Stoners Chapel Rd`
+	TableStreetStonewallLn                  TableStreet = `Stonewall Ln`
+	TableStreetStonewallSt                  TableStreet = `Stonewall St`
+	TableStreetStoneybrookCt                TableStreet = `Stoneybrook Ct`
+	TableStreetStratfordLn                  TableStreet = `Stratford Ln`
+	TableStreetStratfordWay                 TableStreet = `Stratford Way`
+	TableStreetStriplinCv                   TableStreet = `Striplin Cv`
+	TableStreetSueUPath                     TableStreet = `Sue U Path`
+	TableStreetSugarHillRd                  TableStreet = `Sugar Hill Rd`
+	TableStreetSugarMillDr                  TableStreet = `Sugar Mill Dr`
+	TableStreetSugarValleyRd                TableStreet = `Sugar Valley Rd`
+	TableStreetSugarberryPl                 TableStreet = `Sugarberry Pl`
+	TableStreetSukkauDr                     TableStreet = `Sukkau Dr`
+	TableStreetSullinsRd                    TableStreet = `Sullins Rd`
+	TableStreetSummerDr                     TableStreet = `Summer Dr`
+	TableStreetSummerPl                     TableStreet = `Summer Pl`
+	TableStreetSummerSt                     TableStreet = `Summer St`
+	TableStreetSummersetCt                  TableStreet = `Summerset Ct`
+	TableStreetSummitRidgeCir               TableStreet = `Summit Ridge Cir`
+	TableStreetSummitRidgeDr                TableStreet = `Summit Ridge Dr`
+	TableStreetSummitSt                     TableStreet = `Summit St`
+	TableStreetSumnerLn                     TableStreet = `Sumner Ln`
+	TableStreetSunriseDr                    TableStreet = `Sunrise Dr`
+	TableStreetSunsetCir                    TableStreet = `Sunset Cir`
+	TableStreetSunsetDr                     TableStreet = `Sunset Dr`
+	TableStreetSunsetRd                     TableStreet = `Sunset Rd`
+	TableStreetSunsetTer                    TableStreet = `Sunset Ter`
+	TableStreetSurreyLn                     TableStreet = `Surrey Ln`
+	TableStreetSuttonRd                     TableStreet = `Sutton Rd`
+	TableStreetSuttonTrl                    TableStreet = `Sutton Trl`
+	TableStreetSwaffordRd                   TableStreet = `Swafford Rd`
+	TableStreetSwallowDr                    TableStreet = `Swallow Dr`
+	TableStreetSwanCir                      TableStreet = `Swan Cir`
+	TableStreetSweetEloiseLn                TableStreet = `Sweet Eloise Ln`
+	TableStreetSweetGracieHoll              TableStreet = `Sweet Gracie Holl`
+	TableStreetSweetGumLn                   TableStreet = `Sweet Gum Ln`
+	TableStreetSweetWaterCt                 TableStreet = `Sweet Water Ct`
+	TableStreetSweetbriarCir                TableStreet = `Sweetbriar Cir`
+	TableStreetSwisherDr                    TableStreet = `Swisher Dr`
+	TableStreetSybleCv                      TableStreet = `Syble Cv`
+	TableStreetTabernacleSt                 TableStreet = `Tabernacle St`
+	TableStreetTaffRd                       TableStreet = `Taff Rd`
+	TableStreetTalismanDr                   TableStreet = `Talisman Dr`
+	TableStreetTallOaksLn                   TableStreet = `Tall Oaks Ln`
+	TableStreetTanagerLn                    TableStreet = `Tanager Ln`
+	TableStreetTanchezDr                    TableStreet = `Tanchez Dr`
+	TableStreetTanglewoodDr                 TableStreet = `Tanglewood Dr`
+	TableStreetTankHillSt                   TableStreet = `Tank Hill St`
+	TableStreetTantRd                       TableStreet = `Tant Rd`
+	TableStreetTanyardCreekRd               TableStreet = `Tanyard Creek Rd`
+	TableStreetTanyardRd                    TableStreet = `Tanyard Rd`
+	TableStreetTaraWay                      TableStreet = `Tara Way`
+	TableStreetTarponTrl                    TableStreet = `Tarpon Trl`
+	TableStreetTashaTrl                     TableStreet = `Tasha Trl`
+	TableStreetTatumRidgeRd                 TableStreet = `Tatum Ridge Rd`
+	TableStreetTaylorDr                     TableStreet = `Taylor Dr`
+	TableStreetTaylorLn                     TableStreet = `Taylor Ln`
+	TableStreetTaylorsvilleMacedoniaRd      TableStreet = `Taylorsville Macedonia Rd`
+	TableStreetTaylorsvilleRd               TableStreet = `Taylorsville Rd`
+	TableStreetTeagueRd                     TableStreet = `Teague Rd`
+	TableStreetTealCt                       TableStreet = `Teal Ct`
+	TableStreetTellusDr                     TableStreet = `Tellus Dr`
+	TableStreetTennesseeAve                 TableStreet = `Tennessee Ave`
+	TableStreetTerrellDr                    TableStreet = `Terrell Dr`
+	TableStreetTerryLn                      TableStreet = `Terry Ln`
+	TableStreetThatchCt                     TableStreet = `Thatch Ct`
+	TableStreetTheaterAve                   TableStreet = `Theater Ave`
+	TableStreetThirdArmyRd                  TableStreet = `Third Army Rd`
+	TableStreetThistleStop                  TableStreet = `Thistle Stop`
+	TableStreetThomasCt                     TableStreet = `Thomas Ct`
+	TableStreetThompsonSt                   TableStreet = `Thompson St`
+	TableStreetThornwoodDr                  TableStreet = `Thornwood Dr`
+	TableStreetThoroughbredLn               TableStreet = `Thoroughbred Ln`
+	TableStreetThrasherCt                   TableStreet = `Thrasher Ct`
+	TableStreetThrasherRd                   TableStreet = `Thrasher Rd`
+	TableStreetThunderhawkLn                TableStreet = `Thunderhawk Ln`
+	TableStreetTidwellRd                    TableStreet = `Tidwell Rd`
+	TableStreetTillberryCt                  TableStreet = `Tillberry Ct`
+	TableStreetTilltonTrl                   TableStreet = `Tillton Trl`
+	TableStreetTimberRidgeDr                TableStreet = `Timber Ridge Dr`
+	TableStreetTimberTrl                    TableStreet = `Timber Trl`
+	TableStreetTimberlakeCv                 TableStreet = `Timberlake Cv`
+	TableStreetTimberlakePte                TableStreet = `Timberlake Pte`
+	TableStreetTimberlandCir                TableStreet = `Timberland Cir`
+	TableStreetTimberlandCt                 TableStreet = `Timberland Ct`
+	TableStreetTimberwalkCt                 TableStreet = `Timberwalk Ct`
+	TableStreetTimberwoodKnol               TableStreet = `Timberwood Knol`
+	TableStreetTimberwoodRd                 TableStreet = `Timberwood Rd`
+	TableStreetTinsleyDr                    TableStreet = `Tinsley Dr`
+	TableStreetToddRd                       TableStreet = `Todd Rd`
+	TableStreetTomJonesRd                   TableStreet = `Tom Jones Rd`
+	TableStreetTomahawkDr                   TableStreet = `Tomahawk Dr`
+	TableStreetTopRidgeCt                   TableStreet = `Top Ridge Ct`
+	TableStreetTopridgeDr                   TableStreet = `Topridge Dr`
+	TableStreetToriLn                       TableStreet = `Tori Ln`
+	TableStreetToweChapelRd                 TableStreet = `Towe Chapel Rd`
+	TableStreetTowerDr                      TableStreet = `Tower Dr`
+	TableStreetTowerRidgeRd                 TableStreet = `Tower Ridge Rd`
+	TableStreetTowerSt                      TableStreet = `Tower St`
+	TableStreetTownAndCountryDr             TableStreet = `Town And Country Dr`
+	TableStreetTownsendDr                   TableStreet = `Townsend Dr`
+	TableStreetTownsendTeagueRd             TableStreet = `Townsend Teague Rd`
+	TableStreetTownsleyDr                   TableStreet = `Townsley Dr`
+	TableStreetTracyLn                      TableStreet = `Tracy Ln`
+	TableStreetTramoreCt                    TableStreet = `Tramore Ct`
+	TableStreetTrappersCv                   TableStreet = `Trappers Cv`
+	TableStreetTravelersPath                TableStreet = `Travelers Path`
+	TableStreetTreemontDr                   TableStreet = `Treemont Dr`
+	TableStreetTriangleLn                   TableStreet = `Triangle Ln`
+	TableStreetTrimbleHollowRd              TableStreet = `Trimble Hollow Rd`
+	TableStreetTrottersWalk                 TableStreet = `Trotters Walk`
+	TableStreetTubaDr                       TableStreet = `Tuba Dr`
+	TableStreetTumlinSt                     TableStreet = `Tumlin St`
+	TableStreetTupeloDr                     TableStreet = `Tupelo Dr`
+	TableStreetTurnberryCt                  TableStreet = `Turnberry Ct`
+	TableStreetTurnerDr                     TableStreet = `Turner Dr`
+	TableStreetTurnerSt                     TableStreet = `Turner St`
+	TableStreetTurtleRockCt                 TableStreet = `Turtle Rock Ct`
+	TableStreetTwelveOaksDr                 TableStreet = `Twelve Oaks Dr`
+	TableStreetTwinBranchesLn               TableStreet = `Twin Branches Ln`
+	TableStreetTwinBridgesRd                TableStreet = `Twin Bridges Rd`
+	TableStreetTwinOaksLn                   TableStreet = `Twin Oaks Ln`
+	TableStreetTwinPinesRd                  TableStreet = `Twin Pines Rd`
+	TableStreetTwinleafCt                   TableStreet = `Twinleaf Ct`
+	TableStreetTwoGunBaileyRd               TableStreet = `Two Gun Bailey Rd`
+	TableStreetTwoRunCreekRd                TableStreet = `Two Run Creek Rd`
+	TableStreetTwoRunCreekTrl               TableStreet = `Two Run Creek Trl`
+	TableStreetTwoRunTrc                    TableStreet = `Two Run Trc`
+	TableStreetTwoRunXing                   TableStreet = `Two Run Xing`
+	TableStreetUnbridledRd                  TableStreet = `Unbridled Rd`
+	TableStreetValHollowRidgeRd             TableStreet = `Val Hollow Ridge Rd`
+	TableStreetValleyCreekDr                TableStreet = `Valley Creek Dr`
+	TableStreetValleyDaleDr                 TableStreet = `Valley Dale Dr`
+	TableStreetValleyDr                     TableStreet = `Valley Dr`
+	TableStreetValleyTrl                    TableStreet = `Valley Trl`
+	TableStreetValleyViewCt                 TableStreet = `Valley View Ct`
+	TableStreetValleyViewDr                 TableStreet = `Valley View Dr`
+	TableStreetValleyViewFarmRd             TableStreet = `Valley View Farm Rd`
+	TableStreetVaughanCt                    TableStreet = `Vaughan Ct`
+	TableStreetVaughanDr                    TableStreet = `Vaughan Dr`
+	TableStreetVaughnDairyRd                TableStreet = `Vaughn Dairy Rd`
+	TableStreetVaughnRd                     TableStreet = `Vaughn Rd`
+	TableStreetVaughnSpur                   TableStreet = `Vaughn Spur`
+	TableStreetVeachLoop                    TableStreet = `Veach Loop`
+	TableStreetVermontAve                   TableStreet = `Vermont Ave`
+	TableStreetVictoriaDr                   TableStreet = `Victoria Dr`
+	TableStreetVigilantSt                   TableStreet = `Vigilant St`
+	TableStreetVillageDr                    TableStreet = `Village Dr`
+	TableStreetVillageTrc                   TableStreet = `Village Trc`
+	TableStreetVineyardMountainRd           TableStreet = `Vineyard Mountain Rd`
+	TableStreetVineyardRd                   TableStreet = `Vineyard Rd`
+	TableStreetVineyardWay                  TableStreet = `Vineyard Way`
+	TableStreetVinningsLn                   TableStreet = `Vinnings Ln`
+	TableStreetVintageCt                    TableStreet = `Vintage Ct`
+	TableStreetVintagewoodCv                TableStreet = `Vintagewood Cv`
+	TableStreetVirginiaAve                  TableStreet = `Virginia Ave`
+	TableStreetVistaCt                      TableStreet = `Vista Ct`
+	TableStreetVistaWoodsDr                 TableStreet = `Vista Woods Dr`
+	TableStreetVulcanQuarryRd               TableStreet = `Vulcan Quarry Rd`
+	TableStreetWCarterSt                    TableStreet = `W Carter St`
+	TableStreetWCherokeeAve                 TableStreet = `W Cherokee Ave`
+	TableStreetWChurchSt                    TableStreet = `W Church St`
+	TableStreetWFeltonRd                    TableStreet = `W Felton Rd`
+	TableStreetWGeorgeSt                    TableStreet = `W George St`
+	TableStreetWGeorgiaAve                  TableStreet = `W Georgia Ave`
+	TableStreetWHolidayCt                   TableStreet = `W Holiday Ct`
+	TableStreetWHowardSt                    TableStreet = `W Howard St`
+	TableStreetWIndianaAve                  TableStreet = `W Indiana Ave`
+	TableStreetWIronBeltRd                  TableStreet = `W Iron Belt Rd`
+	TableStreetWLeeSt                       TableStreet = `W Lee St`
+	TableStreetWMainSt                      TableStreet = `W Main St`
+	TableStreetWOakGroveRd                  TableStreet = `W Oak Grove Rd`
+	TableStreetWPorterSt                    TableStreet = `W Porter St`
+	TableStreetWRailroadSt                  TableStreet = `W Railroad St`
+	TableStreetWRockySt                     TableStreet = `W Rocky St`
+	TableStreetWadeChapelRd                 TableStreet = `Wade Chapel Rd`
+	TableStreetWadeRd                       TableStreet = `Wade Rd`
+	TableStreetWagonwheelDr                 TableStreet = `Wagonwheel Dr`
+	TableStreetWaldenXing                   TableStreet = `Walden Xing`
+	TableStreetWalkerHillsCir               TableStreet = `Walker Hills Cir`
+	TableStreetWalkerRd                     TableStreet = `Walker Rd`
+	TableStreetWalkerSt                     TableStreet = `Walker St`
+	TableStreetWalnutDr                     TableStreet = `Walnut Dr`
+	TableStreetWalnutGroveRd                TableStreet = `Walnut Grove Rd`
+	TableStreetWalnutLn                     TableStreet = `Walnut Ln`
+	TableStreetWalnutTrl                    TableStreet = `Walnut Trl`
+	TableStreetWaltWay                      TableStreet = `Walt Way`
+	TableStreetWaltonSt                     TableStreet = `Walton St`
+	TableStreetWandaDr                      TableStreet = `Wanda Dr`
+	TableStreetWansleyDr                    TableStreet = `Wansley Dr`
+	TableStreetWardCir                      TableStreet = `Ward Cir`
+	TableStreetWardMountainRd               TableStreet = `Ward Mountain Rd`
+	TableStreetWardMountainTrl              TableStreet = `Ward Mountain Trl`
+	TableStreetWarrenCv                     TableStreet = `Warren Cv`
+	TableStreetWashakieLn                   TableStreet = `Washakie Ln`
+	TableStreetWaterTowerRd                 TableStreet = `Water Tower Rd`
+	TableStreetWaterfordDr                  TableStreet = `Waterford Dr`
+	TableStreetWaterfrontDr                 TableStreet = `Waterfront Dr`
+	TableStreetWatersEdgeDr                 TableStreet = `Waters Edge Dr`
+	TableStreetWatersView                   TableStreet = `Waters View`
+	TableStreetWatersideDr                  TableStreet = `Waterside Dr`
+	TableStreetWaterstoneCt                 TableStreet = `Waterstone Ct`
+	TableStreetWaterstoneDr                 TableStreet = `Waterstone Dr`
+	TableStreetWattersRd                    TableStreet = `Watters Rd`
+	TableStreetWaylandCir                   TableStreet = `Wayland Cir`
+	TableStreetWayneAve                     TableStreet = `Wayne Ave`
+	TableStreetWayneDr                      TableStreet = `Wayne Dr`
+	TableStreetWaysideDr                    TableStreet = `Wayside Dr`
+	TableStreetWaysideRd                    TableStreet = `Wayside Rd`
+	TableStreetWeaverSt                     TableStreet = `Weaver St`
+	TableStreetWebbDr                       TableStreet = `Webb Dr`
+	TableStreetWebstersFerryLndg            TableStreet = `Websters Ferry Lndg`
+	TableStreetWebstersOverlookDr           TableStreet = `Websters Overlook Dr`
+	TableStreetWebstersOverlookLndg         TableStreet = `Websters Overlook Lndg`
+	TableStreetWeemsDr                      TableStreet = `Weems Dr`
+	TableStreetWeemsRd                      TableStreet = `Weems Rd`
+	TableStreetWeemsSpur                    TableStreet = `Weems Spur`
+	TableStreetWeepingWillowLn              TableStreet = `Weeping Willow Ln`
+	TableStreetWeissingerRd                 TableStreet = `Weissinger Rd`
+	TableStreetWellingtonDr                 TableStreet = `Wellington Dr`
+	TableStreetWellonsRd                    TableStreet = `Wellons Rd`
+	TableStreetWellsDr                      TableStreet = `Wells Dr`
+	TableStreetWellsSt                      TableStreet = `Wells St`
+	TableStreetWentercressDr                TableStreet = `Wentercress Dr`
+	TableStreetWesleyDrewLn                 TableStreet = `Wesley Drew Ln`
+	TableStreetWesleyMillDr                 TableStreet = `Wesley Mill Dr`
+	TableStreetWesleyRd                     TableStreet = `Wesley Rd`
+	TableStreetWesleyTrc                    TableStreet = `Wesley Trc`
+	TableStreetWessingtonPl                 TableStreet = `Wessington Pl`
+	TableStreetWestAve                      TableStreet = `West Ave`
+	TableStreetWestDr                       TableStreet = `West Dr`
+	TableStreetWestLn                       TableStreet = `West Ln`
+	TableStreetWestOakDr                    TableStreet = `West Oak Dr`
+	TableStreetWestRidgeCt                  TableStreet = `West Ridge Ct`
+	TableStreetWestRidgeDr                  TableStreet = `West Ridge Dr`
+	TableStreetWestchesterDr                TableStreet = `Westchester Dr`
+	TableStreetWestfieldPark                TableStreet = `Westfield Park`
+	TableStreetWestgateDr                   TableStreet = `Westgate Dr`
+	TableStreetWestminsterDr                TableStreet = `Westminster Dr`
+	TableStreetWestoverDr                   TableStreet = `Westover Dr`
+	TableStreetWestoverRdg                  TableStreet = `Westover Rdg`
+	TableStreetWestsideChas                 TableStreet = `Westside Chas`
+	TableStreetWestviewDr                   TableStreet = `Westview Dr`
+	TableStreetWestwoodCir                  TableStreet = `Westwood Cir`
+	TableStreetWestwoodDr                   TableStreet = `Westwood Dr`
+	TableStreetWestwoodLn                   TableStreet = `Westwood Ln`
+	TableStreetWetlandsRd                   TableStreet = `Wetlands Rd`
+	TableStreetWexfordCir                   TableStreet = `Wexford Cir`
+	TableStreetWeyBridgeCt                  TableStreet = `Wey Bridge Ct`
+	TableStreetWheelerRd                    TableStreet = `Wheeler Rd`
+	TableStreetWhipporwillCt                TableStreet = `Whipporwill Ct`
+	TableStreetWhipporwillLn                TableStreet = `Whipporwill Ln`
+	TableStreetWhisperingPineCir            TableStreet = `Whispering Pine Cir`
+	TableStreetWhisperingPineLn             TableStreet = `Whispering Pine Ln`
+	TableStreetWhistleStopDr                TableStreet = `Whistle Stop Dr`
+	TableStreetWhiteOakDr                   TableStreet = `White Oak Dr`
+	TableStreetWhiteRd                      TableStreet = `White Rd`
+	TableStreetWidgeonWay                   TableStreet = `Widgeon Way`
+	TableStreetWildFlowerTrl                TableStreet = `Wild Flower Trl`
+	TableStreetWildberryPath                TableStreet = `Wildberry Path`
+	TableStreetWildernessCampRd             TableStreet = `Wilderness Camp Rd`
+	TableStreetWildwoodDr                   TableStreet = `Wildwood Dr`
+	TableStreetWilkeySt                     TableStreet = `Wilkey St`
+	TableStreetWilkinsRd                    TableStreet = `Wilkins Rd`
+	TableStreetWilliamDr                    TableStreet = `William Dr`
+	TableStreetWilliamsCreekTrl             TableStreet = `Williams Creek Trl`
+	TableStreetWilliamsRd                   TableStreet = `Williams Rd`
+	TableStreetWilliamsSpurRd               TableStreet = `Williams Spur Rd`
+	TableStreetWilliamsSt                   TableStreet = `Williams St`
+	TableStreetWillisRd                     TableStreet = `Willis Rd`
+	TableStreetWillowBendDr                 TableStreet = `Willow Bend Dr`
+	TableStreetWillowCir                    TableStreet = `Willow Cir`
+	TableStreetWillowCt                     TableStreet = `Willow Ct`
+	TableStreetWillowLn                     TableStreet = `Willow Ln`
+	TableStreetWillowTrc                    TableStreet = `Willow Trc`
+	TableStreetWillowWoodLn                 TableStreet = `Willow Wood Ln`
+	TableStreetWillowbrookCt                TableStreet = `Willowbrook Ct`
+	TableStreetWilmerWay                    TableStreet = `Wilmer Way`
+	TableStreetWilsonSt                     TableStreet = `Wilson St`
+	TableStreetWinchesterAve                TableStreet = `Winchester Ave`
+	TableStreetWinchesterDr                 TableStreet = `Winchester Dr`
+	TableStreetWindcliffCt                  TableStreet = `Windcliff Ct`
+	TableStreetWindermereBend               TableStreet = `Windermere Bend`
+	TableStreetWindfieldDr                  TableStreet = `Windfield Dr`
+	TableStreetWindingBranchTrc             TableStreet = `Winding Branch Trc`
+	TableStreetWindingWaterCv               TableStreet = `Winding Water Cv`
+	TableStreetWindrushDr                   TableStreet = `Windrush Dr`
+	TableStreetWindsorCt                    TableStreet = `Windsor Ct`
+	TableStreetWindsorTrc                   TableStreet = `Windsor Trc`
+	TableStreetWindyHillRd                  TableStreet = `Windy Hill Rd`
+	TableStreetWingfootCt                   TableStreet = `Wingfoot Ct`
+	TableStreetWingfootTrl                  TableStreet = `Wingfoot Trl`
+	TableStreetWinnieFrankRd                TableStreet = `Winnie Frank Rd`
+	TableStreetWinterWoodCt                 TableStreet = `Winter Wood Ct`
+	TableStreetWinterWoodCv                 TableStreet = `Winter Wood Cv`
+	TableStreetWinterWoodDr                 TableStreet = `Winter Wood Dr`
+	TableStreetWinterWoodTrc                TableStreet = `Winter Wood Trc`
+	TableStreetWinterWoodTrl                TableStreet = `Winter Wood Trl`
+	TableStreetWinterWoodWay                TableStreet = `Winter Wood Way`
+	TableStreetWintersetDr                  TableStreet = `Winterset Dr`
+	TableStreetWisemanRd                    TableStreet = `Wiseman Rd`
+	TableStreetWisteriaTrl                  TableStreet = `Wisteria Trl`
+	TableStreetWoffordSt                    TableStreet = `Wofford St`
+	TableStreetWolfcliffRd                  TableStreet = `Wolfcliff Rd`
+	TableStreetWolfpenPass                  TableStreet = `Wolfpen Pass`
+	TableStreetWolfridgeTrl                 TableStreet = `Wolfridge Trl`
+	TableStreetWomackDr                     TableStreet = `Womack Dr`
+	TableStreetWoodCir                      TableStreet = `Wood Cir`
+	TableStreetWoodForestDr                 TableStreet = `Wood Forest Dr`
+	TableStreetWoodLn                       TableStreet = `Wood Ln`
+	TableStreetWoodRd                       TableStreet = `Wood Rd`
+	TableStreetWoodSt                       TableStreet = `Wood St`
+	TableStreetWoodallRd                    TableStreet = `Woodall Rd`
+	TableStreetWoodbineDr                   TableStreet = `Woodbine Dr`
+	TableStreetWoodbridgeDr                 TableStreet = `Woodbridge Dr`
+	TableStreetWoodcrestDr                  TableStreet = `Woodcrest Dr`
+	TableStreetWoodcrestRd                  TableStreet = `Woodcrest Rd`
+	TableStreetWooddaleDr                   TableStreet = `Wooddale Dr`
+	TableStreetWoodhavenCt                  TableStreet = `Woodhaven Ct`
+	TableStreetWoodhavenDr                  TableStreet = `Woodhaven Dr`
+	TableStreetWoodlandBridgeRd             TableStreet = `Woodland Bridge Rd`
+	TableStreetWoodlandDr                   TableStreet = `Woodland Dr`
+	TableStreetWoodlandRd                   TableStreet = `Woodland Rd`
+	TableStreetWoodlandWay                  TableStreet = `Woodland Way`
+	TableStreetWoodlandsWay                 TableStreet = `Woodlands Way`
+	TableStreetWoodsongCt                   TableStreet = `Woodsong Ct`
+	TableStreetWoodviewDr                   TableStreet = `Woodview Dr`
+	TableStreetWoodvineCt                   TableStreet = `Woodvine Ct`
+	TableStreetWoodvineDr                   TableStreet = `Woodvine Dr`
+	TableStreetWoodyRd                      TableStreet = `Woody Rd`
+	TableStreetWooleysRd                    TableStreet = `Wooleys Rd`
+	TableStreetWorthingtonRd                TableStreet = `Worthington Rd`
+	TableStreetWrenCt                       TableStreet = `Wren Ct`
+	TableStreetWykleSt                      TableStreet = `Wykle St`
+	TableStreetWynnLoop                     TableStreet = `Wynn Loop`
+	TableStreetWynnRd                       TableStreet = `Wynn Rd`
+	TableStreetYachtClubRd                  TableStreet = `Yacht Club Rd`
+	TableStreetYellowBrickRd                TableStreet = `Yellow Brick Rd`
+	TableStreetYellowHammerDr               TableStreet = `Yellow Hammer Dr`
+	TableStreetYonahCt                      TableStreet = `Yonah Ct`
+	TableStreetYorkTrl                      TableStreet = `York Trl`
+	TableStreetYoungDeerTrl                 TableStreet = `Young Deer Trl`
+	TableStreetYoungLoop                    TableStreet = `Young Loop`
+	TableStreetYoungRd                      TableStreet = `Young Rd`
+	TableStreetYoungSt                      TableStreet = `Young St`
+	TableStreetYoungsMillRd                 TableStreet = `Youngs Mill Rd`
+	TableStreetZenaDr                       TableStreet = `Zena Dr`
+	TableStreetZionRd                       TableStreet = `Zion Rd`
+)
+
+// Description of the value, or empty if the value is not in table Street.
+func (v TableStreet) Description() string {
+	return tableDescription(`Street`, string(v))
+}
+
+// Valid reports if the value is in table Street.
+func (v TableStreet) Valid() bool {
+	return TableValueLookup[`Street`][string(v)]
+}
+
+// Table Street.
+func (v TableStreet) Table() Table {
+	return TableLookup[`Street`]
+}
+
+// TableZipCode is a value of table ZipCode, Zip Code.
+// Fields in this table are strings; convert a field, such as TableZipCode(v), to use the helpers.
+type TableZipCode string
+
+const (
+	TableZipCode01770 TableZipCode = `01770`
+	TableZipCode02030 TableZipCode = `02030`
+	TableZipCode02108 TableZipCode = `02108`
+	TableZipCode02109 TableZipCode = `02109`
+	TableZipCode02110 TableZipCode = `02110`
+	TableZipCode02199 TableZipCode = `02199`
+	TableZipCode02481 TableZipCode = `02481`
+	TableZipCode02493 TableZipCode = `02493`
+	TableZipCode06820 TableZipCode = `06820`
+	TableZipCode06830 TableZipCode = `06830`
+	TableZipCode06831 TableZipCode = `06831`
+	TableZipCode06840 TableZipCode = `06840`
+	TableZipCode06853 TableZipCode = `06853`
+	TableZipCode06870 TableZipCode = `06870`
+	TableZipCode06878 TableZipCode = `06878`
+	TableZipCode06880 TableZipCode = `06880`
+	TableZipCode06883 TableZipCode = `06883`
+	TableZipCode07021 TableZipCode = `07021`
+	TableZipCode07046 TableZipCode = `07046`
+	TableZipCode07078 TableZipCode = `07078`
+	TableZipCode07417 TableZipCode = `07417`
+	TableZipCode07458 TableZipCode = `07458`
+	TableZipCode07620 TableZipCode = `07620`
+	TableZipCode07760 TableZipCode = `07760`
+	TableZipCode07924 TableZipCode = `07924`
+	TableZipCode07931 TableZipCode = `07931`
+	TableZipCode07945 TableZipCode = `07945`
+	TableZipCode10004 TableZipCode = `10004`
+	TableZipCode10017 TableZipCode = `10017`
+	TableZipCode10018 TableZipCode = `10018`
+	TableZipCode10021 TableZipCode = `10021`
+	TableZipCode10022 TableZipCode = `10022`
+	TableZipCode10028 TableZipCode = `10028`
+	TableZipCode10069 TableZipCode = `10069`
+	TableZipCode10504 TableZipCode = `10504`
+	TableZipCode10506 TableZipCode = `10506`
+	TableZipCode10514 TableZipCode = `10514`
+	TableZipCode10538 TableZipCode = `10538`
+	TableZipCode10576 TableZipCode = `10576`
+	TableZipCode10577 TableZipCode = `10577`
+	TableZipCode10580 TableZipCode = `10580`
+	TableZipCode10583 TableZipCode = `10583`
+	TableZipCode11024 TableZipCode = `11024`
+	TableZipCode11030 TableZipCode = `11030`
+	TableZipCode11568 TableZipCode = `11568`
+	TableZipCode11724 TableZipCode = `11724`
+	TableZipCode19035 TableZipCode = `19035`
+	TableZipCode19041 TableZipCode = `19041`
+	TableZipCode19085 TableZipCode = `19085`
+	TableZipCode19807 TableZipCode = `19807`
+	TableZipCode20198 TableZipCode = `20198`
+	TableZipCode20854 TableZipCode = `20854`
+	TableZipCode22066 TableZipCode = `22066`
+	TableZipCode23219 TableZipCode = `23219`
+	TableZipCode28207 TableZipCode = `28207`
+	TableZipCode30326 TableZipCode = `30326`
+	TableZipCode32963 TableZipCode = `32963`
+	TableZipCode33480 TableZipCode = `33480`
+	TableZipCode33786 TableZipCode = `33786`
+	TableZipCode33921 TableZipCode = `33921`
+	TableZipCode34102 TableZipCode = `34102`
+	TableZipCode34108 TableZipCode = `34108`
+	TableZipCode34228 TableZipCode = `34228`
+	TableZipCode60022 TableZipCode = `60022`
+	TableZipCode60043 TableZipCode = `60043`
+	TableZipCode60045 TableZipCode = `60045`
+	TableZipCode60093 TableZipCode = `60093`
+	TableZipCode60521 TableZipCode = `60521`
+	TableZipCode60606 TableZipCode = `60606`
+	TableZipCode60611 TableZipCode = `60611`
+	TableZipCode63124 TableZipCode = `63124`
+	TableZipCode74103 TableZipCode = `74103`
+	TableZipCode75205 TableZipCode = `75205`
+	TableZipCode75225 TableZipCode = `75225`
+	TableZipCode76102 TableZipCode = `76102`
+	TableZipCode77002 TableZipCode = `77002`
+	TableZipCode77024 TableZipCode = `77024`
+	TableZipCode78257 TableZipCode = `78257`
+	TableZipCode83014 TableZipCode = `83014`
+	TableZipCode85253 TableZipCode = `85253`
+	TableZipCode89451 TableZipCode = `89451`
+	TableZipCode90067 TableZipCode = `90067`
+	TableZipCode90077 TableZipCode = `90077`
+	TableZipCode90210 TableZipCode = `90210`
+	TableZipCode90212 TableZipCode = `90212`
+	TableZipCode90272 TableZipCode = `90272`
+	TableZipCode90402 TableZipCode = `90402`
+	TableZipCode91436 TableZipCode = `91436`
+	TableZipCode92067 TableZipCode = `92067`
+	TableZipCode92091 TableZipCode = `92091`
+	TableZipCode92657 TableZipCode = `92657`
+	TableZipCode93108 TableZipCode = `93108`
+	TableZipCode94022 TableZipCode = `94022`
+	TableZipCode94027 TableZipCode = `94027`
+	TableZipCode94028 TableZipCode = `94028`
+	TableZipCode94062 TableZipCode = `94062`
+	TableZipCode94111 TableZipCode = `94111`
+	TableZipCode94301 TableZipCode = `94301`
+	TableZipCode94304 TableZipCode = `94304`
+	TableZipCode94920 TableZipCode = `94920`
+	TableZipCode98039 TableZipCode = `98039`
+)
+
+// Description of the value, or empty if the value is not in table ZipCode.
+func (v TableZipCode) Description() string {
+	return tableDescription(`ZipCode`, string(v))
+}
+
+// Valid reports if the value is in table ZipCode.
+func (v TableZipCode) Valid() bool {
+	return TableValueLookup[`ZipCode`][string(v)]
+}
+
+// Table ZipCode.
+func (v TableZipCode) Table() Table {
+	return TableLookup[`ZipCode`]
+}
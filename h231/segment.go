@@ -1,4 +1,4 @@
-// Code generated by "hl7fetch -pkgdir h231 -root ./genjson -version 2.3.1"; DO NOT EDIT.
+// Code generated by "hl7fetch -pkgdir h231 -root ./genjson -version 2.3.1 -enum"; DO NOT EDIT.
 
 package h231
 
@@ -38,17 +38,17 @@ type ADD struct {
 // are identified with a simple identification code.
 type AIG struct {
 	HL7                      HL7Name `hl7:",name=AIG,type=s"`
-	SetID                    SI      `hl7:"1,seq,required,len=4,display=Set ID - AIG"`
+	SetID                    SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - AIG"`
 	SegmentActionCode        ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
 	ResourceID               *CE     `hl7:"3,conditional,len=200,display=Resource ID"`
 	ResourceType             CE      `hl7:"4,required,len=200,display=Resource Type"`
 	ResourceGroup            []CE    `hl7:"5,len=200,display=Resource Group"`
-	ResourceQuantity         NM      `hl7:"6,len=5,display=Resource Quantity"`
+	ResourceQuantity         NM      `hl7:"6,len=5,format=NM,display=Resource Quantity"`
 	ResourceQuantityUnits    *CE     `hl7:"7,len=200,display=Resource Quantity Units"`
 	StartDateTime            TS      `hl7:"8,conditional,len=26,format=YMDHMS,display=Start Date/Time"`
-	StartDateTimeOffset      NM      `hl7:"9,conditional,len=20,display=Start Date/Time Offset"`
+	StartDateTimeOffset      NM      `hl7:"9,conditional,len=20,format=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits *CE     `hl7:"10,conditional,len=200,display=Start Date/Time Offset Units"`
-	Duration                 NM      `hl7:"11,len=20,display=Duration"`
+	Duration                 NM      `hl7:"11,len=20,format=NM,display=Duration"`
 	DurationUnits            *CE     `hl7:"12,len=200,display=Duration Units"`
 	AllowSubstitutionCode    IS      `hl7:"13,conditional,len=10,table=0279,display=Allow Substitution Code"`
 	FillerStatusCode         *CE     `hl7:"14,conditional,len=200,table=0278,display=Filler Status Code"`
@@ -63,15 +63,15 @@ type AIG struct {
 // of locations used by the HL7 specification.
 type AIL struct {
 	HL7                      HL7Name `hl7:",name=AIL,type=s"`
-	SetID                    SI      `hl7:"1,seq,required,len=4,display=Set ID - AIL"`
+	SetID                    SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - AIL"`
 	SegmentActionCode        ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
 	LocationResourceID       *PL     `hl7:"3,conditional,len=80,display=Location Resource ID"`
 	LocationType             CE      `hl7:"4,required,len=200,display=Location Type-AIL"`
 	LocationGroup            *CE     `hl7:"5,len=200,display=Location Group"`
 	StartDateTime            TS      `hl7:"6,conditional,len=26,format=YMDHMS,display=Start Date/Time"`
-	StartDateTimeOffset      NM      `hl7:"7,conditional,len=20,display=Start Date/Time Offset"`
+	StartDateTimeOffset      NM      `hl7:"7,conditional,len=20,format=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits *CE     `hl7:"8,conditional,len=200,display=Start Date/Time Offset Units"`
-	Duration                 NM      `hl7:"9,len=20,display=Duration"`
+	Duration                 NM      `hl7:"9,len=20,format=NM,display=Duration"`
 	DurationUnits            *CE     `hl7:"10,len=200,display=Duration Units"`
 	AllowSubstitutionCode    IS      `hl7:"11,conditional,len=10,table=0279,display=Allow Substitution Code"`
 	FillerStatusCode         *CE     `hl7:"12,conditional,len=200,table=0278,display=Filler Status Code"`
@@ -86,15 +86,15 @@ type AIL struct {
 // nurses, surgeons, anesthesiologists, or CRNAs).
 type AIP struct {
 	HL7                      HL7Name `hl7:",name=AIP,type=s"`
-	SetID                    SI      `hl7:"1,seq,required,len=4,display=Set ID - AIP"`
+	SetID                    SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - AIP"`
 	SegmentActionCode        ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
 	PersonnelResourceID      []XCN   `hl7:"3,conditional,len=80,display=Personnel Resource ID"`
 	ResourceRole             CE      `hl7:"4,required,len=200,display=Resource Role"`
 	ResourceGroup            *CE     `hl7:"5,len=200,display=Resource Group"`
 	StartDateTime            TS      `hl7:"6,conditional,len=26,format=YMDHMS,display=Start Date/Time"`
-	StartDateTimeOffset      NM      `hl7:"7,conditional,len=20,display=Start Date/Time Offset"`
+	StartDateTimeOffset      NM      `hl7:"7,conditional,len=20,format=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits *CE     `hl7:"8,conditional,len=200,display=Start Date/Time Offset Units"`
-	Duration                 NM      `hl7:"9,len=20,display=Duration"`
+	Duration                 NM      `hl7:"9,len=20,format=NM,display=Duration"`
 	DurationUnits            *CE     `hl7:"10,len=200,display=Duration Units"`
 	AllowSubstitutionCode    IS      `hl7:"11,conditional,len=10,table=0279,display=Allow Substitution Code"`
 	FillerStatusCode         *CE     `hl7:"12,conditional,len=200,table=0278,display=Filler Status Code"`
@@ -107,13 +107,13 @@ type AIP struct {
 // by a schedule are not identified on a schedule request using this segment.
 type AIS struct {
 	HL7                      HL7Name `hl7:",name=AIS,type=s"`
-	SetID                    SI      `hl7:"1,seq,required,len=4,display=Set ID - AIS"`
+	SetID                    SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - AIS"`
 	SegmentActionCode        ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
 	UniversalServiceID       CE      `hl7:"3,required,len=200,display=Universal Service ID"`
 	StartDateTime            TS      `hl7:"4,conditional,len=26,format=YMDHMS,display=Start Date/Time"`
-	StartDateTimeOffset      NM      `hl7:"5,conditional,len=20,display=Start Date/Time Offset"`
+	StartDateTimeOffset      NM      `hl7:"5,conditional,len=20,format=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits *CE     `hl7:"6,conditional,len=200,display=Start Date/Time Offset Units"`
-	Duration                 NM      `hl7:"7,len=20,display=Duration"`
+	Duration                 NM      `hl7:"7,len=20,format=NM,display=Duration"`
 	DurationUnits            *CE     `hl7:"8,len=200,display=Duration Units"`
 	AllowSubstitutionCode    IS      `hl7:"9,conditional,len=10,table=0279,display=Allow Substitution Code"`
 	FillerStatusCode         *CE     `hl7:"10,conditional,len=200,table=0278,display=Filler Status Code"`
@@ -125,7 +125,7 @@ type AIS struct {
 // tables. Each AL1 segment describes a single patient allergy.
 type AL1 struct {
 	HL7                            HL7Name `hl7:",name=AL1,type=s"`
-	SetID                          SI      `hl7:"1,seq,required,len=4,display=Set ID - AL1"`
+	SetID                          SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - AL1"`
 	AllergyType                    IS      `hl7:"2,len=2,table=0127,display=Allergy Type"`
 	AllergyCodeMnemonicDescription CE      `hl7:"3,required,len=60,display=Allergy Code/Mnemonic/Description"`
 	AllergySeverity                IS      `hl7:"4,len=2,table=0128,display=Allergy Severity"`
@@ -147,7 +147,7 @@ type APR struct {
 	TimeSelectionCriteria     []SCV   `hl7:"1,len=80,display=Time Selection Criteria"`
 	ResourceSelectionCriteria []SCV   `hl7:"2,len=80,display=Resource Selection Criteria"`
 	LocationSelectionCriteria []SCV   `hl7:"3,len=80,display=Location Selection Criteria"`
-	SlotSpacingCriteria       NM      `hl7:"4,len=5,display=Slot Spacing Criteria"`
+	SlotSpacingCriteria       NM      `hl7:"4,len=5,format=NM,display=Slot Spacing Criteria"`
 	FillerOverrideCriteria    []SCV   `hl7:"5,len=80,display=Filler Override Criteria"`
 }
 
@@ -159,13 +159,13 @@ type ARQ struct {
 	HL7                         HL7Name `hl7:",name=ARQ,type=s"`
 	PlacerAppointmentID         EI      `hl7:"1,required,len=75,display=Placer Appointment ID"`
 	FillerAppointmentID         *EI     `hl7:"2,conditional,len=75,display=Filler Appointment ID"`
-	OccurrenceNumber            NM      `hl7:"3,conditional,len=5,display=Occurrence Number"`
+	OccurrenceNumber            NM      `hl7:"3,conditional,len=5,format=NM,display=Occurrence Number"`
 	PlacerGroupNumber           *EI     `hl7:"4,len=22,display=Placer Group Number"`
 	ScheduleID                  *CE     `hl7:"5,len=200,display=Schedule ID"`
 	RequestEventReason          *CE     `hl7:"6,len=200,display=Request Event Reason"`
 	AppointmentReason           *CE     `hl7:"7,len=200,table=0276,display=Appointment Reason"`
 	AppointmentType             *CE     `hl7:"8,len=200,table=0277,display=Appointment Type"`
-	AppointmentDuration         NM      `hl7:"9,len=20,display=Appointment Duration"`
+	AppointmentDuration         NM      `hl7:"9,len=20,format=NM,display=Appointment Duration"`
 	AppointmentDurationUnits    *CE     `hl7:"10,len=200,display=Appointment Duration Units"`
 	RequestedStartDateTimeRange []DR    `hl7:"11,len=53,display=Requested Start Date/Time Range"`
 	Priority                    ST      `hl7:"12,len=5,display=Priority-ARQ"`
@@ -195,8 +195,8 @@ type AUT struct {
 	AuthorizationExpirationDate  TS      `hl7:"5,len=26,format=YMDHMS,display=Authorization Expiration Date"`
 	AuthorizationIdentifier      *EI     `hl7:"6,conditional,len=30,display=Authorization Identifier"`
 	ReimbursementLimit           *CP     `hl7:"7,len=25,display=Reimbursement Limit"`
-	RequestedNumberOfTreatments  NM      `hl7:"8,len=2,display=Requested Number of Treatments"`
-	AuthorizedNumberOfTreatments NM      `hl7:"9,len=2,display=Authorized Number of Treatments"`
+	RequestedNumberOfTreatments  NM      `hl7:"8,len=2,format=NM,display=Requested Number of Treatments"`
+	AuthorizedNumberOfTreatments NM      `hl7:"9,len=2,format=NM,display=Authorized Number of Treatments"`
 	ProcessDate                  TS      `hl7:"10,len=26,format=YMDHMS,display=Process Date"`
 }
 
@@ -236,7 +236,7 @@ type BTS struct {
 	HL7               HL7Name `hl7:",name=BTS,type=s"`
 	BatchMessageCount ST      `hl7:"1,len=10,display=Batch Message Count"`
 	BatchComment      ST      `hl7:"2,len=80,display=Batch Comment"`
-	BatchTotals       []NM    `hl7:"3,len=100,display=Batch Totals"`
+	BatchTotals       []NM    `hl7:"3,len=100,format=NM,display=Batch Totals"`
 }
 
 // Charge description master segment
@@ -260,7 +260,7 @@ type CDM struct {
 	ProcedureCode                []CE    `hl7:"7,len=80,table=0088,display=Procedure Code"`
 	ActiveInactiveFlag           ID      `hl7:"8,len=1,table=0183,display=Active/Inactive Flag"`
 	InventoryNumber              []CE    `hl7:"9,len=60,display=Inventory Number"`
-	ResourceLoad                 NM      `hl7:"10,len=12,display=Resource Load"`
+	ResourceLoad                 NM      `hl7:"10,len=12,format=NM,display=Resource Load"`
 	ContractNumber               []CK    `hl7:"11,len=200,display=Contract Number"`
 	ContractOrganization         []XON   `hl7:"12,len=200,display=Contract Organization"`
 	RoomFeeIndicator             ID      `hl7:"13,len=1,table=0136,display=Room Fee Indicator"`
@@ -274,13 +274,13 @@ type CDM struct {
 // unless otherwise agreed upon.
 type CM0 struct {
 	HL7                 HL7Name `hl7:",name=CM0,type=s"`
-	SetID               SI      `hl7:"1,seq,len=4,display=Set ID - CM0"`
+	SetID               SI      `hl7:"1,seq,len=4,format=SI,display=Set ID - CM0"`
 	SponsorStudyID      EI      `hl7:"2,required,len=60,display=Sponsor Study ID"`
 	AlternateStudyID    []EI    `hl7:"3,max=3,len=60,display=Alternate Study ID"`
 	TitleOfStudy        ST      `hl7:"4,required,len=300,display=Title of Study"`
 	ChairmanOfStudy     []XCN   `hl7:"5,len=60,display=Chairman of Study"`
 	LastIRBApprovalDate DT      `hl7:"6,len=8,format=YMD,display=Last IRB Approval Date"`
-	TotalAccrualToDate  NM      `hl7:"7,len=8,display=Total Accrual to Date"`
+	TotalAccrualToDate  NM      `hl7:"7,len=8,format=NM,display=Total Accrual to Date"`
 	LastAccrualDate     DT      `hl7:"8,len=8,format=YMD,display=Last Accrual Date"`
 	ContactForStudy     []XCN   `hl7:"9,len=60,display=Contact for Study"`
 	ContactsTelNumber   *XTN    `hl7:"10,len=40,display=Contact's Tel. Number"`
@@ -295,7 +295,7 @@ type CM0 struct {
 // for the receiving system.
 type CM1 struct {
 	HL7                     HL7Name `hl7:",name=CM1,type=s"`
-	SetID                   SI      `hl7:"1,seq,required,len=4,display=Set ID - CM1"`
+	SetID                   SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - CM1"`
 	StudyPhaseIdentifier    CE      `hl7:"2,required,len=60,display=Study Phase Identifier"`
 	DescriptionOfStudyPhase ST      `hl7:"3,required,len=300,display=Description of Study Phase"`
 }
@@ -307,7 +307,7 @@ type CM1 struct {
 // sequence 2. The CM2 segment describes the scheduled time points in general.
 type CM2 struct {
 	HL7                          HL7Name `hl7:",name=CM2,type=s"`
-	SetID                        SI      `hl7:"1,seq,len=4,display=Set ID - CM2"`
+	SetID                        SI      `hl7:"1,seq,len=4,format=SI,display=Set ID - CM2"`
 	ScheduledTimePoint           CE      `hl7:"2,required,len=60,display=Scheduled Time Point"`
 	DescriptionOfTimePoint       ST      `hl7:"3,len=300,display=Description of Time Point"`
 	EventsScheduledThisTimePoint []CE    `hl7:"4,required,max=200,len=60,display=Events Scheduled This Time Point"`
@@ -409,7 +409,7 @@ type CTI struct {
 // The disabled person code and identifier allow for the association of the disability information to the person.
 type DB1 struct {
 	HL7                        HL7Name `hl7:",name=DB1,type=s"`
-	SetID                      SI      `hl7:"1,seq,required,len=4,display=Set ID - DB1"`
+	SetID                      SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - DB1"`
 	DisabledPersonCode         IS      `hl7:"2,len=2,table=0334,display=Disabled Person Code"`
 	DisabledPersonIdentifier   []CX    `hl7:"3,len=32,display=Disabled Person Identifier"`
 	DisabledIndicator          ID      `hl7:"4,len=1,table=0136,display=Disabled Indicator"`
@@ -428,7 +428,7 @@ type DB1 struct {
 // are also defined.
 type DG1 struct {
 	HL7                     HL7Name `hl7:",name=DG1,type=s"`
-	SetID                   SI      `hl7:"1,seq,required,len=4,display=Set ID - DG1"`
+	SetID                   SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - DG1"`
 	DiagnosisCodingMethod   ID      `hl7:"2,len=2,table=0053,display=Diagnosis Coding Method"`
 	DiagnosisCode           *CE     `hl7:"3,len=60,table=0051,display=Diagnosis Code - DG1"`
 	DiagnosisDescription    ST      `hl7:"4,len=40,display=Diagnosis Description"`
@@ -439,7 +439,7 @@ type DG1 struct {
 	DRGApprovalIndicator    ID      `hl7:"9,len=1,table=0136,display=DRG Approval Indicator"`
 	DRGGrouperReviewCode    IS      `hl7:"10,len=2,table=0056,display=DRG Grouper Review Code"`
 	OutlierType             CE      `hl7:"11,len=60,table=0083,display=Outlier Type"`
-	OutlierDays             NM      `hl7:"12,len=3,display=Outlier Days"`
+	OutlierDays             NM      `hl7:"12,len=3,format=NM,display=Outlier Days"`
 	OutlierCost             CP      `hl7:"13,len=12,display=Outlier Cost"`
 	GrouperVersionAndType   ST      `hl7:"14,len=4,display=Grouper Version And Type"`
 	DiagnosisPriority       ID      `hl7:"15,len=2,table=0359,display=Diagnosis Priority"`
@@ -460,7 +460,7 @@ type DRG struct {
 	ApprovalIndicator      ID      `hl7:"3,len=1,table=0136,display=DRG Approval Indicator"`
 	GrouperReviewCode      IS      `hl7:"4,len=2,table=0056,display=DRG Grouper Review Code"`
 	OutlierType            *CE     `hl7:"5,len=60,table=0083,display=Outlier Type"`
-	OutlierDays            NM      `hl7:"6,len=3,display=Outlier Days"`
+	OutlierDays            NM      `hl7:"6,len=3,format=NM,display=Outlier Days"`
 	OutlierCost            *CP     `hl7:"7,len=12,display=Outlier Cost"`
 	Payor                  IS      `hl7:"8,len=1,table=0229,display=DRG Payor"`
 	OutlierReimbursement   *CP     `hl7:"9,len=9,display=Outlier Reimbursement"`
@@ -481,8 +481,8 @@ type DSC struct {
 // data is lost; the data is simply treated as lines of text.
 type DSP struct {
 	HL7               HL7Name `hl7:",name=DSP,type=s"`
-	SetID             SI      `hl7:"1,seq,len=4,display=Set ID - DSP"`
-	DisplayLevel      SI      `hl7:"2,len=4,display=Display Level"`
+	SetID             SI      `hl7:"1,seq,len=4,format=SI,display=Set ID - DSP"`
+	DisplayLevel      SI      `hl7:"2,len=4,format=SI,display=Display Level"`
 	DataLine          TX      `hl7:"3,required,len=300,display=Data Line"`
 	LogicalBreakPoint ST      `hl7:"4,len=2,display=Logical Break Point"`
 	ResultID          TX      `hl7:"5,len=20,display=Result ID"`
@@ -579,7 +579,7 @@ type FHS struct {
 // records.
 type FT1 struct {
 	HL7                       HL7Name `hl7:",name=FT1,type=s"`
-	SetID                     SI      `hl7:"1,seq,len=4,display=Set ID - FT1"`
+	SetID                     SI      `hl7:"1,seq,len=4,format=SI,display=Set ID - FT1"`
 	TransactionID             ST      `hl7:"2,len=12,display=Transaction ID"`
 	TransactionBatchID        ST      `hl7:"3,len=10,display=Transaction Batch ID"`
 	TransactionDate           TS      `hl7:"4,required,len=26,format=YMDHMS,display=Transaction Date"`
@@ -588,7 +588,7 @@ type FT1 struct {
 	TransactionCode           CE      `hl7:"7,required,len=80,table=0132,display=Transaction Code"`
 	TransactionDescription    ST      `hl7:"8,len=40,display=Transaction Description"`
 	TransactionDescriptionAlt ST      `hl7:"9,len=40,display=Transaction Description - Alt"`
-	TransactionQuantity       NM      `hl7:"10,len=6,display=Transaction Quantity"`
+	TransactionQuantity       NM      `hl7:"10,len=6,format=NM,display=Transaction Quantity"`
 	TransactionAmountExtended *CP     `hl7:"11,len=12,display=Transaction Amount - Extended"`
 	TransactionAmountUnit     *CP     `hl7:"12,len=12,display=Transaction Amount - Unit"`
 	DepartmentCode            *CE     `hl7:"13,len=60,table=0049,display=Department Code"`
@@ -612,7 +612,7 @@ type FT1 struct {
 // The FTS segment defines the end of a file.
 type FTS struct {
 	HL7                HL7Name `hl7:",name=FTS,type=s"`
-	FileBatchCount     NM      `hl7:"1,len=10,display=File Batch Count"`
+	FileBatchCount     NM      `hl7:"1,len=10,format=NM,display=File Batch Count"`
 	FileTrailerComment ST      `hl7:"2,len=80,display=File Trailer Comment"`
 }
 
@@ -626,7 +626,7 @@ type GOL struct {
 	GoalID                      CE      `hl7:"3,required,len=80,display=Goal ID"`
 	GoalInstanceID              EI      `hl7:"4,required,len=60,display=Goal Instance ID"`
 	EpisodeOfCareID             *EI     `hl7:"5,len=60,display=Episode of Care ID"`
-	GoalListPriority            NM      `hl7:"6,len=60,display=Goal List Priority"`
+	GoalListPriority            NM      `hl7:"6,len=60,format=NM,display=Goal List Priority"`
 	GoalEstablishedDateTime     TS      `hl7:"7,len=26,format=YMDHMS,display=Goal Established Date/Time"`
 	ExpectedGoalAchieveDateTime TS      `hl7:"8,len=26,format=YMDHMS,display=Expected Goal Achieve Date/Time"`
 	GoalClassification          *CE     `hl7:"9,len=80,display=Goal Classification"`
@@ -650,7 +650,7 @@ type GOL struct {
 // a patient account) data for patient and insurance billing applications.
 type GT1 struct {
 	HL7                                HL7Name `hl7:",name=GT1,type=s"`
-	SetID                              SI      `hl7:"1,seq,required,len=4,display=Set ID - GT1"`
+	SetID                              SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - GT1"`
 	GuarantorNumber                    []CX    `hl7:"2,len=59,display=Guarantor Number"`
 	GuarantorName                      []XPN   `hl7:"3,required,len=48,display=Guarantor Name"`
 	GuarantorSpouseName                []XPN   `hl7:"4,len=48,display=Guarantor Spouse Name"`
@@ -664,7 +664,7 @@ type GT1 struct {
 	GuarantorSSN                       ST      `hl7:"12,len=11,display=Guarantor SSN"`
 	GuarantorDateBegin                 DT      `hl7:"13,len=8,format=YMD,display=Guarantor Date - Begin"`
 	GuarantorDateEnd                   DT      `hl7:"14,len=8,format=YMD,display=Guarantor Date - End"`
-	GuarantorPriority                  NM      `hl7:"15,len=2,display=Guarantor Priority"`
+	GuarantorPriority                  NM      `hl7:"15,len=2,format=NM,display=Guarantor Priority"`
 	GuarantorEmployerName              []XPN   `hl7:"16,len=130,display=Guarantor Employer Name"`
 	GuarantorEmployerAddress           []XAD   `hl7:"17,len=106,display=Guarantor Employer Address"`
 	GuarantorEmployerPhoneNumber       []XTN   `hl7:"18,len=40,display=Guarantor Employer Phone Number"`
@@ -677,7 +677,7 @@ type GT1 struct {
 	GuarantorDeathFlag                 ID      `hl7:"25,len=1,table=0136,display=Guarantor Death Flag"`
 	GuarantorChargeAdjustmentCode      *CE     `hl7:"26,len=80,table=0218,display=Guarantor Charge Adjustment Code"`
 	GuarantorHouseholdAnnualIncome     *CP     `hl7:"27,len=10,display=Guarantor Household Annual Income"`
-	GuarantorHouseholdSize             NM      `hl7:"28,len=3,display=Guarantor Household Size"`
+	GuarantorHouseholdSize             NM      `hl7:"28,len=3,format=NM,display=Guarantor Household Size"`
 	GuarantorEmployerIDNumber          []CX    `hl7:"29,len=20,display=Guarantor Employer ID Number"`
 	GuarantorMaritalStatusCode         *CE     `hl7:"30,len=80,table=0002,display=Guarantor Marital Status Code"`
 	GuarantorHireEffectiveDate         DT      `hl7:"31,len=8,format=YMD,display=Guarantor Hire Effective Date"`
@@ -713,7 +713,7 @@ type GT1 struct {
 // and insurance bills.
 type IN1 struct {
 	HL7                           HL7Name `hl7:",name=IN1,type=s"`
-	SetID                         SI      `hl7:"1,seq,required,len=4,display=Set ID - IN1"`
+	SetID                         SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - IN1"`
 	InsurancePlanID               CE      `hl7:"2,required,len=60,table=0072,display=Insurance Plan ID"`
 	InsuranceCompanyID            []CX    `hl7:"3,required,len=59,display=Insurance Company ID"`
 	InsuranceCompanyName          []XON   `hl7:"4,len=130,display=Insurance Company Name"`
@@ -745,13 +745,13 @@ type IN1 struct {
 	VerificationBy                []XCN   `hl7:"30,len=60,display=Verification By"`
 	TypeOfAgreementCode           IS      `hl7:"31,len=2,table=0098,display=Type Of Agreement Code"`
 	BillingStatus                 IS      `hl7:"32,len=2,table=0022,display=Billing Status"`
-	LifetimeReserveDays           NM      `hl7:"33,len=4,display=Lifetime Reserve Days"`
-	DelayBeforeLRDay              NM      `hl7:"34,len=4,display=Delay Before L.R. Day"`
+	LifetimeReserveDays           NM      `hl7:"33,len=4,format=NM,display=Lifetime Reserve Days"`
+	DelayBeforeLRDay              NM      `hl7:"34,len=4,format=NM,display=Delay Before L.R. Day"`
 	CompanyPlanCode               IS      `hl7:"35,len=8,table=0042,display=Company Plan Code"`
 	PolicyNumber                  ST      `hl7:"36,len=15,display=Policy Number"`
 	PolicyDeductible              *CP     `hl7:"37,len=12,display=Policy Deductible"`
 	PolicyLimitAmount             CP      `hl7:"38,len=12,display=Policy Limit - Amount"`
-	PolicyLimitDays               NM      `hl7:"39,len=4,display=Policy Limit - Days"`
+	PolicyLimitDays               NM      `hl7:"39,len=4,format=NM,display=Policy Limit - Days"`
 	RoomRateSemiPrivate           CP      `hl7:"40,len=12,display=Room Rate - Semi-Private"`
 	RoomRatePrivate               CP      `hl7:"41,len=12,display=Room Rate - Private"`
 	InsuredSEmploymentStatus      *CE     `hl7:"42,len=60,table=0066,display=Insured s Employment Status"`
@@ -850,7 +850,7 @@ type IN2 struct {
 // segment are defined by HCFA, or other regulatory agencies.
 type IN3 struct {
 	HL7                                HL7Name `hl7:",name=IN3,type=s"`
-	SetID                              SI      `hl7:"1,seq,required,len=4,display=Set ID - IN3"`
+	SetID                              SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - IN3"`
 	CertificationNumber                *CX     `hl7:"2,len=59,display=Certification Number"`
 	CertifiedBy                        []XCN   `hl7:"3,len=60,display=Certified By"`
 	CertificationRequired              ID      `hl7:"4,len=1,table=0136,display=Certification Required"`
@@ -979,6 +979,28 @@ type MFE struct {
 	PrimaryKeyValueType  []ID     `hl7:"5,required,len=3,table=0355,display=Primary Key Value Type"`
 }
 
+// FieldVaries returns the value to decode a repetition of a VARIES field into,
+// with the data type selected by another field of the segment.
+func (v MFE) FieldVaries(field int32, rep int, reg func(string) (any, bool)) (reflect.Value, error) {
+	var name string
+	switch field {
+	default:
+		return reflect.Value{}, fmt.Errorf("MFE-%d has no field to select the data type", field)
+	case 4:
+		if n := len(v.PrimaryKeyValueType); n > 0 {
+			if rep >= n {
+				rep = n - 1
+			}
+			name = v.PrimaryKeyValueType[rep]
+		}
+	}
+	vt, ok := reg(name)
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("unknown MFE data type %q", name)
+	}
+	return reflect.New(reflect.TypeOf(vt)).Elem(), nil
+}
+
 // Master file identification segment
 //
 // The fields in the MFI segment are defined in Figure 8-1 - MFI attributes .
@@ -1016,7 +1038,7 @@ type MSA struct {
 	AcknowledgementCode       ID      `hl7:"1,required,len=2,table=0008,display=Acknowledgement Code"`
 	MessageControlID          ST      `hl7:"2,required,len=20,display=Message Control ID"`
 	TextMessage               ST      `hl7:"3,len=80,display=Text Message"`
-	ExpectedSequenceNumber    NM      `hl7:"4,len=15,display=Expected Sequence Number"`
+	ExpectedSequenceNumber    NM      `hl7:"4,len=15,format=NM,display=Expected Sequence Number"`
 	DelayedAcknowledgmentType ID      `hl7:"5,len=1,table=0102,display=Delayed Acknowledgment Type"`
 	ErrorCondition            *CE     `hl7:"6,len=100,display=Error Condition"`
 }
@@ -1038,7 +1060,7 @@ type MSH struct {
 	MessageControlID                    ST      `hl7:"10,required,len=20,display=Message Control ID"`
 	ProcessingID                        PT      `hl7:"11,required,len=3,display=Processing ID"`
 	VersionID                           VID     `hl7:"12,required,len=60,display=Version ID"`
-	SequenceNumber                      NM      `hl7:"13,len=15,display=Sequence Number"`
+	SequenceNumber                      NM      `hl7:"13,len=15,format=NM,display=Sequence Number"`
 	ContinuationPointer                 ST      `hl7:"14,len=180,display=Continuation Pointer"`
 	AcceptAcknowledgmentType            ID      `hl7:"15,len=2,table=0155,display=Accept Acknowledgment Type"`
 	ApplicationAcknowledgmentType       ID      `hl7:"16,len=2,table=0155,display=Application Acknowledgment Type"`
@@ -1068,7 +1090,7 @@ type NCK struct {
 // Utilizing NK1-1-set ID, multiple NK1 segments can be sent to patient accounts.
 type NK1 struct {
 	HL7                                      HL7Name `hl7:",name=NK1,type=s"`
-	SetID                                    SI      `hl7:"1,seq,required,len=4,display=Set ID - NK1"`
+	SetID                                    SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - NK1"`
 	NKName                                   []XPN   `hl7:"2,len=48,display=NK Name"`
 	Relationship                             *CE     `hl7:"3,len=60,table=0063,display=Relationship"`
 	Address                                  []XAD   `hl7:"4,len=106,display=Address"`
@@ -1147,16 +1169,16 @@ type NST struct {
 	SourceType             ID      `hl7:"3,len=3,table=0332,display=Source Type"`
 	StatisticsStart        TS      `hl7:"4,len=26,format=YMDHMS,display=Statistics Start"`
 	StatisticsEnd          TS      `hl7:"5,len=26,format=YMDHMS,display=Statistics End"`
-	ReceiveCharacterCount  NM      `hl7:"6,len=10,display=Receive Character Count"`
-	SendCharacterCount     NM      `hl7:"7,len=10,display=Send Character Count"`
-	MessagesReceived       NM      `hl7:"8,len=10,display=Messages Received"`
-	MessagesSent           NM      `hl7:"9,len=10,display=Messages Sent"`
-	ChecksumErrorsReceived NM      `hl7:"10,len=10,display=Checksum Errors Received"`
-	LengthErrorsReceived   NM      `hl7:"11,len=10,display=Length Errors Received"`
-	OtherErrorsReceived    NM      `hl7:"12,len=10,display=Other Errors Received"`
-	ConnectTimeouts        NM      `hl7:"13,len=10,display=Connect Timeouts"`
-	ReceiveTimeouts        NM      `hl7:"14,len=10,display=Receive Timeouts"`
-	NetworkErrors          NM      `hl7:"15,len=10,display=Network Errors"`
+	ReceiveCharacterCount  NM      `hl7:"6,len=10,format=NM,display=Receive Character Count"`
+	SendCharacterCount     NM      `hl7:"7,len=10,format=NM,display=Send Character Count"`
+	MessagesReceived       NM      `hl7:"8,len=10,format=NM,display=Messages Received"`
+	MessagesSent           NM      `hl7:"9,len=10,format=NM,display=Messages Sent"`
+	ChecksumErrorsReceived NM      `hl7:"10,len=10,format=NM,display=Checksum Errors Received"`
+	LengthErrorsReceived   NM      `hl7:"11,len=10,format=NM,display=Length Errors Received"`
+	OtherErrorsReceived    NM      `hl7:"12,len=10,format=NM,display=Other Errors Received"`
+	ConnectTimeouts        NM      `hl7:"13,len=10,format=NM,display=Connect Timeouts"`
+	ReceiveTimeouts        NM      `hl7:"14,len=10,format=NM,display=Receive Timeouts"`
+	NetworkErrors          NM      `hl7:"15,len=10,format=NM,display=Network Errors"`
 }
 
 // Notes and comments segment
@@ -1165,7 +1187,7 @@ type NST struct {
 // and comments.
 type NTE struct {
 	HL7             HL7Name `hl7:",name=NTE,type=s"`
-	SetID           SI      `hl7:"1,seq,len=4,display=Set ID - NTE"`
+	SetID           SI      `hl7:"1,seq,len=4,format=SI,display=Set ID - NTE"`
 	SourceOfComment ID      `hl7:"2,len=8,table=0105,display=Source of Comment"`
 	Comment         []FT    `hl7:"3,len=65536,display=Comment"`
 	CommentType     *CE     `hl7:"4,len=60,table=0364,display=Comment Type"`
@@ -1177,7 +1199,7 @@ type NTE struct {
 // physical exam, or assessment.
 type OBR struct {
 	HL7                                 HL7Name `hl7:",name=OBR,type=s"`
-	SetID                               SI      `hl7:"1,seq,len=4,display=Set ID - OBR"`
+	SetID                               SI      `hl7:"1,seq,len=4,format=SI,display=Set ID - OBR"`
 	PlacerOrderNumber                   *EI     `hl7:"2,conditional,len=22,display=Placer Order Number"`
 	FillerOrderNumber                   *EI     `hl7:"3,conditional,len=22,display=Filler Order Number"`
 	UniversalServiceID                  CE      `hl7:"4,required,len=200,display=Universal Service ID"`
@@ -1213,7 +1235,7 @@ type OBR struct {
 	Technician                          []NDL   `hl7:"34,len=200,display=Technician +"`
 	Transcriptionist                    []NDL   `hl7:"35,len=200,display=Transcriptionist +"`
 	ScheduledDateTime                   TS      `hl7:"36,len=26,format=YMDHMS,display=Scheduled Date/Time +"`
-	NumberOfSampleContainers            NM      `hl7:"37,len=4,display=Number of Sample Containers *"`
+	NumberOfSampleContainers            NM      `hl7:"37,len=4,format=NM,display=Number of Sample Containers *"`
 	TransportLogisticsOfCollectedSample []CE    `hl7:"38,len=60,display=Transport Logistics of Collected Sample *"`
 	CollectorSComment                   []CE    `hl7:"39,len=200,display=Collector s Comment *"`
 	TransportArrangementResponsibility  *CE     `hl7:"40,len=60,display=Transport Arrangement Responsibility"`
@@ -1230,7 +1252,7 @@ type OBR struct {
 // unit of a report. Its structure is summarized in Figure 7-5.
 type OBX struct {
 	HL7                      HL7Name  `hl7:",name=OBX,type=s"`
-	SetID                    SI       `hl7:"1,seq,len=4,display=Set ID - OBX"`
+	SetID                    SI       `hl7:"1,seq,len=4,format=SI,display=Set ID - OBX"`
 	ValueType                ID       `hl7:"2,conditional,len=3,table=0125,display=Value Type"`
 	ObservationIdentifier    CE       `hl7:"3,required,len=80,display=Observation Identifier"`
 	ObservationSubID         ST       `hl7:"4,conditional,len=20,display=Observation Sub-ID"`
@@ -1238,7 +1260,7 @@ type OBX struct {
 	Units                    *CE      `hl7:"6,len=60,display=Units"`
 	ReferencesRange          ST       `hl7:"7,len=60,display=References Range"`
 	AbnormalFlags            []ID     `hl7:"8,max=5,len=5,table=0078,display=Abnormal Flags"`
-	Probability              []NM     `hl7:"9,max=5,len=5,display=Probability"`
+	Probability              []NM     `hl7:"9,max=5,len=5,format=NM,display=Probability"`
 	NatureOfAbnormalTest     []ID     `hl7:"10,len=2,table=0080,display=Nature of Abnormal Test"`
 	ObservationResultStatus  ID       `hl7:"11,required,len=1,table=0085,display=Observation Result Status"`
 	DateLastObsNormalValues  TS       `hl7:"12,len=26,format=YMDHMS,display=Date Last Obs Normal Values"`
@@ -1249,15 +1271,25 @@ type OBX struct {
 	ObservationMethod        []CE     `hl7:"17,len=60,display=Observation Method"`
 }
 
-func (v OBX) ChildVaries(reg func(string) (any, bool)) (reflect.Value, error) {
-	vt, ok := reg(v.ValueType)
+// FieldVaries returns the value to decode a repetition of a VARIES field into,
+// with the data type selected by another field of the segment.
+func (v OBX) FieldVaries(field int32, rep int, reg func(string) (any, bool)) (reflect.Value, error) {
+	var name string
+	switch field {
+	default:
+		return reflect.Value{}, fmt.Errorf("OBX-%d has no field to select the data type", field)
+	case 5:
+		name = v.ValueType
+	}
+	vt, ok := reg(name)
 	if !ok {
-		return reflect.Value{}, fmt.Errorf("unknown OBX data type %q", v.ValueType)
+		return reflect.Value{}, fmt.Errorf("unknown OBX data type %q", name)
 	}
+	return reflect.New(reflect.TypeOf(vt)).Elem(), nil
+}
 
-	rt := reflect.TypeOf(vt)
-	rv := reflect.New(rt)
-	return rv.Elem(), nil
+func (v OBX) ChildVaries(reg func(string) (any, bool)) (reflect.Value, error) {
+	return v.FieldVaries(5, 0, reg)
 }
 
 // Dietary orders, supplements, and preferences segment
@@ -1293,7 +1325,7 @@ type ODT struct {
 // the field attributes that specify what additional segments might also be defined for this observation.
 type OM1 struct {
 	HL7                                                    HL7Name `hl7:",name=OM1,type=s"`
-	SequenceNumberTestObservationMasterFile                NM      `hl7:"1,required,len=4,display=Sequence Number - Test/Observation Master File"`
+	SequenceNumberTestObservationMasterFile                NM      `hl7:"1,required,len=4,format=NM,display=Sequence Number - Test/Observation Master File"`
 	ProducersTestObservationID                             CE      `hl7:"2,required,len=200,display=Producer's Test/Observation ID"`
 	PermittedDataTypes                                     []ID    `hl7:"3,len=12,table=0125,display=Permitted Data Types"`
 	SpecimenRequired                                       ID      `hl7:"4,required,len=1,table=0136,display=Specimen Required"`
@@ -1315,8 +1347,8 @@ type OM1 struct {
 	ReportDisplayOrder                                     ST      `hl7:"20,len=20,display=Report Display Order"`
 	DateTimeStampForAnyChangeInDefinitionForTheObservation TS      `hl7:"21,len=26,format=YMDHMS,display=Date/Time Stamp for any change in Definition for the Observation"`
 	EffectiveDateTimeOfChange                              TS      `hl7:"22,len=26,format=YMDHMS,display=Effective Date/Time of Change"`
-	TypicalTurnAroundTime                                  NM      `hl7:"23,len=20,display=Typical Turn-Around Time"`
-	ProcessingTime                                         NM      `hl7:"24,len=20,display=Processing Time"`
+	TypicalTurnAroundTime                                  NM      `hl7:"23,len=20,format=NM,display=Typical Turn-Around Time"`
+	ProcessingTime                                         NM      `hl7:"24,len=20,format=NM,display=Processing Time"`
 	ProcessingPriority                                     []ID    `hl7:"25,len=40,table=0168,display=Processing Priority"`
 	ReportingPriority                                      ID      `hl7:"26,len=5,table=0169,display=Reporting Priority"`
 	OutsideSite                                            []CE    `hl7:"27,len=200,display=Outside Site"`
@@ -1349,16 +1381,16 @@ type OM1 struct {
 // ).
 type OM2 struct {
 	HL7                                     HL7Name       `hl7:",name=OM2,type=s"`
-	SequenceNumberTestObservationMasterFile NM            `hl7:"1,len=4,display=Sequence Number - Test/Observation Master File"`
+	SequenceNumberTestObservationMasterFile NM            `hl7:"1,len=4,format=NM,display=Sequence Number - Test/Observation Master File"`
 	UnitsOfMeasure                          *CE           `hl7:"2,len=60,display=Units of Measure"`
-	RangeOfDecimalPrecision                 []NM          `hl7:"3,len=10,display=Range of Decimal Precision"`
+	RangeOfDecimalPrecision                 []NM          `hl7:"3,len=10,format=NM,display=Range of Decimal Precision"`
 	CorrespondingSIUnitsOfMeasure           *CE           `hl7:"4,len=60,display=Corresponding SI Units of Measure"`
 	SIConversionFactor                      TX            `hl7:"5,len=60,display=SI Conversion Factor"`
 	Reference                               *RFR          `hl7:"6,len=200,display=Reference"`
 	CriticalRangeForOrdinalContinuousObs    *NR           `hl7:"7,len=200,display=Critical Range for Ordinal & Continuous Obs"`
 	AbsoluteRangeForOrdinalContinuousObs    *CM_ABS_RANGE `hl7:"8,len=200,display=Absolute Range for Ordinal & Continuous Obs"`
 	DeltaCheckCriteria                      []DLT         `hl7:"9,len=200,display=Delta Check Criteria"`
-	MinimumMeaningfulIncrements             NM            `hl7:"10,len=20,display=Minimum Meaningful Increments"`
+	MinimumMeaningfulIncrements             NM            `hl7:"10,len=20,format=NM,display=Minimum Meaningful Increments"`
 }
 
 // Categorical test/observation segment
@@ -1366,7 +1398,7 @@ type OM2 struct {
 // This segment applies to free text and other non-numeric data types.
 type OM3 struct {
 	HL7                                         HL7Name `hl7:",name=OM3,type=s"`
-	SequenceNumberTestObservationMasterFile     NM      `hl7:"1,len=4,display=Sequence Number - Test/Observation Master File"`
+	SequenceNumberTestObservationMasterFile     NM      `hl7:"1,len=4,format=NM,display=Sequence Number - Test/Observation Master File"`
 	PreferredCodingSystem                       *CE     `hl7:"2,len=60,display=Preferred Coding System"`
 	ValidCodedAnswers                           *CE     `hl7:"3,len=60,display=Valid Coded  Answers "`
 	NormalTextCodesForCategoricalObservations   []CE    `hl7:"4,len=200,display=Normal Text/Codes for Categorical Observations"`
@@ -1382,10 +1414,10 @@ type OM3 struct {
 // and a serum specimen), multiple segments may be included, one for each specimen type.
 type OM4 struct {
 	HL7                                     HL7Name `hl7:",name=OM4,type=s"`
-	SequenceNumberTestObservationMasterFile NM      `hl7:"1,len=4,display=Sequence Number - Test/Observation Master File"`
+	SequenceNumberTestObservationMasterFile NM      `hl7:"1,len=4,format=NM,display=Sequence Number - Test/Observation Master File"`
 	DerivedSpecimen                         ID      `hl7:"2,len=1,table=0170,display=Derived Specimen"`
 	ContainerDescription                    TX      `hl7:"3,len=60,display=Container Description"`
-	ContainerVolume                         NM      `hl7:"4,len=20,display=Container Volume"`
+	ContainerVolume                         NM      `hl7:"4,len=20,format=NM,display=Container Volume"`
 	ContainerUnits                          *CE     `hl7:"5,len=60,display=Container Units"`
 	Specimen                                *CE     `hl7:"6,len=60,display=Specimen"`
 	Additive                                *CE     `hl7:"7,len=60,display=Additive"`
@@ -1404,7 +1436,7 @@ type OM4 struct {
 // of test/observation ).
 type OM5 struct {
 	HL7                                                HL7Name `hl7:",name=OM5,type=s"`
-	SequenceNumberTestObservationMasterFile            NM      `hl7:"1,len=4,display=Sequence Number - Test/Observation Master File"`
+	SequenceNumberTestObservationMasterFile            NM      `hl7:"1,len=4,format=NM,display=Sequence Number - Test/Observation Master File"`
 	TestObservationsIncludedWithinAnOrderedTestBattery []CE    `hl7:"2,len=200,display=Test/Observations Included within an Ordered Test Battery"`
 	ObservationIDSuffixes                              ST      `hl7:"3,len=200,display=Observation ID Suffixes"`
 }
@@ -1415,7 +1447,7 @@ type OM5 struct {
 // by mathematical or logical means.
 type OM6 struct {
 	HL7                                     HL7Name `hl7:",name=OM6,type=s"`
-	SequenceNumberTestObservationMasterFile NM      `hl7:"1,len=4,display=Sequence Number - Test/Observation Master File"`
+	SequenceNumberTestObservationMasterFile NM      `hl7:"1,len=4,format=NM,display=Sequence Number - Test/Observation Master File"`
 	DerivationRule                          TX      `hl7:"2,len=10240,display=Derivation Rule"`
 }
 
@@ -1571,7 +1603,7 @@ type PES struct {
 	SenderAddress          []XAD   `hl7:"3,len=200,display=Sender Address"`
 	SenderTelephone        []XTN   `hl7:"4,len=44,display=Sender Telephone"`
 	SenderEventIdentifier  *EI     `hl7:"5,len=75,display=Sender Event Identifier"`
-	SenderSequenceNumber   NM      `hl7:"6,len=2,display=Sender Sequence Number"`
+	SenderSequenceNumber   NM      `hl7:"6,len=2,format=NM,display=Sender Sequence Number"`
 	SenderEventDescription []FT    `hl7:"7,len=600,display=Sender Event Description"`
 	SenderComment          FT      `hl7:"8,len=600,display=Sender Comment"`
 	SenderAwareDateTime    TS      `hl7:"9,len=26,format=YMDHMS,display=Sender Aware Date/Time"`
@@ -1588,7 +1620,7 @@ type PES struct {
 // to change frequently.
 type PID struct {
 	HL7                         HL7Name `hl7:",name=PID,type=s"`
-	SetID                       SI      `hl7:"1,seq,len=4,display=Set ID - PID"`
+	SetID                       SI      `hl7:"1,seq,len=4,format=SI,display=Set ID - PID"`
 	PatientID                   CX      `hl7:"2,len=20,display=Patient ID"`
 	PatientIdentifierList       []CX    `hl7:"3,required,len=20,display=Patient Identifier List"`
 	AlternatePatientID          []CX    `hl7:"4,len=20,display=Alternate Patient ID - PID"`
@@ -1612,7 +1644,7 @@ type PID struct {
 	EthnicGroup                 []CE    `hl7:"22,len=80,table=0189,display=Ethnic Group"`
 	BirthPlace                  ST      `hl7:"23,len=60,display=Birth Place"`
 	MultipleBirthIndicator      ID      `hl7:"24,len=1,table=0136,display=Multiple Birth Indicator"`
-	BirthOrder                  NM      `hl7:"25,len=2,display=Birth Order"`
+	BirthOrder                  NM      `hl7:"25,len=2,format=NM,display=Birth Order"`
 	Citizenship                 []CE    `hl7:"26,len=80,table=0171,display=Citizenship"`
 	VeteransMilitaryStatus      *CE     `hl7:"27,len=60,table=0172,display=Veterans Military Status"`
 	Nationality                 *CE     `hl7:"28,len=80,table=0212,display=Nationality"`
@@ -1627,20 +1659,20 @@ type PID struct {
 // The PR1 segment is used to send multiple procedures, for example, for medical records encoding or for billing systems.
 type PR1 struct {
 	HL7                     HL7Name `hl7:",name=PR1,type=s"`
-	SetID                   SI      `hl7:"1,seq,required,len=4,display=Set ID - PR1"`
+	SetID                   SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - PR1"`
 	ProcedureCodingMethod   IS      `hl7:"2,len=2,table=0089,display=Procedure Coding Method"`
 	ProcedureCode           CE      `hl7:"3,required,len=80,table=0088,display=Procedure Code"`
 	ProcedureDescription    ST      `hl7:"4,len=40,display=Procedure Description"`
 	ProcedureDateTime       TS      `hl7:"5,required,len=26,format=YMDHMS,display=Procedure Date/Time"`
 	ProcedureFunctionalType IS      `hl7:"6,required,len=2,table=0230,display=Procedure Functional Type"`
-	ProcedureMinutes        NM      `hl7:"7,len=4,display=Procedure Minutes"`
+	ProcedureMinutes        NM      `hl7:"7,len=4,format=NM,display=Procedure Minutes"`
 	Anesthesiologist        []XCN   `hl7:"8,len=120,table=0010,display=Anesthesiologist"`
 	AnesthesiaCode          IS      `hl7:"9,len=2,table=0019,display=Anesthesia Code"`
-	AnesthesiaMinutes       NM      `hl7:"10,len=4,display=Anesthesia Minutes"`
+	AnesthesiaMinutes       NM      `hl7:"10,len=4,format=NM,display=Anesthesia Minutes"`
 	Surgeon                 []XCN   `hl7:"11,len=120,table=0010,display=Surgeon"`
 	ProcedurePractitioner   []XCN   `hl7:"12,len=230,table=0010,display=Procedure Practitioner"`
 	ConsentCode             *CE     `hl7:"13,len=60,table=0059,display=Consent Code"`
-	ProcedurePriority       NM      `hl7:"14,len=2,display=Procedure Priority"`
+	ProcedurePriority       NM      `hl7:"14,len=2,format=NM,display=Procedure Priority"`
 	AssociatedDiagnosisCode *CE     `hl7:"15,len=80,table=0051,display=Associated Diagnosis Code"`
 	ProcedureCodeModifier   []CE    `hl7:"16,len=80,table=0340,display=Procedure Code Modifier"`
 }
@@ -1673,7 +1705,7 @@ type PRB struct {
 	ProblemID                                         CE      `hl7:"3,required,len=80,display=Problem ID"`
 	ProblemInstanceID                                 EI      `hl7:"4,required,len=60,display=Problem Instance ID"`
 	EpisodeOfCareID                                   *EI     `hl7:"5,len=60,display=Episode of Care ID"`
-	ProblemListPriority                               NM      `hl7:"6,len=60,display=Problem List Priority"`
+	ProblemListPriority                               NM      `hl7:"6,len=60,format=NM,display=Problem List Priority"`
 	ProblemEstablishedDateTime                        TS      `hl7:"7,len=26,format=YMDHMS,display=Problem Established Date/Time"`
 	AnticipatedProblemResolutionDateTime              TS      `hl7:"8,len=26,format=YMDHMS,display=Anticipated Problem Resolution Date/Time"`
 	ActualProblemResolutionDateTime                   TS      `hl7:"9,len=26,format=YMDHMS,display=Actual Problem Resolution Date/Time"`
@@ -1687,7 +1719,7 @@ type PRB struct {
 	ProblemOnsetText                                  ST      `hl7:"17,len=80,display=Problem Onset Text"`
 	ProblemRanking                                    *CE     `hl7:"18,len=80,display=Problem Ranking"`
 	CertaintyOfProblem                                *CE     `hl7:"19,len=60,display=Certainty of Problem"`
-	ProbabilityOfProblem                              NM      `hl7:"20,len=5,display=Probability of Problem"`
+	ProbabilityOfProblem                              NM      `hl7:"20,len=5,format=NM,display=Probability of Problem"`
 	IndividualAwarenessOfProblem                      *CE     `hl7:"21,len=80,display=Individual Awareness of Problem"`
 	ProblemPrognosis                                  *CE     `hl7:"22,len=80,display=Problem Prognosis"`
 	IndividualAwarenessOfPrognosis                    *CE     `hl7:"23,len=80,display=Individual Awareness of Prognosis"`
@@ -1709,8 +1741,8 @@ type PRC struct {
 	ValidPatientClasses []IS    `hl7:"4,len=1,table=0004,display=Valid Patient Classes"`
 	Price               []CP    `hl7:"5,conditional,len=12,display=Price"`
 	Formula             []ST    `hl7:"6,len=200,display=Formula"`
-	MinimumQuantity     NM      `hl7:"7,len=4,display=Minimum Quantity"`
-	MaximumQuantity     NM      `hl7:"8,len=4,display=Maximum Quantity"`
+	MinimumQuantity     NM      `hl7:"7,len=4,format=NM,display=Minimum Quantity"`
+	MaximumQuantity     NM      `hl7:"8,len=4,format=NM,display=Maximum Quantity"`
 	MinimumPrice        *MO     `hl7:"9,len=12,display=Minimum Price"`
 	MaximumPrice        *MO     `hl7:"10,len=12,display=Maximum Price"`
 	EffectiveStartDate  TS      `hl7:"11,len=26,format=YMDHMS,display=Effective Start Date"`
@@ -1763,8 +1795,8 @@ type PSH struct {
 	QuantityInUse                                      *CQ     `hl7:"10,len=12,display=Quantity in Use"`
 	QuantityInUseMethod                                ID      `hl7:"11,len=1,table=0329,display=Quantity in Use Method"`
 	QuantityInUseComment                               FT      `hl7:"12,len=600,display=Quantity in Use Comment"`
-	NumberOfProductExperienceReportsFiledByFacility    []NM    `hl7:"13,max=8,len=2,display=Number of Product Experience Reports Filed by Facility"`
-	NumberOfProductExperienceReportsFiledByDistributor []NM    `hl7:"14,max=8,len=2,display=Number of Product Experience Reports Filed by Distributor"`
+	NumberOfProductExperienceReportsFiledByFacility    []NM    `hl7:"13,max=8,len=2,format=NM,display=Number of Product Experience Reports Filed by Facility"`
+	NumberOfProductExperienceReportsFiledByDistributor []NM    `hl7:"14,max=8,len=2,format=NM,display=Number of Product Experience Reports Filed by Distributor"`
 }
 
 // Pathway
@@ -1788,7 +1820,7 @@ type PTH struct {
 // to more than one account. Individual sites must determine the use for this segment.
 type PV1 struct {
 	HL7                     HL7Name `hl7:",name=PV1,type=s"`
-	SetID                   SI      `hl7:"1,seq,len=4,display=Set ID - PV1"`
+	SetID                   SI      `hl7:"1,seq,len=4,format=SI,display=Set ID - PV1"`
 	PatientClass            IS      `hl7:"2,required,len=1,table=0004,display=Patient Class"`
 	AssignedPatientLocation *PL     `hl7:"3,len=80,display=Assigned Patient Location"`
 	AdmissionType           IS      `hl7:"4,len=2,table=0007,display=Admission Type"`
@@ -1813,14 +1845,14 @@ type PV1 struct {
 	CreditRating            IS      `hl7:"23,len=2,table=0046,display=Credit Rating"`
 	ContractCode            []IS    `hl7:"24,len=2,table=0044,display=Contract Code"`
 	ContractEffectiveDate   []DT    `hl7:"25,len=8,format=YMD,display=Contract Effective Date"`
-	ContractAmount          []NM    `hl7:"26,len=12,display=Contract Amount"`
-	ContractPeriod          []NM    `hl7:"27,len=3,display=Contract Period"`
+	ContractAmount          []NM    `hl7:"26,len=12,format=NM,display=Contract Amount"`
+	ContractPeriod          []NM    `hl7:"27,len=3,format=NM,display=Contract Period"`
 	InterestCode            IS      `hl7:"28,len=2,table=0073,display=Interest Code"`
 	TransferToBadDebtCode   IS      `hl7:"29,len=1,table=0110,display=Transfer to Bad Debt Code"`
 	TransferToBadDebtDate   DT      `hl7:"30,len=8,format=YMD,display=Transfer to Bad Debt Date"`
 	BadDebtAgencyCode       IS      `hl7:"31,len=10,table=0021,display=Bad Debt Agency Code"`
-	BadDebtTransferAmount   NM      `hl7:"32,len=12,display=Bad Debt Transfer Amount"`
-	BadDebtRecoveryAmount   NM      `hl7:"33,len=12,display=Bad Debt Recovery Amount"`
+	BadDebtTransferAmount   NM      `hl7:"32,len=12,format=NM,display=Bad Debt Transfer Amount"`
+	BadDebtRecoveryAmount   NM      `hl7:"33,len=12,format=NM,display=Bad Debt Recovery Amount"`
 	DeleteAccountIndicator  IS      `hl7:"34,len=1,table=0111,display=Delete Account Indicator"`
 	DeleteAccountDate       DT      `hl7:"35,len=8,format=YMD,display=Delete Account Date"`
 	DischargeDisposition    IS      `hl7:"36,len=3,table=0112,display=Discharge Disposition"`
@@ -1833,10 +1865,10 @@ type PV1 struct {
 	PriorTemporaryLocation  *PL     `hl7:"43,len=80,display=Prior Temporary Location"`
 	AdmitDateTime           TS      `hl7:"44,len=26,format=YMDHMS,display=Admit Date/Time"`
 	DischargeDateTime       TS      `hl7:"45,len=26,format=YMDHMS,display=Discharge Date/Time"`
-	CurrentPatientBalance   NM      `hl7:"46,len=12,display=Current Patient Balance"`
-	TotalCharges            NM      `hl7:"47,len=12,display=Total Charges"`
-	TotalAdjustments        NM      `hl7:"48,len=12,display=Total Adjustments"`
-	TotalPayments           NM      `hl7:"49,len=12,display=Total Payments"`
+	CurrentPatientBalance   NM      `hl7:"46,len=12,format=NM,display=Current Patient Balance"`
+	TotalCharges            NM      `hl7:"47,len=12,format=NM,display=Total Charges"`
+	TotalAdjustments        NM      `hl7:"48,len=12,format=NM,display=Total Adjustments"`
+	TotalPayments           NM      `hl7:"49,len=12,format=NM,display=Total Payments"`
 	AlternateVisitID        *CX     `hl7:"50,len=20,display=Alternate Visit ID"`
 	VisitIndicator          IS      `hl7:"51,len=1,table=0326,display=Visit Indicator"`
 	OtherHealthcareProvider []XCN   `hl7:"52,len=60,table=0010,display=Other Healthcare Provider"`
@@ -1856,8 +1888,8 @@ type PV2 struct {
 	VisitUserCode                     IS      `hl7:"7,len=2,table=0130,display=Visit User Code"`
 	ExpectedAdmitDateTime             TS      `hl7:"8,len=26,format=YMDHMS,display=Expected Admit Date/Time"`
 	ExpectedDischargeDateTime         TS      `hl7:"9,len=26,format=YMDHMS,display=Expected Discharge Date/Time"`
-	EstimatedLengthOfInpatientStay    NM      `hl7:"10,len=3,display=Estimated Length of Inpatient Stay"`
-	ActualLengthOfInpatientStay       NM      `hl7:"11,len=3,display=Actual Length of Inpatient Stay"`
+	EstimatedLengthOfInpatientStay    NM      `hl7:"10,len=3,format=NM,display=Estimated Length of Inpatient Stay"`
+	ActualLengthOfInpatientStay       NM      `hl7:"11,len=3,format=NM,display=Actual Length of Inpatient Stay"`
 	VisitDescription                  ST      `hl7:"12,len=50,display=Visit Description"`
 	ReferralSourceCode                []XCN   `hl7:"13,len=90,display=Referral Source Code"`
 	PreviousServiceDate               DT      `hl7:"14,len=8,format=YMD,display=Previous Service Date"`
@@ -1866,7 +1898,7 @@ type PV2 struct {
 	PurgeStatusDate                   DT      `hl7:"17,len=8,format=YMD,display=Purge Status Date"`
 	SpecialProgramCode                IS      `hl7:"18,len=2,table=0214,display=Special Program Code"`
 	RetentionIndicator                ID      `hl7:"19,len=1,table=0136,display=Retention Indicator"`
-	ExpectedNumberOfInsurancePlans    NM      `hl7:"20,len=1,display=Expected Number of Insurance Plans"`
+	ExpectedNumberOfInsurancePlans    NM      `hl7:"20,len=1,format=NM,display=Expected Number of Insurance Plans"`
 	VisitPublicityCode                IS      `hl7:"21,len=1,table=0215,display=Visit Publicity Code"`
 	VisitProtectionIndicator          ID      `hl7:"22,len=1,table=0136,display=Visit Protection Indicator"`
 	ClinicOrganizationName            []XON   `hl7:"23,len=90,display=Clinic Organization Name"`
@@ -1938,7 +1970,7 @@ type QRF struct {
 // in two ways:
 type RDF struct {
 	HL7                   HL7Name `hl7:",name=RDF,type=s"`
-	NumberOfColumnsPerRow NM      `hl7:"1,required,len=3,display=Number of Columns per Row"`
+	NumberOfColumnsPerRow NM      `hl7:"1,required,len=3,format=NM,display=Number of Columns per Row"`
 	ColumnDescription     []RCD   `hl7:"2,required,len=40,display=Column Description"`
 }
 
@@ -1946,8 +1978,27 @@ type RDF struct {
 //
 // The RDT segment contains the row data of the tabular data response message (TBR).
 type RDT struct {
-	HL7         HL7Name `hl7:",name=RDT,type=s"`
-	ColumnValue VARIES  `hl7:"1,required,display=Column Value"`
+	HL7           HL7Name `hl7:",name=RDT,type=s"`
+	ColumnValue   VARIES  `hl7:"1,required,display=Column Value"`
+	ColumnValue2  *VARIES `hl7:"2,display=Column Value"`
+	ColumnValue3  *VARIES `hl7:"3,display=Column Value"`
+	ColumnValue4  *VARIES `hl7:"4,display=Column Value"`
+	ColumnValue5  *VARIES `hl7:"5,display=Column Value"`
+	ColumnValue6  *VARIES `hl7:"6,display=Column Value"`
+	ColumnValue7  *VARIES `hl7:"7,display=Column Value"`
+	ColumnValue8  *VARIES `hl7:"8,display=Column Value"`
+	ColumnValue9  *VARIES `hl7:"9,display=Column Value"`
+	ColumnValue10 *VARIES `hl7:"10,display=Column Value"`
+	ColumnValue11 *VARIES `hl7:"11,display=Column Value"`
+	ColumnValue12 *VARIES `hl7:"12,display=Column Value"`
+	ColumnValue13 *VARIES `hl7:"13,display=Column Value"`
+	ColumnValue14 *VARIES `hl7:"14,display=Column Value"`
+	ColumnValue15 *VARIES `hl7:"15,display=Column Value"`
+	ColumnValue16 *VARIES `hl7:"16,display=Column Value"`
+	ColumnValue17 *VARIES `hl7:"17,display=Column Value"`
+	ColumnValue18 *VARIES `hl7:"18,display=Column Value"`
+	ColumnValue19 *VARIES `hl7:"19,display=Column Value"`
+	ColumnValue20 *VARIES `hl7:"20,display=Column Value"`
 }
 
 // Referral Infomation
@@ -1978,7 +2029,7 @@ type RF1 struct {
 // or AIP).
 type RGS struct {
 	HL7               HL7Name `hl7:",name=RGS,type=s"`
-	SetID             SI      `hl7:"1,seq,required,len=4,display=Set ID - RGS"`
+	SetID             SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - RGS"`
 	SegmentActionCode ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
 	ResourceGroupID   *CE     `hl7:"3,len=200,display=Resource Group ID"`
 }
@@ -2019,11 +2070,11 @@ type RQ1 struct {
 // RQD contains the detail for each requisitioned item. See assumptions above.
 type RQD struct {
 	HL7                      HL7Name `hl7:",name=RQD,type=s"`
-	RequisitionLineNumber    SI      `hl7:"1,len=4,display=Requisition Line Number"`
+	RequisitionLineNumber    SI      `hl7:"1,len=4,format=SI,display=Requisition Line Number"`
 	ItemCodeInternal         *CE     `hl7:"2,conditional,len=60,display=Item Code - Internal"`
 	ItemCodeExternal         *CE     `hl7:"3,conditional,len=60,display=Item Code - External"`
 	HospitalItemCode         *CE     `hl7:"4,conditional,len=60,display=Hospital Item Code"`
-	RequisitionQuantity      NM      `hl7:"5,len=6,display=Requisition Quantity"`
+	RequisitionQuantity      NM      `hl7:"5,len=6,format=NM,display=Requisition Quantity"`
 	RequisitionUnitOfMeasure *CE     `hl7:"6,len=60,display=Requisition Unit of Measure"`
 	DeptCostCenter           IS      `hl7:"7,len=30,table=0319,display=Dept. Cost Center"`
 	ItemNaturalAccountCode   IS      `hl7:"8,len=30,table=0320,display=Item Natural Account Code"`
@@ -2038,19 +2089,19 @@ type RQD struct {
 // the administration data.
 type RXA struct {
 	HL7                           HL7Name `hl7:",name=RXA,type=s"`
-	GiveSubIDCounter              NM      `hl7:"1,required,len=4,display=Give Sub-ID Counter"`
-	AdministrationSubIDCounter    NM      `hl7:"2,required,len=4,display=Administration Sub-ID Counter"`
+	GiveSubIDCounter              NM      `hl7:"1,required,len=4,format=NM,display=Give Sub-ID Counter"`
+	AdministrationSubIDCounter    NM      `hl7:"2,required,len=4,format=NM,display=Administration Sub-ID Counter"`
 	DateTimeStartOfAdministration TS      `hl7:"3,required,len=26,format=YMDHMS,display=Date/Time Start of Administration"`
 	DateTimeEndOfAdministration   TS      `hl7:"4,required,len=26,format=YMDHMS,display=Date/Time End of Administration"`
 	AdministeredCode              CE      `hl7:"5,required,len=100,table=0292,display=Administered Code"`
-	AdministeredAmount            NM      `hl7:"6,required,len=20,display=Administered Amount"`
+	AdministeredAmount            NM      `hl7:"6,required,len=20,format=NM,display=Administered Amount"`
 	AdministeredUnits             *CE     `hl7:"7,conditional,len=60,display=Administered Units"`
 	AdministeredDosageForm        *CE     `hl7:"8,len=60,display=Administered Dosage Form"`
 	AdministrationNotes           []CE    `hl7:"9,len=200,display=Administration Notes"`
 	AdministeringProvider         []XCN   `hl7:"10,len=200,display=Administering Provider"`
 	AdministeredAtLocation        *LA2    `hl7:"11,conditional,len=200,display=Administered-at Location"`
 	AdministeredPer               ST      `hl7:"12,conditional,len=20,display=Administered Per"`
-	AdministeredStrength          NM      `hl7:"13,len=20,display=Administered Strength"`
+	AdministeredStrength          NM      `hl7:"13,len=20,format=NM,display=Administered Strength"`
 	AdministeredStrengthUnits     *CE     `hl7:"14,len=60,display=Administered Strength Units"`
 	SubstanceLotNumber            []ST    `hl7:"15,len=20,display=Substance Lot Number"`
 	SubstanceExpirationDate       []TS    `hl7:"16,len=26,format=YMDHMS,display=Substance Expiration Date"`
@@ -2072,9 +2123,9 @@ type RXC struct {
 	HL7                    HL7Name `hl7:",name=RXC,type=s"`
 	RXComponentType        ID      `hl7:"1,required,len=1,table=0166,display=RX Component Type"`
 	ComponentCode          CE      `hl7:"2,required,len=100,display=Component Code"`
-	ComponentAmount        NM      `hl7:"3,required,len=20,display=Component Amount"`
+	ComponentAmount        NM      `hl7:"3,required,len=20,format=NM,display=Component Amount"`
 	ComponentUnits         CE      `hl7:"4,required,len=60,display=Component Units"`
-	ComponentStrength      NM      `hl7:"5,len=20,display=Component Strength"`
+	ComponentStrength      NM      `hl7:"5,len=20,format=NM,display=Component Strength"`
 	ComponentStrengthUnits *CE     `hl7:"6,len=60,display=Component Strength Units"`
 }
 
@@ -2083,14 +2134,14 @@ type RXC struct {
 // Figure 4-17. RXD attributes
 type RXD struct {
 	HL7                                                     HL7Name `hl7:",name=RXD,type=s"`
-	DispenseSubIDCounter                                    NM      `hl7:"1,required,len=4,display=Dispense Sub-ID Counter"`
+	DispenseSubIDCounter                                    NM      `hl7:"1,required,len=4,format=NM,display=Dispense Sub-ID Counter"`
 	DispenseGiveCode                                        CE      `hl7:"2,required,len=100,table=0292,display=Dispense/Give Code"`
 	DateTimeDispensed                                       TS      `hl7:"3,required,len=26,format=YMDHMS,display=Date/Time Dispensed"`
-	ActualDispenseAmount                                    NM      `hl7:"4,required,len=20,display=Actual Dispense Amount"`
+	ActualDispenseAmount                                    NM      `hl7:"4,required,len=20,format=NM,display=Actual Dispense Amount"`
 	ActualDispenseUnits                                     *CE     `hl7:"5,conditional,len=60,display=Actual Dispense Units"`
 	ActualDosageForm                                        *CE     `hl7:"6,len=60,display=Actual Dosage Form"`
 	PrescriptionNumber                                      ST      `hl7:"7,required,len=20,display=Prescription Number"`
-	NumberOfRefillsRemaining                                NM      `hl7:"8,conditional,len=20,display=Number of Refills Remaining"`
+	NumberOfRefillsRemaining                                NM      `hl7:"8,conditional,len=20,format=NM,display=Number of Refills Remaining"`
 	DispenseNotes                                           []ST    `hl7:"9,len=200,display=Dispense Notes"`
 	DispensingProvider                                      []XCN   `hl7:"10,len=200,display=Dispensing Provider"`
 	SubstitutionStatus                                      ID      `hl7:"11,len=1,table=0167,display=Substitution Status"`
@@ -2098,13 +2149,13 @@ type RXD struct {
 	DispenseToLocation                                      *LA2    `hl7:"13,conditional,len=200,display=Dispense-To Location"`
 	NeedsHumanReview                                        ID      `hl7:"14,len=1,table=0136,display=Needs Human Review"`
 	PharmacyTreatmentSupplierSSpecialDispensingInstructions []CE    `hl7:"15,len=200,display=Pharmacy/Treatment Supplier s Special Dispensing Instructions"`
-	ActualStrength                                          NM      `hl7:"16,len=20,display=Actual Strength"`
+	ActualStrength                                          NM      `hl7:"16,len=20,format=NM,display=Actual Strength"`
 	ActualStrengthUnit                                      *CE     `hl7:"17,len=60,display=Actual Strength Unit"`
 	SubstanceLotNumber                                      []ST    `hl7:"18,len=20,display=Substance Lot Number"`
 	SubstanceExpirationDate                                 []TS    `hl7:"19,len=26,format=YMDHMS,display=Substance Expiration Date"`
 	SubstanceManufacturerName                               []CE    `hl7:"20,len=60,table=0227,display=Substance Manufacturer Name"`
 	Indication                                              []CE    `hl7:"21,len=200,display=Indication"`
-	DispensePackageSize                                     NM      `hl7:"22,len=20,display=Dispense Package Size"`
+	DispensePackageSize                                     NM      `hl7:"22,len=20,format=NM,display=Dispense Package Size"`
 	DispensePackageSizeUnit                                 *CE     `hl7:"23,len=60,display=Dispense Package Size Unit"`
 	DispensePackageMethod                                   ID      `hl7:"24,len=2,table=0321,display=Dispense Package Method"`
 }
@@ -2118,21 +2169,21 @@ type RXE struct {
 	HL7                                                     HL7Name `hl7:",name=RXE,type=s"`
 	QuantityTiming                                          TQ      `hl7:"1,required,len=200,display=Quantity/Timing"`
 	GiveCode                                                CE      `hl7:"2,required,len=100,table=0292,display=Give Code"`
-	GiveAmountMinimum                                       NM      `hl7:"3,required,len=20,display=Give Amount - Minimum"`
-	GiveAmountMaximum                                       NM      `hl7:"4,len=20,display=Give Amount - Maximum"`
+	GiveAmountMinimum                                       NM      `hl7:"3,required,len=20,format=NM,display=Give Amount - Minimum"`
+	GiveAmountMaximum                                       NM      `hl7:"4,len=20,format=NM,display=Give Amount - Maximum"`
 	GiveUnits                                               CE      `hl7:"5,required,len=60,display=Give Units"`
 	GiveDosageForm                                          *CE     `hl7:"6,len=60,display=Give Dosage Form"`
 	ProviderAdministrationInstructions                      []CE    `hl7:"7,len=200,display=Provider Administration Instructions"`
 	DeliverToLocation                                       *LA1    `hl7:"8,conditional,len=200,display=Deliver-to Location"`
 	SubstitutionStatus                                      ID      `hl7:"9,len=1,table=0167,display=Substitution Status"`
-	DispenseAmount                                          NM      `hl7:"10,conditional,len=20,display=Dispense Amount"`
+	DispenseAmount                                          NM      `hl7:"10,conditional,len=20,format=NM,display=Dispense Amount"`
 	DispenseUnits                                           *CE     `hl7:"11,conditional,len=60,display=Dispense Units"`
-	NumberOfRefills                                         NM      `hl7:"12,len=3,display=Number Of Refills"`
+	NumberOfRefills                                         NM      `hl7:"12,len=3,format=NM,display=Number Of Refills"`
 	OrderingProviderSDEANumber                              []XCN   `hl7:"13,conditional,len=60,display=Ordering Provider s DEA Number"`
 	PharmacistTreatmentSupplierSVerifierID                  []XCN   `hl7:"14,len=60,display=Pharmacist/Treatment Supplier s Verifier ID"`
 	PrescriptionNumber                                      ST      `hl7:"15,conditional,len=20,display=Prescription Number"`
-	NumberOfRefillsRemaining                                NM      `hl7:"16,conditional,len=20,display=Number of Refills Remaining"`
-	NumberOfRefillsDosesDispensed                           NM      `hl7:"17,conditional,len=20,display=Number of Refills/Doses Dispensed"`
+	NumberOfRefillsRemaining                                NM      `hl7:"16,conditional,len=20,format=NM,display=Number of Refills Remaining"`
+	NumberOfRefillsDosesDispensed                           NM      `hl7:"17,conditional,len=20,format=NM,display=Number of Refills/Doses Dispensed"`
 	DTOfMostRecentRefillOrDoseDispensed                     TS      `hl7:"18,conditional,len=26,format=YMDHMS,display=D/T of Most Recent Refill or Dose Dispensed"`
 	TotalDailyDose                                          *CQ     `hl7:"19,conditional,len=10,display=Total Daily Dose"`
 	NeedsHumanReview                                        ID      `hl7:"20,len=1,table=0136,display=Needs Human Review"`
@@ -2140,10 +2191,10 @@ type RXE struct {
 	GivePer                                                 ST      `hl7:"22,conditional,len=20,display=Give Per"`
 	GiveRateAmount                                          ST      `hl7:"23,len=6,display=Give Rate Amount"`
 	GiveRateUnits                                           *CE     `hl7:"24,len=60,display=Give Rate Units"`
-	GiveStrength                                            NM      `hl7:"25,len=20,display=Give Strength"`
+	GiveStrength                                            NM      `hl7:"25,len=20,format=NM,display=Give Strength"`
 	GiveStrengthUnits                                       *CE     `hl7:"26,len=60,display=Give Strength Units"`
 	GiveIndication                                          []CE    `hl7:"27,len=200,display=Give Indication"`
-	DispensePackageSize                                     NM      `hl7:"28,len=20,display=Dispense Package Size"`
+	DispensePackageSize                                     NM      `hl7:"28,len=20,format=NM,display=Dispense Package Size"`
 	DispensePackageSizeUnit                                 *CE     `hl7:"29,len=60,display=Dispense Package Size Unit"`
 	DispensePackageMethod                                   ID      `hl7:"30,len=2,table=0321,display=Dispense Package Method"`
 }
@@ -2153,12 +2204,12 @@ type RXE struct {
 // Figure 4-18. RXG attributes
 type RXG struct {
 	HL7                                                         HL7Name `hl7:",name=RXG,type=s"`
-	GiveSubIDCounter                                            NM      `hl7:"1,required,len=4,display=Give Sub-ID Counter"`
-	DispenseSubIDCounter                                        NM      `hl7:"2,len=4,display=Dispense Sub-ID Counter"`
+	GiveSubIDCounter                                            NM      `hl7:"1,required,len=4,format=NM,display=Give Sub-ID Counter"`
+	DispenseSubIDCounter                                        NM      `hl7:"2,len=4,format=NM,display=Dispense Sub-ID Counter"`
 	QuantityTiming                                              TQ      `hl7:"3,required,len=200,display=Quantity/Timing"`
 	GiveCode                                                    CE      `hl7:"4,required,len=100,table=0292,display=Give Code"`
-	GiveAmountMinimum                                           NM      `hl7:"5,required,len=20,display=Give Amount - Minimum"`
-	GiveAmountMaximum                                           NM      `hl7:"6,len=20,display=Give Amount - Maximum"`
+	GiveAmountMinimum                                           NM      `hl7:"5,required,len=20,format=NM,display=Give Amount - Minimum"`
+	GiveAmountMaximum                                           NM      `hl7:"6,len=20,format=NM,display=Give Amount - Maximum"`
 	GiveUnits                                                   CE      `hl7:"7,required,len=60,display=Give Units"`
 	GiveDosageForm                                              *CE     `hl7:"8,len=60,display=Give Dosage Form"`
 	AdministrationNotes                                         []CE    `hl7:"9,len=200,display=Administration Notes"`
@@ -2169,7 +2220,7 @@ type RXG struct {
 	GivePer                                                     ST      `hl7:"14,conditional,len=20,display=Give Per"`
 	GiveRateAmount                                              ST      `hl7:"15,len=6,display=Give Rate Amount"`
 	GiveRateUnits                                               *CE     `hl7:"16,len=60,display=Give Rate Units"`
-	GiveStrength                                                NM      `hl7:"17,len=20,display=Give Strength"`
+	GiveStrength                                                NM      `hl7:"17,len=20,format=NM,display=Give Strength"`
 	GiveStrengthUnits                                           *CE     `hl7:"18,len=60,display=Give Strength Units"`
 	SubstanceLotNumber                                          []ST    `hl7:"19,len=20,display=Substance Lot Number"`
 	SubstanceExpirationDate                                     []TS    `hl7:"20,len=26,format=YMDHMS,display=Substance Expiration Date"`
@@ -2184,8 +2235,8 @@ type RXG struct {
 type RXO struct {
 	HL7                                    HL7Name `hl7:",name=RXO,type=s"`
 	RequestedGiveCode                      *CE     `hl7:"1,conditional,len=100,display=Requested Give Code"`
-	RequestedGiveAmountMinimum             NM      `hl7:"2,conditional,len=20,display=Requested Give Amount - Minimum"`
-	RequestedGiveAmountMaximum             NM      `hl7:"3,len=20,display=Requested Give Amount - Maximum"`
+	RequestedGiveAmountMinimum             NM      `hl7:"2,conditional,len=20,format=NM,display=Requested Give Amount - Minimum"`
+	RequestedGiveAmountMaximum             NM      `hl7:"3,len=20,format=NM,display=Requested Give Amount - Maximum"`
 	RequestedGiveUnits                     *CE     `hl7:"4,conditional,len=60,display=Requested Give Units"`
 	RequestedDosageForm                    *CE     `hl7:"5,len=60,display=Requested Dosage Form"`
 	ProviderSPharmacyTreatmentInstructions []CE    `hl7:"6,len=200,display=Provider s Pharmacy/Treatment Instructions"`
@@ -2193,14 +2244,14 @@ type RXO struct {
 	DeliverToLocation                      *LA1    `hl7:"8,len=200,display=Deliver-to Location"`
 	AllowSubstitutions                     ID      `hl7:"9,len=1,table=0161,display=Allow Substitutions"`
 	RequestedDispenseCode                  *CE     `hl7:"10,len=100,display=Requested Dispense Code"`
-	RequestedDispenseAmount                NM      `hl7:"11,len=20,display=Requested Dispense Amount"`
+	RequestedDispenseAmount                NM      `hl7:"11,len=20,format=NM,display=Requested Dispense Amount"`
 	RequestedDispenseUnits                 *CE     `hl7:"12,len=60,display=Requested Dispense Units"`
-	NumberOfRefills                        NM      `hl7:"13,len=3,display=Number Of Refills"`
+	NumberOfRefills                        NM      `hl7:"13,len=3,format=NM,display=Number Of Refills"`
 	OrderingProviderSDEANumber             []XCN   `hl7:"14,conditional,len=60,display=Ordering Provider s DEA Number"`
 	PharmacistTreatmentSupplierSVerifierID []XCN   `hl7:"15,conditional,len=60,display=Pharmacist/Treatment Supplier s Verifier ID"`
 	NeedsHumanReview                       ID      `hl7:"16,len=1,table=0136,display=Needs Human Review"`
 	RequestedGivePer                       ST      `hl7:"17,conditional,len=20,display=Requested Give Per"`
-	RequestedGiveStrength                  NM      `hl7:"18,len=20,display=Requested Give Strength"`
+	RequestedGiveStrength                  NM      `hl7:"18,len=20,format=NM,display=Requested Give Strength"`
 	RequestedGiveStrengthUnits             *CE     `hl7:"19,len=60,display=Requested Give Strength Units"`
 	Indication                             []CE    `hl7:"20,len=200,display=Indication"`
 	RequestedGiveRateAmount                ST      `hl7:"21,len=6,display=Requested Give Rate Amount"`
@@ -2229,13 +2280,13 @@ type SCH struct {
 	HL7                       HL7Name `hl7:",name=SCH,type=s"`
 	PlacerAppointmentID       *EI     `hl7:"1,conditional,len=75,display=Placer Appointment ID"`
 	FillerAppointmentID       *EI     `hl7:"2,conditional,len=75,display=Filler Appointment ID"`
-	OccurrenceNumber          NM      `hl7:"3,conditional,len=5,display=Occurrence Number"`
+	OccurrenceNumber          NM      `hl7:"3,conditional,len=5,format=NM,display=Occurrence Number"`
 	PlacerGroupNumber         *EI     `hl7:"4,len=22,display=Placer Group Number"`
 	ScheduleID                *CE     `hl7:"5,len=200,display=Schedule ID"`
 	EventReason               CE      `hl7:"6,required,len=200,display=Event Reason"`
 	AppointmentReason         *CE     `hl7:"7,len=200,table=0276,display=Appointment Reason"`
 	AppointmentType           *CE     `hl7:"8,len=200,table=0277,display=Appointment Type"`
-	AppointmentDuration       NM      `hl7:"9,len=20,display=Appointment Duration"`
+	AppointmentDuration       NM      `hl7:"9,len=20,format=NM,display=Appointment Duration"`
 	AppointmentDurationUnits  *CE     `hl7:"10,len=200,display=Appointment Duration Units"`
 	AppointmentTimingQuantity []TQ    `hl7:"11,required,len=200,display=Appointment Timing Quantity"`
 	PlacerContactPerson       []XCN   `hl7:"12,len=48,display=Placer Contact Person"`
@@ -2314,7 +2365,7 @@ type STF struct {
 // text.
 type TXA struct {
 	HL7                             HL7Name `hl7:",name=TXA,type=s"`
-	SetID                           SI      `hl7:"1,seq,required,len=4,display=Set ID - TXA"`
+	SetID                           SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - TXA"`
 	DocumentType                    IS      `hl7:"2,required,len=30,table=0270,display=Document Type"`
 	DocumentContentPresentation     ID      `hl7:"3,conditional,len=2,table=0191,display=Document Content Presentation"`
 	ActivityDateTime                TS      `hl7:"4,len=26,format=YMDHMS,display=Activity Date/Time"`
@@ -2347,17 +2398,17 @@ type TXA struct {
 // Refer to a UB specification for additional information.
 type UB1 struct {
 	HL7                     HL7Name `hl7:",name=UB1,type=s"`
-	SetID                   SI      `hl7:"1,seq,len=4,display=Set ID - UB1"`
-	BloodDeductible         NM      `hl7:"2,len=1,display=Blood Deductible"`
-	BloodFurnishedPintsOf   NM      `hl7:"3,len=2,display=Blood Furnished-Pints Of"`
-	BloodReplacedPints      NM      `hl7:"4,len=2,display=Blood Replaced-Pints"`
-	BloodNotReplacedPints   NM      `hl7:"5,len=2,display=Blood Not Replaced-Pints"`
-	CoInsuranceDays         NM      `hl7:"6,len=2,display=Co-Insurance Days"`
+	SetID                   SI      `hl7:"1,seq,len=4,format=SI,display=Set ID - UB1"`
+	BloodDeductible         NM      `hl7:"2,len=1,format=NM,display=Blood Deductible"`
+	BloodFurnishedPintsOf   NM      `hl7:"3,len=2,format=NM,display=Blood Furnished-Pints Of"`
+	BloodReplacedPints      NM      `hl7:"4,len=2,format=NM,display=Blood Replaced-Pints"`
+	BloodNotReplacedPints   NM      `hl7:"5,len=2,format=NM,display=Blood Not Replaced-Pints"`
+	CoInsuranceDays         NM      `hl7:"6,len=2,format=NM,display=Co-Insurance Days"`
 	ConditionCode           []IS    `hl7:"7,max=5,len=14,table=0043,display=Condition Code"`
-	CoveredDays             NM      `hl7:"8,len=3,display=Covered Days -"`
-	NonCoveredDays          NM      `hl7:"9,len=3,display=Non Covered Days -"`
+	CoveredDays             NM      `hl7:"8,len=3,format=NM,display=Covered Days -"`
+	NonCoveredDays          NM      `hl7:"9,len=3,format=NM,display=Non Covered Days -"`
 	ValueAmountCode         []UVC   `hl7:"10,max=8,len=12,display=Value Amount & Code"`
-	NumberOfGraceDays       NM      `hl7:"11,len=2,display=Number Of Grace Days"`
+	NumberOfGraceDays       NM      `hl7:"11,len=2,format=NM,display=Number Of Grace Days"`
 	SpecialProgramIndicator *CE     `hl7:"12,len=60,table=0348,display=Special Program Indicator"`
 	PSROURApprovalIndicator *CE     `hl7:"13,len=60,table=0349,display=PSRO/UR Approval Indicator"`
 	PSROURApprovedStayFm    DT      `hl7:"14,len=8,format=YMD,display=PSRO/UR Approved Stay-Fm"`
@@ -2381,7 +2432,7 @@ type UB1 struct {
 // list; refer to a UB specification for additional information.
 type UB2 struct {
 	HL7                     HL7Name `hl7:",name=UB2,type=s"`
-	SetID                   SI      `hl7:"1,seq,len=4,display=Set ID - UB2"`
+	SetID                   SI      `hl7:"1,seq,len=4,format=SI,display=Set ID - UB2"`
 	CoInsuranceDays         ST      `hl7:"2,len=3,display=Co-Insurance Days"`
 	ConditionCode           []IS    `hl7:"3,max=7,len=2,table=0043,display=Condition Code"`
 	CoveredDays             ST      `hl7:"4,len=3,display=Covered Days"`
@@ -2397,7 +2448,7 @@ type UB2 struct {
 	UB92Locator56           []ST    `hl7:"14,max=5,len=14,display=UB92 Locator 56"`
 	UB92Locator57           ST      `hl7:"15,len=27,display=UB92 Locator 57"`
 	UB92Locator78           []ST    `hl7:"16,max=2,len=2,display=UB92 Locator 78"`
-	SpecialVisitCount       NM      `hl7:"17,len=3,display=Special Visit Count"`
+	SpecialVisitCount       NM      `hl7:"17,len=3,format=NM,display=Special Visit Count"`
 }
 
 // Results/update definition segment
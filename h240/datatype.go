@@ -1,4 +1,4 @@
-// Code generated by "hl7fetch -pkgdir h240 -root ./genjson -version 2.4"; DO NOT EDIT.
+// Code generated by "hl7fetch -pkgdir h240 -root ./genjson -version 2.4 -enum"; DO NOT EDIT.
 
 package h240
 
@@ -62,7 +62,7 @@ type CE struct {
 // |128952^6^M11^ADT01|
 type CK struct {
 	HL7                                        HL7Name `hl7:",name=CK,len=250,type=d"`
-	IDNumber                                   NM      `hl7:"1,display=Id Number"`
+	IDNumber                                   NM      `hl7:"1,format=NM,display=Id Number"`
 	CheckDigit                                 ST      `hl7:"2,display=The check digit in this data type is not an add-on produced by the message processor. It is the check digit that is part of the identifying number used in the sending application. If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null."`
 	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"3,table=0061,display=The check digit scheme codes are defined in HL7 Table 0061 - Check digit scheme."`
 	AssigningAuthority                         *HD     `hl7:"4,display=The assigning authority is a unique identifier of the system (or organization or agency or department) that creates the data. It is a HD data type. Assigning authorities are unique across a given HL7 implementation. User-defined Table 0363 - Assigning authorit y is used as the HL7 identifier for the user-defined table of values for the first sub-component- namespace ID."`
@@ -110,8 +110,8 @@ type CP struct {
 	HL7        HL7Name `hl7:",name=CP,len=0,type=d"`
 	Price      *MO     `hl7:"1,display=The only required component; usually containing a decimal point. Note that each component of the MO data type (Section 2.9.26- MO - money) is a subcomponent here."`
 	PriceType  ID      `hl7:"2,table=0205,display=A coded value- data type ID. Refer to HL7 Table 0205 - Price type for valid values."`
-	FromValue  NM      `hl7:"3,display=Each is a NM data type; together they specify the range. The range can be defined as either time or quantity. For example- the range can indicate that the first 10 minutes of the procedure has one price. Another repetition of the data type can use the range to specify that the following 10 to 60 minutes of the procedure is charged at another price per; a final repetition can specify that the final 60 to N minutes of the procedure at a third price."`
-	ToValue    NM      `hl7:"4,display=See <from value> above."`
+	FromValue  NM      `hl7:"3,format=NM,display=Each is a NM data type; together they specify the range. The range can be defined as either time or quantity. For example- the range can indicate that the first 10 minutes of the procedure has one price. Another repetition of the data type can use the range to specify that the following 10 to 60 minutes of the procedure is charged at another price per; a final repetition can specify that the final 60 to N minutes of the procedure at a third price."`
+	ToValue    NM      `hl7:"4,format=NM,display=See <from value> above."`
 	RangeUnits *CE     `hl7:"5,display=A coded value- data type CE- defined by the standard table of units for either time or quantity  (see for example- the tables in Section 7.1.4- “Coding schemes”).  This describes the units associated with the range- e.g.- seconds- minutes- hours- days- quantity (i.e.- count); it is required if <from value> and  <to value> are present.  Subcomponents of range units: <identifier (ST)> & <text (ST)> & <name of coding system (IS)> & <alternate identifier (ST)> & <alternate text (ST)> & <name of alternate coding system (IS)>"`
 	RangeType  ID      `hl7:"6,table=0298,display=Refers to HL7 Table 0298 - CP range type for valid values."`
 }
@@ -126,7 +126,7 @@ type CP struct {
 // |150^lb&&ANSI+| weight in pounds is a customary US unit defined within ANSI+.
 type CQ struct {
 	HL7      HL7Name `hl7:",name=CQ,len=0,type=d"`
-	Quantity NM      `hl7:"1,display=Quantity"`
+	Quantity NM      `hl7:"1,format=NM,display=Quantity"`
 	Units    ST      `hl7:"2,display=The units in which the quantity is expressed. Field-by-field- default units may be defined within the specifications. When the observation is measured in the default units- the units need not be transmitted. If the measure is recorded in units different from the default- the measurement units must be transmitted as the second component. If the units are ISO+ units- then units should be recorded as lowercase abbreviations as specified in Chapter 7. If the units are ANSI or local- the units and the source table must be recorded as specified in Chapter 7. But in these cases the component separator should be replaced by the subcomponent delimiter"`
 }
 
@@ -165,9 +165,9 @@ type CX struct {
 // Daily Deductible
 type DDI struct {
 	HL7          HL7Name `hl7:",name=DDI,len=0,type=d"`
-	DelayDays    NM      `hl7:"1,display=Delay Days"`
-	Amount       NM      `hl7:"2,display=Amount"`
-	NumberOfDays NM      `hl7:"3,display=Number Of Days"`
+	DelayDays    NM      `hl7:"1,format=NM,display=Delay Days"`
+	Amount       NM      `hl7:"2,format=NM,display=Amount"`
+	NumberOfDays NM      `hl7:"3,format=NM,display=Number Of Days"`
 }
 
 // Activation Date
@@ -199,9 +199,9 @@ type DLN struct {
 type DLT struct {
 	HL7               HL7Name `hl7:",name=DLT,len=0,type=d"`
 	Range             *NR     `hl7:"1,display=Range"`
-	NumericThreshold  NM      `hl7:"2,display=Numeric Threshold"`
+	NumericThreshold  NM      `hl7:"2,format=NM,display=Numeric Threshold"`
 	ChangeComputation ST      `hl7:"3,display=Change Computation"`
-	LengthOfTimeDays  NM      `hl7:"4,display=Length Of Time-days"`
+	LengthOfTimeDays  NM      `hl7:"4,format=NM,display=Length Of Time-days"`
 }
 
 // Date/time Range
@@ -229,7 +229,7 @@ type DT = time.Time
 type DTN struct {
 	HL7          HL7Name `hl7:",name=DTN,len=0,type=d"`
 	DayType      IS      `hl7:"1,table=0149,display=Day Type"`
-	NumberOfDays NM      `hl7:"2,display=Number Of Days"`
+	NumberOfDays NM      `hl7:"2,format=NM,display=Number Of Days"`
 }
 
 // Entity Identifier
@@ -254,8 +254,8 @@ type EIP struct {
 type ELD struct {
 	HL7                  HL7Name `hl7:",name=ELD,len=0,type=d"`
 	SegmentID            ST      `hl7:"1,display=Segment ID"`
-	Sequence             NM      `hl7:"2,display=Sequence"`
-	FieldPosition        NM      `hl7:"3,display=Field Position"`
+	Sequence             NM      `hl7:"2,format=NM,display=Sequence"`
+	FieldPosition        NM      `hl7:"3,format=NM,display=Field Position"`
 	CodeIdentifyingError *CE     `hl7:"4,display=Code Identifying Error"`
 }
 
@@ -359,7 +359,7 @@ type LA2 struct {
 // Note: Intent is that it appear only as a component of data type CP.
 type MO struct {
 	HL7          HL7Name `hl7:",name=MO,len=0,type=d"`
-	Quantity     NM      `hl7:"1,display=The first component is a quantity."`
+	Quantity     NM      `hl7:"1,format=NM,display=The first component is a quantity."`
 	Denomination ID      `hl7:"2,table=ISO4217,display=The second component is the denomination in which the quantity is expressed. The values for the denomination component are those specified in ISO-4217. If the denomination is not specified- MSH-17-country code is used to determine the default.   Example: |99.50^USD|  where USD is the ISO 4217 code for the U.S. American dollar."`
 }
 
@@ -374,7 +374,7 @@ type MOC struct {
 type MOP struct {
 	HL7                        HL7Name `hl7:",name=MOP,len=0,type=d"`
 	MoneyOrPercentageIndicator IS      `hl7:"1,table=0148,display=Money Or Percentage Indicator"`
-	MoneyOrPercentageQuantity  NM      `hl7:"2,display=Money Or Percentage Quantity"`
+	MoneyOrPercentageQuantity  NM      `hl7:"2,format=NM,display=Money Or Percentage Quantity"`
 }
 
 // Message Type
@@ -407,10 +407,10 @@ func (d MSG) MessageStructureID() []string {
 // is determined by the maximum field length
 type NA struct {
 	HL7    HL7Name `hl7:",name=NA,len=0,type=d"`
-	Value1 NM      `hl7:"1,display=Value1"`
-	Value2 NM      `hl7:"2,display=Value2"`
-	Value3 NM      `hl7:"3,display=Value3"`
-	Value4 NM      `hl7:"4,display=Value4"`
+	Value1 NM      `hl7:"1,format=NM,display=Value1"`
+	Value2 NM      `hl7:"2,format=NM,display=Value2"`
+	Value3 NM      `hl7:"3,format=NM,display=Value3"`
+	Value4 NM      `hl7:"4,format=NM,display=Value4"`
 }
 
 // Observing Practitioner
@@ -443,8 +443,8 @@ type NM = string
 // Wertebereich
 type NR struct {
 	HL7       HL7Name `hl7:",name=NR,len=0,type=d"`
-	LowValue  NM      `hl7:"1,display=Low Value"`
-	HighValue NM      `hl7:"2,display=High Value"`
+	LowValue  NM      `hl7:"1,format=NM,display=Low Value"`
+	HighValue NM      `hl7:"2,format=NM,display=High Value"`
 }
 
 // Occurence
@@ -463,7 +463,7 @@ type OSD struct {
 	FillerOrderNumberEntityIdentifier ST      `hl7:"4,required,display=Filler Order Number: Entity Identifier"`
 	FillerOrderNumberNamespaceID      IS      `hl7:"5,table=0363,display=Filler Order Number: Namespace ID"`
 	SequenceConditionValue            ST      `hl7:"6,required,display=Sequence Condition Value"`
-	MaximumNumberOfRepeats            NM      `hl7:"7,required,display=The maximum number of repeats to be used only on cyclic groups.  The total number of repeats is constrained by the end date/time of the last repeat or the end date/time of the parent- whichever is first."`
+	MaximumNumberOfRepeats            NM      `hl7:"7,required,format=NM,display=The maximum number of repeats to be used only on cyclic groups.  The total number of repeats is constrained by the end date/time of the last repeat or the end date/time of the parent- whichever is first."`
 	PlacerOrderNumberUniversalID      ST      `hl7:"8,required,display=Placer Order Number: Universal ID"`
 	PlacerOrderNumberUniversalIDType  ID      `hl7:"9,table=0301,display=Placer Order Number; Universal ID Type"`
 	FillerOrderNumberUniversalID      ST      `hl7:"10,required,display=Filler Order Number: Universal ID"`
@@ -585,7 +585,7 @@ type PTA struct {
 	HL7         HL7Name `hl7:",name=PTA,len=0,type=d"`
 	PolicyType  IS      `hl7:"1,table=0147,display=Policy Type"`
 	AmountClass IS      `hl7:"2,table=0193,display=Amount Class"`
-	Amount      NM      `hl7:"3,display=Amount"`
+	Amount      NM      `hl7:"3,format=NM,display=Amount"`
 }
 
 // Query Input Parameter List
@@ -628,7 +628,7 @@ type RCD struct {
 	HL7                HL7Name `hl7:",name=RCD,len=0,type=d"`
 	SegmentFieldName   ST      `hl7:"1,display=The HL7 segment field name- which identifies the field occupying the column. (Refer to Section 2.9.33.1- Segment field name (ST)- for segment field name definition conventions.)"`
 	Hl7DataType        ST      `hl7:"2,table=0440,display=The two or three character HL7 data type. Refer to HL7 Table 0440 - Data Types for valid values."`
-	MaximumColumnWidth NM      `hl7:"3,display=The maximum width of the column- as dictated by the responding system. (This may vary from the HL7-defined maximum field length.)"`
+	MaximumColumnWidth NM      `hl7:"3,format=NM,display=The maximum width of the column- as dictated by the responding system. (This may vary from the HL7-defined maximum field length.)"`
 }
 
 // Reference Range
@@ -659,7 +659,7 @@ type RMC struct {
 	HL7            HL7Name `hl7:",name=RMC,len=0,type=d"`
 	RoomType       IS      `hl7:"1,table=0145,display=Room Type"`
 	AmountType     IS      `hl7:"2,table=0146,display=Amount Type"`
-	CoverageAmount NM      `hl7:"3,display=Coverage Amount"`
+	CoverageAmount NM      `hl7:"3,format=NM,display=Coverage Amount"`
 }
 
 // Street Address
@@ -701,9 +701,9 @@ type SI = string
 type SN struct {
 	HL7             HL7Name `hl7:",name=SN,len=0,type=d"`
 	Comparator      ST      `hl7:"1,display=Defined as greater than- less than- greater than or equal- less than or equal- equal- and not equal- respectively (: > or < or >: or <: or : or <>"`
-	Num1            NM      `hl7:"2,display=A number."`
+	Num1            NM      `hl7:"2,format=NM,display=A number."`
 	SeparatorSuffix ST      `hl7:"3,display=- or + or / or . or :"`
-	Num2            NM      `hl7:"4,display=A number or null depending on the measurement."`
+	Num2            NM      `hl7:"4,format=NM,display=A number or null depending on the measurement."`
 }
 
 // Specialty
@@ -788,7 +788,7 @@ type TQ struct {
 	ConjunctionComponent ID      `hl7:"9,table=0472,display=This non-null component indicates that a second timing specification is to follow using the repeat delimiter.  This field can take three values as shown in HL7 table 0472 - TQ Conjunction ID."`
 	OrderSequencing      *OSD    `hl7:"10,display=There are many situations- such as the creation of an order for a group of intravenous (IV) solutions- where the sequence of the individual intravenous solutions (each a service in itself) needs to be specified- e.g.- hyperalimentation with multi-vitamins in every third bottle."`
 	OccurrenceDuration   *CE     `hl7:"11,display=This field contains the duration for a single performance of a service- e.g.- whirlpool twenty minutes three times per day for three days. It is optional within TQ and does not repeat.  Note: The component delimiter in this CQ is demoted to a subcomponent delimiter."`
-	TotalOccurences      NM      `hl7:"12,display=This field contains the total number of occurrences of a service that should result from this order.  It is optional within TQ and does not repeat.  If both the end date/time and the total occurrences are valued and the occurrences would extend beyond the end date/time- then the end date/time takes precedence.  Otherwise the number of occurrences takes precedence."`
+	TotalOccurences      NM      `hl7:"12,format=NM,display=This field contains the total number of occurrences of a service that should result from this order.  It is optional within TQ and does not repeat.  If both the end date/time and the total occurrences are valued and the occurrences would extend beyond the end date/time- then the end date/time takes precedence.  Otherwise the number of occurrences takes precedence."`
 }
 
 // Time Stamp
@@ -827,7 +827,7 @@ type TX = string
 type UVC struct {
 	HL7         HL7Name `hl7:",name=UVC,len=0,type=d"`
 	ValueCode   IS      `hl7:"1,table=0153,display=Value Code"`
-	ValueAmount NM      `hl7:"2,display=Value Amount"`
+	ValueAmount NM      `hl7:"2,format=NM,display=Value Amount"`
 }
 
 // Variable Datatype
@@ -919,7 +919,7 @@ type XON struct {
 	HL7                                        HL7Name `hl7:",name=XON,len=250,type=d"`
 	OrganizationName                           ST      `hl7:"1,display=The name of the specified organization."`
 	OrganizationNameTypeCode                   IS      `hl7:"2,table=0204,display=A code that represents the type of name i.e.- legal name- display name. Refer to User-defined Table 0204 - Organizational name type for suggested values."`
-	IDNumber                                   NM      `hl7:"3,display=Id Number"`
+	IDNumber                                   NM      `hl7:"3,format=NM,display=Id Number"`
 	CheckDigit                                 ST      `hl7:"4,display=The check digit in this data type is not an add-on produced by the message processor. It is the check digit that is part of the identifying number used in the sending application. If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null."`
 	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"5,table=0061,display=The check digit scheme codes are defined in HL7 Table 0061 - Check digit scheme."`
 	AssigningAuthority                         *HD     `hl7:"6,display=The assigning authority is a unique identifier of the system (or organization or agency or department) that creates the data. Assigning authorities are unique across a given HL7 implementation. User-defined Table 0363 - Assigning authority is used as the HL7 identifier for the user-defined table of values for the first sub-component of the HD component <namespace ID>."`
@@ -961,9 +961,9 @@ type XTN struct {
 	TelecommunicationUseCode       ID      `hl7:"2,table=0201,display=A code that represents a specific use of a telecommunicationnumber. Refer to HL7 Table 0201 - Telecommunication use code for valid values."`
 	TelecommunicationEquipmentType ID      `hl7:"3,table=0202,display=A code that represents the type of telecommunicationequipment. Refer to HL7 Table 0202 - Telecommunication equipment type for valid values."`
 	EmailAddress                   ST      `hl7:"4,display=Email Address"`
-	CountryCode                    NM      `hl7:"5,display=Country Code"`
-	AreaCityCode                   NM      `hl7:"6,display=Area/City Code"`
-	PhoneNumber                    NM      `hl7:"7,display=Phone Number"`
-	Extension                      NM      `hl7:"8,display=Extension"`
+	CountryCode                    NM      `hl7:"5,format=NM,display=Country Code"`
+	AreaCityCode                   NM      `hl7:"6,format=NM,display=Area/City Code"`
+	PhoneNumber                    NM      `hl7:"7,format=NM,display=Phone Number"`
+	Extension                      NM      `hl7:"8,format=NM,display=Extension"`
 	AnyText                        ST      `hl7:"9,display=Any Text"`
 }
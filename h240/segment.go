@@ -1,4 +1,4 @@
-// Code generated by "hl7fetch -pkgdir h240 -root ./genjson -version 2.4"; DO NOT EDIT.
+// Code generated by "hl7fetch -pkgdir h240 -root ./genjson -version 2.4 -enum"; DO NOT EDIT.
 
 package h240
 
@@ -24,7 +24,7 @@ type ABS struct {
 	CaseCategoryCode           *CE     `hl7:"9,len=250,table=0423,display=Case Category Code"`
 	CaesarianSectionIndicator  ID      `hl7:"10,len=1,table=0136,display=Caesarian Section Indicator"`
 	GestationCategoryCode      *CE     `hl7:"11,len=250,table=0424,display=Gestation Category Code"`
-	GestationPeriodWeeks       NM      `hl7:"12,len=3,display=Gestation Period - Weeks"`
+	GestationPeriodWeeks       NM      `hl7:"12,len=3,format=NM,display=Gestation Period - Weeks"`
 	NewbornCode                *CE     `hl7:"13,len=250,table=0425,display=Newborn Code"`
 	StillbornIndicator         ID      `hl7:"14,len=1,table=0136,display=Stillborn Indicator"`
 }
@@ -61,7 +61,7 @@ type ADD struct {
 // by the STF segment is/was associated
 type AFF struct {
 	HL7                                          HL7Name `hl7:",name=AFF,type=s"`
-	SetID                                        SI      `hl7:"1,seq,required,len=60,display=Set ID - AFF"`
+	SetID                                        SI      `hl7:"1,seq,required,len=60,format=SI,display=Set ID - AFF"`
 	ProfessionalOrganization                     XON     `hl7:"2,required,len=250,display=Professional Organization"`
 	ProfessionalOrganizationAddress              *XAD    `hl7:"3,len=250,display=Professional Organization Address"`
 	ProfessionalOrganizationAffiliationDateRange []DR    `hl7:"4,len=52,display=Professional Organization Affiliation Date Range"`
@@ -77,17 +77,17 @@ type AFF struct {
 // are identified with a simple identification code.
 type AIG struct {
 	HL7                      HL7Name `hl7:",name=AIG,type=s"`
-	SetID                    SI      `hl7:"1,seq,required,len=4,display=Set ID - AIG"`
+	SetID                    SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - AIG"`
 	SegmentActionCode        ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
 	ResourceID               *CE     `hl7:"3,conditional,len=250,display=Resource ID"`
 	ResourceType             CE      `hl7:"4,required,len=250,display=Resource Type"`
 	ResourceGroup            []CE    `hl7:"5,len=250,display=Resource Group"`
-	ResourceQuantity         NM      `hl7:"6,len=5,display=Resource Quantity"`
+	ResourceQuantity         NM      `hl7:"6,len=5,format=NM,display=Resource Quantity"`
 	ResourceQuantityUnits    *CE     `hl7:"7,len=250,display=Resource Quantity Units"`
 	StartDateTime            TS      `hl7:"8,conditional,len=26,format=YMDHMS,display=Start Date/Time"`
-	StartDateTimeOffset      NM      `hl7:"9,conditional,len=20,display=Start Date/Time Offset"`
+	StartDateTimeOffset      NM      `hl7:"9,conditional,len=20,format=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits *CE     `hl7:"10,conditional,len=250,display=Start Date/Time Offset Units"`
-	Duration                 NM      `hl7:"11,len=20,display=Duration"`
+	Duration                 NM      `hl7:"11,len=20,format=NM,display=Duration"`
 	DurationUnits            *CE     `hl7:"12,len=250,display=Duration Units"`
 	AllowSubstitutionCode    IS      `hl7:"13,conditional,len=10,table=0279,display=Allow Substitution Code"`
 	FillerStatusCode         *CE     `hl7:"14,conditional,len=250,table=0278,display=Filler Status Code"`
@@ -102,15 +102,15 @@ type AIG struct {
 // of locations used by the HL7 specification.
 type AIL struct {
 	HL7                      HL7Name `hl7:",name=AIL,type=s"`
-	SetID                    SI      `hl7:"1,seq,required,len=4,display=Set ID - AIL"`
+	SetID                    SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - AIL"`
 	SegmentActionCode        ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
 	LocationResourceID       *PL     `hl7:"3,conditional,len=80,display=Location Resource ID"`
 	LocationType             CE      `hl7:"4,required,len=250,display=Location Type-AIL"`
 	LocationGroup            *CE     `hl7:"5,len=250,display=Location Group"`
 	StartDateTime            TS      `hl7:"6,conditional,len=26,format=YMDHMS,display=Start Date/Time"`
-	StartDateTimeOffset      NM      `hl7:"7,conditional,len=20,display=Start Date/Time Offset"`
+	StartDateTimeOffset      NM      `hl7:"7,conditional,len=20,format=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits *CE     `hl7:"8,conditional,len=250,display=Start Date/Time Offset Units"`
-	Duration                 NM      `hl7:"9,len=20,display=Duration"`
+	Duration                 NM      `hl7:"9,len=20,format=NM,display=Duration"`
 	DurationUnits            *CE     `hl7:"10,len=250,display=Duration Units"`
 	AllowSubstitutionCode    IS      `hl7:"11,conditional,len=10,table=0279,display=Allow Substitution Code"`
 	FillerStatusCode         *CE     `hl7:"12,conditional,len=250,table=0278,display=Filler Status Code"`
@@ -125,15 +125,15 @@ type AIL struct {
 // nurses, surgeons, anesthesiologists, or CRNAs).
 type AIP struct {
 	HL7                      HL7Name `hl7:",name=AIP,type=s"`
-	SetID                    SI      `hl7:"1,seq,required,len=4,display=Set ID - AIP"`
+	SetID                    SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - AIP"`
 	SegmentActionCode        ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
 	PersonnelResourceID      []XCN   `hl7:"3,conditional,len=250,display=Personnel Resource ID"`
 	ResourceRole             CE      `hl7:"4,required,len=250,display=Resource Role"`
 	ResourceGroup            *CE     `hl7:"5,len=250,display=Resource Group"`
 	StartDateTime            TS      `hl7:"6,conditional,len=26,format=YMDHMS,display=Start Date/Time"`
-	StartDateTimeOffset      NM      `hl7:"7,conditional,len=20,display=Start Date/Time Offset"`
+	StartDateTimeOffset      NM      `hl7:"7,conditional,len=20,format=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits *CE     `hl7:"8,conditional,len=250,display=Start Date/Time Offset Units"`
-	Duration                 NM      `hl7:"9,len=20,display=Duration"`
+	Duration                 NM      `hl7:"9,len=20,format=NM,display=Duration"`
 	DurationUnits            *CE     `hl7:"10,len=250,display=Duration Units"`
 	AllowSubstitutionCode    IS      `hl7:"11,conditional,len=10,table=0279,display=Allow Substitution Code"`
 	FillerStatusCode         *CE     `hl7:"12,conditional,len=250,table=0278,display=Filler Status Code"`
@@ -146,13 +146,13 @@ type AIP struct {
 // by a schedule are not identified on a schedule request using this segment.
 type AIS struct {
 	HL7                                  HL7Name `hl7:",name=AIS,type=s"`
-	SetID                                SI      `hl7:"1,seq,required,len=4,display=Set ID - AIS"`
+	SetID                                SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - AIS"`
 	SegmentActionCode                    ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
 	UniversalServiceIdentifier           CE      `hl7:"3,required,len=250,display=Universal Service Identifier"`
 	StartDateTime                        TS      `hl7:"4,conditional,len=26,format=YMDHMS,display=Start Date/Time"`
-	StartDateTimeOffset                  NM      `hl7:"5,conditional,len=20,display=Start Date/Time Offset"`
+	StartDateTimeOffset                  NM      `hl7:"5,conditional,len=20,format=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits             *CE     `hl7:"6,conditional,len=250,display=Start Date/Time Offset Units"`
-	Duration                             NM      `hl7:"7,len=20,display=Duration"`
+	Duration                             NM      `hl7:"7,len=20,format=NM,display=Duration"`
 	DurationUnits                        *CE     `hl7:"8,len=250,display=Duration Units"`
 	AllowSubstitutionCode                IS      `hl7:"9,conditional,len=10,table=0279,display=Allow Substitution Code"`
 	FillerStatusCode                     *CE     `hl7:"10,conditional,len=250,table=0278,display=Filler Status Code"`
@@ -188,7 +188,7 @@ type APR struct {
 	TimeSelectionCriteria     []SCV   `hl7:"1,len=80,display=Time Selection Criteria"`
 	ResourceSelectionCriteria []SCV   `hl7:"2,len=80,display=Resource Selection Criteria"`
 	LocationSelectionCriteria []SCV   `hl7:"3,len=80,display=Location Selection Criteria"`
-	SlotSpacingCriteria       NM      `hl7:"4,len=5,display=Slot Spacing Criteria"`
+	SlotSpacingCriteria       NM      `hl7:"4,len=5,format=NM,display=Slot Spacing Criteria"`
 	FillerOverrideCriteria    []SCV   `hl7:"5,len=80,display=Filler Override Criteria"`
 }
 
@@ -200,13 +200,13 @@ type ARQ struct {
 	HL7                         HL7Name `hl7:",name=ARQ,type=s"`
 	PlacerAppointmentID         EI      `hl7:"1,required,len=75,display=Placer Appointment ID"`
 	FillerAppointmentID         *EI     `hl7:"2,conditional,len=75,display=Filler Appointment ID"`
-	OccurrenceNumber            NM      `hl7:"3,conditional,len=5,display=Occurrence Number"`
+	OccurrenceNumber            NM      `hl7:"3,conditional,len=5,format=NM,display=Occurrence Number"`
 	PlacerGroupNumber           *EI     `hl7:"4,len=22,display=Placer Group Number"`
 	ScheduleID                  *CE     `hl7:"5,len=250,display=Schedule ID"`
 	RequestEventReason          *CE     `hl7:"6,len=250,display=Request Event Reason"`
 	AppointmentReason           *CE     `hl7:"7,len=250,table=0276,display=Appointment Reason"`
 	AppointmentType             *CE     `hl7:"8,len=250,table=0277,display=Appointment Type"`
-	AppointmentDuration         NM      `hl7:"9,len=20,display=Appointment Duration"`
+	AppointmentDuration         NM      `hl7:"9,len=20,format=NM,display=Appointment Duration"`
 	AppointmentDurationUnits    *CE     `hl7:"10,len=250,display=Appointment Duration Units"`
 	RequestedStartDateTimeRange []DR    `hl7:"11,len=53,display=Requested Start Date/Time Range"`
 	Priority                    ST      `hl7:"12,len=5,display=Priority-ARQ"`
@@ -238,8 +238,8 @@ type AUT struct {
 	AuthorizationExpirationDate  TS      `hl7:"5,len=26,format=YMDHMS,display=Authorization Expiration Date"`
 	AuthorizationIdentifier      *EI     `hl7:"6,conditional,len=30,display=Authorization Identifier"`
 	ReimbursementLimit           *CP     `hl7:"7,len=25,display=Reimbursement Limit"`
-	RequestedNumberOfTreatments  NM      `hl7:"8,len=2,display=Requested Number of Treatments"`
-	AuthorizedNumberOfTreatments NM      `hl7:"9,len=2,display=Authorized Number of Treatments"`
+	RequestedNumberOfTreatments  NM      `hl7:"8,len=2,format=NM,display=Requested Number of Treatments"`
+	AuthorizedNumberOfTreatments NM      `hl7:"9,len=2,format=NM,display=Authorized Number of Treatments"`
 	ProcessDate                  TS      `hl7:"10,len=26,format=YMDHMS,display=Process Date"`
 }
 
@@ -289,7 +289,7 @@ type BTS struct {
 	HL7               HL7Name `hl7:",name=BTS,type=s"`
 	BatchMessageCount ST      `hl7:"1,len=10,display=Batch Message Count"`
 	BatchComment      ST      `hl7:"2,len=80,display=Batch Comment"`
-	BatchTotals       []NM    `hl7:"3,len=100,display=Batch Totals"`
+	BatchTotals       []NM    `hl7:"3,len=100,format=NM,display=Batch Totals"`
 }
 
 // Charge Description Master
@@ -315,7 +315,7 @@ type CDM struct {
 	ProcedureCode                []CE    `hl7:"7,len=250,table=0088,display=Procedure Code"`
 	ActiveInactiveFlag           ID      `hl7:"8,len=1,table=0183,display=Active/Inactive Flag"`
 	InventoryNumber              []CE    `hl7:"9,len=250,table=0463,display=Inventory Number"`
-	ResourceLoad                 NM      `hl7:"10,len=12,display=Resource Load"`
+	ResourceLoad                 NM      `hl7:"10,len=12,format=NM,display=Resource Load"`
 	ContractNumber               []CK    `hl7:"11,len=250,display=Contract Number"`
 	ContractOrganization         []XON   `hl7:"12,len=250,display=Contract Organization"`
 	RoomFeeIndicator             ID      `hl7:"13,len=1,table=0136,display=Room Fee Indicator"`
@@ -331,13 +331,13 @@ type CDM struct {
 // The Technical Steward for the CM0 segment is ORDERS.
 type CM0 struct {
 	HL7                     HL7Name `hl7:",name=CM0,type=s"`
-	SetID                   SI      `hl7:"1,seq,len=4,display=Set ID - CM0"`
+	SetID                   SI      `hl7:"1,seq,len=4,format=SI,display=Set ID - CM0"`
 	SponsorStudyID          EI      `hl7:"2,required,len=60,display=Sponsor Study ID"`
 	AlternateStudyID        []EI    `hl7:"3,max=3,len=60,display=Alternate Study ID"`
 	TitleOfStudy            ST      `hl7:"4,required,len=300,display=Title of Study"`
 	ChairmanOfStudy         []XCN   `hl7:"5,len=250,display=Chairman of Study"`
 	LastIRBApprovalDate     DT      `hl7:"6,len=8,format=YMD,display=Last IRB Approval Date"`
-	TotalAccrualToDate      NM      `hl7:"7,len=8,display=Total Accrual to Date"`
+	TotalAccrualToDate      NM      `hl7:"7,len=8,format=NM,display=Total Accrual to Date"`
 	LastAccrualDate         DT      `hl7:"8,len=8,format=YMD,display=Last Accrual Date"`
 	ContactForStudy         []XCN   `hl7:"9,len=250,display=Contact for Study"`
 	ContactsTelephoneNumber *XTN    `hl7:"10,len=250,display=Contact's Telephone Number"`
@@ -354,7 +354,7 @@ type CM0 struct {
 // The Technical Steward for the CM1 segment is ORDERS.
 type CM1 struct {
 	HL7                     HL7Name `hl7:",name=CM1,type=s"`
-	SetID                   SI      `hl7:"1,seq,required,len=4,display=Set ID - CM1"`
+	SetID                   SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - CM1"`
 	StudyPhaseIdentifier    CE      `hl7:"2,required,len=250,display=Study Phase Identifier"`
 	DescriptionOfStudyPhase ST      `hl7:"3,required,len=300,display=Description of Study Phase"`
 }
@@ -368,7 +368,7 @@ type CM1 struct {
 // The Technical Steward for the CM2 segment is ORDERS.
 type CM2 struct {
 	HL7                          HL7Name `hl7:",name=CM2,type=s"`
-	SetID                        SI      `hl7:"1,seq,len=4,display=Set ID- CM2"`
+	SetID                        SI      `hl7:"1,seq,len=4,format=SI,display=Set ID- CM2"`
 	ScheduledTimePoint           CE      `hl7:"2,required,len=250,display=Scheduled Time Point"`
 	DescriptionOfTimePoint       ST      `hl7:"3,len=300,display=Description of Time Point"`
 	EventsScheduledThisTimePoint []CE    `hl7:"4,required,max=200,len=250,display=Events Scheduled This Time Point"`
@@ -380,8 +380,8 @@ type CM2 struct {
 // notifications.
 type CNS struct {
 	HL7                                 HL7Name `hl7:",name=CNS,type=s"`
-	StartingNotificationReferenceNumber NM      `hl7:"1,len=20,display=Starting Notification Reference Number"`
-	EndingNotificationReferenceNumber   NM      `hl7:"2,len=20,display=Ending Notification Reference Number"`
+	StartingNotificationReferenceNumber NM      `hl7:"1,len=20,format=NM,display=Starting Notification Reference Number"`
+	EndingNotificationReferenceNumber   NM      `hl7:"2,len=20,format=NM,display=Ending Notification Reference Number"`
 	StartingNotificationDateTime        TS      `hl7:"3,len=26,format=YMDHMS,display=Starting Notification Date/Time"`
 	EndingNotificationDateTime          TS      `hl7:"4,len=26,format=YMDHMS,display=Ending Notification Date/Time"`
 	StartingNotificationCode            *CE     `hl7:"5,len=250,display=Starting Notification Code"`
@@ -484,7 +484,7 @@ type CTI struct {
 // The disabled person code and identifier allow for the association of the disability information to the person.
 type DB1 struct {
 	HL7                        HL7Name `hl7:",name=DB1,type=s"`
-	SetID                      SI      `hl7:"1,seq,required,len=4,display=Set ID - DB1"`
+	SetID                      SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - DB1"`
 	DisabledPersonCode         IS      `hl7:"2,len=2,table=0334,display=Disabled Person Code"`
 	DisabledPersonIdentifier   []CX    `hl7:"3,len=250,display=Disabled Person Identifier"`
 	DisabilityIndicator        ID      `hl7:"4,len=1,table=0136,display=Disability Indicator"`
@@ -503,7 +503,7 @@ type DB1 struct {
 // are also defined.
 type DG1 struct {
 	HL7                     HL7Name `hl7:",name=DG1,type=s"`
-	SetID                   SI      `hl7:"1,seq,required,len=4,display=Set ID - DG1"`
+	SetID                   SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - DG1"`
 	DiagnosisCodingMethod   ID      `hl7:"2,len=2,table=0053,display=Diagnosis Coding Method"`
 	DiagnosisCode           *CE     `hl7:"3,len=250,table=0051,display=Diagnosis Code - DG1"`
 	DiagnosisDescription    ST      `hl7:"4,len=40,display=Diagnosis Description"`
@@ -514,7 +514,7 @@ type DG1 struct {
 	DRGApprovalIndicator    ID      `hl7:"9,len=1,table=0136,display=DRG Approval Indicator"`
 	DRGGrouperReviewCode    IS      `hl7:"10,len=2,table=0056,display=DRG Grouper Review Code"`
 	OutlierType             CE      `hl7:"11,len=250,table=0083,display=Outlier Type"`
-	OutlierDays             NM      `hl7:"12,len=3,display=Outlier Days"`
+	OutlierDays             NM      `hl7:"12,len=3,format=NM,display=Outlier Days"`
 	OutlierCost             CP      `hl7:"13,len=12,display=Outlier Cost"`
 	GrouperVersionAndType   ST      `hl7:"14,len=4,display=Grouper Version And Type"`
 	DiagnosisPriority       ID      `hl7:"15,len=2,table=0359,display=Diagnosis Priority"`
@@ -535,7 +535,7 @@ type DRG struct {
 	ApprovalIndicator      ID      `hl7:"3,len=1,table=0136,display=DRG Approval Indicator"`
 	GrouperReviewCode      IS      `hl7:"4,len=2,table=0056,display=DRG Grouper Review Code"`
 	OutlierType            *CE     `hl7:"5,len=250,table=0083,display=Outlier Type"`
-	OutlierDays            NM      `hl7:"6,len=3,display=Outlier Days"`
+	OutlierDays            NM      `hl7:"6,len=3,format=NM,display=Outlier Days"`
 	OutlierCost            *CP     `hl7:"7,len=12,display=Outlier Cost"`
 	Payor                  IS      `hl7:"8,len=1,table=0229,display=DRG Payor"`
 	OutlierReimbursement   *CP     `hl7:"9,len=9,display=Outlier Reimbursement"`
@@ -558,8 +558,8 @@ type DSC struct {
 // data is lost; the data is simply treated as lines of text.
 type DSP struct {
 	HL7               HL7Name `hl7:",name=DSP,type=s"`
-	SetID             SI      `hl7:"1,seq,len=4,display=Set ID - DSP"`
-	DisplayLevel      SI      `hl7:"2,len=4,display=Display Level"`
+	SetID             SI      `hl7:"1,seq,len=4,format=SI,display=Set ID - DSP"`
+	DisplayLevel      SI      `hl7:"2,len=4,format=SI,display=Display Level"`
 	DataLine          TX      `hl7:"3,required,len=300,display=Data Line"`
 	LogicalBreakPoint ST      `hl7:"4,len=2,display=Logical Break Point"`
 	ResultID          TX      `hl7:"5,len=20,display=Result ID"`
@@ -573,7 +573,7 @@ type DSP struct {
 // such as centrifuges, aliquoters, sorters, uncappers, recappers, automated storage units, etc.
 type ECD struct {
 	HL7                     HL7Name `hl7:",name=ECD,type=s"`
-	ReferenceCommandNumber  NM      `hl7:"1,required,len=20,display=Reference Command Number"`
+	ReferenceCommandNumber  NM      `hl7:"1,required,len=20,format=NM,display=Reference Command Number"`
 	RemoteControlCommand    CE      `hl7:"2,required,len=250,table=0368,display=Remote Control Command"`
 	ResponseRequired        ID      `hl7:"3,len=80,table=0136,display=Response Required"`
 	RequestedCompletionTime *TQ     `hl7:"4,len=200,display=Requested Completion Time"`
@@ -596,7 +596,7 @@ type ECR struct {
 // may optionally follow an STF segment.  An EDU segment must always have been preceded by a corresponding STF segment
 type EDU struct {
 	HL7                                         HL7Name `hl7:",name=EDU,type=s"`
-	SetID                                       SI      `hl7:"1,seq,required,len=60,display=Set ID - EDU"`
+	SetID                                       SI      `hl7:"1,seq,required,len=60,format=SI,display=Set ID - EDU"`
 	AcademicDegree                              IS      `hl7:"2,len=10,table=0360,display=Academic Degree"`
 	AcademicDegreeProgramDateRange              *DR     `hl7:"3,len=52,display=Academic Degree Program Date Range"`
 	AcademicDegreeProgramParticipationDateRange *DR     `hl7:"4,len=52,display=Academic Degree Program Participation Date Range"`
@@ -721,7 +721,7 @@ type FHS struct {
 // records.
 type FT1 struct {
 	HL7                       HL7Name `hl7:",name=FT1,type=s"`
-	SetID                     SI      `hl7:"1,seq,len=4,display=Set ID - FT1"`
+	SetID                     SI      `hl7:"1,seq,len=4,format=SI,display=Set ID - FT1"`
 	TransactionID             ST      `hl7:"2,len=12,display=Transaction ID"`
 	TransactionBatchID        ST      `hl7:"3,len=10,display=Transaction Batch ID"`
 	TransactionDate           TS      `hl7:"4,required,len=26,format=YMDHMS,display=Transaction Date"`
@@ -730,7 +730,7 @@ type FT1 struct {
 	TransactionCode           CE      `hl7:"7,required,len=250,table=0132,display=Transaction Code"`
 	TransactionDescription    ST      `hl7:"8,len=40,display=Transaction Description"`
 	TransactionDescriptionAlt ST      `hl7:"9,len=40,display=Transaction Description - Alt"`
-	TransactionQuantity       NM      `hl7:"10,len=6,display=Transaction Quantity"`
+	TransactionQuantity       NM      `hl7:"10,len=6,format=NM,display=Transaction Quantity"`
 	TransactionAmountExtended *CP     `hl7:"11,len=12,display=Transaction Amount - Extended"`
 	TransactionAmountUnit     *CP     `hl7:"12,len=12,display=Transaction Amount - Unit"`
 	DepartmentCode            *CE     `hl7:"13,len=250,table=0049,display=Department Code"`
@@ -754,7 +754,7 @@ type FT1 struct {
 // The FTS segment defines the end of a file.
 type FTS struct {
 	HL7                HL7Name `hl7:",name=FTS,type=s"`
-	FileBatchCount     NM      `hl7:"1,len=10,display=File Batch Count"`
+	FileBatchCount     NM      `hl7:"1,len=10,format=NM,display=File Batch Count"`
 	FileTrailerComment ST      `hl7:"2,len=80,display=File Trailer Comment"`
 }
 
@@ -768,7 +768,7 @@ type GOL struct {
 	GoalID                      CE      `hl7:"3,required,len=250,display=Goal ID"`
 	GoalInstanceID              EI      `hl7:"4,required,len=60,display=Goal Instance ID"`
 	EpisodeOfCareID             *EI     `hl7:"5,len=60,display=Episode of Care ID"`
-	GoalListPriority            NM      `hl7:"6,len=60,display=Goal List Priority"`
+	GoalListPriority            NM      `hl7:"6,len=60,format=NM,display=Goal List Priority"`
 	GoalEstablishedDateTime     TS      `hl7:"7,len=26,format=YMDHMS,display=Goal Established Date/Time"`
 	ExpectedGoalAchieveDateTime TS      `hl7:"8,len=26,format=YMDHMS,display=Expected Goal Achieve Date/Time"`
 	GoalClassification          *CE     `hl7:"9,len=250,display=Goal Classification"`
@@ -809,7 +809,7 @@ type GP1 struct {
 type GP2 struct {
 	HL7                                 HL7Name `hl7:",name=GP2,type=s"`
 	RevenueCode                         IS      `hl7:"1,len=3,table=0456,display=Revenue Code"`
-	NumberOfServiceUnits                NM      `hl7:"2,len=7,display=Number of Service Units"`
+	NumberOfServiceUnits                NM      `hl7:"2,len=7,format=NM,display=Number of Service Units"`
 	Charge                              *CP     `hl7:"3,len=12,display=Charge"`
 	ReimbursementActionCode             IS      `hl7:"4,len=1,table=0459,display=Reimbursement Action Code"`
 	DenialOrRejectionCode               IS      `hl7:"5,len=1,table=0460,display=Denial or Rejection Code"`
@@ -821,7 +821,7 @@ type GP2 struct {
 	ExpectedHCFAPaymentAmount           *CP     `hl7:"11,len=12,display=Expected HCFA Payment Amount"`
 	ReimbursementTypeCode               IS      `hl7:"12,len=2,table=0470,display=Reimbursement Type Code"`
 	CoPayAmount                         *CP     `hl7:"13,len=12,display=Co-Pay Amount"`
-	PayRatePerUnit                      NM      `hl7:"14,len=4,display=Pay Rate per Unit"`
+	PayRatePerUnit                      NM      `hl7:"14,len=4,format=NM,display=Pay Rate per Unit"`
 }
 
 // Guarantor
@@ -830,7 +830,7 @@ type GP2 struct {
 // a patient account) data for patient and insurance billing applications.
 type GT1 struct {
 	HL7                                HL7Name `hl7:",name=GT1,type=s"`
-	SetID                              SI      `hl7:"1,seq,required,len=4,display=Set ID - GT1"`
+	SetID                              SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - GT1"`
 	GuarantorNumber                    []CX    `hl7:"2,len=250,display=Guarantor Number"`
 	GuarantorName                      []XPN   `hl7:"3,required,len=250,display=Guarantor Name"`
 	GuarantorSpouseName                []XPN   `hl7:"4,len=250,display=Guarantor Spouse Name"`
@@ -844,7 +844,7 @@ type GT1 struct {
 	GuarantorSSN                       ST      `hl7:"12,len=11,display=Guarantor SSN"`
 	GuarantorDateBegin                 DT      `hl7:"13,len=8,format=YMD,display=Guarantor Date - Begin"`
 	GuarantorDateEnd                   DT      `hl7:"14,len=8,format=YMD,display=Guarantor Date - End"`
-	GuarantorPriority                  NM      `hl7:"15,len=2,display=Guarantor Priority"`
+	GuarantorPriority                  NM      `hl7:"15,len=2,format=NM,display=Guarantor Priority"`
 	GuarantorEmployerName              []XPN   `hl7:"16,len=250,display=Guarantor Employer Name"`
 	GuarantorEmployerAddress           []XAD   `hl7:"17,len=250,display=Guarantor Employer Address"`
 	GuarantorEmployerPhoneNumber       []XTN   `hl7:"18,len=250,display=Guarantor Employer Phone Number"`
@@ -857,7 +857,7 @@ type GT1 struct {
 	GuarantorDeathFlag                 ID      `hl7:"25,len=1,table=0136,display=Guarantor Death Flag"`
 	GuarantorChargeAdjustmentCode      *CE     `hl7:"26,len=250,table=0218,display=Guarantor Charge Adjustment Code"`
 	GuarantorHouseholdAnnualIncome     *CP     `hl7:"27,len=10,display=Guarantor Household Annual Income"`
-	GuarantorHouseholdSize             NM      `hl7:"28,len=3,display=Guarantor Household Size"`
+	GuarantorHouseholdSize             NM      `hl7:"28,len=3,format=NM,display=Guarantor Household Size"`
 	GuarantorEmployerIDNumber          []CX    `hl7:"29,len=250,display=Guarantor Employer ID Number"`
 	GuarantorMaritalStatusCode         *CE     `hl7:"30,len=250,table=0002,display=Guarantor Marital Status Code"`
 	GuarantorHireEffectiveDate         DT      `hl7:"31,len=8,format=YMD,display=Guarantor Hire Effective Date"`
@@ -896,7 +896,7 @@ type GT1 struct {
 // in 2.14.4.1.
 type IAM struct {
 	HL7                                  HL7Name `hl7:",name=IAM,type=s"`
-	SetID                                SI      `hl7:"1,seq,required,len=4,display=Set ID - IAM"`
+	SetID                                SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - IAM"`
 	AllergenTypeCode                     *CE     `hl7:"2,len=250,table=0127,display=Allergen Type Code"`
 	AllergenCodeMnemonicDescription      CE      `hl7:"3,required,len=250,display=Allergen Code/Mnemonic/Description"`
 	AllergySeverityCode                  *CE     `hl7:"4,len=250,table=0128,display=Allergy Severity Code"`
@@ -924,7 +924,7 @@ type IAM struct {
 // insurance bills.
 type IN1 struct {
 	HL7                           HL7Name `hl7:",name=IN1,type=s"`
-	SetID                         SI      `hl7:"1,seq,required,len=4,display=Set ID - IN1"`
+	SetID                         SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - IN1"`
 	InsurancePlanID               CE      `hl7:"2,required,len=250,table=0072,display=Insurance Plan ID"`
 	InsuranceCompanyID            []CX    `hl7:"3,required,len=250,display=Insurance Company ID"`
 	InsuranceCompanyName          []XON   `hl7:"4,len=250,display=Insurance Company Name"`
@@ -956,13 +956,13 @@ type IN1 struct {
 	VerificationBy                []XCN   `hl7:"30,len=250,display=Verification By"`
 	TypeOfAgreementCode           IS      `hl7:"31,len=2,table=0098,display=Type Of Agreement Code"`
 	BillingStatus                 IS      `hl7:"32,len=2,table=0022,display=Billing Status"`
-	LifetimeReserveDays           NM      `hl7:"33,len=4,display=Lifetime Reserve Days"`
-	DelayBeforeLRDay              NM      `hl7:"34,len=4,display=Delay Before L.R. Day"`
+	LifetimeReserveDays           NM      `hl7:"33,len=4,format=NM,display=Lifetime Reserve Days"`
+	DelayBeforeLRDay              NM      `hl7:"34,len=4,format=NM,display=Delay Before L.R. Day"`
 	CompanyPlanCode               IS      `hl7:"35,len=8,table=0042,display=Company Plan Code"`
 	PolicyNumber                  ST      `hl7:"36,len=15,display=Policy Number"`
 	PolicyDeductible              *CP     `hl7:"37,len=12,display=Policy Deductible"`
 	PolicyLimitAmount             CP      `hl7:"38,len=12,display=Policy Limit - Amount"`
-	PolicyLimitDays               NM      `hl7:"39,len=4,display=Policy Limit - Days"`
+	PolicyLimitDays               NM      `hl7:"39,len=4,format=NM,display=Policy Limit - Days"`
 	RoomRateSemiPrivate           CP      `hl7:"40,len=12,display=Room Rate - Semi-Private"`
 	RoomRatePrivate               CP      `hl7:"41,len=12,display=Room Rate - Private"`
 	InsuredsEmploymentStatus      *CE     `hl7:"42,len=250,table=0066,display=Insured's Employment Status"`
@@ -1061,7 +1061,7 @@ type IN2 struct {
 // segment are defined by HCFA, or other regulatory agencies.
 type IN3 struct {
 	HL7                                HL7Name `hl7:",name=IN3,type=s"`
-	SetID                              SI      `hl7:"1,seq,required,len=4,display=Set ID - IN3"`
+	SetID                              SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - IN3"`
 	CertificationNumber                *CX     `hl7:"2,len=250,display=Certification Number"`
 	CertifiedBy                        []XCN   `hl7:"3,len=250,display=Certified By"`
 	CertificationRequired              ID      `hl7:"4,len=1,table=0136,display=Certification Required"`
@@ -1100,10 +1100,10 @@ type INV struct {
 	InventoryContainerIdentifier *CE     `hl7:"4,len=250,display=Inventory Container Identifier"`
 	ContainerCarrierIdentifier   *CE     `hl7:"5,len=250,display=Container Carrier Identifier"`
 	PositionOnCarrier            *CE     `hl7:"6,len=250,display=Position on Carrier"`
-	InitialQuantity              NM      `hl7:"7,len=20,display=Initial Quantity"`
-	CurrentQuantity              NM      `hl7:"8,len=20,display=Current Quantity"`
-	AvailableQuantity            NM      `hl7:"9,len=20,display=Available Quantity"`
-	ConsumptionQuantity          NM      `hl7:"10,len=20,display=Consumption Quantity"`
+	InitialQuantity              NM      `hl7:"7,len=20,format=NM,display=Initial Quantity"`
+	CurrentQuantity              NM      `hl7:"8,len=20,format=NM,display=Current Quantity"`
+	AvailableQuantity            NM      `hl7:"9,len=20,format=NM,display=Available Quantity"`
+	ConsumptionQuantity          NM      `hl7:"10,len=20,format=NM,display=Consumption Quantity"`
 	QuantityUnits                *CE     `hl7:"11,len=250,display=Quantity Units"`
 	ExpirationDateTime           TS      `hl7:"12,len=26,format=YMDHMS,display=Expiration Date/Time"`
 	FirstUsedDateTime            TS      `hl7:"13,len=26,format=YMDHMS,display=First Used Date/Time"`
@@ -1120,7 +1120,7 @@ type INV struct {
 // section Glossary) on the specific equipment.
 type ISD struct {
 	HL7                        HL7Name `hl7:",name=ISD,type=s"`
-	ReferenceInteractionNumber NM      `hl7:"1,required,len=20,display=Reference Interaction Number"`
+	ReferenceInteractionNumber NM      `hl7:"1,required,len=20,format=NM,display=Reference Interaction Number"`
 	InteractionTypeIdentifier  *CE     `hl7:"2,len=250,table=0368,display=Interaction Type Identifier"`
 	InteractionActiveState     CE      `hl7:"3,required,len=250,table=0387,display=Interaction Active State"`
 }
@@ -1131,7 +1131,7 @@ type ISD struct {
 // optionally follow an STF segment.  An LAN segment must always have been preceded by a corresponding STF segment
 type LAN struct {
 	HL7                     HL7Name `hl7:",name=LAN,type=s"`
-	SetID                   SI      `hl7:"1,seq,required,len=60,display=Set ID - LAN"`
+	SetID                   SI      `hl7:"1,seq,required,len=60,format=SI,display=Set ID - LAN"`
 	LanguageCode            CE      `hl7:"2,required,len=250,table=0296,display=Language Code"`
 	LanguageAbilityCode     []CE    `hl7:"3,len=250,table=0403,display=Language Ability Code"`
 	LanguageProficiencyCode *CE     `hl7:"4,len=250,table=0404,display=Language Proficiency Code"`
@@ -1251,6 +1251,28 @@ type MFE struct {
 	PrimaryKeyValueType  []ID     `hl7:"5,required,len=3,table=0355,display=Primary Key Value Type"`
 }
 
+// FieldVaries returns the value to decode a repetition of a VARIES field into,
+// with the data type selected by another field of the segment.
+func (v MFE) FieldVaries(field int32, rep int, reg func(string) (any, bool)) (reflect.Value, error) {
+	var name string
+	switch field {
+	default:
+		return reflect.Value{}, fmt.Errorf("MFE-%d has no field to select the data type", field)
+	case 4:
+		if n := len(v.PrimaryKeyValueType); n > 0 {
+			if rep >= n {
+				rep = n - 1
+			}
+			name = v.PrimaryKeyValueType[rep]
+		}
+	}
+	vt, ok := reg(name)
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("unknown MFE data type %q", name)
+	}
+	return reflect.New(reflect.TypeOf(vt)).Elem(), nil
+}
+
 // Master File Identification
 //
 // The Technical Steward for the MFI segment is CQ.
@@ -1288,7 +1310,7 @@ type MSA struct {
 	AcknowledgementCode       ID      `hl7:"1,required,len=2,table=0008,display=Acknowledgement Code"`
 	MessageControlID          ST      `hl7:"2,required,len=20,display=Message Control ID"`
 	TextMessage               ST      `hl7:"3,len=80,display=Text Message"`
-	ExpectedSequenceNumber    NM      `hl7:"4,len=15,display=Expected Sequence Number"`
+	ExpectedSequenceNumber    NM      `hl7:"4,len=15,format=NM,display=Expected Sequence Number"`
 	DelayedAcknowledgmentType ID      `hl7:"5,len=1,table=0102,display=Delayed Acknowledgment Type"`
 	ErrorCondition            *CE     `hl7:"6,len=250,table=0357,display=Error Condition"`
 }
@@ -1310,7 +1332,7 @@ type MSH struct {
 	MessageControlID                    ST      `hl7:"10,required,len=20,display=Message Control ID"`
 	ProcessingID                        PT      `hl7:"11,required,len=3,display=Processing ID"`
 	VersionID                           VID     `hl7:"12,required,len=60,display=Version ID"`
-	SequenceNumber                      NM      `hl7:"13,len=15,display=Sequence Number"`
+	SequenceNumber                      NM      `hl7:"13,len=15,format=NM,display=Sequence Number"`
 	ContinuationPointer                 ST      `hl7:"14,len=180,display=Continuation Pointer"`
 	AcceptAcknowledgmentType            ID      `hl7:"15,len=2,table=0155,display=Accept Acknowledgment Type"`
 	ApplicationAcknowledgmentType       ID      `hl7:"16,len=2,table=0155,display=Application Acknowledgment Type"`
@@ -1342,7 +1364,7 @@ type NCK struct {
 // of events, (e.g., alarms that have occurred on a particular piece of equipment.
 type NDS struct {
 	HL7                         HL7Name `hl7:",name=NDS,type=s"`
-	NotificationReferenceNumber NM      `hl7:"1,required,len=20,display=Notification Reference Number"`
+	NotificationReferenceNumber NM      `hl7:"1,required,len=20,format=NM,display=Notification Reference Number"`
 	NotificationDateTime        TS      `hl7:"2,required,len=26,format=YMDHMS,display=Notification Date/Time"`
 	NotificationAlertSeverity   CE      `hl7:"3,required,len=250,table=0367,display=Notification Alert Severity"`
 	NotificationCode            CE      `hl7:"4,required,len=250,display=Notification Code"`
@@ -1354,7 +1376,7 @@ type NDS struct {
 // Utilizing NK1-1 - set ID , multiple NK1 segments can be sent to patient accounts.
 type NK1 struct {
 	HL7                                      HL7Name `hl7:",name=NK1,type=s"`
-	SetID                                    SI      `hl7:"1,seq,required,len=4,display=Set ID - NK1"`
+	SetID                                    SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - NK1"`
 	NKName                                   []XPN   `hl7:"2,len=250,display=NK Name"`
 	Relationship                             *CE     `hl7:"3,len=250,table=0063,display=Relationship"`
 	Address                                  []XAD   `hl7:"4,len=250,display=Address"`
@@ -1432,16 +1454,16 @@ type NST struct {
 	SourceType                    ID      `hl7:"3,len=3,table=0332,display=Source Type"`
 	StatisticsStart               TS      `hl7:"4,len=26,format=YMDHMS,display=Statistics Start"`
 	StatisticsEnd                 TS      `hl7:"5,len=26,format=YMDHMS,display=Statistics End"`
-	ReceiveCharacterCount         NM      `hl7:"6,len=10,display=Receive Character Count"`
-	SendCharacterCount            NM      `hl7:"7,len=10,display=Send Character Count"`
-	MessagesReceived              NM      `hl7:"8,len=10,display=Messages Received"`
-	MessagesSent                  NM      `hl7:"9,len=10,display=Messages Sent"`
-	ChecksumErrorsReceived        NM      `hl7:"10,len=10,display=Checksum Errors Received"`
-	LengthErrorsReceived          NM      `hl7:"11,len=10,display=Length Errors Received"`
-	OtherErrorsReceived           NM      `hl7:"12,len=10,display=Other Errors Received"`
-	ConnectTimeouts               NM      `hl7:"13,len=10,display=Connect Timeouts"`
-	ReceiveTimeouts               NM      `hl7:"14,len=10,display=Receive Timeouts"`
-	ApplicationControlLevelErrors NM      `hl7:"15,len=10,display=Application control-level Errors"`
+	ReceiveCharacterCount         NM      `hl7:"6,len=10,format=NM,display=Receive Character Count"`
+	SendCharacterCount            NM      `hl7:"7,len=10,format=NM,display=Send Character Count"`
+	MessagesReceived              NM      `hl7:"8,len=10,format=NM,display=Messages Received"`
+	MessagesSent                  NM      `hl7:"9,len=10,format=NM,display=Messages Sent"`
+	ChecksumErrorsReceived        NM      `hl7:"10,len=10,format=NM,display=Checksum Errors Received"`
+	LengthErrorsReceived          NM      `hl7:"11,len=10,format=NM,display=Length Errors Received"`
+	OtherErrorsReceived           NM      `hl7:"12,len=10,format=NM,display=Other Errors Received"`
+	ConnectTimeouts               NM      `hl7:"13,len=10,format=NM,display=Connect Timeouts"`
+	ReceiveTimeouts               NM      `hl7:"14,len=10,format=NM,display=Receive Timeouts"`
+	ApplicationControlLevelErrors NM      `hl7:"15,len=10,format=NM,display=Application control-level Errors"`
 }
 
 // Notes and Comments
@@ -1450,7 +1472,7 @@ type NST struct {
 // and comments.
 type NTE struct {
 	HL7             HL7Name `hl7:",name=NTE,type=s"`
-	SetID           SI      `hl7:"1,seq,len=4,display=Set ID - NTE"`
+	SetID           SI      `hl7:"1,seq,len=4,format=SI,display=Set ID - NTE"`
 	SourceOfComment ID      `hl7:"2,len=8,table=0105,display=Source of Comment"`
 	Comment         []FT    `hl7:"3,len=65536,display=Comment"`
 	CommentType     *CE     `hl7:"4,len=250,table=0364,display=Comment Type"`
@@ -1472,7 +1494,7 @@ type NTE struct {
 // obtained directly from a subject (e.g., BP, Chest X-ray), they represent the start and end time of the observation.
 type OBR struct {
 	HL7                                  HL7Name `hl7:",name=OBR,type=s"`
-	SetID                                SI      `hl7:"1,seq,len=4,display=Set ID - OBR"`
+	SetID                                SI      `hl7:"1,seq,len=4,format=SI,display=Set ID - OBR"`
 	PlacerOrderNumber                    *EI     `hl7:"2,conditional,len=22,display=Placer Order Number"`
 	FillerOrderNumber                    *EI     `hl7:"3,conditional,len=22,display=Filler Order Number"`
 	UniversalServiceIdentifier           CE      `hl7:"4,required,len=250,display=Universal Service Identifier"`
@@ -1508,7 +1530,7 @@ type OBR struct {
 	Technician                           []NDL   `hl7:"34,len=200,display=Technician +"`
 	Transcriptionist                     []NDL   `hl7:"35,len=200,display=Transcriptionist +"`
 	ScheduledDateTime                    TS      `hl7:"36,len=26,format=YMDHMS,display=Scheduled Date/Time +"`
-	NumberOfSampleContainers             NM      `hl7:"37,len=4,display=Number of Sample Containers *"`
+	NumberOfSampleContainers             NM      `hl7:"37,len=4,format=NM,display=Number of Sample Containers *"`
 	TransportLogisticsOfCollectedSample  []CE    `hl7:"38,len=250,display=Transport Logistics of Collected Sample *"`
 	CollectorsComment                    []CE    `hl7:"39,len=250,display=Collector's Comment *"`
 	TransportArrangementResponsibility   *CE     `hl7:"40,len=250,display=Transport Arrangement Responsibility"`
@@ -1527,7 +1549,7 @@ type OBR struct {
 // unit of a report. Its structure is summarized in Figure 7-5.
 type OBX struct {
 	HL7                            HL7Name  `hl7:",name=OBX,type=s"`
-	SetID                          SI       `hl7:"1,seq,len=4,display=Set ID - OBX"`
+	SetID                          SI       `hl7:"1,seq,len=4,format=SI,display=Set ID - OBX"`
 	ValueType                      ID       `hl7:"2,conditional,len=2,table=0125,display=Value Type"`
 	ObservationIdentifier          CE       `hl7:"3,required,len=250,display=Observation Identifier"`
 	ObservationSubID               ST       `hl7:"4,conditional,len=20,display=Observation Sub-Id"`
@@ -1535,7 +1557,7 @@ type OBX struct {
 	Units                          *CE      `hl7:"6,len=250,display=Units"`
 	ReferencesRange                ST       `hl7:"7,len=60,display=References Range"`
 	AbnormalFlags                  []IS     `hl7:"8,max=5,len=5,table=0078,display=Abnormal Flags"`
-	Probability                    NM       `hl7:"9,len=5,display=Probability"`
+	Probability                    NM       `hl7:"9,len=5,format=NM,display=Probability"`
 	NatureOfAbnormalTest           []ID     `hl7:"10,len=2,table=0080,display=Nature of Abnormal Test"`
 	ObservationResultStatus        ID       `hl7:"11,required,len=1,table=0085,display=Observation Result Status"`
 	DateLastObservationNormalValue TS       `hl7:"12,len=26,format=YMDHMS,display=Date Last Observation Normal Value"`
@@ -1548,15 +1570,25 @@ type OBX struct {
 	DateTimeOfTheAnalysis          TS       `hl7:"19,len=26,format=YMDHMS,display=Date/Time of the Analysis"`
 }
 
-func (v OBX) ChildVaries(reg func(string) (any, bool)) (reflect.Value, error) {
-	vt, ok := reg(v.ValueType)
+// FieldVaries returns the value to decode a repetition of a VARIES field into,
+// with the data type selected by another field of the segment.
+func (v OBX) FieldVaries(field int32, rep int, reg func(string) (any, bool)) (reflect.Value, error) {
+	var name string
+	switch field {
+	default:
+		return reflect.Value{}, fmt.Errorf("OBX-%d has no field to select the data type", field)
+	case 5:
+		name = v.ValueType
+	}
+	vt, ok := reg(name)
 	if !ok {
-		return reflect.Value{}, fmt.Errorf("unknown OBX data type %q", v.ValueType)
+		return reflect.Value{}, fmt.Errorf("unknown OBX data type %q", name)
 	}
+	return reflect.New(reflect.TypeOf(vt)).Elem(), nil
+}
 
-	rt := reflect.TypeOf(vt)
-	rv := reflect.New(rt)
-	return rv.Elem(), nil
+func (v OBX) ChildVaries(reg func(string) (any, bool)) (reflect.Value, error) {
+	return v.FieldVaries(5, 0, reg)
 }
 
 // Dietary Orders, Supplements, and Preferences
@@ -1594,7 +1626,7 @@ type ODT struct {
 // The Technical Steward for the OM1 segment is ORDERS.
 type OM1 struct {
 	HL7                                                    HL7Name `hl7:",name=OM1,type=s"`
-	SequenceNumberTestObservationMasterFile                NM      `hl7:"1,required,len=4,display=Sequence Number - Test/ Observation Master File"`
+	SequenceNumberTestObservationMasterFile                NM      `hl7:"1,required,len=4,format=NM,display=Sequence Number - Test/ Observation Master File"`
 	ProducersServiceTestObservationID                      CE      `hl7:"2,required,len=250,display=Producer's Service/Test/Observation ID"`
 	PermittedDataTypes                                     []ID    `hl7:"3,len=12,table=0125,display=Permitted Data Types"`
 	SpecimenRequired                                       ID      `hl7:"4,required,len=1,table=0136,display=Specimen Required"`
@@ -1616,8 +1648,8 @@ type OM1 struct {
 	ReportDisplayOrder                                     ST      `hl7:"20,len=20,display=Report Display Order"`
 	DateTimeStampForAnyChangeInDefinitionForTheObservation TS      `hl7:"21,len=26,format=YMDHMS,display=Date/Time Stamp for any change in Definition for the Observation"`
 	EffectiveDateTimeOfChange                              TS      `hl7:"22,len=26,format=YMDHMS,display=Effective Date/Time of Change"`
-	TypicalTurnAroundTime                                  NM      `hl7:"23,len=20,display=Typical Turn-Around Time"`
-	ProcessingTime                                         NM      `hl7:"24,len=20,display=Processing Time"`
+	TypicalTurnAroundTime                                  NM      `hl7:"23,len=20,format=NM,display=Typical Turn-Around Time"`
+	ProcessingTime                                         NM      `hl7:"24,len=20,format=NM,display=Processing Time"`
 	ProcessingPriority                                     []ID    `hl7:"25,len=40,table=0168,display=Processing Priority"`
 	ReportingPriority                                      ID      `hl7:"26,len=5,table=0169,display=Reporting Priority"`
 	OutsideSite                                            []CE    `hl7:"27,len=250,display=Outside Site"`
@@ -1651,16 +1683,16 @@ type OM1 struct {
 // The Technical Steward for the OM2 segment is ORDERS.
 type OM2 struct {
 	HL7                                              HL7Name `hl7:",name=OM2,type=s"`
-	SequenceNumberTestObservationMasterFile          NM      `hl7:"1,len=4,display=Sequence Number - Test/ Observation Master File"`
+	SequenceNumberTestObservationMasterFile          NM      `hl7:"1,len=4,format=NM,display=Sequence Number - Test/ Observation Master File"`
 	UnitsOfMeasure                                   *CE     `hl7:"2,len=250,display=Units of Measure"`
-	RangeOfDecimalPrecision                          []NM    `hl7:"3,len=10,display=Range of Decimal Precision"`
+	RangeOfDecimalPrecision                          []NM    `hl7:"3,len=10,format=NM,display=Range of Decimal Precision"`
 	CorrespondingSIUnitsOfMeasure                    *CE     `hl7:"4,len=250,display=Corresponding SI Units of Measure"`
 	SIConversionFactor                               TX      `hl7:"5,len=60,display=SI Conversion Factor"`
 	Reference                                        *RFR    `hl7:"6,len=250,display=Reference"`
 	CriticalRangeForOrdinalAndContinuousObservations *NR     `hl7:"7,len=205,display=Critical Range for Ordinal and Continuous Observations"`
 	AbsoluteRangeForOrdinalAndContinuousObservations *RFR    `hl7:"8,len=250,display=Absolute Range for Ordinal and Continuous Observations"`
 	DeltaCheckCriteria                               []DLT   `hl7:"9,len=250,display=Delta Check Criteria"`
-	MinimumMeaningfulIncrements                      NM      `hl7:"10,len=20,display=Minimum Meaningful Increments"`
+	MinimumMeaningfulIncrements                      NM      `hl7:"10,len=20,format=NM,display=Minimum Meaningful Increments"`
 }
 
 // Categorical Service/Test/Observation
@@ -1670,7 +1702,7 @@ type OM2 struct {
 // The Technical Steward for the OM3 segment is ORDERS.
 type OM3 struct {
 	HL7                                         HL7Name `hl7:",name=OM3,type=s"`
-	SequenceNumberTestObservationMasterFile     NM      `hl7:"1,len=4,display=Sequence Number - Test/ Observation Master File"`
+	SequenceNumberTestObservationMasterFile     NM      `hl7:"1,len=4,format=NM,display=Sequence Number - Test/ Observation Master File"`
 	PreferredCodingSystem                       *CE     `hl7:"2,len=250,display=Preferred Coding System"`
 	ValidCodedAnswers                           *CE     `hl7:"3,len=250,display=Valid Coded 'Answers'"`
 	NormalTextCodesForCategoricalObservations   []CE    `hl7:"4,len=250,display=Normal Text/Codes for Categorical Observations"`
@@ -1688,10 +1720,10 @@ type OM3 struct {
 // The Technical Steward for the OM4 segment is ORDERS.
 type OM4 struct {
 	HL7                                     HL7Name `hl7:",name=OM4,type=s"`
-	SequenceNumberTestObservationMasterFile NM      `hl7:"1,len=4,display=Sequence Number - Test/ Observation Master File"`
+	SequenceNumberTestObservationMasterFile NM      `hl7:"1,len=4,format=NM,display=Sequence Number - Test/ Observation Master File"`
 	DerivedSpecimen                         ID      `hl7:"2,len=1,table=0170,display=Derived Specimen"`
 	ContainerDescription                    TX      `hl7:"3,len=60,display=Container Description"`
-	ContainerVolume                         NM      `hl7:"4,len=20,display=Container Volume"`
+	ContainerVolume                         NM      `hl7:"4,len=20,format=NM,display=Container Volume"`
 	ContainerUnits                          *CE     `hl7:"5,len=250,display=Container Units"`
 	Specimen                                *CE     `hl7:"6,len=250,display=Specimen"`
 	Additive                                *CE     `hl7:"7,len=250,table=0371,display=Additive"`
@@ -1712,7 +1744,7 @@ type OM4 struct {
 // The Technical Steward for the OM5 segment is ORDERS.
 type OM5 struct {
 	HL7                                                HL7Name `hl7:",name=OM5,type=s"`
-	SequenceNumberTestObservationMasterFile            NM      `hl7:"1,len=4,display=Sequence Number - Test/ Observation Master File"`
+	SequenceNumberTestObservationMasterFile            NM      `hl7:"1,len=4,format=NM,display=Sequence Number - Test/ Observation Master File"`
 	TestObservationsIncludedWithinAnOrderedTestBattery []CE    `hl7:"2,len=250,display=Test/Observations Included within an Ordered Test Battery"`
 	ObservationIDSuffixes                              ST      `hl7:"3,len=250,display=Observation ID Suffixes"`
 }
@@ -1725,7 +1757,7 @@ type OM5 struct {
 // The Technical Steward for the OM6 segment is ORDERS.
 type OM6 struct {
 	HL7                                     HL7Name `hl7:",name=OM6,type=s"`
-	SequenceNumberTestObservationMasterFile NM      `hl7:"1,len=4,display=Sequence Number - Test/ Observation Master File"`
+	SequenceNumberTestObservationMasterFile NM      `hl7:"1,len=4,format=NM,display=Sequence Number - Test/ Observation Master File"`
 	DerivationRule                          TX      `hl7:"2,len=10240,display=Derivation Rule"`
 }
 
@@ -1734,23 +1766,23 @@ type OM6 struct {
 // The OM7 segment contains additional basic attributes that apply to the definition of most observations/services.
 type OM7 struct {
 	HL7                                     HL7Name `hl7:",name=OM7,type=s"`
-	SequenceNumberTestObservationMasterFile NM      `hl7:"1,required,len=4,display=Sequence Number - Test/ Observation Master File"`
+	SequenceNumberTestObservationMasterFile NM      `hl7:"1,required,len=4,format=NM,display=Sequence Number - Test/ Observation Master File"`
 	UniversalServiceIdentifier              CE      `hl7:"2,required,len=250,display=Universal Service Identifier"`
 	CategoryIdentifier                      []CE    `hl7:"3,len=250,table=0412,display=Category Identifier"`
 	CategoryDescription                     TX      `hl7:"4,len=200,display=Category Description"`
 	CategorySynonym                         []ST    `hl7:"5,len=200,display=Category Synonym"`
 	EffectiveTestServiceStartDateTime       TS      `hl7:"6,len=26,format=YMDHMS,display=Effective Test/Service Start Date/Time"`
 	EffectiveTestServiceEndDateTime         TS      `hl7:"7,len=26,format=YMDHMS,display=Effective Test/Service End Date/Time"`
-	TestServiceDefaultDurationQuantity      NM      `hl7:"8,len=5,display=Test/Service Default Duration Quantity"`
+	TestServiceDefaultDurationQuantity      NM      `hl7:"8,len=5,format=NM,display=Test/Service Default Duration Quantity"`
 	TestServiceDefaultDurationUnits         *CE     `hl7:"9,len=250,display=Test/Service Default Duration Units"`
 	TestServiceDefaultFrequency             IS      `hl7:"10,len=60,table=0335,display=Test/Service Default Frequency"`
 	ConsentIndicator                        ID      `hl7:"11,len=1,table=0136,display=Consent Indicator"`
 	ConsentIdentifier                       *CE     `hl7:"12,len=250,table=0413,display=Consent Identifier"`
 	ConsentEffectiveStartDateTime           TS      `hl7:"13,len=26,format=YMDHMS,display=Consent Effective Start Date/Time"`
 	ConsentEffectiveEndDateTime             TS      `hl7:"14,len=26,format=YMDHMS,display=Consent Effective End Date/Time"`
-	ConsentIntervalQuantity                 NM      `hl7:"15,len=5,display=Consent Interval Quantity"`
+	ConsentIntervalQuantity                 NM      `hl7:"15,len=5,format=NM,display=Consent Interval Quantity"`
 	ConsentIntervalUnits                    *CE     `hl7:"16,conditional,len=250,table=0414,display=Consent Interval Units"`
-	ConsentWaitingPeriodQuantity            NM      `hl7:"17,len=5,display=Consent Waiting Period Quantity"`
+	ConsentWaitingPeriodQuantity            NM      `hl7:"17,len=5,format=NM,display=Consent Waiting Period Quantity"`
 	ConsentWaitingPeriodUnits               *CE     `hl7:"18,conditional,len=250,table=0414,display=Consent Waiting Period Units"`
 	EffectiveDateTimeOfChange               TS      `hl7:"19,len=26,format=YMDHMS,display=Effective Date/Time of Change"`
 	EnteredBy                               *XCN    `hl7:"20,len=250,display=Entered By"`
@@ -1804,7 +1836,7 @@ type ORC struct {
 // Category.
 type ORG struct {
 	HL7                                        HL7Name `hl7:",name=ORG,type=s"`
-	SetID                                      SI      `hl7:"1,seq,required,len=60,display=Set ID - ORG"`
+	SetID                                      SI      `hl7:"1,seq,required,len=60,format=SI,display=Set ID - ORG"`
 	OrganizationUnitCode                       *CE     `hl7:"2,len=250,table=0405,display=Organization Unit Code"`
 	OrganizationUnitTypeCode                   *CE     `hl7:"3,len=250,table=0474,display=Organization Unit Type Code - ORG"`
 	PrimaryUnitIndicator                       ID      `hl7:"4,len=1,table=0136,display=Primary Org Unit Indicator"`
@@ -1960,7 +1992,7 @@ type PES struct {
 	SenderAddress          []XAD   `hl7:"3,len=250,display=Sender Address"`
 	SenderTelephone        []XTN   `hl7:"4,len=250,display=Sender Telephone"`
 	SenderEventIdentifier  *EI     `hl7:"5,len=75,display=Sender Event Identifier"`
-	SenderSequenceNumber   NM      `hl7:"6,len=2,display=Sender Sequence Number"`
+	SenderSequenceNumber   NM      `hl7:"6,len=2,format=NM,display=Sender Sequence Number"`
 	SenderEventDescription []FT    `hl7:"7,len=600,display=Sender Event Description"`
 	SenderComment          FT      `hl7:"8,len=600,display=Sender Comment"`
 	SenderAwareDateTime    TS      `hl7:"9,len=26,format=YMDHMS,display=Sender Aware Date/Time"`
@@ -1977,7 +2009,7 @@ type PES struct {
 // to change frequently.
 type PID struct {
 	HL7                         HL7Name `hl7:",name=PID,type=s"`
-	SetID                       SI      `hl7:"1,seq,len=4,display=Set ID - PID"`
+	SetID                       SI      `hl7:"1,seq,len=4,format=SI,display=Set ID - PID"`
 	PatientID                   CX      `hl7:"2,len=20,display=Patient ID"`
 	PatientIdentifierList       []CX    `hl7:"3,required,len=250,display=Patient Identifier List"`
 	AlternatePatientID          []CX    `hl7:"4,len=20,display=Alternate Patient ID - PID"`
@@ -2001,7 +2033,7 @@ type PID struct {
 	EthnicGroup                 []CE    `hl7:"22,len=250,table=0189,display=Ethnic Group"`
 	BirthPlace                  ST      `hl7:"23,len=250,display=Birth Place"`
 	MultipleBirthIndicator      ID      `hl7:"24,len=1,table=0136,display=Multiple Birth Indicator"`
-	BirthOrder                  NM      `hl7:"25,len=2,display=Birth Order"`
+	BirthOrder                  NM      `hl7:"25,len=2,format=NM,display=Birth Order"`
 	Citizenship                 []CE    `hl7:"26,len=250,table=0171,display=Citizenship"`
 	VeteransMilitaryStatus      *CE     `hl7:"27,len=250,table=0172,display=Veterans Military Status"`
 	Nationality                 CE      `hl7:"28,len=250,table=0212,display=Nationality"`
@@ -2024,16 +2056,16 @@ type PID struct {
 // The PR1 segment is used to send multiple procedures, for example, for medical records encoding or for billing systems.
 type PR1 struct {
 	HL7                     HL7Name `hl7:",name=PR1,type=s"`
-	SetID                   SI      `hl7:"1,seq,required,len=4,display=Set ID - PR1"`
+	SetID                   SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - PR1"`
 	ProcedureCodingMethod   IS      `hl7:"2,len=3,table=0089,display=Procedure Coding Method"`
 	ProcedureCode           CE      `hl7:"3,required,len=250,table=0088,display=Procedure Code"`
 	ProcedureDescription    ST      `hl7:"4,len=40,display=Procedure Description"`
 	ProcedureDateTime       TS      `hl7:"5,required,len=26,format=YMDHMS,display=Procedure Date/Time"`
 	ProcedureFunctionalType IS      `hl7:"6,len=2,table=0230,display=Procedure Functional Type"`
-	ProcedureMinutes        NM      `hl7:"7,len=4,display=Procedure Minutes"`
+	ProcedureMinutes        NM      `hl7:"7,len=4,format=NM,display=Procedure Minutes"`
 	Anesthesiologist        []XCN   `hl7:"8,len=250,table=0010,display=Anesthesiologist"`
 	AnesthesiaCode          IS      `hl7:"9,len=2,table=0019,display=Anesthesia Code"`
-	AnesthesiaMinutes       NM      `hl7:"10,len=4,display=Anesthesia Minutes"`
+	AnesthesiaMinutes       NM      `hl7:"10,len=4,format=NM,display=Anesthesia Minutes"`
 	Surgeon                 []XCN   `hl7:"11,len=250,table=0010,display=Surgeon"`
 	ProcedurePractitioner   []XCN   `hl7:"12,len=250,table=0010,display=Procedure Practitioner"`
 	ConsentCode             *CE     `hl7:"13,len=250,table=0059,display=Consent Code"`
@@ -2065,7 +2097,7 @@ type PRA struct {
 	Institution                               *CE     `hl7:"9,len=250,display=Institution"`
 	DateLeftPractice                          DT      `hl7:"10,len=8,format=YMD,display=Date Left Practice"`
 	GovernmentReimbursementBillingEligibility []CE    `hl7:"11,len=250,table=0401,display=Government Reimbursement Billing Eligibility"`
-	SetID                                     SI      `hl7:"12,seq,conditional,len=60,display=Set ID - PRA"`
+	SetID                                     SI      `hl7:"12,seq,conditional,len=60,format=SI,display=Set ID - PRA"`
 }
 
 // Problem Details
@@ -2078,7 +2110,7 @@ type PRB struct {
 	ProblemID                                         CE      `hl7:"3,required,len=250,display=Problem ID"`
 	ProblemInstanceID                                 EI      `hl7:"4,required,len=60,display=Problem Instance ID"`
 	EpisodeOfCareID                                   *EI     `hl7:"5,len=60,display=Episode of Care ID"`
-	ProblemListPriority                               NM      `hl7:"6,len=60,display=Problem List Priority"`
+	ProblemListPriority                               NM      `hl7:"6,len=60,format=NM,display=Problem List Priority"`
 	ProblemEstablishedDateTime                        TS      `hl7:"7,len=26,format=YMDHMS,display=Problem Established Date/Time"`
 	AnticipatedProblemResolutionDateTime              TS      `hl7:"8,len=26,format=YMDHMS,display=Anticipated Problem Resolution Date/Time"`
 	ActualProblemResolutionDateTime                   TS      `hl7:"9,len=26,format=YMDHMS,display=Actual Problem Resolution Date/Time"`
@@ -2092,7 +2124,7 @@ type PRB struct {
 	ProblemOnsetText                                  ST      `hl7:"17,len=80,display=Problem Onset Text"`
 	ProblemRanking                                    *CE     `hl7:"18,len=250,display=Problem Ranking"`
 	CertaintyOfProblem                                *CE     `hl7:"19,len=250,display=Certainty of Problem"`
-	ProbabilityOfProblem                              NM      `hl7:"20,len=5,display=Probability of Problem"`
+	ProbabilityOfProblem                              NM      `hl7:"20,len=5,format=NM,display=Probability of Problem"`
 	IndividualAwarenessOfProblem                      *CE     `hl7:"21,len=250,display=Individual Awareness of Problem"`
 	ProblemPrognosis                                  *CE     `hl7:"22,len=250,display=Problem Prognosis"`
 	IndividualAwarenessOfPrognosis                    *CE     `hl7:"23,len=250,display=Individual Awareness of Prognosis"`
@@ -2116,8 +2148,8 @@ type PRC struct {
 	ValidPatientClasses []IS    `hl7:"4,len=1,table=0004,display=Valid Patient Classes"`
 	Price               []CP    `hl7:"5,conditional,len=12,display=Price"`
 	Formula             []ST    `hl7:"6,len=200,display=Formula"`
-	MinimumQuantity     NM      `hl7:"7,len=4,display=Minimum Quantity"`
-	MaximumQuantity     NM      `hl7:"8,len=4,display=Maximum Quantity"`
+	MinimumQuantity     NM      `hl7:"7,len=4,format=NM,display=Minimum Quantity"`
+	MaximumQuantity     NM      `hl7:"8,len=4,format=NM,display=Maximum Quantity"`
 	MinimumPrice        *MO     `hl7:"9,len=12,display=Minimum Price"`
 	MaximumPrice        *MO     `hl7:"10,len=12,display=Maximum Price"`
 	EffectiveStartDate  TS      `hl7:"11,len=26,format=YMDHMS,display=Effective Start Date"`
@@ -2168,8 +2200,8 @@ type PSH struct {
 	QuantityInUse                                      *CQ     `hl7:"10,len=12,display=Quantity in Use"`
 	QuantityInUseMethod                                ID      `hl7:"11,len=1,table=0329,display=Quantity in Use Method"`
 	QuantityInUseComment                               FT      `hl7:"12,len=600,display=Quantity in Use Comment"`
-	NumberOfProductExperienceReportsFiledByFacility    []NM    `hl7:"13,max=8,len=2,display=Number of Product Experience Reports Filed by Facility"`
-	NumberOfProductExperienceReportsFiledByDistributor []NM    `hl7:"14,max=8,len=2,display=Number of Product Experience Reports Filed by Distributor"`
+	NumberOfProductExperienceReportsFiledByFacility    []NM    `hl7:"13,max=8,len=2,format=NM,display=Number of Product Experience Reports Filed by Facility"`
+	NumberOfProductExperienceReportsFiledByDistributor []NM    `hl7:"14,max=8,len=2,format=NM,display=Number of Product Experience Reports Filed by Distributor"`
 }
 
 // Pathway
@@ -2194,7 +2226,7 @@ type PTH struct {
 // that are part of the associated PV1 hierarchy (e.g. ROL, DG1, or OBX).
 type PV1 struct {
 	HL7                     HL7Name `hl7:",name=PV1,type=s"`
-	SetID                   SI      `hl7:"1,seq,len=4,display=Set ID - PV1"`
+	SetID                   SI      `hl7:"1,seq,len=4,format=SI,display=Set ID - PV1"`
 	PatientClass            IS      `hl7:"2,required,len=1,table=0004,display=Patient Class"`
 	AssignedPatientLocation *PL     `hl7:"3,len=80,display=Assigned Patient Location"`
 	AdmissionType           IS      `hl7:"4,len=2,table=0007,display=Admission Type"`
@@ -2219,14 +2251,14 @@ type PV1 struct {
 	CreditRating            IS      `hl7:"23,len=2,table=0046,display=Credit Rating"`
 	ContractCode            []IS    `hl7:"24,len=2,table=0044,display=Contract Code"`
 	ContractEffectiveDate   []DT    `hl7:"25,len=8,format=YMD,display=Contract Effective Date"`
-	ContractAmount          []NM    `hl7:"26,len=12,display=Contract Amount"`
-	ContractPeriod          []NM    `hl7:"27,len=3,display=Contract Period"`
+	ContractAmount          []NM    `hl7:"26,len=12,format=NM,display=Contract Amount"`
+	ContractPeriod          []NM    `hl7:"27,len=3,format=NM,display=Contract Period"`
 	InterestCode            IS      `hl7:"28,len=2,table=0073,display=Interest Code"`
 	TransferToBadDebtCode   IS      `hl7:"29,len=1,table=0110,display=Transfer to Bad Debt Code"`
 	TransferToBadDebtDate   DT      `hl7:"30,len=8,format=YMD,display=Transfer to Bad Debt Date"`
 	BadDebtAgencyCode       IS      `hl7:"31,len=10,table=0021,display=Bad Debt Agency Code"`
-	BadDebtTransferAmount   NM      `hl7:"32,len=12,display=Bad Debt Transfer Amount"`
-	BadDebtRecoveryAmount   NM      `hl7:"33,len=12,display=Bad Debt Recovery Amount"`
+	BadDebtTransferAmount   NM      `hl7:"32,len=12,format=NM,display=Bad Debt Transfer Amount"`
+	BadDebtRecoveryAmount   NM      `hl7:"33,len=12,format=NM,display=Bad Debt Recovery Amount"`
 	DeleteAccountIndicator  IS      `hl7:"34,len=1,table=0111,display=Delete Account Indicator"`
 	DeleteAccountDate       DT      `hl7:"35,len=8,format=YMD,display=Delete Account Date"`
 	DischargeDisposition    IS      `hl7:"36,len=3,table=0112,display=Discharge Disposition"`
@@ -2239,10 +2271,10 @@ type PV1 struct {
 	PriorTemporaryLocation  *PL     `hl7:"43,len=80,display=Prior Temporary Location"`
 	AdmitDateTime           TS      `hl7:"44,len=26,format=YMDHMS,display=Admit Date/Time"`
 	DischargeDateTime       []TS    `hl7:"45,len=26,format=YMDHMS,display=Discharge Date/Time"`
-	CurrentPatientBalance   NM      `hl7:"46,len=12,display=Current Patient Balance"`
-	TotalCharges            NM      `hl7:"47,len=12,display=Total Charges"`
-	TotalAdjustments        NM      `hl7:"48,len=12,display=Total Adjustments"`
-	TotalPayments           NM      `hl7:"49,len=12,display=Total Payments"`
+	CurrentPatientBalance   NM      `hl7:"46,len=12,format=NM,display=Current Patient Balance"`
+	TotalCharges            NM      `hl7:"47,len=12,format=NM,display=Total Charges"`
+	TotalAdjustments        NM      `hl7:"48,len=12,format=NM,display=Total Adjustments"`
+	TotalPayments           NM      `hl7:"49,len=12,format=NM,display=Total Payments"`
 	AlternateVisitID        *CX     `hl7:"50,len=250,display=Alternate Visit ID"`
 	VisitIndicator          IS      `hl7:"51,len=1,table=0326,display=Visit Indicator"`
 	OtherHealthcareProvider []XCN   `hl7:"52,len=250,table=0010,display=Other Healthcare Provider"`
@@ -2262,8 +2294,8 @@ type PV2 struct {
 	VisitUserCode                     []IS    `hl7:"7,len=2,table=0130,display=Visit User Code"`
 	ExpectedAdmitDateTime             TS      `hl7:"8,len=26,format=YMDHMS,display=Expected Admit Date/Time"`
 	ExpectedDischargeDateTime         TS      `hl7:"9,len=26,format=YMDHMS,display=Expected Discharge Date/Time"`
-	EstimatedLengthOfInpatientStay    NM      `hl7:"10,len=3,display=Estimated Length of Inpatient Stay"`
-	ActualLengthOfInpatientStay       NM      `hl7:"11,len=3,display=Actual Length of Inpatient Stay"`
+	EstimatedLengthOfInpatientStay    NM      `hl7:"10,len=3,format=NM,display=Estimated Length of Inpatient Stay"`
+	ActualLengthOfInpatientStay       NM      `hl7:"11,len=3,format=NM,display=Actual Length of Inpatient Stay"`
 	VisitDescription                  ST      `hl7:"12,len=50,display=Visit Description"`
 	ReferralSourceCode                []XCN   `hl7:"13,len=250,display=Referral Source Code"`
 	PreviousServiceDate               DT      `hl7:"14,len=8,format=YMD,display=Previous Service Date"`
@@ -2272,7 +2304,7 @@ type PV2 struct {
 	PurgeStatusDate                   DT      `hl7:"17,len=8,format=YMD,display=Purge Status Date"`
 	SpecialProgramCode                IS      `hl7:"18,len=2,table=0214,display=Special Program Code"`
 	RetentionIndicator                ID      `hl7:"19,len=1,table=0136,display=Retention Indicator"`
-	ExpectedNumberOfInsurancePlans    NM      `hl7:"20,len=1,display=Expected Number of Insurance Plans"`
+	ExpectedNumberOfInsurancePlans    NM      `hl7:"20,len=1,format=NM,display=Expected Number of Insurance Plans"`
 	VisitPublicityCode                IS      `hl7:"21,len=1,table=0215,display=Visit Publicity Code"`
 	VisitProtectionIndicator          ID      `hl7:"22,len=1,table=0136,display=Visit Protection Indicator"`
 	ClinicOrganizationName            []XON   `hl7:"23,len=250,display=Clinic Organization Name"`
@@ -2312,9 +2344,9 @@ type QAK struct {
 	QueryTag            ST      `hl7:"1,conditional,len=32,display=Query Tag"`
 	QueryResponseStatus ID      `hl7:"2,len=2,table=0208,display=Query Response Status"`
 	MessageQueryName    *CE     `hl7:"3,len=250,display=Message Query Name"`
-	HitCountTotal       NM      `hl7:"4,len=10,display=Hit Count Total"`
-	ThisPayload         NM      `hl7:"5,len=10,display=This payload"`
-	HitsRemaining       NM      `hl7:"6,len=10,display=Hits remaining"`
+	HitCountTotal       NM      `hl7:"4,len=10,format=NM,display=Hit Count Total"`
+	ThisPayload         NM      `hl7:"5,len=10,format=NM,display=This payload"`
+	HitsRemaining       NM      `hl7:"6,len=10,format=NM,display=Hits remaining"`
 }
 
 // Query Identification
@@ -2333,6 +2365,25 @@ type QPD struct {
 	MessageQueryName CE      `hl7:"1,required,len=250,table=0471,display=Message Query Name"`
 	QueryTag         ST      `hl7:"2,len=32,display=Query Tag"`
 	UserParameters   *VARIES `hl7:"3,len=256,display=User Parameters"`
+	UserParameters2  *VARIES `hl7:"4,len=256,display=User Parameters"`
+	UserParameters3  *VARIES `hl7:"5,len=256,display=User Parameters"`
+	UserParameters4  *VARIES `hl7:"6,len=256,display=User Parameters"`
+	UserParameters5  *VARIES `hl7:"7,len=256,display=User Parameters"`
+	UserParameters6  *VARIES `hl7:"8,len=256,display=User Parameters"`
+	UserParameters7  *VARIES `hl7:"9,len=256,display=User Parameters"`
+	UserParameters8  *VARIES `hl7:"10,len=256,display=User Parameters"`
+	UserParameters9  *VARIES `hl7:"11,len=256,display=User Parameters"`
+	UserParameters10 *VARIES `hl7:"12,len=256,display=User Parameters"`
+	UserParameters11 *VARIES `hl7:"13,len=256,display=User Parameters"`
+	UserParameters12 *VARIES `hl7:"14,len=256,display=User Parameters"`
+	UserParameters13 *VARIES `hl7:"15,len=256,display=User Parameters"`
+	UserParameters14 *VARIES `hl7:"16,len=256,display=User Parameters"`
+	UserParameters15 *VARIES `hl7:"17,len=256,display=User Parameters"`
+	UserParameters16 *VARIES `hl7:"18,len=256,display=User Parameters"`
+	UserParameters17 *VARIES `hl7:"19,len=256,display=User Parameters"`
+	UserParameters18 *VARIES `hl7:"20,len=256,display=User Parameters"`
+	UserParameters19 *VARIES `hl7:"21,len=256,display=User Parameters"`
+	UserParameters20 *VARIES `hl7:"22,len=256,display=User Parameters"`
 }
 
 // Original-Style Query Definition
@@ -2368,7 +2419,7 @@ type QRF struct {
 	WhichDateTimeStatusQualifier []ID    `hl7:"7,len=12,table=0157,display=Which Date/Time Status Qualifier"`
 	DateTimeSelectionQualifier   []ID    `hl7:"8,len=12,table=0158,display=Date/Time Selection Qualifier"`
 	WhenQuantityTimingQualifier  *TQ     `hl7:"9,len=60,display=When Quantity/Timing Qualifier"`
-	SearchConfidenceThreshold    NM      `hl7:"10,len=10,display=Search Confidence Threshold"`
+	SearchConfidenceThreshold    NM      `hl7:"10,len=10,format=NM,display=Search Confidence Threshold"`
 }
 
 // Query Response Instance
@@ -2377,7 +2428,7 @@ type QRF struct {
 // algorithm) and/or the match reason code (where the responding system uses rules or other match options).
 type QRI struct {
 	HL7                 HL7Name `hl7:",name=QRI,type=s"`
-	CandidateConfidence NM      `hl7:"1,len=10,display=Candidate Confidence"`
+	CandidateConfidence NM      `hl7:"1,len=10,format=NM,display=Candidate Confidence"`
 	MatchReasonCode     []IS    `hl7:"2,len=2,table=0392,display=Match Reason Code"`
 	AlgorithmDescriptor *CE     `hl7:"3,len=250,table=0393,display=Algorithm Descriptor"`
 }
@@ -2401,7 +2452,7 @@ type RCP struct {
 // The RDF segment defines the content of the row data segments (RDT) in the tabular response (RTB).
 type RDF struct {
 	HL7                   HL7Name `hl7:",name=RDF,type=s"`
-	NumberOfColumnsPerRow NM      `hl7:"1,required,len=3,display=Number of Columns per Row"`
+	NumberOfColumnsPerRow NM      `hl7:"1,required,len=3,format=NM,display=Number of Columns per Row"`
 	ColumnDescription     []RCD   `hl7:"2,required,len=40,display=Column Description"`
 }
 
@@ -2409,8 +2460,27 @@ type RDF struct {
 //
 // The RDT segment contains the row data of the tabular data response message (TBR).
 type RDT struct {
-	HL7         HL7Name `hl7:",name=RDT,type=s"`
-	ColumnValue VARIES  `hl7:"1,required,display=Column Value"`
+	HL7           HL7Name `hl7:",name=RDT,type=s"`
+	ColumnValue   VARIES  `hl7:"1,required,display=Column Value"`
+	ColumnValue2  *VARIES `hl7:"2,display=Column Value"`
+	ColumnValue3  *VARIES `hl7:"3,display=Column Value"`
+	ColumnValue4  *VARIES `hl7:"4,display=Column Value"`
+	ColumnValue5  *VARIES `hl7:"5,display=Column Value"`
+	ColumnValue6  *VARIES `hl7:"6,display=Column Value"`
+	ColumnValue7  *VARIES `hl7:"7,display=Column Value"`
+	ColumnValue8  *VARIES `hl7:"8,display=Column Value"`
+	ColumnValue9  *VARIES `hl7:"9,display=Column Value"`
+	ColumnValue10 *VARIES `hl7:"10,display=Column Value"`
+	ColumnValue11 *VARIES `hl7:"11,display=Column Value"`
+	ColumnValue12 *VARIES `hl7:"12,display=Column Value"`
+	ColumnValue13 *VARIES `hl7:"13,display=Column Value"`
+	ColumnValue14 *VARIES `hl7:"14,display=Column Value"`
+	ColumnValue15 *VARIES `hl7:"15,display=Column Value"`
+	ColumnValue16 *VARIES `hl7:"16,display=Column Value"`
+	ColumnValue17 *VARIES `hl7:"17,display=Column Value"`
+	ColumnValue18 *VARIES `hl7:"18,display=Column Value"`
+	ColumnValue19 *VARIES `hl7:"19,display=Column Value"`
+	ColumnValue20 *VARIES `hl7:"20,display=Column Value"`
 }
 
 // Referral Information
@@ -2441,7 +2511,7 @@ type RF1 struct {
 // or AIP).
 type RGS struct {
 	HL7               HL7Name `hl7:",name=RGS,type=s"`
-	SetID             SI      `hl7:"1,seq,required,len=4,display=Set ID - RGS"`
+	SetID             SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID - RGS"`
 	SegmentActionCode ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
 	ResourceGroupID   *CE     `hl7:"3,len=250,display=Resource Group ID"`
 }
@@ -2496,11 +2566,11 @@ type RQ1 struct {
 // RQD contains the detail for each requisitioned item. See assumptions above.
 type RQD struct {
 	HL7                      HL7Name `hl7:",name=RQD,type=s"`
-	RequisitionLineNumber    SI      `hl7:"1,len=4,display=Requisition Line Number"`
+	RequisitionLineNumber    SI      `hl7:"1,len=4,format=SI,display=Requisition Line Number"`
 	ItemCodeInternal         *CE     `hl7:"2,conditional,len=250,display=Item Code - Internal"`
 	ItemCodeExternal         *CE     `hl7:"3,conditional,len=250,display=Item Code - External"`
 	HospitalItemCode         *CE     `hl7:"4,conditional,len=250,display=Hospital Item Code"`
-	RequisitionQuantity      NM      `hl7:"5,len=6,display=Requisition Quantity"`
+	RequisitionQuantity      NM      `hl7:"5,len=6,format=NM,display=Requisition Quantity"`
 	RequisitionUnitOfMeasure *CE     `hl7:"6,len=250,display=Requisition Unit of Measure"`
 	DeptCostCenter           IS      `hl7:"7,len=30,table=0319,display=Dept. Cost Center"`
 	ItemNaturalAccountCode   IS      `hl7:"8,len=30,table=0320,display=Item Natural Account Code"`
@@ -2515,19 +2585,19 @@ type RQD struct {
 // the administration data.
 type RXA struct {
 	HL7                             HL7Name `hl7:",name=RXA,type=s"`
-	GiveSubIDCounter                NM      `hl7:"1,required,len=4,display=Give Sub-ID Counter"`
-	AdministrationSubIDCounter      NM      `hl7:"2,required,len=4,display=Administration Sub-ID Counter"`
+	GiveSubIDCounter                NM      `hl7:"1,required,len=4,format=NM,display=Give Sub-ID Counter"`
+	AdministrationSubIDCounter      NM      `hl7:"2,required,len=4,format=NM,display=Administration Sub-ID Counter"`
 	DateTimeStartOfAdministration   TS      `hl7:"3,required,len=26,format=YMDHMS,display=Date/Time Start of Administration"`
 	DateTimeEndOfAdministration     TS      `hl7:"4,required,len=26,format=YMDHMS,display=Date/Time End of Administration"`
 	AdministeredCode                CE      `hl7:"5,required,len=250,table=0292,display=Administered Code"`
-	AdministeredAmount              NM      `hl7:"6,required,len=20,display=Administered Amount"`
+	AdministeredAmount              NM      `hl7:"6,required,len=20,format=NM,display=Administered Amount"`
 	AdministeredUnits               *CE     `hl7:"7,conditional,len=250,display=Administered Units"`
 	AdministeredDosageForm          *CE     `hl7:"8,len=250,display=Administered Dosage Form"`
 	AdministrationNotes             []CE    `hl7:"9,len=250,display=Administration Notes"`
 	AdministeringProvider           []XCN   `hl7:"10,len=250,display=Administering Provider"`
 	AdministeredAtLocation          *LA2    `hl7:"11,conditional,len=200,display=Administered-at Location"`
 	AdministeredPer                 ST      `hl7:"12,conditional,len=20,display=Administered Per"`
-	AdministeredStrength            NM      `hl7:"13,len=20,display=Administered Strength"`
+	AdministeredStrength            NM      `hl7:"13,len=20,format=NM,display=Administered Strength"`
 	AdministeredStrengthUnits       *CE     `hl7:"14,len=250,display=Administered Strength Units"`
 	SubstanceLotNumber              []ST    `hl7:"15,len=20,display=Substance Lot Number"`
 	SubstanceExpirationDate         []TS    `hl7:"16,len=26,format=YMDHMS,display=Substance Expiration Date"`
@@ -2549,9 +2619,9 @@ type RXC struct {
 	HL7                    HL7Name `hl7:",name=RXC,type=s"`
 	RXComponentType        ID      `hl7:"1,required,len=1,table=0166,display=RX Component Type"`
 	ComponentCode          CE      `hl7:"2,required,len=250,display=Component Code"`
-	ComponentAmount        NM      `hl7:"3,required,len=20,display=Component Amount"`
+	ComponentAmount        NM      `hl7:"3,required,len=20,format=NM,display=Component Amount"`
 	ComponentUnits         CE      `hl7:"4,required,len=250,display=Component Units"`
-	ComponentStrength      NM      `hl7:"5,len=20,display=Component Strength"`
+	ComponentStrength      NM      `hl7:"5,len=20,format=NM,display=Component Strength"`
 	ComponentStrengthUnits *CE     `hl7:"6,len=250,display=Component Strength Units"`
 	SupplementaryCode      []CE    `hl7:"7,len=250,display=Supplementary Code"`
 }
@@ -2561,14 +2631,14 @@ type RXC struct {
 // HL7 Attribute Table - RXD - Pharmacy/Treatment Dispense
 type RXD struct {
 	HL7                                                     HL7Name `hl7:",name=RXD,type=s"`
-	DispenseSubIDCounter                                    NM      `hl7:"1,required,len=4,display=Dispense Sub-ID Counter"`
+	DispenseSubIDCounter                                    NM      `hl7:"1,required,len=4,format=NM,display=Dispense Sub-ID Counter"`
 	DispenseGiveCode                                        CE      `hl7:"2,required,len=250,table=0292,display=Dispense/Give Code"`
 	DateTimeDispensed                                       TS      `hl7:"3,required,len=26,format=YMDHMS,display=Date/Time Dispensed"`
-	ActualDispenseAmount                                    NM      `hl7:"4,required,len=20,display=Actual Dispense Amount"`
+	ActualDispenseAmount                                    NM      `hl7:"4,required,len=20,format=NM,display=Actual Dispense Amount"`
 	ActualDispenseUnits                                     *CE     `hl7:"5,conditional,len=250,display=Actual Dispense Units"`
 	ActualDosageForm                                        *CE     `hl7:"6,len=250,display=Actual Dosage Form"`
 	PrescriptionNumber                                      ST      `hl7:"7,required,len=20,display=Prescription Number"`
-	NumberOfRefillsRemaining                                NM      `hl7:"8,conditional,len=20,display=Number of Refills Remaining"`
+	NumberOfRefillsRemaining                                NM      `hl7:"8,conditional,len=20,format=NM,display=Number of Refills Remaining"`
 	DispenseNotes                                           []ST    `hl7:"9,len=200,display=Dispense Notes"`
 	DispensingProvider                                      []XCN   `hl7:"10,len=200,display=Dispensing Provider"`
 	SubstitutionStatus                                      ID      `hl7:"11,len=1,table=0167,display=Substitution Status"`
@@ -2576,13 +2646,13 @@ type RXD struct {
 	DispenseToLocation                                      *LA2    `hl7:"13,conditional,len=200,display=Dispense-To Location"`
 	NeedsHumanReview                                        ID      `hl7:"14,len=1,table=0136,display=Needs Human Review"`
 	PharmacyTreatmentSuppliersSpecialDispensingInstructions []CE    `hl7:"15,len=250,display=Pharmacy/Treatment Supplier's Special Dispensing Instructions"`
-	ActualStrength                                          NM      `hl7:"16,len=20,display=Actual Strength"`
+	ActualStrength                                          NM      `hl7:"16,len=20,format=NM,display=Actual Strength"`
 	ActualStrengthUnit                                      *CE     `hl7:"17,len=250,display=Actual Strength Unit"`
 	SubstanceLotNumber                                      []ST    `hl7:"18,len=20,display=Substance Lot Number"`
 	SubstanceExpirationDate                                 []TS    `hl7:"19,len=26,format=YMDHMS,display=Substance Expiration Date"`
 	SubstanceManufacturerName                               []CE    `hl7:"20,len=250,table=0227,display=Substance Manufacturer Name"`
 	Indication                                              []CE    `hl7:"21,len=250,display=Indication"`
-	DispensePackageSize                                     NM      `hl7:"22,len=20,display=Dispense Package Size"`
+	DispensePackageSize                                     NM      `hl7:"22,len=20,format=NM,display=Dispense Package Size"`
 	DispensePackageSizeUnit                                 *CE     `hl7:"23,len=250,display=Dispense Package Size Unit"`
 	DispensePackageMethod                                   ID      `hl7:"24,len=2,table=0321,display=Dispense Package Method"`
 	SupplementaryCode                                       []CE    `hl7:"25,len=250,display=Supplementary Code"`
@@ -2599,21 +2669,21 @@ type RXE struct {
 	HL7                                                     HL7Name `hl7:",name=RXE,type=s"`
 	QuantityTiming                                          TQ      `hl7:"1,required,len=200,display=Quantity/Timing"`
 	GiveCode                                                CE      `hl7:"2,required,len=250,table=0292,display=Give Code"`
-	GiveAmountMinimum                                       NM      `hl7:"3,required,len=20,display=Give Amount - Minimum"`
-	GiveAmountMaximum                                       NM      `hl7:"4,len=20,display=Give Amount - Maximum"`
+	GiveAmountMinimum                                       NM      `hl7:"3,required,len=20,format=NM,display=Give Amount - Minimum"`
+	GiveAmountMaximum                                       NM      `hl7:"4,len=20,format=NM,display=Give Amount - Maximum"`
 	GiveUnits                                               CE      `hl7:"5,required,len=250,display=Give Units"`
 	GiveDosageForm                                          *CE     `hl7:"6,len=250,display=Give Dosage Form"`
 	ProvidersAdministrationInstructions                     []CE    `hl7:"7,len=250,display=Provider's Administration Instructions"`
 	DeliverToLocation                                       *LA1    `hl7:"8,conditional,len=200,display=Deliver-To Location"`
 	SubstitutionStatus                                      ID      `hl7:"9,len=1,table=0167,display=Substitution Status"`
-	DispenseAmount                                          NM      `hl7:"10,conditional,len=20,display=Dispense Amount"`
+	DispenseAmount                                          NM      `hl7:"10,conditional,len=20,format=NM,display=Dispense Amount"`
 	DispenseUnits                                           *CE     `hl7:"11,conditional,len=250,display=Dispense Units"`
-	NumberOfRefills                                         NM      `hl7:"12,len=3,display=Number of Refills"`
+	NumberOfRefills                                         NM      `hl7:"12,len=3,format=NM,display=Number of Refills"`
 	OrderingProvidersDEANumber                              []XCN   `hl7:"13,conditional,len=250,display=Ordering Provider's DEA Number"`
 	PharmacistTreatmentSuppliersVerifierID                  []XCN   `hl7:"14,len=250,display=Pharmacist/Treatment Supplier's Verifier ID"`
 	PrescriptionNumber                                      ST      `hl7:"15,conditional,len=20,display=Prescription Number"`
-	NumberOfRefillsRemaining                                NM      `hl7:"16,conditional,len=20,display=Number of Refills Remaining"`
-	NumberOfRefillsDosesDispensed                           NM      `hl7:"17,conditional,len=20,display=Number of Refills/Doses Dispensed"`
+	NumberOfRefillsRemaining                                NM      `hl7:"16,conditional,len=20,format=NM,display=Number of Refills Remaining"`
+	NumberOfRefillsDosesDispensed                           NM      `hl7:"17,conditional,len=20,format=NM,display=Number of Refills/Doses Dispensed"`
 	DTOfMostRecentRefillOrDoseDispensed                     TS      `hl7:"18,conditional,len=26,format=YMDHMS,display=D/T of Most Recent Refill or Dose Dispensed"`
 	TotalDailyDose                                          *CQ     `hl7:"19,conditional,len=10,display=Total Daily Dose"`
 	NeedsHumanReview                                        ID      `hl7:"20,len=1,table=0136,display=Needs Human Review"`
@@ -2621,10 +2691,10 @@ type RXE struct {
 	GivePer                                                 ST      `hl7:"22,conditional,len=20,display=Give Per"`
 	GiveRateAmount                                          ST      `hl7:"23,len=6,display=Give Rate Amount"`
 	GiveRateUnits                                           *CE     `hl7:"24,len=250,display=Give Rate Units"`
-	GiveStrength                                            NM      `hl7:"25,len=20,display=Give Strength"`
+	GiveStrength                                            NM      `hl7:"25,len=20,format=NM,display=Give Strength"`
 	GiveStrengthUnits                                       *CE     `hl7:"26,len=250,display=Give Strength Units"`
 	GiveIndication                                          []CE    `hl7:"27,len=250,display=Give Indication"`
-	DispensePackageSize                                     NM      `hl7:"28,len=20,display=Dispense Package Size"`
+	DispensePackageSize                                     NM      `hl7:"28,len=20,format=NM,display=Dispense Package Size"`
 	DispensePackageSizeUnit                                 *CE     `hl7:"29,len=250,display=Dispense Package Size Unit"`
 	DispensePackageMethod                                   ID      `hl7:"30,len=2,table=0321,display=Dispense Package Method"`
 	SupplementaryCode                                       []CE    `hl7:"31,len=250,display=Supplementary Code"`
@@ -2633,12 +2703,12 @@ type RXE struct {
 // Pharmacy/Treatment Give
 type RXG struct {
 	HL7                                                         HL7Name `hl7:",name=RXG,type=s"`
-	GiveSubIDCounter                                            NM      `hl7:"1,required,len=4,display=Give Sub-ID Counter"`
-	DispenseSubIDCounter                                        NM      `hl7:"2,len=4,display=Dispense Sub-ID Counter"`
+	GiveSubIDCounter                                            NM      `hl7:"1,required,len=4,format=NM,display=Give Sub-ID Counter"`
+	DispenseSubIDCounter                                        NM      `hl7:"2,len=4,format=NM,display=Dispense Sub-ID Counter"`
 	QuantityTiming                                              TQ      `hl7:"3,required,len=200,display=Quantity/Timing"`
 	GiveCode                                                    CE      `hl7:"4,required,len=250,table=0292,display=Give Code"`
-	GiveAmountMinimum                                           NM      `hl7:"5,required,len=20,display=Give Amount - Minimum"`
-	GiveAmountMaximum                                           NM      `hl7:"6,len=20,display=Give Amount - Maximum"`
+	GiveAmountMinimum                                           NM      `hl7:"5,required,len=20,format=NM,display=Give Amount - Minimum"`
+	GiveAmountMaximum                                           NM      `hl7:"6,len=20,format=NM,display=Give Amount - Maximum"`
 	GiveUnits                                                   CE      `hl7:"7,required,len=250,display=Give Units"`
 	GiveDosageForm                                              *CE     `hl7:"8,len=250,display=Give Dosage Form"`
 	AdministrationNotes                                         []CE    `hl7:"9,len=250,display=Administration Notes"`
@@ -2649,7 +2719,7 @@ type RXG struct {
 	GivePer                                                     ST      `hl7:"14,conditional,len=20,display=Give Per"`
 	GiveRateAmount                                              ST      `hl7:"15,len=6,display=Give Rate Amount"`
 	GiveRateUnits                                               *CE     `hl7:"16,len=250,display=Give Rate Units"`
-	GiveStrength                                                NM      `hl7:"17,len=20,display=Give Strength"`
+	GiveStrength                                                NM      `hl7:"17,len=20,format=NM,display=Give Strength"`
 	GiveStrengthUnits                                           *CE     `hl7:"18,len=250,display=Give Strength Units"`
 	SubstanceLotNumber                                          []ST    `hl7:"19,len=20,display=Substance Lot Number"`
 	SubstanceExpirationDate                                     []TS    `hl7:"20,len=26,format=YMDHMS,display=Substance Expiration Date"`
@@ -2664,8 +2734,8 @@ type RXG struct {
 type RXO struct {
 	HL7                                    HL7Name `hl7:",name=RXO,type=s"`
 	RequestedGiveCode                      *CE     `hl7:"1,conditional,len=250,display=Requested Give Code"`
-	RequestedGiveAmountMinimum             NM      `hl7:"2,conditional,len=20,display=Requested Give Amount - Minimum"`
-	RequestedGiveAmountMaximum             NM      `hl7:"3,len=20,display=Requested Give Amount - Maximum"`
+	RequestedGiveAmountMinimum             NM      `hl7:"2,conditional,len=20,format=NM,display=Requested Give Amount - Minimum"`
+	RequestedGiveAmountMaximum             NM      `hl7:"3,len=20,format=NM,display=Requested Give Amount - Maximum"`
 	RequestedGiveUnits                     *CE     `hl7:"4,conditional,len=250,display=Requested Give Units"`
 	RequestedDosageForm                    *CE     `hl7:"5,conditional,len=250,display=Requested Dosage Form"`
 	ProvidersPharmacyTreatmentInstructions []CE    `hl7:"6,len=250,display=Provider's Pharmacy/Treatment Instructions"`
@@ -2673,14 +2743,14 @@ type RXO struct {
 	DeliverToLocation                      *LA1    `hl7:"8,len=200,display=Deliver-To Location"`
 	AllowSubstitutions                     ID      `hl7:"9,len=1,table=0161,display=Allow Substitutions"`
 	RequestedDispenseCode                  *CE     `hl7:"10,len=250,display=Requested Dispense Code"`
-	RequestedDispenseAmount                NM      `hl7:"11,len=20,display=Requested Dispense Amount"`
+	RequestedDispenseAmount                NM      `hl7:"11,len=20,format=NM,display=Requested Dispense Amount"`
 	RequestedDispenseUnits                 *CE     `hl7:"12,len=250,display=Requested Dispense Units"`
-	NumberOfRefills                        NM      `hl7:"13,len=3,display=Number of Refills"`
+	NumberOfRefills                        NM      `hl7:"13,len=3,format=NM,display=Number of Refills"`
 	OrderingProvidersDEANumber             []XCN   `hl7:"14,conditional,len=250,display=Ordering Provider's DEA Number"`
 	PharmacistTreatmentSuppliersVerifierID []XCN   `hl7:"15,conditional,len=250,display=Pharmacist/Treatment Supplier's Verifier ID"`
 	NeedsHumanReview                       ID      `hl7:"16,len=1,table=0136,display=Needs Human Review"`
 	RequestedGivePer                       ST      `hl7:"17,conditional,len=20,display=Requested Give Per"`
-	RequestedGiveStrength                  NM      `hl7:"18,len=20,display=Requested Give Strength"`
+	RequestedGiveStrength                  NM      `hl7:"18,len=20,format=NM,display=Requested Give Strength"`
 	RequestedGiveStrengthUnits             *CE     `hl7:"19,len=250,display=Requested Give Strength Units"`
 	Indication                             []CE    `hl7:"20,len=250,display=Indication"`
 	RequestedGiveRateAmount                ST      `hl7:"21,len=6,display=Requested Give Rate Amount"`
@@ -2725,14 +2795,14 @@ type SAC struct {
 	TrayIdentifier                    *EI     `hl7:"13,len=80,display=Tray Identifier"`
 	PositionInTray                    *NA     `hl7:"14,len=80,display=Position in Tray"`
 	Location                          []CE    `hl7:"15,len=250,display=Location"`
-	ContainerHeight                   NM      `hl7:"16,len=20,display=Container Height"`
-	ContainerDiameter                 NM      `hl7:"17,len=20,display=Container Diameter"`
-	BarrierDelta                      NM      `hl7:"18,len=20,display=Barrier Delta"`
-	BottomDelta                       NM      `hl7:"19,len=20,display=Bottom Delta"`
+	ContainerHeight                   NM      `hl7:"16,len=20,format=NM,display=Container Height"`
+	ContainerDiameter                 NM      `hl7:"17,len=20,format=NM,display=Container Diameter"`
+	BarrierDelta                      NM      `hl7:"18,len=20,format=NM,display=Barrier Delta"`
+	BottomDelta                       NM      `hl7:"19,len=20,format=NM,display=Bottom Delta"`
 	ContainerHeightDiameterDeltaUnits *CE     `hl7:"20,len=250,display=Container Height/Diameter/Delta Units"`
-	ContainerVolume                   NM      `hl7:"21,len=20,display=Container Volume"`
-	AvailableVolume                   NM      `hl7:"22,len=20,display=Available Volume"`
-	InitialSpecimenVolume             NM      `hl7:"23,len=20,display=Initial Specimen Volume"`
+	ContainerVolume                   NM      `hl7:"21,len=20,format=NM,display=Container Volume"`
+	AvailableVolume                   NM      `hl7:"22,len=20,format=NM,display=Available Volume"`
+	InitialSpecimenVolume             NM      `hl7:"23,len=20,format=NM,display=Initial Specimen Volume"`
 	VolumeUnits                       *CE     `hl7:"24,len=250,display=Volume  Units"`
 	SeparatorType                     *CE     `hl7:"25,len=250,table=0380,display=Separator Type"`
 	CapType                           *CE     `hl7:"26,len=250,table=0381,display=Cap Type"`
@@ -2741,13 +2811,13 @@ type SAC struct {
 	DilutionFactor                    *SN     `hl7:"29,len=20,display=Dilution Factor"`
 	Treatment                         *CE     `hl7:"30,len=250,table=0373,display=Treatment"`
 	Temperature                       *SN     `hl7:"31,len=20,display=Temperature"`
-	HemolysisIndex                    NM      `hl7:"32,len=20,display=Hemolysis Index"`
+	HemolysisIndex                    NM      `hl7:"32,len=20,format=NM,display=Hemolysis Index"`
 	HemolysisIndexUnits               *CE     `hl7:"33,len=250,display=Hemolysis Index Units"`
-	LipemiaIndex                      NM      `hl7:"34,len=20,display=Lipemia Index"`
+	LipemiaIndex                      NM      `hl7:"34,len=20,format=NM,display=Lipemia Index"`
 	LipemiaIndexUnits                 *CE     `hl7:"35,len=250,display=Lipemia Index Units"`
-	IcterusIndex                      NM      `hl7:"36,len=20,display=Icterus Index"`
+	IcterusIndex                      NM      `hl7:"36,len=20,format=NM,display=Icterus Index"`
 	IcterusIndexUnits                 *CE     `hl7:"37,len=250,display=Icterus Index Units"`
-	FibrinIndex                       NM      `hl7:"38,len=20,display=Fibrin Index"`
+	FibrinIndex                       NM      `hl7:"38,len=20,format=NM,display=Fibrin Index"`
 	FibrinIndexUnits                  *CE     `hl7:"39,len=250,display=Fibrin Index Units"`
 	SystemInducedContaminants         []CE    `hl7:"40,len=250,table=0374,display=System Induced Contaminants"`
 	DrugInterference                  []CE    `hl7:"41,len=250,table=0382,display=Drug Interference"`
@@ -2763,13 +2833,13 @@ type SCH struct {
 	HL7                       HL7Name `hl7:",name=SCH,type=s"`
 	PlacerAppointmentID       *EI     `hl7:"1,conditional,len=75,display=Placer Appointment ID"`
 	FillerAppointmentID       *EI     `hl7:"2,conditional,len=75,display=Filler Appointment ID"`
-	OccurrenceNumber          NM      `hl7:"3,conditional,len=5,display=Occurrence Number"`
+	OccurrenceNumber          NM      `hl7:"3,conditional,len=5,format=NM,display=Occurrence Number"`
 	PlacerGroupNumber         *EI     `hl7:"4,len=22,display=Placer Group Number"`
 	ScheduleID                *CE     `hl7:"5,len=250,display=Schedule ID"`
 	EventReason               CE      `hl7:"6,required,len=250,display=Event Reason"`
 	AppointmentReason         *CE     `hl7:"7,len=250,table=0276,display=Appointment Reason"`
 	AppointmentType           *CE     `hl7:"8,len=250,table=0277,display=Appointment Type"`
-	AppointmentDuration       NM      `hl7:"9,len=20,display=Appointment Duration"`
+	AppointmentDuration       NM      `hl7:"9,len=20,format=NM,display=Appointment Duration"`
 	AppointmentDurationUnits  *CE     `hl7:"10,len=250,display=Appointment Duration Units"`
 	AppointmentTimingQuantity []TQ    `hl7:"11,required,len=200,display=Appointment Timing Quantity"`
 	PlacerContactPerson       []XCN   `hl7:"12,len=250,display=Placer Contact Person"`
@@ -2876,7 +2946,7 @@ type TCC struct {
 	RerunDilutionFactorDefault            *SN     `hl7:"5,len=20,display=Rerun Dilution Factor Default"`
 	PreDilutionFactorDefault              *SN     `hl7:"6,len=20,display=Pre-Dilution Factor Default"`
 	EndogenousContentOfPreDilutionDiluent *SN     `hl7:"7,len=20,display=Endogenous Content of Pre-Dilution Diluent"`
-	InventoryLimitsWarningLevel           NM      `hl7:"8,len=10,display=Inventory Limits Warning Level"`
+	InventoryLimitsWarningLevel           NM      `hl7:"8,len=10,format=NM,display=Inventory Limits Warning Level"`
 	AutomaticRerunAllowed                 ID      `hl7:"9,len=1,table=0136,display=Automatic Rerun Allowed"`
 	AutomaticRepeatAllowed                ID      `hl7:"10,len=1,table=0136,display=Automatic Repeat Allowed"`
 	AutomaticReflexAllowed                ID      `hl7:"11,len=1,table=0136,display=Automatic Reflex Allowed"`
@@ -2911,7 +2981,7 @@ type TCD struct {
 // text.
 type TXA struct {
 	HL7                             HL7Name `hl7:",name=TXA,type=s"`
-	SetID                           SI      `hl7:"1,seq,required,len=4,display=Set ID- TXA"`
+	SetID                           SI      `hl7:"1,seq,required,len=4,format=SI,display=Set ID- TXA"`
 	DocumentType                    IS      `hl7:"2,required,len=30,table=0270,display=Document Type"`
 	DocumentContentPresentation     ID      `hl7:"3,conditional,len=2,table=0191,display=Document Content Presentation"`
 	ActivityDateTime                TS      `hl7:"4,len=26,format=YMDHMS,display=Activity Date/Time"`
@@ -2944,17 +3014,17 @@ type TXA struct {
 // Refer to a UB specification for additional information.
 type UB1 struct {
 	HL7                       HL7Name `hl7:",name=UB1,type=s"`
-	SetID                     SI      `hl7:"1,seq,len=4,display=Set ID - UB1"`
-	BloodDeductible43         NM      `hl7:"2,len=1,display=Blood Deductible (43)"`
-	BloodFurnishedPintsOf40   NM      `hl7:"3,len=2,display=Blood Furnished-Pints Of (40)"`
-	BloodReplacedPints41      NM      `hl7:"4,len=2,display=Blood Replaced-Pints (41)"`
-	BloodNotReplacedPints42   NM      `hl7:"5,len=2,display=Blood Not Replaced-Pints (42)"`
-	CoInsuranceDays25         NM      `hl7:"6,len=2,display=Co-Insurance Days (25)"`
+	SetID                     SI      `hl7:"1,seq,len=4,format=SI,display=Set ID - UB1"`
+	BloodDeductible43         NM      `hl7:"2,len=1,format=NM,display=Blood Deductible (43)"`
+	BloodFurnishedPintsOf40   NM      `hl7:"3,len=2,format=NM,display=Blood Furnished-Pints Of (40)"`
+	BloodReplacedPints41      NM      `hl7:"4,len=2,format=NM,display=Blood Replaced-Pints (41)"`
+	BloodNotReplacedPints42   NM      `hl7:"5,len=2,format=NM,display=Blood Not Replaced-Pints (42)"`
+	CoInsuranceDays25         NM      `hl7:"6,len=2,format=NM,display=Co-Insurance Days (25)"`
 	ConditionCode3539         []IS    `hl7:"7,max=5,len=14,table=0043,display=Condition Code (35-39)"`
-	CoveredDays23             NM      `hl7:"8,len=3,display=Covered Days (23)"`
-	NonCoveredDays24          NM      `hl7:"9,len=3,display=Non Covered Days (24)"`
+	CoveredDays23             NM      `hl7:"8,len=3,format=NM,display=Covered Days (23)"`
+	NonCoveredDays24          NM      `hl7:"9,len=3,format=NM,display=Non Covered Days (24)"`
 	ValueAmountCode4649       []UVC   `hl7:"10,max=8,len=12,display=Value Amount & Code (46-49)"`
-	NumberOfGraceDays90       NM      `hl7:"11,len=2,display=Number Of Grace Days (90)"`
+	NumberOfGraceDays90       NM      `hl7:"11,len=2,format=NM,display=Number Of Grace Days (90)"`
 	SpecialProgramIndicator44 *CE     `hl7:"12,len=250,table=0348,display=Special Program Indicator (44)"`
 	PSROURApprovalIndicator87 *CE     `hl7:"13,len=250,table=0349,display=PSRO/UR Approval Indicator (87)"`
 	PSROURApprovedStayFm88    DT      `hl7:"14,len=8,format=YMD,display=PSRO/UR Approved Stay-Fm (88)"`
@@ -2980,7 +3050,7 @@ type UB1 struct {
 // The Uniform Billing segments are specific to the US and may not be implemented in non-US systems.
 type UB2 struct {
 	HL7                       HL7Name `hl7:",name=UB2,type=s"`
-	SetID                     SI      `hl7:"1,seq,len=4,display=Set ID - UB2"`
+	SetID                     SI      `hl7:"1,seq,len=4,format=SI,display=Set ID - UB2"`
 	CoInsuranceDays9          ST      `hl7:"2,len=3,display=Co-Insurance Days (9)"`
 	ConditionCode2430         []IS    `hl7:"3,max=7,len=2,table=0043,display=Condition Code (24-30)"`
 	CoveredDays7              ST      `hl7:"4,len=3,display=Covered Days (7)"`
@@ -2996,7 +3066,7 @@ type UB2 struct {
 	UB92Locator56State        []ST    `hl7:"14,max=5,len=14,display=UB92 Locator 56 (state)"`
 	UB92Locator57National     ST      `hl7:"15,len=27,display=UB92 Locator 57 (national)"`
 	UB92Locator78State        []ST    `hl7:"16,max=2,len=2,display=UB92 Locator 78 (state)"`
-	SpecialVisitCount         NM      `hl7:"17,len=3,display=Special Visit Count"`
+	SpecialVisitCount         NM      `hl7:"17,len=3,format=NM,display=Special Visit Count"`
 }
 
 // Results/update Definition
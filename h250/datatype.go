@@ -1,4 +1,4 @@
-// Code generated by "hl7fetch -pkgdir h250 -root ./genjson -version 2.5"; DO NOT EDIT.
+// Code generated by "hl7fetch -pkgdir h250 -root ./genjson -version 2.5 -enum"; DO NOT EDIT.
 
 package h250
 
@@ -92,8 +92,8 @@ type CP struct {
 	HL7        HL7Name `hl7:",name=CP,len=543,type=d"`
 	Price      MO      `hl7:"1,required,len=20,display=The only required component; usually containing a decimal point. Note that each component of the MO data type (Section 2.A.41- 'MO - money') is a subcomponent here."`
 	PriceType  ID      `hl7:"2,len=2,table=0205,display=A coded value- data type ID. Refer to HL7 Table 0205 - Price type for valid values."`
-	FromValue  NM      `hl7:"3,len=16,display=Each is a NM data type; together they specify the 'range'. The range can be defined as either time or quantity. For example- the range can indicate that the first 10 minutes of the procedure has one price. Another repetition of the data type can use the range to specify that the following 10 to 60 minutes of the procedure is charged at another price per; a final repetition can specify that the final 60 to N minutes of the procedure at a third price."`
-	ToValue    NM      `hl7:"4,len=16,display=See <from value> above."`
+	FromValue  NM      `hl7:"3,len=16,format=NM,display=Each is a NM data type; together they specify the 'range'. The range can be defined as either time or quantity. For example- the range can indicate that the first 10 minutes of the procedure has one price. Another repetition of the data type can use the range to specify that the following 10 to 60 minutes of the procedure is charged at another price per; a final repetition can specify that the final 60 to N minutes of the procedure at a third price."`
+	ToValue    NM      `hl7:"4,len=16,format=NM,display=See <from value> above."`
 	RangeUnits *CE     `hl7:"5,len=483,display=A coded value- data type CE- defined by the standard table of units for either time or quantity (see for example- the tables in Section 7.1.4- 'Coding schemes'). This describes the units associated with the range- e.g.- seconds- minutes- hours- days- quantity (i.e.- count); it is required if <from value> and <to value> are present."`
 	RangeType  ID      `hl7:"6,len=1,table=0298,display=Range Type"`
 }
@@ -103,7 +103,7 @@ type CP struct {
 // Note: CQ cannot be legally expressed when embedded within another data type. Its use is constrained to a segment field.
 type CQ struct {
 	HL7      HL7Name `hl7:",name=CQ,len=500,type=d"`
-	Quantity NM      `hl7:"1,len=16,display=This component specifies the numeric quantity or amount of an entity."`
+	Quantity NM      `hl7:"1,len=16,format=NM,display=This component specifies the numeric quantity or amount of an entity."`
 	Units    *CE     `hl7:"2,len=483,display=This component species the units in which the quantity is expressed. Field-by-field- default units may be defined within the specifications. When the quantity is measured in the default units- the units need not be transmitted. If the quantity is recorded in units different from the default- the units must be transmitted."`
 }
 
@@ -147,9 +147,9 @@ type CX struct {
 // This data type specifies the detail information for the daily deductible.
 type DDI struct {
 	HL7            HL7Name `hl7:",name=DDI,len=25,type=d"`
-	DelayDays      NM      `hl7:"1,len=3,display=The number of days after which the daily deductible begins"`
+	DelayDays      NM      `hl7:"1,len=3,format=NM,display=The number of days after which the daily deductible begins"`
 	MonetaryAmount MO      `hl7:"2,required,len=16,display=The monetary amount of the deductible"`
-	NumberOfDays   NM      `hl7:"3,len=4,display=The number of days to apply the deductible. If this component is not populated- it means that the number of days is indefinite."`
+	NumberOfDays   NM      `hl7:"3,len=4,format=NM,display=The number of days to apply the deductible. If this component is not populated- it means that the number of days is indefinite."`
 }
 
 // Date and Institution Name
@@ -187,9 +187,9 @@ type DLN struct {
 type DLT struct {
 	HL7               HL7Name `hl7:",name=DLT,len=0,type=d"`
 	NormalRange       *NR     `hl7:"1,len=33,display=Specifies the normal interval of the reference data"`
-	NumericThreshold  NM      `hl7:"2,len=4,display=The numeric threshold of the change that is detected."`
+	NumericThreshold  NM      `hl7:"2,len=4,format=NM,display=The numeric threshold of the change that is detected."`
 	ChangeComputation ID      `hl7:"3,len=1,table=0523,display=Specifies if the change is computed as a percent change or as an absolute change. Refer to HL7 Table 0523 - Computation type for valid values."`
-	DaysRetained      NM      `hl7:"4,len=4,display=The length of time in days that the value is retained for computing delta checks."`
+	DaysRetained      NM      `hl7:"4,len=4,format=NM,display=The length of time in days that the value is retained for computing delta checks."`
 }
 
 // Date/Time Range
@@ -222,7 +222,7 @@ type DTM = time.Time
 type DTN struct {
 	HL7          HL7Name `hl7:",name=DTN,len=6,type=d"`
 	DayType      IS      `hl7:"1,required,len=2,table=0149,display=Specifies whether the days are denied- pending- or approved."`
-	NumberOfDays NM      `hl7:"2,required,len=3,display=Specifies the number of days for which the certification is valid."`
+	NumberOfDays NM      `hl7:"2,required,len=3,format=NM,display=Specifies the number of days for which the certification is valid."`
 }
 
 // Encapsulated Data
@@ -267,8 +267,8 @@ type EIP struct {
 type ELD struct {
 	HL7                  HL7Name `hl7:",name=ELD,len=493,type=d"`
 	SegmentID            ST      `hl7:"1,len=3,display=The segment containing the error in another message"`
-	SegmentSequence      NM      `hl7:"2,len=2,display=Specifies the specific occurrence if the segment specified in component 1 occurs more than once in the message."`
-	FieldPosition        NM      `hl7:"3,len=2,display=Ordinal position of the data field within the segment. For systems that do not use the HL7 Encoding Rules- the data item number may be used for the third component."`
+	SegmentSequence      NM      `hl7:"2,len=2,format=NM,display=Specifies the specific occurrence if the segment specified in component 1 occurs more than once in the message."`
+	FieldPosition        NM      `hl7:"3,len=2,format=NM,display=Ordinal position of the data field within the segment. For systems that do not use the HL7 Encoding Rules- the data item number may be used for the third component."`
 	CodeIdentifyingError *CE     `hl7:"4,len=483,table=0357,display=A code that describes the nature of the error. Refer to HL7 Table 0357 - Message error condition codes for valid values."`
 }
 
@@ -278,11 +278,11 @@ type ELD struct {
 type ERL struct {
 	HL7                HL7Name `hl7:",name=ERL,len=18,type=d"`
 	SegmentID          ST      `hl7:"1,required,len=3,display=Specifies the 3-letter name for the segment."`
-	SegmentSequence    NM      `hl7:"2,required,len=2,display=Identifies the segment occurrence within the message."`
-	FieldPosition      NM      `hl7:"3,len=2,display=Identifies the number of the field within the segment. The first field is assigned a number of 1. Field number should not be specified when referring to the entire segment."`
-	FieldRepetition    NM      `hl7:"4,len=2,display=Identifies the repetition number of the field. The first repetition is counted as 1. If a Field Position is specified- but Field Repetition is not- Field Repetition should be assumed to be 1. If Field Position is not specified- Field Repetition should not be specified."`
-	ComponentNumber    NM      `hl7:"5,len=2,display=Identifies the number of the component within the field. The first component is assigned a number of 1. Component number should not be specified when referring to the entire field."`
-	SubComponentNumber NM      `hl7:"6,len=2,display=Identifies the number of the sub-component within the component. The first sub-component is assigned a number of 1. Sub-component number should not be specified when referring to the entire component."`
+	SegmentSequence    NM      `hl7:"2,required,len=2,format=NM,display=Identifies the segment occurrence within the message."`
+	FieldPosition      NM      `hl7:"3,len=2,format=NM,display=Identifies the number of the field within the segment. The first field is assigned a number of 1. Field number should not be specified when referring to the entire segment."`
+	FieldRepetition    NM      `hl7:"4,len=2,format=NM,display=Identifies the repetition number of the field. The first repetition is counted as 1. If a Field Position is specified- but Field Repetition is not- Field Repetition should be assumed to be 1. If Field Position is not specified- Field Repetition should not be specified."`
+	ComponentNumber    NM      `hl7:"5,len=2,format=NM,display=Identifies the number of the component within the field. The first component is assigned a number of 1. Component number should not be specified when referring to the entire field."`
+	SubComponentNumber NM      `hl7:"6,len=2,format=NM,display=Identifies the number of the sub-component within the component. The first sub-component is assigned a number of 1. Sub-component number should not be specified when referring to the entire component."`
 }
 
 // Financial Class
@@ -409,7 +409,7 @@ type LA2 struct {
 // This data type specifies an amount of money and the denomination in which it is expressed.
 type MO struct {
 	HL7          HL7Name `hl7:",name=MO,len=20,type=d"`
-	Quantity     NM      `hl7:"1,len=16,display=The first component is a quantity."`
+	Quantity     NM      `hl7:"1,len=16,format=NM,display=The first component is a quantity."`
 	Denomination ID      `hl7:"2,len=3,table=ISO4217,display=The second component is the denomination in which the quantity is expressed. The values for the denomination component are those specified in ISO-4217. If the denomination is not specified- MSH-17-country code is used to determine the default."`
 }
 
@@ -429,7 +429,7 @@ type MOC struct {
 type MOP struct {
 	HL7                        HL7Name `hl7:",name=MOP,len=23,type=d"`
 	MoneyOrPercentageIndicator ID      `hl7:"1,required,len=2,table=0148,display=Specifies whether the amount is currency or a percentage."`
-	MoneyOrPercentageQuantity  NM      `hl7:"2,required,len=16,display=Specifies the currency or percentage quantity."`
+	MoneyOrPercentageQuantity  NM      `hl7:"2,required,len=16,format=NM,display=Specifies the currency or percentage quantity."`
 	CurrencyDenomination       ID      `hl7:"3,len=3,table=ISO4217,display=the denomination in which the quantity is expressed where the amount is currency. The values for the denomination component are those specified in ISO-4217. If the denomination is not specified- the context of the message or MSH-17-country code is used to determine the default."`
 }
 
@@ -464,16 +464,16 @@ func (d MSG) MessageStructureID() []string {
 // repetition delimiters).
 type NA struct {
 	HL7     HL7Name `hl7:",name=NA,len=65536,type=d"`
-	Value1  NM      `hl7:"1,required,len=16,display=Value1"`
-	Value2  NM      `hl7:"2,len=16,display=Value2"`
-	Value3  NM      `hl7:"3,len=16,display=Value3"`
-	Value4  NM      `hl7:"4,len=16,display=Value4"`
-	Value5  NM      `hl7:"5,display=Value5"`
-	Value6  NM      `hl7:"6,display=Value6"`
-	Value7  NM      `hl7:"7,display=Value7"`
-	Value8  NM      `hl7:"8,display=Value8"`
-	Value9  NM      `hl7:"9,display=Value9"`
-	Value10 NM      `hl7:"10,display=Value10"`
+	Value1  NM      `hl7:"1,required,len=16,format=NM,display=Value1"`
+	Value2  NM      `hl7:"2,len=16,format=NM,display=Value2"`
+	Value3  NM      `hl7:"3,len=16,format=NM,display=Value3"`
+	Value4  NM      `hl7:"4,len=16,format=NM,display=Value4"`
+	Value5  NM      `hl7:"5,format=NM,display=Value5"`
+	Value6  NM      `hl7:"6,format=NM,display=Value6"`
+	Value7  NM      `hl7:"7,format=NM,display=Value7"`
+	Value8  NM      `hl7:"8,format=NM,display=Value8"`
+	Value9  NM      `hl7:"9,format=NM,display=Value9"`
+	Value10 NM      `hl7:"10,format=NM,display=Value10"`
 }
 
 // Name with Date and Location
@@ -509,8 +509,8 @@ type NM = string
 // in the usage note for the field.
 type NR struct {
 	HL7       HL7Name `hl7:",name=NR,len=33,type=d"`
-	LowValue  NM      `hl7:"1,len=16,display=The number specifying the lower limit or boundary of the range."`
-	HighValue NM      `hl7:"2,len=16,display=The number specifying the high limit or boundary of the range."`
+	LowValue  NM      `hl7:"1,len=16,format=NM,display=The number specifying the lower limit or boundary of the range."`
+	HighValue NM      `hl7:"2,len=16,format=NM,display=The number specifying the high limit or boundary of the range."`
 }
 
 // Occurrence Code and Date
@@ -534,7 +534,7 @@ type OSD struct {
 	FillerOrderNumberEntityIdentifier ST      `hl7:"4,required,len=15,display=Contains the first component of the filler order number- entity identifier."`
 	FillerOrderNumberNamespaceID      IS      `hl7:"5,len=6,table=0363,display=Contains the second component of the filler order number- namespace ID. Refer to user-defined table 0363 - Assigning Authority for suggested values"`
 	SequenceConditionValue            ST      `hl7:"6,len=12,display=Defines the relationship between the start/end of the related predecessor or successor order and the current order from ORC-2- 3 or 4."`
-	MaximumNumberOfRepeats            NM      `hl7:"7,len=3,display=The maximum number of repeats to be used only on cyclic groups. The total number of repeats is constrained by the end date/time of the last repeat or the end date/time of the parent- whichever is first."`
+	MaximumNumberOfRepeats            NM      `hl7:"7,len=3,format=NM,display=The maximum number of repeats to be used only on cyclic groups. The total number of repeats is constrained by the end date/time of the last repeat or the end date/time of the parent- whichever is first."`
 	PlacerOrderNumberUniversalID      ST      `hl7:"8,required,len=15,display=Contains the next to the last component of the placer order number- universal ID."`
 	PlacerOrderNumberUniversalIDType  ID      `hl7:"9,len=6,table=0301,display=Contains the last component of the placer order number. Refer to HL7 table 0301 - Universal ID Type for valid values."`
 	FillerOrderNumberUniversalID      ST      `hl7:"10,required,len=15,display=Contains the next to the last component of the filler order number- universal ID."`
@@ -655,7 +655,7 @@ type PTA struct {
 	HL7                       HL7Name `hl7:",name=PTA,len=56,type=d"`
 	PolicyType                IS      `hl7:"1,required,len=5,table=0147,display=Specifies the policy type."`
 	AmountClass               IS      `hl7:"2,len=9,table=0193,display=Specifies the amount quantity class."`
-	MoneyOrPercentageQuantity NM      `hl7:"3,len=16,display=Specifies the currency or percentage quantity.  Retained for backward compatibility only as of v 2.5. Refer to PTA.4 instead. "`
+	MoneyOrPercentageQuantity NM      `hl7:"3,len=16,format=NM,display=Specifies the currency or percentage quantity.  Retained for backward compatibility only as of v 2.5. Refer to PTA.4 instead. "`
 	MoneyOrPercentage         MOP     `hl7:"4,required,len=23,display=specifies an amount that may be either currency or a percentage."`
 }
 
@@ -687,7 +687,7 @@ type RCD struct {
 	HL7                HL7Name `hl7:",name=RCD,len=19,type=d"`
 	SegmentFieldName   ST      `hl7:"1,len=12,display=The HL7 segment field name- which identifies the field occupying the column. Refer to Section 2.A.59.1- 'Segment Field Name (ST)-' for segment field name definition conventions."`
 	Hl7DataType        ID      `hl7:"2,len=3,table=0440,display=The two or three character HL7 data type. Refer to HL7 Table 0440 - Data Types in section 2.16 for valid values."`
-	MaximumColumnWidth NM      `hl7:"3,len=2,display=The maximum width of the column- as dictated by the responding system. This may vary from the HL7-defined maximum field length."`
+	MaximumColumnWidth NM      `hl7:"3,len=2,format=NM,display=The maximum width of the column- as dictated by the responding system. This may vary from the HL7-defined maximum field length."`
 }
 
 // Reference Range
@@ -720,7 +720,7 @@ type RMC struct {
 	HL7               HL7Name `hl7:",name=RMC,len=82,type=d"`
 	RoomType          IS      `hl7:"1,required,len=20,table=0145,display=Specifies the room type."`
 	AmountType        IS      `hl7:"2,len=20,table=0146,display=Specifies amount quantity type"`
-	CoverageAmount    NM      `hl7:"3,len=16,display=Specifies amount covered by the insurance as a currency or percentage quantity.  Retained for backward compatibility only as of v 2.5. Refer to Money or Percentage. "`
+	CoverageAmount    NM      `hl7:"3,len=16,format=NM,display=Specifies amount covered by the insurance as a currency or percentage quantity.  Retained for backward compatibility only as of v 2.5. Refer to Money or Percentage. "`
 	MoneyOrPercentage MOP     `hl7:"4,required,len=23,display=specifies an amount that may be either currency or a percentage."`
 }
 
@@ -739,13 +739,13 @@ type RPT struct {
 	HL7                        HL7Name `hl7:",name=RPT,len=984,type=d"`
 	RepeatPatternCode          CWE     `hl7:"1,required,len=705,table=0335,display=A code representing the repeat pattern defined by the other components of this data type. Refer to User-defined Table 335 - Repeat Pattern for suggested values."`
 	CalendarAlignment          ID      `hl7:"2,len=2,table=0527,display=Specifies an alignment of the repetition to a calendar (e.g.- to distinguish every 30 days from the 5th of every month). Refer to HL7 Table 0527 - Calendar Alignment for valid values."`
-	PhaseRangeBeginValue       NM      `hl7:"3,len=10,display=Used for Calendar aligned repeat patterns to determine the amount of time from the beginning of particular RPT-2 (Calendar Alignment) to the beginning of the phase. If Calendar Alignment is DW (days of week)- then this would be the offset from the beginning of the week."`
-	PhaseRangeEndValue         NM      `hl7:"4,len=10,display=Used for Calendar aligned repeat patterns to determine the amount of time from the beginning of particular RPT-2 (Calendar Alignment) to the end of the phase."`
-	PeriodQuantity             NM      `hl7:"5,len=10,display=A time duration specifying the frequency at which the periodic interval repeats. RPT-6 (Period Units) defines the units of time for this component."`
+	PhaseRangeBeginValue       NM      `hl7:"3,len=10,format=NM,display=Used for Calendar aligned repeat patterns to determine the amount of time from the beginning of particular RPT-2 (Calendar Alignment) to the beginning of the phase. If Calendar Alignment is DW (days of week)- then this would be the offset from the beginning of the week."`
+	PhaseRangeEndValue         NM      `hl7:"4,len=10,format=NM,display=Used for Calendar aligned repeat patterns to determine the amount of time from the beginning of particular RPT-2 (Calendar Alignment) to the end of the phase."`
+	PeriodQuantity             NM      `hl7:"5,len=10,format=NM,display=A time duration specifying the frequency at which the periodic interval repeats. RPT-6 (Period Units) defines the units of time for this component."`
 	PeriodUnits                IS      `hl7:"6,conditional,len=10,display=Defines the units used for RPT-5 (Period Quantity). Constrained to units of time. The codes for unit of measure are specified in the Unified Code for Units of Measure (UCUM) [http://aurora.rg.iupui.edu/UCUM]."`
 	InstitutionSpecifiedTime   ID      `hl7:"7,len=1,table=0136,display=A code that indicates whether the exact timing is up to the party executing the schedule (e.g.- to distinguish every 8 hours from 3 times a day.) Refer to HL7 Table 0136 - Yes/No Indicator for valid values."`
 	Event                      ID      `hl7:"8,len=6,table=0528,display=A code for a common (periodical) activity of daily living. Refer to HL7 Table 0528- Event-Related Period for valid values."`
-	EventOffsetQuantity        NM      `hl7:"9,len=10,display=An interval that marks the offsets for the beginning- width and end of the event-related periodic interval measured from the time each such event actually occurred. A positive numeric value indicates the amount of time after the event in RPT-8. A negative numeric value indicates the amount of time prior to the event in RPT-8. RPT-10 (Event Offset Units) defines the units of time for this component."`
+	EventOffsetQuantity        NM      `hl7:"9,len=10,format=NM,display=An interval that marks the offsets for the beginning- width and end of the event-related periodic interval measured from the time each such event actually occurred. A positive numeric value indicates the amount of time after the event in RPT-8. A negative numeric value indicates the amount of time prior to the event in RPT-8. RPT-10 (Event Offset Units) defines the units of time for this component."`
 	EventOffsetUnits           IS      `hl7:"10,conditional,len=10,display=Defines the units used for RPT-9 (Event Offset Quantity). Constrained to units of time. The codes for unit of measure are specified in the Unified Code for Units of Measure (UCUM) [http://aurora.rg.iupui.edu/UCUM]."`
 	GeneralTimingSpecification GTS     `hl7:"11,len=200,display=The General Timing Specification as defined by the Version 3 Data Types document."`
 }
@@ -786,9 +786,9 @@ type SI = string
 type SN struct {
 	HL7             HL7Name `hl7:",name=SN,len=36,type=d"`
 	Comparator      ST      `hl7:"1,len=2,display=Defined as greater than- less than- greater than or equal- less than or equal- equal- and not equal- respectively (: '>' or '<' or '>:' or '<:' or ':' or '<>'"`
-	Num1            NM      `hl7:"2,len=15,display=A number."`
+	Num1            NM      `hl7:"2,len=15,format=NM,display=A number."`
 	SeparatorSuffix ST      `hl7:"3,len=1,display='-' or '+' or '/' or '.' or ':'"`
-	Num2            NM      `hl7:"4,len=15,display=A number or null depending on the measurement."`
+	Num2            NM      `hl7:"4,len=15,format=NM,display=A number or null depending on the measurement."`
 }
 
 // Specialty Description
@@ -857,7 +857,7 @@ type TQ struct {
 	Conjunction        ID      `hl7:"9,len=1,table=0472,display=This non-null component indicates that a second timing specification is to follow using the repeat delimiter. Refer to HL7 table 0472 - TQ Conjunction ID for valid values"`
 	OrderSequencing    *OSD    `hl7:"10,len=110,display=Order Sequencing"`
 	OccurrenceDuration *CE     `hl7:"11,len=483,display=This component contains the duration for a single performance of a service- e.g.- whirlpool twenty minutes three times per day for three days. It is optional within TQ and does not repeat."`
-	TotalOccurrences   NM      `hl7:"12,len=4,display=This component contains the total number of occurrences of a service that should result from this order. It is optional within TQ and does not repeat. If both the end date/time and the total occurrences are valued and the occurrences would extend beyond the end date/time- then the end date/time takes precedence. Otherwise the number of occurrences takes precedence."`
+	TotalOccurrences   NM      `hl7:"12,len=4,format=NM,display=This component contains the total number of occurrences of a service that should result from this order. It is optional within TQ and does not repeat. If both the end date/time and the total occurrences are valued and the occurrences would extend beyond the end date/time- then the end date/time takes precedence. Otherwise the number of occurrences takes precedence."`
 }
 
 // Time Stamp
@@ -974,8 +974,8 @@ type XON struct {
 	HL7                      HL7Name `hl7:",name=XON,len=567,type=d"`
 	OrganizationName         ST      `hl7:"1,len=50,display=The name of the specified organization."`
 	OrganizationNameTypeCode IS      `hl7:"2,len=20,table=0204,display=A code that represents the type of name i.e.- legal name- display name. Refer to User-defined Table 0204 - Organizational Name Type for suggested values."`
-	IDNumber                 NM      `hl7:"3,len=4,display=This component has been retained for backward compatibility only as of v 2.5. It is recommended to use component 10 Organization identifier that accommodates alphanumeric identifiers."`
-	CheckDigit               NM      `hl7:"4,len=1,display=The check digit in this data type is not an add-on produced by the message processor. It is the check digit that is part of the identifying number used in the sending application. If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null."`
+	IDNumber                 NM      `hl7:"3,len=4,format=NM,display=This component has been retained for backward compatibility only as of v 2.5. It is recommended to use component 10 Organization identifier that accommodates alphanumeric identifiers."`
+	CheckDigit               NM      `hl7:"4,len=1,format=NM,display=The check digit in this data type is not an add-on produced by the message processor. It is the check digit that is part of the identifying number used in the sending application. If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null."`
 	CheckDigitScheme         ID      `hl7:"5,len=3,table=0061,display=Contains the code identifying the check digit scheme employed."`
 	AssigningAuthority       *HD     `hl7:"6,len=227,table=0363,display=The assigning authority is a unique identifier of the system (or organization or agency or department) that creates the data. Assigning authorities are unique across a given HL7 implementation. Refer to User-defined Table 0363 - Assigning Authority for suggested values."`
 	IdentifierTypeCode       ID      `hl7:"7,len=5,table=0203,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the 'Assigning authority' component. Refer to HL7 Table 0203 - Identifier type for suggested values."`
@@ -1010,10 +1010,10 @@ type XTN struct {
 	TelecommunicationUseCode       ID      `hl7:"2,len=3,table=0201,display=A code that represents a specific use of a telecommunicationnumber. Refer to HL7 Table 0201 - Telecommunication use code for valid values."`
 	TelecommunicationEquipmentType ID      `hl7:"3,len=8,table=0202,display=A code that represents the type of telecommunicationequipment. Refer to HL7 Table 0202 - Telecommunication equipment type for valid values."`
 	EmailAddress                   ST      `hl7:"4,len=199,display=Email Address"`
-	CountryCode                    NM      `hl7:"5,len=3,display=Country Code"`
-	AreaCityCode                   NM      `hl7:"6,len=5,display=Area/City Code"`
-	LocalNumber                    NM      `hl7:"7,len=9,display=Local Number"`
-	Extension                      NM      `hl7:"8,len=5,display=Contains comments with respect to the telephone number."`
+	CountryCode                    NM      `hl7:"5,len=3,format=NM,display=Country Code"`
+	AreaCityCode                   NM      `hl7:"6,len=5,format=NM,display=Area/City Code"`
+	LocalNumber                    NM      `hl7:"7,len=9,format=NM,display=Local Number"`
+	Extension                      NM      `hl7:"8,len=5,format=NM,display=Contains comments with respect to the telephone number."`
 	AnyText                        ST      `hl7:"9,len=199,display=The characters established within a companys internal telephone system network used as a prefix to the Extension component for internal dialing. Note that the use of Extension Prefix requires that the Extension component be valued and that digits- as well as special characters (e.g.- *- #) may be used."`
 	ExtensionPrefix                ST      `hl7:"10,len=4,display=The characters established within a companys internal telephone system used in place of the (external) telephone number to facilitate calling because its length is shorter than that of the telephone number. Note that digits- as well as special characters (e.g.- *- #) may be used."`
 	SpeedDialCode                  ST      `hl7:"11,len=6,display=An expression of the telephone number as an unparsible string."`
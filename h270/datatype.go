@@ -1,4 +1,4 @@
-// Code generated by "hl7fetch -pkgdir h270 -root ./genjson -version 2.7"; DO NOT EDIT.
+// Code generated by "hl7fetch -pkgdir h270 -root ./genjson -version 2.7 -enum"; DO NOT EDIT.
 
 package h270
 
@@ -132,8 +132,8 @@ type CP struct {
 	HL7        HL7Name `hl7:",name=CP,len=0,type=d"`
 	Price      MO      `hl7:"1,required,display=The only required component; usually containing a decimal point. Note that each component of the MO data type (Section 2.A.41- 'MO - money') is a subcomponent here."`
 	PriceType  ID      `hl7:"2,len=2,table=0205,display=A coded value- data type ID.  Refer to HL7 Table 0205 – Price Type for valid values."`
-	FromValue  NM      `hl7:"3,display=The number specifying the lower limit or boundary of the range. This component- together with the CP.4 component- specifies the 'price range'. The range can be defined as either time or quantity. For example- the range can indicate that the first 10 minutes of the procedure has one price. Another repetition of the data type can use the range to specify that the following 10 to 60 minutes of the procedure is charged at another price per; a final repetition can specify that the final 60 to N minutes of the procedure at a third price.  Note that- if the CP.2 Price Type component is TP- both CP.3 and CP.4 may be null."`
-	ToValue    NM      `hl7:"4,display=The number specifying the high limit or boundary of the range."`
+	FromValue  NM      `hl7:"3,format=NM,display=The number specifying the lower limit or boundary of the range. This component- together with the CP.4 component- specifies the 'price range'. The range can be defined as either time or quantity. For example- the range can indicate that the first 10 minutes of the procedure has one price. Another repetition of the data type can use the range to specify that the following 10 to 60 minutes of the procedure is charged at another price per; a final repetition can specify that the final 60 to N minutes of the procedure at a third price.  Note that- if the CP.2 Price Type component is TP- both CP.3 and CP.4 may be null."`
+	ToValue    NM      `hl7:"4,format=NM,display=The number specifying the high limit or boundary of the range."`
 	RangeUnits *CWE    `hl7:"5,conditional,display=This component describes the units associated with the range- e.g.- seconds- minutes- hours- days- quantity (i.e.- count).  As of v2.7 the Externally-defined Unified Code for Units of Measure (UCUM) case sensitive code is the required code for units of measure.  Refer to the externally-defined table 'Unified Code for Units of Measure' (UCUM) [http://aurora.rg.iupui.edu/UCUM] for valid values. Local codes may be transmitted in addition to UCUM codes.   This component is required if CP.3 From Value and/or CP.4 To Value are present."`
 	RangeType  ID      `hl7:"6,len=1,table=0298,display=Refer to HL7 Table 0298 - CP Range Type for valid values."`
 }
@@ -150,7 +150,7 @@ type CP struct {
 // |150^lb&&ANSI+|
 type CQ struct {
 	HL7      HL7Name `hl7:",name=CQ,len=0,type=d"`
-	Quantity NM      `hl7:"1,display=This component specifies the numeric quantity or amount of an entity."`
+	Quantity NM      `hl7:"1,format=NM,display=This component specifies the numeric quantity or amount of an entity."`
 	Units    *CWE    `hl7:"2,display=This component species the units in which the quantity is expressed.  As of v2.7 the externally-defined Unified Code for Units of Measure (UCUM) case sensitive code is the required code for units of measure. Refer to the external table 'Unified Code for Units of Measure' (UCUM) [http://aurora.rg.iupui.edu/UCUM] for valid values. Local codes may be transmitted in addition to UCUM codes."`
 }
 
@@ -231,9 +231,9 @@ type CX struct {
 // Note: Replaces the CM data type used in section 6.5.7.30 IN2-30, as of v 2.5.
 type DDI struct {
 	HL7            HL7Name `hl7:",name=DDI,len=0,type=d"`
-	DelayDays      NM      `hl7:"1,display=The number of days after which the daily deductible begins."`
+	DelayDays      NM      `hl7:"1,format=NM,display=The number of days after which the daily deductible begins."`
 	MonetaryAmount MO      `hl7:"2,required,display=The monetary amount of the deductible."`
-	NumberOfDays   NM      `hl7:"3,display=The number of days to apply the deductible. If this component is not populated- it means that the number of days is indefinite."`
+	NumberOfDays   NM      `hl7:"3,format=NM,display=The number of days to apply the deductible. If this component is not populated- it means that the number of days is indefinite."`
 }
 
 // Date And Institution Name
@@ -277,9 +277,9 @@ type DLN struct {
 type DLT struct {
 	HL7               HL7Name `hl7:",name=DLT,len=0,type=d"`
 	NormalRange       *NR     `hl7:"1,display=Specifies the normal interval of the reference data."`
-	NumericThreshold  NM      `hl7:"2,display=The numeric threshold of the change that is detected.   For example the threshold may be set to 10. "`
+	NumericThreshold  NM      `hl7:"2,format=NM,display=The numeric threshold of the change that is detected.   For example the threshold may be set to 10. "`
 	ChangeComputation ID      `hl7:"3,table=0523,display=Specifies if the change is computed as a percent change or as an absolute change. Refer to HL7 Table 0523 - Computation Type for valid values."`
-	DaysRetained      NM      `hl7:"4,display=The length of time in days that the value is retained for computing delta checks."`
+	DaysRetained      NM      `hl7:"4,format=NM,display=The length of time in days that the value is retained for computing delta checks."`
 }
 
 // Date/time Range
@@ -334,7 +334,7 @@ type DTM = time.Time
 type DTN struct {
 	HL7          HL7Name `hl7:",name=DTN,len=0,type=d"`
 	DayType      CWE     `hl7:"1,required,table=0149,display=Specifies whether the days are denied- pending- or approved.  Refer to User-defined Table 0149 - Day Type for suggested values."`
-	NumberOfDays NM      `hl7:"2,required,display=Specifies the number of days for which the certification is valid."`
+	NumberOfDays NM      `hl7:"2,required,format=NM,display=Specifies the number of days for which the certification is valid."`
 }
 
 // Encapsulated Data
@@ -391,11 +391,11 @@ type EIP struct {
 type ERL struct {
 	HL7                HL7Name `hl7:",name=ERL,len=0,type=d"`
 	SegmentID          ST      `hl7:"1,required,len=3,display=Specifies the 3-letter name for the segment."`
-	SegmentSequence    NM      `hl7:"2,required,display=Identifies the segment occurrence within the message."`
-	FieldPosition      NM      `hl7:"3,display=Identifies the number of the field within the segment. The first field is assigned a number of 1. Field number should not be specified when referring to the entire segment."`
-	FieldRepetition    NM      `hl7:"4,display=Identifies the repetition number of the field. The first repetition is counted as 1. If a Field Position is specified- but Field Repetition is not- Field Repetition should be assumed to be 1. If Field Position is not specified- Field Repetition should not be specified."`
-	ComponentNumber    NM      `hl7:"5,display=Identifies the number of the component within the field. The first component is assigned a number of 1. Component number should not be specified when referring to the entire field."`
-	SubComponentNumber NM      `hl7:"6,display=Identifies the number of the sub-component within the component. The first sub-component is assigned a number of 1. Sub-component number should not be specified when referring to the entire component."`
+	SegmentSequence    NM      `hl7:"2,required,format=NM,display=Identifies the segment occurrence within the message."`
+	FieldPosition      NM      `hl7:"3,format=NM,display=Identifies the number of the field within the segment. The first field is assigned a number of 1. Field number should not be specified when referring to the entire segment."`
+	FieldRepetition    NM      `hl7:"4,format=NM,display=Identifies the repetition number of the field. The first repetition is counted as 1. If a Field Position is specified- but Field Repetition is not- Field Repetition should be assumed to be 1. If Field Position is not specified- Field Repetition should not be specified."`
+	ComponentNumber    NM      `hl7:"5,format=NM,display=Identifies the number of the component within the field. The first component is assigned a number of 1. Component number should not be specified when referring to the entire field."`
+	SubComponentNumber NM      `hl7:"6,format=NM,display=Identifies the number of the sub-component within the component. The first sub-component is assigned a number of 1. Sub-component number should not be specified when referring to the entire component."`
 }
 
 // Financial Class
@@ -622,7 +622,7 @@ type LA2 struct {
 // This data type specifies an amount of money and the denomination in which it is expressed.
 type MO struct {
 	HL7          HL7Name `hl7:",name=MO,len=0,type=d"`
-	Quantity     NM      `hl7:"1,display=The first component is a quantity."`
+	Quantity     NM      `hl7:"1,format=NM,display=The first component is a quantity."`
 	Denomination ID      `hl7:"2,len=3,table=0913,display=The second component is the denomination in which the quantity is expressed. The values for the denomination component are the three-character codes specified in ISO-4217. See Externally-defined Table 0913 – Monetary Denomination Code.  If the denomination is not specified- 'MSH-17-country code'- in Chapter 2- section 2.14.9.17- is used to determine the default.   Example- where USD is the ISO 4217 code for the U.S. American dollar. |99.50^USD|"`
 }
 
@@ -649,7 +649,7 @@ type MOC struct {
 type MOP struct {
 	HL7                        HL7Name `hl7:",name=MOP,len=0,type=d"`
 	MoneyOrPercentageIndicator ID      `hl7:"1,required,table=0148,display=Specifies whether the amount is currency or a percentage.  Refer to HL7 Table 0148 – Money or Percentage Indicator for valid values."`
-	MoneyOrPercentageQuantity  NM      `hl7:"2,required,display=Specifies the currency or percentage quantity."`
+	MoneyOrPercentageQuantity  NM      `hl7:"2,required,format=NM,display=Specifies the currency or percentage quantity."`
 	MonetaryDenomination       ID      `hl7:"3,len=3,table=0913,display=the denomination in which the quantity is expressed where the amount is currency. The values for the denomination component are the three-character codes specified in ISO-4217. See Externally-defined Table 0913 – Monetary Denomination Code.  If the denomination is not specified- the context of the message or MSH-17 Country Code- in Chapter 2- section 2.14.9.17- is used to determine the default."`
 }
 
@@ -696,10 +696,10 @@ func (d MSG) MessageStructureID() []string {
 // |^2^3^4~5^^^8~9^10~~17^18^19^20|
 type NA struct {
 	HL7    HL7Name `hl7:",name=NA,len=0,type=d"`
-	Value1 NM      `hl7:"1,display=Value1"`
-	Value2 NM      `hl7:"2,display=Value2"`
-	Value3 NM      `hl7:"3,display=Value3"`
-	Value4 NM      `hl7:"4,display=Value4"`
+	Value1 NM      `hl7:"1,format=NM,display=Value1"`
+	Value2 NM      `hl7:"2,format=NM,display=Value2"`
+	Value3 NM      `hl7:"3,format=NM,display=Value3"`
+	Value4 NM      `hl7:"4,format=NM,display=Value4"`
 }
 
 // Name With Date And Location
@@ -763,8 +763,8 @@ type NM = string
 // v 2.5.
 type NR struct {
 	HL7       HL7Name `hl7:",name=NR,len=0,type=d"`
-	LowValue  NM      `hl7:"1,display=The number specifying the lower limit or boundary of the range."`
-	HighValue NM      `hl7:"2,display=The number specifying the high limit or boundary of the range."`
+	LowValue  NM      `hl7:"1,format=NM,display=The number specifying the lower limit or boundary of the range."`
+	HighValue NM      `hl7:"2,format=NM,display=The number specifying the high limit or boundary of the range."`
 }
 
 // Occurrence Code And Date
@@ -965,7 +965,7 @@ type RCD struct {
 	HL7                HL7Name `hl7:",name=RCD,len=0,type=d"`
 	SegmentFieldName   ST      `hl7:"1,len=12,display=This component contains the segment field name.  Naming conventions: Segment field names are designated by the “@” symbol concatenated with the HL7 segment ID followed by the sequence number for the field separated by a period. See sections 2.5.2- 'Segments and segment groups' and 2.5.3.1- 'Position (sequence within the segment)' for a definition of segment ID and sequence number. If the field is divided into components- the designation may be suffixed with '.nn'- to identify a particular component (a suffix of “.3” indicates the third component of the field); otherwise- the whole field is assumed. If the field is further divided into subcomponents- the designation is suffixed with “.nn.mm'- which identifies the component and subcomponent requested by relative position.  Site-specific segment field names may be used. In this case- the site-specific segment ID (if the field is not being added to an existing HL7 segment) and the sequence number must be defined so that they do not conflict with existing HL7 segment IDs and field sequence numbers. Values for this field are defined in the function-specific chapters of this specification.  Note: If the “@” is being used as one of the delimiter characters defined in MSH-2-encoding characters- it must be “escaped.”  See Section 2.7.1- 'Formatting Codes'."`
 	Hl7DataType        ID      `hl7:"2,len=3,table=0440,display=The two or three character HL7 data type. Refer to HL7 Table 0440 – Data Types for valid values."`
-	MaximumColumnWidth NM      `hl7:"3,len=5,display=The maximum width of the column- as dictated by the responding system. This may vary from the HL7-defined maximum field length."`
+	MaximumColumnWidth NM      `hl7:"3,len=5,format=NM,display=The maximum width of the column- as dictated by the responding system. This may vary from the HL7-defined maximum field length."`
 }
 
 // Reference Range
@@ -1055,13 +1055,13 @@ type RPT struct {
 	HL7                        HL7Name `hl7:",name=RPT,len=0,type=d"`
 	RepeatPatternCode          CWE     `hl7:"1,required,table=0335,display=A code representing the repeat pattern defined by the other components of this data type. Refer to User-defined Table 0335 – Repeat Pattern for suggested values."`
 	CalendarAlignment          ID      `hl7:"2,len=2,table=0527,display=Specifies an alignment of the repetition to a calendar (e.g.- to distinguish every 30 days from “the 5th of every month”). Refer to HL7 Table 0527 - Calendar Alignment for valid values."`
-	PhaseRangeBeginValue       NM      `hl7:"3,display=Used for Calendar aligned repeat patterns to determine the amount of time from the beginning of particular RPT.2 - Calendar Alignment to the beginning of the phase. If Calendar Alignment is DW (days of week)- then this would be the offset from the beginning of the week.   If Phase Range Begin Value is populated- but Phase Range End Value is not populated- then this component defines when the period (RPT.5- 6) begins.  If both Phase Range Begin Value and Phase Range End Value are populated- then this component defines the earliest point in time at which the period (RPT.5- 6) will begin.  The units of measure for this component are derived from the Calendar Alignment value in RPT.2. See HL7 Table 0527 - Calendar Alignment for the units of measure associated with a particular calendar alignment."`
-	PhaseRangeEndValue         NM      `hl7:"4,display=Used for Calendar aligned repeat patterns to determine the amount of time from the beginning of particular RPT.2 - Calendar Alignment to the end of the phase.   If Phase Range End Value is populated- but Phase Range Begin Value is not populated- then this component defines when the timing period (RPT.5- 6) begins.  If both Phase Range Begin Value and Phase Range End Value are populated- then this component defines the latest point in time at which the period (RPT.5- 6) will begin.  The units of measure for this component are derived from the Calendar Alignment value in RPT.2. See HL7 Table 0527 - Calendar Alignment for the units of measure associated with a particular calendar alignment."`
-	PeriodQuantity             NM      `hl7:"5,display=A time duration specifying the frequency at which the periodic interval repeats. RPT.6 - Period Units defines the units of time for this component."`
+	PhaseRangeBeginValue       NM      `hl7:"3,format=NM,display=Used for Calendar aligned repeat patterns to determine the amount of time from the beginning of particular RPT.2 - Calendar Alignment to the beginning of the phase. If Calendar Alignment is DW (days of week)- then this would be the offset from the beginning of the week.   If Phase Range Begin Value is populated- but Phase Range End Value is not populated- then this component defines when the period (RPT.5- 6) begins.  If both Phase Range Begin Value and Phase Range End Value are populated- then this component defines the earliest point in time at which the period (RPT.5- 6) will begin.  The units of measure for this component are derived from the Calendar Alignment value in RPT.2. See HL7 Table 0527 - Calendar Alignment for the units of measure associated with a particular calendar alignment."`
+	PhaseRangeEndValue         NM      `hl7:"4,format=NM,display=Used for Calendar aligned repeat patterns to determine the amount of time from the beginning of particular RPT.2 - Calendar Alignment to the end of the phase.   If Phase Range End Value is populated- but Phase Range Begin Value is not populated- then this component defines when the timing period (RPT.5- 6) begins.  If both Phase Range Begin Value and Phase Range End Value are populated- then this component defines the latest point in time at which the period (RPT.5- 6) will begin.  The units of measure for this component are derived from the Calendar Alignment value in RPT.2. See HL7 Table 0527 - Calendar Alignment for the units of measure associated with a particular calendar alignment."`
+	PeriodQuantity             NM      `hl7:"5,format=NM,display=A time duration specifying the frequency at which the periodic interval repeats. RPT.6 - Period Units defines the units of time for this component."`
 	PeriodUnits                *CWE    `hl7:"6,conditional,display=Defines the units used for RPT.5 - Period Quantity. Constrained to units of time.  Best practice is to use UCUM. Refer to External table the Unified Code for Units of Measure (UCUM) [http://aurora.rg.iupui.edu/UCUM] for valid values.   Condition Rule: This component is required if RPT.5 - Period Quantity is populated."`
 	InstitutionSpecifiedTime   ID      `hl7:"7,len=1,table=0136,display=A code that indicates whether the exact timing is up to the party executing the schedule (e.g.- to distinguish “every 8 hours” from “3 times a day”).  Refer to HL7 Table 0136 - Yes/No Indicator for valid values.   - Y - exact timing up to party executing schedule.   - N - exact timing as specified."`
 	Event                      ID      `hl7:"8,len=3,table=0528,display=A code for a common (periodical) activity of daily living. Refer to HL7 Table 0528 - Event-Related Period for valid values."`
-	EventOffsetQuantity        NM      `hl7:"9,display=An interval that marks the offsets for the beginning- width and end of the event-related periodic interval measured from the time each such event actually occurred. A positive numeric value indicates the amount of time after the event in RPT.8. A negative numeric value indicates the amount of time prior to the event in RPT.8. RPT.10 - Event Offset Units defines the units of time for this component."`
+	EventOffsetQuantity        NM      `hl7:"9,format=NM,display=An interval that marks the offsets for the beginning- width and end of the event-related periodic interval measured from the time each such event actually occurred. A positive numeric value indicates the amount of time after the event in RPT.8. A negative numeric value indicates the amount of time prior to the event in RPT.8. RPT.10 - Event Offset Units defines the units of time for this component."`
 	EventOffsetUnits           *CWE    `hl7:"10,conditional,display=Defines the units used for RPT-9 (Event Offset Quantity). Constrained to units of time. The codes for unit of measure are specified in the Unified Code for Units of Measure (UCUM) [http://aurora.rg.iupui.edu/UCUM].  Condition Rule: This component is required if RPT.9 - Event Offset Quantity is populated."`
 	GeneralTimingSpecification GTS     `hl7:"11,display=The General Timing Specification as defined by the Version 3 Data Types document."`
 }
@@ -1110,9 +1110,9 @@ type SI = string
 type SN struct {
 	HL7             HL7Name `hl7:",name=SN,len=0,type=d"`
 	Comparator      ST      `hl7:"1,len=2,display=Defined as greater than- less than- greater than or equal- less than or equal- equal- and not equal- respectively (:  '>' or '<' or '>:' or '<:' or ':' or '<>'."`
-	Num1            NM      `hl7:"2,display=A number. "`
+	Num1            NM      `hl7:"2,format=NM,display=A number. "`
 	SeparatorSuffix ST      `hl7:"3,len=1,display='-' or '+' or '/' or '.' or ':' Examples: Greater than 100 |>^100|  Equal to range of 100 through 200 |^100^-^200|  Ratio of 1 to 128- e.g.- the results of a serological test |^1^:^228|  Categorical response- e.g.- occult blood positivity |^2^+|"`
-	Num2            NM      `hl7:"4,display=A number or null depending on the measurement."`
+	Num2            NM      `hl7:"4,format=NM,display=A number or null depending on the measurement."`
 }
 
 // String Of Telephone Number Digits
@@ -1256,7 +1256,7 @@ type UVC struct {
 	HL7                            HL7Name `hl7:",name=UVC,len=0,type=d"`
 	ValueCode                      CWE     `hl7:"1,required,table=0153,display=Specifies the National Uniform Billing Committee (NUBC) code itself.  Refer to External Table 0153 – Value Code for valid values. In the United States- values for this component need to come from National Uniform Billing Committee (NUBC); no extensions are allowed.    Other realms should determine the precise set appropriate for their realm."`
 	ValueAmount                    *MO     `hl7:"2,display=Specifies the numeric amount when needed to pair with the value when it is monetary."`
-	NonMonetaryValueAmountQuantity NM      `hl7:"3,display=Specifies the numeric amount when needed to pair with the value when it is non-monetary. "`
+	NonMonetaryValueAmountQuantity NM      `hl7:"3,format=NM,display=Specifies the numeric amount when needed to pair with the value when it is non-monetary. "`
 	NonMonetaryValueAmountUnits    *CWE    `hl7:"4,display=This component species the units in which the  non-monetary quantity is expressed. Refer to the Unified Code for Units of Measure (UCUM) for valid values [http://aurora.rg.iupui.edu/UCUM]."`
 }
 
@@ -1318,7 +1318,7 @@ type XAD struct {
 	AddressUsage               ID      `hl7:"18,len=1,table=0617,display=This indicates how the address is intended to be used.  Refer to User-defined Table 0617 – Address Usage for valid values."`
 	Addressee                  ST      `hl7:"19,display=Identifies the name of the person or organization that should appear as part of the address. This is also known as the 'Care of' or 'C/O' line."`
 	Comment                    ST      `hl7:"20,display=Descriptive information about the use of the address.  Example: 'Do not ring bell.'"`
-	PreferenceOrder            NM      `hl7:"21,display=For an entity having multiple addresses- indicates which is the 'most preferred' (lowest number) to 'least preferred' (highest number).  If the preference order is unique across all usages for a given type- then it indicates the preference across all usages- (e.g.- first try my home address- then my business address). Otherwise- the preference order is assumed to only apply within a specific usage.  Preference order numbers need not be sequential (i.e.- four addresses with the priority orders of 10- 1- 5 and 15 are legitimate). The preference order numbers must be non-negative."`
+	PreferenceOrder            NM      `hl7:"21,format=NM,display=For an entity having multiple addresses- indicates which is the 'most preferred' (lowest number) to 'least preferred' (highest number).  If the preference order is unique across all usages for a given type- then it indicates the preference across all usages- (e.g.- first try my home address- then my business address). Otherwise- the preference order is assumed to only apply within a specific usage.  Preference order numbers need not be sequential (i.e.- four addresses with the priority orders of 10- 1- 5 and 15 are legitimate). The preference order numbers must be non-negative."`
 	ProtectionCode             *CWE    `hl7:"22,table=0618,display=Identifies that an address needs to be treated with special care or sensitivity.  The specific set of codes and appropriate handling of such addresses will vary by jurisdiction and is subject to site-specific negotiation. For example- a provider address marked as 'unlisted' might not be printed in an internal address listing.  Refer to User-defined Table 0618 – Protection Code for valid values."`
 	AddressIdentifier          *EI     `hl7:"23,display=A unique identifier for the address.  This component allows an address to be uniquely identified- and enables the linking of a single address to multiple people and contexts. For example- each member of a family living at the same location would share the same address- having the same identifier. A correction to the address of one family member could be automatically propagated to other members of the family."`
 }
@@ -1394,7 +1394,7 @@ type XON struct {
 	OrganizationName         ST      `hl7:"1,display=The name of the specified organization."`
 	OrganizationNameTypeCode *CWE    `hl7:"2,table=0204,display=A code that represents the type of name i.e.- legal name- display name. Refer to User-defined Table 0204 - Organizational Name Type for suggested values."`
 	IDNumber                 ST      `hl7:"3,display=Attention: The XON.3 component was deprecated as of v2.5 and the detail was withdrawn and removed from the standard as of v 2.7. Refer to XON.10 Organization Identifier."`
-	IdentifierCheckDigit     NM      `hl7:"4,display=This component contains the digit- or digits- exclusive of the identifier in XON.1- usually calculated by applying an algorithm to all or some of the digits in the number- which may be used to test validity of the non-check-digit identifier.  This component may also be used to transmit information intended to validate the veracity of the supplied identifier or the presenter of the identifier. For example- this component may be used to ensure that the presenter of a credit card is an authorized user of that card."`
+	IdentifierCheckDigit     NM      `hl7:"4,format=NM,display=This component contains the digit- or digits- exclusive of the identifier in XON.1- usually calculated by applying an algorithm to all or some of the digits in the number- which may be used to test validity of the non-check-digit identifier.  This component may also be used to transmit information intended to validate the veracity of the supplied identifier or the presenter of the identifier. For example- this component may be used to ensure that the presenter of a credit card is an authorized user of that card."`
 	CheckDigitScheme         ID      `hl7:"5,len=3,table=0061,display=Contains the code identifying the check digit scheme employed.  The check digit scheme codes are defined in HL7 Table 0061 - Check Digit Scheme."`
 	AssigningAuthority       *HD     `hl7:"6,table=0363,display=The assigning authority is a unique identifier of the system (or organization or agency or department) that creates the data. Assigning authorities are unique across a given HL7 implementation. Refer to User-defined Table 0363 - Assigning Authority for suggested values.   Note: When the HD data type is used in a given segment as a component of a field of another data type- User-defined Table 0300 - Namespace ID (referenced by the first sub-component of the HD component) may be re-defined (given a different user-defined table number and name) by the technical committee responsible for that segment.  By site agreement- implementers may continue to use User-defined Table 0300 – Namespace ID for the first sub-component."`
 	IdentifierTypeCode       ID      `hl7:"7,len=5,table=0203,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the 'Assigning authority' component. Refer to HL7 Table 0203 - Identifier Type for suggested values."`
@@ -1464,5 +1464,5 @@ type XTN struct {
 	ExpirationReason                  *CWE    `hl7:"15,table=0868,display=Indicates why this contact number/e-mail was marked as 'ended'.  Refer to User-defined Table 0868 – Telecommunication Expiration Reason for valid values."`
 	ProtectionCode                    *CWE    `hl7:"16,table=0618,display=Indicates whether this information is considered sensitive or should be protected in some way. Refer to User-defined table 0618 - Protection Code for suggested values.  The specific set of codes and appropriate handling of such telecommunications addresses will vary by jurisdiction and is subject to site-specific negotiation. For example- a provider address marked as 'unlisted' might not be printed in an internal address listing."`
 	SharedTelecommunicationIdentifier *EI     `hl7:"17,display=A unique identifier for the phone number or e-mail address.  This component allows a phone number to be uniquely identified- and enables the linking of a single phone number to multiple people and contexts. For example- each member of a family living at the same location would share the same phone number- having the same identifier. A correction to the phone number of one family member could be automatically propagated to other members of the family.  HL7 does not assume responsibility for the processing rules for this component."`
-	PreferenceOrder                   NM      `hl7:"18,display=For an entity having multiple telecommunication addresses- indicates which is the 'most preferred' (lowest number) to 'least preferred' (highest number).  If the preference order is unique across all usages for a given type- then it indicates the preference across all usages- (e.g. first try my home number- then my business number). If the preference order is unique across all usages and types- then it indicates the preference across all types of telecommunication addresses.  (E.g.- first try my phone; if that doesn't work- use my pager.) Otherwise- the preference order is assumed to only apply within a specific type and usage.  Preference order numbers need not be sequential (i.e.- three numbers with the priority orders of 0- 5 and 15 are legitimate).  The preference order numbers must be non-negative."`
+	PreferenceOrder                   NM      `hl7:"18,format=NM,display=For an entity having multiple telecommunication addresses- indicates which is the 'most preferred' (lowest number) to 'least preferred' (highest number).  If the preference order is unique across all usages for a given type- then it indicates the preference across all usages- (e.g. first try my home number- then my business number). If the preference order is unique across all usages and types- then it indicates the preference across all types of telecommunication addresses.  (E.g.- first try my phone; if that doesn't work- use my pager.) Otherwise- the preference order is assumed to only apply within a specific type and usage.  Preference order numbers need not be sequential (i.e.- three numbers with the priority orders of 0- 5 and 15 are legitimate).  The preference order numbers must be non-negative."`
 }
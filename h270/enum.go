@@ -0,0 +1,18041 @@
+// Code generated by "hl7fetch -pkgdir h270 -root ./genjson -version 2.7 -enum"; DO NOT EDIT.
+
+package h270
+
+// Table0001 is a value of table 0001, Administrative Sex.
+// Fields in this table are strings; convert a field, such as Table0001(v), to use the helpers.
+type Table0001 string
+
+const (
+	Table0001Ambiguous     Table0001 = `A` // Ambiguous
+	Table0001Female        Table0001 = `F` // Female
+	Table0001Male          Table0001 = `M` // Male
+	Table0001NotApplicable Table0001 = `N` // Not applicable
+	Table0001Other         Table0001 = `O` // Other
+	Table0001Unknown       Table0001 = `U` // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0001.
+func (v Table0001) Description() string {
+	return tableDescription(`0001`, string(v))
+}
+
+// Valid reports if the value is in table 0001.
+func (v Table0001) Valid() bool {
+	return TableValueLookup[`0001`][string(v)]
+}
+
+// Table 0001.
+func (v Table0001) Table() Table {
+	return TableLookup[`0001`]
+}
+
+// Table0002 is a value of table 0002, Marital Status.
+// Fields in this table are strings; convert a field, such as Table0002(v), to use the helpers.
+type Table0002 string
+
+const (
+	Table0002Separated                 Table0002 = `A` // Separated
+	Table0002Unmarried                 Table0002 = `B` // Unmarried
+	Table0002CommonLaw                 Table0002 = `C` // Common law
+	Table0002Divorced                  Table0002 = `D` // Divorced
+	Table0002LegallySeparated          Table0002 = `E` // Legally Separated
+	Table0002LivingTogether            Table0002 = `G` // Living together
+	Table0002Interlocutory             Table0002 = `I` // Interlocutory
+	Table0002Married                   Table0002 = `M` // Married
+	Table0002Annulled                  Table0002 = `N` // Annulled
+	Table0002Other                     Table0002 = `O` // Other
+	Table0002DomesticPartner           Table0002 = `P` // Domestic partner
+	Table0002RegisteredDomesticPartner Table0002 = `R` // Registered domestic partner
+	Table0002Single                    Table0002 = `S` // Single
+	Table0002Unreported                Table0002 = `T` // Unreported
+	Table0002Unknown                   Table0002 = `U` // Unknown
+	Table0002Widowed                   Table0002 = `W` // Widowed
+)
+
+// Description of the value, or empty if the value is not in table 0002.
+func (v Table0002) Description() string {
+	return tableDescription(`0002`, string(v))
+}
+
+// Valid reports if the value is in table 0002.
+func (v Table0002) Valid() bool {
+	return TableValueLookup[`0002`][string(v)]
+}
+
+// Table 0002.
+func (v Table0002) Table() Table {
+	return TableLookup[`0002`]
+}
+
+// Table0003 is a value of table 0003, Event Type.
+// Fields in this table are strings; convert a field, such as Table0003(v), to use the helpers.
+type Table0003 string
+
+const (
+	Table0003ADTACKAdmitVisitNotification                           Table0003 = `A01` // ADT/ACK - Admit/visit notification
+	Table0003ADTACKTransferAPatient                                 Table0003 = `A02` // ADT/ACK - Transfer a patient
+	Table0003ADTACKDischargeEndVisit                                Table0003 = `A03` // ADT/ACK -  Discharge/end visit
+	Table0003ADTACKRegisterAPatient                                 Table0003 = `A04` // ADT/ACK -  Register a patient
+	Table0003ADTACKPreAdmitAPatient                                 Table0003 = `A05` // ADT/ACK -  Pre-admit a patient
+	Table0003ADTACKChangeAnOutpatientTo                             Table0003 = `A06` // ADT/ACK -  Change an outpatient to an inpatient
+	Table0003ADTACKChangeAnInpatientTo                              Table0003 = `A07` // ADT/ACK -  Change an inpatient to an outpatient
+	Table0003ADTACKUpdatePatientInformation                         Table0003 = `A08` // ADT/ACK -  Update patient information
+	Table0003ADTACKPatientDepartingTracking                         Table0003 = `A09` // ADT/ACK -  Patient departing - tracking
+	Table0003ADTACKPatientArrivingTracking                          Table0003 = `A10` // ADT/ACK -  Patient arriving - tracking
+	Table0003ADTACKCancelAdmitVisitNotification                     Table0003 = `A11` // ADT/ACK -  Cancel admit/visit notification
+	Table0003ADTACKCancelTransfer                                   Table0003 = `A12` // ADT/ACK -  Cancel transfer
+	Table0003ADTACKCancelDischargeEndVisit                          Table0003 = `A13` // ADT/ACK -  Cancel discharge/end visit
+	Table0003ADTACKPendingAdmit                                     Table0003 = `A14` // ADT/ACK -  Pending admit
+	Table0003ADTACKPendingTransfer                                  Table0003 = `A15` // ADT/ACK -  Pending transfer
+	Table0003ADTACKPendingDischarge                                 Table0003 = `A16` // ADT/ACK -  Pending discharge
+	Table0003ADTACKSwapPatients                                     Table0003 = `A17` // ADT/ACK -  Swap patients
+	Table0003ADTACKMergePatientInformation                          Table0003 = `A18` // ADT/ACK -  Merge patient information
+	Table0003QRYADRPatientQuery                                     Table0003 = `A19` // QRY/ADR -  Patient query
+	Table0003ADTACKBedStatusUpdate                                  Table0003 = `A20` // ADT/ACK -  Bed status update
+	Table0003ADTACKPatientGoesOnA                                   Table0003 = `A21` // ADT/ACK -  Patient goes on a "leave of absence"
+	Table0003ADTACKPatientReturnsFromA                              Table0003 = `A22` // ADT/ACK -  Patient returns from a "leave of absence"
+	Table0003ADTACKDeleteAPatientRecord                             Table0003 = `A23` // ADT/ACK -  Delete a patient record
+	Table0003ADTACKLinkPatientInformation                           Table0003 = `A24` // ADT/ACK -  Link patient information
+	Table0003ADTACKCancelPendingDischarge                           Table0003 = `A25` // ADT/ACK -  Cancel pending discharge
+	Table0003ADTACKCancelPendingTransfer                            Table0003 = `A26` // ADT/ACK -  Cancel pending transfer
+	Table0003ADTACKCancelPendingAdmit                               Table0003 = `A27` // ADT/ACK -  Cancel pending admit
+	Table0003ADTACKAddPersonInformation                             Table0003 = `A28` // ADT/ACK -  Add person information
+	Table0003ADTACKDeletePersonInformation                          Table0003 = `A29` // ADT/ACK -  Delete person information
+	Table0003ADTACKMergePersonInformation                           Table0003 = `A30` // ADT/ACK -  Merge person information
+	Table0003ADTACKUpdatePersonInformation                          Table0003 = `A31` // ADT/ACK -  Update person information
+	Table0003ADTACKCancelPatientArrivingTracking                    Table0003 = `A32` // ADT/ACK -  Cancel patient arriving - tracking
+	Table0003ADTACKCancelPatientDepartingTracking                   Table0003 = `A33` // ADT/ACK -  Cancel patient departing - tracking
+	Table0003ADTACKMergePatientInformationPatient                   Table0003 = `A34` // ADT/ACK -  Merge patient information - patient ID only
+	Table0003ADTACKMergePatientInformationAccount                   Table0003 = `A35` // ADT/ACK -  Merge patient information - account number only
+	Table0003ADTACKMergePatientInformationPatient_A36               Table0003 = `A36` // ADT/ACK -  Merge patient information - patient ID and account number
+	Table0003ADTACKUnlinkPatientInformation                         Table0003 = `A37` // ADT/ACK -  Unlink patient information
+	Table0003ADTACKCancelPreAdmit                                   Table0003 = `A38` // ADT/ACK - Cancel pre-admit
+	Table0003ADTACKMergePersonPatientID                             Table0003 = `A39` // ADT/ACK - Merge person - patient ID
+	Table0003ADTACKMergePatientPatientIdentifier                    Table0003 = `A40` // ADT/ACK - Merge patient - patient identifier list
+	Table0003ADTACKMergeAccountPatientAccount                       Table0003 = `A41` // ADT/ACK - Merge account - patient account number
+	Table0003ADTACKMergeVisitVisitNumber                            Table0003 = `A42` // ADT/ACK - Merge visit - visit number
+	Table0003ADTACKMovePatientInformationPatient                    Table0003 = `A43` // ADT/ACK - Move patient information - patient identifier list
+	Table0003ADTACKMoveAccountInformationPatient                    Table0003 = `A44` // ADT/ACK - Move account information - patient account number
+	Table0003ADTACKMoveVisitInformationVisit                        Table0003 = `A45` // ADT/ACK - Move visit information - visit number
+	Table0003ADTACKChangePatientID                                  Table0003 = `A46` // ADT/ACK - Change patient ID
+	Table0003ADTACKChangePatientIdentifierList                      Table0003 = `A47` // ADT/ACK - Change patient identifier list
+	Table0003ADTACKChangeAlternatePatientID                         Table0003 = `A48` // ADT/ACK - Change alternate patient ID
+	Table0003ADTACKChangePatientAccountNumber                       Table0003 = `A49` // ADT/ACK - Change patient account number
+	Table0003ADTACKChangeVisitNumber                                Table0003 = `A50` // ADT/ACK - Change visit number
+	Table0003ADTACKChangeAlternateVisitID                           Table0003 = `A51` // ADT/ACK - Change alternate visit ID
+	Table0003ADTACKCancelLeaveOfAbsence                             Table0003 = `A52` // ADT/ACK - Cancel leave of absence for a patient
+	Table0003ADTACKCancelPatientReturnsFrom                         Table0003 = `A53` // ADT/ACK - Cancel patient returns from a leave of absence
+	Table0003ADTACKChangeAttendingDoctor                            Table0003 = `A54` // ADT/ACK - Change attending doctor
+	Table0003ADTACKCancelChangeAttendingDoctor                      Table0003 = `A55` // ADT/ACK - Cancel change attending doctor
+	Table0003ADTACKUpdateAllergyInformation                         Table0003 = `A60` // ADT/ACK - Update allergy information
+	Table0003ADTACKChangeConsultingDoctor                           Table0003 = `A61` // ADT/ACK - Change consulting doctor
+	Table0003ADTACKCancelChangeConsultingDoctor                     Table0003 = `A62` // ADT/ACK - Cancel change consulting doctor
+	Table0003PMUACKAddPersonnelRecord                               Table0003 = `B01` // PMU/ACK - Add personnel record
+	Table0003PMUACKUpdatePersonnelRecord                            Table0003 = `B02` // PMU/ACK - Update personnel record
+	Table0003PMUACKDeletePersonnelReCord                            Table0003 = `B03` // PMU/ACK - Delete personnel re cord
+	Table0003PMUACKActivePracticingPerson                           Table0003 = `B04` // PMU/ACK - Active practicing person
+	Table0003PMUACKDeactivatePracticingPerson                       Table0003 = `B05` // PMU/ACK - Deactivate practicing person
+	Table0003PMUACKTerminatePracticingPerson                        Table0003 = `B06` // PMU/ACK - Terminate practicing person
+	Table0003PMUACKGrantCertificatePermission                       Table0003 = `B07` // PMU/ACK - Grant Certificate/Permission
+	Table0003PMUACKRevokeCertificatePermission                      Table0003 = `B08` // PMU/ACK - Revoke Certificate/Permission
+	Table0003CRMRegisterAPatientOnA                                 Table0003 = `C01` // CRM - Register a patient on a clinical trial
+	Table0003CRMCancelAPatientRegistrationOn                        Table0003 = `C02` // CRM - Cancel a patient registration on clinical trial (for clerical mistakes only)
+	Table0003CRMCorrectUpdateRegistrationInformation                Table0003 = `C03` // CRM - Correct/update registration information
+	Table0003CRMPatientHasGoneOffA                                  Table0003 = `C04` // CRM - Patient has gone off a clinical trial
+	Table0003CRMPatientEntersPhaseOfClinical                        Table0003 = `C05` // CRM - Patient enters phase of clinical trial
+	Table0003CRMCancelPatientEnteringAPhase                         Table0003 = `C06` // CRM - Cancel patient entering a phase (clerical mistake)
+	Table0003CRMCorrectUpdatePhaseInformation                       Table0003 = `C07` // CRM - Correct/update phase information
+	Table0003CRMPatientHasGoneOffPhase                              Table0003 = `C08` // CRM - Patient has gone off phase of clinical trial
+	Table0003CSUAutomatedTimeIntervalsForReporting                  Table0003 = `C09` // CSU - Automated time intervals for reporting, like monthly
+	Table0003CSUPatientCompletesTheClinicalTrial                    Table0003 = `C10` // CSU - Patient completes the clinical trial
+	Table0003CSUPatientCompletesAPhaseOf                            Table0003 = `C11` // CSU - Patient completes a phase of the clinical trial
+	Table0003CSUUpdateCorrectionOfPatientOrder                      Table0003 = `C12` // CSU - Update/correction of patient order/result information
+	Table0003SubmitHealthCareServicesInvoice                        Table0003 = `E01` // Submit HealthCare Services Invoice
+	Table0003CancelHealthCareServicesInvoice                        Table0003 = `E02` // Cancel HealthCare Services Invoice
+	Table0003HealthCareServicesInvoiceStatus                        Table0003 = `E03` // HealthCare Services Invoice Status
+	Table0003ReAssessHealthCareServicesInvoiceRequest               Table0003 = `E04` // Re-Assess HealthCare Services Invoice Request
+	Table0003EditAdjudicationResults                                Table0003 = `E10` // Edit/Adjudication Results
+	Table0003RequestAdditionalInformation                           Table0003 = `E12` // Request Additional Information
+	Table0003AdditionalInformationResponse                          Table0003 = `E13` // Additional Information Response
+	Table0003PaymentRemittanceAdvice                                Table0003 = `E15` // Payment/Remittance Advice
+	Table0003SubmitAuthorizationRequest                             Table0003 = `E20` // Submit Authorization Request
+	Table0003CancelAuthorizationRequest                             Table0003 = `E21` // Cancel Authorization Request
+	Table0003AuthorizationRequestStatus                             Table0003 = `E22` // Authorization Request Status
+	Table0003AuthorizationResponse                                  Table0003 = `E24` // Authorization Response
+	Table0003SubmitHealthDocumentRelatedToAuthorization             Table0003 = `E30` // Submit Health Document related to Authorization Request
+	Table0003CancelHealthDocumentRelatedToAuthorization             Table0003 = `E31` // Cancel Health Document related to Authorization Request
+	Table0003RQIRPIRequestForInsuranceInformation                   Table0003 = `I01` // RQI/RPI - Request for insurance information
+	Table0003RQIRPLRequestReceiptOfPatient                          Table0003 = `I02` // RQI/RPL - Request/receipt of patient selection display list
+	Table0003RQIRPRRequestReceiptOfPatient                          Table0003 = `I03` // RQI/RPR - Request/receipt of patient selection list
+	Table0003RQDRPIRequestForPatientDemographic                     Table0003 = `I04` // RQD/RPI - Request for patient demographic data
+	Table0003RQCRCIRequestForPatientClinical                        Table0003 = `I05` // RQC/RCI - Request for patient clinical information
+	Table0003RQCRCLRequestReceiptOfClinical                         Table0003 = `I06` // RQC/RCL - Request/receipt of clinical data listing
+	Table0003PINACKUnsolicitedInsuranceInformation                  Table0003 = `I07` // PIN/ACK - Unsolicited insurance information
+	Table0003RQARPARequestForTreatmentAuthorization                 Table0003 = `I08` // RQA/RPA - Request for treatment authorization information
+	Table0003RQARPARequestForModificationTo                         Table0003 = `I09` // RQA/RPA - Request for modification to an authorization
+	Table0003RQARPARequestForResubmissionOf                         Table0003 = `I10` // RQA/RPA - Request for resubmission of an authorization
+	Table0003RQARPARequestForCancellationOf                         Table0003 = `I11` // RQA/RPA - Request for cancellation of an authorization
+	Table0003REFRRIPatientReferral                                  Table0003 = `I12` // REF/RRI - Patient referral
+	Table0003REFRRIModifyPatientReferral                            Table0003 = `I13` // REF/RRI - Modify patient referral
+	Table0003REFRRICancelPatientReferral                            Table0003 = `I14` // REF/RRI - Cancel patient referral
+	Table0003REFRRIRequestPatientReferralStatus                     Table0003 = `I15` // REF/RRI - Request patient referral status
+	Table0003CollaborativeCareReferral                              Table0003 = `I16` // Collaborative Care Referral
+	Table0003ModifyCollaborativeCareReferral                        Table0003 = `I17` // Modify Collaborative Care Referral
+	Table0003CancelCollaborativeCareReferral                        Table0003 = `I18` // Cancel Collaborative Care Referral
+	Table0003CollaborativeCareQueryCollaborativeCareQuery           Table0003 = `I19` // Collaborative Care Query/Collaborative Care Query Update
+	Table0003AsynchronousCollaborativeCareUpdate                    Table0003 = `I20` // Asynchronous Collaborative Care Update
+	Table0003CollaborativeCareMessage                               Table0003 = `I21` // Collaborative Care Message
+	Table0003CollaborativeCareFetchCollaborativeCareInformation     Table0003 = `I22` // Collaborative Care Fetch / Collaborative Care Information
+	Table0003QCNACKCancelQueryAcknowledgeMessage                    Table0003 = `J01` // QCN/ACK - Cancel query/acknowledge message
+	Table0003QSXACKCancelSubscriptionAcknowledgeMessage             Table0003 = `J02` // QSX/ACK - Cancel subscription/acknowledge message
+	Table0003RSPSegmentPatternResponseInResponse                    Table0003 = `K11` // RSP - Segment pattern response in response to QBP^Q11
+	Table0003RTBTabularResponseInResponseTo                         Table0003 = `K13` // RTB - Tabular response in response to QBP^Q13
+	Table0003RDYDisplayResponseInResponseTo                         Table0003 = `K15` // RDY - Display response in response to QBP^Q15
+	Table0003RSPGetPersonDemographicsResponse                       Table0003 = `K21` // RSP - Get person demographics response
+	Table0003RSPFindCandidatesResponse                              Table0003 = `K22` // RSP - Find candidates response
+	Table0003RSPGetCorrespondingIdentifiersResponse                 Table0003 = `K23` // RSP - Get corresponding identifiers response
+	Table0003RSPAllocateIdentifiersResponse                         Table0003 = `K24` // RSP - Allocate identifiers response
+	Table0003RSPPersonnelInformationBySegmentResponse               Table0003 = `K25` // RSP - Personnel Information by Segment Response
+	Table0003RSPDispenseHistoryResponse                             Table0003 = `K31` // RSP -Dispense History Response
+	Table0003FindCandidatesIncludingVisitInformationResponse        Table0003 = `K32` // Find Candidates including Visit Information Response
+	Table0003MFNMFKMasterFileNotOtherwise                           Table0003 = `M01` // MFN/MFK - Master file not otherwise specified
+	Table0003MFNMFKMasterFileStaffPractitioner                      Table0003 = `M02` // MFN/MFK - Master file - staff practitioner
+	Table0003MFNMFKMasterFileTestObservation                        Table0003 = `M03` // MFN/MFK - Master file - test/observation
+	Table0003MFNMFKMasterFilesChargeDescription                     Table0003 = `M04` // MFN/MFK - Master files charge description
+	Table0003MFNMFKPatientLocationMasterFile                        Table0003 = `M05` // MFN/MFK - Patient location master file
+	Table0003MFNMFKClinicalStudyWithPhases                          Table0003 = `M06` // MFN/MFK - Clinical study with phases and schedules master file
+	Table0003MFNMFKClinicalStudyWithoutPhases                       Table0003 = `M07` // MFN/MFK - Clinical study without phases but with schedules master file
+	Table0003MFNMFKTestObservationNumericMaster                     Table0003 = `M08` // MFN/MFK - Test/observation (numeric) master file
+	Table0003MFNMFKTestObservationCategoricalMaster                 Table0003 = `M09` // MFN/MFK - Test/observation (categorical) master file
+	Table0003MFNMFKTestObservationBatteriesMaster                   Table0003 = `M10` // MFN/MFK - Test /observation batteries master file
+	Table0003MFNMFKTestCalculatedObservationsMaster                 Table0003 = `M11` // MFN/MFK - Test/calculated observations master file
+	Table0003MFNMFKMasterFileNotificationMessage                    Table0003 = `M12` // MFN/MFK - Master file notification message
+	Table0003MFNMFKMasterFileNotificationGeneral                    Table0003 = `M13` // MFN/MFK - Master file notification - general
+	Table0003MFNMFKMasterFileNotificationSite                       Table0003 = `M14` // MFN/MFK - Master file notification - site defined
+	Table0003MFNMFKInventoryItemMasterFile                          Table0003 = `M15` // MFN/MFK - Inventory item master file notification
+	Table0003MFNMFKMasterFileNotificationInventory                  Table0003 = `M16` // MFN/MFK - Master File Notification Inventory Item Enhanced
+	Table0003DRGMasterFileMessage                                   Table0003 = `M17` // DRG Master File Message
+	Table0003NMQNMRApplicationManagementQueryMessage                Table0003 = `N01` // NMQ/NMR - Application management query message
+	Table0003NMDACKApplicationManagementDataMessage                 Table0003 = `N02` // NMD/ACK - Application management data message (unsolicited)
+	Table0003ORMOrderMessageAlsoRDERDS                              Table0003 = `O01` // ORM - Order message (also RDE, RDS, RGV, RAS)
+	Table0003ORROrderResponseAlsoRRERRD                             Table0003 = `O02` // ORR - Order response (also RRE, RRD, RRG, RRA)
+	Table0003OMDDietOrder                                           Table0003 = `O03` // OMD - Diet order
+	Table0003ORDDietOrderAcknowledgment                             Table0003 = `O04` // ORD - Diet order acknowledgment
+	Table0003OMSStockRequisitionOrder                               Table0003 = `O05` // OMS - Stock requisition order
+	Table0003ORSStockRequisitionAcknowledgment                      Table0003 = `O06` // ORS - Stock requisition acknowledgment
+	Table0003OMNNonStockRequisitionOrder                            Table0003 = `O07` // OMN - Non-stock requisition order
+	Table0003ORNNonStockRequisitionAcknowledgment                   Table0003 = `O08` // ORN - Non-stock requisition acknowledgment
+	Table0003OMPPharmacyTreatmentOrder                              Table0003 = `O09` // OMP - Pharmacy/treatment order
+	Table0003ORPPharmacyTreatmentOrderAcknowledgment                Table0003 = `O10` // ORP - Pharmacy/treatment order acknowledgment
+	Table0003RDEPharmacyTreatmentEncodedOrder                       Table0003 = `O11` // RDE - Pharmacy/treatment encoded order
+	Table0003RREPharmacyTreatmentEncodedOrderAcknowledgment         Table0003 = `O12` // RRE - Pharmacy/treatment encoded order acknowledgment
+	Table0003RDSPharmacyTreatmentDispense                           Table0003 = `O13` // RDS - Pharmacy/treatment dispense
+	Table0003RRDPharmacyTreatmentDispenseAcknowledgment             Table0003 = `O14` // RRD - Pharmacy/treatment dispense acknowledgment
+	Table0003RGVPharmacyTreatmentGive                               Table0003 = `O15` // RGV - Pharmacy/treatment give
+	Table0003RRGPharmacyTreatmentGiveAcknowledgment                 Table0003 = `O16` // RRG - Pharmacy/treatment give acknowledgment
+	Table0003RASPharmacyTreatmentAdministration                     Table0003 = `O17` // RAS - Pharmacy/treatment administration
+	Table0003RRAPharmacyTreatmentAdministrationAcknowledgment       Table0003 = `O18` // RRA - Pharmacy/treatment administration acknowledgment
+	Table0003OMGGeneralClinicalOrder                                Table0003 = `O19` // OMG - General clinical order
+	Table0003ORGORLGeneralClinicalOrderResponse                     Table0003 = `O20` // ORG/ORL - General clinical order response
+	Table0003OMLLaboratoryOrder                                     Table0003 = `O21` // OML - Laboratory order
+	Table0003ORLGeneralLaboratoryOrderResponseMessage               Table0003 = `O22` // ORL - General laboratory order response message to any OML
+	Table0003OMIImagingOrder                                        Table0003 = `O23` // OMI - Imaging order
+	Table0003ORIImagingOrderResponseMessageTo                       Table0003 = `O24` // ORI - Imaging order response message to any OMI
+	Table0003RDEPharmacyTreatmentRefillAuthorizationRequest         Table0003 = `O25` // RDE - Pharmacy/treatment refill authorization request
+	Table0003RREPharmacyTreatmentRefillAuthorizationAcknowledgement Table0003 = `O26` // RRE - Pharmacy/Treatment Refill Authorization Acknowledgement
+	Table0003OMBBloodProductOrder                                   Table0003 = `O27` // OMB - Blood product order
+	Table0003ORBBloodProductOrderAcknowledgment                     Table0003 = `O28` // ORB - Blood product order acknowledgment
+	Table0003BPSBloodProductDispenseStatus                          Table0003 = `O29` // BPS - Blood product dispense status
+	Table0003BRPBloodProductDispenseStatusAcknowledgment            Table0003 = `O30` // BRP - Blood product dispense status acknowledgment
+	Table0003BTSBloodProductTransfusionDisposition                  Table0003 = `O31` // BTS - Blood product transfusion/disposition
+	Table0003BRTBloodProductTransfusionDispositionAcknowledgment    Table0003 = `O32` // BRT - Blood product transfusion/disposition acknowledgment
+	Table0003OMLLaboratoryOrderForMultipleOrders                    Table0003 = `O33` // OML - Laboratory order for multiple orders related to a single specimen
+	Table0003ORLLaboratoryOrderResponseMessageTo                    Table0003 = `O34` // ORL - Laboratory order response message to a multiple order related to single specimen OML
+	Table0003OMLLaboratoryOrderForMultipleOrders_O35                Table0003 = `O35` // OML - Laboratory order for multiple orders related to a single container of a specimen
+	Table0003ORLLaboratoryOrderResponseMessageTo_O36                Table0003 = `O36` // ORL - Laboratory order response message to a single container of a specimen OML
+	Table0003OPLPopulationLocationBasedLaboratoryOrder              Table0003 = `O37` // OPL - Population/Location-Based Laboratory Order Message
+	Table0003OPRPopulationLocationBasedLaboratoryOrder              Table0003 = `O38` // OPR - Population/Location-Based Laboratory Order Acknowledgment Message
+	Table0003SpecimenShipmentCentricLaboratoryOrder                 Table0003 = `O39` // Specimen shipment centric laboratory order
+	Table0003SpecimenShipmentCentricLaboratoryOrderAcknowledgment   Table0003 = `O40` // Specimen Shipment Centric Laboratory Order Acknowledgment Message
+	Table0003BARACKAddPatientAccounts                               Table0003 = `P01` // BAR/ACK - Add patient accounts
+	Table0003BARACKPurgePatientAccounts                             Table0003 = `P02` // BAR/ACK - Purge patient accounts
+	Table0003DFTACKPostDetailFinancialTransaction                   Table0003 = `P03` // DFT/ACK - Post detail financial transaction
+	Table0003QRYDSPGenerateBillAndA                                 Table0003 = `P04` // QRY/DSP - Generate bill and A/R statements
+	Table0003BARACKUpdateAccount                                    Table0003 = `P05` // BAR/ACK - Update account
+	Table0003BARACKEndAccount                                       Table0003 = `P06` // BAR/ACK - End account
+	Table0003PEXUnsolicitedInitialIndividualProductExperience       Table0003 = `P07` // PEX - Unsolicited initial individual product experience report
+	Table0003PEXUnsolicitedUpdateIndividualProductExperience        Table0003 = `P08` // PEX - Unsolicited update individual product experience report
+	Table0003SURSummaryProductExperienceReport                      Table0003 = `P09` // SUR - Summary product experience report
+	Table0003BARACKTransmitAmbulatoryPaymentClassification          Table0003 = `P10` // BAR/ACK -Transmit Ambulatory Payment  Classification(APC)
+	Table0003DFTACKPostDetailFinancialTransactions                  Table0003 = `P11` // DFT/ACK - Post Detail Financial Transactions - New
+	Table0003BARACKUpdateDiagnosisProcedure                         Table0003 = `P12` // BAR/ACK - Update Diagnosis/Procedure
+	Table0003PPRPCProblemAdd                                        Table0003 = `PC1` // PPR - PC/ problem add
+	Table0003PPRPCProblemUpdate                                     Table0003 = `PC2` // PPR - PC/ problem update
+	Table0003PPRPCProblemDelete                                     Table0003 = `PC3` // PPR - PC/ problem delete
+	Table0003QRYPCProblemQuery                                      Table0003 = `PC4` // QRY - PC/ problem query
+	Table0003PRRPCProblemResponse                                   Table0003 = `PC5` // PRR - PC/ problem response
+	Table0003PGLPCGoalAdd                                           Table0003 = `PC6` // PGL - PC/ goal add
+	Table0003PGLPCGoalUpdate                                        Table0003 = `PC7` // PGL - PC/ goal update
+	Table0003PGLPCGoalDelete                                        Table0003 = `PC8` // PGL - PC/ goal delete
+	Table0003QRYPCGoalQuery                                         Table0003 = `PC9` // QRY - PC/ goal query
+	Table0003PPVPCGoalResponse                                      Table0003 = `PCA` // PPV - PC/ goal response
+	Table0003PPPPCPathwayProblemOrientedAdd                         Table0003 = `PCB` // PPP - PC/ pathway (problem-oriented) add
+	Table0003PPPPCPathwayProblemOrientedUpdate                      Table0003 = `PCC` // PPP - PC/ pathway (problem-oriented) update
+	Table0003PPPPCPathwayProblemOrientedDelete                      Table0003 = `PCD` // PPP - PC/ pathway (problem-oriented) delete
+	Table0003QRYPCPathwayProblemOrientedQuery                       Table0003 = `PCE` // QRY - PC/ pathway (problem-oriented) query
+	Table0003PTRPCPathwayProblemOrientedQuery                       Table0003 = `PCF` // PTR - PC/ pathway (problem-oriented) query response
+	Table0003PPGPCPathwayGoalOrientedAdd                            Table0003 = `PCG` // PPG - PC/ pathway (goal-oriented) add
+	Table0003PPGPCPathwayGoalOrientedUpdate                         Table0003 = `PCH` // PPG - PC/ pathway (goal-oriented) update
+	Table0003PPGPCPathwayGoalOrientedDelete                         Table0003 = `PCJ` // PPG - PC/ pathway (goal-oriented) delete
+	Table0003QRYPCPathwayGoalOrientedQuery                          Table0003 = `PCK` // QRY - PC/ pathway (goal-oriented) query
+	Table0003PPTPCPathwayGoalOrientedQuery                          Table0003 = `PCL` // PPT - PC/ pathway (goal-oriented) query response
+	Table0003QRYDSRQuerySentForImmediate                            Table0003 = `Q01` // QRY/DSR - Query sent for immediate response
+	Table0003QRYQCKQuerySentForDeferred                             Table0003 = `Q02` // QRY/QCK - Query sent for deferred response
+	Table0003DSRACKDeferredResponseToA                              Table0003 = `Q03` // DSR/ACK - Deferred response to a query
+	Table0003UDMACKUnsolicitedDisplayUpdateMessage                  Table0003 = `Q05` // UDM/ACK - Unsolicited display update message
+	Table0003OSQOSRQueryForOrderStatus                              Table0003 = `Q06` // OSQ/OSR - Query for order status
+	Table0003QBPQueryByParameterRequestingAn                        Table0003 = `Q11` // QBP - Query by parameter requesting an RSP segment pattern response
+	Table0003QBPQueryByParameterRequestingAn_Q13                    Table0003 = `Q13` // QBP - Query by parameter requesting an  RTB - tabular response
+	Table0003QBPQueryByParameterRequestingAn_Q15                    Table0003 = `Q15` // QBP - Query by parameter requesting an RDY display response
+	Table0003QSBCreateSubscription                                  Table0003 = `Q16` // QSB - Create subscription
+	Table0003QVRQueryForPreviousEvents                              Table0003 = `Q17` // QVR - Query for previous events
+	Table0003QBPGetPersonDemographics                               Table0003 = `Q21` // QBP - Get person demographics
+	Table0003QBPFindCandidates                                      Table0003 = `Q22` // QBP - Find candidates
+	Table0003QBPGetCorrespondingIdentifiers                         Table0003 = `Q23` // QBP - Get corresponding identifiers
+	Table0003QBPAllocateIdentifiers                                 Table0003 = `Q24` // QBP - Allocate identifiers
+	Table0003QBPPersonnelInformationBySegmentQuery                  Table0003 = `Q25` // QBP - Personnel Information by Segment Query
+	Table0003RORPharmacyTreatmentOrderResponse                      Table0003 = `Q26` // ROR - Pharmacy/treatment order response
+	Table0003RARPharmacyTreatmentAdministrationInformation          Table0003 = `Q27` // RAR - Pharmacy/treatment administration information
+	Table0003RDRPharmacyTreatmentDispenseInformation                Table0003 = `Q28` // RDR - Pharmacy/treatment dispense information
+	Table0003RERPharmacyTreatmentEncodedOrderInformation            Table0003 = `Q29` // RER - Pharmacy/treatment encoded order information
+	Table0003RGRPharmacyTreatmentDoseInformation                    Table0003 = `Q30` // RGR - Pharmacy/treatment dose information
+	Table0003QBPQueryDispenseHistory                                Table0003 = `Q31` // QBP Query Dispense history
+	Table0003FindCandidatesIncludingVisitInformation                Table0003 = `Q32` // Find Candidates including Visit Information
+	Table0003ORUACKUnsolicitedTransmissionOfAn                      Table0003 = `R01` // ORU/ACK - Unsolicited transmission of an observation message
+	Table0003QRYQueryForResultsOfObservation                        Table0003 = `R02` // QRY - Query for results of observation
+	Table0003ORFResponseToQueryTransmissionOf                       Table0003 = `R04` // ORF - Response to query; transmission of requested observation
+	Table0003OULUnsolicitedLaboratoryObservation                    Table0003 = `R21` // OUL - Unsolicited laboratory observation
+	Table0003OULUnsolicitedSpecimenOrientedObservationMessage       Table0003 = `R22` // OUL - Unsolicited Specimen Oriented Observation Message
+	Table0003OULUnsolicitedSpecimenContainerOrientedObservation     Table0003 = `R23` // OUL - Unsolicited Specimen Container Oriented Observation Message
+	Table0003OULUnsolicitedOrderOrientedObservationMessage          Table0003 = `R24` // OUL - Unsolicited Order Oriented Observation Message
+	Table0003OPUUnsolicitedPopulationLocationBasedLaboratory        Table0003 = `R25` // OPU - Unsolicited Population/Location-Based Laboratory Observation Message
+	Table0003OSMUnsolicitedSpecimenShipmentManifestMessage          Table0003 = `R26` // OSM - Unsolicited Specimen Shipment Manifest Message
+	Table0003ORUUnsolicitedPointOfCareObservation                   Table0003 = `R30` // ORU - Unsolicited Point-Of-Care Observation Message Without Existing Order - Place An Order
+	Table0003ORUUnsolicitedNewPointOfCare                           Table0003 = `R31` // ORU - Unsolicited New Point-Of-Care Observation Message - Search For An Order
+	Table0003ORUUnsolicitedPreOrderedPointOf                        Table0003 = `R32` // ORU - Unsolicited Pre-Ordered Point-Of-Care Observation
+	Table0003ORAObservationReportAcknowledgement                    Table0003 = `R33` // ORA - Observation Report Acknowledgement
+	Table0003RORPharmacyPrescriptionOrderQueryResponse              Table0003 = `ROR` // ROR - Pharmacy prescription order query response
+	Table0003SRMSRRRequestNewAppointmentBooking                     Table0003 = `S01` // SRM/SRR - Request new appointment booking
+	Table0003SRMSRRRequestAppointmentRescheduling                   Table0003 = `S02` // SRM/SRR - Request appointment rescheduling
+	Table0003SRMSRRRequestAppointmentModification                   Table0003 = `S03` // SRM/SRR - Request appointment modification
+	Table0003SRMSRRRequestAppointmentCancellation                   Table0003 = `S04` // SRM/SRR - Request appointment cancellation
+	Table0003SRMSRRRequestAppointmentDiscontinuation                Table0003 = `S05` // SRM/SRR - Request appointment discontinuation
+	Table0003SRMSRRRequestAppointmentDeletion                       Table0003 = `S06` // SRM/SRR - Request appointment deletion
+	Table0003SRMSRRRequestAdditionOfService                         Table0003 = `S07` // SRM/SRR - Request addition of service/resource on appointment
+	Table0003SRMSRRRequestModificationOfService                     Table0003 = `S08` // SRM/SRR - Request modification of service/resource on appointment
+	Table0003SRMSRRRequestCancellationOfService                     Table0003 = `S09` // SRM/SRR - Request cancellation of service/resource on appointment
+	Table0003SRMSRRRequestDiscontinuationOfService                  Table0003 = `S10` // SRM/SRR - Request discontinuation of service/resource on appointment
+	Table0003SRMSRRRequestDeletionOfService                         Table0003 = `S11` // SRM/SRR - Request deletion of service/resource on appointment
+	Table0003SIUACKNotificationOfNewAppointment                     Table0003 = `S12` // SIU/ACK - Notification of new appointment booking
+	Table0003SIUACKNotificationOfAppointmentRescheduling            Table0003 = `S13` // SIU/ACK - Notification of appointment rescheduling
+	Table0003SIUACKNotificationOfAppointmentModification            Table0003 = `S14` // SIU/ACK - Notification of appointment modification
+	Table0003SIUACKNotificationOfAppointmentCancellation            Table0003 = `S15` // SIU/ACK - Notification of appointment cancellation
+	Table0003SIUACKNotificationOfAppointmentDiscontinuation         Table0003 = `S16` // SIU/ACK - Notification of appointment discontinuation
+	Table0003SIUACKNotificationOfAppointmentDeletion                Table0003 = `S17` // SIU/ACK - Notification of appointment deletion
+	Table0003SIUACKNotificationOfAdditionOf                         Table0003 = `S18` // SIU/ACK - Notification of addition of service/resource on appointment
+	Table0003SIUACKNotificationOfModificationOf                     Table0003 = `S19` // SIU/ACK - Notification of modification of service/resource on appointment
+	Table0003SIUACKNotificationOfCancellationOf                     Table0003 = `S20` // SIU/ACK - Notification of cancellation of service/resource on appointment
+	Table0003SIUACKNotificationOfDiscontinuationOf                  Table0003 = `S21` // SIU/ACK - Notification of discontinuation of service/resource on appointment
+	Table0003SIUACKNotificationOfDeletionOf                         Table0003 = `S22` // SIU/ACK - Notification of deletion of service/resource on appointment
+	Table0003SIUACKNotificationOfBlockedSchedule                    Table0003 = `S23` // SIU/ACK - Notification of blocked schedule time slot(s)
+	Table0003SIUACKNotificationOfOpenedUnblocked                    Table0003 = `S24` // SIU/ACK - Notification of opened ("unblocked") schedule time slot(s)
+	Table0003SQMSQRScheduleQueryMessageAnd                          Table0003 = `S25` // SQM/SQR - Schedule query message and response
+	Table0003SIUACKNotificationThatPatientDid                       Table0003 = `S26` // SIU/ACK Notification that patient did not show up for schedule appointment
+	Table0003SIUACKBroadcastNotificationOfScheduled                 Table0003 = `S27` // SIU/ACK - Broadcast Notification of Scheduled Appointments
+	Table0003SLRSLSRequestNewSterilizationLot                       Table0003 = `S28` // SLR/SLS - Request new sterilization lot
+	Table0003SLRSLSRequestSterilizationLotDeletion                  Table0003 = `S29` // SLR/SLS - Request Sterilization lot deletion
+	Table0003STISTSRequestItem                                      Table0003 = `S30` // STI/STS - Request item
+	Table0003SDRSDSRequestAntiMicrobialDevice                       Table0003 = `S31` // SDR/SDS - Request anti-microbial device data
+	Table0003SMDSMSRequestAntiMicrobialDevice                       Table0003 = `S32` // SMD/SMS - Request anti-microbial device cycle data
+	Table0003STCACKNotificationOfSterilizationConfiguration         Table0003 = `S33` // STC/ACK - Notification of sterilization configuration
+	Table0003SLNACKNotificationOfSterilizationLot                   Table0003 = `S34` // SLN/ACK - Notification of sterilization lot
+	Table0003SLNACKNotificationOfSterilizationLot_S35               Table0003 = `S35` // SLN/ACK - Notification of sterilization lot deletion
+	Table0003SDNACKNotificationOfAntiMicrobial                      Table0003 = `S36` // SDN/ACK - Notification of anti-microbial device data
+	Table0003SCNACKNotificationOfAntiMicrobial                      Table0003 = `S37` // SCN/ACK - Notification of anti-microbial device cycle data
+	Table0003MDMACKOriginalDocumentNotification                     Table0003 = `T01` // MDM/ACK - Original document notification
+	Table0003MDMACKOriginalDocumentNotificationAnd                  Table0003 = `T02` // MDM/ACK - Original document notification and content
+	Table0003MDMACKDocumentStatusChangeNotification                 Table0003 = `T03` // MDM/ACK - Document status change notification
+	Table0003MDMACKDocumentStatusChangeNotification_T04             Table0003 = `T04` // MDM/ACK - Document status change notification and content
+	Table0003MDMACKDocumentAddendumNotification                     Table0003 = `T05` // MDM/ACK - Document addendum notification
+	Table0003MDMACKDocumentAddendumNotificationAnd                  Table0003 = `T06` // MDM/ACK - Document addendum notification and content
+	Table0003MDMACKDocumentEditNotification                         Table0003 = `T07` // MDM/ACK - Document edit notification
+	Table0003MDMACKDocumentEditNotificationAnd                      Table0003 = `T08` // MDM/ACK - Document edit notification and content
+	Table0003MDMACKDocumentReplacementNotification                  Table0003 = `T09` // MDM/ACK - Document replacement notification
+	Table0003MDMACKDocumentReplacementNotificationAnd               Table0003 = `T10` // MDM/ACK - Document replacement notification and content
+	Table0003MDMACKDocumentCancelNotification                       Table0003 = `T11` // MDM/ACK - Document cancel notification
+	Table0003QRYDOCDocumentQuery                                    Table0003 = `T12` // QRY/DOC - Document query
+	Table0003ESUACKAutomatedEquipmentStatusUpdate                   Table0003 = `U01` // ESU/ACK - Automated equipment status update
+	Table0003ESRACKAutomatedEquipmentStatusRequest                  Table0003 = `U02` // ESR/ACK - Automated equipment status request
+	Table0003SSUACKSpecimenStatusUpdate                             Table0003 = `U03` // SSU/ACK - Specimen status update
+	Table0003SSRACKSpecimenStatusRequest                            Table0003 = `U04` // SSR/ACK - specimen status request
+	Table0003INUACKAutomatedEquipmentInventoryUpdate                Table0003 = `U05` // INU/ACK  - Automated equipment inventory update
+	Table0003INRACKAutomatedEquipmentInventoryRequest               Table0003 = `U06` // INR/ACK - Automated equipment inventory request
+	Table0003EACACKAutomatedEquipmentCommand                        Table0003 = `U07` // EAC/ACK - Automated equipment command
+	Table0003EARACKAutomatedEquipmentResponse                       Table0003 = `U08` // EAR/ACK - Automated equipment response
+	Table0003EANACKAutomatedEquipmentNotification                   Table0003 = `U09` // EAN/ACK - Automated equipment notification
+	Table0003TCUACKAutomatedEquipmentTestCode                       Table0003 = `U10` // TCU/ACK - Automated equipment test code settings update
+	Table0003TCRACKAutomatedEquipmentTestCode                       Table0003 = `U11` // TCR/ACK - Automated equipment test code settings request
+	Table0003LSUACKAutomatedEquipmentLogService                     Table0003 = `U12` // LSU/ACK - Automated equipment log/service update
+	Table0003LSRACKAutomatedEquipmentLogService                     Table0003 = `U13` // LSR/ACK - Automated equipment log/service request
+	Table0003VXQQueryForVaccinationRecord                           Table0003 = `V01` // VXQ - Query for vaccination record
+	Table0003VXXResponseToVaccinationQueryReturning                 Table0003 = `V02` // VXX - Response to vaccination query returning multiple PID matches
+	Table0003VXRVaccinationRecordResponse                           Table0003 = `V03` // VXR - Vaccination record response
+	Table0003VXUUnsolicitedVaccinationRecordUpdate                  Table0003 = `V04` // VXU - Unsolicited vaccination record update
+	Table0003ORUWaveformResultUnsolicitedTransmissionOf             Table0003 = `W01` // ORU - Waveform result, unsolicited transmission of requested information
+	Table0003QRFWaveformResultResponseToQuery                       Table0003 = `W02` // QRF - Waveform result, response to query
+	Table0003Z73                                                    Table0003 = `Z73`
+	Table0003Z74                                                    Table0003 = `Z74`
+	Table0003Z75                                                    Table0003 = `Z75`
+	Table0003Z76                                                    Table0003 = `Z76`
+	Table0003Z77                                                    Table0003 = `Z77`
+	Table0003Z78                                                    Table0003 = `Z78`
+	Table0003Z79                                                    Table0003 = `Z79`
+	Table0003Z80                                                    Table0003 = `Z80`
+	Table0003Z81                                                    Table0003 = `Z81`
+	Table0003Z82                                                    Table0003 = `Z82`
+	Table0003Z83                                                    Table0003 = `Z83`
+	Table0003Z84                                                    Table0003 = `Z84`
+	Table0003Z85                                                    Table0003 = `Z85`
+	Table0003Z86                                                    Table0003 = `Z86`
+	Table0003Z87                                                    Table0003 = `Z87`
+	Table0003Z88                                                    Table0003 = `Z88`
+	Table0003Z89                                                    Table0003 = `Z89`
+	Table0003Z90                                                    Table0003 = `Z90`
+	Table0003Z91                                                    Table0003 = `Z91`
+	Table0003Z92                                                    Table0003 = `Z92`
+	Table0003Z93                                                    Table0003 = `Z93`
+	Table0003Z94                                                    Table0003 = `Z94`
+	Table0003Z95                                                    Table0003 = `Z95`
+	Table0003Z96                                                    Table0003 = `Z96`
+	Table0003Z97                                                    Table0003 = `Z97`
+	Table0003Z98                                                    Table0003 = `Z98`
+	Table0003Z99                                                    Table0003 = `Z99`
+)
+
+// Description of the value, or empty if the value is not in table 0003.
+func (v Table0003) Description() string {
+	return tableDescription(`0003`, string(v))
+}
+
+// Valid reports if the value is in table 0003.
+func (v Table0003) Valid() bool {
+	return TableValueLookup[`0003`][string(v)]
+}
+
+// Table 0003.
+func (v Table0003) Table() Table {
+	return TableLookup[`0003`]
+}
+
+// Table0004 is a value of table 0004, Patient Class.
+// Fields in this table are strings; convert a field, such as Table0004(v), to use the helpers.
+type Table0004 string
+
+const (
+	Table0004Obstetrics        Table0004 = `B` // Obstetrics
+	Table0004CommercialAccount Table0004 = `C` // Commercial Account
+	Table0004Emergency         Table0004 = `E` // Emergency
+	Table0004Inpatient         Table0004 = `I` // Inpatient
+	Table0004NotApplicable     Table0004 = `N` // Not Applicable
+	Table0004Outpatient        Table0004 = `O` // Outpatient
+	Table0004Preadmit          Table0004 = `P` // Preadmit
+	Table0004RecurringPatient  Table0004 = `R` // Recurring patient
+	Table0004Unknown           Table0004 = `U` // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0004.
+func (v Table0004) Description() string {
+	return tableDescription(`0004`, string(v))
+}
+
+// Valid reports if the value is in table 0004.
+func (v Table0004) Valid() bool {
+	return TableValueLookup[`0004`][string(v)]
+}
+
+// Table 0004.
+func (v Table0004) Table() Table {
+	return TableLookup[`0004`]
+}
+
+// Table0005 is a value of table 0005, Race.
+// Fields in this table are strings; convert a field, such as Table0005(v), to use the helpers.
+type Table0005 string
+
+const (
+	Table0005AmericanIndianOrAlaskaNative         Table0005 = `1002-5` // American Indian or Alaska Native
+	Table0005Asian                                Table0005 = `2028-9` // Asian
+	Table0005BlackOrAfricanAmerican               Table0005 = `2054-5` // Black or African American
+	Table0005NativeHawaiianOrOtherPacificIslander Table0005 = `2076-8` // Native Hawaiian or Other Pacific Islander
+	Table0005White                                Table0005 = `2106-3` // White
+	Table0005OtherRace                            Table0005 = `2131-1` // Other Race
+)
+
+// Description of the value, or empty if the value is not in table 0005.
+func (v Table0005) Description() string {
+	return tableDescription(`0005`, string(v))
+}
+
+// Valid reports if the value is in table 0005.
+func (v Table0005) Valid() bool {
+	return TableValueLookup[`0005`][string(v)]
+}
+
+// Table 0005.
+func (v Table0005) Table() Table {
+	return TableLookup[`0005`]
+}
+
+// Table0006 is a value of table 0006, Religion.
+// Fields in this table are strings; convert a field, such as Table0006(v), to use the helpers.
+type Table0006 string
+
+const (
+	Table0006ChristianAmericanBaptistChurch          Table0006 = `ABC` // Christian: American Baptist Church
+	Table0006Agnostic                                Table0006 = `AGN` // Agnostic
+	Table0006ChristianAfricanMethodistEpiscopalZion  Table0006 = `AME` // Christian: African Methodist Episcopal Zion
+	Table0006ChristianAfricanMethodistEpiscopal      Table0006 = `AMT` // Christian: African Methodist Episcopal
+	Table0006ChristianAnglican                       Table0006 = `ANG` // Christian: Anglican
+	Table0006ChristianAssemblyOfGod                  Table0006 = `AOG` // Christian: Assembly of God
+	Table0006Atheist                                 Table0006 = `ATH` // Atheist
+	Table0006BahaI                                   Table0006 = `BAH` // Baha'i
+	Table0006ChristianBaptist                        Table0006 = `BAP` // Christian: Baptist
+	Table0006BuddhistMahayana                        Table0006 = `BMA` // Buddhist: Mahayana
+	Table0006BuddhistOther                           Table0006 = `BOT` // Buddhist: Other
+	Table0006Brethren                                Table0006 = `BRE` // Brethren
+	Table0006BuddhistTantrayana                      Table0006 = `BTA` // Buddhist: Tantrayana
+	Table0006BuddhistTheravada                       Table0006 = `BTH` // Buddhist: Theravada
+	Table0006Buddhist                                Table0006 = `BUD` // Buddhist
+	Table0006ChristianRomanCatholic                  Table0006 = `CAT` // Christian: Roman Catholic
+	Table0006ChineseFolkReligionist                  Table0006 = `CFR` // Chinese Folk Religionist
+	Table0006Christian                               Table0006 = `CHR` // Christian
+	Table0006ChristianChristianScience               Table0006 = `CHS` // Christian: Christian Science
+	Table0006ChristianChristianMissionaryAlliance    Table0006 = `CMA` // Christian: Christian Missionary Alliance
+	Table0006Confucian                               Table0006 = `CNF` // Confucian
+	Table0006ChristianChurchOfChrist                 Table0006 = `COC` // Christian: Church of Christ
+	Table0006ChristianChurchOfGod                    Table0006 = `COG` // Christian: Church of God
+	Table0006ChristianChurchOfGodInChrist            Table0006 = `COI` // Christian: Church of God in Christ
+	Table0006ChristianCongregational                 Table0006 = `COL` // Christian: Congregational
+	Table0006ChristianCommunity                      Table0006 = `COM` // Christian: Community
+	Table0006ChristianOtherPentecostal               Table0006 = `COP` // Christian: Other Pentecostal
+	Table0006ChristianOther                          Table0006 = `COT` // Christian: Other
+	Table0006ChristianChristianReformed              Table0006 = `CRR` // Christian: Christian Reformed
+	Table0006DisciplesOfChrist                       Table0006 = `DOC` // Disciples of Christ
+	Table0006ChristianEasternOrthodox                Table0006 = `EOT` // Christian: Eastern Orthodox
+	Table0006ChristianEpiscopalian                   Table0006 = `EPI` // Christian: Episcopalian
+	Table0006EthnicReligionist                       Table0006 = `ERL` // Ethnic Religionist
+	Table0006ChristianEvangelicalChurch              Table0006 = `EVC` // Christian: Evangelical Church
+	Table0006ChristianFriends                        Table0006 = `FRQ` // Christian: Friends
+	Table0006ChristianFullGospel                     Table0006 = `FUL` // Christian: Full Gospel
+	Table0006ChristianFreeWillBaptist                Table0006 = `FWB` // Christian: Free Will Baptist
+	Table0006ChristianGreekOrthodox                  Table0006 = `GRE` // Christian: Greek Orthodox
+	Table0006Hindu                                   Table0006 = `HIN` // Hindu
+	Table0006HinduOther                              Table0006 = `HOT` // Hindu: Other
+	Table0006HinduShaivites                          Table0006 = `HSH` // Hindu: Shaivites
+	Table0006HinduVaishnavites                       Table0006 = `HVA` // Hindu: Vaishnavites
+	Table0006Jain                                    Table0006 = `JAI` // Jain
+	Table0006JewishConservative                      Table0006 = `JCO` // Jewish: Conservative
+	Table0006Jewish                                  Table0006 = `JEW` // Jewish
+	Table0006JewishOrthodox                          Table0006 = `JOR` // Jewish: Orthodox
+	Table0006JewishOther                             Table0006 = `JOT` // Jewish: Other
+	Table0006JewishReconstructionist                 Table0006 = `JRC` // Jewish: Reconstructionist
+	Table0006JewishReform                            Table0006 = `JRF` // Jewish: Reform
+	Table0006JewishRenewal                           Table0006 = `JRN` // Jewish: Renewal
+	Table0006ChristianJehovahSWitness                Table0006 = `JWN` // Christian: Jehovah's Witness
+	Table0006ChristianLutheranMissouriSynod          Table0006 = `LMS` // Christian: Lutheran Missouri Synod
+	Table0006ChristianLutheran                       Table0006 = `LUT` // Christian: Lutheran
+	Table0006ChristianMennonite                      Table0006 = `MEN` // Christian: Mennonite
+	Table0006ChristianMethodist                      Table0006 = `MET` // Christian: Methodist
+	Table0006ChristianLatterDaySaints                Table0006 = `MOM` // Christian: Latter-day Saints
+	Table0006Muslim                                  Table0006 = `MOS` // Muslim
+	Table0006MuslimOther                             Table0006 = `MOT` // Muslim: Other
+	Table0006MuslimShiite                            Table0006 = `MSH` // Muslim: Shiite
+	Table0006MuslimSunni                             Table0006 = `MSU` // Muslim: Sunni
+	Table0006NativeAmerican                          Table0006 = `NAM` // Native American
+	Table0006ChristianChurchOfTheNazarene            Table0006 = `NAZ` // Christian: Church of the Nazarene
+	Table0006Nonreligious                            Table0006 = `NOE` // Nonreligious
+	Table0006NewReligionist                          Table0006 = `NRL` // New Religionist
+	Table0006ChristianOrthodox                       Table0006 = `ORT` // Christian: Orthodox
+	Table0006Other                                   Table0006 = `OTH` // Other
+	Table0006ChristianPentecostal                    Table0006 = `PEN` // Christian: Pentecostal
+	Table0006ChristianOtherProtestant                Table0006 = `PRC` // Christian: Other Protestant
+	Table0006ChristianPresbyterian                   Table0006 = `PRE` // Christian: Presbyterian
+	Table0006ChristianProtestant                     Table0006 = `PRO` // Christian: Protestant
+	Table0006ChristianReformedChurch                 Table0006 = `REC` // Christian: Reformed Church
+	Table0006ChristianReorganizedChurchOfJesusChrist Table0006 = `REO` // Christian: Reorganized Church of Jesus Christ-LDS
+	Table0006ChristianSalvationArmy                  Table0006 = `SAA` // Christian: Salvation Army
+	Table0006ChristianSeventhDayAdventist            Table0006 = `SEV` // Christian: Seventh Day Adventist
+	Table0006Shintoist                               Table0006 = `SHN` // Shintoist
+	Table0006Sikh                                    Table0006 = `SIK` // Sikh
+	Table0006ChristianSouthernBaptist                Table0006 = `SOU` // Christian: Southern Baptist
+	Table0006Spiritist                               Table0006 = `SPI` // Spiritist
+	Table0006ChristianUnitedChurchOfChrist           Table0006 = `UCC` // Christian: United Church of Christ
+	Table0006ChristianUnitedMethodist                Table0006 = `UMD` // Christian: United Methodist
+	Table0006ChristianUnitarian                      Table0006 = `UNI` // Christian: Unitarian
+	Table0006ChristianUnitarianUniversalist          Table0006 = `UNU` // Christian: Unitarian Universalist
+	Table0006Unknown                                 Table0006 = `VAR` // Unknown
+	Table0006ChristianWesleyan                       Table0006 = `WES` // Christian: Wesleyan
+	Table0006ChristianWesleyanMethodist              Table0006 = `WMC` // Christian: Wesleyan Methodist
+)
+
+// Description of the value, or empty if the value is not in table 0006.
+func (v Table0006) Description() string {
+	return tableDescription(`0006`, string(v))
+}
+
+// Valid reports if the value is in table 0006.
+func (v Table0006) Valid() bool {
+	return TableValueLookup[`0006`][string(v)]
+}
+
+// Table 0006.
+func (v Table0006) Table() Table {
+	return TableLookup[`0006`]
+}
+
+// Table0007 is a value of table 0007, Admission Type.
+// Fields in this table are strings; convert a field, such as Table0007(v), to use the helpers.
+type Table0007 string
+
+const (
+	Table0007Accident                         Table0007 = `A` // Accident
+	Table0007Elective                         Table0007 = `C` // Elective
+	Table0007Emergency                        Table0007 = `E` // Emergency
+	Table0007LaborAndDelivery                 Table0007 = `L` // Labor and Delivery
+	Table0007NewbornBirthInHealthcareFacility Table0007 = `N` // Newborn (Birth in healthcare facility)
+	Table0007Routine                          Table0007 = `R` // Routine
+	Table0007Urgent                           Table0007 = `U` // Urgent
+)
+
+// Description of the value, or empty if the value is not in table 0007.
+func (v Table0007) Description() string {
+	return tableDescription(`0007`, string(v))
+}
+
+// Valid reports if the value is in table 0007.
+func (v Table0007) Valid() bool {
+	return TableValueLookup[`0007`][string(v)]
+}
+
+// Table 0007.
+func (v Table0007) Table() Table {
+	return TableLookup[`0007`]
+}
+
+// Table0008 is a value of table 0008, Acknowledgment Code.
+// Fields in this table are strings; convert a field, such as Table0008(v), to use the helpers.
+type Table0008 string
+
+const (
+	Table0008OriginalModeApplicationAcceptEnhancedMode    Table0008 = `AA` // Original mode: Application Accept - Enhanced mode: Application acknowledgment: Accept
+	Table0008OriginalModeApplicationErrorEnhancedMode     Table0008 = `AE` // Original mode: Application Error - Enhanced mode: Application acknowledgment: Error
+	Table0008OriginalModeApplicationRejectEnhancedMode    Table0008 = `AR` // Original mode: Application Reject - Enhanced mode: Application acknowledgment: Reject
+	Table0008EnhancedModeAcceptAcknowledgmentCommitAccept Table0008 = `CA` // Enhanced mode: Accept acknowledgment: Commit Accept
+	Table0008EnhancedModeAcceptAcknowledgmentCommitError  Table0008 = `CE` // Enhanced mode: Accept acknowledgment: Commit Error
+	Table0008EnhancedModeAcceptAcknowledgmentCommitReject Table0008 = `CR` // Enhanced mode: Accept acknowledgment: Commit Reject
+)
+
+// Description of the value, or empty if the value is not in table 0008.
+func (v Table0008) Description() string {
+	return tableDescription(`0008`, string(v))
+}
+
+// Valid reports if the value is in table 0008.
+func (v Table0008) Valid() bool {
+	return TableValueLookup[`0008`][string(v)]
+}
+
+// Table 0008.
+func (v Table0008) Table() Table {
+	return TableLookup[`0008`]
+}
+
+// Table0009 is a value of table 0009, Ambulatory Status.
+// Fields in this table are strings; convert a field, such as Table0009(v), to use the helpers.
+type Table0009 string
+
+const (
+	Table0009NoFunctionalLimitations           Table0009 = `A0` // No functional limitations
+	Table0009AmbulatesWithAssistiveDevice      Table0009 = `A1` // Ambulates with assistive device
+	Table0009WheelchairStretcherBound          Table0009 = `A2` // Wheelchair/stretcher bound
+	Table0009ComatoseNonResponsive             Table0009 = `A3` // Comatose; non-responsive
+	Table0009Disoriented                       Table0009 = `A4` // Disoriented
+	Table0009VisionImpaired                    Table0009 = `A5` // Vision impaired
+	Table0009HearingImpaired                   Table0009 = `A6` // Hearing impaired
+	Table0009SpeechImpaired                    Table0009 = `A7` // Speech impaired
+	Table0009NonEnglishSpeaking                Table0009 = `A8` // Non-English speaking
+	Table0009FunctionalLevelUnknown            Table0009 = `A9` // Functional level unknown
+	Table0009OxygenTherapy                     Table0009 = `B1` // Oxygen therapy
+	Table0009SpecialEquipmentTubesIVsCatheters Table0009 = `B2` // Special equipment (tubes, IVs, catheters)
+	Table0009Amputee                           Table0009 = `B3` // Amputee
+	Table0009Mastectomy                        Table0009 = `B4` // Mastectomy
+	Table0009Paraplegic                        Table0009 = `B5` // Paraplegic
+	Table0009Pregnant                          Table0009 = `B6` // Pregnant
+)
+
+// Description of the value, or empty if the value is not in table 0009.
+func (v Table0009) Description() string {
+	return tableDescription(`0009`, string(v))
+}
+
+// Valid reports if the value is in table 0009.
+func (v Table0009) Valid() bool {
+	return TableValueLookup[`0009`][string(v)]
+}
+
+// Table 0009.
+func (v Table0009) Table() Table {
+	return TableLookup[`0009`]
+}
+
+// Table0017 is a value of table 0017, Transaction Type.
+// Fields in this table are strings; convert a field, such as Table0017(v), to use the helpers.
+type Table0017 string
+
+const (
+	Table0017Adjustment Table0017 = `AJ` // Adjustment
+	Table0017Credit     Table0017 = `CD` // Credit
+	Table0017Charge     Table0017 = `CG` // Charge
+	Table0017CoPayment  Table0017 = `CO` // Co-payment
+	Table0017Payment    Table0017 = `PY` // Payment
+)
+
+// Description of the value, or empty if the value is not in table 0017.
+func (v Table0017) Description() string {
+	return tableDescription(`0017`, string(v))
+}
+
+// Valid reports if the value is in table 0017.
+func (v Table0017) Valid() bool {
+	return TableValueLookup[`0017`][string(v)]
+}
+
+// Table 0017.
+func (v Table0017) Table() Table {
+	return TableLookup[`0017`]
+}
+
+// Table0027 is a value of table 0027, Priority.
+// Fields in this table are strings; convert a field, such as Table0027(v), to use the helpers.
+type Table0027 string
+
+const (
+	Table0027AsSoonAsPossibleAPriority   Table0027 = `A` // As soon as possible (a priority lower than stat)
+	Table0027PreoperativeToBeDonePriorTo Table0027 = `P` // Preoperative (to be done prior to surgery)
+	Table0027Routine                     Table0027 = `R` // Routine
+	Table0027StatDoImmediately           Table0027 = `S` // Stat (do immediately)
+	Table0027TimingCriticalDoAsNearAs    Table0027 = `T` // Timing critical (do as near as possible to requested time)
+)
+
+// Description of the value, or empty if the value is not in table 0027.
+func (v Table0027) Description() string {
+	return tableDescription(`0027`, string(v))
+}
+
+// Valid reports if the value is in table 0027.
+func (v Table0027) Valid() bool {
+	return TableValueLookup[`0027`][string(v)]
+}
+
+// Table 0027.
+func (v Table0027) Table() Table {
+	return TableLookup[`0027`]
+}
+
+// Table0038 is a value of table 0038, Order status.
+// Fields in this table are strings; convert a field, such as Table0038(v), to use the helpers.
+type Table0038 string
+
+const (
+	Table0038SomeButNotAllResultsAvailable Table0038 = `A`  // Some, but not all, results available
+	Table0038OrderWasCanceled              Table0038 = `CA` // Order was canceled
+	Table0038OrderIsCompleted              Table0038 = `CM` // Order is completed
+	Table0038OrderWasDiscontinued          Table0038 = `DC` // Order was discontinued
+	Table0038ErrorOrderNotFound            Table0038 = `ER` // Error, order not found
+	Table0038OrderIsOnHold                 Table0038 = `HD` // Order is on hold
+	Table0038InProcessUnspecified          Table0038 = `IP` // In process, unspecified
+	Table0038OrderHasBeenReplaced          Table0038 = `RP` // Order has been replaced
+	Table0038InProcessScheduled            Table0038 = `SC` // In process, scheduled
+)
+
+// Description of the value, or empty if the value is not in table 0038.
+func (v Table0038) Description() string {
+	return tableDescription(`0038`, string(v))
+}
+
+// Valid reports if the value is in table 0038.
+func (v Table0038) Valid() bool {
+	return TableValueLookup[`0038`][string(v)]
+}
+
+// Table 0038.
+func (v Table0038) Table() Table {
+	return TableLookup[`0038`]
+}
+
+// Table0052 is a value of table 0052, Diagnosis Type.
+// Fields in this table are strings; convert a field, such as Table0052(v), to use the helpers.
+type Table0052 string
+
+const (
+	Table0052Admitting Table0052 = `A` // Admitting
+	Table0052Final     Table0052 = `F` // Final
+	Table0052Working   Table0052 = `W` // Working
+)
+
+// Description of the value, or empty if the value is not in table 0052.
+func (v Table0052) Description() string {
+	return tableDescription(`0052`, string(v))
+}
+
+// Valid reports if the value is in table 0052.
+func (v Table0052) Valid() bool {
+	return TableValueLookup[`0052`][string(v)]
+}
+
+// Table 0052.
+func (v Table0052) Table() Table {
+	return TableLookup[`0052`]
+}
+
+// Table0061 is a value of table 0061, Check Digit Scheme.
+// Fields in this table are strings; convert a field, such as Table0061(v), to use the helpers.
+type Table0061 string
+
+const (
+	Table0061BankCardValidationNumber   Table0061 = `BCV` // Bank Card Validation Number
+	Table0061ISO70641983                Table0061 = `ISO` // ISO 7064: 1983
+	Table0061Mod10Algorithm             Table0061 = `M10` // Mod 10 algorithm
+	Table0061Mod11Algorithm             Table0061 = `M11` // Mod 11 algorithm
+	Table0061CheckDigitAlgorithmInTheUS Table0061 = `NPI` // Check digit algorithm in the US National Provider Identifier
+)
+
+// Description of the value, or empty if the value is not in table 0061.
+func (v Table0061) Description() string {
+	return tableDescription(`0061`, string(v))
+}
+
+// Valid reports if the value is in table 0061.
+func (v Table0061) Valid() bool {
+	return TableValueLookup[`0061`][string(v)]
+}
+
+// Table 0061.
+func (v Table0061) Table() Table {
+	return TableLookup[`0061`]
+}
+
+// Table0062 is a value of table 0062, Event Reason.
+// Fields in this table are strings; convert a field, such as Table0062(v), to use the helpers.
+type Table0062 string
+
+const (
+	Table0062PatientRequest                   Table0062 = `01` // Patient request
+	Table0062PhysicianHealthPractitionerOrder Table0062 = `02` // Physician/health practitioner order
+	Table0062CensusManagement                 Table0062 = `03` // Census management
+	Table0062Other                            Table0062 = `O`  // Other
+	Table0062Unknown                          Table0062 = `U`  // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0062.
+func (v Table0062) Description() string {
+	return tableDescription(`0062`, string(v))
+}
+
+// Valid reports if the value is in table 0062.
+func (v Table0062) Valid() bool {
+	return TableValueLookup[`0062`][string(v)]
+}
+
+// Table 0062.
+func (v Table0062) Table() Table {
+	return TableLookup[`0062`]
+}
+
+// Table0063 is a value of table 0063, Relationship.
+// Fields in this table are strings; convert a field, such as Table0063(v), to use the helpers.
+type Table0063 string
+
+const (
+	Table0063Associate            Table0063 = `ASC` // Associate
+	Table0063Brother              Table0063 = `BRO` // Brother
+	Table0063CareGiver            Table0063 = `CGV` // Care giver
+	Table0063Child                Table0063 = `CHD` // Child
+	Table0063HandicappedDependent Table0063 = `DEP` // Handicapped dependent
+	Table0063LifePartner          Table0063 = `DOM` // Life partner
+	Table0063EmergencyContact     Table0063 = `EMC` // Emergency contact
+	Table0063Employee             Table0063 = `EME` // Employee
+	Table0063Employer             Table0063 = `EMR` // Employer
+	Table0063ExtendedFamily       Table0063 = `EXF` // Extended family
+	Table0063FosterChild          Table0063 = `FCH` // Foster child
+	Table0063Friend               Table0063 = `FND` // Friend
+	Table0063Father               Table0063 = `FTH` // Father
+	Table0063Grandchild           Table0063 = `GCH` // Grandchild
+	Table0063Guardian             Table0063 = `GRD` // Guardian
+	Table0063Grandparent          Table0063 = `GRP` // Grandparent
+	Table0063Manager              Table0063 = `MGR` // Manager
+	Table0063Mother               Table0063 = `MTH` // Mother
+	Table0063NaturalChild         Table0063 = `NCH` // Natural child
+	Table0063None                 Table0063 = `NON` // None
+	Table0063OtherAdult           Table0063 = `OAD` // Other adult
+	Table0063Other                Table0063 = `OTH` // Other
+	Table0063Owner                Table0063 = `OWN` // Owner
+	Table0063Parent               Table0063 = `PAR` // Parent
+	Table0063Stepchild            Table0063 = `SCH` // Stepchild
+	Table0063Self                 Table0063 = `SEL` // Self
+	Table0063Sibling              Table0063 = `SIB` // Sibling
+	Table0063Sister               Table0063 = `SIS` // Sister
+	Table0063Spouse               Table0063 = `SPO` // Spouse
+	Table0063Trainer              Table0063 = `TRA` // Trainer
+	Table0063Unknown              Table0063 = `UNK` // Unknown
+	Table0063WardOfCourt          Table0063 = `WRD` // Ward of court
+)
+
+// Description of the value, or empty if the value is not in table 0063.
+func (v Table0063) Description() string {
+	return tableDescription(`0063`, string(v))
+}
+
+// Valid reports if the value is in table 0063.
+func (v Table0063) Valid() bool {
+	return TableValueLookup[`0063`][string(v)]
+}
+
+// Table 0063.
+func (v Table0063) Table() Table {
+	return TableLookup[`0063`]
+}
+
+// Table0065 is a value of table 0065, Specimen Action Code.
+// Fields in this table are strings; convert a field, such as Table0065(v), to use the helpers.
+type Table0065 string
+
+const (
+	Table0065AddOrderedTestsToTheExisting       Table0065 = `A` // Add ordered tests to the existing specimen
+	Table0065GeneratedOrderReflexOrder          Table0065 = `G` // Generated order; reflex order
+	Table0065LabToObtainSpecimenFromPatient     Table0065 = `L` // Lab to obtain specimen from patient
+	Table0065SpecimenObtainedByServiceOtherThan Table0065 = `O` // Specimen obtained by service other than Lab
+	Table0065PendingSpecimenOrderSentPriorTo    Table0065 = `P` // Pending specimen; Order sent prior to delivery
+	Table0065RevisedOrder                       Table0065 = `R` // Revised order
+	Table0065ScheduleTheTestsSpecifiedBelow     Table0065 = `S` // Schedule the tests specified below
+)
+
+// Description of the value, or empty if the value is not in table 0065.
+func (v Table0065) Description() string {
+	return tableDescription(`0065`, string(v))
+}
+
+// Valid reports if the value is in table 0065.
+func (v Table0065) Valid() bool {
+	return TableValueLookup[`0065`][string(v)]
+}
+
+// Table 0065.
+func (v Table0065) Table() Table {
+	return TableLookup[`0065`]
+}
+
+// Table0066 is a value of table 0066, Employment Status.
+// Fields in this table are strings; convert a field, such as Table0066(v), to use the helpers.
+type Table0066 string
+
+const (
+	Table0066FullTimeEmployed       Table0066 = `1` // Full time employed
+	Table0066PartTimeEmployed       Table0066 = `2` // Part time employed
+	Table0066Unemployed             Table0066 = `3` // Unemployed
+	Table0066SelfEmployed           Table0066 = `4` // Self-employed,
+	Table0066Retired                Table0066 = `5` // Retired
+	Table0066OnActiveMilitaryDuty   Table0066 = `6` // On active military duty
+	Table0066Unknown                Table0066 = `9` // Unknown
+	Table0066ContractPerDiem        Table0066 = `C` // Contract, per diem
+	Table0066LeaveOfAbsenceEGFamily Table0066 = `L` // Leave of absence (e.g., family leave, sabbatical, etc.)
+	Table0066Other                  Table0066 = `O` // Other
+	Table0066TemporarilyUnemployed  Table0066 = `T` // Temporarily unemployed
+)
+
+// Description of the value, or empty if the value is not in table 0066.
+func (v Table0066) Description() string {
+	return tableDescription(`0066`, string(v))
+}
+
+// Valid reports if the value is in table 0066.
+func (v Table0066) Valid() bool {
+	return TableValueLookup[`0066`][string(v)]
+}
+
+// Table 0066.
+func (v Table0066) Table() Table {
+	return TableLookup[`0066`]
+}
+
+// Table0069 is a value of table 0069, Hospital Service.
+// Fields in this table are strings; convert a field, such as Table0069(v), to use the helpers.
+type Table0069 string
+
+const (
+	Table0069CardiacService   Table0069 = `CAR` // Cardiac Service
+	Table0069MedicalService   Table0069 = `MED` // Medical Service
+	Table0069PulmonaryService Table0069 = `PUL` // Pulmonary Service
+	Table0069SurgicalService  Table0069 = `SUR` // Surgical Service
+	Table0069UrologyService   Table0069 = `URO` // Urology Service
+)
+
+// Description of the value, or empty if the value is not in table 0069.
+func (v Table0069) Description() string {
+	return tableDescription(`0069`, string(v))
+}
+
+// Valid reports if the value is in table 0069.
+func (v Table0069) Valid() bool {
+	return TableValueLookup[`0069`][string(v)]
+}
+
+// Table 0069.
+func (v Table0069) Table() Table {
+	return TableLookup[`0069`]
+}
+
+// Table0074 is a value of table 0074, Diagnostic Service Section ID.
+// Fields in this table are strings; convert a field, such as Table0074(v), to use the helpers.
+type Table0074 string
+
+const (
+	Table0074Audiology                     Table0074 = `AU`  // Audiology
+	Table0074BloodGases                    Table0074 = `BG`  // Blood Gases
+	Table0074BloodBank                     Table0074 = `BLB` // Blood Bank
+	Table0074Chemistry                     Table0074 = `CH`  // Chemistry
+	Table0074Cytopathology                 Table0074 = `CP`  // Cytopathology
+	Table0074CATScan                       Table0074 = `CT`  // CAT Scan
+	Table0074CardiacCatheterization        Table0074 = `CTH` // Cardiac Catheterization
+	Table0074CardiacUltrasound             Table0074 = `CUS` // Cardiac Ultrasound
+	Table0074ElectrocardiacEGEKGEECHolter  Table0074 = `EC`  // Electrocardiac (e.g., EKG,  EEC, Holter)
+	Table0074ElectroneuroEEGEMGEPPSG       Table0074 = `EN`  // Electroneuro (EEG, EMG,EP,PSG)
+	Table0074Hematology                    Table0074 = `HM`  // Hematology
+	Table0074BedsideICUMonitoring          Table0074 = `ICU` // Bedside ICU Monitoring
+	Table0074Immunology                    Table0074 = `IMM` // Immunology
+	Table0074Laboratory                    Table0074 = `LAB` // Laboratory
+	Table0074Microbiology                  Table0074 = `MB`  // Microbiology
+	Table0074Mycobacteriology              Table0074 = `MCB` // Mycobacteriology
+	Table0074Mycology                      Table0074 = `MYC` // Mycology
+	Table0074NuclearMagneticResonance      Table0074 = `NMR` // Nuclear Magnetic Resonance
+	Table0074NuclearMedicineScan           Table0074 = `NMS` // Nuclear Medicine Scan
+	Table0074NursingServiceMeasures        Table0074 = `NRS` // Nursing Service Measures
+	Table0074OutsideLab                    Table0074 = `OSL` // Outside Lab
+	Table0074OccupationalTherapy           Table0074 = `OT`  // Occupational Therapy
+	Table0074Other                         Table0074 = `OTH` // Other
+	Table0074OBUltrasound                  Table0074 = `OUS` // OB Ultrasound
+	Table0074PulmonaryFunction             Table0074 = `PF`  // Pulmonary Function
+	Table0074Pharmacy                      Table0074 = `PHR` // Pharmacy
+	Table0074PhysicianHxDxAdmissionNoteEtc Table0074 = `PHY` // Physician (Hx. Dx, admission note, etc.)
+	Table0074PhysicalTherapy               Table0074 = `PT`  // Physical Therapy
+	Table0074Radiology                     Table0074 = `RAD` // Radiology
+	Table0074RespiratoryCareTherapy        Table0074 = `RC`  // Respiratory Care (therapy)
+	Table0074RadiationTherapy              Table0074 = `RT`  // Radiation Therapy
+	Table0074RadiologyUltrasound           Table0074 = `RUS` // Radiology Ultrasound
+	Table0074Radiograph                    Table0074 = `RX`  // Radiograph
+	Table0074SurgicalPathology             Table0074 = `SP`  // Surgical Pathology
+	Table0074Serology                      Table0074 = `SR`  // Serology
+	Table0074Toxicology                    Table0074 = `TX`  // Toxicology
+	Table0074Virology                      Table0074 = `VR`  // Virology
+	Table0074VascularUltrasound            Table0074 = `VUS` // Vascular Ultrasound
+	Table0074Cineradiograph                Table0074 = `XRC` // Cineradiograph
+)
+
+// Description of the value, or empty if the value is not in table 0074.
+func (v Table0074) Description() string {
+	return tableDescription(`0074`, string(v))
+}
+
+// Valid reports if the value is in table 0074.
+func (v Table0074) Valid() bool {
+	return TableValueLookup[`0074`][string(v)]
+}
+
+// Table 0074.
+func (v Table0074) Table() Table {
+	return TableLookup[`0074`]
+}
+
+// Table0076 is a value of table 0076, Message Type.
+// Fields in this table are strings; convert a field, such as Table0076(v), to use the helpers.
+type Table0076 string
+
+const (
+	Table0076GeneralAcknowledgmentMessage                             Table0076 = `ACK` // General acknowledgment message
+	Table0076ADTResponse                                              Table0076 = `ADR` // ADT response
+	Table0076ADTMessage                                               Table0076 = `ADT` // ADT message
+	Table0076AddChangeBillingAccount                                  Table0076 = `BAR` // Add/change billing account
+	Table0076BloodProductDispenseStatusMessage                        Table0076 = `BPS` // Blood product dispense status message
+	Table0076BloodProductDispenseStatusAcknowledgementMessage         Table0076 = `BRP` // Blood product dispense status acknowledgement message
+	Table0076BloodProductTransfusionDispositionAcknowledgementMessage Table0076 = `BRT` // Blood product transfusion/disposition acknowledgement message
+	Table0076BloodProductTransfusionDispositionMessage                Table0076 = `BTS` // Blood product transfusion/disposition message
+	Table0076CollaborativeCareFetch                                   Table0076 = `CCF` // Collaborative Care Fetch
+	Table0076CollaborativeCareInformation                             Table0076 = `CCI` // Collaborative Care Information
+	Table0076CollaborativeCareMessage                                 Table0076 = `CCM` // Collaborative Care Message
+	Table0076CollaborativeCareReferral                                Table0076 = `CCQ` // Collaborative Care Referral
+	Table0076CollaborativeCareReferral_CCU                            Table0076 = `CCU` // Collaborative Care Referral
+	Table0076CollaborativeCareReferral_CQU                            Table0076 = `CQU` // Collaborative Care Referral
+	Table0076ClinicalStudyRegistrationMessage                         Table0076 = `CRM` // Clinical study registration message
+	Table0076UnsolicitedStudyDataMessage                              Table0076 = `CSU` // Unsolicited study data message
+	Table0076DetailFinancialTransactions                              Table0076 = `DFT` // Detail financial transactions
+	Table0076DocumentResponse                                         Table0076 = `DOC` // Document response
+	Table0076DisplayResponse                                          Table0076 = `DSR` // Display response
+	Table0076AutomatedEquipmentCommandMessage                         Table0076 = `EAC` // Automated equipment command message
+	Table0076AutomatedEquipmentNotificationMessage                    Table0076 = `EAN` // Automated equipment notification message
+	Table0076AutomatedEquipmentResponseMessage                        Table0076 = `EAR` // Automated equipment response message
+	Table0076HealthCareInvoice                                        Table0076 = `EHC` // Health Care Invoice
+	Table0076AutomatedEquipmentStatusUpdateAcknowledgmentMessage      Table0076 = `ESR` // Automated equipment status update acknowledgment message
+	Table0076AutomatedEquipmentStatusUpdateMessage                    Table0076 = `ESU` // Automated equipment status update message
+	Table0076AutomatedEquipmentInventoryRequestMessage                Table0076 = `INR` // Automated equipment inventory request message
+	Table0076AutomatedEquipmentInventoryUpdateMessage                 Table0076 = `INU` // Automated equipment inventory update message
+	Table0076AutomatedEquipmentLogServiceRequestMessage               Table0076 = `LSR` // Automated equipment log/service request message
+	Table0076AutomatedEquipmentLogServiceUpdateMessage                Table0076 = `LSU` // Automated equipment log/service update message
+	Table0076MedicalDocumentManagement                                Table0076 = `MDM` // Medical document management
+	Table0076MasterFilesDelayedApplicationAcknowledgment              Table0076 = `MFD` // Master files delayed application acknowledgment
+	Table0076MasterFilesApplicationAcknowledgment                     Table0076 = `MFK` // Master files application acknowledgment
+	Table0076MasterFilesNotification                                  Table0076 = `MFN` // Master files notification
+	Table0076MasterFilesQuery                                         Table0076 = `MFQ` // Master files query
+	Table0076MasterFilesResponse                                      Table0076 = `MFR` // Master files response
+	Table0076ApplicationManagementDataMessage                         Table0076 = `NMD` // Application management data message
+	Table0076ApplicationManagementQueryMessage                        Table0076 = `NMQ` // Application management query message
+	Table0076ApplicationManagementResponseMessage                     Table0076 = `NMR` // Application management response message
+	Table0076BloodProductOrderMessage                                 Table0076 = `OMB` // Blood product order message
+	Table0076DietaryOrder                                             Table0076 = `OMD` // Dietary order
+	Table0076GeneralClinicalOrderMessage                              Table0076 = `OMG` // General clinical order message
+	Table0076ImagingOrder                                             Table0076 = `OMI` // Imaging order
+	Table0076LaboratoryOrderMessage                                   Table0076 = `OML` // Laboratory order message
+	Table0076NonStockRequisitionOrderMessage                          Table0076 = `OMN` // Non-stock requisition order message
+	Table0076PharmacyTreatmentOrderMessage                            Table0076 = `OMP` // Pharmacy/treatment order message
+	Table0076StockRequisitionOrderMessage                             Table0076 = `OMS` // Stock requisition order message
+	Table0076PopulationLocationBasedLaboratoryOrderMessage            Table0076 = `OPL` // Population/Location-Based Laboratory Order Message
+	Table0076PopulationLocationBasedLaboratoryOrderAcknowledgment     Table0076 = `OPR` // Population/Location-Based Laboratory Order Acknowledgment Message
+	Table0076UnsolicitedPopulationLocationBasedLaboratoryObservation  Table0076 = `OPU` // Unsolicited Population/Location-Based Laboratory Observation Message
+	Table0076ObservationReportAcknowledgment                          Table0076 = `ORA` // Observation Report  Acknowledgment
+	Table0076BloodProductOrderAcknowledgementMessage                  Table0076 = `ORB` // Blood product order acknowledgement message
+	Table0076DietaryOrderAcknowledgmentMessage                        Table0076 = `ORD` // Dietary order acknowledgment message
+	Table0076QueryForResultsOfObservation                             Table0076 = `ORF` // Query for results of observation
+	Table0076GeneralClinicalOrderAcknowledgmentMessage                Table0076 = `ORG` // General clinical order acknowledgment message
+	Table0076ImagingOrderAcknowledgementMessage                       Table0076 = `ORI` // Imaging order acknowledgement message
+	Table0076LaboratoryAcknowledgmentMessageUnsolicited               Table0076 = `ORL` // Laboratory acknowledgment message (unsolicited)
+	Table0076PharmacyTreatmentOrderMessage_ORM                        Table0076 = `ORM` // Pharmacy/treatment order message
+	Table0076NonStockRequisitionGeneralOrderAcknowledgment            Table0076 = `ORN` // Non-stock requisition - General order acknowledgment message
+	Table0076PharmacyTreatmentOrderAcknowledgmentMessage              Table0076 = `ORP` // Pharmacy/treatment order acknowledgment message
+	Table0076GeneralOrderResponseMessageResponseTo                    Table0076 = `ORR` // General order response message response to any ORM
+	Table0076StockRequisitionOrderAcknowledgmentMessage               Table0076 = `ORS` // Stock requisition - Order acknowledgment message
+	Table0076UnsolicitedTransmissionOfAnObservationMessage            Table0076 = `ORU` // Unsolicited transmission of an observation message
+	Table0076SpecimenShipmentMessage                                  Table0076 = `OSM` // Specimen Shipment Message
+	Table0076QueryResponseForOrderStatus                              Table0076 = `OSQ` // Query response for order status
+	Table0076QueryResponseForOrderStatus_OSR                          Table0076 = `OSR` // Query response for order status
+	Table0076UnsolicitedLaboratoryObservationMessage                  Table0076 = `OUL` // Unsolicited laboratory observation message
+	Table0076ProductExperienceMessage                                 Table0076 = `PEX` // Product experience message
+	Table0076PatientGoalMessage                                       Table0076 = `PGL` // Patient goal message
+	Table0076PatientInsuranceInformation                              Table0076 = `PIN` // Patient insurance information
+	Table0076AddPersonnelRecord                                       Table0076 = `PMU` // Add personnel record
+	Table0076PatientPathwayMessageGoalOriented                        Table0076 = `PPG` // Patient pathway message (goal-oriented)
+	Table0076PatientPathwayMessageProblemOriented                     Table0076 = `PPP` // Patient pathway message (problem-oriented)
+	Table0076PatientProblemMessage                                    Table0076 = `PPR` // Patient problem message
+	Table0076PatientPathwayGoalOrientedResponse                       Table0076 = `PPT` // Patient pathway goal-oriented response
+	Table0076PatientGoalResponse                                      Table0076 = `PPV` // Patient goal response
+	Table0076PatientProblemResponse                                   Table0076 = `PRR` // Patient problem response
+	Table0076PatientPathwayProblemOrientedResponse                    Table0076 = `PTR` // Patient pathway problem-oriented response
+	Table0076QueryByParameter                                         Table0076 = `QBP` // Query by parameter
+	Table0076DeferredQuery                                            Table0076 = `QCK` // Deferred query
+	Table0076CancelQuery                                              Table0076 = `QCN` // Cancel query
+	Table0076QueryOriginalMode                                        Table0076 = `QRY` // Query, original mode
+	Table0076CreateSubscription                                       Table0076 = `QSB` // Create subscription
+	Table0076CancelSubscriptionAcknowledgeMessage                     Table0076 = `QSX` // Cancel subscription/acknowledge message
+	Table0076QueryForPreviousEvents                                   Table0076 = `QVR` // Query for previous events
+	Table0076PharmacyTreatmentAdministrationInformation               Table0076 = `RAR` // Pharmacy/treatment administration information
+	Table0076PharmacyTreatmentAdministrationMessage                   Table0076 = `RAS` // Pharmacy/treatment administration message
+	Table0076ReturnClinicalInformation                                Table0076 = `RCI` // Return clinical information
+	Table0076ReturnClinicalList                                       Table0076 = `RCL` // Return clinical list
+	Table0076PharmacyTreatmentEncodedOrderMessage                     Table0076 = `RDE` // Pharmacy/treatment encoded order message
+	Table0076PharmacyTreatmentDispenseInformation                     Table0076 = `RDR` // Pharmacy/treatment dispense information
+	Table0076PharmacyTreatmentDispenseMessage                         Table0076 = `RDS` // Pharmacy/treatment dispense message
+	Table0076DisplayBasedResponse                                     Table0076 = `RDY` // Display based response
+	Table0076PatientReferral                                          Table0076 = `REF` // Patient referral
+	Table0076PharmacyTreatmentEncodedOrderInformation                 Table0076 = `RER` // Pharmacy/treatment encoded order information
+	Table0076PharmacyTreatmentDoseInformation                         Table0076 = `RGR` // Pharmacy/treatment dose information
+	Table0076PharmacyTreatmentGiveMessage                             Table0076 = `RGV` // Pharmacy/treatment give message
+	Table0076PharmacyTreatmentOrderResponse                           Table0076 = `ROR` // Pharmacy/treatment order response
+	Table0076ReturnPatientAuthorization                               Table0076 = `RPA` // Return patient authorization
+	Table0076ReturnPatientInformation                                 Table0076 = `RPI` // Return patient information
+	Table0076ReturnPatientDisplayList                                 Table0076 = `RPL` // Return patient display list
+	Table0076ReturnPatientList                                        Table0076 = `RPR` // Return patient list
+	Table0076RequestPatientAuthorization                              Table0076 = `RQA` // Request patient authorization
+	Table0076RequestClinicalInformation                               Table0076 = `RQC` // Request clinical information
+	Table0076RequestPatientInformation                                Table0076 = `RQI` // Request patient information
+	Table0076RequestPatientDemographics                               Table0076 = `RQP` // Request patient demographics
+	Table0076PharmacyTreatmentAdministrationAcknowledgmentMessage     Table0076 = `RRA` // Pharmacy/treatment administration acknowledgment message
+	Table0076PharmacyTreatmentDispenseAcknowledgmentMessage           Table0076 = `RRD` // Pharmacy/treatment dispense acknowledgment message
+	Table0076PharmacyTreatmentEncodedOrderAcknowledgmentMessage       Table0076 = `RRE` // Pharmacy/treatment encoded order acknowledgment message
+	Table0076PharmacyTreatmentGiveAcknowledgmentMessage               Table0076 = `RRG` // Pharmacy/treatment give acknowledgment message
+	Table0076ReturnReferralInformation                                Table0076 = `RRI` // Return referral information
+	Table0076SegmentPatternResponse                                   Table0076 = `RSP` // Segment pattern response
+	Table0076TabularResponse                                          Table0076 = `RTB` // Tabular response
+	Table0076NotificationOfAntiMicrobialDeviceCycle                   Table0076 = `SCN` // Notification of Anti-Microbial Device Cycle Data
+	Table0076NotificationOfAntiMicrobialDeviceData                    Table0076 = `SDN` // Notification of Anti-Microbial Device Data
+	Table0076SterilizationAntiMicrobialDeviceDataRequest              Table0076 = `SDR` // Sterilization anti-microbial device data request
+	Table0076ScheduleInformationUnsolicited                           Table0076 = `SIU` // Schedule information unsolicited
+	Table0076NotificationOfNewSterilizationLot                        Table0076 = `SLN` // Notification of New Sterilization Lot
+	Table0076SterilizationLotRequest                                  Table0076 = `SLR` // Sterilization lot request
+	Table0076SterilizationAntiMicrobialDeviceCycleData                Table0076 = `SMD` // Sterilization anti-microbial device cycle data request
+	Table0076ScheduleQueryMessage                                     Table0076 = `SQM` // Schedule query message
+	Table0076ScheduleQueryResponse                                    Table0076 = `SQR` // Schedule query response
+	Table0076ScheduleRequestMessage                                   Table0076 = `SRM` // Schedule request message
+	Table0076ScheduledRequestResponse                                 Table0076 = `SRR` // Scheduled request response
+	Table0076SpecimenStatusRequestMessage                             Table0076 = `SSR` // Specimen status request message
+	Table0076SpecimenStatusUpdateMessage                              Table0076 = `SSU` // Specimen status update message
+	Table0076NotificationOfSterilizationConfiguration                 Table0076 = `STC` // Notification of Sterilization Configuration
+	Table0076SterilizationItemRequest                                 Table0076 = `STI` // Sterilization item request
+	Table0076SummaryProductExperienceReport                           Table0076 = `SUR` // Summary product experience report
+	Table0076TabularDataResponse                                      Table0076 = `TBR` // Tabular data response
+	Table0076AutomatedEquipmentTestCodeSettingsRequest                Table0076 = `TCR` // Automated equipment test code settings request message
+	Table0076AutomatedEquipmentTestCodeSettingsUpdate                 Table0076 = `TCU` // Automated equipment test code settings update message
+	Table0076UnsolicitedDisplayUpdateMessage                          Table0076 = `UDM` // Unsolicited display update message
+	Table0076QueryForVaccinationRecord                                Table0076 = `VXQ` // Query for vaccination record
+	Table0076VaccinationRecordResponse                                Table0076 = `VXR` // Vaccination record response
+	Table0076UnsolicitedVaccinationRecordUpdate                       Table0076 = `VXU` // Unsolicited vaccination record update
+	Table0076ResponseForVaccinationQueryWithMultiple                  Table0076 = `VXX` // Response for vaccination query with multiple PID matches
+)
+
+// Description of the value, or empty if the value is not in table 0076.
+func (v Table0076) Description() string {
+	return tableDescription(`0076`, string(v))
+}
+
+// Valid reports if the value is in table 0076.
+func (v Table0076) Valid() bool {
+	return TableValueLookup[`0076`][string(v)]
+}
+
+// Table 0076.
+func (v Table0076) Table() Table {
+	return TableLookup[`0076`]
+}
+
+// Table0080 is a value of table 0080, Nature of Abnormal Testing.
+// Fields in this table are strings; convert a field, such as Table0080(v), to use the helpers.
+type Table0080 string
+
+const (
+	Table0080AnAgeBasedPopulation   Table0080 = `A`  // An age-based population
+	Table0080Breed                  Table0080 = `B`  // Breed
+	Table0080NoneGenericNormalRange Table0080 = `N`  // None - generic normal range
+	Table0080ARaceBasedPopulation   Table0080 = `R`  // A race-based population
+	Table0080ASexBasedPopulation    Table0080 = `S`  // A sex-based population
+	Table0080Species                Table0080 = `SP` // Species
+	Table0080Strain                 Table0080 = `ST` // Strain
+)
+
+// Description of the value, or empty if the value is not in table 0080.
+func (v Table0080) Description() string {
+	return tableDescription(`0080`, string(v))
+}
+
+// Valid reports if the value is in table 0080.
+func (v Table0080) Valid() bool {
+	return TableValueLookup[`0080`][string(v)]
+}
+
+// Table 0080.
+func (v Table0080) Table() Table {
+	return TableLookup[`0080`]
+}
+
+// Table0083 is a value of table 0083, Outlier Type.
+// Fields in this table are strings; convert a field, such as Table0083(v), to use the helpers.
+type Table0083 string
+
+const (
+	Table0083OutlierCost Table0083 = `C` // Outlier cost
+	Table0083OutlierDays Table0083 = `D` // Outlier days
+)
+
+// Description of the value, or empty if the value is not in table 0083.
+func (v Table0083) Description() string {
+	return tableDescription(`0083`, string(v))
+}
+
+// Valid reports if the value is in table 0083.
+func (v Table0083) Valid() bool {
+	return TableValueLookup[`0083`][string(v)]
+}
+
+// Table 0083.
+func (v Table0083) Table() Table {
+	return TableLookup[`0083`]
+}
+
+// Table0085 is a value of table 0085, Observation Result Status Codes Interpretation.
+// Fields in this table are strings; convert a field, such as Table0085(v), to use the helpers.
+type Table0085 string
+
+const (
+	Table0085RecordComingOverIsACorrection           Table0085 = `C` // Record coming over is a correction and thus replaces a final result
+	Table0085DeletesTheOBXRecord                     Table0085 = `D` // Deletes the OBX record
+	Table0085FinalResultsCanOnlyBeChanged            Table0085 = `F` // Final results; Can only be changed with a corrected result.
+	Table0085SpecimenInLabResultsPending             Table0085 = `I` // Specimen in lab; results pending
+	Table0085NotAskedUsedToAffirmativelyDocument     Table0085 = `N` // Not asked; used to affirmatively document that the observation identified in the OBX was not sought when the universal service ID in OBR-4 implies that it would be sought.
+	Table0085OrderDetailDescriptionOnlyNoResult      Table0085 = `O` // Order detail description only (no result)
+	Table0085PreliminaryResults                      Table0085 = `P` // Preliminary results
+	Table0085ResultsEnteredNotVerified               Table0085 = `R` // Results entered -- not verified
+	Table0085PartialResultsDeprecatedRetainedOnlyFor Table0085 = `S` // Partial results.   Deprecated. Retained only for backward compatibility as of V2.6.
+	Table0085ResultsStatusChangeToFinalWithout       Table0085 = `U` // Results status change to final without retransmitting results already sent as 'preliminary.'  E.g., radiology changes status from preliminary to final
+	Table0085PostOriginalAsWrongEG                   Table0085 = `W` // Post original as wrong, e.g., transmitted for wrong patient
+	Table0085ResultsCannotBeObtainedForThis          Table0085 = `X` // Results cannot be obtained for this observation
+)
+
+// Description of the value, or empty if the value is not in table 0085.
+func (v Table0085) Description() string {
+	return tableDescription(`0085`, string(v))
+}
+
+// Valid reports if the value is in table 0085.
+func (v Table0085) Valid() bool {
+	return TableValueLookup[`0085`][string(v)]
+}
+
+// Table 0085.
+func (v Table0085) Table() Table {
+	return TableLookup[`0085`]
+}
+
+// Table0091 is a value of table 0091, Query Priority.
+// Fields in this table are strings; convert a field, such as Table0091(v), to use the helpers.
+type Table0091 string
+
+const (
+	Table0091Deferred  Table0091 = `D` // Deferred
+	Table0091Immediate Table0091 = `I` // Immediate
+)
+
+// Description of the value, or empty if the value is not in table 0091.
+func (v Table0091) Description() string {
+	return tableDescription(`0091`, string(v))
+}
+
+// Valid reports if the value is in table 0091.
+func (v Table0091) Valid() bool {
+	return TableValueLookup[`0091`][string(v)]
+}
+
+// Table 0091.
+func (v Table0091) Table() Table {
+	return TableLookup[`0091`]
+}
+
+// Table0092 is a value of table 0092, Re-Admission Indicator.
+// Fields in this table are strings; convert a field, such as Table0092(v), to use the helpers.
+type Table0092 string
+
+const (
+	Table0092ReAdmission Table0092 = `R` // Re-admission
+)
+
+// Description of the value, or empty if the value is not in table 0092.
+func (v Table0092) Description() string {
+	return tableDescription(`0092`, string(v))
+}
+
+// Valid reports if the value is in table 0092.
+func (v Table0092) Valid() bool {
+	return TableValueLookup[`0092`][string(v)]
+}
+
+// Table 0092.
+func (v Table0092) Table() Table {
+	return TableLookup[`0092`]
+}
+
+// Table0093 is a value of table 0093, Release Information.
+// Fields in this table are strings; convert a field, such as Table0093(v), to use the helpers.
+type Table0093 string
+
+const (
+	Table0093No  Table0093 = `N` // No
+	Table0093Yes Table0093 = `Y` // Yes
+)
+
+// Description of the value, or empty if the value is not in table 0093.
+func (v Table0093) Description() string {
+	return tableDescription(`0093`, string(v))
+}
+
+// Valid reports if the value is in table 0093.
+func (v Table0093) Valid() bool {
+	return TableValueLookup[`0093`][string(v)]
+}
+
+// Table 0093.
+func (v Table0093) Table() Table {
+	return TableLookup[`0093`]
+}
+
+// Table0098 is a value of table 0098, Type of Agreement.
+// Fields in this table are strings; convert a field, such as Table0098(v), to use the helpers.
+type Table0098 string
+
+const (
+	Table0098Maternity Table0098 = `M` // Maternity
+	Table0098Standard  Table0098 = `S` // Standard
+	Table0098Unified   Table0098 = `U` // Unified
+)
+
+// Description of the value, or empty if the value is not in table 0098.
+func (v Table0098) Description() string {
+	return tableDescription(`0098`, string(v))
+}
+
+// Valid reports if the value is in table 0098.
+func (v Table0098) Valid() bool {
+	return TableValueLookup[`0098`][string(v)]
+}
+
+// Table 0098.
+func (v Table0098) Table() Table {
+	return TableLookup[`0098`]
+}
+
+// Table0100 is a value of table 0100, Invocation event.
+// Fields in this table are strings; convert a field, such as Table0100(v), to use the helpers.
+type Table0100 string
+
+const (
+	Table0100OnDischarge              Table0100 = `D` // On discharge
+	Table0100OnReceiptOfOrder         Table0100 = `O` // On receipt of order
+	Table0100AtTimeServiceIsCompleted Table0100 = `R` // At time service is completed
+	Table0100AtTimeServiceIsStarted   Table0100 = `S` // At time service is started
+	Table0100AtADesignatedDateTime    Table0100 = `T` // At a designated date/time
+)
+
+// Description of the value, or empty if the value is not in table 0100.
+func (v Table0100) Description() string {
+	return tableDescription(`0100`, string(v))
+}
+
+// Valid reports if the value is in table 0100.
+func (v Table0100) Valid() bool {
+	return TableValueLookup[`0100`][string(v)]
+}
+
+// Table 0100.
+func (v Table0100) Table() Table {
+	return TableLookup[`0100`]
+}
+
+// Table0103 is a value of table 0103, Processing ID.
+// Fields in this table are strings; convert a field, such as Table0103(v), to use the helpers.
+type Table0103 string
+
+const (
+	Table0103Debugging  Table0103 = `D` // Debugging
+	Table0103Production Table0103 = `P` // Production
+	Table0103Training   Table0103 = `T` // Training
+)
+
+// Description of the value, or empty if the value is not in table 0103.
+func (v Table0103) Description() string {
+	return tableDescription(`0103`, string(v))
+}
+
+// Valid reports if the value is in table 0103.
+func (v Table0103) Valid() bool {
+	return TableValueLookup[`0103`][string(v)]
+}
+
+// Table 0103.
+func (v Table0103) Table() Table {
+	return TableLookup[`0103`]
+}
+
+// Table0104 is a value of table 0104, Version ID.
+// Fields in this table are strings; convert a field, such as Table0104(v), to use the helpers.
+type Table0104 string
+
+const (
+	Table0104Release20  Table0104 = `2.0`   // Release 2.0
+	Table0104Demo20     Table0104 = `2.0D`  // Demo 2.0
+	Table0104Release21  Table0104 = `2.1`   // Release 2.1
+	Table0104Release22  Table0104 = `2.2`   // Release 2.2
+	Table0104Release23  Table0104 = `2.3`   // Release 2.3
+	Table0104Release231 Table0104 = `2.3.1` // Release 2.3.1
+	Table0104Release24  Table0104 = `2.4`   // Release 2.4
+	Table0104Release25  Table0104 = `2.5`   // Release 2.5
+	Table0104Release251 Table0104 = `2.5.1` // Release 2.5.1
+	Table0104Release26  Table0104 = `2.6`   // Release 2.6
+	Table0104Release27  Table0104 = `2.7`   // Release 2.7
+)
+
+// Description of the value, or empty if the value is not in table 0104.
+func (v Table0104) Description() string {
+	return tableDescription(`0104`, string(v))
+}
+
+// Valid reports if the value is in table 0104.
+func (v Table0104) Valid() bool {
+	return TableValueLookup[`0104`][string(v)]
+}
+
+// Table 0104.
+func (v Table0104) Table() Table {
+	return TableLookup[`0104`]
+}
+
+// Table0105 is a value of table 0105, Source of Comment.
+// Fields in this table are strings; convert a field, such as Table0105(v), to use the helpers.
+type Table0105 string
+
+const (
+	Table0105AncillaryFillerDepartmentIsSourceOf Table0105 = `L` // Ancillary (filler) department is source of comment
+	Table0105OtherSystemIsSourceOfComment        Table0105 = `O` // Other system is source of comment
+	Table0105OrdererPlacerIsSourceOfComment      Table0105 = `P` // Orderer (placer) is source of comment
+)
+
+// Description of the value, or empty if the value is not in table 0105.
+func (v Table0105) Description() string {
+	return tableDescription(`0105`, string(v))
+}
+
+// Valid reports if the value is in table 0105.
+func (v Table0105) Valid() bool {
+	return TableValueLookup[`0105`][string(v)]
+}
+
+// Table 0105.
+func (v Table0105) Table() Table {
+	return TableLookup[`0105`]
+}
+
+// Table0116 is a value of table 0116, Bed Status.
+// Fields in this table are strings; convert a field, such as Table0116(v), to use the helpers.
+type Table0116 string
+
+const (
+	Table0116Closed       Table0116 = `C` // Closed
+	Table0116Housekeeping Table0116 = `H` // Housekeeping
+	Table0116Isolated     Table0116 = `I` // Isolated
+	Table0116Contaminated Table0116 = `K` // Contaminated
+	Table0116Occupied     Table0116 = `O` // Occupied
+	Table0116Unoccupied   Table0116 = `U` // Unoccupied
+)
+
+// Description of the value, or empty if the value is not in table 0116.
+func (v Table0116) Description() string {
+	return tableDescription(`0116`, string(v))
+}
+
+// Valid reports if the value is in table 0116.
+func (v Table0116) Valid() bool {
+	return TableValueLookup[`0116`][string(v)]
+}
+
+// Table 0116.
+func (v Table0116) Table() Table {
+	return TableLookup[`0116`]
+}
+
+// Table0119 is a value of table 0119, Order Control Codes.
+// Fields in this table are strings; convert a field, such as Table0119(v), to use the helpers.
+type Table0119 string
+
+const (
+	Table0119OrderServiceRefillRequestApproval        Table0119 = `AF` // Order/service refill request approval
+	Table0119CancelOrderServiceRequest                Table0119 = `CA` // Cancel order/service request
+	Table0119ChildOrderService                        Table0119 = `CH` // Child order/service
+	Table0119CombinedResult                           Table0119 = `CN` // Combined result
+	Table0119CanceledAsRequested                      Table0119 = `CR` // Canceled as requested
+	Table0119DiscontinueOrderServiceRequest           Table0119 = `DC` // Discontinue order/service request
+	Table0119DataErrors                               Table0119 = `DE` // Data errors
+	Table0119OrderServiceRefillRequestDenied          Table0119 = `DF` // Order/service refill request denied
+	Table0119DiscontinuedAsRequested                  Table0119 = `DR` // Discontinued as requested
+	Table0119OrderServiceRefilledUnsolicited          Table0119 = `FU` // Order/service refilled, unsolicited
+	Table0119HoldOrderRequest                         Table0119 = `HD` // Hold order request
+	Table0119OnHoldAsRequested                        Table0119 = `HR` // On hold as requested
+	Table0119LinkOrderServiceToPatientCare            Table0119 = `LI` // Link order/service to patient care problem or goal
+	Table0119MiscellaneousChargeNotAssociatedWithAn   Table0119 = `MC` // Miscellaneous Charge - not associated with an order
+	Table0119NumberAssigned                           Table0119 = `NA` // Number assigned
+	Table0119NewOrderService                          Table0119 = `NW` // New order/service
+	Table0119OrderServiceCanceled                     Table0119 = `OC` // Order/service canceled
+	Table0119OrderServiceDiscontinued                 Table0119 = `OD` // Order/service discontinued
+	Table0119OrderServiceReleased                     Table0119 = `OE` // Order/service released
+	Table0119OrderServiceRefilledAsRequested          Table0119 = `OF` // Order/service refilled as requested
+	Table0119OrderServiceHeld                         Table0119 = `OH` // Order/service held
+	Table0119OrderServiceAcceptedOK                   Table0119 = `OK` // Order/service accepted & OK
+	Table0119NotificationOfOrderForOutsideDispense    Table0119 = `OP` // Notification of order for outside dispense
+	Table0119ReleasedAsRequested                      Table0119 = `OR` // Released as requested
+	Table0119ParentOrderService                       Table0119 = `PA` // Parent order/service
+	Table0119PreviousResultsWithNewOrderService       Table0119 = `PR` // Previous Results with new order/service
+	Table0119NotificationOfReplacementOrderForOutside Table0119 = `PY` // Notification of replacement order for outside dispense
+	Table0119ObservationsPerformedServiceToFollow     Table0119 = `RE` // Observations/Performed Service to follow
+	Table0119RefillOrderServiceRequest                Table0119 = `RF` // Refill order/service request
+	Table0119ReleasePreviousHold                      Table0119 = `RL` // Release previous hold
+	Table0119ReplacementOrder                         Table0119 = `RO` // Replacement order
+	Table0119OrderServiceReplaceRequest               Table0119 = `RP` // Order/service replace request
+	Table0119ReplacedAsRequested                      Table0119 = `RQ` // Replaced as requested
+	Table0119RequestReceived                          Table0119 = `RR` // Request received
+	Table0119ReplacedUnsolicited                      Table0119 = `RU` // Replaced unsolicited
+	Table0119StatusChanged                            Table0119 = `SC` // Status changed
+	Table0119SendOrderServiceNumber                   Table0119 = `SN` // Send order/service number
+	Table0119ResponseToSendOrderServiceStatus         Table0119 = `SR` // Response to send order/service status request
+	Table0119SendOrderServiceStatusRequest            Table0119 = `SS` // Send order/service status request
+	Table0119UnableToAcceptOrderService               Table0119 = `UA` // Unable to accept order/service
+	Table0119UnableToCancel                           Table0119 = `UC` // Unable to cancel
+	Table0119UnableToDiscontinue                      Table0119 = `UD` // Unable to discontinue
+	Table0119UnableToRefill                           Table0119 = `UF` // Unable to refill
+	Table0119UnableToPutOnHold                        Table0119 = `UH` // Unable to put on hold
+	Table0119UnableToReplace                          Table0119 = `UM` // Unable to replace
+	Table0119UnlinkOrderServiceFromPatientCare        Table0119 = `UN` // Unlink order/service from patient care problem or goal
+	Table0119UnableToRelease                          Table0119 = `UR` // Unable to release
+	Table0119UnableToChange                           Table0119 = `UX` // Unable to change
+	Table0119ChangeOrderServiceRequest                Table0119 = `XO` // Change order/service request
+	Table0119ChangedAsRequested                       Table0119 = `XR` // Changed as requested
+	Table0119OrderServiceChangedUnsol                 Table0119 = `XX` // Order/service changed, unsol.
+)
+
+// Description of the value, or empty if the value is not in table 0119.
+func (v Table0119) Description() string {
+	return tableDescription(`0119`, string(v))
+}
+
+// Valid reports if the value is in table 0119.
+func (v Table0119) Valid() bool {
+	return TableValueLookup[`0119`][string(v)]
+}
+
+// Table 0119.
+func (v Table0119) Table() Table {
+	return TableLookup[`0119`]
+}
+
+// Table0121 is a value of table 0121, Response Flag.
+// Fields in this table are strings; convert a field, such as Table0121(v), to use the helpers.
+type Table0121 string
+
+const (
+	Table0121SameAsRAlsoOtherAssociated         Table0121 = `D` // Same as R, also other associated segments
+	Table0121ReportExceptionsOnly               Table0121 = `E` // Report exceptions only
+	Table0121SameAsDPlusConfirmationsExplicitly Table0121 = `F` // Same as D, plus confirmations explicitly
+	Table0121OnlyTheMSASegmentIsReturned        Table0121 = `N` // Only the MSA segment is returned
+	Table0121SameAsEAlsoReplacementAnd          Table0121 = `R` // Same as E, also Replacement and Parent-Child
+)
+
+// Description of the value, or empty if the value is not in table 0121.
+func (v Table0121) Description() string {
+	return tableDescription(`0121`, string(v))
+}
+
+// Valid reports if the value is in table 0121.
+func (v Table0121) Valid() bool {
+	return TableValueLookup[`0121`][string(v)]
+}
+
+// Table 0121.
+func (v Table0121) Table() Table {
+	return TableLookup[`0121`]
+}
+
+// Table0122 is a value of table 0122, Charge Type.
+// Fields in this table are strings; convert a field, such as Table0122(v), to use the helpers.
+type Table0122 string
+
+const (
+	Table0122Charge       Table0122 = `CH` // Charge
+	Table0122Contract     Table0122 = `CO` // Contract
+	Table0122Credit       Table0122 = `CR` // Credit
+	Table0122Department   Table0122 = `DP` // Department
+	Table0122Grant        Table0122 = `GR` // Grant
+	Table0122NoCharge     Table0122 = `NC` // No Charge
+	Table0122Professional Table0122 = `PC` // Professional
+	Table0122Research     Table0122 = `RS` // Research
+)
+
+// Description of the value, or empty if the value is not in table 0122.
+func (v Table0122) Description() string {
+	return tableDescription(`0122`, string(v))
+}
+
+// Valid reports if the value is in table 0122.
+func (v Table0122) Valid() bool {
+	return TableValueLookup[`0122`][string(v)]
+}
+
+// Table 0122.
+func (v Table0122) Table() Table {
+	return TableLookup[`0122`]
+}
+
+// Table0123 is a value of table 0123, Result Status.
+// Fields in this table are strings; convert a field, such as Table0123(v), to use the helpers.
+type Table0123 string
+
+const (
+	Table0123SomeButNotAllResultsAvailable               Table0123 = `A` // Some, but not all, results available
+	Table0123CorrectionToResults                         Table0123 = `C` // Correction to results
+	Table0123FinalResultsResultsStoredAndVerified        Table0123 = `F` // Final results; results stored and verified.  Can only be changed with a corrected result.
+	Table0123NoResultsAvailableSpecimenReceivedProcedure Table0123 = `I` // No results available; specimen received, procedure incomplete
+	Table0123OrderReceivedSpecimenNotYetReceived         Table0123 = `O` // Order received; specimen not yet received
+	Table0123PreliminaryAVerifiedEarlyResultIs           Table0123 = `P` // Preliminary: A verified early result is available, final results not yet obtained
+	Table0123ResultsStoredNotYetVerified                 Table0123 = `R` // Results stored; not yet verified
+	Table0123NoResultsAvailableProcedureScheduledBut     Table0123 = `S` // No results available; procedure scheduled, but not done
+	Table0123NoResultsAvailableOrderCanceled             Table0123 = `X` // No results available; Order canceled.
+	Table0123NoOrderOnRecordForThis                      Table0123 = `Y` // No order on record for this test.  (Used only on queries)
+	Table0123NoRecordOfThisPatientUsed                   Table0123 = `Z` // No record of this patient. (Used only on queries)
+)
+
+// Description of the value, or empty if the value is not in table 0123.
+func (v Table0123) Description() string {
+	return tableDescription(`0123`, string(v))
+}
+
+// Valid reports if the value is in table 0123.
+func (v Table0123) Valid() bool {
+	return TableValueLookup[`0123`][string(v)]
+}
+
+// Table 0123.
+func (v Table0123) Table() Table {
+	return TableLookup[`0123`]
+}
+
+// Table0124 is a value of table 0124, Transportation Mode.
+// Fields in this table are strings; convert a field, such as Table0124(v), to use the helpers.
+type Table0124 string
+
+const (
+	Table0124CartPatientTravelsOnCartOr      Table0124 = `CART` // Cart - patient travels on cart or gurney
+	Table0124TheExaminingDeviceGoesToPatient Table0124 = `PORT` // The examining device goes to patient's location
+	Table0124PatientWalksToDiagnosticService Table0124 = `WALK` // Patient walks to diagnostic service
+	Table0124Wheelchair                      Table0124 = `WHLC` // Wheelchair
+)
+
+// Description of the value, or empty if the value is not in table 0124.
+func (v Table0124) Description() string {
+	return tableDescription(`0124`, string(v))
+}
+
+// Valid reports if the value is in table 0124.
+func (v Table0124) Valid() bool {
+	return TableValueLookup[`0124`][string(v)]
+}
+
+// Table 0124.
+func (v Table0124) Table() Table {
+	return TableLookup[`0124`]
+}
+
+// Table0125 is a value of table 0125, Value Type.
+// Fields in this table are strings; convert a field, such as Table0125(v), to use the helpers.
+type Table0125 string
+
+const (
+	Table0125Address                               Table0125 = `AD`  // Address
+	Table0125CodedElementWithFormattedValues       Table0125 = `CF`  // Coded Element With Formatted Values
+	Table0125CompositeIDWithCheckDigit             Table0125 = `CK`  // Composite ID With Check Digit
+	Table0125CompositeIDAndName                    Table0125 = `CN`  // Composite ID And Name
+	Table0125CodedWithNoExceptions                 Table0125 = `CNE` // Coded with No Exceptions
+	Table0125CompositePrice                        Table0125 = `CP`  // Composite Price
+	Table0125CodedEntry                            Table0125 = `CWE` // Coded Entry
+	Table0125ExtendedCompositeIDWithCheckDigit     Table0125 = `CX`  // Extended Composite ID With Check Digit
+	Table0125DateTimeRange                         Table0125 = `DR`  // Date/Time Range
+	Table0125Date                                  Table0125 = `DT`  // Date
+	Table0125TimeStampDateTime                     Table0125 = `DTM` // Time Stamp (Date & Time)
+	Table0125EncapsulatedData                      Table0125 = `ED`  // Encapsulated Data
+	Table0125FormattedTextDisplay                  Table0125 = `FT`  // Formatted Text (Display)
+	Table0125CodedValueForHL7DefinedTables         Table0125 = `ID`  // Coded Value for HL7 Defined Tables
+	Table0125CodedValueForUserDefinedTables        Table0125 = `IS`  // Coded Value for User-Defined Tables
+	Table0125MultiplexedArray                      Table0125 = `MA`  // Multiplexed Array
+	Table0125Money                                 Table0125 = `MO`  // Money
+	Table0125NumericArray                          Table0125 = `NA`  // Numeric Array
+	Table0125Numeric                               Table0125 = `NM`  // Numeric
+	Table0125PersonName                            Table0125 = `PN`  // Person Name
+	Table0125ReferencePointer                      Table0125 = `RP`  // Reference Pointer
+	Table0125StructuredNumeric                     Table0125 = `SN`  // Structured Numeric
+	Table0125StringData                            Table0125 = `ST`  // String Data.
+	Table0125Time                                  Table0125 = `TM`  // Time
+	Table0125TelephoneNumber                       Table0125 = `TN`  // Telephone Number
+	Table0125TextDataDisplay                       Table0125 = `TX`  // Text Data (Display)
+	Table0125ExtendedAddress                       Table0125 = `XAD` // Extended Address
+	Table0125ExtendedCompositeNameAndNumberFor     Table0125 = `XCN` // Extended Composite Name And Number For Persons
+	Table0125ExtendedCompositeNameAndNumberFor_XON Table0125 = `XON` // Extended Composite Name And Number For Organizations
+	Table0125ExtendedPersonName                    Table0125 = `XPN` // Extended Person Name
+	Table0125ExtendedTelecommunicationsNumber      Table0125 = `XTN` // Extended Telecommunications Number
+)
+
+// Description of the value, or empty if the value is not in table 0125.
+func (v Table0125) Description() string {
+	return tableDescription(`0125`, string(v))
+}
+
+// Valid reports if the value is in table 0125.
+func (v Table0125) Valid() bool {
+	return TableValueLookup[`0125`][string(v)]
+}
+
+// Table 0125.
+func (v Table0125) Table() Table {
+	return TableLookup[`0125`]
+}
+
+// Table0127 is a value of table 0127, Allergen Type.
+// Fields in this table are strings; convert a field, such as Table0127(v), to use the helpers.
+type Table0127 string
+
+const (
+	Table0127AnimalAllergy                 Table0127 = `AA` // Animal Allergy
+	Table0127DrugAllergy                   Table0127 = `DA` // Drug allergy
+	Table0127EnvironmentalAllergy          Table0127 = `EA` // Environmental Allergy
+	Table0127FoodAllergy                   Table0127 = `FA` // Food allergy
+	Table0127PollenAllergy                 Table0127 = `LA` // Pollen Allergy
+	Table0127MiscellaneousAllergy          Table0127 = `MA` // Miscellaneous allergy
+	Table0127MiscellaneousContraindication Table0127 = `MC` // Miscellaneous contraindication
+	Table0127PlantAllergy                  Table0127 = `PA` // Plant Allergy
+)
+
+// Description of the value, or empty if the value is not in table 0127.
+func (v Table0127) Description() string {
+	return tableDescription(`0127`, string(v))
+}
+
+// Valid reports if the value is in table 0127.
+func (v Table0127) Valid() bool {
+	return TableValueLookup[`0127`][string(v)]
+}
+
+// Table 0127.
+func (v Table0127) Table() Table {
+	return TableLookup[`0127`]
+}
+
+// Table0128 is a value of table 0128, Allergy Severity.
+// Fields in this table are strings; convert a field, such as Table0128(v), to use the helpers.
+type Table0128 string
+
+const (
+	Table0128Mild     Table0128 = `MI` // Mild
+	Table0128Moderate Table0128 = `MO` // Moderate
+	Table0128Severe   Table0128 = `SV` // Severe
+	Table0128Unknown  Table0128 = `U`  // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0128.
+func (v Table0128) Description() string {
+	return tableDescription(`0128`, string(v))
+}
+
+// Valid reports if the value is in table 0128.
+func (v Table0128) Valid() bool {
+	return TableValueLookup[`0128`][string(v)]
+}
+
+// Table 0128.
+func (v Table0128) Table() Table {
+	return TableLookup[`0128`]
+}
+
+// Table0130 is a value of table 0130, Visit User Code.
+// Fields in this table are strings; convert a field, such as Table0130(v), to use the helpers.
+type Table0130 string
+
+const (
+	Table0130Home       Table0130 = `HO` // Home
+	Table0130MobileUnit Table0130 = `MO` // Mobile Unit
+	Table0130Phone      Table0130 = `PH` // Phone
+	Table0130Teaching   Table0130 = `TE` // Teaching
+)
+
+// Description of the value, or empty if the value is not in table 0130.
+func (v Table0130) Description() string {
+	return tableDescription(`0130`, string(v))
+}
+
+// Valid reports if the value is in table 0130.
+func (v Table0130) Valid() bool {
+	return TableValueLookup[`0130`][string(v)]
+}
+
+// Table 0130.
+func (v Table0130) Table() Table {
+	return TableLookup[`0130`]
+}
+
+// Table0131 is a value of table 0131, Contact Role.
+// Fields in this table are strings; convert a field, such as Table0131(v), to use the helpers.
+type Table0131 string
+
+const (
+	Table0131EmergencyContact Table0131 = `C` // Emergency Contact
+	Table0131Employer         Table0131 = `E` // Employer
+	Table0131FederalAgency    Table0131 = `F` // Federal Agency
+	Table0131InsuranceCompany Table0131 = `I` // Insurance Company
+	Table0131NextOfKin        Table0131 = `N` // Next-of-Kin
+	Table0131Other            Table0131 = `O` // Other
+	Table0131StateAgency      Table0131 = `S` // State Agency
+	Table0131Unknown          Table0131 = `U` // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0131.
+func (v Table0131) Description() string {
+	return tableDescription(`0131`, string(v))
+}
+
+// Valid reports if the value is in table 0131.
+func (v Table0131) Valid() bool {
+	return TableValueLookup[`0131`][string(v)]
+}
+
+// Table 0131.
+func (v Table0131) Table() Table {
+	return TableLookup[`0131`]
+}
+
+// Table0135 is a value of table 0135, Assignment of Benefits.
+// Fields in this table are strings; convert a field, such as Table0135(v), to use the helpers.
+type Table0135 string
+
+const (
+	Table0135ModifiedAssignment Table0135 = `M` // Modified assignment
+	Table0135No                 Table0135 = `N` // No
+	Table0135Yes                Table0135 = `Y` // Yes
+)
+
+// Description of the value, or empty if the value is not in table 0135.
+func (v Table0135) Description() string {
+	return tableDescription(`0135`, string(v))
+}
+
+// Valid reports if the value is in table 0135.
+func (v Table0135) Valid() bool {
+	return TableValueLookup[`0135`][string(v)]
+}
+
+// Table 0135.
+func (v Table0135) Table() Table {
+	return TableLookup[`0135`]
+}
+
+// Table0136 is a value of table 0136, Yes/no Indicator.
+// Fields in this table are strings; convert a field, such as Table0136(v), to use the helpers.
+type Table0136 string
+
+const (
+	Table0136No  Table0136 = `N` // No
+	Table0136Yes Table0136 = `Y` // Yes
+)
+
+// Description of the value, or empty if the value is not in table 0136.
+func (v Table0136) Description() string {
+	return tableDescription(`0136`, string(v))
+}
+
+// Valid reports if the value is in table 0136.
+func (v Table0136) Valid() bool {
+	return TableValueLookup[`0136`][string(v)]
+}
+
+// Table 0136.
+func (v Table0136) Table() Table {
+	return TableLookup[`0136`]
+}
+
+// Table0137 is a value of table 0137, Mail Claim Party.
+// Fields in this table are strings; convert a field, such as Table0137(v), to use the helpers.
+type Table0137 string
+
+const (
+	Table0137Employer         Table0137 = `E` // Employer
+	Table0137Guarantor        Table0137 = `G` // Guarantor
+	Table0137InsuranceCompany Table0137 = `I` // Insurance company
+	Table0137Other            Table0137 = `O` // Other
+	Table0137Patient          Table0137 = `P` // Patient
+)
+
+// Description of the value, or empty if the value is not in table 0137.
+func (v Table0137) Description() string {
+	return tableDescription(`0137`, string(v))
+}
+
+// Valid reports if the value is in table 0137.
+func (v Table0137) Valid() bool {
+	return TableValueLookup[`0137`][string(v)]
+}
+
+// Table 0137.
+func (v Table0137) Table() Table {
+	return TableLookup[`0137`]
+}
+
+// Table0140 is a value of table 0140, Military Service.
+// Fields in this table are strings; convert a field, such as Table0140(v), to use the helpers.
+type Table0140 string
+
+const (
+	Table0140AustralianArmy                              Table0140 = `AUSA`  // Australian Army
+	Table0140AustralianAirForce                          Table0140 = `AUSAF` // Australian Air Force
+	Table0140AustralianNavy                              Table0140 = `AUSN`  // Australian Navy
+	Table0140NorthAtlanticTreatyOrganization             Table0140 = `NATO`  // North Atlantic Treaty Organization
+	Table0140NationalOceanicAndAtmosphericAdministration Table0140 = `NOAA`  // National Oceanic and Atmospheric Administration
+	Table0140USArmy                                      Table0140 = `USA`   // US Army
+	Table0140USAirForce                                  Table0140 = `USAF`  // US Air Force
+	Table0140USCoastGuard                                Table0140 = `USCG`  // US Coast Guard
+	Table0140USMarineCorps                               Table0140 = `USMC`  // US Marine Corps
+	Table0140USNavy                                      Table0140 = `USN`   // US Navy
+	Table0140USPublicHealthService                       Table0140 = `USPHS` // US Public Health Service
+)
+
+// Description of the value, or empty if the value is not in table 0140.
+func (v Table0140) Description() string {
+	return tableDescription(`0140`, string(v))
+}
+
+// Valid reports if the value is in table 0140.
+func (v Table0140) Valid() bool {
+	return TableValueLookup[`0140`][string(v)]
+}
+
+// Table 0140.
+func (v Table0140) Table() Table {
+	return TableLookup[`0140`]
+}
+
+// Table0141 is a value of table 0141, Military Rank/Grade.
+// Fields in this table are strings; convert a field, such as Table0141(v), to use the helpers.
+type Table0141 string
+
+const (
+	Table0141Enlisted        Table0141 = `E1... E9`  // Enlisted
+	Table0141Officers        Table0141 = `O1 ... O9` // Officers
+	Table0141WarrantOfficers Table0141 = `W1 ... W4` // Warrant Officers
+)
+
+// Description of the value, or empty if the value is not in table 0141.
+func (v Table0141) Description() string {
+	return tableDescription(`0141`, string(v))
+}
+
+// Valid reports if the value is in table 0141.
+func (v Table0141) Valid() bool {
+	return TableValueLookup[`0141`][string(v)]
+}
+
+// Table 0141.
+func (v Table0141) Table() Table {
+	return TableLookup[`0141`]
+}
+
+// Table0142 is a value of table 0142, Military Status.
+// Fields in this table are strings; convert a field, such as Table0142(v), to use the helpers.
+type Table0142 string
+
+const (
+	Table0142ActiveDuty Table0142 = `ACT` // Active duty
+	Table0142Deceased   Table0142 = `DEC` // Deceased
+	Table0142Retired    Table0142 = `RET` // Retired
+)
+
+// Description of the value, or empty if the value is not in table 0142.
+func (v Table0142) Description() string {
+	return tableDescription(`0142`, string(v))
+}
+
+// Valid reports if the value is in table 0142.
+func (v Table0142) Valid() bool {
+	return TableValueLookup[`0142`][string(v)]
+}
+
+// Table 0142.
+func (v Table0142) Table() Table {
+	return TableLookup[`0142`]
+}
+
+// Table0144 is a value of table 0144, Eligibility Source.
+// Fields in this table are strings; convert a field, such as Table0144(v), to use the helpers.
+type Table0144 string
+
+const (
+	Table0144InsuranceCompany       Table0144 = `1` // Insurance company
+	Table0144Employer               Table0144 = `2` // Employer
+	Table0144InsuredPresentedPolicy Table0144 = `3` // Insured presented policy
+	Table0144InsuredPresentedCard   Table0144 = `4` // Insured presented card
+	Table0144SignedStatementOnFile  Table0144 = `5` // Signed statement on file
+	Table0144VerbalInformation      Table0144 = `6` // Verbal information
+	Table0144None                   Table0144 = `7` // None
+)
+
+// Description of the value, or empty if the value is not in table 0144.
+func (v Table0144) Description() string {
+	return tableDescription(`0144`, string(v))
+}
+
+// Valid reports if the value is in table 0144.
+func (v Table0144) Valid() bool {
+	return TableValueLookup[`0144`][string(v)]
+}
+
+// Table 0144.
+func (v Table0144) Table() Table {
+	return TableLookup[`0144`]
+}
+
+// Table0145 is a value of table 0145, Room Type.
+// Fields in this table are strings; convert a field, such as Table0145(v), to use the helpers.
+type Table0145 string
+
+const (
+	Table0145SecondIntensiveCareUnit Table0145 = `2ICU` // Second intensive care unit
+	Table0145SecondPrivateRoom       Table0145 = `2PRI` // Second private room
+	Table0145SecondSemiPrivateRoom   Table0145 = `2SPR` // Second semi-private room
+	Table0145IntensiveCareUnit       Table0145 = `ICU`  // Intensive care unit
+	Table0145PrivateRoom             Table0145 = `PRI`  // Private room
+	Table0145SemiPrivateRoom         Table0145 = `SPR`  // Semi-private room
+)
+
+// Description of the value, or empty if the value is not in table 0145.
+func (v Table0145) Description() string {
+	return tableDescription(`0145`, string(v))
+}
+
+// Valid reports if the value is in table 0145.
+func (v Table0145) Valid() bool {
+	return TableValueLookup[`0145`][string(v)]
+}
+
+// Table 0145.
+func (v Table0145) Table() Table {
+	return TableLookup[`0145`]
+}
+
+// Table0146 is a value of table 0146, Amount Type.
+// Fields in this table are strings; convert a field, such as Table0146(v), to use the helpers.
+type Table0146 string
+
+const (
+	Table0146Differential Table0146 = `DF` // Differential
+	Table0146Limit        Table0146 = `LM` // Limit
+	Table0146Percentage   Table0146 = `PC` // Percentage
+	Table0146Rate         Table0146 = `RT` // Rate
+	Table0146Unlimited    Table0146 = `UL` // Unlimited
+)
+
+// Description of the value, or empty if the value is not in table 0146.
+func (v Table0146) Description() string {
+	return tableDescription(`0146`, string(v))
+}
+
+// Valid reports if the value is in table 0146.
+func (v Table0146) Valid() bool {
+	return TableValueLookup[`0146`][string(v)]
+}
+
+// Table 0146.
+func (v Table0146) Table() Table {
+	return TableLookup[`0146`]
+}
+
+// Table0147 is a value of table 0147, Policy Type.
+// Fields in this table are strings; convert a field, such as Table0147(v), to use the helpers.
+type Table0147 string
+
+const (
+	Table0147SecondAncillary    Table0147 = `2ANC` // Second ancillary
+	Table0147SecondMajorMedical Table0147 = `2MMD` // Second major medical
+	Table0147ThirdMajorMedical  Table0147 = `3MMD` // Third major medical
+	Table0147Ancillary          Table0147 = `ANC`  // Ancillary
+	Table0147MajorMedical       Table0147 = `MMD`  // Major medical
+)
+
+// Description of the value, or empty if the value is not in table 0147.
+func (v Table0147) Description() string {
+	return tableDescription(`0147`, string(v))
+}
+
+// Valid reports if the value is in table 0147.
+func (v Table0147) Valid() bool {
+	return TableValueLookup[`0147`][string(v)]
+}
+
+// Table 0147.
+func (v Table0147) Table() Table {
+	return TableLookup[`0147`]
+}
+
+// Table0148 is a value of table 0148, Money or Percentage Indicator.
+// Fields in this table are strings; convert a field, such as Table0148(v), to use the helpers.
+type Table0148 string
+
+const (
+	Table0148CurrencyAmount Table0148 = `AT` // Currency amount
+	Table0148Percentage     Table0148 = `PC` // Percentage
+)
+
+// Description of the value, or empty if the value is not in table 0148.
+func (v Table0148) Description() string {
+	return tableDescription(`0148`, string(v))
+}
+
+// Valid reports if the value is in table 0148.
+func (v Table0148) Valid() bool {
+	return TableValueLookup[`0148`][string(v)]
+}
+
+// Table 0148.
+func (v Table0148) Table() Table {
+	return TableLookup[`0148`]
+}
+
+// Table0149 is a value of table 0149, Day Type.
+// Fields in this table are strings; convert a field, such as Table0149(v), to use the helpers.
+type Table0149 string
+
+const (
+	Table0149Approved Table0149 = `AP` // Approved
+	Table0149Denied   Table0149 = `DE` // Denied
+	Table0149Pending  Table0149 = `PE` // Pending
+)
+
+// Description of the value, or empty if the value is not in table 0149.
+func (v Table0149) Description() string {
+	return tableDescription(`0149`, string(v))
+}
+
+// Valid reports if the value is in table 0149.
+func (v Table0149) Valid() bool {
+	return TableValueLookup[`0149`][string(v)]
+}
+
+// Table 0149.
+func (v Table0149) Table() Table {
+	return TableLookup[`0149`]
+}
+
+// Table0150 is a value of table 0150, Certification Patient Type.
+// Fields in this table are strings; convert a field, such as Table0150(v), to use the helpers.
+type Table0150 string
+
+const (
+	Table0150Emergency          Table0150 = `ER`  // Emergency
+	Table0150InpatientElective  Table0150 = `IPE` // Inpatient elective
+	Table0150OutpatientElective Table0150 = `OPE` // Outpatient elective
+	Table0150Urgent             Table0150 = `UR`  // Urgent
+)
+
+// Description of the value, or empty if the value is not in table 0150.
+func (v Table0150) Description() string {
+	return tableDescription(`0150`, string(v))
+}
+
+// Valid reports if the value is in table 0150.
+func (v Table0150) Valid() bool {
+	return TableValueLookup[`0150`][string(v)]
+}
+
+// Table 0150.
+func (v Table0150) Table() Table {
+	return TableLookup[`0150`]
+}
+
+// Table0155 is a value of table 0155, Accept/Application Acknowledgment Conditions.
+// Fields in this table are strings; convert a field, such as Table0155(v), to use the helpers.
+type Table0155 string
+
+const (
+	Table0155Always                    Table0155 = `AL` // Always
+	Table0155ErrorRejectConditionsOnly Table0155 = `ER` // Error/reject conditions only
+	Table0155Never                     Table0155 = `NE` // Never
+	Table0155SuccessfulCompletionOnly  Table0155 = `SU` // Successful completion only
+)
+
+// Description of the value, or empty if the value is not in table 0155.
+func (v Table0155) Description() string {
+	return tableDescription(`0155`, string(v))
+}
+
+// Valid reports if the value is in table 0155.
+func (v Table0155) Valid() bool {
+	return TableValueLookup[`0155`][string(v)]
+}
+
+// Table 0155.
+func (v Table0155) Table() Table {
+	return TableLookup[`0155`]
+}
+
+// Table0159 is a value of table 0159, Diet Code Specification Type.
+// Fields in this table are strings; convert a field, such as Table0159(v), to use the helpers.
+type Table0159 string
+
+const (
+	Table0159Diet       Table0159 = `D` // Diet
+	Table0159Preference Table0159 = `P` // Preference
+	Table0159Supplement Table0159 = `S` // Supplement
+)
+
+// Description of the value, or empty if the value is not in table 0159.
+func (v Table0159) Description() string {
+	return tableDescription(`0159`, string(v))
+}
+
+// Valid reports if the value is in table 0159.
+func (v Table0159) Valid() bool {
+	return TableValueLookup[`0159`][string(v)]
+}
+
+// Table 0159.
+func (v Table0159) Table() Table {
+	return TableLookup[`0159`]
+}
+
+// Table0160 is a value of table 0160, Tray Type.
+// Fields in this table are strings; convert a field, such as Table0160(v), to use the helpers.
+type Table0160 string
+
+const (
+	Table0160EarlyTray       Table0160 = `EARLY` // Early tray
+	Table0160GuestTray       Table0160 = `GUEST` // Guest tray
+	Table0160LateTray        Table0160 = `LATE`  // Late tray
+	Table0160TrayMessageOnly Table0160 = `MSG`   // Tray message only
+	Table0160NoTray          Table0160 = `NO`    // No tray
+)
+
+// Description of the value, or empty if the value is not in table 0160.
+func (v Table0160) Description() string {
+	return tableDescription(`0160`, string(v))
+}
+
+// Valid reports if the value is in table 0160.
+func (v Table0160) Valid() bool {
+	return TableValueLookup[`0160`][string(v)]
+}
+
+// Table 0160.
+func (v Table0160) Table() Table {
+	return TableLookup[`0160`]
+}
+
+// Table0161 is a value of table 0161, Allow Substitution.
+// Fields in this table are strings; convert a field, such as Table0161(v), to use the helpers.
+type Table0161 string
+
+const (
+	Table0161AllowGenericSubstitutions           Table0161 = `G` // Allow generic substitutions.
+	Table0161SubstitutionsAreNOTAuthorizedThisIs Table0161 = `N` // Substitutions are NOT authorized.  (This is the default - null.)
+	Table0161AllowTherapeuticSubstitutions       Table0161 = `T` // Allow therapeutic substitutions
+)
+
+// Description of the value, or empty if the value is not in table 0161.
+func (v Table0161) Description() string {
+	return tableDescription(`0161`, string(v))
+}
+
+// Valid reports if the value is in table 0161.
+func (v Table0161) Valid() bool {
+	return TableValueLookup[`0161`][string(v)]
+}
+
+// Table 0161.
+func (v Table0161) Table() Table {
+	return TableLookup[`0161`]
+}
+
+// Table0162 is a value of table 0162, Route of Administration.
+// Fields in this table are strings; convert a field, such as Table0162(v), to use the helpers.
+type Table0162 string
+
+const (
+	Table0162ApplyExternally     Table0162 = `AP`  // Apply Externally
+	Table0162Buccal              Table0162 = `B`   // Buccal
+	Table0162Dental              Table0162 = `DT`  // Dental
+	Table0162Epidural            Table0162 = `EP`  // Epidural
+	Table0162EndotrachialTube    Table0162 = `ET`  // Endotrachial Tube*
+	Table0162GastrostomyTube     Table0162 = `GTT` // Gastrostomy Tube
+	Table0162GUIrrigant          Table0162 = `GU`  // GU Irrigant
+	Table0162IntraArterial       Table0162 = `IA`  // Intra-arterial
+	Table0162Intrabursal         Table0162 = `IB`  // Intrabursal
+	Table0162Intracardiac        Table0162 = `IC`  // Intracardiac
+	Table0162IntracervicalUterus Table0162 = `ICV` // Intracervical (uterus)
+	Table0162Intradermal         Table0162 = `ID`  // Intradermal
+	Table0162Inhalation          Table0162 = `IH`  // Inhalation
+	Table0162IntrahepaticArtery  Table0162 = `IHA` // Intrahepatic Artery
+	Table0162Intramuscular       Table0162 = `IM`  // Intramuscular
+	Table0162ImmerseSoakBodyPart Table0162 = `IMR` // Immerse (Soak) Body Part
+	Table0162Intranasal          Table0162 = `IN`  // Intranasal
+	Table0162Intraocular         Table0162 = `IO`  // Intraocular
+	Table0162Intraperitoneal     Table0162 = `IP`  // Intraperitoneal
+	Table0162Intrasynovial       Table0162 = `IS`  // Intrasynovial
+	Table0162Intrathecal         Table0162 = `IT`  // Intrathecal
+	Table0162Intrauterine        Table0162 = `IU`  // Intrauterine
+	Table0162Intravenous         Table0162 = `IV`  // Intravenous
+	Table0162MucousMembrane      Table0162 = `MM`  // Mucous Membrane
+	Table0162MouthThroat         Table0162 = `MTH` // Mouth/Throat
+	Table0162Nasogastric         Table0162 = `NG`  // Nasogastric
+	Table0162NasalProngs         Table0162 = `NP`  // Nasal Prongs*
+	Table0162Nasal               Table0162 = `NS`  // Nasal
+	Table0162NasotrachialTube    Table0162 = `NT`  // Nasotrachial Tube
+	Table0162Ophthalmic          Table0162 = `OP`  // Ophthalmic
+	Table0162Otic                Table0162 = `OT`  // Otic
+	Table0162OtherMiscellaneous  Table0162 = `OTH` // Other/Miscellaneous
+	Table0162Perfusion           Table0162 = `PF`  // Perfusion
+	Table0162Oral                Table0162 = `PO`  // Oral
+	Table0162Rectal              Table0162 = `PR`  // Rectal
+	Table0162RebreatherMask      Table0162 = `RM`  // Rebreather Mask*
+	Table0162Subcutaneous        Table0162 = `SC`  // Subcutaneous
+	Table0162SoakedDressing      Table0162 = `SD`  // Soaked Dressing
+	Table0162Sublingual          Table0162 = `SL`  // Sublingual
+	Table0162Transdermal         Table0162 = `TD`  // Transdermal
+	Table0162Translingual        Table0162 = `TL`  // Translingual
+	Table0162Topical             Table0162 = `TP`  // Topical
+	Table0162Tracheostomy        Table0162 = `TRA` // Tracheostomy*
+	Table0162Urethral            Table0162 = `UR`  // Urethral
+	Table0162Vaginal             Table0162 = `VG`  // Vaginal
+	Table0162Ventimask           Table0162 = `VM`  // Ventimask
+	Table0162Wound               Table0162 = `WND` // Wound
+)
+
+// Description of the value, or empty if the value is not in table 0162.
+func (v Table0162) Description() string {
+	return tableDescription(`0162`, string(v))
+}
+
+// Valid reports if the value is in table 0162.
+func (v Table0162) Valid() bool {
+	return TableValueLookup[`0162`][string(v)]
+}
+
+// Table 0162.
+func (v Table0162) Table() Table {
+	return TableLookup[`0162`]
+}
+
+// Table0163 is a value of table 0163, Body Site.
+// Fields in this table are strings; convert a field, such as Table0163(v), to use the helpers.
+type Table0163 string
+
+const (
+	Table0163BilateralEars         Table0163 = `BE`    // Bilateral Ears
+	Table0163BilateralNares        Table0163 = `BN`    // Bilateral Nares
+	Table0163Buttock               Table0163 = `BU`    // Buttock
+	Table0163ChestTube             Table0163 = `CT`    // Chest Tube
+	Table0163LeftArm               Table0163 = `LA`    // Left Arm
+	Table0163LeftAnteriorChest     Table0163 = `LAC`   // Left Anterior Chest
+	Table0163LeftAntecubitalFossa  Table0163 = `LACF`  // Left Antecubital Fossa
+	Table0163LeftDeltoid           Table0163 = `LD`    // Left Deltoid
+	Table0163LeftEar               Table0163 = `LE`    // Left Ear
+	Table0163LeftExternalJugular   Table0163 = `LEJ`   // Left External Jugular
+	Table0163LeftFoot              Table0163 = `LF`    // Left Foot
+	Table0163LeftGluteusMedius     Table0163 = `LG`    // Left Gluteus Medius
+	Table0163LeftHand              Table0163 = `LH`    // Left Hand
+	Table0163LeftInternalJugular   Table0163 = `LIJ`   // Left Internal Jugular
+	Table0163LeftLowerAbdQuadrant  Table0163 = `LLAQ`  // Left Lower Abd Quadrant
+	Table0163LeftLowerForearm      Table0163 = `LLFA`  // Left Lower Forearm
+	Table0163LeftMidForearm        Table0163 = `LMFA`  // Left Mid Forearm
+	Table0163LeftNaris             Table0163 = `LN`    // Left Naris
+	Table0163LeftPosteriorChest    Table0163 = `LPC`   // Left Posterior Chest
+	Table0163LeftSubclavian        Table0163 = `LSC`   // Left Subclavian
+	Table0163LeftThigh             Table0163 = `LT`    // Left Thigh
+	Table0163LeftUpperArm          Table0163 = `LUA`   // Left Upper Arm
+	Table0163LeftUpperAbdQuadrant  Table0163 = `LUAQ`  // Left Upper Abd Quadrant
+	Table0163LeftUpperForearm      Table0163 = `LUFA`  // Left Upper Forearm
+	Table0163LeftVentragluteal     Table0163 = `LVG`   // Left Ventragluteal
+	Table0163LeftVastusLateralis   Table0163 = `LVL`   // Left Vastus Lateralis
+	Table0163Nebulized             Table0163 = `NB`    // Nebulized
+	Table0163RightEye              Table0163 = `OD`    // Right Eye
+	Table0163LeftEye               Table0163 = `OS`    // Left Eye
+	Table0163BilateralEyes         Table0163 = `OU`    // Bilateral Eyes
+	Table0163Perianal              Table0163 = `PA`    // Perianal
+	Table0163Perineal              Table0163 = `PERIN` // Perineal
+	Table0163RightArm              Table0163 = `RA`    // Right Arm
+	Table0163RightAnteriorChest    Table0163 = `RAC`   // Right Anterior Chest
+	Table0163RightAntecubitalFossa Table0163 = `RACF`  // Right Antecubital Fossa
+	Table0163RightDeltoid          Table0163 = `RD`    // Right Deltoid
+	Table0163RightEar              Table0163 = `RE`    // Right Ear
+	Table0163RightExternalJugular  Table0163 = `REJ`   // Right External Jugular
+	Table0163RightFoot             Table0163 = `RF`    // Right Foot
+	Table0163RightGluteusMedius    Table0163 = `RG`    // Right Gluteus Medius
+	Table0163RightHand             Table0163 = `RH`    // Right Hand
+	Table0163RightInternalJugular  Table0163 = `RIJ`   // Right Internal Jugular
+	Table0163RtLowerAbdQuadrant    Table0163 = `RLAQ`  // Rt Lower Abd Quadrant
+	Table0163RightLowerForearm     Table0163 = `RLFA`  // Right Lower Forearm
+	Table0163RightMidForearm       Table0163 = `RMFA`  // Right Mid Forearm
+	Table0163RightNaris            Table0163 = `RN`    // Right Naris
+	Table0163RightPosteriorChest   Table0163 = `RPC`   // Right Posterior Chest
+	Table0163RightSubclavian       Table0163 = `RSC`   // Right Subclavian
+	Table0163RightThigh            Table0163 = `RT`    // Right Thigh
+	Table0163RightUpperArm         Table0163 = `RUA`   // Right Upper Arm
+	Table0163RightUpperAbdQuadrant Table0163 = `RUAQ`  // Right Upper Abd Quadrant
+	Table0163RightUpperForearm     Table0163 = `RUFA`  // Right Upper Forearm
+	Table0163RightVentragluteal    Table0163 = `RVG`   // Right Ventragluteal
+	Table0163RightVastusLateralis  Table0163 = `RVL`   // Right Vastus Lateralis
+)
+
+// Description of the value, or empty if the value is not in table 0163.
+func (v Table0163) Description() string {
+	return tableDescription(`0163`, string(v))
+}
+
+// Valid reports if the value is in table 0163.
+func (v Table0163) Valid() bool {
+	return TableValueLookup[`0163`][string(v)]
+}
+
+// Table 0163.
+func (v Table0163) Table() Table {
+	return TableLookup[`0163`]
+}
+
+// Table0164 is a value of table 0164, Administration Device.
+// Fields in this table are strings; convert a field, such as Table0164(v), to use the helpers.
+type Table0164 string
+
+const (
+	Table0164Applicator     Table0164 = `AP`   // Applicator
+	Table0164Buretrol       Table0164 = `BT`   // Buretrol
+	Table0164HeparinLock    Table0164 = `HL`   // Heparin Lock
+	Table0164IPPB           Table0164 = `IPPB` // IPPB
+	Table0164IVPump         Table0164 = `IVP`  // IV Pump
+	Table0164IVSoluset      Table0164 = `IVS`  // IV Soluset
+	Table0164MeteredInhaler Table0164 = `MI`   // Metered Inhaler
+	Table0164Nebulizer      Table0164 = `NEB`  // Nebulizer
+	Table0164PCAPump        Table0164 = `PCA`  // PCA Pump
+)
+
+// Description of the value, or empty if the value is not in table 0164.
+func (v Table0164) Description() string {
+	return tableDescription(`0164`, string(v))
+}
+
+// Valid reports if the value is in table 0164.
+func (v Table0164) Valid() bool {
+	return TableValueLookup[`0164`][string(v)]
+}
+
+// Table 0164.
+func (v Table0164) Table() Table {
+	return TableLookup[`0164`]
+}
+
+// Table0165 is a value of table 0165, Administration Method.
+// Fields in this table are strings; convert a field, such as Table0165(v), to use the helpers.
+type Table0165 string
+
+const (
+	Table0165Chew        Table0165 = `CH`   // Chew
+	Table0165Dissolve    Table0165 = `DI`   // Dissolve
+	Table0165Dust        Table0165 = `DU`   // Dust
+	Table0165Infiltrate  Table0165 = `IF`   // Infiltrate
+	Table0165Irrigate    Table0165 = `IR`   // Irrigate
+	Table0165Insert      Table0165 = `IS`   // Insert
+	Table0165IVPush      Table0165 = `IVP`  // IV Push
+	Table0165IVPiggyback Table0165 = `IVPB` // IV Piggyback
+	Table0165Nebulized   Table0165 = `NB`   // Nebulized
+	Table0165Perfuse     Table0165 = `PF`   // Perfuse
+	Table0165Paint       Table0165 = `PT`   // Paint
+	Table0165Shampoo     Table0165 = `SH`   // Shampoo
+	Table0165Soak        Table0165 = `SO`   // Soak
+	Table0165Wash        Table0165 = `WA`   // Wash
+	Table0165Wipe        Table0165 = `WI`   // Wipe
+)
+
+// Description of the value, or empty if the value is not in table 0165.
+func (v Table0165) Description() string {
+	return tableDescription(`0165`, string(v))
+}
+
+// Valid reports if the value is in table 0165.
+func (v Table0165) Valid() bool {
+	return TableValueLookup[`0165`][string(v)]
+}
+
+// Table 0165.
+func (v Table0165) Table() Table {
+	return TableLookup[`0165`]
+}
+
+// Table0166 is a value of table 0166, RX Component Type.
+// Fields in this table are strings; convert a field, such as Table0166(v), to use the helpers.
+type Table0166 string
+
+const (
+	Table0166Additive Table0166 = `A` // Additive
+	Table0166Base     Table0166 = `B` // Base
+)
+
+// Description of the value, or empty if the value is not in table 0166.
+func (v Table0166) Description() string {
+	return tableDescription(`0166`, string(v))
+}
+
+// Valid reports if the value is in table 0166.
+func (v Table0166) Valid() bool {
+	return TableValueLookup[`0166`][string(v)]
+}
+
+// Table 0166.
+func (v Table0166) Table() Table {
+	return TableLookup[`0166`]
+}
+
+// Table0167 is a value of table 0167, Substitution Status.
+// Fields in this table are strings; convert a field, such as Table0167(v), to use the helpers.
+type Table0167 string
+
+const (
+	Table0167NoProductSelectionIndicated                           Table0167 = `0` // No product selection indicated
+	Table0167SubstitutionNotAllowedByPrescriber                    Table0167 = `1` // Substitution not allowed by prescriber
+	Table0167SubstitutionAllowedPatientRequestedProductDispensed   Table0167 = `2` // Substitution allowed - patient requested product dispensed
+	Table0167SubstitutionAllowedPharmacistSelectedProductDispensed Table0167 = `3` // Substitution allowed - pharmacist selected product dispensed
+	Table0167SubstitutionAllowedGenericDrugNotIn                   Table0167 = `4` // Substitution allowed - generic drug not in stock
+	Table0167SubstitutionAllowedBrandDrugDispensedAs               Table0167 = `5` // Substitution allowed - brand drug dispensed as a generic
+	Table0167SubstitutionNotAllowedBrandDrugMandated               Table0167 = `7` // Substitution not allowed - brand drug mandated by law
+	Table0167SubstitutionAllowedGenericDrugNotAvailable            Table0167 = `8` // Substitution allowed - generic drug not available in marketplace
+	Table0167AGenericSubstitutionWasDispensed                      Table0167 = `G` // A generic substitution was dispensed.
+	Table0167NoSubstituteWasDispensedThisIs                        Table0167 = `N` // No substitute was dispensed.  This is equivalent to the default (null) value.
+	Table0167ATherapeuticSubstitutionWasDispensed                  Table0167 = `T` // A therapeutic substitution was dispensed.
+)
+
+// Description of the value, or empty if the value is not in table 0167.
+func (v Table0167) Description() string {
+	return tableDescription(`0167`, string(v))
+}
+
+// Valid reports if the value is in table 0167.
+func (v Table0167) Valid() bool {
+	return TableValueLookup[`0167`][string(v)]
+}
+
+// Table 0167.
+func (v Table0167) Table() Table {
+	return TableLookup[`0167`]
+}
+
+// Table0168 is a value of table 0168, Processing Priority.
+// Fields in this table are strings; convert a field, such as Table0168(v), to use the helpers.
+type Table0168 string
+
+const (
+	Table0168AsSoonAsPossibleAPriority         Table0168 = `A` // As soon as possible (a priority lower than stat)
+	Table0168DoAtBedsideOrPortableMay          Table0168 = `B` // Do at bedside or portable (may be used with other codes)
+	Table0168MeasureContinuouslyEGArterialLine Table0168 = `C` // Measure continuously (e.g., arterial line blood pressure)
+	Table0168PreoperativeToBeDonePriorTo       Table0168 = `P` // Preoperative (to be done prior to surgery)
+	Table0168Routine                           Table0168 = `R` // Routine
+	Table0168StatDoImmediately                 Table0168 = `S` // Stat (do immediately)
+	Table0168TimingCriticalDoAsNearAs          Table0168 = `T` // Timing critical (do as near as possible to requested time)
+)
+
+// Description of the value, or empty if the value is not in table 0168.
+func (v Table0168) Description() string {
+	return tableDescription(`0168`, string(v))
+}
+
+// Valid reports if the value is in table 0168.
+func (v Table0168) Valid() bool {
+	return TableValueLookup[`0168`][string(v)]
+}
+
+// Table 0168.
+func (v Table0168) Table() Table {
+	return TableLookup[`0168`]
+}
+
+// Table0169 is a value of table 0169, Reporting Priority.
+// Fields in this table are strings; convert a field, such as Table0169(v), to use the helpers.
+type Table0169 string
+
+const (
+	Table0169CallBackResults Table0169 = `C` // Call back results
+	Table0169RushReporting   Table0169 = `R` // Rush reporting
+)
+
+// Description of the value, or empty if the value is not in table 0169.
+func (v Table0169) Description() string {
+	return tableDescription(`0169`, string(v))
+}
+
+// Valid reports if the value is in table 0169.
+func (v Table0169) Valid() bool {
+	return TableValueLookup[`0169`][string(v)]
+}
+
+// Table 0169.
+func (v Table0169) Table() Table {
+	return TableLookup[`0169`]
+}
+
+// Table0170 is a value of table 0170, Derived Specimen.
+// Fields in this table are strings; convert a field, such as Table0170(v), to use the helpers.
+type Table0170 string
+
+const (
+	Table0170ChildObservation  Table0170 = `C` // Child Observation
+	Table0170NotApplicable     Table0170 = `N` // Not Applicable
+	Table0170ParentObservation Table0170 = `P` // Parent Observation
+)
+
+// Description of the value, or empty if the value is not in table 0170.
+func (v Table0170) Description() string {
+	return tableDescription(`0170`, string(v))
+}
+
+// Valid reports if the value is in table 0170.
+func (v Table0170) Valid() bool {
+	return TableValueLookup[`0170`][string(v)]
+}
+
+// Table 0170.
+func (v Table0170) Table() Table {
+	return TableLookup[`0170`]
+}
+
+// Table0173 is a value of table 0173, Coordination of Benefits.
+// Fields in this table are strings; convert a field, such as Table0173(v), to use the helpers.
+type Table0173 string
+
+const (
+	Table0173Coordination Table0173 = `CO` // Coordination
+	Table0173Independent  Table0173 = `IN` // Independent
+)
+
+// Description of the value, or empty if the value is not in table 0173.
+func (v Table0173) Description() string {
+	return tableDescription(`0173`, string(v))
+}
+
+// Valid reports if the value is in table 0173.
+func (v Table0173) Valid() bool {
+	return TableValueLookup[`0173`][string(v)]
+}
+
+// Table 0173.
+func (v Table0173) Table() Table {
+	return TableLookup[`0173`]
+}
+
+// Table0174 is a value of table 0174, Nature of Service/Test/Observation.
+// Fields in this table are strings; convert a field, such as Table0174(v), to use the helpers.
+type Table0174 string
+
+const (
+	Table0174AtomicServiceTestObservationTestCode Table0174 = `A` // Atomic service/test/observation (test code or treatment code)
+	Table0174SingleObservationCalculatedViaARule  Table0174 = `C` // Single observation calculated via a rule or formula from other independent observations (e.g., Alveolar-arterial ratio, cardiac output)
+	Table0174FunctionalProcedureThatMayConsistOf  Table0174 = `F` // Functional procedure that may consist of one or more interrelated measures (e.g., glucose tolerance test, creatinine clearance), usually done at different times and/or on different specimens
+	Table0174ProfileOrBatteryConsistingOfMany     Table0174 = `P` // Profile or battery consisting of many independent atomic observations (e.g., SMA12, electrolytes), usually done at one instrument on one specimen
+	Table0174SupersetASetOfBatteriesOr            Table0174 = `S` // Superset-a set of batteries or procedures ordered under a single code unit but processed as separate batteries (e.g., routines = CBC, UA, electrolytes)<p>This set indicates that the code being described is used to order multiple service/test/observation b
+)
+
+// Description of the value, or empty if the value is not in table 0174.
+func (v Table0174) Description() string {
+	return tableDescription(`0174`, string(v))
+}
+
+// Valid reports if the value is in table 0174.
+func (v Table0174) Valid() bool {
+	return TableValueLookup[`0174`][string(v)]
+}
+
+// Table 0174.
+func (v Table0174) Table() Table {
+	return TableLookup[`0174`]
+}
+
+// Table0175 is a value of table 0175, Master File Identifier Code.
+// Fields in this table are strings; convert a field, such as Table0175(v), to use the helpers.
+type Table0175 string
+
+const (
+	Table0175ChargeDescriptionMasterFile           Table0175 = `CDM` // Charge description master file
+	Table0175ClinicMasterFile                      Table0175 = `CLN` // Clinic master file
+	Table0175ClinicalStudyWithPhasesAndScheduled   Table0175 = `CMA` // Clinical study with phases and scheduled master file
+	Table0175ClinicalStudyWithoutPhasesButWith     Table0175 = `CMB` // Clinical study without phases but with scheduled master file
+	Table0175InventoryMasterFile                   Table0175 = `INV` // Inventory master file
+	Table0175LocationMasterFile                    Table0175 = `LOC` // Location master file
+	Table0175NumericalObservationMasterFile        Table0175 = `OMA` // Numerical observation master file
+	Table0175CategoricalObservationMasterFile      Table0175 = `OMB` // Categorical observation master file
+	Table0175ObservationBatteriesMasterFile        Table0175 = `OMC` // Observation batteries master file
+	Table0175CalculatedObservationsMasterFile      Table0175 = `OMD` // Calculated observations master file
+	Table0175OtherObservationServiceItemMasterFile Table0175 = `OME` // Other Observation/Service Item master file
+	Table0175PractitionerMasterFile                Table0175 = `PRA` // Practitioner master file
+	Table0175StaffMasterFile                       Table0175 = `STF` // Staff master file
+)
+
+// Description of the value, or empty if the value is not in table 0175.
+func (v Table0175) Description() string {
+	return tableDescription(`0175`, string(v))
+}
+
+// Valid reports if the value is in table 0175.
+func (v Table0175) Valid() bool {
+	return TableValueLookup[`0175`][string(v)]
+}
+
+// Table 0175.
+func (v Table0175) Table() Table {
+	return TableLookup[`0175`]
+}
+
+// Table0177 is a value of table 0177, Confidentiality Code.
+// Fields in this table are strings; convert a field, such as Table0177(v), to use the helpers.
+type Table0177 string
+
+const (
+	Table0177AIDSPatient                    Table0177 = `AID` // AIDS patient
+	Table0177Employee                       Table0177 = `EMP` // Employee
+	Table0177AlcoholDrugTreatmentPatient    Table0177 = `ETH` // Alcohol/drug treatment patient
+	Table0177HIVPatient                     Table0177 = `HIV` // HIV(+) patient
+	Table0177PsychiatricPatient             Table0177 = `PSY` // Psychiatric patient
+	Table0177Restricted                     Table0177 = `R`   // Restricted
+	Table0177UsualControl                   Table0177 = `U`   // Usual control
+	Table0177UnwedMother                    Table0177 = `UWM` // Unwed mother
+	Table0177VeryRestricted                 Table0177 = `V`   // Very restricted
+	Table0177VeryImportantPersonOrCelebrity Table0177 = `VIP` // Very important person or celebrity
+)
+
+// Description of the value, or empty if the value is not in table 0177.
+func (v Table0177) Description() string {
+	return tableDescription(`0177`, string(v))
+}
+
+// Valid reports if the value is in table 0177.
+func (v Table0177) Valid() bool {
+	return TableValueLookup[`0177`][string(v)]
+}
+
+// Table 0177.
+func (v Table0177) Table() Table {
+	return TableLookup[`0177`]
+}
+
+// Table0178 is a value of table 0178, File Level Event Code.
+// Fields in this table are strings; convert a field, such as Table0178(v), to use the helpers.
+type Table0178 string
+
+const (
+	Table0178ReplaceCurrentVersionOfThisMaster Table0178 = `REP` // Replace current version of this master file with the version contained in this message
+	Table0178ChangeFileRecordsAsDefinedIn      Table0178 = `UPD` // Change file records as defined in the record-level event codes for each record that follows
+)
+
+// Description of the value, or empty if the value is not in table 0178.
+func (v Table0178) Description() string {
+	return tableDescription(`0178`, string(v))
+}
+
+// Valid reports if the value is in table 0178.
+func (v Table0178) Valid() bool {
+	return TableValueLookup[`0178`][string(v)]
+}
+
+// Table 0178.
+func (v Table0178) Table() Table {
+	return TableLookup[`0178`]
+}
+
+// Table0179 is a value of table 0179, Response Level.
+// Fields in this table are strings; convert a field, such as Table0179(v), to use the helpers.
+type Table0179 string
+
+const (
+	Table0179AlwaysAllMFASegmentsWhetherDenoting   Table0179 = `AL` // Always.  All MFA segments (whether denoting errors or not) must be returned via the application-level acknowledgment message
+	Table0179ErrorRejectConditionsOnlyOnlyMFA      Table0179 = `ER` // Error/Reject conditions only.  Only MFA segments denoting errors must be returned via the application-level acknowledgment for this message
+	Table0179NeverNoApplicationLevelResponseNeeded Table0179 = `NE` // Never.  No application-level response needed
+	Table0179SuccessOnlyMFASegmentsDenotingSuccess Table0179 = `SU` // Success.  Only MFA segments denoting success must be returned via the application-level acknowledgment for this message
+)
+
+// Description of the value, or empty if the value is not in table 0179.
+func (v Table0179) Description() string {
+	return tableDescription(`0179`, string(v))
+}
+
+// Valid reports if the value is in table 0179.
+func (v Table0179) Valid() bool {
+	return TableValueLookup[`0179`][string(v)]
+}
+
+// Table 0179.
+func (v Table0179) Table() Table {
+	return TableLookup[`0179`]
+}
+
+// Table0180 is a value of table 0180, Record-level Event Code.
+// Fields in this table are strings; convert a field, such as Table0180(v), to use the helpers.
+type Table0180 string
+
+const (
+	Table0180ReactivateDeactivatedRecord              Table0180 = `MAC` // Reactivate deactivated record
+	Table0180AddRecordToMasterFile                    Table0180 = `MAD` // Add record to master file
+	Table0180DeactivateDiscontinueUsingRecordInMaster Table0180 = `MDC` // Deactivate: discontinue using record in master file, but do not delete from database
+	Table0180DeleteRecordFromMasterFile               Table0180 = `MDL` // Delete record from master file
+	Table0180UpdateRecordForMasterFile                Table0180 = `MUP` // Update record for master file
+)
+
+// Description of the value, or empty if the value is not in table 0180.
+func (v Table0180) Description() string {
+	return tableDescription(`0180`, string(v))
+}
+
+// Valid reports if the value is in table 0180.
+func (v Table0180) Valid() bool {
+	return TableValueLookup[`0180`][string(v)]
+}
+
+// Table 0180.
+func (v Table0180) Table() Table {
+	return TableLookup[`0180`]
+}
+
+// Table0181 is a value of table 0181, MFN Record-level Error Return.
+// Fields in this table are strings; convert a field, such as Table0181(v), to use the helpers.
+type Table0181 string
+
+const (
+	Table0181SuccessfulPostingOfTheRecordDefined   Table0181 = `S` // Successful posting of the record defined by the MFE segment
+	Table0181UnsuccessfulPostingOfTheRecordDefined Table0181 = `U` // Unsuccessful posting of the record defined by the MFE segment
+)
+
+// Description of the value, or empty if the value is not in table 0181.
+func (v Table0181) Description() string {
+	return tableDescription(`0181`, string(v))
+}
+
+// Valid reports if the value is in table 0181.
+func (v Table0181) Valid() bool {
+	return TableValueLookup[`0181`][string(v)]
+}
+
+// Table 0181.
+func (v Table0181) Table() Table {
+	return TableLookup[`0181`]
+}
+
+// Table0183 is a value of table 0183, Active/Inactive.
+// Fields in this table are strings; convert a field, such as Table0183(v), to use the helpers.
+type Table0183 string
+
+const (
+	Table0183ActiveStaff   Table0183 = `A` // Active Staff
+	Table0183InactiveStaff Table0183 = `I` // Inactive Staff
+)
+
+// Description of the value, or empty if the value is not in table 0183.
+func (v Table0183) Description() string {
+	return tableDescription(`0183`, string(v))
+}
+
+// Valid reports if the value is in table 0183.
+func (v Table0183) Valid() bool {
+	return TableValueLookup[`0183`][string(v)]
+}
+
+// Table 0183.
+func (v Table0183) Table() Table {
+	return TableLookup[`0183`]
+}
+
+// Table0185 is a value of table 0185, Preferred Method of Contact.
+// Fields in this table are strings; convert a field, such as Table0185(v), to use the helpers.
+type Table0185 string
+
+const (
+	Table0185BeeperNumber                         Table0185 = `B` // Beeper Number
+	Table0185CellularPhoneNumber                  Table0185 = `C` // Cellular Phone Number
+	Table0185EMailAddressForBackwardCompatibility Table0185 = `E` // E-Mail Address (for backward compatibility)
+	Table0185FAXNumber                            Table0185 = `F` // FAX Number
+	Table0185HomePhoneNumber                      Table0185 = `H` // Home Phone Number
+	Table0185OfficePhoneNumber                    Table0185 = `O` // Office Phone Number
+)
+
+// Description of the value, or empty if the value is not in table 0185.
+func (v Table0185) Description() string {
+	return tableDescription(`0185`, string(v))
+}
+
+// Valid reports if the value is in table 0185.
+func (v Table0185) Valid() bool {
+	return TableValueLookup[`0185`][string(v)]
+}
+
+// Table 0185.
+func (v Table0185) Table() Table {
+	return TableLookup[`0185`]
+}
+
+// Table0187 is a value of table 0187, Provider Billing.
+// Fields in this table are strings; convert a field, such as Table0187(v), to use the helpers.
+type Table0187 string
+
+const (
+	Table0187InstitutionBillsForProvider Table0187 = `I` // Institution bills for provider
+	Table0187ProviderDoesOwnBilling      Table0187 = `P` // Provider does own billing
+)
+
+// Description of the value, or empty if the value is not in table 0187.
+func (v Table0187) Description() string {
+	return tableDescription(`0187`, string(v))
+}
+
+// Valid reports if the value is in table 0187.
+func (v Table0187) Valid() bool {
+	return TableValueLookup[`0187`][string(v)]
+}
+
+// Table 0187.
+func (v Table0187) Table() Table {
+	return TableLookup[`0187`]
+}
+
+// Table0189 is a value of table 0189, Ethnic Group.
+// Fields in this table are strings; convert a field, such as Table0189(v), to use the helpers.
+type Table0189 string
+
+const (
+	Table0189HispanicOrLatino    Table0189 = `H` // Hispanic or Latino
+	Table0189NotHispanicOrLatino Table0189 = `N` // Not Hispanic or Latino
+	Table0189Unknown             Table0189 = `U` // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0189.
+func (v Table0189) Description() string {
+	return tableDescription(`0189`, string(v))
+}
+
+// Valid reports if the value is in table 0189.
+func (v Table0189) Valid() bool {
+	return TableValueLookup[`0189`][string(v)]
+}
+
+// Table 0189.
+func (v Table0189) Table() Table {
+	return TableLookup[`0189`]
+}
+
+// Table0190 is a value of table 0190, Address Type.
+// Fields in this table are strings; convert a field, such as Table0190(v), to use the helpers.
+type Table0190 string
+
+const (
+	Table0190FirmBusiness                           Table0190 = `B`   // Firm/Business
+	Table0190BadAddress                             Table0190 = `BA`  // Bad address
+	Table0190BirthDeliveryLocationAddressWhereBirth Table0190 = `BDL` // Birth delivery location  (address where birth occurred)
+	Table0190BillingAddress                         Table0190 = `BI`  // Billing Address
+	Table0190ResidenceAtBirthHomeAddressAt          Table0190 = `BR`  // Residence at birth (home address at time of birth)
+	Table0190CurrentOrTemporary                     Table0190 = `C`   // Current Or Temporary
+	Table0190CountryOfOrigin                        Table0190 = `F`   // Country Of Origin
+	Table0190Home                                   Table0190 = `H`   // Home
+	Table0190LegalAddress                           Table0190 = `L`   // Legal Address
+	Table0190Mailing                                Table0190 = `M`   // Mailing
+	Table0190BirthNeeBirthAddressNotOtherwise       Table0190 = `N`   // Birth (nee)  (birth address, not otherwise specified)
+	Table0190OfficeBusiness                         Table0190 = `O`   // Office/Business
+	Table0190Permanent                              Table0190 = `P`   // Permanent
+	Table0190RegistryHomeRefersToTheInformation     Table0190 = `RH`  // Registry home. Refers to the information system, typically managed by a public health agency, that stores patient information such as immunization histories or cancer data, regardless of where the patient obtains services.
+	Table0190ServiceLocation                        Table0190 = `S`   // Service Location
+	Table0190ShippingAddress                        Table0190 = `SH`  // Shipping Address
+	Table0190TubeAddress                            Table0190 = `TM`  // Tube Address
+	Table0190Vacation                               Table0190 = `V`   // Vacation
+)
+
+// Description of the value, or empty if the value is not in table 0190.
+func (v Table0190) Description() string {
+	return tableDescription(`0190`, string(v))
+}
+
+// Valid reports if the value is in table 0190.
+func (v Table0190) Valid() bool {
+	return TableValueLookup[`0190`][string(v)]
+}
+
+// Table 0190.
+func (v Table0190) Table() Table {
+	return TableLookup[`0190`]
+}
+
+// Table0191 is a value of table 0191, Type of Referenced Data.
+// Fields in this table are strings; convert a field, such as Table0191(v), to use the helpers.
+type Table0191 string
+
+const (
+	Table0191OtherApplicationDataTypicallyUninterpretedBinary Table0191 = `AP`        // Other application data, typically uninterpreted binary data (HL7 V2.3 and later)
+	Table0191AudioDataHL7V23And                               Table0191 = `AU`        // Audio data (HL7 V2.3 and later)
+	Table0191FormattedTextHL7V22Only                          Table0191 = `FT`        // Formatted text (HL7 V2.2 only)
+	Table0191ImageDataHL7V23And                               Table0191 = `IM`        // Image data (HL7 V2.3 and later)
+	Table0191MIMEMultipartPackage                             Table0191 = `multipart` // MIME multipart package
+	Table0191NonScannedImageHL7V22                            Table0191 = `NS`        // Non-scanned image (HL7 V2.2 only)
+	Table0191ScannedDocumentHL7V22Only                        Table0191 = `SD`        // Scanned document (HL7 V2.2 only)
+	Table0191ScannedImageHL7V22Only                           Table0191 = `SI`        // Scanned image (HL7 V2.2 only)
+	Table0191MachineReadableTextDocumentHL7V2                 Table0191 = `TEXT`      // Machine readable text document (HL7 V2.3.1 and later)
+	Table0191MachineReadableTextDocumentHL7V2_TX              Table0191 = `TX`        // Machine readable text document (HL7 V2.2 only)
+)
+
+// Description of the value, or empty if the value is not in table 0191.
+func (v Table0191) Description() string {
+	return tableDescription(`0191`, string(v))
+}
+
+// Valid reports if the value is in table 0191.
+func (v Table0191) Valid() bool {
+	return TableValueLookup[`0191`][string(v)]
+}
+
+// Table 0191.
+func (v Table0191) Table() Table {
+	return TableLookup[`0191`]
+}
+
+// Table0193 is a value of table 0193, Amount Class.
+// Fields in this table are strings; convert a field, such as Table0193(v), to use the helpers.
+type Table0193 string
+
+const (
+	Table0193Amount     Table0193 = `AT` // Amount
+	Table0193Limit      Table0193 = `LM` // Limit
+	Table0193Percentage Table0193 = `PC` // Percentage
+	Table0193Unlimited  Table0193 = `UL` // Unlimited
+)
+
+// Description of the value, or empty if the value is not in table 0193.
+func (v Table0193) Description() string {
+	return tableDescription(`0193`, string(v))
+}
+
+// Valid reports if the value is in table 0193.
+func (v Table0193) Valid() bool {
+	return TableValueLookup[`0193`][string(v)]
+}
+
+// Table 0193.
+func (v Table0193) Table() Table {
+	return TableLookup[`0193`]
+}
+
+// Table0200 is a value of table 0200, Name Type.
+// Fields in this table are strings; convert a field, such as Table0200(v), to use the helpers.
+type Table0200 string
+
+const (
+	Table0200Assigned               Table0200 = `A`     // Assigned
+	Table0200BirthName              Table0200 = `B`     // Birth name
+	Table0200BadName                Table0200 = `BAD`   // Bad Name
+	Table0200AdoptedName            Table0200 = `C`     // Adopted Name
+	Table0200CustomaryName          Table0200 = `D`     // Customary Name
+	Table0200LicensingName          Table0200 = `I`     // Licensing Name
+	Table0200BusinessName           Table0200 = `K`     // Business name
+	Table0200OfficialRegistryName   Table0200 = `L`     // Official Registry Name
+	Table0200MaidenName             Table0200 = `M`     // Maiden Name
+	Table0200Masked                 Table0200 = `MSK`   // Masked
+	Table0200Nickname               Table0200 = `N`     // Nickname
+	Table0200TemporarilyUnavailable Table0200 = `NAV`   // Temporarily Unavailable
+	Table0200NewbornName            Table0200 = `NB`    // Newborn Name
+	Table0200NoLongerToBeUsed       Table0200 = `NOUSE` // No Longer To Be Used
+	Table0200NameOfPartnerSpouse    Table0200 = `P`     // Name of Partner/Spouse
+	Table0200RegisteredName         Table0200 = `R`     // Registered Name
+	Table0200Religious              Table0200 = `REL`   // Religious
+	Table0200Pseudonym              Table0200 = `S`     // Pseudonym
+	Table0200IndigenousTribal       Table0200 = `T`     // Indigenous/Tribal
+	Table0200TemporaryName          Table0200 = `TEMP`  // Temporary Name
+	Table0200Unknown                Table0200 = `U`     // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0200.
+func (v Table0200) Description() string {
+	return tableDescription(`0200`, string(v))
+}
+
+// Valid reports if the value is in table 0200.
+func (v Table0200) Valid() bool {
+	return TableValueLookup[`0200`][string(v)]
+}
+
+// Table 0200.
+func (v Table0200) Table() Table {
+	return TableLookup[`0200`]
+}
+
+// Table0201 is a value of table 0201, Telecommunication Use Code.
+// Fields in this table are strings; convert a field, such as Table0201(v), to use the helpers.
+type Table0201 string
+
+const (
+	Table0201AnsweringServiceNumber Table0201 = `ASN` // Answering Service Number
+	Table0201BeeperNumber           Table0201 = `BPN` // Beeper Number
+	Table0201EmergencyNumber        Table0201 = `EMR` // Emergency Number
+	Table0201NetworkEmailAddress    Table0201 = `NET` // Network (email) Address
+	Table0201OtherResidenceNumber   Table0201 = `ORN` // Other Residence Number
+	Table0201PrimaryResidenceNumber Table0201 = `PRN` // Primary Residence Number
+	Table0201Personal               Table0201 = `PRS` // Personal
+	Table0201VacationHomeNumber     Table0201 = `VHN` // Vacation Home Number
+	Table0201WorkNumber             Table0201 = `WPN` // Work Number
+)
+
+// Description of the value, or empty if the value is not in table 0201.
+func (v Table0201) Description() string {
+	return tableDescription(`0201`, string(v))
+}
+
+// Valid reports if the value is in table 0201.
+func (v Table0201) Valid() bool {
+	return TableValueLookup[`0201`][string(v)]
+}
+
+// Table 0201.
+func (v Table0201) Table() Table {
+	return TableLookup[`0201`]
+}
+
+// Table0202 is a value of table 0202, Telecommunication Equipment Type.
+// Fields in this table are strings; convert a field, such as Table0202(v), to use the helpers.
+type Table0202 string
+
+const (
+	Table0202Beeper                             Table0202 = `BP`       // Beeper
+	Table0202CellularOrMobilePhone              Table0202 = `CP`       // Cellular or Mobile Phone
+	Table0202Fax                                Table0202 = `FX`       // Fax
+	Table0202InternetAddress                    Table0202 = `Internet` // Internet Address
+	Table0202Modem                              Table0202 = `MD`       // Modem
+	Table0202Telephone                          Table0202 = `PH`       // Telephone
+	Table0202SatellitePhone                     Table0202 = `SAT`      // Satellite Phone
+	Table0202TelecommunicationsDeviceForTheDeaf Table0202 = `TDD`      // Telecommunications Device for the Deaf
+	Table0202Teletypewriter                     Table0202 = `TTY`      // Teletypewriter
+	Table0202X400EmailAddress                   Table0202 = `X.400`    // X.400 email address
+)
+
+// Description of the value, or empty if the value is not in table 0202.
+func (v Table0202) Description() string {
+	return tableDescription(`0202`, string(v))
+}
+
+// Valid reports if the value is in table 0202.
+func (v Table0202) Valid() bool {
+	return TableValueLookup[`0202`][string(v)]
+}
+
+// Table 0202.
+func (v Table0202) Table() Table {
+	return TableLookup[`0202`]
+}
+
+// Table0203 is a value of table 0203, Identifier Type.
+// Fields in this table are strings; convert a field, such as Table0203(v), to use the helpers.
+type Table0203 string
+
+const (
+	Table0203AccessionID                                       Table0203 = `ACSN` // Accession ID
+	Table0203AmericanExpress                                   Table0203 = `AM`   // American Express
+	Table0203AmericanMedicalAssociationNumber                  Table0203 = `AMA`  // American Medical Association Number
+	Table0203AccountNumber                                     Table0203 = `AN`   // Account number
+	Table0203AnIdentifierForAProvi                             Table0203 = `An Identifier for a provi`
+	Table0203AccountNumberCreditor                             Table0203 = `ANC`   // Account number Creditor
+	Table0203AccountNumberDebitor                              Table0203 = `AND`   // Account number debitor
+	Table0203AnonymousIdentifier                               Table0203 = `ANON`  // Anonymous identifier
+	Table0203TemporaryAccountNumber                            Table0203 = `ANT`   // Temporary Account Number
+	Table0203AdvancedPracticeRegisteredNurseNumber             Table0203 = `APRN`  // Advanced Practice Registered Nurse number
+	Table0203AncestorSpecimenID                                Table0203 = `ASID`  // Ancestor Specimen ID
+	Table0203BankAccountNumber                                 Table0203 = `BA`    // Bank Account Number
+	Table0203BankCardNumber                                    Table0203 = `BC`    // Bank Card Number
+	Table0203BirthCertificate                                  Table0203 = `BCT`   // Birth Certificate
+	Table0203BirthRegistryNumber                               Table0203 = `BR`    // Birth registry number
+	Table0203BreedRegistryNumber                               Table0203 = `BRN`   // Breed Registry Number
+	Table0203PrimaryPhysicianOfficeNumber                      Table0203 = `BSNR`  // Primary physician office number
+	Table0203CostCenterNumber                                  Table0203 = `CC`    // Cost Center number
+	Table0203ChangeOfNameDocument                              Table0203 = `CONM`  // Change of Name Document
+	Table0203CountyNumber                                      Table0203 = `CY`    // County number
+	Table0203CitizenshipCard                                   Table0203 = `CZ`    // Citizenship Card
+	Table0203DentistLicenseNumber                              Table0203 = `DDS`   // Dentist license number
+	Table0203DrugEnforcementAdministrationRegistrationNumber   Table0203 = `DEA`   // Drug Enforcement Administration registration number
+	Table0203DrugFurnishingOrPrescriptiveAuthorityNumber       Table0203 = `DFN`   // Drug Furnishing or prescriptive authority Number
+	Table0203DinerSClubCard                                    Table0203 = `DI`    // Diner's Club card
+	Table0203DriverSLicenseNumber                              Table0203 = `DL`    // Driver's license number
+	Table0203DoctorNumber                                      Table0203 = `DN`    // Doctor number
+	Table0203OsteopathicLicenseNumber                          Table0203 = `DO`    // Osteopathic License number
+	Table0203DiplomaticPassport                                Table0203 = `DP`    // Diplomatic Passport
+	Table0203PodiatristLicenseNumber                           Table0203 = `DPM`   // Podiatrist license number
+	Table0203DonorRegistrationNumber                           Table0203 = `DR`    // Donor Registration Number
+	Table0203DiscoverCard                                      Table0203 = `DS`    // Discover Card
+	Table0203EmployeeNumber                                    Table0203 = `EI`    // Employee number
+	Table0203EmployerNumber                                    Table0203 = `EN`    // Employer number
+	Table0203StaffEnterpriseNumber                             Table0203 = `ESN`   // Staff Enterprise Number
+	Table0203FacilityID                                        Table0203 = `FI`    // Facility ID
+	Table0203GuarantorInternalIdentifier                       Table0203 = `GI`    // Guarantor internal identifier
+	Table0203GeneralLedgerNumber                               Table0203 = `GL`    // General ledger number
+	Table0203GuarantorExternalIdentifier                       Table0203 = `GN`    // Guarantor external  identifier
+	Table0203HealthCardNumber                                  Table0203 = `HC`    // Health Card Number
+	Table0203IndigenousAboriginal                              Table0203 = `IND`   // Indigenous/Aboriginal
+	Table0203JurisdictionalHealthNumberCanada                  Table0203 = `JHN`   // Jurisdictional health number (Canada)
+	Table0203LaboratoryAccessionID                             Table0203 = `LACSN` // Laboratory Accession ID
+	Table0203LifelongPhysicianNumber                           Table0203 = `LANR`  // Lifelong physician number
+	Table0203LaborAndIndustriesNumber                          Table0203 = `LI`    // Labor and industries number
+	Table0203LicenseNumber                                     Table0203 = `LN`    // License number
+	Table0203LocalRegistryID                                   Table0203 = `LR`    // Local Registry ID
+	Table0203PatientMedicaidNumber                             Table0203 = `MA`    // Patient Medicaid number
+	Table0203MemberNumber                                      Table0203 = `MB`    // Member Number
+	Table0203PatientSMedicareNumber                            Table0203 = `MC`    // Patient's Medicare number
+	Table0203PractitionerMedicaidNumber                        Table0203 = `MCD`   // Practitioner Medicaid number
+	Table0203MicrochipNumber                                   Table0203 = `MCN`   // Microchip Number
+	Table0203PractitionerMedicareNumber                        Table0203 = `MCR`   // Practitioner Medicare number
+	Table0203MarriageCertificate                               Table0203 = `MCT`   // Marriage Certificate
+	Table0203MedicalLicenseNumber                              Table0203 = `MD`    // Medical License number
+	Table0203MilitaryIDNumber                                  Table0203 = `MI`    // Military ID number
+	Table0203MedicalRecordNumber                               Table0203 = `MR`    // Medical record number
+	Table0203TemporaryMedicalRecordNumber                      Table0203 = `MRT`   // Temporary Medical Record Number
+	Table0203MasterCard                                        Table0203 = `MS`    // MasterCard
+	Table0203SecondaryPhysicianOfficeNumber                    Table0203 = `NBSNR` // Secondary physician office number
+	Table0203NaturalizationCertificate                         Table0203 = `NCT`   // Naturalization Certificate
+	Table0203NationalEmployerIdentifier                        Table0203 = `NE`    // National employer identifier
+	Table0203NationalHealthPlanIdentifier                      Table0203 = `NH`    // National Health Plan Identifier
+	Table0203NationalUniqueIndividualIdentifier                Table0203 = `NI`    // National unique individual identifier
+	Table0203NationalInsuranceOrganizationIdentifier           Table0203 = `NII`   // National Insurance Organization Identifier
+	Table0203NationalInsurancePayorIdentifierPayor             Table0203 = `NIIP`  // National Insurance Payor Identifier (Payor)
+	Table0203NationalPersonIdentifierWhereTheXxx               Table0203 = `NNxxx` // National Person Identifier where the xxx is the ISO table 3166 3-character (alphabetic) country code
+	Table0203NursePractitionerNumber                           Table0203 = `NP`    // Nurse practitioner number
+	Table0203NationalProviderIdentifier                        Table0203 = `NPI`   // National provider identifier
+	Table0203OptometristLicenseNumber                          Table0203 = `OD`    // Optometrist license number
+	Table0203PhysicianAssistantNumber                          Table0203 = `PA`    // Physician Assistant number
+	Table0203ParoleCard                                        Table0203 = `PC`    // Parole Card
+	Table0203PenitentiaryCorrectionalInstitutionNumber         Table0203 = `PCN`   // Penitentiary/correctional institution Number
+	Table0203LivingSubjectEnterpriseNumber                     Table0203 = `PE`    // Living Subject Enterprise Number
+	Table0203PensionNumber                                     Table0203 = `PEN`   // Pension Number
+	Table0203PatientInternalIdentifier                         Table0203 = `PI`    // Patient internal identifier
+	Table0203PersonNumber                                      Table0203 = `PN`    // Person number
+	Table0203TemporaryLivingSubjectNumber                      Table0203 = `PNT`   // Temporary Living Subject Number
+	Table0203MedicareCMSPerformingProviderIdentificationNumber Table0203 = `PPIN`  // Medicare/CMS Performing Provider Identification Number
+	Table0203PassportNumber                                    Table0203 = `PPN`   // Passport number
+	Table0203PermanentResidentCardNumber                       Table0203 = `PRC`   // Permanent Resident Card Number
+	Table0203ProviderNumber                                    Table0203 = `PRN`   // Provider number
+	Table0203PatientExternalIdentifier                         Table0203 = `PT`    // Patient external identifier
+	Table0203QANumber                                          Table0203 = `QA`    // QA number
+	Table0203ResourceIdentifier                                Table0203 = `RI`    // Resource identifier
+	Table0203RegisteredNurseNumber                             Table0203 = `RN`    // Registered Nurse Number
+	Table0203PharmacistLicenseNumber                           Table0203 = `RPH`   // Pharmacist license number
+	Table0203RailroadRetirementNumber                          Table0203 = `RR`    // Railroad Retirement number
+	Table0203RegionalRegistryID                                Table0203 = `RRI`   // Regional registry ID
+	Table0203RailroadRetirementProvider                        Table0203 = `RRP`   // Railroad Retirement Provider
+	Table0203SpecimenID                                        Table0203 = `SID`   // Specimen ID
+	Table0203StateLicense                                      Table0203 = `SL`    // State license
+	Table0203SubscriberNumber                                  Table0203 = `SN`    // Subscriber Number
+	Table0203StudyPermit                                       Table0203 = `SP`    // Study Permit
+	Table0203StateRegistryID                                   Table0203 = `SR`    // State registry ID
+	Table0203SocialSecurityNumber                              Table0203 = `SS`    // Social Security number
+	Table0203TaxIDNumber                                       Table0203 = `TAX`   // Tax ID number
+	Table0203TreatyNumberCanada                                Table0203 = `TN`    // Treaty Number/ (Canada)
+	Table0203TemporaryPermanentResidentCanada                  Table0203 = `TPR`   // Temporary Permanent Resident (Canada)
+	Table0203UnspecifiedIdentifier                             Table0203 = `U`     // Unspecified identifier
+	Table0203MedicareCMSFormerlyHCFASUniversal                 Table0203 = `UPIN`  // Medicare/CMS (formerly HCFA)'s Universal Physician Identification numbers
+	Table0203UniqueSpecimenID                                  Table0203 = `USID`  // Unique Specimen ID
+	Table0203VisitNumber                                       Table0203 = `VN`    // Visit number
+	Table0203VisitorPermit                                     Table0203 = `VP`    // Visitor Permit
+	Table0203VISA                                              Table0203 = `VS`    // VISA
+	Table0203WICIdentifier                                     Table0203 = `WC`    // WIC identifier
+	Table0203WorkersCompNumber                                 Table0203 = `WCN`   // Workers' Comp Number
+	Table0203WorkPermit                                        Table0203 = `WP`    // Work Permit
+	Table0203OrganizationIdentifier                            Table0203 = `XX`    // Organization identifier
+)
+
+// Description of the value, or empty if the value is not in table 0203.
+func (v Table0203) Description() string {
+	return tableDescription(`0203`, string(v))
+}
+
+// Valid reports if the value is in table 0203.
+func (v Table0203) Valid() bool {
+	return TableValueLookup[`0203`][string(v)]
+}
+
+// Table 0203.
+func (v Table0203) Table() Table {
+	return TableLookup[`0203`]
+}
+
+// Table0204 is a value of table 0204, Organizational Name Type.
+// Fields in this table are strings; convert a field, such as Table0204(v), to use the helpers.
+type Table0204 string
+
+const (
+	Table0204AliasName                Table0204 = `A`  // Alias name
+	Table0204DisplayName              Table0204 = `D`  // Display name
+	Table0204LegalName                Table0204 = `L`  // Legal name
+	Table0204StockExchangeListingName Table0204 = `SL` // Stock exchange listing name
+)
+
+// Description of the value, or empty if the value is not in table 0204.
+func (v Table0204) Description() string {
+	return tableDescription(`0204`, string(v))
+}
+
+// Valid reports if the value is in table 0204.
+func (v Table0204) Valid() bool {
+	return TableValueLookup[`0204`][string(v)]
+}
+
+// Table 0204.
+func (v Table0204) Table() Table {
+	return TableLookup[`0204`]
+}
+
+// Table0205 is a value of table 0205, Price Type.
+// Fields in this table are strings; convert a field, such as Table0205(v), to use the helpers.
+type Table0205 string
+
+const (
+	Table0205AdministrativePriceOrHandlingFee     Table0205 = `AP` // administrative price or handling fee
+	Table0205DirectUnitCost                       Table0205 = `DC` // direct unit cost
+	Table0205IndirectUnitCost                     Table0205 = `IC` // indirect unit cost
+	Table0205ProfessionalFeeForPerformingProvider Table0205 = `PF` // professional fee for performing provider
+	Table0205TechnologyFeeForUseOfEquipment       Table0205 = `TF` // technology fee for use of equipment
+	Table0205TotalPrice                           Table0205 = `TP` // total price
+	Table0205UnitPriceMayBeBasedOn                Table0205 = `UP` // unit price, may be based on length of procedure or service
+)
+
+// Description of the value, or empty if the value is not in table 0205.
+func (v Table0205) Description() string {
+	return tableDescription(`0205`, string(v))
+}
+
+// Valid reports if the value is in table 0205.
+func (v Table0205) Valid() bool {
+	return TableValueLookup[`0205`][string(v)]
+}
+
+// Table 0205.
+func (v Table0205) Table() Table {
+	return TableLookup[`0205`]
+}
+
+// Table0206 is a value of table 0206, Segment action code.
+// Fields in this table are strings; convert a field, such as Table0206(v), to use the helpers.
+type Table0206 string
+
+const (
+	Table0206AddInsert Table0206 = `A` // Add/Insert
+	Table0206Delete    Table0206 = `D` // Delete
+	Table0206Update    Table0206 = `U` // Update
+	Table0206NoChange  Table0206 = `X` // No Change
+)
+
+// Description of the value, or empty if the value is not in table 0206.
+func (v Table0206) Description() string {
+	return tableDescription(`0206`, string(v))
+}
+
+// Valid reports if the value is in table 0206.
+func (v Table0206) Valid() bool {
+	return TableValueLookup[`0206`][string(v)]
+}
+
+// Table 0206.
+func (v Table0206) Table() Table {
+	return TableLookup[`0206`]
+}
+
+// Table0207 is a value of table 0207, Processing Mode.
+// Fields in this table are strings; convert a field, such as Table0207(v), to use the helpers.
+type Table0207 string
+
+const (
+	Table0207Archive                                          Table0207 = `A`           // Archive
+	Table0207InitialLoad                                      Table0207 = `I`           // Initial load
+	Table0207NotPresentTheDefaultMeaningCurrent               Table0207 = `Not present` // Not present (the default, meaning current  processing)
+	Table0207RestoreFromArchive                               Table0207 = `R`           // Restore from archive
+	Table0207CurrentProcessingTransmittedAtIntervalsScheduled Table0207 = `T`           // Current processing, transmitted at intervals (scheduled or on demand)
+)
+
+// Description of the value, or empty if the value is not in table 0207.
+func (v Table0207) Description() string {
+	return tableDescription(`0207`, string(v))
+}
+
+// Valid reports if the value is in table 0207.
+func (v Table0207) Valid() bool {
+	return TableValueLookup[`0207`][string(v)]
+}
+
+// Table 0207.
+func (v Table0207) Table() Table {
+	return TableLookup[`0207`]
+}
+
+// Table0208 is a value of table 0208, Query Response Status.
+// Fields in this table are strings; convert a field, such as Table0208(v), to use the helpers.
+type Table0208 string
+
+const (
+	Table0208ApplicationError        Table0208 = `AE` // Application error
+	Table0208ApplicationReject       Table0208 = `AR` // Application reject
+	Table0208NoDataFoundNoErrors     Table0208 = `NF` // No data found, no errors
+	Table0208DataFoundNoErrorsThisIs Table0208 = `OK` // Data found, no errors (this is the default)
+)
+
+// Description of the value, or empty if the value is not in table 0208.
+func (v Table0208) Description() string {
+	return tableDescription(`0208`, string(v))
+}
+
+// Valid reports if the value is in table 0208.
+func (v Table0208) Valid() bool {
+	return TableValueLookup[`0208`][string(v)]
+}
+
+// Table 0208.
+func (v Table0208) Table() Table {
+	return TableLookup[`0208`]
+}
+
+// Table0211 is a value of table 0211, Alternate Character Sets.
+// Fields in this table are strings; convert a field, such as Table0211(v), to use the helpers.
+type Table0211 string
+
+const (
+	Table0211ThePrintableCharactersFromTheISO        Table0211 = `8859/1`         // The printable characters from the ISO 8859/1 Character set
+	Table0211ThePrintableCharactersFromTheISO_885915 Table0211 = `8859/15`        // The printable characters from the ISO 8859/15 (Latin-15)
+	Table0211ThePrintableCharactersFromTheISO_88592  Table0211 = `8859/2`         // The printable characters from the ISO 8859/2 Character set
+	Table0211ThePrintableCharactersFromTheISO_88593  Table0211 = `8859/3`         // The printable characters from the ISO 8859/3 Character set
+	Table0211ThePrintableCharactersFromTheISO_88594  Table0211 = `8859/4`         // The printable characters from the ISO 8859/4 Character set
+	Table0211ThePrintableCharactersFromTheISO_88595  Table0211 = `8859/5`         // The printable characters from the ISO 8859/5 Character set
+	Table0211ThePrintableCharactersFromTheISO_88596  Table0211 = `8859/6`         // The printable characters from the ISO 8859/6 Character set
+	Table0211ThePrintableCharactersFromTheISO_88597  Table0211 = `8859/7`         // The printable characters from the ISO 8859/7 Character set
+	Table0211ThePrintableCharactersFromTheISO_88598  Table0211 = `8859/8`         // The printable characters from the ISO 8859/8 Character set
+	Table0211ThePrintableCharactersFromTheISO_88599  Table0211 = `8859/9`         // The printable characters from the ISO 8859/9 Character set
+	Table0211ThePrintable7BitASCIICharacter          Table0211 = `ASCII`          // The printable 7-bit ASCII character set.
+	Table0211CodeForTaiwaneseCharacterSetBIG         Table0211 = `BIG-5`          // Code for Taiwanese Character Set (BIG-5)
+	Table0211CodeForTaiwaneseCharacterSetCNS         Table0211 = `CNS 11643-1992` // Code for Taiwanese Character Set (CNS 11643-1992)
+	Table0211CodeForChineseCharacterSetGB            Table0211 = `GB 18030-2000`  // Code for Chinese Character Set (GB 18030-2000)
+	Table0211CodeForInformationExchangeOneByte       Table0211 = `ISO IR14`       // Code for Information Exchange (one byte)(JIS X 0201-1976).
+	Table0211CodeOfTheSupplementaryJapaneseGraphic   Table0211 = `ISO IR159`      // Code of the supplementary Japanese Graphic Character set for information interchange (JIS X 0212-1990).
+	Table0211ASCIIGraphicCharacterSetConsistingOf    Table0211 = `ISO IR6`        // ASCII graphic character set consisting of 94 characters.
+	Table0211CodeForTheJapaneseGraphicCharacter      Table0211 = `ISO IR87`       // Code for the Japanese Graphic Character set for information interchange (JIS X 0208-1990),
+	Table0211CodeForKoreanCharacterSetKS             Table0211 = `KS X 1001`      // Code for Korean Character Set (KS X 1001)
+	Table0211TheWorldWideCharacterStandardFrom       Table0211 = `UNICODE`        // The world wide character standard from ISO/IEC 10646-1-1993
+	Table0211UCSTransformationFormat16BitForm        Table0211 = `UNICODE UTF-16` // UCS Transformation Format, 16-bit form
+	Table0211UCSTransformationFormat32BitForm        Table0211 = `UNICODE UTF-32` // UCS Transformation Format, 32-bit form
+	Table0211UCSTransformationFormat8BitForm         Table0211 = `UNICODE UTF-8`  // UCS Transformation Format, 8-bit form
+)
+
+// Description of the value, or empty if the value is not in table 0211.
+func (v Table0211) Description() string {
+	return tableDescription(`0211`, string(v))
+}
+
+// Valid reports if the value is in table 0211.
+func (v Table0211) Valid() bool {
+	return TableValueLookup[`0211`][string(v)]
+}
+
+// Table 0211.
+func (v Table0211) Table() Table {
+	return TableLookup[`0211`]
+}
+
+// Table0213 is a value of table 0213, Purge Status Code.
+// Fields in this table are strings; convert a field, such as Table0213(v), to use the helpers.
+type Table0213 string
+
+const (
+	Table0213TheVisitIsMarkedForDeletion Table0213 = `D` // The visit is marked for deletion and the user cannot enter new data against it.
+	Table0213TheVisitIsMarkedInactiveAnd Table0213 = `I` // The visit is marked inactive and the user cannot enter new data against it.
+	Table0213MarkedForPurgeUserIsNo      Table0213 = `P` // Marked for purge.  User is no longer able to update the visit.
+)
+
+// Description of the value, or empty if the value is not in table 0213.
+func (v Table0213) Description() string {
+	return tableDescription(`0213`, string(v))
+}
+
+// Valid reports if the value is in table 0213.
+func (v Table0213) Valid() bool {
+	return TableValueLookup[`0213`][string(v)]
+}
+
+// Table 0213.
+func (v Table0213) Table() Table {
+	return TableLookup[`0213`]
+}
+
+// Table0214 is a value of table 0214, Special Program Code.
+// Fields in this table are strings; convert a field, such as Table0214(v), to use the helpers.
+type Table0214 string
+
+const (
+	Table0214ChildHealthAssistance  Table0214 = `CH` // Child Health Assistance
+	Table0214ElectiveSurgeryProgram Table0214 = `ES` // Elective Surgery Program
+	Table0214FamilyPlanning         Table0214 = `FP` // Family Planning
+	Table0214Other                  Table0214 = `O`  // Other
+	Table0214Unknown                Table0214 = `U`  // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0214.
+func (v Table0214) Description() string {
+	return tableDescription(`0214`, string(v))
+}
+
+// Valid reports if the value is in table 0214.
+func (v Table0214) Valid() bool {
+	return TableValueLookup[`0214`][string(v)]
+}
+
+// Table 0214.
+func (v Table0214) Table() Table {
+	return TableLookup[`0214`]
+}
+
+// Table0215 is a value of table 0215, Publicity Code.
+// Fields in this table are strings; convert a field, such as Table0215(v), to use the helpers.
+type Table0215 string
+
+const (
+	Table0215FamilyOnly  Table0215 = `F` // Family only
+	Table0215NoPublicity Table0215 = `N` // No Publicity
+	Table0215Other       Table0215 = `O` // Other
+	Table0215Unknown     Table0215 = `U` // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0215.
+func (v Table0215) Description() string {
+	return tableDescription(`0215`, string(v))
+}
+
+// Valid reports if the value is in table 0215.
+func (v Table0215) Valid() bool {
+	return TableValueLookup[`0215`][string(v)]
+}
+
+// Table 0215.
+func (v Table0215) Table() Table {
+	return TableLookup[`0215`]
+}
+
+// Table0216 is a value of table 0216, Patient Status Code.
+// Fields in this table are strings; convert a field, such as Table0216(v), to use the helpers.
+type Table0216 string
+
+const (
+	Table0216ActiveInpatient     Table0216 = `AI` // Active Inpatient
+	Table0216DischargedInpatient Table0216 = `DI` // Discharged Inpatient
+)
+
+// Description of the value, or empty if the value is not in table 0216.
+func (v Table0216) Description() string {
+	return tableDescription(`0216`, string(v))
+}
+
+// Valid reports if the value is in table 0216.
+func (v Table0216) Valid() bool {
+	return TableValueLookup[`0216`][string(v)]
+}
+
+// Table 0216.
+func (v Table0216) Table() Table {
+	return TableLookup[`0216`]
+}
+
+// Table0217 is a value of table 0217, Visit Priority Code.
+// Fields in this table are strings; convert a field, such as Table0217(v), to use the helpers.
+type Table0217 string
+
+const (
+	Table0217Emergency Table0217 = `1` // Emergency
+	Table0217Urgent    Table0217 = `2` // Urgent
+	Table0217Elective  Table0217 = `3` // Elective
+)
+
+// Description of the value, or empty if the value is not in table 0217.
+func (v Table0217) Description() string {
+	return tableDescription(`0217`, string(v))
+}
+
+// Valid reports if the value is in table 0217.
+func (v Table0217) Valid() bool {
+	return TableValueLookup[`0217`][string(v)]
+}
+
+// Table 0217.
+func (v Table0217) Table() Table {
+	return TableLookup[`0217`]
+}
+
+// Table0220 is a value of table 0220, Living Arrangement.
+// Fields in this table are strings; convert a field, such as Table0220(v), to use the helpers.
+type Table0220 string
+
+const (
+	Table0220Alone       Table0220 = `A` // Alone
+	Table0220Family      Table0220 = `F` // Family
+	Table0220Institution Table0220 = `I` // Institution
+	Table0220Relative    Table0220 = `R` // Relative
+	Table0220SpouseOnly  Table0220 = `S` // Spouse Only
+	Table0220Unknown     Table0220 = `U` // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0220.
+func (v Table0220) Description() string {
+	return tableDescription(`0220`, string(v))
+}
+
+// Valid reports if the value is in table 0220.
+func (v Table0220) Valid() bool {
+	return TableValueLookup[`0220`][string(v)]
+}
+
+// Table 0220.
+func (v Table0220) Table() Table {
+	return TableLookup[`0220`]
+}
+
+// Table0223 is a value of table 0223, Living Dependency.
+// Fields in this table are strings; convert a field, such as Table0223(v), to use the helpers.
+type Table0223 string
+
+const (
+	Table0223SmallChildrenDependent     Table0223 = `C` // Small Children Dependent
+	Table0223MedicalSupervisionRequired Table0223 = `M` // Medical Supervision Required
+	Table0223Other                      Table0223 = `O` // Other
+	Table0223SpouseDependent            Table0223 = `S` // Spouse Dependent
+	Table0223Unknown                    Table0223 = `U` // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0223.
+func (v Table0223) Description() string {
+	return tableDescription(`0223`, string(v))
+}
+
+// Valid reports if the value is in table 0223.
+func (v Table0223) Valid() bool {
+	return TableValueLookup[`0223`][string(v)]
+}
+
+// Table 0223.
+func (v Table0223) Table() Table {
+	return TableLookup[`0223`]
+}
+
+// Table0224 is a value of table 0224, Transport Arranged.
+// Fields in this table are strings; convert a field, such as Table0224(v), to use the helpers.
+type Table0224 string
+
+const (
+	Table0224Arranged    Table0224 = `A` // Arranged
+	Table0224NotArranged Table0224 = `N` // Not Arranged
+	Table0224Unknown     Table0224 = `U` // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0224.
+func (v Table0224) Description() string {
+	return tableDescription(`0224`, string(v))
+}
+
+// Valid reports if the value is in table 0224.
+func (v Table0224) Valid() bool {
+	return TableValueLookup[`0224`][string(v)]
+}
+
+// Table 0224.
+func (v Table0224) Table() Table {
+	return TableLookup[`0224`]
+}
+
+// Table0225 is a value of table 0225, Escort Required.
+// Fields in this table are strings; convert a field, such as Table0225(v), to use the helpers.
+type Table0225 string
+
+const (
+	Table0225NotRequired Table0225 = `N` // Not Required
+	Table0225Required    Table0225 = `R` // Required
+	Table0225Unknown     Table0225 = `U` // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0225.
+func (v Table0225) Description() string {
+	return tableDescription(`0225`, string(v))
+}
+
+// Valid reports if the value is in table 0225.
+func (v Table0225) Valid() bool {
+	return TableValueLookup[`0225`][string(v)]
+}
+
+// Table 0225.
+func (v Table0225) Table() Table {
+	return TableLookup[`0225`]
+}
+
+// Table0227 is a value of table 0227, Manufacturers of Vaccines.
+// Fields in this table are strings; convert a field, such as Table0227(v), to use the helpers.
+type Table0227 string
+
+const (
+	Table0227AbbottLaboratories                            Table0227 = `AB`  // Abbott Laboratories
+	Table0227AdamsLaboratoriesInc                          Table0227 = `AD`  // Adams Laboratories, Inc.
+	Table0227AlphaTherapeuticCorporation                   Table0227 = `ALP` // Alpha Therapeutic Corporation
+	Table0227Armour                                        Table0227 = `AR`  // Armour
+	Table0227AventisBehringLLC                             Table0227 = `AVB` // Aventis Behring L.L.C.
+	Table0227Aviron                                        Table0227 = `AVI` // Aviron
+	Table0227BaxterHealthcareCorporation                   Table0227 = `BA`  // Baxter Healthcare Corporation
+	Table0227BaxterHealthcareCorporation_BAH               Table0227 = `BAH` // Baxter Healthcare Corporation
+	Table0227BayerCorporation                              Table0227 = `BAY` // Bayer Corporation
+	Table0227BernaProducts                                 Table0227 = `BP`  // Berna Products
+	Table0227BernaProductsCorporation                      Table0227 = `BPC` // Berna Products Corporation
+	Table0227CenteonLLC                                    Table0227 = `CEN` // Centeon L.L.C.
+	Table0227ChironCorporation                             Table0227 = `CHI` // Chiron Corporation
+	Table0227CelltechMedevaPharmaceuticals                 Table0227 = `CMP` // Celltech Medeva Pharmaceuticals
+	Table0227CangeneCorporation                            Table0227 = `CNJ` // Cangene Corporation
+	Table0227Connaught                                     Table0227 = `CON` // Connaught
+	Table0227DynPortVaccineCompanyLLC                      Table0227 = `DVC` // DynPort Vaccine Company, LLC
+	Table0227EvansMedicalLimited                           Table0227 = `EVN` // Evans Medical Limited
+	Table0227GeoVaxLabsInc                                 Table0227 = `GEO` // GeoVax Labs, Inc.
+	Table0227GreerLaboratoriesInc                          Table0227 = `GRE` // Greer Laboratories, Inc.
+	Table0227ImmunoInternationalAG                         Table0227 = `IAG` // Immuno International AG
+	Table0227Merieux                                       Table0227 = `IM`  // Merieux
+	Table0227ImmunoUSInc                                   Table0227 = `IUS` // Immuno-U.S., Inc.
+	Table0227TheResearchFoundationForMicrobialDiseases     Table0227 = `JPN` // The Research Foundation for Microbial Diseases of Osaka University
+	Table0227KoreaGreenCrossCorporation                    Table0227 = `KGC` // Korea Green Cross Corporation
+	Table0227Lederle                                       Table0227 = `LED` // Lederle
+	Table0227MassachusettsPublicHealthBiologicLaboratories Table0227 = `MA`  // Massachusetts Public Health Biologic Laboratories
+	Table0227MassachusettsBiologicLaboratories             Table0227 = `MBL` // Massachusetts Biologic Laboratories
+	Table0227MedImmuneInc                                  Table0227 = `MED` // MedImmune, Inc.
+	Table0227Miles                                         Table0227 = `MIL` // Miles
+	Table0227BioportCorporation                            Table0227 = `MIP` // Bioport Corporation
+	Table0227MerckCoInc                                    Table0227 = `MSD` // Merck & Co., Inc.
+	Table0227NABI                                          Table0227 = `NAB` // NABI
+	Table0227NorthAmericanVaccineInc                       Table0227 = `NAV` // North American Vaccine, Inc.
+	Table0227NovartisPharmaceuticalCorporation             Table0227 = `NOV` // Novartis Pharmaceutical Corporation
+	Table0227NovavaxInc                                    Table0227 = `NVX` // Novavax, Inc.
+	Table0227NewYorkBloodCenter                            Table0227 = `NYB` // New York Blood Center
+	Table0227OrthoClinicalDiagnostics                      Table0227 = `ORT` // Ortho-Clinical Diagnostics
+	Table0227OrganonTeknikaCorporation                     Table0227 = `OTC` // Organon Teknika Corporation
+	Table0227OtherManufacturer                             Table0227 = `OTH` // Other manufacturer
+	Table0227ParkedalePharmaceuticals                      Table0227 = `PD`  // Parkedale Pharmaceuticals
+	Table0227SanofiPasteur                                 Table0227 = `PMC` // sanofi pasteur
+	Table0227PraxisBiologics                               Table0227 = `PRX` // Praxis Biologics
+	Table0227PowderJectPharmaceuticals                     Table0227 = `PWJ` // PowderJect Pharmaceuticals
+	Table0227SclavoInc                                     Table0227 = `SCL` // Sclavo, Inc.
+	Table0227SwissSerumAndVaccineInst                      Table0227 = `SI`  // Swiss Serum and Vaccine Inst.
+	Table0227GlaxoSmithKline                               Table0227 = `SKB` // GlaxoSmithKline
+	Table0227SolvayPharmaceuticals                         Table0227 = `SOL` // Solvay Pharmaceuticals
+	Table0227TalecrisBiotherapeutics                       Table0227 = `TAL` // Talecris Biotherapeutics
+	Table0227UnknownManufacturer                           Table0227 = `UNK` // Unknown manufacturer
+	Table0227UnitedStatesArmyMedicalResearchAnd            Table0227 = `USA` // United States Army Medical Research and Material Command
+	Table0227VaxGen                                        Table0227 = `VXG` // VaxGen
+	Table0227WyethAyerst                                   Table0227 = `WA`  // Wyeth-Ayerst
+	Table0227WyethAyerst_WAL                               Table0227 = `WAL` // Wyeth-Ayerst
+	Table0227ZLBBehring                                    Table0227 = `ZLB` // ZLB Behring
+)
+
+// Description of the value, or empty if the value is not in table 0227.
+func (v Table0227) Description() string {
+	return tableDescription(`0227`, string(v))
+}
+
+// Valid reports if the value is in table 0227.
+func (v Table0227) Valid() bool {
+	return TableValueLookup[`0227`][string(v)]
+}
+
+// Table 0227.
+func (v Table0227) Table() Table {
+	return TableLookup[`0227`]
+}
+
+// Table0228 is a value of table 0228, Diagnosis Classification.
+// Fields in this table are strings; convert a field, such as Table0228(v), to use the helpers.
+type Table0228 string
+
+const (
+	Table0228Consultation                             Table0228 = `C` // Consultation
+	Table0228Diagnosis                                Table0228 = `D` // Diagnosis
+	Table0228InvasiveProcedureNotClassifiedElsewhereI Table0228 = `I` // Invasive procedure not classified elsewhere (I.V., catheter, etc.)
+	Table0228MedicationAntibiotic                     Table0228 = `M` // Medication (antibiotic)
+	Table0228Other                                    Table0228 = `O` // Other
+	Table0228RadiologicalSchedulingNotUsingICDACodes  Table0228 = `R` // Radiological scheduling (not using ICDA codes)
+	Table0228SignAndSymptom                           Table0228 = `S` // Sign and symptom
+	Table0228TissueDiagnosis                          Table0228 = `T` // Tissue diagnosis
+)
+
+// Description of the value, or empty if the value is not in table 0228.
+func (v Table0228) Description() string {
+	return tableDescription(`0228`, string(v))
+}
+
+// Valid reports if the value is in table 0228.
+func (v Table0228) Valid() bool {
+	return TableValueLookup[`0228`][string(v)]
+}
+
+// Table 0228.
+func (v Table0228) Table() Table {
+	return TableLookup[`0228`]
+}
+
+// Table0229 is a value of table 0229, DRG Payor.
+// Fields in this table are strings; convert a field, such as Table0229(v), to use the helpers.
+type Table0229 string
+
+const (
+	Table0229Champus                 Table0229 = `C` // Champus
+	Table0229ManagedCareOrganization Table0229 = `G` // Managed Care Organization
+	Table0229Medicare                Table0229 = `M` // Medicare
+)
+
+// Description of the value, or empty if the value is not in table 0229.
+func (v Table0229) Description() string {
+	return tableDescription(`0229`, string(v))
+}
+
+// Valid reports if the value is in table 0229.
+func (v Table0229) Valid() bool {
+	return TableValueLookup[`0229`][string(v)]
+}
+
+// Table 0229.
+func (v Table0229) Table() Table {
+	return TableLookup[`0229`]
+}
+
+// Table0230 is a value of table 0230, Procedure Functional Type.
+// Fields in this table are strings; convert a field, such as Table0230(v), to use the helpers.
+type Table0230 string
+
+const (
+	Table0230Anesthesia                                          Table0230 = `A` // Anesthesia
+	Table0230DiagnosticProcedure                                 Table0230 = `D` // Diagnostic procedure
+	Table0230InvasiveProcedureNotClassifiedElsewhereE            Table0230 = `I` // Invasive procedure not classified elsewhere (e.g., IV, catheter, etc.)
+	Table0230ProcedureForTreatmentTherapeuticIncludingOperations Table0230 = `P` // Procedure for treatment (therapeutic, including operations)
+)
+
+// Description of the value, or empty if the value is not in table 0230.
+func (v Table0230) Description() string {
+	return tableDescription(`0230`, string(v))
+}
+
+// Valid reports if the value is in table 0230.
+func (v Table0230) Valid() bool {
+	return TableValueLookup[`0230`][string(v)]
+}
+
+// Table 0230.
+func (v Table0230) Table() Table {
+	return TableLookup[`0230`]
+}
+
+// Table0231 is a value of table 0231, Student Status.
+// Fields in this table are strings; convert a field, such as Table0231(v), to use the helpers.
+type Table0231 string
+
+const (
+	Table0231FullTimeStudent Table0231 = `F` // Full-time student
+	Table0231NotAStudent     Table0231 = `N` // Not a student
+	Table0231PartTimeStudent Table0231 = `P` // Part-time student
+)
+
+// Description of the value, or empty if the value is not in table 0231.
+func (v Table0231) Description() string {
+	return tableDescription(`0231`, string(v))
+}
+
+// Valid reports if the value is in table 0231.
+func (v Table0231) Valid() bool {
+	return TableValueLookup[`0231`][string(v)]
+}
+
+// Table 0231.
+func (v Table0231) Table() Table {
+	return TableLookup[`0231`]
+}
+
+// Table0232 is a value of table 0232, Insurance Company Contact Reason.
+// Fields in this table are strings; convert a field, such as Table0232(v), to use the helpers.
+type Table0232 string
+
+const (
+	Table0232MedicareClaimStatus Table0232 = `01` // Medicare claim status
+	Table0232MedicaidClaimStatus Table0232 = `02` // Medicaid claim status
+	Table0232NameAddressChange   Table0232 = `03` // Name/address change
+)
+
+// Description of the value, or empty if the value is not in table 0232.
+func (v Table0232) Description() string {
+	return tableDescription(`0232`, string(v))
+}
+
+// Valid reports if the value is in table 0232.
+func (v Table0232) Valid() bool {
+	return TableValueLookup[`0232`][string(v)]
+}
+
+// Table 0232.
+func (v Table0232) Table() Table {
+	return TableLookup[`0232`]
+}
+
+// Table0234 is a value of table 0234, Report Timing.
+// Fields in this table are strings; convert a field, such as Table0234(v), to use the helpers.
+type Table0234 string
+
+const (
+	Table023410DayReport           Table0234 = `10D` // 10 day report
+	Table023415DayReport           Table0234 = `15D` // 15 day report
+	Table023430DayReport           Table0234 = `30D` // 30 day report
+	Table02343DayReport            Table0234 = `3D`  // 3 day report
+	Table02347DayReport            Table0234 = `7D`  // 7 day report
+	Table0234AdditionalInformation Table0234 = `AD`  // Additional information
+	Table0234Correction            Table0234 = `CO`  // Correction
+	Table0234DeviceEvaluation      Table0234 = `DE`  // Device evaluation
+	Table0234Periodic              Table0234 = `PD`  // Periodic
+	Table0234RequestedInformation  Table0234 = `RQ`  // Requested information
+)
+
+// Description of the value, or empty if the value is not in table 0234.
+func (v Table0234) Description() string {
+	return tableDescription(`0234`, string(v))
+}
+
+// Valid reports if the value is in table 0234.
+func (v Table0234) Valid() bool {
+	return TableValueLookup[`0234`][string(v)]
+}
+
+// Table 0234.
+func (v Table0234) Table() Table {
+	return TableLookup[`0234`]
+}
+
+// Table0235 is a value of table 0235, Report Source.
+// Fields in this table are strings; convert a field, such as Table0235(v), to use the helpers.
+type Table0235 string
+
+const (
+	Table0235ClinicalTrial                        Table0235 = `C` // Clinical trial
+	Table0235DatabaseRegistryPoisonControlCenter  Table0235 = `D` // Database/registry/poison control center
+	Table0235Distributor                          Table0235 = `E` // Distributor
+	Table0235HealthProfessional                   Table0235 = `H` // Health professional
+	Table0235Literature                           Table0235 = `L` // Literature
+	Table0235ManufacturerMarketingAuthorityHolder Table0235 = `M` // Manufacturer/marketing authority holder
+	Table0235NonHealthcareProfessional            Table0235 = `N` // Non-healthcare professional
+	Table0235Other                                Table0235 = `O` // Other
+	Table0235Patient                              Table0235 = `P` // Patient
+	Table0235RegulatoryAgency                     Table0235 = `R` // Regulatory agency
+)
+
+// Description of the value, or empty if the value is not in table 0235.
+func (v Table0235) Description() string {
+	return tableDescription(`0235`, string(v))
+}
+
+// Valid reports if the value is in table 0235.
+func (v Table0235) Valid() bool {
+	return TableValueLookup[`0235`][string(v)]
+}
+
+// Table 0235.
+func (v Table0235) Table() Table {
+	return TableLookup[`0235`]
+}
+
+// Table0236 is a value of table 0236, Event Reported To.
+// Fields in this table are strings; convert a field, such as Table0236(v), to use the helpers.
+type Table0236 string
+
+const (
+	Table0236Distributor               Table0236 = `D` // Distributor
+	Table0236LocalFacilityUserFacility Table0236 = `L` // Local facility/user facility
+	Table0236Manufacturer              Table0236 = `M` // Manufacturer
+	Table0236RegulatoryAgency          Table0236 = `R` // Regulatory agency
+)
+
+// Description of the value, or empty if the value is not in table 0236.
+func (v Table0236) Description() string {
+	return tableDescription(`0236`, string(v))
+}
+
+// Valid reports if the value is in table 0236.
+func (v Table0236) Valid() bool {
+	return TableValueLookup[`0236`][string(v)]
+}
+
+// Table 0236.
+func (v Table0236) Table() Table {
+	return TableLookup[`0236`]
+}
+
+// Table0237 is a value of table 0237, Event Qualification.
+// Fields in this table are strings; convert a field, such as Table0237(v), to use the helpers.
+type Table0237 string
+
+const (
+	Table0237Abuse                         Table0237 = `A` // Abuse
+	Table0237UnexpectedBeneficialEffect    Table0237 = `B` // Unexpected beneficial effect
+	Table0237Dependency                    Table0237 = `D` // Dependency
+	Table0237Interaction                   Table0237 = `I` // Interaction
+	Table0237LackOfExpectTherapeuticEffect Table0237 = `L` // Lack of expect therapeutic effect
+	Table0237Misuse                        Table0237 = `M` // Misuse
+	Table0237Overdose                      Table0237 = `O` // Overdose
+	Table0237DrugWithdrawal                Table0237 = `W` // Drug withdrawal
+)
+
+// Description of the value, or empty if the value is not in table 0237.
+func (v Table0237) Description() string {
+	return tableDescription(`0237`, string(v))
+}
+
+// Valid reports if the value is in table 0237.
+func (v Table0237) Valid() bool {
+	return TableValueLookup[`0237`][string(v)]
+}
+
+// Table 0237.
+func (v Table0237) Table() Table {
+	return TableLookup[`0237`]
+}
+
+// Table0238 is a value of table 0238, Event Seriousness.
+// Fields in this table are strings; convert a field, such as Table0238(v), to use the helpers.
+type Table0238 string
+
+const (
+	Table0238No          Table0238 = `N` // No
+	Table0238Significant Table0238 = `S` // Significant
+	Table0238Yes         Table0238 = `Y` // Yes
+)
+
+// Description of the value, or empty if the value is not in table 0238.
+func (v Table0238) Description() string {
+	return tableDescription(`0238`, string(v))
+}
+
+// Valid reports if the value is in table 0238.
+func (v Table0238) Valid() bool {
+	return TableValueLookup[`0238`][string(v)]
+}
+
+// Table 0238.
+func (v Table0238) Table() Table {
+	return TableLookup[`0238`]
+}
+
+// Table0239 is a value of table 0239, Event Expected.
+// Fields in this table are strings; convert a field, such as Table0239(v), to use the helpers.
+type Table0239 string
+
+const (
+	Table0239No      Table0239 = `N` // No
+	Table0239Unknown Table0239 = `U` // Unknown
+	Table0239Yes     Table0239 = `Y` // Yes
+)
+
+// Description of the value, or empty if the value is not in table 0239.
+func (v Table0239) Description() string {
+	return tableDescription(`0239`, string(v))
+}
+
+// Valid reports if the value is in table 0239.
+func (v Table0239) Valid() bool {
+	return TableValueLookup[`0239`][string(v)]
+}
+
+// Table 0239.
+func (v Table0239) Table() Table {
+	return TableLookup[`0239`]
+}
+
+// Table0240 is a value of table 0240, Event Consequence.
+// Fields in this table are strings; convert a field, such as Table0240(v), to use the helpers.
+type Table0240 string
+
+const (
+	Table0240CongenitalAnomalyBirthDefect                     Table0240 = `C` // Congenital anomaly/birth defect
+	Table0240Death                                            Table0240 = `D` // Death
+	Table0240CausedHospitalized                               Table0240 = `H` // Caused hospitalized
+	Table0240IncapacityWhichIsSignificantPersistentOr         Table0240 = `I` // Incapacity which is significant, persistent or permanent
+	Table0240DisabilityWhichIsSignificantPersistentOr         Table0240 = `J` // Disability which is significant, persistent or permanent
+	Table0240LifeThreatening                                  Table0240 = `L` // Life threatening
+	Table0240Other                                            Table0240 = `O` // Other
+	Table0240ProlongedHospitalization                         Table0240 = `P` // Prolonged hospitalization
+	Table0240RequiredInterventionToPreventPermanentImpairment Table0240 = `R` // Required intervention to prevent permanent impairment/damage
+)
+
+// Description of the value, or empty if the value is not in table 0240.
+func (v Table0240) Description() string {
+	return tableDescription(`0240`, string(v))
+}
+
+// Valid reports if the value is in table 0240.
+func (v Table0240) Valid() bool {
+	return TableValueLookup[`0240`][string(v)]
+}
+
+// Table 0240.
+func (v Table0240) Table() Table {
+	return TableLookup[`0240`]
+}
+
+// Table0241 is a value of table 0241, Patient Outcome.
+// Fields in this table are strings; convert a field, such as Table0241(v), to use the helpers.
+type Table0241 string
+
+const (
+	Table0241Died                   Table0241 = `D` // Died
+	Table0241FullyRecovered         Table0241 = `F` // Fully recovered
+	Table0241NotRecoveringUnchanged Table0241 = `N` // Not recovering/unchanged
+	Table0241Recovering             Table0241 = `R` // Recovering
+	Table0241Sequelae               Table0241 = `S` // Sequelae
+	Table0241Unknown                Table0241 = `U` // Unknown
+	Table0241Worsening              Table0241 = `W` // Worsening
+)
+
+// Description of the value, or empty if the value is not in table 0241.
+func (v Table0241) Description() string {
+	return tableDescription(`0241`, string(v))
+}
+
+// Valid reports if the value is in table 0241.
+func (v Table0241) Valid() bool {
+	return TableValueLookup[`0241`][string(v)]
+}
+
+// Table 0241.
+func (v Table0241) Table() Table {
+	return TableLookup[`0241`]
+}
+
+// Table0242 is a value of table 0242, Primary Observer's Qualification.
+// Fields in this table are strings; convert a field, such as Table0242(v), to use the helpers.
+type Table0242 string
+
+const (
+	Table0242HealthCareConsumerPatient                  Table0242 = `C` // Health care consumer/patient
+	Table0242OtherHealthProfessional                    Table0242 = `H` // Other health professional
+	Table0242LawyerAttorney                             Table0242 = `L` // Lawyer/attorney
+	Table0242MidLevelProfessionalNurseNursePractitioner Table0242 = `M` // Mid-level professional (nurse, nurse practitioner, physician's assistant)
+	Table0242OtherNonHealthProfessional                 Table0242 = `O` // Other non-health professional
+	Table0242PhysicianOsteopathHomeopath                Table0242 = `P` // Physician (osteopath, homeopath)
+	Table0242Pharmacist                                 Table0242 = `R` // Pharmacist
+)
+
+// Description of the value, or empty if the value is not in table 0242.
+func (v Table0242) Description() string {
+	return tableDescription(`0242`, string(v))
+}
+
+// Valid reports if the value is in table 0242.
+func (v Table0242) Valid() bool {
+	return TableValueLookup[`0242`][string(v)]
+}
+
+// Table 0242.
+func (v Table0242) Table() Table {
+	return TableLookup[`0242`]
+}
+
+// Table0243 is a value of table 0243, Identity May Be Divulged.
+// Fields in this table are strings; convert a field, such as Table0243(v), to use the helpers.
+type Table0243 string
+
+const (
+	Table0243No            Table0243 = `N`  // No
+	Table0243NotApplicable Table0243 = `NA` // Not applicable
+	Table0243Yes           Table0243 = `Y`  // Yes
+)
+
+// Description of the value, or empty if the value is not in table 0243.
+func (v Table0243) Description() string {
+	return tableDescription(`0243`, string(v))
+}
+
+// Valid reports if the value is in table 0243.
+func (v Table0243) Valid() bool {
+	return TableValueLookup[`0243`][string(v)]
+}
+
+// Table 0243.
+func (v Table0243) Table() Table {
+	return TableLookup[`0243`]
+}
+
+// Table0247 is a value of table 0247, Status of Evaluation.
+// Fields in this table are strings; convert a field, such as Table0247(v), to use the helpers.
+type Table0247 string
+
+const (
+	Table0247EvaluationAnticipatedButNotYetBegun        Table0247 = `A` // Evaluation anticipated, but not yet begun
+	Table0247ProductReceivedInConditionWhichMade        Table0247 = `C` // Product received in condition which made analysis impossible
+	Table0247ProductDiscardedUnableToFollowUp           Table0247 = `D` // Product discarded -- unable to follow up
+	Table0247ProductRemainsImplantedUnableToFollow      Table0247 = `I` // Product remains implanted -- unable to follow up
+	Table0247ProblemAlreadyKnownNoEvaluationNecessary   Table0247 = `K` // Problem already known, no evaluation necessary
+	Table0247Other                                      Table0247 = `O` // Other
+	Table0247EvaluationInProgress                       Table0247 = `P` // Evaluation in progress
+	Table0247ProductUnderQuarantineUnableToFollow       Table0247 = `Q` // Product under quarantine -- unable to follow up
+	Table0247ProductUnderRecallCorrectiveAction         Table0247 = `R` // Product under recall/corrective action
+	Table0247ProductUnavailableForFollowUpInvestigation Table0247 = `U` // Product unavailable for follow up investigation
+	Table0247ProductNotMadeByCompany                    Table0247 = `X` // Product not made by company
+	Table0247EvaluationCompleted                        Table0247 = `Y` // Evaluation completed
+)
+
+// Description of the value, or empty if the value is not in table 0247.
+func (v Table0247) Description() string {
+	return tableDescription(`0247`, string(v))
+}
+
+// Valid reports if the value is in table 0247.
+func (v Table0247) Valid() bool {
+	return TableValueLookup[`0247`][string(v)]
+}
+
+// Table 0247.
+func (v Table0247) Table() Table {
+	return TableLookup[`0247`]
+}
+
+// Table0248 is a value of table 0248, Product Source.
+// Fields in this table are strings; convert a field, such as Table0248(v), to use the helpers.
+type Table0248 string
+
+const (
+	Table0248ActualProductInvolvedInIncidentWas Table0248 = `A` // Actual product involved in incident was evaluated
+	Table0248AProductFromTheSameLot             Table0248 = `L` // A product from the same lot as the actual product involved was evaluated
+	Table0248AProductFromAControlledNon         Table0248 = `N` // A product from a controlled/non-related inventory was evaluated
+	Table0248AProductFromAReserveSample         Table0248 = `R` // A product from a reserve sample was evaluated
+)
+
+// Description of the value, or empty if the value is not in table 0248.
+func (v Table0248) Description() string {
+	return tableDescription(`0248`, string(v))
+}
+
+// Valid reports if the value is in table 0248.
+func (v Table0248) Valid() bool {
+	return TableValueLookup[`0248`][string(v)]
+}
+
+// Table 0248.
+func (v Table0248) Table() Table {
+	return TableLookup[`0248`]
+}
+
+// Table0250 is a value of table 0250, Relatedness Assessment.
+// Fields in this table are strings; convert a field, such as Table0250(v), to use the helpers.
+type Table0250 string
+
+const (
+	Table0250HighlyProbable     Table0250 = `H` // Highly probable
+	Table0250Improbable         Table0250 = `I` // Improbable
+	Table0250ModeratelyProbable Table0250 = `M` // Moderately probable
+	Table0250NotRelated         Table0250 = `N` // Not related
+	Table0250SomewhatProbable   Table0250 = `S` // Somewhat probable
+)
+
+// Description of the value, or empty if the value is not in table 0250.
+func (v Table0250) Description() string {
+	return tableDescription(`0250`, string(v))
+}
+
+// Valid reports if the value is in table 0250.
+func (v Table0250) Valid() bool {
+	return TableValueLookup[`0250`][string(v)]
+}
+
+// Table 0250.
+func (v Table0250) Table() Table {
+	return TableLookup[`0250`]
+}
+
+// Table0251 is a value of table 0251, Action Taken in Response to the Event.
+// Fields in this table are strings; convert a field, such as Table0251(v), to use the helpers.
+type Table0251 string
+
+const (
+	Table0251ProductDoseOrFrequencyOfUse    Table0251 = `DI` // Product dose or frequency of use increased
+	Table0251ProductDoseOrFrequencyOfUse_DR Table0251 = `DR` // Product dose or frequency of use reduced
+	Table0251None                           Table0251 = `N`  // None
+	Table0251Other                          Table0251 = `OT` // Other
+	Table0251ProductWithdrawnPermanently    Table0251 = `WP` // Product withdrawn permanently
+	Table0251ProductWithdrawnTemporarily    Table0251 = `WT` // Product withdrawn temporarily
+)
+
+// Description of the value, or empty if the value is not in table 0251.
+func (v Table0251) Description() string {
+	return tableDescription(`0251`, string(v))
+}
+
+// Valid reports if the value is in table 0251.
+func (v Table0251) Valid() bool {
+	return TableValueLookup[`0251`][string(v)]
+}
+
+// Table 0251.
+func (v Table0251) Table() Table {
+	return TableLookup[`0251`]
+}
+
+// Table0252 is a value of table 0252, Causality Observations.
+// Fields in this table are strings; convert a field, such as Table0252(v), to use the helpers.
+type Table0252 string
+
+const (
+	Table0252AbatementOfEventAfterProductWithdrawn       Table0252 = `AW` // Abatement of event after product withdrawn
+	Table0252EventRecurredAfterProductReintroduced       Table0252 = `BE` // Event recurred after product reintroduced
+	Table0252DoseResponseObserved                        Table0252 = `DR` // Dose response observed
+	Table0252AlternativeExplanationsForTheEventAvailable Table0252 = `EX` // Alternative explanations for the event available
+	Table0252EventOccurredAfterProductIntroduced         Table0252 = `IN` // Event occurred after product introduced
+	Table0252LiteratureReportsAssociationOfProductWith   Table0252 = `LI` // Literature reports association of product with event
+	Table0252OccurrenceOfEventWasConfirmedBy             Table0252 = `OE` // Occurrence of event was confirmed by objective evidence
+	Table0252Other                                       Table0252 = `OT` // Other
+	Table0252EffectObservedWhenPatientReceivesPlacebo    Table0252 = `PL` // Effect observed when patient receives placebo
+	Table0252SimilarEventsInPastForThis                  Table0252 = `SE` // Similar events in past for this patient
+	Table0252ToxicLevelsOfProductDocumentedIn            Table0252 = `TC` // Toxic levels of product documented in blood or body fluids
+)
+
+// Description of the value, or empty if the value is not in table 0252.
+func (v Table0252) Description() string {
+	return tableDescription(`0252`, string(v))
+}
+
+// Valid reports if the value is in table 0252.
+func (v Table0252) Valid() bool {
+	return TableValueLookup[`0252`][string(v)]
+}
+
+// Table 0252.
+func (v Table0252) Table() Table {
+	return TableLookup[`0252`]
+}
+
+// Table0253 is a value of table 0253, Indirect Exposure Mechanism.
+// Fields in this table are strings; convert a field, such as Table0253(v), to use the helpers.
+type Table0253 string
+
+const (
+	Table0253BreastMilk     Table0253 = `B` // Breast milk
+	Table0253Father         Table0253 = `F` // Father
+	Table0253Other          Table0253 = `O` // Other
+	Table0253Transplacental Table0253 = `P` // Transplacental
+	Table0253BloodProduct   Table0253 = `X` // Blood product
+)
+
+// Description of the value, or empty if the value is not in table 0253.
+func (v Table0253) Description() string {
+	return tableDescription(`0253`, string(v))
+}
+
+// Valid reports if the value is in table 0253.
+func (v Table0253) Valid() bool {
+	return TableValueLookup[`0253`][string(v)]
+}
+
+// Table 0253.
+func (v Table0253) Table() Table {
+	return TableLookup[`0253`]
+}
+
+// Table0254 is a value of table 0254, Kind of Quantity.
+// Fields in this table are strings; convert a field, such as Table0254(v), to use the helpers.
+type Table0254 string
+
+const (
+	Table0254Absorbance                      Table0254 = `ABS`     // Absorbance
+	Table0254ConcentrationArbitrarySubstance Table0254 = `ACNC`    // Concentration, Arbitrary Substance
+	Table0254Activity                        Table0254 = `ACT`     // *Activity
+	Table0254Appearance                      Table0254 = `APER`    // Appearance
+	Table0254Arbitrary                       Table0254 = `ARB`     // *Arbitrary
+	Table0254Area                            Table0254 = `AREA`    // Area
+	Table0254Aspect                          Table0254 = `ASPECT`  // Aspect
+	Table0254CatalyticActivity               Table0254 = `CACT`    // *Catalytic Activity
+	Table0254CatalyticContent                Table0254 = `CCNT`    // *Catalytic Content
+	Table0254CatalyticConcentrationRatio     Table0254 = `CCRTO`   // Catalytic Concentration Ratio
+	Table0254CatalyticFraction               Table0254 = `CFR`     // *Catalytic Fraction
+	Table0254Class                           Table0254 = `CLAS`    // Class
+	Table0254CatalyticConcentration          Table0254 = `CNC`     // *Catalytic Concentration
+	Table0254Constant                        Table0254 = `CNST`    // *Constant
+	Table0254Coefficient                     Table0254 = `COEF`    // *Coefficient
+	Table0254Color                           Table0254 = `COLOR`   // Color
+	Table0254Consistency                     Table0254 = `CONS`    // Consistency
+	Table0254CatalyticRate                   Table0254 = `CRAT`    // *Catalytic Rate
+	Table0254CatalyticRatio                  Table0254 = `CRTO`    // Catalytic Ratio
+	Table0254Density                         Table0254 = `DEN`     // Density
+	Table0254Device                          Table0254 = `DEV`     // Device
+	Table0254Difference                      Table0254 = `DIFF`    // *Difference
+	Table0254Elasticity                      Table0254 = `ELAS`    // Elasticity
+	Table0254ElectricalPotentialVoltage      Table0254 = `ELPOT`   // Electrical Potential (Voltage)
+	Table0254ElectricalCurrentAmperage       Table0254 = `ELRAT`   // Electrical current (amperage)
+	Table0254ElectricalResistance            Table0254 = `ELRES`   // Electrical Resistance
+	Table0254Energy                          Table0254 = `ENGR`    // Energy
+	Table0254Entitic                         Table0254 = `ENT`     // *Entitic
+	Table0254EntiticCatalyticActivity        Table0254 = `ENTCAT`  // *Entitic Catalytic Activity
+	Table0254EntiticNumber                   Table0254 = `ENTNUM`  // *Entitic Number
+	Table0254EntiticSubstanceOfAmount        Table0254 = `ENTSUB`  // *Entitic Substance of Amount
+	Table0254EntiticVolume                   Table0254 = `ENTVOL`  // *Entitic Volume
+	Table0254Equilibrium                     Table0254 = `EQL`     // Equilibrium
+	Table0254MechanicalForce                 Table0254 = `FORCE`   // Mechanical force
+	Table0254Frequency                       Table0254 = `FREQ`    // Frequency
+	Table0254ImpressionInterpretationOfStudy Table0254 = `IMP`     // Impression/ interpretation of study
+	Table0254KinematicViscosity              Table0254 = `KINV`    // *Kinematic Viscosity
+	Table0254Length                          Table0254 = `LEN`     // Length
+	Table0254LengthIncrement                 Table0254 = `LINC`    // *Length Increment
+	Table0254Liquefaction                    Table0254 = `LIQ`     // *Liquefaction
+	Table0254Mass                            Table0254 = `MASS`    // *Mass
+	Table0254MassConcentration               Table0254 = `MCNC`    // *Mass Concentration
+	Table0254MassContent                     Table0254 = `MCNT`    // Mass Content
+	Table0254MassConcentrationRatio          Table0254 = `MCRTO`   // *Mass Concentration Ratio
+	Table0254MassFraction                    Table0254 = `MFR`     // *Mass Fraction
+	Table0254MagneticFlux                    Table0254 = `MGFLUX`  // Magnetic flux
+	Table0254MassIncrement                   Table0254 = `MINC`    // *Mass Increment
+	Table0254Morphology                      Table0254 = `MORPH`   // Morphology
+	Table0254Motility                        Table0254 = `MOTIL`   // Motility
+	Table0254MassRate                        Table0254 = `MRAT`    // *Mass Rate
+	Table0254MassRatio                       Table0254 = `MRTO`    // *Mass Ratio
+	Table0254NumberConcentration             Table0254 = `NCNC`    // *Number Concentration
+	Table0254NumberContent                   Table0254 = `NCNT`    // *Number Content
+	Table0254NumberFraction                  Table0254 = `NFR`     // *Number Fraction
+	Table0254NumberRatio                     Table0254 = `NRTO`    // *Number Ratio
+	Table0254Number                          Table0254 = `NUM`     // *Number
+	Table0254OpticalDensity                  Table0254 = `OD`      // Optical density
+	Table0254Osmolality                      Table0254 = `OSMOL`   // *Osmolality
+	Table0254PressurePartial                 Table0254 = `PRES`    // *Pressure (Partial)
+	Table0254PresenceIdentityExistence       Table0254 = `PRID`    // Presence/Identity/Existence
+	Table0254PowerWattage                    Table0254 = `PWR`     // Power (wattage)
+	Table0254Ranges                          Table0254 = `RANGE`   // *Ranges
+	Table0254Ratios                          Table0254 = `RATIO`   // *Ratios
+	Table0254ReciprocalRelativeTime          Table0254 = `RCRLTM`  // *Reciprocal Relative Time
+	Table0254RelativeDensity                 Table0254 = `RDEN`    // *Relative Density
+	Table0254Relative                        Table0254 = `REL`     // *Relative
+	Table0254RelativeMassConcentration       Table0254 = `RLMCNC`  // *Relative Mass Concentration
+	Table0254RelativeSubstanceConcentration  Table0254 = `RLSCNC`  // *Relative Substance Concentration
+	Table0254RelativeTime                    Table0254 = `RLTM`    // *Relative Time
+	Table0254SaturationFraction              Table0254 = `SATFR`   // *Saturation Fraction
+	Table0254SubstanceConcentration          Table0254 = `SCNC`    // *Substance Concentration
+	Table0254SubstanceConcentrationIncrement Table0254 = `SCNCIN`  // *Substance Concentration Increment
+	Table0254SubstanceContent                Table0254 = `SCNT`    // *Substance Content
+	Table0254SubstanceContentRate            Table0254 = `SCNTR`   // *Substance Content Rate
+	Table0254SubstanceConcentrationRatio     Table0254 = `SCRTO`   // *Substance Concentration Ratio
+	Table0254SubstanceFraction               Table0254 = `SFR`     // *Substance Fraction
+	Table0254Shape                           Table0254 = `SHAPE`   // Shape
+	Table0254Smell                           Table0254 = `SMELL`   // Smell
+	Table0254SubstanceRate                   Table0254 = `SRAT`    // *Substance Rate
+	Table0254SubstanceRatio                  Table0254 = `SRTO`    // *Substance Ratio
+	Table0254SubstanceAmount                 Table0254 = `SUB`     // *Substance Amount
+	Table0254Susceptibility                  Table0254 = `SUSC`    // *Susceptibility
+	Table0254Taste                           Table0254 = `TASTE`   // Taste
+	Table0254Temperature                     Table0254 = `TEMP`    // *Temperature
+	Table0254TemperatureDifference           Table0254 = `TEMPDF`  // *Temperature Difference
+	Table0254TemperatureIncrement            Table0254 = `TEMPIN`  // *Temperature Increment
+	Table0254ThresholdMassConcentration      Table0254 = `THRMCNC` // *Threshold Mass Concentration
+	Table0254ThresholdSubstanceConcentration Table0254 = `THRSCNC` // *Threshold Substance Concentration
+	Table0254TimeEGSeconds                   Table0254 = `TIME`    // *Time (e.g. seconds)
+	Table0254DilutionFactorTiter             Table0254 = `TITR`    // *Dilution Factor (Titer)
+	Table0254TimeDifference                  Table0254 = `TMDF`    // *Time Difference
+	Table0254TimeStampDateAndTime            Table0254 = `TMSTP`   // *Time Stamp-Date and Time
+	Table0254TimeRatio                       Table0254 = `TRTO`    // *Time Ratio
+	Table0254Type                            Table0254 = `TYPE`    // *Type
+	Table0254VolumeContent                   Table0254 = `VCNT`    // *Volume Content
+	Table0254Velocity                        Table0254 = `VEL`     // *Velocity
+	Table0254VelocityRatio                   Table0254 = `VELRT`   // *Velocity Ratio
+	Table0254VolumeFraction                  Table0254 = `VFR`     // *Volume Fraction
+	Table0254Viscosity                       Table0254 = `VISC`    // *Viscosity
+	Table0254Volume                          Table0254 = `VOL`     // *Volume
+	Table0254VolumeRate                      Table0254 = `VRAT`    // *Volume Rate
+	Table0254VolumeRatio                     Table0254 = `VRTO`    // *Volume Ratio
+)
+
+// Description of the value, or empty if the value is not in table 0254.
+func (v Table0254) Description() string {
+	return tableDescription(`0254`, string(v))
+}
+
+// Valid reports if the value is in table 0254.
+func (v Table0254) Valid() bool {
+	return TableValueLookup[`0254`][string(v)]
+}
+
+// Table 0254.
+func (v Table0254) Table() Table {
+	return TableLookup[`0254`]
+}
+
+// Table0255 is a value of table 0255, Duration Categories.
+// Fields in this table are strings; convert a field, such as Table0255(v), to use the helpers.
+type Table0255 string
+
+const (
+	Table0255AsteriskLifeOfTheUnitUsed  Table0255 = `*`    // (asterisk) Life of the "unit."  Used for blood products.
+	Table025512Hours                    Table0255 = `12H`  // 12 hours
+	Table02551Hour                      Table0255 = `1H`   // 1 hour
+	Table02551Months30Days              Table0255 = `1L`   // 1 months (30 days)
+	Table02551Week                      Table0255 = `1W`   // 1 week
+	Table02552Hours                     Table0255 = `2.5H` // 2Â½ hours
+	Table025524Hours                    Table0255 = `24H`  // 24 hours
+	Table02552Days                      Table0255 = `2D`   // 2 days
+	Table02552Hours_2H                  Table0255 = `2H`   // 2 hours
+	Table02552Months                    Table0255 = `2L`   // 2 months
+	Table02552Weeks                     Table0255 = `2W`   // 2 weeks
+	Table025530Minutes                  Table0255 = `30M`  // 30 minutes
+	Table02553Days                      Table0255 = `3D`   // 3 days
+	Table02553Hours                     Table0255 = `3H`   // 3 hours
+	Table02553Months                    Table0255 = `3L`   // 3 months
+	Table02553Weeks                     Table0255 = `3W`   // 3 weeks
+	Table02554Days                      Table0255 = `4D`   // 4 days
+	Table02554Hours                     Table0255 = `4H`   // 4 hours
+	Table02554Weeks                     Table0255 = `4W`   // 4 weeks
+	Table02555Days                      Table0255 = `5D`   // 5 days
+	Table02555Hours                     Table0255 = `5H`   // 5 hours
+	Table02556Days                      Table0255 = `6D`   // 6 days
+	Table02556Hours                     Table0255 = `6H`   // 6 hours
+	Table02557Hours                     Table0255 = `7H`   // 7 hours
+	Table02558Hours                     Table0255 = `8H`   // 8 hours
+	Table0255ToIdentifyMeasuresAtAPoint Table0255 = `PT`   // To identify measures at a point in time.  This is a synonym for "spot" or "random" as applied to urine measurements.
+)
+
+// Description of the value, or empty if the value is not in table 0255.
+func (v Table0255) Description() string {
+	return tableDescription(`0255`, string(v))
+}
+
+// Valid reports if the value is in table 0255.
+func (v Table0255) Valid() bool {
+	return TableValueLookup[`0255`][string(v)]
+}
+
+// Table 0255.
+func (v Table0255) Table() Table {
+	return TableLookup[`0255`]
+}
+
+// Table0258 is a value of table 0258, Relationship Modifier.
+// Fields in this table are strings; convert a field, such as Table0258(v), to use the helpers.
+type Table0258 string
+
+const (
+	Table0258BloodProductUnit Table0258 = `BPU`     // Blood product unit
+	Table0258Control          Table0258 = `CONTROL` // Control
+	Table0258Donor            Table0258 = `DONOR`   // Donor
+	Table0258Patient          Table0258 = `PATIENT` // Patient
+)
+
+// Description of the value, or empty if the value is not in table 0258.
+func (v Table0258) Description() string {
+	return tableDescription(`0258`, string(v))
+}
+
+// Valid reports if the value is in table 0258.
+func (v Table0258) Valid() bool {
+	return TableValueLookup[`0258`][string(v)]
+}
+
+// Table 0258.
+func (v Table0258) Table() Table {
+	return TableLookup[`0258`]
+}
+
+// Table0260 is a value of table 0260, Patient Location Type.
+// Fields in this table are strings; convert a field, such as Table0260(v), to use the helpers.
+type Table0260 string
+
+const (
+	Table0260Bed           Table0260 = `B` // Bed
+	Table0260Clinic        Table0260 = `C` // Clinic
+	Table0260Department    Table0260 = `D` // Department
+	Table0260ExamRoom      Table0260 = `E` // Exam Room
+	Table0260OtherLocation Table0260 = `L` // Other Location
+	Table0260NursingUnit   Table0260 = `N` // Nursing Unit
+	Table0260OperatingRoom Table0260 = `O` // Operating Room
+	Table0260Room          Table0260 = `R` // Room
+)
+
+// Description of the value, or empty if the value is not in table 0260.
+func (v Table0260) Description() string {
+	return tableDescription(`0260`, string(v))
+}
+
+// Valid reports if the value is in table 0260.
+func (v Table0260) Valid() bool {
+	return TableValueLookup[`0260`][string(v)]
+}
+
+// Table 0260.
+func (v Table0260) Table() Table {
+	return TableLookup[`0260`]
+}
+
+// Table0261 is a value of table 0261, Location Equipment.
+// Fields in this table are strings; convert a field, such as Table0261(v), to use the helpers.
+type Table0261 string
+
+const (
+	Table0261ElectroEncephalogram Table0261 = `EEG` // Electro-Encephalogram
+	Table0261ElectroCardiogram    Table0261 = `EKG` // Electro-Cardiogram
+	Table0261InfusionPump         Table0261 = `INF` // Infusion pump
+	Table0261IVPump               Table0261 = `IVP` // IV pump
+	Table0261Oxygen               Table0261 = `OXY` // Oxygen
+	Table0261Suction              Table0261 = `SUC` // Suction
+	Table0261Ventilator           Table0261 = `VEN` // Ventilator
+	Table0261VitalSignsMonitor    Table0261 = `VIT` // Vital signs monitor
+)
+
+// Description of the value, or empty if the value is not in table 0261.
+func (v Table0261) Description() string {
+	return tableDescription(`0261`, string(v))
+}
+
+// Valid reports if the value is in table 0261.
+func (v Table0261) Valid() bool {
+	return TableValueLookup[`0261`][string(v)]
+}
+
+// Table 0261.
+func (v Table0261) Table() Table {
+	return TableLookup[`0261`]
+}
+
+// Table0265 is a value of table 0265, Specialty Type.
+// Fields in this table are strings; convert a field, such as Table0265(v), to use the helpers.
+type Table0265 string
+
+const (
+	Table0265Allergy                        Table0265 = `ALC` // Allergy
+	Table0265Ambulatory                     Table0265 = `AMB` // Ambulatory
+	Table0265Cancer                         Table0265 = `CAN` // Cancer
+	Table0265CoronaryCardiacCare            Table0265 = `CAR` // Coronary/cardiac care
+	Table0265CriticalCare                   Table0265 = `CCR` // Critical care
+	Table0265Chiropractic                   Table0265 = `CHI` // Chiropractic
+	Table0265Education                      Table0265 = `EDI` // Education
+	Table0265Emergency                      Table0265 = `EMR` // Emergency
+	Table0265FamilyPlanning                 Table0265 = `FPC` // Family planning
+	Table0265IntensiveCare                  Table0265 = `INT` // Intensive care
+	Table0265Isolation                      Table0265 = `ISO` // Isolation
+	Table0265Naturopathic                   Table0265 = `NAT` // Naturopathic
+	Table0265NewbornNurseryInfants          Table0265 = `NBI` // Newborn, nursery, infants
+	Table0265ObstetricsGynecology           Table0265 = `OBG` // Obstetrics, gynecology
+	Table0265Observation                    Table0265 = `OBS` // Observation
+	Table0265OtherSpecialty                 Table0265 = `OTH` // Other specialty
+	Table0265Pediatrics                     Table0265 = `PED` // Pediatrics
+	Table0265GeneralFamilyPractice          Table0265 = `PHY` // General/family practice
+	Table0265PediatricNeonatalIntensiveCare Table0265 = `PIN` // Pediatric/neonatal intensive care
+	Table0265PediatricPsychiatric           Table0265 = `PPS` // Pediatric psychiatric
+	Table0265PediatricRehabilitation        Table0265 = `PRE` // Pediatric rehabilitation
+	Table0265PsychiatricIntensiveCare       Table0265 = `PSI` // Psychiatric intensive care
+	Table0265Psychiatric                    Table0265 = `PSY` // Psychiatric
+	Table0265Rehabilitation                 Table0265 = `REH` // Rehabilitation
+	Table0265Surgery                        Table0265 = `SUR` // Surgery
+	Table0265WalkInClinic                   Table0265 = `WIC` // Walk-in clinic
+)
+
+// Description of the value, or empty if the value is not in table 0265.
+func (v Table0265) Description() string {
+	return tableDescription(`0265`, string(v))
+}
+
+// Valid reports if the value is in table 0265.
+func (v Table0265) Valid() bool {
+	return TableValueLookup[`0265`][string(v)]
+}
+
+// Table 0265.
+func (v Table0265) Table() Table {
+	return TableLookup[`0265`]
+}
+
+// Table0267 is a value of table 0267, Days of the Week.
+// Fields in this table are strings; convert a field, such as Table0267(v), to use the helpers.
+type Table0267 string
+
+const (
+	Table0267Friday    Table0267 = `FRI` // Friday
+	Table0267Monday    Table0267 = `MON` // Monday
+	Table0267Saturday  Table0267 = `SAT` // Saturday
+	Table0267Sunday    Table0267 = `SUN` // Sunday
+	Table0267Thursday  Table0267 = `THU` // Thursday
+	Table0267Tuesday   Table0267 = `TUE` // Tuesday
+	Table0267Wednesday Table0267 = `WED` // Wednesday
+)
+
+// Description of the value, or empty if the value is not in table 0267.
+func (v Table0267) Description() string {
+	return tableDescription(`0267`, string(v))
+}
+
+// Valid reports if the value is in table 0267.
+func (v Table0267) Valid() bool {
+	return TableValueLookup[`0267`][string(v)]
+}
+
+// Table 0267.
+func (v Table0267) Table() Table {
+	return TableLookup[`0267`]
+}
+
+// Table0268 is a value of table 0268, Override.
+// Fields in this table are strings; convert a field, such as Table0268(v), to use the helpers.
+type Table0268 string
+
+const (
+	Table0268OverrideAllowed    Table0268 = `A` // Override allowed
+	Table0268OverrideRequired   Table0268 = `R` // Override required
+	Table0268OverrideNotAllowed Table0268 = `X` // Override not allowed
+)
+
+// Description of the value, or empty if the value is not in table 0268.
+func (v Table0268) Description() string {
+	return tableDescription(`0268`, string(v))
+}
+
+// Valid reports if the value is in table 0268.
+func (v Table0268) Valid() bool {
+	return TableValueLookup[`0268`][string(v)]
+}
+
+// Table 0268.
+func (v Table0268) Table() Table {
+	return TableLookup[`0268`]
+}
+
+// Table0269 is a value of table 0269, Charge On Indicator.
+// Fields in this table are strings; convert a field, such as Table0269(v), to use the helpers.
+type Table0269 string
+
+const (
+	Table0269ChargeOnOrder  Table0269 = `O` // Charge on Order
+	Table0269ChargeOnResult Table0269 = `R` // Charge on Result
+)
+
+// Description of the value, or empty if the value is not in table 0269.
+func (v Table0269) Description() string {
+	return tableDescription(`0269`, string(v))
+}
+
+// Valid reports if the value is in table 0269.
+func (v Table0269) Valid() bool {
+	return TableValueLookup[`0269`][string(v)]
+}
+
+// Table 0269.
+func (v Table0269) Table() Table {
+	return TableLookup[`0269`]
+}
+
+// Table0270 is a value of table 0270, Document Type.
+// Fields in this table are strings; convert a field, such as Table0270(v), to use the helpers.
+type Table0270 string
+
+const (
+	Table0270AutopsyReport                            Table0270 = `AR` // Autopsy report
+	Table0270Cardiodiagnostics                        Table0270 = `CD` // Cardiodiagnostics
+	Table0270Consultation                             Table0270 = `CN` // Consultation
+	Table0270DiagnosticImaging                        Table0270 = `DI` // Diagnostic imaging
+	Table0270DischargeSummary                         Table0270 = `DS` // Discharge summary
+	Table0270EmergencyDepartmentReport                Table0270 = `ED` // Emergency department report
+	Table0270HistoryAndPhysicalExamination            Table0270 = `HP` // History and physical examination
+	Table0270OperativeReport                          Table0270 = `OP` // Operative report
+	Table0270PsychiatricConsultation                  Table0270 = `PC` // Psychiatric consultation
+	Table0270PsychiatricHistoryAndPhysicalExamination Table0270 = `PH` // Psychiatric history and physical examination
+	Table0270ProcedureNote                            Table0270 = `PN` // Procedure note
+	Table0270ProgressNote                             Table0270 = `PR` // Progress note
+	Table0270SurgicalPathology                        Table0270 = `SP` // Surgical pathology
+	Table0270TransferSummary                          Table0270 = `TS` // Transfer summary
+)
+
+// Description of the value, or empty if the value is not in table 0270.
+func (v Table0270) Description() string {
+	return tableDescription(`0270`, string(v))
+}
+
+// Valid reports if the value is in table 0270.
+func (v Table0270) Valid() bool {
+	return TableValueLookup[`0270`][string(v)]
+}
+
+// Table 0270.
+func (v Table0270) Table() Table {
+	return TableLookup[`0270`]
+}
+
+// Table0271 is a value of table 0271, Document Completion Status.
+// Fields in this table are strings; convert a field, such as Table0271(v), to use the helpers.
+type Table0271 string
+
+const (
+	Table0271Authenticated        Table0271 = `AU` // Authenticated
+	Table0271Dictated             Table0271 = `DI` // Dictated
+	Table0271Documented           Table0271 = `DO` // Documented
+	Table0271Incomplete           Table0271 = `IN` // Incomplete
+	Table0271InProgress           Table0271 = `IP` // In Progress
+	Table0271LegallyAuthenticated Table0271 = `LA` // Legally authenticated
+	Table0271PreAuthenticated     Table0271 = `PA` // Pre-authenticated
+)
+
+// Description of the value, or empty if the value is not in table 0271.
+func (v Table0271) Description() string {
+	return tableDescription(`0271`, string(v))
+}
+
+// Valid reports if the value is in table 0271.
+func (v Table0271) Valid() bool {
+	return TableValueLookup[`0271`][string(v)]
+}
+
+// Table 0271.
+func (v Table0271) Table() Table {
+	return TableLookup[`0271`]
+}
+
+// Table0272 is a value of table 0272, Document Confidentiality Status.
+// Fields in this table are strings; convert a field, such as Table0272(v), to use the helpers.
+type Table0272 string
+
+const (
+	Table0272Restricted     Table0272 = `R` // Restricted
+	Table0272UsualControl   Table0272 = `U` // Usual control
+	Table0272VeryRestricted Table0272 = `V` // Very restricted
+)
+
+// Description of the value, or empty if the value is not in table 0272.
+func (v Table0272) Description() string {
+	return tableDescription(`0272`, string(v))
+}
+
+// Valid reports if the value is in table 0272.
+func (v Table0272) Valid() bool {
+	return TableValueLookup[`0272`][string(v)]
+}
+
+// Table 0272.
+func (v Table0272) Table() Table {
+	return TableLookup[`0272`]
+}
+
+// Table0273 is a value of table 0273, Document Availability Status.
+// Fields in this table are strings; convert a field, such as Table0273(v), to use the helpers.
+type Table0273 string
+
+const (
+	Table0273AvailableForPatientCare   Table0273 = `AV` // Available for patient care
+	Table0273Deleted                   Table0273 = `CA` // Deleted
+	Table0273Obsolete                  Table0273 = `OB` // Obsolete
+	Table0273UnavailableForPatientCare Table0273 = `UN` // Unavailable for patient care
+)
+
+// Description of the value, or empty if the value is not in table 0273.
+func (v Table0273) Description() string {
+	return tableDescription(`0273`, string(v))
+}
+
+// Valid reports if the value is in table 0273.
+func (v Table0273) Valid() bool {
+	return TableValueLookup[`0273`][string(v)]
+}
+
+// Table 0273.
+func (v Table0273) Table() Table {
+	return TableLookup[`0273`]
+}
+
+// Table0275 is a value of table 0275, Document Storage Status.
+// Fields in this table are strings; convert a field, such as Table0275(v), to use the helpers.
+type Table0275 string
+
+const (
+	Table0275ActiveAndArchived Table0275 = `AA` // Active and archived
+	Table0275Active            Table0275 = `AC` // Active
+	Table0275ArchivedNotActive Table0275 = `AR` // Archived (not active)
+	Table0275Purged            Table0275 = `PU` // Purged
+)
+
+// Description of the value, or empty if the value is not in table 0275.
+func (v Table0275) Description() string {
+	return tableDescription(`0275`, string(v))
+}
+
+// Valid reports if the value is in table 0275.
+func (v Table0275) Valid() bool {
+	return TableValueLookup[`0275`][string(v)]
+}
+
+// Table 0275.
+func (v Table0275) Table() Table {
+	return TableLookup[`0275`]
+}
+
+// Table0276 is a value of table 0276, Appointment reason codes.
+// Fields in this table are strings; convert a field, such as Table0276(v), to use the helpers.
+type Table0276 string
+
+const (
+	Table0276ARoutineCheckUpSuchAs                Table0276 = `CHECKUP`   // A routine check-up, such as an annual physical
+	Table0276EmergencyAppointment                 Table0276 = `EMERGENCY` // Emergency appointment
+	Table0276AFollowUpVisitFromA                  Table0276 = `FOLLOWUP`  // A follow up visit from a previous appointment
+	Table0276RoutineAppointmentDefaultIfNotValued Table0276 = `ROUTINE`   // Routine appointment - default if not valued
+	Table0276APreviouslyUnscheduledWalkInVisit    Table0276 = `WALKIN`    // A previously unscheduled walk-in visit
+)
+
+// Description of the value, or empty if the value is not in table 0276.
+func (v Table0276) Description() string {
+	return tableDescription(`0276`, string(v))
+}
+
+// Valid reports if the value is in table 0276.
+func (v Table0276) Valid() bool {
+	return TableValueLookup[`0276`][string(v)]
+}
+
+// Table 0276.
+func (v Table0276) Table() Table {
+	return TableLookup[`0276`]
+}
+
+// Table0277 is a value of table 0277, Appointment Type Codes.
+// Fields in this table are strings; convert a field, such as Table0277(v), to use the helpers.
+type Table0277 string
+
+const (
+	Table0277ARequestToAddACompleted             Table0277 = `Complete`  // A request to add a completed appointment, used to maintain records of completed appointments that did not appear in the schedule (e.g., STAT, walk-in, etc.)
+	Table0277RoutineScheduleRequestTypeDefaultIf Table0277 = `Normal`    // Routine schedule request type - default if not valued
+	Table0277ARequestForATentativeE              Table0277 = `Tentative` // A request for a tentative (e.g., "penciled in") appointment
+)
+
+// Description of the value, or empty if the value is not in table 0277.
+func (v Table0277) Description() string {
+	return tableDescription(`0277`, string(v))
+}
+
+// Valid reports if the value is in table 0277.
+func (v Table0277) Valid() bool {
+	return TableValueLookup[`0277`][string(v)]
+}
+
+// Table 0277.
+func (v Table0277) Table() Table {
+	return TableLookup[`0277`]
+}
+
+// Table0278 is a value of table 0278, Filler status codes.
+// Fields in this table are strings; convert a field, such as Table0278(v), to use the helpers.
+type Table0278 string
+
+const (
+	Table0278TheIndicatedTimeSlotSIs                     Table0278 = `Blocked`   // The indicated time slot(s) is(are) blocked
+	Table0278TheIndicatedAppointmentIsBooked             Table0278 = `Booked`    // The indicated appointment is booked
+	Table0278TheIndicatedAppointmentWasStoppedFrom       Table0278 = `Cancelled` // The indicated appointment was stopped from occurring (canceled prior to starting)
+	Table0278TheIndicatedAppointmentHasCompletedNormally Table0278 = `Complete`  // The indicated appointment has completed normally (was not discontinued, canceled, or deleted)
+	Table0278TheIndicatedAppointmentWasDiscontinuedDC    Table0278 = `Dc`        // The indicated appointment was discontinued (DC'ed while in progress, discontinued parent appointment, or discontinued child appointment)
+	Table0278TheIndicatedAppointmentWasDeletedFrom       Table0278 = `Deleted`   // The indicated appointment was deleted from the filler application
+	Table0278ThePatientDidNotShowUp                      Table0278 = `Noshow`    // The patient did not show up for the appointment
+	Table0278TheAppointmentHasBeenConfirmedHowever       Table0278 = `Overbook`  // The appointment has been confirmed; however it is confirmed in an overbooked state
+	Table0278AppointmentHasNotYetBeenConfirmed           Table0278 = `Pending`   // Appointment has not yet been confirmed
+	Table0278TheIndicatedAppointmentHasBegunAnd          Table0278 = `Started`   // The indicated appointment has begun and is currently in progress
+	Table0278AppointmentHasBeenPlacedOnA                 Table0278 = `Waitlist`  // Appointment has been placed on a waiting list for a particular slot, or set of slots
+)
+
+// Description of the value, or empty if the value is not in table 0278.
+func (v Table0278) Description() string {
+	return tableDescription(`0278`, string(v))
+}
+
+// Valid reports if the value is in table 0278.
+func (v Table0278) Valid() bool {
+	return TableValueLookup[`0278`][string(v)]
+}
+
+// Table 0278.
+func (v Table0278) Table() Table {
+	return TableLookup[`0278`]
+}
+
+// Table0279 is a value of table 0279, Allow Substitution Codes.
+// Fields in this table are strings; convert a field, such as Table0279(v), to use the helpers.
+type Table0279 string
+
+const (
+	Table0279ContactThePlacerContactPersonPrior  Table0279 = `Confirm` // Contact the Placer Contact Person prior to making any substitutions of this resource
+	Table0279SubstitutionOfThisResourceIsNot     Table0279 = `No`      // Substitution of this resource is not allowed
+	Table0279NotifyThePlacerContactPersonThrough Table0279 = `Notify`  // Notify the Placer Contact Person, through normal institutional procedures, that a substitution of this resource has been made
+	Table0279SubstitutionOfThisResourceIsAllowed Table0279 = `Yes`     // Substitution of this resource is allowed
+)
+
+// Description of the value, or empty if the value is not in table 0279.
+func (v Table0279) Description() string {
+	return tableDescription(`0279`, string(v))
+}
+
+// Valid reports if the value is in table 0279.
+func (v Table0279) Valid() bool {
+	return TableValueLookup[`0279`][string(v)]
+}
+
+// Table 0279.
+func (v Table0279) Table() Table {
+	return TableLookup[`0279`]
+}
+
+// Table0280 is a value of table 0280, Referral Priority.
+// Fields in this table are strings; convert a field, such as Table0280(v), to use the helpers.
+type Table0280 string
+
+const (
+	Table0280ASAP    Table0280 = `A` // ASAP
+	Table0280Routine Table0280 = `R` // Routine
+	Table0280STAT    Table0280 = `S` // STAT
+)
+
+// Description of the value, or empty if the value is not in table 0280.
+func (v Table0280) Description() string {
+	return tableDescription(`0280`, string(v))
+}
+
+// Valid reports if the value is in table 0280.
+func (v Table0280) Valid() bool {
+	return TableValueLookup[`0280`][string(v)]
+}
+
+// Table 0280.
+func (v Table0280) Table() Table {
+	return TableLookup[`0280`]
+}
+
+// Table0281 is a value of table 0281, Referral Type.
+// Fields in this table are strings; convert a field, such as Table0281(v), to use the helpers.
+type Table0281 string
+
+const (
+	Table0281HomeCare       Table0281 = `Hom` // Home Care
+	Table0281Laboratory     Table0281 = `Lab` // Laboratory
+	Table0281Medical        Table0281 = `Med` // Medical
+	Table0281Psychiatric    Table0281 = `Psy` // Psychiatric
+	Table0281Radiology      Table0281 = `Rad` // Radiology
+	Table0281SkilledNursing Table0281 = `Skn` // Skilled Nursing
+)
+
+// Description of the value, or empty if the value is not in table 0281.
+func (v Table0281) Description() string {
+	return tableDescription(`0281`, string(v))
+}
+
+// Valid reports if the value is in table 0281.
+func (v Table0281) Valid() bool {
+	return TableValueLookup[`0281`][string(v)]
+}
+
+// Table 0281.
+func (v Table0281) Table() Table {
+	return TableLookup[`0281`]
+}
+
+// Table0282 is a value of table 0282, Referral Disposition.
+// Fields in this table are strings; convert a field, such as Table0282(v), to use the helpers.
+type Table0282 string
+
+const (
+	Table0282AssumeManagement             Table0282 = `AM` // Assume Management
+	Table0282ReturnPatientAfterEvaluation Table0282 = `RP` // Return Patient After Evaluation
+	Table0282SecondOpinion                Table0282 = `SO` // Second Opinion
+	Table0282SendWrittenReport            Table0282 = `WR` // Send Written Report
+)
+
+// Description of the value, or empty if the value is not in table 0282.
+func (v Table0282) Description() string {
+	return tableDescription(`0282`, string(v))
+}
+
+// Valid reports if the value is in table 0282.
+func (v Table0282) Valid() bool {
+	return TableValueLookup[`0282`][string(v)]
+}
+
+// Table 0282.
+func (v Table0282) Table() Table {
+	return TableLookup[`0282`]
+}
+
+// Table0283 is a value of table 0283, Referral Status.
+// Fields in this table are strings; convert a field, such as Table0283(v), to use the helpers.
+type Table0283 string
+
+const (
+	Table0283Accepted Table0283 = `A` // Accepted
+	Table0283Expired  Table0283 = `E` // Expired
+	Table0283Pending  Table0283 = `P` // Pending
+	Table0283Rejected Table0283 = `R` // Rejected
+)
+
+// Description of the value, or empty if the value is not in table 0283.
+func (v Table0283) Description() string {
+	return tableDescription(`0283`, string(v))
+}
+
+// Valid reports if the value is in table 0283.
+func (v Table0283) Valid() bool {
+	return TableValueLookup[`0283`][string(v)]
+}
+
+// Table 0283.
+func (v Table0283) Table() Table {
+	return TableLookup[`0283`]
+}
+
+// Table0284 is a value of table 0284, Referral Category.
+// Fields in this table are strings; convert a field, such as Table0284(v), to use the helpers.
+type Table0284 string
+
+const (
+	Table0284Ambulatory Table0284 = `A` // Ambulatory
+	Table0284Emergency  Table0284 = `E` // Emergency
+	Table0284Inpatient  Table0284 = `I` // Inpatient
+	Table0284Outpatient Table0284 = `O` // Outpatient
+)
+
+// Description of the value, or empty if the value is not in table 0284.
+func (v Table0284) Description() string {
+	return tableDescription(`0284`, string(v))
+}
+
+// Valid reports if the value is in table 0284.
+func (v Table0284) Valid() bool {
+	return TableValueLookup[`0284`][string(v)]
+}
+
+// Table 0284.
+func (v Table0284) Table() Table {
+	return TableLookup[`0284`]
+}
+
+// Table0286 is a value of table 0286, Provider Role.
+// Fields in this table are strings; convert a field, such as Table0286(v), to use the helpers.
+type Table0286 string
+
+const (
+	Table0286ConsultingProvider  Table0286 = `CP` // Consulting Provider
+	Table0286PrimaryCareProvider Table0286 = `PP` // Primary Care Provider
+	Table0286ReferringProvider   Table0286 = `RP` // Referring Provider
+	Table0286ReferredToProvider  Table0286 = `RT` // Referred to Provider
+)
+
+// Description of the value, or empty if the value is not in table 0286.
+func (v Table0286) Description() string {
+	return tableDescription(`0286`, string(v))
+}
+
+// Valid reports if the value is in table 0286.
+func (v Table0286) Valid() bool {
+	return TableValueLookup[`0286`][string(v)]
+}
+
+// Table 0286.
+func (v Table0286) Table() Table {
+	return TableLookup[`0286`]
+}
+
+// Table0287 is a value of table 0287, Problem/Goal Action Code.
+// Fields in this table are strings; convert a field, such as Table0287(v), to use the helpers.
+type Table0287 string
+
+const (
+	Table0287ADD       Table0287 = `AD` // ADD
+	Table0287CORRECT   Table0287 = `CO` // CORRECT
+	Table0287DELETE    Table0287 = `DE` // DELETE
+	Table0287LINK      Table0287 = `LI` // LINK
+	Table0287UNCHANGED Table0287 = `UC` // UNCHANGED *
+	Table0287UNLINK    Table0287 = `UN` // UNLINK
+	Table0287UPDATE    Table0287 = `UP` // UPDATE
+)
+
+// Description of the value, or empty if the value is not in table 0287.
+func (v Table0287) Description() string {
+	return tableDescription(`0287`, string(v))
+}
+
+// Valid reports if the value is in table 0287.
+func (v Table0287) Valid() bool {
+	return TableValueLookup[`0287`][string(v)]
+}
+
+// Table 0287.
+func (v Table0287) Table() Table {
+	return TableLookup[`0287`]
+}
+
+// Table0291 is a value of table 0291, Subtype of Referenced Data.
+// Fields in this table are strings; convert a field, such as Table0291(v), to use the helpers.
+type Table0291 string
+
+const (
+	Table0291HL7ClinicalDocumentArchitectureLevelOne Table0291 = `x-hl7-cda-level-one` // HL7 Clinical Document Architecture Level One document
+)
+
+// Description of the value, or empty if the value is not in table 0291.
+func (v Table0291) Description() string {
+	return tableDescription(`0291`, string(v))
+}
+
+// Valid reports if the value is in table 0291.
+func (v Table0291) Valid() bool {
+	return TableValueLookup[`0291`][string(v)]
+}
+
+// Table 0291.
+func (v Table0291) Table() Table {
+	return TableLookup[`0291`]
+}
+
+// Table0292 is a value of table 0292, Vaccines Administered.
+// Fields in this table are strings; convert a field, such as Table0292(v), to use the helpers.
+type Table0292 string
+
+const (
+	Table0292DTP                                      Table0292 = `01`  // DTP
+	Table0292OPV                                      Table0292 = `02`  // OPV
+	Table0292MMR                                      Table0292 = `03`  // MMR
+	Table0292MR                                       Table0292 = `04`  // M/R
+	Table0292Measles                                  Table0292 = `05`  // measles
+	Table0292Rubella                                  Table0292 = `06`  // rubella
+	Table0292Mumps                                    Table0292 = `07`  // mumps
+	Table0292HepBAdolescentOrPediatric                Table0292 = `08`  // Hep B, adolescent or pediatric
+	Table0292TdAdult                                  Table0292 = `09`  // Td (adult)
+	Table0292IPV                                      Table0292 = `10`  // IPV
+	Table0292PneumococcalConjugate                    Table0292 = `100` // pneumococcal conjugate
+	Table0292TyphoidViCPs                             Table0292 = `101` // typhoid, ViCPs
+	Table0292DTPHibHepB                               Table0292 = `102` // DTP-Hib-Hep B
+	Table0292MeningococcalCConjugate                  Table0292 = `103` // meningococcal C conjugate
+	Table0292HepAHepB                                 Table0292 = `104` // Hep A-Hep B
+	Table0292VacciniaSmallpoxDiluted                  Table0292 = `105` // vaccinia (smallpox) diluted
+	Table0292DTaP5PertussisAntigens6                  Table0292 = `106` // DTaP, 5 pertussis antigens6
+	Table0292DTaPNOS                                  Table0292 = `107` // DTaP, NOS
+	Table0292MeningococcalNOS                         Table0292 = `108` // meningococcal, NOS
+	Table0292PneumococcalNOS                          Table0292 = `109` // pneumococcal, NOS
+	Table0292Pertussis                                Table0292 = `11`  // pertussis
+	Table0292DTaPHepBIPV                              Table0292 = `110` // DTaP-Hep B-IPV
+	Table0292InfluenzaLiveIntranasal                  Table0292 = `111` // influenza, live, intranasal
+	Table0292TetanusToxoidNOS                         Table0292 = `112` // tetanus toxoid, NOS
+	Table0292TdAdult_113                              Table0292 = `113` // Td (adult)
+	Table0292MeningococcalACYW135                     Table0292 = `114` // meningococcal A,C,Y,W-135 diphtheria conjugate
+	Table0292Tdap                                     Table0292 = `115` // Tdap
+	Table0292RotavirusPentavalent                     Table0292 = `116` // rotavirus, pentavalent
+	Table0292VZIGIND                                  Table0292 = `117` // VZIG (IND)
+	Table0292HPVBivalent                              Table0292 = `118` // HPV, bivalent
+	Table0292RotavirusMonovalent                      Table0292 = `119` // rotavirus, monovalent
+	Table0292DiphtheriaAntitoxin                      Table0292 = `12`  // diphtheria antitoxin
+	Table0292DTaPHibIPV                               Table0292 = `120` // DTaP-Hib-IPV
+	Table0292Zoster                                   Table0292 = `121` // zoster
+	Table0292RotavirusNOS1                            Table0292 = `122` // rotavirus, NOS1
+	Table0292TIG                                      Table0292 = `13`  // TIG
+	Table0292IGNOS                                    Table0292 = `14`  // IG, NOS
+	Table0292InfluenzaSplitInclPurifiedSurfaceAntigen Table0292 = `15`  // influenza, split (incl. purified surface antigen)
+	Table0292InfluenzaWhole                           Table0292 = `16`  // influenza, whole
+	Table0292HibNOS                                   Table0292 = `17`  // Hib, NOS
+	Table0292RabiesIntramuscularInjection             Table0292 = `18`  // rabies, intramuscular injection
+	Table0292BCG                                      Table0292 = `19`  // BCG
+	Table0292DTaP                                     Table0292 = `20`  // DTaP
+	Table0292Varicella                                Table0292 = `21`  // varicella
+	Table0292DTPHib                                   Table0292 = `22`  // DTP-Hib
+	Table0292Plague                                   Table0292 = `23`  // plague
+	Table0292Anthrax                                  Table0292 = `24`  // anthrax
+	Table0292TyphoidOral                              Table0292 = `25`  // typhoid, oral
+	Table0292Cholera                                  Table0292 = `26`  // cholera
+	Table0292BotulinumAntitoxin                       Table0292 = `27`  // botulinum antitoxin
+	Table0292DTPediatric                              Table0292 = `28`  // DT (pediatric)
+	Table0292CMVIG                                    Table0292 = `29`  // CMVIG
+	Table0292HBIG                                     Table0292 = `30`  // HBIG
+	Table0292HepAPediatricNOS                         Table0292 = `31`  // Hep A, pediatric, NOS
+	Table0292Meningococcal                            Table0292 = `32`  // meningococcal
+	Table0292Pneumococcal                             Table0292 = `33`  // pneumococcal
+	Table0292RIG                                      Table0292 = `34`  // RIG
+	Table0292TetanusToxoid                            Table0292 = `35`  // tetanus toxoid
+	Table0292VZIG                                     Table0292 = `36`  // VZIG
+	Table0292YellowFever                              Table0292 = `37`  // yellow fever
+	Table0292RubellaMumps                             Table0292 = `38`  // rubella/mumps
+	Table0292JapaneseEncephalitis                     Table0292 = `39`  // Japanese encephalitis
+	Table0292RabiesIntradermalInjection               Table0292 = `40`  // rabies, intradermal injection
+	Table0292TyphoidParenteral                        Table0292 = `41`  // typhoid, parenteral
+	Table0292HepBAdolescentHighRiskInfant2            Table0292 = `42`  // Hep B, adolescent/high risk infant2
+	Table0292HepBAdult4                               Table0292 = `43`  // Hep B, adult4
+	Table0292HepBDialysis                             Table0292 = `44`  // Hep B, dialysis
+	Table0292HepBNOS                                  Table0292 = `45`  // Hep B, NOS
+	Table0292HibPRPD                                  Table0292 = `46`  // Hib (PRP-D)
+	Table0292HibHbOC                                  Table0292 = `47`  // Hib (HbOC)
+	Table0292HibPRPT                                  Table0292 = `48`  // Hib (PRP-T)
+	Table0292HibPRPOMP                                Table0292 = `49`  // Hib (PRP-OMP)
+	Table0292DTaPHib                                  Table0292 = `50`  // DTaP-Hib
+	Table0292HibHepB                                  Table0292 = `51`  // Hib-Hep B
+	Table0292HepAAdult                                Table0292 = `52`  // Hep A, adult
+	Table0292TyphoidParenteralAKDUSMilitary           Table0292 = `53`  // typhoid, parenteral, AKD (U.S. military)
+	Table0292AdenovirusType4                          Table0292 = `54`  // adenovirus, type 4
+	Table0292AdenovirusType7                          Table0292 = `55`  // adenovirus, type 7
+	Table0292DengueFever                              Table0292 = `56`  // dengue fever
+	Table0292Hantavirus                               Table0292 = `57`  // hantavirus
+	Table0292HepC                                     Table0292 = `58`  // Hep C
+	Table0292HepE                                     Table0292 = `59`  // Hep E
+	Table0292HerpesSimplex2                           Table0292 = `60`  // herpes simplex 2
+	Table0292HIV                                      Table0292 = `61`  // HIV
+	Table0292HPVQuadrivalent                          Table0292 = `62`  // HPV, quadrivalent
+	Table0292JuninVirus                               Table0292 = `63`  // Junin virus
+	Table0292Leishmaniasis                            Table0292 = `64`  // leishmaniasis
+	Table0292Leprosy                                  Table0292 = `65`  // leprosy
+	Table0292LymeDisease                              Table0292 = `66`  // Lyme disease
+	Table0292Malaria                                  Table0292 = `67`  // malaria
+	Table0292Melanoma                                 Table0292 = `68`  // melanoma
+	Table0292Parainfluenza3                           Table0292 = `69`  // parainfluenza-3
+	Table0292QFever                                   Table0292 = `70`  // Q fever
+	Table0292RSVIGIV                                  Table0292 = `71`  // RSV-IGIV
+	Table0292RheumaticFever                           Table0292 = `72`  // rheumatic fever
+	Table0292RiftValleyFever                          Table0292 = `73`  // Rift Valley fever
+	Table0292RotavirusTetravalent                     Table0292 = `74`  // rotavirus, tetravalent
+	Table0292VacciniaSmallpox                         Table0292 = `75`  // vaccinia (smallpox)
+	Table0292StaphylococcusBacterioLysate             Table0292 = `76`  // Staphylococcus bacterio lysate
+	Table0292TickBorneEncephalitis                    Table0292 = `77`  // tick-borne encephalitis
+	Table0292TularemiaVaccine                         Table0292 = `78`  // tularemia vaccine
+	Table0292VacciniaImmuneGlobulin                   Table0292 = `79`  // vaccinia immune globulin
+	Table0292VEELive                                  Table0292 = `80`  // VEE, live
+	Table0292VEEInactivated                           Table0292 = `81`  // VEE, inactivated
+	Table0292AdenovirusNOS1                           Table0292 = `82`  // adenovirus, NOS1
+	Table0292HepAPedAdol2Dose                         Table0292 = `83`  // Hep A, ped/adol, 2 dose
+	Table0292HepAPedAdol3Dose                         Table0292 = `84`  // Hep A, ped/adol, 3 dose
+	Table0292HepANOS                                  Table0292 = `85`  // Hep A, NOS
+	Table0292IG                                       Table0292 = `86`  // IG
+	Table0292IGIV                                     Table0292 = `87`  // IGIV
+	Table0292InfluenzaNOS                             Table0292 = `88`  // influenza, NOS
+	Table0292PolioNOS                                 Table0292 = `89`  // polio, NOS
+	Table0292RabiesNOS                                Table0292 = `90`  // rabies, NOS
+	Table0292TyphoidNOS                               Table0292 = `91`  // typhoid, NOS
+	Table0292VEENOS                                   Table0292 = `92`  // VEE, NOS
+	Table0292RSVMAb                                   Table0292 = `93`  // RSV-MAb
+	Table0292MMRV                                     Table0292 = `94`  // MMRV
+	Table0292TSTOTTineTest                            Table0292 = `95`  // TST-OT tine test
+	Table0292TSTPPDIntradermal                        Table0292 = `96`  // TST-PPD intradermal
+	Table0292TSTPPDTineTest                           Table0292 = `97`  // TST-PPD tine test
+	Table0292TSTNOS                                   Table0292 = `98`  // TST, NOS
+	Table0292RESERVEDDoNotUse3                        Table0292 = `99`  // RESERVED - do not use3
+	Table0292NoVaccineAdministered5                   Table0292 = `998` // no vaccine administered5
+	Table0292Unknown                                  Table0292 = `999` // unknown
+)
+
+// Description of the value, or empty if the value is not in table 0292.
+func (v Table0292) Description() string {
+	return tableDescription(`0292`, string(v))
+}
+
+// Valid reports if the value is in table 0292.
+func (v Table0292) Valid() bool {
+	return TableValueLookup[`0292`][string(v)]
+}
+
+// Table 0292.
+func (v Table0292) Table() Table {
+	return TableLookup[`0292`]
+}
+
+// Table0294 is a value of table 0294, Time Selection Criteria Parameter Class Codes.
+// Fields in this table are strings; convert a field, such as Table0294(v), to use the helpers.
+type Table0294 string
+
+const (
+	Table0294AnIndicatorThatFridayIsOr         Table0294 = `Fri`       // An indicator that Friday is or is not preferred for the day on which the appointment will occur.
+	Table0294AnIndicatorThatMondayIsOr         Table0294 = `Mon`       // An indicator that Monday is or is not preferred for the day on which the appointment will occur.
+	Table0294AnIndicatorThatThereIsA           Table0294 = `Prefend`   // An indicator that there is a preferred end time for the appointment request, service or resource.
+	Table0294AnIndicatorThatThereIsA_Prefstart Table0294 = `Prefstart` // An indicator that there is a preferred start time for the appointment request, service or resource.
+	Table0294AnIndicatorThatSaturdayIsOr       Table0294 = `Sat`       // An indicator that Saturday is or is not preferred for the day on which the appointment will occur.
+	Table0294AnIndicatorThatSundayIsOr         Table0294 = `Sun`       // An indicator that Sunday is or is not preferred for the day on which the appointment will occur.
+	Table0294AnIndicatorThatThursdayIsOr       Table0294 = `Thu`       // An indicator that Thursday is or is not preferred for the day on which the appointment will occur.
+	Table0294AnIndicatorThatTuesdayIsOr        Table0294 = `Tue`       // An indicator that Tuesday is or is not preferred for the day on which the appointment will occur.
+	Table0294AnIndicatorThatWednesdayIsOr      Table0294 = `Wed`       // An indicator that Wednesday is or is not preferred for the day on which the appointment will occur.
+)
+
+// Description of the value, or empty if the value is not in table 0294.
+func (v Table0294) Description() string {
+	return tableDescription(`0294`, string(v))
+}
+
+// Valid reports if the value is in table 0294.
+func (v Table0294) Valid() bool {
+	return TableValueLookup[`0294`][string(v)]
+}
+
+// Table 0294.
+func (v Table0294) Table() Table {
+	return TableLookup[`0294`]
+}
+
+// Table0298 is a value of table 0298, CP Range Type.
+// Fields in this table are strings; convert a field, such as Table0298(v), to use the helpers.
+type Table0298 string
+
+const (
+	Table0298FlatRateApplyTheEntirePrice Table0298 = `F` // Flat-rate. Apply the entire price to this interval, do not pro-rate the price if the full interval has not occurred/been consumed
+	Table0298ProRateApplyThisPriceTo     Table0298 = `P` // Pro-rate. Apply this price to this interval, pro-rated by whatever portion of the interval has occurred/been consumed
+)
+
+// Description of the value, or empty if the value is not in table 0298.
+func (v Table0298) Description() string {
+	return tableDescription(`0298`, string(v))
+}
+
+// Valid reports if the value is in table 0298.
+func (v Table0298) Valid() bool {
+	return TableValueLookup[`0298`][string(v)]
+}
+
+// Table 0298.
+func (v Table0298) Table() Table {
+	return TableLookup[`0298`]
+}
+
+// Table0299 is a value of table 0299, Encoding.
+// Fields in this table are strings; convert a field, such as Table0299(v), to use the helpers.
+type Table0299 string
+
+const (
+	Table0299NoEncodingDataAreDisplayableASCII                Table0299 = `A`      // No encoding - data are displayable ASCII characters.
+	Table0299EncodingAsDefinedByMIMEMultipurpose              Table0299 = `Base64` // Encoding as defined by MIME (Multipurpose Internet Mail Extensions) standard RFC 1521. Four consecutive ASCII characters represent three consecutive octets of binary data. Base64 utilizes a 65-character subset of US-ASCII, consisting of both the upper and
+	Table0299HexadecimalEncodingConsecutivePairsOfHexadecimal Table0299 = `Hex`    // Hexadecimal encoding - consecutive pairs of hexadecimal digits represent consecutive single octets.
+)
+
+// Description of the value, or empty if the value is not in table 0299.
+func (v Table0299) Description() string {
+	return tableDescription(`0299`, string(v))
+}
+
+// Valid reports if the value is in table 0299.
+func (v Table0299) Valid() bool {
+	return TableValueLookup[`0299`][string(v)]
+}
+
+// Table 0299.
+func (v Table0299) Table() Table {
+	return TableLookup[`0299`]
+}
+
+// Table0301 is a value of table 0301, Universal ID Type.
+// Fields in this table are strings; convert a field, such as Table0301(v), to use the helpers.
+type Table0301 string
+
+const (
+	Table0301ClinicalLaboratoryImprovementAmendmentsAllowsFor     Table0301 = `CLIA`   // Clinical Laboratory Improvement Amendments. Allows for the ability to designate organization identifier as a "CLIA" assigned number (for labs)
+	Table0301ClinicalLaboratoryImprovementProgramAllowsFor        Table0301 = `CLIP`   // Clinical laboratory Improvement Program. Allows for the ability to designate organization identifier as a "CLIP" assigned number (for labs).Â Used by US Department of Defense.
+	Table0301AnInternetHostNameInAccordance                       Table0301 = `DNS`    // An Internet host name, in accordance with RFC 1035; or an IP address. Either in ASCII or as integers, with periods between components ("dotted" notation).
+	Table0301IEEE64BitExtendedUniqueIdentifier                    Table0301 = `EUI64`  // IEEE 64-bit Extended Unique Identifier is comprised of a  24-bit company identifier and a 40-bit instance identifier.  The value shall be formatted as 16 ASCII HEX digits, for example, "AABBCC1122334455".  The 24-bit company identifier, formally known as
+	Table0301SameAsUUID                                           Table0301 = `GUID`   // Same as UUID.
+	Table0301TheCENHealthcareCodingSchemeDesignator               Table0301 = `HCD`    // The CEN Healthcare Coding Scheme Designator
+	Table0301HL7RegistrationSchemes                               Table0301 = `HL7`    // HL7 registration schemes
+	Table0301AnInternationalStandardsOrganizationObjectIdentifier Table0301 = `ISO`    // An International Standards Organization Object Identifier (OID), in accordance with ISO/IEC 8824.  Formatted as decimal digits separated by periods; recommended limit of 64 characters
+	Table0301LocallyDefinedCodingEntityIdentifier                 Table0301 = `L,M,N`  // Locally defined coding entity identifier.
+	Table0301UsuallyABase64EncodedStringOf                        Table0301 = `Random` // Usually a base64 encoded string of random bits.<p>Note: Random IDs are typically used for instance identifiers, rather than an identifier of an Assigning Authority that issues instance identifiers
+	Table0301UniformResourceIdentifier                            Table0301 = `URI`    // Uniform Resource Identifier
+	Table0301TheDCEUniversalUniqueIdentifierIn                    Table0301 = `UUID`   // The DCE Universal Unique Identifier, in accordance with RFC 4122. Recommended format is 32 hexadecimal digits separated by hyphens, in the digit grouping 8-4-4-4-12
+	Table0301AnX400MHSIdentifierRecommended                       Table0301 = `x400`   // An X.400 MHS identifier. Recommended format is in accordance with RFC 1649
+	Table0301AnX500DirectoryName                                  Table0301 = `x500`   // An X.500 directory name
+)
+
+// Description of the value, or empty if the value is not in table 0301.
+func (v Table0301) Description() string {
+	return tableDescription(`0301`, string(v))
+}
+
+// Valid reports if the value is in table 0301.
+func (v Table0301) Valid() bool {
+	return TableValueLookup[`0301`][string(v)]
+}
+
+// Table 0301.
+func (v Table0301) Table() Table {
+	return TableLookup[`0301`]
+}
+
+// Table0305 is a value of table 0305, Person Location Type.
+// Fields in this table are strings; convert a field, such as Table0305(v), to use the helpers.
+type Table0305 string
+
+const (
+	Table0305Clinic          Table0305 = `C` // Clinic
+	Table0305Department      Table0305 = `D` // Department
+	Table0305Home            Table0305 = `H` // Home
+	Table0305NursingUnit     Table0305 = `N` // Nursing Unit
+	Table0305ProviderSOffice Table0305 = `O` // Provider's Office
+	Table0305Phone           Table0305 = `P` // Phone
+	Table0305SNF             Table0305 = `S` // SNF
+)
+
+// Description of the value, or empty if the value is not in table 0305.
+func (v Table0305) Description() string {
+	return tableDescription(`0305`, string(v))
+}
+
+// Valid reports if the value is in table 0305.
+func (v Table0305) Valid() bool {
+	return TableValueLookup[`0305`][string(v)]
+}
+
+// Table 0305.
+func (v Table0305) Table() Table {
+	return TableLookup[`0305`]
+}
+
+// Table0309 is a value of table 0309, Coverage Type.
+// Fields in this table are strings; convert a field, such as Table0309(v), to use the helpers.
+type Table0309 string
+
+const (
+	Table0309BothHospitalAndPhysician Table0309 = `B`  // Both hospital and physician
+	Table0309HospitalInstitutional    Table0309 = `H`  // Hospital/institutional
+	Table0309PhysicianProfessional    Table0309 = `P`  // Physician/professional
+	Table0309Pharmacy                 Table0309 = `RX` // Pharmacy
+)
+
+// Description of the value, or empty if the value is not in table 0309.
+func (v Table0309) Description() string {
+	return tableDescription(`0309`, string(v))
+}
+
+// Valid reports if the value is in table 0309.
+func (v Table0309) Valid() bool {
+	return TableValueLookup[`0309`][string(v)]
+}
+
+// Table 0309.
+func (v Table0309) Table() Table {
+	return TableLookup[`0309`]
+}
+
+// Table0311 is a value of table 0311, Job Status.
+// Fields in this table are strings; convert a field, such as Table0311(v), to use the helpers.
+type Table0311 string
+
+const (
+	Table0311Other     Table0311 = `O` // Other
+	Table0311Permanent Table0311 = `P` // Permanent
+	Table0311Temporary Table0311 = `T` // Temporary
+	Table0311Unknown   Table0311 = `U` // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0311.
+func (v Table0311) Description() string {
+	return tableDescription(`0311`, string(v))
+}
+
+// Valid reports if the value is in table 0311.
+func (v Table0311) Valid() bool {
+	return TableValueLookup[`0311`][string(v)]
+}
+
+// Table 0311.
+func (v Table0311) Table() Table {
+	return TableLookup[`0311`]
+}
+
+// Table0315 is a value of table 0315, Living Will Code.
+// Fields in this table are strings; convert a field, such as Table0315(v), to use the helpers.
+type Table0315 string
+
+const (
+	Table0315YesPatientHasALivingWill   Table0315 = `F` // Yes, patient has a living will but it is not on file
+	Table0315NoPatientDoesNotHaveA      Table0315 = `I` // No, patient does not have a living will but information was provided
+	Table0315NoPatientDoesNotHaveA_N    Table0315 = `N` // No, patient does not have a living will and no information was provided
+	Table0315Unknown                    Table0315 = `U` // Unknown
+	Table0315YesPatientHasALivingWill_Y Table0315 = `Y` // Yes, patient has a living will
+)
+
+// Description of the value, or empty if the value is not in table 0315.
+func (v Table0315) Description() string {
+	return tableDescription(`0315`, string(v))
+}
+
+// Valid reports if the value is in table 0315.
+func (v Table0315) Valid() bool {
+	return TableValueLookup[`0315`][string(v)]
+}
+
+// Table 0315.
+func (v Table0315) Table() Table {
+	return TableLookup[`0315`]
+}
+
+// Table0316 is a value of table 0316, Organ Donor Code.
+// Fields in this table are strings; convert a field, such as Table0316(v), to use the helpers.
+type Table0316 string
+
+const (
+	Table0316YesPatientIsADocumentedDonor           Table0316 = `F` // Yes, patient is a documented donor, but documentation is not on file
+	Table0316NoPatientIsNotADocumented              Table0316 = `I` // No, patient is not a documented donor, but information was provided
+	Table0316NoPatientHasNotAgreedTo                Table0316 = `N` // No, patient has not agreed to be a donor
+	Table0316PatientLeavesOrganDonationDecisionTo   Table0316 = `P` // Patient leaves organ donation decision to a specific person
+	Table0316PatientLeavesOrganDonationDecisionTo_R Table0316 = `R` // Patient leaves organ donation decision to relatives
+	Table0316Unknown                                Table0316 = `U` // Unknown
+	Table0316YesPatientIsADocumentedDonor_Y         Table0316 = `Y` // Yes, patient is a documented donor and documentation is on file
+)
+
+// Description of the value, or empty if the value is not in table 0316.
+func (v Table0316) Description() string {
+	return tableDescription(`0316`, string(v))
+}
+
+// Valid reports if the value is in table 0316.
+func (v Table0316) Valid() bool {
+	return TableValueLookup[`0316`][string(v)]
+}
+
+// Table 0316.
+func (v Table0316) Table() Table {
+	return TableLookup[`0316`]
+}
+
+// Table0321 is a value of table 0321, Dispense Method.
+// Fields in this table are strings; convert a field, such as Table0321(v), to use the helpers.
+type Table0321 string
+
+const (
+	Table0321AutomaticDispensing Table0321 = `AD` // Automatic Dispensing
+	Table0321FloorStock          Table0321 = `F`  // Floor Stock
+	Table0321Traditional         Table0321 = `TR` // Traditional
+	Table0321UnitDose            Table0321 = `UD` // Unit Dose
+)
+
+// Description of the value, or empty if the value is not in table 0321.
+func (v Table0321) Description() string {
+	return tableDescription(`0321`, string(v))
+}
+
+// Valid reports if the value is in table 0321.
+func (v Table0321) Valid() bool {
+	return TableValueLookup[`0321`][string(v)]
+}
+
+// Table 0321.
+func (v Table0321) Table() Table {
+	return TableLookup[`0321`]
+}
+
+// Table0322 is a value of table 0322, Completion Status.
+// Fields in this table are strings; convert a field, such as Table0322(v), to use the helpers.
+type Table0322 string
+
+const (
+	Table0322Complete              Table0322 = `CP` // Complete
+	Table0322NotAdministered       Table0322 = `NA` // Not Administered
+	Table0322PartiallyAdministered Table0322 = `PA` // Partially Administered
+	Table0322Refused               Table0322 = `RE` // Refused
+)
+
+// Description of the value, or empty if the value is not in table 0322.
+func (v Table0322) Description() string {
+	return tableDescription(`0322`, string(v))
+}
+
+// Valid reports if the value is in table 0322.
+func (v Table0322) Valid() bool {
+	return TableValueLookup[`0322`][string(v)]
+}
+
+// Table 0322.
+func (v Table0322) Table() Table {
+	return TableLookup[`0322`]
+}
+
+// Table0324 is a value of table 0324, Location Characteristic ID.
+// Fields in this table are strings; convert a field, such as Table0324(v), to use the helpers.
+type Table0324 string
+
+const (
+	Table0324GenderOfPatientS                    Table0324 = `GEN` // Gender of patient(s)
+	Table0324ImplantCanBeUsedForRadiation        Table0324 = `IMP` // Implant: can be used for radiation implant patients
+	Table0324InfectiousDiseaseThisLocationCanBe  Table0324 = `INF` // Infectious disease: this location can be used for isolation
+	Table0324LevelOfCare                         Table0324 = `LCR` // Level of care
+	Table0324Licensed                            Table0324 = `LIC` // Licensed
+	Table0324Overflow                            Table0324 = `OVR` // Overflow
+	Table0324PrivacyLevelIndicatingTheLevelOf    Table0324 = `PRL` // Privacy level: indicating the level of private versus non-private room
+	Table0324BedIsSetUp                          Table0324 = `SET` // Bed is set up
+	Table0324ShadowATemporaryHoldingLocationThat Table0324 = `SHA` // Shadow: a temporary holding location that does not physically exist
+	Table0324Smoking                             Table0324 = `SMK` // Smoking
+	Table0324BedIsStaffed                        Table0324 = `STF` // Bed is staffed
+	Table0324TeachingLocation                    Table0324 = `TEA` // Teaching location
+)
+
+// Description of the value, or empty if the value is not in table 0324.
+func (v Table0324) Description() string {
+	return tableDescription(`0324`, string(v))
+}
+
+// Valid reports if the value is in table 0324.
+func (v Table0324) Valid() bool {
+	return TableValueLookup[`0324`][string(v)]
+}
+
+// Table 0324.
+func (v Table0324) Table() Table {
+	return TableLookup[`0324`]
+}
+
+// Table0325 is a value of table 0325, Location Relationship ID.
+// Fields in this table are strings; convert a field, such as Table0325(v), to use the helpers.
+type Table0325 string
+
+const (
+	Table0325LocationAliasEs        Table0325 = `ALI` // Location Alias(es)
+	Table0325NearestDietaryLocation Table0325 = `DTY` // Nearest  dietary location
+	Table0325NearestLab             Table0325 = `LAB` // Nearest  lab
+	Table0325SecondNearestLab       Table0325 = `LB2` // Second nearest lab
+	Table0325ParentLocation         Table0325 = `PAR` // Parent location
+	Table0325NearestPharmacy        Table0325 = `RX`  // Nearest  pharmacy
+	Table0325SecondNearestPharmacy  Table0325 = `RX2` // Second nearest pharmacy
+)
+
+// Description of the value, or empty if the value is not in table 0325.
+func (v Table0325) Description() string {
+	return tableDescription(`0325`, string(v))
+}
+
+// Valid reports if the value is in table 0325.
+func (v Table0325) Valid() bool {
+	return TableValueLookup[`0325`][string(v)]
+}
+
+// Table 0325.
+func (v Table0325) Table() Table {
+	return TableLookup[`0325`]
+}
+
+// Table0326 is a value of table 0326, Visit Indicator.
+// Fields in this table are strings; convert a field, such as Table0326(v), to use the helpers.
+type Table0326 string
+
+const (
+	Table0326AccountLevelDefault Table0326 = `A` // Account level (default)
+	Table0326VisitLevel          Table0326 = `V` // Visit level
+)
+
+// Description of the value, or empty if the value is not in table 0326.
+func (v Table0326) Description() string {
+	return tableDescription(`0326`, string(v))
+}
+
+// Valid reports if the value is in table 0326.
+func (v Table0326) Valid() bool {
+	return TableValueLookup[`0326`][string(v)]
+}
+
+// Table 0326.
+func (v Table0326) Table() Table {
+	return TableLookup[`0326`]
+}
+
+// Table0332 is a value of table 0332, Source Type.
+// Fields in this table are strings; convert a field, such as Table0332(v), to use the helpers.
+type Table0332 string
+
+const (
+	Table0332Accept   Table0332 = `A` // Accept
+	Table0332Initiate Table0332 = `I` // Initiate
+)
+
+// Description of the value, or empty if the value is not in table 0332.
+func (v Table0332) Description() string {
+	return tableDescription(`0332`, string(v))
+}
+
+// Valid reports if the value is in table 0332.
+func (v Table0332) Valid() bool {
+	return TableValueLookup[`0332`][string(v)]
+}
+
+// Table 0332.
+func (v Table0332) Table() Table {
+	return TableLookup[`0332`]
+}
+
+// Table0334 is a value of table 0334, Disabled Person Code  .
+// Fields in this table are strings; convert a field, such as Table0334(v), to use the helpers.
+type Table0334 string
+
+const (
+	Table0334AssociatedParty Table0334 = `AP` // Associated party
+	Table0334Guarantor       Table0334 = `GT` // Guarantor
+	Table0334Insured         Table0334 = `IN` // Insured
+	Table0334Patient         Table0334 = `PT` // Patient
+)
+
+// Description of the value, or empty if the value is not in table 0334.
+func (v Table0334) Description() string {
+	return tableDescription(`0334`, string(v))
+}
+
+// Valid reports if the value is in table 0334.
+func (v Table0334) Valid() bool {
+	return TableValueLookup[`0334`][string(v)]
+}
+
+// Table 0334.
+func (v Table0334) Table() Table {
+	return TableLookup[`0334`]
+}
+
+// Table0335 is a value of table 0335, Repeat Pattern.
+// Fields in this table are strings; convert a field, such as Table0335(v), to use the helpers.
+type Table0335 string
+
+const (
+	Table0335AnteBefore                                Table0335 = `A`                    // Ante (before)
+	Table0335TwiceADayAtInstitutionSpecified           Table0335 = `BID`                  // twice a day at institution-specified times
+	Table0335ServiceIsProvidedContinuouslyBetweenStart Table0335 = `C`                    // service is provided continuously between start time and stop time
+	Table0335CibusDiurnusLunch                         Table0335 = `D`                    // Cibus Diurnus (lunch)
+	Table0335Inter                                     Table0335 = `I`                    // Inter
+	Table0335CibusMatutinusBreakfast                   Table0335 = `M`                    // Cibus Matutinus (breakfast)
+	Table0335TimingCCumMeal                            Table0335 = `Meal Related Timings` // <timing>C ("cum")<meal>
+	Table0335OneTimeOnly                               Table0335 = `Once`                 // one time only.
+	Table0335PostAfter                                 Table0335 = `P`                    // Post (after)
+	Table0335GivenAsNeeded                             Table0335 = `PRN`                  // given as needed
+	Table0335WhereXxxIsSomeFrequencyCode               Table0335 = `PRNxxx`               // where xxx is some frequency code
+	Table0335EveryIntegerDays                          Table0335 = `Q<integer>D`          // every <integer> days
+	Table0335EveryIntegerHours                         Table0335 = `Q<integer>H`          // every <integer> hours
+	Table0335RepeatsOnAParticularDayOf                 Table0335 = `Q<integer>J<day#>`    // repeats on a particular day of the week,
+	Table0335EveryIntegerMonthsLunarCycle              Table0335 = `Q<integer>L`          // every <integer> months (Lunar cycle)
+	Table0335EveryIntegerMinutes                       Table0335 = `Q<integer>M`          // every <integer> minutes
+	Table0335EveryIntegerSeconds                       Table0335 = `Q<integer>S`          // every <integer> seconds
+	Table0335EveryIntegerWeeks                         Table0335 = `Q<integer>W`          // every <integer> weeks
+	Table0335InTheMorningAtInstitutionSpecified        Table0335 = `QAM`                  // in the morning at institution-specified time
+	Table0335EveryDayBeforeTheHourOf                   Table0335 = `QHS`                  // every day before the hour of sleep
+	Table0335FourTimesADayAtInstitution                Table0335 = `QID`                  // four times a day at institution-specified times
+	Table0335EveryOtherDay                             Table0335 = `QOD`                  // every other day
+	Table0335InTheEveningAtInstitutionSpecified        Table0335 = `QPM`                  // in the evening at institution-specified time
+	Table0335DuringEachOfThreeEightHour                Table0335 = `QSHIFT`               // during each of three eight-hour shifts at institution-specified times
+	Table0335ThreeTimesADayAtInstitution               Table0335 = `TID`                  // three times a day at institution-specified times
+	Table0335ForFutureUseWhereSpecIs                   Table0335 = `U <spec>`             // for future use, where <spec> is an interval specification as defined by the UNIX cron specification.
+	Table0335CibusVespertinusDinner                    Table0335 = `V`                    // Cibus Vespertinus (dinner)
+	Table0335XTimesPerDayAtInstitution                 Table0335 = `xID`                  // "X" times per day at institution-specified times, where X is a numeral 5 or greater.
+)
+
+// Description of the value, or empty if the value is not in table 0335.
+func (v Table0335) Description() string {
+	return tableDescription(`0335`, string(v))
+}
+
+// Valid reports if the value is in table 0335.
+func (v Table0335) Valid() bool {
+	return TableValueLookup[`0335`][string(v)]
+}
+
+// Table 0335.
+func (v Table0335) Table() Table {
+	return TableLookup[`0335`]
+}
+
+// Table0336 is a value of table 0336, Referral Reason.
+// Fields in this table are strings; convert a field, such as Table0336(v), to use the helpers.
+type Table0336 string
+
+const (
+	Table0336ProviderOrdered   Table0336 = `O` // Provider Ordered
+	Table0336PatientPreference Table0336 = `P` // Patient Preference
+	Table0336SecondOpinion     Table0336 = `S` // Second Opinion
+	Table0336WorkLoad          Table0336 = `W` // Work Load
+)
+
+// Description of the value, or empty if the value is not in table 0336.
+func (v Table0336) Description() string {
+	return tableDescription(`0336`, string(v))
+}
+
+// Valid reports if the value is in table 0336.
+func (v Table0336) Valid() bool {
+	return TableValueLookup[`0336`][string(v)]
+}
+
+// Table 0336.
+func (v Table0336) Table() Table {
+	return TableLookup[`0336`]
+}
+
+// Table0337 is a value of table 0337, Certification Status.
+// Fields in this table are strings; convert a field, such as Table0337(v), to use the helpers.
+type Table0337 string
+
+const (
+	Table0337Certified Table0337 = `C` // Certified
+	Table0337Eligible  Table0337 = `E` // Eligible
+)
+
+// Description of the value, or empty if the value is not in table 0337.
+func (v Table0337) Description() string {
+	return tableDescription(`0337`, string(v))
+}
+
+// Valid reports if the value is in table 0337.
+func (v Table0337) Valid() bool {
+	return TableValueLookup[`0337`][string(v)]
+}
+
+// Table 0337.
+func (v Table0337) Table() Table {
+	return TableLookup[`0337`]
+}
+
+// Table0338 is a value of table 0338, Practitioner ID Number Type.
+// Fields in this table are strings; convert a field, such as Table0338(v), to use the helpers.
+type Table0338 string
+
+const (
+	Table0338CountyNumber                Table0338 = `CY`   // County number
+	Table0338DrugEnforcementAgencyNo     Table0338 = `DEA`  // Drug Enforcement Agency no.
+	Table0338GeneralLedgerNumber         Table0338 = `GL`   // General ledger number
+	Table0338LaborAndIndustriesNumber    Table0338 = `L&I`  // Labor and industries number
+	Table0338LaborAndIndustriesNumber_LI Table0338 = `LI`   // Labor and industries number
+	Table0338MedicaidNumber              Table0338 = `MCD`  // Medicaid number
+	Table0338MedicareNumber              Table0338 = `MCR`  // Medicare number
+	Table0338QANumber                    Table0338 = `QA`   // QA number
+	Table0338StateLicenseNumber          Table0338 = `SL`   // State license number
+	Table0338TaxIDNumber                 Table0338 = `TAX`  // Tax ID number
+	Table0338TrainingLicenseNumber       Table0338 = `TRL`  // Training license number
+	Table0338UniquePhysicianIDNo         Table0338 = `UPIN` // Unique physician ID no.
+)
+
+// Description of the value, or empty if the value is not in table 0338.
+func (v Table0338) Description() string {
+	return tableDescription(`0338`, string(v))
+}
+
+// Valid reports if the value is in table 0338.
+func (v Table0338) Valid() bool {
+	return TableValueLookup[`0338`][string(v)]
+}
+
+// Table 0338.
+func (v Table0338) Table() Table {
+	return TableLookup[`0338`]
+}
+
+// Table0339 is a value of table 0339, Advanced Beneficiary Notice Code.
+// Fields in this table are strings; convert a field, such as Table0339(v), to use the helpers.
+type Table0339 string
+
+const (
+	Table0339ServiceIsSubjectToMedicalNecessity       Table0339 = `1` // Service is subject to medical necessity procedures
+	Table0339PatientHasBeenInformedOfResponsibility   Table0339 = `2` // Patient has been informed of responsibility, and agrees to pay for service
+	Table0339PatientHasBeenInformedOfResponsibility_3 Table0339 = `3` // Patient has been informed of responsibility, and asks that the payer be billed
+	Table0339AdvancedBeneficiaryNoticeHasNotBeen      Table0339 = `4` // Advanced Beneficiary Notice has not been signed
+)
+
+// Description of the value, or empty if the value is not in table 0339.
+func (v Table0339) Description() string {
+	return tableDescription(`0339`, string(v))
+}
+
+// Valid reports if the value is in table 0339.
+func (v Table0339) Valid() bool {
+	return TableValueLookup[`0339`][string(v)]
+}
+
+// Table 0339.
+func (v Table0339) Table() Table {
+	return TableLookup[`0339`]
+}
+
+// Table0344 is a value of table 0344, Patient's Relationship to Insured.
+// Fields in this table are strings; convert a field, such as Table0344(v), to use the helpers.
+type Table0344 string
+
+const (
+	Table0344PatientIsInsured                           Table0344 = `01` // Patient is insured
+	Table0344Spouse                                     Table0344 = `02` // Spouse
+	Table0344NaturalChildInsuredFinancialResponsibility Table0344 = `03` // Natural child/insured financial responsibility
+	Table0344NaturalChildInsuredDoesNotHave             Table0344 = `04` // Natural child/Insured does not have financial responsibility
+	Table0344StepChild                                  Table0344 = `05` // Step child
+	Table0344FosterChild                                Table0344 = `06` // Foster child
+	Table0344WardOfTheCourt                             Table0344 = `07` // Ward of the court
+	Table0344Employee                                   Table0344 = `08` // Employee
+	Table0344Unknown                                    Table0344 = `09` // Unknown
+	Table0344HandicappedDependent                       Table0344 = `10` // Handicapped dependent
+	Table0344OrganDonor                                 Table0344 = `11` // Organ donor
+	Table0344CadaverDonor                               Table0344 = `12` // Cadaver donor
+	Table0344Grandchild                                 Table0344 = `13` // Grandchild
+	Table0344NieceNephew                                Table0344 = `14` // Niece/nephew
+	Table0344InjuredPlaintiff                           Table0344 = `15` // Injured plaintiff
+	Table0344SponsoredDependent                         Table0344 = `16` // Sponsored dependent
+	Table0344MinorDependentOfAMinorDependent            Table0344 = `17` // Minor dependent of a minor dependent
+	Table0344Parent                                     Table0344 = `18` // Parent
+	Table0344Grandparent                                Table0344 = `19` // Grandparent
+)
+
+// Description of the value, or empty if the value is not in table 0344.
+func (v Table0344) Description() string {
+	return tableDescription(`0344`, string(v))
+}
+
+// Valid reports if the value is in table 0344.
+func (v Table0344) Valid() bool {
+	return TableValueLookup[`0344`][string(v)]
+}
+
+// Table 0344.
+func (v Table0344) Table() Table {
+	return TableLookup[`0344`]
+}
+
+// Table0354 is a value of table 0354, Message Structure.
+// Fields in this table are strings; convert a field, such as Table0354(v), to use the helpers.
+type Table0354 string
+
+const (
+	Table0354Varies                    Table0354 = `ACK` // Varies
+	Table0354ADRA19                    Table0354 = `ADR_A19`
+	Table0354A01A04A08A13              Table0354 = `ADT_A01` // A01, A04, A08, A13
+	Table0354A02                       Table0354 = `ADT_A02` // A02
+	Table0354A03                       Table0354 = `ADT_A03` // A03
+	Table0354A05A14A28A31              Table0354 = `ADT_A05` // A05, A14, A28, A31
+	Table0354A06A07                    Table0354 = `ADT_A06` // A06, A07
+	Table0354A09A10A11                 Table0354 = `ADT_A09` // A09, A10, A11
+	Table0354A12                       Table0354 = `ADT_A12` // A12
+	Table0354A15                       Table0354 = `ADT_A15` // A15
+	Table0354A16                       Table0354 = `ADT_A16` // A16
+	Table0354A17                       Table0354 = `ADT_A17` // A17
+	Table0354ADTA18                    Table0354 = `ADT_A18`
+	Table0354A20                       Table0354 = `ADT_A20` // A20
+	Table0354A21A22A23A25A26A27        Table0354 = `ADT_A21` // A21, A22, A23, A25, A26, A27, A29, A32, A33
+	Table0354A24                       Table0354 = `ADT_A24` // A24
+	Table0354ADTA30                    Table0354 = `ADT_A30`
+	Table0354A37                       Table0354 = `ADT_A37` // A37
+	Table0354A38                       Table0354 = `ADT_A38` // A38
+	Table0354A39A40A41A42              Table0354 = `ADT_A39` // A39, A40, A41, A42
+	Table0354A43                       Table0354 = `ADT_A43` // A43
+	Table0354A44                       Table0354 = `ADT_A44` // A44
+	Table0354A45                       Table0354 = `ADT_A45` // A45
+	Table0354A50A51                    Table0354 = `ADT_A50` // A50, A51
+	Table0354A52A53                    Table0354 = `ADT_A52` // A52, A53
+	Table0354A54A55                    Table0354 = `ADT_A54` // A54, A55
+	Table0354A60                       Table0354 = `ADT_A60` // A60
+	Table0354A61A62                    Table0354 = `ADT_A61` // A61, A62
+	Table0354P01                       Table0354 = `BAR_P01` // P01
+	Table0354P02                       Table0354 = `BAR_P02` // P02
+	Table0354P05                       Table0354 = `BAR_P05` // P05
+	Table0354P06                       Table0354 = `BAR_P06` // P06
+	Table0354P10                       Table0354 = `BAR_P10` // P10
+	Table0354P12                       Table0354 = `BAR_P12` // P12
+	Table0354O29                       Table0354 = `BPS_O29` // O29
+	Table0354O30                       Table0354 = `BRP_O30` // O30
+	Table0354O32                       Table0354 = `BRT_O32` // O32
+	Table0354O31                       Table0354 = `BTS_O31` // O31
+	Table0354I22                       Table0354 = `CCF_I22` // I22
+	Table0354I22_CCII22                Table0354 = `CCI_I22` // I22
+	Table0354I21                       Table0354 = `CCM_I21` // I21
+	Table0354I19                       Table0354 = `CCQ_I19` // I19
+	Table0354I161718                   Table0354 = `CCR_I16` // I16, |17, |18
+	Table0354I20                       Table0354 = `CCU_I20` // I20
+	Table0354I19_CQUI19                Table0354 = `CQU_I19` // I19
+	Table0354C01C02C03C04C05C06        Table0354 = `CRM_C01` // C01, C02, C03, C04, C05, C06, C07, C08
+	Table0354C09C10C11C12              Table0354 = `CSU_C09` // C09, C10, C11, C12
+	Table0354P03                       Table0354 = `DFT_P03` // P03
+	Table0354P11                       Table0354 = `DFT_P11` // P11
+	Table0354DOCT12                    Table0354 = `DOC_T12`
+	Table0354U07                       Table0354 = `EAC_U07` // U07
+	Table0354U09                       Table0354 = `EAN_U09` // U09
+	Table0354U08                       Table0354 = `EAR_U08` // U08
+	Table0354E01                       Table0354 = `EHC_E01` // E01
+	Table0354E02                       Table0354 = `EHC_E02` // E02
+	Table0354E04                       Table0354 = `EHC_E04` // E04
+	Table0354E10                       Table0354 = `EHC_E10` // E10
+	Table0354E12                       Table0354 = `EHC_E12` // E12
+	Table0354E13                       Table0354 = `EHC_E13` // E13
+	Table0354E15                       Table0354 = `EHC_E15` // E15
+	Table0354E20                       Table0354 = `EHC_E20` // E20
+	Table0354E21                       Table0354 = `EHC_E21` // E21
+	Table0354E24                       Table0354 = `EHC_E24` // E24
+	Table0354U02                       Table0354 = `ESR_U02` // U02
+	Table0354U01                       Table0354 = `ESU_U01` // U01
+	Table0354U06                       Table0354 = `INR_U06` // U06
+	Table0354U05                       Table0354 = `INU_U05` // U05
+	Table0354U12U13                    Table0354 = `LSU_U12` // U12, U13
+	Table0354T01T03T05T07T09T11        Table0354 = `MDM_T01` // T01, T03, T05, T07, T09, T11
+	Table0354T02T04T06T08T10           Table0354 = `MDM_T02` // T02, T04, T06, T08, T10
+	Table0354M01M02M03M04M05M06        Table0354 = `MFK_M01` // M01, M02, M03, M04, M05, M06, M07, M08, M09, M10, M11
+	Table0354MFNM01                    Table0354 = `MFN_M01`
+	Table0354M02                       Table0354 = `MFN_M02` // M02
+	Table0354M03                       Table0354 = `MFN_M03` // M03
+	Table0354M04                       Table0354 = `MFN_M04` // M04
+	Table0354M05                       Table0354 = `MFN_M05` // M05
+	Table0354M06                       Table0354 = `MFN_M06` // M06
+	Table0354M07                       Table0354 = `MFN_M07` // M07
+	Table0354M08                       Table0354 = `MFN_M08` // M08
+	Table0354M09                       Table0354 = `MFN_M09` // M09
+	Table0354M10                       Table0354 = `MFN_M10` // M10
+	Table0354M11                       Table0354 = `MFN_M11` // M11
+	Table0354M12                       Table0354 = `MFN_M12` // M12
+	Table0354M13                       Table0354 = `MFN_M13` // M13
+	Table0354M15                       Table0354 = `MFN_M15` // M15
+	Table0354M16                       Table0354 = `MFN_M16` // M16
+	Table0354M17                       Table0354 = `MFN_M17` // M17
+	Table0354Znn                       Table0354 = `MFN_Znn` // Znn
+	Table0354M01M02M03M04M05M06_MFQM01 Table0354 = `MFQ_M01` // M01, M02, M03, M04, M05, M06
+	Table0354M01M02M03                 Table0354 = `MFR_M01` // M01, M02, M03,
+	Table0354M04_MFRM04                Table0354 = `MFR_M04` // M04
+	Table0354M05_MFRM05                Table0354 = `MFR_M05` // M05
+	Table0354M06_MFRM06                Table0354 = `MFR_M06` // M06
+	Table0354M07_MFRM07                Table0354 = `MFR_M07` // M07
+	Table0354N02                       Table0354 = `NMD_N02` // N02
+	Table0354N01                       Table0354 = `NMQ_N01` // N01
+	Table0354N01_NMRN01                Table0354 = `NMR_N01` // N01
+	Table0354O27                       Table0354 = `OMB_O27` // O27
+	Table0354O03                       Table0354 = `OMD_O03` // O03
+	Table0354O19                       Table0354 = `OMG_O19` // O19
+	Table0354O23                       Table0354 = `OMI_O23` // O23
+	Table0354O21                       Table0354 = `OML_O21` // O21
+	Table0354O33                       Table0354 = `OML_O33` // O33
+	Table0354O35                       Table0354 = `OML_O35` // O35
+	Table0354O39                       Table0354 = `OML_O39` // O39
+	Table0354O07                       Table0354 = `OMN_O07` // O07
+	Table0354O09                       Table0354 = `OMP_O09` // O09
+	Table0354O05                       Table0354 = `OMS_O05` // O05
+	Table0354O37                       Table0354 = `OPL_O37` // O37
+	Table0354O38                       Table0354 = `OPR_O38` // O38
+	Table0354R25                       Table0354 = `OPU_R25` // R25
+	Table0354R33                       Table0354 = `ORA_R33` // R33
+	Table0354O28                       Table0354 = `ORB_O28` // O28
+	Table0354O04                       Table0354 = `ORD_O04` // O04
+	Table0354R04                       Table0354 = `ORF_R04` // R04
+	Table0354O20                       Table0354 = `ORG_O20` // O20
+	Table0354O24                       Table0354 = `ORI_O24` // O24
+	Table0354O22                       Table0354 = `ORL_O22` // O22
+	Table0354O34                       Table0354 = `ORL_O34` // O34
+	Table0354O36                       Table0354 = `ORL_O36` // O36
+	Table0354O40                       Table0354 = `ORL_O40` // O40
+	Table0354O01                       Table0354 = `ORM_O01` // O01
+	Table0354O08                       Table0354 = `ORN_O08` // O08
+	Table0354O10                       Table0354 = `ORP_O10` // O10
+	Table0354O02                       Table0354 = `ORR_O02` // O02
+	Table0354O06                       Table0354 = `ORS_O06` // O06
+	Table0354R01                       Table0354 = `ORU_R01` // R01
+	Table0354R30                       Table0354 = `ORU_R30` // R30
+	Table0354W01                       Table0354 = `ORU_W01` // W01
+	Table0354R26                       Table0354 = `OSM_R26` // R26
+	Table0354Q06                       Table0354 = `OSQ_Q06` // Q06
+	Table0354Q06_OSRQ06                Table0354 = `OSR_Q06` // Q06
+	Table0354R21                       Table0354 = `OUL_R21` // R21
+	Table0354R22                       Table0354 = `OUL_R22` // R22
+	Table0354R23                       Table0354 = `OUL_R23` // R23
+	Table0354R24                       Table0354 = `OUL_R24` // R24
+	Table0354P07P08                    Table0354 = `PEX_P07` // P07, P08
+	Table0354PC6PC7PC8                 Table0354 = `PGL_PC6` // PC6, PC7, PC8
+	Table0354B01B02                    Table0354 = `PMU_B01` // B01, B02
+	Table0354B03                       Table0354 = `PMU_B03` // B03
+	Table0354B04B05B06                 Table0354 = `PMU_B04` // B04, B05, B06
+	Table0354B07                       Table0354 = `PMU_B07` // B07
+	Table0354B08                       Table0354 = `PMU_B08` // B08
+	Table0354PCCPCGPCHPCJ              Table0354 = `PPG_PCG` // PCC, PCG, PCH, PCJ
+	Table0354PCBPCD                    Table0354 = `PPP_PCB` // PCB, PCD
+	Table0354PC1PC2PC3                 Table0354 = `PPR_PC1` // PC1, PC2, PC3
+	Table0354PCL                       Table0354 = `PPT_PCL` // PCL
+	Table0354PCA                       Table0354 = `PPV_PCA` // PCA
+	Table0354PC5                       Table0354 = `PRR_PC5` // PC5
+	Table0354PCF                       Table0354 = `PTR_PCF` // PCF
+	Table0354E03                       Table0354 = `QBP_E03` // E03
+	Table0354E22                       Table0354 = `QBP_E22` // E22
+	Table0354Q11                       Table0354 = `QBP_Q11` // Q11
+	Table0354Q13                       Table0354 = `QBP_Q13` // Q13
+	Table0354Q15                       Table0354 = `QBP_Q15` // Q15
+	Table0354Q21Q22Q23Q24Q25           Table0354 = `QBP_Q21` // Q21, Q22, Q23,Q24, Q25
+	Table0354Qnn                       Table0354 = `QBP_Qnn` // Qnn
+	Table0354Z73                       Table0354 = `QBP_Z73` // Z73
+	Table0354Q02                       Table0354 = `QCK_Q02` // Q02
+	Table0354J01J02                    Table0354 = `QCN_J01` // J01, J02
+	Table0354W02                       Table0354 = `QRF_W02` // W02
+	Table0354A19                       Table0354 = `QRY_A19` // A19
+	Table0354PC4PC9PCEPCK              Table0354 = `QRY_PC4` // PC4, PC9, PCE, PCK
+	Table0354Q01Q26Q27Q28Q29Q30        Table0354 = `QRY_Q01` // Q01, Q26, Q27, Q28, Q29, Q30
+	Table0354Q02_QRYQ02                Table0354 = `QRY_Q02` // Q02
+	Table0354R02                       Table0354 = `QRY_R02` // R02
+	Table0354T12                       Table0354 = `QRY_T12` // T12
+	Table0354Q16                       Table0354 = `QSB_Q16` // Q16
+	Table0354Q17                       Table0354 = `QVR_Q17` // Q17
+	Table0354RAR                       Table0354 = `RAR_RAR` // RAR
+	Table0354O17                       Table0354 = `RAS_O17` // O17
+	Table0354I05                       Table0354 = `RCI_I05` // I05
+	Table0354I06                       Table0354 = `RCL_I06` // I06
+	Table0354O11O25                    Table0354 = `RDE_O11` // O11, O25
+	Table0354RDR                       Table0354 = `RDR_RDR` // RDR
+	Table0354O13                       Table0354 = `RDS_O13` // O13
+	Table0354K15                       Table0354 = `RDY_K15` // K15
+	Table0354I12I13I14I15              Table0354 = `REF_I12` // I12, I13, I14, I15
+	Table0354RER                       Table0354 = `RER_RER` // RER
+	Table0354RGR                       Table0354 = `RGR_RGR` // RGR
+	Table0354O15                       Table0354 = `RGV_O15` // O15
+	Table0354ROR                       Table0354 = `ROR_ROR` // ROR
+	Table0354I08I09I10I11              Table0354 = `RPA_I08` // I08, I09. I10, I11
+	Table0354I01I04                    Table0354 = `RPI_I01` // I01, I04
+	Table0354I04                       Table0354 = `RPI_I04` // I04
+	Table0354I02                       Table0354 = `RPL_I02` // I02
+	Table0354I03                       Table0354 = `RPR_I03` // I03
+	Table0354I08I09I10I11_RQAI08       Table0354 = `RQA_I08` // I08, I09, I10, I11
+	Table0354I05I06                    Table0354 = `RQC_I05` // I05, I06
+	Table0354I01I02I03I07              Table0354 = `RQI_I01` // I01, I02, I03, I07
+	Table0354I04_RQPI04                Table0354 = `RQP_I04` // I04
+	Table0354O18                       Table0354 = `RRA_O18` // O18
+	Table0354O14                       Table0354 = `RRD_O14` // O14
+	Table0354O12O26                    Table0354 = `RRE_O12` // O12, O26
+	Table0354O16                       Table0354 = `RRG_O16` // O16
+	Table0354I12I13I14I15_RRII12       Table0354 = `RRI_I12` // I12, I13, I14, I15
+	Table0354E03_RSPE03                Table0354 = `RSP_E03` // E03
+	Table0354E22_RSPE22                Table0354 = `RSP_E22` // E22
+	Table0354K11                       Table0354 = `RSP_K11` // K11
+	Table0354K21                       Table0354 = `RSP_K21` // K21
+	Table0354K22                       Table0354 = `RSP_K22` // K22
+	Table0354K23K24                    Table0354 = `RSP_K23` // K23, K24
+	Table0354K25                       Table0354 = `RSP_K25` // K25
+	Table0354K31                       Table0354 = `RSP_K31` // K31
+	Table0354K32                       Table0354 = `RSP_K32` // K32
+	Table0354Q11_RSPQ11                Table0354 = `RSP_Q11` // Q11
+	Table0354Z82                       Table0354 = `RSP_Z82` // Z82
+	Table0354Z86                       Table0354 = `RSP_Z86` // Z86
+	Table0354Z88                       Table0354 = `RSP_Z88` // Z88
+	Table0354Z90                       Table0354 = `RSP_Z90` // Z90
+	Table0354K13                       Table0354 = `RTB_K13` // K13
+	Table0354Knn                       Table0354 = `RTB_Knn` // Knn
+	Table0354Z74                       Table0354 = `RTB_Z74` // Z74
+	Table0354S31S36                    Table0354 = `SDR_S31` // S31, S36
+	Table0354S32S37                    Table0354 = `SDR_S32` // S32, S37
+	Table0354S12S13S14S15S16S17        Table0354 = `SIU_S12` // S12, S13, S14, S15, S16, S17, S18, S19, S20, S21, S22, S23, S24, S26
+	Table0354S28S29S30S34S35           Table0354 = `SLR_S28` // S28, S29, S30, S34, S35
+	Table0354S25                       Table0354 = `SQM_S25` // S25
+	Table0354S25_SQRS25                Table0354 = `SQR_S25` // S25
+	Table0354S01S02S03S04S05S06        Table0354 = `SRM_S01` // S01, S02, S03, S04, S05, S06, S07, S08, S09, S10, S11
+	Table0354S01S02S03S04S05S06_SRRS01 Table0354 = `SRR_S01` // S01, S02, S03, S04, S05, S06, S07, S08, S09, S10, S11
+	Table0354U04                       Table0354 = `SSR_U04` // U04
+	Table0354U03                       Table0354 = `SSU_U03` // U03
+	Table0354S33                       Table0354 = `STC_S33` // S33
+	Table0354P09                       Table0354 = `SUR_P09` // P09
+	Table0354U10U11                    Table0354 = `TCU_U10` // U10, U11
+	Table0354Q05                       Table0354 = `UDM_Q05` // Q05
+	Table0354V01                       Table0354 = `VXQ_V01` // V01
+	Table0354V03                       Table0354 = `VXR_V03` // V03
+	Table0354V04                       Table0354 = `VXU_V04` // V04
+	Table0354V02                       Table0354 = `VXX_V02` // V02
+)
+
+// Description of the value, or empty if the value is not in table 0354.
+func (v Table0354) Description() string {
+	return tableDescription(`0354`, string(v))
+}
+
+// Valid reports if the value is in table 0354.
+func (v Table0354) Valid() bool {
+	return TableValueLookup[`0354`][string(v)]
+}
+
+// Table 0354.
+func (v Table0354) Table() Table {
+	return TableLookup[`0354`]
+}
+
+// Table0355 is a value of table 0355, Primary Key Value Type.
+// Fields in this table are strings; convert a field, such as Table0355(v), to use the helpers.
+type Table0355 string
+
+const (
+	Table0355CodedElement        Table0355 = `CE`  // Coded element
+	Table0355CodedWithExceptions Table0355 = `CWE` // Coded with Exceptions
+	Table0355PersonLocation      Table0355 = `PL`  // Person location
+)
+
+// Description of the value, or empty if the value is not in table 0355.
+func (v Table0355) Description() string {
+	return tableDescription(`0355`, string(v))
+}
+
+// Valid reports if the value is in table 0355.
+func (v Table0355) Valid() bool {
+	return TableValueLookup[`0355`][string(v)]
+}
+
+// Table 0355.
+func (v Table0355) Table() Table {
+	return TableLookup[`0355`]
+}
+
+// Table0356 is a value of table 0356, Alternate Character Set Handling Scheme.
+// Fields in this table are strings; convert a field, such as Table0356(v), to use the helpers.
+type Table0356 string
+
+const (
+	Table0356ThisIsTheDefaultIndicatingThat            Table0356 = `<null>`        // This is the default, indicating that there is no character set switching occurring in this message.
+	Table0356TheCharacterSetSwitchingModeSpecified     Table0356 = `2.3`           // The character set switching mode specified in HL7 2.5, section 2.7.2 and section 2.A.46, "XPN - extended person name".
+	Table0356ThisStandardIsTitledInformationTechnology Table0356 = `ISO 2022-1994` // This standard is titled "Information Technology - Character Code Structure and Extension Technique". .
+)
+
+// Description of the value, or empty if the value is not in table 0356.
+func (v Table0356) Description() string {
+	return tableDescription(`0356`, string(v))
+}
+
+// Valid reports if the value is in table 0356.
+func (v Table0356) Valid() bool {
+	return TableValueLookup[`0356`][string(v)]
+}
+
+// Table 0356.
+func (v Table0356) Table() Table {
+	return TableLookup[`0356`]
+}
+
+// Table0357 is a value of table 0357, Message Error Condition Codes.
+// Fields in this table are strings; convert a field, such as Table0357(v), to use the helpers.
+type Table0357 string
+
+const (
+	Table0357MessageAccepted          Table0357 = `0`   // Message accepted
+	Table0357SegmentSequenceError     Table0357 = `100` // Segment sequence error
+	Table0357RequiredFieldMissing     Table0357 = `101` // Required field missing
+	Table0357DataTypeError            Table0357 = `102` // Data type error
+	Table0357TableValueNotFound       Table0357 = `103` // Table value not found
+	Table0357ValueTooLong             Table0357 = `104` // Value too long
+	Table0357UnsupportedMessageType   Table0357 = `200` // Unsupported message type
+	Table0357UnsupportedEventCode     Table0357 = `201` // Unsupported event code
+	Table0357UnsupportedProcessingId  Table0357 = `202` // Unsupported processing id
+	Table0357UnsupportedVersionId     Table0357 = `203` // Unsupported version id
+	Table0357UnknownKeyIdentifier     Table0357 = `204` // Unknown key identifier
+	Table0357DuplicateKeyIdentifier   Table0357 = `205` // Duplicate key identifier
+	Table0357ApplicationRecordLocked  Table0357 = `206` // Application record locked
+	Table0357ApplicationInternalError Table0357 = `207` // Application internal error
+)
+
+// Description of the value, or empty if the value is not in table 0357.
+func (v Table0357) Description() string {
+	return tableDescription(`0357`, string(v))
+}
+
+// Valid reports if the value is in table 0357.
+func (v Table0357) Valid() bool {
+	return TableValueLookup[`0357`][string(v)]
+}
+
+// Table 0357.
+func (v Table0357) Table() Table {
+	return TableLookup[`0357`]
+}
+
+// Table0359 is a value of table 0359, Diagnosis Priority.
+// Fields in this table are strings; convert a field, such as Table0359(v), to use the helpers.
+type Table0359 string
+
+const (
+	Table0359NotIncludedInDiagnosisRanking Table0359 = `0` // Not included in diagnosis ranking
+	Table0359ThePrimaryDiagnosis           Table0359 = `1` // The primary diagnosis
+	Table0359ForRankedSecondaryDiagnoses   Table0359 = `2` // For ranked secondary diagnoses
+)
+
+// Description of the value, or empty if the value is not in table 0359.
+func (v Table0359) Description() string {
+	return tableDescription(`0359`, string(v))
+}
+
+// Valid reports if the value is in table 0359.
+func (v Table0359) Valid() bool {
+	return TableValueLookup[`0359`][string(v)]
+}
+
+// Table 0359.
+func (v Table0359) Table() Table {
+	return TableLookup[`0359`]
+}
+
+// Table0360 is a value of table 0360, Degree/License/Certificate.
+// Fields in this table are strings; convert a field, such as Table0360(v), to use the helpers.
+type Table0360 string
+
+const (
+	Table0360AssociateOfArts                     Table0360 = `AA`     // Associate of Arts
+	Table0360AssociateOfAppliedScience           Table0360 = `AAS`    // Associate of Applied Science
+	Table0360AssociateOfBusinessAdministration   Table0360 = `ABA`    // Associate of Business Administration
+	Table0360AssociateOfEngineering              Table0360 = `AE`     // Associate of Engineering
+	Table0360AssociateOfScience                  Table0360 = `AS`     // Associate of Science
+	Table0360BachelorOfArts                      Table0360 = `BA`     // Bachelor of Arts
+	Table0360BachelorOfBusinessAdministration    Table0360 = `BBA`    // Bachelor of Business Administration
+	Table0360BachelorOrEngineering               Table0360 = `BE`     // Bachelor or Engineering
+	Table0360BachelorOfFineArts                  Table0360 = `BFA`    // Bachelor of Fine Arts
+	Table0360BachelorOfNursing                   Table0360 = `BN`     // Bachelor of Nursing
+	Table0360BachelorOfScience                   Table0360 = `BS`     // Bachelor of Science
+	Table0360BachelorOfScienceLaw                Table0360 = `BSL`    // Bachelor of Science - Law
+	Table0360BachelorOnScienceNursing            Table0360 = `BSN`    // Bachelor on Science - Nursing
+	Table0360BachelorOfTheology                  Table0360 = `BT`     // Bachelor of Theology
+	Table0360CertifiedAdultNursePractitioner     Table0360 = `CANP`   // Certified Adult Nurse Practitioner
+	Table0360Certificate                         Table0360 = `CER`    // Certificate
+	Table0360CertifiedMedicalAssistant           Table0360 = `CMA`    // Certified Medical Assistant
+	Table0360CertifiedNurseMidwife               Table0360 = `CNM`    // Certified Nurse Midwife
+	Table0360CertifiedNursePractitioner          Table0360 = `CNP`    // Certified Nurse Practitioner
+	Table0360CertifiedNurseSpecialist            Table0360 = `CNS`    // Certified Nurse Specialist
+	Table0360CertifiedPediatricNursePractitioner Table0360 = `CPNP`   // Certified Pediatric Nurse Practitioner
+	Table0360CertifiedRegisteredNurse            Table0360 = `CRN`    // Certified Registered Nurse
+	Table0360DoctorOfBusinessAdministration      Table0360 = `DBA`    // Doctor of Business Administration
+	Table0360DoctorOfEducation                   Table0360 = `DED`    // Doctor of Education
+	Table0360Diploma                             Table0360 = `DIP`    // Diploma
+	Table0360DoctorOfOsteopathy                  Table0360 = `DO`     // Doctor of Osteopathy
+	Table0360EmergencyMedicalTechnician          Table0360 = `EMT`    // Emergency Medical Technician
+	Table0360EmergencyMedicalTechnicianParamedic Table0360 = `EMTP`   // Emergency Medical Technician - Paramedic
+	Table0360FamilyPracticeNursePractitioner     Table0360 = `FPNP`   // Family Practice Nurse Practitioner
+	Table0360HighSchoolGraduate                  Table0360 = `HS`     // High School Graduate
+	Table0360JurisDoctor                         Table0360 = `JD`     // Juris Doctor
+	Table0360MasterOfArts                        Table0360 = `MA`     // Master of Arts
+	Table0360MasterOfBusinessAdministration      Table0360 = `MBA`    // Master of Business Administration
+	Table0360MasterOfCivilEngineering            Table0360 = `MCE`    // Master of Civil Engineering
+	Table0360DoctorOfMedicine                    Table0360 = `MD`     // Doctor of Medicine
+	Table0360MedicalAssistant                    Table0360 = `MDA`    // Medical Assistant
+	Table0360MasterOfDivinity                    Table0360 = `MDI`    // Master of Divinity
+	Table0360MasterOfEngineering                 Table0360 = `ME`     // Master of Engineering
+	Table0360MasterOfEducation                   Table0360 = `MED`    // Master of Education
+	Table0360MasterOfElectricalEngineering       Table0360 = `MEE`    // Master of Electrical Engineering
+	Table0360MasterOfFineArts                    Table0360 = `MFA`    // Master of Fine Arts
+	Table0360MasterOfMechanicalEngineering       Table0360 = `MME`    // Master of Mechanical Engineering
+	Table0360MasterOfScience                     Table0360 = `MS`     // Master of Science
+	Table0360MasterOfScienceLaw                  Table0360 = `MSL`    // Master of Science - Law
+	Table0360MasterOfScienceNursing              Table0360 = `MSN`    // Master of Science - Nursing
+	Table0360MedicalTechnician                   Table0360 = `MT`     // Medical Technician
+	Table0360MasterOfTheology                    Table0360 = `MTH`    // Master of Theology
+	Table0360NonGraduate                         Table0360 = `NG`     // Non-Graduate
+	Table0360NursePractitioner                   Table0360 = `NP`     // Nurse Practitioner
+	Table0360PhysicianAssistant                  Table0360 = `PA`     // Physician Assistant
+	Table0360DoctorOfPharmacy                    Table0360 = `PharmD` // Doctor of Pharmacy
+	Table0360DoctorOfPhilosophy                  Table0360 = `PHD`    // Doctor of Philosophy
+	Table0360DoctorOfEngineering                 Table0360 = `PHE`    // Doctor of Engineering
+	Table0360DoctorOfScience                     Table0360 = `PHS`    // Doctor of Science
+	Table0360AdvancedPracticeNurse               Table0360 = `PN`     // Advanced Practice Nurse
+	Table0360RegisteredMedicalAssistant          Table0360 = `RMA`    // Registered Medical Assistant
+	Table0360RegisteredNurse                     Table0360 = `RN`     // Registered Nurse
+	Table0360RegisteredPharmacist                Table0360 = `RPH`    // Registered Pharmacist
+	Table0360SecretarialCertificate              Table0360 = `SEC`    // Secretarial Certificate
+	Table0360TradeSchoolGraduate                 Table0360 = `TS`     // Trade School Graduate
+)
+
+// Description of the value, or empty if the value is not in table 0360.
+func (v Table0360) Description() string {
+	return tableDescription(`0360`, string(v))
+}
+
+// Valid reports if the value is in table 0360.
+func (v Table0360) Valid() bool {
+	return TableValueLookup[`0360`][string(v)]
+}
+
+// Table 0360.
+func (v Table0360) Table() Table {
+	return TableLookup[`0360`]
+}
+
+// Table0364 is a value of table 0364, Comment Type.
+// Fields in this table are strings; convert a field, such as Table0364(v), to use the helpers.
+type Table0364 string
+
+const (
+	Table0364PrimaryReason              Table0364 = `1R` // Primary Reason
+	Table0364SecondaryReason            Table0364 = `2R` // Secondary Reason
+	Table0364AncillaryInstructions      Table0364 = `AI` // Ancillary Instructions
+	Table0364DuplicateInteractionReason Table0364 = `DR` // Duplicate/Interaction Reason
+	Table0364GeneralInstructions        Table0364 = `GI` // General Instructions
+	Table0364GeneralReason              Table0364 = `GR` // General Reason
+	Table0364PatientInstructions        Table0364 = `PI` // Patient Instructions
+	Table0364Remark                     Table0364 = `RE` // Remark
+)
+
+// Description of the value, or empty if the value is not in table 0364.
+func (v Table0364) Description() string {
+	return tableDescription(`0364`, string(v))
+}
+
+// Valid reports if the value is in table 0364.
+func (v Table0364) Valid() bool {
+	return TableValueLookup[`0364`][string(v)]
+}
+
+// Table 0364.
+func (v Table0364) Table() Table {
+	return TableLookup[`0364`]
+}
+
+// Table0365 is a value of table 0365, Equipment State.
+// Fields in this table are strings; convert a field, such as Table0365(v), to use the helpers.
+type Table0365 string
+
+const (
+	Table0365Clearing        Table0365 = `CL` // Clearing
+	Table0365Configuring     Table0365 = `CO` // Configuring
+	Table0365EStopped        Table0365 = `ES` // E-stopped
+	Table0365Idle            Table0365 = `ID` // Idle
+	Table0365Initializing    Table0365 = `IN` // Initializing
+	Table0365NormalOperation Table0365 = `OP` // Normal Operation
+	Table0365Pausing         Table0365 = `PA` // Pausing
+	Table0365Paused          Table0365 = `PD` // Paused
+	Table0365PoweredUp       Table0365 = `PU` // Powered Up
+)
+
+// Description of the value, or empty if the value is not in table 0365.
+func (v Table0365) Description() string {
+	return tableDescription(`0365`, string(v))
+}
+
+// Valid reports if the value is in table 0365.
+func (v Table0365) Valid() bool {
+	return TableValueLookup[`0365`][string(v)]
+}
+
+// Table 0365.
+func (v Table0365) Table() Table {
+	return TableLookup[`0365`]
+}
+
+// Table0366 is a value of table 0366, Local/Remote Control State.
+// Fields in this table are strings; convert a field, such as Table0366(v), to use the helpers.
+type Table0366 string
+
+const (
+	Table0366Local  Table0366 = `L` // Local
+	Table0366Remote Table0366 = `R` // Remote
+)
+
+// Description of the value, or empty if the value is not in table 0366.
+func (v Table0366) Description() string {
+	return tableDescription(`0366`, string(v))
+}
+
+// Valid reports if the value is in table 0366.
+func (v Table0366) Valid() bool {
+	return TableValueLookup[`0366`][string(v)]
+}
+
+// Table 0366.
+func (v Table0366) Table() Table {
+	return TableLookup[`0366`]
+}
+
+// Table0367 is a value of table 0367, Alert Level.
+// Fields in this table are strings; convert a field, such as Table0367(v), to use the helpers.
+type Table0367 string
+
+const (
+	Table0367Critical Table0367 = `C` // Critical
+	Table0367Normal   Table0367 = `N` // Normal
+	Table0367Serious  Table0367 = `S` // Serious
+	Table0367Warning  Table0367 = `W` // Warning
+)
+
+// Description of the value, or empty if the value is not in table 0367.
+func (v Table0367) Description() string {
+	return tableDescription(`0367`, string(v))
+}
+
+// Valid reports if the value is in table 0367.
+func (v Table0367) Valid() bool {
+	return TableValueLookup[`0367`][string(v)]
+}
+
+// Table 0367.
+func (v Table0367) Table() Table {
+	return TableLookup[`0367`]
+}
+
+// Table0368 is a value of table 0368, Remote Control Command.
+// Fields in this table are strings; convert a field, such as Table0368(v), to use the helpers.
+type Table0368 string
+
+const (
+	Table0368Abort                                    Table0368 = `AB` // Abort
+	Table0368Clear                                    Table0368 = `CL` // Clear
+	Table0368ClearNotification                        Table0368 = `CN` // Clear Notification
+	Table0368DisableSendingEvents                     Table0368 = `DI` // Disable Sending Events
+	Table0368EnableSendingEvents                      Table0368 = `EN` // Enable Sending Events
+	Table0368EmergencyStop                            Table0368 = `ES` // Emergency -stop
+	Table0368ExecuteCommandSpecifiedInFieldParameters Table0368 = `EX` // Execute (command specified in field Parameters (ST) 01394)
+	Table0368InitializeInitiate                       Table0368 = `IN` // Initialize/Initiate
+	Table0368LocalControlRequest                      Table0368 = `LC` // Local Control Request
+	Table0368Lock                                     Table0368 = `LK` // Lock
+	Table0368Load                                     Table0368 = `LO` // Load
+	Table0368Pause                                    Table0368 = `PA` // Pause
+	Table0368RemoteControlRequest                     Table0368 = `RC` // Remote Control Request
+	Table0368Resume                                   Table0368 = `RE` // Resume
+	Table0368Sampling                                 Table0368 = `SA` // Sampling
+	Table0368Setup                                    Table0368 = `SU` // Setup
+	Table0368TransportTo                              Table0368 = `TT` // Transport To
+	Table0368Unlock                                   Table0368 = `UC` // Unlock
+	Table0368Unload                                   Table0368 = `UN` // Unload
+)
+
+// Description of the value, or empty if the value is not in table 0368.
+func (v Table0368) Description() string {
+	return tableDescription(`0368`, string(v))
+}
+
+// Valid reports if the value is in table 0368.
+func (v Table0368) Valid() bool {
+	return TableValueLookup[`0368`][string(v)]
+}
+
+// Table 0368.
+func (v Table0368) Table() Table {
+	return TableLookup[`0368`]
+}
+
+// Table0369 is a value of table 0369, Specimen Role.
+// Fields in this table are strings; convert a field, such as Table0369(v), to use the helpers.
+type Table0369 string
+
+const (
+	Table0369BlindSample                                   Table0369 = `B` // Blind Sample
+	Table0369CalibratorUsedForInitialSettingOf             Table0369 = `C` // Calibrator, used for initial setting of calibration
+	Table0369ElectronicQCUsedWithManufacturedReference     Table0369 = `E` // Electronic QC, used with manufactured reference providing signals that simulate QC results
+	Table0369SpecimenUsedForTestingProficiencyOf           Table0369 = `F` // Specimen used for testing proficiency of the organization performing the testing (Filler)
+	Table0369GroupWhereASpecimenConsistsOf                 Table0369 = `G` // Group (where a specimen consists of multiple individual elements that are not individually identified)
+	Table0369PoolAliquotsOfIndividualSpecimensCombined     Table0369 = `L` // Pool (aliquots of individual specimens combined to form a single specimen representing all of the components.)
+	Table0369SpecimenUsedForTestingOperatorProficiency     Table0369 = `O` // Specimen used for testing Operator Proficiency
+	Table0369PatientDefaultIfBlankComponentValue           Table0369 = `P` // Patient (default if blank component value)
+	Table0369ControlSpecimen                               Table0369 = `Q` // Control specimen
+	Table0369ReplicateOfPatientSampleAsA                   Table0369 = `R` // Replicate (of patient sample as a control)
+	Table0369VerifyingCalibratorUsedForPeriodicCalibration Table0369 = `V` // Verifying Calibrator, used for periodic calibration checks
+)
+
+// Description of the value, or empty if the value is not in table 0369.
+func (v Table0369) Description() string {
+	return tableDescription(`0369`, string(v))
+}
+
+// Valid reports if the value is in table 0369.
+func (v Table0369) Valid() bool {
+	return TableValueLookup[`0369`][string(v)]
+}
+
+// Table 0369.
+func (v Table0369) Table() Table {
+	return TableLookup[`0369`]
+}
+
+// Table0370 is a value of table 0370, Container Status.
+// Fields in this table are strings; convert a field, such as Table0370(v), to use the helpers.
+type Table0370 string
+
+const (
+	Table0370Identified           Table0370 = `I` // Identified
+	Table0370LeftEquipment        Table0370 = `L` // Left Equipment
+	Table0370Missing              Table0370 = `M` // Missing
+	Table0370InProcess            Table0370 = `O` // In Process
+	Table0370InPosition           Table0370 = `P` // In Position
+	Table0370ProcessCompleted     Table0370 = `R` // Process Completed
+	Table0370Unknown              Table0370 = `U` // Unknown
+	Table0370ContainerUnavailable Table0370 = `X` // Container Unavailable
+)
+
+// Description of the value, or empty if the value is not in table 0370.
+func (v Table0370) Description() string {
+	return tableDescription(`0370`, string(v))
+}
+
+// Valid reports if the value is in table 0370.
+func (v Table0370) Valid() bool {
+	return TableValueLookup[`0370`][string(v)]
+}
+
+// Table 0370.
+func (v Table0370) Table() Table {
+	return TableLookup[`0370`]
+}
+
+// Table0371 is a value of table 0371, Additive/Preservative.
+// Fields in this table are strings; convert a field, such as Table0371(v), to use the helpers.
+type Table0371 string
+
+const (
+	Table0371ACDSolutionA                               Table0371 = `ACDA`   // ACD Solution A
+	Table0371ACDSolutionB                               Table0371 = `ACDB`   // ACD Solution B
+	Table0371AceticAcid                                 Table0371 = `ACET`   // Acetic Acid
+	Table0371AmiesTransportMedium                       Table0371 = `AMIES`  // Amies transport medium
+	Table0371BacterialTransportMedium                   Table0371 = `BACTM`  // Bacterial Transport medium
+	Table0371Buffered10Formalin                         Table0371 = `BF10`   // Buffered 10% formalin
+	Table0371BorateBoricAcid                            Table0371 = `BOR`    // Borate Boric Acid
+	Table0371BouinSSolution                             Table0371 = `BOUIN`  // Bouin's solution
+	Table0371BufferedSkimMilk                           Table0371 = `BSKM`   // Buffered skim milk
+	Table037132Citrate                                  Table0371 = `C32`    // 3.2%  Citrate
+	Table037138Citrate                                  Table0371 = `C38`    // 3.8% Citrate
+	Table0371CarsonSModified10Formalin                  Table0371 = `CARS`   // Carson's Modified 10% formalin
+	Table0371CaryBlairMedium                            Table0371 = `CARY`   // Cary Blair Medium
+	Table0371ChlamydiaTransportMedium                   Table0371 = `CHLTM`  // Chlamydia transport medium
+	Table0371CTADThisShouldBeSpelledOut                 Table0371 = `CTAD`   // CTAD (this should be spelled out if not universally understood)
+	Table0371PotassiumKEDTA                             Table0371 = `EDTK`   // Potassium/K EDTA
+	Table0371PotassiumKEDTA15                           Table0371 = `EDTK15` // Potassium/K EDTA 15%
+	Table0371PotassiumKEDTA75                           Table0371 = `EDTK75` // Potassium/K EDTA 7.5%
+	Table0371SodiumNaEDTA                               Table0371 = `EDTN`   // Sodium/Na EDTA
+	Table0371EntericBacteriaTransportMedium             Table0371 = `ENT`    // Enteric bacteria transport medium
+	Table0371EntericPlus                                Table0371 = `ENT+`   // Enteric plus
+	Table037110Formalin                                 Table0371 = `F10`    // 10% Formalin
+	Table0371ThrombinNIHSoybeanTrypsinInhibitorFibrin   Table0371 = `FDP`    // Thrombin NIH; soybean trypsin inhibitor (Fibrin Degradation Products)
+	Table0371SodiumFluoride10mg                         Table0371 = `FL10`   // Sodium Fluoride, 10mg
+	Table0371SodiumFluoride100mg                        Table0371 = `FL100`  // Sodium Fluoride, 100mg
+	Table03716NHCL                                      Table0371 = `HCL6`   // 6N HCL
+	Table0371AmmoniumHeparin                            Table0371 = `HEPA`   // Ammonium heparin
+	Table0371LithiumLiHeparin                           Table0371 = `HEPL`   // Lithium/Li  Heparin
+	Table0371SodiumNaHeparin                            Table0371 = `HEPN`   // Sodium/Na  Heparin
+	Table0371NitricAcid                                 Table0371 = `HNO3`   // Nitric Acid
+	Table0371JonesKendrickMedium                        Table0371 = `JKM`    // Jones Kendrick Medium
+	Table0371KarnovskySFixative                         Table0371 = `KARN`   // Karnovsky's fixative
+	Table0371PotassiumOxalate                           Table0371 = `KOX`    // Potassium Oxalate
+	Table0371LithiumIodoacetate                         Table0371 = `LIA`    // Lithium iodoacetate
+	Table0371M4                                         Table0371 = `M4`     // M4
+	Table0371M4RT                                       Table0371 = `M4RT`   // M4-RT
+	Table0371M5                                         Table0371 = `M5`     // M5
+	Table0371MichelSTransportMedium                     Table0371 = `MICHTM` // Michel's transport medium
+	Table0371MMDTransportMedium                         Table0371 = `MMDTM`  // MMD transport medium
+	Table0371SodiumFluoride                             Table0371 = `NAF`    // Sodium Fluoride
+	Table0371SodiumPolyanetholSulfonate035In            Table0371 = `NAPS`   // Sodium polyanethol sulfonate 0.35% in 0.85% sodium chloride
+	Table0371None                                       Table0371 = `NONE`   // None
+	Table0371PagesSSaline                               Table0371 = `PAGE`   // Pages's Saline
+	Table0371Phenol                                     Table0371 = `PHENOL` // Phenol
+	Table0371PVAPolyvinylalcohol                        Table0371 = `PVA`    // PVA (polyvinylalcohol)
+	Table0371ReaganLoweMedium                           Table0371 = `RLM`    // Reagan Lowe Medium
+	Table0371SiliceousEarth12Mg                         Table0371 = `SILICA` // Siliceous earth, 12 mg
+	Table0371SPSThisShouldBeSpelledOut                  Table0371 = `SPS`    // SPS(this should be spelled out if not universally understood)
+	Table0371SerumSeparatorTubePolymerGel               Table0371 = `SST`    // Serum Separator Tube (Polymer Gel)
+	Table0371StuartTransportMedium                      Table0371 = `STUTM`  // Stuart transport medium
+	Table0371Thrombin                                   Table0371 = `THROM`  // Thrombin
+	Table0371Thymol                                     Table0371 = `THYMOL` // Thymol
+	Table0371ThyoglycollateBroth                        Table0371 = `THYO`   // Thyoglycollate broth
+	Table0371Toluene                                    Table0371 = `TOLU`   // Toluene
+	Table0371UreaplasmaTransportMedium                  Table0371 = `URETM`  // Ureaplasma transport medium
+	Table0371ViralTransportMedium                       Table0371 = `VIRTM`  // Viral Transport medium
+	Table0371BufferedCitrateWestergrenSedimentationRate Table0371 = `WEST`   // Buffered Citrate (Westergren Sedimentation Rate)
+)
+
+// Description of the value, or empty if the value is not in table 0371.
+func (v Table0371) Description() string {
+	return tableDescription(`0371`, string(v))
+}
+
+// Valid reports if the value is in table 0371.
+func (v Table0371) Valid() bool {
+	return TableValueLookup[`0371`][string(v)]
+}
+
+// Table 0371.
+func (v Table0371) Table() Table {
+	return TableLookup[`0371`]
+}
+
+// Table0372 is a value of table 0372, Specimen Component.
+// Fields in this table are strings; convert a field, such as Table0372(v), to use the helpers.
+type Table0372 string
+
+const (
+	Table0372WholeBloodHomogeneous          Table0372 = `BLD`  // Whole blood, homogeneous
+	Table0372WholeBloodSeparated            Table0372 = `BSEP` // Whole blood, separated
+	Table0372PlasmaNOSNotOtherwiseSpecified Table0372 = `PLAS` // Plasma, NOS (not otherwise specified)
+	Table0372PlateletPoorPlasma             Table0372 = `PPP`  // Platelet poor plasma
+	Table0372PlateletRichPlasma             Table0372 = `PRP`  // Platelet rich plasma
+	Table0372Sediment                       Table0372 = `SED`  // Sediment
+	Table0372SerumNOSNotOtherwiseSpecified  Table0372 = `SER`  // Serum, NOS (not otherwise specified)
+	Table0372Supernatant                    Table0372 = `SUP`  // Supernatant
+)
+
+// Description of the value, or empty if the value is not in table 0372.
+func (v Table0372) Description() string {
+	return tableDescription(`0372`, string(v))
+}
+
+// Valid reports if the value is in table 0372.
+func (v Table0372) Valid() bool {
+	return TableValueLookup[`0372`][string(v)]
+}
+
+// Table 0372.
+func (v Table0372) Table() Table {
+	return TableLookup[`0372`]
+}
+
+// Table0373 is a value of table 0373, Treatment.
+// Fields in this table are strings; convert a field, such as Table0373(v), to use the helpers.
+type Table0373 string
+
+const (
+	Table0373Acidification    Table0373 = `ACID` // Acidification
+	Table0373Alkalization     Table0373 = `ALK`  // Alkalization
+	Table0373Defibrination    Table0373 = `DEFB` // Defibrination
+	Table0373Filtration       Table0373 = `FILT` // Filtration
+	Table0373LDLPrecipitation Table0373 = `LDLP` // LDL Precipitation
+	Table0373Neutralization   Table0373 = `NEUT` // Neutralization
+	Table0373Recalification   Table0373 = `RECA` // Recalification
+	Table0373Ultrafiltration  Table0373 = `UFIL` // Ultrafiltration
+)
+
+// Description of the value, or empty if the value is not in table 0373.
+func (v Table0373) Description() string {
+	return tableDescription(`0373`, string(v))
+}
+
+// Valid reports if the value is in table 0373.
+func (v Table0373) Valid() bool {
+	return TableValueLookup[`0373`][string(v)]
+}
+
+// Table 0373.
+func (v Table0373) Table() Table {
+	return TableLookup[`0373`]
+}
+
+// Table0374 is a value of table 0374, System Induced Contaminants.
+// Fields in this table are strings; convert a field, such as Table0374(v), to use the helpers.
+type Table0374 string
+
+const (
+	Table0374PresentTypeOfContaminationUnspecified Table0374 = `CNTM` // Present, type of contamination unspecified
+)
+
+// Description of the value, or empty if the value is not in table 0374.
+func (v Table0374) Description() string {
+	return tableDescription(`0374`, string(v))
+}
+
+// Valid reports if the value is in table 0374.
+func (v Table0374) Valid() bool {
+	return TableValueLookup[`0374`][string(v)]
+}
+
+// Table 0374.
+func (v Table0374) Table() Table {
+	return TableLookup[`0374`]
+}
+
+// Table0375 is a value of table 0375, Artificial Blood.
+// Fields in this table are strings; convert a field, such as Table0375(v), to use the helpers.
+type Table0375 string
+
+const (
+	Table0375Fluorocarbons                     Table0375 = `FLUR` // Fluorocarbons
+	Table0375StromalFreeHemoglobinPreparations Table0375 = `SFHB` // Stromal free hemoglobin preparations
+)
+
+// Description of the value, or empty if the value is not in table 0375.
+func (v Table0375) Description() string {
+	return tableDescription(`0375`, string(v))
+}
+
+// Valid reports if the value is in table 0375.
+func (v Table0375) Valid() bool {
+	return TableValueLookup[`0375`][string(v)]
+}
+
+// Table 0375.
+func (v Table0375) Table() Table {
+	return TableLookup[`0375`]
+}
+
+// Table0376 is a value of table 0376, Special Handling Code.
+// Fields in this table are strings; convert a field, such as Table0376(v), to use the helpers.
+type Table0376 string
+
+const (
+	Table0376AmbientTemperature              Table0376 = `AMB`  // Ambient temperature
+	Table0376BodyTemperature                 Table0376 = `C37`  // Body temperature
+	Table0376CriticalAmbientTemperature      Table0376 = `CAMB` // Critical ambient temperature
+	Table0376ProtectFromAir                  Table0376 = `CATM` // Protect from air
+	Table0376CriticalFrozenTemperature       Table0376 = `CFRZ` // Critical frozen temperature
+	Table0376CriticalRefrigeratedTemperature Table0376 = `CREF` // Critical refrigerated temperature
+	Table0376DeepFrozen                      Table0376 = `DFRZ` // Deep frozen
+	Table0376Dry                             Table0376 = `DRY`  // Dry
+	Table0376FrozenTemperature               Table0376 = `FRZ`  // Frozen temperature
+	Table0376MetalFree                       Table0376 = `MTLF` // Metal Free
+	Table0376LiquidNitrogen                  Table0376 = `NTR`  // Liquid nitrogen
+	Table0376ProtectFromLight                Table0376 = `PRTL` // Protect from light
+	Table0376DoNotShake                      Table0376 = `PSA`  // Do not shake
+	Table0376NoShock                         Table0376 = `PSO`  // No shock
+	Table0376RefrigeratedTemperature         Table0376 = `REF`  // Refrigerated temperature
+	Table0376UltraFrozen                     Table0376 = `UFRZ` // Ultra frozen
+	Table0376Upright                         Table0376 = `UPR`  // Upright
+)
+
+// Description of the value, or empty if the value is not in table 0376.
+func (v Table0376) Description() string {
+	return tableDescription(`0376`, string(v))
+}
+
+// Valid reports if the value is in table 0376.
+func (v Table0376) Valid() bool {
+	return TableValueLookup[`0376`][string(v)]
+}
+
+// Table 0376.
+func (v Table0376) Table() Table {
+	return TableLookup[`0376`]
+}
+
+// Table0377 is a value of table 0377, Other Environmental Factors.
+// Fields in this table are strings; convert a field, such as Table0377(v), to use the helpers.
+type Table0377 string
+
+const (
+	Table0377OpenedContainerIndoorAtmosphere60Minutes        Table0377 = `A60` // Opened container, indoor atmosphere, 60 minutes duration
+	Table0377OpenedContainerAtmosphereAndDurationUnspecified Table0377 = `ATM` // Opened container, atmosphere and duration unspecified
+)
+
+// Description of the value, or empty if the value is not in table 0377.
+func (v Table0377) Description() string {
+	return tableDescription(`0377`, string(v))
+}
+
+// Valid reports if the value is in table 0377.
+func (v Table0377) Valid() bool {
+	return TableValueLookup[`0377`][string(v)]
+}
+
+// Table 0377.
+func (v Table0377) Table() Table {
+	return TableLookup[`0377`]
+}
+
+// Table0383 is a value of table 0383, Substance Status.
+// Fields in this table are strings; convert a field, such as Table0383(v), to use the helpers.
+type Table0383 string
+
+const (
+	Table0383CalibrationError    Table0383 = `CE` // Calibration Error
+	Table0383CalibrationWarning  Table0383 = `CW` // Calibration Warning
+	Table0383ExpiredError        Table0383 = `EE` // Expired Error
+	Table0383ExpiredWarning      Table0383 = `EW` // Expired Warning
+	Table0383NotAvailableError   Table0383 = `NE` // Not Available Error
+	Table0383NotAvailableWarning Table0383 = `NW` // Not Available Warning
+	Table0383OtherError          Table0383 = `OE` // Other Error
+	Table0383OKStatus            Table0383 = `OK` // OK Status
+	Table0383OtherWarning        Table0383 = `OW` // Other Warning
+	Table0383QCError             Table0383 = `QE` // QC Error
+	Table0383QCWarning           Table0383 = `QW` // QC Warning
+)
+
+// Description of the value, or empty if the value is not in table 0383.
+func (v Table0383) Description() string {
+	return tableDescription(`0383`, string(v))
+}
+
+// Valid reports if the value is in table 0383.
+func (v Table0383) Valid() bool {
+	return TableValueLookup[`0383`][string(v)]
+}
+
+// Table 0383.
+func (v Table0383) Table() Table {
+	return TableLookup[`0383`]
+}
+
+// Table0384 is a value of table 0384, Substance Type.
+// Fields in this table are strings; convert a field, such as Table0384(v), to use the helpers.
+type Table0384 string
+
+const (
+	Table0384Control              Table0384 = `CO` // Control
+	Table0384Diluent              Table0384 = `DI` // Diluent
+	Table0384MeasurableLiquidItem Table0384 = `LI` // Measurable Liquid Item
+	Table0384LiquidWaste          Table0384 = `LW` // Liquid Waste
+	Table0384MultipleTestReagent  Table0384 = `MR` // Multiple Test Reagent
+	Table0384Other                Table0384 = `OT` // Other
+	Table0384Pretreatment         Table0384 = `PT` // Pretreatment
+	Table0384PurifiedWater        Table0384 = `PW` // Purified Water
+	Table0384ReagentCalibrator    Table0384 = `RC` // Reagent Calibrator
+	Table0384CountableSolidItem   Table0384 = `SC` // Countable Solid Item
+	Table0384SingleTestReagent    Table0384 = `SR` // Single Test Reagent
+	Table0384SolidWaste           Table0384 = `SW` // Solid Waste
+)
+
+// Description of the value, or empty if the value is not in table 0384.
+func (v Table0384) Description() string {
+	return tableDescription(`0384`, string(v))
+}
+
+// Valid reports if the value is in table 0384.
+func (v Table0384) Valid() bool {
+	return TableValueLookup[`0384`][string(v)]
+}
+
+// Table 0384.
+func (v Table0384) Table() Table {
+	return TableLookup[`0384`]
+}
+
+// Table0387 is a value of table 0387, Command Response.
+// Fields in this table are strings; convert a field, such as Table0387(v), to use the helpers.
+type Table0387 string
+
+const (
+	Table0387CommandCannotBeCompletedBecauseOf       Table0387 = `ER` // Command cannot be completed because of error condition
+	Table0387CommandCompletedSuccessfully            Table0387 = `OK` // Command completed successfully
+	Table0387CommandCannotBeCompletedBecauseOf_ST    Table0387 = `ST` // Command cannot be completed because of the status of the requested equipment
+	Table0387CommandCannotBeCompletedWithinRequested Table0387 = `TI` // Command cannot be completed within requested completion time
+	Table0387CommandCannotBeCompletedForUnknown      Table0387 = `UN` // Command cannot be completed for unknown reasons
+)
+
+// Description of the value, or empty if the value is not in table 0387.
+func (v Table0387) Description() string {
+	return tableDescription(`0387`, string(v))
+}
+
+// Valid reports if the value is in table 0387.
+func (v Table0387) Valid() bool {
+	return TableValueLookup[`0387`][string(v)]
+}
+
+// Table 0387.
+func (v Table0387) Table() Table {
+	return TableLookup[`0387`]
+}
+
+// Table0388 is a value of table 0388, Processing Type.
+// Fields in this table are strings; convert a field, such as Table0388(v), to use the helpers.
+type Table0388 string
+
+const (
+	Table0388Evaluation        Table0388 = `E` // Evaluation
+	Table0388RegularProduction Table0388 = `P` // Regular Production
+)
+
+// Description of the value, or empty if the value is not in table 0388.
+func (v Table0388) Description() string {
+	return tableDescription(`0388`, string(v))
+}
+
+// Valid reports if the value is in table 0388.
+func (v Table0388) Valid() bool {
+	return TableValueLookup[`0388`][string(v)]
+}
+
+// Table 0388.
+func (v Table0388) Table() Table {
+	return TableLookup[`0388`]
+}
+
+// Table0389 is a value of table 0389, Analyte Repeat Status.
+// Fields in this table are strings; convert a field, such as Table0389(v), to use the helpers.
+type Table0389 string
+
+const (
+	Table0389RepeatedWithDilution    Table0389 = `D` // Repeated with dilution
+	Table0389ReflexTest              Table0389 = `F` // Reflex test
+	Table0389OriginalFirstRun        Table0389 = `O` // Original, first run
+	Table0389RepeatedWithoutDilution Table0389 = `R` // Repeated without dilution
+)
+
+// Description of the value, or empty if the value is not in table 0389.
+func (v Table0389) Description() string {
+	return tableDescription(`0389`, string(v))
+}
+
+// Valid reports if the value is in table 0389.
+func (v Table0389) Valid() bool {
+	return TableValueLookup[`0389`][string(v)]
+}
+
+// Table 0389.
+func (v Table0389) Table() Table {
+	return TableLookup[`0389`]
+}
+
+// Table0391 is a value of table 0391, Segment Group.
+// Fields in this table are strings; convert a field, such as Table0391(v), to use the helpers.
+type Table0391 string
+
+const (
+	Table0391ADMINISTRATION          Table0391 = `ADMINISTRATION`
+	Table0391ALLERGY                 Table0391 = `ALLERGY`
+	Table0391APPSTATS                Table0391 = `APP_STATS`
+	Table0391APPSTATUS               Table0391 = `APP_STATUS`
+	Table0391ASSOCIATEDPERSON        Table0391 = `ASSOCIATED_PERSON`
+	Table0391ASSOCIATEDRXADMIN       Table0391 = `ASSOCIATED_RX_ADMIN`
+	Table0391ASSOCIATEDRXORDER       Table0391 = `ASSOCIATED_RX_ORDER`
+	Table0391AUTHORIZATION           Table0391 = `AUTHORIZATION`
+	Table0391AUTHORIZATIONCONTACT    Table0391 = `AUTHORIZATION_CONTACT`
+	Table0391CERTIFICATE             Table0391 = `CERTIFICATE`
+	Table0391CLOCK                   Table0391 = `CLOCK`
+	Table0391CLOCKANDSTATISTICS      Table0391 = `CLOCK_AND_STATISTICS`
+	Table0391CLOCKANDSTATSWITHNOTE   Table0391 = `CLOCK_AND_STATS_WITH_NOTE`
+	Table0391COMMAND                 Table0391 = `COMMAND`
+	Table0391COMMANDRESPONSE         Table0391 = `COMMAND_RESPONSE`
+	Table0391COMMONORDER             Table0391 = `COMMON_ORDER`
+	Table0391COMPONENT               Table0391 = `COMPONENT`
+	Table0391COMPONENTS              Table0391 = `COMPONENTS`
+	Table0391CONTAINER               Table0391 = `CONTAINER`
+	Table0391DEFINITION              Table0391 = `DEFINITION`
+	Table0391DIET                    Table0391 = `DIET`
+	Table0391DISPENSE                Table0391 = `DISPENSE`
+	Table0391ENCODEDORDER            Table0391 = `ENCODED_ORDER`
+	Table0391ENCODING                Table0391 = `ENCODING`
+	Table0391EXPERIENCE              Table0391 = `EXPERIENCE`
+	Table0391FINANCIAL               Table0391 = `FINANCIAL`
+	Table0391FINANCIALCOMMONORDER    Table0391 = `FINANCIAL_COMMON_ORDER`
+	Table0391FINANCIALINSURANCE      Table0391 = `FINANCIAL_INSURANCE`
+	Table0391FINANCIALOBSERVATION    Table0391 = `FINANCIAL_OBSERVATION`
+	Table0391FINANCIALORDER          Table0391 = `FINANCIAL_ORDER`
+	Table0391FINANCIALPROCEDURE      Table0391 = `FINANCIAL_PROCEDURE`
+	Table0391FINANCIALTIMINGQUANTITY Table0391 = `FINANCIAL_TIMING_QUANTITY`
+	Table0391GENERALRESOURCE         Table0391 = `GENERAL_RESOURCE`
+	Table0391GIVE                    Table0391 = `GIVE`
+	Table0391GOAL                    Table0391 = `GOAL`
+	Table0391GOALOBSERVATION         Table0391 = `GOAL_OBSERVATION`
+	Table0391GOALPATHWAY             Table0391 = `GOAL_PATHWAY`
+	Table0391GOALROLE                Table0391 = `GOAL_ROLE`
+	Table0391GUARANTORINSURANCE      Table0391 = `GUARANTOR_INSURANCE`
+	Table0391INSURANCE               Table0391 = `INSURANCE`
+	Table0391LOCATIONRESOURCE        Table0391 = `LOCATION_RESOURCE`
+	Table0391MERGEINFO               Table0391 = `MERGE_INFO`
+	Table0391MF                      Table0391 = `MF`
+	Table0391MFCDM                   Table0391 = `MF_CDM`
+	Table0391MFCLINSTUDY             Table0391 = `MF_CLIN_STUDY`
+	Table0391MFCLINSTUDYSCHED        Table0391 = `MF_CLIN_STUDY_SCHED`
+	Table0391MFINVITEM               Table0391 = `MF_INV_ITEM`
+	Table0391MFLOCDEPT               Table0391 = `MF_LOC_DEPT`
+	Table0391MFLOCATION              Table0391 = `MF_LOCATION`
+	Table0391MFOBSATTRIBUTES         Table0391 = `MF_OBS_ATTRIBUTES`
+	Table0391MFPHASESCHEDDETAIL      Table0391 = `MF_PHASE_SCHED_DETAIL`
+	Table0391MFQUERY                 Table0391 = `MF_QUERY`
+	Table0391MFSITEDEFINED           Table0391 = `MF_SITE_DEFINED`
+	Table0391MFSTAFF                 Table0391 = `MF_STAFF`
+	Table0391MFTEST                  Table0391 = `MF_TEST`
+	Table0391MFTESTBATTDETAIL        Table0391 = `MF_TEST_BATT_DETAIL`
+	Table0391MFTESTBATTERIES         Table0391 = `MF_TEST_BATTERIES`
+	Table0391MFTESTCALCDETAIL        Table0391 = `MF_TEST_CALC_DETAIL`
+	Table0391MFTESTCALCULATED        Table0391 = `MF_TEST_CALCULATED`
+	Table0391MFTESTCATDETAIL         Table0391 = `MF_TEST_CAT_DETAIL`
+	Table0391MFTESTCATEGORICAL       Table0391 = `MF_TEST_CATEGORICAL`
+	Table0391MFTESTNUMERIC           Table0391 = `MF_TEST_NUMERIC`
+	Table0391NK1TIMINGQTY            Table0391 = `NK1_TIMING_QTY`
+	Table0391NOTIFICATION            Table0391 = `NOTIFICATION`
+	Table0391OBSERVATION             Table0391 = `OBSERVATION`
+	Table0391OBSERVATIONPRIOR        Table0391 = `OBSERVATION_PRIOR`
+	Table0391OBSERVATIONREQUEST      Table0391 = `OBSERVATION_REQUEST`
+	Table0391OMSERVATION             Table0391 = `OMSERVATION`
+	Table0391ORDER                   Table0391 = `ORDER`
+	Table0391ORDERCHOICE             Table0391 = `ORDER_CHOICE`
+	Table0391ORDERDETAIL             Table0391 = `ORDER_DETAIL`
+	Table0391ORDERDETAILSUPPLEMENT   Table0391 = `ORDER_DETAIL_SUPPLEMENT`
+	Table0391ORDERDIET               Table0391 = `ORDER_DIET`
+	Table0391ORDERENCODED            Table0391 = `ORDER_ENCODED`
+	Table0391ORDEROBSERVATION        Table0391 = `ORDER_OBSERVATION`
+	Table0391ORDERPRIOR              Table0391 = `ORDER_PRIOR`
+	Table0391ORDERTRAY               Table0391 = `ORDER_TRAY`
+	Table0391PATHWAY                 Table0391 = `PATHWAY`
+	Table0391PATHWAYROLE             Table0391 = `PATHWAY_ROLE`
+	Table0391PATIENT                 Table0391 = `PATIENT`
+	Table0391PATIENTPRIOR            Table0391 = `PATIENT_PRIOR`
+	Table0391PATIENTRESULT           Table0391 = `PATIENT_RESULT`
+	Table0391PATIENTVISIT            Table0391 = `PATIENT_VISIT`
+	Table0391PATIENTVISITPRIOR       Table0391 = `PATIENT_VISIT_PRIOR`
+	Table0391PERSONNELRESOURCE       Table0391 = `PERSONNEL_RESOURCE`
+	Table0391PEXCAUSE                Table0391 = `PEX_CAUSE`
+	Table0391PEXOBSERVATION          Table0391 = `PEX_OBSERVATION`
+	Table0391PRIORRESULT             Table0391 = `PRIOR_RESULT`
+	Table0391PROBLEM                 Table0391 = `PROBLEM`
+	Table0391PROBLEMOBSERVATION      Table0391 = `PROBLEM_OBSERVATION`
+	Table0391PROBLEMPATHWAY          Table0391 = `PROBLEM_PATHWAY`
+	Table0391PROBLEMROLE             Table0391 = `PROBLEM_ROLE`
+	Table0391PROCEDURE               Table0391 = `PROCEDURE`
+	Table0391PRODUCT                 Table0391 = `PRODUCT`
+	Table0391PRODUCTSTATUS           Table0391 = `PRODUCT_STATUS`
+	Table0391PROVIDER                Table0391 = `PROVIDER`
+	Table0391PROVIDERCONTACT         Table0391 = `PROVIDER_CONTACT`
+	Table0391QBP                     Table0391 = `QBP`
+	Table0391QRYWITHDETAIL           Table0391 = `QRY_WITH_DETAIL`
+	Table0391QUERYRESPONSE           Table0391 = `QUERY_RESPONSE`
+	Table0391QUERYRESULTCLUSTER      Table0391 = `QUERY_RESULT_CLUSTER`
+	Table0391REQUEST                 Table0391 = `REQUEST`
+	Table0391RESOURCE                Table0391 = `RESOURCE`
+	Table0391RESOURCES               Table0391 = `RESOURCES`
+	Table0391RESPONSE                Table0391 = `RESPONSE`
+	Table0391RESULT                  Table0391 = `RESULT`
+	Table0391RESULTS                 Table0391 = `RESULTS`
+	Table0391RESULTSNOTES            Table0391 = `RESULTS_NOTES`
+	Table0391ROWDEFINITION           Table0391 = `ROW_DEFINITION`
+	Table0391RXADMINISTRATION        Table0391 = `RX_ADMINISTRATION`
+	Table0391RXORDER                 Table0391 = `RX_ORDER`
+	Table0391SCHEDULE                Table0391 = `SCHEDULE`
+	Table0391SERVICE                 Table0391 = `SERVICE`
+	Table0391SPECIMEN                Table0391 = `SPECIMEN`
+	Table0391SPECIMENCONTAINER       Table0391 = `SPECIMEN_CONTAINER`
+	Table0391STAFF                   Table0391 = `STAFF`
+	Table0391STUDY                   Table0391 = `STUDY`
+	Table0391STUDYOBSERVATION        Table0391 = `STUDY_OBSERVATION`
+	Table0391STUDYPHASE              Table0391 = `STUDY_PHASE`
+	Table0391STUDYSCHEDULE           Table0391 = `STUDY_SCHEDULE`
+	Table0391TESTCONFIGURATION       Table0391 = `TEST_CONFIGURATION`
+	Table0391TIMING                  Table0391 = `TIMING`
+	Table0391TIMINGDIET              Table0391 = `TIMING_DIET`
+	Table0391TIMINGENCODED           Table0391 = `TIMING_ENCODED`
+	Table0391TIMINGGIVE              Table0391 = `TIMING_GIVE`
+	Table0391TIMINGPRIOR             Table0391 = `TIMING_PRIOR`
+	Table0391TIMINGQTY               Table0391 = `TIMING_QTY`
+	Table0391TIMINGQUANTITY          Table0391 = `TIMING_QUANTITY`
+	Table0391TIMINGTRAY              Table0391 = `TIMING_TRAY`
+	Table0391TREATMENT               Table0391 = `TREATMENT`
+	Table0391VISIT                   Table0391 = `VISIT`
+)
+
+// Description of the value, or empty if the value is not in table 0391.
+func (v Table0391) Description() string {
+	return tableDescription(`0391`, string(v))
+}
+
+// Valid reports if the value is in table 0391.
+func (v Table0391) Valid() bool {
+	return TableValueLookup[`0391`][string(v)]
+}
+
+// Table 0391.
+func (v Table0391) Table() Table {
+	return TableLookup[`0391`]
+}
+
+// Table0392 is a value of table 0392, Match Reason.
+// Fields in this table are strings; convert a field, such as Table0392(v), to use the helpers.
+type Table0392 string
+
+const (
+	Table0392MatchOnDateOfBirth          Table0392 = `DB` // Match on Date of Birth
+	Table0392MatchOnNameAlphaMatch       Table0392 = `NA` // Match on Name (Alpha Match)
+	Table0392MatchOnNamePhoneticMatch    Table0392 = `NP` // Match on Name (Phonetic Match)
+	Table0392MatchOnSocialSecurityNumber Table0392 = `SS` // Match on Social Security Number
+)
+
+// Description of the value, or empty if the value is not in table 0392.
+func (v Table0392) Description() string {
+	return tableDescription(`0392`, string(v))
+}
+
+// Valid reports if the value is in table 0392.
+func (v Table0392) Valid() bool {
+	return TableValueLookup[`0392`][string(v)]
+}
+
+// Table 0392.
+func (v Table0392) Table() Table {
+	return TableLookup[`0392`]
+}
+
+// Table0393 is a value of table 0393, Match Algorithms.
+// Fields in this table are strings; convert a field, such as Table0393(v), to use the helpers.
+type Table0393 string
+
+const (
+	Table0393ProprietaryAlgorithmForLinkSoftV201 Table0393 = `LINKSOFT_2.01` // Proprietary algorithm for LinkSoft v2.01
+	Table0393ProprietaryAlgorithmForMatchWareV12 Table0393 = `MATCHWARE_1.2` // Proprietary algorithm for MatchWare v1.2
+)
+
+// Description of the value, or empty if the value is not in table 0393.
+func (v Table0393) Description() string {
+	return tableDescription(`0393`, string(v))
+}
+
+// Valid reports if the value is in table 0393.
+func (v Table0393) Valid() bool {
+	return TableValueLookup[`0393`][string(v)]
+}
+
+// Table 0393.
+func (v Table0393) Table() Table {
+	return TableLookup[`0393`]
+}
+
+// Table0394 is a value of table 0394, Response Modality.
+// Fields in this table are strings; convert a field, such as Table0394(v), to use the helpers.
+type Table0394 string
+
+const (
+	Table0394Batch                       Table0394 = `B` // Batch
+	Table0394RealTime                    Table0394 = `R` // Real Time
+	Table0394BolusASeriesOfResponsesSent Table0394 = `T` // Bolus (a series of responses sent at the same time without use of batch formatting)
+)
+
+// Description of the value, or empty if the value is not in table 0394.
+func (v Table0394) Description() string {
+	return tableDescription(`0394`, string(v))
+}
+
+// Valid reports if the value is in table 0394.
+func (v Table0394) Valid() bool {
+	return TableValueLookup[`0394`][string(v)]
+}
+
+// Table 0394.
+func (v Table0394) Table() Table {
+	return TableLookup[`0394`]
+}
+
+// Table0395 is a value of table 0395, Modify Indicator.
+// Fields in this table are strings; convert a field, such as Table0395(v), to use the helpers.
+type Table0395 string
+
+const (
+	Table0395ModifiedSubscription Table0395 = `M` // Modified Subscription
+	Table0395NewSubscription      Table0395 = `N` // New Subscription
+)
+
+// Description of the value, or empty if the value is not in table 0395.
+func (v Table0395) Description() string {
+	return tableDescription(`0395`, string(v))
+}
+
+// Valid reports if the value is in table 0395.
+func (v Table0395) Valid() bool {
+	return TableValueLookup[`0395`][string(v)]
+}
+
+// Table 0395.
+func (v Table0395) Table() Table {
+	return TableLookup[`0395`]
+}
+
+// Table0396 is a value of table 0396, Coding System.
+// Fields in this table are strings; convert a field, such as Table0396(v), to use the helpers.
+type Table0396 string
+
+const (
+	Table0396LocalGeneralCodeWhereZIs                              Table0396 = `99zzz`                // Local general code where z is an alphanumeric character
+	Table0396AmericanCollegeOfRadiologyFindingCodes                Table0396 = `ACR`                  // American College of Radiology finding codes
+	Table0396GermanAlphaIDV2006                                    Table0396 = `ALPHAID2006`          // German Alpha-ID v2006
+	Table0396GermanAlphaIDV2007                                    Table0396 = `ALPHAID2007`          // German Alpha-ID v2007
+	Table0396GermanAlphaIDV2008                                    Table0396 = `ALPHAID2008`          // German Alpha-ID v2008
+	Table0396GermanAlphaIDV2009                                    Table0396 = `ALPHAID2009`          // German Alpha-ID v2009
+	Table0396GermanAlphaIDV2010                                    Table0396 = `ALPHAID2010`          // German Alpha-ID v2010
+	Table0396GermanAlphaIDV2011                                    Table0396 = `ALPHAID2011`          // German Alpha-ID v2011
+	Table0396HL7SetOfUnitsOfMeasure                                Table0396 = `ANS+`                 // HL7 set of units of measure
+	Table0396WHOAdverseReactionTerms                               Table0396 = `ART`                  // WHO Adverse Reaction Terms
+	Table0396ASTME1238E1467Universal                               Table0396 = `AS4`                  // ASTM E1238/ E1467 Universal
+	Table0396AS4NeurophysiologyCodes                               Table0396 = `AS4E`                 // AS4 Neurophysiology Codes
+	Table0396AmericanTypeCultureCollection                         Table0396 = `ATC`                  // American Type Culture Collection
+	Table0396CPT4                                                  Table0396 = `C4`                   // CPT-4
+	Table0396CollegeOfAmericanPathologistsElectronicCancer         Table0396 = `CAPECC`               // College of American Pathologists Electronic Cancer Checklist
+	Table0396ChemicalAbstractCodes                                 Table0396 = `CAS`                  // Chemical abstract codes
+	Table0396ClinicalCareClassificationSystem                      Table0396 = `CCC`                  // Clinical Care Classification system
+	Table0396CDT2Codes                                             Table0396 = `CD2`                  // CDT-2 Codes
+	Table0396CDCAnalyteCodes                                       Table0396 = `CDCA`                 // CDC Analyte Codes
+	Table0396CDCEmergencyDepartmentAcuity                          Table0396 = `CDCEDACUITY`          // CDC Emergency Department Acuity
+	Table0396CDCMethodsInstrumentsCodes                            Table0396 = `CDCM`                 // CDC Methods/Instruments Codes
+	Table0396CDCBioSenseRTObservationsCensusCDC                    Table0396 = `CDCOBS`               // CDC BioSense RT observations (Census) - CDC
+	Table0396CDCPHINVocabularyCodingSystem                         Table0396 = `CDCPHINVS`            // CDC PHIN Vocabulary Coding System
+	Table0396RaceEthnicityCDC                                      Table0396 = `CDCREC`               // Race & Ethnicity - CDC
+	Table0396CDCSurveillance                                       Table0396 = `CDS`                  // CDC Surveillance
+	Table0396CENECGDiagnosticCodes                                 Table0396 = `CE (obsolete)`        // CEN ECG diagnostic codes
+	Table0396CLIP                                                  Table0396 = `CLP`                  // CLIP
+	Table0396CPTModifierCode                                       Table0396 = `CPTM`                 // CPT Modifier Code
+	Table0396COSTART                                               Table0396 = `CST`                  // COSTART
+	Table0396CDCVaccineCodes                                       Table0396 = `CVX`                  // CDC Vaccine Codes
+	Table0396DICOMControlledTerminology                            Table0396 = `DCM`                  // DICOM Controlled Terminology
+	Table0396EUCLIDES                                              Table0396 = `E`                    // EUCLIDES
+	Table0396EuclidesQuantityCodes                                 Table0396 = `E5`                   // Euclides  quantity codes
+	Table0396EuclidesLabMethodCodes                                Table0396 = `E6`                   // Euclides Lab method codes
+	Table0396EuclidesLabEquipmentCodes                             Table0396 = `E7`                   // Euclides Lab equipment codes
+	Table0396EnzymeCodes                                           Table0396 = `ENZC`                 // Enzyme Codes
+	Table0396EPASRS                                                Table0396 = `EPASRS`               // EPA SRS
+	Table0396UniqueIngredientIdentifierUNII                        Table0396 = `FDAUNII`              // Unique Ingredient Identifier (UNII)
+	Table0396FirstDataBankDrugCodes                                Table0396 = `FDDC`                 // First DataBank Drug Codes
+	Table0396FirstDataBankDiagnosticCodes                          Table0396 = `FDDX`                 // First DataBank Diagnostic Codes
+	Table0396FDAK10                                                Table0396 = `FDK`                  // FDA K10
+	Table0396FIPS52State                                           Table0396 = `FIPS5_2`              // FIPS 5-2 (State)
+	Table0396FIPS64County                                          Table0396 = `FIPS6_4`              // FIPS 6-4 (County)
+	Table0396GDRGGermanDRGCodesV2004                               Table0396 = `GDRG2004`             // G-DRG German DRG Codes v2004
+	Table0396GDRGGermanDRGCodesV2005                               Table0396 = `GDRG2005`             // G-DRG German DRG Codes v2005
+	Table0396GDRGGermanDRGCodesV2006                               Table0396 = `GDRG2006`             // G-DRG German DRG Codes v2006
+	Table0396GDRGGermanDRGCodesV2007                               Table0396 = `GDRG2007`             // G-DRG German DRG Codes v2007
+	Table0396GDRGGermanDRGCodesV2008                               Table0396 = `GDRG2008`             // G-DRG German DRG Codes v2008
+	Table0396GDRGGermanDRGCodesV2009                               Table0396 = `GDRG2009`             // G-DRG German DRG Codes v2009
+	Table0396GermanMajorDiagnosticCodesV2004                       Table0396 = `GMDC2004`             // German Major Diagnostic Codes v2004
+	Table0396GermanMajorDiagnosticCodesV2005                       Table0396 = `GMDC2005`             // German Major Diagnostic Codes v2005
+	Table0396GermanMajorDiagnosticCodesV2006                       Table0396 = `GMDC2006`             // German Major Diagnostic Codes v2006
+	Table0396GermanMajorDiagnosticCodesV2007                       Table0396 = `GMDC2007`             // German Major Diagnostic Codes v2007
+	Table0396GermanMajorDiagnosticCodesV2008                       Table0396 = `GMDC2008`             // German Major Diagnostic Codes v2008
+	Table0396GermanMajorDiagnosticCodesV2009                       Table0396 = `GMDC2009`             // German Major Diagnostic Codes v2009
+	Table0396HIBCC                                                 Table0396 = `HB`                   // HIBCC
+	Table0396CMSFormerlyHCFACommonProcedureCoding                  Table0396 = `HCPCS`                // CMS (formerly HCFA)  Common Procedure Coding System
+	Table0396HealthCareProviderTaxonomy                            Table0396 = `HCPT`                 // Health Care Provider Taxonomy
+	Table0396HomeHealthCare                                        Table0396 = `HHC`                  // Home Health Care
+	Table0396HealthOutcomes                                        Table0396 = `HI`                   // Health Outcomes
+	Table0396HL7DefinedCodesWhereNnnnIs                            Table0396 = `HL7nnnn`              // HL7 Defined Codes where nnnn is the HL7 table number
+	Table0396JapaneseNationwideMedicineCode                        Table0396 = `HOT`                  // Japanese Nationwide Medicine Code
+	Table0396CMSFormerlyHCFAProcedureCodesHCPCS                    Table0396 = `HPC`                  // CMS (formerly HCFA )Procedure Codes (HCPCS)
+	Table0396ICD10                                                 Table0396 = `I10`                  // ICD-10
+	Table0396ICD10Germany2004                                      Table0396 = `I10G2004`             // ICD 10 Germany 2004
+	Table0396ICD10Germany2005                                      Table0396 = `I10G2005`             // ICD 10 Germany 2005
+	Table0396ICD10Germany2006                                      Table0396 = `I10G2006`             // ICD 10 Germany 2006
+	Table0396ICD10ProcedureCodes                                   Table0396 = `I10P`                 // ICD-10  Procedure Codes
+	Table0396ICD9                                                  Table0396 = `I9`                   // ICD9
+	Table0396ICD9CM                                                Table0396 = `I9C`                  // ICD-9CM
+	Table0396ICD9CMDiagnosisCodes                                  Table0396 = `I9CDX`                // ICD-9CM Diagnosis codes
+	Table0396ICD9CMProcedureCodes                                  Table0396 = `I9CP`                 // ICD-9CM Procedure codes
+	Table0396ISBT                                                  Table0396 = `IBT`                  // ISBT
+	Table0396ISBT128CodesWhereNnnnSpecifies                        Table0396 = `IBTnnnn`              // ISBT 128 codes where nnnn  specifies a specific table within ISBT 128.
+	Table0396ICHPPC2                                               Table0396 = `IC2`                  // ICHPPC-2
+	Table0396ICD10AustralianModification                           Table0396 = `ICD10AM`              // ICD-10 Australian modification
+	Table0396ICD10Canada                                           Table0396 = `ICD10CA`              // ICD-10 Canada
+	Table0396ICD10GermanyV2007                                     Table0396 = `ICD10GM2007`          // ICD 10 Germany v2007
+	Table0396ICD10GermanyV2008                                     Table0396 = `ICD10GM2008`          // ICD 10 Germany v2008
+	Table0396ICD10GermanyV2009                                     Table0396 = `ICD10GM2009`          // ICD 10 Germany v2009
+	Table0396ICD10GermanyV2010                                     Table0396 = `ICD10GM2010`          // ICD 10 Germany v2010
+	Table0396ICD10GermanyV2011                                     Table0396 = `ICD10GM2011`          // ICD 10 Germany v2011
+	Table0396InternationalClassificationOfDiseasesForOncology      Table0396 = `ICDO`                 // International Classification of Diseases for Oncology
+	Table0396InternationalClassificationOfDiseaseForOncology       Table0396 = `ICDO2`                // International Classification of Disease for Oncology Second Edition
+	Table0396InternationalClassificationOfDiseaseForOncology_ICDO3 Table0396 = `ICDO3`                // International Classification of Disease for Oncology Third Edition
+	Table0396ICCS                                                  Table0396 = `ICS`                  // ICCS
+	Table0396InternationalClassificationOfSleepDisorders           Table0396 = `ICSD`                 // International Classification of Sleep Disorders
+	Table0396ISO295583UnitsOfMeasure                               Table0396 = `ISO`                  // ISO 2955.83 (units of measure) with HL7 extensions
+	Table0396ISO31661CountryCodes                                  Table0396 = `ISO3166_1`            // ISO 3166-1 Country Codes
+	Table0396ISO31662CountrySubdivisions                           Table0396 = `ISO3166_2`            // ISO 3166-2 Country subdivisions
+	Table0396ISO4217CurrencyCodes                                  Table0396 = `ISO4217`              // ISO4217 Currency Codes
+	Table0396ISO639Language                                        Table0396 = `ISO639`               // ISO 639 Language
+	Table0396ISODefinedCodesWhereNnnnIs                            Table0396 = `ISOnnnn (deprecated)` // ISO Defined Codes where nnnn is the ISO table number
+	Table0396IntegratedTaxonomicInformationSystem                  Table0396 = `ITIS`                 // Integrated Taxonomic Information System
+	Table0396IUPACIFCCComponentCodes                               Table0396 = `IUPC`                 // IUPAC/IFCC Component Codes
+	Table0396IUPACIFCCPropertyCodes                                Table0396 = `IUPP`                 // IUPAC/IFCC Property Codes
+	Table0396JLACJSLMNationwideLaboratoryCode                      Table0396 = `JC10`                 // JLAC/JSLM, nationwide laboratory code
+	Table0396JapaneseChemistry                                     Table0396 = `JC8`                  // Japanese Chemistry
+	Table0396JapaneseImageExaminationCache                         Table0396 = `JJ1017`               // Japanese Image Examination Cache
+	Table0396LocalGeneralCode                                      Table0396 = `L`                    // Local general code
+	Table0396LocalBillingCode                                      Table0396 = `LB`                   // Local billing code
+	Table0396LogicalObservationIdentifierNamesAndCodes             Table0396 = `LN`                   // Logical Observation Identifier Names and Codes (LOINCÂ®)
+	Table0396Medicaid                                              Table0396 = `MCD`                  // Medicaid
+	Table0396Medicare                                              Table0396 = `MCR`                  // Medicare
+	Table0396MedicalDeviceCommunication                            Table0396 = `MDC`                  // Medical Device Communication
+	Table0396MedispanDiagnosticCodes                               Table0396 = `MDDX`                 // Medispan Diagnostic Codes
+	Table0396MedicalEconomicsDrugCodes                             Table0396 = `MEDC`                 // Medical Economics Drug Codes
+	Table0396MedicalDictionaryForDrugRegulatoryAffairs             Table0396 = `MEDR`                 // Medical Dictionary for Drug Regulatory Affairs (MEDDRA)
+	Table0396MedicalEconomicsDiagnosticCodes                       Table0396 = `MEDX`                 // Medical Economics Diagnostic Codes
+	Table0396MedispanGPI                                           Table0396 = `MGPI`                 // Medispan GPI
+	Table0396CDCVaccineManufacturerCodes                           Table0396 = `MVX`                  // CDC Vaccine Manufacturer Codes
+	Table0396IndustryNAICS                                         Table0396 = `NAICS`                // Industry (NAICS)
+	Table0396NCPDPCodeListForDataElement                           Table0396 = `NCPDPnnnnsss`         // NCPDP code list for data element nnnn [as used in segment sss]
+	Table0396NANDA                                                 Table0396 = `NDA`                  // NANDA
+	Table0396NationalDrugCodes                                     Table0396 = `NDC`                  // National drug codes
+	Table0396NDFRTDrugClassification                               Table0396 = `NDFRT`                // NDF-RT (Drug Classification)
+	Table0396NursingInterventionsClassification                    Table0396 = `NIC`                  // Nursing Interventions Classification
+	Table0396SourceOfInformationImmunization                       Table0396 = `NIP001`               // Source of Information (Immunization)
+	Table0396SubstanceRefusalReason                                Table0396 = `NIP002`               // Substance refusal reason
+	Table0396VaccinationContraindicationsPrecautionsAndImmunities  Table0396 = `NIP004`               // Vaccination - Contraindications, Precautions, and Immunities
+	Table0396VaccinatedAtLocationFacility                          Table0396 = `NIP007`               // Vaccinated at location (facility)
+	Table0396VaccinePurchasedWithTypeOfFunding                     Table0396 = `NIP008`               // Vaccine purchased with (Type of funding)
+	Table0396ReportedAdverseEventPreviously                        Table0396 = `NIP009`               // Reported adverse event previously
+	Table0396VAERSReportType                                       Table0396 = `NIP010`               // VAERS Report type
+	Table0396NotifiableEventDiseaseConditionCodeList               Table0396 = `NND`                  // Notifiable Event (Disease/Condition) Code List
+	Table0396NationalProviderIdentifier                            Table0396 = `NPI`                  // National Provider Identifier
+	Table0396NationalUniformBillingCommittee                       Table0396 = `NUBC`                 // National Uniform Billing Committee
+	Table0396NullFlavor                                            Table0396 = `NULLFL`               // Null Flavor
+	Table0396GermanProcedureCodes                                  Table0396 = `O301`                 // German Procedure Codes
+	Table0396OPSGermanyV2004                                       Table0396 = `O3012004`             // OPS Germany v2004
+	Table0396OPSGermanyV2005                                       Table0396 = `O3012005`             // OPS Germany v2005
+	Table0396OPSGermanyV2006                                       Table0396 = `O3012006`             // OPS Germany v2006
+	Table0396OmahaSystem                                           Table0396 = `OHA`                  // Omaha System
+	Table0396OPSGermanyV2007                                       Table0396 = `OPS2007`              // OPS Germany v2007
+	Table0396OPSGermanyV2008                                       Table0396 = `OPS2008`              // OPS Germany v2008
+	Table0396OPSGermanyV2009                                       Table0396 = `OPS2009`              // OPS Germany v2009
+	Table0396OPSGermanyV2010                                       Table0396 = `OPS2010`              // OPS Germany v2010
+	Table0396OPSGermanyV2011                                       Table0396 = `OPS2011`              // OPS Germany v2011
+	Table0396CDCPublicHealthInformationNetworkPHIN                 Table0396 = `PHINQUESTION`         // CDC Public Health Information Network (PHIN) Question
+	Table0396CDCPHLIPLabResultCodesThat                            Table0396 = `PLR`                  // CDC PHLIP Lab result codes that are not covered in SNOMED at the time of this implementation
+	Table0396CDCPHLIPLabTestCodesWhere                             Table0396 = `PLT`                  // CDC PHLIP Lab test codes, where LOINC concept is too broad or not yet available, especially as needed for ordering and or lab to lab reporting )
+	Table0396POSCodes                                              Table0396 = `POS`                  // POS Codes
+	Table0396ReadClassification                                    Table0396 = `RC`                   // Read Classification
+	Table0396RxNorm                                                Table0396 = `RXNORM`               // RxNorm
+	Table0396SNOMEDClinicalTerms                                   Table0396 = `SCT`                  // SNOMED Clinical Terms
+	Table0396SNOMEDClinicalTermsAlphanumericCodes                  Table0396 = `SCT2`                 // SNOMED Clinical Terms alphanumeric codes
+	Table0396SNOMEDDICOMMicroglossary                              Table0396 = `SDM`                  // SNOMED- DICOM Microglossary
+	Table0396IndustrySIC                                           Table0396 = `SIC`                  // Industry (SIC)
+	Table0396SystemizedNomenclatureOfMedicineSNOMED                Table0396 = `SNM`                  // Systemized Nomenclature of Medicine (SNOMED)
+	Table0396SNOMEDInternational                                   Table0396 = `SNM3`                 // SNOMED International
+	Table0396SNOMEDTopologyCodesAnatomicSites                      Table0396 = `SNT`                  // SNOMED topology codes (anatomic sites)
+	Table0396OccupationSOC2000                                     Table0396 = `SOC`                  // Occupation (SOC 2000)
+	Table0396PriorityTypeOfVisit                                   Table0396 = `UB04FL14`             // Priority (Type) of Visit
+	Table0396PointOfOrigin                                         Table0396 = `UB04FL15`             // Point of Origin
+	Table0396PatientDischargeStatus                                Table0396 = `UB04FL17`             // Patient Discharge Status
+	Table0396OccurrenceCode                                        Table0396 = `UB04FL31`             // Occurrence Code
+	Table0396OccurrenceSpan                                        Table0396 = `UB04FL35`             // Occurrence Span
+	Table0396ValueCode                                             Table0396 = `UB04FL39`             // Value Code
+	Table0396UCDS                                                  Table0396 = `UC`                   // UCDS
+	Table0396UCUMCodeSetForUnitsOf                                 Table0396 = `UCUM`                 // UCUM code set for units of measure(from Regenstrief)
+	Table0396MDNS                                                  Table0396 = `UMD`                  // MDNS
+	Table0396UnifiedMedicalLanguage                                Table0396 = `UML`                  // Unified Medical Language
+	Table0396UniversalProductCode                                  Table0396 = `UPC`                  // Universal Product Code
+	Table0396UPIN                                                  Table0396 = `UPIN`                 // UPIN
+	Table0396UnitedStatesPostalService                             Table0396 = `USPS`                 // United States Postal Service
+	Table0396WHORecordDrugCodes6Digit                              Table0396 = `W1`                   // WHO record # drug codes (6 digit)
+	Table0396WHORecordDrugCodes8Digit                              Table0396 = `W2`                   // WHO record # drug codes (8 digit)
+	Table0396WHORecordCodeWithASTMExtension                        Table0396 = `W4`                   // WHO record # code with ASTM extension
+	Table0396WHOATC                                                Table0396 = `WC`                   // WHO ATC
+	Table0396ASCX12CodeListNnnn                                    Table0396 = `X12Dennnn`            // ASC X12 Code List nnnn
+)
+
+// Description of the value, or empty if the value is not in table 0396.
+func (v Table0396) Description() string {
+	return tableDescription(`0396`, string(v))
+}
+
+// Valid reports if the value is in table 0396.
+func (v Table0396) Valid() bool {
+	return TableValueLookup[`0396`][string(v)]
+}
+
+// Table 0396.
+func (v Table0396) Table() Table {
+	return TableLookup[`0396`]
+}
+
+// Table0397 is a value of table 0397, Sequencing.
+// Fields in this table are strings; convert a field, such as Table0397(v), to use the helpers.
+type Table0397 string
+
+const (
+	Table0397Ascending                 Table0397 = `A`  // Ascending
+	Table0397AscendingCaseInsensitive  Table0397 = `AN` // Ascending, case insensitive
+	Table0397Descending                Table0397 = `D`  // Descending
+	Table0397DescendingCaseInsensitive Table0397 = `DN` // Descending, case insensitive
+	Table0397None                      Table0397 = `N`  // None
+)
+
+// Description of the value, or empty if the value is not in table 0397.
+func (v Table0397) Description() string {
+	return tableDescription(`0397`, string(v))
+}
+
+// Valid reports if the value is in table 0397.
+func (v Table0397) Valid() bool {
+	return TableValueLookup[`0397`][string(v)]
+}
+
+// Table 0397.
+func (v Table0397) Table() Table {
+	return TableLookup[`0397`]
+}
+
+// Table0398 is a value of table 0398, Continuation Style Code.
+// Fields in this table are strings; convert a field, such as Table0398(v), to use the helpers.
+type Table0398 string
+
+const (
+	Table0398Fragmentation           Table0398 = `F` // Fragmentation
+	Table0398InteractiveContinuation Table0398 = `I` // Interactive Continuation
+)
+
+// Description of the value, or empty if the value is not in table 0398.
+func (v Table0398) Description() string {
+	return tableDescription(`0398`, string(v))
+}
+
+// Valid reports if the value is in table 0398.
+func (v Table0398) Valid() bool {
+	return TableValueLookup[`0398`][string(v)]
+}
+
+// Table 0398.
+func (v Table0398) Table() Table {
+	return TableLookup[`0398`]
+}
+
+// Table0401 is a value of table 0401, Government Reimbursement Program.
+// Fields in this table are strings; convert a field, such as Table0401(v), to use the helpers.
+type Table0401 string
+
+const (
+	Table0401MediCal  Table0401 = `C`  // Medi-Cal
+	Table0401Medicare Table0401 = `MM` // Medicare
+)
+
+// Description of the value, or empty if the value is not in table 0401.
+func (v Table0401) Description() string {
+	return tableDescription(`0401`, string(v))
+}
+
+// Valid reports if the value is in table 0401.
+func (v Table0401) Valid() bool {
+	return TableValueLookup[`0401`][string(v)]
+}
+
+// Table 0401.
+func (v Table0401) Table() Table {
+	return TableLookup[`0401`]
+}
+
+// Table0402 is a value of table 0402, School Type.
+// Fields in this table are strings; convert a field, such as Table0402(v), to use the helpers.
+type Table0402 string
+
+const (
+	Table0402Dental        Table0402 = `D` // Dental
+	Table0402Graduate      Table0402 = `G` // Graduate
+	Table0402Medical       Table0402 = `M` // Medical
+	Table0402Undergraduate Table0402 = `U` // Undergraduate
+)
+
+// Description of the value, or empty if the value is not in table 0402.
+func (v Table0402) Description() string {
+	return tableDescription(`0402`, string(v))
+}
+
+// Valid reports if the value is in table 0402.
+func (v Table0402) Valid() bool {
+	return TableValueLookup[`0402`][string(v)]
+}
+
+// Table 0402.
+func (v Table0402) Table() Table {
+	return TableLookup[`0402`]
+}
+
+// Table0403 is a value of table 0403, Language Ability.
+// Fields in this table are strings; convert a field, such as Table0403(v), to use the helpers.
+type Table0403 string
+
+const (
+	Table0403Read       Table0403 = `1` // Read
+	Table0403Write      Table0403 = `2` // Write
+	Table0403Speak      Table0403 = `3` // Speak
+	Table0403Understand Table0403 = `4` // Understand
+	Table0403Sign       Table0403 = `5` // Sign
+)
+
+// Description of the value, or empty if the value is not in table 0403.
+func (v Table0403) Description() string {
+	return tableDescription(`0403`, string(v))
+}
+
+// Valid reports if the value is in table 0403.
+func (v Table0403) Valid() bool {
+	return TableValueLookup[`0403`][string(v)]
+}
+
+// Table 0403.
+func (v Table0403) Table() Table {
+	return TableLookup[`0403`]
+}
+
+// Table0404 is a value of table 0404, Language Proficiency.
+// Fields in this table are strings; convert a field, such as Table0404(v), to use the helpers.
+type Table0404 string
+
+const (
+	Table0404Excellent        Table0404 = `1` // Excellent
+	Table0404Good             Table0404 = `2` // Good
+	Table0404Fair             Table0404 = `3` // Fair
+	Table0404Poor             Table0404 = `4` // Poor
+	Table0404SomeLevelUnknown Table0404 = `5` // Some (level unknown)
+	Table0404None             Table0404 = `6` // None
+)
+
+// Description of the value, or empty if the value is not in table 0404.
+func (v Table0404) Description() string {
+	return tableDescription(`0404`, string(v))
+}
+
+// Valid reports if the value is in table 0404.
+func (v Table0404) Valid() bool {
+	return TableValueLookup[`0404`][string(v)]
+}
+
+// Table 0404.
+func (v Table0404) Table() Table {
+	return TableLookup[`0404`]
+}
+
+// Table0406 is a value of table 0406, Organization Unit Type.
+// Fields in this table are strings; convert a field, such as Table0406(v), to use the helpers.
+type Table0406 string
+
+const (
+	Table0406Hospital        Table0406 = `1` // Hospital
+	Table0406PhysicianClinic Table0406 = `2` // Physician Clinic
+	Table0406LongTermCare    Table0406 = `3` // Long Term Care
+	Table0406AcuteCare       Table0406 = `4` // Acute Care
+	Table0406Other           Table0406 = `5` // Other
+	Table0406Home            Table0406 = `H` // Home
+	Table0406Office          Table0406 = `O` // Office
+)
+
+// Description of the value, or empty if the value is not in table 0406.
+func (v Table0406) Description() string {
+	return tableDescription(`0406`, string(v))
+}
+
+// Valid reports if the value is in table 0406.
+func (v Table0406) Valid() bool {
+	return TableValueLookup[`0406`][string(v)]
+}
+
+// Table 0406.
+func (v Table0406) Table() Table {
+	return TableLookup[`0406`]
+}
+
+// Table0409 is a value of table 0409, Application Change Type.
+// Fields in this table are strings; convert a field, such as Table0409(v), to use the helpers.
+type Table0409 string
+
+const (
+	Table0409MigratesToDifferentCPU Table0409 = `M`  // Migrates to different CPU
+	Table0409ShutDown               Table0409 = `SD` // Shut down
+	Table0409StartUp                Table0409 = `SU` // Start up
+)
+
+// Description of the value, or empty if the value is not in table 0409.
+func (v Table0409) Description() string {
+	return tableDescription(`0409`, string(v))
+}
+
+// Valid reports if the value is in table 0409.
+func (v Table0409) Valid() bool {
+	return TableValueLookup[`0409`][string(v)]
+}
+
+// Table 0409.
+func (v Table0409) Table() Table {
+	return TableLookup[`0409`]
+}
+
+// Table0415 is a value of table 0415, DRG Transfer Type.
+// Fields in this table are strings; convert a field, such as Table0415(v), to use the helpers.
+type Table0415 string
+
+const (
+	Table0415DRGExempt    Table0415 = `E` // DRG Exempt
+	Table0415DRGNonExempt Table0415 = `N` // DRG Non Exempt
+)
+
+// Description of the value, or empty if the value is not in table 0415.
+func (v Table0415) Description() string {
+	return tableDescription(`0415`, string(v))
+}
+
+// Valid reports if the value is in table 0415.
+func (v Table0415) Valid() bool {
+	return TableValueLookup[`0415`][string(v)]
+}
+
+// Table 0415.
+func (v Table0415) Table() Table {
+	return TableLookup[`0415`]
+}
+
+// Table0416 is a value of table 0416, Procedure DRG Type.
+// Fields in this table are strings; convert a field, such as Table0416(v), to use the helpers.
+type Table0416 string
+
+const (
+	Table04161stNonOperative Table0416 = `1` // 1st non-Operative
+	Table04162ndNonOperative Table0416 = `2` // 2nd non-Operative
+	Table0416MajorOperative  Table0416 = `3` // Major Operative
+	Table04162ndOperative    Table0416 = `4` // 2nd Operative
+	Table04163rdOperative    Table0416 = `5` // 3rd Operative
+)
+
+// Description of the value, or empty if the value is not in table 0416.
+func (v Table0416) Description() string {
+	return tableDescription(`0416`, string(v))
+}
+
+// Valid reports if the value is in table 0416.
+func (v Table0416) Valid() bool {
+	return TableValueLookup[`0416`][string(v)]
+}
+
+// Table 0416.
+func (v Table0416) Table() Table {
+	return TableLookup[`0416`]
+}
+
+// Table0417 is a value of table 0417, Tissue Type Code.
+// Fields in this table are strings; convert a field, such as Table0417(v), to use the helpers.
+type Table0417 string
+
+const (
+	Table0417NoTissueExpected         Table0417 = `0` // No tissue expected
+	Table0417InsufficientTissue       Table0417 = `1` // Insufficient Tissue
+	Table0417NotAbnormal              Table0417 = `2` // Not abnormal
+	Table0417AbnormalNotCategorized   Table0417 = `3` // Abnormal-not categorized
+	Table0417MechanicalAbnormal       Table0417 = `4` // Mechanical abnormal
+	Table0417GrowthAlteration         Table0417 = `5` // Growth alteration
+	Table0417DegenerationNecrosis     Table0417 = `6` // Degeneration & necrosis
+	Table0417NonAcuteInflammation     Table0417 = `7` // Non-acute inflammation
+	Table0417NonMalignantNeoplasm     Table0417 = `8` // Non-malignant neoplasm
+	Table0417MalignantNeoplasm        Table0417 = `9` // Malignant neoplasm
+	Table0417BasalCellCarcinoma       Table0417 = `B` // Basal cell carcinoma
+	Table0417CarcinomaUnspecifiedType Table0417 = `C` // Carcinoma-unspecified type
+	Table0417AdditionalTissueRequired Table0417 = `G` // Additional tissue required
+)
+
+// Description of the value, or empty if the value is not in table 0417.
+func (v Table0417) Description() string {
+	return tableDescription(`0417`, string(v))
+}
+
+// Valid reports if the value is in table 0417.
+func (v Table0417) Valid() bool {
+	return TableValueLookup[`0417`][string(v)]
+}
+
+// Table 0417.
+func (v Table0417) Table() Table {
+	return TableLookup[`0417`]
+}
+
+// Table0418 is a value of table 0418, Procedure Priority.
+// Fields in this table are strings; convert a field, such as Table0418(v), to use the helpers.
+type Table0418 string
+
+const (
+	Table0418TheAdmittingProcedure        Table0418 = `0` // the admitting procedure
+	Table0418ThePrimaryProcedure          Table0418 = `1` // the primary procedure
+	Table0418ForRankedSecondaryProcedures Table0418 = `2` // for ranked secondary procedures
+)
+
+// Description of the value, or empty if the value is not in table 0418.
+func (v Table0418) Description() string {
+	return tableDescription(`0418`, string(v))
+}
+
+// Valid reports if the value is in table 0418.
+func (v Table0418) Valid() bool {
+	return TableValueLookup[`0418`][string(v)]
+}
+
+// Table 0418.
+func (v Table0418) Table() Table {
+	return TableLookup[`0418`]
+}
+
+// Table0421 is a value of table 0421, Severity of Illness Code.
+// Fields in this table are strings; convert a field, such as Table0421(v), to use the helpers.
+type Table0421 string
+
+const (
+	Table0421Mild     Table0421 = `MI` // Mild
+	Table0421Moderate Table0421 = `MO` // Moderate
+	Table0421Severe   Table0421 = `SE` // Severe
+)
+
+// Description of the value, or empty if the value is not in table 0421.
+func (v Table0421) Description() string {
+	return tableDescription(`0421`, string(v))
+}
+
+// Valid reports if the value is in table 0421.
+func (v Table0421) Valid() bool {
+	return TableValueLookup[`0421`][string(v)]
+}
+
+// Table 0421.
+func (v Table0421) Table() Table {
+	return TableLookup[`0421`]
+}
+
+// Table0422 is a value of table 0422, Triage Code.
+// Fields in this table are strings; convert a field, such as Table0422(v), to use the helpers.
+type Table0422 string
+
+const (
+	Table0422NonAcute         Table0422 = `1`  // Non-acute
+	Table0422Acute            Table0422 = `2`  // Acute
+	Table0422Urgent           Table0422 = `3`  // Urgent
+	Table0422Severe           Table0422 = `4`  // Severe
+	Table0422DeadOnArrivalDOA Table0422 = `5`  // Dead on Arrival (DOA)
+	Table0422Other            Table0422 = `99` // Other
+)
+
+// Description of the value, or empty if the value is not in table 0422.
+func (v Table0422) Description() string {
+	return tableDescription(`0422`, string(v))
+}
+
+// Valid reports if the value is in table 0422.
+func (v Table0422) Valid() bool {
+	return TableValueLookup[`0422`][string(v)]
+}
+
+// Table 0422.
+func (v Table0422) Table() Table {
+	return TableLookup[`0422`]
+}
+
+// Table0423 is a value of table 0423, Case Category Code.
+// Fields in this table are strings; convert a field, such as Table0423(v), to use the helpers.
+type Table0423 string
+
+const (
+	Table0423DoctorSOfficeClosed Table0423 = `D` // Doctor's Office Closed
+)
+
+// Description of the value, or empty if the value is not in table 0423.
+func (v Table0423) Description() string {
+	return tableDescription(`0423`, string(v))
+}
+
+// Valid reports if the value is in table 0423.
+func (v Table0423) Valid() bool {
+	return TableValueLookup[`0423`][string(v)]
+}
+
+// Table 0423.
+func (v Table0423) Table() Table {
+	return TableLookup[`0423`]
+}
+
+// Table0424 is a value of table 0424, Gestation Category Code.
+// Fields in this table are strings; convert a field, such as Table0424(v), to use the helpers.
+type Table0424 string
+
+const (
+	Table0424PrematurePreTerm Table0424 = `1` // Premature / Pre-term
+	Table0424FullTerm         Table0424 = `2` // Full Term
+	Table0424OverduePostTerm  Table0424 = `3` // Overdue / Post-term
+)
+
+// Description of the value, or empty if the value is not in table 0424.
+func (v Table0424) Description() string {
+	return tableDescription(`0424`, string(v))
+}
+
+// Valid reports if the value is in table 0424.
+func (v Table0424) Valid() bool {
+	return TableValueLookup[`0424`][string(v)]
+}
+
+// Table 0424.
+func (v Table0424) Table() Table {
+	return TableLookup[`0424`]
+}
+
+// Table0425 is a value of table 0425, Newborn Code.
+// Fields in this table are strings; convert a field, such as Table0425(v), to use the helpers.
+type Table0425 string
+
+const (
+	Table0425BornInFacility Table0425 = `1` // Born in facility
+	Table0425TransferIn     Table0425 = `2` // Transfer in
+	Table0425BornEnRoute    Table0425 = `3` // Born en route
+	Table0425Other          Table0425 = `4` // Other
+	Table0425BornAtHome     Table0425 = `5` // Born at home
+)
+
+// Description of the value, or empty if the value is not in table 0425.
+func (v Table0425) Description() string {
+	return tableDescription(`0425`, string(v))
+}
+
+// Valid reports if the value is in table 0425.
+func (v Table0425) Valid() bool {
+	return TableValueLookup[`0425`][string(v)]
+}
+
+// Table 0425.
+func (v Table0425) Table() Table {
+	return TableLookup[`0425`]
+}
+
+// Table0426 is a value of table 0426, Blood Product Code.
+// Fields in this table are strings; convert a field, such as Table0426(v), to use the helpers.
+type Table0426 string
+
+const (
+	Table0426CryoprecipitatedAHF          Table0426 = `CRYO`    // Cryoprecipitated AHF
+	Table0426PooledCryoprecipitate        Table0426 = `CRYOP`   // Pooled Cryoprecipitate
+	Table0426FreshFrozenPlasma            Table0426 = `FFP`     // Fresh Frozen Plasma
+	Table0426FreshFrozenPlasmaThawed      Table0426 = `FFPTH`   // Fresh Frozen Plasma - Thawed
+	Table0426PackedCells                  Table0426 = `PC`      // Packed Cells
+	Table0426AutologousPackedCells        Table0426 = `PCA`     // Autologous Packed Cells
+	Table0426PackedCellsNeonatal          Table0426 = `PCNEO`   // Packed Cells - Neonatal
+	Table0426WashedPackedCells            Table0426 = `PCW`     // Washed Packed Cells
+	Table0426PlateletConcentrate          Table0426 = `PLT`     // Platelet Concentrate
+	Table0426ReducedVolumePlatelets       Table0426 = `PLTNEO`  // Reduced Volume Platelets
+	Table0426PooledPlatelets              Table0426 = `PLTP`    // Pooled Platelets
+	Table0426PlateletPheresis             Table0426 = `PLTPH`   // Platelet Pheresis
+	Table0426LeukoreducedPlateletPheresis Table0426 = `PLTPHLR` // Leukoreduced Platelet Pheresis
+	Table0426ReconstitutedWholeBlood      Table0426 = `RWB`     // Reconstituted Whole Blood
+	Table0426AutologousWholeBlood         Table0426 = `WBA`     // Autologous Whole Blood
+)
+
+// Description of the value, or empty if the value is not in table 0426.
+func (v Table0426) Description() string {
+	return tableDescription(`0426`, string(v))
+}
+
+// Valid reports if the value is in table 0426.
+func (v Table0426) Valid() bool {
+	return TableValueLookup[`0426`][string(v)]
+}
+
+// Table 0426.
+func (v Table0426) Table() Table {
+	return TableLookup[`0426`]
+}
+
+// Table0427 is a value of table 0427, Risk Management Incident Code.
+// Fields in this table are strings; convert a field, such as Table0427(v), to use the helpers.
+type Table0427 string
+
+const (
+	Table0427BodyFluidExposure              Table0427 = `B` // Body fluid exposure
+	Table0427ContaminatedSubstance          Table0427 = `C` // Contaminated Substance
+	Table0427DietErrors                     Table0427 = `D` // Diet Errors
+	Table0427EquipmentProblem               Table0427 = `E` // Equipment problem
+	Table0427PatientFellNotFromBed          Table0427 = `F` // Patient fell (not from bed)
+	Table0427PatientFellFromBed             Table0427 = `H` // Patient fell from bed
+	Table0427InfusionError                  Table0427 = `I` // Infusion error
+	Table0427ForeignObjectLeftDuringSurgery Table0427 = `J` // Foreign object left during surgery
+	Table0427SterilePrecautionViolated      Table0427 = `K` // Sterile precaution violated
+	Table0427Other                          Table0427 = `O` // Other
+	Table0427ProcedureError                 Table0427 = `P` // Procedure error
+	Table0427PharmaceuticalError            Table0427 = `R` // Pharmaceutical error
+	Table0427SuicideAttempt                 Table0427 = `S` // Suicide Attempt
+	Table0427TransfusionError               Table0427 = `T` // Transfusion error
+)
+
+// Description of the value, or empty if the value is not in table 0427.
+func (v Table0427) Description() string {
+	return tableDescription(`0427`, string(v))
+}
+
+// Valid reports if the value is in table 0427.
+func (v Table0427) Valid() bool {
+	return TableValueLookup[`0427`][string(v)]
+}
+
+// Table 0427.
+func (v Table0427) Table() Table {
+	return TableLookup[`0427`]
+}
+
+// Table0428 is a value of table 0428, Incident Type Code.
+// Fields in this table are strings; convert a field, such as Table0428(v), to use the helpers.
+type Table0428 string
+
+const (
+	Table0428Other       Table0428 = `O` // Other
+	Table0428Preventable Table0428 = `P` // Preventable
+	Table0428UserError   Table0428 = `U` // User Error
+)
+
+// Description of the value, or empty if the value is not in table 0428.
+func (v Table0428) Description() string {
+	return tableDescription(`0428`, string(v))
+}
+
+// Valid reports if the value is in table 0428.
+func (v Table0428) Valid() bool {
+	return TableValueLookup[`0428`][string(v)]
+}
+
+// Table 0428.
+func (v Table0428) Table() Table {
+	return TableLookup[`0428`]
+}
+
+// Table0429 is a value of table 0429, Production Class Code.
+// Fields in this table are strings; convert a field, such as Table0429(v), to use the helpers.
+type Table0429 string
+
+const (
+	Table0429BreedingGeneticStock          Table0429 = `BR` // Breeding/genetic stock
+	Table0429Dairy                         Table0429 = `DA` // Dairy
+	Table0429Draft                         Table0429 = `DR` // Draft
+	Table0429DualPurpose                   Table0429 = `DU` // Dual Purpose
+	Table0429LayerIncludesMultiplierFlocks Table0429 = `LY` // Layer, Includes Multiplier flocks
+	Table0429Meat                          Table0429 = `MT` // Meat
+	Table0429NotApplicable                 Table0429 = `NA` // Not Applicable
+	Table0429Other                         Table0429 = `OT` // Other
+	Table0429Pleasure                      Table0429 = `PL` // Pleasure
+	Table0429Racing                        Table0429 = `RA` // Racing
+	Table0429Show                          Table0429 = `SH` // Show
+	Table0429Unknown                       Table0429 = `U`  // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0429.
+func (v Table0429) Description() string {
+	return tableDescription(`0429`, string(v))
+}
+
+// Valid reports if the value is in table 0429.
+func (v Table0429) Valid() bool {
+	return TableValueLookup[`0429`][string(v)]
+}
+
+// Table 0429.
+func (v Table0429) Table() Table {
+	return TableLookup[`0429`]
+}
+
+// Table0430 is a value of table 0430, Mode of Arrival Code.
+// Fields in this table are strings; convert a field, such as Table0430(v), to use the helpers.
+type Table0430 string
+
+const (
+	Table0430Ambulance       Table0430 = `A` // Ambulance
+	Table0430Car             Table0430 = `C` // Car
+	Table0430OnFoot          Table0430 = `F` // On foot
+	Table0430Helicopter      Table0430 = `H` // Helicopter
+	Table0430Other           Table0430 = `O` // Other
+	Table0430PublicTransport Table0430 = `P` // Public Transport
+	Table0430Unknown         Table0430 = `U` // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0430.
+func (v Table0430) Description() string {
+	return tableDescription(`0430`, string(v))
+}
+
+// Valid reports if the value is in table 0430.
+func (v Table0430) Valid() bool {
+	return TableValueLookup[`0430`][string(v)]
+}
+
+// Table 0430.
+func (v Table0430) Table() Table {
+	return TableLookup[`0430`]
+}
+
+// Table0431 is a value of table 0431, Recreational Drug Use Code.
+// Fields in this table are strings; convert a field, such as Table0431(v), to use the helpers.
+type Table0431 string
+
+const (
+	Table0431Alcohol       Table0431 = `A` // Alcohol
+	Table0431TobaccoChewed Table0431 = `C` // Tobacco - chewed
+	Table0431Kava          Table0431 = `K` // Kava
+	Table0431Marijuana     Table0431 = `M` // Marijuana
+	Table0431Other         Table0431 = `O` // Other
+	Table0431TobaccoSmoked Table0431 = `T` // Tobacco - smoked
+	Table0431Unknown       Table0431 = `U` // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0431.
+func (v Table0431) Description() string {
+	return tableDescription(`0431`, string(v))
+}
+
+// Valid reports if the value is in table 0431.
+func (v Table0431) Valid() bool {
+	return TableValueLookup[`0431`][string(v)]
+}
+
+// Table 0431.
+func (v Table0431) Table() Table {
+	return TableLookup[`0431`]
+}
+
+// Table0432 is a value of table 0432, Admission Level of Care Code.
+// Fields in this table are strings; convert a field, such as Table0432(v), to use the helpers.
+type Table0432 string
+
+const (
+	Table0432Acute    Table0432 = `AC` // Acute
+	Table0432Chronic  Table0432 = `CH` // Chronic
+	Table0432Comatose Table0432 = `CO` // Comatose
+	Table0432Critical Table0432 = `CR` // Critical
+	Table0432Improved Table0432 = `IM` // Improved
+	Table0432Moribund Table0432 = `MO` // Moribund
+)
+
+// Description of the value, or empty if the value is not in table 0432.
+func (v Table0432) Description() string {
+	return tableDescription(`0432`, string(v))
+}
+
+// Valid reports if the value is in table 0432.
+func (v Table0432) Valid() bool {
+	return TableValueLookup[`0432`][string(v)]
+}
+
+// Table 0432.
+func (v Table0432) Table() Table {
+	return TableLookup[`0432`]
+}
+
+// Table0433 is a value of table 0433, Precaution Code.
+// Fields in this table are strings; convert a field, such as Table0433(v), to use the helpers.
+type Table0433 string
+
+const (
+	Table0433Aggressive    Table0433 = `A` // Aggressive
+	Table0433Blind         Table0433 = `B` // Blind
+	Table0433Confused      Table0433 = `C` // Confused
+	Table0433Deaf          Table0433 = `D` // Deaf
+	Table0433OnIV          Table0433 = `I` // On IV
+	Table0433NoCodeIEDoNot Table0433 = `N` // "No-code" (i.e. Do not resuscitate)
+	Table0433Other         Table0433 = `O` // Other
+	Table0433Paraplegic    Table0433 = `P` // Paraplegic
+	Table0433Unknown       Table0433 = `U` // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0433.
+func (v Table0433) Description() string {
+	return tableDescription(`0433`, string(v))
+}
+
+// Valid reports if the value is in table 0433.
+func (v Table0433) Valid() bool {
+	return TableValueLookup[`0433`][string(v)]
+}
+
+// Table 0433.
+func (v Table0433) Table() Table {
+	return TableLookup[`0433`]
+}
+
+// Table0434 is a value of table 0434, Patient Condition Code.
+// Fields in this table are strings; convert a field, such as Table0434(v), to use the helpers.
+type Table0434 string
+
+const (
+	Table0434Satisfactory Table0434 = `A` // Satisfactory
+	Table0434Critical     Table0434 = `C` // Critical
+	Table0434Other        Table0434 = `O` // Other
+	Table0434Poor         Table0434 = `P` // Poor
+	Table0434Stable       Table0434 = `S` // Stable
+	Table0434Unknown      Table0434 = `U` // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0434.
+func (v Table0434) Description() string {
+	return tableDescription(`0434`, string(v))
+}
+
+// Valid reports if the value is in table 0434.
+func (v Table0434) Valid() bool {
+	return TableValueLookup[`0434`][string(v)]
+}
+
+// Table 0434.
+func (v Table0434) Table() Table {
+	return TableLookup[`0434`]
+}
+
+// Table0435 is a value of table 0435, Advance Directive Code.
+// Fields in this table are strings; convert a field, such as Table0435(v), to use the helpers.
+type Table0435 string
+
+const (
+	Table0435DoNotResuscitate Table0435 = `DNR` // Do not resuscitate
+	Table0435NoDirective      Table0435 = `N`   // No directive
+)
+
+// Description of the value, or empty if the value is not in table 0435.
+func (v Table0435) Description() string {
+	return tableDescription(`0435`, string(v))
+}
+
+// Valid reports if the value is in table 0435.
+func (v Table0435) Valid() bool {
+	return TableValueLookup[`0435`][string(v)]
+}
+
+// Table 0435.
+func (v Table0435) Table() Table {
+	return TableLookup[`0435`]
+}
+
+// Table0436 is a value of table 0436, Sensitivity to Causative Agent Code.
+// Fields in this table are strings; convert a field, such as Table0436(v), to use the helpers.
+type Table0436 string
+
+const (
+	Table0436AdverseReactionNotOtherwiseClassified Table0436 = `AD` // Adverse Reaction (Not otherwise classified)
+	Table0436Allergy                               Table0436 = `AL` // Allergy
+	Table0436Contraindication                      Table0436 = `CT` // Contraindication
+	Table0436Intolerance                           Table0436 = `IN` // Intolerance
+	Table0436SideEffect                            Table0436 = `SE` // Side Effect
+)
+
+// Description of the value, or empty if the value is not in table 0436.
+func (v Table0436) Description() string {
+	return tableDescription(`0436`, string(v))
+}
+
+// Valid reports if the value is in table 0436.
+func (v Table0436) Valid() bool {
+	return TableValueLookup[`0436`][string(v)]
+}
+
+// Table 0436.
+func (v Table0436) Table() Table {
+	return TableLookup[`0436`]
+}
+
+// Table0437 is a value of table 0437, Alert Device Code.
+// Fields in this table are strings; convert a field, such as Table0437(v), to use the helpers.
+type Table0437 string
+
+const (
+	Table0437Bracelet   Table0437 = `B` // Bracelet
+	Table0437Necklace   Table0437 = `N` // Necklace
+	Table0437WalletCard Table0437 = `W` // Wallet Card
+)
+
+// Description of the value, or empty if the value is not in table 0437.
+func (v Table0437) Description() string {
+	return tableDescription(`0437`, string(v))
+}
+
+// Valid reports if the value is in table 0437.
+func (v Table0437) Valid() bool {
+	return TableValueLookup[`0437`][string(v)]
+}
+
+// Table 0437.
+func (v Table0437) Table() Table {
+	return TableLookup[`0437`]
+}
+
+// Table0438 is a value of table 0438, Allergy Clinical Status.
+// Fields in this table are strings; convert a field, such as Table0438(v), to use the helpers.
+type Table0438 string
+
+const (
+	Table0438ConfirmedOrVerified  Table0438 = `C` // Confirmed or verified
+	Table0438DoubtRaised          Table0438 = `D` // Doubt raised
+	Table0438Erroneous            Table0438 = `E` // Erroneous
+	Table0438ConfirmedButInactive Table0438 = `I` // Confirmed but inactive
+	Table0438Pending              Table0438 = `P` // Pending
+	Table0438Suspect              Table0438 = `S` // Suspect
+	Table0438Unconfirmed          Table0438 = `U` // Unconfirmed
+)
+
+// Description of the value, or empty if the value is not in table 0438.
+func (v Table0438) Description() string {
+	return tableDescription(`0438`, string(v))
+}
+
+// Valid reports if the value is in table 0438.
+func (v Table0438) Valid() bool {
+	return TableValueLookup[`0438`][string(v)]
+}
+
+// Table 0438.
+func (v Table0438) Table() Table {
+	return TableLookup[`0438`]
+}
+
+// Table0440 is a value of table 0440, Data Types.
+// Fields in this table are strings; convert a field, such as Table0440(v), to use the helpers.
+type Table0440 string
+
+const (
+	Table0440Address                             Table0440 = `AD`  // Address
+	Table0440AuthorizationInformation            Table0440 = `AUI` // Authorization information
+	Table0440ChargeCodeAndDate                   Table0440 = `CCD` // Charge code and date
+	Table0440ChannelCalibrationParameters        Table0440 = `CCP` // Channel calibration parameters
+	Table0440ChannelDefinition                   Table0440 = `CD`  // Channel definition
+	Table0440CodedElement                        Table0440 = `CE`  // Coded element
+	Table0440CodedElementWithFormattedValues     Table0440 = `CF`  // Coded element with formatted values
+	Table0440CompositeIDWithCheckDigit           Table0440 = `CK`  // Composite ID with check digit
+	Table0440Composite                           Table0440 = `CM`  // Composite
+	Table0440CompositeIDNumberAndName            Table0440 = `CN`  // Composite ID number and name
+	Table0440CodedWithNoExceptions               Table0440 = `CNE` // Coded with no exceptions
+	Table0440CompositeIDNumberAndNameSimplified  Table0440 = `CNN` // Composite ID number and name simplified
+	Table0440CompositePrice                      Table0440 = `CP`  // Composite price
+	Table0440CompositeQuantityWithUnits          Table0440 = `CQ`  // Composite quantity with units
+	Table0440ChannelSensitivityAndUnits          Table0440 = `CSU` // Channel sensitivity and units
+	Table0440CodedWithExceptions                 Table0440 = `CWE` // Coded with exceptions
+	Table0440ExtendedCompositeIDWithCheckDigit   Table0440 = `CX`  // Extended composite ID with check digit
+	Table0440DailyDeductibleInformation          Table0440 = `DDI` // Daily deductible information
+	Table0440DateAndInstitutionName              Table0440 = `DIN` // Date and institution name
+	Table0440DischargeToLocationAndDate          Table0440 = `DLD` // Discharge to location and date
+	Table0440DriverSLicenseNumber                Table0440 = `DLN` // Driver's license number
+	Table0440Delta                               Table0440 = `DLT` // Delta
+	Table0440DateTimeRange                       Table0440 = `DR`  // Date/time range
+	Table0440Date                                Table0440 = `DT`  // Date
+	Table0440DateTime                            Table0440 = `DTM` // Date/time
+	Table0440DayTypeAndNumber                    Table0440 = `DTN` // Day type and number
+	Table0440EncapsulatedData                    Table0440 = `ED`  // Encapsulated data
+	Table0440EntityIdentifier                    Table0440 = `EI`  // Entity identifier
+	Table0440EntityIdentifierPair                Table0440 = `EIP` // Entity identifier pair
+	Table0440ErrorLocationAndDescription         Table0440 = `ELD` // Error location and description
+	Table0440ErrorLocation                       Table0440 = `ERL` // Error location
+	Table0440FinancialClass                      Table0440 = `FC`  // Financial class
+	Table0440FamilyName                          Table0440 = `FN`  // Family name
+	Table0440FormattedText                       Table0440 = `FT`  // Formatted text
+	Table0440GeneralTimingSpecification          Table0440 = `GTS` // General timing specification
+	Table0440HierarchicDesignator                Table0440 = `HD`  // Hierarchic designator
+	Table0440InsuranceCertificationDefinition    Table0440 = `ICD` // Insurance certification definition
+	Table0440CodedValuesForHL7Tables             Table0440 = `ID`  // Coded values for HL7 tables
+	Table0440CodedValueForUserDefinedTables      Table0440 = `IS`  // Coded value for user-defined tables
+	Table0440JobCodeClass                        Table0440 = `JCC` // Job code/class
+	Table0440LocationWithAddressVariation1       Table0440 = `LA1` // Location with address variation 1
+	Table0440LocationWithAddressVariation2       Table0440 = `LA2` // Location with address variation 2
+	Table0440MultiplexedArray                    Table0440 = `MA`  // Multiplexed array
+	Table0440Money                               Table0440 = `MO`  // Money
+	Table0440MoneyAndChargeCode                  Table0440 = `MOC` // Money and charge code
+	Table0440MoneyOrPercentage                   Table0440 = `MOP` // Money or percentage
+	Table0440MessageType                         Table0440 = `MSG` // Message type
+	Table0440NumericArray                        Table0440 = `NA`  // Numeric array
+	Table0440NameWithDateAndLocation             Table0440 = `NDL` // Name with date and location
+	Table0440Numeric                             Table0440 = `NM`  // Numeric
+	Table0440NumericRange                        Table0440 = `NR`  // Numeric range
+	Table0440OccurrenceCodeAndDate               Table0440 = `OCD` // Occurrence code and date
+	Table0440OrderSequenceDefinition             Table0440 = `OSD` // Order sequence definition
+	Table0440OccurrenceSpanCodeAndDate           Table0440 = `OSP` // Occurrence span code and date
+	Table0440PractitionerInstitutionalPrivileges Table0440 = `PIP` // Practitioner institutional privileges
+	Table0440PersonLocation                      Table0440 = `PL`  // Person location
+	Table0440PractitionerLicenseOrOtherIDNumber  Table0440 = `PLN` // Practitioner license or other ID number
+	Table0440PersonName                          Table0440 = `PN`  // Person name
+	Table0440PerformingPersonTimeStamp           Table0440 = `PPN` // Performing person time stamp
+	Table0440ParentResultLink                    Table0440 = `PRL` // Parent result link
+	Table0440ProcessingType                      Table0440 = `PT`  // Processing type
+	Table0440PolicyTypeAndAmount                 Table0440 = `PTA` // Policy type and amount
+	Table0440QueryInputParameterList             Table0440 = `QIP` // Query input parameter list
+	Table0440QuerySelectionCriteria              Table0440 = `QSC` // Query selection criteria
+	Table0440RowColumnDefinition                 Table0440 = `RCD` // Row column definition
+	Table0440ReferenceRange                      Table0440 = `RFR` // Reference range
+	Table0440RepeatInterval                      Table0440 = `RI`  // Repeat interval
+	Table0440RoomCoverage                        Table0440 = `RMC` // Room coverage
+	Table0440ReferencePointer                    Table0440 = `RP`  // Reference pointer
+	Table0440RepeatPattern                       Table0440 = `RPT` // Repeat pattern
+	Table0440StreetAddress                       Table0440 = `SAD` // Street Address
+	Table0440SchedulingClassValuePair            Table0440 = `SCV` // Scheduling class value pair
+	Table0440SequenceID                          Table0440 = `SI`  // Sequence ID
+	Table0440StructuredNumeric                   Table0440 = `SN`  // Structured numeric
+	Table0440StringOfTelephoneNumberDigits       Table0440 = `SNM` // String of telephone number digits
+	Table0440SpecialtyDescription                Table0440 = `SPD` // Specialty description
+	Table0440SpecimenSource                      Table0440 = `SPS` // Specimen source
+	Table0440SortOrder                           Table0440 = `SRT` // Sort order
+	Table0440StringData                          Table0440 = `ST`  // String data
+	Table0440Time                                Table0440 = `TM`  // Time
+	Table0440TelephoneNumber                     Table0440 = `TN`  // Telephone number
+	Table0440TimingQuantity                      Table0440 = `TQ`  // Timing/quantity
+	Table0440TimeStamp                           Table0440 = `TS`  // Time stamp
+	Table0440TextData                            Table0440 = `TX`  // Text data
+	Table0440UBValueCodeAndAmount                Table0440 = `UVC` // UB value code and amount
+	Table0440VisitingHours                       Table0440 = `VH`  // Visiting hours
+	Table0440VersionIdentifier                   Table0440 = `VID` // Version identifier
+	Table0440ValueRange                          Table0440 = `VR`  // Value range
+	Table0440ChannelIdentifier                   Table0440 = `WVI` // Channel Identifier
+	Table0440WaveformSource                      Table0440 = `WVS` // Waveform source
+	Table0440ExtendedAddress                     Table0440 = `XAD` // Extended address
+	Table0440ExtendedCompositeIDNumberAndName    Table0440 = `XCN` // Extended composite ID number and name for persons
+	Table0440ExtendedCompositeNameAndIDNumber    Table0440 = `XON` // Extended composite name and ID number for organizations
+	Table0440ExtendedPersonName                  Table0440 = `XPN` // Extended person name
+	Table0440ExtendedTelecommunicationsNumber    Table0440 = `XTN` // Extended telecommunications number
+)
+
+// Description of the value, or empty if the value is not in table 0440.
+func (v Table0440) Description() string {
+	return tableDescription(`0440`, string(v))
+}
+
+// Valid reports if the value is in table 0440.
+func (v Table0440) Valid() bool {
+	return TableValueLookup[`0440`][string(v)]
+}
+
+// Table 0440.
+func (v Table0440) Table() Table {
+	return TableLookup[`0440`]
+}
+
+// Table0441 is a value of table 0441, Immunization Registry Status.
+// Fields in this table are strings; convert a field, such as Table0441(v), to use the helpers.
+type Table0441 string
+
+const (
+	Table0441Active                                     Table0441 = `A` // Active
+	Table0441Inactive                                   Table0441 = `I` // Inactive
+	Table0441InactiveLostToFollowUpCancel               Table0441 = `L` // Inactive - Lost to follow-up (cancel contract)
+	Table0441InactiveMovedOrGoneElsewhereCancel         Table0441 = `M` // Inactive - Moved or gone elsewhere (cancel contract)
+	Table0441Other                                      Table0441 = `O` // Other
+	Table0441InactivePermanentlyInactiveDoNotReactivate Table0441 = `P` // Inactive - Permanently inactive (Do not reactivate or add new entries to the record)
+	Table0441Unknown                                    Table0441 = `U` // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0441.
+func (v Table0441) Description() string {
+	return tableDescription(`0441`, string(v))
+}
+
+// Valid reports if the value is in table 0441.
+func (v Table0441) Valid() bool {
+	return TableValueLookup[`0441`][string(v)]
+}
+
+// Table 0441.
+func (v Table0441) Table() Table {
+	return TableLookup[`0441`]
+}
+
+// Table0442 is a value of table 0442, Location Service Code.
+// Fields in this table are strings; convert a field, such as Table0442(v), to use the helpers.
+type Table0442 string
+
+const (
+	Table0442Diagnostic            Table0442 = `D` // Diagnostic
+	Table0442EmergencyRoomCasualty Table0442 = `E` // Emergency Room Casualty
+	Table0442PrimaryCare           Table0442 = `P` // Primary Care
+	Table0442Therapeutic           Table0442 = `T` // Therapeutic
+)
+
+// Description of the value, or empty if the value is not in table 0442.
+func (v Table0442) Description() string {
+	return tableDescription(`0442`, string(v))
+}
+
+// Valid reports if the value is in table 0442.
+func (v Table0442) Valid() bool {
+	return TableValueLookup[`0442`][string(v)]
+}
+
+// Table 0442.
+func (v Table0442) Table() Table {
+	return TableLookup[`0442`]
+}
+
+// Table0443 is a value of table 0443, Provider Role.
+// Fields in this table are strings; convert a field, such as Table0443(v), to use the helpers.
+type Table0443 string
+
+const (
+	Table0443Admitting                                 Table0443 = `AD`   // Admitting
+	Table0443AssistantAlternateInterpreter             Table0443 = `AI`   // Assistant/Alternate Interpreter
+	Table0443AdministeringProvider                     Table0443 = `AP`   // Administering Provider
+	Table0443Attending                                 Table0443 = `AT`   // Attending
+	Table0443CollectingProvider                        Table0443 = `CLP`  // Collecting Provider
+	Table0443ConsultingProvider                        Table0443 = `CP`   // Consulting Provider
+	Table0443DispensingProvider                        Table0443 = `DP`   // Dispensing Provider
+	Table0443EnteringProviderProbablyNotTheSame        Table0443 = `EP`   // Entering Provider (probably not the same as transcriptionist?)
+	Table0443FamilyHealthCareProfessional              Table0443 = `FHCP` // Family Health Care Professional
+	Table0443InitiatingProviderAsInActionBy            Table0443 = `IP`   // Initiating Provider (as in action by)
+	Table0443MedicalDirector                           Table0443 = `MDIR` // Medical Director
+	Table0443OrderingProvider                          Table0443 = `OP`   // Ordering Provider
+	Table0443PharmacistNotSureHowToDissect             Table0443 = `PH`   // Pharmacist   (not sure how to dissect Pharmacist/Treatment Supplier's Verifier ID)
+	Table0443PrimaryInterpreter                        Table0443 = `PI`   // Primary Interpreter
+	Table0443PrimaryCareProvider                       Table0443 = `PP`   // Primary Care Provider
+	Table0443ResponsibleObserver                       Table0443 = `RO`   // Responsible Observer
+	Table0443ReferringProvider                         Table0443 = `RP`   // Referring Provider
+	Table0443ReferredToProvider                        Table0443 = `RT`   // Referred to Provider
+	Table0443Technician                                Table0443 = `TN`   // Technician
+	Table0443Transcriptionist                          Table0443 = `TR`   // Transcriptionist
+	Table0443VerifyingProvider                         Table0443 = `VP`   // Verifying Provider
+	Table0443VerifyingPharmaceuticalSupplierNotSureHow Table0443 = `VPS`  // Verifying Pharmaceutical Supplier   (not sure how to dissect Pharmacist/Treatment Supplier's Verifier ID)
+	Table0443VerifyingTreatmentSupplierNotSureHow      Table0443 = `VTS`  // Verifying Treatment Supplier   (not sure how to dissect Pharmacist/Treatment Supplier's Verifier ID)
+)
+
+// Description of the value, or empty if the value is not in table 0443.
+func (v Table0443) Description() string {
+	return tableDescription(`0443`, string(v))
+}
+
+// Valid reports if the value is in table 0443.
+func (v Table0443) Valid() bool {
+	return TableValueLookup[`0443`][string(v)]
+}
+
+// Table 0443.
+func (v Table0443) Table() Table {
+	return TableLookup[`0443`]
+}
+
+// Table0444 is a value of table 0444, Name Assembly Order.
+// Fields in this table are strings; convert a field, such as Table0444(v), to use the helpers.
+type Table0444 string
+
+const (
+	Table0444PrefixFamilyMiddleGivenSuffix Table0444 = `F` // Prefix Family Middle Given Suffix
+	Table0444PrefixGivenMiddleFamilySuffix Table0444 = `G` // Prefix Given Middle Family Suffix
+)
+
+// Description of the value, or empty if the value is not in table 0444.
+func (v Table0444) Description() string {
+	return tableDescription(`0444`, string(v))
+}
+
+// Valid reports if the value is in table 0444.
+func (v Table0444) Valid() bool {
+	return TableValueLookup[`0444`][string(v)]
+}
+
+// Table 0444.
+func (v Table0444) Table() Table {
+	return TableLookup[`0444`]
+}
+
+// Table0445 is a value of table 0445, Identity Reliability Code.
+// Fields in this table are strings; convert a field, such as Table0445(v), to use the helpers.
+type Table0445 string
+
+const (
+	Table0445PatientPersonNameIsAnAlias         Table0445 = `AL` // Patient/Person Name is an Alias
+	Table0445UnknownDefaultAddress              Table0445 = `UA` // Unknown/Default Address
+	Table0445UnknownDefaultDateOfBirth          Table0445 = `UD` // Unknown/Default Date of Birth
+	Table0445UnknownDefaultSocialSecurityNumber Table0445 = `US` // Unknown/Default Social Security Number
+)
+
+// Description of the value, or empty if the value is not in table 0445.
+func (v Table0445) Description() string {
+	return tableDescription(`0445`, string(v))
+}
+
+// Valid reports if the value is in table 0445.
+func (v Table0445) Valid() bool {
+	return TableValueLookup[`0445`][string(v)]
+}
+
+// Table 0445.
+func (v Table0445) Table() Table {
+	return TableLookup[`0445`]
+}
+
+// Table0450 is a value of table 0450, Event Type.
+// Fields in this table are strings; convert a field, such as Table0450(v), to use the helpers.
+type Table0450 string
+
+const (
+	Table0450LogEvent     Table0450 = `LOG` // Log Event
+	Table0450ServiceEvent Table0450 = `SER` // Service Event
+)
+
+// Description of the value, or empty if the value is not in table 0450.
+func (v Table0450) Description() string {
+	return tableDescription(`0450`, string(v))
+}
+
+// Valid reports if the value is in table 0450.
+func (v Table0450) Valid() bool {
+	return TableValueLookup[`0450`][string(v)]
+}
+
+// Table 0450.
+func (v Table0450) Table() Table {
+	return TableLookup[`0450`]
+}
+
+// Table0451 is a value of table 0451, Substance Identifier.
+// Fields in this table are strings; convert a field, such as Table0451(v), to use the helpers.
+type Table0451 string
+
+const (
+	Table0451UsedForQueryOfAllInventory Table0451 = `ALL` // Used for query of all inventory items
+)
+
+// Description of the value, or empty if the value is not in table 0451.
+func (v Table0451) Description() string {
+	return tableDescription(`0451`, string(v))
+}
+
+// Valid reports if the value is in table 0451.
+func (v Table0451) Valid() bool {
+	return TableValueLookup[`0451`][string(v)]
+}
+
+// Table 0451.
+func (v Table0451) Table() Table {
+	return TableLookup[`0451`]
+}
+
+// Table0452 is a value of table 0452, Health Care Provider Type Code.
+// Fields in this table are strings; convert a field, such as Table0452(v), to use the helpers.
+type Table0452 string
+
+const (
+	Table0452ANSIASCX12HealthCareProvider Table0452 = `SUGGESTION` // ANSI ASC X12 Health Care Provider Taxonomy, Level 1 - Type
+)
+
+// Description of the value, or empty if the value is not in table 0452.
+func (v Table0452) Description() string {
+	return tableDescription(`0452`, string(v))
+}
+
+// Valid reports if the value is in table 0452.
+func (v Table0452) Valid() bool {
+	return TableValueLookup[`0452`][string(v)]
+}
+
+// Table 0452.
+func (v Table0452) Table() Table {
+	return TableLookup[`0452`]
+}
+
+// Table0453 is a value of table 0453, Health Care Provider Classification.
+// Fields in this table are strings; convert a field, such as Table0453(v), to use the helpers.
+type Table0453 string
+
+const (
+	Table0453ANSIASCX12HealthCareProvider Table0453 = `SUGGESTION` // ANSI ASC X12 Health Care Provider Taxonomy, Level 2 -  Classification
+)
+
+// Description of the value, or empty if the value is not in table 0453.
+func (v Table0453) Description() string {
+	return tableDescription(`0453`, string(v))
+}
+
+// Valid reports if the value is in table 0453.
+func (v Table0453) Valid() bool {
+	return TableValueLookup[`0453`][string(v)]
+}
+
+// Table 0453.
+func (v Table0453) Table() Table {
+	return TableLookup[`0453`]
+}
+
+// Table0454 is a value of table 0454, Health Care Provider Area of Specialization.
+// Fields in this table are strings; convert a field, such as Table0454(v), to use the helpers.
+type Table0454 string
+
+const (
+	Table0454ANSIASCX12HealthCareProvider Table0454 = `SUGGESTION` // ANSI ASC X12 Health Care Provider Taxonomy, Level 3 - specialization
+)
+
+// Description of the value, or empty if the value is not in table 0454.
+func (v Table0454) Description() string {
+	return tableDescription(`0454`, string(v))
+}
+
+// Valid reports if the value is in table 0454.
+func (v Table0454) Valid() bool {
+	return TableValueLookup[`0454`][string(v)]
+}
+
+// Table 0454.
+func (v Table0454) Table() Table {
+	return TableLookup[`0454`]
+}
+
+// Table0457 is a value of table 0457, Overall Claim Disposition Code.
+// Fields in this table are strings; convert a field, such as Table0457(v), to use the helpers.
+type Table0457 string
+
+const (
+	Table0457NoEditsPresentOnClaim                    Table0457 = `0` // No edits present on claim
+	Table0457OnlyEditsPresentAreForLine               Table0457 = `1` // Only edits present are for line item denial or rejection
+	Table0457MultipleDayClaimWithOneOr                Table0457 = `2` // Multiple-day claim with one or more days denied or rejected
+	Table0457ClaimDeniedRejectedSuspendedOrReturned   Table0457 = `3` // Claim denied, rejected, suspended or returned to provider with only post payment edits
+	Table0457ClaimDeniedRejectedSuspendedOrReturned_4 Table0457 = `4` // Claim denied, rejected, suspended or returned to provider with only pre payment edits
+)
+
+// Description of the value, or empty if the value is not in table 0457.
+func (v Table0457) Description() string {
+	return tableDescription(`0457`, string(v))
+}
+
+// Valid reports if the value is in table 0457.
+func (v Table0457) Valid() bool {
+	return TableValueLookup[`0457`][string(v)]
+}
+
+// Table 0457.
+func (v Table0457) Table() Table {
+	return TableLookup[`0457`]
+}
+
+// Table0458 is a value of table 0458, OCE Edit Code.
+// Fields in this table are strings; convert a field, such as Table0458(v), to use the helpers.
+type Table0458 string
+
+const (
+	Table0458InvalidDiagnosisCode                               Table0458 = `1`   // Invalid diagnosis code
+	Table0458NonCoveredServiceSubmittedForVerification          Table0458 = `10`  // Non-covered  service submitted for verification of denial (condition code 21 from header information on claim)
+	Table0458NonCoveredServiceSubmittedForFI                    Table0458 = `11`  // Non-covered service submitted for FI review (condition code 20 from header information on claim)
+	Table0458QuestionableCoveredService                         Table0458 = `12`  // Questionable covered service
+	Table0458AdditionalPaymentForServiceNotProvided             Table0458 = `13`  // Additional payment for service not provided by Medicare
+	Table0458CodeIndicatesASiteOfService                        Table0458 = `14`  // Code indicates a site of service not included in OPPS
+	Table0458ServiceUnitOutOfRangeFor                           Table0458 = `15`  // Service unit out of range for procedure
+	Table0458MultipleBilateralProceduresWithoutModifier50       Table0458 = `16`  // Multiple bilateral procedures without modifier 50 (see Appendix A)
+	Table0458MultipleBilateralProceduresWithModifier50          Table0458 = `17`  // Multiple bilateral procedures with modifier 50 (see Appendix A)
+	Table0458InpatientProcedure                                 Table0458 = `18`  // Inpatient procedure
+	Table0458MutuallyExclusiveProcedureThatIsNot                Table0458 = `19`  // Mutually exclusive procedure that is not allowed even if appropriate modifier present
+	Table0458DiagnosisAndAgeConflict                            Table0458 = `2`   // Diagnosis and age conflict
+	Table0458ComponentOfAComprehensiveProcedureThat             Table0458 = `20`  // Component of a comprehensive procedure that is not allowed even if appropriate modifier present
+	Table0458MedicalVisitOnSameDayAs                            Table0458 = `21`  // Medical visit on same day as a type "T" or "S" procedure without modifier 25 (see Appendix B)
+	Table0458InvalidModifier                                    Table0458 = `22`  // Invalid modifier
+	Table0458InvalidDate                                        Table0458 = `23`  // Invalid date
+	Table0458DateOutOfOCERange                                  Table0458 = `24`  // Date out of OCE range
+	Table0458InvalidAge                                         Table0458 = `25`  // Invalid age
+	Table0458InvalidSex                                         Table0458 = `26`  // Invalid sex
+	Table0458OnlyIncidentalServicesReported                     Table0458 = `27`  // Only incidental services reported
+	Table0458CodeNotRecognizedByMedicareAlternate               Table0458 = `28`  // Code not recognized by Medicare; alternate code for same service available
+	Table0458PartialHospitalizationServiceForNonMental          Table0458 = `29`  // Partial hospitalization service for non-mental health diagnosis
+	Table0458DiagnosisAndSexConflict                            Table0458 = `3`   // Diagnosis and sex conflict
+	Table0458InsufficientServicesOnDayOfPartial                 Table0458 = `30`  // Insufficient services on day of partial hospitalization
+	Table0458PartialHospitalizationOnSameDayAs                  Table0458 = `31`  // Partial hospitalization on same day as ECT or type "T" procedure
+	Table0458PartialHospitalizationClaimSpans3Or                Table0458 = `32`  // Partial hospitalization claim spans 3 or less days with in-sufficient services, or ECT or significant procedure on at least one of the days
+	Table0458PartialHospitalizationClaimSpansMoreThan           Table0458 = `33`  // Partial hospitalization claim spans more than 3 days with insufficient number of days having mental health services
+	Table0458PartialHospitalizationClaimSpansMoreThan_34        Table0458 = `34`  // Partial hospitalization claim spans more than 3 days with insufficient number of days meeting partial hospitalization criteria
+	Table0458OnlyActivityTherapyAndOrOccupational               Table0458 = `35.` // Only activity therapy and/or occupational therapy services provided
+	Table0458ExtensiveMentalHealthServicesProvidedOn            Table0458 = `36.` // Extensive mental health services provided on day of ECT or significant procedure
+	Table0458TerminatedBilateralProcedureOrTerminatedProcedure  Table0458 = `37`  // Terminated bilateral procedure or terminated procedure with units greater than one
+	Table0458InconsistencyBetweenImplantedDeviceAndImplantation Table0458 = `38.` // Inconsistency between implanted device and implantation procedure
+	Table0458MutuallyExclusiveProcedureThatWouldBe              Table0458 = `39.` // Mutually exclusive procedure that would be allowed if appropriate modifier were present
+	Table0458MedicareSecondaryPayerAlert                        Table0458 = `4`   // Medicare secondary payer alert
+	Table0458ComponentOfAComprehensiveProcedureThat_40          Table0458 = `40.` // Component of a comprehensive procedure that would be allowed if appropriate modifier were present
+	Table0458InvalidRevenueCode                                 Table0458 = `41.` // Invalid revenue code
+	Table0458MultipleMedicalVisitsOnSameDay                     Table0458 = `42.` // Multiple medical visits on same day with same revenue code without condition code G0 (see Appendix B)
+	Table0458ECodeAsReasonForVisit                              Table0458 = `5`   // E-code as reason for visit
+	Table0458InvalidProcedureCode                               Table0458 = `6`   // Invalid procedure code
+	Table0458ProcedureAndAgeConflict                            Table0458 = `7`   // Procedure and age conflict
+	Table0458ProcedureAndSexConflict                            Table0458 = `8`   // Procedure and sex conflict
+	Table0458NovCoveredService                                  Table0458 = `9`   // Nov-covered service
+)
+
+// Description of the value, or empty if the value is not in table 0458.
+func (v Table0458) Description() string {
+	return tableDescription(`0458`, string(v))
+}
+
+// Valid reports if the value is in table 0458.
+func (v Table0458) Valid() bool {
+	return TableValueLookup[`0458`][string(v)]
+}
+
+// Table 0458.
+func (v Table0458) Table() Table {
+	return TableLookup[`0458`]
+}
+
+// Table0459 is a value of table 0459, Reimbursement Action Code.
+// Fields in this table are strings; convert a field, such as Table0459(v), to use the helpers.
+type Table0459 string
+
+const (
+	Table0459OCELineItemDenialOrRejection      Table0459 = `0` // OCE line item denial or rejection is not ignored
+	Table0459OCELineItemDenialOrRejection_1    Table0459 = `1` // OCE line item denial or rejection is ignored
+	Table0459ExternalLineItemDenialLineItem    Table0459 = `2` // External line item denial. Line item is denied even if no OCE edits
+	Table0459ExternalLineItemRejectionLineItem Table0459 = `3` // External line item rejection. Line item is rejected even if no OCE edits
+)
+
+// Description of the value, or empty if the value is not in table 0459.
+func (v Table0459) Description() string {
+	return tableDescription(`0459`, string(v))
+}
+
+// Valid reports if the value is in table 0459.
+func (v Table0459) Valid() bool {
+	return TableValueLookup[`0459`][string(v)]
+}
+
+// Table 0459.
+func (v Table0459) Table() Table {
+	return TableLookup[`0459`]
+}
+
+// Table0460 is a value of table 0460, Denial or Rejection Code.
+// Fields in this table are strings; convert a field, such as Table0460(v), to use the helpers.
+type Table0460 string
+
+const (
+	Table0460LineItemNotDeniedOrRejected Table0460 = `0` // Line item not denied or rejected
+	Table0460LineItemDeniedOrRejected    Table0460 = `1` // Line item denied or rejected
+	Table0460LineItemIsOnAMultiple       Table0460 = `2` // Line item is on a multiple-day claim. The line item is not denied or rejected, but occurs on a day that has been denied or rejected.
+)
+
+// Description of the value, or empty if the value is not in table 0460.
+func (v Table0460) Description() string {
+	return tableDescription(`0460`, string(v))
+}
+
+// Valid reports if the value is in table 0460.
+func (v Table0460) Valid() bool {
+	return TableValueLookup[`0460`][string(v)]
+}
+
+// Table 0460.
+func (v Table0460) Table() Table {
+	return TableLookup[`0460`]
+}
+
+// Table0465 is a value of table 0465, Name/Address Representation.
+// Fields in this table are strings; convert a field, such as Table0465(v), to use the helpers.
+type Table0465 string
+
+const (
+	Table0465AlphabeticIEDefaultOrSome       Table0465 = `A` // Alphabetic (i.e., Default or some single-byte)
+	Table0465IdeographicIEKanji              Table0465 = `I` // Ideographic (i.e., Kanji)
+	Table0465PhoneticIEASCIIKatakanaHiragana Table0465 = `P` // Phonetic (i.e., ASCII, Katakana, Hiragana, etc.)
+)
+
+// Description of the value, or empty if the value is not in table 0465.
+func (v Table0465) Description() string {
+	return tableDescription(`0465`, string(v))
+}
+
+// Valid reports if the value is in table 0465.
+func (v Table0465) Valid() bool {
+	return TableValueLookup[`0465`][string(v)]
+}
+
+// Table 0465.
+func (v Table0465) Table() Table {
+	return TableLookup[`0465`]
+}
+
+// Table0466 is a value of table 0466, Ambulatory Payment Classification Code.
+// Fields in this table are strings; convert a field, such as Table0466(v), to use the helpers.
+type Table0466 string
+
+const (
+	Table0466DentalProcedures Table0466 = `031` // Dental procedures
+	Table0466ExcisionBiopsy   Table0466 = `163` // Excision/biopsy
+	Table0466Level1SkinRepair Table0466 = `181` // Level 1 skin repair.
+)
+
+// Description of the value, or empty if the value is not in table 0466.
+func (v Table0466) Description() string {
+	return tableDescription(`0466`, string(v))
+}
+
+// Valid reports if the value is in table 0466.
+func (v Table0466) Valid() bool {
+	return TableValueLookup[`0466`][string(v)]
+}
+
+// Table 0466.
+func (v Table0466) Table() Table {
+	return TableLookup[`0466`]
+}
+
+// Table0467 is a value of table 0467, Modifier Edit Code.
+// Fields in this table are strings; convert a field, such as Table0467(v), to use the helpers.
+type Table0467 string
+
+const (
+	Table0467ModifierDoesNOTExist          Table0467 = `0` // Modifier does NOT exist
+	Table0467ModifierPresentNoErrors       Table0467 = `1` // Modifier present, no errors
+	Table0467ModifierInvalid               Table0467 = `2` // Modifier invalid
+	Table0467ModifierNOTApprovedForASCHOPD Table0467 = `3` // Modifier NOT approved for ASC/HOPD use
+	Table0467ModifierApprovedForASCHOPDUse Table0467 = `4` // Modifier approved for ASC/HOPD use, inappropriate for code
+	Table0467ModifierEditCodeUnknown       Table0467 = `U` // Modifier edit code unknown
+)
+
+// Description of the value, or empty if the value is not in table 0467.
+func (v Table0467) Description() string {
+	return tableDescription(`0467`, string(v))
+}
+
+// Valid reports if the value is in table 0467.
+func (v Table0467) Valid() bool {
+	return TableValueLookup[`0467`][string(v)]
+}
+
+// Table 0467.
+func (v Table0467) Table() Table {
+	return TableLookup[`0467`]
+}
+
+// Table0468 is a value of table 0468, Payment Adjustment Code.
+// Fields in this table are strings; convert a field, such as Table0468(v), to use the helpers.
+type Table0468 string
+
+const (
+	Table0468NoPaymentAdjustment                         Table0468 = `1` // No payment adjustment
+	Table0468DesignatedCurrentDrugOrBiologicalPayment    Table0468 = `2` // Designated current drug or biological payment adjustment applies to APC (status indicator G)
+	Table0468DesignatedNewDevicePaymentAdjustmentApplies Table0468 = `3` // Designated new device payment adjustment applies to APC (status indicator H)
+	Table0468DesignatedNewDrugOrNewBiological            Table0468 = `4` // Designated new drug or new biological payment adjustment applies to APC (status indicator J)
+	Table0468DeductibleNotApplicableSpecificListOf       Table0468 = `5` // Deductible not applicable (specific list of HCPCS codes)
+)
+
+// Description of the value, or empty if the value is not in table 0468.
+func (v Table0468) Description() string {
+	return tableDescription(`0468`, string(v))
+}
+
+// Valid reports if the value is in table 0468.
+func (v Table0468) Valid() bool {
+	return TableValueLookup[`0468`][string(v)]
+}
+
+// Table 0468.
+func (v Table0468) Table() Table {
+	return TableLookup[`0468`]
+}
+
+// Table0469 is a value of table 0469, Packaging Status Code.
+// Fields in this table are strings; convert a field, such as Table0469(v), to use the helpers.
+type Table0469 string
+
+const (
+	Table0469NotPackaged                            Table0469 = `0` // Not packaged
+	Table0469PackagedServiceStatusIndicatorNOr      Table0469 = `1` // Packaged service (status indicator N, or no HCPCS code and certain revenue codes)
+	Table0469PackagedAsPartOfPartialHospitalization Table0469 = `2` // Packaged as part of partial hospitalization per diem or daily mental health service per diem
+)
+
+// Description of the value, or empty if the value is not in table 0469.
+func (v Table0469) Description() string {
+	return tableDescription(`0469`, string(v))
+}
+
+// Valid reports if the value is in table 0469.
+func (v Table0469) Valid() bool {
+	return TableValueLookup[`0469`][string(v)]
+}
+
+// Table 0469.
+func (v Table0469) Table() Table {
+	return TableLookup[`0469`]
+}
+
+// Table0470 is a value of table 0470, Reimbursement Type Code.
+// Fields in this table are strings; convert a field, such as Table0470(v), to use the helpers.
+type Table0470 string
+
+const (
+	Table0470CornealTissueAPC                   Table0470 = `Crnl`  // Corneal Tissue APC
+	Table0470DurableMedicalEquipment            Table0470 = `DME`   // Durable Medical Equipment
+	Table0470Epotein                            Table0470 = `EPO`   // Epotein
+	Table0470ClinicalLaboratoryAPC              Table0470 = `Lab`   // Clinical Laboratory APC
+	Table0470ScreeningMammographyAPC            Table0470 = `Mamm`  // Screening Mammography APC
+	Table0470ThisAPCIsNotPaid                   Table0470 = `NoPay` // This APC is not paid
+	Table0470OutpatientProspectivePaymentSystem Table0470 = `OPPS`  // Outpatient Prospective Payment System
+	Table0470PartialHospitalizationAPC          Table0470 = `PartH` // Partial Hospitalization APC
+	Table0470PackagedAPC                        Table0470 = `Pckg`  // Packaged APC
+	Table0470TherapyAPC                         Table0470 = `Thrpy` // Therapy APC
+)
+
+// Description of the value, or empty if the value is not in table 0470.
+func (v Table0470) Description() string {
+	return tableDescription(`0470`, string(v))
+}
+
+// Valid reports if the value is in table 0470.
+func (v Table0470) Valid() bool {
+	return TableValueLookup[`0470`][string(v)]
+}
+
+// Table 0470.
+func (v Table0470) Table() Table {
+	return TableLookup[`0470`]
+}
+
+// Table0472 is a value of table 0472, TQ Conjunction ID.
+// Fields in this table are strings; convert a field, such as Table0472(v), to use the helpers.
+type Table0472 string
+
+const (
+	Table0472Asynchronous  Table0472 = `A` // Asynchronous
+	Table0472ActuationTime Table0472 = `C` // Actuation Time
+	Table0472Synchronous   Table0472 = `S` // Synchronous
+)
+
+// Description of the value, or empty if the value is not in table 0472.
+func (v Table0472) Description() string {
+	return tableDescription(`0472`, string(v))
+}
+
+// Valid reports if the value is in table 0472.
+func (v Table0472) Valid() bool {
+	return TableValueLookup[`0472`][string(v)]
+}
+
+// Table 0472.
+func (v Table0472) Table() Table {
+	return TableLookup[`0472`]
+}
+
+// Table0473 is a value of table 0473, Formulary Status.
+// Fields in this table are strings; convert a field, such as Table0473(v), to use the helpers.
+type Table0473 string
+
+const (
+	Table0473ThisObservationServiceIsOnThe   Table0473 = `G` // This observation/service is on the formulary, and has guidelines
+	Table0473ThisObservationServiceIsNotOn   Table0473 = `N` // This observation/service is not on the formulary
+	Table0473ThisObservationServiceIsOnThe_R Table0473 = `R` // This observation/service is on the formulary, but is restricted
+	Table0473ThisObservationServiceIsOnThe_Y Table0473 = `Y` // This observation/service is on the formulary
+)
+
+// Description of the value, or empty if the value is not in table 0473.
+func (v Table0473) Description() string {
+	return tableDescription(`0473`, string(v))
+}
+
+// Valid reports if the value is in table 0473.
+func (v Table0473) Valid() bool {
+	return TableValueLookup[`0473`][string(v)]
+}
+
+// Table 0473.
+func (v Table0473) Table() Table {
+	return TableLookup[`0473`]
+}
+
+// Table0474 is a value of table 0474, Organization Unit Type.
+// Fields in this table are strings; convert a field, such as Table0474(v), to use the helpers.
+type Table0474 string
+
+const (
+	Table0474Department    Table0474 = `D` // Department
+	Table0474Facility      Table0474 = `F` // Facility
+	Table0474Subdivision   Table0474 = `S` // Subdivision
+	Table0474Subdepartment Table0474 = `U` // Subdepartment
+	Table0474Division      Table0474 = `V` // Division
+)
+
+// Description of the value, or empty if the value is not in table 0474.
+func (v Table0474) Description() string {
+	return tableDescription(`0474`, string(v))
+}
+
+// Valid reports if the value is in table 0474.
+func (v Table0474) Valid() bool {
+	return TableValueLookup[`0474`][string(v)]
+}
+
+// Table 0474.
+func (v Table0474) Table() Table {
+	return TableLookup[`0474`]
+}
+
+// Table0475 is a value of table 0475, Charge Type Reason.
+// Fields in this table are strings; convert a field, such as Table0475(v), to use the helpers.
+type Table0475 string
+
+const (
+	Table0475Allergy          Table0475 = `01` // Allergy
+	Table0475Intolerance      Table0475 = `02` // Intolerance
+	Table0475TreatmentFailure Table0475 = `03` // Treatment Failure
+	Table0475PatientRequest   Table0475 = `04` // Patient Request
+	Table0475NoException      Table0475 = `05` // No Exception
+)
+
+// Description of the value, or empty if the value is not in table 0475.
+func (v Table0475) Description() string {
+	return tableDescription(`0475`, string(v))
+}
+
+// Valid reports if the value is in table 0475.
+func (v Table0475) Valid() bool {
+	return TableValueLookup[`0475`][string(v)]
+}
+
+// Table 0475.
+func (v Table0475) Table() Table {
+	return TableLookup[`0475`]
+}
+
+// Table0477 is a value of table 0477, Controlled Substance Schedule*.
+// Fields in this table are strings; convert a field, such as Table0477(v), to use the helpers.
+type Table0477 string
+
+const (
+	Table0477ScheduleI   Table0477 = `I`   // Schedule I
+	Table0477ScheduleII  Table0477 = `II`  // Schedule II
+	Table0477ScheduleIII Table0477 = `III` // Schedule III
+	Table0477ScheduleIV  Table0477 = `IV`  // Schedule IV
+	Table0477ScheduleV   Table0477 = `V`   // Schedule V
+	Table0477ScheduleVI  Table0477 = `VI`  // Schedule VI
+)
+
+// Description of the value, or empty if the value is not in table 0477.
+func (v Table0477) Description() string {
+	return tableDescription(`0477`, string(v))
+}
+
+// Valid reports if the value is in table 0477.
+func (v Table0477) Valid() bool {
+	return TableValueLookup[`0477`][string(v)]
+}
+
+// Table 0477.
+func (v Table0477) Table() Table {
+	return TableLookup[`0477`]
+}
+
+// Table0478 is a value of table 0478, Formulary Status.
+// Fields in this table are strings; convert a field, such as Table0478(v), to use the helpers.
+type Table0478 string
+
+const (
+	Table0478PharmaceuticalSubstanceIsInTheFormulary   Table0478 = `G` // Pharmaceutical substance is in the formulary, but guidelines apply
+	Table0478PharmaceuticalSubstanceIsNOTInThe         Table0478 = `N` // Pharmaceutical substance is NOT in the formulary
+	Table0478PharmaceuticalSubstanceIsInTheFormulary_R Table0478 = `R` // Pharmaceutical substance is in the formulary, but restrictions apply
+	Table0478PharmaceuticalSubstanceIsInTheFormulary_Y Table0478 = `Y` // Pharmaceutical substance is in the formulary
+)
+
+// Description of the value, or empty if the value is not in table 0478.
+func (v Table0478) Description() string {
+	return tableDescription(`0478`, string(v))
+}
+
+// Valid reports if the value is in table 0478.
+func (v Table0478) Valid() bool {
+	return TableValueLookup[`0478`][string(v)]
+}
+
+// Table 0478.
+func (v Table0478) Table() Table {
+	return TableLookup[`0478`]
+}
+
+// Table0480 is a value of table 0480, Pharmacy Order Types.
+// Fields in this table are strings; convert a field, such as Table0480(v), to use the helpers.
+type Table0480 string
+
+const (
+	Table0480Medication                      Table0480 = `M` // Medication
+	Table0480OtherSolutionAsMedicationOrders Table0480 = `O` // Other solution as medication orders
+	Table0480IVLargeVolumeSolutions          Table0480 = `S` // IV Large Volume Solutions
+)
+
+// Description of the value, or empty if the value is not in table 0480.
+func (v Table0480) Description() string {
+	return tableDescription(`0480`, string(v))
+}
+
+// Valid reports if the value is in table 0480.
+func (v Table0480) Valid() bool {
+	return TableValueLookup[`0480`][string(v)]
+}
+
+// Table 0480.
+func (v Table0480) Table() Table {
+	return TableLookup[`0480`]
+}
+
+// Table0482 is a value of table 0482, Order Type.
+// Fields in this table are strings; convert a field, such as Table0482(v), to use the helpers.
+type Table0482 string
+
+const (
+	Table0482InpatientOrder  Table0482 = `I` // Inpatient Order
+	Table0482OutpatientOrder Table0482 = `O` // Outpatient Order
+)
+
+// Description of the value, or empty if the value is not in table 0482.
+func (v Table0482) Description() string {
+	return tableDescription(`0482`, string(v))
+}
+
+// Valid reports if the value is in table 0482.
+func (v Table0482) Valid() bool {
+	return TableValueLookup[`0482`][string(v)]
+}
+
+// Table 0482.
+func (v Table0482) Table() Table {
+	return TableLookup[`0482`]
+}
+
+// Table0483 is a value of table 0483, Authorization Mode.
+// Fields in this table are strings; convert a field, such as Table0483(v), to use the helpers.
+type Table0483 string
+
+const (
+	Table0483Electronic               Table0483 = `EL` // Electronic
+	Table0483EMail                    Table0483 = `EM` // E-mail
+	Table0483Fax                      Table0483 = `FX` // Fax
+	Table0483InPerson                 Table0483 = `IP` // In Person
+	Table0483Mail                     Table0483 = `MA` // Mail
+	Table0483Paper                    Table0483 = `PA` // Paper
+	Table0483Phone                    Table0483 = `PH` // Phone
+	Table0483ReflexiveAutomatedSystem Table0483 = `RE` // Reflexive (Automated system)
+	Table0483VideoConference          Table0483 = `VC` // Video-conference
+	Table0483Voice                    Table0483 = `VO` // Voice
+)
+
+// Description of the value, or empty if the value is not in table 0483.
+func (v Table0483) Description() string {
+	return tableDescription(`0483`, string(v))
+}
+
+// Valid reports if the value is in table 0483.
+func (v Table0483) Valid() bool {
+	return TableValueLookup[`0483`][string(v)]
+}
+
+// Table 0483.
+func (v Table0483) Table() Table {
+	return TableLookup[`0483`]
+}
+
+// Table0484 is a value of table 0484, Dispense Type.
+// Fields in this table are strings; convert a field, such as Table0484(v), to use the helpers.
+type Table0484 string
+
+const (
+	Table0484TrialQuantityBalance Table0484 = `B` // Trial Quantity Balance
+	Table0484CompassionateFill    Table0484 = `C` // Compassionate Fill
+	Table0484NewRenewFullFill     Table0484 = `N` // New/Renew - Full Fill
+	Table0484NewRenewPartFill     Table0484 = `P` // New/Renew - Part Fill
+	Table0484RefillPartFill       Table0484 = `Q` // Refill - Part Fill
+	Table0484RefillFullFill       Table0484 = `R` // Refill - Full Fill
+	Table0484ManufacturerSample   Table0484 = `S` // Manufacturer Sample
+	Table0484TrialQuantity        Table0484 = `T` // Trial Quantity
+	Table0484NonPrescriptionFill  Table0484 = `Z` // Non-Prescription Fill
+)
+
+// Description of the value, or empty if the value is not in table 0484.
+func (v Table0484) Description() string {
+	return tableDescription(`0484`, string(v))
+}
+
+// Valid reports if the value is in table 0484.
+func (v Table0484) Valid() bool {
+	return TableValueLookup[`0484`][string(v)]
+}
+
+// Table 0484.
+func (v Table0484) Table() Table {
+	return TableLookup[`0484`]
+}
+
+// Table0485 is a value of table 0485, Extended Priority Codes.
+// Fields in this table are strings; convert a field, such as Table0485(v), to use the helpers.
+type Table0485 string
+
+const (
+	Table0485ASAP           Table0485 = `A`   // ASAP
+	Table0485Callback       Table0485 = `C`   // Callback
+	Table0485Preop          Table0485 = `P`   // Preop
+	Table0485AsNeeded       Table0485 = `PRN` // As needed
+	Table0485Routine        Table0485 = `R`   // Routine
+	Table0485Stat           Table0485 = `S`   // Stat
+	Table0485TimingCritical Table0485 = `T`   // Timing critical
+	Table0485TDInteger      Table0485 = `TD<integer>`
+	Table0485THInteger      Table0485 = `TH<integer>`
+	Table0485TLInteger      Table0485 = `TL<integer>`
+	Table0485TMInteger      Table0485 = `TM<integer>`
+	Table0485TSInteger      Table0485 = `TS<integer>`
+	Table0485TWInteger      Table0485 = `TW<integer>`
+)
+
+// Description of the value, or empty if the value is not in table 0485.
+func (v Table0485) Description() string {
+	return tableDescription(`0485`, string(v))
+}
+
+// Valid reports if the value is in table 0485.
+func (v Table0485) Valid() bool {
+	return TableValueLookup[`0485`][string(v)]
+}
+
+// Table 0485.
+func (v Table0485) Table() Table {
+	return TableLookup[`0485`]
+}
+
+// Table0487 is a value of table 0487, Specimen Type.
+// Fields in this table are strings; convert a field, such as Table0487(v), to use the helpers.
+type Table0487 string
+
+const (
+	Table0487Abscess                            Table0487 = `ABS`    // Abscess
+	Table0487TissueAcne                         Table0487 = `ACNE`   // Tissue, Acne
+	Table0487FluidAcne                          Table0487 = `ACNFLD` // Fluid, Acne
+	Table0487AirSample                          Table0487 = `AIRS`   // Air Sample
+	Table0487Allograft                          Table0487 = `ALL`    // Allograft
+	Table0487Amputation                         Table0487 = `AMP`    // Amputation
+	Table0487CatheterTipAngio                   Table0487 = `ANGI`   // Catheter Tip, Angio
+	Table0487CatheterTipArterial                Table0487 = `ARTC`   // Catheter Tip, Arterial
+	Table0487SerumAcute                         Table0487 = `ASERU`  // Serum, Acute
+	Table0487Aspirate                           Table0487 = `ASP`    // Aspirate
+	Table0487EnvironmentAttest                  Table0487 = `ATTE`   // Environment, Attest
+	Table0487EnvironmentalAutoclaveAmpule       Table0487 = `AUTOA`  // Environmental, Autoclave Ampule
+	Table0487EnvironmentalAutoclaveCapsule      Table0487 = `AUTOC`  // Environmental, Autoclave Capsule
+	Table0487Autopsy                            Table0487 = `AUTP`   // Autopsy
+	Table0487BloodBag                           Table0487 = `BBL`    // Blood bag
+	Table0487CystBakerS                         Table0487 = `BCYST`  // Cyst, Baker's
+	Table0487Bite                               Table0487 = `BITE`   // Bite
+	Table0487Bleb                               Table0487 = `BLEB`   // Bleb
+	Table0487Blister                            Table0487 = `BLIST`  // Blister
+	Table0487Boil                               Table0487 = `BOIL`   // Boil
+	Table0487Bone                               Table0487 = `BON`    // Bone
+	Table0487BowelContents                      Table0487 = `BOWL`   // Bowel contents
+	Table0487BloodProductUnit                   Table0487 = `BPU`    // Blood product unit
+	Table0487Burn                               Table0487 = `BRN`    // Burn
+	Table0487Brush                              Table0487 = `BRSH`   // Brush
+	Table0487BreathUseEXHLD                     Table0487 = `BRTH`   // Breath (use EXHLD)
+	Table0487Brushing                           Table0487 = `BRUS`   // Brushing
+	Table0487Bubo                               Table0487 = `BUB`    // Bubo
+	Table0487BullaBullae                        Table0487 = `BULLA`  // Bulla/Bullae
+	Table0487Biopsy                             Table0487 = `BX`     // Biopsy
+	Table0487CalculusStone                      Table0487 = `CALC`   // Calculus (=Stone)
+	Table0487Carbuncle                          Table0487 = `CARBU`  // Carbuncle
+	Table0487Catheter                           Table0487 = `CAT`    // Catheter
+	Table0487BiteCat                            Table0487 = `CBITE`  // Bite, Cat
+	Table0487Clippings                          Table0487 = `CLIPP`  // Clippings
+	Table0487Conjunctiva                        Table0487 = `CNJT`   // Conjunctiva
+	Table0487Colostrum                          Table0487 = `COL`    // Colostrum
+	Table0487BiospyCone                         Table0487 = `CONE`   // Biospy, Cone
+	Table0487ScratchCat                         Table0487 = `CSCR`   // Scratch, Cat
+	Table0487SerumConvalescent                  Table0487 = `CSERU`  // Serum, Convalescent
+	Table0487CatheterInsertionSite              Table0487 = `CSITE`  // Catheter Insertion Site
+	Table0487FluidCystostomyTube                Table0487 = `CSMY`   // Fluid,  Cystostomy Tube
+	Table0487FluidCyst                          Table0487 = `CST`    // Fluid, Cyst
+	Table0487BloodCellSaver                     Table0487 = `CSVR`   // Blood, Cell Saver
+	Table0487CatheterTip                        Table0487 = `CTP`    // Catheter tip
+	Table0487SiteCVP                            Table0487 = `CVPS`   // Site, CVP
+	Table0487CatheterTipCVP                     Table0487 = `CVPT`   // Catheter Tip, CVP
+	Table0487NoduleCystic                       Table0487 = `CYN`    // Nodule, Cystic
+	Table0487Cyst                               Table0487 = `CYST`   // Cyst
+	Table0487BiteDog                            Table0487 = `DBITE`  // Bite, Dog
+	Table0487SputumDeepCough                    Table0487 = `DCS`    // Sputum, Deep Cough
+	Table0487UlcerDecubitus                     Table0487 = `DEC`    // Ulcer, Decubitus
+	Table0487EnvironmentalWaterDeionized        Table0487 = `DEION`  // Environmental, Water  (Deionized)
+	Table0487Dialysate                          Table0487 = `DIA`    // Dialysate
+	Table0487Discharge                          Table0487 = `DISCHG` // Discharge
+	Table0487Diverticulum                       Table0487 = `DIV`    // Diverticulum
+	Table0487Drain                              Table0487 = `DRN`    // Drain
+	Table0487DrainageTube                       Table0487 = `DRNG`   // Drainage, Tube
+	Table0487DrainagePenrose                    Table0487 = `DRNGP`  // Drainage, Penrose
+	Table0487EarWaxCerumen                      Table0487 = `EARW`   // Ear wax (cerumen)
+	Table0487BrushEsophageal                    Table0487 = `EBRUSH` // Brush, Esophageal
+	Table0487EnvironmentalEyeWash               Table0487 = `EEYE`   // Environmental, Eye Wash
+	Table0487EnvironmentalEffluent              Table0487 = `EFF`    // Environmental, Effluent
+	Table0487Effusion                           Table0487 = `EFFUS`  // Effusion
+	Table0487EnvironmentalFood                  Table0487 = `EFOD`   // Environmental, Food
+	Table0487EnvironmentalIsolette              Table0487 = `EISO`   // Environmental, Isolette
+	Table0487Electrode                          Table0487 = `ELT`    // Electrode
+	Table0487EnvironmentalUnidentifiedSubstance Table0487 = `ENVIR`  // Environmental, Unidentified Substance
+	Table0487EnvironmentalOtherSubstance        Table0487 = `EOTH`   // Environmental, Other Substance
+	Table0487EnvironmentalSoil                  Table0487 = `ESOI`   // Environmental, Soil
+	Table0487EnvironmentalSolutionSterile       Table0487 = `ESOS`   // Environmental, Solution (Sterile)
+	Table0487AspirateEndotrach                  Table0487 = `ETA`    // Aspirate,  Endotrach
+	Table0487CatheterTipEndotracheal            Table0487 = `ETTP`   // Catheter Tip, Endotracheal
+	Table0487TubeEndotracheal                   Table0487 = `ETTUB`  // Tube, Endotracheal
+	Table0487EnvironmentalWhirlpool             Table0487 = `EWHI`   // Environmental, Whirlpool
+	Table0487GasExhaledBreath                   Table0487 = `EXG`    // Gas, exhaled (=breath)
+	Table0487ShuntExternal                      Table0487 = `EXS`    // Shunt, External
+	Table0487Exudate                            Table0487 = `EXUDTE` // Exudate
+	Table0487EnvironmentalWaterWell             Table0487 = `FAW`    // Environmental, Water  (Well)
+	Table0487BloodFetal                         Table0487 = `FBLOOD` // Blood, Fetal
+	Table0487FluidAbdomen                       Table0487 = `FGA`    // Fluid,  Abdomen
+	Table0487Fistula                            Table0487 = `FIST`   // Fistula
+	Table0487FluidOther                         Table0487 = `FLD`    // Fluid, Other
+	Table0487Filter                             Table0487 = `FLT`    // Filter
+	Table0487FluidBodyUnsp                      Table0487 = `FLU`    // Fluid, Body unsp
+	Table0487Fluid                              Table0487 = `FLUID`  // Fluid
+	Table0487CatheterTipFoley                   Table0487 = `FOLEY`  // Catheter Tip, Foley
+	Table0487FluidRespiratory                   Table0487 = `FRS`    // Fluid, Respiratory
+	Table0487ScalpFetal                         Table0487 = `FSCLP`  // Scalp, Fetal
+	Table0487Furuncle                           Table0487 = `FUR`    // Furuncle
+	Table0487Gas                                Table0487 = `GAS`    // Gas
+	Table0487AspirateGastric                    Table0487 = `GASA`   // Aspirate, Gastric
+	Table0487AntrumGastric                      Table0487 = `GASAN`  // Antrum, Gastric
+	Table0487BrushingGastric                    Table0487 = `GASBR`  // Brushing, Gastric
+	Table0487DrainageGastric                    Table0487 = `GASD`   // Drainage, Gastric
+	Table0487FluidContentsGastric               Table0487 = `GAST`   // Fluid/contents, Gastric
+	Table0487GenitalVaginal                     Table0487 = `GENV`   // Genital vaginal
+	Table0487Graft                              Table0487 = `GRAFT`  // Graft
+	Table0487GraftSite                          Table0487 = `GRAFTS` // Graft Site
+	Table0487Granuloma                          Table0487 = `GRANU`  // Granuloma
+	Table0487CatheterGroshong                   Table0487 = `GROSH`  // Catheter, Groshong
+	Table0487SolutionGastrostomy                Table0487 = `GSOL`   // Solution, Gastrostomy
+	Table0487BiopsyGastric                      Table0487 = `GSPEC`  // Biopsy, Gastric
+	Table0487TubeGastric                        Table0487 = `GT`     // Tube, Gastric
+	Table0487DrainageTubeDrainageGastrostomy    Table0487 = `GTUBE`  // Drainage Tube, Drainage (Gastrostomy)
+	Table0487BiteHuman                          Table0487 = `HBITE`  // Bite, Human
+	Table0487BloodAutopsy                       Table0487 = `HBLUD`  // Blood, Autopsy
+	Table0487CatheterTipHemaquit                Table0487 = `HEMAQ`  // Catheter Tip, Hemaquit
+	Table0487CatheterTipHemovac                 Table0487 = `HEMO`   // Catheter Tip, Hemovac
+	Table0487TissueHerniated                    Table0487 = `HERNI`  // Tissue, Herniated
+	Table0487DrainHemovac                       Table0487 = `HEV`    // Drain, Hemovac
+	Table0487CatheterHickman                    Table0487 = `HIC`    // Catheter, Hickman
+	Table0487FluidHydrocele                     Table0487 = `HYDC`   // Fluid, Hydrocele
+	Table0487BiteInsect                         Table0487 = `IBITE`  // Bite, Insect
+	Table0487CystInclusion                      Table0487 = `ICYST`  // Cyst, Inclusion
+	Table0487CatheterTipIndwelling              Table0487 = `IDC`    // Catheter Tip, Indwelling
+	Table0487GasInhaled                         Table0487 = `IHG`    // Gas, Inhaled
+	Table0487DrainageIleostomy                  Table0487 = `ILEO`   // Drainage, Ileostomy
+	Table0487SourceOfSpecimenIsIllegible        Table0487 = `ILLEG`  // Source of Specimen Is Illegible
+	Table0487Implant                            Table0487 = `IMP`    // Implant
+	Table0487SiteIncisionSurgical               Table0487 = `INCI`   // Site, Incision/Surgical
+	Table0487Infiltrate                         Table0487 = `INFIL`  // Infiltrate
+	Table0487Insect                             Table0487 = `INS`    // Insect
+	Table0487CatheterTipIntroducer              Table0487 = `INTRD`  // Catheter Tip, Introducer
+	Table0487IntubationTube                     Table0487 = `IT`     // Intubation tube
+	Table0487IntrauterineDevice                 Table0487 = `IUD`    // Intrauterine Device
+	Table0487CatheterTipIV                      Table0487 = `IVCAT`  // Catheter Tip, IV
+	Table0487FluidIV                            Table0487 = `IVFLD`  // Fluid, IV
+	Table0487TubingTipIV                        Table0487 = `IVTIP`  // Tubing Tip, IV
+	Table0487DrainageJejunal                    Table0487 = `JEJU`   // Drainage, Jejunal
+	Table0487FluidJoint                         Table0487 = `JNTFLD` // Fluid, Joint
+	Table0487DrainageJacksonPratt               Table0487 = `JP`     // Drainage, Jackson Pratt
+	Table0487Lavage                             Table0487 = `KELOI`  // Lavage
+	Table0487FluidKidney                        Table0487 = `KIDFLD` // Fluid, Kidney
+	Table0487LavageBronhial                     Table0487 = `LAVG`   // Lavage, Bronhial
+	Table0487LavageGastric                      Table0487 = `LAVGG`  // Lavage, Gastric
+	Table0487LavagePeritoneal                   Table0487 = `LAVGP`  // Lavage, Peritoneal
+	Table0487LavagePreBronch                    Table0487 = `LAVPG`  // Lavage, Pre-Bronch
+	Table0487ContactLens                        Table0487 = `LENS1`  // Contact Lens
+	Table0487ContactLensCase                    Table0487 = `LENS2`  // Contact Lens Case
+	Table0487Lesion                             Table0487 = `LESN`   // Lesion
+	Table0487LiquidUnspecified                  Table0487 = `LIQ`    // Liquid, Unspecified
+	Table0487LiquidOther                        Table0487 = `LIQO`   // Liquid, Other
+	Table0487FluidLumbarSac                     Table0487 = `LSAC`   // Fluid, Lumbar Sac
+	Table0487CatheterTipMakurkour               Table0487 = `MAHUR`  // Catheter Tip, Makurkour
+	Table0487Mass                               Table0487 = `MASS`   // Mass
+	Table0487BloodMenstrual                     Table0487 = `MBLD`   // Blood, Menstrual
+	Table0487Mucosa                             Table0487 = `MUCOS`  // Mucosa
+	Table0487Mucus                              Table0487 = `MUCUS`  // Mucus
+	Table0487DrainageNasal                      Table0487 = `NASDR`  // Drainage, Nasal
+	Table0487Needle                             Table0487 = `NEDL`   // Needle
+	Table0487SiteNephrostomy                    Table0487 = `NEPH`   // Site, Nephrostomy
+	Table0487AspirateNasogastric                Table0487 = `NGASP`  // Aspirate, Nasogastric
+	Table0487DrainageNasogastric                Table0487 = `NGAST`  // Drainage, Nasogastric
+	Table0487SiteNasoGastric                    Table0487 = `NGS`    // Site, Naso/Gastric
+	Table0487NoduleS                            Table0487 = `NODUL`  // Nodule(s)
+	Table0487SecretionNasal                     Table0487 = `NSECR`  // Secretion, Nasal
+	Table0487Other                              Table0487 = `ORH`    // Other
+	Table0487LesionOral                         Table0487 = `ORL`    // Lesion, Oral
+	Table0487SourceOther                        Table0487 = `OTH`    // Source, Other
+	Table0487Pacemaker                          Table0487 = `PACEM`  // Pacemaker
+	Table0487FluidPericardial                   Table0487 = `PCFL`   // Fluid, Pericardial
+	Table0487SitePeritonealDialysis             Table0487 = `PDSIT`  // Site, Peritoneal Dialysis
+	Table0487SitePeritonealDialysisTunnel       Table0487 = `PDTS`   // Site, Peritoneal Dialysis Tunnel
+	Table0487AbscessPelvic                      Table0487 = `PELVA`  // Abscess, Pelvic
+	Table0487LesionPenile                       Table0487 = `PENIL`  // Lesion, Penile
+	Table0487AbscessPerianal                    Table0487 = `PERIA`  // Abscess, Perianal
+	Table0487CystPilonidal                      Table0487 = `PILOC`  // Cyst, Pilonidal
+	Table0487SitePin                            Table0487 = `PINS`   // Site, Pin
+	Table0487SitePacemakerInsetion              Table0487 = `PIS`    // Site, Pacemaker Insetion
+	Table0487PlantMaterial                      Table0487 = `PLAN`   // Plant Material
+	Table0487Plasma                             Table0487 = `PLAS`   // Plasma
+	Table0487PlasmaBag                          Table0487 = `PLB`    // Plasma bag
+	Table0487SerumPeakLevel                     Table0487 = `PLEVS`  // Serum, Peak Level
+	Table0487DrainagePenile                     Table0487 = `PND`    // Drainage, Penile
+	Table0487Polyps                             Table0487 = `POL`    // Polyps
+	Table0487GraftSitePopliteal                 Table0487 = `POPGS`  // Graft Site, Popliteal
+	Table0487GraftPopliteal                     Table0487 = `POPLG`  // Graft, Popliteal
+	Table0487SitePoplitealVein                  Table0487 = `POPLV`  // Site, Popliteal Vein
+	Table0487CatheterPorta                      Table0487 = `PORTA`  // Catheter, Porta
+	Table0487PlasmaPlateletPoor                 Table0487 = `PPP`    // Plasma, Platelet poor
+	Table0487ProstheticDevice                   Table0487 = `PROST`  // Prosthetic Device
+	Table0487PlasmaPlateletRich                 Table0487 = `PRP`    // Plasma, Platelet rich
+	Table0487Pseudocyst                         Table0487 = `PSC`    // Pseudocyst
+	Table0487WoundPuncture                      Table0487 = `PUNCT`  // Wound, Puncture
+	Table0487Pus                                Table0487 = `PUS`    // Pus
+	Table0487Pustule                            Table0487 = `PUSFR`  // Pustule
+	Table0487Pus_PUST                           Table0487 = `PUST`   // Pus
+	Table0487QualityControl                     Table0487 = `QC3`    // Quality Control
+	Table0487UrineRandom                        Table0487 = `RANDU`  // Urine, Random
+	Table0487BiteReptile                        Table0487 = `RBITE`  // Bite, Reptile
+	Table0487DrainageRectal                     Table0487 = `RECT`   // Drainage, Rectal
+	Table0487AbscessRectal                      Table0487 = `RECTA`  // Abscess, Rectal
+	Table0487CystRenal                          Table0487 = `RENALC` // Cyst, Renal
+	Table0487FluidRenalCyst                     Table0487 = `RENC`   // Fluid, Renal Cyst
+	Table0487Respiratory                        Table0487 = `RES`    // Respiratory
+	Table0487Saliva                             Table0487 = `SAL`    // Saliva
+	Table0487TissueKeloidScar                   Table0487 = `SCAR`   // Tissue, Keloid (Scar)
+	Table0487CatheterTipSubclavian              Table0487 = `SCLV`   // Catheter Tip, Subclavian
+	Table0487AbscessScrotal                     Table0487 = `SCROA`  // Abscess, Scrotal
+	Table0487SecretionS                         Table0487 = `SECRE`  // Secretion(s)
+	Table0487Serum                              Table0487 = `SER`    // Serum
+	Table0487SiteShunt                          Table0487 = `SHU`    // Site, Shunt
+	Table0487FluidShunt                         Table0487 = `SHUNF`  // Fluid, Shunt
+	Table0487Shunt                              Table0487 = `SHUNT`  // Shunt
+	Table0487Site                               Table0487 = `SITE`   // Site
+	Table0487BiopsySkin                         Table0487 = `SKBP`   // Biopsy, Skin
+	Table0487Skin                               Table0487 = `SKN`    // Skin
+	Table0487MassSubMandibular                  Table0487 = `SMM`    // Mass, Sub-Mandibular
+	Table0487FluidSynovialJointFluid            Table0487 = `SNV`    // Fluid, synovial (Joint fluid)
+	Table0487Spermatozoa                        Table0487 = `SPRM`   // Spermatozoa
+	Table0487CatheterTipSuprapubic              Table0487 = `SPRP`   // Catheter Tip, Suprapubic
+	Table0487CathetherTipSuprapubic             Table0487 = `SPRPB`  // Cathether Tip, Suprapubic
+	Table0487EnvironmentalSporeStrip            Table0487 = `SPS`    // Environmental, Spore Strip
+	Table0487Sputum                             Table0487 = `SPT`    // Sputum
+	Table0487SputumCoughed                      Table0487 = `SPTC`   // Sputum - coughed
+	Table0487SputumTrachealAspirate             Table0487 = `SPTT`   // Sputum - tracheal aspirate
+	Table0487SputumSimulated                    Table0487 = `SPUT1`  // Sputum, Simulated
+	Table0487SputumInducted                     Table0487 = `SPUTIN` // Sputum, Inducted
+	Table0487SputumSpontaneous                  Table0487 = `SPUTSP` // Sputum, Spontaneous
+	Table0487EnvironmentalSterrad               Table0487 = `STER`   // Environmental, Sterrad
+	Table0487StoolFecal                         Table0487 = `STL`    // Stool = Fecal
+	Table0487StoneKidney                        Table0487 = `STONE`  // Stone, Kidney
+	Table0487AbscessSubmandibular               Table0487 = `SUBMA`  // Abscess, Submandibular
+	Table0487AbscessSubmaxillary                Table0487 = `SUBMX`  // Abscess, Submaxillary
+	Table0487DrainageSump                       Table0487 = `SUMP`   // Drainage, Sump
+	Table0487SuprapubicTap                      Table0487 = `SUP`    // Suprapubic Tap
+	Table0487Suture                             Table0487 = `SUTUR`  // Suture
+	Table0487CatheterTipSwanGantz               Table0487 = `SWGZ`   // Catheter Tip, Swan Gantz
+	Table0487AspirateTracheal                   Table0487 = `TASP`   // Aspirate, Tracheal
+	Table0487Tissue                             Table0487 = `TISS`   // Tissue
+	Table0487TissueUlcer                        Table0487 = `TISU`   // Tissue ulcer
+	Table0487CathetherTipTripleLumen            Table0487 = `TLC`    // Cathether Tip, Triple Lumen
+	Table0487SiteTracheostomy                   Table0487 = `TRAC`   // Site, Tracheostomy
+	Table0487Transudate                         Table0487 = `TRANS`  // Transudate
+	Table0487SerumTrough                        Table0487 = `TSERU`  // Serum, Trough
+	Table0487AbscessTesticular                  Table0487 = `TSTES`  // Abscess, Testicular
+	Table0487AspirateTranstracheal              Table0487 = `TTRA`   // Aspirate, Transtracheal
+	Table0487Tubes                              Table0487 = `TUBES`  // Tubes
+	Table0487Tumor                              Table0487 = `TUMOR`  // Tumor
+	Table0487SmearTzanck                        Table0487 = `TZANC`  // Smear, Tzanck
+	Table0487SourceUnidentified                 Table0487 = `UDENT`  // Source, Unidentified
+	Table0487Urine                              Table0487 = `UR`     // Urine
+	Table0487UrineCleanCatch                    Table0487 = `URC`    // Urine clean catch
+	Table0487UrineBladderWashings               Table0487 = `URINB`  // Urine, Bladder Washings
+	Table0487UrineCatheterized                  Table0487 = `URINC`  // Urine, Catheterized
+	Table0487UrineMidstream                     Table0487 = `URINM`  // Urine, Midstream
+	Table0487UrineNephrostomy                   Table0487 = `URINN`  // Urine, Nephrostomy
+	Table0487UrinePedibag                       Table0487 = `URINP`  // Urine, Pedibag
+	Table0487UrineCatheter                      Table0487 = `URT`    // Urine catheter
+	Table0487UrineCystoscopy                    Table0487 = `USCOP`  // Urine, Cystoscopy
+	Table0487SourceUnspecified                  Table0487 = `USPEC`  // Source, Unspecified
+	Table0487CatheterTipVas                     Table0487 = `VASTIP` // Catheter Tip, Vas
+	Table0487CatheterTipVentricular             Table0487 = `VENT`   // Catheter Tip, Ventricular
+	Table0487VitreousFluid                      Table0487 = `VITF`   // Vitreous Fluid
+	Table0487Vomitus                            Table0487 = `VOM`    // Vomitus
+	Table0487Wash                               Table0487 = `WASH`   // Wash
+	Table0487WashingEGBronchialWashing          Table0487 = `WASI`   // Washing, e.g. bronchial washing
+	Table0487Water                              Table0487 = `WAT`    // Water
+	Table0487BloodWhole                         Table0487 = `WB`     // Blood, Whole
+	Table0487Wen                                Table0487 = `WEN`    // Wen
+	Table0487Wick                               Table0487 = `WICK`   // Wick
+	Table0487Wound                              Table0487 = `WND`    // Wound
+	Table0487WoundAbscess                       Table0487 = `WNDA`   // Wound abscess
+	Table0487WoundDrainage                      Table0487 = `WNDD`   // Wound drainage
+	Table0487WoundExudate                       Table0487 = `WNDE`   // Wound exudate
+	Table0487Worm                               Table0487 = `WORM`   // Worm
+	Table0487Wart                               Table0487 = `WRT`    // Wart
+	Table0487EnvironmentalWater                 Table0487 = `WWA`    // Environmental, Water
+	Table0487EnvironmentalWaterOcean            Table0487 = `WWO`    // Environmental, Water (Ocean)
+	Table0487EnvironmentalWaterTap              Table0487 = `WWT`    // Environmental, Water  (Tap)
+)
+
+// Description of the value, or empty if the value is not in table 0487.
+func (v Table0487) Description() string {
+	return tableDescription(`0487`, string(v))
+}
+
+// Valid reports if the value is in table 0487.
+func (v Table0487) Valid() bool {
+	return TableValueLookup[`0487`][string(v)]
+}
+
+// Table 0487.
+func (v Table0487) Table() Table {
+	return TableLookup[`0487`]
+}
+
+// Table0488 is a value of table 0488, Specimen Collection Method.
+// Fields in this table are strings; convert a field, such as Table0488(v), to use the helpers.
+type Table0488 string
+
+const (
+	Table0488PlatesAnaerobic             Table0488 = `ANP`    // Plates, Anaerobic
+	Table0488PlatesBloodAgar             Table0488 = `BAP`    // Plates, Blood Agar
+	Table0488BloodCultureAerobicBottle   Table0488 = `BCAE`   // Blood Culture, Aerobic Bottle
+	Table0488BloodCultureAnaerobicBottle Table0488 = `BCAN`   // Blood Culture, Anaerobic Bottle
+	Table0488BloodCulturePediatricBottle Table0488 = `BCPD`   // Blood Culture, Pediatric Bottle
+	Table0488Biopsy                      Table0488 = `BIO`    // Biopsy
+	Table0488CapillarySpecimen           Table0488 = `CAP`    // Capillary Specimen
+	Table0488Catheterized                Table0488 = `CATH`   // Catheterized
+	Table0488LineCVP                     Table0488 = `CVP`    // Line, CVP
+	Table0488EnvironmentalPlate          Table0488 = `EPLA`   // Environmental, Plate
+	Table0488EnvironmentalSwab           Table0488 = `ESWA`   // Environmental, Swab
+	Table0488AspirationFineNeedle        Table0488 = `FNA`    // Aspiration, Fine Needle
+	Table0488PlateCough                  Table0488 = `KOFFP`  // Plate, Cough
+	Table0488LineArterial                Table0488 = `LNA`    // Line, Arterial
+	Table0488LineVenous                  Table0488 = `LNV`    // Line, Venous
+	Table0488MartinLewisAgar             Table0488 = `MARTL`  // Martin-Lewis Agar
+	Table0488ModMartinLewisAgar          Table0488 = `ML11`   // Mod. Martin-Lewis Agar
+	Table0488PlateMartinLewis            Table0488 = `MLP`    // Plate, Martin-Lewis
+	Table0488PlateNewYorkCity            Table0488 = `NYP`    // Plate, New York City
+	Table0488PaceGenProbe                Table0488 = `PACE`   // Pace, Gen-Probe
+	Table0488PinwormPrep                 Table0488 = `PIN`    // Pinworm Prep
+	Table0488ArterialPuncture            Table0488 = `PNA`    // Arterial puncture
+	Table0488PumpPrime                   Table0488 = `PRIME`  // Pump Prime
+	Table0488PumpSpecimen                Table0488 = `PUMP`   // Pump Specimen
+	Table0488QualityControlForMicro      Table0488 = `QC5`    // Quality Control For Micro
+	Table0488ScalpFetalVein              Table0488 = `SCLP`   // Scalp, Fetal Vein
+	Table0488Scrapings                   Table0488 = `SCRAPS` // Scrapings
+	Table0488Shaving                     Table0488 = `SHA`    // Shaving
+	Table0488Swab                        Table0488 = `SWA`    // Swab
+	Table0488SwabDacronTipped            Table0488 = `SWD`    // Swab, Dacron tipped
+	Table0488TransportMediaAnaerobic     Table0488 = `TMAN`   // Transport Media, Anaerobic
+	Table0488TransportMediaChalamydia    Table0488 = `TMCH`   // Transport Media, Chalamydia
+	Table0488TransportMediaM4            Table0488 = `TMM4`   // Transport Media, M4
+	Table0488TransportMediaMycoplasma    Table0488 = `TMMY`   // Transport Media, Mycoplasma
+	Table0488TransportMedia              Table0488 = `TMOT`   // Transport Media,
+	Table0488PlateThayerMartin           Table0488 = `TMP`    // Plate, Thayer-Martin
+	Table0488TransportMediaPVA           Table0488 = `TMPV`   // Transport Media, PVA
+	Table0488TransportMediaStoolCulture  Table0488 = `TMSC`   // Transport Media, Stool Culture
+	Table0488TransportMediaUreaplasma    Table0488 = `TMUP`   // Transport Media, Ureaplasma
+	Table0488TransportMediaViral         Table0488 = `TMVI`   // Transport Media, Viral
+	Table0488Venipuncture                Table0488 = `VENIP`  // Venipuncture
+	Table0488SwabWoodenShaft             Table0488 = `WOOD`   // Swab, Wooden Shaft
+)
+
+// Description of the value, or empty if the value is not in table 0488.
+func (v Table0488) Description() string {
+	return tableDescription(`0488`, string(v))
+}
+
+// Valid reports if the value is in table 0488.
+func (v Table0488) Valid() bool {
+	return TableValueLookup[`0488`][string(v)]
+}
+
+// Table 0488.
+func (v Table0488) Table() Table {
+	return TableLookup[`0488`]
+}
+
+// Table0489 is a value of table 0489, Risk Codes.
+// Fields in this table are strings; convert a field, such as Table0489(v), to use the helpers.
+type Table0489 string
+
+const (
+	Table0489Aggressive                Table0489 = `AGG` // Aggressive
+	Table0489Biohazard                 Table0489 = `BHZ` // Biohazard
+	Table0489Biological                Table0489 = `BIO` // Biological
+	Table0489Corrosive                 Table0489 = `COR` // Corrosive
+	Table0489EscapeRisk                Table0489 = `ESC` // Escape Risk
+	Table0489Explosive                 Table0489 = `EXP` // Explosive
+	Table0489MaterialDangerInflammable Table0489 = `IFL` // MaterialDangerInflammable
+	Table0489MaterialDangerInfectious  Table0489 = `INF` // MaterialDangerInfectious
+	Table0489InjuryHazard              Table0489 = `INJ` // Injury Hazard
+	Table0489Poison                    Table0489 = `POI` // Poison
+	Table0489Radioactive               Table0489 = `RAD` // Radioactive
+)
+
+// Description of the value, or empty if the value is not in table 0489.
+func (v Table0489) Description() string {
+	return tableDescription(`0489`, string(v))
+}
+
+// Valid reports if the value is in table 0489.
+func (v Table0489) Valid() bool {
+	return TableValueLookup[`0489`][string(v)]
+}
+
+// Table 0489.
+func (v Table0489) Table() Table {
+	return TableLookup[`0489`]
+}
+
+// Table0490 is a value of table 0490, Specimen Reject Reason.
+// Fields in this table are strings; convert a field, such as Table0490(v), to use the helpers.
+type Table0490 string
+
+const (
+	Table0490Expired                Table0490 = `EX` // Expired
+	Table0490QuantityNotSufficient  Table0490 = `QS` // Quantity not sufficient
+	Table0490MissingPatientIDNumber Table0490 = `RA` // Missing patient ID number
+	Table0490BrokenContainer        Table0490 = `RB` // Broken container
+	Table0490Clotting               Table0490 = `RC` // Clotting
+	Table0490MissingCollectionDate  Table0490 = `RD` // Missing collection date
+	Table0490MissingPatientName     Table0490 = `RE` // Missing patient name
+	Table0490Hemolysis              Table0490 = `RH` // Hemolysis
+	Table0490IdentificationProblem  Table0490 = `RI` // Identification problem
+	Table0490Labeling               Table0490 = `RM` // Labeling
+	Table0490Contamination          Table0490 = `RN` // Contamination
+	Table0490MissingPhlebotomistID  Table0490 = `RP` // Missing phlebotomist ID
+	Table0490ImproperStorage        Table0490 = `RR` // Improper storage
+	Table0490NameMisspelling        Table0490 = `RS` // Name misspelling
+)
+
+// Description of the value, or empty if the value is not in table 0490.
+func (v Table0490) Description() string {
+	return tableDescription(`0490`, string(v))
+}
+
+// Valid reports if the value is in table 0490.
+func (v Table0490) Valid() bool {
+	return TableValueLookup[`0490`][string(v)]
+}
+
+// Table 0490.
+func (v Table0490) Table() Table {
+	return TableLookup[`0490`]
+}
+
+// Table0491 is a value of table 0491, Specimen Quality.
+// Fields in this table are strings; convert a field, such as Table0491(v), to use the helpers.
+type Table0491 string
+
+const (
+	Table0491Excellent Table0491 = `E` // Excellent
+	Table0491Fair      Table0491 = `F` // Fair
+	Table0491Good      Table0491 = `G` // Good
+	Table0491Poor      Table0491 = `P` // Poor
+)
+
+// Description of the value, or empty if the value is not in table 0491.
+func (v Table0491) Description() string {
+	return tableDescription(`0491`, string(v))
+}
+
+// Valid reports if the value is in table 0491.
+func (v Table0491) Valid() bool {
+	return TableValueLookup[`0491`][string(v)]
+}
+
+// Table 0491.
+func (v Table0491) Table() Table {
+	return TableLookup[`0491`]
+}
+
+// Table0492 is a value of table 0492, Specimen Appropriateness.
+// Fields in this table are strings; convert a field, such as Table0492(v), to use the helpers.
+type Table0492 string
+
+const (
+	Table0492InappropriateDueTo Table0492 = `??` // Inappropriate due to ...
+	Table0492Appropriate        Table0492 = `A`  // Appropriate
+	Table0492Inappropriate      Table0492 = `I`  // Inappropriate
+	Table0492Preferred          Table0492 = `P`  // Preferred
+)
+
+// Description of the value, or empty if the value is not in table 0492.
+func (v Table0492) Description() string {
+	return tableDescription(`0492`, string(v))
+}
+
+// Valid reports if the value is in table 0492.
+func (v Table0492) Valid() bool {
+	return TableValueLookup[`0492`][string(v)]
+}
+
+// Table 0492.
+func (v Table0492) Table() Table {
+	return TableLookup[`0492`]
+}
+
+// Table0493 is a value of table 0493, Specimen Condition.
+// Fields in this table are strings; convert a field, such as Table0493(v), to use the helpers.
+type Table0493 string
+
+const (
+	Table0493Autolyzed         Table0493 = `AUT`  // Autolyzed
+	Table0493Clotted           Table0493 = `CLOT` // Clotted
+	Table0493Contaminated      Table0493 = `CON`  // Contaminated
+	Table0493Cool              Table0493 = `COOL` // Cool
+	Table0493Frozen            Table0493 = `FROZ` // Frozen
+	Table0493Hemolyzed         Table0493 = `HEM`  // Hemolyzed
+	Table0493Live              Table0493 = `LIVE` // Live
+	Table0493RoomTemperature   Table0493 = `ROOM` // Room temperature
+	Table0493SampleNotReceived Table0493 = `SNR`  // Sample not received
+)
+
+// Description of the value, or empty if the value is not in table 0493.
+func (v Table0493) Description() string {
+	return tableDescription(`0493`, string(v))
+}
+
+// Valid reports if the value is in table 0493.
+func (v Table0493) Valid() bool {
+	return TableValueLookup[`0493`][string(v)]
+}
+
+// Table 0493.
+func (v Table0493) Table() Table {
+	return TableLookup[`0493`]
+}
+
+// Table0494 is a value of table 0494, Specimen Child Role.
+// Fields in this table are strings; convert a field, such as Table0494(v), to use the helpers.
+type Table0494 string
+
+const (
+	Table0494Aliquot                      Table0494 = `A` // Aliquot
+	Table0494Component                    Table0494 = `C` // Component
+	Table0494ModifiedFromOriginalSpecimen Table0494 = `M` // Modified from original specimen
+)
+
+// Description of the value, or empty if the value is not in table 0494.
+func (v Table0494) Description() string {
+	return tableDescription(`0494`, string(v))
+}
+
+// Valid reports if the value is in table 0494.
+func (v Table0494) Valid() bool {
+	return TableValueLookup[`0494`][string(v)]
+}
+
+// Table 0494.
+func (v Table0494) Table() Table {
+	return TableLookup[`0494`]
+}
+
+// Table0495 is a value of table 0495, Body Site Modifier.
+// Fields in this table are strings; convert a field, such as Table0495(v), to use the helpers.
+type Table0495 string
+
+const (
+	Table0495Anterior           Table0495 = `ANT` // Anterior
+	Table0495Bilateral          Table0495 = `BIL` // Bilateral
+	Table0495Distal             Table0495 = `DIS` // Distal
+	Table0495External           Table0495 = `EXT` // External
+	Table0495Left               Table0495 = `L`   // Left
+	Table0495Lateral            Table0495 = `LAT` // Lateral
+	Table0495QuadrantLeftLower  Table0495 = `LLQ` // Quadrant, Left Lower
+	Table0495Lower              Table0495 = `LOW` // Lower
+	Table0495QuadrantLeftUpper  Table0495 = `LUQ` // Quadrant, Left Upper
+	Table0495Medial             Table0495 = `MED` // Medial
+	Table0495Posterior          Table0495 = `POS` // Posterior
+	Table0495Proximal           Table0495 = `PRO` // Proximal
+	Table0495Right              Table0495 = `R`   // Right
+	Table0495QuadrantRightLower Table0495 = `RLQ` // Quadrant, Right Lower
+	Table0495QuadrantRightUpper Table0495 = `RUQ` // Quadrant, Right Upper
+	Table0495Upper              Table0495 = `UPP` // Upper
+)
+
+// Description of the value, or empty if the value is not in table 0495.
+func (v Table0495) Description() string {
+	return tableDescription(`0495`, string(v))
+}
+
+// Valid reports if the value is in table 0495.
+func (v Table0495) Valid() bool {
+	return TableValueLookup[`0495`][string(v)]
+}
+
+// Table 0495.
+func (v Table0495) Table() Table {
+	return TableLookup[`0495`]
+}
+
+// Table0496 is a value of table 0496, Consent Type.
+// Fields in this table are strings; convert a field, such as Table0496(v), to use the helpers.
+type Table0496 string
+
+const (
+	Table0496ReleaseOfInformationMRAuthorizationTo         Table0496 = `001`  // Release of Information/MR / Authorization to Disclosure Protected Health Information
+	Table0496MedicalProcedureInvasive                      Table0496 = `002`  // Medical Procedure (invasive)
+	Table0496AcknowledgeReceiptOfPrivacyNotice             Table0496 = `003`  // Acknowledge Receipt of Privacy Notice
+	Table0496Abortion                                      Table0496 = `004`  // Abortion
+	Table0496AbortionLaminaria                             Table0496 = `005`  // Abortion/Laminaria
+	Table0496AccutaneInformation                           Table0496 = `006`  // Accutane - Information
+	Table0496AccutaneWoman                                 Table0496 = `007`  // Accutane - Woman
+	Table0496AdvancedBeneficiaryNotice                     Table0496 = `008`  // Advanced Beneficiary Notice
+	Table0496AFPAlphaFetoproteinScreening                  Table0496 = `009`  // AFP (Alpha Fetoprotein) Screening
+	Table0496AmniocentesisConsentRefusal                   Table0496 = `010`  // Amniocentesis (consent & refusal)
+	Table0496AnatomicalGiftOrganDonation                   Table0496 = `011`  // Anatomical Gift (organ donation)
+	Table0496AnesthesiaComplications                       Table0496 = `012`  // Anesthesia - Complications
+	Table0496AnesthesiaQuestionnaire                       Table0496 = `013`  // Anesthesia - Questionnaire
+	Table0496Angiogram                                     Table0496 = `014`  // Angiogram
+	Table0496Angioplasty                                   Table0496 = `015`  // Angioplasty
+	Table0496AnticancerDrugs                               Table0496 = `016`  // Anticancer Drugs
+	Table0496AntipsychoticMedications                      Table0496 = `017`  // Antipsychotic Medications
+	Table0496Arthrogram                                    Table0496 = `018`  // Arthrogram
+	Table0496Autopsy                                       Table0496 = `019`  // Autopsy
+	Table0496AZTTherapy                                    Table0496 = `020`  // AZT Therapy
+	Table0496BiliaryDrainage                               Table0496 = `021`  // Biliary Drainage
+	Table0496BiliaryStoneExtraction                        Table0496 = `022`  // Biliary Stone Extraction
+	Table0496Biopsy                                        Table0496 = `023`  // Biopsy
+	Table0496BleedingTimeTest                              Table0496 = `024`  // Bleeding Time Test
+	Table0496Bronchogram                                   Table0496 = `025`  // Bronchogram
+	Table0496CardiacCatheterization                        Table0496 = `026`  // Cardiac Catheterization
+	Table0496CoronaryAngiography                           Table0496 = `027`  // Coronary Angiography
+	Table0496WOSurgeryCapability                           Table0496 = `028`  // ""      "" w/o Surgery Capability
+	Table0496CataractOpImplantOfFDAAprvd                   Table0496 = `029`  // Cataract Op/Implant of FDA Aprvd Lens
+	Table0496CataractOpImplantOfInvestigationalLens        Table0496 = `030`  // Cataract Op/Implant of Investigational Lens
+	Table0496CataractSurgery                               Table0496 = `031`  // Cataract Surgery
+	Table0496CholeraImmunization                           Table0496 = `032`  // Cholera Immunization
+	Table0496CholesterolScreening                          Table0496 = `033`  // Cholesterol Screening
+	Table0496CircumcisionNewborn                           Table0496 = `034`  // Circumcision - Newborn
+	Table0496Colonoscopy                                   Table0496 = `035`  // Colonoscopy
+	Table0496ContactLenses                                 Table0496 = `036`  // Contact Lenses
+	Table0496CTScanCervicalLumbar                          Table0496 = `037`  // CT Scan - Cervical & Lumbar
+	Table0496CTScanWIVContrastMedia                        Table0496 = `038`  // CT Scan w/ IV Contrast Media into Vein
+	Table0496CVSChorionicVillusSampling                    Table0496 = `039`  // CVS (Chorionic Villus) Sampling
+	Table0496Cystospy                                      Table0496 = `040`  // Cystospy
+	Table0496DisclosureOfProtectedHealthInformationTo      Table0496 = `041`  // Disclosure of Protected Health Information to Family/Friends
+	Table0496DCAndConization                               Table0496 = `042`  // D & C and Conization
+	Table0496Dacryocystogram                               Table0496 = `043`  // Dacryocystogram
+	Table0496DiagnosticIsotope                             Table0496 = `044`  // Diagnostic Isotope
+	Table0496DrainageOfAnAbscess                           Table0496 = `045`  // Drainage of an Abscess
+	Table0496DrugScreening                                 Table0496 = `046`  // Drug Screening
+	Table0496ElectronicMonitoringOfLaborRefusal            Table0496 = `047`  // Electronic Monitoring of Labor - Refusal
+	Table0496EndometrialBiopsy                             Table0496 = `048`  // Endometrial Biopsy
+	Table0496EndoscopySclerosisOfEsophagealVarices         Table0496 = `049`  // Endoscopy/Sclerosis of Esophageal Varices
+	Table0496ERCP                                          Table0496 = `050`  // ERCP
+	Table0496ExposureToReportableCommunicableDisease       Table0496 = `051`  // Exposure to reportable Communicable Disease
+	Table0496ExternalVersion                               Table0496 = `052`  // External Version
+	Table0496FluoresceinAngioscopy                         Table0496 = `053`  // Fluorescein Angioscopy
+	Table0496HepatitisBConsentDeclination                  Table0496 = `054`  // Hepatitis B - Consent/Declination
+	Table0496Herniogram                                    Table0496 = `055`  // Herniogram
+	Table0496HIVTestConsentRefusal                         Table0496 = `056`  // HIV Test - Consent Refusal
+	Table0496HIVTestDisclosure                             Table0496 = `057`  // HIV Test - Disclosure
+	Table0496HIVTestPrenatal                               Table0496 = `058`  // HIV Test - Prenatal
+	Table0496HomeIVTreatmentProgram                        Table0496 = `059`  // Home IV Treatment Program
+	Table0496HomeParenteralTreatmentProgram                Table0496 = `060`  // Home Parenteral Treatment Program
+	Table0496Hysterectomy                                  Table0496 = `061`  // Hysterectomy
+	Table0496Hysterosalpingogram                           Table0496 = `062`  // Hysterosalpingogram
+	Table0496InjectionSlipConsent                          Table0496 = `063`  // Injection Slip/ Consent
+	Table0496IntrauterineDevice                            Table0496 = `064`  // Intrauterine Device
+	Table0496IntrauterineDeviceSterilization               Table0496 = `065`  // Intrauterine Device/Sterilization
+	Table0496IntravascularInfusionOfStreptokinaseUrokinase Table0496 = `066`  // Intravascular Infusion of Streptokinase/Urokinase
+	Table0496IntravenousCholangiogram                      Table0496 = `067`  // Intravenous Cholangiogram
+	Table0496IntravenousDigitalAngiography                 Table0496 = `068`  // Intravenous Digital Angiography
+	Table0496IodineAdministration                          Table0496 = `069`  // Iodine Administration
+	Table0496ISG                                           Table0496 = `070`  // ISG
+	Table0496IVP                                           Table0496 = `071`  // IVP
+	Table0496LaserPhotocoagulation                         Table0496 = `072`  // Laser Photocoagulation
+	Table0496LaserTreatment                                Table0496 = `073`  // Laser treatment
+	Table0496LithiumCarbonate                              Table0496 = `074`  // Lithium Carbonate
+	Table0496LiverBiopsy                                   Table0496 = `075`  // Liver Biopsy
+	Table0496LumbarPuncture                                Table0496 = `076`  // Lumbar Puncture
+	Table0496Lymphangiogram                                Table0496 = `077`  // Lymphangiogram
+	Table0496MAOInhibitors                                 Table0496 = `078`  // MAO Inhibitors
+	Table0496MedPsychAndOrDrugAlcohol                      Table0496 = `079`  // Med, Psych, and/or Drug/Alcohol
+	Table0496MedicalTreatmentRefusal                       Table0496 = `080`  // Medical Treatment - Refusal
+	Table0496MorningAfterPill                              Table0496 = `081`  // Morning-after Pill
+	Table0496MRIAdult                                      Table0496 = `082`  // MRI - Adult
+	Table0496MRIPediatric                                  Table0496 = `083`  // MRI - Pediatric
+	Table0496Myelogram                                     Table0496 = `084`  // Myelogram
+	Table0496NeedleBiopsy                                  Table0496 = `085`  // Needle Biopsy
+	Table0496NeedleBiopsyOfLung                            Table0496 = `086`  // Needle Biopsy of Lung
+	Table0496NewbornTreatmentAndRelease                    Table0496 = `087`  // Newborn Treatment and Release
+	Table0496NorplantSubdermalBirthControlImplant          Table0496 = `088`  // Norplant Subdermal Birth Control Implant
+	Table0496OperationsAnesthesiaTransfusions              Table0496 = `089`  // Operations, Anesthesia, Transfusions
+	Table0496OralContraceptives                            Table0496 = `090`  // Oral Contraceptives
+	Table0496OrganDonation                                 Table0496 = `091`  // Organ Donation
+	Table0496PatientPermitsConsents                        Table0496 = `092`  // Patient Permits, Consents
+	Table0496PatientTreatmentPermitReleaseAdmission        Table0496 = `093`  // Patient Treatment Permit, Release & Admission
+	Table0496PenileInjections                              Table0496 = `094`  // Penile Injections
+	Table0496PercutaneousNephrostomy                       Table0496 = `095`  // Percutaneous Nephrostomy
+	Table0496PercutaneousTranshepaticCholangiogram         Table0496 = `096`  // Percutaneous Transhepatic Cholangiogram
+	Table0496Photographs                                   Table0496 = `097`  // Photographs
+	Table0496PhotographsEmployee                           Table0496 = `098`  // Photographs - Employee
+	Table0496PhotographsMedicalResearch                    Table0496 = `099`  // Photographs - Medical Research
+	Table0496PhotographsNewsMedia                          Table0496 = `100`  // Photographs - news Media
+	Table0496PsychiatricAdmissionNextOfKin                 Table0496 = `101`  // Psychiatric Admission - Next of Kin
+	Table0496PsychiatricInformationDuringHospitalStay      Table0496 = `102`  // Psychiatric Information During Hospital Stay
+	Table0496PublicReleaseOfInformation                    Table0496 = `103`  // Public Release of Information
+	Table0496RadiologicProcedure                           Table0496 = `104`  // Radiologic Procedure
+	Table0496RefusalOfTreatment                            Table0496 = `105`  // Refusal of Treatment
+	Table0496ReleaseOfBody                                 Table0496 = `106`  // Release of Body
+	Table0496ReleaseOfLimb                                 Table0496 = `107`  // Release of Limb
+	Table0496RhImmuneGlobulin                              Table0496 = `108`  // Rh Immune Globulin
+	Table0496RightsOfMedicalResearchParticipants           Table0496 = `109`  // Rights of Medical Research Participants
+	Table0496RequestToRestrictAccessDisclosureTo           Table0496 = `110`  // Request to Restrict Access/Disclosure to Medical Record/Protected Health Information
+	Table0496RequestForRemainAnonymous                     Table0496 = `111`  // Request for Remain Anonymous
+	Table0496SeatBeltExemption                             Table0496 = `112`  // Seat Belt Exemption
+	Table0496Sialogram                                     Table0496 = `113`  // Sialogram
+	Table0496VoidingCystogram                              Table0496 = `1137` // Voiding Cystogram
+	Table0496Sigmoidoscopy                                 Table0496 = `114`  // Sigmoidoscopy
+	Table0496SterilizationAnesthesiaMedicalServices        Table0496 = `115`  // Sterilization - Anesthesia & Medical Services
+	Table0496SterilizationFederallyFunded                  Table0496 = `116`  // Sterilization -Federally Funded
+	Table0496SterilizationFemale                           Table0496 = `117`  // Sterilization - Female
+	Table0496SterilizationLaparoscopyPomeroy               Table0496 = `118`  // Sterilization - Laparoscopy/Pomeroy
+	Table0496SterilizationNonFederallyFunded               Table0496 = `119`  // Sterilization - Non-Federally Funded
+	Table0496SterilizationSecondary                        Table0496 = `120`  // Sterilization - Secondary
+	Table0496Tranquilizers                                 Table0496 = `121`  // Tranquilizers
+	Table0496TransferAcknowledgement                       Table0496 = `122`  // Transfer - Acknowledgement
+	Table0496TransferAuthorization                         Table0496 = `123`  // Transfer - Authorization
+	Table0496TransferCertificationPhysician                Table0496 = `124`  // Transfer Certification - Physician
+	Table0496TransferDischargeRequest                      Table0496 = `125`  // Transfer/Discharge Request
+	Table0496TransferForNonMedicalReasons                  Table0496 = `126`  // Transfer for Non-Medical Reasons
+	Table0496TransferInterfacultyNeonatal                  Table0496 = `127`  // Transfer - Interfaculty Neonatal
+	Table0496TransferRefusal                               Table0496 = `128`  // Transfer Refusal
+	Table0496TransferRefusalOfFurtherTreatment             Table0496 = `129`  // Transfer Refusal of Further Treatment
+	Table0496TreadmillEKG                                  Table0496 = `130`  // Treadmill & EKG
+	Table0496TreadmillThallium201                          Table0496 = `131`  // Treadmill, Thallium-201
+	Table0496Typhoid                                       Table0496 = `132`  // Typhoid
+	Table0496UseOfInvestigationalDevice                    Table0496 = `133`  // Use of Investigational Device
+	Table0496UseOfInvestigationalDrug                      Table0496 = `134`  // Use of Investigational Drug
+	Table0496Venogram                                      Table0496 = `135`  // Venogram
+	Table0496Videotape                                     Table0496 = `136`  // Videotape
+)
+
+// Description of the value, or empty if the value is not in table 0496.
+func (v Table0496) Description() string {
+	return tableDescription(`0496`, string(v))
+}
+
+// Valid reports if the value is in table 0496.
+func (v Table0496) Valid() bool {
+	return TableValueLookup[`0496`][string(v)]
+}
+
+// Table 0496.
+func (v Table0496) Table() Table {
+	return TableLookup[`0496`]
+}
+
+// Table0497 is a value of table 0497, Consent Mode.
+// Fields in this table are strings; convert a field, such as Table0497(v), to use the helpers.
+type Table0497 string
+
+const (
+	Table0497Telephone Table0497 = `T` // Telephone
+	Table0497Verbal    Table0497 = `V` // Verbal
+	Table0497Written   Table0497 = `W` // Written
+)
+
+// Description of the value, or empty if the value is not in table 0497.
+func (v Table0497) Description() string {
+	return tableDescription(`0497`, string(v))
+}
+
+// Valid reports if the value is in table 0497.
+func (v Table0497) Valid() bool {
+	return TableValueLookup[`0497`][string(v)]
+}
+
+// Table 0497.
+func (v Table0497) Table() Table {
+	return TableLookup[`0497`]
+}
+
+// Table0498 is a value of table 0498, Consent Status.
+// Fields in this table are strings; convert a field, such as Table0498(v), to use the helpers.
+type Table0498 string
+
+const (
+	Table0498ActiveConsentHasBeenGranted            Table0498 = `A` // Active - Consent has been granted
+	Table0498BypassedConsentNotSought               Table0498 = `B` // Bypassed (Consent not sought)
+	Table0498LimitedConsentHasBeenGrantedWith       Table0498 = `L` // Limited - Consent has been granted with limitations
+	Table0498PendingConsentHasNotYetBeen            Table0498 = `P` // Pending - Consent has not yet been sought
+	Table0498RefusedConsentHasBeenRefused           Table0498 = `R` // Refused - Consent has been refused
+	Table0498RescindedConsentWasInitiallyGrantedBut Table0498 = `X` // Rescinded - Consent was initially granted, but was subsequently revoked or ended.
+)
+
+// Description of the value, or empty if the value is not in table 0498.
+func (v Table0498) Description() string {
+	return tableDescription(`0498`, string(v))
+}
+
+// Valid reports if the value is in table 0498.
+func (v Table0498) Valid() bool {
+	return TableValueLookup[`0498`][string(v)]
+}
+
+// Table 0498.
+func (v Table0498) Table() Table {
+	return TableLookup[`0498`]
+}
+
+// Table0499 is a value of table 0499, Consent Bypass Reason.
+// Fields in this table are strings; convert a field, such as Table0499(v), to use the helpers.
+type Table0499 string
+
+const (
+	Table0499Emergency            Table0499 = `E`  // Emergency
+	Table0499ProfessionalJudgment Table0499 = `PJ` // Professional Judgment
+)
+
+// Description of the value, or empty if the value is not in table 0499.
+func (v Table0499) Description() string {
+	return tableDescription(`0499`, string(v))
+}
+
+// Valid reports if the value is in table 0499.
+func (v Table0499) Valid() bool {
+	return TableValueLookup[`0499`][string(v)]
+}
+
+// Table 0499.
+func (v Table0499) Table() Table {
+	return TableLookup[`0499`]
+}
+
+// Table0500 is a value of table 0500, Consent Disclosure Level.
+// Fields in this table are strings; convert a field, such as Table0500(v), to use the helpers.
+type Table0500 string
+
+const (
+	Table0500FullDisclosure    Table0500 = `F` // Full Disclosure
+	Table0500NoDisclosure      Table0500 = `N` // No Disclosure
+	Table0500PartialDisclosure Table0500 = `P` // Partial Disclosure
+)
+
+// Description of the value, or empty if the value is not in table 0500.
+func (v Table0500) Description() string {
+	return tableDescription(`0500`, string(v))
+}
+
+// Valid reports if the value is in table 0500.
+func (v Table0500) Valid() bool {
+	return TableValueLookup[`0500`][string(v)]
+}
+
+// Table 0500.
+func (v Table0500) Table() Table {
+	return TableLookup[`0500`]
+}
+
+// Table0501 is a value of table 0501, Consent Non-Disclosure Reason.
+// Fields in this table are strings; convert a field, such as Table0501(v), to use the helpers.
+type Table0501 string
+
+const (
+	Table0501Emergency      Table0501 = `E`  // Emergency
+	Table0501PatientRequest Table0501 = `PR` // Patient Request
+	Table0501RxPrivate      Table0501 = `RX` // Rx Private
+)
+
+// Description of the value, or empty if the value is not in table 0501.
+func (v Table0501) Description() string {
+	return tableDescription(`0501`, string(v))
+}
+
+// Valid reports if the value is in table 0501.
+func (v Table0501) Valid() bool {
+	return TableValueLookup[`0501`][string(v)]
+}
+
+// Table 0501.
+func (v Table0501) Table() Table {
+	return TableLookup[`0501`]
+}
+
+// Table0502 is a value of table 0502, Non-Subject Consenter Reason.
+// Fields in this table are strings; convert a field, such as Table0502(v), to use the helpers.
+type Table0502 string
+
+const (
+	Table0502LegallyMandated                Table0502 = `LM`  // Legally mandated
+	Table0502SubjectIsAMinor                Table0502 = `MIN` // Subject is a minor
+	Table0502SubjectIsNotCompetentToConsent Table0502 = `NC`  // Subject is not competent to consent
+)
+
+// Description of the value, or empty if the value is not in table 0502.
+func (v Table0502) Description() string {
+	return tableDescription(`0502`, string(v))
+}
+
+// Valid reports if the value is in table 0502.
+func (v Table0502) Valid() bool {
+	return TableValueLookup[`0502`][string(v)]
+}
+
+// Table 0502.
+func (v Table0502) Table() Table {
+	return TableLookup[`0502`]
+}
+
+// Table0503 is a value of table 0503, Sequence/Results Flag.
+// Fields in this table are strings; convert a field, such as Table0503(v), to use the helpers.
+type Table0503 string
+
+const (
+	Table0503Cyclical             Table0503 = `C` // Cyclical
+	Table0503ReservedForFutureUse Table0503 = `R` // Reserved for future use
+	Table0503Sequential           Table0503 = `S` // Sequential
+)
+
+// Description of the value, or empty if the value is not in table 0503.
+func (v Table0503) Description() string {
+	return tableDescription(`0503`, string(v))
+}
+
+// Valid reports if the value is in table 0503.
+func (v Table0503) Valid() bool {
+	return TableValueLookup[`0503`][string(v)]
+}
+
+// Table 0503.
+func (v Table0503) Table() Table {
+	return TableLookup[`0503`]
+}
+
+// Table0504 is a value of table 0504, Sequence Condition Code.
+// Fields in this table are strings; convert a field, such as Table0504(v), to use the helpers.
+type Table0504 string
+
+const (
+	Table0504EndRelatedServiceRequestSEnd     Table0504 = `EE` // End related service request(s), end current service request.
+	Table0504EndRelatedServiceRequestSStart   Table0504 = `ES` // End related service request(s), start current service request.
+	Table0504StartRelatedServiceRequestSEnd   Table0504 = `SE` // Start related service request(s), end current service request.
+	Table0504StartRelatedServiceRequestSStart Table0504 = `SS` // Start related service request(s), start current service request.
+)
+
+// Description of the value, or empty if the value is not in table 0504.
+func (v Table0504) Description() string {
+	return tableDescription(`0504`, string(v))
+}
+
+// Valid reports if the value is in table 0504.
+func (v Table0504) Valid() bool {
+	return TableValueLookup[`0504`][string(v)]
+}
+
+// Table 0504.
+func (v Table0504) Table() Table {
+	return TableLookup[`0504`]
+}
+
+// Table0505 is a value of table 0505, Cyclic Entry/Exit Indicator.
+// Fields in this table are strings; convert a field, such as Table0505(v), to use the helpers.
+type Table0505 string
+
+const (
+	Table0505TheLastServiceRequestInA  Table0505 = `#` // The last service request in a cyclic group.
+	Table0505TheFirstServiceRequestInA Table0505 = `*` // The first service request in a cyclic group
+)
+
+// Description of the value, or empty if the value is not in table 0505.
+func (v Table0505) Description() string {
+	return tableDescription(`0505`, string(v))
+}
+
+// Valid reports if the value is in table 0505.
+func (v Table0505) Valid() bool {
+	return TableValueLookup[`0505`][string(v)]
+}
+
+// Table 0505.
+func (v Table0505) Table() Table {
+	return TableLookup[`0505`]
+}
+
+// Table0506 is a value of table 0506, Service Request Relationship.
+// Fields in this table are strings; convert a field, such as Table0506(v), to use the helpers.
+type Table0506 string
+
+const (
+	Table0506Compound         Table0506 = `C` // Compound
+	Table0506Exclusive        Table0506 = `E` // Exclusive
+	Table0506NursePrerogative Table0506 = `N` // Nurse prerogative
+	Table0506Simultaneous     Table0506 = `S` // Simultaneous
+	Table0506Tapering         Table0506 = `T` // Tapering
+)
+
+// Description of the value, or empty if the value is not in table 0506.
+func (v Table0506) Description() string {
+	return tableDescription(`0506`, string(v))
+}
+
+// Valid reports if the value is in table 0506.
+func (v Table0506) Valid() bool {
+	return TableValueLookup[`0506`][string(v)]
+}
+
+// Table 0506.
+func (v Table0506) Table() Table {
+	return TableLookup[`0506`]
+}
+
+// Table0507 is a value of table 0507, Observation Result Handling.
+// Fields in this table are strings; convert a field, such as Table0507(v), to use the helpers.
+type Table0507 string
+
+const (
+	Table0507FilmWithPatient         Table0507 = `F` // Film-with-patient
+	Table0507NotifyProviderWhenReady Table0507 = `N` // Notify provider when ready
+)
+
+// Description of the value, or empty if the value is not in table 0507.
+func (v Table0507) Description() string {
+	return tableDescription(`0507`, string(v))
+}
+
+// Valid reports if the value is in table 0507.
+func (v Table0507) Valid() bool {
+	return TableValueLookup[`0507`][string(v)]
+}
+
+// Table 0507.
+func (v Table0507) Table() Table {
+	return TableLookup[`0507`]
+}
+
+// Table0508 is a value of table 0508, Blood Product Processing Requirements.
+// Fields in this table are strings; convert a field, such as Table0508(v), to use the helpers.
+type Table0508 string
+
+const (
+	Table0508AutologousUnit      Table0508 = `AU` // Autologous Unit
+	Table0508CMVNegative         Table0508 = `CM` // CMV Negative
+	Table0508CMVSafe             Table0508 = `CS` // CMV Safe
+	Table0508DirectedUnit        Table0508 = `DI` // Directed Unit
+	Table0508FreshUnit           Table0508 = `FR` // Fresh unit
+	Table0508HemoglobinSNegative Table0508 = `HB` // Hemoglobin S Negative
+	Table0508HLAMatched          Table0508 = `HL` // HLA Matched
+	Table0508IgADeficient        Table0508 = `IG` // IgA Deficient
+	Table0508Irradiated          Table0508 = `IR` // Irradiated
+	Table0508Leukoreduced        Table0508 = `LR` // Leukoreduced
+	Table0508Washed              Table0508 = `WA` // Washed
+)
+
+// Description of the value, or empty if the value is not in table 0508.
+func (v Table0508) Description() string {
+	return tableDescription(`0508`, string(v))
+}
+
+// Valid reports if the value is in table 0508.
+func (v Table0508) Valid() bool {
+	return TableValueLookup[`0508`][string(v)]
+}
+
+// Table 0508.
+func (v Table0508) Table() Table {
+	return TableLookup[`0508`]
+}
+
+// Table0510 is a value of table 0510, Blood Product Dispense Status.
+// Fields in this table are strings; convert a field, such as Table0510(v), to use the helpers.
+type Table0510 string
+
+const (
+	Table0510ReleasedIntoInventoryForGeneralAvailability Table0510 = `CR` // Released into inventory for general availability
+	Table0510DispensedToPatientLocation                  Table0510 = `DS` // Dispensed to patient location
+	Table0510PresumedTransfusedDispensedAndNotReturned   Table0510 = `PT` // Presumed transfused (dispensed and not returned)
+	Table0510ReturnedUnusedNoLongerNeeded                Table0510 = `RA` // Returned unused/no longer needed
+	Table0510ReservedAndReadyToDispense                  Table0510 = `RD` // Reserved and ready to dispense
+	Table0510ReleasedNoLongerAllocatedForThe             Table0510 = `RE` // Released (no longer allocated for the patient)
+	Table0510ReceivedIntoInventoryForSpecifiedPatient    Table0510 = `RI` // Received into inventory (for specified patient)
+	Table0510ReturnedUnusedKeepLinkedToPatient           Table0510 = `RL` // Returned unused/keep linked to patient for possible use later
+	Table0510RequestToDispenseBloodProduct               Table0510 = `RQ` // Request to dispense blood product
+	Table0510ReservedOrderedAndProductAllocatedFor       Table0510 = `RS` // Reserved (ordered and product allocated for the patient)
+	Table0510WastedProductNoLongerViable                 Table0510 = `WA` // Wasted (product no longer viable)
+)
+
+// Description of the value, or empty if the value is not in table 0510.
+func (v Table0510) Description() string {
+	return tableDescription(`0510`, string(v))
+}
+
+// Valid reports if the value is in table 0510.
+func (v Table0510) Valid() bool {
+	return TableValueLookup[`0510`][string(v)]
+}
+
+// Table 0510.
+func (v Table0510) Table() Table {
+	return TableLookup[`0510`]
+}
+
+// Table0511 is a value of table 0511, BP Observation Status Codes Interpretation.
+// Fields in this table are strings; convert a field, such as Table0511(v), to use the helpers.
+type Table0511 string
+
+const (
+	Table0511RecordComingOverIsACorrection      Table0511 = `C` // Record coming over is a correction and thus replaces a final status
+	Table0511DeletesTheBPXRecord                Table0511 = `D` // Deletes the BPX record
+	Table0511FinalStatusCanOnlyBeChanged        Table0511 = `F` // Final status; Can only be changed with a corrected status
+	Table0511OrderDetailDescriptionOnlyNoStatus Table0511 = `O` // Order detail description only (no status)
+	Table0511PreliminaryStatus                  Table0511 = `P` // Preliminary status
+	Table0511PostOriginalAsWrongEG              Table0511 = `W` // Post original as wrong, e.g., transmitted for wrong patient
+)
+
+// Description of the value, or empty if the value is not in table 0511.
+func (v Table0511) Description() string {
+	return tableDescription(`0511`, string(v))
+}
+
+// Valid reports if the value is in table 0511.
+func (v Table0511) Valid() bool {
+	return TableValueLookup[`0511`][string(v)]
+}
+
+// Table 0511.
+func (v Table0511) Table() Table {
+	return TableLookup[`0511`]
+}
+
+// Table0513 is a value of table 0513, Blood Product Transfusion/Disposition Status.
+// Fields in this table are strings; convert a field, such as Table0513(v), to use the helpers.
+type Table0513 string
+
+const (
+	Table0513ReturnedUnusedNoLongerNeeded      Table0513 = `RA` // Returned unused/no longer needed
+	Table0513ReturnedUnusedKeepLinkedToPatient Table0513 = `RL` // Returned unused/keep linked to patient for possible use later
+	Table0513TransfusedWithAdverseReaction     Table0513 = `TR` // Transfused with adverse reaction
+	Table0513Transfused                        Table0513 = `TX` // Transfused
+	Table0513WastedProductNoLongerViable       Table0513 = `WA` // Wasted (product no longer viable)
+)
+
+// Description of the value, or empty if the value is not in table 0513.
+func (v Table0513) Description() string {
+	return tableDescription(`0513`, string(v))
+}
+
+// Valid reports if the value is in table 0513.
+func (v Table0513) Valid() bool {
+	return TableValueLookup[`0513`][string(v)]
+}
+
+// Table 0513.
+func (v Table0513) Table() Table {
+	return TableLookup[`0513`]
+}
+
+// Table0514 is a value of table 0514, Transfusion Adverse Reaction.
+// Fields in this table are strings; convert a field, such as Table0514(v), to use the helpers.
+type Table0514 string
+
+const (
+	Table0514ABOIncompatibleTransfusionReaction                  Table0514 = `ABOINC`     // ABO Incompatible Transfusion Reaction
+	Table0514AcuteHemolyticTransfusionReaction                   Table0514 = `ACUTHEHTR`  // Acute Hemolytic Transfusion Reaction
+	Table0514AllergicReactionFirst                               Table0514 = `ALLERGIC1`  // Allergic Reaction - First
+	Table0514AllergicReactionRecurrent                           Table0514 = `ALLERGIC2`  // Allergic Reaction - Recurrent
+	Table0514AllergicReactionRepeating                           Table0514 = `ALLERGICR`  // Allergic Reaction - Repeating
+	Table0514AnaphylacticReaction                                Table0514 = `ANAPHYLAC`  // Anaphylactic Reaction
+	Table0514ReactionToBacterialContamination                    Table0514 = `BACTCONTAM` // Reaction to Bacterial Contamination
+	Table0514DelayedHemolyticTransfusionReaction                 Table0514 = `DELAYEDHTR` // Delayed Hemolytic Transfusion Reaction
+	Table0514DelayedSerologicalTransfusionReaction               Table0514 = `DELAYEDSTR` // Delayed Serological Transfusion Reaction
+	Table0514GraftVsHostDiseaseTransfusionAssociated             Table0514 = `GVHD`       // Graft vs Host Disease - Transfusion - Associated
+	Table0514NonHemolyticHypotensiveReaction                     Table0514 = `HYPOTENS`   // Non-hemolytic Hypotensive Reaction
+	Table0514NonHemolyticFeverChillTransfusionReaction           Table0514 = `NONHTR1`    // Non-Hemolytic Fever Chill Transfusion Reaction - First
+	Table0514NonHemolyticFeverChillTransfusionReaction_NONHTR2   Table0514 = `NONHTR2`    // Non-Hemolytic Fever Chill Transfusion Reaction - Recurrent
+	Table0514NonHemolyticFeverChillTransfusionReaction_NONHTRREC Table0514 = `NONHTRREC`  // Non-Hemolytic Fever Chill Transfusion Reaction - Repeating
+	Table0514NonImmuneHemolysis                                  Table0514 = `NONIMMUNE`  // Non-Immune Hemolysis
+	Table0514NonSpecificNonHemolyticTransfusionReaction          Table0514 = `NONSPEC`    // Non-Specific, Non-Hemolytic Transfusion Reaction
+	Table0514NoEvidenceOfTransfusionReaction                     Table0514 = `NORXN`      // No Evidence of Transfusion Reaction
+	Table0514PosttransfusionPurpura                              Table0514 = `PTP`        // Posttransfusion Purpura
+	Table0514SymptomsMostLikelyDueToVolume                       Table0514 = `VOLOVER`    // Symptoms most likely due to volume overload
+)
+
+// Description of the value, or empty if the value is not in table 0514.
+func (v Table0514) Description() string {
+	return tableDescription(`0514`, string(v))
+}
+
+// Valid reports if the value is in table 0514.
+func (v Table0514) Valid() bool {
+	return TableValueLookup[`0514`][string(v)]
+}
+
+// Table 0514.
+func (v Table0514) Table() Table {
+	return TableLookup[`0514`]
+}
+
+// Table0516 is a value of table 0516, Error Severity.
+// Fields in this table are strings; convert a field, such as Table0516(v), to use the helpers.
+type Table0516 string
+
+const (
+	Table0516Error       Table0516 = `E` // Error
+	Table0516FatalError  Table0516 = `F` // Fatal Error
+	Table0516Information Table0516 = `I` // Information
+	Table0516Warning     Table0516 = `W` // Warning
+)
+
+// Description of the value, or empty if the value is not in table 0516.
+func (v Table0516) Description() string {
+	return tableDescription(`0516`, string(v))
+}
+
+// Valid reports if the value is in table 0516.
+func (v Table0516) Valid() bool {
+	return TableValueLookup[`0516`][string(v)]
+}
+
+// Table 0516.
+func (v Table0516) Table() Table {
+	return TableLookup[`0516`]
+}
+
+// Table0517 is a value of table 0517, Inform Person Code.
+// Fields in this table are strings; convert a field, such as Table0517(v), to use the helpers.
+type Table0517 string
+
+const (
+	Table0517InformHelpDesk     Table0517 = `HD`   // Inform help desk
+	Table0517DoNOTInformPatient Table0517 = `NPAT` // Do NOT inform patient
+	Table0517InformPatient      Table0517 = `PAT`  // Inform patient
+	Table0517InformUser         Table0517 = `USR`  // Inform User
+)
+
+// Description of the value, or empty if the value is not in table 0517.
+func (v Table0517) Description() string {
+	return tableDescription(`0517`, string(v))
+}
+
+// Valid reports if the value is in table 0517.
+func (v Table0517) Valid() bool {
+	return TableValueLookup[`0517`][string(v)]
+}
+
+// Table 0517.
+func (v Table0517) Table() Table {
+	return TableLookup[`0517`]
+}
+
+// Table0518 is a value of table 0518, Override Type.
+// Fields in this table are strings; convert a field, such as Table0518(v), to use the helpers.
+type Table0518 string
+
+const (
+	Table0518EquivalenceOverride Table0518 = `EQV`  // Equivalence Override
+	Table0518ExtensionOverride   Table0518 = `EXTN` // Extension Override
+	Table0518IntervalOverride    Table0518 = `INLV` // Interval Override
+)
+
+// Description of the value, or empty if the value is not in table 0518.
+func (v Table0518) Description() string {
+	return tableDescription(`0518`, string(v))
+}
+
+// Valid reports if the value is in table 0518.
+func (v Table0518) Valid() bool {
+	return TableValueLookup[`0518`][string(v)]
+}
+
+// Table 0518.
+func (v Table0518) Table() Table {
+	return TableLookup[`0518`]
+}
+
+// Table0520 is a value of table 0520, Message Waiting Priority.
+// Fields in this table are strings; convert a field, such as Table0520(v), to use the helpers.
+type Table0520 string
+
+const (
+	Table0520High   Table0520 = `H` // High
+	Table0520Low    Table0520 = `L` // Low
+	Table0520Medium Table0520 = `M` // Medium
+)
+
+// Description of the value, or empty if the value is not in table 0520.
+func (v Table0520) Description() string {
+	return tableDescription(`0520`, string(v))
+}
+
+// Valid reports if the value is in table 0520.
+func (v Table0520) Valid() bool {
+	return TableValueLookup[`0520`][string(v)]
+}
+
+// Table 0520.
+func (v Table0520) Table() Table {
+	return TableLookup[`0520`]
+}
+
+// Table0523 is a value of table 0523, Computation Type.
+// Fields in this table are strings; convert a field, such as Table0523(v), to use the helpers.
+type Table0523 string
+
+const (
+	Table0523IndicatesAPercentChange Table0523 = `%` // Indicates a percent change
+	Table0523AbsoluteChange          Table0523 = `a` // Absolute Change
+)
+
+// Description of the value, or empty if the value is not in table 0523.
+func (v Table0523) Description() string {
+	return tableDescription(`0523`, string(v))
+}
+
+// Valid reports if the value is in table 0523.
+func (v Table0523) Valid() bool {
+	return TableValueLookup[`0523`][string(v)]
+}
+
+// Table 0523.
+func (v Table0523) Table() Table {
+	return TableLookup[`0523`]
+}
+
+// Table0527 is a value of table 0527, Calendar Alignment.
+// Fields in this table are strings; convert a field, such as Table0527(v), to use the helpers.
+type Table0527 string
+
+const (
+	Table0527DayOfTheMonth          Table0527 = `DM` // day of the month
+	Table0527DayOfTheWeekBeginsWith Table0527 = `DW` // day of the week (begins with Monday)
+	Table0527DayOfTheYear           Table0527 = `DY` // day of the year
+	Table0527HourOfTheDay           Table0527 = `HD` // hour of the day
+	Table0527MonthOfTheYear         Table0527 = `MY` // month of the year
+	Table0527MinuteOfTheHour        Table0527 = `NH` // minute of the hour
+	Table0527SecondOfTheMinute      Table0527 = `SN` // second of the minute
+	Table0527WeekOfTheYear          Table0527 = `WY` // week of the year
+)
+
+// Description of the value, or empty if the value is not in table 0527.
+func (v Table0527) Description() string {
+	return tableDescription(`0527`, string(v))
+}
+
+// Valid reports if the value is in table 0527.
+func (v Table0527) Valid() bool {
+	return TableValueLookup[`0527`][string(v)]
+}
+
+// Table 0527.
+func (v Table0527) Table() Table {
+	return TableLookup[`0527`]
+}
+
+// Table0528 is a value of table 0528, Event Related Period.
+// Fields in this table are strings; convert a field, such as Table0528(v), to use the helpers.
+type Table0528 string
+
+const (
+	Table0528BeforeMealFromLatAnteCibus      Table0528 = `AC`  // before meal (from lat. ante cibus)
+	Table0528BeforeLunchFromLatAnteCibus     Table0528 = `ACD` // before lunch (from lat. ante cibus diurnus)
+	Table0528BeforeBreakfastFromLatAnteCibus Table0528 = `ACM` // before breakfast (from lat. ante cibus matutinus)
+	Table0528BeforeDinnerFromLatAnteCibus    Table0528 = `ACV` // before dinner (from lat. ante cibus vespertinus)
+	Table0528TheHourOfSleepEG                Table0528 = `HS`  // the hour of sleep (e.g., H18-22)
+	Table0528BetweenMealsFromLatInterCibus   Table0528 = `IC`  // between meals (from lat. inter cibus)
+	Table0528BetweenLunchAndDinner           Table0528 = `ICD` // between lunch and dinner
+	Table0528BetweenBreakfastAndLunch        Table0528 = `ICM` // between breakfast and lunch
+	Table0528BetweenDinnerAndTheHourOf       Table0528 = `ICV` // between dinner and the hour of sleep
+	Table0528AfterMealFromLatPostCibus       Table0528 = `PC`  // after meal (from lat. post cibus)
+	Table0528AfterLunchFromLatPostCibus      Table0528 = `PCD` // after lunch (from lat. post cibus diurnus)
+	Table0528AfterBreakfastFromLatPostCibus  Table0528 = `PCM` // after breakfast (from lat. post cibus matutinus)
+	Table0528AfterDinnerFromLatPostCibus     Table0528 = `PCV` // after dinner (from lat. post cibus vespertinus)
+)
+
+// Description of the value, or empty if the value is not in table 0528.
+func (v Table0528) Description() string {
+	return tableDescription(`0528`, string(v))
+}
+
+// Valid reports if the value is in table 0528.
+func (v Table0528) Valid() bool {
+	return TableValueLookup[`0528`][string(v)]
+}
+
+// Table 0528.
+func (v Table0528) Table() Table {
+	return TableLookup[`0528`]
+}
+
+// Table0532 is a value of table 0532, Expanded Yes/no Indicator.
+// Fields in this table are strings; convert a field, such as Table0532(v), to use the helpers.
+type Table0532 string
+
+const (
+	Table0532AskedButUnknown        Table0532 = `ASKU` // asked but unknown
+	Table0532No                     Table0532 = `N`    // No
+	Table0532NotApplicable          Table0532 = `NA`   // not applicable
+	Table0532NotAsked               Table0532 = `NASK` // not asked
+	Table0532TemporarilyUnavailable Table0532 = `NAV`  // temporarily unavailable
+	Table0532NoInformation          Table0532 = `NI`   // No Information
+	Table0532NotPresent             Table0532 = `NP`   // not present
+	Table0532Unknown                Table0532 = `UNK`  // unknown
+	Table0532Yes                    Table0532 = `Y`    // Yes
+)
+
+// Description of the value, or empty if the value is not in table 0532.
+func (v Table0532) Description() string {
+	return tableDescription(`0532`, string(v))
+}
+
+// Valid reports if the value is in table 0532.
+func (v Table0532) Valid() bool {
+	return TableValueLookup[`0532`][string(v)]
+}
+
+// Table 0532.
+func (v Table0532) Table() Table {
+	return TableLookup[`0532`]
+}
+
+// Table0534 is a value of table 0534, Notify Clergy Code.
+// Fields in this table are strings; convert a field, such as Table0534(v), to use the helpers.
+type Table0534 string
+
+const (
+	Table0534LastRitesOnly Table0534 = `L` // Last Rites only
+	Table0534No            Table0534 = `N` // No
+	Table0534Other         Table0534 = `O` // Other
+	Table0534Unknown       Table0534 = `U` // Unknown
+	Table0534Yes           Table0534 = `Y` // Yes
+)
+
+// Description of the value, or empty if the value is not in table 0534.
+func (v Table0534) Description() string {
+	return tableDescription(`0534`, string(v))
+}
+
+// Valid reports if the value is in table 0534.
+func (v Table0534) Valid() bool {
+	return TableValueLookup[`0534`][string(v)]
+}
+
+// Table 0534.
+func (v Table0534) Table() Table {
+	return TableLookup[`0534`]
+}
+
+// Table0535 is a value of table 0535, Signature Code.
+// Fields in this table are strings; convert a field, such as Table0535(v), to use the helpers.
+type Table0535 string
+
+const (
+	Table0535SignedCMS1500ClaimFormOn                   Table0535 = `C` // Signed CMS-1500 claim form on file, e.g., authorization for release of any medical or other information necessary to process this claim and assignment of benefits.
+	Table0535SignedAuthorizationForAssignmentOfBenefits Table0535 = `M` // Signed authorization for assignment of benefits on file.
+	Table0535SignatureGeneratedByProviderBecauseThe     Table0535 = `P` // Signature generated by provider because the patient was not physically present for services.
+	Table0535SignedAuthorizationForReleaseOfAny         Table0535 = `S` // Signed authorization for release of any medical or other information necessary to process this claim on file.
+)
+
+// Description of the value, or empty if the value is not in table 0535.
+func (v Table0535) Description() string {
+	return tableDescription(`0535`, string(v))
+}
+
+// Valid reports if the value is in table 0535.
+func (v Table0535) Valid() bool {
+	return TableValueLookup[`0535`][string(v)]
+}
+
+// Table 0535.
+func (v Table0535) Table() Table {
+	return TableLookup[`0535`]
+}
+
+// Table0536 is a value of table 0536, Certificate Status.
+// Fields in this table are strings; convert a field, such as Table0536(v), to use the helpers.
+type Table0536 string
+
+const (
+	Table0536Expired     Table0536 = `E` // Expired
+	Table0536Inactive    Table0536 = `I` // Inactive
+	Table0536Provisional Table0536 = `P` // Provisional
+	Table0536Revoked     Table0536 = `R` // Revoked
+	Table0536ActiveValid Table0536 = `V` // Active/Valid
+)
+
+// Description of the value, or empty if the value is not in table 0536.
+func (v Table0536) Description() string {
+	return tableDescription(`0536`, string(v))
+}
+
+// Valid reports if the value is in table 0536.
+func (v Table0536) Valid() bool {
+	return TableValueLookup[`0536`][string(v)]
+}
+
+// Table 0536.
+func (v Table0536) Table() Table {
+	return TableLookup[`0536`]
+}
+
+// Table0538 is a value of table 0538, Institution Relationship Type.
+// Fields in this table are strings; convert a field, such as Table0538(v), to use the helpers.
+type Table0538 string
+
+const (
+	Table0538Contractor Table0538 = `CON` // Contractor
+	Table0538Consultant Table0538 = `CST` // Consultant
+	Table0538Employee   Table0538 = `EMP` // Employee
+	Table0538Volunteer  Table0538 = `VOL` // Volunteer
+)
+
+// Description of the value, or empty if the value is not in table 0538.
+func (v Table0538) Description() string {
+	return tableDescription(`0538`, string(v))
+}
+
+// Valid reports if the value is in table 0538.
+func (v Table0538) Valid() bool {
+	return TableValueLookup[`0538`][string(v)]
+}
+
+// Table 0538.
+func (v Table0538) Table() Table {
+	return TableLookup[`0538`]
+}
+
+// Table0540 is a value of table 0540, Inactive Reason Code.
+// Fields in this table are strings; convert a field, such as Table0540(v), to use the helpers.
+type Table0540 string
+
+const (
+	Table0540LeaveOfAbsence Table0540 = `L` // Leave of Absence
+	Table0540Retired        Table0540 = `R` // Retired
+	Table0540Termination    Table0540 = `T` // Termination
+)
+
+// Description of the value, or empty if the value is not in table 0540.
+func (v Table0540) Description() string {
+	return tableDescription(`0540`, string(v))
+}
+
+// Valid reports if the value is in table 0540.
+func (v Table0540) Valid() bool {
+	return TableValueLookup[`0540`][string(v)]
+}
+
+// Table 0540.
+func (v Table0540) Table() Table {
+	return TableLookup[`0540`]
+}
+
+// Table0544 is a value of table 0544, Container Condition.
+// Fields in this table are strings; convert a field, such as Table0544(v), to use the helpers.
+type Table0544 string
+
+const (
+	Table0544ContainerCracked                   Table0544 = `CC`    // Container Cracked
+	Table0544ContainerLeaking                   Table0544 = `CL`    // Container Leaking
+	Table0544ContainerTorn                      Table0544 = `CT`    // Container Torn
+	Table0544SealBroken                         Table0544 = `SB`    // Seal Broken
+	Table0544NotAmbientTemperature              Table0544 = `XAMB`  // Not Ambient temperature
+	Table0544NotBodyTemperature                 Table0544 = `XC37`  // Not Body temperature
+	Table0544NotCriticalAmbientTemperature      Table0544 = `XCAMB` // Not Critical ambient temperature
+	Table0544ExposedToAir                       Table0544 = `XCATM` // Exposed to Air
+	Table0544NotCriticalFrozenTemperature       Table0544 = `XCFRZ` // Not Critical frozen temperature
+	Table0544NotCriticalRefrigeratedTemperature Table0544 = `XCREF` // Not Critical refrigerated temperature
+	Table0544NotDeepFrozen                      Table0544 = `XDFRZ` // Not Deep frozen
+	Table0544NotDry                             Table0544 = `XDRY`  // Not Dry
+	Table0544NotFrozenTemperature               Table0544 = `XFRZ`  // Not Frozen temperature
+	Table0544MetalExposed                       Table0544 = `XMTLF` // Metal Exposed
+	Table0544NotLiquidNitrogen                  Table0544 = `XNTR`  // Not Liquid nitrogen
+	Table0544NotProtectedFromLight              Table0544 = `XPRTL` // Not Protected from light
+	Table0544Shaken                             Table0544 = `XPSA`  // Shaken
+	Table0544ExposedToShock                     Table0544 = `XPSO`  // Exposed to shock
+	Table0544NotRefrigeratedTemperature         Table0544 = `XREF`  // Not Refrigerated temperature
+	Table0544NotUltraFrozen                     Table0544 = `XUFRZ` // Not Ultra frozen
+	Table0544NotUpright                         Table0544 = `XUPR`  // Not Upright
+)
+
+// Description of the value, or empty if the value is not in table 0544.
+func (v Table0544) Description() string {
+	return tableDescription(`0544`, string(v))
+}
+
+// Valid reports if the value is in table 0544.
+func (v Table0544) Valid() bool {
+	return TableValueLookup[`0544`][string(v)]
+}
+
+// Table 0544.
+func (v Table0544) Table() Table {
+	return TableLookup[`0544`]
+}
+
+// Table0547 is a value of table 0547, Jurisdictional Breadth.
+// Fields in this table are strings; convert a field, such as Table0547(v), to use the helpers.
+type Table0547 string
+
+const (
+	Table0547CountyParish  Table0547 = `C` // County/Parish
+	Table0547Country       Table0547 = `N` // Country
+	Table0547StateProvince Table0547 = `S` // State/Province
+)
+
+// Description of the value, or empty if the value is not in table 0547.
+func (v Table0547) Description() string {
+	return tableDescription(`0547`, string(v))
+}
+
+// Valid reports if the value is in table 0547.
+func (v Table0547) Valid() bool {
+	return TableValueLookup[`0547`][string(v)]
+}
+
+// Table 0547.
+func (v Table0547) Table() Table {
+	return TableLookup[`0547`]
+}
+
+// Table0548 is a value of table 0548, Signatory's Relationship to Subject.
+// Fields in this table are strings; convert a field, such as Table0548(v), to use the helpers.
+type Table0548 string
+
+const (
+	Table0548Self                                          Table0548 = `1` // Self
+	Table0548Parent                                        Table0548 = `2` // Parent
+	Table0548NextOfKin                                     Table0548 = `3` // Next of Kin
+	Table0548DurablePowerOfAttorneyInHealthcare            Table0548 = `4` // Durable Power of Attorney in Healthcare Affairs
+	Table0548Conservator                                   Table0548 = `5` // Conservator
+	Table0548EmergentPractitionerPractitionerJudgingCaseAs Table0548 = `6` // Emergent Practitioner (practitioner judging case as emergency requiring care without a consent)
+	Table0548NonEmergentPractitionerIEMedical              Table0548 = `7` // Non-Emergent Practitioner (i.e. medical ethics committee)
+)
+
+// Description of the value, or empty if the value is not in table 0548.
+func (v Table0548) Description() string {
+	return tableDescription(`0548`, string(v))
+}
+
+// Valid reports if the value is in table 0548.
+func (v Table0548) Valid() bool {
+	return TableValueLookup[`0548`][string(v)]
+}
+
+// Table 0548.
+func (v Table0548) Table() Table {
+	return TableLookup[`0548`]
+}
+
+// Table0550 is a value of table 0550, Body Parts.
+// Fields in this table are strings; convert a field, such as Table0550(v), to use the helpers.
+type Table0550 string
+
+const (
+	Table0550ExternalJugular               Table0550 = `Â`      // External Jugular
+	Table0550Acetabulum                    Table0550 = `ACET`   // Acetabulum
+	Table0550Achilles                      Table0550 = `ACHIL`  // Achilles
+	Table0550Abdomen                       Table0550 = `ADB`    // Abdomen
+	Table0550Adenoids                      Table0550 = `ADE`    // Adenoids
+	Table0550Adrenal                       Table0550 = `ADR`    // Adrenal
+	Table0550AmnioticFluid                 Table0550 = `AMN`    // Amniotic fluid
+	Table0550AmnioticSac                   Table0550 = `AMS`    // Amniotic Sac
+	Table0550Anal                          Table0550 = `ANAL`   // Anal
+	Table0550Ankle                         Table0550 = `ANKL`   // Ankle
+	Table0550Antecubital                   Table0550 = `ANTEC`  // Antecubital
+	Table0550AntecubitalFossa              Table0550 = `ANTECF` // Antecubital Fossa
+	Table0550Antrum                        Table0550 = `ANTR`   // Antrum
+	Table0550Anus                          Table0550 = `ANUS`   // Anus
+	Table0550Aorta                         Table0550 = `AORTA`  // Aorta
+	Table0550Appendix                      Table0550 = `APDX`   // Appendix
+	Table0550AorticRim                     Table0550 = `AR`     // Aortic Rim
+	Table0550Areola                        Table0550 = `AREO`   // Areola
+	Table0550Arm                           Table0550 = `ARM`    // Arm
+	Table0550Artery                        Table0550 = `ARTE`   // Artery
+	Table0550Ascites                       Table0550 = `ASCIT`  // Ascites
+	Table0550AsciticFluid                  Table0550 = `ASCT`   // Ascitic Fluid
+	Table0550Atrium                        Table0550 = `ATR`    // Atrium
+	Table0550Auricular                     Table0550 = `AURI`   // Auricular
+	Table0550AorticValve                   Table0550 = `AV`     // Aortic Valve
+	Table0550Axilla                        Table0550 = `AXI`    // Axilla
+	Table0550Back                          Table0550 = `BACK`   // Back
+	Table0550BartholinDuct                 Table0550 = `BARTD`  // Bartholin Duct
+	Table0550BartholinGland                Table0550 = `BARTG`  // Bartholin Gland
+	Table0550BrainCystFluid                Table0550 = `BCYS`   // Brain Cyst Fluid
+	Table0550BodyWhole                     Table0550 = `BDY`    // Body, Whole
+	Table0550BileDuct                      Table0550 = `BID`    // Bile Duct
+	Table0550BileFluid                     Table0550 = `BIFL`   // Bile fluid
+	Table0550Bladder                       Table0550 = `BLAD`   // Bladder
+	Table0550BloodWhole                    Table0550 = `BLD`    // Blood, Whole
+	Table0550BloodArterial                 Table0550 = `BLDA`   // Blood,  Arterial
+	Table0550BloodCapillary                Table0550 = `BLDC`   // Blood,  Capillary
+	Table0550BloodVenous                   Table0550 = `BLDV`   // Blood,  Venous
+	Table0550Blood                         Table0550 = `BLOOD`  // Blood
+	Table0550BoneMarrow                    Table0550 = `BMAR`   // Bone marrow
+	Table0550Bone                          Table0550 = `BON`    // Bone
+	Table0550Bowel                         Table0550 = `BOWEL`  // Bowel
+	Table0550BowelLarge                    Table0550 = `BOWLA`  // Bowel, Large
+	Table0550BowelSmall                    Table0550 = `BOWSM`  // Bowel, Small
+	Table0550Basophils                     Table0550 = `BPH`    // Basophils
+	Table0550Brachial                      Table0550 = `BRA`    // Brachial
+	Table0550Brain                         Table0550 = `BRAIN`  // Brain
+	Table0550Bronchial                     Table0550 = `BRO`    // Bronchial
+	Table0550BronchioleBronchiolar         Table0550 = `BROCH`  // Bronchiole/Bronchiolar
+	Table0550BronchusBronchial             Table0550 = `BRONC`  // Bronchus/Bronchial
+	Table0550Eyebrow                       Table0550 = `BROW`   // Eyebrow
+	Table0550Breast                        Table0550 = `BRST`   // Breast
+	Table0550BreastFluid                   Table0550 = `BRSTFL` // Breast fluid
+	Table0550BartholinGlandFluid           Table0550 = `BRTGF`  // Bartholin Gland Fluid
+	Table0550Broviac                       Table0550 = `BRV`    // Broviac
+	Table0550Buccal                        Table0550 = `BUCCA`  // Buccal
+	Table0550Bursa                         Table0550 = `BURSA`  // Bursa
+	Table0550BursaFluid                    Table0550 = `BURSF`  // Bursa Fluid
+	Table0550Buttocks                      Table0550 = `BUTT`   // Buttocks
+	Table0550Calf                          Table0550 = `CALF`   // Calf
+	Table0550Canal                         Table0550 = `CANAL`  // Canal
+	Table0550Canaliculis                   Table0550 = `CANLI`  // Canaliculis
+	Table0550Canthus                       Table0550 = `CANTH`  // Canthus
+	Table0550Carotid                       Table0550 = `CARO`   // Carotid
+	Table0550Carpal                        Table0550 = `CARP`   // Carpal
+	Table0550Cavity                        Table0550 = `CAVIT`  // Cavity
+	Table0550BloodCord                     Table0550 = `CBLD`   // Blood, Cord
+	Table0550CardiacMuscle                 Table0550 = `CDM`    // Cardiac Muscle
+	Table0550CommonDuct                    Table0550 = `CDUCT`  // Common Duct
+	Table0550CecumCecal                    Table0550 = `CECUM`  // Cecum/Cecal
+	Table0550CervixUterus                  Table0550 = `CERVUT` // Cervix/Uterus
+	Table0550CavityChest                   Table0550 = `CHE`    // Cavity, Chest
+	Table0550Cheek                         Table0550 = `CHEEK`  // Cheek
+	Table0550Chest                         Table0550 = `CHES`   // Chest
+	Table0550ChestTube                     Table0550 = `CHESTÂ` // Chest Tube
+	Table0550Chin                          Table0550 = `CHIN`   // Chin
+	Table0550CircumcisionSite              Table0550 = `CIRCU`  // Circumcision Site
+	Table0550ClavicleClavicular            Table0550 = `CLAVI`  // Clavicle/Clavicular
+	Table0550Clitoris                      Table0550 = `CLIT`   // Clitoris
+	Table0550Clitoral                      Table0550 = `CLITO`  // Clitoral
+	Table0550Cannula                       Table0550 = `CNL`    // Cannula
+	Table0550Coccygeal                     Table0550 = `COCCG`  // Coccygeal
+	Table0550Coccyx                        Table0550 = `COCCY`  // Coccyx
+	Table0550Colon                         Table0550 = `COLON`  // Colon
+	Table0550Colostomy                     Table0550 = `COLOS`  // Colostomy
+	Table0550Conjunctiva                   Table0550 = `CONJ`   // Conjunctiva
+	Table0550Cord                          Table0550 = `COR`    // Cord
+	Table0550Coral                         Table0550 = `CORAL`  // Coral
+	Table0550CordBlood                     Table0550 = `CORD`   // Cord Blood
+	Table0550Cornea                        Table0550 = `CORN`   // Cornea
+	Table0550ColostomyStoma                Table0550 = `COS`    // Colostomy Stoma
+	Table0550CraniumEthmoid                Table0550 = `CRANE`  // Cranium, ethmoid
+	Table0550CraniumFrontal                Table0550 = `CRANF`  // Cranium, frontal
+	Table0550CraniumOccipital              Table0550 = `CRANO`  // Cranium, occipital
+	Table0550CraniumParietal               Table0550 = `CRANP`  // Cranium, parietal
+	Table0550CraniumSphenoid               Table0550 = `CRANS`  // Cranium, sphenoid
+	Table0550CraniumTemporal               Table0550 = `CRANT`  // Cranium, temporal
+	Table0550CerebralSpinalFluid           Table0550 = `CSF`    // Cerebral Spinal Fluid
+	Table0550Cubitus                       Table0550 = `CUBIT`  // Cubitus
+	Table0550Cuff                          Table0550 = `CUFF`   // Cuff
+	Table0550CulDeSac                      Table0550 = `CULD`   // Cul De Sac
+	Table0550Culdocentesis                 Table0550 = `CULDO`  // Culdocentesis
+	Table0550Cervix                        Table0550 = `CVX`    // Cervix
+	Table0550Deltoid                       Table0550 = `DELT`   // Deltoid
+	Table0550DentalGingiva                 Table0550 = `DEN`    // Dental Gingiva
+	Table0550Dental                        Table0550 = `DENTA`  // Dental
+	Table0550DialysisFluid                 Table0550 = `DIAF`   // Dialysis Fluid
+	Table0550Digit                         Table0550 = `DIGIT`  // Digit
+	Table0550Disc                          Table0550 = `DISC`   // Disc
+	Table0550DorsumDorsal                  Table0550 = `DORS`   // Dorsum/Dorsal
+	Table0550Diaphragm                     Table0550 = `DPH`    // Diaphragm
+	Table0550DuodenalFluid                 Table0550 = `DUFL`   // Duodenal Fluid
+	Table0550DuodenumDuodenal              Table0550 = `DUODE`  // Duodenum/Duodenal
+	Table0550Dura                          Table0550 = `DUR`    // Dura
+	Table0550Ear                           Table0550 = `EAR`    // Ear
+	Table0550EarBoneIncus                  Table0550 = `EARBI`  // Ear bone, incus
+	Table0550EarBoneMalleus                Table0550 = `EARBM`  // Ear bone, malleus
+	Table0550EarBoneStapes                 Table0550 = `EARBS`  // Ear bone,stapes
+	Table0550EarLobe                       Table0550 = `EARLO`  // Ear Lobe
+	Table0550Endocervical                  Table0550 = `EC`     // Endocervical
+	Table0550Elbow                         Table0550 = `ELBOW`  // Elbow
+	Table0550ElbowJoint                    Table0550 = `ELBOWJ` // Elbow Joint
+	Table0550Endocardium                   Table0550 = `ENDC`   // Endocardium
+	Table0550Endometrium                   Table0550 = `ENDM`   // Endometrium
+	Table0550Endolpthamitis                Table0550 = `EOLPH`  // endolpthamitis
+	Table0550Eosinophils                   Table0550 = `EOS`    // Eosinophils
+	Table0550Epididymis                    Table0550 = `EPD`    // Epididymis
+	Table0550Epicardial                    Table0550 = `EPICA`  // Epicardial
+	Table0550Epicardium                    Table0550 = `EPICM`  // Epicardium
+	Table0550Epidural                      Table0550 = `EPIDU`  // Epidural
+	Table0550Epiglottis                    Table0550 = `EPIGL`  // Epiglottis
+	Table0550Esophagus                     Table0550 = `ESO`    // Esophagus
+	Table0550Esophageal                    Table0550 = `ESOPG`  // Esophageal
+	Table0550Endotracheal                  Table0550 = `ET`     // Endotracheal
+	Table0550Ethmoid                       Table0550 = `ETHMO`  // Ethmoid
+	Table0550Endourethral                  Table0550 = `EUR`    // Endourethral
+	Table0550Eye                           Table0550 = `EYE`    // Eye
+	Table0550Eyelid                        Table0550 = `EYELI`  // Eyelid
+	Table0550Face                          Table0550 = `FACE`   // Face
+	Table0550FallopianTube                 Table0550 = `FALLT`  // Fallopian Tube
+	Table0550FacialBoneInferiorNasalConcha Table0550 = `FBINC`  // Facial bone, inferior nasal concha
+	Table0550FacialBoneLacrimal            Table0550 = `FBLAC`  // Facial bone, lacrimal
+	Table0550FacialBoneMaxilla             Table0550 = `FBMAX`  // Facial bone, maxilla
+	Table0550FacialBoneNasal               Table0550 = `FBNAS`  // Facial bone, nasal
+	Table0550FacialBonePalatine            Table0550 = `FBPAL`  // Facial bone, palatine
+	Table0550FacialBoneVomer               Table0550 = `FBVOM`  // Facial bone, vomer
+	Table0550FacialBoneZygomatic           Table0550 = `FBZYG`  // Facial bone, zygomatic
+	Table0550Femoral                       Table0550 = `FEMOR`  // Femoral
+	Table0550Femur                         Table0550 = `FEMUR`  // Femur
+	Table0550Fetus                         Table0550 = `FET`    // Fetus
+	Table0550Fibula                        Table0550 = `FIBU`   // Fibula
+	Table0550Finger                        Table0550 = `FING`   // Finger
+	Table0550FingerNail                    Table0550 = `FINGN`  // Finger Nail
+	Table0550FemoralHead                   Table0550 = `FMH`    // Femoral Head
+	Table0550Follicle                      Table0550 = `FOL`    // Follicle
+	Table0550Foot                          Table0550 = `FOOT`   // Foot
+	Table0550Forearm                       Table0550 = `FOREA`  // Forearm
+	Table0550Forehead                      Table0550 = `FOREH`  // Forehead
+	Table0550Foreskin                      Table0550 = `FORES`  // Foreskin
+	Table0550Fourchette                    Table0550 = `FOURC`  // Fourchette
+	Table0550GallBladder                   Table0550 = `GB`     // Gall Bladder
+	Table0550Genital                       Table0550 = `GEN`    // Genital
+	Table0550GenitalCervix                 Table0550 = `GENC`   // Genital Cervix
+	Table0550GenitalLochia                 Table0550 = `GENL`   // Genital Lochia
+	Table0550GenitalLesion                 Table0550 = `GL`     // Genital Lesion
+	Table0550Gland                         Table0550 = `GLAND`  // Gland
+	Table0550Glans                         Table0550 = `GLANS`  // Glans
+	Table0550Gluteus                       Table0550 = `GLUT`   // Gluteus
+	Table0550Gluteal                       Table0550 = `GLUTE`  // Gluteal
+	Table0550GluteusMedius                 Table0550 = `GLUTM`  // Gluteus Medius
+	Table0550Groin                         Table0550 = `GROIN`  // Groin
+	Table0550Gum                           Table0550 = `GUM`    // Gum
+	Table0550GenitalVulva                  Table0550 = `GVU`    // Genital - Vulva
+	Table0550Hallux                        Table0550 = `HAL`    // Hallux
+	Table0550Hand                          Table0550 = `HAND`   // Hand
+	Table0550Hair                          Table0550 = `HAR`    // Hair
+	Table0550Heart                         Table0550 = `HART`   // Heart
+	Table0550Head                          Table0550 = `HEAD`   // Head
+	Table0550Heel                          Table0550 = `HEEL`   // Heel
+	Table0550Hemorrhoid                    Table0550 = `HEM`    // Hemorrhoid
+	Table0550Hip                           Table0550 = `HIP`    // Hip
+	Table0550HipJoint                      Table0550 = `HIPJ`   // Hip Joint
+	Table0550Humerus                       Table0550 = `HUMER`  // Humerus
+	Table0550HeartValve                    Table0550 = `HV`     // Heart Valve
+	Table0550HeartValveBicuspid            Table0550 = `HVB`    // Heart Valve, Bicuspid
+	Table0550HeartValveTricuspid           Table0550 = `HVT`    // Heart Valve, Tricuspid
+	Table0550Hymen                         Table0550 = `HYMEN`  // Hymen
+	Table0550Intracervical                 Table0550 = `ICX`    // Intracervical
+	Table0550IlealConduit                  Table0550 = `ILC`    // Ileal Conduit
+	Table0550IlicalConduit                 Table0550 = `ILCON`  // Ilical Conduit
+	Table0550IliacCrest                    Table0550 = `ILCR`   // Iliac Crest
+	Table0550IlealLoop                     Table0550 = `ILE`    // Ileal Loop
+	Table0550Ileostomy                     Table0550 = `ILEOS`  // Ileostomy
+	Table0550Ileum                         Table0550 = `ILEUM`  // Ileum
+	Table0550Iliac                         Table0550 = `ILIAC`  // Iliac
+	Table0550Intranasal                    Table0550 = `INASA`  // Intranasal
+	Table0550Inguinal                      Table0550 = `INGUI`  // Inguinal
+	Table0550IntestineLarge                Table0550 = `INSTL`  // Intestine, Large
+	Table0550IntestineSmall                Table0550 = `INSTS`  // Intestine, Small
+	Table0550Intestine                     Table0550 = `INT`    // Intestine
+	Table0550Introitus                     Table0550 = `INTRO`  // Introitus
+	Table0550Intrauterine                  Table0550 = `INTRU`  // Intrauterine
+	Table0550Ischium                       Table0550 = `ISCHI`  // Ischium
+	Table0550LoopIshial                    Table0550 = `ISH`    // Loop, Ishial
+	Table0550Jaw                           Table0550 = `JAW`    // Jaw
+	Table0550JugularInternal               Table0550 = `JUGI`   // Jugular, Internal
+	Table0550Kidney                        Table0550 = `KIDNÂ`  // Kidney
+	Table0550Knee                          Table0550 = `KNEE`   // Knee
+	Table0550KneeFluid                     Table0550 = `KNEEF`  // Knee Fluid
+	Table0550KneeJoint                     Table0550 = `KNEEJ`  // Knee Joint
+	Table0550Labia                         Table0550 = `LABIA`  // Labia
+	Table0550LabiaMajora                   Table0550 = `LABMA`  // Labia Majora
+	Table0550LabiaMinora                   Table0550 = `LABMI`  // Labia Minora
+	Table0550Lacrimal                      Table0550 = `LACRI`  // Lacrimal
+	Table0550Lamella                       Table0550 = `LAM`    // Lamella
+	Table0550Larynx                        Table0550 = `LARYN`  // Larynx
+	Table0550Leg                           Table0550 = `LEG`    // Leg
+	Table0550Lens                          Table0550 = `LENS`   // Lens
+	Table0550Lingual                       Table0550 = `LING`   // Lingual
+	Table0550Lingula                       Table0550 = `LINGU`  // Lingula
+	Table0550Lip                           Table0550 = `LIP`    // Lip
+	Table0550Liver                         Table0550 = `LIVER`  // Liver
+	Table0550Lumen                         Table0550 = `LMN`    // Lumen
+	Table0550LymphNode                     Table0550 = `LN`     // Lymph Node
+	Table0550LymphNodeGroin                Table0550 = `LNG`    // Lymph Node, Groin
+	Table0550Lobe                          Table0550 = `LOBE`   // Lobe
+	Table0550Lochia                        Table0550 = `LOCH`   // Lochia
+	Table0550Lumbar                        Table0550 = `LUMBA`  // Lumbar
+	Table0550Lung                          Table0550 = `LUNG`   // Lung
+	Table0550Lymphocytes                   Table0550 = `LYM`    // Lymphocytes
+	Table0550Macrophages                   Table0550 = `MAC`    // Macrophages
+	Table0550Malleolus                     Table0550 = `MALLE`  // Malleolus
+	Table0550MandibleMandibular            Table0550 = `MANDI`  // Mandible/Mandibular
+	Table0550Marrow                        Table0550 = `MAR`    // Marrow
+	Table0550Mastoid                       Table0550 = `MAST`   // Mastoid
+	Table0550MaxillaMaxillary              Table0550 = `MAXIL`  // Maxilla/Maxillary
+	Table0550MaxillarySinus                Table0550 = `MAXS`   // Maxillary Sinus
+	Table0550Meatus                        Table0550 = `MEATU`  // Meatus
+	Table0550Meconium                      Table0550 = `MEC`    // Meconium
+	Table0550Mediastinum                   Table0550 = `MEDST`  // Mediastinum
+	Table0550Medullary                     Table0550 = `MEDU`   // Medullary
+	Table0550Metacarpal                    Table0550 = `METAC`  // Metacarpal
+	Table0550Metatarsal                    Table0550 = `METAT`  // Metatarsal
+	Table0550MilkBreast                    Table0550 = `MILK`   // Milk, Breast
+	Table0550MitralValve                   Table0550 = `MITRL`  // Mitral Valve
+	Table0550Molar                         Table0550 = `MOLAR`  // Molar
+	Table0550MonsUreteris                  Table0550 = `MONSU`  // Mons Ureteris
+	Table0550MonsVenerisMonsPubis          Table0550 = `MONSV`  // Mons Veneris(Mons Pubis)
+	Table0550Membrane                      Table0550 = `MOU`    // Membrane
+	Table0550Mouth                         Table0550 = `MOUTH`  // Mouth
+	Table0550MonsPubis                     Table0550 = `MP`     // Mons Pubis
+	Table0550Meninges                      Table0550 = `MPB`    // Meninges
+	Table0550Mrsa                          Table0550 = `MRSA2`  // Mrsa:
+	Table0550Myocardium                    Table0550 = `MYO`    // Myocardium
+	Table0550Nail                          Table0550 = `NAIL`   // Nail
+	Table0550NailBed                       Table0550 = `NAILB`  // Nail Bed
+	Table0550NailFinger                    Table0550 = `NAILF`  // Nail, Finger
+	Table0550NailToe                       Table0550 = `NAILT`  // Nail, Toe
+	Table0550Nares                         Table0550 = `NARES`  // Nares
+	Table0550Nasal                         Table0550 = `NASL`   // Nasal
+	Table0550Navel                         Table0550 = `NAVEL`  // Navel
+	Table0550Neck                          Table0550 = `NECK`   // Neck
+	Table0550Nerve                         Table0550 = `NERVE`  // Nerve
+	Table0550Nipple                        Table0550 = `NIPPL`  // Nipple
+	Table0550Nasolacrimal                  Table0550 = `NLACR`  // Nasolacrimal
+	Table0550NoseNasalPassage              Table0550 = `NOS`    // Nose (Nasal Passage)
+	Table0550NoseNoseOutside               Table0550 = `NOSE`   // Nose/Nose(outside)
+	Table0550Nostril                       Table0550 = `NOSTR`  // Nostril
+	Table0550NasopharyngealNasopharynx     Table0550 = `NP`     // Nasopharyngeal/Nasopharynx
+	Table0550NasalSeptum                   Table0550 = `NSS`    // Nasal Septum
+	Table0550Nasotracheal                  Table0550 = `NTRAC`  // Nasotracheal
+	Table0550Occipital                     Table0550 = `OCCIP`  // Occipital
+	Table0550Olecranon                     Table0550 = `OLECR`  // Olecranon
+	Table0550Omentum                       Table0550 = `OMEN`   // Omentum
+	Table0550OrbitOrbital                  Table0550 = `ORBIT`  // Orbit/Orbital
+	Table0550Oropharynx                    Table0550 = `ORO`    // Oropharynx
+	Table0550OsCoxaPelvicGirdle            Table0550 = `OSCOX`  // Os coxa (pelvic girdle)
+	Table0550Ovary                         Table0550 = `OVARY`  // Ovary
+	Table0550PancreaticFluid               Table0550 = `PAFL`   // Pancreatic Fluid
+	Table0550Palate                        Table0550 = `PALAT`  // Palate
+	Table0550Palm                          Table0550 = `PALM`   // Palm
+	Table0550PerianalPerirectal            Table0550 = `PANAL`  // Perianal/Perirectal
+	Table0550Pancreas                      Table0550 = `PANCR`  // Pancreas
+	Table0550Paratracheal                  Table0550 = `PARAT`  // Paratracheal
+	Table0550Parietal                      Table0550 = `PARIE`  // Parietal
+	Table0550Paronychia                    Table0550 = `PARON`  // Paronychia
+	Table0550ParotidParotidGland           Table0550 = `PAROT`  // Parotid/Parotid Gland
+	Table0550Parasternal                   Table0550 = `PAS`    // Parasternal
+	Table0550Patella                       Table0550 = `PATEL`  // Patella
+	Table0550Pericardium                   Table0550 = `PCARD`  // Pericardium
+	Table0550Periclitoral                  Table0550 = `PCLIT`  // Periclitoral
+	Table0550Pelvis                        Table0550 = `PELV`   // Pelvis
+	Table0550Penis                         Table0550 = `PENIS`  // Penis
+	Table0550PenileShaft                   Table0550 = `PENSH`  // Penile Shaft
+	Table0550Peritoneal                    Table0550 = `PER`    // Peritoneal
+	Table0550PericardialFluid              Table0550 = `PERI`   // Pericardial Fluid
+	Table0550Perihepatic                   Table0550 = `PERIH`  // Perihepatic
+	Table0550PerinealAbscess               Table0550 = `PERIN`  // Perineal Abscess
+	Table0550Perisplenic                   Table0550 = `PERIS`  // Perisplenic
+	Table0550Peritoneum                    Table0550 = `PERIT`  // Peritoneum
+	Table0550Periurethal                   Table0550 = `PERIU`  // Periurethal
+	Table0550Perivesicular                 Table0550 = `PERIV`  // Perivesicular
+	Table0550Perirectal                    Table0550 = `PERRA`  // Perirectal
+	Table0550PeritonealFluid               Table0550 = `PERT`   // Peritoneal Fluid
+	Table0550Phalanyx                      Table0550 = `PHALA`  // Phalanyx
+	Table0550Pilonidal                     Table0550 = `PILO`   // Pilonidal
+	Table0550Pinna                         Table0550 = `PINNA`  // Pinna
+	Table0550PlacentaFetalSide             Table0550 = `PLACF`  // Placenta (Fetal Side)
+	Table0550PlacentaMaternalSide          Table0550 = `PLACM`  // Placenta (Maternal Side)
+	Table0550Plantar                       Table0550 = `PLANT`  // Plantar
+	Table0550PalateHard                    Table0550 = `PLATH`  // Palate, Hard
+	Table0550PalateSoft                    Table0550 = `PLATS`  // Palate, Soft
+	Table0550Placenta                      Table0550 = `PLC`    // Placenta
+	Table0550PleuralFluid                  Table0550 = `PLEU`   // Pleural Fluid
+	Table0550Pleura                        Table0550 = `PLEUR`  // Pleura
+	Table0550PleuralFluidThoracentesisFld  Table0550 = `PLR`    // Pleural Fluid (Thoracentesis Fld)
+	Table0550Perineal                      Table0550 = `PNEAL`  // Perineal
+	Table0550Perinephric                   Table0550 = `PNEPH`  // Perinephric
+	Table0550Perineum                      Table0550 = `PNM`    // Perineum
+	Table0550Popliteal                     Table0550 = `POPLI`  // Popliteal
+	Table0550Periorbital                   Table0550 = `PORBI`  // Periorbital
+	Table0550Preauricular                  Table0550 = `PREAU`  // Preauricular
+	Table0550Prerenal                      Table0550 = `PRERE`  // Prerenal
+	Table0550ProstaticFluid                Table0550 = `PROS`   // Prostatic Fluid
+	Table0550ProstateGland                 Table0550 = `PRST`   // Prostate Gland
+	Table0550Peritonsillar                 Table0550 = `PTONS`  // Peritonsillar
+	Table0550Pubic                         Table0550 = `PUBIC`  // Pubic
+	Table0550PulmonaryArtery               Table0550 = `PUL`    // Pulmonary Artery
+	Table0550Radial                        Table0550 = `RADI`   // Radial
+	Table0550Radius                        Table0550 = `RADIUS` // Radius
+	Table0550RedBloodCells                 Table0550 = `RBC`    // Red Blood Cells
+	Table0550Rectal                        Table0550 = `RECTL`  // Rectal
+	Table0550Rectum                        Table0550 = `RECTU`  // Rectum
+	Table0550Renal                         Table0550 = `RENL`   // Renal
+	Table0550Rib                           Table0550 = `RIB`    // Rib
+	Table0550RenalPelvis                   Table0550 = `RNP`    // Renal Pelvis
+	Table0550Retroperitoneal               Table0550 = `RPERI`  // Retroperitoneal
+	Table0550UterineCulDeSac               Table0550 = `SAC`    // Uterine Cul/De/Sac
+	Table0550Sacroiliac                    Table0550 = `SACIL`  // Sacroiliac
+	Table0550Sacral                        Table0550 = `SACRA`  // Sacral
+	Table0550Sacrococcygeal                Table0550 = `SACRO`  // Sacrococcygeal
+	Table0550Sacrum                        Table0550 = `SACRU`  // Sacrum
+	Table0550SalivaryGland                 Table0550 = `SALGL`  // Salivary Gland
+	Table0550Scalp                         Table0550 = `SCALP`  // Scalp
+	Table0550ScapulaScapular               Table0550 = `SCAPU`  // Scapula/Scapular
+	Table0550SupraclavicleSupraclavicular  Table0550 = `SCLAV`  // Supraclavicle/Supraclavicular
+	Table0550Sclera                        Table0550 = `SCLER`  // Sclera
+	Table0550SubClavian                    Table0550 = `SCLV`   // Sub Clavian
+	Table0550ScrotumScrotal                Table0550 = `SCROT`  // Scrotum/Scrotal
+	Table0550Subdiaphramatic               Table0550 = `SDP`    // Subdiaphramatic
+	Table0550SeminalFluid                  Table0550 = `SEM`    // Seminal Fluid
+	Table0550Semen                         Table0550 = `SEMN`   // Semen
+	Table0550SeptumSeptal                  Table0550 = `SEPTU`  // Septum/Septal
+	Table0550Seroma                        Table0550 = `SEROM`  // Seroma
+	Table0550SubgalealFluid                Table0550 = `SGF`    // Subgaleal Fluid
+	Table0550Shin                          Table0550 = `SHIN`   // Shin
+	Table0550Shoulder                      Table0550 = `SHOL`   // Shoulder
+	Table0550SholderJoint                  Table0550 = `SHOLJ`  // Sholder Joint
+	Table0550Sigmoid                       Table0550 = `SIGMO`  // Sigmoid
+	Table0550Sinus                         Table0550 = `SINUS`  // Sinus
+	Table0550SkeneSGland                   Table0550 = `SKENE`  // Skene's Gland
+	Table0550SkeletalMuscle                Table0550 = `SKM`    // Skeletal Muscle
+	Table0550Skull                         Table0550 = `SKULL`  // Skull
+	Table0550Sole                          Table0550 = `SOLE`   // Sole
+	Table0550SpinalCord                    Table0550 = `SPCOR`  // Spinal Cord
+	Table0550Sphenoid                      Table0550 = `SPHEN`  // Sphenoid
+	Table0550Spleen                        Table0550 = `SPLN`   // Spleen
+	Table0550Spermatozoa                   Table0550 = `SPRM`   // Spermatozoa
+	Table0550SupraCervical                 Table0550 = `SPX`    // Supra Cervical
+	Table0550SternumSternal                Table0550 = `STER`   // Sternum/Sternal
+	Table0550Stoma                         Table0550 = `STOM`   // Stoma
+	Table0550Stomach                       Table0550 = `STOMA`  // Stomach
+	Table0550LiquidStool                   Table0550 = `STOOLL` // Liquid Stool
+	Table0550Stump                         Table0550 = `STUMP`  // Stump
+	Table0550Subdural                      Table0550 = `SUB`    // Subdural
+	Table0550SubduralFluid                 Table0550 = `SUBD`   // Subdural Fluid
+	Table0550Submandibular                 Table0550 = `SUBM`   // Submandibular
+	Table0550Submental                     Table0550 = `SUBME`  // Submental
+	Table0550Subphrenic                    Table0550 = `SUBPH`  // Subphrenic
+	Table0550Submaxillary                  Table0550 = `SUBX`   // Submaxillary
+	Table0550SuprapubicSpecimen            Table0550 = `SUPB`   // Suprapubic Specimen
+	Table0550Suprapubic                    Table0550 = `SUPRA`  // Suprapubic
+	Table0550Sweat                         Table0550 = `SWT`    // Sweat
+	Table0550SweatGland                    Table0550 = `SWTG`   // Sweat Gland
+	Table0550SynovialFluid                 Table0550 = `SYN`    // Synovial Fluid
+	Table0550Synovial                      Table0550 = `SYNOL`  // Synovial
+	Table0550Synovium                      Table0550 = `SYNOV`  // Synovium
+	Table0550Tarsal                        Table0550 = `TARS`   // Tarsal
+	Table0550Transbronchial                Table0550 = `TBRON`  // Transbronchial
+	Table0550TranscarinaAsp                Table0550 = `TCN`    // Transcarina Asp
+	Table0550TearDuct                      Table0550 = `TDUCT`  // Tear Duct
+	Table0550Tears                         Table0550 = `TEAR`   // Tears
+	Table0550Temple                        Table0550 = `TEMPL`  // Temple
+	Table0550Temporal                      Table0550 = `TEMPO`  // Temporal
+	Table0550TesticleTestis                Table0550 = `TESTI`  // Testicle(Testis)
+	Table0550Thigh                         Table0550 = `THIGH`  // Thigh
+	Table0550Thymus                        Table0550 = `THM`    // Thymus
+	Table0550ThoraxThoracicThoracentesis   Table0550 = `THORA`  // Thorax/Thoracic/Thoracentesis
+	Table0550Throat                        Table0550 = `THRB`   // Throat
+	Table0550Thumb                         Table0550 = `THUMB`  // Thumb
+	Table0550Thyroid                       Table0550 = `THYRD`  // Thyroid
+	Table0550Tibia                         Table0550 = `TIBIA`  // Tibia
+	Table0550TemporalLobe                  Table0550 = `TML`    // Temporal Lobe
+	Table0550Thumbnail                     Table0550 = `TNL`    // Thumbnail
+	Table0550Toe                           Table0550 = `TOE`    // Toe
+	Table0550ToeNail                       Table0550 = `TOEN`   // Toe Nail
+	Table0550Tongue                        Table0550 = `TONG`   // Tongue
+	Table0550Tonsil                        Table0550 = `TONS`   // Tonsil
+	Table0550Tooth                         Table0550 = `TOOTH`  // Tooth
+	Table0550TracheaTracheal               Table0550 = `TRCHE`  // Trachea/Tracheal
+	Table0550ToothSocket                   Table0550 = `TSK`    // Tooth Socket
+	Table0550UlnaUlnar                     Table0550 = `ULNA`   // Ulna/Ulnar
+	Table0550UmbilicalBlood                Table0550 = `UMB`    // Umbilical Blood
+	Table0550UmbilicusUmbilical            Table0550 = `UMBL`   // Umbilicus/Umbilical
+	Table0550Ureter                        Table0550 = `URET`   // Ureter
+	Table0550Urethra                       Table0550 = `URTH`   // Urethra
+	Table0550StomaUrinary                  Table0550 = `USTOM`  // Stoma, Urinary
+	Table0550Uterus                        Table0550 = `UTER`   // Uterus
+	Table0550Uterine                       Table0550 = `UTERI`  // Uterine
+	Table0550VaginaVaginal                 Table0550 = `VAGIN`  // Vagina/Vaginal
+	Table0550Valve                         Table0550 = `VAL`    // Valve
+	Table0550VasDeferens                   Table0550 = `VAS`    // Vas Deferens
+	Table0550VastusLateralis               Table0550 = `VASTL`  // Vastus Lateralis
+	Table0550Vault                         Table0550 = `VAULT`  // Vault
+	Table0550VentricularCSF                Table0550 = `VCSF`   // Ventricular CSF
+	Table0550VaginalCuff                   Table0550 = `VCUFF`  // Vaginal Cuff
+	Table0550Vein                          Table0550 = `VEIN`   // Vein
+	Table0550Ventragluteal                 Table0550 = `VENTG`  // Ventragluteal
+	Table0550VermisCerebelli               Table0550 = `VERMI`  // Vermis Cerebelli
+	Table0550VertebraCervical              Table0550 = `VERTC`  // Vertebra, cervical
+	Table0550VertebraLumbar                Table0550 = `VERTL`  // Vertebra, lumbar
+	Table0550VertebraThoracic              Table0550 = `VERTT`  // Vertebra, thoracic
+	Table0550Vesicular                     Table0550 = `VESCL`  // Vesicular
+	Table0550VesicularFluid                Table0550 = `VESFLD` // Vesicular Fluid
+	Table0550Vesicle                       Table0550 = `VESI`   // Vesicle
+	Table0550VestibuleGenital              Table0550 = `VESTI`  // Vestibule(Genital)
+	Table0550VaginalVault                  Table0550 = `VGV`    // Vaginal Vault
+	Table0550VitreousFluid                 Table0550 = `VITR`   // Vitreous Fluid
+	Table0550VocalCord                     Table0550 = `VOC`    // Vocal Cord
+	Table0550Vulva                         Table0550 = `VULVA`  // Vulva
+	Table0550Leukocytes                    Table0550 = `WBC`    // Leukocytes
+	Table0550Wrist                         Table0550 = `WRIST`  // Wrist
+)
+
+// Description of the value, or empty if the value is not in table 0550.
+func (v Table0550) Description() string {
+	return tableDescription(`0550`, string(v))
+}
+
+// Valid reports if the value is in table 0550.
+func (v Table0550) Valid() bool {
+	return TableValueLookup[`0550`][string(v)]
+}
+
+// Table 0550.
+func (v Table0550) Table() Table {
+	return TableLookup[`0550`]
+}
+
+// Table0553 is a value of table 0553, Invoice Control Code.
+// Fields in this table are strings; convert a field, such as Table0553(v), to use the helpers.
+type Table0553 string
+
+const (
+	Table0553AuthorizationRequestForInpatientAdmission     Table0553 = `AA` // Authorization request for inpatient admission
+	Table0553CombinedAuthorizationAndAdjudicationRequest   Table0553 = `AI` // Combined Authorization and Adjudication request
+	Table0553CancelAuthorizationRequest                    Table0553 = `CA` // Cancel Authorization request
+	Table0553CancelInvoiceProductServiceGroup              Table0553 = `CG` // Cancel Invoice Product/Service Group
+	Table0553CancelInvoiceProductServiceLineItem           Table0553 = `CL` // Cancel Invoice Product/Service Line Item
+	Table0553CancelInvoice                                 Table0553 = `CN` // Cancel Invoice
+	Table0553CopyOfInvoice                                 Table0553 = `CP` // Copy of Invoice
+	Table0553CoverageRegisterQuery                         Table0553 = `CQ` // Coverage Register Query
+	Table0553AuthorizationRequestForInpatientStayExtension Table0553 = `EA` // Authorization request for inpatient stay extension
+	Table0553OriginalAuthorization                         Table0553 = `OA` // Original Authorization
+	Table0553OriginalInvoice                               Table0553 = `OR` // Original Invoice
+	Table0553PreAuthorization                              Table0553 = `PA` // Pre-Authorization
+	Table0553PreDeterminationInvoice                       Table0553 = `PD` // Pre-Determination Invoice
+	Table0553ReAssessment                                  Table0553 = `RA` // Re-Assessment
+	Table0553ReferralPreAuthorization                      Table0553 = `RC` // Referral Pre-Authorization
+	Table0553ReferralAuthorization                         Table0553 = `RU` // Referral authorization
+	Table0553SpecialAuthorization                          Table0553 = `SA` // Special Authorization
+)
+
+// Description of the value, or empty if the value is not in table 0553.
+func (v Table0553) Description() string {
+	return tableDescription(`0553`, string(v))
+}
+
+// Valid reports if the value is in table 0553.
+func (v Table0553) Valid() bool {
+	return TableValueLookup[`0553`][string(v)]
+}
+
+// Table 0553.
+func (v Table0553) Table() Table {
+	return TableLookup[`0553`]
+}
+
+// Table0554 is a value of table 0554, Invoice Reason Codes.
+// Fields in this table are strings; convert a field, such as Table0554(v), to use the helpers.
+type Table0554 string
+
+const (
+	Table0554LateInvoice               Table0554 = `LATE` // Late Invoice
+	Table0554NormalSubmission          Table0554 = `NORM` // Normal submission
+	Table0554SubscriberCoverageProblem Table0554 = `SUB`  // Subscriber coverage problem
+)
+
+// Description of the value, or empty if the value is not in table 0554.
+func (v Table0554) Description() string {
+	return tableDescription(`0554`, string(v))
+}
+
+// Valid reports if the value is in table 0554.
+func (v Table0554) Valid() bool {
+	return TableValueLookup[`0554`][string(v)]
+}
+
+// Table 0554.
+func (v Table0554) Table() Table {
+	return TableLookup[`0554`]
+}
+
+// Table0555 is a value of table 0555, Invoice Type.
+// Fields in this table are strings; convert a field, such as Table0555(v), to use the helpers.
+type Table0555 string
+
+const (
+	Table0555Block           Table0555 = `BK` // Block
+	Table0555Final           Table0555 = `FN` // Final
+	Table0555FeeForService   Table0555 = `FS` // Fee for Service
+	Table0555Group           Table0555 = `GP` // Group
+	Table0555InformationOnly Table0555 = `IN` // Information Only
+	Table0555NonPatient      Table0555 = `NP` // Non Patient
+	Table0555Partial         Table0555 = `PA` // Partial
+	Table0555Salary          Table0555 = `SL` // Salary
+	Table0555BySession       Table0555 = `SS` // By Session
+	Table0555Supplemental    Table0555 = `SU` // Supplemental
+)
+
+// Description of the value, or empty if the value is not in table 0555.
+func (v Table0555) Description() string {
+	return tableDescription(`0555`, string(v))
+}
+
+// Valid reports if the value is in table 0555.
+func (v Table0555) Valid() bool {
+	return TableValueLookup[`0555`][string(v)]
+}
+
+// Table 0555.
+func (v Table0555) Table() Table {
+	return TableLookup[`0555`]
+}
+
+// Table0556 is a value of table 0556, Benefit Group.
+// Fields in this table are strings; convert a field, such as Table0556(v), to use the helpers.
+type Table0556 string
+
+const (
+	Table0556AMBULATORYCARE Table0556 = `AMB`  // AMBULATORY CARE
+	Table0556DENTAL         Table0556 = `DENT` // DENTAL
+)
+
+// Description of the value, or empty if the value is not in table 0556.
+func (v Table0556) Description() string {
+	return tableDescription(`0556`, string(v))
+}
+
+// Valid reports if the value is in table 0556.
+func (v Table0556) Valid() bool {
+	return TableValueLookup[`0556`][string(v)]
+}
+
+// Table 0556.
+func (v Table0556) Table() Table {
+	return TableLookup[`0556`]
+}
+
+// Table0557 is a value of table 0557, Payee Type.
+// Fields in this table are strings; convert a field, such as Table0557(v), to use the helpers.
+type Table0557 string
+
+const (
+	Table0557Employer          Table0557 = `EMPL` // Employer
+	Table0557PayeeOrganization Table0557 = `ORG`  // Payee Organization
+	Table0557Person            Table0557 = `PERS` // Person
+	Table0557PayPerson         Table0557 = `PPER` // Pay Person
+)
+
+// Description of the value, or empty if the value is not in table 0557.
+func (v Table0557) Description() string {
+	return tableDescription(`0557`, string(v))
+}
+
+// Valid reports if the value is in table 0557.
+func (v Table0557) Valid() bool {
+	return TableValueLookup[`0557`][string(v)]
+}
+
+// Table 0557.
+func (v Table0557) Table() Table {
+	return TableLookup[`0557`]
+}
+
+// Table0558 is a value of table 0558, Payee Relationship to Invoice.
+// Fields in this table are strings; convert a field, such as Table0558(v), to use the helpers.
+type Table0558 string
+
+const (
+	Table0558FamilyMember Table0558 = `FM` // Family Member
+	Table0558Guarantor    Table0558 = `GT` // Guarantor
+	Table0558Patient      Table0558 = `PT` // Patient
+	Table0558Subscriber   Table0558 = `SB` // Subscriber
+)
+
+// Description of the value, or empty if the value is not in table 0558.
+func (v Table0558) Description() string {
+	return tableDescription(`0558`, string(v))
+}
+
+// Valid reports if the value is in table 0558.
+func (v Table0558) Valid() bool {
+	return TableValueLookup[`0558`][string(v)]
+}
+
+// Table 0558.
+func (v Table0558) Table() Table {
+	return TableLookup[`0558`]
+}
+
+// Table0559 is a value of table 0559, Product/Service Status.
+// Fields in this table are strings; convert a field, such as Table0559(v), to use the helpers.
+type Table0559 string
+
+const (
+	Table0559Denied    Table0559 = `D` // Denied
+	Table0559Processed Table0559 = `P` // Processed
+	Table0559Rejected  Table0559 = `R` // Rejected
+)
+
+// Description of the value, or empty if the value is not in table 0559.
+func (v Table0559) Description() string {
+	return tableDescription(`0559`, string(v))
+}
+
+// Valid reports if the value is in table 0559.
+func (v Table0559) Valid() bool {
+	return TableValueLookup[`0559`][string(v)]
+}
+
+// Table 0559.
+func (v Table0559) Table() Table {
+	return TableLookup[`0559`]
+}
+
+// Table0561 is a value of table 0561, Product/Services Clarification Codes.
+// Fields in this table are strings; convert a field, such as Table0561(v), to use the helpers.
+type Table0561 string
+
+const (
+	Table0561ClaimCenter              Table0561 = `CLCTR` // Claim Center
+	Table0561DiagnosticApprovalNumber Table0561 = `DGAPP` // Diagnostic Approval Number
+	Table0561DataCenterNumber         Table0561 = `DTCTR` // Data Center Number
+	Table0561EncounterNumber          Table0561 = `ENC`   // Encounter Number
+	Table0561GoodFaithIndicator       Table0561 = `GFTH`  // Good Faith Indicator
+	Table0561OutOfProvinceIndicator   Table0561 = `OOP`   // Out of Province Indicator
+	Table0561SequenceNumber           Table0561 = `SEQ`   // Sequence Number
+)
+
+// Description of the value, or empty if the value is not in table 0561.
+func (v Table0561) Description() string {
+	return tableDescription(`0561`, string(v))
+}
+
+// Valid reports if the value is in table 0561.
+func (v Table0561) Valid() bool {
+	return TableValueLookup[`0561`][string(v)]
+}
+
+// Table 0561.
+func (v Table0561) Table() Table {
+	return TableLookup[`0561`]
+}
+
+// Table0562 is a value of table 0562, Processing Consideration Codes.
+// Fields in this table are strings; convert a field, such as Table0562(v), to use the helpers.
+type Table0562 string
+
+const (
+	Table0562DeferredAdjudicationProcessing Table0562 = `DFADJ`    // Deferred Adjudication Processing
+	Table0562ElectronicFormToFollow         Table0562 = `EFORM`    // Electronic form to follow
+	Table0562FaxToFollow                    Table0562 = `FAX`      // Fax to follow
+	Table0562PaperDocumentationToFollow     Table0562 = `PAPER`    // Paper documentation to follow
+	Table0562DelayedByAPreviousPayer        Table0562 = `PYRDELAY` // Delayed by a Previous Payer
+	Table0562RealTimeAdjudicationProcessing Table0562 = `RTADJ`    // Real Time Adjudication Processing
+)
+
+// Description of the value, or empty if the value is not in table 0562.
+func (v Table0562) Description() string {
+	return tableDescription(`0562`, string(v))
+}
+
+// Valid reports if the value is in table 0562.
+func (v Table0562) Valid() bool {
+	return TableValueLookup[`0562`][string(v)]
+}
+
+// Table 0562.
+func (v Table0562) Table() Table {
+	return TableLookup[`0562`]
+}
+
+// Table0564 is a value of table 0564, Adjustment Category Code.
+// Fields in this table are strings; convert a field, such as Table0564(v), to use the helpers.
+type Table0564 string
+
+const (
+	Table0564EditAdjudicationResponse Table0564 = `EA` // Edit/Adjudication Response
+	Table0564Information              Table0564 = `IN` // Information
+	Table0564ProviderAdjustment       Table0564 = `PA` // Provider Adjustment
+	Table0564ProcessingResult         Table0564 = `PR` // Processing Result
+)
+
+// Description of the value, or empty if the value is not in table 0564.
+func (v Table0564) Description() string {
+	return tableDescription(`0564`, string(v))
+}
+
+// Valid reports if the value is in table 0564.
+func (v Table0564) Valid() bool {
+	return TableValueLookup[`0564`][string(v)]
+}
+
+// Table 0564.
+func (v Table0564) Table() Table {
+	return TableLookup[`0564`]
+}
+
+// Table0565 is a value of table 0565, Provider Adjustment Reason Code.
+// Fields in this table are strings; convert a field, such as Table0565(v), to use the helpers.
+type Table0565 string
+
+const (
+	Table0565DispensingFee       Table0565 = `DISP` // Dispensing Fee
+	Table0565GoodsAndServicesTax Table0565 = `GST`  // Goods and Services Tax
+	Table0565HarmonizedSalesTax  Table0565 = `HST`  // Harmonized Sales Tax
+	Table0565MarkUpFee           Table0565 = `MKUP` // Mark up Fee
+	Table0565ProvincialSalesTax  Table0565 = `PST`  // Provincial Sales Tax
+)
+
+// Description of the value, or empty if the value is not in table 0565.
+func (v Table0565) Description() string {
+	return tableDescription(`0565`, string(v))
+}
+
+// Valid reports if the value is in table 0565.
+func (v Table0565) Valid() bool {
+	return TableValueLookup[`0565`][string(v)]
+}
+
+// Table 0565.
+func (v Table0565) Table() Table {
+	return TableLookup[`0565`]
+}
+
+// Table0569 is a value of table 0569, Adjustment Action.
+// Fields in this table are strings; convert a field, such as Table0569(v), to use the helpers.
+type Table0569 string
+
+const (
+	Table0569PrintOnEOB     Table0569 = `EOB` // Print on EOB
+	Table0569InformPatient  Table0569 = `PAT` // Inform Patient
+	Table0569InformProvider Table0569 = `PRO` // Inform Provider
+)
+
+// Description of the value, or empty if the value is not in table 0569.
+func (v Table0569) Description() string {
+	return tableDescription(`0569`, string(v))
+}
+
+// Valid reports if the value is in table 0569.
+func (v Table0569) Valid() bool {
+	return TableValueLookup[`0569`][string(v)]
+}
+
+// Table 0569.
+func (v Table0569) Table() Table {
+	return TableLookup[`0569`]
+}
+
+// Table0570 is a value of table 0570, Payment Method Code.
+// Fields in this table are strings; convert a field, such as Table0570(v), to use the helpers.
+type Table0570 string
+
+const (
+	Table0570Cash                                                    Table0570 = `CASH` // Cash
+	Table0570CreditCard                                              Table0570 = `CCCA` // Credit Card
+	Table0570CashierSCheck                                           Table0570 = `CCHK` // Cashier's Check
+	Table0570CreditDebitAccount                                      Table0570 = `CDAC` // Credit/Debit Account
+	Table0570Check                                                   Table0570 = `CHCK` // Check
+	Table0570DirectDeposit                                           Table0570 = `DDPO` // Direct Deposit
+	Table0570DebitCard                                               Table0570 = `DEBC` // Debit Card
+	Table0570SocietyForWorldwideInterbankFinancialTelecommunications Table0570 = `SWFT` // Society for Worldwide Interbank Financial Telecommunications (S.W.I.F.T.)
+	Table0570TravelerSCheck                                          Table0570 = `TRAC` // Traveler's Check
+	Table0570VISASpecialElectronicFundsTransferNetwork               Table0570 = `VISN` // VISA Special Electronic Funds Transfer Network
+)
+
+// Description of the value, or empty if the value is not in table 0570.
+func (v Table0570) Description() string {
+	return tableDescription(`0570`, string(v))
+}
+
+// Valid reports if the value is in table 0570.
+func (v Table0570) Valid() bool {
+	return TableValueLookup[`0570`][string(v)]
+}
+
+// Table 0570.
+func (v Table0570) Table() Table {
+	return TableLookup[`0570`]
+}
+
+// Table0571 is a value of table 0571, Invoice Processing Results Status.
+// Fields in this table are strings; convert a field, such as Table0571(v), to use the helpers.
+type Table0571 string
+
+const (
+	Table0571Acknowledge                Table0571 = `ACK`    // Acknowledge
+	Table0571AdjudicatedWithAdjustments Table0571 = `ADJ`    // Adjudicated with Adjustments
+	Table0571AdjudicatedAsSubmitted     Table0571 = `ADJSUB` // Adjudicated as Submitted
+	Table0571AdjudicatedToZero          Table0571 = `ADJZER` // Adjudicated to Zero
+	Table0571Paid                       Table0571 = `PAID`   // Paid
+	Table0571Pending                    Table0571 = `PEND`   // Pending
+	Table0571PreDetermination           Table0571 = `PRED`   // Pre-Determination
+	Table0571Reject                     Table0571 = `REJECT` // Reject
+)
+
+// Description of the value, or empty if the value is not in table 0571.
+func (v Table0571) Description() string {
+	return tableDescription(`0571`, string(v))
+}
+
+// Valid reports if the value is in table 0571.
+func (v Table0571) Valid() bool {
+	return TableValueLookup[`0571`][string(v)]
+}
+
+// Table 0571.
+func (v Table0571) Table() Table {
+	return TableLookup[`0571`]
+}
+
+// Table0572 is a value of table 0572, Tax status.
+// Fields in this table are strings; convert a field, such as Table0572(v), to use the helpers.
+type Table0572 string
+
+const (
+	Table0572RegisteredInVATRegister   Table0572 = `RVAT` // Registered in VAT register
+	Table0572UnregisteredInVATRegister Table0572 = `UVAT` // Unregistered in VAT register
+)
+
+// Description of the value, or empty if the value is not in table 0572.
+func (v Table0572) Description() string {
+	return tableDescription(`0572`, string(v))
+}
+
+// Valid reports if the value is in table 0572.
+func (v Table0572) Valid() bool {
+	return TableValueLookup[`0572`][string(v)]
+}
+
+// Table 0572.
+func (v Table0572) Table() Table {
+	return TableLookup[`0572`]
+}
+
+// Table0615 is a value of table 0615, User Authentication Credential Type Code.
+// Fields in this table are strings; convert a field, such as Table0615(v), to use the helpers.
+type Table0615 string
+
+const (
+	Table0615KerberosServiceTicket              Table0615 = `KERB` // Kerberos Service Ticket
+	Table0615AuthenticatedUserIdentityAssertion Table0615 = `SAML` // Authenticated User Identity Assertion
+)
+
+// Description of the value, or empty if the value is not in table 0615.
+func (v Table0615) Description() string {
+	return tableDescription(`0615`, string(v))
+}
+
+// Valid reports if the value is in table 0615.
+func (v Table0615) Valid() bool {
+	return TableValueLookup[`0615`][string(v)]
+}
+
+// Table 0615.
+func (v Table0615) Table() Table {
+	return TableLookup[`0615`]
+}
+
+// Table0616 is a value of table 0616, Address Expiration Reason.
+// Fields in this table are strings; convert a field, such as Table0616(v), to use the helpers.
+type Table0616 string
+
+const (
+	Table0616Corrected    Table0616 = `C` // Corrected
+	Table0616AddedInError Table0616 = `E` // Added in error
+	Table0616Moved        Table0616 = `M` // Moved
+	Table0616OnRequest    Table0616 = `R` // On request
+)
+
+// Description of the value, or empty if the value is not in table 0616.
+func (v Table0616) Description() string {
+	return tableDescription(`0616`, string(v))
+}
+
+// Valid reports if the value is in table 0616.
+func (v Table0616) Valid() bool {
+	return TableValueLookup[`0616`][string(v)]
+}
+
+// Table 0616.
+func (v Table0616) Table() Table {
+	return TableLookup[`0616`]
+}
+
+// Table0617 is a value of table 0617, Address Usage.
+// Fields in this table are strings; convert a field, such as Table0617(v), to use the helpers.
+type Table0617 string
+
+const (
+	Table0617Classification Table0617 = `C` // Classification
+	Table0617Mailing        Table0617 = `M` // Mailing
+	Table0617Visit          Table0617 = `V` // Visit
+)
+
+// Description of the value, or empty if the value is not in table 0617.
+func (v Table0617) Description() string {
+	return tableDescription(`0617`, string(v))
+}
+
+// Valid reports if the value is in table 0617.
+func (v Table0617) Valid() bool {
+	return TableValueLookup[`0617`][string(v)]
+}
+
+// Table 0617.
+func (v Table0617) Table() Table {
+	return TableLookup[`0617`]
+}
+
+// Table0618 is a value of table 0618, Protection Code.
+// Fields in this table are strings; convert a field, such as Table0618(v), to use the helpers.
+type Table0618 string
+
+const (
+	Table0618Listed                               Table0618 = `LI` // Listed
+	Table0618UnlistedShouldNotAppearInDirectories Table0618 = `UL` // Unlisted (Should not appear in directories)
+	Table0618Unpublished                          Table0618 = `UP` // Unpublished
+)
+
+// Description of the value, or empty if the value is not in table 0618.
+func (v Table0618) Description() string {
+	return tableDescription(`0618`, string(v))
+}
+
+// Valid reports if the value is in table 0618.
+func (v Table0618) Valid() bool {
+	return TableValueLookup[`0618`][string(v)]
+}
+
+// Table 0618.
+func (v Table0618) Table() Table {
+	return TableLookup[`0618`]
+}
+
+// Table0625 is a value of table 0625, Item Status Codes.
+// Fields in this table are strings; convert a field, such as Table0625(v), to use the helpers.
+type Table0625 string
+
+const (
+	Table0625Active          Table0625 = `1` // Active
+	Table0625PendingInactive Table0625 = `2` // Pending Inactive
+	Table0625Inactive        Table0625 = `3` // Inactive
+)
+
+// Description of the value, or empty if the value is not in table 0625.
+func (v Table0625) Description() string {
+	return tableDescription(`0625`, string(v))
+}
+
+// Valid reports if the value is in table 0625.
+func (v Table0625) Valid() bool {
+	return TableValueLookup[`0625`][string(v)]
+}
+
+// Table 0625.
+func (v Table0625) Table() Table {
+	return TableLookup[`0625`]
+}
+
+// Table0634 is a value of table 0634, Item Importance Codes.
+// Fields in this table are strings; convert a field, such as Table0634(v), to use the helpers.
+type Table0634 string
+
+const (
+	Table0634Critical Table0634 = `CRT` // Critical
+)
+
+// Description of the value, or empty if the value is not in table 0634.
+func (v Table0634) Description() string {
+	return tableDescription(`0634`, string(v))
+}
+
+// Valid reports if the value is in table 0634.
+func (v Table0634) Valid() bool {
+	return TableValueLookup[`0634`][string(v)]
+}
+
+// Table 0634.
+func (v Table0634) Table() Table {
+	return TableLookup[`0634`]
+}
+
+// Table0642 is a value of table 0642, Reorder Theory Codes.
+// Fields in this table are strings; convert a field, such as Table0642(v), to use the helpers.
+type Table0642 string
+
+const (
+	Table0642DOPDOQ   Table0642 = `D` // DOP/DOQ
+	Table0642MINMAX   Table0642 = `M` // MIN/MAX
+	Table0642Override Table0642 = `O` // Override
+)
+
+// Description of the value, or empty if the value is not in table 0642.
+func (v Table0642) Description() string {
+	return tableDescription(`0642`, string(v))
+}
+
+// Valid reports if the value is in table 0642.
+func (v Table0642) Valid() bool {
+	return TableValueLookup[`0642`][string(v)]
+}
+
+// Table 0642.
+func (v Table0642) Table() Table {
+	return TableLookup[`0642`]
+}
+
+// Table0651 is a value of table 0651, Labor Calculation Type.
+// Fields in this table are strings; convert a field, such as Table0651(v), to use the helpers.
+type Table0651 string
+
+const (
+	Table0651Cost Table0651 = `CST` // Cost
+	Table0651Time Table0651 = `TME` // Time
+)
+
+// Description of the value, or empty if the value is not in table 0651.
+func (v Table0651) Description() string {
+	return tableDescription(`0651`, string(v))
+}
+
+// Valid reports if the value is in table 0651.
+func (v Table0651) Valid() bool {
+	return TableValueLookup[`0651`][string(v)]
+}
+
+// Table 0651.
+func (v Table0651) Table() Table {
+	return TableLookup[`0651`]
+}
+
+// Table0653 is a value of table 0653, Date Format.
+// Fields in this table are strings; convert a field, such as Table0653(v), to use the helpers.
+type Table0653 string
+
+const (
+	Table0653MmDdYy   Table0653 = `1` // mm/dd/yy
+	Table0653YyMmDd   Table0653 = `2` // yy.mm.dd
+	Table0653DdMmYy   Table0653 = `3` // dd/mm/yy
+	Table0653DdMmYy_4 Table0653 = `4` // dd.mm.yy
+	Table0653YyMmDd_5 Table0653 = `5` // yy/mm/dd
+	Table0653Yymmdd   Table0653 = `6` // Yymmdd
+)
+
+// Description of the value, or empty if the value is not in table 0653.
+func (v Table0653) Description() string {
+	return tableDescription(`0653`, string(v))
+}
+
+// Valid reports if the value is in table 0653.
+func (v Table0653) Valid() bool {
+	return TableValueLookup[`0653`][string(v)]
+}
+
+// Table 0653.
+func (v Table0653) Table() Table {
+	return TableLookup[`0653`]
+}
+
+// Table0657 is a value of table 0657, Device Type.
+// Fields in this table are strings; convert a field, such as Table0657(v), to use the helpers.
+type Table0657 string
+
+const (
+	Table0657EOGasSterilizer Table0657 = `1` // EO Gas Sterilizer
+	Table0657SteamSterilizer Table0657 = `2` // Steam Sterilizer
+	Table0657PeraceticAcid   Table0657 = `3` // Peracetic Acid
+)
+
+// Description of the value, or empty if the value is not in table 0657.
+func (v Table0657) Description() string {
+	return tableDescription(`0657`, string(v))
+}
+
+// Valid reports if the value is in table 0657.
+func (v Table0657) Valid() bool {
+	return TableValueLookup[`0657`][string(v)]
+}
+
+// Table 0657.
+func (v Table0657) Table() Table {
+	return TableLookup[`0657`]
+}
+
+// Table0659 is a value of table 0659, Lot Control.
+// Fields in this table are strings; convert a field, such as Table0659(v), to use the helpers.
+type Table0659 string
+
+const (
+	Table0659ORModeWithoutOperator  Table0659 = `1` // OR Mode Without Operator
+	Table0659ORModeWithOperator     Table0659 = `2` // OR Mode with Operator
+	Table0659CPDModeWithoutOperator Table0659 = `3` // CPD Mode Without Operator
+	Table0659CPDModeWithOperator    Table0659 = `4` // CPD Mode With Operator
+	Table0659OfflineMode            Table0659 = `5` // Offline Mode
+)
+
+// Description of the value, or empty if the value is not in table 0659.
+func (v Table0659) Description() string {
+	return tableDescription(`0659`, string(v))
+}
+
+// Valid reports if the value is in table 0659.
+func (v Table0659) Valid() bool {
+	return TableValueLookup[`0659`][string(v)]
+}
+
+// Table 0659.
+func (v Table0659) Table() Table {
+	return TableLookup[`0659`]
+}
+
+// Table0667 is a value of table 0667, Device Data State -.
+// Fields in this table are strings; convert a field, such as Table0667(v), to use the helpers.
+type Table0667 string
+
+const (
+	Table0667RealTimeValues Table0667 = `0` // Real Time Values
+	Table0667HistoricValues Table0667 = `1` // Historic Values
+)
+
+// Description of the value, or empty if the value is not in table 0667.
+func (v Table0667) Description() string {
+	return tableDescription(`0667`, string(v))
+}
+
+// Valid reports if the value is in table 0667.
+func (v Table0667) Valid() bool {
+	return TableValueLookup[`0667`][string(v)]
+}
+
+// Table 0667.
+func (v Table0667) Table() Table {
+	return TableLookup[`0667`]
+}
+
+// Table0669 is a value of table 0669, Load Status.
+// Fields in this table are strings; convert a field, such as Table0669(v), to use the helpers.
+type Table0669 string
+
+const (
+	Table0669LoadIsComplete Table0669 = `LCC` // Load is Complete
+	Table0669LoadCanceled   Table0669 = `LCN` // Load Canceled
+	Table0669LoadInProcess  Table0669 = `LCP` // Load In Process
+	Table0669BuildingALoad  Table0669 = `LLD` // Building a Load
+)
+
+// Description of the value, or empty if the value is not in table 0669.
+func (v Table0669) Description() string {
+	return tableDescription(`0669`, string(v))
+}
+
+// Valid reports if the value is in table 0669.
+func (v Table0669) Valid() bool {
+	return TableValueLookup[`0669`][string(v)]
+}
+
+// Table 0669.
+func (v Table0669) Table() Table {
+	return TableLookup[`0669`]
+}
+
+// Table0702 is a value of table 0702, Cycle Type-.
+// Fields in this table are strings; convert a field, such as Table0702(v), to use the helpers.
+type Table0702 string
+
+const (
+	Table0702SecondRinse                     Table0702 = `2RS` // Second Rinse
+	Table0702AnesthesiaRespiratory           Table0702 = `ANR` // Anesthesia/Respiratory
+	Table0702Bedpans                         Table0702 = `BDP` // Bedpans
+	Table0702BowieDickTest                   Table0702 = `BWD` // Bowie-Dick Test
+	Table0702ChemicalWash                    Table0702 = `CMW` // Chemical Wash
+	Table0702Code                            Table0702 = `COD` // Code
+	Table0702CartWash                        Table0702 = `CRT` // Cart Wash
+	Table0702Decontamination                 Table0702 = `DEC` // Decontamination
+	Table0702Dart                            Table0702 = `DRT` // Dart
+	Table0702DartWarmUpCycle                 Table0702 = `DRW` // Dart Warm-up Cycle
+	Table0702EOHighTemperature               Table0702 = `EOH` // EO High Temperature
+	Table0702EOLowTemperature                Table0702 = `EOL` // EO Low Temperature
+	Table0702Express                         Table0702 = `EXP` // Express
+	Table0702Flash                           Table0702 = `FLS` // Flash
+	Table0702Glassware                       Table0702 = `GLS` // Glassware
+	Table0702GenPurpose                      Table0702 = `GNP` // Gen. Purpose
+	Table0702Gravity                         Table0702 = `GRV` // Gravity
+	Table0702Gentle                          Table0702 = `GTL` // Gentle
+	Table0702Isothermal                      Table0702 = `ISO` // Isothermal
+	Table0702InstrumentWash                  Table0702 = `IST` // Instrument Wash
+	Table0702LeakTest                        Table0702 = `LKT` // Leak Test
+	Table0702Liquid                          Table0702 = `LQD` // Liquid
+	Table0702OptionalWash                    Table0702 = `OPW` // Optional Wash
+	Table0702PeraceticAcid                   Table0702 = `PEA` // Peracetic Acid
+	Table0702PlasticGoodsWash                Table0702 = `PLA` // Plastic Goods Wash
+	Table0702Prevac                          Table0702 = `PRV` // Prevac
+	Table0702Rinse                           Table0702 = `RNS` // Rinse
+	Table0702SteamFlushPressurePulse         Table0702 = `SFP` // Steam Flush Pressure Pulse
+	Table0702Thermal                         Table0702 = `THR` // Thermal
+	Table0702TrayBasin                       Table0702 = `TRB` // Tray/Basin
+	Table0702UtensilWash                     Table0702 = `UTL` // Utensil Wash
+	Table0702WrapSteamFlushPressurePulseWrap Table0702 = `WFP` // Wrap/Steam Flush Pressure Pulse (Wrap/SFPP)
+)
+
+// Description of the value, or empty if the value is not in table 0702.
+func (v Table0702) Description() string {
+	return tableDescription(`0702`, string(v))
+}
+
+// Valid reports if the value is in table 0702.
+func (v Table0702) Valid() bool {
+	return TableValueLookup[`0702`][string(v)]
+}
+
+// Table 0702.
+func (v Table0702) Table() Table {
+	return TableLookup[`0702`]
+}
+
+// Table0717 is a value of table 0717, Access Restriction Value.
+// Fields in this table are strings; convert a field, such as Table0717(v), to use the helpers.
+type Table0717 string
+
+const (
+	Table0717All                         Table0717 = `ALL`    // All
+	Table0717AllDemographicData          Table0717 = `DEM`    // All demographic data
+	Table0717Drug                        Table0717 = `DRG`    // Drug
+	Table0717HIVStatusAndResults         Table0717 = `HIV`    // HIV status and results
+	Table0717PatientLocation             Table0717 = `LOC`    // Patient Location
+	Table0717None                        Table0717 = `NO`     // None
+	Table0717OptInAllRegistriesHIPAA     Table0717 = `OI`     // Opt in all registries (HIPAA)
+	Table0717OptOutAllRegistriesHIPAA    Table0717 = `OO`     // Opt out all registries (HIPAA)
+	Table0717Religion                    Table0717 = `PID-17` // Religion
+	Table0717DateOfBirth                 Table0717 = `PID-7`  // Date of Birth
+	Table0717PsychiatricMentalHealth     Table0717 = `PSY`    // Psychiatric Mental health
+	Table0717SensitiveMedicalData        Table0717 = `SMD`    // Sensitive medical data
+	Table0717SexuallyTransmittedDiseases Table0717 = `STD`    // Sexually transmitted diseases
+)
+
+// Description of the value, or empty if the value is not in table 0717.
+func (v Table0717) Description() string {
+	return tableDescription(`0717`, string(v))
+}
+
+// Valid reports if the value is in table 0717.
+func (v Table0717) Valid() bool {
+	return TableValueLookup[`0717`][string(v)]
+}
+
+// Table 0717.
+func (v Table0717) Table() Table {
+	return TableLookup[`0717`]
+}
+
+// Table0719 is a value of table 0719, Access Restriction Reason Code.
+// Fields in this table are strings; convert a field, such as Table0719(v), to use the helpers.
+type Table0719 string
+
+const (
+	Table0719DiagnosisRelated                  Table0719 = `DIA` // Diagnosis-related
+	Table0719EmployeeOfThisOrganization        Table0719 = `EMP` // Employee of this organization
+	Table0719OrganizationalPolicyOrRequirement Table0719 = `ORG` // Organizational policy or requirement
+	Table0719PatientRequest                    Table0719 = `PAT` // Patient Request
+	Table0719PhysicianRequest                  Table0719 = `PHY` // Physician Request
+	Table0719RegulatoryRequirement             Table0719 = `REG` // Regulatory requirement
+	Table0719VeryImportantPersonOrCelebrity    Table0719 = `VIP` // Very important person or celebrity
+)
+
+// Description of the value, or empty if the value is not in table 0719.
+func (v Table0719) Description() string {
+	return tableDescription(`0719`, string(v))
+}
+
+// Valid reports if the value is in table 0719.
+func (v Table0719) Valid() bool {
+	return TableValueLookup[`0719`][string(v)]
+}
+
+// Table 0719.
+func (v Table0719) Table() Table {
+	return TableLookup[`0719`]
+}
+
+// Table0725 is a value of table 0725, Mood Codes.
+// Fields in this table are strings; convert a field, such as Table0725(v), to use the helpers.
+type Table0725 string
+
+const (
+	Table0725Appointment        Table0725 = `APT`     // Appointment
+	Table0725AppointmentRequest Table0725 = `ARQ`     // Appointment Request
+	Table0725Event              Table0725 = `EVN`     // Event
+	Table0725EventCriterion     Table0725 = `EVN.CRT` // Event Criterion
+	Table0725Expectation        Table0725 = `EXP`     // Expectation
+	Table0725Intent             Table0725 = `INT`     // Intent
+	Table0725Promise            Table0725 = `PRMS`    // Promise
+	Table0725Proposal           Table0725 = `PRP`     // Proposal
+	Table0725RequestOrder       Table0725 = `RQO`     // Request-Order
+)
+
+// Description of the value, or empty if the value is not in table 0725.
+func (v Table0725) Description() string {
+	return tableDescription(`0725`, string(v))
+}
+
+// Valid reports if the value is in table 0725.
+func (v Table0725) Valid() bool {
+	return TableValueLookup[`0725`][string(v)]
+}
+
+// Table 0725.
+func (v Table0725) Table() Table {
+	return TableLookup[`0725`]
+}
+
+// Table0728 is a value of table 0728, CCL Value.
+// Fields in this table are strings; convert a field, such as Table0728(v), to use the helpers.
+type Table0728 string
+
+const (
+	Table0728NothingObvious Table0728 = `0` // Nothing obvious
+	Table0728Low            Table0728 = `1` // Low
+	Table0728Moderate       Table0728 = `2` // Moderate
+	Table0728High           Table0728 = `3` // High
+	Table0728VeryHigh       Table0728 = `4` // Very high
+)
+
+// Description of the value, or empty if the value is not in table 0728.
+func (v Table0728) Description() string {
+	return tableDescription(`0728`, string(v))
+}
+
+// Valid reports if the value is in table 0728.
+func (v Table0728) Valid() bool {
+	return TableValueLookup[`0728`][string(v)]
+}
+
+// Table 0728.
+func (v Table0728) Table() Table {
+	return TableLookup[`0728`]
+}
+
+// Table0731 is a value of table 0731, DRG Diagnosis Determination Status.
+// Fields in this table are strings; convert a field, such as Table0731(v), to use the helpers.
+type Table0731 string
+
+const (
+	Table0731ValidCode                Table0731 = `0` // Valid code
+	Table0731InvalidCode              Table0731 = `1` // Invalid code
+	Table0731TwoPrimaryDiagnosisCodes Table0731 = `2` // Two primary diagnosis codes
+	Table0731InvalidForThisGender     Table0731 = `3` // Invalid for this gender
+	Table0731InvalidForThisAge        Table0731 = `4` // Invalid for this age
+)
+
+// Description of the value, or empty if the value is not in table 0731.
+func (v Table0731) Description() string {
+	return tableDescription(`0731`, string(v))
+}
+
+// Valid reports if the value is in table 0731.
+func (v Table0731) Valid() bool {
+	return TableValueLookup[`0731`][string(v)]
+}
+
+// Table 0731.
+func (v Table0731) Table() Table {
+	return TableLookup[`0731`]
+}
+
+// Table0734 is a value of table 0734, Grouper Status.
+// Fields in this table are strings; convert a field, such as Table0734(v), to use the helpers.
+type Table0734 string
+
+const (
+	Table0734NormalGrouping                   Table0734 = `0` // Normal grouping
+	Table0734InvalidOrMissingPrimaryDiagnosis Table0734 = `1` // Invalid or missing primary diagnosis
+	Table0734DiagnosisIsNotAllowedToBe        Table0734 = `2` // Diagnosis is not allowed to be primary
+	Table0734DataDoesNotFulfillDRGCriteria    Table0734 = `3` // Data does not fulfill DRG criteria
+	Table0734InvalidAgeAdmissionDateDateOf    Table0734 = `4` // Invalid age, admission date, date of birth or discharge date
+	Table0734InvalidGender                    Table0734 = `5` // Invalid gender
+	Table0734InvalidDischargeStatus           Table0734 = `6` // Invalid discharge status
+	Table0734InvalidWeightAdAdmission         Table0734 = `7` // Invalid weight ad admission
+	Table0734InvalidLengthOfStay              Table0734 = `8` // Invalid length of stay
+	Table0734InvalidFieldSameDay              Table0734 = `9` // Invalid field "same day"
+)
+
+// Description of the value, or empty if the value is not in table 0734.
+func (v Table0734) Description() string {
+	return tableDescription(`0734`, string(v))
+}
+
+// Valid reports if the value is in table 0734.
+func (v Table0734) Valid() bool {
+	return TableValueLookup[`0734`][string(v)]
+}
+
+// Table 0734.
+func (v Table0734) Table() Table {
+	return TableLookup[`0734`]
+}
+
+// Table0739 is a value of table 0739, Status Patient.
+// Fields in this table are strings; convert a field, such as Table0739(v), to use the helpers.
+type Table0739 string
+
+const (
+	Table0739NormalLengthOfStay Table0739 = `1` // Normal length of stay
+	Table0739ShortLengthOfStay  Table0739 = `2` // Short length of stay
+	Table0739LongLengthOfStay   Table0739 = `3` // Long length of stay
+)
+
+// Description of the value, or empty if the value is not in table 0739.
+func (v Table0739) Description() string {
+	return tableDescription(`0739`, string(v))
+}
+
+// Valid reports if the value is in table 0739.
+func (v Table0739) Valid() bool {
+	return TableValueLookup[`0739`][string(v)]
+}
+
+// Table 0739.
+func (v Table0739) Table() Table {
+	return TableLookup[`0739`]
+}
+
+// Table0742 is a value of table 0742, DRG Status Financial Calculation.
+// Fields in this table are strings; convert a field, such as Table0742(v), to use the helpers.
+type Table0742 string
+
+const (
+	Table0742EffectiveWeightCalculated                     Table0742 = `00` // Effective weight calculated
+	Table0742HospitalSpecificContract                      Table0742 = `01` // Hospital specific contract
+	Table0742EeffectiveWeightForTransferReferralCalculated Table0742 = `03` // Eeffective weight for transfer/referral calculated
+	Table0742ReferralFromOtherHospitalBasedOn              Table0742 = `04` // Referral from other hospital based on a cooperation (no DRG reimbursement)
+	Table0742InvalidLengthOfStay                           Table0742 = `05` // Invalid length of stay
+	Table0742NoInformationEntryInCostData                  Table0742 = `10` // No information/entry in cost data for this DRG
+	Table0742NoRelativeWeightFoundForDepartment            Table0742 = `11` // No relative weight found for department (type)
+)
+
+// Description of the value, or empty if the value is not in table 0742.
+func (v Table0742) Description() string {
+	return tableDescription(`0742`, string(v))
+}
+
+// Valid reports if the value is in table 0742.
+func (v Table0742) Valid() bool {
+	return TableValueLookup[`0742`][string(v)]
+}
+
+// Table 0742.
+func (v Table0742) Table() Table {
+	return TableLookup[`0742`]
+}
+
+// Table0749 is a value of table 0749, DRG Grouping Status.
+// Fields in this table are strings; convert a field, such as Table0749(v), to use the helpers.
+type Table0749 string
+
+const (
+	Table0749ValidCodeNotUsedForGrouping   Table0749 = `0` // Valid code; not used for grouping
+	Table0749ValidCodeUsedForGrouping      Table0749 = `1` // Valid code; used for grouping
+	Table0749InvalidCodeNotUsedForGrouping Table0749 = `2` // Invalid code; not used for grouping
+	Table0749InvalidCodeCodeIsRelevantFor  Table0749 = `3` // Invalid code; code is relevant for grouping
+)
+
+// Description of the value, or empty if the value is not in table 0749.
+func (v Table0749) Description() string {
+	return tableDescription(`0749`, string(v))
+}
+
+// Valid reports if the value is in table 0749.
+func (v Table0749) Valid() bool {
+	return TableValueLookup[`0749`][string(v)]
+}
+
+// Table 0749.
+func (v Table0749) Table() Table {
+	return TableLookup[`0749`]
+}
+
+// Table0755 is a value of table 0755, Status Weight At Birth.
+// Fields in this table are strings; convert a field, such as Table0755(v), to use the helpers.
+type Table0755 string
+
+const (
+	Table0755NoWeightReportedAtAdmissionUsed   Table0755 = `0` // No weight reported at admission used for grouping
+	Table0755WeightReportedAtAdmissionUsedFor  Table0755 = `1` // Weight reported at admission used for grouping
+	Table0755DefaultWeight2499gUsedForGrouping Table0755 = `2` // Default weight (>2499g) used for grouping
+)
+
+// Description of the value, or empty if the value is not in table 0755.
+func (v Table0755) Description() string {
+	return tableDescription(`0755`, string(v))
+}
+
+// Valid reports if the value is in table 0755.
+func (v Table0755) Valid() bool {
+	return TableValueLookup[`0755`][string(v)]
+}
+
+// Table 0755.
+func (v Table0755) Table() Table {
+	return TableLookup[`0755`]
+}
+
+// Table0757 is a value of table 0757, Status Respiration Minutes.
+// Fields in this table are strings; convert a field, such as Table0757(v), to use the helpers.
+type Table0757 string
+
+const (
+	Table0757RespirationMinutesNotUsedForGrouping    Table0757 = `0` // Respiration minutes not used for grouping
+	Table0757ListedRespirationMinutesUsedForGrouping Table0757 = `1` // Listed respiration minutes used for grouping
+	Table0757OPSCodeValueUsedForGrouping             Table0757 = `2` // OPS code value used for grouping
+)
+
+// Description of the value, or empty if the value is not in table 0757.
+func (v Table0757) Description() string {
+	return tableDescription(`0757`, string(v))
+}
+
+// Valid reports if the value is in table 0757.
+func (v Table0757) Valid() bool {
+	return TableValueLookup[`0757`][string(v)]
+}
+
+// Table 0757.
+func (v Table0757) Table() Table {
+	return TableLookup[`0757`]
+}
+
+// Table0759 is a value of table 0759, Status Admission.
+// Fields in this table are strings; convert a field, such as Table0759(v), to use the helpers.
+type Table0759 string
+
+const (
+	Table0759AdmissionStatusIsValidUsedFor        Table0759 = `0` // Admission status is valid; used for grouping
+	Table0759AdmissionStatusIsValidNotUsed        Table0759 = `1` // Admission status is valid; not used for grouping
+	Table0759AdmissionStatusIsInvalidNotUsed      Table0759 = `2` // Admission status is invalid; not used for grouping
+	Table0759AdmissionStatusIsInvalidDefaultValue Table0759 = `3` // Admission status is invalid; default value used for grouping
+)
+
+// Description of the value, or empty if the value is not in table 0759.
+func (v Table0759) Description() string {
+	return tableDescription(`0759`, string(v))
+}
+
+// Valid reports if the value is in table 0759.
+func (v Table0759) Valid() bool {
+	return TableValueLookup[`0759`][string(v)]
+}
+
+// Table 0759.
+func (v Table0759) Table() Table {
+	return TableLookup[`0759`]
+}
+
+// Table0761 is a value of table 0761, DRG Procedure Determination Status.
+// Fields in this table are strings; convert a field, such as Table0761(v), to use the helpers.
+type Table0761 string
+
+const (
+	Table0761ValidCode            Table0761 = `0` // Valid code
+	Table0761InvalidCode          Table0761 = `1` // Invalid code
+	Table0761NotUsed              Table0761 = `2` // Not used
+	Table0761InvalidForThisGender Table0761 = `3` // Invalid for this gender
+	Table0761InvalidForThisAge    Table0761 = `4` // Invalid for this age
+)
+
+// Description of the value, or empty if the value is not in table 0761.
+func (v Table0761) Description() string {
+	return tableDescription(`0761`, string(v))
+}
+
+// Valid reports if the value is in table 0761.
+func (v Table0761) Valid() bool {
+	return TableValueLookup[`0761`][string(v)]
+}
+
+// Table 0761.
+func (v Table0761) Table() Table {
+	return TableLookup[`0761`]
+}
+
+// Table0763 is a value of table 0763, DRG Procedure Relevance.
+// Fields in this table are strings; convert a field, such as Table0763(v), to use the helpers.
+type Table0763 string
+
+const (
+	Table0763NeitherOperationRelevantNorNonOperation Table0763 = `0` // Neither operation relevant nor non-operation relevant procedure
+	Table0763OperationRelevantProcedure              Table0763 = `1` // Operation relevant procedure
+	Table0763NonOperationRelevantProcedure           Table0763 = `2` // Non-operation relevant procedure
+)
+
+// Description of the value, or empty if the value is not in table 0763.
+func (v Table0763) Description() string {
+	return tableDescription(`0763`, string(v))
+}
+
+// Valid reports if the value is in table 0763.
+func (v Table0763) Valid() bool {
+	return TableValueLookup[`0763`][string(v)]
+}
+
+// Table 0763.
+func (v Table0763) Table() Table {
+	return TableLookup[`0763`]
+}
+
+// Table0776 is a value of table 0776, Item Status.
+// Fields in this table are strings; convert a field, such as Table0776(v), to use the helpers.
+type Table0776 string
+
+const (
+	Table0776Active          Table0776 = `A` // Active
+	Table0776Inactive        Table0776 = `I` // Inactive
+	Table0776PendingInactive Table0776 = `P` // Pending Inactive
+)
+
+// Description of the value, or empty if the value is not in table 0776.
+func (v Table0776) Description() string {
+	return tableDescription(`0776`, string(v))
+}
+
+// Valid reports if the value is in table 0776.
+func (v Table0776) Valid() bool {
+	return TableValueLookup[`0776`][string(v)]
+}
+
+// Table 0776.
+func (v Table0776) Table() Table {
+	return TableLookup[`0776`]
+}
+
+// Table0778 is a value of table 0778, Item Type.
+// Fields in this table are strings; convert a field, such as Table0778(v), to use the helpers.
+type Table0778 string
+
+const (
+	Table0778Equipment               Table0778 = `EQP` // Equipment
+	Table0778Implant                 Table0778 = `IMP` // Implant
+	Table0778Medication              Table0778 = `MED` // Medication
+	Table0778Supply                  Table0778 = `SUP` // Supply
+	Table0778TubesDrainsAndCatheters Table0778 = `TDC` // Tubes, Drains, and Catheters
+)
+
+// Description of the value, or empty if the value is not in table 0778.
+func (v Table0778) Description() string {
+	return tableDescription(`0778`, string(v))
+}
+
+// Valid reports if the value is in table 0778.
+func (v Table0778) Valid() bool {
+	return TableValueLookup[`0778`][string(v)]
+}
+
+// Table 0778.
+func (v Table0778) Table() Table {
+	return TableLookup[`0778`]
+}
+
+// Table0790 is a value of table 0790, Approving Regulatory Agency.
+// Fields in this table are strings; convert a field, such as Table0790(v), to use the helpers.
+type Table0790 string
+
+const (
+	Table0790AmericanMedicalAssociation Table0790 = `AMA` // American Medical Association
+	Table0790FoodAndDrugAdministration  Table0790 = `FDA` // Food and Drug Administration
+)
+
+// Description of the value, or empty if the value is not in table 0790.
+func (v Table0790) Description() string {
+	return tableDescription(`0790`, string(v))
+}
+
+// Valid reports if the value is in table 0790.
+func (v Table0790) Valid() bool {
+	return TableValueLookup[`0790`][string(v)]
+}
+
+// Table 0790.
+func (v Table0790) Table() Table {
+	return TableLookup[`0790`]
+}
+
+// Table0793 is a value of table 0793, Ruling Act.
+// Fields in this table are strings; convert a field, such as Table0793(v), to use the helpers.
+type Table0793 string
+
+const (
+	Table0793SafeMedicalDevicesAct Table0793 = `SMDA` // Safe Medical Devices Act
+)
+
+// Description of the value, or empty if the value is not in table 0793.
+func (v Table0793) Description() string {
+	return tableDescription(`0793`, string(v))
+}
+
+// Valid reports if the value is in table 0793.
+func (v Table0793) Valid() bool {
+	return TableValueLookup[`0793`][string(v)]
+}
+
+// Table 0793.
+func (v Table0793) Table() Table {
+	return TableLookup[`0793`]
+}
+
+// Table0806 is a value of table 0806, Sterilization Type.
+// Fields in this table are strings; convert a field, such as Table0806(v), to use the helpers.
+type Table0806 string
+
+const (
+	Table0806EthyleneOxideGas Table0806 = `EOG` // Ethylene Oxide Gas
+	Table0806PeraceticAcid    Table0806 = `PCA` // Peracetic acid
+	Table0806Steam            Table0806 = `STM` // Steam
+)
+
+// Description of the value, or empty if the value is not in table 0806.
+func (v Table0806) Description() string {
+	return tableDescription(`0806`, string(v))
+}
+
+// Valid reports if the value is in table 0806.
+func (v Table0806) Valid() bool {
+	return TableValueLookup[`0806`][string(v)]
+}
+
+// Table 0806.
+func (v Table0806) Table() Table {
+	return TableLookup[`0806`]
+}
+
+// Table0818 is a value of table 0818, Package.
+// Fields in this table are strings; convert a field, such as Table0818(v), to use the helpers.
+type Table0818 string
+
+const (
+	Table0818Box  Table0818 = `BX`  // Box
+	Table0818Case Table0818 = `CS`  // Case
+	Table0818Each Table0818 = `EA`  // Each
+	Table0818Set  Table0818 = `SET` // Set
+)
+
+// Description of the value, or empty if the value is not in table 0818.
+func (v Table0818) Description() string {
+	return tableDescription(`0818`, string(v))
+}
+
+// Valid reports if the value is in table 0818.
+func (v Table0818) Valid() bool {
+	return TableValueLookup[`0818`][string(v)]
+}
+
+// Table 0818.
+func (v Table0818) Table() Table {
+	return TableLookup[`0818`]
+}
+
+// Table0834 is a value of table 0834, MIME Types.
+// Fields in this table are strings; convert a field, such as Table0834(v), to use the helpers.
+type Table0834 string
+
+const (
+	Table0834ApplicationData      Table0834 = `application` // Application data
+	Table0834AudioData            Table0834 = `audio`       // Audio data
+	Table0834ImageData            Table0834 = `image`       // Image data
+	Table0834ModelData            Table0834 = `model`       // Model data
+	Table0834MIMEMultipartPackage Table0834 = `multipart`   // MIME multipart package
+	Table0834TextData             Table0834 = `text`        // Text data
+	Table0834VideoData            Table0834 = `video`       // Video data
+)
+
+// Description of the value, or empty if the value is not in table 0834.
+func (v Table0834) Description() string {
+	return tableDescription(`0834`, string(v))
+}
+
+// Valid reports if the value is in table 0834.
+func (v Table0834) Valid() bool {
+	return TableValueLookup[`0834`][string(v)]
+}
+
+// Table 0834.
+func (v Table0834) Table() Table {
+	return TableLookup[`0834`]
+}
+
+// Table0836 is a value of table 0836, Problem Severity.
+// Fields in this table are strings; convert a field, such as Table0836(v), to use the helpers.
+type Table0836 string
+
+const (
+	Table0836NoValuesDefined Table0836 = `No Values Defined`
+)
+
+// Description of the value, or empty if the value is not in table 0836.
+func (v Table0836) Description() string {
+	return tableDescription(`0836`, string(v))
+}
+
+// Valid reports if the value is in table 0836.
+func (v Table0836) Valid() bool {
+	return TableValueLookup[`0836`][string(v)]
+}
+
+// Table 0836.
+func (v Table0836) Table() Table {
+	return TableLookup[`0836`]
+}
+
+// Table0838 is a value of table 0838, Problem Perspective.
+// Fields in this table are strings; convert a field, such as Table0838(v), to use the helpers.
+type Table0838 string
+
+const (
+	Table0838NoValuesDefined Table0838 = `No Values Defined`
+)
+
+// Description of the value, or empty if the value is not in table 0838.
+func (v Table0838) Description() string {
+	return tableDescription(`0838`, string(v))
+}
+
+// Valid reports if the value is in table 0838.
+func (v Table0838) Valid() bool {
+	return TableValueLookup[`0838`][string(v)]
+}
+
+// Table 0838.
+func (v Table0838) Table() Table {
+	return TableLookup[`0838`]
+}
+
+// Table0868 is a value of table 0868, Telecommunication Expiration Reason.
+// Fields in this table are strings; convert a field, such as Table0868(v), to use the helpers.
+type Table0868 string
+
+const (
+	Table0868Corrected         Table0868 = `C` // Corrected
+	Table0868AddedInError      Table0868 = `E` // Added in error
+	Table0868Moved             Table0868 = `M` // Moved
+	Table0868NoLongerInService Table0868 = `N` // No longer in service
+	Table0868OnRequest         Table0868 = `R` // On request
+)
+
+// Description of the value, or empty if the value is not in table 0868.
+func (v Table0868) Description() string {
+	return tableDescription(`0868`, string(v))
+}
+
+// Valid reports if the value is in table 0868.
+func (v Table0868) Valid() bool {
+	return TableValueLookup[`0868`][string(v)]
+}
+
+// Table 0868.
+func (v Table0868) Table() Table {
+	return TableLookup[`0868`]
+}
+
+// Table0871 is a value of table 0871, Supply Risk Codes.
+// Fields in this table are strings; convert a field, such as Table0871(v), to use the helpers.
+type Table0871 string
+
+const (
+	Table0871Corrosive    Table0871 = `COR` // Corrosive
+	Table0871Explosive    Table0871 = `EXP` // Explosive
+	Table0871Flammable    Table0871 = `FLA` // Flammable
+	Table0871InjuryHazard Table0871 = `INJ` // Injury Hazard
+	Table0871Radioactive  Table0871 = `RAD` // Radioactive
+	Table0871Toxic        Table0871 = `TOX` // Toxic
+	Table0871Unknown      Table0871 = `UNK` // Unknown
+)
+
+// Description of the value, or empty if the value is not in table 0871.
+func (v Table0871) Description() string {
+	return tableDescription(`0871`, string(v))
+}
+
+// Valid reports if the value is in table 0871.
+func (v Table0871) Valid() bool {
+	return TableValueLookup[`0871`][string(v)]
+}
+
+// Table 0871.
+func (v Table0871) Table() Table {
+	return TableLookup[`0871`]
+}
+
+// Table0881 is a value of table 0881, Role Executing Physician.
+// Fields in this table are strings; convert a field, such as Table0881(v), to use the helpers.
+type Table0881 string
+
+const (
+	Table0881Both             Table0881 = `B` // Both
+	Table0881ProfessionalPart Table0881 = `P` // Professional Part
+	Table0881TechnicalPart    Table0881 = `T` // Technical Part
+)
+
+// Description of the value, or empty if the value is not in table 0881.
+func (v Table0881) Description() string {
+	return tableDescription(`0881`, string(v))
+}
+
+// Valid reports if the value is in table 0881.
+func (v Table0881) Valid() bool {
+	return TableValueLookup[`0881`][string(v)]
+}
+
+// Table 0881.
+func (v Table0881) Table() Table {
+	return TableLookup[`0881`]
+}
+
+// Table0882 is a value of table 0882, Medical Role Executing Physician.
+// Fields in this table are strings; convert a field, such as Table0882(v), to use the helpers.
+type Table0882 string
+
+const (
+	Table0882Employed     Table0882 = `E`  // Employed
+	Table0882SelfEmployed Table0882 = `SE` // Self-employed
+)
+
+// Description of the value, or empty if the value is not in table 0882.
+func (v Table0882) Description() string {
+	return tableDescription(`0882`, string(v))
+}
+
+// Valid reports if the value is in table 0882.
+func (v Table0882) Valid() bool {
+	return TableValueLookup[`0882`][string(v)]
+}
+
+// Table 0882.
+func (v Table0882) Table() Table {
+	return TableLookup[`0882`]
+}
+
+// Table0894 is a value of table 0894, Side of body.
+// Fields in this table are strings; convert a field, such as Table0894(v), to use the helpers.
+type Table0894 string
+
+const (
+	Table0894Left  Table0894 = `L` // Left
+	Table0894Right Table0894 = `R` // Right
+)
+
+// Description of the value, or empty if the value is not in table 0894.
+func (v Table0894) Description() string {
+	return tableDescription(`0894`, string(v))
+}
+
+// Valid reports if the value is in table 0894.
+func (v Table0894) Valid() bool {
+	return TableValueLookup[`0894`][string(v)]
+}
+
+// Table 0894.
+func (v Table0894) Table() Table {
+	return TableLookup[`0894`]
+}
+
+// Table0895 is a value of table 0895, Present On Admission (POA) Indicator.
+// Fields in this table are strings; convert a field, such as Table0895(v), to use the helpers.
+type Table0895 string
+
+const (
+	Table0895Exempt        Table0895 = `E` // Exempt
+	Table0895No            Table0895 = `N` // No
+	Table0895Unknown       Table0895 = `U` // Unknown
+	Table0895NotApplicable Table0895 = `W` // Not applicable
+	Table0895Yes           Table0895 = `Y` // Yes
+)
+
+// Description of the value, or empty if the value is not in table 0895.
+func (v Table0895) Description() string {
+	return tableDescription(`0895`, string(v))
+}
+
+// Valid reports if the value is in table 0895.
+func (v Table0895) Valid() bool {
+	return TableValueLookup[`0895`][string(v)]
+}
+
+// Table 0895.
+func (v Table0895) Table() Table {
+	return TableLookup[`0895`]
+}
+
+// Table0904 is a value of table 0904, Security Check Scheme.
+// Fields in this table are strings; convert a field, such as Table0904(v), to use the helpers.
+type Table0904 string
+
+const (
+	Table0904BankCardValidationNumber Table0904 = `BCV` // Bank Card Validation Number
+	Table0904CreditCardSecurityCode   Table0904 = `CCS` // Credit Card Security code
+	Table0904VersionID                Table0904 = `VID` // Version ID
+)
+
+// Description of the value, or empty if the value is not in table 0904.
+func (v Table0904) Description() string {
+	return tableDescription(`0904`, string(v))
+}
+
+// Valid reports if the value is in table 0904.
+func (v Table0904) Valid() bool {
+	return TableValueLookup[`0904`][string(v)]
+}
+
+// Table 0904.
+func (v Table0904) Table() Table {
+	return TableLookup[`0904`]
+}
+
+// Table0905 is a value of table 0905, Shipment Status.
+// Fields in this table are strings; convert a field, such as Table0905(v), to use the helpers.
+type Table0905 string
+
+const (
+	Table0905Inventoried  Table0905 = `INV` // Inventoried
+	Table0905OnHold       Table0905 = `ONH` // On Hold
+	Table0905Processing   Table0905 = `PRC` // Processing
+	Table0905Rejected     Table0905 = `REJ` // Rejected
+	Table0905InTransit    Table0905 = `TRN` // In Transit
+	Table0905TriagedToLab Table0905 = `TTL` // Triaged to Lab
+)
+
+// Description of the value, or empty if the value is not in table 0905.
+func (v Table0905) Description() string {
+	return tableDescription(`0905`, string(v))
+}
+
+// Valid reports if the value is in table 0905.
+func (v Table0905) Valid() bool {
+	return TableValueLookup[`0905`][string(v)]
+}
+
+// Table 0905.
+func (v Table0905) Table() Table {
+	return TableLookup[`0905`]
+}
+
+// Table0906 is a value of table 0906, ActPriority.
+// Fields in this table are strings; convert a field, such as Table0906(v), to use the helpers.
+type Table0906 string
+
+const (
+	Table0906ASAPAsSoonAsPossibleNext                        Table0906 = `A`   // ASAP - As soon as possible, next highest priority after stat
+	Table0906CallbackResultsFillerShouldContactThe           Table0906 = `CR`  // Callback results - filler should contact the placer as soon as results are available, even for preliminary results
+	Table0906CallbackForSchedulingFillerShouldContact        Table0906 = `CS`  // Callback for scheduling - Filler should contact the placer (or target) to schedule the service.
+	Table0906CallbackPlacerForSchedulingFillerShould         Table0906 = `CSP` // Callback placer for scheduling - filler should contact the placer to schedule the service
+	Table0906ContactRecipientForSchedulingFillerShould       Table0906 = `CSR` // Contact recipient for scheduling - Filler should contact the service recipient (target) to schedule the service
+	Table0906ElectiveBeneficialToThePatientBut               Table0906 = `EL`  // Elective - Beneficial to the patient but not essential for survival.
+	Table0906EmergencyAnUnforeseenCombinationOfCircumstances Table0906 = `EM`  // Emergency - An unforeseen combination of circumstances or the resulting state that calls for immediate action
+	Table0906PreopUsedToIndicateThatA                        Table0906 = `P`   // Preop - Used to indicate that a service is to be performed prior to a scheduled surgery.  When ordering a service and using the pre-op priority, a check is done to see the amount of time that must be allowed for performance of the service.  When the order
+	Table0906AsNeededAnAsNeededOrder                         Table0906 = `PRN` // As needed - An "as needed" order should be accompanied by a description of what constitutes a need.  This description is represented by an observation service predicate as a precondition.
+	Table0906RoutineRoutineServiceDoAtUsual                  Table0906 = `R`   // Routine - Routine service, do at usual work hours
+	Table0906RushReportingAReportShouldBe                    Table0906 = `RR`  // Rush reporting - A report should be prepared and sent as quickly as possible
+	Table0906StatWithHighestPriorityEG                       Table0906 = `S`   // Stat - With highest priority (e.g. emergency).
+	Table0906TimingCriticalItIsCriticalTo                    Table0906 = `T`   // Timing critical - It is critical to come as close as possible to the requested time (e.g. for a through antimicrobial level).
+	Table0906UseAsDirectedDrugIsTo                           Table0906 = `UD`  // Use as directed - Drug is to be used as directed by the prescriber.
+	Table0906UrgentCallsForPromptAction                      Table0906 = `UR`  // Urgent - Calls for prompt action
+)
+
+// Description of the value, or empty if the value is not in table 0906.
+func (v Table0906) Description() string {
+	return tableDescription(`0906`, string(v))
+}
+
+// Valid reports if the value is in table 0906.
+func (v Table0906) Valid() bool {
+	return TableValueLookup[`0906`][string(v)]
+}
+
+// Table 0906.
+func (v Table0906) Table() Table {
+	return TableLookup[`0906`]
+}
+
+// Table0907 is a value of table 0907, Confidentiality.
+// Fields in this table are strings; convert a field, such as Table0907(v), to use the helpers.
+type Table0907 string
+
+const (
+	Table0907BusinessSinceTheServiceClassCan             Table0907 = `B`   // Business - Since the service class can represent knowledge structures that may be considered a trade or business secret, there is sometimes (though rarely) the need to flag those items as of business level confidentiality.  However, no patient related inf
+	Table0907CelebrityCelebritiesArePeopleOfPublic       Table0907 = `C`   // Celebrity - Celebrities are people of public interest (VIP) including employees, whose information require special protection.
+	Table0907ClinicianOnlyCliniciansMaySeeThis           Table0907 = `D`   // Clinician - Only clinicians may see this item, billing and administration persons can not access this item without special permission.
+	Table0907SubstanceAbuseRelatedAlcoholDrugAbuse       Table0907 = `ETH` // Substance abuse related - Alcohol/drug-abuse related item
+	Table0907HIVRelatedHIVAndAIDSRelated                 Table0907 = `HIV` // HIV Related - HIV and AIDS related item
+	Table0907IndividualAccessOnlyToIndividualPersons     Table0907 = `I`   // Individual - Access only to individual persons who are mentioned explicitly as actors of this service and whose actor type warrants that access (cf. to actor typed code).
+	Table0907LowNoPatientRecordItemCan                   Table0907 = `L`   // Low - No patient record item can be of low confidentiality.  However, some service objects are not patient related and therefore may have low confidentiality.
+	Table0907NormalNormalConfidentialityRulesAccordingTo Table0907 = `N`   // Normal - Normal confidentiality rules (according to good health care practice) apply, that is, only authorized individuals with a legitimate medical or business need may access this item.
+	Table0907PsychiatryRelatedPsychiatryRelatedItem      Table0907 = `PSY` // Psychiatry related - Psychiatry related item
+	Table0907RestrictedRestrictedAccessEGOnly            Table0907 = `R`   // Restricted - Restricted access, e.g. only to providers having a current care relationship to the patient.
+	Table0907SensitiveInformationForWhichThePatient      Table0907 = `S`   // Sensitive - Information for which the patient seeks heightened confidentiality.  Sensitive information is not to be shared with family members.  Information reported by the patient about family members is sensitive by default.  Flag can be set or cleared
+	Table0907SexualAndDomesticViolenceRelatedSexual      Table0907 = `SDV` // Sexual and domestic violence related - Sexual assault / domestic violence related item
+	Table0907TabooInformationNotToBeDisclosed            Table0907 = `T`   // Taboo - Information not to be disclosed or discussed with patient except through physician assigned to patient in this case.  This is usually a temporary constraint only; example use is a new fatal diagnosis or finding, such as malignancy or HIV.
+	Table0907VeryRestrictedVeryRestrictedAccessAs        Table0907 = `V`   // Very restricted - Very restricted access as declared by the Privacy Officer of the record holder.
+)
+
+// Description of the value, or empty if the value is not in table 0907.
+func (v Table0907) Description() string {
+	return tableDescription(`0907`, string(v))
+}
+
+// Valid reports if the value is in table 0907.
+func (v Table0907) Valid() bool {
+	return TableValueLookup[`0907`][string(v)]
+}
+
+// Table 0907.
+func (v Table0907) Table() Table {
+	return TableLookup[`0907`]
+}
+
+// Table0909 is a value of table 0909, Patient Results Release Categorization Scheme.
+// Fields in this table are strings; convert a field, such as Table0909(v), to use the helpers.
+type Table0909 string
+
+const (
+	Table0909ShareIn1DayPShareResult                Table0909 = `SID`  // Share In1 Day -<p>Share result regardless of reference/therapeutic range after 1 or more business day as agreed to by the systems in play.
+	Table0909ShareIn1DayConditionallyP              Table0909 = `SIDC` // Share in 1 Day Conditionally -<p>Share result in reference ranges/therapeutic with patient after 1 or more business day as agreed to by the systems in play.<p>Withhold result out of reference/therapeutic range until physician release
+	Table0909ShareImmediatelyPShareResultWith       Table0909 = `SIMM` // Share Immediately -<p>Share result with patient immediately
+	Table0909ShareToBeDeterminedPCategory           Table0909 = `STBD` // Share To Be Determined -<p>Category to be determined
+	Table0909ShareWithinNormalLimitsPShare          Table0909 = `SWNL` // Share Within Normal Limits -<p>Share result in reference/therapeutic range with patient immediately<p>Share result out of reference/therapeutic ranges with patient after 1 or more business day as agreed to by the systems in play.
+	Table0909ShareWithholdPWithholdResultRegardless Table0909 = `SWTH` // Share Withhold -<p>Withhold result regardless of reference/therapeutic ranges
+)
+
+// Description of the value, or empty if the value is not in table 0909.
+func (v Table0909) Description() string {
+	return tableDescription(`0909`, string(v))
+}
+
+// Valid reports if the value is in table 0909.
+func (v Table0909) Valid() bool {
+	return TableValueLookup[`0909`][string(v)]
+}
+
+// Table 0909.
+func (v Table0909) Table() Table {
+	return TableLookup[`0909`]
+}
+
+// Table0910 is a value of table 0910, Acquisition Modality.
+// Fields in this table are strings; convert a field, such as Table0910(v), to use the helpers.
+type Table0910 string
+
+const ()
+
+// Description of the value, or empty if the value is not in table 0910.
+func (v Table0910) Description() string {
+	return tableDescription(`0910`, string(v))
+}
+
+// Valid reports if the value is in table 0910.
+func (v Table0910) Valid() bool {
+	return TableValueLookup[`0910`][string(v)]
+}
+
+// Table 0910.
+func (v Table0910) Table() Table {
+	return TableLookup[`0910`]
+}
+
+// Table0912 is a value of table 0912, Participation.
+// Fields in this table are strings; convert a field, such as Table0912(v), to use the helpers.
+type Table0912 string
+
+const (
+	Table0912AdmittingProvider                         Table0912 = `AD`    // Admitting Provider
+	Table0912AssistantAlternateInterpreter             Table0912 = `AI`    // Assistant/Alternate Interpreter
+	Table0912AdministeringProvider                     Table0912 = `AP`    // Administering Provider
+	Table0912AssistantResultInterpreter                Table0912 = `ARI`   // Assistant Result Interpreter
+	Table0912AttendingProvider                         Table0912 = `AT`    // Attending Provider
+	Table0912AUTAuthorEventInitiator                   Table0912 = `AUT`   // AUT Author/Event Initiator
+	Table0912ConsultingProvider                        Table0912 = `CP`    // Consulting Provider
+	Table0912DispensingProvider                        Table0912 = `DP`    // Dispensing Provider
+	Table0912EnteringProviderProbablyNotTheSame        Table0912 = `EP`    // Entering Provider (probably not the same as transcriptionist)
+	Table0912Equipment                                 Table0912 = `EQUIP` // Equipment
+	Table0912FamilyHealthCareProfessional              Table0912 = `FHCP`  // Family Health Care Professional
+	Table0912MedicalDirector                           Table0912 = `MDIR`  // Medical Director
+	Table0912OrderingProvider                          Table0912 = `OP`    // Ordering Provider
+	Table0912PackedBy                                  Table0912 = `PB`    // Packed by
+	Table0912PharmacistNotSureHowToDissect             Table0912 = `PH`    // Pharmacist   (not sure how to dissect Pharmacist/Treatment Supplier's Verifier ID)
+	Table0912PrimaryInterpreter                        Table0912 = `PI`    // Primary Interpreter
+	Table0912PerformingOrganization                    Table0912 = `PO`    // Performing Organization
+	Table0912PerformingOrganizationMedicalDirector     Table0912 = `POMD`  // Performing Organization Medical Director
+	Table0912PrimaryCareProvider                       Table0912 = `PP`    // Primary Care Provider
+	Table0912PrincipalResultInterpreter                Table0912 = `PRI`   // Principal Result Interpreter
+	Table0912ResultsCopiesTo                           Table0912 = `RCT`   // Results Copies To
+	Table0912ResponsibleObserver                       Table0912 = `RO`    // Responsible Observer
+	Table0912ReferringProvider                         Table0912 = `RP`    // Referring Provider
+	Table0912ReferredToProvider                        Table0912 = `RT`    // Referred to Provider
+	Table0912SendBy                                    Table0912 = `SB`    // Send by
+	Table0912SpecimenCollector                         Table0912 = `SC`    // Specimen Collector
+	Table0912Technician                                Table0912 = `TN`    // Technician
+	Table0912Transcriptionist                          Table0912 = `TR`    // Transcriptionist
+	Table0912VerifyingProvider                         Table0912 = `VP`    // Verifying Provider
+	Table0912VerifyingPharmaceuticalSupplierNotSureHow Table0912 = `VPS`   // Verifying Pharmaceutical Supplier   (not sure how to dissect Pharmacist/Treatment Supplier's Verifier ID)
+	Table0912VerifyingTreatmentSupplierNotSureHow      Table0912 = `VTS`   // Verifying Treatment Supplier   (not sure how to dissect Pharmacist/Treatment Supplier's Verifier ID)
+	Table0912Waypoint                                  Table0912 = `WAY`   // Waypoint
+	Table0912WaypointRecipient                         Table0912 = `WAYR`  // Waypoint Recipient
+)
+
+// Description of the value, or empty if the value is not in table 0912.
+func (v Table0912) Description() string {
+	return tableDescription(`0912`, string(v))
+}
+
+// Valid reports if the value is in table 0912.
+func (v Table0912) Valid() bool {
+	return TableValueLookup[`0912`][string(v)]
+}
+
+// Table 0912.
+func (v Table0912) Table() Table {
+	return TableLookup[`0912`]
+}
+
+// Table0913 is a value of table 0913, Monetary Denomination Code.
+// Fields in this table are strings; convert a field, such as Table0913(v), to use the helpers.
+type Table0913 string
+
+const (
+	Table0913NoUniversalCurrency                         Table0913 = ``    // No universal currency
+	Table0913UAEDirham                                   Table0913 = `AED` // UAE Dirham
+	Table0913Afghani                                     Table0913 = `AFN` // Afghani
+	Table0913Lek                                         Table0913 = `ALL` // Lek
+	Table0913ArmenianDram                                Table0913 = `AMD` // Armenian Dram
+	Table0913NetherlandsAntilleanGuilder                 Table0913 = `ANG` // Netherlands Antillean Guilder
+	Table0913Kwanza                                      Table0913 = `AOA` // Kwanza
+	Table0913ArgentinePeso                               Table0913 = `ARS` // Argentine Peso
+	Table0913AustralianDollar                            Table0913 = `AUD` // Australian Dollar
+	Table0913ArubanFlorin                                Table0913 = `AWG` // Aruban Florin
+	Table0913AzerbaijanManat                             Table0913 = `AZN` // Azerbaijan Manat
+	Table0913ConvertibleMark                             Table0913 = `BAM` // Convertible Mark
+	Table0913BarbadosDollar                              Table0913 = `BBD` // Barbados Dollar
+	Table0913Taka                                        Table0913 = `BDT` // Taka
+	Table0913BulgarianLev                                Table0913 = `BGN` // Bulgarian Lev
+	Table0913BahrainiDinar                               Table0913 = `BHD` // Bahraini Dinar
+	Table0913BurundiFranc                                Table0913 = `BIF` // Burundi Franc
+	Table0913BermudianDollar                             Table0913 = `BMD` // Bermudian Dollar
+	Table0913BruneiDollar                                Table0913 = `BND` // Brunei Dollar
+	Table0913Boliviano                                   Table0913 = `BOB` // Boliviano
+	Table0913Mvdol                                       Table0913 = `BOV` // Mvdol
+	Table0913BrazilianReal                               Table0913 = `BRL` // Brazilian Real
+	Table0913BahamianDollar                              Table0913 = `BSD` // Bahamian Dollar
+	Table0913Ngultrum                                    Table0913 = `BTN` // Ngultrum
+	Table0913Pula                                        Table0913 = `BWP` // Pula
+	Table0913BelarusianRuble                             Table0913 = `BYN` // Belarusian Ruble
+	Table0913BelizeDollar                                Table0913 = `BZD` // Belize Dollar
+	Table0913CanadianDollar                              Table0913 = `CAD` // Canadian Dollar
+	Table0913CongoleseFranc                              Table0913 = `CDF` // Congolese Franc
+	Table0913WIREuro                                     Table0913 = `CHE` // WIR Euro
+	Table0913SwissFranc                                  Table0913 = `CHF` // Swiss Franc
+	Table0913WIRFranc                                    Table0913 = `CHW` // WIR Franc
+	Table0913UnidadDeFomento                             Table0913 = `CLF` // Unidad de Fomento
+	Table0913ChileanPeso                                 Table0913 = `CLP` // Chilean Peso
+	Table0913YuanRenminbi                                Table0913 = `CNY` // Yuan Renminbi
+	Table0913ColombianPeso                               Table0913 = `COP` // Colombian Peso
+	Table0913UnidadDeValorReal                           Table0913 = `COU` // Unidad de Valor Real
+	Table0913CostaRicanColon                             Table0913 = `CRC` // Costa Rican Colon
+	Table0913PesoConvertible                             Table0913 = `CUC` // Peso Convertible
+	Table0913CubanPeso                                   Table0913 = `CUP` // Cuban Peso
+	Table0913CaboVerdeEscudo                             Table0913 = `CVE` // Cabo Verde Escudo
+	Table0913CzechKoruna                                 Table0913 = `CZK` // Czech Koruna
+	Table0913DjiboutiFranc                               Table0913 = `DJF` // Djibouti Franc
+	Table0913DanishKrone                                 Table0913 = `DKK` // Danish Krone
+	Table0913DominicanPeso                               Table0913 = `DOP` // Dominican Peso
+	Table0913AlgerianDinar                               Table0913 = `DZD` // Algerian Dinar
+	Table0913EgyptianPound                               Table0913 = `EGP` // Egyptian Pound
+	Table0913Nakfa                                       Table0913 = `ERN` // Nakfa
+	Table0913EthiopianBirr                               Table0913 = `ETB` // Ethiopian Birr
+	Table0913Euro                                        Table0913 = `EUR` // Euro
+	Table0913FijiDollar                                  Table0913 = `FJD` // Fiji Dollar
+	Table0913FalklandIslandsPound                        Table0913 = `FKP` // Falkland Islands Pound
+	Table0913PoundSterling                               Table0913 = `GBP` // Pound Sterling
+	Table0913Lari                                        Table0913 = `GEL` // Lari
+	Table0913GhanaCedi                                   Table0913 = `GHS` // Ghana Cedi
+	Table0913GibraltarPound                              Table0913 = `GIP` // Gibraltar Pound
+	Table0913Dalasi                                      Table0913 = `GMD` // Dalasi
+	Table0913GuineanFranc                                Table0913 = `GNF` // Guinean Franc
+	Table0913Quetzal                                     Table0913 = `GTQ` // Quetzal
+	Table0913GuyanaDollar                                Table0913 = `GYD` // Guyana Dollar
+	Table0913HongKongDollar                              Table0913 = `HKD` // Hong Kong Dollar
+	Table0913Lempira                                     Table0913 = `HNL` // Lempira
+	Table0913Kuna                                        Table0913 = `HRK` // Kuna
+	Table0913Gourde                                      Table0913 = `HTG` // Gourde
+	Table0913Forint                                      Table0913 = `HUF` // Forint
+	Table0913Rupiah                                      Table0913 = `IDR` // Rupiah
+	Table0913NewIsraeliSheqel                            Table0913 = `ILS` // New Israeli Sheqel
+	Table0913IndianRupee                                 Table0913 = `INR` // Indian Rupee
+	Table0913IraqiDinar                                  Table0913 = `IQD` // Iraqi Dinar
+	Table0913IranianRial                                 Table0913 = `IRR` // Iranian Rial
+	Table0913IcelandKrona                                Table0913 = `ISK` // Iceland Krona
+	Table0913JamaicanDollar                              Table0913 = `JMD` // Jamaican Dollar
+	Table0913JordanianDinar                              Table0913 = `JOD` // Jordanian Dinar
+	Table0913Yen                                         Table0913 = `JPY` // Yen
+	Table0913KenyanShilling                              Table0913 = `KES` // Kenyan Shilling
+	Table0913Som                                         Table0913 = `KGS` // Som
+	Table0913Riel                                        Table0913 = `KHR` // Riel
+	Table0913ComorianFranc                               Table0913 = `KMF` // Comorian Franc
+	Table0913NorthKoreanWon                              Table0913 = `KPW` // North Korean Won
+	Table0913Won                                         Table0913 = `KRW` // Won
+	Table0913KuwaitiDinar                                Table0913 = `KWD` // Kuwaiti Dinar
+	Table0913CaymanIslandsDollar                         Table0913 = `KYD` // Cayman Islands Dollar
+	Table0913Tenge                                       Table0913 = `KZT` // Tenge
+	Table0913LaoKip                                      Table0913 = `LAK` // Lao Kip
+	Table0913LebanesePound                               Table0913 = `LBP` // Lebanese Pound
+	Table0913SriLankaRupee                               Table0913 = `LKR` // Sri Lanka Rupee
+	Table0913LiberianDollar                              Table0913 = `LRD` // Liberian Dollar
+	Table0913Loti                                        Table0913 = `LSL` // Loti
+	Table0913LibyanDinar                                 Table0913 = `LYD` // Libyan Dinar
+	Table0913MoroccanDirham                              Table0913 = `MAD` // Moroccan Dirham
+	Table0913MoldovanLeu                                 Table0913 = `MDL` // Moldovan Leu
+	Table0913MalagasyAriary                              Table0913 = `MGA` // Malagasy Ariary
+	Table0913Denar                                       Table0913 = `MKD` // Denar
+	Table0913Kyat                                        Table0913 = `MMK` // Kyat
+	Table0913Tugrik                                      Table0913 = `MNT` // Tugrik
+	Table0913Pataca                                      Table0913 = `MOP` // Pataca
+	Table0913Ouguiya                                     Table0913 = `MRU` // Ouguiya
+	Table0913MauritiusRupee                              Table0913 = `MUR` // Mauritius Rupee
+	Table0913Rufiyaa                                     Table0913 = `MVR` // Rufiyaa
+	Table0913MalawiKwacha                                Table0913 = `MWK` // Malawi Kwacha
+	Table0913MexicanPeso                                 Table0913 = `MXN` // Mexican Peso
+	Table0913MexicanUnidadDeInversionUDI                 Table0913 = `MXV` // Mexican Unidad de Inversion (UDI)
+	Table0913MalaysianRinggit                            Table0913 = `MYR` // Malaysian Ringgit
+	Table0913MozambiqueMetical                           Table0913 = `MZN` // Mozambique Metical
+	Table0913NamibiaDollar                               Table0913 = `NAD` // Namibia Dollar
+	Table0913Naira                                       Table0913 = `NGN` // Naira
+	Table0913CordobaOro                                  Table0913 = `NIO` // Cordoba Oro
+	Table0913NorwegianKrone                              Table0913 = `NOK` // Norwegian Krone
+	Table0913NepaleseRupee                               Table0913 = `NPR` // Nepalese Rupee
+	Table0913NewZealandDollar                            Table0913 = `NZD` // New Zealand Dollar
+	Table0913RialOmani                                   Table0913 = `OMR` // Rial Omani
+	Table0913Balboa                                      Table0913 = `PAB` // Balboa
+	Table0913Sol                                         Table0913 = `PEN` // Sol
+	Table0913Kina                                        Table0913 = `PGK` // Kina
+	Table0913PhilippinePeso                              Table0913 = `PHP` // Philippine Peso
+	Table0913PakistanRupee                               Table0913 = `PKR` // Pakistan Rupee
+	Table0913Zloty                                       Table0913 = `PLN` // Zloty
+	Table0913Guarani                                     Table0913 = `PYG` // Guarani
+	Table0913QatariRial                                  Table0913 = `QAR` // Qatari Rial
+	Table0913RomanianLeu                                 Table0913 = `RON` // Romanian Leu
+	Table0913SerbianDinar                                Table0913 = `RSD` // Serbian Dinar
+	Table0913RussianRuble                                Table0913 = `RUB` // Russian Ruble
+	Table0913RwandaFranc                                 Table0913 = `RWF` // Rwanda Franc
+	Table0913SaudiRiyal                                  Table0913 = `SAR` // Saudi Riyal
+	Table0913SolomonIslandsDollar                        Table0913 = `SBD` // Solomon Islands Dollar
+	Table0913SeychellesRupee                             Table0913 = `SCR` // Seychelles Rupee
+	Table0913SudanesePound                               Table0913 = `SDG` // Sudanese Pound
+	Table0913SwedishKrona                                Table0913 = `SEK` // Swedish Krona
+	Table0913SingaporeDollar                             Table0913 = `SGD` // Singapore Dollar
+	Table0913SaintHelenaPound                            Table0913 = `SHP` // Saint Helena Pound
+	Table0913Leone                                       Table0913 = `SLL` // Leone
+	Table0913SomaliShilling                              Table0913 = `SOS` // Somali Shilling
+	Table0913SurinamDollar                               Table0913 = `SRD` // Surinam Dollar
+	Table0913SouthSudanesePound                          Table0913 = `SSP` // South Sudanese Pound
+	Table0913Dobra                                       Table0913 = `STN` // Dobra
+	Table0913ElSalvadorColon                             Table0913 = `SVC` // El Salvador Colon
+	Table0913SyrianPound                                 Table0913 = `SYP` // Syrian Pound
+	Table0913Lilangeni                                   Table0913 = `SZL` // Lilangeni
+	Table0913Baht                                        Table0913 = `THB` // Baht
+	Table0913Somoni                                      Table0913 = `TJS` // Somoni
+	Table0913TurkmenistanNewManat                        Table0913 = `TMT` // Turkmenistan New Manat
+	Table0913TunisianDinar                               Table0913 = `TND` // Tunisian Dinar
+	Table0913PaAnga                                      Table0913 = `TOP` // Pa’anga
+	Table0913TurkishLira                                 Table0913 = `TRY` // Turkish Lira
+	Table0913TrinidadAndTobagoDollar                     Table0913 = `TTD` // Trinidad and Tobago Dollar
+	Table0913NewTaiwanDollar                             Table0913 = `TWD` // New Taiwan Dollar
+	Table0913TanzanianShilling                           Table0913 = `TZS` // Tanzanian Shilling
+	Table0913Hryvnia                                     Table0913 = `UAH` // Hryvnia
+	Table0913UgandaShilling                              Table0913 = `UGX` // Uganda Shilling
+	Table0913USDollar                                    Table0913 = `USD` // US Dollar
+	Table0913USDollarNextDay                             Table0913 = `USN` // US Dollar (Next day)
+	Table0913UruguayPesoEnUnidadesIndexadasUI            Table0913 = `UYI` // Uruguay Peso en Unidades Indexadas (UI)
+	Table0913PesoUruguayo                                Table0913 = `UYU` // Peso Uruguayo
+	Table0913UnidadPrevisional                           Table0913 = `UYW` // Unidad Previsional
+	Table0913UzbekistanSum                               Table0913 = `UZS` // Uzbekistan Sum
+	Table0913BolVarSoberano                              Table0913 = `VES` // Bolívar Soberano
+	Table0913Dong                                        Table0913 = `VND` // Dong
+	Table0913Vatu                                        Table0913 = `VUV` // Vatu
+	Table0913Tala                                        Table0913 = `WST` // Tala
+	Table0913CFAFrancBEAC                                Table0913 = `XAF` // CFA Franc BEAC
+	Table0913Silver                                      Table0913 = `XAG` // Silver
+	Table0913Gold                                        Table0913 = `XAU` // Gold
+	Table0913BondMarketsUnitEuropeanCompositeUnit        Table0913 = `XBA` // Bond Markets Unit European Composite Unit (EURCO)
+	Table0913BondMarketsUnitEuropeanMonetaryUnit         Table0913 = `XBB` // Bond Markets Unit European Monetary Unit (E.M.U.-6)
+	Table0913BondMarketsUnitEuropeanUnitOf               Table0913 = `XBC` // Bond Markets Unit European Unit of Account 9 (E.U.A.-9)
+	Table0913BondMarketsUnitEuropeanUnitOf_XBD           Table0913 = `XBD` // Bond Markets Unit European Unit of Account 17 (E.U.A.-17)
+	Table0913EastCaribbeanDollar                         Table0913 = `XCD` // East Caribbean Dollar
+	Table0913SDRSpecialDrawingRight                      Table0913 = `XDR` // SDR (Special Drawing Right)
+	Table0913CFAFrancBCEAO                               Table0913 = `XOF` // CFA Franc BCEAO
+	Table0913Palladium                                   Table0913 = `XPD` // Palladium
+	Table0913CFPFranc                                    Table0913 = `XPF` // CFP Franc
+	Table0913Platinum                                    Table0913 = `XPT` // Platinum
+	Table0913Sucre                                       Table0913 = `XSU` // Sucre
+	Table0913CodesSpecificallyReservedForTestingPurposes Table0913 = `XTS` // Codes specifically reserved for testing purposes
+	Table0913ADBUnitOfAccount                            Table0913 = `XUA` // ADB Unit of Account
+	Table0913TheCodesAssignedForTransactionsWhere        Table0913 = `XXX` // The codes assigned for transactions where no currency is involved
+	Table0913YemeniRial                                  Table0913 = `YER` // Yemeni Rial
+	Table0913Rand                                        Table0913 = `ZAR` // Rand
+	Table0913ZambianKwacha                               Table0913 = `ZMW` // Zambian Kwacha
+	Table0913ZimbabweDollar                              Table0913 = `ZWL` // Zimbabwe Dollar
+)
+
+// Description of the value, or empty if the value is not in table 0913.
+func (v Table0913) Description() string {
+	return tableDescription(`0913`, string(v))
+}
+
+// Valid reports if the value is in table 0913.
+func (v Table0913) Valid() bool {
+	return TableValueLookup[`0913`][string(v)]
+}
+
+// Table 0913.
+func (v Table0913) Table() Table {
+	return TableLookup[`0913`]
+}
+
+// TableCity is a value of table City, City.
+// Fields in this table are strings; convert a field, such as TableCity(v), to use the helpers.
+type TableCity string
+
+const (
+	TableCityAlbuquerque               TableCity = `Albuquerque`
+	TableCityArlington                 TableCity = `Arlington`
+	TableCityAtlanta                   TableCity = `Atlanta`
+	TableCityAustin                    TableCity = `Austin`
+	TableCityBaltimore                 TableCity = `Baltimore`
+	TableCityBoston                    TableCity = `Boston`
+	TableCityCharlotte                 TableCity = `Charlotte`
+	TableCityChicago                   TableCity = `Chicago`
+	TableCityCleveland                 TableCity = `Cleveland`
+	TableCityColoradoSprings           TableCity = `Colorado Springs`
+	TableCityColumbus                  TableCity = `Columbus`
+	TableCityDallas                    TableCity = `Dallas`
+	TableCityDenver                    TableCity = `Denver`
+	TableCityDetroit                   TableCity = `Detroit`
+	TableCityElPaso                    TableCity = `El Paso`
+	TableCityFortWorth                 TableCity = `Fort Worth`
+	TableCityFresno                    TableCity = `Fresno`
+	TableCityHonolulu                  TableCity = `Honolulu`
+	TableCityHouston                   TableCity = `Houston`
+	TableCityIndianapolis              TableCity = `Indianapolis`
+	TableCityJacksonville              TableCity = `Jacksonville`
+	TableCityKansasCity                TableCity = `Kansas City`
+	TableCityLasVegas                  TableCity = `Las Vegas`
+	TableCityLongBeach                 TableCity = `Long Beach`
+	TableCityLosAngeles                TableCity = `Los Angeles`
+	TableCityLouisvilleJeffersonCounty TableCity = `Louisville-Jefferson County`
+	TableCityMemphis                   TableCity = `Memphis`
+	TableCityMesa                      TableCity = `Mesa`
+	TableCityMiami                     TableCity = `Miami`
+	TableCityMilwaukee                 TableCity = `Milwaukee`
+	TableCityMinneapolis               TableCity = `Minneapolis`
+	TableCityNashvilleDavidson         TableCity = `Nashville-Davidson`
+	TableCityNewYork                   TableCity = `New York`
+	TableCityOakland                   TableCity = `Oakland`
+	TableCityOklahomaCity              TableCity = `Oklahoma City`
+	TableCityOmaha                     TableCity = `Omaha`
+	TableCityPhiladelphia              TableCity = `Philadelphia`
+	TableCityPhoenix                   TableCity = `Phoenix`
+	TableCityPortland                  TableCity = `Portland`
+	TableCityRaleigh                   TableCity = `Raleigh`
+	TableCitySacramento                TableCity = `Sacramento`
+	TableCitySanAntonio                TableCity = `San Antonio`
+	TableCitySanDiego                  TableCity = `San Diego`
+	TableCitySanFrancisco              TableCity = `San Francisco`
+	TableCitySanJose                   TableCity = `San Jose`
+	TableCitySeattle                   TableCity = `Seattle`
+	TableCityTucson                    TableCity = `Tucson`
+	TableCityTulsa                     TableCity = `Tulsa`
+	TableCityVirginiaBeach             TableCity = `Virginia Beach`
+	TableCityWashington                TableCity = `Washington`
+)
+
+// Description of the value, or empty if the value is not in table City.
+func (v TableCity) Description() string {
+	return tableDescription(`City`, string(v))
+}
+
+// Valid reports if the value is in table City.
+func (v TableCity) Valid() bool {
+	return TableValueLookup[`City`][string(v)]
+}
+
+// Table City.
+func (v TableCity) Table() Table {
+	return TableLookup[`City`]
+}
+
+// TableFirstName is a value of table FirstName, First Name.
+// Fields in this table are strings; convert a field, such as TableFirstName(v), to use the helpers.
+type TableFirstName string
+
+const (
+	TableFirstNameAaron       TableFirstName = `Aaron`
+	TableFirstNameAbby        TableFirstName = `Abby`
+	TableFirstNameAbe         TableFirstName = `Abe`
+	TableFirstNameAbel        TableFirstName = `Abel`
+	TableFirstNameAbigail     TableFirstName = `Abigail`
+	TableFirstNameAbraham     TableFirstName = `Abraham`
+	TableFirstNameAda         TableFirstName = `Ada`
+	TableFirstNameAdam        TableFirstName = `Adam`
+	TableFirstNameAddie       TableFirstName = `Addie`
+	TableFirstNameAdela       TableFirstName = `Adela`
+	TableFirstNameAdele       TableFirstName = `Adele`
+	TableFirstNameAdolfo      TableFirstName = `Adolfo`
+	TableFirstNameAdolph      TableFirstName = `Adolph`
+	TableFirstNameAdolphus    TableFirstName = `Adolphus`
+	TableFirstNameAdrian      TableFirstName = `Adrian`
+	TableFirstNameAdrienne    TableFirstName = `Adrienne`
+	TableFirstNameAgnes       TableFirstName = `Agnes`
+	TableFirstNameAgustin     TableFirstName = `Agustin`
+	TableFirstNameAida        TableFirstName = `Aida`
+	TableFirstNameAileen      TableFirstName = `Aileen`
+	TableFirstNameAimee       TableFirstName = `Aimee`
+	TableFirstNameAl          TableFirstName = `Al`
+	TableFirstNameAlan        TableFirstName = `Alan`
+	TableFirstNameAlana       TableFirstName = `Alana`
+	TableFirstNameAlbert      TableFirstName = `Albert`
+	TableFirstNameAlberta     TableFirstName = `Alberta`
+	TableFirstNameAlberto     TableFirstName = `Alberto`
+	TableFirstNameAlden       TableFirstName = `Alden`
+	TableFirstNameAlejandro   TableFirstName = `Alejandro`
+	TableFirstNameAleta       TableFirstName = `Aleta`
+	TableFirstNameAletha      TableFirstName = `Aletha`
+	TableFirstNameAlex        TableFirstName = `Alex`
+	TableFirstNameAlexander   TableFirstName = `Alexander`
+	TableFirstNameAlexandra   TableFirstName = `Alexandra`
+	TableFirstNameAlexis      TableFirstName = `Alexis`
+	TableFirstNameAlfonso     TableFirstName = `Alfonso`
+	TableFirstNameAlfonzo     TableFirstName = `Alfonzo`
+	TableFirstNameAlford      TableFirstName = `Alford`
+	TableFirstNameAlfred      TableFirstName = `Alfred`
+	TableFirstNameAlfreda     TableFirstName = `Alfreda`
+	TableFirstNameAlfredo     TableFirstName = `Alfredo`
+	TableFirstNameAlice       TableFirstName = `Alice`
+	TableFirstNameAlicia      TableFirstName = `Alicia`
+	TableFirstNameAlisa       TableFirstName = `Alisa`
+	TableFirstNameAlison      TableFirstName = `Alison`
+	TableFirstNameAllan       TableFirstName = `Allan`
+	TableFirstNameAllen       TableFirstName = `Allen`
+	TableFirstNameAllison     TableFirstName = `Allison`
+	TableFirstNameAllyn       TableFirstName = `Allyn`
+	TableFirstNameAlma        TableFirstName = `Alma`
+	TableFirstNameAlonzo      TableFirstName = `Alonzo`
+	TableFirstNameAlphonse    TableFirstName = `Alphonse`
+	TableFirstNameAlphonso    TableFirstName = `Alphonso`
+	TableFirstNameAlta        TableFirstName = `Alta`
+	TableFirstNameAlthea      TableFirstName = `Althea`
+	TableFirstNameAlton       TableFirstName = `Alton`
+	TableFirstNameAlva        TableFirstName = `Alva`
+	TableFirstNameAlvin       TableFirstName = `Alvin`
+	TableFirstNameAlyce       TableFirstName = `Alyce`
+	TableFirstNameAmanda      TableFirstName = `Amanda`
+	TableFirstNameAmber       TableFirstName = `Amber`
+	TableFirstNameAmelia      TableFirstName = `Amelia`
+	TableFirstNameAmos        TableFirstName = `Amos`
+	TableFirstNameAmy         TableFirstName = `Amy`
+	TableFirstNameAna         TableFirstName = `Ana`
+	TableFirstNameAnderson    TableFirstName = `Anderson`
+	TableFirstNameAndre       TableFirstName = `Andre`
+	TableFirstNameAndrea      TableFirstName = `Andrea`
+	TableFirstNameAndres      TableFirstName = `Andres`
+	TableFirstNameAndrew      TableFirstName = `Andrew`
+	TableFirstNameAndy        TableFirstName = `Andy`
+	TableFirstNameAngel       TableFirstName = `Angel`
+	TableFirstNameAngela      TableFirstName = `Angela`
+	TableFirstNameAngelia     TableFirstName = `Angelia`
+	TableFirstNameAngelina    TableFirstName = `Angelina`
+	TableFirstNameAngeline    TableFirstName = `Angeline`
+	TableFirstNameAngelita    TableFirstName = `Angelita`
+	TableFirstNameAngelo      TableFirstName = `Angelo`
+	TableFirstNameAngie       TableFirstName = `Angie`
+	TableFirstNameAnita       TableFirstName = `Anita`
+	TableFirstNameAnn         TableFirstName = `Ann`
+	TableFirstNameAnna        TableFirstName = `Anna`
+	TableFirstNameAnne        TableFirstName = `Anne`
+	TableFirstNameAnnemarie   TableFirstName = `Annemarie`
+	TableFirstNameAnnetta     TableFirstName = `Annetta`
+	TableFirstNameAnnette     TableFirstName = `Annette`
+	TableFirstNameAnnie       TableFirstName = `Annie`
+	TableFirstNameAnnmarie    TableFirstName = `Annmarie`
+	TableFirstNameAnthony     TableFirstName = `Anthony`
+	TableFirstNameAntionette  TableFirstName = `Antionette`
+	TableFirstNameAntoinette  TableFirstName = `Antoinette`
+	TableFirstNameAnton       TableFirstName = `Anton`
+	TableFirstNameAntonia     TableFirstName = `Antonia`
+	TableFirstNameAntonio     TableFirstName = `Antonio`
+	TableFirstNameApril       TableFirstName = `April`
+	TableFirstNameArchie      TableFirstName = `Archie`
+	TableFirstNameArden       TableFirstName = `Arden`
+	TableFirstNameArleen      TableFirstName = `Arleen`
+	TableFirstNameArlen       TableFirstName = `Arlen`
+	TableFirstNameArlene      TableFirstName = `Arlene`
+	TableFirstNameArlie       TableFirstName = `Arlie`
+	TableFirstNameArmand      TableFirstName = `Armand`
+	TableFirstNameArmando     TableFirstName = `Armando`
+	TableFirstNameArnold      TableFirstName = `Arnold`
+	TableFirstNameArnulfo     TableFirstName = `Arnulfo`
+	TableFirstNameArt         TableFirstName = `Art`
+	TableFirstNameArthur      TableFirstName = `Arthur`
+	TableFirstNameArtie       TableFirstName = `Artie`
+	TableFirstNameArtis       TableFirstName = `Artis`
+	TableFirstNameArturo      TableFirstName = `Arturo`
+	TableFirstNameAshley      TableFirstName = `Ashley`
+	TableFirstNameAthena      TableFirstName = `Athena`
+	TableFirstNameAubrey      TableFirstName = `Aubrey`
+	TableFirstNameAudie       TableFirstName = `Audie`
+	TableFirstNameAudrey      TableFirstName = `Audrey`
+	TableFirstNameAugust      TableFirstName = `August`
+	TableFirstNameAugustine   TableFirstName = `Augustine`
+	TableFirstNameAugustus    TableFirstName = `Augustus`
+	TableFirstNameAurelio     TableFirstName = `Aurelio`
+	TableFirstNameAurora      TableFirstName = `Aurora`
+	TableFirstNameAustin      TableFirstName = `Austin`
+	TableFirstNameAva         TableFirstName = `Ava`
+	TableFirstNameAvery       TableFirstName = `Avery`
+	TableFirstNameAvis        TableFirstName = `Avis`
+	TableFirstNameBambi       TableFirstName = `Bambi`
+	TableFirstNameBarbara     TableFirstName = `Barbara`
+	TableFirstNameBarbra      TableFirstName = `Barbra`
+	TableFirstNameBarney      TableFirstName = `Barney`
+	TableFirstNameBarrett     TableFirstName = `Barrett`
+	TableFirstNameBarry       TableFirstName = `Barry`
+	TableFirstNameBart        TableFirstName = `Bart`
+	TableFirstNameBarton      TableFirstName = `Barton`
+	TableFirstNameBasil       TableFirstName = `Basil`
+	TableFirstNameBeatrice    TableFirstName = `Beatrice`
+	TableFirstNameBecky       TableFirstName = `Becky`
+	TableFirstNameBelinda     TableFirstName = `Belinda`
+	TableFirstNameBen         TableFirstName = `Ben`
+	TableFirstNameBenedict    TableFirstName = `Benedict`
+	TableFirstNameBenita      TableFirstName = `Benita`
+	TableFirstNameBenito      TableFirstName = `Benito`
+	TableFirstNameBenjamin    TableFirstName = `Benjamin`
+	TableFirstNameBennett     TableFirstName = `Bennett`
+	TableFirstNameBennie      TableFirstName = `Bennie`
+	TableFirstNameBenny       TableFirstName = `Benny`
+	TableFirstNameBenton      TableFirstName = `Benton`
+	TableFirstNameBernadette  TableFirstName = `Bernadette`
+	TableFirstNameBernadine   TableFirstName = `Bernadine`
+	TableFirstNameBernard     TableFirstName = `Bernard`
+	TableFirstNameBernice     TableFirstName = `Bernice`
+	TableFirstNameBernie      TableFirstName = `Bernie`
+	TableFirstNameBerry       TableFirstName = `Berry`
+	TableFirstNameBert        TableFirstName = `Bert`
+	TableFirstNameBertha      TableFirstName = `Bertha`
+	TableFirstNameBertram     TableFirstName = `Bertram`
+	TableFirstNameBeryl       TableFirstName = `Beryl`
+	TableFirstNameBessie      TableFirstName = `Bessie`
+	TableFirstNameBeth        TableFirstName = `Beth`
+	TableFirstNameBethany     TableFirstName = `Bethany`
+	TableFirstNameBetsy       TableFirstName = `Betsy`
+	TableFirstNameBette       TableFirstName = `Bette`
+	TableFirstNameBettie      TableFirstName = `Bettie`
+	TableFirstNameBetty       TableFirstName = `Betty`
+	TableFirstNameBettye      TableFirstName = `Bettye`
+	TableFirstNameBeulah      TableFirstName = `Beulah`
+	TableFirstNameBeverley    TableFirstName = `Beverley`
+	TableFirstNameBeverly     TableFirstName = `Beverly`
+	TableFirstNameBill        TableFirstName = `Bill`
+	TableFirstNameBillie      TableFirstName = `Billie`
+	TableFirstNameBilly       TableFirstName = `Billy`
+	TableFirstNameBlaine      TableFirstName = `Blaine`
+	TableFirstNameBlair       TableFirstName = `Blair`
+	TableFirstNameBlake       TableFirstName = `Blake`
+	TableFirstNameBlanca      TableFirstName = `Blanca`
+	TableFirstNameBlanche     TableFirstName = `Blanche`
+	TableFirstNameBlane       TableFirstName = `Blane`
+	TableFirstNameBob         TableFirstName = `Bob`
+	TableFirstNameBobbi       TableFirstName = `Bobbi`
+	TableFirstNameBobbie      TableFirstName = `Bobbie`
+	TableFirstNameBobby       TableFirstName = `Bobby`
+	TableFirstNameBonita      TableFirstName = `Bonita`
+	TableFirstNameBonnie      TableFirstName = `Bonnie`
+	TableFirstNameBonny       TableFirstName = `Bonny`
+	TableFirstNameBooker      TableFirstName = `Booker`
+	TableFirstNameBoyce       TableFirstName = `Boyce`
+	TableFirstNameBoyd        TableFirstName = `Boyd`
+	TableFirstNameBrad        TableFirstName = `Brad`
+	TableFirstNameBradford    TableFirstName = `Bradford`
+	TableFirstNameBradley     TableFirstName = `Bradley`
+	TableFirstNameBradly      TableFirstName = `Bradly`
+	TableFirstNameBrady       TableFirstName = `Brady`
+	TableFirstNameBrandon     TableFirstName = `Brandon`
+	TableFirstNameBrant       TableFirstName = `Brant`
+	TableFirstNameBrenda      TableFirstName = `Brenda`
+	TableFirstNameBrendan     TableFirstName = `Brendan`
+	TableFirstNameBrent       TableFirstName = `Brent`
+	TableFirstNameBret        TableFirstName = `Bret`
+	TableFirstNameBrett       TableFirstName = `Brett`
+	TableFirstNameBrian       TableFirstName = `Brian`
+	TableFirstNameBrice       TableFirstName = `Brice`
+	TableFirstNameBridget     TableFirstName = `Bridget`
+	TableFirstNameBritt       TableFirstName = `Britt`
+	TableFirstNameBrooks      TableFirstName = `Brooks`
+	TableFirstNameBruce       TableFirstName = `Bruce`
+	TableFirstNameBruno       TableFirstName = `Bruno`
+	TableFirstNameBryan       TableFirstName = `Bryan`
+	TableFirstNameBryant      TableFirstName = `Bryant`
+	TableFirstNameBryce       TableFirstName = `Bryce`
+	TableFirstNameBryon       TableFirstName = `Bryon`
+	TableFirstNameBud         TableFirstName = `Bud`
+	TableFirstNameBuddy       TableFirstName = `Buddy`
+	TableFirstNameBuford      TableFirstName = `Buford`
+	TableFirstNameBurl        TableFirstName = `Burl`
+	TableFirstNameBurt        TableFirstName = `Burt`
+	TableFirstNameBurton      TableFirstName = `Burton`
+	TableFirstNameBuster      TableFirstName = `Buster`
+	TableFirstNameButch       TableFirstName = `Butch`
+	TableFirstNameByron       TableFirstName = `Byron`
+	TableFirstNameCallie      TableFirstName = `Callie`
+	TableFirstNameCalvin      TableFirstName = `Calvin`
+	TableFirstNameCameron     TableFirstName = `Cameron`
+	TableFirstNameCamilla     TableFirstName = `Camilla`
+	TableFirstNameCamille     TableFirstName = `Camille`
+	TableFirstNameCandace     TableFirstName = `Candace`
+	TableFirstNameCandice     TableFirstName = `Candice`
+	TableFirstNameCandy       TableFirstName = `Candy`
+	TableFirstNameCara        TableFirstName = `Cara`
+	TableFirstNameCaren       TableFirstName = `Caren`
+	TableFirstNameCarey       TableFirstName = `Carey`
+	TableFirstNameCarl        TableFirstName = `Carl`
+	TableFirstNameCarla       TableFirstName = `Carla`
+	TableFirstNameCarleen     TableFirstName = `Carleen`
+	TableFirstNameCarlene     TableFirstName = `Carlene`
+	TableFirstNameCarleton    TableFirstName = `Carleton`
+	TableFirstNameCarlo       TableFirstName = `Carlo`
+	TableFirstNameCarlos      TableFirstName = `Carlos`
+	TableFirstNameCarlotta    TableFirstName = `Carlotta`
+	TableFirstNameCarlton     TableFirstName = `Carlton`
+	TableFirstNameCarmel      TableFirstName = `Carmel`
+	TableFirstNameCarmela     TableFirstName = `Carmela`
+	TableFirstNameCarmella    TableFirstName = `Carmella`
+	TableFirstNameCarmelo     TableFirstName = `Carmelo`
+	TableFirstNameCarmen      TableFirstName = `Carmen`
+	TableFirstNameCarmine     TableFirstName = `Carmine`
+	TableFirstNameCarnell     TableFirstName = `Carnell`
+	TableFirstNameCarol       TableFirstName = `Carol`
+	TableFirstNameCarole      TableFirstName = `Carole`
+	TableFirstNameCarolina    TableFirstName = `Carolina`
+	TableFirstNameCaroline    TableFirstName = `Caroline`
+	TableFirstNameCarolyn     TableFirstName = `Carolyn`
+	TableFirstNameCaron       TableFirstName = `Caron`
+	TableFirstNameCarrie      TableFirstName = `Carrie`
+	TableFirstNameCarroll     TableFirstName = `Carroll`
+	TableFirstNameCarson      TableFirstName = `Carson`
+	TableFirstNameCarter      TableFirstName = `Carter`
+	TableFirstNameCary        TableFirstName = `Cary`
+	TableFirstNameCaryl       TableFirstName = `Caryl`
+	TableFirstNameCaryn       TableFirstName = `Caryn`
+	TableFirstNameCasey       TableFirstName = `Casey`
+	TableFirstNameCassandra   TableFirstName = `Cassandra`
+	TableFirstNameCatharine   TableFirstName = `Catharine`
+	TableFirstNameCatherine   TableFirstName = `Catherine`
+	TableFirstNameCathey      TableFirstName = `Cathey`
+	TableFirstNameCathie      TableFirstName = `Cathie`
+	TableFirstNameCathleen    TableFirstName = `Cathleen`
+	TableFirstNameCathrine    TableFirstName = `Cathrine`
+	TableFirstNameCathryn     TableFirstName = `Cathryn`
+	TableFirstNameCathy       TableFirstName = `Cathy`
+	TableFirstNameCecelia     TableFirstName = `Cecelia`
+	TableFirstNameCecil       TableFirstName = `Cecil`
+	TableFirstNameCecile      TableFirstName = `Cecile`
+	TableFirstNameCecilia     TableFirstName = `Cecilia`
+	TableFirstNameCedric      TableFirstName = `Cedric`
+	TableFirstNameCeleste     TableFirstName = `Celeste`
+	TableFirstNameCelestine   TableFirstName = `Celestine`
+	TableFirstNameCelia       TableFirstName = `Celia`
+	TableFirstNameCesar       TableFirstName = `Cesar`
+	TableFirstNameChad        TableFirstName = `Chad`
+	TableFirstNameCharla      TableFirstName = `Charla`
+	TableFirstNameCharleen    TableFirstName = `Charleen`
+	TableFirstNameCharlene    TableFirstName = `Charlene`
+	TableFirstNameCharles     TableFirstName = `Charles`
+	TableFirstNameCharley     TableFirstName = `Charley`
+	TableFirstNameCharlie     TableFirstName = `Charlie`
+	TableFirstNameCharlotte   TableFirstName = `Charlotte`
+	TableFirstNameCharmaine   TableFirstName = `Charmaine`
+	TableFirstNameChauncey    TableFirstName = `Chauncey`
+	TableFirstNameCheri       TableFirstName = `Cheri`
+	TableFirstNameCherie      TableFirstName = `Cherie`
+	TableFirstNameCherri      TableFirstName = `Cherri`
+	TableFirstNameCherrie     TableFirstName = `Cherrie`
+	TableFirstNameCherry      TableFirstName = `Cherry`
+	TableFirstNameCheryl      TableFirstName = `Cheryl`
+	TableFirstNameCheryle     TableFirstName = `Cheryle`
+	TableFirstNameCheryll     TableFirstName = `Cheryll`
+	TableFirstNameChester     TableFirstName = `Chester`
+	TableFirstNameChip        TableFirstName = `Chip`
+	TableFirstNameChris       TableFirstName = `Chris`
+	TableFirstNameChrista     TableFirstName = `Christa`
+	TableFirstNameChristi     TableFirstName = `Christi`
+	TableFirstNameChristian   TableFirstName = `Christian`
+	TableFirstNameChristie    TableFirstName = `Christie`
+	TableFirstNameChristina   TableFirstName = `Christina`
+	TableFirstNameChristine   TableFirstName = `Christine`
+	TableFirstNameChristophe  TableFirstName = `Christophe`
+	TableFirstNameChristopher TableFirstName = `Christopher`
+	TableFirstNameChristy     TableFirstName = `Christy`
+	TableFirstNameChuck       TableFirstName = `Chuck`
+	TableFirstNameCinda       TableFirstName = `Cinda`
+	TableFirstNameCindi       TableFirstName = `Cindi`
+	TableFirstNameCindy       TableFirstName = `Cindy`
+	TableFirstNameClair       TableFirstName = `Clair`
+	TableFirstNameClaire      TableFirstName = `Claire`
+	TableFirstNameClara       TableFirstName = `Clara`
+	TableFirstNameClare       TableFirstName = `Clare`
+	TableFirstNameClarence    TableFirstName = `Clarence`
+	TableFirstNameClarice     TableFirstName = `Clarice`
+	TableFirstNameClarissa    TableFirstName = `Clarissa`
+	TableFirstNameClark       TableFirstName = `Clark`
+	TableFirstNameClarke      TableFirstName = `Clarke`
+	TableFirstNameClaud       TableFirstName = `Claud`
+	TableFirstNameClaude      TableFirstName = `Claude`
+	TableFirstNameClaudette   TableFirstName = `Claudette`
+	TableFirstNameClaudia     TableFirstName = `Claudia`
+	TableFirstNameClay        TableFirstName = `Clay`
+	TableFirstNameClayton     TableFirstName = `Clayton`
+	TableFirstNameClement     TableFirstName = `Clement`
+	TableFirstNameCleo        TableFirstName = `Cleo`
+	TableFirstNameCletus      TableFirstName = `Cletus`
+	TableFirstNameCleve       TableFirstName = `Cleve`
+	TableFirstNameCleveland   TableFirstName = `Cleveland`
+	TableFirstNameCliff       TableFirstName = `Cliff`
+	TableFirstNameClifford    TableFirstName = `Clifford`
+	TableFirstNameClifton     TableFirstName = `Clifton`
+	TableFirstNameClint       TableFirstName = `Clint`
+	TableFirstNameClinton     TableFirstName = `Clinton`
+	TableFirstNameClyde       TableFirstName = `Clyde`
+	TableFirstNameCody        TableFirstName = `Cody`
+	TableFirstNameCole        TableFirstName = `Cole`
+	TableFirstNameColeen      TableFirstName = `Coleen`
+	TableFirstNameColeman     TableFirstName = `Coleman`
+	TableFirstNameColette     TableFirstName = `Colette`
+	TableFirstNameColin       TableFirstName = `Colin`
+	TableFirstNameColleen     TableFirstName = `Colleen`
+	TableFirstNameCollette    TableFirstName = `Collette`
+	TableFirstNameColumbus    TableFirstName = `Columbus`
+	TableFirstNameConcetta    TableFirstName = `Concetta`
+	TableFirstNameConnie      TableFirstName = `Connie`
+	TableFirstNameConrad      TableFirstName = `Conrad`
+	TableFirstNameConstance   TableFirstName = `Constance`
+	TableFirstNameConsuelo    TableFirstName = `Consuelo`
+	TableFirstNameCora        TableFirstName = `Cora`
+	TableFirstNameCordell     TableFirstName = `Cordell`
+	TableFirstNameCorey       TableFirstName = `Corey`
+	TableFirstNameCorine      TableFirstName = `Corine`
+	TableFirstNameCorinne     TableFirstName = `Corinne`
+	TableFirstNameCorliss     TableFirstName = `Corliss`
+	TableFirstNameCornelia    TableFirstName = `Cornelia`
+	TableFirstNameCornelius   TableFirstName = `Cornelius`
+	TableFirstNameCornell     TableFirstName = `Cornell`
+	TableFirstNameCorrine     TableFirstName = `Corrine`
+	TableFirstNameCory        TableFirstName = `Cory`
+	TableFirstNameCourtney    TableFirstName = `Courtney`
+	TableFirstNameCoy         TableFirstName = `Coy`
+	TableFirstNameCraig       TableFirstName = `Craig`
+	TableFirstNameCris        TableFirstName = `Cris`
+	TableFirstNameCristina    TableFirstName = `Cristina`
+	TableFirstNameCruz        TableFirstName = `Cruz`
+	TableFirstNameCrystal     TableFirstName = `Crystal`
+	TableFirstNameCurt        TableFirstName = `Curt`
+	TableFirstNameCurtis      TableFirstName = `Curtis`
+	TableFirstNameCurtiss     TableFirstName = `Curtiss`
+	TableFirstNameCynthia     TableFirstName = `Cynthia`
+	TableFirstNameCyril       TableFirstName = `Cyril`
+	TableFirstNameCyrus       TableFirstName = `Cyrus`
+	TableFirstNameDaisy       TableFirstName = `Daisy`
+	TableFirstNameDale        TableFirstName = `Dale`
+	TableFirstNameDallas      TableFirstName = `Dallas`
+	TableFirstNameDalton      TableFirstName = `Dalton`
+	TableFirstNameDamian      TableFirstName = `Damian`
+	TableFirstNameDamon       TableFirstName = `Damon`
+	TableFirstNameDan         TableFirstName = `Dan`
+	TableFirstNameDana        TableFirstName = `Dana`
+	TableFirstNameDane        TableFirstName = `Dane`
+	TableFirstNameDanette     TableFirstName = `Danette`
+	TableFirstNameDanial      TableFirstName = `Danial`
+	TableFirstNameDaniel      TableFirstName = `Daniel`
+	TableFirstNameDanielle    TableFirstName = `Danielle`
+	TableFirstNameDanita      TableFirstName = `Danita`
+	TableFirstNameDann        TableFirstName = `Dann`
+	TableFirstNameDanna       TableFirstName = `Danna`
+	TableFirstNameDannie      TableFirstName = `Dannie`
+	TableFirstNameDanny       TableFirstName = `Danny`
+	TableFirstNameDante       TableFirstName = `Dante`
+	TableFirstNameDaphne      TableFirstName = `Daphne`
+	TableFirstNameDarcy       TableFirstName = `Darcy`
+	TableFirstNameDarell      TableFirstName = `Darell`
+	TableFirstNameDaria       TableFirstName = `Daria`
+	TableFirstNameDarius      TableFirstName = `Darius`
+	TableFirstNameDarla       TableFirstName = `Darla`
+	TableFirstNameDarleen     TableFirstName = `Darleen`
+	TableFirstNameDarlene     TableFirstName = `Darlene`
+	TableFirstNameDarnell     TableFirstName = `Darnell`
+	TableFirstNameDarold      TableFirstName = `Darold`
+	TableFirstNameDarrel      TableFirstName = `Darrel`
+	TableFirstNameDarrell     TableFirstName = `Darrell`
+	TableFirstNameDarryl      TableFirstName = `Darryl`
+	TableFirstNameDarwin      TableFirstName = `Darwin`
+	TableFirstNameDaryl       TableFirstName = `Daryl`
+	TableFirstNameDaryle      TableFirstName = `Daryle`
+	TableFirstNameDave        TableFirstName = `Dave`
+	TableFirstNameDavey       TableFirstName = `Davey`
+	TableFirstNameDavid       TableFirstName = `David`
+	TableFirstNameDavie       TableFirstName = `Davie`
+	TableFirstNameDavis       TableFirstName = `Davis`
+	TableFirstNameDavy        TableFirstName = `Davy`
+	TableFirstNameDawn        TableFirstName = `Dawn`
+	TableFirstNameDayna       TableFirstName = `Dayna`
+	TableFirstNameDean        TableFirstName = `Dean`
+	TableFirstNameDeana       TableFirstName = `Deana`
+	TableFirstNameDeann       TableFirstName = `Deann`
+	TableFirstNameDeanna      TableFirstName = `Deanna`
+	TableFirstNameDeanne      TableFirstName = `Deanne`
+	TableFirstNameDebbi       TableFirstName = `Debbi`
+	TableFirstNameDebbie      TableFirstName = `Debbie`
+	TableFirstNameDebbra      TableFirstName = `Debbra`
+	TableFirstNameDebby       TableFirstName = `Debby`
+	TableFirstNameDebi        TableFirstName = `Debi`
+	TableFirstNameDebora      TableFirstName = `Debora`
+	TableFirstNameDeborah     TableFirstName = `Deborah`
+	TableFirstNameDebra       TableFirstName = `Debra`
+	TableFirstNameDebrah      TableFirstName = `Debrah`
+	TableFirstNameDebroah     TableFirstName = `Debroah`
+	TableFirstNameDee         TableFirstName = `Dee`
+	TableFirstNameDeena       TableFirstName = `Deena`
+	TableFirstNameDeidre      TableFirstName = `Deidre`
+	TableFirstNameDeirdre     TableFirstName = `Deirdre`
+	TableFirstNameDel         TableFirstName = `Del`
+	TableFirstNameDelbert     TableFirstName = `Delbert`
+	TableFirstNameDelia       TableFirstName = `Delia`
+	TableFirstNameDelilah     TableFirstName = `Delilah`
+	TableFirstNameDell        TableFirstName = `Dell`
+	TableFirstNameDella       TableFirstName = `Della`
+	TableFirstNameDelma       TableFirstName = `Delma`
+	TableFirstNameDelmar      TableFirstName = `Delmar`
+	TableFirstNameDelmer      TableFirstName = `Delmer`
+	TableFirstNameDelois      TableFirstName = `Delois`
+	TableFirstNameDelores     TableFirstName = `Delores`
+	TableFirstNameDeloris     TableFirstName = `Deloris`
+	TableFirstNameDelphine    TableFirstName = `Delphine`
+	TableFirstNameDelton      TableFirstName = `Delton`
+	TableFirstNameDemetrius   TableFirstName = `Demetrius`
+	TableFirstNameDena        TableFirstName = `Dena`
+	TableFirstNameDenice      TableFirstName = `Denice`
+	TableFirstNameDenis       TableFirstName = `Denis`
+	TableFirstNameDenise      TableFirstName = `Denise`
+	TableFirstNameDennie      TableFirstName = `Dennie`
+	TableFirstNameDennis      TableFirstName = `Dennis`
+	TableFirstNameDenny       TableFirstName = `Denny`
+	TableFirstNameDenver      TableFirstName = `Denver`
+	TableFirstNameDerek       TableFirstName = `Derek`
+	TableFirstNameDerrell     TableFirstName = `Derrell`
+	TableFirstNameDerrick     TableFirstName = `Derrick`
+	TableFirstNameDesiree     TableFirstName = `Desiree`
+	TableFirstNameDesmond     TableFirstName = `Desmond`
+	TableFirstNameDewayne     TableFirstName = `Dewayne`
+	TableFirstNameDewey       TableFirstName = `Dewey`
+	TableFirstNameDewitt      TableFirstName = `Dewitt`
+	TableFirstNameDexter      TableFirstName = `Dexter`
+	TableFirstNameDian        TableFirstName = `Dian`
+	TableFirstNameDiana       TableFirstName = `Diana`
+	TableFirstNameDiane       TableFirstName = `Diane`
+	TableFirstNameDiann       TableFirstName = `Diann`
+	TableFirstNameDianna      TableFirstName = `Dianna`
+	TableFirstNameDianne      TableFirstName = `Dianne`
+	TableFirstNameDick        TableFirstName = `Dick`
+	TableFirstNameDickie      TableFirstName = `Dickie`
+	TableFirstNameDina        TableFirstName = `Dina`
+	TableFirstNameDinah       TableFirstName = `Dinah`
+	TableFirstNameDino        TableFirstName = `Dino`
+	TableFirstNameDirk        TableFirstName = `Dirk`
+	TableFirstNameDixie       TableFirstName = `Dixie`
+	TableFirstNameDollie      TableFirstName = `Dollie`
+	TableFirstNameDolly       TableFirstName = `Dolly`
+	TableFirstNameDolores     TableFirstName = `Dolores`
+	TableFirstNameDomenic     TableFirstName = `Domenic`
+	TableFirstNameDomingo     TableFirstName = `Domingo`
+	TableFirstNameDominic     TableFirstName = `Dominic`
+	TableFirstNameDominick    TableFirstName = `Dominick`
+	TableFirstNameDon         TableFirstName = `Don`
+	TableFirstNameDona        TableFirstName = `Dona`
+	TableFirstNameDonald      TableFirstName = `Donald`
+	TableFirstNameDonell      TableFirstName = `Donell`
+	TableFirstNameDonita      TableFirstName = `Donita`
+	TableFirstNameDonn        TableFirstName = `Donn`
+	TableFirstNameDonna       TableFirstName = `Donna`
+	TableFirstNameDonnell     TableFirstName = `Donnell`
+	TableFirstNameDonnie      TableFirstName = `Donnie`
+	TableFirstNameDonny       TableFirstName = `Donny`
+	TableFirstNameDonovan     TableFirstName = `Donovan`
+	TableFirstNameDora        TableFirstName = `Dora`
+	TableFirstNameDorcas      TableFirstName = `Dorcas`
+	TableFirstNameDoreen      TableFirstName = `Doreen`
+	TableFirstNameDorene      TableFirstName = `Dorene`
+	TableFirstNameDoretha     TableFirstName = `Doretha`
+	TableFirstNameDorian      TableFirstName = `Dorian`
+	TableFirstNameDorinda     TableFirstName = `Dorinda`
+	TableFirstNameDoris       TableFirstName = `Doris`
+	TableFirstNameDorothea    TableFirstName = `Dorothea`
+	TableFirstNameDorothy     TableFirstName = `Dorothy`
+	TableFirstNameDorsey      TableFirstName = `Dorsey`
+	TableFirstNameDorthy      TableFirstName = `Dorthy`
+	TableFirstNameDottie      TableFirstName = `Dottie`
+	TableFirstNameDoug        TableFirstName = `Doug`
+	TableFirstNameDouglas     TableFirstName = `Douglas`
+	TableFirstNameDouglass    TableFirstName = `Douglass`
+	TableFirstNameDoyle       TableFirstName = `Doyle`
+	TableFirstNameDrew        TableFirstName = `Drew`
+	TableFirstNameDuane       TableFirstName = `Duane`
+	TableFirstNameDudley      TableFirstName = `Dudley`
+	TableFirstNameDuke        TableFirstName = `Duke`
+	TableFirstNameDuncan      TableFirstName = `Duncan`
+	TableFirstNameDusty       TableFirstName = `Dusty`
+	TableFirstNameDuwayne     TableFirstName = `Duwayne`
+	TableFirstNameDwain       TableFirstName = `Dwain`
+	TableFirstNameDwaine      TableFirstName = `Dwaine`
+	TableFirstNameDwayne      TableFirstName = `Dwayne`
+	TableFirstNameDwight      TableFirstName = `Dwight`
+	TableFirstNameEarl        TableFirstName = `Earl`
+	TableFirstNameEarle       TableFirstName = `Earle`
+	TableFirstNameEarlene     TableFirstName = `Earlene`
+	TableFirstNameEarline     TableFirstName = `Earline`
+	TableFirstNameEarnest     TableFirstName = `Earnest`
+	TableFirstNameEarnestine  TableFirstName = `Earnestine`
+	TableFirstNameEartha      TableFirstName = `Eartha`
+	TableFirstNameEd          TableFirstName = `Ed`
+	TableFirstNameEddie       TableFirstName = `Eddie`
+	TableFirstNameEddy        TableFirstName = `Eddy`
+	TableFirstNameEdgar       TableFirstName = `Edgar`
+	TableFirstNameEdith       TableFirstName = `Edith`
+	TableFirstNameEdmund      TableFirstName = `Edmund`
+	TableFirstNameEdna        TableFirstName = `Edna`
+	TableFirstNameEduardo     TableFirstName = `Eduardo`
+	TableFirstNameEdward      TableFirstName = `Edward`
+	TableFirstNameEdwardo     TableFirstName = `Edwardo`
+	TableFirstNameEdwin       TableFirstName = `Edwin`
+	TableFirstNameEdwina      TableFirstName = `Edwina`
+	TableFirstNameEffie       TableFirstName = `Effie`
+	TableFirstNameEfrain      TableFirstName = `Efrain`
+	TableFirstNameEileen      TableFirstName = `Eileen`
+	TableFirstNameElaine      TableFirstName = `Elaine`
+	TableFirstNameElbert      TableFirstName = `Elbert`
+	TableFirstNameEldon       TableFirstName = `Eldon`
+	TableFirstNameEldridge    TableFirstName = `Eldridge`
+	TableFirstNameEleanor     TableFirstName = `Eleanor`
+	TableFirstNameElena       TableFirstName = `Elena`
+	TableFirstNameEli         TableFirstName = `Eli`
+	TableFirstNameElias       TableFirstName = `Elias`
+	TableFirstNameElijah      TableFirstName = `Elijah`
+	TableFirstNameElisa       TableFirstName = `Elisa`
+	TableFirstNameElisabeth   TableFirstName = `Elisabeth`
+	TableFirstNameElise       TableFirstName = `Elise`
+	TableFirstNameEliseo      TableFirstName = `Eliseo`
+	TableFirstNameElissa      TableFirstName = `Elissa`
+	TableFirstNameEliza       TableFirstName = `Eliza`
+	TableFirstNameElizabeth   TableFirstName = `Elizabeth`
+	TableFirstNameElla        TableFirstName = `Ella`
+	TableFirstNameEllen       TableFirstName = `Ellen`
+	TableFirstNameElliot      TableFirstName = `Elliot`
+	TableFirstNameElliott     TableFirstName = `Elliott`
+	TableFirstNameEllis       TableFirstName = `Ellis`
+	TableFirstNameElma        TableFirstName = `Elma`
+	TableFirstNameElmer       TableFirstName = `Elmer`
+	TableFirstNameElmo        TableFirstName = `Elmo`
+	TableFirstNameElnora      TableFirstName = `Elnora`
+	TableFirstNameEloise      TableFirstName = `Eloise`
+	TableFirstNameEloy        TableFirstName = `Eloy`
+	TableFirstNameElroy       TableFirstName = `Elroy`
+	TableFirstNameElsa        TableFirstName = `Elsa`
+	TableFirstNameElsie       TableFirstName = `Elsie`
+	TableFirstNameElton       TableFirstName = `Elton`
+	TableFirstNameElva        TableFirstName = `Elva`
+	TableFirstNameElvin       TableFirstName = `Elvin`
+	TableFirstNameElvira      TableFirstName = `Elvira`
+	TableFirstNameElvis       TableFirstName = `Elvis`
+	TableFirstNameElwood      TableFirstName = `Elwood`
+	TableFirstNameElyse       TableFirstName = `Elyse`
+	TableFirstNameEmanuel     TableFirstName = `Emanuel`
+	TableFirstNameEmerson     TableFirstName = `Emerson`
+	TableFirstNameEmery       TableFirstName = `Emery`
+	TableFirstNameEmil        TableFirstName = `Emil`
+	TableFirstNameEmilio      TableFirstName = `Emilio`
+	TableFirstNameEmily       TableFirstName = `Emily`
+	TableFirstNameEmma        TableFirstName = `Emma`
+	TableFirstNameEmmanuel    TableFirstName = `Emmanuel`
+	TableFirstNameEmmett      TableFirstName = `Emmett`
+	TableFirstNameEmmitt      TableFirstName = `Emmitt`
+	TableFirstNameEmory       TableFirstName = `Emory`
+	TableFirstNameEnoch       TableFirstName = `Enoch`
+	TableFirstNameEnrique     TableFirstName = `Enrique`
+	TableFirstNameEric        TableFirstName = `Eric`
+	TableFirstNameErica       TableFirstName = `Erica`
+	TableFirstNameErich       TableFirstName = `Erich`
+	TableFirstNameErick       TableFirstName = `Erick`
+	TableFirstNameErik        TableFirstName = `Erik`
+	TableFirstNameErin        TableFirstName = `Erin`
+	TableFirstNameErma        TableFirstName = `Erma`
+	TableFirstNameErnest      TableFirstName = `Ernest`
+	TableFirstNameErnestine   TableFirstName = `Ernestine`
+	TableFirstNameErnesto     TableFirstName = `Ernesto`
+	TableFirstNameErnie       TableFirstName = `Ernie`
+	TableFirstNameErrol       TableFirstName = `Errol`
+	TableFirstNameErvin       TableFirstName = `Ervin`
+	TableFirstNameErwin       TableFirstName = `Erwin`
+	TableFirstNameEsmeralda   TableFirstName = `Esmeralda`
+	TableFirstNameEsperanza   TableFirstName = `Esperanza`
+	TableFirstNameEssie       TableFirstName = `Essie`
+	TableFirstNameEsteban     TableFirstName = `Esteban`
+	TableFirstNameEstela      TableFirstName = `Estela`
+	TableFirstNameEstella     TableFirstName = `Estella`
+	TableFirstNameEstelle     TableFirstName = `Estelle`
+	TableFirstNameEster       TableFirstName = `Ester`
+	TableFirstNameEsther      TableFirstName = `Esther`
+	TableFirstNameEthel       TableFirstName = `Ethel`
+	TableFirstNameEtta        TableFirstName = `Etta`
+	TableFirstNameEugene      TableFirstName = `Eugene`
+	TableFirstNameEugenia     TableFirstName = `Eugenia`
+	TableFirstNameEula        TableFirstName = `Eula`
+	TableFirstNameEunice      TableFirstName = `Eunice`
+	TableFirstNameEva         TableFirstName = `Eva`
+	TableFirstNameEvan        TableFirstName = `Evan`
+	TableFirstNameEvangeline  TableFirstName = `Evangeline`
+	TableFirstNameEve         TableFirstName = `Eve`
+	TableFirstNameEvelyn      TableFirstName = `Evelyn`
+	TableFirstNameEverett     TableFirstName = `Everett`
+	TableFirstNameEverette    TableFirstName = `Everette`
+	TableFirstNameEzra        TableFirstName = `Ezra`
+	TableFirstNameFaith       TableFirstName = `Faith`
+	TableFirstNameFannie      TableFirstName = `Fannie`
+	TableFirstNameFaron       TableFirstName = `Faron`
+	TableFirstNameFarrell     TableFirstName = `Farrell`
+	TableFirstNameFay         TableFirstName = `Fay`
+	TableFirstNameFaye        TableFirstName = `Faye`
+	TableFirstNameFederico    TableFirstName = `Federico`
+	TableFirstNameFelecia     TableFirstName = `Felecia`
+	TableFirstNameFelicia     TableFirstName = `Felicia`
+	TableFirstNameFelipe      TableFirstName = `Felipe`
+	TableFirstNameFelix       TableFirstName = `Felix`
+	TableFirstNameFelton      TableFirstName = `Felton`
+	TableFirstNameFerdinand   TableFirstName = `Ferdinand`
+	TableFirstNameFern        TableFirstName = `Fern`
+	TableFirstNameFernando    TableFirstName = `Fernando`
+	TableFirstNameFidel       TableFirstName = `Fidel`
+	TableFirstNameFletcher    TableFirstName = `Fletcher`
+	TableFirstNameFlora       TableFirstName = `Flora`
+	TableFirstNameFlorence    TableFirstName = `Florence`
+	TableFirstNameFlorine     TableFirstName = `Florine`
+	TableFirstNameFloyd       TableFirstName = `Floyd`
+	TableFirstNameForest      TableFirstName = `Forest`
+	TableFirstNameForrest     TableFirstName = `Forrest`
+	TableFirstNameFoster      TableFirstName = `Foster`
+	TableFirstNameFran        TableFirstName = `Fran`
+	TableFirstNameFrances     TableFirstName = `Frances`
+	TableFirstNameFrancesca   TableFirstName = `Francesca`
+	TableFirstNameFrancine    TableFirstName = `Francine`
+	TableFirstNameFrancis     TableFirstName = `Francis`
+	TableFirstNameFrancisco   TableFirstName = `Francisco`
+	TableFirstNameFrank       TableFirstName = `Frank`
+	TableFirstNameFrankie     TableFirstName = `Frankie`
+	TableFirstNameFranklin    TableFirstName = `Franklin`
+	TableFirstNameFranklyn    TableFirstName = `Franklyn`
+	TableFirstNameFred        TableFirstName = `Fred`
+	TableFirstNameFreda       TableFirstName = `Freda`
+	TableFirstNameFreddie     TableFirstName = `Freddie`
+	TableFirstNameFreddy      TableFirstName = `Freddy`
+	TableFirstNameFrederic    TableFirstName = `Frederic`
+	TableFirstNameFrederick   TableFirstName = `Frederick`
+	TableFirstNameFredric     TableFirstName = `Fredric`
+	TableFirstNameFredrick    TableFirstName = `Fredrick`
+	TableFirstNameFreeman     TableFirstName = `Freeman`
+	TableFirstNameFreida      TableFirstName = `Freida`
+	TableFirstNameFrieda      TableFirstName = `Frieda`
+	TableFirstNameFritz       TableFirstName = `Fritz`
+	TableFirstNameGabriel     TableFirstName = `Gabriel`
+	TableFirstNameGail        TableFirstName = `Gail`
+	TableFirstNameGale        TableFirstName = `Gale`
+	TableFirstNameGalen       TableFirstName = `Galen`
+	TableFirstNameGarland     TableFirstName = `Garland`
+	TableFirstNameGarold      TableFirstName = `Garold`
+	TableFirstNameGarrett     TableFirstName = `Garrett`
+	TableFirstNameGarry       TableFirstName = `Garry`
+	TableFirstNameGarth       TableFirstName = `Garth`
+	TableFirstNameGary        TableFirstName = `Gary`
+	TableFirstNameGavin       TableFirstName = `Gavin`
+	TableFirstNameGay         TableFirstName = `Gay`
+	TableFirstNameGaye        TableFirstName = `Gaye`
+	TableFirstNameGayla       TableFirstName = `Gayla`
+	TableFirstNameGayle       TableFirstName = `Gayle`
+	TableFirstNameGaylon      TableFirstName = `Gaylon`
+	TableFirstNameGaylord     TableFirstName = `Gaylord`
+	TableFirstNameGearld      TableFirstName = `Gearld`
+	TableFirstNameGeary       TableFirstName = `Geary`
+	TableFirstNameGena        TableFirstName = `Gena`
+	TableFirstNameGene        TableFirstName = `Gene`
+	TableFirstNameGeneva      TableFirstName = `Geneva`
+	TableFirstNameGenevieve   TableFirstName = `Genevieve`
+	TableFirstNameGeoffrey    TableFirstName = `Geoffrey`
+	TableFirstNameGeorge      TableFirstName = `George`
+	TableFirstNameGeorgette   TableFirstName = `Georgette`
+	TableFirstNameGeorgia     TableFirstName = `Georgia`
+	TableFirstNameGeorgina    TableFirstName = `Georgina`
+	TableFirstNameGerald      TableFirstName = `Gerald`
+	TableFirstNameGeraldine   TableFirstName = `Geraldine`
+	TableFirstNameGeralyn     TableFirstName = `Geralyn`
+	TableFirstNameGerard      TableFirstName = `Gerard`
+	TableFirstNameGerardo     TableFirstName = `Gerardo`
+	TableFirstNameGeri        TableFirstName = `Geri`
+	TableFirstNameGerri       TableFirstName = `Gerri`
+	TableFirstNameGerry       TableFirstName = `Gerry`
+	TableFirstNameGertrude    TableFirstName = `Gertrude`
+	TableFirstNameGil         TableFirstName = `Gil`
+	TableFirstNameGilbert     TableFirstName = `Gilbert`
+	TableFirstNameGilberto    TableFirstName = `Gilberto`
+	TableFirstNameGilda       TableFirstName = `Gilda`
+	TableFirstNameGiles       TableFirstName = `Giles`
+	TableFirstNameGina        TableFirstName = `Gina`
+	TableFirstNameGinger      TableFirstName = `Ginger`
+	TableFirstNameGino        TableFirstName = `Gino`
+	TableFirstNameGisele      TableFirstName = `Gisele`
+	TableFirstNameGladys      TableFirstName = `Gladys`
+	TableFirstNameGlen        TableFirstName = `Glen`
+	TableFirstNameGlenda      TableFirstName = `Glenda`
+	TableFirstNameGlenn       TableFirstName = `Glenn`
+	TableFirstNameGlenna      TableFirstName = `Glenna`
+	TableFirstNameGlinda      TableFirstName = `Glinda`
+	TableFirstNameGloria      TableFirstName = `Gloria`
+	TableFirstNameGlynn       TableFirstName = `Glynn`
+	TableFirstNameGoldie      TableFirstName = `Goldie`
+	TableFirstNameGordon      TableFirstName = `Gordon`
+	TableFirstNameGrace       TableFirstName = `Grace`
+	TableFirstNameGracie      TableFirstName = `Gracie`
+	TableFirstNameGraciela    TableFirstName = `Graciela`
+	TableFirstNameGrady       TableFirstName = `Grady`
+	TableFirstNameGraham      TableFirstName = `Graham`
+	TableFirstNameGrant       TableFirstName = `Grant`
+	TableFirstNameGreg        TableFirstName = `Greg`
+	TableFirstNameGregg       TableFirstName = `Gregg`
+	TableFirstNameGreggory    TableFirstName = `Greggory`
+	TableFirstNameGregorio    TableFirstName = `Gregorio`
+	TableFirstNameGregory     TableFirstName = `Gregory`
+	TableFirstNameGreta       TableFirstName = `Greta`
+	TableFirstNameGretchen    TableFirstName = `Gretchen`
+	TableFirstNameGrover      TableFirstName = `Grover`
+	TableFirstNameGuadalupe   TableFirstName = `Guadalupe`
+	TableFirstNameGuillermo   TableFirstName = `Guillermo`
+	TableFirstNameGus         TableFirstName = `Gus`
+	TableFirstNameGustavo     TableFirstName = `Gustavo`
+	TableFirstNameGuy         TableFirstName = `Guy`
+	TableFirstNameGwen        TableFirstName = `Gwen`
+	TableFirstNameGwendolyn   TableFirstName = `Gwendolyn`
+	TableFirstNameHal         TableFirstName = `Hal`
+	TableFirstNameHank        TableFirstName = `Hank`
+	TableFirstNameHannah      TableFirstName = `Hannah`
+	TableFirstNameHans        TableFirstName = `Hans`
+	TableFirstNameHarlan      TableFirstName = `Harlan`
+	TableFirstNameHarley      TableFirstName = `Harley`
+	TableFirstNameHarmon      TableFirstName = `Harmon`
+	TableFirstNameHarold      TableFirstName = `Harold`
+	TableFirstNameHarriet     TableFirstName = `Harriet`
+	TableFirstNameHarriett    TableFirstName = `Harriett`
+	TableFirstNameHarris      TableFirstName = `Harris`
+	TableFirstNameHarrison    TableFirstName = `Harrison`
+	TableFirstNameHarry       TableFirstName = `Harry`
+	TableFirstNameHarvey      TableFirstName = `Harvey`
+	TableFirstNameHattie      TableFirstName = `Hattie`
+	TableFirstNameHayward     TableFirstName = `Hayward`
+	TableFirstNameHaywood     TableFirstName = `Haywood`
+	TableFirstNameHazel       TableFirstName = `Hazel`
+	TableFirstNameHeather     TableFirstName = `Heather`
+	TableFirstNameHector      TableFirstName = `Hector`
+	TableFirstNameHeidi       TableFirstName = `Heidi`
+	TableFirstNameHelen       TableFirstName = `Helen`
+	TableFirstNameHelena      TableFirstName = `Helena`
+	TableFirstNameHelene      TableFirstName = `Helene`
+	TableFirstNameHenrietta   TableFirstName = `Henrietta`
+	TableFirstNameHenry       TableFirstName = `Henry`
+	TableFirstNameHerbert     TableFirstName = `Herbert`
+	TableFirstNameHeriberto   TableFirstName = `Heriberto`
+	TableFirstNameHerman      TableFirstName = `Herman`
+	TableFirstNameHerschel    TableFirstName = `Herschel`
+	TableFirstNameHershel     TableFirstName = `Hershel`
+	TableFirstNameHilary      TableFirstName = `Hilary`
+	TableFirstNameHilda       TableFirstName = `Hilda`
+	TableFirstNameHilton      TableFirstName = `Hilton`
+	TableFirstNameHiram       TableFirstName = `Hiram`
+	TableFirstNameHollis      TableFirstName = `Hollis`
+	TableFirstNameHolly       TableFirstName = `Holly`
+	TableFirstNameHomer       TableFirstName = `Homer`
+	TableFirstNameHope        TableFirstName = `Hope`
+	TableFirstNameHorace      TableFirstName = `Horace`
+	TableFirstNameHosea       TableFirstName = `Hosea`
+	TableFirstNameHouston     TableFirstName = `Houston`
+	TableFirstNameHoward      TableFirstName = `Howard`
+	TableFirstNameHoyt        TableFirstName = `Hoyt`
+	TableFirstNameHubert      TableFirstName = `Hubert`
+	TableFirstNameHuey        TableFirstName = `Huey`
+	TableFirstNameHugh        TableFirstName = `Hugh`
+	TableFirstNameHugo        TableFirstName = `Hugo`
+	TableFirstNameHumberto    TableFirstName = `Humberto`
+	TableFirstNameIan         TableFirstName = `Ian`
+	TableFirstNameIda         TableFirstName = `Ida`
+	TableFirstNameIgnacio     TableFirstName = `Ignacio`
+	TableFirstNameIke         TableFirstName = `Ike`
+	TableFirstNameIlene       TableFirstName = `Ilene`
+	TableFirstNameImogene     TableFirstName = `Imogene`
+	TableFirstNameIna         TableFirstName = `Ina`
+	TableFirstNameInez        TableFirstName = `Inez`
+	TableFirstNameIngrid      TableFirstName = `Ingrid`
+	TableFirstNameIra         TableFirstName = `Ira`
+	TableFirstNameIrene       TableFirstName = `Irene`
+	TableFirstNameIris        TableFirstName = `Iris`
+	TableFirstNameIrma        TableFirstName = `Irma`
+	TableFirstNameIrvin       TableFirstName = `Irvin`
+	TableFirstNameIrving      TableFirstName = `Irving`
+	TableFirstNameIrwin       TableFirstName = `Irwin`
+	TableFirstNameIsaac       TableFirstName = `Isaac`
+	TableFirstNameIsabel      TableFirstName = `Isabel`
+	TableFirstNameIsaiah      TableFirstName = `Isaiah`
+	TableFirstNameIsiah       TableFirstName = `Isiah`
+	TableFirstNameIsmael      TableFirstName = `Ismael`
+	TableFirstNameIsrael      TableFirstName = `Israel`
+	TableFirstNameIssac       TableFirstName = `Issac`
+	TableFirstNameIva         TableFirstName = `Iva`
+	TableFirstNameIvan        TableFirstName = `Ivan`
+	TableFirstNameIvory       TableFirstName = `Ivory`
+	TableFirstNameIvy         TableFirstName = `Ivy`
+	TableFirstNameJacalyn     TableFirstName = `Jacalyn`
+	TableFirstNameJack        TableFirstName = `Jack`
+	TableFirstNameJackie      TableFirstName = `Jackie`
+	TableFirstNameJacklyn     TableFirstName = `Jacklyn`
+	TableFirstNameJackson     TableFirstName = `Jackson`
+	TableFirstNameJacky       TableFirstName = `Jacky`
+	TableFirstNameJacob       TableFirstName = `Jacob`
+	TableFirstNameJacque      TableFirstName = `Jacque`
+	TableFirstNameJacqueline  TableFirstName = `Jacqueline`
+	TableFirstNameJacquelyn   TableFirstName = `Jacquelyn`
+	TableFirstNameJacques     TableFirstName = `Jacques`
+	TableFirstNameJacquline   TableFirstName = `Jacquline`
+	TableFirstNameJaime       TableFirstName = `Jaime`
+	TableFirstNameJake        TableFirstName = `Jake`
+	TableFirstNameJame        TableFirstName = `Jame`
+	TableFirstNameJames       TableFirstName = `James`
+	TableFirstNameJamie       TableFirstName = `Jamie`
+	TableFirstNameJan         TableFirstName = `Jan`
+	TableFirstNameJana        TableFirstName = `Jana`
+	TableFirstNameJane        TableFirstName = `Jane`
+	TableFirstNameJaneen      TableFirstName = `Janeen`
+	TableFirstNameJanell      TableFirstName = `Janell`
+	TableFirstNameJanelle     TableFirstName = `Janelle`
+	TableFirstNameJanet       TableFirstName = `Janet`
+	TableFirstNameJanette     TableFirstName = `Janette`
+	TableFirstNameJanice      TableFirstName = `Janice`
+	TableFirstNameJanie       TableFirstName = `Janie`
+	TableFirstNameJanine      TableFirstName = `Janine`
+	TableFirstNameJanis       TableFirstName = `Janis`
+	TableFirstNameJann        TableFirstName = `Jann`
+	TableFirstNameJanna       TableFirstName = `Janna`
+	TableFirstNameJannette    TableFirstName = `Jannette`
+	TableFirstNameJannie      TableFirstName = `Jannie`
+	TableFirstNameJared       TableFirstName = `Jared`
+	TableFirstNameJarvis      TableFirstName = `Jarvis`
+	TableFirstNameJason       TableFirstName = `Jason`
+	TableFirstNameJasper      TableFirstName = `Jasper`
+	TableFirstNameJavier      TableFirstName = `Javier`
+	TableFirstNameJay         TableFirstName = `Jay`
+	TableFirstNameJaye        TableFirstName = `Jaye`
+	TableFirstNameJayne       TableFirstName = `Jayne`
+	TableFirstNameJean        TableFirstName = `Jean`
+	TableFirstNameJeanette    TableFirstName = `Jeanette`
+	TableFirstNameJeanie      TableFirstName = `Jeanie`
+	TableFirstNameJeanine     TableFirstName = `Jeanine`
+	TableFirstNameJeanne      TableFirstName = `Jeanne`
+	TableFirstNameJeannette   TableFirstName = `Jeannette`
+	TableFirstNameJeannie     TableFirstName = `Jeannie`
+	TableFirstNameJeannine    TableFirstName = `Jeannine`
+	TableFirstNameJed         TableFirstName = `Jed`
+	TableFirstNameJeff        TableFirstName = `Jeff`
+	TableFirstNameJefferey    TableFirstName = `Jefferey`
+	TableFirstNameJefferson   TableFirstName = `Jefferson`
+	TableFirstNameJeffery     TableFirstName = `Jeffery`
+	TableFirstNameJeffry      TableFirstName = `Jeffry`
+	TableFirstNameJenifer     TableFirstName = `Jenifer`
+	TableFirstNameJennie      TableFirstName = `Jennie`
+	TableFirstNameJennifer    TableFirstName = `Jennifer`
+	TableFirstNameJenny       TableFirstName = `Jenny`
+	TableFirstNameJerald      TableFirstName = `Jerald`
+	TableFirstNameJere        TableFirstName = `Jere`
+	TableFirstNameJeremiah    TableFirstName = `Jeremiah`
+	TableFirstNameJeremy      TableFirstName = `Jeremy`
+	TableFirstNameJeri        TableFirstName = `Jeri`
+	TableFirstNameJerilyn     TableFirstName = `Jerilyn`
+	TableFirstNameJerold      TableFirstName = `Jerold`
+	TableFirstNameJerome      TableFirstName = `Jerome`
+	TableFirstNameJerri       TableFirstName = `Jerri`
+	TableFirstNameJerrie      TableFirstName = `Jerrie`
+	TableFirstNameJerrold     TableFirstName = `Jerrold`
+	TableFirstNameJerry       TableFirstName = `Jerry`
+	TableFirstNameJeryl       TableFirstName = `Jeryl`
+	TableFirstNameJess        TableFirstName = `Jess`
+	TableFirstNameJesse       TableFirstName = `Jesse`
+	TableFirstNameJessica     TableFirstName = `Jessica`
+	TableFirstNameJessie      TableFirstName = `Jessie`
+	TableFirstNameJesus       TableFirstName = `Jesus`
+	TableFirstNameJewel       TableFirstName = `Jewel`
+	TableFirstNameJewell      TableFirstName = `Jewell`
+	TableFirstNameJill        TableFirstName = `Jill`
+	TableFirstNameJim         TableFirstName = `Jim`
+	TableFirstNameJimmie      TableFirstName = `Jimmie`
+	TableFirstNameJimmy       TableFirstName = `Jimmy`
+	TableFirstNameJo          TableFirstName = `Jo`
+	TableFirstNameJoan        TableFirstName = `Joan`
+	TableFirstNameJoanie      TableFirstName = `Joanie`
+	TableFirstNameJoann       TableFirstName = `Joann`
+	TableFirstNameJoanna      TableFirstName = `Joanna`
+	TableFirstNameJoanne      TableFirstName = `Joanne`
+	TableFirstNameJoaquin     TableFirstName = `Joaquin`
+	TableFirstNameJocelyn     TableFirstName = `Jocelyn`
+	TableFirstNameJodi        TableFirstName = `Jodi`
+	TableFirstNameJodie       TableFirstName = `Jodie`
+	TableFirstNameJody        TableFirstName = `Jody`
+	TableFirstNameJoe         TableFirstName = `Joe`
+	TableFirstNameJoel        TableFirstName = `Joel`
+	TableFirstNameJoellen     TableFirstName = `Joellen`
+	TableFirstNameJoesph      TableFirstName = `Joesph`
+	TableFirstNameJoette      TableFirstName = `Joette`
+	TableFirstNameJoey        TableFirstName = `Joey`
+	TableFirstNameJohanna     TableFirstName = `Johanna`
+	TableFirstNameJohn        TableFirstName = `John`
+	TableFirstNameJohnathan   TableFirstName = `Johnathan`
+	TableFirstNameJohnie      TableFirstName = `Johnie`
+	TableFirstNameJohnnie     TableFirstName = `Johnnie`
+	TableFirstNameJohnny      TableFirstName = `Johnny`
+	TableFirstNameJoleen      TableFirstName = `Joleen`
+	TableFirstNameJolene      TableFirstName = `Jolene`
+	TableFirstNameJon         TableFirstName = `Jon`
+	TableFirstNameJonas       TableFirstName = `Jonas`
+	TableFirstNameJonathan    TableFirstName = `Jonathan`
+	TableFirstNameJonathon    TableFirstName = `Jonathon`
+	TableFirstNameJoni        TableFirstName = `Joni`
+	TableFirstNameJordan      TableFirstName = `Jordan`
+	TableFirstNameJorge       TableFirstName = `Jorge`
+	TableFirstNameJose        TableFirstName = `Jose`
+	TableFirstNameJosefina    TableFirstName = `Josefina`
+	TableFirstNameJoseph      TableFirstName = `Joseph`
+	TableFirstNameJosephine   TableFirstName = `Josephine`
+	TableFirstNameJoshua      TableFirstName = `Joshua`
+	TableFirstNameJosie       TableFirstName = `Josie`
+	TableFirstNameJoy         TableFirstName = `Joy`
+	TableFirstNameJoyce       TableFirstName = `Joyce`
+	TableFirstNameJoycelyn    TableFirstName = `Joycelyn`
+	TableFirstNameJuan        TableFirstName = `Juan`
+	TableFirstNameJuana       TableFirstName = `Juana`
+	TableFirstNameJuanita     TableFirstName = `Juanita`
+	TableFirstNameJude        TableFirstName = `Jude`
+	TableFirstNameJudi        TableFirstName = `Judi`
+	TableFirstNameJudith      TableFirstName = `Judith`
+	TableFirstNameJudson      TableFirstName = `Judson`
+	TableFirstNameJudy        TableFirstName = `Judy`
+	TableFirstNameJules       TableFirstName = `Jules`
+	TableFirstNameJulia       TableFirstName = `Julia`
+	TableFirstNameJulian      TableFirstName = `Julian`
+	TableFirstNameJuliana     TableFirstName = `Juliana`
+	TableFirstNameJuliann     TableFirstName = `Juliann`
+	TableFirstNameJulianne    TableFirstName = `Julianne`
+	TableFirstNameJulie       TableFirstName = `Julie`
+	TableFirstNameJuliet      TableFirstName = `Juliet`
+	TableFirstNameJuliette    TableFirstName = `Juliette`
+	TableFirstNameJulio       TableFirstName = `Julio`
+	TableFirstNameJulius      TableFirstName = `Julius`
+	TableFirstNameJune        TableFirstName = `June`
+	TableFirstNameJunior      TableFirstName = `Junior`
+	TableFirstNameJustin      TableFirstName = `Justin`
+	TableFirstNameJustine     TableFirstName = `Justine`
+	TableFirstNameKandy       TableFirstName = `Kandy`
+	TableFirstNameKaran       TableFirstName = `Karan`
+	TableFirstNameKaren       TableFirstName = `Karen`
+	TableFirstNameKari        TableFirstName = `Kari`
+	TableFirstNameKarin       TableFirstName = `Karin`
+	TableFirstNameKarl        TableFirstName = `Karl`
+	TableFirstNameKarla       TableFirstName = `Karla`
+	TableFirstNameKarol       TableFirstName = `Karol`
+	TableFirstNameKaron       TableFirstName = `Karon`
+	TableFirstNameKaryn       TableFirstName = `Karyn`
+	TableFirstNameKate        TableFirstName = `Kate`
+	TableFirstNameKathaleen   TableFirstName = `Kathaleen`
+	TableFirstNameKatharine   TableFirstName = `Katharine`
+	TableFirstNameKatherine   TableFirstName = `Katherine`
+	TableFirstNameKatheryn    TableFirstName = `Katheryn`
+	TableFirstNameKathi       TableFirstName = `Kathi`
+	TableFirstNameKathie      TableFirstName = `Kathie`
+	TableFirstNameKathleen    TableFirstName = `Kathleen`
+	TableFirstNameKathrine    TableFirstName = `Kathrine`
+	TableFirstNameKathryn     TableFirstName = `Kathryn`
+	TableFirstNameKathy       TableFirstName = `Kathy`
+	TableFirstNameKatie       TableFirstName = `Katie`
+	TableFirstNameKatrina     TableFirstName = `Katrina`
+	TableFirstNameKay         TableFirstName = `Kay`
+	TableFirstNameKaye        TableFirstName = `Kaye`
+	TableFirstNameKeith       TableFirstName = `Keith`
+	TableFirstNameKelley      TableFirstName = `Kelley`
+	TableFirstNameKelly       TableFirstName = `Kelly`
+	TableFirstNameKelvin      TableFirstName = `Kelvin`
+	TableFirstNameKen         TableFirstName = `Ken`
+	TableFirstNameKendall     TableFirstName = `Kendall`
+	TableFirstNameKendra      TableFirstName = `Kendra`
+	TableFirstNameKenneth     TableFirstName = `Kenneth`
+	TableFirstNameKennith     TableFirstName = `Kennith`
+	TableFirstNameKenny       TableFirstName = `Kenny`
+	TableFirstNameKent        TableFirstName = `Kent`
+	TableFirstNameKenton      TableFirstName = `Kenton`
+	TableFirstNameKermit      TableFirstName = `Kermit`
+	TableFirstNameKerri       TableFirstName = `Kerri`
+	TableFirstNameKerry       TableFirstName = `Kerry`
+	TableFirstNameKevan       TableFirstName = `Kevan`
+	TableFirstNameKeven       TableFirstName = `Keven`
+	TableFirstNameKevin       TableFirstName = `Kevin`
+	TableFirstNameKim         TableFirstName = `Kim`
+	TableFirstNameKimberlee   TableFirstName = `Kimberlee`
+	TableFirstNameKimberley   TableFirstName = `Kimberley`
+	TableFirstNameKimberly    TableFirstName = `Kimberly`
+	TableFirstNameKing        TableFirstName = `King`
+	TableFirstNameKip         TableFirstName = `Kip`
+	TableFirstNameKirby       TableFirstName = `Kirby`
+	TableFirstNameKirk        TableFirstName = `Kirk`
+	TableFirstNameKirt        TableFirstName = `Kirt`
+	TableFirstNameKit         TableFirstName = `Kit`
+	TableFirstNameKitty       TableFirstName = `Kitty`
+	TableFirstNameKraig       TableFirstName = `Kraig`
+	TableFirstNameKris        TableFirstName = `Kris`
+	TableFirstNameKrista      TableFirstName = `Krista`
+	TableFirstNameKristen     TableFirstName = `Kristen`
+	TableFirstNameKristi      TableFirstName = `Kristi`
+	TableFirstNameKristie     TableFirstName = `Kristie`
+	TableFirstNameKristin     TableFirstName = `Kristin`
+	TableFirstNameKristina    TableFirstName = `Kristina`
+	TableFirstNameKristine    TableFirstName = `Kristine`
+	TableFirstNameKristy      TableFirstName = `Kristy`
+	TableFirstNameKurt        TableFirstName = `Kurt`
+	TableFirstNameKurtis      TableFirstName = `Kurtis`
+	TableFirstNameKyle        TableFirstName = `Kyle`
+	TableFirstNameLacy        TableFirstName = `Lacy`
+	TableFirstNameLadonna     TableFirstName = `Ladonna`
+	TableFirstNameLafayette   TableFirstName = `Lafayette`
+	TableFirstNameLamar       TableFirstName = `Lamar`
+	TableFirstNameLamont      TableFirstName = `Lamont`
+	TableFirstNameLana        TableFirstName = `Lana`
+	TableFirstNameLance       TableFirstName = `Lance`
+	TableFirstNameLane        TableFirstName = `Lane`
+	TableFirstNameLanette     TableFirstName = `Lanette`
+	TableFirstNameLanny       TableFirstName = `Lanny`
+	TableFirstNameLarry       TableFirstName = `Larry`
+	TableFirstNameLaura       TableFirstName = `Laura`
+	TableFirstNameLaureen     TableFirstName = `Laureen`
+	TableFirstNameLaurel      TableFirstName = `Laurel`
+	TableFirstNameLauren      TableFirstName = `Lauren`
+	TableFirstNameLaurence    TableFirstName = `Laurence`
+	TableFirstNameLaurene     TableFirstName = `Laurene`
+	TableFirstNameLauretta    TableFirstName = `Lauretta`
+	TableFirstNameLauri       TableFirstName = `Lauri`
+	TableFirstNameLaurie      TableFirstName = `Laurie`
+	TableFirstNameLavern      TableFirstName = `Lavern`
+	TableFirstNameLaverne     TableFirstName = `Laverne`
+	TableFirstNameLavonne     TableFirstName = `Lavonne`
+	TableFirstNameLawanda     TableFirstName = `Lawanda`
+	TableFirstNameLawerence   TableFirstName = `Lawerence`
+	TableFirstNameLawrence    TableFirstName = `Lawrence`
+	TableFirstNameLayne       TableFirstName = `Layne`
+	TableFirstNameLea         TableFirstName = `Lea`
+	TableFirstNameLeah        TableFirstName = `Leah`
+	TableFirstNameLeander     TableFirstName = `Leander`
+	TableFirstNameLeann       TableFirstName = `Leann`
+	TableFirstNameLeanna      TableFirstName = `Leanna`
+	TableFirstNameLeanne      TableFirstName = `Leanne`
+	TableFirstNameLee         TableFirstName = `Lee`
+	TableFirstNameLeeann      TableFirstName = `Leeann`
+	TableFirstNameLeigh       TableFirstName = `Leigh`
+	TableFirstNameLeila       TableFirstName = `Leila`
+	TableFirstNameLela        TableFirstName = `Lela`
+	TableFirstNameLeland      TableFirstName = `Leland`
+	TableFirstNameLelia       TableFirstName = `Lelia`
+	TableFirstNameLemuel      TableFirstName = `Lemuel`
+	TableFirstNameLen         TableFirstName = `Len`
+	TableFirstNameLena        TableFirstName = `Lena`
+	TableFirstNameLenard      TableFirstName = `Lenard`
+	TableFirstNameLennie      TableFirstName = `Lennie`
+	TableFirstNameLenny       TableFirstName = `Lenny`
+	TableFirstNameLenora      TableFirstName = `Lenora`
+	TableFirstNameLenore      TableFirstName = `Lenore`
+	TableFirstNameLeo         TableFirstName = `Leo`
+	TableFirstNameLeola       TableFirstName = `Leola`
+	TableFirstNameLeon        TableFirstName = `Leon`
+	TableFirstNameLeona       TableFirstName = `Leona`
+	TableFirstNameLeonard     TableFirstName = `Leonard`
+	TableFirstNameLeonardo    TableFirstName = `Leonardo`
+	TableFirstNameLeroy       TableFirstName = `Leroy`
+	TableFirstNameLes         TableFirstName = `Les`
+	TableFirstNameLesa        TableFirstName = `Lesa`
+	TableFirstNameLeslee      TableFirstName = `Leslee`
+	TableFirstNameLesley      TableFirstName = `Lesley`
+	TableFirstNameLeslie      TableFirstName = `Leslie`
+	TableFirstNameLessie      TableFirstName = `Lessie`
+	TableFirstNameLester      TableFirstName = `Lester`
+	TableFirstNameLeta        TableFirstName = `Leta`
+	TableFirstNameLetha       TableFirstName = `Letha`
+	TableFirstNameLeticia     TableFirstName = `Leticia`
+	TableFirstNameLetitia     TableFirstName = `Letitia`
+	TableFirstNameLevern      TableFirstName = `Levern`
+	TableFirstNameLevi        TableFirstName = `Levi`
+	TableFirstNameLevon       TableFirstName = `Levon`
+	TableFirstNameLewis       TableFirstName = `Lewis`
+	TableFirstNameLex         TableFirstName = `Lex`
+	TableFirstNameLibby       TableFirstName = `Libby`
+	TableFirstNameLila        TableFirstName = `Lila`
+	TableFirstNameLillian     TableFirstName = `Lillian`
+	TableFirstNameLillie      TableFirstName = `Lillie`
+	TableFirstNameLilly       TableFirstName = `Lilly`
+	TableFirstNameLily        TableFirstName = `Lily`
+	TableFirstNameLincoln     TableFirstName = `Lincoln`
+	TableFirstNameLinda       TableFirstName = `Linda`
+	TableFirstNameLindsay     TableFirstName = `Lindsay`
+	TableFirstNameLindsey     TableFirstName = `Lindsey`
+	TableFirstNameLindy       TableFirstName = `Lindy`
+	TableFirstNameLinnea      TableFirstName = `Linnea`
+	TableFirstNameLinwood     TableFirstName = `Linwood`
+	TableFirstNameLionel      TableFirstName = `Lionel`
+	TableFirstNameLisa        TableFirstName = `Lisa`
+	TableFirstNameLise        TableFirstName = `Lise`
+	TableFirstNameLizabeth    TableFirstName = `Lizabeth`
+	TableFirstNameLizzie      TableFirstName = `Lizzie`
+	TableFirstNameLloyd       TableFirstName = `Lloyd`
+	TableFirstNameLogan       TableFirstName = `Logan`
+	TableFirstNameLois        TableFirstName = `Lois`
+	TableFirstNameLola        TableFirstName = `Lola`
+	TableFirstNameLolita      TableFirstName = `Lolita`
+	TableFirstNameLon         TableFirstName = `Lon`
+	TableFirstNameLona        TableFirstName = `Lona`
+	TableFirstNameLonnie      TableFirstName = `Lonnie`
+	TableFirstNameLonny       TableFirstName = `Lonny`
+	TableFirstNameLora        TableFirstName = `Lora`
+	TableFirstNameLoraine     TableFirstName = `Loraine`
+	TableFirstNameLorelei     TableFirstName = `Lorelei`
+	TableFirstNameLoren       TableFirstName = `Loren`
+	TableFirstNameLorena      TableFirstName = `Lorena`
+	TableFirstNameLorene      TableFirstName = `Lorene`
+	TableFirstNameLorenzo     TableFirstName = `Lorenzo`
+	TableFirstNameLoretta     TableFirstName = `Loretta`
+	TableFirstNameLori        TableFirstName = `Lori`
+	TableFirstNameLorie       TableFirstName = `Lorie`
+	TableFirstNameLorin       TableFirstName = `Lorin`
+	TableFirstNameLorinda     TableFirstName = `Lorinda`
+	TableFirstNameLorna       TableFirstName = `Lorna`
+	TableFirstNameLorraine    TableFirstName = `Lorraine`
+	TableFirstNameLorri       TableFirstName = `Lorri`
+	TableFirstNameLorrie      TableFirstName = `Lorrie`
+	TableFirstNameLottie      TableFirstName = `Lottie`
+	TableFirstNameLou         TableFirstName = `Lou`
+	TableFirstNameLouann      TableFirstName = `Louann`
+	TableFirstNameLouella     TableFirstName = `Louella`
+	TableFirstNameLouie       TableFirstName = `Louie`
+	TableFirstNameLouis       TableFirstName = `Louis`
+	TableFirstNameLouisa      TableFirstName = `Louisa`
+	TableFirstNameLouise      TableFirstName = `Louise`
+	TableFirstNameLourdes     TableFirstName = `Lourdes`
+	TableFirstNameLowell      TableFirstName = `Lowell`
+	TableFirstNameLoyd        TableFirstName = `Loyd`
+	TableFirstNameLu          TableFirstName = `Lu`
+	TableFirstNameLuann       TableFirstName = `Luann`
+	TableFirstNameLuanne      TableFirstName = `Luanne`
+	TableFirstNameLucia       TableFirstName = `Lucia`
+	TableFirstNameLucille     TableFirstName = `Lucille`
+	TableFirstNameLucinda     TableFirstName = `Lucinda`
+	TableFirstNameLucius      TableFirstName = `Lucius`
+	TableFirstNameLucretia    TableFirstName = `Lucretia`
+	TableFirstNameLucy        TableFirstName = `Lucy`
+	TableFirstNameLuella      TableFirstName = `Luella`
+	TableFirstNameLuis        TableFirstName = `Luis`
+	TableFirstNameLuke        TableFirstName = `Luke`
+	TableFirstNameLula        TableFirstName = `Lula`
+	TableFirstNameLupe        TableFirstName = `Lupe`
+	TableFirstNameLuther      TableFirstName = `Luther`
+	TableFirstNameLuz         TableFirstName = `Luz`
+	TableFirstNameLydia       TableFirstName = `Lydia`
+	TableFirstNameLyle        TableFirstName = `Lyle`
+	TableFirstNameLyman       TableFirstName = `Lyman`
+	TableFirstNameLyn         TableFirstName = `Lyn`
+	TableFirstNameLynda       TableFirstName = `Lynda`
+	TableFirstNameLyndon      TableFirstName = `Lyndon`
+	TableFirstNameLynette     TableFirstName = `Lynette`
+	TableFirstNameLynn        TableFirstName = `Lynn`
+	TableFirstNameLynne       TableFirstName = `Lynne`
+	TableFirstNameLynnette    TableFirstName = `Lynnette`
+	TableFirstNameLynwood     TableFirstName = `Lynwood`
+	TableFirstNameMabel       TableFirstName = `Mabel`
+	TableFirstNameMable       TableFirstName = `Mable`
+	TableFirstNameMac         TableFirstName = `Mac`
+	TableFirstNameMack        TableFirstName = `Mack`
+	TableFirstNameMadeleine   TableFirstName = `Madeleine`
+	TableFirstNameMadeline    TableFirstName = `Madeline`
+	TableFirstNameMadelyn     TableFirstName = `Madelyn`
+	TableFirstNameMadonna     TableFirstName = `Madonna`
+	TableFirstNameMae         TableFirstName = `Mae`
+	TableFirstNameMagdalena   TableFirstName = `Magdalena`
+	TableFirstNameMaggie      TableFirstName = `Maggie`
+	TableFirstNameMajor       TableFirstName = `Major`
+	TableFirstNameMalcolm     TableFirstName = `Malcolm`
+	TableFirstNameMalinda     TableFirstName = `Malinda`
+	TableFirstNameMamie       TableFirstName = `Mamie`
+	TableFirstNameManuel      TableFirstName = `Manuel`
+	TableFirstNameMara        TableFirstName = `Mara`
+	TableFirstNameMarc        TableFirstName = `Marc`
+	TableFirstNameMarcel      TableFirstName = `Marcel`
+	TableFirstNameMarcella    TableFirstName = `Marcella`
+	TableFirstNameMarci       TableFirstName = `Marci`
+	TableFirstNameMarcia      TableFirstName = `Marcia`
+	TableFirstNameMarcie      TableFirstName = `Marcie`
+	TableFirstNameMarco       TableFirstName = `Marco`
+	TableFirstNameMarcos      TableFirstName = `Marcos`
+	TableFirstNameMarcus      TableFirstName = `Marcus`
+	TableFirstNameMarcy       TableFirstName = `Marcy`
+	TableFirstNameMargaret    TableFirstName = `Margaret`
+	TableFirstNameMargarita   TableFirstName = `Margarita`
+	TableFirstNameMargarito   TableFirstName = `Margarito`
+	TableFirstNameMargery     TableFirstName = `Margery`
+	TableFirstNameMargie      TableFirstName = `Margie`
+	TableFirstNameMargo       TableFirstName = `Margo`
+	TableFirstNameMargot      TableFirstName = `Margot`
+	TableFirstNameMargret     TableFirstName = `Margret`
+	TableFirstNameMarguerite  TableFirstName = `Marguerite`
+	TableFirstNameMari        TableFirstName = `Mari`
+	TableFirstNameMaria       TableFirstName = `Maria`
+	TableFirstNameMarian      TableFirstName = `Marian`
+	TableFirstNameMariann     TableFirstName = `Mariann`
+	TableFirstNameMarianna    TableFirstName = `Marianna`
+	TableFirstNameMarianne    TableFirstName = `Marianne`
+	TableFirstNameMaribeth    TableFirstName = `Maribeth`
+	TableFirstNameMarie       TableFirstName = `Marie`
+	TableFirstNameMarietta    TableFirstName = `Marietta`
+	TableFirstNameMarilee     TableFirstName = `Marilee`
+	TableFirstNameMarilyn     TableFirstName = `Marilyn`
+	TableFirstNameMarilynn    TableFirstName = `Marilynn`
+	TableFirstNameMarina      TableFirstName = `Marina`
+	TableFirstNameMario       TableFirstName = `Mario`
+	TableFirstNameMarion      TableFirstName = `Marion`
+	TableFirstNameMarita      TableFirstName = `Marita`
+	TableFirstNameMarjorie    TableFirstName = `Marjorie`
+	TableFirstNameMark        TableFirstName = `Mark`
+	TableFirstNameMarla       TableFirstName = `Marla`
+	TableFirstNameMarlene     TableFirstName = `Marlene`
+	TableFirstNameMarlin      TableFirstName = `Marlin`
+	TableFirstNameMarlon      TableFirstName = `Marlon`
+	TableFirstNameMarlys      TableFirstName = `Marlys`
+	TableFirstNameMarsha      TableFirstName = `Marsha`
+	TableFirstNameMarshall    TableFirstName = `Marshall`
+	TableFirstNameMarta       TableFirstName = `Marta`
+	TableFirstNameMartha      TableFirstName = `Martha`
+	TableFirstNameMartin      TableFirstName = `Martin`
+	TableFirstNameMartina     TableFirstName = `Martina`
+	TableFirstNameMarty       TableFirstName = `Marty`
+	TableFirstNameMarva       TableFirstName = `Marva`
+	TableFirstNameMarvin      TableFirstName = `Marvin`
+	TableFirstNameMary        TableFirstName = `Mary`
+	TableFirstNameMaryann     TableFirstName = `Maryann`
+	TableFirstNameMaryanne    TableFirstName = `Maryanne`
+	TableFirstNameMarybeth    TableFirstName = `Marybeth`
+	TableFirstNameMaryellen   TableFirstName = `Maryellen`
+	TableFirstNameMaryjane    TableFirstName = `Maryjane`
+	TableFirstNameMaryjo      TableFirstName = `Maryjo`
+	TableFirstNameMarylou     TableFirstName = `Marylou`
+	TableFirstNameMason       TableFirstName = `Mason`
+	TableFirstNameMathew      TableFirstName = `Mathew`
+	TableFirstNameMatilda     TableFirstName = `Matilda`
+	TableFirstNameMatt        TableFirstName = `Matt`
+	TableFirstNameMatthew     TableFirstName = `Matthew`
+	TableFirstNameMattie      TableFirstName = `Mattie`
+	TableFirstNameMaura       TableFirstName = `Maura`
+	TableFirstNameMaureen     TableFirstName = `Maureen`
+	TableFirstNameMaurice     TableFirstName = `Maurice`
+	TableFirstNameMavis       TableFirstName = `Mavis`
+	TableFirstNameMax         TableFirstName = `Max`
+	TableFirstNameMaxine      TableFirstName = `Maxine`
+	TableFirstNameMaxwell     TableFirstName = `Maxwell`
+	TableFirstNameMay         TableFirstName = `May`
+	TableFirstNameMaynard     TableFirstName = `Maynard`
+	TableFirstNameMckinley    TableFirstName = `Mckinley`
+	TableFirstNameMegan       TableFirstName = `Megan`
+	TableFirstNameMel         TableFirstName = `Mel`
+	TableFirstNameMelanie     TableFirstName = `Melanie`
+	TableFirstNameMelba       TableFirstName = `Melba`
+	TableFirstNameMelinda     TableFirstName = `Melinda`
+	TableFirstNameMelissa     TableFirstName = `Melissa`
+	TableFirstNameMelodee     TableFirstName = `Melodee`
+	TableFirstNameMelodie     TableFirstName = `Melodie`
+	TableFirstNameMelody      TableFirstName = `Melody`
+	TableFirstNameMelva       TableFirstName = `Melva`
+	TableFirstNameMelvin      TableFirstName = `Melvin`
+	TableFirstNameMercedes    TableFirstName = `Mercedes`
+	TableFirstNameMeredith    TableFirstName = `Meredith`
+	TableFirstNameMerle       TableFirstName = `Merle`
+	TableFirstNameMerlin      TableFirstName = `Merlin`
+	TableFirstNameMerri       TableFirstName = `Merri`
+	TableFirstNameMerrill     TableFirstName = `Merrill`
+	TableFirstNameMerry       TableFirstName = `Merry`
+	TableFirstNameMervin      TableFirstName = `Mervin`
+	TableFirstNameMeryl       TableFirstName = `Meryl`
+	TableFirstNameMichael     TableFirstName = `Michael`
+	TableFirstNameMichal      TableFirstName = `Michal`
+	TableFirstNameMichale     TableFirstName = `Michale`
+	TableFirstNameMicheal     TableFirstName = `Micheal`
+	TableFirstNameMichel      TableFirstName = `Michel`
+	TableFirstNameMichele     TableFirstName = `Michele`
+	TableFirstNameMichelle    TableFirstName = `Michelle`
+	TableFirstNameMickey      TableFirstName = `Mickey`
+	TableFirstNameMickie      TableFirstName = `Mickie`
+	TableFirstNameMicky       TableFirstName = `Micky`
+	TableFirstNameMigdalia    TableFirstName = `Migdalia`
+	TableFirstNameMiguel      TableFirstName = `Miguel`
+	TableFirstNameMike        TableFirstName = `Mike`
+	TableFirstNameMikel       TableFirstName = `Mikel`
+	TableFirstNameMilagros    TableFirstName = `Milagros`
+	TableFirstNameMilan       TableFirstName = `Milan`
+	TableFirstNameMildred     TableFirstName = `Mildred`
+	TableFirstNameMiles       TableFirstName = `Miles`
+	TableFirstNameMilford     TableFirstName = `Milford`
+	TableFirstNameMillard     TableFirstName = `Millard`
+	TableFirstNameMillicent   TableFirstName = `Millicent`
+	TableFirstNameMillie      TableFirstName = `Millie`
+	TableFirstNameMilo        TableFirstName = `Milo`
+	TableFirstNameMilton      TableFirstName = `Milton`
+	TableFirstNameMimi        TableFirstName = `Mimi`
+	TableFirstNameMindy       TableFirstName = `Mindy`
+	TableFirstNameMinerva     TableFirstName = `Minerva`
+	TableFirstNameMinnie      TableFirstName = `Minnie`
+	TableFirstNameMiriam      TableFirstName = `Miriam`
+	TableFirstNameMitch       TableFirstName = `Mitch`
+	TableFirstNameMitchel     TableFirstName = `Mitchel`
+	TableFirstNameMitchell    TableFirstName = `Mitchell`
+	TableFirstNameMitzi       TableFirstName = `Mitzi`
+	TableFirstNameMoira       TableFirstName = `Moira`
+	TableFirstNameMoises      TableFirstName = `Moises`
+	TableFirstNameMollie      TableFirstName = `Mollie`
+	TableFirstNameMolly       TableFirstName = `Molly`
+	TableFirstNameMona        TableFirstName = `Mona`
+	TableFirstNameMonica      TableFirstName = `Monica`
+	TableFirstNameMonique     TableFirstName = `Monique`
+	TableFirstNameMonroe      TableFirstName = `Monroe`
+	TableFirstNameMonte       TableFirstName = `Monte`
+	TableFirstNameMonty       TableFirstName = `Monty`
+	TableFirstNameMorgan      TableFirstName = `Morgan`
+	TableFirstNameMorris      TableFirstName = `Morris`
+	TableFirstNameMose        TableFirstName = `Mose`
+	TableFirstNameMoses       TableFirstName = `Moses`
+	TableFirstNameMuriel      TableFirstName = `Muriel`
+	TableFirstNameMurphy      TableFirstName = `Murphy`
+	TableFirstNameMurray      TableFirstName = `Murray`
+	TableFirstNameMyles       TableFirstName = `Myles`
+	TableFirstNameMyra        TableFirstName = `Myra`
+	TableFirstNameMyrna       TableFirstName = `Myrna`
+	TableFirstNameMyron       TableFirstName = `Myron`
+	TableFirstNameMyrtle      TableFirstName = `Myrtle`
+	TableFirstNameNadine      TableFirstName = `Nadine`
+	TableFirstNameNan         TableFirstName = `Nan`
+	TableFirstNameNanci       TableFirstName = `Nanci`
+	TableFirstNameNancy       TableFirstName = `Nancy`
+	TableFirstNameNanette     TableFirstName = `Nanette`
+	TableFirstNameNannette    TableFirstName = `Nannette`
+	TableFirstNameNaomi       TableFirstName = `Naomi`
+	TableFirstNameNapoleon    TableFirstName = `Napoleon`
+	TableFirstNameNatalie     TableFirstName = `Natalie`
+	TableFirstNameNathan      TableFirstName = `Nathan`
+	TableFirstNameNathaniel   TableFirstName = `Nathaniel`
+	TableFirstNameNeal        TableFirstName = `Neal`
+	TableFirstNameNed         TableFirstName = `Ned`
+	TableFirstNameNedra       TableFirstName = `Nedra`
+	TableFirstNameNeil        TableFirstName = `Neil`
+	TableFirstNameNelda       TableFirstName = `Nelda`
+	TableFirstNameNellie      TableFirstName = `Nellie`
+	TableFirstNameNelson      TableFirstName = `Nelson`
+	TableFirstNameNettie      TableFirstName = `Nettie`
+	TableFirstNameNeva        TableFirstName = `Neva`
+	TableFirstNameNewton      TableFirstName = `Newton`
+	TableFirstNameNicholas    TableFirstName = `Nicholas`
+	TableFirstNameNick        TableFirstName = `Nick`
+	TableFirstNameNicki       TableFirstName = `Nicki`
+	TableFirstNameNickolas    TableFirstName = `Nickolas`
+	TableFirstNameNicky       TableFirstName = `Nicky`
+	TableFirstNameNicolas     TableFirstName = `Nicolas`
+	TableFirstNameNicole      TableFirstName = `Nicole`
+	TableFirstNameNikki       TableFirstName = `Nikki`
+	TableFirstNameNina        TableFirstName = `Nina`
+	TableFirstNameNita        TableFirstName = `Nita`
+	TableFirstNameNoah        TableFirstName = `Noah`
+	TableFirstNameNoe         TableFirstName = `Noe`
+	TableFirstNameNoel        TableFirstName = `Noel`
+	TableFirstNameNoemi       TableFirstName = `Noemi`
+	TableFirstNameNola        TableFirstName = `Nola`
+	TableFirstNameNolan       TableFirstName = `Nolan`
+	TableFirstNameNona        TableFirstName = `Nona`
+	TableFirstNameNora        TableFirstName = `Nora`
+	TableFirstNameNorbert     TableFirstName = `Norbert`
+	TableFirstNameNoreen      TableFirstName = `Noreen`
+	TableFirstNameNorma       TableFirstName = `Norma`
+	TableFirstNameNorman      TableFirstName = `Norman`
+	TableFirstNameNormand     TableFirstName = `Normand`
+	TableFirstNameNorris      TableFirstName = `Norris`
+	TableFirstNameOdell       TableFirstName = `Odell`
+	TableFirstNameOdessa      TableFirstName = `Odessa`
+	TableFirstNameOdis        TableFirstName = `Odis`
+	TableFirstNameOfelia      TableFirstName = `Ofelia`
+	TableFirstNameOla         TableFirstName = `Ola`
+	TableFirstNameOlen        TableFirstName = `Olen`
+	TableFirstNameOlga        TableFirstName = `Olga`
+	TableFirstNameOlin        TableFirstName = `Olin`
+	TableFirstNameOliver      TableFirstName = `Oliver`
+	TableFirstNameOlivia      TableFirstName = `Olivia`
+	TableFirstNameOllie       TableFirstName = `Ollie`
+	TableFirstNameOmar        TableFirstName = `Omar`
+	TableFirstNameOpal        TableFirstName = `Opal`
+	TableFirstNameOphelia     TableFirstName = `Ophelia`
+	TableFirstNameOra         TableFirstName = `Ora`
+	TableFirstNameOralia      TableFirstName = `Oralia`
+	TableFirstNameOrlando     TableFirstName = `Orlando`
+	TableFirstNameOrval       TableFirstName = `Orval`
+	TableFirstNameOrville     TableFirstName = `Orville`
+	TableFirstNameOscar       TableFirstName = `Oscar`
+	TableFirstNameOtha        TableFirstName = `Otha`
+	TableFirstNameOtis        TableFirstName = `Otis`
+	TableFirstNameOtto        TableFirstName = `Otto`
+	TableFirstNameOwen        TableFirstName = `Owen`
+	TableFirstNamePablo       TableFirstName = `Pablo`
+	TableFirstNamePaige       TableFirstName = `Paige`
+	TableFirstNamePam         TableFirstName = `Pam`
+	TableFirstNamePamala      TableFirstName = `Pamala`
+	TableFirstNamePamela      TableFirstName = `Pamela`
+	TableFirstNamePamella     TableFirstName = `Pamella`
+	TableFirstNamePasquale    TableFirstName = `Pasquale`
+	TableFirstNamePat         TableFirstName = `Pat`
+	TableFirstNamePatrica     TableFirstName = `Patrica`
+	TableFirstNamePatrice     TableFirstName = `Patrice`
+	TableFirstNamePatricia    TableFirstName = `Patricia`
+	TableFirstNamePatrick     TableFirstName = `Patrick`
+	TableFirstNamePatsy       TableFirstName = `Patsy`
+	TableFirstNamePatti       TableFirstName = `Patti`
+	TableFirstNamePattie      TableFirstName = `Pattie`
+	TableFirstNamePatty       TableFirstName = `Patty`
+	TableFirstNamePaul        TableFirstName = `Paul`
+	TableFirstNamePaula       TableFirstName = `Paula`
+	TableFirstNamePaulette    TableFirstName = `Paulette`
+	TableFirstNamePauline     TableFirstName = `Pauline`
+	TableFirstNamePearl       TableFirstName = `Pearl`
+	TableFirstNamePearlie     TableFirstName = `Pearlie`
+	TableFirstNamePedro       TableFirstName = `Pedro`
+	TableFirstNamePeggie      TableFirstName = `Peggie`
+	TableFirstNamePeggy       TableFirstName = `Peggy`
+	TableFirstNamePenelope    TableFirstName = `Penelope`
+	TableFirstNamePennie      TableFirstName = `Pennie`
+	TableFirstNamePenny       TableFirstName = `Penny`
+	TableFirstNamePercy       TableFirstName = `Percy`
+	TableFirstNamePerry       TableFirstName = `Perry`
+	TableFirstNamePete        TableFirstName = `Pete`
+	TableFirstNamePeter       TableFirstName = `Peter`
+	TableFirstNamePhil        TableFirstName = `Phil`
+	TableFirstNamePhilip      TableFirstName = `Philip`
+	TableFirstNamePhoebe      TableFirstName = `Phoebe`
+	TableFirstNamePhyllis     TableFirstName = `Phyllis`
+	TableFirstNamePierre      TableFirstName = `Pierre`
+	TableFirstNamePolly       TableFirstName = `Polly`
+	TableFirstNamePorter      TableFirstName = `Porter`
+	TableFirstNamePortia      TableFirstName = `Portia`
+	TableFirstNamePreston     TableFirstName = `Preston`
+	TableFirstNamePrince      TableFirstName = `Prince`
+	TableFirstNamePriscilla   TableFirstName = `Priscilla`
+	TableFirstNameQueen       TableFirstName = `Queen`
+	TableFirstNameQuentin     TableFirstName = `Quentin`
+	TableFirstNameQuincy      TableFirstName = `Quincy`
+	TableFirstNameQuinton     TableFirstName = `Quinton`
+	TableFirstNameRachael     TableFirstName = `Rachael`
+	TableFirstNameRachel      TableFirstName = `Rachel`
+	TableFirstNameRachelle    TableFirstName = `Rachelle`
+	TableFirstNameRae         TableFirstName = `Rae`
+	TableFirstNameRafael      TableFirstName = `Rafael`
+	TableFirstNameRaleigh     TableFirstName = `Raleigh`
+	TableFirstNameRalph       TableFirstName = `Ralph`
+	TableFirstNameRamiro      TableFirstName = `Ramiro`
+	TableFirstNameRamon       TableFirstName = `Ramon`
+	TableFirstNameRamona      TableFirstName = `Ramona`
+	TableFirstNameRand        TableFirstName = `Rand`
+	TableFirstNameRandal      TableFirstName = `Randal`
+	TableFirstNameRandall     TableFirstName = `Randall`
+	TableFirstNameRandel      TableFirstName = `Randel`
+	TableFirstNameRandi       TableFirstName = `Randi`
+	TableFirstNameRandle      TableFirstName = `Randle`
+	TableFirstNameRandolf     TableFirstName = `Randolf`
+	TableFirstNameRandolph    TableFirstName = `Randolph`
+	TableFirstNameRandy       TableFirstName = `Randy`
+	TableFirstNameRaphael     TableFirstName = `Raphael`
+	TableFirstNameRaquel      TableFirstName = `Raquel`
+	TableFirstNameRaul        TableFirstName = `Raul`
+	TableFirstNameRay         TableFirstName = `Ray`
+	TableFirstNameRayford     TableFirstName = `Rayford`
+	TableFirstNameRaymon      TableFirstName = `Raymon`
+	TableFirstNameRaymond     TableFirstName = `Raymond`
+	TableFirstNameRaymundo    TableFirstName = `Raymundo`
+	TableFirstNameReba        TableFirstName = `Reba`
+	TableFirstNameRebecca     TableFirstName = `Rebecca`
+	TableFirstNameRebekah     TableFirstName = `Rebekah`
+	TableFirstNameReed        TableFirstName = `Reed`
+	TableFirstNameRegenia     TableFirstName = `Regenia`
+	TableFirstNameReggie      TableFirstName = `Reggie`
+	TableFirstNameRegina      TableFirstName = `Regina`
+	TableFirstNameReginald    TableFirstName = `Reginald`
+	TableFirstNameRegis       TableFirstName = `Regis`
+	TableFirstNameReid        TableFirstName = `Reid`
+	TableFirstNameRena        TableFirstName = `Rena`
+	TableFirstNameRenae       TableFirstName = `Renae`
+	TableFirstNameRene        TableFirstName = `Rene`
+	TableFirstNameRenee       TableFirstName = `Renee`
+	TableFirstNameRenita      TableFirstName = `Renita`
+	TableFirstNameReta        TableFirstName = `Reta`
+	TableFirstNameRetha       TableFirstName = `Retha`
+	TableFirstNameReuben      TableFirstName = `Reuben`
+	TableFirstNameReva        TableFirstName = `Reva`
+	TableFirstNameRex         TableFirstName = `Rex`
+	TableFirstNameReynaldo    TableFirstName = `Reynaldo`
+	TableFirstNameReynold     TableFirstName = `Reynold`
+	TableFirstNameRhea        TableFirstName = `Rhea`
+	TableFirstNameRhett       TableFirstName = `Rhett`
+	TableFirstNameRhoda       TableFirstName = `Rhoda`
+	TableFirstNameRhonda      TableFirstName = `Rhonda`
+	TableFirstNameRicardo     TableFirstName = `Ricardo`
+	TableFirstNameRichard     TableFirstName = `Richard`
+	TableFirstNameRick        TableFirstName = `Rick`
+	TableFirstNameRickey      TableFirstName = `Rickey`
+	TableFirstNameRicki       TableFirstName = `Ricki`
+	TableFirstNameRickie      TableFirstName = `Rickie`
+	TableFirstNameRicky       TableFirstName = `Ricky`
+	TableFirstNameRiley       TableFirstName = `Riley`
+	TableFirstNameRita        TableFirstName = `Rita`
+	TableFirstNameRitchie     TableFirstName = `Ritchie`
+	TableFirstNameRob         TableFirstName = `Rob`
+	TableFirstNameRobbie      TableFirstName = `Robbie`
+	TableFirstNameRobbin      TableFirstName = `Robbin`
+	TableFirstNameRobby       TableFirstName = `Robby`
+	TableFirstNameRobert      TableFirstName = `Robert`
+	TableFirstNameRoberta     TableFirstName = `Roberta`
+	TableFirstNameRoberto     TableFirstName = `Roberto`
+	TableFirstNameRobin       TableFirstName = `Robin`
+	TableFirstNameRobyn       TableFirstName = `Robyn`
+	TableFirstNameRocco       TableFirstName = `Rocco`
+	TableFirstNameRochelle    TableFirstName = `Rochelle`
+	TableFirstNameRock        TableFirstName = `Rock`
+	TableFirstNameRocky       TableFirstName = `Rocky`
+	TableFirstNameRod         TableFirstName = `Rod`
+	TableFirstNameRoderick    TableFirstName = `Roderick`
+	TableFirstNameRodger      TableFirstName = `Rodger`
+	TableFirstNameRodney      TableFirstName = `Rodney`
+	TableFirstNameRodolfo     TableFirstName = `Rodolfo`
+	TableFirstNameRodrick     TableFirstName = `Rodrick`
+	TableFirstNameRogelio     TableFirstName = `Rogelio`
+	TableFirstNameRoger       TableFirstName = `Roger`
+	TableFirstNameRogers      TableFirstName = `Rogers`
+	TableFirstNameRoland      TableFirstName = `Roland`
+	TableFirstNameRolando     TableFirstName = `Rolando`
+	TableFirstNameRolf        TableFirstName = `Rolf`
+	TableFirstNameRolland     TableFirstName = `Rolland`
+	TableFirstNameRoman       TableFirstName = `Roman`
+	TableFirstNameRomona      TableFirstName = `Romona`
+	TableFirstNameRon         TableFirstName = `Ron`
+	TableFirstNameRona        TableFirstName = `Rona`
+	TableFirstNameRonald      TableFirstName = `Ronald`
+	TableFirstNameRonda       TableFirstName = `Ronda`
+	TableFirstNameRoni        TableFirstName = `Roni`
+	TableFirstNameRonna       TableFirstName = `Ronna`
+	TableFirstNameRonnie      TableFirstName = `Ronnie`
+	TableFirstNameRonny       TableFirstName = `Ronny`
+	TableFirstNameRoosevelt   TableFirstName = `Roosevelt`
+	TableFirstNameRory        TableFirstName = `Rory`
+	TableFirstNameRosa        TableFirstName = `Rosa`
+	TableFirstNameRosalie     TableFirstName = `Rosalie`
+	TableFirstNameRosalind    TableFirstName = `Rosalind`
+	TableFirstNameRosalinda   TableFirstName = `Rosalinda`
+	TableFirstNameRosalyn     TableFirstName = `Rosalyn`
+	TableFirstNameRosanna     TableFirstName = `Rosanna`
+	TableFirstNameRosanne     TableFirstName = `Rosanne`
+	TableFirstNameRosario     TableFirstName = `Rosario`
+	TableFirstNameRoscoe      TableFirstName = `Roscoe`
+	TableFirstNameRose        TableFirstName = `Rose`
+	TableFirstNameRoseann     TableFirstName = `Roseann`
+	TableFirstNameRoseanne    TableFirstName = `Roseanne`
+	TableFirstNameRosemarie   TableFirstName = `Rosemarie`
+	TableFirstNameRosemary    TableFirstName = `Rosemary`
+	TableFirstNameRosendo     TableFirstName = `Rosendo`
+	TableFirstNameRosetta     TableFirstName = `Rosetta`
+	TableFirstNameRosie       TableFirstName = `Rosie`
+	TableFirstNameRosita      TableFirstName = `Rosita`
+	TableFirstNameRoslyn      TableFirstName = `Roslyn`
+	TableFirstNameRoss        TableFirstName = `Ross`
+	TableFirstNameRowena      TableFirstName = `Rowena`
+	TableFirstNameRowland     TableFirstName = `Rowland`
+	TableFirstNameRoxane      TableFirstName = `Roxane`
+	TableFirstNameRoxann      TableFirstName = `Roxann`
+	TableFirstNameRoxanna     TableFirstName = `Roxanna`
+	TableFirstNameRoxanne     TableFirstName = `Roxanne`
+	TableFirstNameRoxie       TableFirstName = `Roxie`
+	TableFirstNameRoy         TableFirstName = `Roy`
+	TableFirstNameRoyal       TableFirstName = `Royal`
+	TableFirstNameRoyce       TableFirstName = `Royce`
+	TableFirstNameRuben       TableFirstName = `Ruben`
+	TableFirstNameRubin       TableFirstName = `Rubin`
+	TableFirstNameRuby        TableFirstName = `Ruby`
+	TableFirstNameRudolfo     TableFirstName = `Rudolfo`
+	TableFirstNameRudolph     TableFirstName = `Rudolph`
+	TableFirstNameRudy        TableFirstName = `Rudy`
+	TableFirstNameRufus       TableFirstName = `Rufus`
+	TableFirstNameRuss        TableFirstName = `Russ`
+	TableFirstNameRussel      TableFirstName = `Russel`
+	TableFirstNameRussell     TableFirstName = `Russell`
+	TableFirstNameRusty       TableFirstName = `Rusty`
+	TableFirstNameRuth        TableFirstName = `Ruth`
+	TableFirstNameRuthie      TableFirstName = `Ruthie`
+	TableFirstNameRyan        TableFirstName = `Ryan`
+	TableFirstNameSabrina     TableFirstName = `Sabrina`
+	TableFirstNameSadie       TableFirstName = `Sadie`
+	TableFirstNameSallie      TableFirstName = `Sallie`
+	TableFirstNameSally       TableFirstName = `Sally`
+	TableFirstNameSalvador    TableFirstName = `Salvador`
+	TableFirstNameSalvatore   TableFirstName = `Salvatore`
+	TableFirstNameSam         TableFirstName = `Sam`
+	TableFirstNameSammie      TableFirstName = `Sammie`
+	TableFirstNameSammy       TableFirstName = `Sammy`
+	TableFirstNameSamuel      TableFirstName = `Samuel`
+	TableFirstNameSandi       TableFirstName = `Sandi`
+	TableFirstNameSandra      TableFirstName = `Sandra`
+	TableFirstNameSandy       TableFirstName = `Sandy`
+	TableFirstNameSanford     TableFirstName = `Sanford`
+	TableFirstNameSantiago    TableFirstName = `Santiago`
+	TableFirstNameSantos      TableFirstName = `Santos`
+	TableFirstNameSara        TableFirstName = `Sara`
+	TableFirstNameSarah       TableFirstName = `Sarah`
+	TableFirstNameSaul        TableFirstName = `Saul`
+	TableFirstNameSaundra     TableFirstName = `Saundra`
+	TableFirstNameScot        TableFirstName = `Scot`
+	TableFirstNameScott       TableFirstName = `Scott`
+	TableFirstNameScottie     TableFirstName = `Scottie`
+	TableFirstNameScotty      TableFirstName = `Scotty`
+	TableFirstNameSean        TableFirstName = `Sean`
+	TableFirstNameSelma       TableFirstName = `Selma`
+	TableFirstNameSerena      TableFirstName = `Serena`
+	TableFirstNameSergio      TableFirstName = `Sergio`
+	TableFirstNameSeth        TableFirstName = `Seth`
+	TableFirstNameShane       TableFirstName = `Shane`
+	TableFirstNameShannon     TableFirstName = `Shannon`
+	TableFirstNameSharen      TableFirstName = `Sharen`
+	TableFirstNameShari       TableFirstName = `Shari`
+	TableFirstNameSharlene    TableFirstName = `Sharlene`
+	TableFirstNameSharon      TableFirstName = `Sharon`
+	TableFirstNameSharron     TableFirstName = `Sharron`
+	TableFirstNameShaun       TableFirstName = `Shaun`
+	TableFirstNameShauna      TableFirstName = `Shauna`
+	TableFirstNameShawn       TableFirstName = `Shawn`
+	TableFirstNameSheila      TableFirstName = `Sheila`
+	TableFirstNameSheilah     TableFirstName = `Sheilah`
+	TableFirstNameShelby      TableFirstName = `Shelby`
+	TableFirstNameSheldon     TableFirstName = `Sheldon`
+	TableFirstNameShelia      TableFirstName = `Shelia`
+	TableFirstNameShelley     TableFirstName = `Shelley`
+	TableFirstNameShelly      TableFirstName = `Shelly`
+	TableFirstNameShelton     TableFirstName = `Shelton`
+	TableFirstNameSheree      TableFirstName = `Sheree`
+	TableFirstNameSheri       TableFirstName = `Sheri`
+	TableFirstNameSherie      TableFirstName = `Sherie`
+	TableFirstNameSherman     TableFirstName = `Sherman`
+	TableFirstNameSheron      TableFirstName = `Sheron`
+	TableFirstNameSherree     TableFirstName = `Sherree`
+	TableFirstNameSherri      TableFirstName = `Sherri`
+	TableFirstNameSherrie     TableFirstName = `Sherrie`
+	TableFirstNameSherrill    TableFirstName = `Sherrill`
+	TableFirstNameSherry      TableFirstName = `Sherry`
+	TableFirstNameSherryl     TableFirstName = `Sherryl`
+	TableFirstNameSherwood    TableFirstName = `Sherwood`
+	TableFirstNameSheryl      TableFirstName = `Sheryl`
+	TableFirstNameSheryll     TableFirstName = `Sheryll`
+	TableFirstNameShirlene    TableFirstName = `Shirlene`
+	TableFirstNameShirley     TableFirstName = `Shirley`
+	TableFirstNameSidney      TableFirstName = `Sidney`
+	TableFirstNameSilas       TableFirstName = `Silas`
+	TableFirstNameSilvia      TableFirstName = `Silvia`
+	TableFirstNameSimon       TableFirstName = `Simon`
+	TableFirstNameSkip        TableFirstName = `Skip`
+	TableFirstNameSolomon     TableFirstName = `Solomon`
+	TableFirstNameSondra      TableFirstName = `Sondra`
+	TableFirstNameSonia       TableFirstName = `Sonia`
+	TableFirstNameSonja       TableFirstName = `Sonja`
+	TableFirstNameSonny       TableFirstName = `Sonny`
+	TableFirstNameSonya       TableFirstName = `Sonya`
+	TableFirstNameSophia      TableFirstName = `Sophia`
+	TableFirstNameSophie      TableFirstName = `Sophie`
+	TableFirstNameSpencer     TableFirstName = `Spencer`
+	TableFirstNameStacey      TableFirstName = `Stacey`
+	TableFirstNameStacy       TableFirstName = `Stacy`
+	TableFirstNameStan        TableFirstName = `Stan`
+	TableFirstNameStanford    TableFirstName = `Stanford`
+	TableFirstNameStanley     TableFirstName = `Stanley`
+	TableFirstNameStanton     TableFirstName = `Stanton`
+	TableFirstNameStarla      TableFirstName = `Starla`
+	TableFirstNameStella      TableFirstName = `Stella`
+	TableFirstNameStephan     TableFirstName = `Stephan`
+	TableFirstNameStephanie   TableFirstName = `Stephanie`
+	TableFirstNameStephen     TableFirstName = `Stephen`
+	TableFirstNameSterling    TableFirstName = `Sterling`
+	TableFirstNameStevan      TableFirstName = `Stevan`
+	TableFirstNameSteve       TableFirstName = `Steve`
+	TableFirstNameSteven      TableFirstName = `Steven`
+	TableFirstNameStevie      TableFirstName = `Stevie`
+	TableFirstNameStewart     TableFirstName = `Stewart`
+	TableFirstNameStuart      TableFirstName = `Stuart`
+	TableFirstNameSue         TableFirstName = `Sue`
+	TableFirstNameSuellen     TableFirstName = `Suellen`
+	TableFirstNameSusan       TableFirstName = `Susan`
+	TableFirstNameSusana      TableFirstName = `Susana`
+	TableFirstNameSusanna     TableFirstName = `Susanna`
+	TableFirstNameSusanne     TableFirstName = `Susanne`
+	TableFirstNameSusie       TableFirstName = `Susie`
+	TableFirstNameSuzan       TableFirstName = `Suzan`
+	TableFirstNameSuzann      TableFirstName = `Suzann`
+	TableFirstNameSuzanne     TableFirstName = `Suzanne`
+	TableFirstNameSuzette     TableFirstName = `Suzette`
+	TableFirstNameSybil       TableFirstName = `Sybil`
+	TableFirstNameSydney      TableFirstName = `Sydney`
+	TableFirstNameSylvester   TableFirstName = `Sylvester`
+	TableFirstNameSylvia      TableFirstName = `Sylvia`
+	TableFirstNameTad         TableFirstName = `Tad`
+	TableFirstNameTalmadge    TableFirstName = `Talmadge`
+	TableFirstNameTamara      TableFirstName = `Tamara`
+	TableFirstNameTami        TableFirstName = `Tami`
+	TableFirstNameTammy       TableFirstName = `Tammy`
+	TableFirstNameTana        TableFirstName = `Tana`
+	TableFirstNameTanya       TableFirstName = `Tanya`
+	TableFirstNameTara        TableFirstName = `Tara`
+	TableFirstNameTaryn       TableFirstName = `Taryn`
+	TableFirstNameTaylor      TableFirstName = `Taylor`
+	TableFirstNameTed         TableFirstName = `Ted`
+	TableFirstNameTeddy       TableFirstName = `Teddy`
+	TableFirstNameTeena       TableFirstName = `Teena`
+	TableFirstNameTena        TableFirstName = `Tena`
+	TableFirstNameTerence     TableFirstName = `Terence`
+	TableFirstNameTeresa      TableFirstName = `Teresa`
+	TableFirstNameTerese      TableFirstName = `Terese`
+	TableFirstNameTeressa     TableFirstName = `Teressa`
+	TableFirstNameTeri        TableFirstName = `Teri`
+	TableFirstNameTerrance    TableFirstName = `Terrance`
+	TableFirstNameTerrell     TableFirstName = `Terrell`
+	TableFirstNameTerrence    TableFirstName = `Terrence`
+	TableFirstNameTerri       TableFirstName = `Terri`
+	TableFirstNameTerrie      TableFirstName = `Terrie`
+	TableFirstNameTerry       TableFirstName = `Terry`
+	TableFirstNameThad        TableFirstName = `Thad`
+	TableFirstNameThaddeus    TableFirstName = `Thaddeus`
+	TableFirstNameThea        TableFirstName = `Thea`
+	TableFirstNameTheadore    TableFirstName = `Theadore`
+	TableFirstNameThelma      TableFirstName = `Thelma`
+	TableFirstNameTheodis     TableFirstName = `Theodis`
+	TableFirstNameTheodore    TableFirstName = `Theodore`
+	TableFirstNameTheresa     TableFirstName = `Theresa`
+	TableFirstNameTherese     TableFirstName = `Therese`
+	TableFirstNameTheron      TableFirstName = `Theron`
+	TableFirstNameThomas      TableFirstName = `Thomas`
+	TableFirstNameThurman     TableFirstName = `Thurman`
+	TableFirstNameTim         TableFirstName = `Tim`
+	TableFirstNameTimmothy    TableFirstName = `Timmothy`
+	TableFirstNameTimmy       TableFirstName = `Timmy`
+	TableFirstNameTimothy     TableFirstName = `Timothy`
+	TableFirstNameTina        TableFirstName = `Tina`
+	TableFirstNameToby        TableFirstName = `Toby`
+	TableFirstNameTod         TableFirstName = `Tod`
+	TableFirstNameTodd        TableFirstName = `Todd`
+	TableFirstNameTom         TableFirstName = `Tom`
+	TableFirstNameTomas       TableFirstName = `Tomas`
+	TableFirstNameTommie      TableFirstName = `Tommie`
+	TableFirstNameTommy       TableFirstName = `Tommy`
+	TableFirstNameToney       TableFirstName = `Toney`
+	TableFirstNameToni        TableFirstName = `Toni`
+	TableFirstNameTonia       TableFirstName = `Tonia`
+	TableFirstNameTony        TableFirstName = `Tony`
+	TableFirstNameTonya       TableFirstName = `Tonya`
+	TableFirstNameTracey      TableFirstName = `Tracey`
+	TableFirstNameTracy       TableFirstName = `Tracy`
+	TableFirstNameTravis      TableFirstName = `Travis`
+	TableFirstNameTrena       TableFirstName = `Trena`
+	TableFirstNameTrent       TableFirstName = `Trent`
+	TableFirstNameTreva       TableFirstName = `Treva`
+	TableFirstNameTricia      TableFirstName = `Tricia`
+	TableFirstNameTrina       TableFirstName = `Trina`
+	TableFirstNameTroy        TableFirstName = `Troy`
+	TableFirstNameTrudy       TableFirstName = `Trudy`
+	TableFirstNameTruman      TableFirstName = `Truman`
+	TableFirstNameTwila       TableFirstName = `Twila`
+	TableFirstNameTwyla       TableFirstName = `Twyla`
+	TableFirstNameTy          TableFirstName = `Ty`
+	TableFirstNameTyler       TableFirstName = `Tyler`
+	TableFirstNameTyrone      TableFirstName = `Tyrone`
+	TableFirstNameUlysses     TableFirstName = `Ulysses`
+	TableFirstNameUrsula      TableFirstName = `Ursula`
+	TableFirstNameVal         TableFirstName = `Val`
+	TableFirstNameValarie     TableFirstName = `Valarie`
+	TableFirstNameValentine   TableFirstName = `Valentine`
+	TableFirstNameValeria     TableFirstName = `Valeria`
+	TableFirstNameValerie     TableFirstName = `Valerie`
+	TableFirstNameValorie     TableFirstName = `Valorie`
+	TableFirstNameVan         TableFirstName = `Van`
+	TableFirstNameVance       TableFirstName = `Vance`
+	TableFirstNameVanessa     TableFirstName = `Vanessa`
+	TableFirstNameVaughn      TableFirstName = `Vaughn`
+	TableFirstNameVelda       TableFirstName = `Velda`
+	TableFirstNameVelma       TableFirstName = `Velma`
+	TableFirstNameVenita      TableFirstName = `Venita`
+	TableFirstNameVera        TableFirstName = `Vera`
+	TableFirstNameVern        TableFirstName = `Vern`
+	TableFirstNameVerna       TableFirstName = `Verna`
+	TableFirstNameVerne       TableFirstName = `Verne`
+	TableFirstNameVernell     TableFirstName = `Vernell`
+	TableFirstNameVernita     TableFirstName = `Vernita`
+	TableFirstNameVernon      TableFirstName = `Vernon`
+	TableFirstNameVeronica    TableFirstName = `Veronica`
+	TableFirstNameVicente     TableFirstName = `Vicente`
+	TableFirstNameVickey      TableFirstName = `Vickey`
+	TableFirstNameVicki       TableFirstName = `Vicki`
+	TableFirstNameVickie      TableFirstName = `Vickie`
+	TableFirstNameVicky       TableFirstName = `Vicky`
+	TableFirstNameVictor      TableFirstName = `Victor`
+	TableFirstNameVictoria    TableFirstName = `Victoria`
+	TableFirstNameVikki       TableFirstName = `Vikki`
+	TableFirstNameVince       TableFirstName = `Vince`
+	TableFirstNameVincent     TableFirstName = `Vincent`
+	TableFirstNameViola       TableFirstName = `Viola`
+	TableFirstNameViolet      TableFirstName = `Violet`
+	TableFirstNameVirgie      TableFirstName = `Virgie`
+	TableFirstNameVirgil      TableFirstName = `Virgil`
+	TableFirstNameVirginia    TableFirstName = `Virginia`
+	TableFirstNameVito        TableFirstName = `Vito`
+	TableFirstNameVivian      TableFirstName = `Vivian`
+	TableFirstNameVon         TableFirstName = `Von`
+	TableFirstNameVonda       TableFirstName = `Vonda`
+	TableFirstNameWade        TableFirstName = `Wade`
+	TableFirstNameWalker      TableFirstName = `Walker`
+	TableFirstNameWallace     TableFirstName = `Wallace`
+	TableFirstNameWally       TableFirstName = `Wally`
+	TableFirstNameWalter      TableFirstName = `Walter`
+	TableFirstNameWanda       TableFirstName = `Wanda`
+	TableFirstNameWard        TableFirstName = `Ward`
+	TableFirstNameWardell     TableFirstName = `Wardell`
+	TableFirstNameWarner      TableFirstName = `Warner`
+	TableFirstNameWarren      TableFirstName = `Warren`
+	TableFirstNameWaymon      TableFirstName = `Waymon`
+	TableFirstNameWayne       TableFirstName = `Wayne`
+	TableFirstNameWeldon      TableFirstName = `Weldon`
+	TableFirstNameWendell     TableFirstName = `Wendell`
+	TableFirstNameWendy       TableFirstName = `Wendy`
+	TableFirstNameWesley      TableFirstName = `Wesley`
+	TableFirstNameWilbert     TableFirstName = `Wilbert`
+	TableFirstNameWilbur      TableFirstName = `Wilbur`
+	TableFirstNameWilburn     TableFirstName = `Wilburn`
+	TableFirstNameWiley       TableFirstName = `Wiley`
+	TableFirstNameWilford     TableFirstName = `Wilford`
+	TableFirstNameWilfred     TableFirstName = `Wilfred`
+	TableFirstNameWilfredo    TableFirstName = `Wilfredo`
+	TableFirstNameWill        TableFirstName = `Will`
+	TableFirstNameWilla       TableFirstName = `Willa`
+	TableFirstNameWillard     TableFirstName = `Willard`
+	TableFirstNameWilliam     TableFirstName = `William`
+	TableFirstNameWilliams    TableFirstName = `Williams`
+	TableFirstNameWillie      TableFirstName = `Willie`
+	TableFirstNameWillis      TableFirstName = `Willis`
+	TableFirstNameWilma       TableFirstName = `Wilma`
+	TableFirstNameWilmer      TableFirstName = `Wilmer`
+	TableFirstNameWilson      TableFirstName = `Wilson`
+	TableFirstNameWilton      TableFirstName = `Wilton`
+	TableFirstNameWinford     TableFirstName = `Winford`
+	TableFirstNameWinfred     TableFirstName = `Winfred`
+	TableFirstNameWinifred    TableFirstName = `Winifred`
+	TableFirstNameWinona      TableFirstName = `Winona`
+	TableFirstNameWinston     TableFirstName = `Winston`
+	TableFirstNameWoodrow     TableFirstName = `Woodrow`
+	TableFirstNameWoody       TableFirstName = `Woody`
+	TableFirstNameWyatt       TableFirstName = `Wyatt`
+	TableFirstNameXavier      TableFirstName = `Xavier`
+	TableFirstNameYolanda     TableFirstName = `Yolanda`
+	TableFirstNameYvette      TableFirstName = `Yvette`
+	TableFirstNameYvonne      TableFirstName = `Yvonne`
+	TableFirstNameZachary     TableFirstName = `Zachary`
+	TableFirstNameZane        TableFirstName = `Zane`
+	TableFirstNameZelda       TableFirstName = `Zelda`
+	TableFirstNameZelma       TableFirstName = `Zelma`
+	TableFirstNameZoe         TableFirstName = `Zoe`
+)
+
+// Description of the value, or empty if the value is not in table FirstName.
+func (v TableFirstName) Description() string {
+	return tableDescription(`FirstName`, string(v))
+}
+
+// Valid reports if the value is in table FirstName.
+func (v TableFirstName) Valid() bool {
+	return TableValueLookup[`FirstName`][string(v)]
+}
+
+// Table FirstName.
+func (v TableFirstName) Table() Table {
+	return TableLookup[`FirstName`]
+}
+
+// TableState is a value of table State, State.
+// Fields in this table are strings; convert a field, such as TableState(v), to use the helpers.
+type TableState string
+
+const (
+	TableStateAlabama       TableState = `Alabama`
+	TableStateAlaska        TableState = `Alaska`
+	TableStateArizona       TableState = `Arizona`
+	TableStateArkansas      TableState = `Arkansas`
+	TableStateCalifornia    TableState = `California`
+	TableStateColorado      TableState = `Colorado`
+	TableStateConnecticut   TableState = `Connecticut`
+	TableStateDelaware      TableState = `Delaware`
+	TableStateFlorida       TableState = `Florida`
+	TableStateGeorgia       TableState = `Georgia`
+	TableStateHawaii        TableState = `Hawaii`
+	TableStateIdaho         TableState = `Idaho`
+	TableStateIllinois      TableState = `Illinois`
+	TableStateIndiana       TableState = `Indiana`
+	TableStateIowa          TableState = `Iowa`
+	TableStateKansas        TableState = `Kansas`
+	TableStateKentucky      TableState = `Kentucky`
+	TableStateLouisiana     TableState = `Louisiana`
+	TableStateMaine         TableState = `Maine`
+	TableStateMaryland      TableState = `Maryland`
+	TableStateMassachusetts TableState = `Massachusetts`
+	TableStateMichigan      TableState = `Michigan`
+	TableStateMinnesota     TableState = `Minnesota`
+	TableStateMississippi   TableState = `Mississippi`
+	TableStateMissouri      TableState = `Missouri`
+	TableStateMontana       TableState = `Montana`
+	TableStateNebraska      TableState = `Nebraska`
+	TableStateNevada        TableState = `Nevada`
+	TableStateNewHampshire  TableState = `New Hampshire`
+	TableStateNewJersey     TableState = `New Jersey`
+	TableStateNewMexico     TableState = `New Mexico`
+	TableStateNewYork       TableState = `New York  `
+	TableStateNorthCarolina TableState = `North Carolina`
+	TableStateNorthDakota   TableState = `North Dakota`
+	TableStateOhio          TableState = `Ohio`
+	TableStateOklahoma      TableState = `Oklahoma`
+	TableStateOregon        TableState = `Oregon`
+	TableStatePennsylvania  TableState = `Pennsylvania`
+	TableStateRhodeIsland   TableState = `Rhode Island`
+	TableStateSouthCarolina TableState = `South Carolina`
+	TableStateSouthDakota   TableState = `South Dakota`
+	TableStateTennessee     TableState = `Tennessee`
+	TableStateTexas         TableState = `Texas`
+	TableStateUtah          TableState = `Utah`
+	TableStateVermont       TableState = `Vermont`
+	TableStateVirginia      TableState = `Virginia`
+	TableStateWashington    TableState = `Washington`
+	TableStateWestVirginia  TableState = `West Virginia`
+	TableStateWisconsin     TableState = `Wisconsin`
+	TableStateWyoming       TableState = `Wyoming`
+)
+
+// Description of the value, or empty if the value is not in table State.
+func (v TableState) Description() string {
+	return tableDescription(`State`, string(v))
+}
+
+// Valid reports if the value is in table State.
+func (v TableState) Valid() bool {
+	return TableValueLookup[`State`][string(v)]
+}
+
+// Table State.
+func (v TableState) Table() Table {
+	return TableLookup[`State`]
+}
+
+// TableStreet is a value of table Street, Street.
+// Fields in this table are strings; convert a field, such as TableStreet(v), to use the helpers.
+type TableStreet string
+
+const (
+	TableStreet11thSt                       TableStreet = `11th St`
+	TableStreet1stSt                        TableStreet = `1st St`
+	TableStreet2ndSt                        TableStreet = `2nd St`
+	TableStreet3rdSt                        TableStreet = `3rd St`
+	TableStreet41stSt                       TableStreet = `41st St`
+	TableStreet4thSt                        TableStreet = `4th St`
+	TableStreet6thSt                        TableStreet = `6th St`
+	TableStreet7thSt                        TableStreet = `7th St`
+	TableStreet8thSt                        TableStreet = `8th St`
+	TableStreet9thSt                        TableStreet = `9th St`
+	TableStreetAaronLn                      TableStreet = `Aaron Ln`
+	TableStreetAbbeyLn                      TableStreet = `Abbey Ln`
+	TableStreetAbercrombieSt                TableStreet = `Abercrombie St`
+	TableStreetAberdeenCt                   TableStreet = `Aberdeen Ct`
+	TableStreetAbernathyRd                  TableStreet = `Abernathy Rd`
+	TableStreetAbingtonCt                   TableStreet = `Abington Ct`
+	TableStreetAccessRd                     TableStreet = `Access Rd`
+	TableStreetAckermanRd                   TableStreet = `Ackerman Rd`
+	TableStreetAcornHillNW                  TableStreet = `Acorn Hill NW`
+	TableStreetAdairDr                      TableStreet = `Adair Dr`
+	TableStreetAdairHollowRd                TableStreet = `Adair Hollow Rd`
+	TableStreetAdairsvillePleasantValleyRd  TableStreet = `Adairsville Pleasant Valley Rd`
+	TableStreetAdamsChapelRd                TableStreet = `Adams Chapel Rd`
+	TableStreetAdamsWay                     TableStreet = `Adams Way`
+	TableStreetAdcockLoop                   TableStreet = `Adcock Loop`
+	TableStreetAdcockRd                     TableStreet = `Adcock Rd`
+	TableStreetAikenSt                      TableStreet = `Aiken St`
+	TableStreetAkinDr                       TableStreet = `Akin Dr`
+	TableStreetAkinRd                       TableStreet = `Akin Rd`
+	TableStreetAkinWay                      TableStreet = `Akin Way`
+	TableStreetAkronSt                      TableStreet = `Akron St`
+	TableStreetAlexDr                       TableStreet = `Alex Dr`
+	TableStreetAlexanderSt                  TableStreet = `Alexander St`
+	TableStreetAlfordRd                     TableStreet = `Alford Rd`
+	TableStreetAlishaCir                    TableStreet = `Alisha Cir`
+	TableStreetAllMetalsDr                  TableStreet = `All Metals Dr`
+	TableStreetAllatoonaDamRd               TableStreet = `Allatoona Dam Rd`
+	TableStreetAllatoonaDr                  TableStreet = `Allatoona Dr`
+	TableStreetAllatoonaEstatesDr           TableStreet = `Allatoona Estates Dr`
+	TableStreetAllatoonaLandingRd           TableStreet = `Allatoona Landing Rd`
+	TableStreetAllatoonaPassRd              TableStreet = `Allatoona Pass Rd`
+	TableStreetAllatoonaTraceDr             TableStreet = `Allatoona Trace Dr`
+	TableStreetAllatoonaWoodsTrl            TableStreet = `Allatoona Woods Trl`
+	TableStreetAllegianceCt                 TableStreet = `Allegiance Ct`
+	TableStreetAllenCir                     TableStreet = `Allen Cir`
+	TableStreetAllenRd                      TableStreet = `Allen Rd`
+	TableStreetAllisonCir                   TableStreet = `Allison Cir`
+	TableStreetAllweatherSt                 TableStreet = `Allweather St`
+	TableStreetAlpineDr                     TableStreet = `Alpine Dr`
+	TableStreetAmandaLn                     TableStreet = `Amanda Ln`
+	TableStreetAmandasCt                    TableStreet = `Amandas Ct`
+	TableStreetAmberidgeDr                  TableStreet = `Amberidge Dr`
+	TableStreetAmberlyLn                    TableStreet = `Amberly Ln`
+	TableStreetAmberwoodConn                TableStreet = `Amberwood Conn`
+	TableStreetAmberwoodCt                  TableStreet = `Amberwood Ct`
+	TableStreetAmberwoodLn                  TableStreet = `Amberwood Ln`
+	TableStreetAmberwoodPl                  TableStreet = `Amberwood Pl`
+	TableStreetAmberwoodTrl                 TableStreet = `Amberwood Trl`
+	TableStreetAmberwoodWay                 TableStreet = `Amberwood Way`
+	TableStreetAmpacetDr                    TableStreet = `Ampacet Dr`
+	TableStreetAnchorRd                     TableStreet = `Anchor Rd`
+	TableStreetAndersonSt                   TableStreet = `Anderson St`
+	TableStreetAndrewColeTrl                TableStreet = `Andrew Cole Trl`
+	TableStreetAngusTrl                     TableStreet = `Angus Trl`
+	TableStreetAnnCir                       TableStreet = `Ann Cir`
+	TableStreetAnnRd                        TableStreet = `Ann Rd`
+	TableStreetAnnaPl                       TableStreet = `Anna Pl`
+	TableStreetAnnsCt                       TableStreet = `Anns Ct`
+	TableStreetAnnsTrc                      TableStreet = `Anns Trc`
+	TableStreetAnsleyWay                    TableStreet = `Ansley Way`
+	TableStreetAnsubetDr                    TableStreet = `Ansubet Dr`
+	TableStreetAntebellumCt                 TableStreet = `Antebellum Ct`
+	TableStreetApacheDr                     TableStreet = `Apache Dr`
+	TableStreetApacheTrl                    TableStreet = `Apache Trl`
+	TableStreetApexDr                       TableStreet = `Apex Dr`
+	TableStreetAppaloosaCt                  TableStreet = `Appaloosa Ct`
+	TableStreetAppleBarrelWay               TableStreet = `Apple Barrel Way`
+	TableStreetAppleLn                      TableStreet = `Apple Ln`
+	TableStreetAppleTreeLn                  TableStreet = `Apple Tree Ln`
+	TableStreetAppleWoodLn                  TableStreet = `Apple Wood Ln`
+	TableStreetAppletreeCt                  TableStreet = `Appletree Ct`
+	TableStreetApplingWay                   TableStreet = `Appling Way`
+	TableStreetAprilLn                      TableStreet = `April Ln`
+	TableStreetAragonRd                     TableStreet = `Aragon Rd`
+	TableStreetArapahoDr                    TableStreet = `Arapaho Dr`
+	TableStreetArborLn                      TableStreet = `Arbor Ln`
+	TableStreetArborsWay                    TableStreet = `Arbors Way`
+	TableStreetArbutusTrl                   TableStreet = `Arbutus Trl`
+	TableStreetArdmoreCir                   TableStreet = `Ardmore Cir`
+	TableStreetArizonaAve                   TableStreet = `Arizona Ave`
+	TableStreetArlingtonWay                 TableStreet = `Arlington Way`
+	TableStreetArnoldRd                     TableStreet = `Arnold Rd`
+	TableStreetArnoldSpur                   TableStreet = `Arnold Spur`
+	TableStreetArringtonRd                  TableStreet = `Arrington Rd`
+	TableStreetArrowMountainDr              TableStreet = `Arrow Mountain Dr`
+	TableStreetArrowheadCt                  TableStreet = `Arrowhead Ct`
+	TableStreetArrowheadDr                  TableStreet = `Arrowhead Dr`
+	TableStreetArrowheadLn                  TableStreet = `Arrowhead Ln`
+	TableStreetAshCt                        TableStreet = `Ash Ct`
+	TableStreetAshWay                       TableStreet = `Ash Way`
+	TableStreetAshfordCt                    TableStreet = `Ashford Ct`
+	TableStreetAshfordPt                    TableStreet = `Ashford Pt`
+	TableStreetAshleyCt                     TableStreet = `Ashley Ct`
+	TableStreetAshleyRd                     TableStreet = `Ashley Rd`
+	TableStreetAshwoodDr                    TableStreet = `Ashwood Dr`
+	TableStreetAspenDr                      TableStreet = `Aspen Dr`
+	TableStreetAspenLn                      TableStreet = `Aspen Ln`
+	TableStreetAssemblyDr                   TableStreet = `Assembly Dr`
+	TableStreetAtcoAll                      TableStreet = `Atco All`
+	TableStreetAttawayDr                    TableStreet = `Attaway Dr`
+	TableStreetAtwoodRd                     TableStreet = `Atwood Rd`
+	TableStreetAubreyLakeRd                 TableStreet = `Aubrey Lake Rd`
+	TableStreetAubreyRd                     TableStreet = `Aubrey Rd`
+	TableStreetAubreySt                     TableStreet = `Aubrey St`
+	TableStreetAuburnDr                     TableStreet = `Auburn Dr`
+	TableStreetAustinRd                     TableStreet = `Austin Rd`
+	TableStreetAutryRd                      TableStreet = `Autry Rd`
+	TableStreetAutumnPl                     TableStreet = `Autumn Pl`
+	TableStreetAutumnRidgeDr                TableStreet = `Autumn Ridge Dr`
+	TableStreetAutumnTurn                   TableStreet = `Autumn Turn`
+	TableStreetAutumnWoodDr                 TableStreet = `Autumn Wood Dr`
+	TableStreetAvenueOfSavannah             TableStreet = `Avenue Of Savannah`
+	TableStreetAvonCt                       TableStreet = `Avon Ct`
+	TableStreetAzaleaDr                     TableStreet = `Azalea Dr`
+	TableStreetAztecWay                     TableStreet = `Aztec Way`
+	TableStreetBDTrl                        TableStreet = `B D Trl`
+	TableStreetBackusRd                     TableStreet = `Backus Rd`
+	TableStreetBagwellLn                    TableStreet = `Bagwell Ln`
+	TableStreetBaileyHillRd                 TableStreet = `Bailey Hill Rd`
+	TableStreetBaileyRd                     TableStreet = `Bailey Rd`
+	TableStreetBakerRd                      TableStreet = `Baker Rd`
+	TableStreetBakerSt                      TableStreet = `Baker St`
+	TableStreetBaldEagleLndg                TableStreet = `Bald Eagle Lndg`
+	TableStreetBaldwellCt                   TableStreet = `Baldwell Ct`
+	TableStreetBalfourDr                    TableStreet = `Balfour Dr`
+	TableStreetBalsamDr                     TableStreet = `Balsam Dr`
+	TableStreetBanditLn                     TableStreet = `Bandit Ln`
+	TableStreetBangorSt                     TableStreet = `Bangor St`
+	TableStreetBaptistCir                   TableStreet = `Baptist Cir`
+	TableStreetBarnsleyChurchRd             TableStreet = `Barnsley Church Rd`
+	TableStreetBarnsleyCt                   TableStreet = `Barnsley Ct`
+	TableStreetBarnsleyDr                   TableStreet = `Barnsley Dr`
+	TableStreetBarnsleyGardenRd             TableStreet = `Barnsley Garden Rd`
+	TableStreetBarnsleyVillageDr            TableStreet = `Barnsley Village Dr`
+	TableStreetBarnsleyVillageTrl           TableStreet = `Barnsley Village Trl`
+	TableStreetBarrettLn                    TableStreet = `Barrett Ln`
+	TableStreetBarrettRd                    TableStreet = `Barrett Rd`
+	TableStreetBarryDr                      TableStreet = `Barry Dr`
+	TableStreetBartowBeachRd                TableStreet = `Bartow Beach Rd`
+	TableStreetBartowCarverRd               TableStreet = `Bartow Carver Rd`
+	TableStreetBartowSt                     TableStreet = `Bartow St`
+	TableStreetBatesRd                      TableStreet = `Bates Rd`
+	TableStreetBayCt                        TableStreet = `Bay Ct`
+	TableStreetBeardenRd                    TableStreet = `Bearden Rd`
+	TableStreetBeasleyRd                    TableStreet = `Beasley Rd`
+	TableStreetBeaureguardSt                TableStreet = `Beaureguard St`
+	TableStreetBeaverTrl                    TableStreet = `Beaver Trl`
+	TableStreetBeaversDr                    TableStreet = `Beavers Dr`
+	TableStreetBedfordCt                    TableStreet = `Bedford Ct`
+	TableStreetBedfordRdg                   TableStreet = `Bedford Rdg`
+	TableStreetBeeTreeRd                    TableStreet = `Bee Tree Rd`
+	TableStreetBeechwoodDr                  TableStreet = `Beechwood Dr`
+	TableStreetBeguineDr                    TableStreet = `Beguine Dr`
+	TableStreetBellDr                       TableStreet = `Bell Dr`
+	TableStreetBellsFerryRd                 TableStreet = `Bells Ferry Rd`
+	TableStreetBelmontDr                    TableStreet = `Belmont Dr`
+	TableStreetBelmontWay                   TableStreet = `Belmont Way`
+	TableStreetBenfieldCir                  TableStreet = `Benfield Cir`
+	TableStreetBenhamCir                    TableStreet = `Benham Cir`
+	TableStreetBennettKimbralRd             TableStreet = `Bennett Kimbral Rd`
+	TableStreetBennettWay                   TableStreet = `Bennett Way`
+	TableStreetBentWaterDr                  TableStreet = `Bent Water Dr`
+	TableStreetBerkeleyPl                   TableStreet = `Berkeley Pl`
+	TableStreetBerkshireDr                  TableStreet = `Berkshire Dr`
+	TableStreetBestwayDr                    TableStreet = `Bestway Dr`
+	TableStreetBestwayPkwy                  TableStreet = `Bestway Pkwy`
+	TableStreetBetsyLockePt                 TableStreet = `Betsy Locke Pt`
+	TableStreetBeverlieDr                   TableStreet = `Beverlie Dr`
+	TableStreetBevilRidgeRd                 TableStreet = `Bevil Ridge Rd`
+	TableStreetBiddyRd                      TableStreet = `Biddy Rd`
+	TableStreetBigBranchCt                  TableStreet = `Big Branch Ct`
+	TableStreetBigDitchRd                   TableStreet = `Big Ditch Rd`
+	TableStreetBigOakTreeRd                 TableStreet = `Big Oak Tree Rd`
+	TableStreetBigPondRd                    TableStreet = `Big Pond Rd`
+	TableStreetBillBlackRd                  TableStreet = `Bill Black Rd`
+	TableStreetBillNelsonRd                 TableStreet = `Bill Nelson Rd`
+	TableStreetBilliesCv                    TableStreet = `Billies Cv`
+	TableStreetBinghamRd                    TableStreet = `Bingham Rd`
+	TableStreetBirchPl                      TableStreet = `Birch Pl`
+	TableStreetBirchWay                     TableStreet = `Birch Way`
+	TableStreetBishopCv                     TableStreet = `Bishop Cv`
+	TableStreetBishopDr                     TableStreet = `Bishop Dr`
+	TableStreetBishopLoop                   TableStreet = `Bishop Loop`
+	TableStreetBishopMillDr                 TableStreet = `Bishop Mill Dr`
+	TableStreetBishopRd                     TableStreet = `Bishop Rd`
+	TableStreetBlackJackMountainCir         TableStreet = `Black Jack Mountain Cir`
+	TableStreetBlackOakRd                   TableStreet = `Black Oak Rd`
+	TableStreetBlackRd                      TableStreet = `Black Rd`
+	TableStreetBlackWaterDr                 TableStreet = `Black Water Dr`
+	TableStreetBlackacreTrl                 TableStreet = `Blackacre Trl`
+	TableStreetBlackberryRdg                TableStreet = `Blackberry Rdg`
+	TableStreetBlackfootTrl                 TableStreet = `Blackfoot Trl`
+	TableStreetBlacksmithLn                 TableStreet = `Blacksmith Ln`
+	TableStreetBloomfieldCt                 TableStreet = `Bloomfield Ct`
+	TableStreetBlueberryRdg                 TableStreet = `Blueberry Rdg`
+	TableStreetBluebirdCir                  TableStreet = `Bluebird Cir`
+	TableStreetBluegrassCv                  TableStreet = `Bluegrass Cv`
+	TableStreetBluffCt                      TableStreet = `Bluff Ct`
+	TableStreetBoardwalkCt                  TableStreet = `Boardwalk Ct`
+	TableStreetBoatnerAve                   TableStreet = `Boatner Ave`
+	TableStreetBobOLinkDr                   TableStreet = `Bob O Link Dr`
+	TableStreetBobWhiteTrl                  TableStreet = `Bob White Trl`
+	TableStreetBobcatCt                     TableStreet = `Bobcat Ct`
+	TableStreetBoliverRd                    TableStreet = `Boliver Rd`
+	TableStreetBonairSt                     TableStreet = `Bonair St`
+	TableStreetBoonesFarm                   TableStreet = `Boones Farm`
+	TableStreetBoonesRidgeDr                TableStreet = `Boones Ridge Dr`
+	TableStreetBoonesRidgePkwy              TableStreet = `Boones Ridge Pkwy`
+	TableStreetBossRd                       TableStreet = `Boss Rd`
+	TableStreetBoulderCrestRd               TableStreet = `Boulder Crest Rd`
+	TableStreetBoulderRd                    TableStreet = `Boulder Rd`
+	TableStreetBowenRd                      TableStreet = `Bowen Rd`
+	TableStreetBowensCt                     TableStreet = `Bowens Ct`
+	TableStreetBoydMorrisDr                 TableStreet = `Boyd Morris Dr`
+	TableStreetBoydMountainRd               TableStreet = `Boyd Mountain Rd`
+	TableStreetBoysenberryCt                TableStreet = `Boysenberry Ct`
+	TableStreetBozemanRd                    TableStreet = `Bozeman Rd`
+	TableStreetBradfordCt                   TableStreet = `Bradford Ct`
+	TableStreetBradfordDr                   TableStreet = `Bradford Dr`
+	TableStreetBradleyLn                    TableStreet = `Bradley Ln`
+	TableStreetBradleyTrl                   TableStreet = `Bradley Trl`
+	TableStreetBramblewoodCt                TableStreet = `Bramblewood Ct`
+	TableStreetBramblewoodDr                TableStreet = `Bramblewood Dr`
+	TableStreetBramblewoodPl                TableStreet = `Bramblewood Pl`
+	TableStreetBramblewoodTrl               TableStreet = `Bramblewood Trl`
+	TableStreetBramblewoodWay               TableStreet = `Bramblewood Way`
+	TableStreetBrandonFarmRd                TableStreet = `Brandon Farm Rd`
+	TableStreetBrandonRdg                   TableStreet = `Brandon Rdg`
+	TableStreetBrandyLn                     TableStreet = `Brandy Ln`
+	TableStreetBransonCrossingRd            TableStreet = `Branson Crossing Rd`
+	TableStreetBransonMillDr                TableStreet = `Branson Mill Dr`
+	TableStreetBrantonRd                    TableStreet = `Branton Rd`
+	TableStreetBrentCir                     TableStreet = `Brent Cir`
+	TableStreetBrentwoodDr                  TableStreet = `Brentwood Dr`
+	TableStreetBrettWay                     TableStreet = `Brett Way`
+	TableStreetBriarChaseCt                 TableStreet = `Briar Chase Ct`
+	TableStreetBriarPatchLn                 TableStreet = `Briar Patch Ln`
+	TableStreetBriarcrestWay                TableStreet = `Briarcrest Way`
+	TableStreetBriarwoodLn                  TableStreet = `Briarwood Ln`
+	TableStreetBrickshire                   TableStreet = `Brickshire`
+	TableStreetBrickshireDr                 TableStreet = `Brickshire Dr`
+	TableStreetBridgestoneWay               TableStreet = `Bridgestone Way`
+	TableStreetBridlewoodCt                 TableStreet = `Bridlewood Ct`
+	TableStreetBrightonCt                   TableStreet = `Brighton Ct`
+	TableStreetBrightwellPl                 TableStreet = `Brightwell Pl`
+	TableStreetBristolCt                    TableStreet = `Bristol Ct`
+	TableStreetBrittaniLn                   TableStreet = `Brittani Ln`
+	TableStreetBroadlandsDr                 TableStreet = `Broadlands Dr`
+	TableStreetBrokenArrowCt                TableStreet = `Broken Arrow Ct`
+	TableStreetBroncoCt                     TableStreet = `Bronco Ct`
+	TableStreetBrookDr                      TableStreet = `Brook Dr`
+	TableStreetBrookKnollCt                 TableStreet = `Brook Knoll Ct`
+	TableStreetBrookeRd                     TableStreet = `Brooke Rd`
+	TableStreetBrookhollowLn                TableStreet = `Brookhollow Ln`
+	TableStreetBrooklandDr                  TableStreet = `Brookland Dr`
+	TableStreetBrookshireDr                 TableStreet = `Brookshire Dr`
+	TableStreetBrooksideCt                  TableStreet = `Brookside Ct`
+	TableStreetBrooksideWay                 TableStreet = `Brookside Way`
+	TableStreetBrookwoodDr                  TableStreet = `Brookwood Dr`
+	TableStreetBrownDr                      TableStreet = `Brown Dr`
+	TableStreetBrownFarmRd                  TableStreet = `Brown Farm Rd`
+	TableStreetBrownLoop                    TableStreet = `Brown Loop`
+	TableStreetBrownLoopSpur                TableStreet = `Brown Loop Spur`
+	TableStreetBrownRd                      TableStreet = `Brown Rd`
+	TableStreetBrownwoodDr                  TableStreet = `Brownwood Dr`
+	TableStreetBruceSt                      TableStreet = `Bruce St`
+	TableStreetBrutisRd                     TableStreet = `Brutis Rd`
+	TableStreetBryanDr                      TableStreet = `Bryan Dr`
+	TableStreetBryanLn                      TableStreet = `Bryan Ln`
+	TableStreetBuckhornTrl                  TableStreet = `Buckhorn Trl`
+	TableStreetBuckinghamCt                 TableStreet = `Buckingham Ct`
+	TableStreetBucklandCt                   TableStreet = `Buckland Ct`
+	TableStreetBuckskinDr                   TableStreet = `Buckskin Dr`
+	TableStreetBuckySt                      TableStreet = `Bucky St`
+	TableStreetBuenaVistaCir                TableStreet = `Buena Vista Cir`
+	TableStreetBufordSt                     TableStreet = `Buford St`
+	TableStreetBunchMountainRd              TableStreet = `Bunch Mountain Rd`
+	TableStreetBurchLn                      TableStreet = `Burch Ln`
+	TableStreetBurgesMillRd                 TableStreet = `Burges Mill Rd`
+	TableStreetBurntHickoryDr               TableStreet = `Burnt Hickory Dr`
+	TableStreetBurntHickoryRd               TableStreet = `Burnt Hickory Rd`
+	TableStreetBuschDr                      TableStreet = `Busch Dr`
+	TableStreetBusheyTrl                    TableStreet = `Bushey Trl`
+	TableStreetButtonwoodDr                 TableStreet = `Buttonwood Dr`
+	TableStreetButtramRd                    TableStreet = `Buttram Rd`
+	TableStreetByarsRd                      TableStreet = `Byars Rd`
+	TableStreetCJCt                         TableStreet = `C J Ct`
+	TableStreetCJDr                         TableStreet = `C J Dr`
+	TableStreetCabooseCt                    TableStreet = `Caboose Ct`
+	TableStreetCadeDr                       TableStreet = `Cade Dr`
+	TableStreetCagleRd                      TableStreet = `Cagle Rd`
+	TableStreetCainDr                       TableStreet = `Cain Dr`
+	TableStreetCalicoValleyRd               TableStreet = `Calico Valley Rd`
+	TableStreetCallowayLn                   TableStreet = `Calloway Ln`
+	TableStreetCalvaryCt                    TableStreet = `Calvary Ct`
+	TableStreetCambridgeCt                  TableStreet = `Cambridge Ct`
+	TableStreetCambridgeWay                 TableStreet = `Cambridge Way`
+	TableStreetCamdenWoodsDr                TableStreet = `Camden Woods Dr`
+	TableStreetCamelliaLn                   TableStreet = `Camellia Ln`
+	TableStreetCamelotDr                    TableStreet = `Camelot Dr`
+	TableStreetCampCreekRd                  TableStreet = `Camp Creek Rd`
+	TableStreetCampDr                       TableStreet = `Camp Dr`
+	TableStreetCampPl                       TableStreet = `Camp Pl`
+	TableStreetCampSunriseRd                TableStreet = `Camp Sunrise Rd`
+	TableStreetCampTrl                      TableStreet = `Camp Trl`
+	TableStreetCampbellDr                   TableStreet = `Campbell Dr`
+	TableStreetCampfireTrl                  TableStreet = `Campfire Trl`
+	TableStreetCandlestickComm              TableStreet = `Candlestick Comm`
+	TableStreetCanefieldDr                  TableStreet = `Canefield Dr`
+	TableStreetCannonCir                    TableStreet = `Cannon Cir`
+	TableStreetCannonCt                     TableStreet = `Cannon Ct`
+	TableStreetCannonadeRun                 TableStreet = `Cannonade Run`
+	TableStreetCanterLn                     TableStreet = `Canter Ln`
+	TableStreetCanterburyCt                 TableStreet = `Canterbury Ct`
+	TableStreetCanterburyWalk               TableStreet = `Canterbury Walk`
+	TableStreetCantrellLn                   TableStreet = `Cantrell Ln`
+	TableStreetCantrellPt                   TableStreet = `Cantrell Pt`
+	TableStreetCantrellSt                   TableStreet = `Cantrell St`
+	TableStreetCantyRd                      TableStreet = `Canty Rd`
+	TableStreetCanyonPkwy                   TableStreet = `Canyon Pkwy`
+	TableStreetCaptainsTurn                 TableStreet = `Captains Turn`
+	TableStreetCaptainsWalk                 TableStreet = `Captains Walk`
+	TableStreetCardinalCt                   TableStreet = `Cardinal Ct`
+	TableStreetCardinalRd                   TableStreet = `Cardinal Rd`
+	TableStreetCarlDuvallDr                 TableStreet = `Carl Duvall Dr`
+	TableStreetCarliePass                   TableStreet = `Carlie Pass`
+	TableStreetCarnesDr                     TableStreet = `Carnes Dr`
+	TableStreetCarnesRd                     TableStreet = `Carnes Rd`
+	TableStreetCarolynCt                    TableStreet = `Carolyn Ct`
+	TableStreetCarrRd                       TableStreet = `Carr Rd`
+	TableStreetCarriageLn                   TableStreet = `Carriage Ln`
+	TableStreetCarriageTrc                  TableStreet = `Carriage Trc`
+	TableStreetCarringtonDr                 TableStreet = `Carrington Dr`
+	TableStreetCarringtonTrc                TableStreet = `Carrington Trc`
+	TableStreetCarrolSloughRd               TableStreet = `Carrol Slough Rd`
+	TableStreetCarsonCt                     TableStreet = `Carson Ct`
+	TableStreetCarsonLoop                   TableStreet = `Carson Loop`
+	TableStreetCarsonRd                     TableStreet = `Carson Rd`
+	TableStreetCarterGroveBlvd              TableStreet = `Carter Grove Blvd`
+	TableStreetCarterLn                     TableStreet = `Carter Ln`
+	TableStreetCarterRd                     TableStreet = `Carter Rd`
+	TableStreetCarverLandingRd              TableStreet = `Carver Landing Rd`
+	TableStreetCaseyDr                      TableStreet = `Casey Dr`
+	TableStreetCaseyLn                      TableStreet = `Casey Ln`
+	TableStreetCaseySt                      TableStreet = `Casey St`
+	TableStreetCassDr                       TableStreet = `Cass Dr`
+	TableStreetCassStationDr                TableStreet = `Cass Station Dr`
+	TableStreetCassStationPass              TableStreet = `Cass Station Pass`
+	TableStreetCassandraView                TableStreet = `Cassandra View`
+	TableStreetCassvillePineLogRd           TableStreet = `Cassville Pine Log Rd`
+	TableStreetCassvilleRd                  TableStreet = `Cassville Rd`
+	TableStreetCassvilleWhiteRd             TableStreet = `Cassville White Rd`
+	TableStreetCatalpaCt                    TableStreet = `Catalpa Ct`
+	TableStreetCathedralHght                TableStreet = `Cathedral Hght`
+	TableStreetCatherineCir                 TableStreet = `Catherine Cir`
+	TableStreetCatherineWay                 TableStreet = `Catherine Way`
+	TableStreetCedarCreekChurchRd           TableStreet = `Cedar Creek Church Rd`
+	TableStreetCedarCreekRd                 TableStreet = `Cedar Creek Rd`
+	TableStreetCedarGateLn                  TableStreet = `Cedar Gate Ln`
+	TableStreetCedarLn                      TableStreet = `Cedar Ln`
+	TableStreetCedarRd                      TableStreet = `Cedar Rd`
+	TableStreetCedarRdg                     TableStreet = `Cedar Rdg`
+	TableStreetCedarSpringsDr               TableStreet = `Cedar Springs Dr`
+	TableStreetCedarSt                      TableStreet = `Cedar St`
+	TableStreetCedarWay                     TableStreet = `Cedar Way`
+	TableStreetCemetaryRd                   TableStreet = `Cemetary Rd`
+	TableStreetCenterBluff                  TableStreet = `Center Bluff`
+	TableStreetCenterRd                     TableStreet = `Center Rd`
+	TableStreetCenterSt                     TableStreet = `Center St`
+	TableStreetCenterportDr                 TableStreet = `Centerport Dr`
+	TableStreetCentralAve                   TableStreet = `Central Ave`
+	TableStreetChanceCir                    TableStreet = `Chance Cir`
+	TableStreetChandlerLn                   TableStreet = `Chandler Ln`
+	TableStreetChapelWay                    TableStreet = `Chapel Way`
+	TableStreetCharismaticRd                TableStreet = `Charismatic Rd`
+	TableStreetCharlesCt                    TableStreet = `Charles Ct`
+	TableStreetCharlesSt                    TableStreet = `Charles St`
+	TableStreetCharlotteDr                  TableStreet = `Charlotte Dr`
+	TableStreetChasePl                      TableStreet = `Chase Pl`
+	TableStreetChateauDr                    TableStreet = `Chateau Dr`
+	TableStreetCherokeeCir                  TableStreet = `Cherokee Cir`
+	TableStreetCherokeeDr                   TableStreet = `Cherokee Dr`
+	TableStreetCherokeeHght                 TableStreet = `Cherokee Hght`
+	TableStreetCherokeeHillsDr              TableStreet = `Cherokee Hills Dr`
+	TableStreetCherokeePl                   TableStreet = `Cherokee Pl`
+	TableStreetCherokeeSt                   TableStreet = `Cherokee St`
+	TableStreetCherrySt                     TableStreet = `Cherry St`
+	TableStreetCherrywoodLn                 TableStreet = `Cherrywood Ln`
+	TableStreetCherrywoodWay                TableStreet = `Cherrywood Way`
+	TableStreetCherylDr                     TableStreet = `Cheryl Dr`
+	TableStreetChestnutRidgeCt              TableStreet = `Chestnut Ridge Ct`
+	TableStreetChestnutRidgeDr              TableStreet = `Chestnut Ridge Dr`
+	TableStreetChestnutSt                   TableStreet = `Chestnut St`
+	TableStreetCheyenneWay                  TableStreet = `Cheyenne Way`
+	TableStreetChickasawTrl                 TableStreet = `Chickasaw Trl`
+	TableStreetChickasawWay                 TableStreet = `Chickasaw Way`
+	TableStreetChimneyLn                    TableStreet = `Chimney Ln`
+	TableStreetChimneySpringsDr             TableStreet = `Chimney Springs Dr`
+	TableStreetChippewaDr                   TableStreet = `Chippewa Dr`
+	TableStreetChippewaWay                  TableStreet = `Chippewa Way`
+	TableStreetChitwoodCemetaryRd           TableStreet = `Chitwood Cemetary Rd`
+	TableStreetChoctawCt                    TableStreet = `Choctaw Ct`
+	TableStreetChristonDr                   TableStreet = `Christon Dr`
+	TableStreetChristopherPl                TableStreet = `Christopher Pl`
+	TableStreetChristopherRdg               TableStreet = `Christopher Rdg`
+	TableStreetChulioDr                     TableStreet = `Chulio Dr`
+	TableStreetChunnDr                      TableStreet = `Chunn Dr`
+	TableStreetChunnFacinRd                 TableStreet = `Chunn Facin Rd`
+	TableStreetChurchSt                     TableStreet = `Church St`
+	TableStreetChurchillDown                TableStreet = `Churchill Down`
+	TableStreetChurchillDownsDown           TableStreet = `Churchill Downs Down`
+	TableStreetCindyLn                      TableStreet = `Cindy Ln`
+	TableStreetCitationPte                  TableStreet = `Citation Pte`
+	TableStreetClaireCv                     TableStreet = `Claire Cv`
+	TableStreetClarkCir                     TableStreet = `Clark Cir`
+	TableStreetClarkWay                     TableStreet = `Clark Way`
+	TableStreetClearCreekRd                 TableStreet = `Clear Creek Rd`
+	TableStreetClearLakeDr                  TableStreet = `Clear Lake Dr`
+	TableStreetClearPass                    TableStreet = `Clear Pass`
+	TableStreetClearviewDr                  TableStreet = `Clearview Dr`
+	TableStreetClearwaterSt                 TableStreet = `Clearwater St`
+	TableStreetCliffNelsonRd                TableStreet = `Cliff Nelson Rd`
+	TableStreetCliffhangerPte               TableStreet = `Cliffhanger Pte`
+	TableStreetClineDr                      TableStreet = `Cline Dr`
+	TableStreetClineSmithRd                 TableStreet = `Cline Smith Rd`
+	TableStreetClineSt                      TableStreet = `Cline St`
+	TableStreetCloverDr                     TableStreet = `Clover Dr`
+	TableStreetCloverLn                     TableStreet = `Clover Ln`
+	TableStreetCloverPass                   TableStreet = `Clover Pass`
+	TableStreetClubhouseCt                  TableStreet = `Clubhouse Ct`
+	TableStreetClubviewDr                   TableStreet = `Clubview Dr`
+	TableStreetClydesdaleTrl                TableStreet = `Clydesdale Trl`
+	TableStreetCobblestoneDr                TableStreet = `Cobblestone Dr`
+	TableStreetCochransWay                  TableStreet = `Cochrans Way`
+	TableStreetCoffeeSt                     TableStreet = `Coffee St`
+	TableStreetColeCt                       TableStreet = `Cole Ct`
+	TableStreetColeDr                       TableStreet = `Cole Dr`
+	TableStreetColemanSt                    TableStreet = `Coleman St`
+	TableStreetColleenAndKarenRd            TableStreet = `Colleen and Karen Rd`
+	TableStreetCollegeSt                    TableStreet = `College St`
+	TableStreetCollinsDr                    TableStreet = `Collins Dr`
+	TableStreetCollinsPl                    TableStreet = `Collins Pl`
+	TableStreetCollinsSt                    TableStreet = `Collins St`
+	TableStreetCollumRd                     TableStreet = `Collum Rd`
+	TableStreetColonialCir                  TableStreet = `Colonial Cir`
+	TableStreetColonialClubDr               TableStreet = `Colonial Club Dr`
+	TableStreetColonyCt                     TableStreet = `Colony Ct`
+	TableStreetColonyDr                     TableStreet = `Colony Dr`
+	TableStreetColtCt                       TableStreet = `Colt Ct`
+	TableStreetColtWay                      TableStreet = `Colt Way`
+	TableStreetColumbiaSt                   TableStreet = `Columbia St`
+	TableStreetComedyLn                     TableStreet = `Comedy Ln`
+	TableStreetCommancheDr                  TableStreet = `Commanche Dr`
+	TableStreetCommancheTrl                 TableStreet = `Commanche Trl`
+	TableStreetCommerceDr                   TableStreet = `Commerce Dr`
+	TableStreetCommerceRow                  TableStreet = `Commerce Row`
+	TableStreetCommerceWay                  TableStreet = `Commerce Way`
+	TableStreetConeRd                       TableStreet = `Cone Rd`
+	TableStreetConfederateSt                TableStreet = `Confederate St`
+	TableStreetConnesenaRd                  TableStreet = `Connesena Rd`
+	TableStreetConnieSt                     TableStreet = `Connie St`
+	TableStreetConyersIndustrialDr          TableStreet = `Conyers Industrial Dr`
+	TableStreetCookSt                       TableStreet = `Cook St`
+	TableStreetCooperMillRd                 TableStreet = `Cooper Mill Rd`
+	TableStreetCopelandRd                   TableStreet = `Copeland Rd`
+	TableStreetCoperitePl                   TableStreet = `Coperite Pl`
+	TableStreetCopperheadRd                 TableStreet = `Copperhead Rd`
+	TableStreetCorbittDr                    TableStreet = `Corbitt Dr`
+	TableStreetCorinthRd                    TableStreet = `Corinth Rd`
+	TableStreetCorsonTrl                    TableStreet = `Corson Trl`
+	TableStreetCottageDr                    TableStreet = `Cottage Dr`
+	TableStreetCottageTrc                   TableStreet = `Cottage Trc`
+	TableStreetCottageWalk                  TableStreet = `Cottage Walk`
+	TableStreetCottonBend                   TableStreet = `Cotton Bend`
+	TableStreetCottonDr                     TableStreet = `Cotton Dr`
+	TableStreetCottonSt                     TableStreet = `Cotton St`
+	TableStreetCottonwoodCt                 TableStreet = `Cottonwood Ct`
+	TableStreetCountryClubDr                TableStreet = `Country Club Dr`
+	TableStreetCountryCoveLn                TableStreet = `Country Cove Ln`
+	TableStreetCountryCreekRd               TableStreet = `Country Creek Rd`
+	TableStreetCountryDr                    TableStreet = `Country Dr`
+	TableStreetCountryLn                    TableStreet = `Country Ln`
+	TableStreetCountryMeadowWay             TableStreet = `Country Meadow Way`
+	TableStreetCountryWalk                  TableStreet = `Country Walk`
+	TableStreetCountyLineLoopNo1            TableStreet = `County Line Loop No 1`
+	TableStreetCountyLineRd                 TableStreet = `County Line Rd`
+	TableStreetCountyLineRoadNo2            TableStreet = `County Line Road No 2`
+	TableStreetCourrantSt                   TableStreet = `Courrant St`
+	TableStreetCourtXing                    TableStreet = `Court Xing`
+	TableStreetCourtyardDr                  TableStreet = `Courtyard Dr`
+	TableStreetCourtyardLn                  TableStreet = `Courtyard Ln`
+	TableStreetCoveredBridgeRd              TableStreet = `Covered Bridge Rd`
+	TableStreetCowanDr                      TableStreet = `Cowan Dr`
+	TableStreetCoxFarmRd                    TableStreet = `Cox Farm Rd`
+	TableStreetCoxFarmSpur                  TableStreet = `Cox Farm Spur`
+	TableStreetCoxRd                        TableStreet = `Cox Rd`
+	TableStreetCoxSt                        TableStreet = `Cox St`
+	TableStreetCozyLn                       TableStreet = `Cozy Ln`
+	TableStreetCraigsRd                     TableStreet = `Craigs Rd`
+	TableStreetCraneCir                     TableStreet = `Crane Cir`
+	TableStreetCreedDr                      TableStreet = `Creed Dr`
+	TableStreetCreekBendCt                  TableStreet = `Creek Bend Ct`
+	TableStreetCreekDr                      TableStreet = `Creek Dr`
+	TableStreetCreekTrl                     TableStreet = `Creek Trl`
+	TableStreetCreekbendDr                  TableStreet = `Creekbend Dr`
+	TableStreetCreeksideDr                  TableStreet = `Creekside Dr`
+	TableStreetCreeksideLn                  TableStreet = `Creekside Ln`
+	TableStreetCreekstoneCt                 TableStreet = `Creekstone Ct`
+	TableStreetCreekviewDr                  TableStreet = `Creekview Dr`
+	TableStreetCrescentDr                   TableStreet = `Crescent Dr`
+	TableStreetCrestbrookDr                 TableStreet = `Crestbrook Dr`
+	TableStreetCrestviewLn                  TableStreet = `Crestview Ln`
+	TableStreetCrestwoodCt                  TableStreet = `Crestwood Ct`
+	TableStreetCrestwoodRd                  TableStreet = `Crestwood Rd`
+	TableStreetCrimsonHillDr                TableStreet = `Crimson Hill Dr`
+	TableStreetCrissBlackRd                 TableStreet = `Criss Black Rd`
+	TableStreetCrolleyLn                    TableStreet = `Crolley Ln`
+	TableStreetCromwellCt                   TableStreet = `Cromwell Ct`
+	TableStreetCrossCreekDr                 TableStreet = `Cross Creek Dr`
+	TableStreetCrossSt                      TableStreet = `Cross St`
+	TableStreetCrossTieCt                   TableStreet = `Cross Tie Ct`
+	TableStreetCrossbridgeCT                TableStreet = `Crossbridge CT`
+	TableStreetCrossfieldCir                TableStreet = `Crossfield Cir`
+	TableStreetCroweDr                      TableStreet = `Crowe Dr`
+	TableStreetCroweSpringsRd               TableStreet = `Crowe Springs Rd`
+	TableStreetCroweSpringsSpur             TableStreet = `Crowe Springs Spur`
+	TableStreetCrumpRd                      TableStreet = `Crump Rd`
+	TableStreetCrystalLn                    TableStreet = `Crystal Ln`
+	TableStreetCrystalMountainRd            TableStreet = `Crystal Mountain Rd`
+	TableStreetCulverRd                     TableStreet = `Culver Rd`
+	TableStreetCumberlandGap                TableStreet = `Cumberland Gap`
+	TableStreetCumberlandRd                 TableStreet = `Cumberland Rd`
+	TableStreetCummingsRd                   TableStreet = `Cummings Rd`
+	TableStreetCurtisCt                     TableStreet = `Curtis Ct`
+	TableStreetCutOffRd                     TableStreet = `Cut Off Rd`
+	TableStreetCypressWay                   TableStreet = `Cypress Way`
+	TableStreetDabbsDr                      TableStreet = `Dabbs Dr`
+	TableStreetDanDr                        TableStreet = `Dan Dr`
+	TableStreetDanaWay                      TableStreet = `Dana Way`
+	TableStreetDanbyCt                      TableStreet = `Danby Ct`
+	TableStreetDarnellRd                    TableStreet = `Darnell Rd`
+	TableStreetDavidRd                      TableStreet = `David Rd`
+	TableStreetDavidSt                      TableStreet = `David St`
+	TableStreetDavidsWay                    TableStreet = `Davids Way`
+	TableStreetDavisDr                      TableStreet = `Davis Dr`
+	TableStreetDavisLoop                    TableStreet = `Davis Loop`
+	TableStreetDavisSt                      TableStreet = `Davis St`
+	TableStreetDavistownRd                  TableStreet = `Davistown Rd`
+	TableStreetDawnDr                       TableStreet = `Dawn Dr`
+	TableStreetDawnsWay                     TableStreet = `Dawns Way`
+	TableStreetDawsonSt                     TableStreet = `Dawson St`
+	TableStreetDeanDr                       TableStreet = `Dean Dr`
+	TableStreetDeanRd                       TableStreet = `Dean Rd`
+	TableStreetDeanSt                       TableStreet = `Dean St`
+	TableStreetDebraCir                     TableStreet = `Debra Cir`
+	TableStreetDeensRd                      TableStreet = `Deens Rd`
+	TableStreetDeerLodgeRd                  TableStreet = `Deer Lodge Rd`
+	TableStreetDeerRunDr                    TableStreet = `Deer Run Dr`
+	TableStreetDeerRunLn                    TableStreet = `Deer Run Ln`
+	TableStreetDeeringWay                   TableStreet = `Deering Way`
+	TableStreetDeerviewCt                   TableStreet = `Deerview Ct`
+	TableStreetDefenderSt                   TableStreet = `Defender St`
+	TableStreetDempseyLoop                  TableStreet = `Dempsey Loop`
+	TableStreetDempseyRd                    TableStreet = `Dempsey Rd`
+	TableStreetDentDr                       TableStreet = `Dent Dr`
+	TableStreetDenverDr                     TableStreet = `Denver Dr`
+	TableStreetDerbyWay                     TableStreet = `Derby Way`
+	TableStreetDerrickLn                    TableStreet = `Derrick Ln`
+	TableStreetDevinLn                      TableStreet = `Devin Ln`
+	TableStreetDevonCt                      TableStreet = `Devon Ct`
+	TableStreetDewberryLn                   TableStreet = `Dewberry Ln`
+	TableStreetDeweyDr                      TableStreet = `Dewey Dr`
+	TableStreetDianneDr                     TableStreet = `Dianne Dr`
+	TableStreetDixieDr                      TableStreet = `Dixie Dr`
+	TableStreetDobsonDr                     TableStreet = `Dobson Dr`
+	TableStreetDoddRd                       TableStreet = `Dodd Rd`
+	TableStreetDodsonRd                     TableStreet = `Dodson Rd`
+	TableStreetDoeCt                        TableStreet = `Doe Ct`
+	TableStreetDogLn                        TableStreet = `Dog Ln`
+	TableStreetDogwoodCir                   TableStreet = `Dogwood Cir`
+	TableStreetDogwoodDr                    TableStreet = `Dogwood Dr`
+	TableStreetDogwoodLn                    TableStreet = `Dogwood Ln`
+	TableStreetDogwoodPl                    TableStreet = `Dogwood Pl`
+	TableStreetDogwoodTrl                   TableStreet = `Dogwood Trl`
+	TableStreetDolphinDr                    TableStreet = `Dolphin Dr`
+	TableStreetDonnDr                       TableStreet = `Donn Dr`
+	TableStreetDooleySpringsRd              TableStreet = `Dooley Springs Rd`
+	TableStreetDorisRd                      TableStreet = `Doris Rd`
+	TableStreetDossDr                       TableStreet = `Doss Dr`
+	TableStreetDouglasSt                    TableStreet = `Douglas St`
+	TableStreetDouthitBridgeRd              TableStreet = `Douthit Bridge Rd`
+	TableStreetDouthitFerryRd               TableStreet = `Douthit Ferry Rd`
+	TableStreetDoveCt                       TableStreet = `Dove Ct`
+	TableStreetDoveDr                       TableStreet = `Dove Dr`
+	TableStreetDoveTrl                      TableStreet = `Dove Trl`
+	TableStreetDoverRd                      TableStreet = `Dover Rd`
+	TableStreetDowningWay                   TableStreet = `Downing Way`
+	TableStreetDrippingRockTrl              TableStreet = `Dripping Rock Trl`
+	TableStreetDruryLn                      TableStreet = `Drury Ln`
+	TableStreetDryCreekRd                   TableStreet = `Dry Creek Rd`
+	TableStreetDuncanDr                     TableStreet = `Duncan Dr`
+	TableStreetDunhillCt                    TableStreet = `Dunhill Ct`
+	TableStreetDureyCt                      TableStreet = `Durey Ct`
+	TableStreetDyarSt                       TableStreet = `Dyar St`
+	TableStreetDysartRd                     TableStreet = `Dysart Rd`
+	TableStreetECarterSt                    TableStreet = `E Carter St`
+	TableStreetECherokeeAve                 TableStreet = `E Cherokee Ave`
+	TableStreetEChurchSt                    TableStreet = `E Church St`
+	TableStreetEFeltonRd                    TableStreet = `E Felton Rd`
+	TableStreetEGeorgeSt                    TableStreet = `E George St`
+	TableStreetEGreenridgeRd                TableStreet = `E Greenridge Rd`
+	TableStreetEHolidayCt                   TableStreet = `E Holiday Ct`
+	TableStreetEHowardSt                    TableStreet = `E Howard St`
+	TableStreetEIndianaAve                  TableStreet = `E Indiana Ave`
+	TableStreetELeeSt                       TableStreet = `E Lee St`
+	TableStreetEMainSt                      TableStreet = `E Main St`
+	TableStreetEMitchellRd                  TableStreet = `E Mitchell Rd`
+	TableStreetEPleasantValleyRd            TableStreet = `E Pleasant Valley Rd`
+	TableStreetEPorterSt                    TableStreet = `E Porter St`
+	TableStreetERailroadSt                  TableStreet = `E Railroad St`
+	TableStreetERockySt                     TableStreet = `E Rocky St`
+	TableStreetEagleDr                      TableStreet = `Eagle Dr`
+	TableStreetEagleGlenDr                  TableStreet = `Eagle Glen Dr`
+	TableStreetEagleLn                      TableStreet = `Eagle Ln`
+	TableStreetEagleMountainTrl             TableStreet = `Eagle Mountain Trl`
+	TableStreetEaglePkwy                    TableStreet = `Eagle Pkwy`
+	TableStreetEaglesCt                     TableStreet = `Eagles Ct`
+	TableStreetEaglesNestCv                 TableStreet = `Eagles Nest Cv`
+	TableStreetEaglesViewDr                 TableStreet = `Eagles View Dr`
+	TableStreetEarleDr                      TableStreet = `Earle Dr`
+	TableStreetEastBoxwoodDr                TableStreet = `East Boxwood Dr`
+	TableStreetEastHeritageDr               TableStreet = `East Heritage Dr`
+	TableStreetEastValleyRd                 TableStreet = `East Valley Rd`
+	TableStreetEastonTrc                    TableStreet = `Easton Trc`
+	TableStreetEastviewCt                   TableStreet = `Eastview Ct`
+	TableStreetEastviewTer                  TableStreet = `Eastview Ter`
+	TableStreetEastwoodDr                   TableStreet = `Eastwood Dr`
+	TableStreetEchotaRd                     TableStreet = `Echota Rd`
+	TableStreetEdgewaterDr                  TableStreet = `Edgewater Dr`
+	TableStreetEdgewoodRd                   TableStreet = `Edgewood Rd`
+	TableStreetEdselDr                      TableStreet = `Edsel Dr`
+	TableStreetEidsonSt                     TableStreet = `Eidson St`
+	TableStreetElizabethRd                  TableStreet = `Elizabeth Rd`
+	TableStreetElizabethSt                  TableStreet = `Elizabeth St`
+	TableStreetElkhornCt                    TableStreet = `Elkhorn Ct`
+	TableStreetElliottSt                    TableStreet = `Elliott St`
+	TableStreetEllisRd                      TableStreet = `Ellis Rd`
+	TableStreetElmDr                        TableStreet = `Elm Dr`
+	TableStreetElmSt                        TableStreet = `Elm St`
+	TableStreetElmwoodPl                    TableStreet = `Elmwood Pl`
+	TableStreetElrodRdg                     TableStreet = `Elrod Rdg`
+	TableStreetElrodSt                      TableStreet = `Elrod St`
+	TableStreetEmberWay                     TableStreet = `Ember Way`
+	TableStreetEmeraldPass                  TableStreet = `Emerald Pass`
+	TableStreetEmeraldRun                   TableStreet = `Emerald Run`
+	TableStreetEmeraldSpringsCt             TableStreet = `Emerald Springs Ct`
+	TableStreetEmeraldSpringsDr             TableStreet = `Emerald Springs Dr`
+	TableStreetEmeraldSpringsWay            TableStreet = `Emerald Springs Way`
+	TableStreetEmeraldTrl                   TableStreet = `Emerald Trl`
+	TableStreetEngineerLn                   TableStreet = `Engineer Ln`
+	TableStreetEnglishTurn                  TableStreet = `English Turn`
+	TableStreetEnonRidgeRd                  TableStreet = `Enon Ridge Rd`
+	TableStreetEnterpriseDr                 TableStreet = `Enterprise Dr`
+	TableStreetEquestrianWay                TableStreet = `Equestrian Way`
+	TableStreetEstateDr                     TableStreet = `Estate Dr`
+	TableStreetEstatesRdg                   TableStreet = `Estates Rdg`
+	TableStreetEtowahCt                     TableStreet = `Etowah Ct`
+	TableStreetEtowahDr                     TableStreet = `Etowah Dr`
+	TableStreetEtowahLn                     TableStreet = `Etowah Ln`
+	TableStreetEtowahRidgeDr                TableStreet = `Etowah Ridge Dr`
+	TableStreetEtowahRidgeOvlk              TableStreet = `Etowah Ridge Ovlk`
+	TableStreetEtowahRidgeTrl               TableStreet = `Etowah Ridge Trl`
+	TableStreetEuharleeFiveForksRd          TableStreet = `Euharlee Five Forks Rd`
+	TableStreetEuharleeRd                   TableStreet = `Euharlee Rd`
+	TableStreetEuharleeSt                   TableStreet = `Euharlee St`
+	TableStreetEvansHightowerRd             TableStreet = `Evans Hightower Rd`
+	TableStreetEvansRd                      TableStreet = `Evans Rd`
+	TableStreetEveningTrl                   TableStreet = `Evening Trl`
+	TableStreetEverestDr                    TableStreet = `Everest Dr`
+	TableStreetEverettCir                   TableStreet = `Everett Cir`
+	TableStreetEvergreenTrl                 TableStreet = `Evergreen Trl`
+	TableStreetEzellRd                      TableStreet = `Ezell Rd`
+	TableStreetFairfieldCt                  TableStreet = `Fairfield Ct`
+	TableStreetFairfieldDr                  TableStreet = `Fairfield Dr`
+	TableStreetFairfieldLn                  TableStreet = `Fairfield Ln`
+	TableStreetFairfieldWay                 TableStreet = `Fairfield Way`
+	TableStreetFairmountRd                  TableStreet = `Fairmount Rd`
+	TableStreetFairviewChurchRd             TableStreet = `Fairview Church Rd`
+	TableStreetFairviewCir                  TableStreet = `Fairview Cir`
+	TableStreetFairviewDr                   TableStreet = `Fairview Dr`
+	TableStreetFairviewSt                   TableStreet = `Fairview St`
+	TableStreetFaithLn                      TableStreet = `Faith Ln`
+	TableStreetFalconCir                    TableStreet = `Falcon Cir`
+	TableStreetFallDr                       TableStreet = `Fall Dr`
+	TableStreetFallingSpringsRd             TableStreet = `Falling Springs Rd`
+	TableStreetFarmRd                       TableStreet = `Farm Rd`
+	TableStreetFarmbrookDr                  TableStreet = `Farmbrook Dr`
+	TableStreetFarmerRd                     TableStreet = `Farmer Rd`
+	TableStreetFarmsteadCt                  TableStreet = `Farmstead Ct`
+	TableStreetFarrCir                      TableStreet = `Farr Cir`
+	TableStreetFawnLakeTrl                  TableStreet = `Fawn Lake Trl`
+	TableStreetFawnRidgeDr                  TableStreet = `Fawn Ridge Dr`
+	TableStreetFawnView                     TableStreet = `Fawn View`
+	TableStreetFeltonPl                     TableStreet = `Felton Pl`
+	TableStreetFeltonSt                     TableStreet = `Felton St`
+	TableStreetFergusonDr                   TableStreet = `Ferguson Dr`
+	TableStreetFerryViews                   TableStreet = `Ferry Views`
+	TableStreetFieldsRd                     TableStreet = `Fields Rd`
+	TableStreetFieldstoneCt                 TableStreet = `Fieldstone Ct`
+	TableStreetFieldstonePath               TableStreet = `Fieldstone Path`
+	TableStreetFieldwoodDr                  TableStreet = `Fieldwood Dr`
+	TableStreetFinchCt                      TableStreet = `Finch Ct`
+	TableStreetFindleyRd                    TableStreet = `Findley Rd`
+	TableStreetFinleySt                     TableStreet = `Finley St`
+	TableStreetFireTowerRd                  TableStreet = `Fire Tower Rd`
+	TableStreetFiresideCt                   TableStreet = `Fireside Ct`
+	TableStreetFiteSt                       TableStreet = `Fite St`
+	TableStreetFiveForksRd                  TableStreet = `Five Forks Rd`
+	TableStreetFlagstoneCt                  TableStreet = `Flagstone Ct`
+	TableStreetFlintHillRd                  TableStreet = `Flint Hill Rd`
+	TableStreetFloralDr                     TableStreet = `Floral Dr`
+	TableStreetFlorenceDr                   TableStreet = `Florence Dr`
+	TableStreetFloridaAve                   TableStreet = `Florida Ave`
+	TableStreetFloydCreekChurchRd           TableStreet = `Floyd Creek Church Rd`
+	TableStreetFloydRd                      TableStreet = `Floyd Rd`
+	TableStreetFolsomGladeRd                TableStreet = `Folsom Glade Rd`
+	TableStreetFolsomRd                     TableStreet = `Folsom Rd`
+	TableStreetFoolsGoldRd                  TableStreet = `Fools Gold Rd`
+	TableStreetFoothillsPkwy                TableStreet = `Foothills Pkwy`
+	TableStreetFordSt                       TableStreet = `Ford St`
+	TableStreetForestHillDr                 TableStreet = `Forest Hill Dr`
+	TableStreetForestTrl                    TableStreet = `Forest Trl`
+	TableStreetForrestAve                   TableStreet = `Forrest Ave`
+	TableStreetForrestHillDr                TableStreet = `Forrest Hill Dr`
+	TableStreetForrestLn                    TableStreet = `Forrest Ln`
+	TableStreetFossettsCv                   TableStreet = `Fossetts Cv`
+	TableStreetFoucheCt                     TableStreet = `Fouche Ct`
+	TableStreetFoucheDr                     TableStreet = `Fouche Dr`
+	TableStreetFourFeathersLn               TableStreet = `Four Feathers Ln`
+	TableStreetFowlerDr                     TableStreet = `Fowler Dr`
+	TableStreetFowlerRd                     TableStreet = `Fowler Rd`
+	TableStreetFoxChas                      TableStreet = `Fox Chas`
+	TableStreetFoxHillDr                    TableStreet = `Fox Hill Dr`
+	TableStreetFoxMeadowCt                  TableStreet = `Fox Meadow Ct`
+	TableStreetFoxfireLn                    TableStreet = `Foxfire Ln`
+	TableStreetFoxhoundWay                  TableStreet = `Foxhound Way`
+	TableStreetFoxrunCt                     TableStreet = `Foxrun Ct`
+	TableStreetFrancesWay                   TableStreet = `Frances Way`
+	TableStreetFranklinDr                   TableStreet = `Franklin Dr`
+	TableStreetFranklinJohnsonDr            TableStreet = `Franklin Johnson Dr`
+	TableStreetFranklinLoop                 TableStreet = `Franklin Loop`
+	TableStreetFreddaLn                     TableStreet = `Fredda Ln`
+	TableStreetFreedomDr                    TableStreet = `Freedom Dr`
+	TableStreetFreemanSt                    TableStreet = `Freeman St`
+	TableStreetFrictionDr                   TableStreet = `Friction Dr`
+	TableStreetFriendlyLn                   TableStreet = `Friendly Ln`
+	TableStreetFriendshipRd                 TableStreet = `Friendship Rd`
+	TableStreetFugerLn                      TableStreet = `Fuger Ln`
+	TableStreetFunDr                        TableStreet = `Fun Dr`
+	TableStreetFunkhouserIndustrialAccessRd TableStreet = `Funkhouser Industrial Access Rd`
+	TableStreetGaddisRd                     TableStreet = `Gaddis Rd`
+	TableStreetGailSt                       TableStreet = `Gail St`
+	TableStreetGainesRd                     TableStreet = `Gaines Rd`
+	TableStreetGaitherBoggsRd               TableStreet = `Gaither Boggs Rd`
+	TableStreetGaltSt                       TableStreet = `Galt St`
+	TableStreetGalwayDr                     TableStreet = `Galway Dr`
+	TableStreetGardenGate                   TableStreet = `Garden Gate`
+	TableStreetGarlandNo1Rd                 TableStreet = `Garland No 1 Rd`
+	TableStreetGarlandNo2Rd                 TableStreet = `Garland No 2 Rd`
+	TableStreetGarrisonDr                   TableStreet = `Garrison Dr`
+	TableStreetGastonWestbrookAve           TableStreet = `Gaston Westbrook Ave`
+	TableStreetGatepostLn                   TableStreet = `Gatepost Ln`
+	TableStreetGatlinRd                     TableStreet = `Gatlin Rd`
+	TableStreetGayleDr                      TableStreet = `Gayle Dr`
+	TableStreetGenevaLn                     TableStreet = `Geneva Ln`
+	TableStreetGentillyBlvd                 TableStreet = `Gentilly Blvd`
+	TableStreetGentryDr                     TableStreet = `Gentry Dr`
+	TableStreetGeorgeSt                     TableStreet = `George St`
+	TableStreetGeorgiaAve                   TableStreet = `Georgia Ave`
+	TableStreetGeorgiaBlvd                  TableStreet = `Georgia Blvd`
+	TableStreetGeorgiaNorthCir              TableStreet = `Georgia North Cir`
+	TableStreetGeorgiaNorthIndustrialRd     TableStreet = `Georgia North Industrial Rd`
+	TableStreetGertrudeLn                   TableStreet = `Gertrude Ln`
+	TableStreetGibbonsRd                    TableStreet = `Gibbons Rd`
+	TableStreetGillRd                       TableStreet = `Gill Rd`
+	TableStreetGilliamsXing                 TableStreet = `Gilliams Xing`
+	TableStreetGilmerSt                     TableStreet = `Gilmer St`
+	TableStreetGilreathRd                   TableStreet = `Gilreath Rd`
+	TableStreetGilreathTrl                  TableStreet = `Gilreath Trl`
+	TableStreetGladeRd                      TableStreet = `Glade Rd`
+	TableStreetGlenCoveDr                   TableStreet = `Glen Cove Dr`
+	TableStreetGlenabbyDr                   TableStreet = `Glenabby Dr`
+	TableStreetGlenmarAve                   TableStreet = `Glenmar Ave`
+	TableStreetGlenmauraWay                 TableStreet = `Glenmaura Way`
+	TableStreetGlenmoreDr                   TableStreet = `Glenmore Dr`
+	TableStreetGlennDr                      TableStreet = `Glenn Dr`
+	TableStreetGloryLn                      TableStreet = `Glory Ln`
+	TableStreetGoldCreekTrl                 TableStreet = `Gold Creek Trl`
+	TableStreetGoldHillDr                   TableStreet = `Gold Hill Dr`
+	TableStreetGoldenEagleDr                TableStreet = `Golden Eagle Dr`
+	TableStreetGoodeDr                      TableStreet = `Goode Dr`
+	TableStreetGoodsonCemetaryRd            TableStreet = `Goodson Cemetary Rd`
+	TableStreetGoodwinSt                    TableStreet = `Goodwin St`
+	TableStreetGoodyearAve                  TableStreet = `Goodyear Ave`
+	TableStreetGordonMcCormickRd            TableStreet = `Gordon McCormick Rd`
+	TableStreetGordonRd                     TableStreet = `Gordon Rd`
+	TableStreetGoreRd                       TableStreet = `Gore Rd`
+	TableStreetGoreSpringsRd                TableStreet = `Gore Springs Rd`
+	TableStreetGossSt                       TableStreet = `Goss St`
+	TableStreetGovernorsCt                  TableStreet = `Governors Ct`
+	TableStreetGraceParkDr                  TableStreet = `Grace Park Dr`
+	TableStreetGraceSt                      TableStreet = `Grace St`
+	TableStreetGrandMainSt                  TableStreet = `Grand Main St`
+	TableStreetGrandviewCt                  TableStreet = `Grandview Ct`
+	TableStreetGrandviewDr                  TableStreet = `Grandview Dr`
+	TableStreetGrangerDr                    TableStreet = `Granger Dr`
+	TableStreetGrantDr                      TableStreet = `Grant Dr`
+	TableStreetGrapevineWay                 TableStreet = `Grapevine Way`
+	TableStreetGrassdaleRd                  TableStreet = `Grassdale Rd`
+	TableStreetGrayRd                       TableStreet = `Gray Rd`
+	TableStreetGraySt                       TableStreet = `Gray St`
+	TableStreetGraysburgDr                  TableStreet = `Graysburg Dr`
+	TableStreetGraystoneDr                  TableStreet = `Graystone Dr`
+	TableStreetGreatwoodDr                  TableStreet = `Greatwood Dr`
+	TableStreetGreenAcreLn                  TableStreet = `Green Acre Ln`
+	TableStreetGreenAppleCt                 TableStreet = `Green Apple Ct`
+	TableStreetGreenGablePt                 TableStreet = `Green Gable Pt`
+	TableStreetGreenIvesLn                  TableStreet = `Green Ives Ln`
+	TableStreetGreenLoopRd                  TableStreet = `Green Loop Rd`
+	TableStreetGreenRd                      TableStreet = `Green Rd`
+	TableStreetGreenSt                      TableStreet = `Green St`
+	TableStreetGreenValleyTrl               TableStreet = `Green Valley Trl`
+	TableStreetGreenbriarAve                TableStreet = `Greenbriar Ave`
+	TableStreetGreencliffWay                TableStreet = `Greencliff Way`
+	TableStreetGreenhouseDr                 TableStreet = `Greenhouse Dr`
+	TableStreetGreenmontCt                  TableStreet = `Greenmont Ct`
+	TableStreetGreenridgeRd                 TableStreet = `Greenridge Rd`
+	TableStreetGreenridgeTrl                TableStreet = `Greenridge Trl`
+	TableStreetGreenwayLn                   TableStreet = `Greenway Ln`
+	TableStreetGreenwoodDr                  TableStreet = `Greenwood Dr`
+	TableStreetGreyrock                     TableStreet = `Greyrock`
+	TableStreetGreystoneLn                  TableStreet = `Greystone Ln`
+	TableStreetGreystoneWay                 TableStreet = `Greystone Way`
+	TableStreetGreywoodLn                   TableStreet = `Greywood Ln`
+	TableStreetGriffinMillDr                TableStreet = `Griffin Mill Dr`
+	TableStreetGriffinRd                    TableStreet = `Griffin Rd`
+	TableStreetGrimshawRd                   TableStreet = `Grimshaw Rd`
+	TableStreetGristMillLn                  TableStreet = `Grist Mill Ln`
+	TableStreetGristmillParish              TableStreet = `Gristmill Parish`
+	TableStreetGroganRd                     TableStreet = `Grogan Rd`
+	TableStreetGrooversLandingRd            TableStreet = `Groovers Landing Rd`
+	TableStreetGroveCir                     TableStreet = `Grove Cir`
+	TableStreetGroveParkCir                 TableStreet = `Grove Park Cir`
+	TableStreetGrovePointeWay               TableStreet = `Grove Pointe Way`
+	TableStreetGroveSpringsCt               TableStreet = `Grove Springs Ct`
+	TableStreetGroveWay                     TableStreet = `Grove Way`
+	TableStreetGroverRd                     TableStreet = `Grover Rd`
+	TableStreetGuestHoll                    TableStreet = `Guest Holl`
+	TableStreetGumDr                        TableStreet = `Gum Dr`
+	TableStreetGumSpringsLn                 TableStreet = `Gum Springs Ln`
+	TableStreetGunstonHall                  TableStreet = `Gunston Hall`
+	TableStreetGuytonIndustrialDr           TableStreet = `Guyton Industrial Dr`
+	TableStreetGuytonSt                     TableStreet = `Guyton St`
+	TableStreetHabershamCir                 TableStreet = `Habersham Cir`
+	TableStreetHaleyPl                      TableStreet = `Haley Pl`
+	TableStreetHallSt                       TableStreet = `Hall St`
+	TableStreetHallStationRd                TableStreet = `Hall Station Rd`
+	TableStreetHamesPte                     TableStreet = `Hames Pte`
+	TableStreetHamilCt                      TableStreet = `Hamil Ct`
+	TableStreetHamiltonBlvd                 TableStreet = `Hamilton Blvd`
+	TableStreetHamiltonCrossingRd           TableStreet = `Hamilton Crossing Rd`
+	TableStreetHamptonDr                    TableStreet = `Hampton Dr`
+	TableStreetHamptonLn                    TableStreet = `Hampton Ln`
+	TableStreetHaneyHollowWay               TableStreet = `Haney Hollow Way`
+	TableStreetHannonWay                    TableStreet = `Hannon Way`
+	TableStreetHappyHollowRd                TableStreet = `Happy Hollow Rd`
+	TableStreetHappyValleyLn                TableStreet = `Happy Valley Ln`
+	TableStreetHarborDr                     TableStreet = `Harbor Dr`
+	TableStreetHarborLn                     TableStreet = `Harbor Ln`
+	TableStreetHardinBridgeRd               TableStreet = `Hardin Bridge Rd`
+	TableStreetHardinRd                     TableStreet = `Hardin Rd`
+	TableStreetHardwoodCt                   TableStreet = `Hardwood Ct`
+	TableStreetHardwoodRidgeDr              TableStreet = `Hardwood Ridge Dr`
+	TableStreetHardwoodRidgePkwy            TableStreet = `Hardwood Ridge Pkwy`
+	TableStreetHardyRd                      TableStreet = `Hardy Rd`
+	TableStreetHargisWay                    TableStreet = `Hargis Way`
+	TableStreetHarrisRd                     TableStreet = `Harris Rd`
+	TableStreetHarrisSt                     TableStreet = `Harris St`
+	TableStreetHarrisonRd                   TableStreet = `Harrison Rd`
+	TableStreetHarvestWay                   TableStreet = `Harvest Way`
+	TableStreetHarveyKnightRd               TableStreet = `Harvey Knight Rd`
+	TableStreetHastingsDr                   TableStreet = `Hastings Dr`
+	TableStreetHatfieldRd                   TableStreet = `Hatfield Rd`
+	TableStreetHattieSt                     TableStreet = `Hattie St`
+	TableStreetHawkRd                       TableStreet = `Hawk Rd`
+	TableStreetHawkinsRd                    TableStreet = `Hawkins Rd`
+	TableStreetHawksBranchLn                TableStreet = `Hawks Branch Ln`
+	TableStreetHawksFarmRd                  TableStreet = `Hawks Farm Rd`
+	TableStreetHawkstoneCt                  TableStreet = `Hawkstone Ct`
+	TableStreetHawthorneDr                  TableStreet = `Hawthorne Dr`
+	TableStreetHayesCemetaryLoop            TableStreet = `Hayes Cemetary Loop`
+	TableStreetHazelwoodRd                  TableStreet = `Hazelwood Rd`
+	TableStreetHeaddenRdg                   TableStreet = `Headden Rdg`
+	TableStreetHeartwoodDr                  TableStreet = `Heartwood Dr`
+	TableStreetHeatcoCt                     TableStreet = `Heatco Ct`
+	TableStreetHedgerowCt                   TableStreet = `Hedgerow Ct`
+	TableStreetHeidelbergLn                 TableStreet = `Heidelberg Ln`
+	TableStreetHelenLn                      TableStreet = `Helen Ln`
+	TableStreetHemlockPl                    TableStreet = `Hemlock Pl`
+	TableStreetHendersonDr                  TableStreet = `Henderson Dr`
+	TableStreetHendersonRd                  TableStreet = `Henderson Rd`
+	TableStreetHendricksRd                  TableStreet = `Hendricks Rd`
+	TableStreetHendricksSt                  TableStreet = `Hendricks St`
+	TableStreetHenryMackHillRd              TableStreet = `Henry Mack Hill Rd`
+	TableStreetHepworthLn                   TableStreet = `Hepworth Ln`
+	TableStreetHeritageAve                  TableStreet = `Heritage Ave`
+	TableStreetHeritageCv                   TableStreet = `Heritage Cv`
+	TableStreetHeritageDr                   TableStreet = `Heritage Dr`
+	TableStreetHeritageWay                  TableStreet = `Heritage Way`
+	TableStreetHerringSt                    TableStreet = `Herring St`
+	TableStreetHickoryForestLn              TableStreet = `Hickory Forest Ln`
+	TableStreetHickoryHillDr                TableStreet = `Hickory Hill Dr`
+	TableStreetHickoryHoll                  TableStreet = `Hickory Holl`
+	TableStreetHickoryLn                    TableStreet = `Hickory Ln`
+	TableStreetHiddenValleyLn               TableStreet = `Hidden Valley Ln`
+	TableStreetHighCt                       TableStreet = `High Ct`
+	TableStreetHighMoonSt                   TableStreet = `High Moon St`
+	TableStreetHighPointCt                  TableStreet = `High Point Ct`
+	TableStreetHighPointeDr                 TableStreet = `High Pointe Dr`
+	TableStreetHighlandCir                  TableStreet = `Highland Cir`
+	TableStreetHighlandCrestDr              TableStreet = `Highland Crest Dr`
+	TableStreetHighlandDr                   TableStreet = `Highland Dr`
+	TableStreetHighlandLn                   TableStreet = `Highland Ln`
+	TableStreetHighlandTrl                  TableStreet = `Highland Trl`
+	TableStreetHighlandWay                  TableStreet = `Highland Way`
+	TableStreetHighpineDr                   TableStreet = `Highpine Dr`
+	TableStreetHillSt                       TableStreet = `Hill St`
+	TableStreetHillsideDr                   TableStreet = `Hillside Dr`
+	TableStreetHillstoneWay                 TableStreet = `Hillstone Way`
+	TableStreetHilltopCt                    TableStreet = `Hilltop Ct`
+	TableStreetHilltopDr                    TableStreet = `Hilltop Dr`
+	TableStreetHistorySt                    TableStreet = `History St`
+	TableStreetHitchcockSta                 TableStreet = `Hitchcock Sta`
+	TableStreetHobgoodRd                    TableStreet = `Hobgood Rd`
+	TableStreetHodgesMineRd                 TableStreet = `Hodges Mine Rd`
+	TableStreetHolcombRd                    TableStreet = `Holcomb Rd`
+	TableStreetHolcombSpur                  TableStreet = `Holcomb Spur`
+	TableStreetHolcombTrl                   TableStreet = `Holcomb Trl`
+	TableStreetHollowayRd                   TableStreet = `Holloway Rd`
+	TableStreetHollowsDr                    TableStreet = `Hollows Dr`
+	TableStreetHollyAnnSt                   TableStreet = `Holly Ann St`
+	TableStreetHollyDr                      TableStreet = `Holly Dr`
+	TableStreetHollySpringsRd               TableStreet = `Holly Springs Rd`
+	TableStreetHollyhockLn                  TableStreet = `Hollyhock Ln`
+	TableStreetHoltRd                       TableStreet = `Holt Rd`
+	TableStreetHomePlaceDr                  TableStreet = `Home Place Dr`
+	TableStreetHomePlaceRd                  TableStreet = `Home Place Rd`
+	TableStreetHomesteadDr                  TableStreet = `Homestead Dr`
+	TableStreetHometownCt                   TableStreet = `Hometown Ct`
+	TableStreetHoneyVineTrl                 TableStreet = `Honey Vine Trl`
+	TableStreetHoneylocustCt                TableStreet = `Honeylocust Ct`
+	TableStreetHoneysuckleDr                TableStreet = `Honeysuckle Dr`
+	TableStreetHopkinsBreeze                TableStreet = `Hopkins Breeze`
+	TableStreetHopkinsFarmDr                TableStreet = `Hopkins Farm Dr`
+	TableStreetHopkinsRd                    TableStreet = `Hopkins Rd`
+	TableStreetHopsonRd                     TableStreet = `Hopson Rd`
+	TableStreetHorizonTrl                   TableStreet = `Horizon Trl`
+	TableStreetHorseshoeCt                  TableStreet = `Horseshoe Ct`
+	TableStreetHorseshoeLoop                TableStreet = `Horseshoe Loop`
+	TableStreetHorseshowRd                  TableStreet = `Horseshow Rd`
+	TableStreetHotelSt                      TableStreet = `Hotel St`
+	TableStreetHowardAve                    TableStreet = `Howard Ave`
+	TableStreetHowardDr                     TableStreet = `Howard Dr`
+	TableStreetHowardHeightsSt              TableStreet = `Howard Heights St`
+	TableStreetHowardSt                     TableStreet = `Howard St`
+	TableStreetHowellBendRd                 TableStreet = `Howell Bend Rd`
+	TableStreetHowellRd                     TableStreet = `Howell Rd`
+	TableStreetHowellSt                     TableStreet = `Howell St`
+	TableStreetHughsPl                      TableStreet = `Hughs Pl`
+	TableStreetHuntClubLn                   TableStreet = `Hunt Club Ln`
+	TableStreetHuntcliffDr                  TableStreet = `Huntcliff Dr`
+	TableStreetHuntersCv                    TableStreet = `Hunters Cv`
+	TableStreetHuntersRdg                   TableStreet = `Hunters Rdg`
+	TableStreetHuntersView                  TableStreet = `Hunters View`
+	TableStreetHuntingtonCt                 TableStreet = `Huntington Ct`
+	TableStreetHwy293                       TableStreet = `Hwy 293`
+	TableStreetHwy411                       TableStreet = `Hwy 411`
+	TableStreetHyattCt                      TableStreet = `Hyatt Ct`
+	TableStreetI75                          TableStreet = `I-75`
+	TableStreetIdlewoodDr                   TableStreet = `Idlewood Dr`
+	TableStreetImperialCt                   TableStreet = `Imperial Ct`
+	TableStreetIndependenceWay              TableStreet = `Independence Way`
+	TableStreetIndianHillsDr                TableStreet = `Indian Hills Dr`
+	TableStreetIndianHillsHght              TableStreet = `Indian Hills Hght`
+	TableStreetIndianMoundsRd               TableStreet = `Indian Mounds Rd`
+	TableStreetIndianRidgeCt                TableStreet = `Indian Ridge Ct`
+	TableStreetIndianSpringsDr              TableStreet = `Indian Springs Dr`
+	TableStreetIndianTrl                    TableStreet = `Indian Trl`
+	TableStreetIndianValleyWay              TableStreet = `Indian Valley Way`
+	TableStreetIndianWoodsDr                TableStreet = `Indian Woods Dr`
+	TableStreetIndustrialDr                 TableStreet = `Industrial Dr`
+	TableStreetIndustrialParkRd             TableStreet = `Industrial Park Rd`
+	TableStreetIngramRd                     TableStreet = `Ingram Rd`
+	TableStreetIronBeltCt                   TableStreet = `Iron Belt Ct`
+	TableStreetIronBeltRd                   TableStreet = `Iron Belt Rd`
+	TableStreetIronHillCv                   TableStreet = `Iron Hill Cv`
+	TableStreetIronHillRd                   TableStreet = `Iron Hill Rd`
+	TableStreetIronMountainRd               TableStreet = `Iron Mountain Rd`
+	TableStreetIrwinSt                      TableStreet = `Irwin St`
+	TableStreetIsabellaCt                   TableStreet = `Isabella Ct`
+	TableStreetIslandFordRd                 TableStreet = `Island Ford Rd`
+	TableStreetIslandMillRd                 TableStreet = `Island Mill Rd`
+	TableStreetIvanEvansRd                  TableStreet = `Ivan Evans Rd`
+	TableStreetIvanhoePl                    TableStreet = `Ivanhoe Pl`
+	TableStreetIvyChaseWay                  TableStreet = `Ivy Chase Way`
+	TableStreetIvyLn                        TableStreet = `Ivy Ln`
+	TableStreetIvyStoneCt                   TableStreet = `Ivy Stone Ct`
+	TableStreetJRRd                         TableStreet = `J R Rd`
+	TableStreetJacksonDr                    TableStreet = `Jackson Dr`
+	TableStreetJacksonPl                    TableStreet = `Jackson Pl`
+	TableStreetJacksonRd                    TableStreet = `Jackson Rd`
+	TableStreetJacksonRow                   TableStreet = `Jackson Row`
+	TableStreetJacksonSt                    TableStreet = `Jackson St`
+	TableStreetJacobDr                      TableStreet = `Jacob Dr`
+	TableStreetJacobsRd                     TableStreet = `Jacobs Rd`
+	TableStreetJamesAve                     TableStreet = `James Ave`
+	TableStreetJamesPlace                   TableStreet = `James Place`
+	TableStreetJamesRd                      TableStreet = `James Rd`
+	TableStreetJamesSt                      TableStreet = `James St`
+	TableStreetJamesportLn                  TableStreet = `Jamesport Ln`
+	TableStreetJaniceDr                     TableStreet = `Janice Dr`
+	TableStreetJaniceLn                     TableStreet = `Janice Ln`
+	TableStreetJarrettLoop                  TableStreet = `Jarrett Loop`
+	TableStreetJasmineLn                    TableStreet = `Jasmine Ln`
+	TableStreetJazminSq                     TableStreet = `Jazmin Sq`
+	TableStreetJefferyLn                    TableStreet = `Jeffery Ln`
+	TableStreetJefferyRd                    TableStreet = `Jeffery Rd`
+	TableStreetJenkinsDr                    TableStreet = `Jenkins Dr`
+	TableStreetJenniferLn                   TableStreet = `Jennifer Ln`
+	TableStreetJennyLn                      TableStreet = `Jenny Ln`
+	TableStreetJewellRd                     TableStreet = `Jewell Rd`
+	TableStreetJillLn                       TableStreet = `Jill Ln`
+	TableStreetJimDr                        TableStreet = `Jim Dr`
+	TableStreetJimKnightRd                  TableStreet = `Jim Knight Rd`
+	TableStreetJimLn                        TableStreet = `Jim Ln`
+	TableStreetJimmieNelsonRd               TableStreet = `Jimmie Nelson Rd`
+	TableStreetJockeyWay                    TableStreet = `Jockey Way`
+	TableStreetJoeFrankHarrisPkwy           TableStreet = `Joe Frank Harris Pkwy`
+	TableStreetJoeLeeRd                     TableStreet = `Joe Lee Rd`
+	TableStreetJoeStellaDr                  TableStreet = `Joe Stella Dr`
+	TableStreetJohnHuskinsSt                TableStreet = `John Huskins St`
+	TableStreetJohnKayRd                    TableStreet = `John Kay Rd`
+	TableStreetJohnsonCove                  TableStreet = `Johnson Cove`
+	TableStreetJohnsonDr                    TableStreet = `Johnson Dr`
+	TableStreetJohnsonLn                    TableStreet = `Johnson Ln`
+	TableStreetJohnsonMountainRd            TableStreet = `Johnson Mountain Rd`
+	TableStreetJohnsonSt                    TableStreet = `Johnson St`
+	TableStreetJohnstonRow                  TableStreet = `Johnston Row`
+	TableStreetJollyCir                     TableStreet = `Jolly Cir`
+	TableStreetJonesCt                      TableStreet = `Jones Ct`
+	TableStreetJonesMillPl                  TableStreet = `Jones Mill Pl`
+	TableStreetJonesMillRd                  TableStreet = `Jones Mill Rd`
+	TableStreetJonesRd                      TableStreet = `Jones Rd`
+	TableStreetJonesSloughRd                TableStreet = `Jones Slough Rd`
+	TableStreetJonesSt                      TableStreet = `Jones St`
+	TableStreetJordanRd                     TableStreet = `Jordan Rd`
+	TableStreetJordanSt                     TableStreet = `Jordan St`
+	TableStreetJoreeRd                      TableStreet = `Joree Rd`
+	TableStreetJosephineAve                 TableStreet = `Josephine Ave`
+	TableStreetJudeDr                       TableStreet = `Jude Dr`
+	TableStreetJudithAve                    TableStreet = `Judith Ave`
+	TableStreetJulianaWay                   TableStreet = `Juliana Way`
+	TableStreetJuniperLn                    TableStreet = `Juniper Ln`
+	TableStreetJustineRd                    TableStreet = `Justine Rd`
+	TableStreetKakkiCt                      TableStreet = `Kakki Ct`
+	TableStreetKaleighCt                    TableStreet = `Kaleigh Ct`
+	TableStreetKatieDr                      TableStreet = `Katie Dr`
+	TableStreetKatieRdg                     TableStreet = `Katie Rdg`
+	TableStreetKatrinaDr                    TableStreet = `Katrina Dr`
+	TableStreetKayRd                        TableStreet = `Kay Rd`
+	TableStreetKaylaCt                      TableStreet = `Kayla Ct`
+	TableStreetKeelingLakeRd                TableStreet = `Keeling Lake Rd`
+	TableStreetKeelingMountainRd            TableStreet = `Keeling Mountain Rd`
+	TableStreetKeithRd                      TableStreet = `Keith Rd`
+	TableStreetKelleyTrl                    TableStreet = `Kelley Trl`
+	TableStreetKelliClarkCt                 TableStreet = `Kelli Clark Ct`
+	TableStreetKelloggCir                   TableStreet = `Kellogg Cir`
+	TableStreetKellyDr                      TableStreet = `Kelly Dr`
+	TableStreetKenSt                        TableStreet = `Ken St`
+	TableStreetKentDr                       TableStreet = `Kent Dr`
+	TableStreetKentuckyAve                  TableStreet = `Kentucky Ave`
+	TableStreetKentuckyWalk                 TableStreet = `Kentucky Walk`
+	TableStreetKenwoodLn                    TableStreet = `Kenwood Ln`
+	TableStreetKerrySt                      TableStreet = `Kerry St`
+	TableStreetKimberlyDr                   TableStreet = `Kimberly Dr`
+	TableStreetKimseyCir                    TableStreet = `Kimsey Cir`
+	TableStreetKincannonRd                  TableStreet = `Kincannon Rd`
+	TableStreetKingRd                       TableStreet = `King Rd`
+	TableStreetKingSt                       TableStreet = `King St`
+	TableStreetKingsCampRd                  TableStreet = `Kings Camp Rd`
+	TableStreetKingsDr                      TableStreet = `Kings Dr`
+	TableStreetKingstonHwy                  TableStreet = `Kingston Hwy`
+	TableStreetKingstonPointeDr             TableStreet = `Kingston Pointe Dr`
+	TableStreetKiowaCt                      TableStreet = `Kiowa Ct`
+	TableStreetKirbyCt                      TableStreet = `Kirby Ct`
+	TableStreetKirbyDr                      TableStreet = `Kirby Dr`
+	TableStreetKirkRd                       TableStreet = `Kirk Rd`
+	TableStreetKitchenMountainRd            TableStreet = `Kitchen Mountain Rd`
+	TableStreetKitchensAll                  TableStreet = `Kitchens All`
+	TableStreetKnightDr                     TableStreet = `Knight Dr`
+	TableStreetKnightSt                     TableStreet = `Knight St`
+	TableStreetKnightWay                    TableStreet = `Knight Way`
+	TableStreetKnollwoodWay                 TableStreet = `Knollwood Way`
+	TableStreetKnucklesvilleRd              TableStreet = `Knucklesville Rd`
+	TableStreetKooweskooweBlvd              TableStreet = `Kooweskoowe Blvd`
+	TableStreetKortlynPl                    TableStreet = `Kortlyn Pl`
+	TableStreetKovellsDr                    TableStreet = `Kovells Dr`
+	TableStreetKristenCt                    TableStreet = `Kristen Ct`
+	TableStreetKristenLn                    TableStreet = `Kristen Ln`
+	TableStreetKuhlmanSt                    TableStreet = `Kuhlman St`
+	TableStreetLaceyRd                      TableStreet = `Lacey Rd`
+	TableStreetLaddsMountainRd              TableStreet = `Ladds Mountain Rd`
+	TableStreetLakeCt                       TableStreet = `Lake Ct`
+	TableStreetLakeDr                       TableStreet = `Lake Dr`
+	TableStreetLakeDriveNo1                 TableStreet = `Lake Drive No 1`
+	TableStreetLakeEagleCt                  TableStreet = `Lake Eagle Ct`
+	TableStreetLakeHavenDr                  TableStreet = `Lake Haven Dr`
+	TableStreetLakeMargueriteRd             TableStreet = `Lake Marguerite Rd`
+	TableStreetLakeOverlookDr               TableStreet = `Lake Overlook Dr`
+	TableStreetLakePointDr                  TableStreet = `Lake Point Dr`
+	TableStreetLakeTopDr                    TableStreet = `Lake Top Dr`
+	TableStreetLakeport                     TableStreet = `Lakeport`
+	TableStreetLakeshoreCir                 TableStreet = `Lakeshore Cir`
+	TableStreetLakesideTrl                  TableStreet = `Lakeside Trl`
+	TableStreetLakesideWay                  TableStreet = `Lakeside Way`
+	TableStreetLakeviewCt                   TableStreet = `Lakeview Ct`
+	TableStreetLakeviewDriveNo1             TableStreet = `Lakeview Drive No 1`
+	TableStreetLakeviewDriveNo2             TableStreet = `Lakeview Drive No 2`
+	TableStreetLakeviewDriveNo3             TableStreet = `Lakeview Drive No 3`
+	TableStreetLakewoodCt                   TableStreet = `Lakewood Ct`
+	TableStreetLamplighterCv                TableStreet = `Lamplighter Cv`
+	TableStreetLancelotCt                   TableStreet = `Lancelot Ct`
+	TableStreetLancerCt                     TableStreet = `Lancer Ct`
+	TableStreetLandersDr                    TableStreet = `Landers Dr`
+	TableStreetLandersRd                    TableStreet = `Landers Rd`
+	TableStreetLanhamFieldRd                TableStreet = `Lanham Field Rd`
+	TableStreetLanhamRd                     TableStreet = `Lanham Rd`
+	TableStreetLanierDr                     TableStreet = `Lanier Dr`
+	TableStreetLanternCir                   TableStreet = `Lantern Cir`
+	TableStreetLanternLightTrl              TableStreet = `Lantern Light Trl`
+	TableStreetLarkCir                      TableStreet = `Lark Cir`
+	TableStreetLarkspurLn                   TableStreet = `Larkspur Ln`
+	TableStreetLarkspurRd                   TableStreet = `Larkspur Rd`
+	TableStreetLarkwoodCir                  TableStreet = `Larkwood Cir`
+	TableStreetLarsenRdg                    TableStreet = `Larsen Rdg`
+	TableStreetLatimerLn                    TableStreet = `Latimer Ln`
+	TableStreetLatimerRd                    TableStreet = `Latimer Rd`
+	TableStreetLauraDr                      TableStreet = `Laura Dr`
+	TableStreetLaurelCv                     TableStreet = `Laurel Cv`
+	TableStreetLaurelDr                     TableStreet = `Laurel Dr`
+	TableStreetLaurelTrc                    TableStreet = `Laurel Trc`
+	TableStreetLaurelWay                    TableStreet = `Laurel Way`
+	TableStreetLaurelwoodLn                 TableStreet = `Laurelwood Ln`
+	TableStreetLaurenLn                     TableStreet = `Lauren Ln`
+	TableStreetLavanesWay                   TableStreet = `Lavanes Way`
+	TableStreetLawRd                        TableStreet = `Law Rd`
+	TableStreetLawrenceSt                   TableStreet = `Lawrence St`
+	TableStreetLazyWaterDr                  TableStreet = `Lazy Water Dr`
+	TableStreetLeadMountainRd               TableStreet = `Lead Mountain Rd`
+	TableStreetLeakeSt                      TableStreet = `Leake St`
+	TableStreetLedfordLn                    TableStreet = `Ledford Ln`
+	TableStreetLeeLn                        TableStreet = `Lee Ln`
+	TableStreetLeeRd                        TableStreet = `Lee Rd`
+	TableStreetLeeSt                        TableStreet = `Lee St`
+	TableStreetLegacyWay                    TableStreet = `Legacy Way`
+	TableStreetLegionSt                     TableStreet = `Legion St`
+	TableStreetLeilaCv                      TableStreet = `Leila Cv`
+	TableStreetLennoxParkAve                TableStreet = `Lennox Park Ave`
+	TableStreetLenoxDr                      TableStreet = `Lenox Dr`
+	TableStreetLeonardDr                    TableStreet = `Leonard Dr`
+	TableStreetLeslieCv                     TableStreet = `Leslie Cv`
+	TableStreetLewisDr                      TableStreet = `Lewis Dr`
+	TableStreetLewisFarmRd                  TableStreet = `Lewis Farm Rd`
+	TableStreetLewisRd                      TableStreet = `Lewis Rd`
+	TableStreetLewisWay                     TableStreet = `Lewis Way`
+	TableStreetLexingtonCt                  TableStreet = `Lexington Ct`
+	TableStreetLexyWay                      TableStreet = `Lexy Way`
+	TableStreetLibertyCrossingDr            TableStreet = `Liberty Crossing Dr`
+	TableStreetLibertyDr                    TableStreet = `Liberty Dr`
+	TableStreetLibertySquareDr              TableStreet = `Liberty Square Dr`
+	TableStreetLilyWay                      TableStreet = `Lily Way`
+	TableStreetLimerickCt                   TableStreet = `Limerick Ct`
+	TableStreetLindaRd                      TableStreet = `Linda Rd`
+	TableStreetLindseyDr                    TableStreet = `Lindsey Dr`
+	TableStreetLingerfeltLn                 TableStreet = `Lingerfelt Ln`
+	TableStreetLinwoodRd                    TableStreet = `Linwood Rd`
+	TableStreetLipscombCir                  TableStreet = `Lipscomb Cir`
+	TableStreetLitchfieldSt                 TableStreet = `Litchfield St`
+	TableStreetLittleGateWay                TableStreet = `Little Gate Way`
+	TableStreetLittleJohnLn                 TableStreet = `Little John Ln`
+	TableStreetLittleJohnTrl                TableStreet = `Little John Trl`
+	TableStreetLittleSt                     TableStreet = `Little St`
+	TableStreetLittleValleyRd               TableStreet = `Little Valley Rd`
+	TableStreetLittlefieldRd                TableStreet = `Littlefield Rd`
+	TableStreetLiveOakRun                   TableStreet = `Live Oak Run`
+	TableStreetLivseyRd                     TableStreet = `Livsey Rd`
+	TableStreetLodgeRd                      TableStreet = `Lodge Rd`
+	TableStreetLoisLn                       TableStreet = `Lois Ln`
+	TableStreetLoisRd                       TableStreet = `Lois Rd`
+	TableStreetLondonCt                     TableStreet = `London Ct`
+	TableStreetLondonderryWay               TableStreet = `Londonderry Way`
+	TableStreetLongBranchCt                 TableStreet = `Long Branch Ct`
+	TableStreetLongRd                       TableStreet = `Long Rd`
+	TableStreetLongTrl                      TableStreet = `Long Trl`
+	TableStreetLongviewPte                  TableStreet = `Longview Pte`
+	TableStreetLovellSt                     TableStreet = `Lovell St`
+	TableStreetLowCt                        TableStreet = `Low Ct`
+	TableStreetLoweryRd                     TableStreet = `Lowery Rd`
+	TableStreetLowryWay                     TableStreet = `Lowry Way`
+	TableStreetLucasLn                      TableStreet = `Lucas Ln`
+	TableStreetLucasRd                      TableStreet = `Lucas Rd`
+	TableStreetLucilleRd                    TableStreet = `Lucille Rd`
+	TableStreetLuckieSt                     TableStreet = `Luckie St`
+	TableStreetLukeLn                       TableStreet = `Luke Ln`
+	TableStreetLumpkinDr                    TableStreet = `Lumpkin Dr`
+	TableStreetLuskAve                      TableStreet = `Lusk Ave`
+	TableStreetLutherKnightRd               TableStreet = `Luther Knight Rd`
+	TableStreetLuwandaTrl                   TableStreet = `Luwanda Trl`
+	TableStreetLynchSt                      TableStreet = `Lynch St`
+	TableStreetMacJohnsonRd                 TableStreet = `Mac Johnson Rd`
+	TableStreetMacedoniaCemetaryRd          TableStreet = `Macedonia Cemetary Rd`
+	TableStreetMacedoniaRd                  TableStreet = `Macedonia Rd`
+	TableStreetMacraDr                      TableStreet = `Macra Dr`
+	TableStreetMaddenRd                     TableStreet = `Madden Rd`
+	TableStreetMaddenSt                     TableStreet = `Madden St`
+	TableStreetMaddoxRd                     TableStreet = `Maddox Rd`
+	TableStreetMadisonCir                   TableStreet = `Madison Cir`
+	TableStreetMadisonCt                    TableStreet = `Madison Ct`
+	TableStreetMadisonLn                    TableStreet = `Madison Ln`
+	TableStreetMadisonPl                    TableStreet = `Madison Pl`
+	TableStreetMadisonWay                   TableStreet = `Madison Way`
+	TableStreetMagnoliaCt                   TableStreet = `Magnolia Ct`
+	TableStreetMagnoliaDr                   TableStreet = `Magnolia Dr`
+	TableStreetMagnoliaFarmRd               TableStreet = `Magnolia Farm Rd`
+	TableStreetMahanLn                      TableStreet = `Mahan Ln`
+	TableStreetMahanRd                      TableStreet = `Mahan Rd`
+	TableStreetMainLakeDr                   TableStreet = `Main Lake Dr`
+	TableStreetMainSt                       TableStreet = `Main St`
+	TableStreetMalletPte                    TableStreet = `Mallet Pte`
+	TableStreetMalloryCt                    TableStreet = `Mallory Ct`
+	TableStreetMalloryDr                    TableStreet = `Mallory Dr`
+	TableStreetManningMillRd                TableStreet = `Manning Mill Rd`
+	TableStreetManningMillWay               TableStreet = `Manning Mill Way`
+	TableStreetManningRd                    TableStreet = `Manning Rd`
+	TableStreetManorHouseLn                 TableStreet = `Manor House Ln`
+	TableStreetManorWay                     TableStreet = `Manor Way`
+	TableStreetMansfieldRd                  TableStreet = `Mansfield Rd`
+	TableStreetMapleAve                     TableStreet = `Maple Ave`
+	TableStreetMapleDr                      TableStreet = `Maple Dr`
+	TableStreetMapleGroveDr                 TableStreet = `Maple Grove Dr`
+	TableStreetMapleLn                      TableStreet = `Maple Ln`
+	TableStreetMapleRidgeDr                 TableStreet = `Maple Ridge Dr`
+	TableStreetMapleSt                      TableStreet = `Maple St`
+	TableStreetMaplewoodPl                  TableStreet = `Maplewood Pl`
+	TableStreetMaplewoodTrl                 TableStreet = `Maplewood Trl`
+	TableStreetMargueriteDr                 TableStreet = `Marguerite Dr`
+	TableStreetMarinaRd                     TableStreet = `Marina Rd`
+	TableStreetMarinerWay                   TableStreet = `Mariner Way`
+	TableStreetMarkTrl                      TableStreet = `Mark Trl`
+	TableStreetMarketPlaceBlvd              TableStreet = `Market Place Blvd`
+	TableStreetMarlinLn                     TableStreet = `Marlin Ln`
+	TableStreetMarrRd                       TableStreet = `Marr Rd`
+	TableStreetMarshwoodGlen                TableStreet = `Marshwood Glen`
+	TableStreetMarthaCt                     TableStreet = `Martha Ct`
+	TableStreetMarthasPl                    TableStreet = `Marthas Pl`
+	TableStreetMarthasWalk                  TableStreet = `Marthas Walk`
+	TableStreetMartinCir                    TableStreet = `Martin Cir`
+	TableStreetMartinLoop                   TableStreet = `Martin Loop`
+	TableStreetMartinLutherKingJrCir        TableStreet = `Martin Luther King Jr Cir`
+	TableStreetMartinLutherKingJrDr         TableStreet = `Martin Luther King Jr Dr`
+	TableStreetMartinRd                     TableStreet = `Martin Rd`
+	TableStreetMaryGraceLn                  TableStreet = `Mary Grace Ln`
+	TableStreetMaryLn                       TableStreet = `Mary Ln`
+	TableStreetMarySt                       TableStreet = `Mary St`
+	TableStreetMassellDr                    TableStreet = `Massell Dr`
+	TableStreetMassingaleRd                 TableStreet = `Massingale Rd`
+	TableStreetMathewsRd                    TableStreet = `Mathews Rd`
+	TableStreetMaudeWhiteRd                 TableStreet = `Maude White Rd`
+	TableStreetMauldinCir                   TableStreet = `Mauldin Cir`
+	TableStreetMaxwellRd                    TableStreet = `Maxwell Rd`
+	TableStreetMaySt                        TableStreet = `May St`
+	TableStreetMaybelleSt                   TableStreet = `Maybelle St`
+	TableStreetMayfieldRd                   TableStreet = `Mayfield Rd`
+	TableStreetMayflowerCir                 TableStreet = `Mayflower Cir`
+	TableStreetMayflowerSt                  TableStreet = `Mayflower St`
+	TableStreetMayflowerWay                 TableStreet = `Mayflower Way`
+	TableStreetMaysRd                       TableStreet = `Mays Rd`
+	TableStreetMcCanlessSt                  TableStreet = `McCanless St`
+	TableStreetMcClureDr                    TableStreet = `McClure Dr`
+	TableStreetMcCormickRd                  TableStreet = `McCormick Rd`
+	TableStreetMcCoyRd                      TableStreet = `McCoy Rd`
+	TableStreetMcElreathSt                  TableStreet = `McElreath St`
+	TableStreetMcEverSt                     TableStreet = `McEver St`
+	TableStreetMcKaskeyCreekRd              TableStreet = `McKaskey Creek Rd`
+	TableStreetMcKayDr                      TableStreet = `McKay Dr`
+	TableStreetMcKelveyCt                   TableStreet = `McKelvey Ct`
+	TableStreetMcKennaRd                    TableStreet = `McKenna Rd`
+	TableStreetMcKenzieCir                  TableStreet = `McKenzie Cir`
+	TableStreetMcKenzieSt                   TableStreet = `McKenzie St`
+	TableStreetMcKinleyCt                   TableStreet = `McKinley Ct`
+	TableStreetMcKinneyCampgroundRd         TableStreet = `McKinney Campground Rd`
+	TableStreetMcMillanRd                   TableStreet = `McMillan Rd`
+	TableStreetMcStottsRd                   TableStreet = `McStotts Rd`
+	TableStreetMcTierCir                    TableStreet = `McTier Cir`
+	TableStreetMeadowLn                     TableStreet = `Meadow Ln`
+	TableStreetMeadowbridgeDr               TableStreet = `Meadowbridge Dr`
+	TableStreetMeadowviewCir                TableStreet = `Meadowview Cir`
+	TableStreetMeadowviewRd                 TableStreet = `Meadowview Rd`
+	TableStreetMedicalDr                    TableStreet = `Medical Dr`
+	TableStreetMelhanaDr                    TableStreet = `Melhana Dr`
+	TableStreetMeloneDr                     TableStreet = `Melone Dr`
+	TableStreetMercerDr                     TableStreet = `Mercer Dr`
+	TableStreetMercerLn                     TableStreet = `Mercer Ln`
+	TableStreetMerchantsSquareDr            TableStreet = `Merchants Square Dr`
+	TableStreetMichaelLn                    TableStreet = `Michael Ln`
+	TableStreetMichiganAve                  TableStreet = `Michigan Ave`
+	TableStreetMiddleCt                     TableStreet = `Middle Ct`
+	TableStreetMiddlebrookDr                TableStreet = `Middlebrook Dr`
+	TableStreetMiddletonCt                  TableStreet = `Middleton Ct`
+	TableStreetMilamBridgeRd                TableStreet = `Milam Bridge Rd`
+	TableStreetMilamCir                     TableStreet = `Milam Cir`
+	TableStreetMilamSt                      TableStreet = `Milam St`
+	TableStreetMilesDr                      TableStreet = `Miles Dr`
+	TableStreetMillCreekRd                  TableStreet = `Mill Creek Rd`
+	TableStreetMillRockDr                   TableStreet = `Mill Rock Dr`
+	TableStreetMillViewCt                   TableStreet = `Mill View Ct`
+	TableStreetMillerFarmRd                 TableStreet = `Miller Farm Rd`
+	TableStreetMillersWay                   TableStreet = `Millers Way`
+	TableStreetMillstonePt                  TableStreet = `Millstone Pt`
+	TableStreetMillstreamCt                 TableStreet = `Millstream Ct`
+	TableStreetMilnerRd                     TableStreet = `Milner Rd`
+	TableStreetMilnerSt                     TableStreet = `Milner St`
+	TableStreetMiltonsWalk                  TableStreet = `Miltons Walk`
+	TableStreetMimosaLn                     TableStreet = `Mimosa Ln`
+	TableStreetMimosaTer                    TableStreet = `Mimosa Ter`
+	TableStreetMineralMuseumDr              TableStreet = `Mineral Museum Dr`
+	TableStreetMinersPt                     TableStreet = `Miners Pt`
+	TableStreetMintRd                       TableStreet = `Mint Rd`
+	TableStreetMirrorLakeDr                 TableStreet = `Mirror Lake Dr`
+	TableStreetMissionHillsDr               TableStreet = `Mission Hills Dr`
+	TableStreetMissionMountainRd            TableStreet = `Mission Mountain Rd`
+	TableStreetMissionRd                    TableStreet = `Mission Rd`
+	TableStreetMissionRidgeDr               TableStreet = `Mission Ridge Dr`
+	TableStreetMistyHollowCt                TableStreet = `Misty Hollow Ct`
+	TableStreetMistyRidgeDr                 TableStreet = `Misty Ridge Dr`
+	TableStreetMistyValleyDr                TableStreet = `Misty Valley Dr`
+	TableStreetMitchellAve                  TableStreet = `Mitchell Ave`
+	TableStreetMitchellRd                   TableStreet = `Mitchell Rd`
+	TableStreetMockingbirdDr                TableStreet = `Mockingbird Dr`
+	TableStreetMohawkDr                     TableStreet = `Mohawk Dr`
+	TableStreetMonroeXing                   TableStreet = `Monroe Xing`
+	TableStreetMontclairCt                  TableStreet = `Montclair Ct`
+	TableStreetMontgomerySt                 TableStreet = `Montgomery St`
+	TableStreetMontviewCir                  TableStreet = `Montview Cir`
+	TableStreetMoodySt                      TableStreet = `Moody St`
+	TableStreetMoonlightDr                  TableStreet = `Moonlight Dr`
+	TableStreetMooreRd                      TableStreet = `Moore Rd`
+	TableStreetMooreSt                      TableStreet = `Moore St`
+	TableStreetMooresSpringRd               TableStreet = `Moores Spring Rd`
+	TableStreetMorganAve                    TableStreet = `Morgan Ave`
+	TableStreetMorganDr                     TableStreet = `Morgan Dr`
+	TableStreetMoriahWay                    TableStreet = `Moriah Way`
+	TableStreetMorrisDr                     TableStreet = `Morris Dr`
+	TableStreetMorrisRd                     TableStreet = `Morris Rd`
+	TableStreetMosleyDr                     TableStreet = `Mosley Dr`
+	TableStreetMossLandingRd                TableStreet = `Moss Landing Rd`
+	TableStreetMossLn                       TableStreet = `Moss Ln`
+	TableStreetMossWay                      TableStreet = `Moss Way`
+	TableStreetMossyRockLn                  TableStreet = `Mossy Rock Ln`
+	TableStreetMostellersMillRd             TableStreet = `Mostellers Mill Rd`
+	TableStreetMotorsportsDr                TableStreet = `Motorsports Dr`
+	TableStreetMountOliveSt                 TableStreet = `Mount Olive St`
+	TableStreetMountPleasantRd              TableStreet = `Mount Pleasant Rd`
+	TableStreetMountainBreezeRd             TableStreet = `Mountain Breeze Rd`
+	TableStreetMountainChaseDr              TableStreet = `Mountain Chase Dr`
+	TableStreetMountainCreekTrl             TableStreet = `Mountain Creek Trl`
+	TableStreetMountainRidgeDr              TableStreet = `Mountain Ridge Dr`
+	TableStreetMountainRidgeRd              TableStreet = `Mountain Ridge Rd`
+	TableStreetMountainTrailCt              TableStreet = `Mountain Trail Ct`
+	TableStreetMountainViewCt               TableStreet = `Mountain View Ct`
+	TableStreetMountainViewDr               TableStreet = `Mountain View Dr`
+	TableStreetMountainViewRd               TableStreet = `Mountain View Rd`
+	TableStreetMountainbrookDr              TableStreet = `Mountainbrook Dr`
+	TableStreetMuirfieldWalk                TableStreet = `Muirfield Walk`
+	TableStreetMulberryLn                   TableStreet = `Mulberry Ln`
+	TableStreetMulberryWay                  TableStreet = `Mulberry Way`
+	TableStreetMulinixRd                    TableStreet = `Mulinix Rd`
+	TableStreetMullSt                       TableStreet = `Mull St`
+	TableStreetMullinaxRd                   TableStreet = `Mullinax Rd`
+	TableStreetMundyRd                      TableStreet = `Mundy Rd`
+	TableStreetMundySt                      TableStreet = `Mundy St`
+	TableStreetMurphyLn                     TableStreet = `Murphy Ln`
+	TableStreetMurrayAve                    TableStreet = `Murray Ave`
+	TableStreetNBartowSt                    TableStreet = `N Bartow St`
+	TableStreetNCassSt                      TableStreet = `N Cass St`
+	TableStreetNCentralAve                  TableStreet = `N Central Ave`
+	TableStreetNDixieAve                    TableStreet = `N Dixie Ave`
+	TableStreetNErwinSt                     TableStreet = `N Erwin St`
+	TableStreetNFranklinSt                  TableStreet = `N Franklin St`
+	TableStreetNGilmerSt                    TableStreet = `N Gilmer St`
+	TableStreetNMainSt                      TableStreet = `N Main St`
+	TableStreetNMorningsideDr               TableStreet = `N Morningside Dr`
+	TableStreetNMuseumDr                    TableStreet = `N Museum Dr`
+	TableStreetNPublicSq                    TableStreet = `N Public Sq`
+	TableStreetNRailroadSt                  TableStreet = `N Railroad St`
+	TableStreetNTennesseeSt                 TableStreet = `N Tennessee St`
+	TableStreetNWallSt                      TableStreet = `N Wall St`
+	TableStreetNallyRd                      TableStreet = `Nally Rd`
+	TableStreetNatalieDr                    TableStreet = `Natalie Dr`
+	TableStreetNatchiTrl                    TableStreet = `Natchi Trl`
+	TableStreetNavajoLn                     TableStreet = `Navajo Ln`
+	TableStreetNavajoTrl                    TableStreet = `Navajo Trl`
+	TableStreetNavigationPte                TableStreet = `Navigation Pte`
+	TableStreetNeedlePointRdg               TableStreet = `Needle Point Rdg`
+	TableStreetNeelSt                       TableStreet = `Neel St`
+	TableStreetNelsonSt                     TableStreet = `Nelson St`
+	TableStreetNewHopeChurchRd              TableStreet = `New Hope Church Rd`
+	TableStreetNicholasLn                   TableStreet = `Nicholas Ln`
+	TableStreetNobleHill                    TableStreet = `Noble Hill`
+	TableStreetNobleSt                      TableStreet = `Noble St`
+	TableStreetNolandSt                     TableStreet = `Noland St`
+	TableStreetNorlandCt                    TableStreet = `Norland Ct`
+	TableStreetNorthAve                     TableStreet = `North Ave`
+	TableStreetNorthDr                      TableStreet = `North Dr`
+	TableStreetNorthHamptonDr               TableStreet = `North Hampton Dr`
+	TableStreetNorthOaksCir                 TableStreet = `North Oaks Cir`
+	TableStreetNorthPointDr                 TableStreet = `North Point Dr`
+	TableStreetNorthRidgeCir                TableStreet = `North Ridge Cir`
+	TableStreetNorthRidgeCt                 TableStreet = `North Ridge Ct`
+	TableStreetNorthRidgeDr                 TableStreet = `North Ridge Dr`
+	TableStreetNorthRidgePt                 TableStreet = `North Ridge Pt`
+	TableStreetNorthValleyRd                TableStreet = `North Valley Rd`
+	TableStreetNorthVillageCir              TableStreet = `North Village Cir`
+	TableStreetNorthWesleyRdg               TableStreet = `North Wesley Rdg`
+	TableStreetNorthWoodsDr                 TableStreet = `North Woods Dr`
+	TableStreetNorthpointPkwy               TableStreet = `Northpoint Pkwy`
+	TableStreetNorthsidePkwy                TableStreet = `Northside Pkwy`
+	TableStreetNortonRd                     TableStreet = `Norton Rd`
+	TableStreetNottinghamDr                 TableStreet = `Nottingham Dr`
+	TableStreetNottinghamWay                TableStreet = `Nottingham Way`
+	TableStreetNovemberLn                   TableStreet = `November Ln`
+	TableStreetOakBeachDr                   TableStreet = `Oak Beach Dr`
+	TableStreetOakCt                        TableStreet = `Oak Ct`
+	TableStreetOakDr                        TableStreet = `Oak Dr`
+	TableStreetOakFarmDr                    TableStreet = `Oak Farm Dr`
+	TableStreetOakGroveCt                   TableStreet = `Oak Grove Ct`
+	TableStreetOakGroveLn                   TableStreet = `Oak Grove Ln`
+	TableStreetOakGroveRd                   TableStreet = `Oak Grove Rd`
+	TableStreetOakHillCir                   TableStreet = `Oak Hill Cir`
+	TableStreetOakHillDr                    TableStreet = `Oak Hill Dr`
+	TableStreetOakHillLn                    TableStreet = `Oak Hill Ln`
+	TableStreetOakHillWay                   TableStreet = `Oak Hill Way`
+	TableStreetOakHollowRd                  TableStreet = `Oak Hollow Rd`
+	TableStreetOakLeafDr                    TableStreet = `Oak Leaf Dr`
+	TableStreetOakLn                        TableStreet = `Oak Ln`
+	TableStreetOakSt                        TableStreet = `Oak St`
+	TableStreetOakStreetNo1                 TableStreet = `Oak Street No 1`
+	TableStreetOakStreetNo2                 TableStreet = `Oak Street No 2`
+	TableStreetOakWay                       TableStreet = `Oak Way`
+	TableStreetOakbrookDr                   TableStreet = `Oakbrook Dr`
+	TableStreetOakdaleDr                    TableStreet = `Oakdale Dr`
+	TableStreetOaklandSt                    TableStreet = `Oakland St`
+	TableStreetOakridgeDr                   TableStreet = `Oakridge Dr`
+	TableStreetOaksideCt                    TableStreet = `Oakside Ct`
+	TableStreetOaksideTrl                   TableStreet = `Oakside Trl`
+	TableStreetOakwoodWay                   TableStreet = `Oakwood Way`
+	TableStreetOhioAve                      TableStreet = `Ohio Ave`
+	TableStreetOhioSt                       TableStreet = `Ohio St`
+	TableStreetOld140Hwy                    TableStreet = `Old 140 Hwy`
+	TableStreetOld41Hwy                     TableStreet = `Old 41 Hwy`
+	TableStreetOldAlabamaRd                 TableStreet = `Old Alabama Rd`
+	TableStreetOldAlabamaSpur               TableStreet = `Old Alabama Spur`
+	TableStreetOldAllatoonaRd               TableStreet = `Old Allatoona Rd`
+	TableStreetOldBarnWay                   TableStreet = `Old Barn Way`
+	TableStreetOldBellsFerryRd              TableStreet = `Old Bells Ferry Rd`
+	TableStreetOldCCCRd                     TableStreet = `Old C C C Rd`
+	TableStreetOldCantonRd                  TableStreet = `Old Canton Rd`
+	TableStreetOldCassvilleWhiteRd          TableStreet = `Old Cassville White Rd`
+	TableStreetOldClineSmithRd              TableStreet = `Old Cline Smith Rd`
+	TableStreetOldDallasHwy                 TableStreet = `Old Dallas Hwy`
+	TableStreetOldDallasRd                  TableStreet = `Old Dallas Rd`
+	TableStreetOldDixieHwy                  TableStreet = `Old Dixie Hwy`
+	TableStreetOldFarmRd                    TableStreet = `Old Farm Rd`
+	TableStreetOldFieldRd                   TableStreet = `Old Field Rd`
+	TableStreetOldFurnaceRd                 TableStreet = `Old Furnace Rd`
+	TableStreetOldGilliamSpringsRd          TableStreet = `Old Gilliam Springs Rd`
+	TableStreetOldGoodieMountainRd          TableStreet = `Old Goodie Mountain Rd`
+	TableStreetOldGrassdaleRd               TableStreet = `Old Grassdale Rd`
+	TableStreetOldHallStationRd             TableStreet = `Old Hall Station Rd`
+	TableStreetOldHardinBridgeRd            TableStreet = `Old Hardin Bridge Rd`
+	TableStreetOldHemlockCv                 TableStreet = `Old Hemlock Cv`
+	TableStreetOldHomePlaceRd               TableStreet = `Old Home Place Rd`
+	TableStreetOldJonesMillRd               TableStreet = `Old Jones Mill Rd`
+	TableStreetOldMacedoniaCampgroundRd     TableStreet = `Old Macedonia Campground Rd`
+	TableStreetOldMartinRd                  TableStreet = `Old Martin Rd`
+	TableStreetOldMcKaskeyCreekRd           TableStreet = `Old McKaskey Creek Rd`
+	TableStreetOldMillFarmRd                TableStreet = `Old Mill Farm Rd`
+	TableStreetOldMillRd                    TableStreet = `Old Mill Rd`
+	TableStreetOldOldAlabamaRd              TableStreet = `Old Old Alabama Rd`
+	TableStreetOldPostRd                    TableStreet = `Old Post Rd`
+	TableStreetOldRiverRd                   TableStreet = `Old River Rd`
+	TableStreetOldRomeRd                    TableStreet = `Old Rome Rd`
+	TableStreetOldRovingRd                  TableStreet = `Old Roving Rd`
+	TableStreetOldRudyYorkRd                TableStreet = `Old Rudy York Rd`
+	TableStreetOldSandtownRd                TableStreet = `Old Sandtown Rd`
+	TableStreetOldSpringPlaceRd             TableStreet = `Old Spring Place Rd`
+	TableStreetOldStilesboroRd              TableStreet = `Old Stilesboro Rd`
+	TableStreetOldTennesseeHwy              TableStreet = `Old Tennessee Hwy`
+	TableStreetOldTennesseeRd               TableStreet = `Old Tennessee Rd`
+	TableStreetOldWadeRd                    TableStreet = `Old Wade Rd`
+	TableStreetOliveVineChurchRd            TableStreet = `Olive Vine Church Rd`
+	TableStreetOpalSt                       TableStreet = `Opal St`
+	TableStreetOrchardRd                    TableStreet = `Orchard Rd`
+	TableStreetOreMineRd                    TableStreet = `Ore Mine Rd`
+	TableStreetOrioleDr                     TableStreet = `Oriole Dr`
+	TableStreetOttingDr                     TableStreet = `Otting Dr`
+	TableStreetOverlookCir                  TableStreet = `Overlook Cir`
+	TableStreetOverlookWay                  TableStreet = `Overlook Way`
+	TableStreetOwensbyLn                    TableStreet = `Owensby Ln`
+	TableStreetOxfordCt                     TableStreet = `Oxford Ct`
+	TableStreetOxfordDr                     TableStreet = `Oxford Dr`
+	TableStreetOxfordLn                     TableStreet = `Oxford Ln`
+	TableStreetOxfordMillWay                TableStreet = `Oxford Mill Way`
+	TableStreetPMBYoungRd                   TableStreet = `P M B Young Rd`
+	TableStreetPaddockWay                   TableStreet = `Paddock Way`
+	TableStreetPadgettRd                    TableStreet = `Padgett Rd`
+	TableStreetPagaMineRd                   TableStreet = `Paga Mine Rd`
+	TableStreetPaigeDr                      TableStreet = `Paige Dr`
+	TableStreetPaigeSt                      TableStreet = `Paige St`
+	TableStreetPalmerRd                     TableStreet = `Palmer Rd`
+	TableStreetPamCir                       TableStreet = `Pam Cir`
+	TableStreetParkCt                       TableStreet = `Park Ct`
+	TableStreetParkMarinaRd                 TableStreet = `Park Marina Rd`
+	TableStreetParkPlaceDr                  TableStreet = `Park Place Dr`
+	TableStreetParkSt                       TableStreet = `Park St`
+	TableStreetParkerAve                    TableStreet = `Parker Ave`
+	TableStreetParkerWoodRd                 TableStreet = `Parker Wood Rd`
+	TableStreetParksideView                 TableStreet = `Parkside View`
+	TableStreetParkviewDr                   TableStreet = `Parkview Dr`
+	TableStreetParmenterSt                  TableStreet = `Parmenter St`
+	TableStreetParrWadeRd                   TableStreet = `Parr Wade Rd`
+	TableStreetPasleyRd                     TableStreet = `Pasley Rd`
+	TableStreetPathfinderSt                 TableStreet = `Pathfinder St`
+	TableStreetPatriciaDr                   TableStreet = `Patricia Dr`
+	TableStreetPattersonDr                  TableStreet = `Patterson Dr`
+	TableStreetPattersonLn                  TableStreet = `Patterson Ln`
+	TableStreetPattonLn                     TableStreet = `Patton Ln`
+	TableStreetPattonSt                     TableStreet = `Patton St`
+	TableStreetPawneeTrl                    TableStreet = `Pawnee Trl`
+	TableStreetPeaceTreeLn                  TableStreet = `Peace Tree Ln`
+	TableStreetPeachBlossomCir              TableStreet = `Peach Blossom Cir`
+	TableStreetPeachtreeSt                  TableStreet = `Peachtree St`
+	TableStreetPearlLn                      TableStreet = `Pearl Ln`
+	TableStreetPearlSt                      TableStreet = `Pearl St`
+	TableStreetPearsonRd                    TableStreet = `Pearson Rd`
+	TableStreetPearsonSt                    TableStreet = `Pearson St`
+	TableStreetPebbleBrookCt                TableStreet = `Pebble Brook Ct`
+	TableStreetPebbleHillCt                 TableStreet = `Pebble Hill Ct`
+	TableStreetPecanLn                      TableStreet = `Pecan Ln`
+	TableStreetPeddlersWay                  TableStreet = `Peddlers Way`
+	TableStreetPeeplesValleyRd              TableStreet = `Peeples Valley Rd`
+	TableStreetPembrokeLn                   TableStreet = `Pembroke Ln`
+	TableStreetPendleyTrl                   TableStreet = `Pendley Trl`
+	TableStreetPenelopeLn                   TableStreet = `Penelope Ln`
+	TableStreetPenfieldDr                   TableStreet = `Penfield Dr`
+	TableStreetPennyCt                      TableStreet = `Penny Ct`
+	TableStreetPennyLn                      TableStreet = `Penny Ln`
+	TableStreetPeppermillDr                 TableStreet = `Peppermill Dr`
+	TableStreetPercheronTrl                 TableStreet = `Percheron Trl`
+	TableStreetPerkinsDr                    TableStreet = `Perkins Dr`
+	TableStreetPerkinsMountainRd            TableStreet = `Perkins Mountain Rd`
+	TableStreetPerryRd                      TableStreet = `Perry Rd`
+	TableStreetPettitCir                    TableStreet = `Pettit Cir`
+	TableStreetPettitRd                     TableStreet = `Pettit Rd`
+	TableStreetPhillipsDr                   TableStreet = `Phillips Dr`
+	TableStreetPhoenixAirDr                 TableStreet = `Phoenix Air Dr`
+	TableStreetPickersRow                   TableStreet = `Pickers Row`
+	TableStreetPicklesimerRd                TableStreet = `Picklesimer Rd`
+	TableStreetPickysHoll                   TableStreet = `Pickys Holl`
+	TableStreetPiedmontAve                  TableStreet = `Piedmont Ave`
+	TableStreetPiedmontLn                   TableStreet = `Piedmont Ln`
+	TableStreetPilgrimSt                    TableStreet = `Pilgrim St`
+	TableStreetPineBowRd                    TableStreet = `Pine Bow Rd`
+	TableStreetPineCir                      TableStreet = `Pine Cir`
+	TableStreetPineDr                       TableStreet = `Pine Dr`
+	TableStreetPineForrestRd                TableStreet = `Pine Forrest Rd`
+	TableStreetPineGroveChurchRd            TableStreet = `Pine Grove Church Rd`
+	TableStreetPineGroveCir                 TableStreet = `Pine Grove Cir`
+	TableStreetPineGroveRd                  TableStreet = `Pine Grove Rd`
+	TableStreetPineLogRd                    TableStreet = `Pine Log Rd`
+	TableStreetPineLoop                     TableStreet = `Pine Loop`
+	TableStreetPineNeedleTrl                TableStreet = `Pine Needle Trl`
+	TableStreetPineOakCt                    TableStreet = `Pine Oak Ct`
+	TableStreetPineRidgeCt                  TableStreet = `Pine Ridge Ct`
+	TableStreetPineRidgeDr                  TableStreet = `Pine Ridge Dr`
+	TableStreetPineRidgeLn                  TableStreet = `Pine Ridge Ln`
+	TableStreetPineRidgeRd                  TableStreet = `Pine Ridge Rd`
+	TableStreetPineSt                       TableStreet = `Pine St`
+	TableStreetPineStreetNo1                TableStreet = `Pine Street No 1`
+	TableStreetPineStreetNo2                TableStreet = `Pine Street No 2`
+	TableStreetPineValleyCir                TableStreet = `Pine Valley Cir`
+	TableStreetPineValleyDr                 TableStreet = `Pine Valley Dr`
+	TableStreetPineVistaCir                 TableStreet = `Pine Vista Cir`
+	TableStreetPineWaterCt                  TableStreet = `Pine Water Ct`
+	TableStreetPineWoodRd                   TableStreet = `Pine Wood Rd`
+	TableStreetPinecrestDr                  TableStreet = `Pinecrest Dr`
+	TableStreetPinehillDr                   TableStreet = `Pinehill Dr`
+	TableStreetPineryDr                     TableStreet = `Pinery Dr`
+	TableStreetPineyWoodsRd                 TableStreet = `Piney Woods Rd`
+	TableStreetPinsonDr                     TableStreet = `Pinson Dr`
+	TableStreetPioneerTrl                   TableStreet = `Pioneer Trl`
+	TableStreetPittmanSt                    TableStreet = `Pittman St`
+	TableStreetPiutePl                      TableStreet = `Piute Pl`
+	TableStreetPlainviewDr                  TableStreet = `Plainview Dr`
+	TableStreetPlantationRd                 TableStreet = `Plantation Rd`
+	TableStreetPlantationRidgeDr            TableStreet = `Plantation Ridge Dr`
+	TableStreetPlantersDr                   TableStreet = `Planters Dr`
+	TableStreetPleasantGroveChurchRd        TableStreet = `Pleasant Grove Church Rd`
+	TableStreetPleasantLn                   TableStreet = `Pleasant Ln`
+	TableStreetPleasantRunDr                TableStreet = `Pleasant Run Dr`
+	TableStreetPleasantValleyRd             TableStreet = `Pleasant Valley Rd`
+	TableStreetPlymouthCt                   TableStreet = `Plymouth Ct`
+	TableStreetPlymouthDr                   TableStreet = `Plymouth Dr`
+	TableStreetPointDr                      TableStreet = `Point Dr`
+	TableStreetPointPlaceDr                 TableStreet = `Point Place Dr`
+	TableStreetPointeNorthDr                TableStreet = `Pointe North Dr`
+	TableStreetPointeWay                    TableStreet = `Pointe Way`
+	TableStreetPoloFlds                     TableStreet = `Polo Flds`
+	TableStreetPompanoLn                    TableStreet = `Pompano Ln`
+	TableStreetPondersRd                    TableStreet = `Ponders Rd`
+	TableStreetPophamRd                     TableStreet = `Popham Rd`
+	TableStreetPoplarSpringsRd              TableStreet = `Poplar Springs Rd`
+	TableStreetPoplarSt                     TableStreet = `Poplar St`
+	TableStreetPopularDr                    TableStreet = `Popular Dr`
+	TableStreetPostOfficeCir                TableStreet = `Post Office Cir`
+	TableStreetPostelleSt                   TableStreet = `Postelle St`
+	TableStreetPowellRd                     TableStreet = `Powell Rd`
+	TableStreetPowersCt                     TableStreet = `Powers Ct`
+	TableStreetPowersportsCir               TableStreet = `Powersports Cir`
+	TableStreetPratherSt                    TableStreet = `Prather St`
+	TableStreetPrattRd                      TableStreet = `Pratt Rd`
+	TableStreetPrayerTrl                    TableStreet = `Prayer Trl`
+	TableStreetPrestwickLoop                TableStreet = `Prestwick Loop`
+	TableStreetPrincetonAve                 TableStreet = `Princeton Ave`
+	TableStreetPrincetonBlvd                TableStreet = `Princeton Blvd`
+	TableStreetPrincetonGlenCt              TableStreet = `Princeton Glen Ct`
+	TableStreetPrincetonPl                  TableStreet = `Princeton Pl`
+	TableStreetPrincetonPlaceDr             TableStreet = `Princeton Place Dr`
+	TableStreetPrincetonWalk                TableStreet = `Princeton Walk`
+	TableStreetPrioryClubDr                 TableStreet = `Priory Club Dr`
+	TableStreetPublicSq                     TableStreet = `Public Sq`
+	TableStreetPuckettRd                    TableStreet = `Puckett Rd`
+	TableStreetPuckettSt                    TableStreet = `Puckett St`
+	TableStreetPumpkinvineTrl               TableStreet = `Pumpkinvine Trl`
+	TableStreetPuritanSt                    TableStreet = `Puritan St`
+	TableStreetPyronCt                      TableStreet = `Pyron Ct`
+	TableStreetQuailCt                      TableStreet = `Quail Ct`
+	TableStreetQuailHollowDr                TableStreet = `Quail Hollow Dr`
+	TableStreetQuailRidgeDr                 TableStreet = `Quail Ridge Dr`
+	TableStreetQuailRidgeRd                 TableStreet = `Quail Ridge Rd`
+	TableStreetQuailRun                     TableStreet = `Quail Run`
+	TableStreetQualityDr                    TableStreet = `Quality Dr`
+	TableStreetREFieldsRd                   TableStreet = `R E Fields Rd`
+	TableStreetRailDr                       TableStreet = `Rail Dr`
+	TableStreetRailOvlk                     TableStreet = `Rail Ovlk`
+	TableStreetRailroadAve                  TableStreet = `Railroad Ave`
+	TableStreetRailroadPass                 TableStreet = `Railroad Pass`
+	TableStreetRailroadSt                   TableStreet = `Railroad St`
+	TableStreetRaindropLn                   TableStreet = `Raindrop Ln`
+	TableStreetRandolphRd                   TableStreet = `Randolph Rd`
+	TableStreetRandyWay                     TableStreet = `Randy Way`
+	TableStreetRanellPl                     TableStreet = `Ranell Pl`
+	TableStreetRangerRd                     TableStreet = `Ranger Rd`
+	TableStreetRattlerRd                    TableStreet = `Rattler Rd`
+	TableStreetRavenfieldRd                 TableStreet = `Ravenfield Rd`
+	TableStreetRaymondJenkinsRd             TableStreet = `Raymond Jenkins Rd`
+	TableStreetRecessRd                     TableStreet = `Recess Rd`
+	TableStreetRedAppleTer                  TableStreet = `Red Apple Ter`
+	TableStreetRedBarnRd                    TableStreet = `Red Barn Rd`
+	TableStreetRedBugPt                     TableStreet = `Red Bug Pt`
+	TableStreetRedFoxTrl                    TableStreet = `Red Fox Trl`
+	TableStreetRedOakDr                     TableStreet = `Red Oak Dr`
+	TableStreetRedTipDr                     TableStreet = `Red Tip Dr`
+	TableStreetRedTopBeachRd                TableStreet = `Red Top Beach Rd`
+	TableStreetRedTopCir                    TableStreet = `Red Top Cir`
+	TableStreetRedTopDr                     TableStreet = `Red Top Dr`
+	TableStreetRedTopMountainRd             TableStreet = `Red Top Mountain Rd`
+	TableStreetRedcombDr                    TableStreet = `Redcomb Dr`
+	TableStreetReddRd                       TableStreet = `Redd Rd`
+	TableStreetReddingRd                    TableStreet = `Redding Rd`
+	TableStreetRedfieldCt                   TableStreet = `Redfield Ct`
+	TableStreetRedigerCt                    TableStreet = `Rediger Ct`
+	TableStreetRedwoodDr                    TableStreet = `Redwood Dr`
+	TableStreetRejectRd                     TableStreet = `Reject Rd`
+	TableStreetRemingtonCt                  TableStreet = `Remington Ct`
+	TableStreetRemingtonDr                  TableStreet = `Remington Dr`
+	TableStreetRemingtonLn                  TableStreet = `Remington Ln`
+	TableStreetRetreatRdg                   TableStreet = `Retreat Rdg`
+	TableStreetRexLn                        TableStreet = `Rex Ln`
+	TableStreetReynoldsBendRd               TableStreet = `Reynolds Bend Rd`
+	TableStreetReynoldsBridgeRd             TableStreet = `Reynolds Bridge Rd`
+	TableStreetReynoldsLn                   TableStreet = `Reynolds Ln`
+	TableStreetRhondaLn                     TableStreet = `Rhonda Ln`
+	TableStreetRiceDr                       TableStreet = `Rice Dr`
+	TableStreetRichardsRd                   TableStreet = `Richards Rd`
+	TableStreetRichlandDr                   TableStreet = `Richland Dr`
+	TableStreetRiddleMillRd                 TableStreet = `Riddle Mill Rd`
+	TableStreetRidgeCrossRd                 TableStreet = `Ridge Cross Rd`
+	TableStreetRidgeRd                      TableStreet = `Ridge Rd`
+	TableStreetRidgeRowDr                   TableStreet = `Ridge Row Dr`
+	TableStreetRidgeViewDr                  TableStreet = `Ridge View Dr`
+	TableStreetRidgeWay                     TableStreet = `Ridge Way`
+	TableStreetRidgedaleRd                  TableStreet = `Ridgedale Rd`
+	TableStreetRidgelineWay                 TableStreet = `Ridgeline Way`
+	TableStreetRidgemontWay                 TableStreet = `Ridgemont Way`
+	TableStreetRidgeviewCt                  TableStreet = `Ridgeview Ct`
+	TableStreetRidgeviewDr                  TableStreet = `Ridgeview Dr`
+	TableStreetRidgeviewTrl                 TableStreet = `Ridgeview Trl`
+	TableStreetRidgewaterDr                 TableStreet = `Ridgewater Dr`
+	TableStreetRidgewayCt                   TableStreet = `Ridgeway Ct`
+	TableStreetRidgewoodDr                  TableStreet = `Ridgewood Dr`
+	TableStreetRingersRd                    TableStreet = `Ringers Rd`
+	TableStreetRipRd                        TableStreet = `Rip Rd`
+	TableStreetRipleyAve                    TableStreet = `Ripley Ave`
+	TableStreetRiverBirchCir                TableStreet = `River Birch Cir`
+	TableStreetRiverBirchCt                 TableStreet = `River Birch Ct`
+	TableStreetRiverBirchDr                 TableStreet = `River Birch Dr`
+	TableStreetRiverBirchRd                 TableStreet = `River Birch Rd`
+	TableStreetRiverCt                      TableStreet = `River Ct`
+	TableStreetRiverDr                      TableStreet = `River Dr`
+	TableStreetRiverOaksCt                  TableStreet = `River Oaks Ct`
+	TableStreetRiverOaksDr                  TableStreet = `River Oaks Dr`
+	TableStreetRiverShoalsDr                TableStreet = `River Shoals Dr`
+	TableStreetRiverWalkPkwy                TableStreet = `River Walk Pkwy`
+	TableStreetRiverbendRd                  TableStreet = `Riverbend Rd`
+	TableStreetRivercreekXing               TableStreet = `Rivercreek Xing`
+	TableStreetRiversideCt                  TableStreet = `Riverside Ct`
+	TableStreetRiversideDr                  TableStreet = `Riverside Dr`
+	TableStreetRiverviewCt                  TableStreet = `Riverview Ct`
+	TableStreetRiverviewTrl                 TableStreet = `Riverview Trl`
+	TableStreetRiverwoodCv                  TableStreet = `Riverwood Cv`
+	TableStreetRoachDr                      TableStreet = `Roach Dr`
+	TableStreetRoadNo1South                 TableStreet = `Road No 1 South`
+	TableStreetRoadNo2South                 TableStreet = `Road No 2 South`
+	TableStreetRoadNo3South                 TableStreet = `Road No 3 South`
+	TableStreetRobersonDr                   TableStreet = `Roberson Dr`
+	TableStreetRobersonRd                   TableStreet = `Roberson Rd`
+	TableStreetRobertSt                     TableStreet = `Robert St`
+	TableStreetRobertsDr                    TableStreet = `Roberts Dr`
+	TableStreetRobertsWay                   TableStreet = `Roberts Way`
+	TableStreetRobinDr                      TableStreet = `Robin Dr`
+	TableStreetRobinHoodDr                  TableStreet = `Robin Hood Dr`
+	TableStreetRobinsonLoop                 TableStreet = `Robinson Loop`
+	TableStreetRockFenceCir                 TableStreet = `Rock Fence Cir`
+	TableStreetRockFenceRd                  TableStreet = `Rock Fence Rd`
+	TableStreetRockFenceRoadNo2             TableStreet = `Rock Fence Road No 2`
+	TableStreetRockRidgeCt                  TableStreet = `Rock Ridge Ct`
+	TableStreetRockRidgeRd                  TableStreet = `Rock Ridge Rd`
+	TableStreetRockawayCt                   TableStreet = `Rockaway Ct`
+	TableStreetRockcrestCir                 TableStreet = `Rockcrest Cir`
+	TableStreetRockpoint                    TableStreet = `Rockpoint`
+	TableStreetRockridgeDr                  TableStreet = `Rockridge Dr`
+	TableStreetRockyAve                     TableStreet = `Rocky Ave`
+	TableStreetRockyCir                     TableStreet = `Rocky Cir`
+	TableStreetRockyMountainPass            TableStreet = `Rocky Mountain Pass`
+	TableStreetRockyRd                      TableStreet = `Rocky Rd`
+	TableStreetRockySt                      TableStreet = `Rocky St`
+	TableStreetRogersCutOffRd               TableStreet = `Rogers Cut Off Rd`
+	TableStreetRogersRd                     TableStreet = `Rogers Rd`
+	TableStreetRogersSt                     TableStreet = `Rogers St`
+	TableStreetRollingHillsDr               TableStreet = `Rolling Hills Dr`
+	TableStreetRonSt                        TableStreet = `Ron St`
+	TableStreetRonsonSt                     TableStreet = `Ronson St`
+	TableStreetRooseveltSt                  TableStreet = `Roosevelt St`
+	TableStreetRoseBrookCir                 TableStreet = `Rose Brook Cir`
+	TableStreetRoseTrl                      TableStreet = `Rose Trl`
+	TableStreetRoseWay                      TableStreet = `Rose Way`
+	TableStreetRoseburyCt                   TableStreet = `Rosebury Ct`
+	TableStreetRosenSt                      TableStreet = `Rosen St`
+	TableStreetRosewoodLn                   TableStreet = `Rosewood Ln`
+	TableStreetRossRd                       TableStreet = `Ross Rd`
+	TableStreetRothLn                       TableStreet = `Roth Ln`
+	TableStreetRoundtableCt                 TableStreet = `Roundtable Ct`
+	TableStreetRovingHillsCir               TableStreet = `Roving Hills Cir`
+	TableStreetRovingRd                     TableStreet = `Roving Rd`
+	TableStreetRowlandSpringsRd             TableStreet = `Rowland Springs Rd`
+	TableStreetRoxburghTrl                  TableStreet = `Roxburgh Trl`
+	TableStreetRoxyPl                       TableStreet = `Roxy Pl`
+	TableStreetRoyalLakeCt                  TableStreet = `Royal Lake Ct`
+	TableStreetRoyalLakeCv                  TableStreet = `Royal Lake Cv`
+	TableStreetRoyalLakeDr                  TableStreet = `Royal Lake Dr`
+	TableStreetRoycoDr                      TableStreet = `Royco Dr`
+	TableStreetRubieLn                      TableStreet = `Rubie Ln`
+	TableStreetRubyDabbsLn                  TableStreet = `Ruby Dabbs Ln`
+	TableStreetRubySt                       TableStreet = `Ruby St`
+	TableStreetRudyYorkRd                   TableStreet = `Rudy York Rd`
+	TableStreetRuffDr                       TableStreet = `Ruff Dr`
+	TableStreetRunningDeerTrl               TableStreet = `Running Deer Trl`
+	TableStreetRunningTerraceWay            TableStreet = `Running Terrace Way`
+	TableStreetRussellDr                    TableStreet = `Russell Dr`
+	TableStreetRussellRdg                   TableStreet = `Russell Rdg`
+	TableStreetRusslerWay                   TableStreet = `Russler Way`
+	TableStreetRyanBoulevard                TableStreet = `Ryan Boulevard`
+	TableStreetRyanRd                       TableStreet = `Ryan Rd`
+	TableStreetRylesRd                      TableStreet = `Ryles Rd`
+	TableStreetSBartowSt                    TableStreet = `S Bartow St`
+	TableStreetSCassSt                      TableStreet = `S Cass St`
+	TableStreetSCentralAve                  TableStreet = `S Central Ave`
+	TableStreetSDixieAve                    TableStreet = `S Dixie Ave`
+	TableStreetSErwinSt                     TableStreet = `S Erwin St`
+	TableStreetSFranklinSt                  TableStreet = `S Franklin St`
+	TableStreetSGilmerSt                    TableStreet = `S Gilmer St`
+	TableStreetSMainSt                      TableStreet = `S Main St`
+	TableStreetSMorningsideDr               TableStreet = `S Morningside Dr`
+	TableStreetSMuseumDr                    TableStreet = `S Museum Dr`
+	TableStreetSPublicSq                    TableStreet = `S Public Sq`
+	TableStreetSRailroadSt                  TableStreet = `S Railroad St`
+	TableStreetSTennesseeSt                 TableStreet = `S Tennessee St`
+	TableStreetSWallSt                      TableStreet = `S Wall St`
+	TableStreetSaddleClubDr                 TableStreet = `Saddle Club Dr`
+	TableStreetSaddleFieldCir               TableStreet = `Saddle Field Cir`
+	TableStreetSaddleLn                     TableStreet = `Saddle Ln`
+	TableStreetSaddleRidgeDr                TableStreet = `Saddle Ridge Dr`
+	TableStreetSaddlebrookDr                TableStreet = `Saddlebrook Dr`
+	TableStreetSaddlemanCt                  TableStreet = `Saddleman Ct`
+	TableStreetSageWay                      TableStreet = `Sage Way`
+	TableStreetSaggusRd                     TableStreet = `Saggus Rd`
+	TableStreetSailorsCv                    TableStreet = `Sailors Cv`
+	TableStreetSaintAndrewsDr               TableStreet = `Saint Andrews Dr`
+	TableStreetSaintElmoCir                 TableStreet = `Saint Elmo Cir`
+	TableStreetSaintFrancisPl               TableStreet = `Saint Francis Pl`
+	TableStreetSaintIvesWay                 TableStreet = `Saint Ives Way`
+	TableStreetSalacoaCreekRd               TableStreet = `Salacoa Creek Rd`
+	TableStreetSalacoaRd                    TableStreet = `Salacoa Rd`
+	TableStreetSamSuttonRd                  TableStreet = `Sam Sutton Rd`
+	TableStreetSamuelWay                    TableStreet = `Samuel Way`
+	TableStreetSandcliffeLn                 TableStreet = `Sandcliffe Ln`
+	TableStreetSandtownRd                   TableStreet = `Sandtown Rd`
+	TableStreetSaratogaDr                   TableStreet = `Saratoga Dr`
+	TableStreetSassafrasTrl                 TableStreet = `Sassafras Trl`
+	TableStreetSassyLn                      TableStreet = `Sassy Ln`
+	TableStreetSatcherRd                    TableStreet = `Satcher Rd`
+	TableStreetScaleHouseDr                 TableStreet = `Scale House Dr`
+	TableStreetScarlettOakDr                TableStreet = `Scarlett Oak Dr`
+	TableStreetScenicMountainDr             TableStreet = `Scenic Mountain Dr`
+	TableStreetSchoolSt                     TableStreet = `School St`
+	TableStreetScottDr                      TableStreet = `Scott Dr`
+	TableStreetScreamingEagleCt             TableStreet = `Screaming Eagle Ct`
+	TableStreetSeaHorseCir                  TableStreet = `Sea Horse Cir`
+	TableStreetSecretariatCt                TableStreet = `Secretariat Ct`
+	TableStreetSecretariatRd                TableStreet = `Secretariat Rd`
+	TableStreetSeminoleRd                   TableStreet = `Seminole Rd`
+	TableStreetSeminoleTrl                  TableStreet = `Seminole Trl`
+	TableStreetSenecaLn                     TableStreet = `Seneca Ln`
+	TableStreetSentryDr                     TableStreet = `Sentry Dr`
+	TableStreetSequoiaPl                    TableStreet = `Sequoia Pl`
+	TableStreetSequoyahCir                  TableStreet = `Sequoyah Cir`
+	TableStreetSequoyahTrl                  TableStreet = `Sequoyah Trl`
+	TableStreetSerenaSt                     TableStreet = `Serena St`
+	TableStreetServiceRoadNo3               TableStreet = `Service Road No 3`
+	TableStreetSettersPte                   TableStreet = `Setters Pte`
+	TableStreetSettlersCv                   TableStreet = `Settlers Cv`
+	TableStreetSewellRd                     TableStreet = `Sewell Rd`
+	TableStreetShadowLn                     TableStreet = `Shadow Ln`
+	TableStreetShadyOakLn                   TableStreet = `Shady Oak Ln`
+	TableStreetShadyValleyDr                TableStreet = `Shady Valley Dr`
+	TableStreetShagbarkDr                   TableStreet = `Shagbark Dr`
+	TableStreetShakeRagCir                  TableStreet = `Shake Rag Cir`
+	TableStreetShakeRagRd                   TableStreet = `Shake Rag Rd`
+	TableStreetShakerCt                     TableStreet = `Shaker Ct`
+	TableStreetShallowoodPl                 TableStreet = `Shallowood Pl`
+	TableStreetSharpWay                     TableStreet = `Sharp Way`
+	TableStreetShawBlvd                     TableStreet = `Shaw Blvd`
+	TableStreetShawSt                       TableStreet = `Shaw St`
+	TableStreetSheffieldPl                  TableStreet = `Sheffield Pl`
+	TableStreetSheilaLn                     TableStreet = `Sheila Ln`
+	TableStreetSheilaRdg                    TableStreet = `Sheila Rdg`
+	TableStreetShenandoahPkwy               TableStreet = `Shenandoah Pkwy`
+	TableStreetShermanLn                    TableStreet = `Sherman Ln`
+	TableStreetSherwoodDr                   TableStreet = `Sherwood Dr`
+	TableStreetSherwoodLn                   TableStreet = `Sherwood Ln`
+	TableStreetSherwoodWay                  TableStreet = `Sherwood Way`
+	TableStreetShilohChurchRd               TableStreet = `Shiloh Church Rd`
+	TableStreetShinallGainesRd              TableStreet = `Shinall Gaines Rd`
+	TableStreetShinallRd                    TableStreet = `Shinall Rd`
+	TableStreetShirleyLn                    TableStreet = `Shirley Ln`
+	TableStreetShotgunRd                    TableStreet = `Shotgun Rd`
+	TableStreetShropshireLn                 TableStreet = `Shropshire Ln`
+	TableStreetSignalMountainCir            TableStreet = `Signal Mountain Cir`
+	TableStreetSignalMountainDr             TableStreet = `Signal Mountain Dr`
+	TableStreetSilversmithTrl               TableStreet = `Silversmith Trl`
+	TableStreetSimmonsDr                    TableStreet = `Simmons Dr`
+	TableStreetSimpsonCir                   TableStreet = `Simpson Cir`
+	TableStreetSimpsonRd                    TableStreet = `Simpson Rd`
+	TableStreetSimpsonTrl                   TableStreet = `Simpson Trl`
+	TableStreetSingletreeRdg                TableStreet = `Singletree Rdg`
+	TableStreetSiniardDr                    TableStreet = `Siniard Dr`
+	TableStreetSiniardRd                    TableStreet = `Siniard Rd`
+	TableStreetSiouxRd                      TableStreet = `Sioux Rd`
+	TableStreetSkinnerSt                    TableStreet = `Skinner St`
+	TableStreetSkylineDr                    TableStreet = `Skyline Dr`
+	TableStreetSkyviewDr                    TableStreet = `Skyview Dr`
+	TableStreetSkyviewPte                   TableStreet = `Skyview Pte`
+	TableStreetSlopesDr                     TableStreet = `Slopes Dr`
+	TableStreetSmileyIngramRd               TableStreet = `Smiley Ingram Rd`
+	TableStreetSmithCir                     TableStreet = `Smith Cir`
+	TableStreetSmithDr                      TableStreet = `Smith Dr`
+	TableStreetSmithRd                      TableStreet = `Smith Rd`
+	TableStreetSmyrnaSt                     TableStreet = `Smyrna St`
+	TableStreetSnapperLn                    TableStreet = `Snapper Ln`
+	TableStreetSnowSpringsChurchRd          TableStreet = `Snow Springs Church Rd`
+	TableStreetSnowSpringsRd                TableStreet = `Snow Springs Rd`
+	TableStreetSnugHarborDr                 TableStreet = `Snug Harbor Dr`
+	TableStreetSoaringHeightsDr             TableStreet = `Soaring Heights Dr`
+	TableStreetSohoDr                       TableStreet = `Soho Dr`
+	TableStreetSomersetClubDr               TableStreet = `Somerset Club Dr`
+	TableStreetSomersetLn                   TableStreet = `Somerset Ln`
+	TableStreetSonyaPl                      TableStreet = `Sonya Pl`
+	TableStreetSouthAve                     TableStreet = `South Ave`
+	TableStreetSouthBridgeDr                TableStreet = `South Bridge Dr`
+	TableStreetSouthDr                      TableStreet = `South Dr`
+	TableStreetSouthOaksDr                  TableStreet = `South Oaks Dr`
+	TableStreetSouthValleyRd                TableStreet = `South Valley Rd`
+	TableStreetSouthernRd                   TableStreet = `Southern Rd`
+	TableStreetSouthridgeTrl                TableStreet = `Southridge Trl`
+	TableStreetSouthviewDr                  TableStreet = `Southview Dr`
+	TableStreetSouthwellAve                 TableStreet = `Southwell Ave`
+	TableStreetSouthwoodDr                  TableStreet = `Southwood Dr`
+	TableStreetSparksRd                     TableStreet = `Sparks Rd`
+	TableStreetSparksSt                     TableStreet = `Sparks St`
+	TableStreetSparkyTrl                    TableStreet = `Sparky Trl`
+	TableStreetSparrowCt                    TableStreet = `Sparrow Ct`
+	TableStreetSpigsSt                      TableStreet = `Spigs St`
+	TableStreetSplitOakDr                   TableStreet = `Split Oak Dr`
+	TableStreetSplitRailCt                  TableStreet = `Split Rail Ct`
+	TableStreetSpringCreekCir               TableStreet = `Spring Creek Cir`
+	TableStreetSpringFolly                  TableStreet = `Spring Folly`
+	TableStreetSpringLakeTrl                TableStreet = `Spring Lake Trl`
+	TableStreetSpringMeadowsLn              TableStreet = `Spring Meadows Ln`
+	TableStreetSpringPlaceRd                TableStreet = `Spring Place Rd`
+	TableStreetSpringSt                     TableStreet = `Spring St`
+	TableStreetSpringhouseCt                TableStreet = `Springhouse Ct`
+	TableStreetSpringmontDr                 TableStreet = `Springmont Dr`
+	TableStreetSpringwellLn                 TableStreet = `Springwell Ln`
+	TableStreetSpruceLn                     TableStreet = `Spruce Ln`
+	TableStreetSpruceSt                     TableStreet = `Spruce St`
+	TableStreetSquiresCt                    TableStreet = `Squires Ct`
+	TableStreetSR113                        TableStreet = `SR 113`
+	TableStreetSR140                        TableStreet = `SR 140`
+	TableStreetSR20                         TableStreet = `SR 20`
+	TableStreetSR294                        TableStreet = `SR 294`
+	TableStreetSR61                         TableStreet = `SR 61`
+	TableStreetStampCreekRd                 TableStreet = `Stamp Creek Rd`
+	TableStreetStampedePass                 TableStreet = `Stampede Pass`
+	TableStreetStandardCt                   TableStreet = `Standard Ct`
+	TableStreetStarDustTrl                  TableStreet = `Star Dust Trl`
+	TableStreetStarlightDr                  TableStreet = `Starlight Dr`
+	TableStreetStarnesRd                    TableStreet = `Starnes Rd`
+	TableStreetStartingGateDr               TableStreet = `Starting Gate Dr`
+	TableStreetStatelyOaksDr                TableStreet = `Stately Oaks Dr`
+	TableStreetStationCt                    TableStreet = `Station Ct`
+	TableStreetStationRailDr                TableStreet = `Station Rail Dr`
+	TableStreetStationWay                   TableStreet = `Station Way`
+	TableStreetStatonPl                     TableStreet = `Staton Pl`
+	TableStreetSteeplechaseLn               TableStreet = `Steeplechase Ln`
+	TableStreetStephenWay                   TableStreet = `Stephen Way`
+	TableStreetStephensRd                   TableStreet = `Stephens Rd`
+	TableStreetStephensSt                   TableStreet = `Stephens St`
+	TableStreetSterlingCt                   TableStreet = `Sterling Ct`
+	TableStreetStewartDr                    TableStreet = `Stewart Dr`
+	TableStreetStewartLn                    TableStreet = `Stewart Ln`
+	TableStreetStilesCt                     TableStreet = `Stiles Ct`
+	TableStreetStilesFairway                TableStreet = `Stiles Fairway`
+	TableStreetStilesRd                     TableStreet = `Stiles Rd`
+	TableStreetStillmontWay                 TableStreet = `Stillmont Way`
+	TableStreetStillwaterCt                 TableStreet = `Stillwater Ct`
+	TableStreetStokerRd                     TableStreet = `Stoker Rd`
+	TableStreetStokleySt                    TableStreet = `Stokley St`
+	TableStreetStoneGateDr                  TableStreet = `Stone Gate Dr`
+	TableStreetStoneMillDr                  TableStreet = `Stone Mill Dr`
+	TableStreetStonebridgeCt                TableStreet = `Stonebridge Ct`
+	TableStreetStonebrookDr                 TableStreet = `Stonebrook Dr`
+	TableStreetStonecreekDr                 TableStreet = `Stonecreek Dr`
+	TableStreetStonehavenCir                TableStreet = `Stonehaven Cir`
+	TableStreetStonehengeCt                 TableStreet = `Stonehenge Ct`
+	TableStreetStonerRd                     TableStreet = `Stoner Rd`
+	TableStreetStoneridgePl                 TableStreet = `Stoneridge Pl`
+	TableStreetStonersChapelRd              TableStreet = `Stoners Chapel Rd`
+	TableStreetStonewallLn                  TableStreet = `Stonewall Ln`
+	TableStreetStonewallSt                  TableStreet = `Stonewall St`
+	TableStreetStoneybrookCt                TableStreet = `Stoneybrook Ct`
+	TableStreetStratfordLn                  TableStreet = `Stratford Ln`
+	TableStreetStratfordWay                 TableStreet = `Stratford Way`
+	TableStreetStriplinCv                   TableStreet = `Striplin Cv`
+	TableStreetSueUPath                     TableStreet = `Sue U Path`
+	TableStreetSugarHillRd                  TableStreet = `Sugar Hill Rd`
+	TableStreetSugarMillDr                  TableStreet = `Sugar Mill Dr`
+	TableStreetSugarValleyRd                TableStreet = `Sugar Valley Rd`
+	TableStreetSugarberryPl                 TableStreet = `Sugarberry Pl`
+	TableStreetSukkauDr                     TableStreet = `Sukkau Dr`
+	TableStreetSullinsRd                    TableStreet = `Sullins Rd`
+	TableStreetSummerDr                     TableStreet = `Summer Dr`
+	TableStreetSummerPl                     TableStreet = `Summer Pl`
+	TableStreetSummerSt                     TableStreet = `Summer St`
+	TableStreetSummersetCt                  TableStreet = `Summerset Ct`
+	TableStreetSummitRidgeCir               TableStreet = `Summit Ridge Cir`
+	TableStreetSummitRidgeDr                TableStreet = `Summit Ridge Dr`
+	TableStreetSummitSt                     TableStreet = `Summit St`
+	TableStreetSumnerLn                     TableStreet = `Sumner Ln`
+	TableStreetSunriseDr                    TableStreet = `Sunrise Dr`
+	TableStreetSunsetCir                    TableStreet = `Sunset Cir`
+	TableStreetSunsetDr                     TableStreet = `Sunset Dr`
+	TableStreetSunsetRd                     TableStreet = `Sunset Rd`
+	TableStreetSunsetTer                    TableStreet = `Sunset Ter`
+	TableStreetSurreyLn                     TableStreet = `Surrey Ln`
+	TableStreetSuttonRd                     TableStreet = `Sutton Rd`
+	TableStreetSuttonTrl                    TableStreet = `Sutton Trl`
+	TableStreetSwaffordRd                   TableStreet = `Swafford Rd`
+	TableStreetSwallowDr                    TableStreet = `Swallow Dr`
+	TableStreetSwanCir                      TableStreet = `Swan Cir`
+	TableStreetSweetEloiseLn                TableStreet = `Sweet Eloise Ln`
+	TableStreetSweetGracieHoll              TableStreet = `Sweet Gracie Holl`
+	TableStreetSweetGumLn                   TableStreet = `Sweet Gum Ln`
+	TableStreetSweetWaterCt                 TableStreet = `Sweet Water Ct`
+	TableStreetSweetbriarCir                TableStreet = `Sweetbriar Cir`
+	TableStreetSwisherDr                    TableStreet = `Swisher Dr`
+	TableStreetSybleCv                      TableStreet = `Syble Cv`
+	TableStreetTabernacleSt                 TableStreet = `Tabernacle St`
+	TableStreetTaffRd                       TableStreet = `Taff Rd`
+	TableStreetTalismanDr                   TableStreet = `Talisman Dr`
+	TableStreetTallOaksLn                   TableStreet = `Tall Oaks Ln`
+	TableStreetTanagerLn                    TableStreet = `Tanager Ln`
+	TableStreetTanchezDr                    TableStreet = `Tanchez Dr`
+	TableStreetTanglewoodDr                 TableStreet = `Tanglewood Dr`
+	TableStreetTankHillSt                   TableStreet = `Tank Hill St`
+	TableStreetTantRd                       TableStreet = `Tant Rd`
+	TableStreetTanyardCreekRd               TableStreet = `Tanyard Creek Rd`
+	TableStreetTanyardRd                    TableStreet = `Tanyard Rd`
+	TableStreetTaraWay                      TableStreet = `Tara Way`
+	TableStreetTarponTrl                    TableStreet = `Tarpon Trl`
+	TableStreetTashaTrl                     TableStreet = `Tasha Trl`
+	TableStreetTatumRidgeRd                 TableStreet = `Tatum Ridge Rd`
+	TableStreetTaylorDr                     TableStreet = `Taylor Dr`
+	TableStreetTaylorLn                     TableStreet = `Taylor Ln`
+	TableStreetTaylorsvilleMacedoniaRd      TableStreet = `Taylorsville Macedonia Rd`
+	TableStreetTaylorsvilleRd               TableStreet = `Taylorsville Rd`
+	TableStreetTeagueRd                     TableStreet = `Teague Rd`
+	TableStreetTealCt                       TableStreet = `Teal Ct`
+	TableStreetTellusDr                     TableStreet = `Tellus Dr`
+	TableStreetTennesseeAve                 TableStreet = `Tennessee Ave`
+	TableStreetTerrellDr                    TableStreet = `Terrell Dr`
+	TableStreetTerryLn                      TableStreet = `Terry Ln`
+	TableStreetThatchCt                     TableStreet = `Thatch Ct`
+	TableStreetTheaterAve                   TableStreet = `Theater Ave`
+	TableStreetThirdArmyRd                  TableStreet = `Third Army Rd`
+	TableStreetThistleStop                  TableStreet = `Thistle Stop`
+	TableStreetThomasCt                     TableStreet = `Thomas Ct`
+	TableStreetThompsonSt                   TableStreet = `Thompson St`
+	TableStreetThornwoodDr                  TableStreet = `Thornwood Dr`
+	TableStreetThoroughbredLn               TableStreet = `Thoroughbred Ln`
+	TableStreetThrasherCt                   TableStreet = `Thrasher Ct`
+	TableStreetThrasherRd                   TableStreet = `Thrasher Rd`
+	TableStreetThunderhawkLn                TableStreet = `Thunderhawk Ln`
+	TableStreetTidwellRd                    TableStreet = `Tidwell Rd`
+	TableStreetTillberryCt                  TableStreet = `Tillberry Ct`
+	TableStreetTilltonTrl                   TableStreet = `Tillton Trl`
+	TableStreetTimberRidgeDr                TableStreet = `Timber Ridge Dr`
+	TableStreetTimberTrl                    TableStreet = `Timber Trl`
+	TableStreetTimberlakeCv                 TableStreet = `Timberlake Cv`
+	TableStreetTimberlakePte                TableStreet = `Timberlake Pte`
+	TableStreetTimberlandCir                TableStreet = `Timberland Cir`
+	TableStreetTimberlandCt                 TableStreet = `Timberland Ct`
+	TableStreetTimberwalkCt                 TableStreet = `Timberwalk Ct`
+	TableStreetTimberwoodKnol               TableStreet = `Timberwood Knol`
+	TableStreetTimberwoodRd                 TableStreet = `Timberwood Rd`
+	TableStreetTinsleyDr                    TableStreet = `Tinsley Dr`
+	TableStreetToddRd                       TableStreet = `Todd Rd`
+	TableStreetTomJonesRd                   TableStreet = `Tom Jones Rd`
+	TableStreetTomahawkDr                   TableStreet = `Tomahawk Dr`
+	TableStreetTopRidgeCt                   TableStreet = `Top Ridge Ct`
+	TableStreetTopridgeDr                   TableStreet = `Topridge Dr`
+	TableStreetToriLn                       TableStreet = `Tori Ln`
+	TableStreetToweChapelRd                 TableStreet = `Towe Chapel Rd`
+	TableStreetTowerDr                      TableStreet = `Tower Dr`
+	TableStreetTowerRidgeRd                 TableStreet = `Tower Ridge Rd`
+	TableStreetTowerSt                      TableStreet = `Tower St`
+	TableStreetTownAndCountryDr             TableStreet = `Town And Country Dr`
+	TableStreetTownsendDr                   TableStreet = `Townsend Dr`
+	TableStreetTownsendTeagueRd             TableStreet = `Townsend Teague Rd`
+	TableStreetTownsleyDr                   TableStreet = `Townsley Dr`
+	TableStreetTracyLn                      TableStreet = `Tracy Ln`
+	TableStreetTramoreCt                    TableStreet = `Tramore Ct`
+	TableStreetTrappersCv                   TableStreet = `Trappers Cv`
+	TableStreetTravelersPath                TableStreet = `Travelers Path`
+	TableStreetTreemontDr                   TableStreet = `Treemont Dr`
+	TableStreetTriangleLn                   TableStreet = `Triangle Ln`
+	TableStreetTrimbleHollowRd              TableStreet = `Trimble Hollow Rd`
+	TableStreetTrottersWalk                 TableStreet = `Trotters Walk`
+	TableStreetTubaDr                       TableStreet = `Tuba Dr`
+	TableStreetTumlinSt                     TableStreet = `Tumlin St`
+	TableStreetTupeloDr                     TableStreet = `Tupelo Dr`
+	TableStreetTurnberryCt                  TableStreet = `Turnberry Ct`
+	TableStreetTurnerDr                     TableStreet = `Turner Dr`
+	TableStreetTurnerSt                     TableStreet = `Turner St`
+	TableStreetTurtleRockCt                 TableStreet = `Turtle Rock Ct`
+	TableStreetTwelveOaksDr                 TableStreet = `Twelve Oaks Dr`
+	TableStreetTwinBranchesLn               TableStreet = `Twin Branches Ln`
+	TableStreetTwinBridgesRd                TableStreet = `Twin Bridges Rd`
+	TableStreetTwinOaksLn                   TableStreet = `Twin Oaks Ln`
+	TableStreetTwinPinesRd                  TableStreet = `Twin Pines Rd`
+	TableStreetTwinleafCt                   TableStreet = `Twinleaf Ct`
+	TableStreetTwoGunBaileyRd               TableStreet = `Two Gun Bailey Rd`
+	TableStreetTwoRunCreekRd                TableStreet = `Two Run Creek Rd`
+	TableStreetTwoRunCreekTrl               TableStreet = `Two Run Creek Trl`
+	TableStreetTwoRunTrc                    TableStreet = `Two Run Trc`
+	TableStreetTwoRunXing                   TableStreet = `Two Run Xing`
+	TableStreetUnbridledRd                  TableStreet = `Unbridled Rd`
+	TableStreetValHollowRidgeRd             TableStreet = `Val Hollow Ridge Rd`
+	TableStreetValleyCreekDr                TableStreet = `Valley Creek Dr`
+	TableStreetValleyDaleDr                 TableStreet = `Valley Dale Dr`
+	TableStreetValleyDr                     TableStreet = `Valley Dr`
+	TableStreetValleyTrl                    TableStreet = `Valley Trl`
+	TableStreetValleyViewCt                 TableStreet = `Valley View Ct`
+	TableStreetValleyViewDr                 TableStreet = `Valley View Dr`
+	TableStreetValleyViewFarmRd             TableStreet = `Valley View Farm Rd`
+	TableStreetVaughanCt                    TableStreet = `Vaughan Ct`
+	TableStreetVaughanDr                    TableStreet = `Vaughan Dr`
+	TableStreetVaughnDairyRd                TableStreet = `Vaughn Dairy Rd`
+	TableStreetVaughnRd                     TableStreet = `Vaughn Rd`
+	TableStreetVaughnSpur                   TableStreet = `Vaughn Spur`
+	TableStreetVeachLoop                    TableStreet = `Veach Loop`
+	TableStreetVermontAve                   TableStreet = `Vermont Ave`
+	TableStreetVictoriaDr                   TableStreet = `Victoria Dr`
+	TableStreetVigilantSt                   TableStreet = `Vigilant St`
+	TableStreetVillageDr                    TableStreet = `Village Dr`
+	TableStreetVillageTrc                   TableStreet = `Village Trc`
+	TableStreetVineyardMountainRd           TableStreet = `Vineyard Mountain Rd`
+	TableStreetVineyardRd                   TableStreet = `Vineyard Rd`
+	TableStreetVineyardWay                  TableStreet = `Vineyard Way`
+	TableStreetVinningsLn                   TableStreet = `Vinnings Ln`
+	TableStreetVintageCt                    TableStreet = `Vintage Ct`
+	TableStreetVintagewoodCv                TableStreet = `Vintagewood Cv`
+	TableStreetVirginiaAve                  TableStreet = `Virginia Ave`
+	TableStreetVistaCt                      TableStreet = `Vista Ct`
+	TableStreetVistaWoodsDr                 TableStreet = `Vista Woods Dr`
+	TableStreetVulcanQuarryRd               TableStreet = `Vulcan Quarry Rd`
+	TableStreetWCarterSt                    TableStreet = `W Carter St`
+	TableStreetWCherokeeAve                 TableStreet = `W Cherokee Ave`
+	TableStreetWChurchSt                    TableStreet = `W Church St`
+	TableStreetWFeltonRd                    TableStreet = `W Felton Rd`
+	TableStreetWGeorgeSt                    TableStreet = `W George St`
+	TableStreetWGeorgiaAve                  TableStreet = `W Georgia Ave`
+	TableStreetWHolidayCt                   TableStreet = `W Holiday Ct`
+	TableStreetWHowardSt                    TableStreet = `W Howard St`
+	TableStreetWIndianaAve                  TableStreet = `W Indiana Ave`
+	TableStreetWIronBeltRd                  TableStreet = `W Iron Belt Rd`
+	TableStreetWLeeSt                       TableStreet = `W Lee St`
+	TableStreetWMainSt                      TableStreet = `W Main St`
+	TableStreetWOakGroveRd                  TableStreet = `W Oak Grove Rd`
+	TableStreetWPorterSt                    TableStreet = `W Porter St`
+	TableStreetWRailroadSt                  TableStreet = `W Railroad St`
+	TableStreetWRockySt                     TableStreet = `W Rocky St`
+	TableStreetWadeChapelRd                 TableStreet = `Wade Chapel Rd`
+	TableStreetWadeRd                       TableStreet = `Wade Rd`
+	TableStreetWagonwheelDr                 TableStreet = `Wagonwheel Dr`
+	TableStreetWaldenXing                   TableStreet = `Walden Xing`
+	TableStreetWalkerHillsCir               TableStreet = `Walker Hills Cir`
+	TableStreetWalkerRd                     TableStreet = `Walker Rd`
+	TableStreetWalkerSt                     TableStreet = `Walker St`
+	TableStreetWalnutDr                     TableStreet = `Walnut Dr`
+	TableStreetWalnutGroveRd                TableStreet = `Walnut Grove Rd`
+	TableStreetWalnutLn                     TableStreet = `Walnut Ln`
+	TableStreetWalnutTrl                    TableStreet = `Walnut Trl`
+	TableStreetWaltWay                      TableStreet = `Walt Way`
+	TableStreetWaltonSt                     TableStreet = `Walton St`
+	TableStreetWandaDr                      TableStreet = `Wanda Dr`
+	TableStreetWansleyDr                    TableStreet = `Wansley Dr`
+	TableStreetWardCir                      TableStreet = `Ward Cir`
+	TableStreetWardMountainRd               TableStreet = `Ward Mountain Rd`
+	TableStreetWardMountainTrl              TableStreet = `Ward Mountain Trl`
+	TableStreetWarrenCv                     TableStreet = `Warren Cv`
+	TableStreetWashakieLn                   TableStreet = `Washakie Ln`
+	TableStreetWaterTowerRd                 TableStreet = `Water Tower Rd`
+	TableStreetWaterfordDr                  TableStreet = `Waterford Dr`
+	TableStreetWaterfrontDr                 TableStreet = `Waterfront Dr`
+	TableStreetWatersEdgeDr                 TableStreet = `Waters Edge Dr`
+	TableStreetWatersView                   TableStreet = `Waters View`
+	TableStreetWatersideDr                  TableStreet = `Waterside Dr`
+	TableStreetWaterstoneCt                 TableStreet = `Waterstone Ct`
+	TableStreetWaterstoneDr                 TableStreet = `Waterstone Dr`
+	TableStreetWattersRd                    TableStreet = `Watters Rd`
+	TableStreetWaylandCir                   TableStreet = `Wayland Cir`
+	TableStreetWayneAve                     TableStreet = `Wayne Ave`
+	TableStreetWayneDr                      TableStreet = `Wayne Dr`
+	TableStreetWaysideDr                    TableStreet = `Wayside Dr`
+	TableStreetWaysideRd                    TableStreet = `Wayside Rd`
+	TableStreetWeaverSt                     TableStreet = `Weaver St`
+	TableStreetWebbDr                       TableStreet = `Webb Dr`
+	TableStreetWebstersFerryLndg            TableStreet = `Websters Ferry Lndg`
+	TableStreetWebstersOverlookDr           TableStreet = `Websters Overlook Dr`
+	TableStreetWebstersOverlookLndg         TableStreet = `Websters Overlook Lndg`
+	TableStreetWeemsDr                      TableStreet = `Weems Dr`
+	TableStreetWeemsRd                      TableStreet = `Weems Rd`
+	TableStreetWeemsSpur                    TableStreet = `Weems Spur`
+	TableStreetWeepingWillowLn              TableStreet = `Weeping Willow Ln`
+	TableStreetWeissingerRd                 TableStreet = `Weissinger Rd`
+	TableStreetWellingtonDr                 TableStreet = `Wellington Dr`
+	TableStreetWellonsRd                    TableStreet = `Wellons Rd`
+	TableStreetWellsDr                      TableStreet = `Wells Dr`
+	TableStreetWellsSt                      TableStreet = `Wells St`
+	TableStreetWentercressDr                TableStreet = `Wentercress Dr`
+	TableStreetWesleyDrewLn                 TableStreet = `Wesley Drew Ln`
+	TableStreetWesleyMillDr                 TableStreet = `Wesley Mill Dr`
+	TableStreetWesleyRd                     TableStreet = `Wesley Rd`
+	TableStreetWesleyTrc                    TableStreet = `Wesley Trc`
+	TableStreetWessingtonPl                 TableStreet = `Wessington Pl`
+	TableStreetWestAve                      TableStreet = `West Ave`
+	TableStreetWestDr                       TableStreet = `West Dr`
+	TableStreetWestLn                       TableStreet = `West Ln`
+	TableStreetWestOakDr                    TableStreet = `West Oak Dr`
+	TableStreetWestRidgeCt                  TableStreet = `West Ridge Ct`
+	TableStreetWestRidgeDr                  TableStreet = `West Ridge Dr`
+	TableStreetWestchesterDr                TableStreet = `Westchester Dr`
+	TableStreetWestfieldPark                TableStreet = `Westfield Park`
+	TableStreetWestgateDr                   TableStreet = `Westgate Dr`
+	TableStreetWestminsterDr                TableStreet = `Westminster Dr`
+	TableStreetWestoverDr                   TableStreet = `Westover Dr`
+	TableStreetWestoverRdg                  TableStreet = `Westover Rdg`
+	TableStreetWestsideChas                 TableStreet = `Westside Chas`
+	TableStreetWestviewDr                   TableStreet = `Westview Dr`
+	TableStreetWestwoodCir                  TableStreet = `Westwood Cir`
+	TableStreetWestwoodDr                   TableStreet = `Westwood Dr`
+	TableStreetWestwoodLn                   TableStreet = `Westwood Ln`
+	TableStreetWetlandsRd                   TableStreet = `Wetlands Rd`
+	TableStreetWexfordCir                   TableStreet = `Wexford Cir`
+	TableStreetWeyBridgeCt                  TableStreet = `Wey Bridge Ct`
+	TableStreetWheelerRd                    TableStreet = `Wheeler Rd`
+	TableStreetWhipporwillCt                TableStreet = `Whipporwill Ct`
+	TableStreetWhipporwillLn                TableStreet = `Whipporwill Ln`
+	TableStreetWhisperingPineCir            TableStreet = `Whispering Pine Cir`
+	TableStreetWhisperingPineLn             TableStreet = `Whispering Pine Ln`
+	TableStreetWhistleStopDr                TableStreet = `Whistle Stop Dr`
+	TableStreetWhiteOakDr                   TableStreet = `White Oak Dr`
+	TableStreetWhiteRd                      TableStreet = `White Rd`
+	TableStreetWidgeonWay                   TableStreet = `Widgeon Way`
+	TableStreetWildFlowerTrl                TableStreet = `Wild Flower Trl`
+	TableStreetWildberryPath                TableStreet = `Wildberry Path`
+	TableStreetWildernessCampRd             TableStreet = `Wilderness Camp Rd`
+	TableStreetWildwoodDr                   TableStreet = `Wildwood Dr`
+	TableStreetWilkeySt                     TableStreet = `Wilkey St`
+	TableStreetWilkinsRd                    TableStreet = `Wilkins Rd`
+	TableStreetWilliamDr                    TableStreet = `William Dr`
+	TableStreetWilliamsCreekTrl             TableStreet = `Williams Creek Trl`
+	TableStreetWilliamsRd                   TableStreet = `Williams Rd`
+	TableStreetWilliamsSpurRd               TableStreet = `Williams Spur Rd`
+	TableStreetWilliamsSt                   TableStreet = `Williams St`
+	TableStreetWillisRd                     TableStreet = `Willis Rd`
+	TableStreetWillowBendDr                 TableStreet = `Willow Bend Dr`
+	TableStreetWillowCir                    TableStreet = `Willow Cir`
+	TableStreetWillowCt                     TableStreet = `Willow Ct`
+	TableStreetWillowLn                     TableStreet = `Willow Ln`
+	TableStreetWillowTrc                    TableStreet = `Willow Trc`
+	TableStreetWillowWoodLn                 TableStreet = `Willow Wood Ln`
+	TableStreetWillowbrookCt                TableStreet = `Willowbrook Ct`
+	TableStreetWilmerWay                    TableStreet = `Wilmer Way`
+	TableStreetWilsonSt                     TableStreet = `Wilson St`
+	TableStreetWinchesterAve                TableStreet = `Winchester Ave`
+	TableStreetWinchesterDr                 TableStreet = `Winchester Dr`
+	TableStreetWindcliffCt                  TableStreet = `Windcliff Ct`
+	TableStreetWindermereBend               TableStreet = `Windermere Bend`
+	TableStreetWindfieldDr                  TableStreet = `Windfield Dr`
+	TableStreetWindingBranchTrc             TableStreet = `Winding Branch Trc`
+	TableStreetWindingWaterCv               TableStreet = `Winding Water Cv`
+	TableStreetWindrushDr                   TableStreet = `Windrush Dr`
+	TableStreetWindsorCt                    TableStreet = `Windsor Ct`
+	TableStreetWindsorTrc                   TableStreet = `Windsor Trc`
+	TableStreetWindyHillRd                  TableStreet = `Windy Hill Rd`
+	TableStreetWingfootCt                   TableStreet = `Wingfoot Ct`
+	TableStreetWingfootTrl                  TableStreet = `Wingfoot Trl`
+	TableStreetWinnieFrankRd                TableStreet = `Winnie Frank Rd`
+	TableStreetWinterWoodCt                 TableStreet = `Winter Wood Ct`
+	TableStreetWinterWoodCv                 TableStreet = `Winter Wood Cv`
+	TableStreetWinterWoodDr                 TableStreet = `Winter Wood Dr`
+	TableStreetWinterWoodTrc                TableStreet = `Winter Wood Trc`
+	TableStreetWinterWoodTrl                TableStreet = `Winter Wood Trl`
+	TableStreetWinterWoodWay                TableStreet = `Winter Wood Way`
+	TableStreetWintersetDr                  TableStreet = `Winterset Dr`
+	TableStreetWisemanRd                    TableStreet = `Wiseman Rd`
+	TableStreetWisteriaTrl                  TableStreet = `Wisteria Trl`
+	TableStreetWoffordSt                    TableStreet = `Wofford St`
+	TableStreetWolfcliffRd                  TableStreet = `Wolfcliff Rd`
+	TableStreetWolfpenPass                  TableStreet = `Wolfpen Pass`
+	TableStreetWolfridgeTrl                 TableStreet = `Wolfridge Trl`
+	TableStreetWomackDr                     TableStreet = `Womack Dr`
+	TableStreetWoodCir                      TableStreet = `Wood Cir`
+	TableStreetWoodForestDr                 TableStreet = `Wood Forest Dr`
+	TableStreetWoodLn                       TableStreet = `Wood Ln`
+	TableStreetWoodRd                       TableStreet = `Wood Rd`
+	TableStreetWoodSt                       TableStreet = `Wood St`
+	TableStreetWoodallRd                    TableStreet = `Woodall Rd`
+	TableStreetWoodbineDr                   TableStreet = `Woodbine Dr`
+	TableStreetWoodbridgeDr                 TableStreet = `Woodbridge Dr`
+	TableStreetWoodcrestDr                  TableStreet = `Woodcrest Dr`
+	TableStreetWoodcrestRd                  TableStreet = `Woodcrest Rd`
+	TableStreetWooddaleDr                   TableStreet = `Wooddale Dr`
+	TableStreetWoodhavenCt                  TableStreet = `Woodhaven Ct`
+	TableStreetWoodhavenDr                  TableStreet = `Woodhaven Dr`
+	TableStreetWoodlandBridgeRd             TableStreet = `Woodland Bridge Rd`
+	TableStreetWoodlandDr                   TableStreet = `Woodland Dr`
+	TableStreetWoodlandRd                   TableStreet = `Woodland Rd`
+	TableStreetWoodlandWay                  TableStreet = `Woodland Way`
+	TableStreetWoodlandsWay                 TableStreet = `Woodlands Way`
+	TableStreetWoodsongCt                   TableStreet = `Woodsong Ct`
+	TableStreetWoodviewDr                   TableStreet = `Woodview Dr`
+	TableStreetWoodvineCt                   TableStreet = `Woodvine Ct`
+	TableStreetWoodvineDr                   TableStreet = `Woodvine Dr`
+	TableStreetWoodyRd                      TableStreet = `Woody Rd`
+	TableStreetWooleysRd                    TableStreet = `Wooleys Rd`
+	TableStreetWorthingtonRd                TableStreet = `Worthington Rd`
+	TableStreetWrenCt                       TableStreet = `Wren Ct`
+	TableStreetWykleSt                      TableStreet = `Wykle St`
+	TableStreetWynnLoop                     TableStreet = `Wynn Loop`
+	TableStreetWynnRd                       TableStreet = `Wynn Rd`
+	TableStreetYachtClubRd                  TableStreet = `Yacht Club Rd`
+	TableStreetYellowBrickRd                TableStreet = `Yellow Brick Rd`
+	TableStreetYellowHammerDr               TableStreet = `Yellow Hammer Dr`
+	TableStreetYonahCt                      TableStreet = `Yonah Ct`
+	TableStreetYorkTrl                      TableStreet = `York Trl`
+	TableStreetYoungDeerTrl                 TableStreet = `Young Deer Trl`
+	TableStreetYoungLoop                    TableStreet = `Young Loop`
+	TableStreetYoungRd                      TableStreet = `Young Rd`
+	TableStreetYoungSt                      TableStreet = `Young St`
+	TableStreetYoungsMillRd                 TableStreet = `Youngs Mill Rd`
+	TableStreetZenaDr                       TableStreet = `Zena Dr`
+	TableStreetZionRd                       TableStreet = `Zion Rd`
+)
+
+// Description of the value, or empty if the value is not in table Street.
+func (v TableStreet) Description() string {
+	return tableDescription(`Street`, string(v))
+}
+
+// Valid reports if the value is in table Street.
+func (v TableStreet) Valid() bool {
+	return TableValueLookup[`Street`][string(v)]
+}
+
+// Table Street.
+func (v TableStreet) Table() Table {
+	return TableLookup[`Street`]
+}
+
+// TableZipCode is a value of table ZipCode, Zip Code.
+// Fields in this table are strings; convert a field, such as TableZipCode(v), to use the helpers.
+type TableZipCode string
+
+const (
+	TableZipCode01770 TableZipCode = `01770`
+	TableZipCode02030 TableZipCode = `02030`
+	TableZipCode02108 TableZipCode = `02108`
+	TableZipCode02109 TableZipCode = `02109`
+	TableZipCode02110 TableZipCode = `02110`
+	TableZipCode02199 TableZipCode = `02199`
+	TableZipCode02481 TableZipCode = `02481`
+	TableZipCode02493 TableZipCode = `02493`
+	TableZipCode06820 TableZipCode = `06820`
+	TableZipCode06830 TableZipCode = `06830`
+	TableZipCode06831 TableZipCode = `06831`
+	TableZipCode06840 TableZipCode = `06840`
+	TableZipCode06853 TableZipCode = `06853`
+	TableZipCode06870 TableZipCode = `06870`
+	TableZipCode06878 TableZipCode = `06878`
+	TableZipCode06880 TableZipCode = `06880`
+	TableZipCode06883 TableZipCode = `06883`
+	TableZipCode07021 TableZipCode = `07021`
+	TableZipCode07046 TableZipCode = `07046`
+	TableZipCode07078 TableZipCode = `07078`
+	TableZipCode07417 TableZipCode = `07417`
+	TableZipCode07458 TableZipCode = `07458`
+	TableZipCode07620 TableZipCode = `07620`
+	TableZipCode07760 TableZipCode = `07760`
+	TableZipCode07924 TableZipCode = `07924`
+	TableZipCode07931 TableZipCode = `07931`
+	TableZipCode07945 TableZipCode = `07945`
+	TableZipCode10004 TableZipCode = `10004`
+	TableZipCode10017 TableZipCode = `10017`
+	TableZipCode10018 TableZipCode = `10018`
+	TableZipCode10021 TableZipCode = `10021`
+	TableZipCode10022 TableZipCode = `10022`
+	TableZipCode10028 TableZipCode = `10028`
+	TableZipCode10069 TableZipCode = `10069`
+	TableZipCode10504 TableZipCode = `10504`
+	TableZipCode10506 TableZipCode = `10506`
+	TableZipCode10514 TableZipCode = `10514`
+	TableZipCode10538 TableZipCode = `10538`
+	TableZipCode10576 TableZipCode = `10576`
+	TableZipCode10577 TableZipCode = `10577`
+	TableZipCode10580 TableZipCode = `10580`
+	TableZipCode10583 TableZipCode = `10583`
+	TableZipCode11024 TableZipCode = `11024`
+	TableZipCode11030 TableZipCode = `11030`
+	TableZipCode11568 TableZipCode = `11568`
+	TableZipCode11724 TableZipCode = `11724`
+	TableZipCode19035 TableZipCode = `19035`
+	TableZipCode19041 TableZipCode = `19041`
+	TableZipCode19085 TableZipCode = `19085`
+	TableZipCode19807 TableZipCode = `19807`
+	TableZipCode20198 TableZipCode = `20198`
+	TableZipCode20854 TableZipCode = `20854`
+	TableZipCode22066 TableZipCode = `22066`
+	TableZipCode23219 TableZipCode = `23219`
+	TableZipCode28207 TableZipCode = `28207`
+	TableZipCode30326 TableZipCode = `30326`
+	TableZipCode32963 TableZipCode = `32963`
+	TableZipCode33480 TableZipCode = `33480`
+	TableZipCode33786 TableZipCode = `33786`
+	TableZipCode33921 TableZipCode = `33921`
+	TableZipCode34102 TableZipCode = `34102`
+	TableZipCode34108 TableZipCode = `34108`
+	TableZipCode34228 TableZipCode = `34228`
+	TableZipCode60022 TableZipCode = `60022`
+	TableZipCode60043 TableZipCode = `60043`
+	TableZipCode60045 TableZipCode = `60045`
+	TableZipCode60093 TableZipCode = `60093`
+	TableZipCode60521 TableZipCode = `60521`
+	TableZipCode60606 TableZipCode = `60606`
+	TableZipCode60611 TableZipCode = `60611`
+	TableZipCode63124 TableZipCode = `63124`
+	TableZipCode74103 TableZipCode = `74103`
+	TableZipCode75205 TableZipCode = `75205`
+	TableZipCode75225 TableZipCode = `75225`
+	TableZipCode76102 TableZipCode = `76102`
+	TableZipCode77002 TableZipCode = `77002`
+	TableZipCode77024 TableZipCode = `77024`
+	TableZipCode78257 TableZipCode = `78257`
+	TableZipCode83014 TableZipCode = `83014`
+	TableZipCode85253 TableZipCode = `85253`
+	TableZipCode89451 TableZipCode = `89451`
+	TableZipCode90067 TableZipCode = `90067`
+	TableZipCode90077 TableZipCode = `90077`
+	TableZipCode90210 TableZipCode = `90210`
+	TableZipCode90212 TableZipCode = `90212`
+	TableZipCode90272 TableZipCode = `90272`
+	TableZipCode90402 TableZipCode = `90402`
+	TableZipCode91436 TableZipCode = `91436`
+	TableZipCode92067 TableZipCode = `92067`
+	TableZipCode92091 TableZipCode = `92091`
+	TableZipCode92657 TableZipCode = `92657`
+	TableZipCode93108 TableZipCode = `93108`
+	TableZipCode94022 TableZipCode = `94022`
+	TableZipCode94027 TableZipCode = `94027`
+	TableZipCode94028 TableZipCode = `94028`
+	TableZipCode94062 TableZipCode = `94062`
+	TableZipCode94111 TableZipCode = `94111`
+	TableZipCode94301 TableZipCode = `94301`
+	TableZipCode94304 TableZipCode = `94304`
+	TableZipCode94920 TableZipCode = `94920`
+	TableZipCode98039 TableZipCode = `98039`
+)
+
+// Description of the value, or empty if the value is not in table ZipCode.
+func (v TableZipCode) Description() string {
+	return tableDescription(`ZipCode`, string(v))
+}
+
+// Valid reports if the value is in table ZipCode.
+func (v TableZipCode) Valid() bool {
+	return TableValueLookup[`ZipCode`][string(v)]
+}
+
+// Table ZipCode.
+func (v TableZipCode) Table() Table {
+	return TableLookup[`ZipCode`]
+}
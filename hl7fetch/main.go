@@ -19,6 +19,7 @@ import (
 	"time"
 	"unicode"
 
+	"github.com/kardianos/hl7"
 	"github.com/kardianos/task"
 )
 
@@ -90,6 +91,7 @@ func run(ctx context.Context) error {
 	rootDir := flag.String("root", "", "root of data")
 	pkgDir := flag.String("pkgdir", "", "package directory for generated files")
 	allowNetwork := flag.Bool("network", false, "allow making network calls")
+	enum := flag.Bool("enum", false, "generate typed constants for each table")
 	flag.Parse()
 
 	if len(*rootDir) == 0 {
@@ -251,6 +253,9 @@ func run(ctx context.Context) error {
 		Table       []TableType
 
 		ControlSegment []string
+		EventStructure []EventStructure
+		Enum           []EnumType
+		Coded          []CodedType
 	}
 
 	to := To{
@@ -299,6 +304,15 @@ func run(ctx context.Context) error {
 		a, b := to.Table[i], to.Table[j]
 		return a.ID < b.ID
 	})
+	for _, t := range to.Table {
+		if t.ID != messageStructureTable {
+			continue
+		}
+		to.EventStructure = eventStructureList(t)
+	}
+	if *enum {
+		to.Enum = enumList(to.Table)
+	}
 
 	displaySan := strings.NewReplacer(
 		",", "-",
@@ -396,9 +410,11 @@ func run(ctx context.Context) error {
 			processField(dt.ID, f, j+1, unique)
 		}
 	}
+	to.Coded = codedList(to.DataType)
 	for i := range to.Segment {
 		unique := map[string]int{}
 		s := &to.Segment[i]
+		s.Fields = successive(s.ID, s.Fields)
 		for j := range s.Fields {
 			f := &s.Fields[j]
 			processField(s.ID, f, j+1, unique)
@@ -453,6 +469,20 @@ func run(ctx context.Context) error {
 		})
 	}
 
+	// Segments with a single SetID string field, set from the segment position by builders.
+	setIDSegments := map[string]bool{}
+	for _, s := range to.Segment {
+		for _, f := range s.Fields {
+			if f.ID != "SetID" || f.Rpt != "1" {
+				continue
+			}
+			switch f.DataType {
+			case "SI", "ST", "NM":
+				setIDSegments[s.ID] = true
+			}
+		}
+	}
+
 	type memorizeKey struct {
 		id   string
 		name string
@@ -465,6 +495,31 @@ func run(ctx context.Context) error {
 	memorizeUnique := map[memorizeKey]bool{}
 	var memorizeList []memorizeValue
 
+	// typePrefix returns the Go type prefix of a field: "[]" if it repeats, "*" if it is optional, or empty.
+	typePrefix := func(f Field) string {
+		if f.Rpt != "1" || f.Rpt == "*" {
+			return "[]"
+		}
+		// Special case these, where empty is not present.
+		switch f.DataType {
+		default:
+			if emptyDataTypes[f.DataType] {
+				return ""
+			}
+		case "TS", "TM", "DTM", "DT":
+			return ""
+		case "VARIES":
+			// continue.
+		case "FN":
+			return ""
+		}
+		switch f.Usage {
+		case "O", "C":
+			return "*"
+		}
+		return ""
+	}
+
 	funcs := map[string]any{
 		"raw": func(c string) string {
 			return strings.Map(rawMap, c)
@@ -498,28 +553,9 @@ func run(ctx context.Context) error {
 
 			return buf.String()
 		},
-		"typeprefix": func(f Field) string {
-			if f.Rpt != "1" || f.Rpt == "*" {
-				return "[]"
-			}
-			// Special case these, where empty is not present.
-			switch f.DataType {
-			default:
-				if emptyDataTypes[f.DataType] {
-					return ""
-				}
-			case "TS", "TM", "DTM", "DT":
-				return ""
-			case "VARIES":
-				// continue.
-			case "FN":
-				return ""
-			}
-			switch f.Usage {
-			case "O", "C":
-				return "*"
-			}
-			return ""
+		"typeprefix": typePrefix,
+		"variesFields": func(st SegmentType) []VariesField {
+			return variesList(st, typePrefix)
 		},
 		"tag": func(f Field) string {
 			buf := &strings.Builder{}
@@ -561,6 +597,10 @@ func run(ctx context.Context) error {
 				buf.WriteString(",format=YMDHM")
 			case "TS":
 				buf.WriteString(",format=YMDHMS")
+			case "NM":
+				buf.WriteString(",format=NM")
+			case "SI":
+				buf.WriteString(",format=SI")
 			}
 			desc := f.Description
 			if len(desc) == 0 {
@@ -664,6 +704,16 @@ func run(ctx context.Context) error {
 			}
 			return ""
 		},
+		"setID": func(st SegmentType) bool {
+			return setIDSegments[st.ID]
+		},
+		"segSetID": func(name string) bool {
+			return setIDSegments[name]
+		},
+		"maxRpt": func(rpt string) int {
+			n, _ := strconv.Atoi(rpt)
+			return n
+		},
 		"hasField": func(name string, ff []Field) bool {
 			for _, f := range ff {
 				if f.ID == name {
@@ -688,6 +738,9 @@ func run(ctx context.Context) error {
 			continue
 		}
 		name := strings.TrimSuffix(temp.Name(), ".template")
+		if name == "enum.go" && !*enum {
+			continue
+		}
 		err = temp.Execute(buf, to)
 		if err != nil {
 			return fmt.Errorf("template %s: %w", name, err)
@@ -755,3 +808,283 @@ func visitAllSegment(top string, parent *TriggerSegment, list []TriggerSegment,
 	}
 	return nil
 }
+
+// HL7 table 0354 lists the message structure for each trigger event.
+const messageStructureTable = "0354"
+
+type EventStructure struct {
+	Event     string // Message code and trigger event, such as "ADT_A04".
+	Structure string // Message structure, such as "ADT_A01".
+}
+
+// eventStructureList parses the event list in each message structure row.
+// The description of each row lists the trigger events, such as "A01, A04, A08, A13".
+// Events that share a name with a message structure always map to that structure.
+func eventStructureList(t TableType) []EventStructure {
+	eventClean := strings.NewReplacer(
+		"|", "I", // "I16, |17, |18"
+		".", ",", // "I08, I09. I10"
+	)
+	seen := map[string]bool{}
+	var list []EventStructure
+	add := func(event, structure string) {
+		if seen[event] {
+			return
+		}
+		seen[event] = true
+		list = append(list, EventStructure{
+			Event:     event,
+			Structure: structure,
+		})
+	}
+	for _, row := range t.Entries {
+		if !strings.Contains(row.Value, "_") {
+			continue
+		}
+		add(row.Value, row.Value)
+	}
+	for _, row := range t.Entries {
+		code, _, ok := strings.Cut(row.Value, "_")
+		if !ok {
+			continue
+		}
+		for _, ev := range strings.Split(eventClean.Replace(row.Description), ",") {
+			ev = strings.TrimSpace(ev)
+			if !validEvent(ev) {
+				continue
+			}
+			add(code+"_"+ev, row.Value)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Event < list[j].Event
+	})
+	return list
+}
+
+// validEvent reports if the event looks like a trigger event code, such as "A01" or "PC6".
+// Placeholders such as "Znn" and notes such as "Varies" are rejected.
+func validEvent(ev string) bool {
+	if len(ev) != 3 {
+		return false
+	}
+	for i, r := range ev {
+		switch {
+		default:
+			return false
+		case r >= 'A' && r <= 'Z':
+		case r >= '0' && r <= '9' && i > 0:
+		}
+	}
+	return true
+}
+
+type EnumType struct {
+	Type    string // Go type, such as "Table0001".
+	TableID string
+	Name    string
+	Values  []EnumValue
+}
+
+type EnumValue struct {
+	Name        string // Go constant, such as "Table0001Female".
+	Value       string
+	Description string
+}
+
+// maxEnumWords limits the words of a description used in a constant name.
+const maxEnumWords = 6
+
+// enumList returns a typed enum for each table with entries.
+// Constant names are made from the description of each value, or the value
+// itself if there is no description. Duplicate names are suffixed with the value.
+func enumList(tables []TableType) []EnumType {
+	var list []EnumType
+	for _, t := range tables {
+		typ := "Table" + enumIdent(t.ID, 0)
+		if len(t.Entries) == 0 || typ == "Table" {
+			continue
+		}
+		e := EnumType{
+			Type:    typ,
+			TableID: t.ID,
+			Name:    t.Name,
+		}
+		seenValue := map[string]bool{}
+		seenName := map[string]bool{}
+		for _, row := range t.Entries {
+			if seenValue[row.Value] {
+				continue
+			}
+			seenValue[row.Value] = true
+			name := enumIdent(row.Description, maxEnumWords)
+			if len(name) == 0 {
+				name = enumIdent(row.Value, 0)
+			}
+			if seenName[name] {
+				name += "_" + enumIdent(row.Value, 0)
+			}
+			if len(name) == 0 || seenName[name] {
+				continue
+			}
+			seenName[name] = true
+			e.Values = append(e.Values, EnumValue{
+				Name:        typ + name,
+				Value:       row.Value,
+				Description: row.Description,
+			})
+		}
+		list = append(list, e)
+	}
+	return list
+}
+
+// enumIdent returns an identifier from the ASCII letters and digits of the text, in title case.
+// If max is positive, at most max words are used.
+func enumIdent(text string, max int) string {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	})
+	if max > 0 && len(words) > max {
+		words = words[:max]
+	}
+	buf := &strings.Builder{}
+	for _, w := range words {
+		buf.WriteString(strings.ToUpper(w[:1]))
+		buf.WriteString(w[1:])
+	}
+	return buf.String()
+}
+
+// CodedType is a coded data type, such as CWE, with the field names of each identifier triplet.
+type CodedType struct {
+	ID           string
+	Triplets     []CodedTriplet
+	OriginalText string
+}
+
+// CodedTriplet has the field names of an identifier, text and coding system triplet.
+// OID is empty if the version has no coding system OID for the triplet.
+type CodedTriplet struct {
+	Identifier   string
+	Text         string
+	CodingSystem string
+	OID          string
+}
+
+// codedTriplets are the component numbers of the identifier, text, coding system
+// and coding system OID of each triplet in a coded data type.
+var codedTriplets = [][4]int{{1, 2, 3, 14}, {4, 5, 6, 17}, {10, 11, 12, 20}}
+
+// codedList returns the coded data types, CE, CNE and CWE, defined in the version.
+func codedList(dataTypes []DataType) []CodedType {
+	var list []CodedType
+	for _, dt := range dataTypes {
+		switch dt.ID {
+		default:
+			continue
+		case "CE", "CNE", "CWE":
+		}
+		name := map[int]string{}
+		for _, f := range dt.Fields {
+			_, pos, _ := strings.Cut(f.Position, ".")
+			if n, err := strconv.Atoi(pos); err == nil {
+				name[n] = f.ID
+			}
+		}
+		c := CodedType{ID: dt.ID, OriginalText: name[9]}
+		for _, t := range codedTriplets {
+			if len(name[t[0]]) == 0 || len(name[t[2]]) == 0 {
+				continue
+			}
+			c.Triplets = append(c.Triplets, CodedTriplet{
+				Identifier:   name[t[0]],
+				Text:         name[t[1]],
+				CodingSystem: name[t[2]],
+				OID:          name[t[3]],
+			})
+		}
+		list = append(list, c)
+	}
+	return list
+}
+
+// successiveSegments are the segments with a last field that continues in successive fields,
+// such as the user parameters from QPD-3 and the column values from RDT-1.
+var successiveSegments = map[string]bool{
+	"QPD": true,
+	"RDT": true,
+}
+
+// successiveFields is the number of fields generated for a field that continues in successive fields.
+// Later fields are not decoded.
+const successiveFields = 20
+
+// successive returns the fields of a segment, with the last field continued in optional successive
+// fields if the segment is one of successiveSegments. The successive fields are named as duplicates
+// of the last field, such as UserParametersInSuccessiveFields2 for QPD-4.
+func successive(segment string, fields []Field) []Field {
+	if !successiveSegments[segment] || len(fields) == 0 {
+		return fields
+	}
+	last := fields[len(fields)-1]
+	if last.DataType != "VARIES" {
+		return fields
+	}
+	prefix, pos, _ := strings.Cut(last.Position, ".")
+	first, err := strconv.Atoi(pos)
+	if err != nil {
+		return fields
+	}
+	for n := 1; n < successiveFields; n++ {
+		f := last
+		f.Position = prefix + "." + strconv.Itoa(first+n)
+		f.Usage = "O"
+		f.Rpt = "1"
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// VariesField is a VARIES field of a segment, with the field that selects its data type.
+type VariesField struct {
+	Order    string // Position of the VARIES field, such as "5".
+	Selector string // Name of the field that selects the data type.
+	Repeats  bool   // The selector repeats, with a data type for each repetition of the VARIES field.
+	Pointer  bool   // The selector is optional.
+}
+
+// variesList returns the VARIES fields of a segment with a field that selects their data type.
+// Other VARIES fields, such as QPD-3, are resolved with a VariesRegistry.
+func variesList(st SegmentType, typePrefix func(Field) string) []VariesField {
+	var list []VariesField
+	for _, f := range st.Fields {
+		if f.DataType != "VARIES" {
+			continue
+		}
+		_, pos, _ := strings.Cut(f.Position, ".")
+		order, err := strconv.ParseInt(pos, 10, 32)
+		if err != nil {
+			continue
+		}
+		so, ok := hl7.VariesSelector(st.ID, int32(order))
+		if !ok {
+			continue
+		}
+		sel := strconv.Itoa(int(so))
+		for _, sf := range st.Fields {
+			if _, spos, _ := strings.Cut(sf.Position, "."); spos != sel {
+				continue
+			}
+			v := VariesField{Order: pos, Selector: sf.ID}
+			switch typePrefix(sf) {
+			case "[]":
+				v.Repeats = true
+			case "*":
+				v.Pointer = true
+			}
+			list = append(list, v)
+		}
+	}
+	return list
+}
@@ -0,0 +1,474 @@
+package hl7
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// JSONKey selects how JSON object keys are named.
+type JSONKey byte
+
+const (
+	// JSONKeyPosition names fields and components by position, such as "PID.5" and "PID.5.1".
+	// Trigger groups are named by group name, such as "PATIENT_RESULT", and segments by segment name, such as "PID".
+	JSONKeyPosition JSONKey = iota
+	// JSONKeyName names each value by its field name, such as "PatientName" and "FamilyName".
+	// Field names are derived from the HL7 element names. The display names of the
+	// field tags are not used: those of components are descriptions, such as "First name."
+	// for XPN-2, rather than names.
+	JSONKeyName
+)
+
+// JSON encoding and decoding options.
+type JSONOption struct {
+	Key    JSONKey
+	Indent string // Indent each nested value. Only used when encoding.
+}
+
+// JSONEncoder encodes triggers and segments as JSON objects.
+//
+// Empty values are omitted. Times are written as HL7 time strings in the
+// format of the field, as the Encoder writes them, such as "20250609071616",
+// followed by the offset of a time that is not in UTC, such as "202506090716-0500".
+// Repeating fields and segments are written as arrays.
+type JSONEncoder struct {
+	opt JSONOption
+}
+
+// Create a new JSONEncoder. Options may be nil.
+func NewJSONEncoder(opt *JSONOption) *JSONEncoder {
+	e := &JSONEncoder{}
+	if opt != nil {
+		e.opt = *opt
+	}
+	return e
+}
+
+// Encode a trigger or a segment.
+func (e *JSONEncoder) Encode(message any) ([]byte, error) {
+	rv := reflect.ValueOf(message)
+	for rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("unsupported message type %T", message)
+	}
+	metaTag, err := typeMeta(rv.Type())
+	if err != nil {
+		return nil, err
+	}
+	switch metaTag.Type {
+	default:
+		return nil, fmt.Errorf("message type %T is not a trigger or segment", message)
+	case structTrigger, structSegment:
+	}
+	w := &jsonWriter{buf: &bytes.Buffer{}, key: e.opt.Key}
+	if err := w.members("", rv); err != nil {
+		return nil, err
+	}
+	if len(e.opt.Indent) == 0 {
+		return w.buf.Bytes(), nil
+	}
+	out := &bytes.Buffer{}
+	if err := json.Indent(out, w.buf.Bytes(), "", e.opt.Indent); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// jsonMemberKey returns the key of a trigger or trigger group member.
+func jsonMemberKey(key JSONKey, ft reflect.StructField, t tag) (string, error) {
+	if key == JSONKeyName {
+		return ft.Name, nil
+	}
+	metaTag, err := typeMeta(ft.Type)
+	if err != nil {
+		return "", err
+	}
+	if metaTag.Type == structTriggerGroup {
+		return xmlGroupName(ft.Name, t), nil
+	}
+	return ft.Name, nil
+}
+
+// jsonFieldKey returns the key of a segment field or a data type component.
+func jsonFieldKey(key JSONKey, prefix string, ft reflect.StructField, t tag) string {
+	if key == JSONKeyName {
+		return ft.Name
+	}
+	return prefix + "." + strconv.FormatInt(int64(t.Order), 10)
+}
+
+// jsonEmpty reports if a value is omitted.
+func jsonEmpty(rv reflect.Value) bool {
+	switch rv.Kind() {
+	case reflect.Slice:
+		return rv.Len() == 0
+	default:
+		return rv.IsZero()
+	}
+}
+
+type jsonWriter struct {
+	buf *bytes.Buffer
+	key JSONKey
+}
+
+// string writes a JSON string without HTML escaping, so "^~\\&" remains readable.
+func (w *jsonWriter) string(s string) error {
+	enc := json.NewEncoder(w.buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil {
+		return err
+	}
+	// Remove the trailing new line.
+	w.buf.Truncate(w.buf.Len() - 1)
+	return nil
+}
+
+// members writes a trigger, group, segment, or data type as an object.
+// The prefix is the key of a data type value, such as "PID.5".
+func (w *jsonWriter) members(prefix string, rv reflect.Value) error {
+	rt := rv.Type()
+	metaTag, err := typeMeta(rt)
+	if err != nil {
+		return err
+	}
+	if metaTag.Type == structSegment {
+		prefix = metaTag.Name
+	}
+	w.buf.WriteByte('{')
+	n := 0
+	for i := 0; i < rt.NumField(); i++ {
+		ft := rt.Field(i)
+		t, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+		if err != nil {
+			return err
+		}
+		if !t.Present || t.Meta {
+			continue
+		}
+		if t.Omit && !t.FieldSep {
+			continue
+		}
+		fv := rv.Field(i)
+		if jsonEmpty(fv) {
+			continue
+		}
+		var key string
+		switch metaTag.Type {
+		case structTrigger, structTriggerGroup:
+			key, err = jsonMemberKey(w.key, ft, t)
+			if err != nil {
+				return err
+			}
+		default:
+			key = jsonFieldKey(w.key, prefix, ft, t)
+		}
+		if n > 0 {
+			w.buf.WriteByte(',')
+		}
+		n++
+		if err := w.string(key); err != nil {
+			return err
+		}
+		w.buf.WriteByte(':')
+		if err := w.value(key, t, fv); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+	}
+	w.buf.WriteByte('}')
+	return nil
+}
+
+func (w *jsonWriter) value(key string, t tag, rv reflect.Value) error {
+	switch rv.Kind() {
+	default:
+		return fmt.Errorf("unknown value kind: %v", rv.Kind())
+	case reflect.Interface, reflect.Pointer:
+		if rv.IsNil() {
+			w.buf.WriteString("null")
+			return nil
+		}
+		return w.value(key, t, rv.Elem())
+	case reflect.String:
+		return w.string(rv.String())
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return w.string(string(rv.Bytes()))
+		}
+		w.buf.WriteByte('[')
+		for i := 0; i < rv.Len(); i++ {
+			if i > 0 {
+				w.buf.WriteByte(',')
+			}
+			if err := w.value(key, t, rv.Index(i)); err != nil {
+				return err
+			}
+		}
+		w.buf.WriteByte(']')
+		return nil
+	case reflect.Struct:
+		if rv.Type() == timeType {
+			v := rv.Interface().(time.Time)
+			if v.IsZero() {
+				return w.string("")
+			}
+			return w.string(jsonTime(t, v))
+		}
+		if rv.Type() == numberType {
+			return w.string(rv.Interface().(Number).String())
+		}
+		return w.members(key, rv)
+	}
+}
+
+// jsonTime returns the time in the format of the field, as the Encoder writes it,
+// followed by the offset if the time is not in UTC, such as a time decoded with an offset.
+func jsonTime(t tag, v time.Time) string {
+	s := formatTime(t, v)
+	if v.Location() != time.UTC {
+		s += v.Format("-0700")
+	}
+	return s
+}
+
+// JSONDecoder decodes JSON objects written by the JSONEncoder into the types of a registry.
+type JSONDecoder struct {
+	registry Registry
+	opt      JSONOption
+}
+
+// Create a new JSONDecoder. Options may be nil, but the key option must match the encoder.
+func NewJSONDecoder(registry Registry, opt *JSONOption) *JSONDecoder {
+	d := &JSONDecoder{registry: registry}
+	if opt != nil {
+		d.opt = *opt
+	}
+	return d
+}
+
+// Decode a trigger. The trigger type is chosen from the message header, as with Decoder.Decode.
+// The trigger is returned as a value, such as h251.ADT_A01.
+func (d *JSONDecoder) Decode(data []byte) (any, error) {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	const header = "MSH"
+	raw, ok := m[header]
+	if !ok {
+		return nil, fmt.Errorf("missing %s segment", header)
+	}
+	msh, err := d.DecodeSegment(header, raw)
+	if err != nil {
+		return nil, err
+	}
+	_, rt, err := triggerType([]any{msh}, d.registry, nil)
+	if err != nil {
+		return nil, err
+	}
+	rv := reflect.New(rt).Elem()
+	r := &jsonReader{registry: d.registry, key: d.opt.Key}
+	if err := r.members("", data, rv, nil); err != nil {
+		return nil, err
+	}
+	return rv.Interface(), nil
+}
+
+// DecodeSegment decodes a single segment, such as "PID".
+// The segment is returned as a pointer, such as *h251.PID, as from Decoder.DecodeList.
+func (d *JSONDecoder) DecodeSegment(name string, data []byte) (any, error) {
+	seg, ok := d.registry.Segment(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown segment type %q", name)
+	}
+	rv := reflect.New(reflect.TypeOf(seg))
+	r := &jsonReader{registry: d.registry, key: d.opt.Key}
+	if err := r.members("", data, rv.Elem(), nil); err != nil {
+		return nil, err
+	}
+	return rv.Interface(), nil
+}
+
+type jsonReader struct {
+	registry Registry
+	key      JSONKey
+}
+
+func jsonNull(data []byte) bool {
+	return bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+}
+
+// hasVaries reports if the type is, or is a slice of or pointer to, an interface.
+func hasVaries(rt reflect.Type) bool {
+	for rt.Kind() == reflect.Slice || rt.Kind() == reflect.Pointer {
+		rt = rt.Elem()
+	}
+	return rt.Kind() == reflect.Interface
+}
+
+func (r *jsonReader) members(prefix string, data []byte, rv reflect.Value, vfc variesFunc) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	rt := rv.Type()
+	metaTag, err := typeMeta(rt)
+	if err != nil {
+		return err
+	}
+	if metaTag.Type == structSegment {
+		prefix = metaTag.Name
+		vfc = segmentVaries(rv, r.registry)
+	}
+
+	type field struct {
+		index int
+		key   string
+		tag   tag
+	}
+	var fieldList []field
+	for i := 0; i < rt.NumField(); i++ {
+		ft := rt.Field(i)
+		t, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+		if err != nil {
+			return err
+		}
+		if !t.Present {
+			continue
+		}
+		if t.Meta {
+			if ft.Type.Kind() == reflect.String {
+				rv.Field(i).SetString(t.Name)
+			}
+			continue
+		}
+		var key string
+		switch metaTag.Type {
+		case structTrigger, structTriggerGroup:
+			key, err = jsonMemberKey(r.key, ft, t)
+			if err != nil {
+				return err
+			}
+		default:
+			key = jsonFieldKey(r.key, prefix, ft, t)
+		}
+		fieldList = append(fieldList, field{index: i, key: key, tag: t})
+	}
+
+	// Decode varies fields last, after the field that selects their type.
+	for _, varies := range []bool{false, true} {
+		for _, f := range fieldList {
+			fv := rv.Field(f.index)
+			if hasVaries(fv.Type()) != varies {
+				continue
+			}
+			v, ok := m[f.key]
+			if !ok {
+				continue
+			}
+			delete(m, f.key)
+			if err := r.value(f.key, f.tag, v, fv, vfc); err != nil {
+				return fmt.Errorf("%s: %w", f.key, err)
+			}
+		}
+	}
+	if len(m) > 0 {
+		keys := make([]string, 0, len(m))
+		for key := range m {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		return fmt.Errorf("%s: unknown key %q", metaTag.Name, keys[0])
+	}
+	return nil
+}
+
+func (r *jsonReader) value(key string, t tag, data []byte, rv reflect.Value, vfc variesFunc) error {
+	if jsonNull(data) {
+		return nil
+	}
+	switch rv.Kind() {
+	default:
+		return fmt.Errorf("unknown value kind: %v", rv.Kind())
+	case reflect.Pointer:
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		return r.value(key, t, data, rv.Elem(), vfc)
+	case reflect.Interface:
+		if vfc == nil {
+			return fmt.Errorf("missing varies type")
+		}
+		v, err := vfc(t.Order, 0)
+		if err != nil {
+			return err
+		}
+		if err := r.value(key, t, data, v, vfc); err != nil {
+			return err
+		}
+		rv.Set(v)
+		return nil
+	case reflect.String:
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		rv.SetString(s)
+		return nil
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			var s string
+			if err := json.Unmarshal(data, &s); err != nil {
+				return err
+			}
+			rv.SetBytes([]byte(s))
+			return nil
+		}
+		var list []json.RawMessage
+		if err := json.Unmarshal(data, &list); err != nil {
+			return err
+		}
+		for _, item := range list {
+			ev := reflect.New(rv.Type().Elem()).Elem()
+			if err := r.value(key, t, item, ev, vfc.at(rv.Len())); err != nil {
+				return err
+			}
+			rv.Set(reflect.Append(rv, ev))
+		}
+		return nil
+	case reflect.Struct:
+		if rv.Type() == timeType {
+			var s string
+			if err := json.Unmarshal(data, &s); err != nil {
+				return err
+			}
+			ld := &lineDecoder{}
+			v, err := ld.parseDateTime(s)
+			if err != nil {
+				return err
+			}
+			rv.Set(reflect.ValueOf(v))
+			return nil
+		}
+		if rv.Type() == numberType {
+			var s string
+			if err := json.Unmarshal(data, &s); err != nil {
+				return err
+			}
+			v, err := ParseNumber(s)
+			if err != nil {
+				return err
+			}
+			rv.Set(reflect.ValueOf(v))
+			return nil
+		}
+		return r.members(key, data, rv, vfc)
+	}
+}
@@ -0,0 +1,123 @@
+package hl7
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestJSONEncode(t *testing.T) {
+	var raw = []byte(`MSH|^~\&|LAB|ORG|SYS||20250609071616||ORU^R01^ORU_R01|1|P|2.5.1
+PID|1||123||Smith^John||19800101
+OBR|1|ABC
+OBX|1|CE|GLU||1234-5^Glucose^LN||||||F|||20250609071616-0500
+`)
+	d := NewDecoder(v251.Registry, nil)
+	g, err := d.Decode(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	list := []struct {
+		Key  JSONKey
+		Want []string
+	}{
+		{
+			Key: JSONKeyPosition,
+			Want: []string{
+				`{"MSH":{"MSH.1":"|","MSH.2":"^~\\&",`,
+				`"MSH.7":"20250609071616","MSH.9":{"MSH.9.1":"ORU","MSH.9.2":"R01","MSH.9.3":"ORU_R01"}`,
+				`"PATIENT_RESULT":[{"PATIENT":{"PID":{"PID.1":"1","PID.3":[{"PID.3.1":"123"}],"PID.5":[{"PID.5.1":"Smith","PID.5.2":"John"}],"PID.7":"19800101000000"}}`,
+				`"OBSERVATION":[{"OBX":{"OBX.1":"1","OBX.2":"CE","OBX.3":{"OBX.3.1":"GLU"},"OBX.5":[{"OBX.5.1":"1234-5","OBX.5.2":"Glucose","OBX.5.3":"LN"}],"OBX.11":"F","OBX.14":"20250609071616-0500"}}]`,
+			},
+		},
+		{
+			Key: JSONKeyName,
+			Want: []string{
+				`"PatientResult":[{"Patient":{"PID":{"SetID":"1","PatientIdentifierList":[{"IDNumber":"123"}],"PatientName":[{"FamilyName":"Smith","GivenName":"John"}],"DateTimeOfBirth":"19800101000000"}}`,
+			},
+		},
+	}
+	for _, item := range list {
+		bb, err := NewJSONEncoder(&JSONOption{Key: item.Key}).Encode(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := string(bb)
+		for _, want := range item.Want {
+			if !strings.Contains(got, want) {
+				t.Errorf("missing %s\n%s", want, got)
+			}
+		}
+	}
+}
+
+// TestJSONRoundTrip encodes each message to JSON and decodes it again, which should encode the same.
+func TestJSONRoundTrip(t *testing.T) {
+	fsDir := filepath.Join("testdata", "roundtrip")
+	dirList, err := os.ReadDir(fsDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewDecoder(v251.Registry, nil)
+	e := NewEncoder(nil)
+
+	for _, f := range dirList {
+		if f.IsDir() {
+			continue
+		}
+		name := f.Name()
+		for _, key := range []JSONKey{JSONKeyPosition, JSONKeyName} {
+			opt := &JSONOption{Key: key, Indent: "\t"}
+			je := NewJSONEncoder(opt)
+			jd := NewJSONDecoder(v251.Registry, opt)
+			t.Run(name+"-"+strconv.Itoa(int(key)), func(t *testing.T) {
+				bb, err := os.ReadFile(filepath.Join(fsDir, name))
+				if err != nil {
+					t.Fatal(err)
+				}
+				root, err := d.Decode(bb)
+				if err != nil {
+					t.Fatal("decode", err)
+				}
+				want, err := e.Encode(root)
+				if err != nil {
+					t.Fatal("encode", err)
+				}
+				want = bytes.Clone(want)
+
+				j, err := je.Encode(root)
+				if err != nil {
+					t.Fatal("encode json", err)
+				}
+				root2, err := jd.Decode(j)
+				if err != nil {
+					t.Fatalf("decode json: %v\n%s", err, j)
+				}
+				got, err := e.Encode(root2)
+				if err != nil {
+					t.Fatal("encode from json", err)
+				}
+				if d := lineDiff(want, got); len(d) > 0 {
+					t.Fatalf("mismatch\n%s", d)
+				}
+			})
+		}
+	}
+}
+
+func TestJSONUnknownKey(t *testing.T) {
+	d := NewJSONDecoder(v251.Registry, nil)
+	_, err := d.DecodeSegment("PID", []byte(`{"PID.1":"1","PID.999":"x"}`))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	const want = `PID: unknown key "PID.999"`
+	if got := err.Error(); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
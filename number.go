@@ -0,0 +1,287 @@
+package hl7
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Number is an exact decimal value of the NM data type.
+//
+// The generated packages declare NM and SI as strings. Use ParseNumber to read
+// one of these fields, or declare a field in a custom segment as Number to
+// validate it on decode. Set DecodeOption.ValidateNumber to validate the NM
+// and SI strings of the generated packages on decode. The scale, the number of digits after the decimal
+// point, is kept, so "1.20" stays "1.20". Numbers encode canonically, without a
+// plus sign or leading zeros. The zero value is an empty number, which encodes
+// as an empty field.
+type Number struct {
+	s string // Canonical text, or empty.
+}
+
+var numberType = reflect.TypeOf(Number{})
+
+// ParseNumber parses text with the NM grammar: an optional leading sign, digits,
+// and an optional decimal point. Exponents, spaces and other characters are
+// not allowed. Empty text returns an empty number.
+func ParseNumber(text string) (Number, error) {
+	if len(text) == 0 {
+		return Number{}, nil
+	}
+	s := text
+	neg := false
+	switch s[0] {
+	case '+':
+		s = s[1:]
+	case '-':
+		neg = true
+		s = s[1:]
+	}
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	if len(intPart)+len(fracPart) == 0 || !isDigits(intPart) || !isDigits(fracPart) {
+		return Number{}, fmt.Errorf("invalid NM %q", text)
+	}
+	intPart = strings.TrimLeft(intPart, "0")
+	if len(intPart) == 0 {
+		intPart = "0"
+	}
+	if len(strings.Trim(intPart+fracPart, "0")) == 0 {
+		neg = false
+	}
+	b := &strings.Builder{}
+	if neg {
+		b.WriteByte('-')
+	}
+	b.WriteString(intPart)
+	if len(fracPart) > 0 {
+		b.WriteByte('.')
+		b.WriteString(fracPart)
+	}
+	return Number{s: b.String()}, nil
+}
+
+// ParseSequenceID parses text with the SI grammar, a non-negative integer.
+// Empty text returns zero.
+func ParseSequenceID(text string) (int64, error) {
+	if len(text) == 0 {
+		return 0, nil
+	}
+	if !isDigits(text) {
+		return 0, fmt.Errorf("invalid SI %q", text)
+	}
+	return strconv.ParseInt(text, 10, 64)
+}
+
+// numberErrors returns an error for each NM string of a decoded value, a string with
+// format=NM in the tag, that does not have the NM grammar, and for each SI string,
+// with format=SI, that is not a non-negative integer.
+func numberErrors(t tag, rv reflect.Value) []error {
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return nil
+		}
+		return numberErrors(t, rv.Elem())
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return nil
+		}
+		var list []error
+		for i := 0; i < rv.Len(); i++ {
+			list = append(list, numberErrors(t, rv.Index(i))...)
+		}
+		return list
+	case reflect.String:
+		switch v := rv.String(); t.Format {
+		case "NM":
+			if _, err := ParseNumber(v); err != nil {
+				return []error{err}
+			}
+		case "SI":
+			if _, err := ParseSequenceID(v); err != nil {
+				return []error{err}
+			}
+		}
+	case reflect.Struct:
+		switch rv.Type() {
+		case timeType, numberType:
+			return nil
+		}
+		rt := rv.Type()
+		var list []error
+		for i := 0; i < rt.NumField(); i++ {
+			ft := rt.Field(i)
+			ct, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+			if err != nil || !ct.Present || ct.Meta || ct.Omit {
+				continue
+			}
+			list = append(list, numberErrors(ct, rv.Field(i))...)
+		}
+		return list
+	}
+	return nil
+}
+
+// variesNumberErrors returns the errors of numberErrors for the repetitions of a VARIES field,
+// such as OBX-5, with the NM or SI data type in the selector field, such as OBX-2.
+func variesNumberErrors(segment reflect.Value, name string, t tag, rv reflect.Value) []error {
+	so, ok := variesSelectors[name][t.Order]
+	if !ok {
+		return nil
+	}
+	sel, ok := pathValue(segment, false, so)
+	if !ok {
+		return nil
+	}
+	// A repeating selector has the data type of each repetition.
+	dataType := func(i int) string {
+		if sel.Kind() != reflect.Slice {
+			return firstString(sel)
+		}
+		if sel.Len() == 0 {
+			return ""
+		}
+		if i >= sel.Len() {
+			i = sel.Len() - 1
+		}
+		return firstString(sel.Index(i))
+	}
+	check := func(i int, rv reflect.Value) []error {
+		switch t.Format = dataType(i); t.Format {
+		case "NM", "SI":
+			return numberErrors(t, rv)
+		}
+		return nil
+	}
+	if rv.Kind() != reflect.Slice {
+		return check(0, rv)
+	}
+	var list []error
+	for i := 0; i < rv.Len(); i++ {
+		list = append(list, check(i, rv.Index(i))...)
+	}
+	return list
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// NewNumber returns the number unscaled * 10^-scale, such as "1.20" for NewNumber(120, 2).
+// A negative scale is treated as zero.
+func NewNumber(unscaled int64, scale int) Number {
+	return newNumber(big.NewInt(unscaled), scale)
+}
+
+func newNumber(unscaled *big.Int, scale int) Number {
+	if scale < 0 {
+		scale = 0
+	}
+	digits := new(big.Int).Abs(unscaled).String()
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	s := digits[:len(digits)-scale]
+	if scale > 0 {
+		s += "." + digits[len(digits)-scale:]
+	}
+	if unscaled.Sign() < 0 {
+		s = "-" + s
+	}
+	return Number{s: s}
+}
+
+// String returns the canonical text of the number, or empty.
+func (n Number) String() string {
+	return n.s
+}
+
+// Empty reports if the number has no value.
+func (n Number) Empty() bool {
+	return len(n.s) == 0
+}
+
+// Scale returns the number of digits after the decimal point.
+func (n Number) Scale() int {
+	_, frac, _ := strings.Cut(n.s, ".")
+	return len(frac)
+}
+
+// SignificantDigits returns the number of digits, not counting leading zeros.
+// Trailing zeros are significant, so "1.20" has three significant digits and "0.050" has two.
+func (n Number) SignificantDigits() int {
+	digits := strings.TrimLeft(strings.NewReplacer("-", "", ".", "").Replace(n.s), "0")
+	if len(digits) == 0 && len(n.s) > 0 {
+		return 1
+	}
+	return len(digits)
+}
+
+// Decimal returns the exact value as unscaled * 10^-scale.
+func (n Number) Decimal() (unscaled *big.Int, scale int) {
+	unscaled = new(big.Int)
+	if n.Empty() {
+		return unscaled, 0
+	}
+	unscaled.SetString(strings.Replace(n.s, ".", "", 1), 10)
+	return unscaled, n.Scale()
+}
+
+// Rat returns the exact value as a rational number.
+func (n Number) Rat() *big.Rat {
+	r := new(big.Rat)
+	if !n.Empty() {
+		r.SetString(n.s)
+	}
+	return r
+}
+
+// Float64 returns the nearest float64 of the number, or zero if empty.
+func (n Number) Float64() float64 {
+	if n.Empty() {
+		return 0
+	}
+	f, _ := strconv.ParseFloat(n.s, 64)
+	return f
+}
+
+// Int64 returns the number as an integer. An error is returned if the number
+// has a fraction, such as "1.5", or does not fit. Zeros after the decimal point are allowed.
+func (n Number) Int64() (int64, error) {
+	if n.Empty() {
+		return 0, nil
+	}
+	intPart, frac, _ := strings.Cut(n.s, ".")
+	if len(strings.Trim(frac, "0")) > 0 {
+		return 0, fmt.Errorf("NM %s is not an integer", n.s)
+	}
+	return strconv.ParseInt(intPart, 10, 64)
+}
+
+// Cmp compares the values of two numbers, ignoring the scale, returning -1, 0 or +1.
+// An empty number is zero.
+func (n Number) Cmp(o Number) int {
+	return n.Rat().Cmp(o.Rat())
+}
+
+// MarshalText returns the canonical text.
+func (n Number) MarshalText() ([]byte, error) {
+	return []byte(n.s), nil
+}
+
+// UnmarshalText parses text with ParseNumber.
+func (n *Number) UnmarshalText(text []byte) error {
+	v, err := ParseNumber(string(text))
+	if err != nil {
+		return err
+	}
+	*n = v
+	return nil
+}
@@ -0,0 +1,176 @@
+package hl7
+
+import (
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestParseNumber(t *testing.T) {
+	list := []struct {
+		text   string
+		want   string
+		scale  int
+		digits int
+	}{
+		{"", "", 0, 0},
+		{"1.20", "1.20", 2, 3},
+		{"+001.20", "1.20", 2, 3},
+		{"-0.050", "-0.050", 3, 2},
+		{"-0.00", "0.00", 2, 1},
+		{".5", "0.5", 1, 1},
+		{"12.", "12", 0, 2},
+		{"100", "100", 0, 3},
+	}
+	for _, item := range list {
+		n, err := ParseNumber(item.text)
+		if err != nil {
+			t.Errorf("%q: %v", item.text, err)
+			continue
+		}
+		if g := n.String(); g != item.want {
+			t.Errorf("%q: got %q, want %q", item.text, g, item.want)
+		}
+		if g := n.Scale(); g != item.scale {
+			t.Errorf("%q: scale got %d, want %d", item.text, g, item.scale)
+		}
+		if g := n.SignificantDigits(); g != item.digits {
+			t.Errorf("%q: significant digits got %d, want %d", item.text, g, item.digits)
+		}
+	}
+	for _, text := range []string{"+1.20E2", "1,000", " 1", "1.2.3", "-", ".", "0x10", "NaN"} {
+		if _, err := ParseNumber(text); err == nil {
+			t.Errorf("%q: parsed as a number", text)
+		}
+	}
+}
+
+func TestNumberConvert(t *testing.T) {
+	n, _ := ParseNumber("-12.50")
+	if g := n.Float64(); g != -12.5 {
+		t.Errorf("float got %v", g)
+	}
+	if _, err := n.Int64(); err == nil {
+		t.Error("fraction converted to an integer")
+	}
+	unscaled, scale := n.Decimal()
+	if unscaled.String() != "-1250" || scale != 2 {
+		t.Errorf("decimal got %s scale %d", unscaled, scale)
+	}
+	if g := n.Rat().String(); g != "-25/2" {
+		t.Errorf("rat got %s", g)
+	}
+	if g := NewNumber(-1250, 2); g != n {
+		t.Errorf("new got %s", g)
+	}
+	if g := NewNumber(5, 3).String(); g != "0.005" {
+		t.Errorf("new got %s", g)
+	}
+	i, err := ParseNumber("42.00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := i.Int64(); err != nil || v != 42 {
+		t.Errorf("int got %d %v", v, err)
+	}
+	if i.Cmp(NewNumber(42, 0)) != 0 {
+		t.Error("42.00 != 42")
+	}
+	if _, err := ParseSequenceID("-1"); err == nil {
+		t.Error("negative set ID parsed")
+	}
+}
+
+// ZNM is a custom segment with numeric fields.
+type ZNM struct {
+	HL7   struct{} `hl7:",name=ZNM,type=s"`
+	Value Number   `hl7:"1"`
+	Limit *Number  `hl7:"2"`
+}
+
+type numberRegistry struct {
+	Registry
+}
+
+func (r numberRegistry) Segment(name string) (any, bool) {
+	if name == "ZNM" {
+		return ZNM{}, true
+	}
+	return r.Registry.Segment(name)
+}
+
+func TestNumberCodec(t *testing.T) {
+	raw := []byte("MSH|^~\\&|A|B|C|D|20070305170957||ADT^A01^ADT_A01|1|P|2.5.1\rZNM|+001.20|-.5\rZNM|1.2E2")
+	d := NewDecoder(numberRegistry{v251.Registry}, &DecodeOption{ErrorZSegment: true})
+	list, err := d.DecodeList(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z, ok := list[1].(*ZNM)
+	if !ok {
+		t.Fatalf("got %T, want *ZNM", list[1])
+	}
+	if z.Value.String() != "1.20" || z.Limit == nil || z.Limit.String() != "-0.5" {
+		t.Errorf("got %s %v", z.Value, z.Limit)
+	}
+	if se, ok := list[2].(SegmentError); !ok || !strings.Contains(se.Error(), `invalid NM "1.2E2"`) {
+		t.Errorf("got %v, want invalid NM error", list[2])
+	}
+
+	e := NewEncoder(&EncodeOption{TrimTrailingSeparator: true})
+	got, err := e.Encode(z)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g, w := strings.TrimSpace(string(got)), "ZNM|1.20|-0.5"; g != w {
+		t.Errorf("encode got %q, want %q", g, w)
+	}
+}
+
+func TestValidateNumber(t *testing.T) {
+	raw := []byte("MSH|^~\\&|A|B|C|D|20070305170957||ORU^R01^ORU_R01|1|P|2.5.1\r" +
+		"OBX|1|NM|GLU||1.2E2||||0x5\r" +
+		"OBX|2|ST|NOTE||1.2E2||||0.5\r" +
+		"OBX|3|NM|NA||+140.0\r" +
+		"OBX|4a|SI|SEQ||-1\r")
+	list, err := NewDecoder(v251.Registry, &DecodeOption{ValidateNumber: true}).DecodeList(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	se, ok := list[1].(SegmentError)
+	if !ok {
+		t.Fatalf("OBX 1: got %T, want SegmentError", list[1])
+	}
+	if g, w := len(se.ErrorList), 2; g != w {
+		t.Fatalf("OBX 1: got %d errors, want %d: %v", g, w, se)
+	}
+	for _, want := range []string{`invalid NM "1.2E2"`, `invalid NM "0x5"`} {
+		if !strings.Contains(se.Error(), want) {
+			t.Errorf("OBX 1: missing %s in %v", want, se)
+		}
+	}
+	for _, i := range []int{2, 3} {
+		if se, ok := list[i].(SegmentError); ok {
+			t.Errorf("OBX %d: got %v", i, se)
+		}
+	}
+
+	se, ok = list[4].(SegmentError)
+	if !ok {
+		t.Fatalf("OBX 4: got %T, want SegmentError", list[4])
+	}
+	for _, want := range []string{`invalid SI "4a"`, `invalid SI "-1"`} {
+		if !strings.Contains(se.Error(), want) {
+			t.Errorf("OBX 4: missing %s in %v", want, se)
+		}
+	}
+
+	list, err = NewDecoder(v251.Registry, nil).DecodeList(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if se, ok := list[1].(SegmentError); ok {
+		t.Errorf("without ValidateNumber got %v", se)
+	}
+}
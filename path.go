@@ -0,0 +1,366 @@
+package hl7
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Value is a primitive value of a message, such as a field or a component of a field.
+type Value struct {
+	Path  string // Path of the value, such as "PID-5[1].2", or "OBX[2]-3.1" for the second OBX segment.
+	Value string // Text of the value, not escaped. Times are in the format of the field, such as "20250609071616".
+}
+
+// Values returns the primitive values of the segments of a trigger, or of a single segment,
+// in order. Empty values are omitted.
+//
+// A path names the segment, with the occurrence in the message if after the first,
+// then the field, the repetition of a repeating field, the component of a composite
+// field and the subcomponent of a composite component: "PID-3[2].4.1" is subcomponent 1
+// of component 4 of the second repetition of PID-3.
+func Values(message any) []Value {
+	var list []Value
+	walkSegments(message, func(path string, rv reflect.Value) {
+		walkSegment(path, rv, nil, func(path string, t tag, rv reflect.Value) {
+			list = append(list, Value{Path: path, Value: valueText(t, rv)})
+		})
+	})
+	return list
+}
+
+// walkSegments calls fn with each segment of a trigger, or a single segment, and the path of the segment.
+func walkSegments(message any, fn func(path string, rv reflect.Value)) {
+	count := map[string]int{}
+	for _, f := range FlattenSegments(message) {
+		rv := reflect.Indirect(reflect.ValueOf(f.Segment))
+		meta, err := typeMeta(rv.Type())
+		if err != nil {
+			continue
+		}
+		count[meta.Name]++
+		fn(SegmentPath(meta.Name, count[meta.Name]), rv)
+	}
+}
+
+// SegmentPath returns the path of an occurrence of a segment in a message, starting at one,
+// such as "PID" for the first PID segment and "OBX[2]" for the second OBX segment.
+func SegmentPath(name string, occurrence int) string {
+	if occurrence <= 1 {
+		return name
+	}
+	return name + "[" + strconv.Itoa(occurrence) + "]"
+}
+
+// walkSegment calls field, if not nil, with each field of a segment, and leaf with each
+// non-empty primitive value of the fields: a string, Number, time or bytes.
+func walkSegment(path string, rv reflect.Value, field, leaf func(path string, t tag, rv reflect.Value)) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		ft := rt.Field(i)
+		t, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+		if err != nil || !t.Present || t.Meta || t.Omit {
+			continue
+		}
+		fpath := path + "-" + strconv.Itoa(int(t.Order))
+		f := rv.Field(i)
+		if field != nil {
+			field(fpath, t, f)
+		}
+		if f.Kind() == reflect.Slice && f.Type().Elem().Kind() != reflect.Uint8 {
+			for j := 0; j < f.Len(); j++ {
+				walkValue(fpath+"["+strconv.Itoa(j+1)+"]", t, f.Index(j), leaf)
+			}
+			continue
+		}
+		walkValue(fpath, t, f, leaf)
+	}
+}
+
+func walkValue(path string, t tag, rv reflect.Value, leaf func(path string, t tag, rv reflect.Value)) {
+	for rv.Kind() == reflect.Interface || rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return
+		}
+		rv = rv.Elem()
+	}
+	if rv.IsZero() {
+		return
+	}
+	switch rv.Kind() {
+	case reflect.String:
+		leaf(path, t, rv)
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			leaf(path, t, rv)
+		}
+	case reflect.Struct:
+		switch rv.Type() {
+		case timeType, numberType:
+			leaf(path, t, rv)
+			return
+		}
+		rt := rv.Type()
+		for i := 0; i < rt.NumField(); i++ {
+			ft := rt.Field(i)
+			ct, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+			if err != nil || !ct.Present || ct.Meta || ct.Omit {
+				continue
+			}
+			walkValue(path+"."+strconv.Itoa(int(ct.Order)), ct, rv.Field(i), leaf)
+		}
+	}
+}
+
+// valueText returns the text of a primitive value.
+func valueText(t tag, rv reflect.Value) string {
+	switch v := rv.Interface().(type) {
+	case time.Time:
+		return formatTime(t, v)
+	case Number:
+		return v.String()
+	case []byte:
+		return string(v)
+	}
+	if rv.Kind() == reflect.String {
+		return rv.String()
+	}
+	return fmt.Sprint(rv.Interface())
+}
+
+// pathPart is a part of a path: the segment occurrence, field, repetition, component or subcomponent.
+type pathPart int
+
+const (
+	partOccurrence pathPart = iota
+	partField
+	partRepetition
+	partComponent
+	partSubcomponent
+	partCount
+)
+
+// anyIndex is the index of "*" in a pattern.
+const anyIndex = -1
+
+// pathPattern is a parsed path or pattern, such as "OBX[*]-5" or "PID-5[1].2".
+// Indexes not in the path are zero.
+type pathPattern struct {
+	segment string
+	index   [partCount]int
+	filter  [partCount]*pathFilter // Filter of the segment occurrence or repetition, such as "OBX-3.1=2345-7".
+}
+
+// pathFilter is a condition in place of an index, such as "OBX-3.1=2345-7" or "PID-3.5!=MR".
+type pathFilter struct {
+	path  pathPattern
+	not   bool
+	value string
+}
+
+// hasFilter reports if the pattern has a filter.
+func (p pathPattern) hasFilter() bool {
+	for _, f := range p.filter {
+		if f != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// parsePath parses a path of Values, or a pattern of paths where "*" matches any index, such as "OBX[*]-3.1".
+// A segment occurrence or repetition may be a filter, such as "OBX[OBX-3.1=2345-7]-5".
+func parsePath(s string) (pathPattern, error) {
+	p := pathPattern{}
+	rest := strings.TrimSpace(s)
+	i := strings.IndexAny(rest, "[-")
+	if i < 0 {
+		i = len(rest)
+	}
+	p.segment, rest = rest[:i], rest[i:]
+	if len(p.segment) == 0 {
+		return p, fmt.Errorf("path %q: missing segment", s)
+	}
+	index := func(part pathPart, bracket bool) error {
+		end := strings.IndexAny(rest, "[].-")
+		if bracket {
+			end = closeBracket(rest)
+			if end < 0 {
+				return fmt.Errorf("path %q: missing ]", s)
+			}
+		} else if end < 0 {
+			end = len(rest)
+		}
+		text := rest[:end]
+		rest = rest[end:]
+		if bracket {
+			rest = rest[1:]
+		}
+		if text == "*" {
+			p.index[part] = anyIndex
+			return nil
+		}
+		if bracket && strings.Contains(text, "=") {
+			f, err := parseFilter(text)
+			if err != nil {
+				return fmt.Errorf("path %q: %w", s, err)
+			}
+			p.index[part] = anyIndex
+			p.filter[part] = f
+			return nil
+		}
+		n, err := strconv.Atoi(text)
+		if err != nil || n < 1 {
+			return fmt.Errorf("path %q: invalid index %q", s, text)
+		}
+		p.index[part] = n
+		return nil
+	}
+	type step struct {
+		prefix  string
+		part    pathPart
+		bracket bool
+	}
+	for _, st := range []step{
+		{"[", partOccurrence, true},
+		{"-", partField, false},
+		{"[", partRepetition, true},
+		{".", partComponent, false},
+		{".", partSubcomponent, false},
+	} {
+		if !strings.HasPrefix(rest, st.prefix) {
+			continue
+		}
+		rest = rest[len(st.prefix):]
+		if err := index(st.part, st.bracket); err != nil {
+			return p, err
+		}
+	}
+	if len(rest) > 0 {
+		return p, fmt.Errorf("path %q: unexpected %q", s, rest)
+	}
+	return p, nil
+}
+
+// closeBracket returns the index of the "]" closing the text after a "[", or -1.
+func closeBracket(s string) int {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '[':
+			depth++
+		case ']':
+			if depth == 0 {
+				return i
+			}
+			depth--
+		}
+	}
+	return -1
+}
+
+// parseFilter parses a filter, such as "OBX-3.1=2345-7", "PID-3.5!=MR" or "OBX-3.2='Glucose [Mass/volume]'".
+func parseFilter(s string) (*pathFilter, error) {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '=':
+			if depth > 0 {
+				continue
+			}
+			f := &pathFilter{}
+			left := s[:i]
+			if strings.HasSuffix(left, "!") {
+				f.not = true
+				left = left[:len(left)-1]
+			}
+			var err error
+			if f.path, err = parsePath(left); err != nil {
+				return nil, err
+			}
+			f.value = strings.TrimSpace(s[i+1:])
+			if len(f.value) >= 2 && (f.value[0] == '\'' || f.value[0] == '"') && f.value[len(f.value)-1] == f.value[0] {
+				f.value = f.value[1 : len(f.value)-1]
+			}
+			return f, nil
+		}
+	}
+	return nil, fmt.Errorf("filter %q: missing =", s)
+}
+
+// depth returns the last part in the pattern, or partOccurrence if the pattern is only a segment.
+func (p pathPattern) depth() pathPart {
+	for part := partSubcomponent; part > partOccurrence; part-- {
+		if p.index[part] != 0 {
+			return part
+		}
+	}
+	return partOccurrence
+}
+
+// truncate returns the path up to and including a part.
+func (p pathPattern) truncate(last pathPart) pathPattern {
+	t := pathPattern{segment: p.segment}
+	copy(t.index[:last+1], p.index[:last+1])
+	return t
+}
+
+// String returns the path, as in Values.
+func (p pathPattern) String() string {
+	b := &strings.Builder{}
+	b.WriteString(p.segment)
+	idx := func(n int) string {
+		if n == anyIndex {
+			return "*"
+		}
+		return strconv.Itoa(n)
+	}
+	if n := p.index[partOccurrence]; n != 0 && n != 1 {
+		b.WriteString("[" + idx(n) + "]")
+	}
+	if n := p.index[partField]; n != 0 {
+		b.WriteString("-" + idx(n))
+	}
+	if n := p.index[partRepetition]; n != 0 {
+		b.WriteString("[" + idx(n) + "]")
+	}
+	for _, part := range []pathPart{partComponent, partSubcomponent} {
+		if n := p.index[part]; n != 0 {
+			b.WriteString("." + idx(n))
+		}
+	}
+	return b.String()
+}
+
+// match reports if the pattern matches a path or a value within it.
+// The first segment occurrence is matched if the pattern has none;
+// any index is matched for the other parts not in the pattern.
+// Parts not in the path are one, as a field is its own first repetition and first component.
+func (p pathPattern) match(path pathPattern) bool {
+	if p.segment != path.segment {
+		return false
+	}
+	for part, want := range p.index {
+		got := path.index[part]
+		if got == 0 {
+			got = 1
+		}
+		switch {
+		case want == anyIndex:
+		case want == 0 && pathPart(part) != partOccurrence:
+		case want == 0:
+			if got != 1 {
+				return false
+			}
+		case want != got:
+			return false
+		}
+	}
+	return true
+}
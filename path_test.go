@@ -0,0 +1,76 @@
+package hl7
+
+import (
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestValues(t *testing.T) {
+	raw := "MSH|^~\\&|LAB|ORG|SYS||20250609071616||ORU^R01^ORU_R01|1|P|2.5.1\r" +
+		"PID|1||123^^^HOSP&1.2.3&ISO||Smith^John~Smith^Johnny\r" +
+		"OBR|1|ABC||GLU\r" +
+		"OBX|1|NM|GLU||182\r" +
+		"OBX|2|NM|NA||140\r"
+	g, err := NewDecoder(v251.Registry, nil).Decode([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]string{}
+	for _, v := range Values(g) {
+		got[v.Path] = v.Value
+	}
+	for path, want := range map[string]string{
+		"MSH-7":        "20250609071616",
+		"MSH-9.3":      "ORU_R01",
+		"PID-3[1].1":   "123",
+		"PID-3[1].4.2": "1.2.3",
+		"PID-5[2].2":   "Johnny",
+		"OBX-3.1":      "GLU",
+		"OBX[2]-5[1]":  "140",
+	} {
+		if got[path] != want {
+			t.Errorf("%s: got %q, want %q", path, got[path], want)
+		}
+	}
+}
+
+func TestPathMatch(t *testing.T) {
+	list := []struct {
+		pattern string
+		path    string
+		match   bool
+	}{
+		{"MSH-7", "MSH-7", true},
+		{"MSH-7", "MSH-7.1", true},
+		{"MSH-7", "MSH-70", false},
+		{"OBX-5", "OBX[2]-5[1]", false},
+		{"OBX[*]-5", "OBX[2]-5[1]", true},
+		{"OBX[2]-5[1]", "OBX[2]-5[1]", true},
+		{"OBX[2]-5[2]", "OBX[2]-5[1]", false},
+		{"PID-5.2", "PID-5[2].2", true},
+		{"PID-5[1].2", "PID-5[1].2.1", true},
+		{"PID-8.1", "PID-8", true},
+		{"PID-8.2", "PID-8", false},
+		{"PID", "PID-3[1].1", true},
+		{"PV1", "PID-3[1].1", false},
+	}
+	for _, item := range list {
+		p, err := parsePath(item.pattern)
+		if err != nil {
+			t.Fatal(err)
+		}
+		v, err := parsePath(item.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := p.match(v); got != item.match {
+			t.Errorf("%s matches %s: got %t, want %t", item.pattern, item.path, got, item.match)
+		}
+	}
+	for _, bad := range []string{"", "-5", "PID-", "PID-0", "PID-5[", "PID-5.x", "PID-5 x"} {
+		if _, err := parsePath(bad); err == nil {
+			t.Errorf("%q: expected an error", bad)
+		}
+	}
+}
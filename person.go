@@ -0,0 +1,473 @@
+package hl7
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// Components of the person name, address and telecom data types by placeholder name.
+// Component numbers are the same in each version that defines the data type.
+var personComponents = map[string]map[string]int32{
+	"XPN": {"family": 1, "given": 2, "middle": 3, "suffix": 4, "prefix": 5, "degree": 6, "type": 7, "order": 11},
+	"PN":  {"family": 1, "given": 2, "middle": 3, "suffix": 4, "prefix": 5, "degree": 6},
+	"XCN": {"id": 1, "family": 2, "given": 3, "middle": 4, "suffix": 5, "prefix": 6, "degree": 7, "type": 10, "order": 18},
+	"CN":  {"id": 1, "family": 2, "given": 3, "middle": 4, "suffix": 5, "prefix": 6, "degree": 7},
+	"XAD": {"street": 1, "other": 2, "city": 3, "state": 4, "zip": 5, "country": 6, "type": 7, "county": 9},
+	"AD":  {"street": 1, "other": 2, "city": 3, "state": 4, "zip": 5, "country": 6, "type": 7},
+	"XTN": {"number": 1, "use": 2, "equipment": 3, "email": 4, "country": 5, "area": 6, "local": 7, "ext": 8, "text": 9},
+}
+
+// Default type codes of Preferred, by data type.
+var preferredCodes = map[string][]string{
+	"XPN": {"L"},                 // Table 0200, legal name.
+	"XCN": {"L"},                 // Table 0200, legal name.
+	"XAD": {"H", "M"},            // Table 0190, home then mailing address.
+	"AD":  {"H", "M"},            // Table 0190, home then mailing address.
+	"XTN": {"PRN", "ORN", "WPN"}, // Table 0201, primary residence, other residence, then work number.
+}
+
+// Templates for FormatName, FormatAddress and FormatPhone.
+// A placeholder, such as {family}, is replaced with the component value.
+// A placeholder with a ":1" suffix, such as {middle:1}, is replaced with the first letter.
+//
+// Name placeholders: {family} {given} {middle} {suffix} {prefix} {degree}, and {id} for XCN and CN.
+// Address placeholders: {street} {other} {city} {state} {zip} {country} {county}.
+// Telecom placeholders: {number} {email} {country} {area} {local} {ext} {text}.
+const (
+	NameSorted         = "{family}, {given} {middle:1}"                // Smith, John Q
+	NameGivenFirst     = "{prefix} {given} {middle} {family} {suffix}" // Dr John Quincy Smith Jr
+	NameFamilyFirst    = "{prefix} {family} {middle} {given} {suffix}" // Name assembly order F of table 0444.
+	AddressSingleLine  = "{street}, {other}, {city}, {state} {zip}, {country}"
+	PhoneInternational = "+{country} ({area}) {local} x{ext}"
+)
+
+// Preferred returns the first repetition of a XPN, XCN, XAD, AD or XTN list with a type code,
+// trying each code in order. Type codes are from table 0200 for names (XPN-7, XCN-10),
+// table 0190 for addresses (XAD-7) and table 0201 for telecoms (XTN-2).
+// Without codes, the legal name, home address, or primary residence number is preferred.
+// If no repetition has a code, the first repetition is returned.
+func Preferred[T any](list []T, codes ...string) (T, bool) {
+	var zero T
+	if len(list) == 0 {
+		return zero, false
+	}
+	name := dataTypeName(reflect.TypeOf(zero))
+	comps := personComponents[name]
+	order := comps["type"]
+	if name == "XTN" {
+		order = comps["use"]
+	}
+	if len(codes) == 0 {
+		codes = preferredCodes[name]
+	}
+	if order > 0 {
+		for _, code := range codes {
+			for _, v := range list {
+				if componentString(reflect.ValueOf(v), order) == code {
+					return v, true
+				}
+			}
+		}
+	}
+	return list[0], true
+}
+
+// FormatName formats a XPN, PN, XCN or CN with a template, such as NameSorted.
+// An empty template uses the name assembly order of the value: NameFamilyFirst
+// for "F" and NameGivenFirst otherwise.
+func FormatName(name any, template string) string {
+	rv, comps := personValue(name, "XPN", "PN", "XCN", "CN")
+	if comps == nil {
+		return ""
+	}
+	if len(template) == 0 {
+		template = NameGivenFirst
+		if componentString(rv, comps["order"]) == "F" {
+			template = NameFamilyFirst
+		}
+	}
+	return formatTemplate(template, func(key string) string {
+		return componentString(rv, comps[key])
+	})
+}
+
+// FormatAddress formats a XAD or AD with a template. An empty template uses AddressSingleLine.
+func FormatAddress(address any, template string) string {
+	rv, comps := personValue(address, "XAD", "AD")
+	if comps == nil {
+		return ""
+	}
+	if len(template) == 0 {
+		template = AddressSingleLine
+	}
+	return formatTemplate(template, func(key string) string {
+		return componentString(rv, comps[key])
+	})
+}
+
+// FormatPhone formats a XTN with a template. An empty template uses the email address,
+// if there is no local number, or PhoneInternational.
+// A XTN with only the formatted number in XTN-1, as sent before version 2.3, is parsed for the placeholders.
+func FormatPhone(phone any, template string) string {
+	rv, comps := personValue(phone, "XTN")
+	if comps == nil {
+		return ""
+	}
+	value := map[string]string{}
+	for key, order := range comps {
+		value[key] = componentString(rv, order)
+	}
+	if len(value["local"]) == 0 && len(value["number"]) > 0 {
+		if p, ok := parsePhone(value["number"]); ok {
+			for key, v := range p {
+				if len(value[key]) == 0 {
+					value[key] = v
+				}
+			}
+		}
+	}
+	if len(template) == 0 {
+		template = PhoneInternational
+		if len(value["local"]) == 0 {
+			template = "{email}"
+		}
+	}
+	return formatTemplate(template, func(key string) string {
+		return value[key]
+	})
+}
+
+// ParseName parses free text, such as "Smith, John Q" or "Dr. John Q. Smith Jr.", into a XPN, PN, XCN or CN.
+// Text with a comma has the family name first.
+func ParseName[T any](text string) (T, error) {
+	var v T
+	rv, comps := personValue(&v, "XPN", "PN", "XCN", "CN")
+	if comps == nil {
+		return v, fmt.Errorf("parse name: %T is not a person name", v)
+	}
+	text = strings.TrimSpace(text)
+	if len(text) == 0 {
+		return v, fmt.Errorf("parse name: empty text")
+	}
+	var family string
+	var words []string
+	if before, after, ok := strings.Cut(text, ","); ok {
+		family = strings.TrimSpace(before)
+		words = strings.Fields(strings.ReplaceAll(after, ",", " "))
+	} else {
+		words = strings.Fields(text)
+	}
+	part := map[string]string{}
+	for len(words) > 0 && namePrefix[nameWord(words[0])] {
+		part["prefix"] = strings.TrimSpace(part["prefix"] + " " + words[0])
+		words = words[1:]
+	}
+	for len(words) > 0 {
+		last := words[len(words)-1]
+		switch w := nameWord(last); {
+		default:
+		case nameSuffix[w]:
+			part["suffix"] = strings.TrimSpace(last + " " + part["suffix"])
+			words = words[:len(words)-1]
+			continue
+		case nameDegree[w]:
+			part["degree"] = strings.TrimSpace(last + " " + part["degree"])
+			words = words[:len(words)-1]
+			continue
+		}
+		break
+	}
+	if len(family) == 0 && len(words) > 0 {
+		family = words[len(words)-1]
+		words = words[:len(words)-1]
+	}
+	part["family"] = family
+	if len(words) > 0 {
+		part["given"] = words[0]
+		part["middle"] = strings.Join(words[1:], " ")
+	}
+	for key, s := range part {
+		setComponent(rv, comps[key], s)
+	}
+	return v, nil
+}
+
+var (
+	namePrefix = map[string]bool{"DR": true, "MR": true, "MRS": true, "MS": true, "MISS": true, "PROF": true, "REV": true}
+	nameSuffix = map[string]bool{"JR": true, "SR": true, "II": true, "III": true, "IV": true}
+	nameDegree = map[string]bool{"MD": true, "DO": true, "PHD": true, "RN": true, "NP": true, "PA": true, "DDS": true, "DMD": true}
+)
+
+// nameWord returns a word in upper case without periods, such as "PHD" for "Ph.D.".
+func nameWord(w string) string {
+	return strings.ToUpper(strings.ReplaceAll(w, ".", ""))
+}
+
+var stateZip = regexp.MustCompile(`^([A-Za-z][A-Za-z .]*?)\s+([0-9][0-9A-Za-z -]*)$`)
+
+// ParseAddress parses free text, such as "123 Main St, Apt 4, Springfield, IL 62704, USA", into a XAD or AD.
+// Parts are separated by commas or new lines. The part with the state and zip code
+// follows the city and is followed by the country.
+func ParseAddress[T any](text string) (T, error) {
+	var v T
+	rv, comps := personValue(&v, "XAD", "AD")
+	if comps == nil {
+		return v, fmt.Errorf("parse address: %T is not an address", v)
+	}
+	var parts []string
+	for _, p := range strings.FieldsFunc(text, func(r rune) bool { return r == ',' || r == '\n' || r == '\r' }) {
+		if p = strings.TrimSpace(p); len(p) > 0 {
+			parts = append(parts, p)
+		}
+	}
+	if len(parts) == 0 {
+		return v, fmt.Errorf("parse address: empty text")
+	}
+	part := map[string]string{"street": parts[0]}
+	end := len(parts)
+	for i := len(parts) - 1; i > 0; i-- {
+		if m := stateZip.FindStringSubmatch(parts[i]); m != nil {
+			part["state"], part["zip"] = m[1], m[2]
+			part["country"] = strings.Join(parts[i+1:], ", ")
+			end = i
+			break
+		}
+	}
+	if end > 1 {
+		part["city"] = parts[end-1]
+		part["other"] = strings.Join(parts[1:end-1], ", ")
+	}
+	for key, s := range part {
+		setComponent(rv, comps[key], s)
+	}
+	return v, nil
+}
+
+// phonePattern matches a phone number in the format of XTN-1 before version 2.3,
+// [NNN] [(999)]999-9999 [X99999] [B99999] [C any text], and common variations.
+var phonePattern = regexp.MustCompile(`^(?:\+?(\d{1,3})[ .-]+)?(?:\((\d+)\)[ .-]*|(\d{3})[ .-]?)?(\d{3})[ .-]?(\d{4})` +
+	`(?:\s*(?:[Xx]|ext\.?)\s*(\d+))?(?:\s*[Bb]\s*(\d+))?(?:\s*[Cc]\s*(.*))?$`)
+
+// parsePhone returns the XTN placeholder values of a formatted phone number.
+func parsePhone(text string) (map[string]string, bool) {
+	m := phonePattern.FindStringSubmatch(strings.TrimSpace(text))
+	if m == nil {
+		return nil, false
+	}
+	p := map[string]string{
+		"country": m[1],
+		"area":    m[2] + m[3],
+		"local":   m[4] + m[5],
+		"ext":     m[6],
+		"text":    strings.TrimSpace(m[8]),
+	}
+	if len(m[7]) > 0 {
+		p["text"] = strings.TrimSpace("B" + m[7] + " " + p["text"])
+	}
+	return p, true
+}
+
+// ParsePhone parses free text into a XTN: an email address, or a phone number such as
+// "(555)555-1234X12" in the format of XTN-1 before version 2.3 or "+1 555 555 1234".
+func ParsePhone[T any](text string) (T, error) {
+	var v T
+	rv, comps := personValue(&v, "XTN")
+	if comps == nil {
+		return v, fmt.Errorf("parse phone: %T is not a XTN", v)
+	}
+	text = strings.TrimSpace(text)
+	if strings.Contains(text, "@") && !strings.ContainsAny(text, " \t") {
+		setComponent(rv, comps["use"], "NET")
+		setComponent(rv, comps["equipment"], "Internet")
+		setComponent(rv, comps["email"], text)
+		return v, nil
+	}
+	p, ok := parsePhone(text)
+	if !ok {
+		return v, fmt.Errorf("parse phone: %q is not a phone number", text)
+	}
+	for key, s := range p {
+		setComponent(rv, comps[key], s)
+	}
+	return v, nil
+}
+
+// formatTemplate replaces each placeholder in the template with its value.
+// Text between placeholders is kept only between values that are present,
+// so missing components do not leave stray separators. The separator before a
+// value is the text just before its placeholder; an opening "(", "[" or "<" before a
+// placeholder and the closing ")", "]" or ">" after it are kept only if the value is present.
+func formatTemplate(template string, value func(key string) string) string {
+	type piece struct {
+		before string // Literal text before the placeholder.
+		key    string
+		size   string
+	}
+	var list []piece
+	for {
+		start := strings.IndexByte(template, '{')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(template[start:], '}')
+		if end < 0 {
+			break
+		}
+		end += start
+		key, size, _ := strings.Cut(template[start+1:end], ":")
+		list = append(list, piece{before: template[:start], key: key, size: size})
+		template = template[end+1:]
+	}
+
+	buf := &strings.Builder{}
+	last := -1 // Index of the last value written.
+	for i, p := range list {
+		v := strings.TrimSpace(value(p.key))
+		if p.size == "1" && len(v) > 0 {
+			v = string([]rune(v)[:1])
+		}
+		if len(v) == 0 {
+			continue
+		}
+		closing, sep, opening := splitLiteral(p.before)
+		switch {
+		case last < 0:
+			buf.WriteString(strings.TrimLeft(sep, " ,;"))
+		case last == i-1:
+			buf.WriteString(closing + sep)
+		default:
+			c, _, _ := splitLiteral(list[last+1].before)
+			buf.WriteString(c + sep)
+		}
+		buf.WriteString(opening)
+		buf.WriteString(v)
+		last = i
+	}
+	switch {
+	case last < 0:
+	case last == len(list)-1:
+		buf.WriteString(template)
+	default:
+		c, _, _ := splitLiteral(list[last+1].before)
+		buf.WriteString(c)
+	}
+	return strings.TrimSpace(buf.String())
+}
+
+// splitLiteral splits the text between two placeholders into the closing punctuation
+// of the placeholder before, the separator, and the opening punctuation of the placeholder after.
+func splitLiteral(s string) (closing, sep, opening string) {
+	sep = strings.TrimLeft(s, ")]>")
+	closing = s[:len(s)-len(sep)]
+	trimmed := strings.TrimRight(sep, "([<")
+	return closing, trimmed, sep[len(trimmed):]
+}
+
+// personValue returns the struct value and components of a value with one of the data type names.
+func personValue(v any, names ...string) (reflect.Value, map[string]int32) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return rv, nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return rv, nil
+	}
+	name := dataTypeName(rv.Type())
+	for _, n := range names {
+		if n == name {
+			return rv, personComponents[name]
+		}
+	}
+	return rv, nil
+}
+
+// dataTypeName returns the name of a data type from its meta field, such as "XPN".
+func dataTypeName(rt reflect.Type) string {
+	if rt == nil {
+		return ""
+	}
+	for rt.Kind() == reflect.Pointer {
+		rt = rt.Elem()
+	}
+	meta, err := typeMeta(rt)
+	if err != nil || !meta.Present || meta.Type != structDataType {
+		return ""
+	}
+	return meta.Name
+}
+
+// component returns the component of a data type value by number.
+func component(rv reflect.Value, order int32) (reflect.Value, bool) {
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return rv, false
+		}
+		rv = rv.Elem()
+	}
+	if order <= 0 || rv.Kind() != reflect.Struct {
+		return rv, false
+	}
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		ft := rt.Field(i)
+		t, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+		if err != nil || !t.Present || t.Meta {
+			continue
+		}
+		if t.Order == order {
+			return rv.Field(i), true
+		}
+	}
+	return rv, false
+}
+
+// componentString returns the string of a component. A component with a data type,
+// such as the SAD of XAD-1, returns its first component.
+func componentString(rv reflect.Value, order int32) string {
+	f, ok := component(rv, order)
+	if !ok {
+		return ""
+	}
+	for f.Kind() == reflect.Pointer {
+		if f.IsNil() {
+			return ""
+		}
+		f = f.Elem()
+	}
+	switch f.Kind() {
+	case reflect.String:
+		return f.String()
+	case reflect.Struct:
+		return componentString(f, 1)
+	}
+	return ""
+}
+
+// setComponent sets the string of a component. A component with a data type, such as the SAD of XAD-1,
+// has its first component set.
+func setComponent(rv reflect.Value, order int32, s string) {
+	if len(s) == 0 {
+		return
+	}
+	f, ok := component(rv, order)
+	if !ok {
+		return
+	}
+	if f.Kind() == reflect.Pointer {
+		if f.IsNil() {
+			f.Set(reflect.New(f.Type().Elem()))
+		}
+		f = f.Elem()
+	}
+	switch f.Kind() {
+	case reflect.String:
+		f.SetString(s)
+	case reflect.Struct:
+		setComponent(f, 1, s)
+	}
+}
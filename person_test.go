@@ -0,0 +1,162 @@
+package hl7
+
+import (
+	"testing"
+
+	v231 "github.com/kardianos/hl7/h231"
+	v251 "github.com/kardianos/hl7/h251"
+	v280 "github.com/kardianos/hl7/h280"
+)
+
+func TestPreferred(t *testing.T) {
+	names := []v251.XPN{
+		{FamilyName: "Smithy", NameTypeCode: "A"},
+		{FamilyName: "Smith", NameTypeCode: "L"},
+	}
+	if n, _ := Preferred(names); n.FamilyName != "Smith" {
+		t.Errorf("legal name got %q", n.FamilyName)
+	}
+	if n, _ := Preferred(names, "A"); n.FamilyName != "Smithy" {
+		t.Errorf("alias name got %q", n.FamilyName)
+	}
+	phones := []v280.XTN{
+		{LocalNumber: "5550000", TelecommunicationUseCode: "WPN"},
+		{LocalNumber: "5551234", TelecommunicationUseCode: "PRN"},
+	}
+	if p, _ := Preferred(phones); p.LocalNumber != "5551234" {
+		t.Errorf("home phone got %q", p.LocalNumber)
+	}
+	if _, ok := Preferred([]v251.XAD(nil)); ok {
+		t.Error("empty list has a preferred address")
+	}
+}
+
+func TestFormatName(t *testing.T) {
+	name := v251.XPN{FamilyName: "Smith", GivenName: "John", SecondAndFurtherGivenNamesOrInitialsThereof: "Quincy", Prefix: "Dr", Suffix: "Jr"}
+	list := []struct {
+		template, want string
+	}{
+		{NameSorted, "Smith, John Q"},
+		{"", "Dr John Quincy Smith Jr"},
+		{"{given} {family}", "John Smith"},
+	}
+	for _, item := range list {
+		if g := FormatName(name, item.template); g != item.want {
+			t.Errorf("%q: got %q, want %q", item.template, g, item.want)
+		}
+	}
+	name.NameAssemblyOrder = "F"
+	if g, w := FormatName(&name, ""), "Dr Smith Quincy John Jr"; g != w {
+		t.Errorf("family first got %q, want %q", g, w)
+	}
+	missing := []struct {
+		name     v251.XPN
+		template string
+		want     string
+	}{
+		{v251.XPN{GivenName: "John"}, NameSorted, "John"},
+		{v251.XPN{FamilyName: "Smith", GivenName: "John"}, "", "John Smith"},
+		{v251.XPN{FamilyName: "Smith", GivenName: "John", Suffix: "Jr"}, "", "John Smith Jr"},
+		{v251.XPN{FamilyName: "Smith", SecondAndFurtherGivenNamesOrInitialsThereof: "Quincy"}, NameSorted, "Smith Q"},
+		{v251.XPN{FamilyName: "Smith", GivenName: "John", Degree: "MD"}, "{given} {middle} {family} ({degree})", "John Smith (MD)"},
+		{v251.XPN{FamilyName: "Smith", GivenName: "John"}, "{given} ({middle}) {family}", "John Smith"},
+	}
+	for _, item := range missing {
+		if g := FormatName(item.name, item.template); g != item.want {
+			t.Errorf("%+v %q: got %q, want %q", item.name, item.template, g, item.want)
+		}
+	}
+	doctor := v251.XCN{IDNumber: "1234", FamilyName: "Jones", GivenName: "Ann"}
+	if g, w := FormatName(doctor, "{family}, {given} ({id})"), "Jones, Ann (1234)"; g != w {
+		t.Errorf("XCN got %q, want %q", g, w)
+	}
+}
+
+func TestFormatAddress(t *testing.T) {
+	list := []struct {
+		addr v251.XAD
+		want string
+	}{
+		{v251.XAD{StreetAddress: &v251.SAD{StreetOrMailingAddress: "123 Main St"}, City: "Springfield", StateOrProvince: "IL", ZipOrPostalCode: "62704"}, "123 Main St, Springfield, IL 62704"},
+		{v251.XAD{City: "Springfield", ZipOrPostalCode: "62704"}, "Springfield 62704"},
+		{v251.XAD{StreetAddress: &v251.SAD{StreetOrMailingAddress: "123 Main St"}, StateOrProvince: "IL"}, "123 Main St, IL"},
+		{v251.XAD{City: "Springfield", Country: "USA"}, "Springfield, USA"},
+	}
+	for _, item := range list {
+		if g := FormatAddress(item.addr, ""); g != item.want {
+			t.Errorf("%+v: got %q, want %q", item.addr, g, item.want)
+		}
+	}
+}
+
+func TestFormatPhone(t *testing.T) {
+	list := []struct {
+		phone any
+		want  string
+	}{
+		{v251.XTN{CountryCode: "1", AreaCityCode: "555", LocalNumber: "5551234", Extension: "12"}, "+1 (555) 5551234 x12"},
+		{v251.XTN{AreaCityCode: "555", LocalNumber: "5551234"}, "(555) 5551234"},
+		{v251.XTN{CountryCode: "1", LocalNumber: "5551234"}, "+1 5551234"},
+		{v251.XTN{LocalNumber: "5551234", Extension: "12"}, "5551234 x12"},
+		{v231.XTN{TelephoneNumber: "(555)555-1234X12"}, "(555) 5551234 x12"},
+		{v251.XTN{EmailAddress: "a@example.com"}, "a@example.com"},
+	}
+	for _, item := range list {
+		if g := FormatPhone(item.phone, ""); g != item.want {
+			t.Errorf("%+v: got %q, want %q", item.phone, g, item.want)
+		}
+	}
+}
+
+func TestParseName(t *testing.T) {
+	n, err := ParseName[v251.XPN]("Dr. John Q. Smith Jr. MD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := v251.XPN{FamilyName: "Smith", GivenName: "John", SecondAndFurtherGivenNamesOrInitialsThereof: "Q.", Prefix: "Dr.", Suffix: "Jr.", Degree: "MD"}
+	if n != want {
+		t.Errorf("got %+v, want %+v", n, want)
+	}
+	n, err = ParseName[v251.XPN]("Van Dyke, Mary Ann")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.FamilyName != "Van Dyke" || n.GivenName != "Mary" || n.SecondAndFurtherGivenNamesOrInitialsThereof != "Ann" {
+		t.Errorf("got %+v", n)
+	}
+	if _, err := ParseName[v251.XAD]("Smith"); err == nil {
+		t.Error("parsed a name into an address")
+	}
+}
+
+func TestParseAddress(t *testing.T) {
+	a, err := ParseAddress[v251.XAD]("123 Main St, Apt 4, Springfield, IL 62704, USA")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.StreetAddress == nil || a.StreetAddress.StreetOrMailingAddress != "123 Main St" || a.OtherDesignation != "Apt 4" ||
+		a.City != "Springfield" || a.StateOrProvince != "IL" || a.ZipOrPostalCode != "62704" || a.Country != "USA" {
+		t.Errorf("got %+v %+v", a, a.StreetAddress)
+	}
+}
+
+func TestParsePhone(t *testing.T) {
+	p, err := ParsePhone[v251.XTN]("1 (555)555-1234X12 C after 5pm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := v251.XTN{CountryCode: "1", AreaCityCode: "555", LocalNumber: "5551234", Extension: "12", AnyText: "after 5pm"}
+	if p != want {
+		t.Errorf("got %+v, want %+v", p, want)
+	}
+	e, err := ParsePhone[v280.XTN]("a@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.CommunicationAddress != "a@example.com" || e.TelecommunicationUseCode != "NET" {
+		t.Errorf("got %+v", e)
+	}
+	if _, err := ParsePhone[v251.XTN]("call me"); err == nil {
+		t.Error("parsed text as a phone number")
+	}
+}
@@ -0,0 +1,425 @@
+package hl7
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Query selects values of a message by path, with one or more columns separated by commas,
+// such as "PID-3[*].1, OBX[*]-3.1, OBX[*]-5".
+//
+// Each column is a path, as in Values, where "*" matches any segment occurrence or index,
+// and a segment occurrence or repetition may be a filter, such as "OBX[OBX-3.1=2345-7]-5"
+// for OBX-5 of each OBX segment with an OBX-3.1 of "2345-7", or "PID-3[PID-3.5=MR].1"
+// for the identifier of each repetition of PID-3 with an identifier type of "MR". A filter
+// is true if a value at its path equals, or with "!=" does not equal, the text after the
+// operator, which may be quoted. A filter on another segment, such as "PID[OBX-3.1=2345-7]-3",
+// is true if a value in the message matches.
+//
+// As in Diff ignore paths, a column without a segment occurrence selects the first segment,
+// and indexes after the last part of the column select every index.
+type Query struct {
+	Columns []string // Path of each column.
+
+	paths []pathPattern
+}
+
+// ParseQuery parses a query.
+func ParseQuery(query string) (*Query, error) {
+	q := &Query{}
+	depth := 0
+	start := 0
+	add := func(end int) error {
+		col := strings.TrimSpace(query[start:end])
+		p, err := parsePath(col)
+		if err != nil {
+			return err
+		}
+		q.Columns = append(q.Columns, col)
+		q.paths = append(q.paths, p)
+		return nil
+	}
+	for i := 0; i < len(query); i++ {
+		switch query[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case ',':
+			if depth > 0 {
+				continue
+			}
+			if err := add(i); err != nil {
+				return nil, err
+			}
+			start = i + 1
+		}
+	}
+	if err := add(len(query)); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// Select returns the values of each column of the query in the values of a message, from Values or ParseValues.
+// Each value is at the depth of the column: "OBX[*]-5" selects OBX-5 of each OBX segment, and "OBX[*]-5[*]"
+// selects each repetition. A composite value, or a repeating field, is the text of its values with the
+// separators of the ER7 encoding, such as "2345-7^Glucose^LN", without escapes.
+func (q *Query) Select(values []Value) [][]Value {
+	e := newQueryEval(values)
+	list := make([][]Value, len(q.paths))
+	for i, p := range q.paths {
+		list[i] = e.selectPath(p, pathPattern{})
+	}
+	return list
+}
+
+// Rows returns the text of the values of each column in rows, for a table such as CSV.
+//
+// A row is an occurrence or repetition selected by "*" or a filter in a column, such as each OBX
+// segment for "OBX[*]-3.1, OBX[*]-5". Each column has its value of the occurrence in the row, or
+// is empty if the occurrence has no value, as for an OBX without OBX-5. A column of a deeper
+// occurrence, such as "OBX[*]-5[*]", has a row for each repetition, and the value of a column
+// of the containing occurrence is in each of these rows. A column without "*" or a filter, such
+// as "PID-3.1", has its single value in each row. Columns of other occurrences, such as
+// "PID-3[*]" with "OBX[*]-5", are not related and have rows of their own.
+func (q *Query) Rows(values []Value) [][]string {
+	e := newQueryEval(values)
+	type cell struct {
+		key   pathPattern // Occurrence of the value, or zero for a single value.
+		depth pathPart
+		value string
+	}
+	cols := make([][]cell, len(q.paths))
+	var keys []pathPattern
+	var depths []pathPart
+	for i, p := range q.paths {
+		depth, many := p.rowDepth()
+		for _, v := range e.selectPath(p, pathPattern{}) {
+			c := cell{value: v.Value}
+			if many {
+				path, err := parsePath(v.Path)
+				if err != nil {
+					continue
+				}
+				c.key, c.depth = path.truncate(depth).fill(depth), depth
+				keys, depths = append(keys, c.key), append(depths, depth)
+			}
+			cols[i] = append(cols[i], c)
+		}
+	}
+
+	// A row for each key not within a deeper key, in the order of the message.
+	var rows []pathPattern
+	for i, k := range keys {
+		keep := true
+		for j, o := range keys {
+			if depths[j] > depths[i] && k.contains(depths[i], o) {
+				keep = false
+				break
+			}
+		}
+		for _, r := range rows {
+			if r == k {
+				keep = false
+			}
+		}
+		if keep {
+			rows = append(rows, k)
+		}
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return e.position(rows[i]) < e.position(rows[j])
+	})
+	if len(rows) == 0 {
+		// Only single values.
+		for _, c := range cols {
+			if len(c) > 0 {
+				rows = append(rows, pathPattern{})
+				break
+			}
+		}
+	}
+
+	list := make([][]string, len(rows))
+	for r, key := range rows {
+		row := make([]string, len(cols))
+		for i, c := range cols {
+			for _, v := range c {
+				if v.key.segment == "" || v.key.contains(v.depth, key) {
+					row[i] = v.value
+					break
+				}
+			}
+		}
+		list[r] = row
+	}
+	return list
+}
+
+// rowDepth returns the last part of the pattern that is "*" or a filter, and if there is one.
+func (p pathPattern) rowDepth() (pathPart, bool) {
+	for part := partSubcomponent; part >= partOccurrence; part-- {
+		if p.index[part] == anyIndex {
+			return part, true
+		}
+	}
+	return 0, false
+}
+
+// fill returns the path with the parts up to and including a part that are not in the path set to one.
+func (p pathPattern) fill(last pathPart) pathPattern {
+	for part := partOccurrence; part <= last; part++ {
+		if p.index[part] == 0 {
+			p.index[part] = 1
+		}
+	}
+	return p
+}
+
+// contains reports if a path is within the path up to a part, such as "OBX[2]-5[1]" within "OBX[2]".
+func (p pathPattern) contains(last pathPart, path pathPattern) bool {
+	if p.segment != path.segment {
+		return false
+	}
+	for part := partOccurrence; part <= last; part++ {
+		if p.index[part] != path.fill(last).index[part] {
+			return false
+		}
+	}
+	return true
+}
+
+// position returns the index of the first value within a row key, for the order of the rows.
+func (e *queryEval) position(key pathPattern) int {
+	depth := key.depth()
+	for i, l := range e.leaves {
+		if key.contains(depth, l.path) {
+			return i
+		}
+	}
+	return len(e.leaves)
+}
+
+// queryLeaf is a value of a message with a parsed path.
+type queryLeaf struct {
+	path  pathPattern
+	value string
+}
+
+type queryEval struct {
+	leaves []queryLeaf
+}
+
+func newQueryEval(values []Value) *queryEval {
+	e := &queryEval{leaves: make([]queryLeaf, 0, len(values))}
+	for _, v := range values {
+		p, err := parsePath(v.Path)
+		if err != nil {
+			continue
+		}
+		for part := range p.index {
+			if p.index[part] == 0 && pathPart(part) <= partOccurrence {
+				p.index[part] = 1
+			}
+		}
+		e.leaves = append(e.leaves, queryLeaf{path: p, value: v.Value})
+	}
+	return e
+}
+
+// selectPath returns the values of a pattern, grouped at the depth of the pattern.
+// The indexes of within, if not zero, are set in the pattern before it is matched, such as the
+// segment occurrence of a filter.
+func (e *queryEval) selectPath(p pathPattern, within pathPattern) []Value {
+	if within.segment == p.segment {
+		for part, n := range within.index {
+			if n != 0 {
+				p.index[part] = n
+				p.filter[part] = nil
+			}
+		}
+	}
+	depth := p.depth()
+	var order []string
+	groups := map[string][]queryLeaf{}
+	for _, l := range e.leaves {
+		if !p.match(l.path) || !e.filtered(p, l.path) {
+			continue
+		}
+		key := l.path.truncate(depth).String()
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], l)
+	}
+	list := make([]Value, len(order))
+	for i, key := range order {
+		list[i] = Value{Path: key, Value: renderLeaves(depth, groups[key])}
+	}
+	return list
+}
+
+// filtered reports if the filters of a pattern are true for a path.
+func (e *queryEval) filtered(p pathPattern, path pathPattern) bool {
+	for part, f := range p.filter {
+		if f == nil {
+			continue
+		}
+		within := path.truncate(pathPart(part))
+		for i := 0; i <= part; i++ {
+			if within.index[i] == 0 {
+				within.index[i] = 1
+			}
+		}
+		fp := f.path
+		if fp.segment != path.segment && fp.index[partOccurrence] == 0 {
+			// A filter on another segment is true for any occurrence.
+			fp.index[partOccurrence] = anyIndex
+		}
+		found := false
+		for _, v := range e.selectPath(fp, within) {
+			if v.Value == f.value {
+				found = true
+				break
+			}
+		}
+		if found == f.not {
+			return false
+		}
+	}
+	return true
+}
+
+// separators of the ER7 encoding after each part, without the segment occurrence.
+var partSeparator = [partCount]string{partField: "|", partRepetition: "~", partComponent: "^", partSubcomponent: "&"}
+
+// renderLeaves returns the text of the values within a path of the depth.
+func renderLeaves(depth pathPart, leaves []queryLeaf) string {
+	if depth == partOccurrence {
+		name := leaves[0].path.segment
+		if name == "MSH" || name == "FHS" || name == "BHS" {
+			// The first field is the field separator.
+			rest := leaves[:0:0]
+			for _, l := range leaves {
+				if l.path.index[partField] != 1 {
+					rest = append(rest, l)
+				}
+			}
+			return name + renderPart(partField, rest)
+		}
+		return name + partSeparator[partField] + renderPart(partField, leaves)
+	}
+	return renderPart(depth+1, leaves)
+}
+
+func renderPart(part pathPart, leaves []queryLeaf) string {
+	if part >= partCount || len(leaves) == 0 {
+		if len(leaves) == 0 {
+			return ""
+		}
+		return leaves[0].value
+	}
+	index := func(l queryLeaf) int {
+		if n := l.path.index[part]; n > 0 {
+			return n
+		}
+		return 1
+	}
+	max := 0
+	for _, l := range leaves {
+		if n := index(l); n > max {
+			max = n
+		}
+	}
+	if max == 1 {
+		return renderPart(part+1, leaves)
+	}
+	groups := make([][]queryLeaf, max)
+	for _, l := range leaves {
+		n := index(l) - 1
+		groups[n] = append(groups[n], l)
+	}
+	list := make([]string, max)
+	for i, g := range groups {
+		list[i] = renderPart(part+1, g)
+	}
+	return strings.Join(list, partSeparator[part])
+}
+
+// ParseValues returns the values of a message in the ER7 encoding, as Values would after decoding,
+// without the types of a registry, such as for a message of an unknown version.
+// As the types of the fields are not known, a path has a repetition, component or subcomponent
+// only if the field, repetition or component has more than one, or the component has subcomponents,
+// such as "PID-3.1" for a PID-3 of a single repetition. Query paths match either form.
+func ParseValues(data []byte) ([]Value, error) {
+	lines := bytes.FieldsFunc(data, func(r rune) bool {
+		return r == '\r' || r == '\n'
+	})
+	ld := &lineDecoder{}
+	count := map[string]int{}
+	var list []Value
+	add := func(path string, value []byte) {
+		if len(value) > 0 {
+			list = append(list, Value{Path: path, Value: ld.unescaper.Replace(string(value))})
+		}
+	}
+	for index, line := range lines {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		header := false
+		switch {
+		case bytes.HasPrefix(line, []byte("MSH")), bytes.HasPrefix(line, []byte("FHS")), bytes.HasPrefix(line, []byte("BHS")):
+			if _, err := ld.readSetupChars(line[3:]); err != nil {
+				return nil, fmt.Errorf("line %d: %w", index+1, err)
+			}
+			header = true
+		}
+		if !ld.readSep {
+			return nil, fmt.Errorf("line %d: missing MSH segment", index+1)
+		}
+		fields := bytes.Split(line, []byte{ld.sep})
+		name := string(fields[0])
+		count[name]++
+		path := SegmentPath(name, count[name])
+		start := 1
+		if header {
+			list = append(list, Value{Path: path + "-1", Value: string(ld.sep)}, Value{Path: path + "-2", Value: string(ld.chars[:])})
+			start = 2
+		}
+		for i := start; i < len(fields); i++ {
+			n := i
+			if header {
+				n = i + 1
+			}
+			fpath := path + "-" + strconv.Itoa(n)
+			reps := bytes.Split(fields[i], []byte{ld.repeat})
+			for r, rep := range reps {
+				rpath := fpath
+				if len(reps) > 1 {
+					rpath += "[" + strconv.Itoa(r+1) + "]"
+				}
+				comps := bytes.Split(rep, []byte{ld.dividers[1]})
+				for c, comp := range comps {
+					cpath := rpath
+					if len(comps) > 1 || bytes.IndexByte(comp, ld.dividers[2]) >= 0 {
+						cpath += "." + strconv.Itoa(c+1)
+					}
+					subs := bytes.Split(comp, []byte{ld.dividers[2]})
+					for s, sub := range subs {
+						spath := cpath
+						if len(subs) > 1 {
+							spath += "." + strconv.Itoa(s+1)
+						}
+						add(spath, sub)
+					}
+				}
+			}
+		}
+	}
+	return list, nil
+}
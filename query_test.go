@@ -0,0 +1,128 @@
+package hl7
+
+import (
+	"reflect"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestQuery(t *testing.T) {
+	raw := "MSH|^~\\&|LAB|ORG|SYS||20250609071616||ORU^R01^ORU_R01|1|P|2.5.1\r" +
+		"PID|1||123^^^HOSP^MR~456^^^HOSP^SS||Smith^John\r" +
+		"OBR|1|ABC||GLU\r" +
+		"OBX|1|NM|2345-7^Glucose^LN||182|mg/dL\r" +
+		"OBX|2|NM|2951-2^Sodium^LN||140|mmol/L\r" +
+		"OBX|3|NM|2345-7^Glucose^LN||190|mg/dL\r"
+	g, err := NewDecoder(v251.Registry, nil).Decode([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	untyped, err := ParseValues([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	list := []struct {
+		query string
+		want  [][]string
+	}{
+		{"PID-3[*].1", [][]string{{"123"}, {"456"}}},
+		{"PID-3[PID-3.5=MR].1", [][]string{{"123"}}},
+		{"PID-5", [][]string{{"Smith^John"}}},
+		{"OBX[*]-3.1, OBX[*]-5", [][]string{{"2345-7", "182"}, {"2951-2", "140"}, {"2345-7", "190"}}},
+		{"PID-3[1].1, OBX[OBX-3.1=2345-7]-5", [][]string{{"123", "182"}, {"123", "190"}}},
+		{"OBX[OBX-3.2!='Glucose']-5, OBX[*]-6", [][]string{{"", "mg/dL"}, {"140", "mmol/L"}, {"", "mg/dL"}}},
+		{"OBX[*]-3.1, OBX[*]-3[*].2", [][]string{{"2345-7", "Glucose"}, {"2951-2", "Sodium"}, {"2345-7", "Glucose"}}},
+		{"OBX[OBX-3.2!=Glucose]", [][]string{{"OBX|2|NM|2951-2^Sodium^LN||140|mmol/L"}}},
+		{"PID-3[*].1, OBX[*]-5", [][]string{{"123", ""}, {"456", ""}, {"", "182"}, {"", "140"}, {"", "190"}}},
+		{"PID-3[*].1, PID-3[*].5, PID-5.1", [][]string{{"123", "MR", "Smith"}, {"456", "SS", "Smith"}}},
+		{"PID[OBX-3.1=2951-2]-5.1", [][]string{{"Smith"}}},
+		{"PID[OBX-3.1=0000-0]-5.1", [][]string{}},
+		{"MSH", [][]string{{"MSH|^~\\&|LAB|ORG|SYS||20250609071616||ORU^R01^ORU_R01|1|P|2.5.1"}}},
+	}
+	for _, item := range list {
+		t.Run(item.query, func(t *testing.T) {
+			q, err := ParseQuery(item.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for name, values := range map[string][]Value{"typed": Values(g), "untyped": untyped} {
+				if got := q.Rows(values); !reflect.DeepEqual(got, item.want) {
+					t.Errorf("%s: got %q, want %q", name, got, item.want)
+				}
+			}
+		})
+	}
+}
+
+func TestQueryRowsMissing(t *testing.T) {
+	raw := "MSH|^~\\&|LAB|ORG|SYS||20250609071616||ORU^R01^ORU_R01|1|P|2.5.1\r" +
+		"PID|1||123\r" +
+		"OBR|1|ABC||GLU\r" +
+		"OBX|1|NM|A1||5\r" +
+		"OBX|2|NM|A2\r" +
+		"OBX|3|NM|A3||7\r"
+	g, err := NewDecoder(v251.Registry, nil).Decode([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	untyped, err := ParseValues([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	list := []struct {
+		query string
+		want  [][]string
+	}{
+		{"OBX[*]-3.1, OBX[*]-5", [][]string{{"A1", "5"}, {"A2", ""}, {"A3", "7"}}},
+		{"PID-3.1, OBX[*]-3.1, OBX[*]-5", [][]string{{"123", "A1", "5"}, {"123", "A2", ""}, {"123", "A3", "7"}}},
+		{"OBX[OBX-3.1=A3]-3.1, OBX[*]-5", [][]string{{"", "5"}, {"A3", "7"}}},
+	}
+	for _, item := range list {
+		q, err := ParseQuery(item.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for name, values := range map[string][]Value{"typed": Values(g), "untyped": untyped} {
+			if got := q.Rows(values); !reflect.DeepEqual(got, item.want) {
+				t.Errorf("%s %s: got %q, want %q", item.query, name, got, item.want)
+			}
+		}
+	}
+}
+
+func TestParseQueryError(t *testing.T) {
+	for _, query := range []string{"", "PID-3,", "OBX[OBX-3.1]-5", "OBX[OBX-3.1=x-5"} {
+		if _, err := ParseQuery(query); err == nil {
+			t.Errorf("%q: expected error", query)
+		}
+	}
+}
+
+func TestParseValues(t *testing.T) {
+	raw := "MSH|^~\\&|LAB\r" +
+		"PID|1||123^^^HOSP&1.2.3&ISO~456||Smith\\T\\Co^John\r"
+	got, err := ParseValues([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Value{
+		{"MSH-1", "|"},
+		{"MSH-2", "^~\\&"},
+		{"MSH-3", "LAB"},
+		{"PID-1", "1"},
+		{"PID-3[1].1", "123"},
+		{"PID-3[1].4.1", "HOSP"},
+		{"PID-3[1].4.2", "1.2.3"},
+		{"PID-3[1].4.3", "ISO"},
+		{"PID-3[2]", "456"},
+		{"PID-5.1", "Smith&Co"},
+		{"PID-5.2", "John"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got:\n%q\nwant:\n%q", got, want)
+	}
+	if _, err := ParseValues([]byte("PID|1\r")); err == nil {
+		t.Fatal("expected missing MSH error")
+	}
+}
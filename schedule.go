@@ -0,0 +1,621 @@
+package hl7
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is the timing of an order, from a TQ, such as ORC-7 or OBR-27 before version 2.5,
+// or from a TQ1 segment. Convert with ScheduleOf, NewTQ and NewTQ1.
+//
+// The TQ2 segments that follow a TQ1 are not part of the schedule. TQ2 relates the order to
+// other orders, such as to start after another order completes, and the occurrences then
+// depend on those orders, which a single schedule cannot hold. Read TQ2 from the segment.
+// For the same reason, the TQ-9 conjunction and TQ-10 order sequencing of a TQ are not read.
+type Schedule struct {
+	Quantity Number // Quantity of each occurrence, TQ-1 or TQ1-2.
+	Units    string // Units of the quantity.
+
+	// Repeat pattern code from table 0335, such as "Q6H", "BID" or "PRN", TQ-2.1 or TQ1-3.1.
+	// Empty is "Once".
+	Repeat string
+	// Explicit times of day, as offsets from midnight, TQ-2.2 such as "0800,2000", or TQ1-4.
+	Times []time.Duration
+
+	Duration   time.Duration // Total duration, TQ-3 with S, M, H, D or W units, or TQ1-6.
+	Months     int           // Total duration in months, TQ-3 with L units.
+	Total      int           // Total occurrences, TQ-3 with X units, TQ-12 or TQ1-14.
+	Indefinite bool          // TQ-3 "INDEF".
+
+	Start time.Time // TQ-4 or TQ1-7.
+	End   time.Time // TQ-5 or TQ1-8.
+
+	Priority  string // Priority from table 0027, such as "S" or "R", TQ-6 or TQ1-9.
+	Condition string // TQ-7 or TQ1-10.
+	Text      string // TQ-8 or TQ1-11.
+}
+
+// RepeatPattern is a parsed repeat pattern code of table 0335.
+type RepeatPattern struct {
+	Code       string
+	Once       bool          // "Once", or an empty code.
+	Continuous bool          // "C", continuously from the start to the end.
+	AsNeeded   bool          // "PRN", or "PRN" with a frequency, such as "PRNQ6H".
+	Every      time.Duration // Q<n>S, Q<n>M, Q<n>H, Q<n>D, Q<n>W and QOD.
+	Months     int           // Q<n>L.
+	Weeks      int           // Q<n>J<day#>, every <n> weeks on the weekdays.
+	Weekdays   []time.Weekday
+	PerDay     int // Number of institution-specified times a day, such as 2 for "BID".
+}
+
+// InstitutionTimes are the times of day, as offsets from midnight, of the repeat patterns
+// given at institution-specified times. Replace the entries to match the institution.
+// A "<n>ID" pattern without an entry is spread evenly across the day.
+var InstitutionTimes = map[string][]time.Duration{
+	"QAM":    {9 * time.Hour},
+	"QPM":    {18 * time.Hour},
+	"QHS":    {22 * time.Hour},
+	"BID":    {9 * time.Hour, 16 * time.Hour},
+	"TID":    {9 * time.Hour, 16 * time.Hour, 21 * time.Hour},
+	"QID":    {9 * time.Hour, 11 * time.Hour, 16 * time.Hour, 21 * time.Hour},
+	"QSHIFT": {7 * time.Hour, 15 * time.Hour, 23 * time.Hour},
+}
+
+var repeatUnits = map[byte]time.Duration{
+	'S': time.Second,
+	'M': time.Minute,
+	'H': time.Hour,
+	'D': 24 * time.Hour,
+	'W': 7 * 24 * time.Hour,
+}
+
+// ParseRepeatPattern parses a repeat pattern code of table 0335.
+// Meal related timings, such as "AC", are not supported.
+func ParseRepeatPattern(code string) (RepeatPattern, error) {
+	p := RepeatPattern{Code: code}
+	c := strings.ToUpper(strings.TrimSpace(code))
+	if rest, ok := strings.CutPrefix(c, "PRN"); ok {
+		p.AsNeeded = true
+		if len(rest) == 0 {
+			return p, nil
+		}
+		c = rest
+	}
+	switch c {
+	case "", "ONCE":
+		p.Once = true
+		return p, nil
+	case "C":
+		p.Continuous = true
+		return p, nil
+	case "QOD":
+		p.Every = 48 * time.Hour
+		return p, nil
+	case "QD":
+		p.Every = 24 * time.Hour
+		return p, nil
+	case "QAM", "QPM", "QHS":
+		p.PerDay = 1
+		return p, nil
+	case "BID":
+		p.PerDay = 2
+		return p, nil
+	case "TID", "QSHIFT":
+		p.PerDay = 3
+		return p, nil
+	case "QID":
+		p.PerDay = 4
+		return p, nil
+	}
+	if n, ok := strings.CutSuffix(c, "ID"); ok {
+		if v, err := strconv.Atoi(n); err == nil && v > 0 {
+			p.PerDay = v
+			return p, nil
+		}
+	}
+	if rest, ok := strings.CutPrefix(c, "Q"); ok {
+		i := 0
+		for i < len(rest) && rest[i] >= '0' && rest[i] <= '9' {
+			i++
+		}
+		n := 1
+		if i > 0 {
+			n, _ = strconv.Atoi(rest[:i])
+		}
+		if n > 0 && i < len(rest) {
+			unit, days := rest[i], rest[i+1:]
+			switch {
+			case unit == 'L' && len(days) == 0:
+				p.Months = n
+				return p, nil
+			case unit == 'J' && len(days) > 0:
+				p.Weeks = n
+				for _, d := range days {
+					if d < '1' || d > '7' {
+						return p, fmt.Errorf("invalid repeat pattern %q: day %q", code, d)
+					}
+					p.Weekdays = append(p.Weekdays, time.Weekday((d-'0')%7))
+				}
+				return p, nil
+			case repeatUnits[unit] > 0 && len(days) == 0:
+				p.Every = time.Duration(n) * repeatUnits[unit]
+				return p, nil
+			}
+		}
+	}
+	return p, fmt.Errorf("unknown repeat pattern %q", code)
+}
+
+// Pattern returns the parsed repeat pattern of the schedule.
+func (s Schedule) Pattern() (RepeatPattern, error) {
+	return ParseRepeatPattern(s.Repeat)
+}
+
+// times returns the times of day of the schedule, from the explicit times or the institution times.
+func (s Schedule) times(p RepeatPattern) []time.Duration {
+	if len(s.Times) > 0 {
+		return s.Times
+	}
+	if t, ok := InstitutionTimes[strings.TrimPrefix(strings.ToUpper(p.Code), "PRN")]; ok {
+		return t
+	}
+	list := make([]time.Duration, p.PerDay)
+	for i := range list {
+		list[i] = time.Duration(i) * 24 * time.Hour / time.Duration(p.PerDay)
+	}
+	return list
+}
+
+// Occurrences returns the times of the occurrences of the schedule, starting at Start, up to max occurrences.
+// Occurrences end at End, after Total occurrences, or after Duration or Months from Start.
+// A schedule given as needed has no occurrences.
+func (s Schedule) Occurrences(max int) ([]time.Time, error) {
+	p, err := s.Pattern()
+	if err != nil {
+		return nil, err
+	}
+	if p.AsNeeded {
+		return nil, nil
+	}
+	if s.Start.IsZero() {
+		return nil, fmt.Errorf("schedule has no start time")
+	}
+	if s.Total > 0 && (max <= 0 || s.Total < max) {
+		max = s.Total
+	}
+	if max <= 0 {
+		return nil, fmt.Errorf("schedule has no limit on occurrences")
+	}
+	end := s.End
+	if s.Duration > 0 {
+		if e := s.Start.Add(s.Duration); end.IsZero() || e.Before(end) {
+			end = e
+		}
+	}
+	if s.Months > 0 {
+		if e := s.Start.AddDate(0, s.Months, 0); end.IsZero() || e.Before(end) {
+			end = e
+		}
+	}
+	var list []time.Time
+	add := func(t time.Time) bool {
+		if t.Before(s.Start) {
+			return true
+		}
+		if len(list) >= max || (!end.IsZero() && !t.Before(end)) {
+			return false
+		}
+		list = append(list, t)
+		return true
+	}
+	y, m, d := s.Start.Date()
+	midnight := time.Date(y, m, d, 0, 0, 0, 0, s.Start.Location())
+	// Days from the start, at each time of the day.
+	days := func(step int, on func(day time.Time) bool, times []time.Duration) {
+		for i := 0; len(list) < max; i += step {
+			day := midnight.AddDate(0, 0, i)
+			if !end.IsZero() && !day.Before(end) {
+				return
+			}
+			if !on(day) {
+				continue
+			}
+			for _, t := range times {
+				if !add(day.Add(t)) {
+					return
+				}
+			}
+		}
+	}
+	everyDay := func(time.Time) bool { return true }
+	switch {
+	case p.Once, p.Continuous:
+		add(s.Start)
+	case p.PerDay > 0:
+		days(1, everyDay, s.times(p))
+	case p.Every > 0 && p.Every%(24*time.Hour) == 0 && len(s.Times) > 0:
+		days(int(p.Every/(24*time.Hour)), everyDay, s.Times)
+	case p.Every > 0:
+		for t := s.Start; add(t); t = t.Add(p.Every) {
+		}
+	case p.Months > 0:
+		for i := 0; add(s.Start.AddDate(0, i*p.Months, 0)); i++ {
+		}
+	case p.Weeks > 0:
+		times := []time.Duration{s.Start.Sub(midnight)}
+		if len(s.Times) > 0 {
+			times = s.Times
+		}
+		days(1, func(day time.Time) bool {
+			week := int(day.Sub(midnight.AddDate(0, 0, -int(s.Start.Weekday()))).Hours()+12) / (7 * 24)
+			if week%p.Weeks != 0 {
+				return false
+			}
+			for _, wd := range p.Weekdays {
+				if day.Weekday() == wd {
+					return true
+				}
+			}
+			return false
+		}, times)
+	}
+	return list, nil
+}
+
+// ScheduleOf returns the schedule of a TQ or TQ1 from any version, such as ORC-7 or *h251.TQ1.
+// A TQ2 segment is not a schedule and returns an error.
+func ScheduleOf(v any) (Schedule, error) {
+	s := Schedule{}
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return s, fmt.Errorf("%T is nil", v)
+		}
+		rv = rv.Elem()
+	}
+	meta, err := typeMeta(rv.Type())
+	if err != nil {
+		return s, err
+	}
+	str := func(orders ...int32) string {
+		f, ok := pathValue(rv, false, orders...)
+		if !ok {
+			return ""
+		}
+		return firstString(f)
+	}
+	tm := func(order int32) time.Time {
+		f, ok := pathValue(rv, false, order)
+		if !ok || f.Type() != timeType {
+			return time.Time{}
+		}
+		return f.Interface().(time.Time)
+	}
+	number := func(orders ...int32) (Number, error) {
+		return ParseNumber(str(orders...))
+	}
+	switch meta.Name {
+	default:
+		return s, fmt.Errorf("%T is not a TQ or TQ1", v)
+	case "TQ":
+		if s.Quantity, err = number(1, 1); err != nil {
+			return s, fmt.Errorf("TQ-1: %w", err)
+		}
+		s.Units = str(1, 2)
+		s.Repeat = str(2, 1)
+		if explicit := str(2, 2); len(explicit) > 0 {
+			for _, t := range strings.Split(explicit, ",") {
+				d, err := parseClock(t)
+				if err != nil {
+					return s, fmt.Errorf("TQ-2.2: %w", err)
+				}
+				s.Times = append(s.Times, d)
+			}
+		}
+		if err := s.parseDuration(str(3)); err != nil {
+			return s, fmt.Errorf("TQ-3: %w", err)
+		}
+		s.Start, s.End = tm(4), tm(5)
+		s.Priority, s.Condition, s.Text = str(6), str(7), str(8)
+		if total := str(12); len(total) > 0 {
+			if s.Total, err = strconv.Atoi(total); err != nil {
+				return s, fmt.Errorf("TQ-12: %w", err)
+			}
+		}
+	case "TQ1":
+		if s.Quantity, err = number(2, 1); err != nil {
+			return s, fmt.Errorf("TQ1-2: %w", err)
+		}
+		s.Units = str(2, 2)
+		s.Repeat = str(3, 1)
+		if f, ok := pathValue(rv, false, 4); ok && f.Kind() == reflect.Slice {
+			for i := 0; i < f.Len(); i++ {
+				if t, ok := f.Index(i).Interface().(time.Time); ok {
+					s.Times = append(s.Times, time.Duration(t.Hour())*time.Hour+time.Duration(t.Minute())*time.Minute)
+				}
+			}
+		}
+		if q := str(6, 1); len(q) > 0 {
+			n, err := ParseNumber(q)
+			if err != nil {
+				return s, fmt.Errorf("TQ1-6: %w", err)
+			}
+			if err := s.setDuration(n, str(6, 2)); err != nil {
+				return s, fmt.Errorf("TQ1-6: %w", err)
+			}
+		}
+		s.Start, s.End = tm(7), tm(8)
+		s.Priority, s.Condition, s.Text = str(9, 1), str(10), str(11)
+		if total := str(14); len(total) > 0 {
+			if s.Total, err = strconv.Atoi(total); err != nil {
+				return s, fmt.Errorf("TQ1-14: %w", err)
+			}
+		}
+	}
+	return s, nil
+}
+
+// parseDuration parses a TQ-3 duration, such as "X3", "D5" or "INDEF".
+func (s *Schedule) parseDuration(v string) error {
+	v = strings.ToUpper(strings.TrimSpace(v))
+	if len(v) == 0 {
+		return nil
+	}
+	if v == "INDEF" {
+		s.Indefinite = true
+		return nil
+	}
+	n, err := strconv.Atoi(v[1:])
+	if err != nil || n < 0 {
+		return fmt.Errorf("invalid duration %q", v)
+	}
+	switch unit := v[0]; unit {
+	case 'X':
+		s.Total = n
+	case 'L':
+		s.Months = n
+	default:
+		d, ok := repeatUnits[unit]
+		if !ok {
+			return fmt.Errorf("invalid duration %q", v)
+		}
+		s.Duration = time.Duration(n) * d
+	}
+	return nil
+}
+
+// Units of a TQ1-6 service duration, by the units sent.
+var durationUnits = map[string]byte{
+	"S": 'S', "SEC": 'S', "M": 'M', "MIN": 'M', "H": 'H', "HR": 'H', "D": 'D', "DAY": 'D',
+	"W": 'W', "WK": 'W', "L": 'L', "MO": 'L',
+}
+
+// setDuration sets the duration from a TQ1-6 quantity and units, such as "3^D" or "3^d".
+func (s *Schedule) setDuration(n Number, units string) error {
+	i, err := n.Int64()
+	if err != nil {
+		return err
+	}
+	u, ok := durationUnits[strings.ToUpper(units)]
+	if !ok {
+		return fmt.Errorf("unknown duration units %q", units)
+	}
+	return s.parseDuration(string(u) + strconv.FormatInt(i, 10))
+}
+
+// serviceDuration returns the TQ1-6 quantity and units of the Duration or Months of the schedule, such as 5 and "d".
+func (s Schedule) serviceDuration() (quantity int64, units string) {
+	switch {
+	case s.Months > 0:
+		return int64(s.Months), "mo"
+	case s.Duration > 0:
+		for _, u := range []struct {
+			unit  byte
+			units string
+		}{{'W', "wk"}, {'D', "d"}, {'H', "h"}, {'M', "min"}, {'S', "s"}} {
+			if d := repeatUnits[u.unit]; s.Duration%d == 0 {
+				return int64(s.Duration / d), u.units
+			}
+		}
+	}
+	return 0, ""
+}
+
+// tqDuration returns the TQ-3 duration of the schedule, such as "D5", "INDEF" or "X3".
+// TQ-3 holds a single duration, so the total occurrences are used only without another duration;
+// they are also in TQ-12.
+func (s Schedule) tqDuration() string {
+	switch {
+	case s.Indefinite:
+		return "INDEF"
+	case s.Months > 0:
+		return "L" + strconv.Itoa(s.Months)
+	case s.Duration > 0:
+		for _, unit := range []byte{'W', 'D', 'H', 'M', 'S'} {
+			if d := repeatUnits[unit]; s.Duration%d == 0 {
+				return string(unit) + strconv.FormatInt(int64(s.Duration/d), 10)
+			}
+		}
+	case s.Total > 0:
+		return "X" + strconv.Itoa(s.Total)
+	}
+	return ""
+}
+
+// NewTQ returns the schedule as a TQ of any version, such as h231.TQ.
+func NewTQ[T any](s Schedule) (T, error) {
+	var v T
+	rv := reflect.ValueOf(&v).Elem()
+	if dataTypeName(rv.Type()) != "TQ" {
+		return v, fmt.Errorf("%T is not a TQ", v)
+	}
+	set := func(value string, orders ...int32) {
+		if len(value) == 0 {
+			return
+		}
+		if f, ok := pathValue(rv, true, orders...); ok {
+			setFirstString(f, value)
+		}
+	}
+	set(s.Quantity.String(), 1, 1)
+	set(s.Units, 1, 2)
+	set(s.Repeat, 2, 1)
+	times := make([]string, len(s.Times))
+	for i, t := range s.Times {
+		times[i] = fmt.Sprintf("%02d%02d", int(t.Hours()), int(t.Minutes())%60)
+	}
+	set(strings.Join(times, ","), 2, 2)
+	set(s.tqDuration(), 3)
+	setTime(rv, s.Start, 4)
+	setTime(rv, s.End, 5)
+	set(s.Priority, 6)
+	set(s.Condition, 7)
+	set(s.Text, 8)
+	if s.Total > 0 {
+		set(strconv.Itoa(s.Total), 12)
+	}
+	return v, nil
+}
+
+// NewTQ1 returns the schedule as a TQ1 segment of any version, such as h251.TQ1.
+func NewTQ1[T any](s Schedule) (T, error) {
+	var v T
+	rv := reflect.ValueOf(&v).Elem()
+	meta, err := typeMeta(rv.Type())
+	if err != nil || meta.Name != "TQ1" {
+		return v, fmt.Errorf("%T is not a TQ1", v)
+	}
+	set := func(value string, orders ...int32) {
+		if len(value) == 0 {
+			return
+		}
+		if f, ok := pathValue(rv, true, orders...); ok {
+			setFirstString(f, value)
+		}
+	}
+	set(s.Quantity.String(), 2, 1)
+	set(s.Units, 2, 2)
+	set(s.Repeat, 3, 1)
+	if f, ok := pathValue(rv, false, 4); ok && f.Kind() == reflect.Slice && f.Type().Elem() == timeType {
+		for _, t := range s.Times {
+			f.Set(reflect.Append(f, reflect.ValueOf(time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(t))))
+		}
+	}
+	if n, units := s.serviceDuration(); n > 0 {
+		set(strconv.FormatInt(n, 10), 6, 1)
+		set(units, 6, 2)
+	}
+	setTime(rv, s.Start, 7)
+	setTime(rv, s.End, 8)
+	set(s.Priority, 9)
+	set(s.Condition, 10)
+	set(s.Text, 11)
+	if s.Total > 0 {
+		set(strconv.Itoa(s.Total), 14)
+	}
+	return v, nil
+}
+
+// parseClock parses a time of day, "HHMM", as an offset from midnight.
+func parseClock(v string) (time.Duration, error) {
+	v = strings.TrimSpace(v)
+	if len(v) != 4 || !isDigits(v) {
+		return 0, fmt.Errorf("invalid time %q", v)
+	}
+	h, _ := strconv.Atoi(v[:2])
+	m, _ := strconv.Atoi(v[2:])
+	if h > 23 || m > 59 {
+		return 0, fmt.Errorf("invalid time %q", v)
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+}
+
+// pathValue returns the value at a path of component numbers, such as 3, 1 for TQ1-3.1.
+// The first repetition of a repeating component is used. If create is set, nil pointers
+// are allocated and a missing first repetition is added.
+func pathValue(rv reflect.Value, create bool, orders ...int32) (reflect.Value, bool) {
+	for i, order := range orders {
+		if i > 0 {
+			var ok bool
+			if rv, ok = firstValue(rv, create); !ok {
+				return rv, false
+			}
+		}
+		f, ok := component(rv, order)
+		if !ok {
+			return rv, false
+		}
+		rv = f
+	}
+	return rv, true
+}
+
+// firstValue returns the value within pointers and the first repetition of a slice.
+func firstValue(rv reflect.Value, create bool) (reflect.Value, bool) {
+	for {
+		switch rv.Kind() {
+		default:
+			return rv, true
+		case reflect.Pointer:
+			if rv.IsNil() {
+				if !create {
+					return rv, false
+				}
+				rv.Set(reflect.New(rv.Type().Elem()))
+			}
+			rv = rv.Elem()
+		case reflect.Slice:
+			if rv.Type().Elem().Kind() == reflect.Uint8 {
+				return rv, true
+			}
+			if rv.Len() == 0 {
+				if !create {
+					return rv, false
+				}
+				rv.Set(reflect.Append(rv, reflect.New(rv.Type().Elem()).Elem()))
+			}
+			rv = rv.Index(0)
+		}
+	}
+}
+
+// firstString returns the first string of a value: the value of a string, or the first component of a data type.
+func firstString(rv reflect.Value) string {
+	rv, ok := firstValue(rv, false)
+	if !ok {
+		return ""
+	}
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String()
+	case reflect.Struct:
+		return componentString(rv, 1)
+	}
+	return ""
+}
+
+// setFirstString sets the string of a value, or the first component of a data type.
+func setFirstString(rv reflect.Value, s string) {
+	rv, ok := firstValue(rv, true)
+	if !ok {
+		return
+	}
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(s)
+	case reflect.Struct:
+		setComponent(rv, 1, s)
+	}
+}
+
+func setTime(rv reflect.Value, t time.Time, order int32) {
+	if t.IsZero() {
+		return
+	}
+	if f, ok := component(rv, order); ok && f.Type() == timeType {
+		f.Set(reflect.ValueOf(t))
+	}
+}
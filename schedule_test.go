@@ -0,0 +1,178 @@
+package hl7
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	v231 "github.com/kardianos/hl7/h231"
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestParseRepeatPattern(t *testing.T) {
+	list := []struct {
+		Code string
+		Want RepeatPattern
+		Err  bool
+	}{
+		{Code: "", Want: RepeatPattern{Once: true}},
+		{Code: "Q6H", Want: RepeatPattern{Every: 6 * time.Hour}},
+		{Code: "QOD", Want: RepeatPattern{Every: 48 * time.Hour}},
+		{Code: "Q2L", Want: RepeatPattern{Months: 2}},
+		{Code: "BID", Want: RepeatPattern{PerDay: 2}},
+		{Code: "5ID", Want: RepeatPattern{PerDay: 5}},
+		{Code: "PRN", Want: RepeatPattern{AsNeeded: true}},
+		{Code: "PRNQ4H", Want: RepeatPattern{AsNeeded: true, Every: 4 * time.Hour}},
+		{Code: "Q2J135", Want: RepeatPattern{Weeks: 2, Weekdays: []time.Weekday{time.Monday, time.Wednesday, time.Friday}}},
+		{Code: "Q6X", Err: true},
+		{Code: "QJ8", Err: true},
+	}
+	for _, item := range list {
+		t.Run(item.Code, func(t *testing.T) {
+			p, err := ParseRepeatPattern(item.Code)
+			if item.Err {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			p.Code = ""
+			if got, want := fmt.Sprint(p), fmt.Sprint(item.Want); got != want {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestScheduleTQ(t *testing.T) {
+	raw := "MSH|^~\\&|A|B|C|D|20060101||ORM^O01|1|P|2.3.1\r" +
+		"ORC|NW|1|||||1^Q6H^X3^200601011200^^S\r"
+	d := NewDecoder(v231.Registry, nil)
+	list, err := d.DecodeList([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	orc := list[1].(*v231.ORC)
+	s, err := ScheduleOf(orc.QuantityTiming)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Quantity.String() != "1" || s.Repeat != "Q6H" || s.Total != 3 || s.Priority != "S" {
+		t.Errorf("got %+v", s)
+	}
+	got, err := s.Occurrences(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"2006-01-01 12:00", "2006-01-01 18:00", "2006-01-02 00:00"}
+	if g := formatTimes(got); g != strings.Join(want, ",") {
+		t.Errorf("got %s", g)
+	}
+
+	tq1, err := NewTQ1[v251.TQ1](s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := NewEncoder(&EncodeOption{TrimTrailingSeparator: true})
+	b, err := e.Encode(&tq1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const wantTQ1 = "TQ1|1|1|Q6H||||20060101120000||S|||||3"
+	if g := strings.TrimSpace(string(b)); g != wantTQ1 {
+		t.Errorf("got  %s\nwant %s", g, wantTQ1)
+	}
+
+	back, err := ScheduleOf(&tq1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tq, err := NewTQ[v231.TQ](back)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tq.Interval.RepeatPattern != "Q6H" || tq.Duration != "X3" || tq.Priority != "S" || !tq.StartDateTime.Equal(s.Start) {
+		t.Errorf("got %+v", tq)
+	}
+	if _, err := ScheduleOf(&v251.TQ2{SequenceConditionCode: "ES"}); err == nil {
+		t.Error("got a schedule of a TQ2")
+	}
+}
+
+func TestScheduleRoundTrip(t *testing.T) {
+	list := []Schedule{
+		{Repeat: "Q6H", Duration: 72 * time.Hour, Total: 10},
+		{Repeat: "Q1L", Months: 3, Total: 2},
+		{Repeat: "BID", Total: 4},
+	}
+	for _, s := range list {
+		tq1, err := NewTQ1[v251.TQ1](s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := ScheduleOf(&tq1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, s) {
+			t.Errorf("TQ1 got %+v, want %+v", got, s)
+		}
+		tq, err := NewTQ[v231.TQ](s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err = ScheduleOf(tq); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, s) {
+			t.Errorf("TQ got %+v, want %+v", got, s)
+		}
+	}
+}
+
+func TestScheduleOccurrences(t *testing.T) {
+	start := time.Date(2024, 3, 4, 8, 0, 0, 0, time.UTC) // Monday.
+	list := []struct {
+		Name     string
+		Schedule Schedule
+		Want     string
+	}{
+		{Name: "bid", Schedule: Schedule{Repeat: "BID", Start: start, Duration: 48 * time.Hour},
+			Want: "2024-03-04 09:00,2024-03-04 16:00,2024-03-05 09:00,2024-03-05 16:00"},
+		{Name: "explicit", Schedule: Schedule{Repeat: "QOD", Start: start, Times: []time.Duration{10 * time.Hour}, Total: 2},
+			Want: "2024-03-04 10:00,2024-03-06 10:00"},
+		{Name: "weekly", Schedule: Schedule{Repeat: "Q2J35", Start: start, End: start.AddDate(0, 0, 21)},
+			Want: "2024-03-06 08:00,2024-03-08 08:00,2024-03-20 08:00,2024-03-22 08:00"},
+		{Name: "monthly", Schedule: Schedule{Repeat: "Q1L", Start: start, Months: 3},
+			Want: "2024-03-04 08:00,2024-04-04 08:00,2024-05-04 08:00"},
+		{Name: "once", Schedule: Schedule{Start: start}, Want: "2024-03-04 08:00"},
+		{Name: "prn", Schedule: Schedule{Repeat: "PRN", Start: start}, Want: ""},
+	}
+	for _, item := range list {
+		t.Run(item.Name, func(t *testing.T) {
+			got, err := item.Schedule.Occurrences(10)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if g := formatTimes(got); g != item.Want {
+				t.Errorf("got  %s\nwant %s", g, item.Want)
+			}
+		})
+	}
+	if _, err := (Schedule{Repeat: "Q1H", Start: start}).Occurrences(0); err == nil {
+		t.Error("expected error for an unlimited schedule")
+	}
+}
+
+func formatTimes(list []time.Time) string {
+	s := make([]string, len(list))
+	for i, t := range list {
+		s[i] = t.Format("2006-01-02 15:04")
+	}
+	return strings.Join(s, ",")
+}
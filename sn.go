@@ -0,0 +1,173 @@
+package hl7
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// StructuredNumber is the typed value of the SN data type, such as ">^100", "^10^-^20" or "^1^:^128".
+// It is also the parsed form of a reference range, such as "3.5-5.0" or ">60".
+type StructuredNumber struct {
+	Comparator string // One of ">", "<", ">=", "<=", "=", "<>", or empty for "=".
+	Num1       Number
+	Separator  string // One of "-" (range), "+" (categorical), "/" or ":" (ratio), "." (series), or empty.
+	Num2       Number
+}
+
+var snComparators = map[string]bool{"": true, ">": true, "<": true, ">=": true, "<=": true, "=": true, "<>": true}
+var snSeparators = map[string]bool{"": true, "-": true, "+": true, "/": true, ".": true, ":": true}
+
+// StructuredNumberOf returns the typed value of a SN from any version, such as an OBX-5 value of *h251.SN.
+func StructuredNumberOf(sn any) (StructuredNumber, error) {
+	var s StructuredNumber
+	rv := reflect.ValueOf(sn)
+	if name := dataTypeName(reflect.TypeOf(sn)); name != "SN" {
+		return s, fmt.Errorf("%T is not a SN", sn)
+	}
+	return newStructuredNumber(componentString(rv, 1), componentString(rv, 2), componentString(rv, 3), componentString(rv, 4))
+}
+
+// ParseStructuredNumber parses the encoded components of a SN, such as "<=^5" or "^10^-^20".
+func ParseStructuredNumber(text string) (StructuredNumber, error) {
+	parts := strings.SplitN(text, "^", 4)
+	for len(parts) < 4 {
+		parts = append(parts, "")
+	}
+	return newStructuredNumber(parts[0], parts[1], parts[2], parts[3])
+}
+
+func newStructuredNumber(comparator, num1, separator, num2 string) (StructuredNumber, error) {
+	s := StructuredNumber{Comparator: comparator, Separator: separator}
+	if !snComparators[comparator] {
+		return s, fmt.Errorf("invalid SN comparator %q", comparator)
+	}
+	if !snSeparators[separator] {
+		return s, fmt.Errorf("invalid SN separator %q", separator)
+	}
+	var err error
+	if s.Num1, err = ParseNumber(num1); err != nil {
+		return s, fmt.Errorf("SN num1: %w", err)
+	}
+	if s.Num2, err = ParseNumber(num2); err != nil {
+		return s, fmt.Errorf("SN num2: %w", err)
+	}
+	return s, nil
+}
+
+// ParseReferenceRange parses an OBX-7 reference range: a range such as "3.5-5.0" or "3.5 - 5.0",
+// a limit such as ">60" or "<=0.5", or a single value. Text ranges, such as "negative", return an error.
+func ParseReferenceRange(text string) (StructuredNumber, error) {
+	s := StructuredNumber{}
+	text = strings.TrimSpace(text)
+	for _, c := range []string{">=", "<=", "<>", ">", "<", "="} {
+		if rest, ok := strings.CutPrefix(text, c); ok {
+			s.Comparator = c
+			text = strings.TrimSpace(rest)
+			break
+		}
+	}
+	num1, num2 := text, ""
+	if len(s.Comparator) == 0 && len(text) > 1 {
+		// The range separator follows the first number, which may have a sign.
+		if i := strings.Index(text[1:], "-"); i >= 0 {
+			i++
+			num1, num2 = strings.TrimSpace(text[:i]), strings.TrimSpace(text[i+1:])
+			s.Separator = "-"
+		}
+	}
+	var err error
+	if s.Num1, err = ParseNumber(num1); err != nil || s.Num1.Empty() {
+		return s, fmt.Errorf("invalid reference range %q", text)
+	}
+	if s.Num2, err = ParseNumber(num2); err != nil || (s.Separator == "-" && s.Num2.Empty()) {
+		return s, fmt.Errorf("invalid reference range %q", text)
+	}
+	return s, nil
+}
+
+// String returns the value as text, such as ">100", "10-20", "1:128" or "<=5".
+func (s StructuredNumber) String() string {
+	return s.Comparator + s.Num1.String() + s.Separator + s.Num2.String()
+}
+
+// IsRange reports if the value is a range, "^10^-^20", a limit, ">^100", or a single number.
+func (s StructuredNumber) IsRange() bool {
+	switch {
+	case s.Separator == "-":
+		return !s.Num1.Empty() && !s.Num2.Empty()
+	case len(s.Separator) == 0 && s.Num2.Empty():
+		return !s.Num1.Empty()
+	}
+	return false
+}
+
+// Contains reports if x is within the value: within a range, inclusive, or within a limit,
+// such as x > 100 for ">100". A single number contains only itself, and "<>" every other number.
+// A ratio, such as "1:128", contains x if x equals the ratio. Other values contain nothing.
+func (s StructuredNumber) Contains(x Number) bool {
+	if x.Empty() {
+		return false
+	}
+	switch s.Separator {
+	case "-":
+		if !s.IsRange() || len(s.Comparator) > 0 {
+			return false
+		}
+		return x.Cmp(s.Num1) >= 0 && x.Cmp(s.Num2) <= 0
+	case "/", ":":
+		if s.Num1.Empty() || s.Num2.Empty() || s.Num2.Rat().Sign() == 0 {
+			return false
+		}
+		ratio := s.Num1.Rat()
+		return x.Rat().Cmp(ratio.Quo(ratio, s.Num2.Rat())) == 0
+	case "":
+		if s.Num1.Empty() || !s.Num2.Empty() {
+			return false
+		}
+		c := x.Cmp(s.Num1)
+		switch s.Comparator {
+		case "", "=":
+			return c == 0
+		case "<>":
+			return c != 0
+		case ">":
+			return c > 0
+		case ">=":
+			return c >= 0
+		case "<":
+			return c < 0
+		case "<=":
+			return c <= 0
+		}
+	}
+	return false
+}
+
+// Flag returns the abnormal flag, from table 0078, of x against a reference range:
+// "L" if below the range, "H" if above, and "N" if within. If the value is not a range, Flag returns empty.
+// A single value without a comparator, such as "5", is not a bound: x equal to it is "N",
+// and other values have no flag, as the value does not say which side is abnormal.
+func (s StructuredNumber) Flag(x Number) string {
+	if x.Empty() || !s.IsRange() {
+		return ""
+	}
+	if s.Contains(x) {
+		return "N"
+	}
+	switch s.Comparator {
+	case ">", ">=":
+		return "L"
+	case "<", "<=":
+		return "H"
+	case "<>":
+		return ""
+	}
+	if len(s.Separator) == 0 {
+		return ""
+	}
+	if x.Cmp(s.Num1) < 0 {
+		return "L"
+	}
+	return "H"
+}
@@ -0,0 +1,108 @@
+package hl7
+
+import (
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func num(t *testing.T, s string) Number {
+	t.Helper()
+	n, err := ParseNumber(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestStructuredNumber(t *testing.T) {
+	list := []struct {
+		text    string
+		str     string
+		in      []string
+		out     []string
+		isRange bool
+	}{
+		{">^100", ">100", []string{"100.1", "1000"}, []string{"100", "99"}, true},
+		{"^10^-^20", "10-20", []string{"10", "15.5", "20.0"}, []string{"9.99", "20.01"}, true},
+		{"<=^5", "<=5", []string{"5", "-1"}, []string{"5.01"}, true},
+		{"^1^:^128", "1:128", []string{"0.0078125"}, []string{"1", "128"}, false},
+		{"^2^+", "2+", nil, []string{"2"}, false},
+		{"^7.0", "7.0", []string{"7"}, []string{"7.1"}, true},
+	}
+	for _, item := range list {
+		s, err := ParseStructuredNumber(item.text)
+		if err != nil {
+			t.Errorf("%q: %v", item.text, err)
+			continue
+		}
+		if g := s.String(); g != item.str {
+			t.Errorf("%q: string got %q, want %q", item.text, g, item.str)
+		}
+		if g := s.IsRange(); g != item.isRange {
+			t.Errorf("%q: range got %t", item.text, g)
+		}
+		for _, x := range item.in {
+			if !s.Contains(num(t, x)) {
+				t.Errorf("%q does not contain %s", item.text, x)
+			}
+		}
+		for _, x := range item.out {
+			if s.Contains(num(t, x)) {
+				t.Errorf("%q contains %s", item.text, x)
+			}
+		}
+	}
+	for _, text := range []string{"~^1", "^1^*^2", "^a"} {
+		if _, err := ParseStructuredNumber(text); err == nil {
+			t.Errorf("%q parsed", text)
+		}
+	}
+
+	s, err := StructuredNumberOf(&v251.SN{Comparator: ">=", Num1: "60"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g := s.String(); g != ">=60" {
+		t.Errorf("SN got %q", g)
+	}
+	if _, err := StructuredNumberOf(v251.CE{}); err == nil {
+		t.Error("CE converted to a structured number")
+	}
+}
+
+func TestReferenceRange(t *testing.T) {
+	list := []struct {
+		text string
+		str  string
+		flag map[string]string
+	}{
+		{"3.5-5.0", "3.5-5.0", map[string]string{"3.4": "L", "3.5": "N", "5.0": "N", "5.1": "H"}},
+		{"3.5 - 5.0", "3.5-5.0", map[string]string{"4": "N"}},
+		{"-1.5--0.5", "-1.5--0.5", map[string]string{"-2": "L", "-1": "N", "0": "H"}},
+		{">60", ">60", map[string]string{"60": "L", "61": "N"}},
+		{"<0.5", "<0.5", map[string]string{"0.4": "N", "0.5": "H"}},
+		{"5", "5", map[string]string{"4": "", "5": "N", "6": ""}},
+		{"=5", "=5", map[string]string{"4": "", "5": "N", "6": ""}},
+	}
+	for _, item := range list {
+		r, err := ParseReferenceRange(item.text)
+		if err != nil {
+			t.Errorf("%q: %v", item.text, err)
+			continue
+		}
+		if g := r.String(); g != item.str {
+			t.Errorf("%q: got %q, want %q", item.text, g, item.str)
+		}
+		for x, want := range item.flag {
+			if g := r.Flag(num(t, x)); g != want {
+				t.Errorf("%q flag %s: got %q, want %q", item.text, x, g, want)
+			}
+		}
+	}
+	for _, text := range []string{"negative", "", "3.5-", ">"} {
+		if _, err := ParseReferenceRange(text); err == nil {
+			t.Errorf("%q parsed", text)
+		}
+	}
+}
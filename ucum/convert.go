@@ -0,0 +1,151 @@
+package ucum
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Converter converts values between units that are not Compatible, such as mass and amount of substance.
+// Convert reports false if it does not convert between the units.
+type Converter interface {
+	Convert(value float64, from, to Unit) (float64, bool)
+}
+
+// MolarMass converts between mass and amount of substance, such as "mg/dL" and "mmol/L",
+// with the molar mass of the substance in g/mol.
+type MolarMass float64
+
+var mass = Dimension{2: 1}
+
+// Convert converts between units which differ by a mass in from or in to.
+func (m MolarMass) Convert(value float64, from, to Unit) (float64, bool) {
+	if m <= 0 || from.IsZero() || to.IsZero() || from.special != nil || to.special != nil {
+		return 0, false
+	}
+	mol, err := Parse("mol")
+	if err != nil {
+		return 0, false
+	}
+	// Particles per gram of the substance, in base units.
+	perGram := new(big.Rat).SetFloat64(float64(m))
+	if perGram == nil {
+		return 0, false
+	}
+	perGram.Quo(mol.factor, perGram)
+	v := new(big.Rat).SetFloat64(value)
+	if v == nil {
+		return 0, false
+	}
+	switch {
+	case from.dim == to.dim.add(mass, 1):
+		v.Mul(v, perGram)
+	case to.dim == from.dim.add(mass, 1):
+		v.Quo(v, perGram)
+	default:
+		return 0, false
+	}
+	f, _ := to.fromBase(from.toBase(v)).Float64()
+	return f, true
+}
+
+// ISOPlus maps the ISO+ units that are not case insensitive UCUM to UCUM.
+// Other ISO+ units, such as "mmol/l" or "g/dl", are parsed as case insensitive UCUM.
+var ISOPlus = map[string]string{
+	"iu":      "[IU]",
+	"iu/l":    "[IU]/L",
+	"iu/ml":   "[IU]/mL",
+	"miu/l":   "m[IU]/L",
+	"miu/ml":  "m[IU]/mL",
+	"uiu/ml":  "u[IU]/mL",
+	"mm(hg)":  "mm[Hg]",
+	"mmhg":    "mm[Hg]",
+	"cm(h2o)": "cm[H2O]",
+	"sec":     "s",
+	"hr":      "h",
+	"degc":    "Cel",
+	"degf":    "[degF]",
+	"ratio":   "1",
+	"/hpf":    "/[HPF]",
+	"/lpf":    "/[LPF]",
+}
+
+// System parses units of UCUM and other coding systems, and converts values between them.
+// The zero System is ready to use.
+type System struct {
+	// Aliases maps the units of other coding systems to UCUM, by coding system and unit,
+	// such as {"99LAB": {"MG_DL": "mg/dL"}}. Units are matched ignoring case.
+	// ISOPlus is used for the "ISO+" coding system without an entry.
+	Aliases map[string]map[string]string
+
+	// Converters convert between units that are not Compatible, in order.
+	Converters []Converter
+}
+
+// Parse parses a unit of a coding system, such as ("mg/dL", "UCUM") or ("MMOL/L", "ISO+").
+// Units of other coding systems, or without a coding system, are mapped with the aliases,
+// and are otherwise parsed as UCUM, then as case insensitive UCUM.
+func (s *System) Parse(code, codingSystem string) (Unit, error) {
+	code = strings.TrimSpace(code)
+	codingSystem = strings.ToUpper(strings.TrimSpace(codingSystem))
+	aliases, ok := s.Aliases[codingSystem]
+	if !ok && codingSystem == "ISO+" {
+		aliases = ISOPlus
+	}
+	if alias, ok := lookupAlias(aliases, code); ok {
+		u, err := Parse(alias)
+		if err != nil {
+			return u, err
+		}
+		u.Code = alias
+		return u, nil
+	}
+	u, err := Parse(code)
+	if err == nil {
+		return u, nil
+	}
+	if ci, ciErr := ParseCaseInsensitive(code); ciErr == nil {
+		return ci, nil
+	}
+	return u, err
+}
+
+func lookupAlias(aliases map[string]string, code string) (string, bool) {
+	if alias, ok := aliases[code]; ok {
+		return alias, true
+	}
+	for k, alias := range aliases {
+		if strings.EqualFold(k, code) {
+			return alias, true
+		}
+	}
+	return "", false
+}
+
+// Convert returns a value in from units in to units, directly if the units are Compatible,
+// and otherwise with the first of the Converters that converts between them.
+func (s *System) Convert(value float64, from, to Unit) (float64, error) {
+	if from.Compatible(to) {
+		return from.Convert(value, to)
+	}
+	for _, c := range s.Converters {
+		if v, ok := c.Convert(value, from, to); ok {
+			return v, nil
+		}
+	}
+	return 0, fmt.Errorf("ucum: cannot convert %q (%s) to %q (%s)", from.Code, from.dim, to.Code, to.dim)
+}
+
+// Convert returns a value in from units in to units, which are UCUM codes of Compatible units,
+// such as Convert(196, "mg/dL", "g/L").
+func Convert(value float64, from, to string) (float64, error) {
+	f, err := Parse(from)
+	if err != nil {
+		return 0, err
+	}
+	t, err := Parse(to)
+	if err != nil {
+		return 0, err
+	}
+	return f.Convert(value, t)
+}
@@ -0,0 +1,237 @@
+package ucum
+
+import (
+	_ "embed"
+	"encoding/xml"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//go:embed essence.xml
+var essenceXML []byte
+
+// essence is the XML of the UCUM essence table.
+type essence struct {
+	Prefixes []struct {
+		Code   string `xml:"Code,attr"`
+		CI     string `xml:"CODE,attr"`
+		Factor struct {
+			Value string `xml:"value,attr"`
+		} `xml:"value"`
+	} `xml:"prefix"`
+	BaseUnits []struct {
+		Code string `xml:"Code,attr"`
+		CI   string `xml:"CODE,attr"`
+		Dim  string `xml:"dim,attr"`
+	} `xml:"base-unit"`
+	Units []struct {
+		Code      string `xml:"Code,attr"`
+		CI        string `xml:"CODE,attr"`
+		Metric    string `xml:"isMetric,attr"`
+		Special   string `xml:"isSpecial,attr"`
+		Arbitrary string `xml:"isArbitrary,attr"`
+		Value     struct {
+			Unit     string `xml:"Unit,attr"`
+			Value    string `xml:"value,attr"`
+			Function *struct {
+				Name  string `xml:"name,attr"`
+				Value string `xml:"value,attr"`
+				Unit  string `xml:"Unit,attr"`
+			} `xml:"function"`
+		} `xml:"value"`
+	} `xml:"unit"`
+}
+
+// Offsets of the special units in base units, by function name.
+// Special units without an offset, such as "[pH]", only convert to themselves.
+var specialOffset = map[string]string{
+	"Cel":  "273.15",
+	"degF": "45967/180", // 459.67 * 5/9.
+}
+
+// atom is a unit of the essence table, in base units.
+type atom struct {
+	code   string
+	metric bool
+	unit   Unit
+
+	define    func() (Unit, error)
+	resolved  bool
+	resolving bool // Detects definitions in a cycle.
+}
+
+// prefix of a metric unit, such as "m" for milli.
+type prefix struct {
+	code   string
+	factor *big.Rat
+}
+
+// table of the essence units. Atoms are resolved when loaded.
+type table struct {
+	prefix   []prefix // Longest code first.
+	prefixCI []prefix
+	atom     map[string]*atom
+	atomCI   map[string]*atom
+}
+
+var essenceTable = func() func() (*table, error) {
+	var once sync.Once
+	var t *table
+	var err error
+	return func() (*table, error) {
+		once.Do(func() {
+			t, err = loadEssence(essenceXML)
+		})
+		return t, err
+	}
+}()
+
+func loadEssence(data []byte) (*table, error) {
+	e := essence{}
+	if err := xml.Unmarshal(data, &e); err != nil {
+		return nil, fmt.Errorf("ucum: essence: %w", err)
+	}
+	t := &table{
+		atom:   map[string]*atom{},
+		atomCI: map[string]*atom{},
+	}
+	for _, p := range e.Prefixes {
+		f, ok := new(big.Rat).SetString(p.Factor.Value)
+		if !ok {
+			return nil, fmt.Errorf("ucum: essence: prefix %q value %q", p.Code, p.Factor.Value)
+		}
+		t.prefix = append(t.prefix, prefix{code: p.Code, factor: f})
+		t.prefixCI = append(t.prefixCI, prefix{code: p.CI, factor: f})
+	}
+	for _, list := range [][]prefix{t.prefix, t.prefixCI} {
+		sort.SliceStable(list, func(i, j int) bool { return len(list[i].code) > len(list[j].code) })
+	}
+	add := func(a *atom, ci string) {
+		t.atom[a.code] = a
+		if _, ok := t.atomCI[ci]; !ok {
+			t.atomCI[ci] = a
+		}
+	}
+	for _, b := range e.BaseUnits {
+		i := strings.Index("LTMACQF", b.Dim)
+		if len(b.Dim) != 1 || i < 0 {
+			return nil, fmt.Errorf("ucum: essence: base unit %q dimension %q", b.Code, b.Dim)
+		}
+		u := unity()
+		u.dim[i] = 1
+		add(&atom{code: b.Code, metric: true, unit: u, resolved: true}, b.CI)
+	}
+	for _, item := range e.Units {
+		item := item
+		a := &atom{code: item.Code, metric: item.Metric == "yes"}
+		a.define = func() (Unit, error) {
+			v := item.Value
+			switch {
+			case v.Function != nil:
+				u, err := t.parse(v.Function.Unit)
+				if err != nil {
+					return u, err
+				}
+				scale, ok := new(big.Rat).SetString(v.Function.Value)
+				if !ok {
+					return u, fmt.Errorf("value %q", v.Function.Value)
+				}
+				scale.Mul(scale, u.factor)
+				offset, ok := specialOffset[v.Function.Name]
+				if !ok {
+					// Convert only to itself, as an arbitrary unit.
+					u = unity()
+					u.addArbitrary(item.Code, 1)
+					return u, nil
+				}
+				u.special = &special{code: item.Code, scale: scale}
+				u.special.offset, _ = new(big.Rat).SetString(offset)
+				u.factor = scale
+				return u, nil
+			case item.Arbitrary == "yes" && v.Unit == "1":
+				u := unity()
+				u.addArbitrary(item.Code, 1)
+				return u, nil
+			}
+			u, err := t.parse(v.Unit)
+			if err != nil {
+				return u, err
+			}
+			f, ok := new(big.Rat).SetString(v.Value)
+			if !ok {
+				return u, fmt.Errorf("value %q", v.Value)
+			}
+			u.factor = new(big.Rat).Mul(u.factor, f)
+			return u, nil
+		}
+		add(a, item.CI)
+	}
+	for _, a := range t.atom {
+		if _, err := t.resolve(a); err != nil {
+			return nil, fmt.Errorf("ucum: essence: %w", err)
+		}
+	}
+	return t, nil
+}
+
+// parse parses the case sensitive code of a unit definition.
+func (t *table) parse(code string) (Unit, error) {
+	p := &parser{s: code, t: t}
+	u, err := p.main()
+	if err == nil && p.i < len(p.s) {
+		err = fmt.Errorf("unexpected %q", p.s[p.i:])
+	}
+	return u, err
+}
+
+// resolve returns the unit of an atom in base units.
+func (t *table) resolve(a *atom) (Unit, error) {
+	if a.resolved {
+		return a.unit, nil
+	}
+	if a.resolving {
+		return Unit{}, fmt.Errorf("unit %q is defined by itself", a.code)
+	}
+	a.resolving = true
+	u, err := a.define()
+	a.resolving = false
+	if err != nil {
+		return u, fmt.Errorf("unit %q: %w", a.code, err)
+	}
+	a.unit, a.resolved = u, true
+	return u, nil
+}
+
+// lookup returns the unit of a symbol, an atom with an optional prefix, such as "mg".
+func (t *table) lookup(symbol string, ci bool) (Unit, error) {
+	atoms, prefixes := t.atom, t.prefix
+	if ci {
+		symbol = strings.ToUpper(symbol)
+		atoms, prefixes = t.atomCI, t.prefixCI
+	}
+	if a, ok := atoms[symbol]; ok {
+		return t.resolve(a)
+	}
+	for _, p := range prefixes {
+		rest, ok := strings.CutPrefix(symbol, p.code)
+		if !ok {
+			continue
+		}
+		a, ok := atoms[rest]
+		if !ok || !a.metric {
+			continue
+		}
+		u, err := t.resolve(a)
+		if err != nil {
+			return u, err
+		}
+		if u.special != nil {
+			return Unit{}, fmt.Errorf("special unit %q cannot have a prefix", symbol)
+		}
+		return multiply(Unit{factor: p.factor}, u, 1)
+	}
+	return Unit{}, fmt.Errorf("unknown unit %q", symbol)
+}
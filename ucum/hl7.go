@@ -0,0 +1,184 @@
+package ucum
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/kardianos/hl7"
+)
+
+// Quantity is a value with units, such as a CQ, or OBX-5 and OBX-6 of a numeric observation.
+type Quantity struct {
+	Comparator string // Comparator of a SN value, such as "<" or ">=", or empty.
+	Value      hl7.Number
+	Unit       Unit // Zero if the quantity has no units.
+}
+
+// String returns the quantity as text, such as "196 mg/dL".
+func (q Quantity) String() string {
+	if q.Unit.IsZero() {
+		return q.Comparator + q.Value.String()
+	}
+	return q.Comparator + q.Value.String() + " " + q.Unit.Code
+}
+
+// QuantityOf returns the quantity of a CQ from any version, such as TQ1-2.
+func (s *System) QuantityOf(cq any) (Quantity, error) {
+	q := Quantity{}
+	rv := reflect.Indirect(reflect.ValueOf(cq))
+	if typeName(rv) != "CQ" {
+		return q, fmt.Errorf("ucum: %T is not a CQ", cq)
+	}
+	var err error
+	if q.Value, err = hl7.ParseNumber(fieldString(rv, 1)); err != nil {
+		return q, fmt.Errorf("ucum: CQ-1: %w", err)
+	}
+	if q.Unit, err = s.units(field(rv, 2)); err != nil {
+		return q, fmt.Errorf("ucum: CQ-2: %w", err)
+	}
+	return q, nil
+}
+
+// ObservationQuantity returns the quantity of an OBX segment from any version, such as *h251.OBX,
+// from OBX-5 of a NM value, or of a SN value of a single number, and the units of OBX-6.
+func (s *System) ObservationQuantity(obx any) (Quantity, error) {
+	q := Quantity{}
+	rv := reflect.Indirect(reflect.ValueOf(obx))
+	if typeName(rv) != "OBX" {
+		return q, fmt.Errorf("ucum: %T is not an OBX", obx)
+	}
+	value := field(rv, 5)
+	if value.Kind() == reflect.Slice {
+		if value.Len() == 0 {
+			return q, fmt.Errorf("ucum: OBX-5 is empty")
+		}
+		value = value.Index(0)
+	}
+	for value.Kind() == reflect.Interface || value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return q, fmt.Errorf("ucum: OBX-5 is empty")
+		}
+		value = value.Elem()
+	}
+	var err error
+	switch valueType := fieldString(rv, 2); valueType {
+	default:
+		return q, fmt.Errorf("ucum: OBX-2 value type %q is not NM or SN", valueType)
+	case "NM":
+		if value.Kind() != reflect.String {
+			return q, fmt.Errorf("ucum: OBX-5 %s is not a NM", value.Type())
+		}
+		if q.Value, err = hl7.ParseNumber(strings.TrimSpace(value.String())); err != nil {
+			return q, fmt.Errorf("ucum: OBX-5: %w", err)
+		}
+	case "SN":
+		sn, err := hl7.StructuredNumberOf(value.Interface())
+		if err != nil {
+			return q, fmt.Errorf("ucum: OBX-5: %w", err)
+		}
+		if len(sn.Separator) > 0 || !sn.Num2.Empty() {
+			return q, fmt.Errorf("ucum: OBX-5 %q is not a single number", sn)
+		}
+		q.Comparator, q.Value = sn.Comparator, sn.Num1
+	}
+	if q.Unit, err = s.units(field(rv, 6)); err != nil {
+		return q, fmt.Errorf("ucum: OBX-6: %w", err)
+	}
+	return q, nil
+}
+
+// ConvertQuantity returns the quantity in other units.
+func (s *System) ConvertQuantity(q Quantity, to Unit) (Quantity, error) {
+	v, err := s.Convert(q.Value.Float64(), q.Unit, to)
+	if err != nil {
+		return q, err
+	}
+	n, err := hl7.ParseNumber(strconv.FormatFloat(v, 'f', -1, 64))
+	if err != nil {
+		return q, err
+	}
+	return Quantity{Comparator: q.Comparator, Value: n, Unit: to}, nil
+}
+
+// units parses the units of a ST, or of a CE or CWE from the identifier, the alternate identifier, or the text.
+func (s *System) units(rv reflect.Value) (Unit, error) {
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return Unit{}, nil
+		}
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.String:
+		if len(rv.String()) == 0 {
+			return Unit{}, nil
+		}
+		return s.Parse(rv.String(), "")
+	case reflect.Struct:
+	default:
+		return Unit{}, nil
+	}
+	var firstErr error
+	for _, c := range [][2]int{{1, 3}, {4, 6}, {2, 0}} {
+		code := fieldString(rv, c[0])
+		if len(code) == 0 {
+			continue
+		}
+		u, err := s.Parse(code, fieldString(rv, c[1]))
+		if err == nil {
+			return u, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return Unit{}, firstErr
+}
+
+// typeName returns the name of a data type or segment struct, such as "CQ".
+func typeName(rv reflect.Value) string {
+	if rv.Kind() != reflect.Struct || rv.NumField() == 0 {
+		return ""
+	}
+	for _, part := range strings.Split(rv.Type().Field(0).Tag.Get("hl7"), ",") {
+		if name, ok := strings.CutPrefix(part, "name="); ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// field returns a field or component of a struct by number, or an invalid value.
+func field(rv reflect.Value, order int) reflect.Value {
+	if order <= 0 || rv.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		n, _, _ := strings.Cut(rt.Field(i).Tag.Get("hl7"), ",")
+		if n == strconv.Itoa(order) {
+			return rv.Field(i)
+		}
+	}
+	return reflect.Value{}
+}
+
+// fieldString returns the string of a field or component, or the first component of a data type.
+func fieldString(rv reflect.Value, order int) string {
+	f := field(rv, order)
+	for f.Kind() == reflect.Pointer {
+		if f.IsNil() {
+			return ""
+		}
+		f = f.Elem()
+	}
+	switch f.Kind() {
+	case reflect.String:
+		return f.String()
+	case reflect.Struct:
+		return fieldString(f, 1)
+	}
+	return ""
+}
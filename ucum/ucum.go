@@ -0,0 +1,361 @@
+// Package ucum parses and converts units of measure, such as the units of
+// OBX-6 or of a CQ, with the Unified Code for Units of Measure.
+//
+// Units are parsed with the UCUM grammar, for example "mg/dL", "10*9/L",
+// "mL/min/{1.73_m2}" or "kg.m/s2", from an embedded subset of the UCUM essence
+// table with the units common in laboratory results and orders. Parse reads
+// the case sensitive form and ParseCaseInsensitive the case insensitive form,
+// such as "MG/DL", which is also close to the ISO+ units.
+//
+// Units of the same dimension convert directly, such as "mg/dL" to "g/L" or
+// "Cel" to "[degF]". Units of other dimensions, such as "mg/dL" to "mmol/L",
+// convert through a Converter of a System, such as a MolarMass. Units sent in
+// other coding systems are mapped to UCUM with the aliases of a System.
+package ucum
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Dimension is the exponents of the base units: meter, second, gram, radian, kelvin, coulomb and candela.
+type Dimension [7]int8
+
+var baseUnits = [7]string{"m", "s", "g", "rad", "K", "C", "cd"}
+
+// String returns the dimension in base units, such as "g.m-3", or "1" if dimensionless.
+func (d Dimension) String() string {
+	var list []string
+	for i, exp := range d {
+		switch exp {
+		case 0:
+		case 1:
+			list = append(list, baseUnits[i])
+		default:
+			list = append(list, baseUnits[i]+strconv.Itoa(int(exp)))
+		}
+	}
+	if len(list) == 0 {
+		return "1"
+	}
+	return strings.Join(list, ".")
+}
+
+func (d Dimension) add(o Dimension, sign int8) Dimension {
+	for i := range d {
+		d[i] += sign * o[i]
+	}
+	return d
+}
+
+// Unit is a parsed unit of measure. The zero Unit has no units.
+type Unit struct {
+	Code string // Code as parsed, such as "mg/dL".
+
+	factor    *big.Rat       // Magnitude in base units.
+	dim       Dimension      // Dimension of the base units.
+	arbitrary map[string]int // Exponents of arbitrary units, such as "[iU]", which only convert to themselves.
+	special   *special       // Special unit, such as "Cel", which is the whole unit.
+}
+
+// special is a unit with a conversion other than a factor, such as "Cel".
+// A value of the unit is value*scale + offset in base units.
+type special struct {
+	code   string
+	scale  *big.Rat
+	offset *big.Rat
+}
+
+// IsZero reports if the unit is the zero Unit, without units.
+func (u Unit) IsZero() bool {
+	return u.factor == nil
+}
+
+// String returns the code of the unit.
+func (u Unit) String() string {
+	return u.Code
+}
+
+// Dimension returns the dimension of the unit. Dimensionless units, such as "%" or "10*9", have a zero dimension.
+func (u Unit) Dimension() Dimension {
+	return u.dim
+}
+
+// Special reports if the unit converts with a function other than a factor, such as "Cel" and "[degF]".
+func (u Unit) Special() bool {
+	return u.special != nil
+}
+
+// Compatible reports if values convert between the units with Convert.
+func (u Unit) Compatible(o Unit) bool {
+	if u.IsZero() || o.IsZero() || u.dim != o.dim || len(u.arbitrary) != len(o.arbitrary) {
+		return false
+	}
+	for k, v := range u.arbitrary {
+		if o.arbitrary[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// Convert returns a value of the unit in the other unit. The units must be Compatible.
+func (u Unit) Convert(value float64, to Unit) (float64, error) {
+	if !u.Compatible(to) {
+		return 0, fmt.Errorf("ucum: cannot convert %q to %q", u.Code, to.Code)
+	}
+	v := new(big.Rat).SetFloat64(value)
+	if v == nil {
+		return 0, fmt.Errorf("ucum: invalid value %v", value)
+	}
+	v = u.toBase(v)
+	f, _ := to.fromBase(v).Float64()
+	return f, nil
+}
+
+// toBase returns a value of the unit in base units.
+func (u Unit) toBase(v *big.Rat) *big.Rat {
+	if u.special != nil {
+		v = v.Mul(v, u.special.scale)
+		return v.Add(v, u.special.offset)
+	}
+	return v.Mul(v, u.factor)
+}
+
+// fromBase returns a value in base units in the unit.
+func (u Unit) fromBase(v *big.Rat) *big.Rat {
+	if u.special != nil {
+		v = v.Sub(v, u.special.offset)
+		return v.Quo(v, u.special.scale)
+	}
+	return v.Quo(v, u.factor)
+}
+
+// unity is the unit "1".
+func unity() Unit {
+	return Unit{factor: big.NewRat(1, 1)}
+}
+
+// multiply returns u times o raised to exp.
+func multiply(u, o Unit, exp int) (Unit, error) {
+	if u.special != nil || o.special != nil {
+		return u, fmt.Errorf("special unit cannot be combined with other units")
+	}
+	r := Unit{factor: new(big.Rat).Mul(u.factor, power(o.factor, exp)), dim: u.dim}
+	for i := range r.dim {
+		r.dim[i] += int8(exp) * o.dim[i]
+	}
+	for k, v := range u.arbitrary {
+		r.addArbitrary(k, v)
+	}
+	for k, v := range o.arbitrary {
+		r.addArbitrary(k, v*exp)
+	}
+	return r, nil
+}
+
+func (u *Unit) addArbitrary(code string, exp int) {
+	if u.arbitrary == nil {
+		u.arbitrary = map[string]int{}
+	}
+	u.arbitrary[code] += exp
+	if u.arbitrary[code] == 0 {
+		delete(u.arbitrary, code)
+	}
+}
+
+// power returns r raised to exp.
+func power(r *big.Rat, exp int) *big.Rat {
+	v := big.NewRat(1, 1)
+	b := r
+	if exp < 0 {
+		b = new(big.Rat).Inv(r)
+		exp = -exp
+	}
+	for i := 0; i < exp; i++ {
+		v.Mul(v, b)
+	}
+	return v
+}
+
+// Parse parses a case sensitive UCUM code, such as "mg/dL" or "10*3/uL".
+func Parse(code string) (Unit, error) {
+	return parse(code, false)
+}
+
+// ParseCaseInsensitive parses a case insensitive UCUM code, such as "MG/DL" or "mmol/l".
+func ParseCaseInsensitive(code string) (Unit, error) {
+	return parse(code, true)
+}
+
+func parse(code string, ci bool) (Unit, error) {
+	t, err := essenceTable()
+	if err != nil {
+		return Unit{}, err
+	}
+	p := &parser{s: code, t: t, ci: ci}
+	u, err := p.main()
+	if err == nil && p.i < len(p.s) {
+		err = fmt.Errorf("unexpected %q", p.s[p.i:])
+	}
+	if err != nil {
+		return Unit{}, fmt.Errorf("ucum: invalid unit %q: %w", code, err)
+	}
+	u.Code = code
+	return u, nil
+}
+
+// parser of the UCUM grammar:
+//
+//	main      = "/" term | term
+//	term      = component | term "." component | term "/" component
+//	component = simple exponent? annotation? | annotation | factor annotation? | "(" term ")"
+type parser struct {
+	s  string
+	i  int
+	t  *table
+	ci bool
+}
+
+func (p *parser) peek() byte {
+	if p.i < len(p.s) {
+		return p.s[p.i]
+	}
+	return 0
+}
+
+func (p *parser) main() (Unit, error) {
+	if len(p.s) == 0 {
+		return Unit{}, fmt.Errorf("empty")
+	}
+	if p.peek() == '/' {
+		p.i++
+		u, err := p.term()
+		if err != nil {
+			return u, err
+		}
+		return multiply(unity(), u, -1)
+	}
+	return p.term()
+}
+
+func (p *parser) term() (Unit, error) {
+	u, err := p.component()
+	if err != nil {
+		return u, err
+	}
+	for {
+		exp := 1
+		switch p.peek() {
+		default:
+			return u, nil
+		case '.':
+		case '/':
+			exp = -1
+		}
+		p.i++
+		o, err := p.component()
+		if err != nil {
+			return u, err
+		}
+		if u, err = multiply(u, o, exp); err != nil {
+			return u, err
+		}
+	}
+}
+
+func (p *parser) component() (Unit, error) {
+	switch c := p.peek(); {
+	case c == '(':
+		p.i++
+		u, err := p.term()
+		if err != nil {
+			return u, err
+		}
+		if p.peek() != ')' {
+			return u, fmt.Errorf("missing ')'")
+		}
+		p.i++
+		return u, p.annotation()
+	case c == '{':
+		return unity(), p.annotation()
+	case c >= '0' && c <= '9' && !strings.HasPrefix(p.s[p.i:], "10*") && !strings.HasPrefix(p.s[p.i:], "10^"):
+		start := p.i
+		for c := p.peek(); c >= '0' && c <= '9'; c = p.peek() {
+			p.i++
+		}
+		n, _ := new(big.Rat).SetString(p.s[start:p.i])
+		return Unit{factor: n}, p.annotation()
+	}
+	return p.simple()
+}
+
+// simple parses a unit with an optional prefix and exponent, such as "m", "kg" or "cm2".
+func (p *parser) simple() (Unit, error) {
+	start := p.i
+	if strings.HasPrefix(p.s[p.i:], "10*") || strings.HasPrefix(p.s[p.i:], "10^") {
+		p.i += 3
+	}
+scan:
+	for p.i < len(p.s) {
+		switch c := p.s[p.i]; {
+		case c == '[':
+			end := strings.IndexByte(p.s[p.i:], ']')
+			if end < 0 {
+				return Unit{}, fmt.Errorf("missing ']'")
+			}
+			p.i += end + 1
+		case c == '.' || c == '/' || c == '(' || c == ')' || c == '{' || c == '}':
+			break scan
+		case c >= '0' && c <= '9', (c == '+' || c == '-') && p.i+1 < len(p.s) && p.s[p.i+1] >= '0' && p.s[p.i+1] <= '9':
+			break scan
+		case c <= ' ' || c > '~':
+			return Unit{}, fmt.Errorf("invalid character %q", c)
+		default:
+			p.i++
+		}
+	}
+	symbol := p.s[start:p.i]
+	if len(symbol) == 0 {
+		return Unit{}, fmt.Errorf("missing unit at %q", p.s[start:])
+	}
+	u, err := p.t.lookup(symbol, p.ci)
+	if err != nil {
+		return u, err
+	}
+	expStart := p.i
+	if c := p.peek(); c == '+' || c == '-' {
+		p.i++
+	}
+	for c := p.peek(); c >= '0' && c <= '9'; c = p.peek() {
+		p.i++
+	}
+	if p.i > expStart {
+		exp, err := strconv.Atoi(p.s[expStart:p.i])
+		if err != nil {
+			return u, fmt.Errorf("invalid exponent %q", p.s[expStart:p.i])
+		}
+		if u, err = multiply(unity(), u, exp); err != nil {
+			return u, err
+		}
+	}
+	return u, p.annotation()
+}
+
+// annotation skips an optional annotation, such as "{cells}".
+func (p *parser) annotation() error {
+	if p.peek() != '{' {
+		return nil
+	}
+	end := strings.IndexByte(p.s[p.i:], '}')
+	if end < 0 {
+		return fmt.Errorf("missing '}'")
+	}
+	if strings.ContainsAny(p.s[p.i+1:p.i+end], "{") {
+		return fmt.Errorf("nested annotation")
+	}
+	p.i += end + 1
+	return nil
+}
@@ -0,0 +1,180 @@
+package ucum
+
+import (
+	"math"
+	"testing"
+
+	"github.com/kardianos/hl7"
+	"github.com/kardianos/hl7/h251"
+)
+
+func TestParse(t *testing.T) {
+	list := []struct {
+		Code string
+		Dim  string
+		Err  bool
+	}{
+		{Code: "mg/dL", Dim: "m-3.g"},
+		{Code: "10*9/L", Dim: "m-3"},
+		{Code: "mL/min/{1.73_m2}", Dim: "m3.s-1"},
+		{Code: "kg.m/s2", Dim: "m.s-2.g"},
+		{Code: "mm[Hg]", Dim: "m-1.s-2.g"},
+		{Code: "%", Dim: "1"},
+		{Code: "{cells}/uL", Dim: "m-3"},
+		{Code: "/[HPF]", Dim: "1"},
+		{Code: "Cel", Dim: "K"},
+		{Code: "mmol/L", Dim: "m-3"},
+		{Code: "MG/DL", Err: true},
+		{Code: "mg/", Err: true},
+		{Code: "Cel/h", Err: true},
+		{Code: "mCel", Err: true},
+		{Code: "(m", Err: true},
+		{Code: "xyz", Err: true},
+	}
+	for _, item := range list {
+		t.Run(item.Code, func(t *testing.T) {
+			u, err := Parse(item.Code)
+			if item.Err {
+				if err == nil {
+					t.Fatalf("expected error, got %s", u.Dimension())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := u.Dimension().String(); got != item.Dim {
+				t.Errorf("dimension got %s, want %s", got, item.Dim)
+			}
+		})
+	}
+}
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) <= 1e-9*math.Max(1, math.Abs(b))
+}
+
+func TestConvert(t *testing.T) {
+	list := []struct {
+		Value    float64
+		From, To string
+		Want     float64
+		Err      bool
+	}{
+		{Value: 196, From: "mg/dL", To: "g/L", Want: 1.96},
+		{Value: 5, From: "10*3/uL", To: "10*9/L", Want: 5},
+		{Value: 37, From: "Cel", To: "[degF]", Want: 98.6},
+		{Value: 37, From: "Cel", To: "K", Want: 310.15},
+		{Value: 120, From: "mm[Hg]", To: "kPa", Want: 15.998640},
+		{Value: 12, From: "[in_i]", To: "[ft_i]", Want: 1},
+		{Value: 1, From: "h", To: "min", Want: 60},
+		{Value: 1, From: "[IU]/L", To: "[iU]/mL", Want: 0.001},
+		{Value: 1, From: "[iU]", To: "mg", Err: true},
+		{Value: 1, From: "mg/dL", To: "mmol/L", Err: true},
+	}
+	for _, item := range list {
+		t.Run(item.From+" "+item.To, func(t *testing.T) {
+			got, err := Convert(item.Value, item.From, item.To)
+			if item.Err {
+				if err == nil {
+					t.Fatalf("expected error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !near(got, item.Want) {
+				t.Errorf("got %v, want %v", got, item.Want)
+			}
+		})
+	}
+}
+
+func TestSystem(t *testing.T) {
+	s := &System{
+		Aliases:    map[string]map[string]string{"99LAB": {"MG_DL": "mg/dL"}},
+		Converters: []Converter{MolarMass(180.156)}, // Glucose.
+	}
+	from, err := s.Parse("mg_dl", "99LAB")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if from.Code != "mg/dL" {
+		t.Errorf("alias got %q", from.Code)
+	}
+	to, err := s.Parse("MMOL/L", "ISO+")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.Convert(90, from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !near(got, 90*10/180.156) {
+		t.Errorf("got %v", got)
+	}
+	back, err := s.Convert(got, to, from)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !near(back, 90) {
+		t.Errorf("back got %v", back)
+	}
+	if u, err := s.Parse("IU/L", "ISO+"); err != nil || u.Code != "[IU]/L" {
+		t.Errorf("ISO+ got %q, %v", u.Code, err)
+	}
+	if _, err := (&System{}).Convert(90, from, to); err == nil {
+		t.Error("expected error without a converter")
+	}
+}
+
+func TestObservationQuantity(t *testing.T) {
+	const raw = "MSH|^~\\&|LAB|GHH|EHR|GHH|20250609081500||ORU^R01^ORU_R01|1|P|2.5.1\r" +
+		"OBX|1|NM|2345-7^Glucose^LN||90|mg/dL^mg/dL^UCUM|70-99|N|||F\r" +
+		"OBX|2|SN|2345-7^Glucose^LN||<^40|MG/DL^^ISO+\r" +
+		"OBX|3|ST|2345-7^Glucose^LN||high|mg/dL^^UCUM\r"
+	list, err := hl7.NewDecoder(h251.Registry, nil).DecodeList([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &System{Converters: []Converter{MolarMass(180.156)}}
+	q, err := s.ObservationQuantity(list[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.String() != "90 mg/dL" {
+		t.Errorf("got %s", q)
+	}
+	mmol, err := Parse("mmol/L")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := s.ConvertQuantity(q, mmol)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !near(c.Value.Float64(), 90*10/180.156) || c.Unit.Code != "mmol/L" {
+		t.Errorf("got %s", c)
+	}
+
+	q, err = s.ObservationQuantity(list[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.String() != "<40 MG/DL" {
+		t.Errorf("got %s", q)
+	}
+	if _, err := s.ObservationQuantity(list[3]); err == nil {
+		t.Error("expected error for a ST value")
+	}
+
+	cq := &h251.CQ{Quantity: "2", Units: &h251.CE{Identifier: "mL", NameOfCodingSystem: "UCUM"}}
+	q, err = s.QuantityOf(cq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.String() != "2 mL" {
+		t.Errorf("got %s", q)
+	}
+}
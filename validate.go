@@ -0,0 +1,85 @@
+package hl7
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ValidateOption are the options of Validate.
+type ValidateOption struct {
+	// Tables of valid values by table, such as h251.TableValueLookup.
+	// Values of a table without values, such as a user-defined table, and of
+	// tables other than HL7 tables, such as "FirstName", are not checked.
+	Tables map[string]map[string]bool
+}
+
+// ValidationError is a value of a message that fails a check of Validate.
+type ValidationError struct {
+	Path   string // Path of the value, as in Values, such as "PID-5[1].1" or "OBX[2]-3.1".
+	Rule   string // Check that failed: "required", "len" or "table".
+	Detail string
+}
+
+func (e ValidationError) Error() string {
+	return e.Path + ": " + e.Detail
+}
+
+// Validate checks the segments of a trigger, or a single segment, for missing required fields,
+// values longer than the length of the field or component, and values not in the table of the
+// field or component. Options may be nil.
+func Validate(message any, opt *ValidateOption) []ValidationError {
+	v := &validator{}
+	if opt != nil {
+		v.opt = *opt
+	}
+	walkSegments(message, func(path string, rv reflect.Value) {
+		walkSegment(path, rv, v.field, v.value)
+	})
+	return v.list
+}
+
+type validator struct {
+	opt  ValidateOption
+	list []ValidationError
+}
+
+func (v *validator) add(path, rule, format string, args ...any) {
+	v.list = append(v.list, ValidationError{Path: path, Rule: rule, Detail: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) field(path string, t tag, rv reflect.Value) {
+	if t.Required && rv.IsZero() {
+		v.add(path, "required", "%s is required", t.Display)
+	}
+}
+
+func (v *validator) value(path string, t tag, rv reflect.Value) {
+	var s string
+	switch rv.Kind() {
+	default:
+		return
+	case reflect.String:
+		s = rv.String()
+		if values := v.opt.Tables[t.Table]; len(values) > 0 && !values[s] && hl7Table(t.Table) {
+			defer v.add(path, "table", "value %q is not in table %s", s, t.Table)
+		}
+	case reflect.Struct:
+		if rv.Type() != numberType {
+			return
+		}
+		s = rv.Interface().(Number).String()
+	}
+	if t.Len > 0 && len(s) > int(t.Len) {
+		v.add(path, "len", "length %d is longer than %d", len(s), t.Len)
+	}
+}
+
+// hl7Table reports if the table is a numbered HL7 table, such as "0001".
+func hl7Table(table string) bool {
+	for i := 0; i < len(table); i++ {
+		if table[i] < '0' || table[i] > '9' {
+			return false
+		}
+	}
+	return len(table) > 0
+}
@@ -0,0 +1,35 @@
+package hl7
+
+import (
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestValidate(t *testing.T) {
+	raw := "MSH|^~\\&|LAB|ORG|SYS||20250609071616||ORU^R01^ORU_R01|1|P|2.5.1\r" +
+		"PID|1||123||Smith^John||19700101|Q\r" +
+		"OBR|1|ABC\r" +
+		"OBX|1|NM|GLU||182||||||F\r" +
+		"OBX|2|NUMBER|NA\r"
+	g, err := NewDecoder(v251.Registry, nil).Decode([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	list := Validate(g, &ValidateOption{Tables: v251.TableValueLookup})
+	want := []ValidationError{
+		{Path: "PID-8", Rule: "table", Detail: `value "Q" is not in table 0001`},
+		{Path: "OBR-4", Rule: "required", Detail: "Universal Service Identifier is required"},
+		{Path: "OBX[2]-2", Rule: "len", Detail: "length 6 is longer than 2"},
+		{Path: "OBX[2]-2", Rule: "table", Detail: `value "NUMBER" is not in table 0125`},
+		{Path: "OBX[2]-11", Rule: "required", Detail: "Observation Result Status is required"},
+	}
+	if len(list) != len(want) {
+		t.Fatalf("got %d errors, want %d: %v", len(list), len(want), list)
+	}
+	for i, w := range want {
+		if list[i] != w {
+			t.Errorf("%d: got %+v, want %+v", i, list[i], w)
+		}
+	}
+}
@@ -0,0 +1,281 @@
+package hl7
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// VariesResolver returns the name of the data type of a repetition of a VARIES field,
+// such as QPD-3 of a site-specific query. The segment is a pointer, such as *h251.QPD,
+// with the fields before the VARIES field decoded. Return false to leave the
+// data type to the segment, such as OBX-2 for OBX-5.
+type VariesResolver func(segment any, field int32, rep int) (dataType string, ok bool)
+
+// VariesRegistry may be implemented by a Registry to resolve the data type of VARIES fields
+// before the segment. Use WithVaries to add resolvers to a registry.
+type VariesRegistry interface {
+	Varies(segment any, field int32, rep int) (dataType string, ok bool)
+}
+
+// WithVaries returns the registry with resolvers of VARIES fields, which are tried in order.
+// The returned registry may be used with the Decoder, JSONDecoder and XMLDecoder.
+func WithVaries(registry Registry, resolvers ...VariesResolver) Registry {
+	return variesRegistry{Registry: registry, resolvers: resolvers}
+}
+
+type variesRegistry struct {
+	Registry
+	resolvers []VariesResolver
+}
+
+func (r variesRegistry) Varies(segment any, field int32, rep int) (string, bool) {
+	for _, resolve := range r.resolvers {
+		if name, ok := resolve(segment, field, rep); ok {
+			return name, true
+		}
+	}
+	if vr, ok := r.Registry.(VariesRegistry); ok {
+		return vr.Varies(segment, field, rep)
+	}
+	return "", false
+}
+
+func (r variesRegistry) EventStructure(event string) (string, bool) {
+	if er, ok := r.Registry.(EventRegistry); ok {
+		return er.EventStructure(event)
+	}
+	return "", false
+}
+
+// QueryParameters returns a resolver of the user parameters of site-specific queries,
+// by the message query name in QPD-1, such as {"Z01": {"CX", "XPN"}} for the data types
+// of QPD-3 and QPD-4. The generated QPD segments declare the user parameters as the VARIES fields
+// QPD-3 through QPD-22, such as UserParametersInSuccessiveFields2 of h251.QPD for QPD-4.
+func QueryParameters(types map[string][]string) VariesResolver {
+	return func(segment any, field int32, rep int) (string, bool) {
+		rv := reflect.ValueOf(segment)
+		if rv.Kind() == reflect.Pointer {
+			rv = rv.Elem()
+		}
+		if rv.Kind() != reflect.Struct {
+			return "", false
+		}
+		meta, err := typeMeta(rv.Type())
+		if err != nil || meta.Name != "QPD" {
+			return "", false
+		}
+		list := types[strings.TrimSpace(componentString(rv, 1))]
+		if i := int(field) - 3; i >= 0 && i < len(list) {
+			return list[i], true
+		}
+		return "", false
+	}
+}
+
+// segmentVaries returns the function to create the value of a VARIES field of a segment,
+// from the registry, the FieldVaries or Varies of the segment, or nil if there is none.
+func segmentVaries(rv reflect.Value, registry Registry) variesFunc {
+	rt := rv.Type()
+	vr, hasRegistry := registry.(VariesRegistry)
+	hasField, hasVaries := rt.Implements(fieldVariesType), rt.Implements(variesType)
+	if !hasRegistry && !hasField && !hasVaries {
+		return nil
+	}
+	return func(field int32, rep int) (reflect.Value, error) {
+		if hasRegistry {
+			seg := rv.Interface()
+			if rv.CanAddr() {
+				seg = rv.Addr().Interface()
+			}
+			if name, ok := vr.Varies(seg, field, rep); ok {
+				vt, ok := registry.DataType(name)
+				if !ok {
+					return reflect.Value{}, fmt.Errorf("unknown data type %q", name)
+				}
+				return reflect.New(reflect.TypeOf(vt)).Elem(), nil
+			}
+		}
+		switch {
+		case hasField:
+			return rv.Interface().(FieldVaries).FieldVaries(field, rep, registry.DataType)
+		case hasVaries:
+			return rv.Interface().(Varies).ChildVaries(registry.DataType)
+		}
+		return reflect.Value{}, fmt.Errorf("no data type for VARIES field %d", field)
+	}
+}
+
+// at returns the function for a repetition of the field, or nil.
+func (f variesFunc) at(rep int) variesFunc {
+	if f == nil {
+		return nil
+	}
+	return func(field int32, _ int) (reflect.Value, error) {
+		return f(field, rep)
+	}
+}
+
+// variesSelectors are the fields which hold the data type of a VARIES field, by segment and VARIES field.
+var variesSelectors = map[string]map[int32]int32{
+	"OBX": {5: 2},
+	"MFE": {4: 5},
+	"MFA": {5: 6},
+}
+
+// VariesSelector returns the field of a segment which holds the data type of a VARIES field,
+// such as 2 for OBX-5. Other VARIES fields, such as QPD-3, are resolved with a VariesRegistry.
+func VariesSelector(segment string, field int32) (int32, bool) {
+	so, ok := variesSelectors[segment][field]
+	return so, ok
+}
+
+// variesSelector checks the values of a VARIES field against the data type field, and returns
+// the data type field with empty data types set from the values. The data type field
+// is either a single data type for all repetitions, such as OBX-2, or one for each repetition, such as MFE-5.
+func (e *Encoder) variesSelector(selector, value any) (any, error) {
+	values := variesValues(reflect.ValueOf(value))
+	switch sel := selector.(type) {
+	case string:
+		name := sel
+		for i, v := range values {
+			if !v.IsValid() {
+				continue
+			}
+			if len(sel) > 0 {
+				if err := e.checkVaries(sel, v); err != nil {
+					return selector, fmt.Errorf("repetition %d: %w", i+1, err)
+				}
+				continue
+			}
+			n, err := e.variesName(v)
+			if err != nil {
+				return selector, fmt.Errorf("repetition %d: %w", i+1, err)
+			}
+			switch {
+			case len(name) == 0:
+				name = n
+			case name != n:
+				return selector, fmt.Errorf("repetition %d: data type %s differs from %s of the other repetitions", i+1, n, name)
+			}
+		}
+		return name, nil
+	case []string:
+		list := sel
+		for i, v := range values {
+			if !v.IsValid() {
+				continue
+			}
+			if i < len(sel) && len(sel[i]) > 0 {
+				if err := e.checkVaries(sel[i], v); err != nil {
+					return selector, fmt.Errorf("repetition %d: %w", i+1, err)
+				}
+				continue
+			}
+			n, err := e.variesName(v)
+			if err != nil {
+				return selector, fmt.Errorf("repetition %d: %w", i+1, err)
+			}
+			if len(list) == len(sel) {
+				// Copy before the first change, the value belongs to the caller.
+				list = append(make([]string, 0, len(values)), sel...)
+			}
+			for len(list) <= i {
+				list = append(list, "")
+			}
+			list[i] = n
+		}
+		return list, nil
+	}
+	return selector, nil
+}
+
+// variesValues returns the concrete values of each repetition of a VARIES field,
+// with an invalid value for an empty repetition.
+func variesValues(rv reflect.Value) []reflect.Value {
+	if !rv.IsValid() {
+		return nil
+	}
+	if rv.Kind() != reflect.Slice || rv.Type().Elem().Kind() != reflect.Interface {
+		return []reflect.Value{variesValue(rv)}
+	}
+	list := make([]reflect.Value, rv.Len())
+	for i := range list {
+		list[i] = variesValue(rv.Index(i))
+	}
+	return list
+}
+
+func variesValue(rv reflect.Value) reflect.Value {
+	for rv.Kind() == reflect.Interface || rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return reflect.Value{}
+		}
+		rv = rv.Elem()
+	}
+	if rv.IsZero() {
+		return reflect.Value{}
+	}
+	return rv
+}
+
+// variesName returns the data type of a VARIES value.
+func (e *Encoder) variesName(v reflect.Value) (string, error) {
+	switch v.Interface().(type) {
+	case Number:
+		return "NM", nil
+	case string:
+		return "ST", nil
+	case time.Time:
+		if e.opt.Registry != nil {
+			if _, ok := e.opt.Registry.DataType("TS"); !ok {
+				return "DTM", nil
+			}
+		}
+		return "TS", nil
+	}
+	meta, err := typeMeta(v.Type())
+	if err != nil {
+		return "", err
+	}
+	if !meta.Present || meta.Type != structDataType {
+		return "", fmt.Errorf("no data type for %s", v.Type())
+	}
+	if e.opt.Registry != nil {
+		if _, ok := e.opt.Registry.DataType(meta.Name); !ok {
+			return "", fmt.Errorf("unknown data type %q", meta.Name)
+		}
+	}
+	return meta.Name, nil
+}
+
+// checkVaries returns an error if a VARIES value is not of the data type.
+func (e *Encoder) checkVaries(name string, v reflect.Value) error {
+	var ok bool
+	switch v.Interface().(type) {
+	case Number:
+		ok = name == "NM"
+	case string, time.Time:
+		if e.opt.Registry == nil {
+			_, isTime := v.Interface().(time.Time)
+			ok = !isTime || name == "TS" || name == "DTM" || name == "DT" || name == "TM"
+			break
+		}
+		vt, found := e.opt.Registry.DataType(name)
+		if !found {
+			return fmt.Errorf("unknown data type %q", name)
+		}
+		ok = reflect.TypeOf(vt) == v.Type()
+	default:
+		meta, err := typeMeta(v.Type())
+		if err != nil {
+			return err
+		}
+		ok = meta.Present && meta.Name == name
+	}
+	if !ok {
+		return fmt.Errorf("%s value is not of data type %s", v.Type(), name)
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package hl7
+
+import (
+	"strings"
+	"testing"
+
+	v231 "github.com/kardianos/hl7/h231"
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestFieldVaries(t *testing.T) {
+	raw := "MSH|^~\\&|A|B|C|D|20240101||MFN^M01^MFN_M01|1|P|2.5.1\r" +
+		"MFE|MAD|1|20240101|2345-7^Glucose^LN~Z1|CE~ST\r"
+	d := NewDecoder(v251.Registry, nil)
+	list, err := d.DecodeList([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mfe, ok := list[1].(*v251.MFE)
+	if !ok {
+		t.Fatalf("got %T: %v", list[1], list[1])
+	}
+	check := func(t *testing.T, mfe *v251.MFE) {
+		t.Helper()
+		if len(mfe.PrimaryKeyValue) != 2 {
+			t.Fatalf("got %d values", len(mfe.PrimaryKeyValue))
+		}
+		if ce, ok := mfe.PrimaryKeyValue[0].(v251.CE); !ok || ce.Identifier != "2345-7" {
+			t.Errorf("MFE-4[1] got %#v", mfe.PrimaryKeyValue[0])
+		}
+		if st, ok := mfe.PrimaryKeyValue[1].(string); !ok || st != "Z1" {
+			t.Errorf("MFE-4[2] got %#v", mfe.PrimaryKeyValue[1])
+		}
+	}
+	check(t, mfe)
+
+	t.Run("json", func(t *testing.T) {
+		data, err := NewJSONEncoder(nil).Encode(mfe)
+		if err != nil {
+			t.Fatal(err)
+		}
+		seg, err := NewJSONDecoder(v251.Registry, nil).DecodeSegment("MFE", data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		check(t, seg.(*v251.MFE))
+	})
+	t.Run("xml", func(t *testing.T) {
+		data, err := NewXMLEncoder(nil).Encode(mfe)
+		if err != nil {
+			t.Fatal(err)
+		}
+		seg, err := NewXMLDecoder(v251.Registry).Decode(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		check(t, seg.(*v251.MFE))
+	})
+}
+
+func TestQueryParameters(t *testing.T) {
+	raw := "MSH|^~\\&|A|B|C|D|20240101||QBP^Z01^QBP_Q11|1|P|2.5.1\r" +
+		"QPD|Z01^Site Query^99SITE|T1|12345^^^HOSP^MR|Smith^John\r"
+
+	d := NewDecoder(v251.Registry, nil)
+	list, err := d.DecodeList([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if se, ok := list[1].(SegmentError); !ok || !strings.Contains(se.Error(), "unsupported interface") {
+		t.Errorf("without a resolver got %v", list[1])
+	}
+
+	reg := WithVaries(v251.Registry, QueryParameters(map[string][]string{"Z01": {"CX", "XPN"}}))
+	d = NewDecoder(reg, nil)
+	list, err = d.DecodeList([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	qpd, ok := list[1].(*v251.QPD)
+	if !ok {
+		t.Fatalf("got %T: %v", list[1], list[1])
+	}
+	if qpd.UserParametersInSuccessiveFields == nil {
+		t.Fatal("QPD-3 is empty")
+	}
+	cx, ok := (*qpd.UserParametersInSuccessiveFields).(v251.CX)
+	if !ok || cx.IDNumber != "12345" || cx.IdentifierTypeCode != "MR" {
+		t.Errorf("QPD-3 got %#v", *qpd.UserParametersInSuccessiveFields)
+	}
+	if qpd.UserParametersInSuccessiveFields2 == nil {
+		t.Fatal("QPD-4 is empty")
+	}
+	xpn, ok := (*qpd.UserParametersInSuccessiveFields2).(v251.XPN)
+	if !ok || xpn.FamilyName != "Smith" || xpn.GivenName != "John" {
+		t.Errorf("QPD-4 got %#v", *qpd.UserParametersInSuccessiveFields2)
+	}
+
+	got, err := NewEncoder(&EncodeOption{TrimTrailingSeparator: true}).Encode(qpd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g, w := string(got), strings.TrimSpace(raw[strings.IndexByte(raw, '\r')+1:]); g != w {
+		t.Errorf("encode got %q, want %q", g, w)
+	}
+}
+
+func TestEncodeVaries(t *testing.T) {
+	list := []struct {
+		name string
+		seg  any
+		opt  *EncodeOption
+		want string
+		err  string
+	}{
+		{
+			name: "infer",
+			seg: &v251.OBX{
+				ObservationIdentifier: v251.CE{Identifier: "2345-7"},
+				ObservationValue:      []v251.VARIES{v251.CE{Identifier: "A"}, v251.CE{Identifier: "B"}},
+			},
+			want: "OBX|1|CE|2345-7||A~B",
+		},
+		{
+			name: "string",
+			seg:  &v251.OBX{ObservationValue: []v251.VARIES{"high"}},
+			want: "OBX|1|ST|||high",
+		},
+		{
+			name: "keep",
+			seg:  &v251.OBX{ValueType: "NM", ObservationValue: []v251.VARIES{"182"}},
+			opt:  &EncodeOption{TrimTrailingSeparator: true, Registry: v251.Registry},
+			want: "OBX|1|NM|||182",
+		},
+		{
+			name: "per repetition",
+			seg: &v251.MFE{
+				RecordLevelEventCode: "MAD",
+				PrimaryKeyValue:      []v251.VARIES{v251.CE{Identifier: "2345-7"}, "Z1"},
+				PrimaryKeyValueType:  []v251.ID{"", "ST"},
+			},
+			want: "MFE|MAD|||2345-7~Z1|CE~ST",
+		},
+		{
+			name: "fixed type",
+			seg: &v231.MFA{
+				RecordLevelEventCode: "MAD",
+				PrimaryKeyValue:      []v231.CE{{Identifier: "Z1"}},
+				PrimaryKeyValueType:  []v231.ID{"ST"},
+			},
+			want: "MFA|MAD||||Z1|ST",
+		},
+		{
+			name: "differ",
+			seg:  &v251.OBX{ObservationValue: []v251.VARIES{v251.CE{Identifier: "A"}, "B"}},
+			err:  "OBX-5: repetition 2: data type ST differs from CE",
+		},
+		{
+			name: "mismatch",
+			seg:  &v251.OBX{ValueType: "ST", ObservationValue: []v251.VARIES{v251.CE{Identifier: "A"}}},
+			err:  "OBX-5: repetition 1: h251.CE value is not of data type ST",
+		},
+		{
+			name: "mismatch registry",
+			seg:  &v251.OBX{ValueType: "CE", ObservationValue: []v251.VARIES{"A"}},
+			opt:  &EncodeOption{Registry: v251.Registry},
+			err:  "OBX-5: repetition 1: string value is not of data type CE",
+		},
+	}
+	for _, item := range list {
+		t.Run(item.name, func(t *testing.T) {
+			opt := item.opt
+			if opt == nil {
+				opt = &EncodeOption{TrimTrailingSeparator: true}
+			}
+			got, err := NewEncoder(opt).Encode(item.seg)
+			if len(item.err) > 0 {
+				if err == nil || !strings.Contains(err.Error(), item.err) {
+					t.Fatalf("got error %v, want %q", err, item.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != item.want {
+				t.Fatalf("got %q, want %q", got, item.want)
+			}
+		})
+	}
+}
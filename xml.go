@@ -0,0 +1,603 @@
+package hl7
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// XMLNamespace is the namespace of the HL7 v2 XML encoding.
+const XMLNamespace = "urn:hl7-org:v2xml"
+
+// XML encoding options.
+type XMLEncodeOption struct {
+	Indent string // Indent each nested element. No indent or new lines if empty.
+}
+
+// XMLEncoder encodes triggers and segments in the HL7 v2 XML encoding.
+//
+// Elements are named from the same struct tags the Encoder uses:
+// triggers by message structure ("ADT_A01"), trigger groups by message
+// structure and group name ("ADT_A01.PROCEDURE"), segments by name ("PR1"),
+// fields by segment and position ("PR1.3"), and components by data type
+// and position ("CNE.1").
+type XMLEncoder struct {
+	opt XMLEncodeOption
+}
+
+// Create a new XMLEncoder. Options may be nil.
+func NewXMLEncoder(opt *XMLEncodeOption) *XMLEncoder {
+	e := &XMLEncoder{}
+	if opt != nil {
+		e.opt = *opt
+	}
+	return e
+}
+
+// Encode a trigger or a segment.
+func (e *XMLEncoder) Encode(message any) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	x := xml.NewEncoder(buf)
+	x.Indent("", e.opt.Indent)
+	w := &xmlWriter{x: x}
+
+	rv := reflect.ValueOf(message)
+	for rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("unsupported message type %T", message)
+	}
+	metaTag, err := typeMeta(rv.Type())
+	if err != nil {
+		return nil, err
+	}
+	root := xml.Attr{Name: xml.Name{Local: "xmlns"}, Value: XMLNamespace}
+	switch metaTag.Type {
+	default:
+		return nil, fmt.Errorf("message type %T is not a trigger or segment", message)
+	case structTrigger:
+		w.trigger = metaTag.Name
+		err = w.group(metaTag.Name, rv, root)
+	case structSegment:
+		err = w.segment(rv, root)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = x.Flush(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type xmlWriter struct {
+	x       *xml.Encoder
+	trigger string
+}
+
+// xmlGroupName returns the element name of a trigger group, such as "PROCEDURE".
+func xmlGroupName(fieldName string, t tag) string {
+	name := t.Display
+	if len(name) == 0 {
+		name = fieldName
+	}
+	return strings.ToUpper(strings.ReplaceAll(name, " ", "_"))
+}
+
+// xmlEach calls fn for each struct in a pointer, slice, or struct value.
+func xmlEach(rv reflect.Value, fn func(rv reflect.Value) error) error {
+	switch rv.Kind() {
+	default:
+		return nil
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return nil
+		}
+		return xmlEach(rv.Elem(), fn)
+	case reflect.Slice:
+		for i := 0; i < rv.Len(); i++ {
+			if err := xmlEach(rv.Index(i), fn); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Struct:
+		return fn(rv)
+	}
+}
+
+func (w *xmlWriter) start(name string, attr ...xml.Attr) error {
+	return w.x.EncodeToken(xml.StartElement{Name: xml.Name{Local: name}, Attr: attr})
+}
+
+func (w *xmlWriter) end(name string) error {
+	return w.x.EncodeToken(xml.EndElement{Name: xml.Name{Local: name}})
+}
+
+func (w *xmlWriter) text(name, value string) error {
+	if err := w.start(name); err != nil {
+		return err
+	}
+	if err := w.x.EncodeToken(xml.CharData(value)); err != nil {
+		return err
+	}
+	return w.end(name)
+}
+
+func (w *xmlWriter) group(name string, rv reflect.Value, attr ...xml.Attr) error {
+	if err := w.start(name, attr...); err != nil {
+		return err
+	}
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		ft := rt.Field(i)
+		t, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+		if err != nil {
+			return err
+		}
+		if !t.Present || t.Meta {
+			continue
+		}
+		metaTag, err := typeMeta(ft.Type)
+		if err != nil {
+			return err
+		}
+		switch metaTag.Type {
+		case structTriggerGroup:
+			childName := w.trigger + "." + xmlGroupName(ft.Name, t)
+			err = xmlEach(rv.Field(i), func(rv reflect.Value) error {
+				return w.group(childName, rv)
+			})
+		case structSegment:
+			err = xmlEach(rv.Field(i), func(rv reflect.Value) error {
+				return w.segment(rv)
+			})
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return w.end(name)
+}
+
+func (w *xmlWriter) segment(rv reflect.Value, attr ...xml.Attr) error {
+	rt := rv.Type()
+	metaTag, err := typeMeta(rt)
+	if err != nil {
+		return err
+	}
+	name := metaTag.Name
+	if err := w.start(name, attr...); err != nil {
+		return err
+	}
+	for i := 0; i < rt.NumField(); i++ {
+		ft := rt.Field(i)
+		t, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+		if err != nil {
+			return err
+		}
+		if !t.Present || t.Meta {
+			continue
+		}
+		if t.Omit && !t.FieldSep {
+			continue
+		}
+		fieldName := name + "." + strconv.FormatInt(int64(t.Order), 10)
+		if err := w.value(fieldName, t, rv.Field(i)); err != nil {
+			return fmt.Errorf("%s.%s: %w", name, ft.Name, err)
+		}
+	}
+	return w.end(name)
+}
+
+// value writes a field or component. Repetitions are written as repeated elements.
+func (w *xmlWriter) value(name string, t tag, rv reflect.Value) error {
+	switch rv.Kind() {
+	default:
+		return fmt.Errorf("unknown value kind: %v", rv.Kind())
+	case reflect.Interface, reflect.Pointer:
+		if rv.IsNil() {
+			return nil
+		}
+		return w.value(name, t, rv.Elem())
+	case reflect.String:
+		if rv.Len() == 0 {
+			return nil
+		}
+		return w.text(name, rv.String())
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			if rv.Len() == 0 {
+				return nil
+			}
+			return w.text(name, string(rv.Bytes()))
+		}
+		for i := 0; i < rv.Len(); i++ {
+			if err := w.value(name, t, rv.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Struct:
+		if rv.IsZero() {
+			return nil
+		}
+		if rv.Type() == timeType {
+			v := formatTime(t, rv.Interface().(time.Time))
+			// The TS data type is a composite of the time and the degree of precision.
+			if t.Format != "YMDHMS" {
+				return w.text(name, v)
+			}
+			if err := w.start(name); err != nil {
+				return err
+			}
+			if err := w.text("TS.1", v); err != nil {
+				return err
+			}
+			return w.end(name)
+		}
+		if rv.Type() == numberType {
+			return w.text(name, rv.Interface().(Number).String())
+		}
+		rt := rv.Type()
+		metaTag, err := typeMeta(rt)
+		if err != nil {
+			return err
+		}
+		if err := w.start(name); err != nil {
+			return err
+		}
+		for i := 0; i < rt.NumField(); i++ {
+			ft := rt.Field(i)
+			ct, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+			if err != nil {
+				return err
+			}
+			if !ct.Present || ct.Meta {
+				continue
+			}
+			componentName := metaTag.Name + "." + strconv.FormatInt(int64(ct.Order), 10)
+			if err := w.value(componentName, ct, rv.Field(i)); err != nil {
+				return err
+			}
+		}
+		return w.end(name)
+	}
+}
+
+// XMLDecoder decodes the HL7 v2 XML encoding into the types of a registry.
+type XMLDecoder struct {
+	registry Registry
+}
+
+// Create a new XMLDecoder.
+func NewXMLDecoder(registry Registry) *XMLDecoder {
+	return &XMLDecoder{registry: registry}
+}
+
+// Decode a trigger or a single segment.
+// A trigger is returned as a value, such as h251.ADT_A01.
+// A segment is returned as a pointer, such as *h251.PID, as from Decoder.DecodeList.
+func (d *XMLDecoder) Decode(data []byte) (any, error) {
+	root, err := parseXMLNode(data)
+	if err != nil {
+		return nil, err
+	}
+	if tr, ok := d.registry.Trigger(root.Name); ok {
+		rv := reflect.New(reflect.TypeOf(tr)).Elem()
+		r := &xmlReader{registry: d.registry, trigger: root.Name}
+		if err := r.group(root, rv); err != nil {
+			return nil, err
+		}
+		return rv.Interface(), nil
+	}
+	if seg, ok := d.registry.Segment(root.Name); ok {
+		rv := reflect.New(reflect.TypeOf(seg))
+		r := &xmlReader{registry: d.registry}
+		if err := r.segment(root, rv.Elem()); err != nil {
+			return nil, err
+		}
+		return rv.Interface(), nil
+	}
+	return nil, fmt.Errorf("unknown trigger or segment %q", root.Name)
+}
+
+// xmlNode is a decoded XML element. Namespaces and attributes are ignored.
+type xmlNode struct {
+	Name     string
+	Text     string
+	Children []*xmlNode
+}
+
+// text returns the element text, or the text of the first child element.
+// This reads a composite value, such as a TS, as its first component.
+func (n *xmlNode) text() string {
+	if len(n.Children) > 0 {
+		return n.Children[0].text()
+	}
+	return n.Text
+}
+
+func parseXMLNode(data []byte) (*xmlNode, error) {
+	x := xml.NewDecoder(bytes.NewReader(data))
+	var root *xmlNode
+	var stack []*xmlNode
+	for {
+		tok, err := x.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			n := &xmlNode{Name: tok.Name.Local}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, n)
+			} else if root == nil {
+				root = n
+			}
+			stack = append(stack, n)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				n := stack[len(stack)-1]
+				n.Text += string(tok)
+			}
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("missing root element")
+	}
+	return root, nil
+}
+
+type xmlReader struct {
+	registry Registry
+	trigger  string
+}
+
+// group decodes the children of a trigger or trigger group in order.
+// Each child is placed in the first matching field at or after the previous field.
+func (r *xmlReader) group(n *xmlNode, rv reflect.Value) error {
+	type field struct {
+		index int
+		name  string
+		group bool
+	}
+	var fieldList []field
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		ft := rt.Field(i)
+		t, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+		if err != nil {
+			return err
+		}
+		if !t.Present || t.Meta {
+			continue
+		}
+		metaTag, err := typeMeta(ft.Type)
+		if err != nil {
+			return err
+		}
+		switch metaTag.Type {
+		case structTriggerGroup:
+			fieldList = append(fieldList, field{index: i, name: r.trigger + "." + xmlGroupName(ft.Name, t), group: true})
+		case structSegment:
+			fieldList = append(fieldList, field{index: i, name: metaTag.Name})
+		}
+	}
+
+	cursor := 0
+	for _, c := range n.Children {
+		found := -1
+		for j := cursor; j < len(fieldList); j++ {
+			f := fieldList[j]
+			if f.name != c.Name {
+				continue
+			}
+			fv := rv.Field(f.index)
+			if fv.Kind() != reflect.Slice && !fv.IsZero() {
+				continue
+			}
+			found = j
+			break
+		}
+		if found < 0 {
+			return fmt.Errorf("%s: unexpected element %q", n.Name, c.Name)
+		}
+		cursor = found
+		f := fieldList[found]
+		fv := rv.Field(f.index)
+
+		et := fv.Type()
+		if et.Kind() == reflect.Pointer || et.Kind() == reflect.Slice {
+			et = et.Elem()
+		}
+		ev := reflect.New(et)
+		var err error
+		if f.group {
+			err = r.group(c, ev.Elem())
+		} else {
+			err = r.segment(c, ev.Elem())
+		}
+		if err != nil {
+			return err
+		}
+		switch fv.Kind() {
+		case reflect.Pointer:
+			fv.Set(ev)
+		case reflect.Slice:
+			fv.Set(reflect.Append(fv, ev.Elem()))
+		default:
+			fv.Set(ev.Elem())
+		}
+	}
+	return nil
+}
+
+func (r *xmlReader) segment(n *xmlNode, rv reflect.Value) error {
+	rt := rv.Type()
+	var name string
+	fieldIndex := map[int32]int{}
+	for i := 0; i < rt.NumField(); i++ {
+		ft := rt.Field(i)
+		t, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+		if err != nil {
+			return err
+		}
+		if !t.Present {
+			continue
+		}
+		if t.Meta {
+			name = t.Name
+			if ft.Type.Kind() == reflect.String {
+				rv.Field(i).SetString(t.Name)
+			}
+			continue
+		}
+		fieldIndex[t.Order] = i
+	}
+
+	vfc := segmentVaries(rv, r.registry)
+
+	var errList []error
+	// Decode varies fields last, after the field that selects their type.
+	for _, varies := range []bool{false, true} {
+		for _, c := range n.Children {
+			order, ok := xmlPosition(name, c.Name)
+			if !ok {
+				return fmt.Errorf("%s: unexpected element %q", name, c.Name)
+			}
+			i, ok := fieldIndex[order]
+			if !ok {
+				continue
+			}
+			ft := rt.Field(i)
+			if hasVaries(ft.Type) != varies {
+				continue
+			}
+			t, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+			if err != nil {
+				return err
+			}
+			err = r.value(c, t, rv.Field(i), vfc)
+			if err != nil {
+				errList = append(errList, fmt.Errorf("%s.%s: %w", name, ft.Name, err))
+			}
+		}
+	}
+	return errors.Join(errList...)
+}
+
+// xmlPosition returns the position of a field or component element, such as 5 for "PID.5".
+func xmlPosition(prefix, name string) (int32, bool) {
+	p, pos, ok := strings.Cut(name, ".")
+	if !ok || p != prefix {
+		return 0, false
+	}
+	v, err := strconv.ParseInt(pos, 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return int32(v), true
+}
+
+func (r *xmlReader) value(n *xmlNode, t tag, rv reflect.Value, vfc variesFunc) error {
+	switch rv.Kind() {
+	default:
+		return fmt.Errorf("unknown value kind: %v", rv.Kind())
+	case reflect.Pointer:
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		return r.value(n, t, rv.Elem(), vfc)
+	case reflect.Interface:
+		if vfc == nil {
+			return fmt.Errorf("missing varies type for %s", n.Name)
+		}
+		v, err := vfc(t.Order, 0)
+		if err != nil {
+			return err
+		}
+		if err := r.value(n, t, v, vfc); err != nil {
+			return err
+		}
+		rv.Set(v)
+		return nil
+	case reflect.String:
+		if rv.Len() > 0 {
+			return fmt.Errorf("%s repeats, but is not a repeating field", n.Name)
+		}
+		rv.SetString(n.text())
+		return nil
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			rv.SetBytes([]byte(n.text()))
+			return nil
+		}
+		ev := reflect.New(rv.Type().Elem()).Elem()
+		if err := r.value(n, t, ev, vfc.at(rv.Len())); err != nil {
+			return err
+		}
+		rv.Set(reflect.Append(rv, ev))
+		return nil
+	case reflect.Struct:
+		if !rv.IsZero() {
+			return fmt.Errorf("%s repeats, but is not a repeating field", n.Name)
+		}
+		if rv.Type() == timeType {
+			ld := &lineDecoder{}
+			v, err := ld.parseDateTime(n.text())
+			if err != nil {
+				return err
+			}
+			rv.Set(reflect.ValueOf(v))
+			return nil
+		}
+		if rv.Type() == numberType {
+			v, err := ParseNumber(n.text())
+			if err != nil {
+				return err
+			}
+			rv.Set(reflect.ValueOf(v))
+			return nil
+		}
+		rt := rv.Type()
+		metaTag, err := typeMeta(rt)
+		if err != nil {
+			return err
+		}
+		for _, c := range n.Children {
+			order, ok := xmlPosition(metaTag.Name, c.Name)
+			if !ok {
+				return fmt.Errorf("%s: unexpected element %q", n.Name, c.Name)
+			}
+			for i := 0; i < rt.NumField(); i++ {
+				ft := rt.Field(i)
+				ct, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+				if err != nil {
+					return err
+				}
+				if !ct.Present || ct.Meta || ct.Order != order {
+					continue
+				}
+				if err := r.value(c, ct, rv.Field(i), vfc); err != nil {
+					return err
+				}
+				break
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,155 @@
+package hl7
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	v210 "github.com/kardianos/hl7/h210"
+	v220 "github.com/kardianos/hl7/h220"
+	v231 "github.com/kardianos/hl7/h231"
+	v240 "github.com/kardianos/hl7/h240"
+	v250 "github.com/kardianos/hl7/h250"
+	v251 "github.com/kardianos/hl7/h251"
+	v270 "github.com/kardianos/hl7/h270"
+	v271 "github.com/kardianos/hl7/h271"
+	v280 "github.com/kardianos/hl7/h280"
+)
+
+func TestXMLEncode(t *testing.T) {
+	var raw = []byte(`MSH|^~\&|LAB|ORG|SYS||20250609071616||ORU^R01^ORU_R01|1|P|2.5.1
+PID|1||123||Smith^John||19800101
+OBR|1|ABC
+OBX|1|CE|GLU||1234-5^Glucose^LN||||||F
+`)
+	d := NewDecoder(v251.Registry, nil)
+	g, err := d.Decode(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bb, err := NewXMLEncoder(nil).Encode(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(bb)
+	for _, want := range []string{
+		`<ORU_R01 xmlns="urn:hl7-org:v2xml"><MSH><MSH.1>|</MSH.1><MSH.2>^~\&amp;</MSH.2>`,
+		`<MSH.9><MSG.1>ORU</MSG.1><MSG.2>R01</MSG.2><MSG.3>ORU_R01</MSG.3></MSH.9>`,
+		`<ORU_R01.PATIENT_RESULT><ORU_R01.PATIENT><PID>`,
+		`<PID.5><XPN.1>Smith</XPN.1><XPN.2>John</XPN.2></PID.5>`,
+		`<PID.7><TS.1>19800101000000</TS.1></PID.7>`,
+		`<ORU_R01.OBSERVATION><OBX><OBX.1>1</OBX.1><OBX.2>CE</OBX.2>`,
+		`<OBX.5><CE.1>1234-5</CE.1><CE.2>Glucose</CE.2><CE.3>LN</CE.3></OBX.5>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %s\n%s", want, got)
+		}
+	}
+}
+
+// TestXMLRoundTrip encodes each message to XML and decodes it again, which should encode the same.
+func TestXMLRoundTrip(t *testing.T) {
+	fsDir := filepath.Join("testdata", "roundtrip")
+	dirList, err := os.ReadDir(fsDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewDecoder(v251.Registry, nil)
+	e := NewEncoder(nil)
+	xe := NewXMLEncoder(&XMLEncodeOption{Indent: "\t"})
+	xd := NewXMLDecoder(v251.Registry)
+
+	for _, f := range dirList {
+		if f.IsDir() {
+			continue
+		}
+		name := f.Name()
+		t.Run(name, func(t *testing.T) {
+			bb, err := os.ReadFile(filepath.Join(fsDir, name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			root, err := d.Decode(bb)
+			if err != nil {
+				t.Fatal("decode", err)
+			}
+			want, err := e.Encode(root)
+			if err != nil {
+				t.Fatal("encode", err)
+			}
+			want = bytes.Clone(want)
+
+			x, err := xe.Encode(root)
+			if err != nil {
+				t.Fatal("encode xml", err)
+			}
+			root2, err := xd.Decode(x)
+			if err != nil {
+				t.Fatalf("decode xml: %v\n%s", err, x)
+			}
+			got, err := e.Encode(root2)
+			if err != nil {
+				t.Fatal("encode from xml", err)
+			}
+			if d := lineDiff(want, got); len(d) > 0 {
+				t.Fatalf("mismatch\n%s", d)
+			}
+		})
+	}
+}
+
+// TestXMLVersions encodes each segment of each version to XML and decodes it again.
+func TestXMLVersions(t *testing.T) {
+	var raw = `MSH|^~\&|ADM|ORG|SYS||20250609071616||ADT|1|P|%s
+EVN|A01|20250609071616
+PID|1||123||Smith||19800101|F
+NK1|1|Smith
+PV1|1|I
+`
+	list := []Registry{
+		v210.Registry,
+		v220.Registry,
+		v231.Registry,
+		v240.Registry,
+		v250.Registry,
+		v251.Registry,
+		v270.Registry,
+		v271.Registry,
+		v280.Registry,
+	}
+	e := NewEncoder(nil)
+	for _, reg := range list {
+		t.Run(reg.Version(), func(t *testing.T) {
+			d := NewDecoder(reg, nil)
+			list, err := d.DecodeList([]byte(strings.ReplaceAll(raw, "%s", reg.Version())))
+			if err != nil {
+				t.Fatal("decode", err)
+			}
+			for _, seg := range list {
+				want, err := e.Encode(seg)
+				if err != nil {
+					t.Fatal("encode", err)
+				}
+				want = bytes.Clone(want)
+
+				x, err := NewXMLEncoder(nil).Encode(seg)
+				if err != nil {
+					t.Fatal("encode xml", err)
+				}
+				seg2, err := NewXMLDecoder(reg).Decode(x)
+				if err != nil {
+					t.Fatalf("decode xml: %v\n%s", err, x)
+				}
+				got, err := e.Encode(seg2)
+				if err != nil {
+					t.Fatal("encode from xml", err)
+				}
+				if d := lineDiff(want, got); len(d) > 0 {
+					t.Fatalf("mismatch\n%s", d)
+				}
+			}
+		})
+	}
+}